@@ -239,7 +239,7 @@ func run(logger *slog.Logger, cfg seedConfig) error {
 		return fmt.Errorf("create media dir: %w", mkErr)
 	}
 	mediaSvc := media.NewService(
-		stores.Media, cfg.mediaDir,
+		stores.Media, media.NewLocalStorage(cfg.mediaDir),
 		config.MediaImageMaxBytesDefault, config.MediaAudioMaxBytesDefault, logger,
 	)
 
@@ -206,7 +206,7 @@ func newAudioSeedHarness(t *testing.T) audioSeedHarness {
 	stores := store.New(dbtest.Open(t), logger)
 	mediaDir := t.TempDir()
 	mediaSvc := media.NewService(
-		stores.Media, mediaDir,
+		stores.Media, media.NewLocalStorage(mediaDir),
 		config.MediaImageMaxBytesDefault, config.MediaAudioMaxBytesDefault, logger,
 	)
 
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/starquake/topbanana/internal/analytics"
+	"github.com/starquake/topbanana/internal/game"
+)
+
+// analyticsFilePerm is the permission for an ANALYTICS_SINK=file: target
+// created at startup.
+const analyticsFilePerm os.FileMode = 0o640
+
+// startAnalytics builds the ANALYTICS_SINK emitter, wires it onto the game
+// service, and starts its delivery goroutine with [runEmitter]. The returned
+// stop func waits for the final flush and closes a file sink. With no sink configured
+// nothing is wired and stop is a no-op.
+func startAnalytics(
+	ctx context.Context, sinkSpec string, logger *slog.Logger, gameService *game.Service,
+) (func(), error) {
+	if sinkSpec == "" {
+		return func() {}, nil
+	}
+	sink, closer, err := openAnalyticsSink(sinkSpec)
+	if err != nil {
+		return nil, err
+	}
+	emitter := analytics.NewEmitter(sink, logger)
+	gameService.SetAnalyticsEmitter(emitter)
+	stopEmitter := runEmitter(ctx, emitter, logger, "analytics events dropped on a full queue")
+	logger.InfoContext(ctx, "analytics export enabled", slog.String("sink", analyticsSinkKind(sinkSpec)))
+
+	return func() {
+		stopEmitter()
+		if closer == nil {
+			return
+		}
+		if cErr := closer.Close(); cErr != nil {
+			logger.ErrorContext(ctx, "error closing analytics file", slog.Any("err", cErr))
+		}
+	}, nil
+}
+
+// startGameEventLog wires the game event log onto the game service: an
+// emitter, the same queue the analytics export uses, delivering to the
+// game_events table. Its stop func must run before the database closes so
+// the final flush still has somewhere to write.
+func startGameEventLog(
+	ctx context.Context, logger *slog.Logger, events analytics.Sink, gameService *game.Service,
+) func() {
+	emitter := analytics.NewEmitter(events, logger)
+	gameService.SetEventLog(emitter)
+
+	return runEmitter(ctx, emitter, logger, "game events dropped on a full queue")
+}
+
+// runEmitter starts emitter's delivery goroutine on a context detached from
+// the shutdown signal, so events emitted while the HTTP server drains are
+// still delivered. The returned stop func cancels it, waits for the final
+// flush, and logs droppedMsg if the queue ever overflowed.
+func runEmitter(ctx context.Context, emitter *analytics.Emitter, logger *slog.Logger, droppedMsg string) func() {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		emitter.Run(runCtx)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+		if dropped := emitter.Dropped(); dropped > 0 {
+			logger.WarnContext(ctx, droppedMsg, slog.Int64("dropped", dropped))
+		}
+	}
+}
+
+// openAnalyticsSink resolves a validated ANALYTICS_SINK value. The closer is
+// non-nil only for a file sink.
+func openAnalyticsSink(spec string) (analytics.Sink, io.Closer, error) {
+	switch {
+	case spec == "stdout":
+		return analytics.NewWriterSink(os.Stdout), nil, nil
+	case strings.HasPrefix(spec, "file:"):
+		path := strings.TrimPrefix(spec, "file:")
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, analyticsFilePerm)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open analytics file: %w", err)
+		}
+
+		return analytics.NewWriterSink(f), f, nil
+	default:
+		return analytics.NewHTTPSink(spec), nil, nil
+	}
+}
+
+// analyticsSinkKind names the sink for the startup log without echoing a
+// collector URL, which may carry a token in its query string.
+func analyticsSinkKind(spec string) string {
+	if spec == "stdout" || strings.HasPrefix(spec, "file:") {
+		return spec
+	}
+
+	return "http"
+}
@@ -20,12 +20,14 @@ import (
 	"github.com/starquake/topbanana/internal/database"
 	"github.com/starquake/topbanana/internal/envtag"
 	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/health"
 	"github.com/starquake/topbanana/internal/leaderboard"
 	"github.com/starquake/topbanana/internal/livesession"
 	"github.com/starquake/topbanana/internal/mailer"
 	"github.com/starquake/topbanana/internal/media"
 	"github.com/starquake/topbanana/internal/server"
 	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/tracing"
 	"github.com/starquake/topbanana/internal/version"
 )
 
@@ -39,20 +41,26 @@ const (
 	// a tick past its TTL, infrequent enough that the DELETE shows up
 	// once an hour in the slow-query log.
 	tokenSweepInterval = time.Hour
+	// maintenanceCheckInterval is how often the database maintenance job
+	// checks whether DB_MAINTENANCE_WINDOW is open. The check is a clock
+	// comparison, so a minute costs nothing and keeps even a short window
+	// from slipping between two ticks.
+	maintenanceCheckInterval = time.Minute
 )
 
 // Option configures a [Run] invocation. Used by integration tests to
 // override values that have no env-var hook (the HTTP server's write
 // timeout and the SSE handlers' heartbeat intervals, used by the SSE
 // heartbeat regression tests to keep the assertion inside a sub-second
-// window without leaning on the production 10s / 25s defaults). No
-// production caller passes options.
+// window without leaning on the production 10s / 25s defaults, and the
+// game clock). No production caller passes options.
 type Option func(*options)
 
 type options struct {
 	writeTimeout                  time.Duration
 	leaderboardHeartbeatInterval  time.Duration
 	sessionEventHeartbeatInterval time.Duration
+	now                           func() time.Time
 }
 
 // WithWriteTimeout overrides the HTTP server's WriteTimeout. The SSE
@@ -91,20 +99,40 @@ func WithSessionEventHeartbeatInterval(d time.Duration) Option {
 	}
 }
 
-// newRealtime bundles the process-local pub/sub deps and the resolved
-// streaming-timer settings into a [server.Realtime] for [server.New].
+// WithClock overrides the clock the game service and the live-session
+// runner read, so question windows, answer times and phase beats follow now
+// rather than the wall clock. The test server harness seeds it so a played
+// game's timestamps are reproducible. A nil now is ignored.
+func WithClock(now func() time.Time) Option {
+	return func(o *options) {
+		if now != nil {
+			o.now = now
+		}
+	}
+}
+
+// funcClock adapts a clock function to [livesession.Clock].
+type funcClock func() time.Time
+
+func (f funcClock) Now() time.Time { return f() }
+
+// newRealtime bundles the process-local pub/sub deps, the shutdown drain
+// the SSE streams watch, and the resolved streaming-timer settings into a
+// [server.Realtime] for [server.New].
 func newRealtime(
 	leaderboardHub *leaderboard.Hub,
-	sessionService *livesession.Service,
-	sessionHub *livesession.Hub,
+	sessions sessionRuntime,
+	drain *health.Drain,
 	o options,
 ) server.Realtime {
 	return server.Realtime{
 		LeaderboardHub:                leaderboardHub,
-		SessionService:                sessionService,
-		SessionHub:                    sessionHub,
+		SessionService:                sessions.service,
+		SessionHub:                    sessions.hub,
+		SessionRunner:                 sessions.runner,
 		LeaderboardHeartbeatInterval:  o.leaderboardHeartbeatInterval,
 		SessionEventHeartbeatInterval: o.sessionEventHeartbeatInterval,
+		Drain:                         drain,
 	}
 }
 
@@ -141,7 +169,10 @@ func Run(
 	signalCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	logger := slog.New(slog.NewTextHandler(stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	// Debug until LOG_LEVEL is parsed, so a config error is never filtered.
+	level := new(slog.LevelVar)
+	level.Set(slog.LevelDebug)
+	logger := slog.New(slog.NewTextHandler(stdout, &slog.HandlerOptions{Level: level}))
 
 	cfg, err := config.Parse(getenv)
 	if err != nil {
@@ -150,45 +181,81 @@ func Run(
 
 		return fmt.Errorf("%s: %w", msg, err)
 	}
+	level.Set(cfg.LogLevel)
 	logConfigSummary(signalCtx, logger, cfg)
 
-	if err = ensureMediaDir(signalCtx, cfg.MediaDir, logger); err != nil {
-		return err
+	if cfg.MediaStorage == config.MediaStorageLocal {
+		if err = ensureMediaDir(signalCtx, cfg.MediaDir, logger); err != nil {
+			return err
+		}
 	}
 
 	conn, err := setupDB(signalCtx, cfg.DatabaseConfig(), logger)
 	if err != nil {
 		return err
 	}
+	// Deferred first so it runs last: by then the HTTP server has drained,
+	// and the session runner, the analytics and trace flushes, and the
+	// maintenance job have all stopped, so nothing writes to a closed pool.
 	defer func() {
 		conErr := conn.Close()
 		if conErr != nil {
 			logger.ErrorContext(signalCtx, "error closing database connection", slog.Any("err", conErr))
+
+			return
+		}
+		logger.InfoContext(signalCtx, "database connection closed")
+	}()
+
+	reader, err := setupReadDB(signalCtx, cfg.DatabaseConfig(), logger)
+	if err != nil {
+		return err
+	}
+	database.PublishPoolStats("writer", conn)
+	database.PublishPoolStats("reader", reader)
+	defer func() {
+		if rErr := reader.Close(); rErr != nil {
+			logger.ErrorContext(signalCtx, "error closing read-only database connection", slog.Any("err", rErr))
 		}
 	}()
 
 	envtag.Set(cfg.EnvTitleTag())
 	version.SetEnv(cfg.AppEnvironment)
 
-	stores := store.New(conn, logger)
+	stores := store.NewWithReader(conn, reader, logger)
 
 	if err = bootstrapInitialAdmin(signalCtx, cfg, store.NewPlayerStore(conn, logger), logger); err != nil {
 		return err
 	}
 
 	startSweeps(signalCtx, cfg, logger, stores)
+	stopMaintenance := startDBMaintenance(signalCtx, cfg.DBMaintenanceWindow, logger, conn)
+	defer stopMaintenance()
 	gameService, leaderboardHub := newGameService(cfg, logger, stores)
+	if o.now != nil {
+		gameService.SetClock(o.now)
+	}
+	stopAnalytics, err := startAnalytics(signalCtx, cfg.AnalyticsSink, logger, gameService)
+	if err != nil {
+		return err
+	}
+	defer stopAnalytics()
+	stopEventLog := startGameEventLog(signalCtx, logger, stores.GameEvents, gameService)
+	defer stopEventLog()
+	tracer, stopTracing := startTracing(signalCtx, cfg, logger)
+	defer stopTracing()
 	// Own the runner's context so shutdown waits for its goroutine to exit
 	// before Run returns - else it logs past test teardown under -race (#608).
 	runnerCtx, stopRunner := context.WithCancel(signalCtx)
-	sessionService, sessionHub, runnerDone := startSessionRunner(runnerCtx, cfg, logger, stores, gameService)
+	sessions := startSessionRunner(runnerCtx, cfg, logger, stores, gameService, o.now)
 	defer func() {
 		stopRunner()
-		<-runnerDone
+		<-sessions.done
 	}()
 
-	realtime := newRealtime(leaderboardHub, sessionService, sessionHub, o)
-	srv, emailTasks, err := buildServer(signalCtx, cfg, logger, stores, gameService, realtime)
+	drain := health.NewDrain()
+	realtime := newRealtime(leaderboardHub, sessions, drain, o)
+	handlers, emailTasks, err := buildServer(signalCtx, cfg, logger, stores, gameService, realtime, tracer)
 	if err != nil {
 		return err
 	}
@@ -200,12 +267,26 @@ func Run(
 	} else {
 		logger.InfoContext(signalCtx, "listener overridden")
 	}
+	sides, err := sideListeners(signalCtx, cfg, logger, handlers.admin)
+	if err != nil {
+		return err
+	}
+
+	return runHTTPServer(
+		ctx, signalCtx, ln, handlers.public, sides, newShutdownPlan(cfg, drain, emailTasks), logger, o.writeTimeout,
+	)
+}
 
-	return runHTTPServer(ctx, signalCtx, ln, srv, emailTasks, logger, o.writeTimeout)
+// serverHandlers is what buildServer builds: the handler the main listener
+// serves and, when ADMIN_PORT moves the admin UI to a listener of its own,
+// the handler for that listener. admin is nil otherwise.
+type serverHandlers struct {
+	public http.Handler
+	admin  http.Handler
 }
 
 // buildServer constructs the mailer, the background-task tracker, and the HTTP
-// handler. It returns the tracker alongside the handler so runHTTPServer can
+// handlers. It returns the tracker alongside the handler so runHTTPServer can
 // drain the detached email-dispatch goroutines the handlers spawn after the
 // HTTP server stops accepting requests and before the deferred conn.Close
 // runs, so a dispatch never writes to a closed DB on shutdown (#740, #741).
@@ -216,20 +297,26 @@ func buildServer(
 	stores *store.Stores,
 	gameService *game.Service,
 	realtime server.Realtime,
-) (http.Handler, *bgtasks.Tracker, error) {
+	tracer *tracing.Tracer,
+) (serverHandlers, *bgtasks.Tracker, error) {
 	mailerTester, mailerStatus, err := buildMailer(ctx, cfg, logger)
 	if err != nil {
-		return nil, nil, err
+		return serverHandlers{}, nil, err
 	}
 	emailTasks := bgtasks.New()
 	mail := server.Mail{Tester: mailerTester, Status: mailerStatus, Tasks: emailTasks}
 
-	return server.New(logger, stores, gameService, realtime, cfg, mail), emailTasks, nil
+	if cfg.AdminPort == "" {
+		return serverHandlers{public: server.New(logger, stores, gameService, realtime, cfg, mail, tracer)}, emailTasks, nil
+	}
+	public, adminHandler := server.NewSplit(logger, stores, gameService, realtime, cfg, mail, tracer)
+
+	return serverHandlers{public: public, admin: adminHandler}, emailTasks, nil
 }
 
-// newGameService builds the game service with the reveal-delay override
-// applied and the leaderboard hub wired as its publisher. The hub is the
-// process-local pub/sub for the leaderboard SSE stream (#239): the same
+// newGameService builds the game service with the reveal-delay and minimum
+// question interval overrides applied and the leaderboard hub wired as its
+// publisher. The hub is the process-local pub/sub for the leaderboard SSE stream (#239): the same
 // instance feeds the game service (publisher) and the server (subscriber
 // side) so submitted answers fan out to live viewers. Returns both so the
 // server can subscribe to the same hub.
@@ -238,33 +325,51 @@ func newGameService(cfg *config.Config, logger *slog.Logger, stores *store.Store
 	if cfg.RevealDelay > 0 {
 		gameService.SetRevealDelay(cfg.RevealDelay)
 	}
+	gameService.SetMinQuestionInterval(cfg.MinQuestionInterval)
+	gameService.SetHintPenalty(cfg.HintPenaltyPercent)
 	leaderboardHub := leaderboard.NewHub()
 	gameService.SetLeaderboardPublisher(leaderboardHub)
 
 	return gameService, leaderboardHub
 }
 
+// sessionRuntime is what startSessionRunner wires: the live-session service,
+// hub and runner for the server, and done, which closes when the runner
+// goroutine exits.
+type sessionRuntime struct {
+	service *livesession.Service
+	hub     *livesession.Hub
+	runner  *livesession.Runner
+	done    <-chan struct{}
+}
+
 // startSessionRunner wires the hosted live-session service, its SSE tick hub,
 // and the runner over one set of instances: the service and runner both
 // publish ticks through the hub, the runner advances phases on the server
 // clock, and Start hands a started session to the runner via SetAdvancer. The
 // runner is one goroutine bound to ctx (the shutdown context), so it stops
-// before the DB closes. Returns the service + hub for server wiring plus a
-// done channel that closes when the runner goroutine exits, so the caller
-// can wait for it on shutdown rather than leaking a still-logging goroutine
-// past Run (MP-5 / #682, #608).
+// before the DB closes. Returns the service, hub and runner for server wiring
+// plus a done channel that closes when the runner goroutine exits, so the
+// caller can wait for it on shutdown rather than leaking a still-logging
+// goroutine past Run (MP-5 / #682, #608). A non-nil now replaces the runner's
+// wall clock (see [WithClock]).
 func startSessionRunner(
 	ctx context.Context,
 	cfg *config.Config,
 	logger *slog.Logger,
 	stores *store.Stores,
 	scorer livesession.Scorer,
-) (*livesession.Service, *livesession.Hub, <-chan struct{}) {
+	now func() time.Time,
+) sessionRuntime {
 	service := livesession.NewService(stores.LiveSessions, stores.Quizzes, logger)
 	hub := livesession.NewHub()
 	service.SetPublisher(hub)
 	service.SetStartCountdown(cfg.SessionStartCountdown)
+	service.SetMaxPlayers(cfg.SessionMaxPlayers)
 	runner := livesession.NewRunner(stores.LiveSessions, stores.Quizzes, hub, scorer, logger, runnerConfig(cfg))
+	if now != nil {
+		runner.SetClock(funcClock(now))
+	}
 	service.SetAdvancer(runner)
 	done := make(chan struct{})
 	go func() {
@@ -272,7 +377,7 @@ func startSessionRunner(
 		runner.Run(ctx)
 	}()
 
-	return service, hub, done
+	return sessionRuntime{service: service, hub: hub, runner: runner, done: done}
 }
 
 // runnerBeatTickDivisor keeps the runner's per-beat scan tick a fraction of
@@ -336,11 +441,12 @@ type inviteSweeper interface {
 
 // retentionSweeper is the slice of the retention store the sweep calls.
 // Narrow interface so the unit test can drive the loop without a real DB.
-// Each method takes its retention window in days; the cutoff date is then
-// computed in SQL.
+// Each method takes its retention window in days (minutes for never-started
+// games); the cutoff date is then computed in SQL.
 type retentionSweeper interface {
 	SweepStaleAnonymousPlayers(ctx context.Context, days int) error
 	SweepAbandonedGames(ctx context.Context, days int) error
+	SweepNeverStartedGames(ctx context.Context, minutes int) error
 	SweepStaleAuditLog(ctx context.Context, days int) error
 }
 
@@ -369,6 +475,9 @@ func runRetentionSweep(ctx context.Context, logger *slog.Logger, retention reten
 	if err := retention.SweepAbandonedGames(ctx, store.AbandonedGameDays); err != nil {
 		logger.WarnContext(ctx, "abandoned-game retention sweep failed", slog.Any("err", err))
 	}
+	if err := retention.SweepNeverStartedGames(ctx, store.NeverStartedGameMinutes); err != nil {
+		logger.WarnContext(ctx, "never-started-game retention sweep failed", slog.Any("err", err))
+	}
 	if err := retention.SweepStaleAuditLog(ctx, store.AdminAuditRetentionDays); err != nil {
 		logger.WarnContext(ctx, "admin-audit retention sweep failed", slog.Any("err", err))
 	}
@@ -380,7 +489,9 @@ func runRetentionSweep(ctx context.Context, logger *slog.Logger, retention reten
 // not-ready row is the residue of a cancelled upload, hidden from the library
 // until it is dropped, so it is bounded but not urgent (#992, #472).
 func startSweeps(ctx context.Context, cfg *config.Config, logger *slog.Logger, stores *store.Stores) {
-	mediaSweep := media.NewService(stores.Media, cfg.MediaDir, cfg.MediaImageMaxBytes, cfg.MediaAudioMaxBytes, logger)
+	mediaSweep := media.NewService(
+		stores.Media, server.NewMediaStorage(cfg), cfg.MediaImageMaxBytes, cfg.MediaAudioMaxBytes, logger,
+	)
 	sweepExpiredAtStartup(ctx, logger, stores, mediaSweep)
 	go runTokenSweep(
 		ctx, logger,
@@ -452,6 +563,72 @@ func runTokenSweep(
 	}
 }
 
+// startDBMaintenance launches the database maintenance job when
+// DB_MAINTENANCE_WINDOW is set. The returned stop func cancels the job and
+// waits for an in-flight pass to finish, so Run's deferred conn.Close never
+// lands mid-VACUUM. With no window nothing runs and stop is a no-op.
+func startDBMaintenance(
+	ctx context.Context, window *config.QuietWindow, logger *slog.Logger, conn *sql.DB,
+) func() {
+	if window == nil {
+		return func() {}
+	}
+	logger.InfoContext(ctx, "database maintenance scheduled", slog.String("window", window.String()))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runDBMaintenance(runCtx, logger, *window, func(ctx context.Context) (database.MaintenanceResult, error) {
+			return database.Maintain(ctx, conn)
+		}, maintenanceCheckInterval)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// runDBMaintenance ticks at interval and runs maintain once per opening of
+// window: the first tick inside the window runs it, and the ticks after wait
+// until a full window length has passed. Each pass logs its duration and the
+// bytes it reclaimed; a failure is logged at warn and the next window tries
+// again. Returns when ctx is cancelled.
+func runDBMaintenance(
+	ctx context.Context,
+	logger *slog.Logger,
+	window config.QuietWindow,
+	maintain func(context.Context) (database.MaintenanceResult, error),
+	interval time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var lastRun time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if !window.Contains(now) || (!lastRun.IsZero() && now.Sub(lastRun) < window.Length()) {
+				continue
+			}
+			lastRun = now
+			res, err := maintain(ctx)
+			if err != nil {
+				logger.WarnContext(ctx, "database maintenance failed",
+					slog.Duration("duration", res.Duration), slog.Any("err", err))
+
+				continue
+			}
+			logger.InfoContext(ctx, "database maintenance finished",
+				slog.Duration("duration", res.Duration),
+				slog.Int64("reclaimed_bytes", res.ReclaimedBytes),
+				slog.Bool("full_vacuum", res.FullVacuum))
+		}
+	}
+}
+
 // buildMailer constructs the mailer + status view the admin diagnostics
 // page consumes (#321). When SMTP is unconfigured we wrap the no-op
 // mailer in a Tester so the same ring buffer surfaces "tried to send
@@ -502,8 +679,26 @@ func buildMailer(
 }
 
 func setupDB(signalCtx context.Context, dbc config.DatabaseConfig, logger *slog.Logger) (*sql.DB, error) {
+	conn, err := openDB(signalCtx, dbc, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = database.Migrate(conn); err != nil {
+		msg := "error migrating database"
+		logger.ErrorContext(signalCtx, msg, slog.Any("err", err))
+
+		return nil, fmt.Errorf("%s: %w", msg, err)
+	}
+
+	return conn, nil
+}
+
+// openDB opens the read-write pool without migrating it, for the
+// `migrate` subcommand, which drives the schema itself.
+func openDB(ctx context.Context, dbc config.DatabaseConfig, logger *slog.Logger) (*sql.DB, error) {
 	conn, err := database.Open(
-		signalCtx,
+		ctx,
 		dbc.Driver,
 		dbc.URI,
 		dbc.MaxOpenConns,
@@ -511,19 +706,33 @@ func setupDB(signalCtx context.Context, dbc config.DatabaseConfig, logger *slog.
 		dbc.ConnMaxLifetime,
 	)
 	if err != nil {
-		logger.ErrorContext(signalCtx, "error opening database connection", slog.Any("err", err))
+		logger.ErrorContext(ctx, "error opening database connection", slog.Any("err", err))
 
 		return nil, fmt.Errorf("error opening database connection: %w", err)
 	}
 
-	if err = database.Migrate(conn); err != nil {
-		msg := "error migrating database"
-		logger.ErrorContext(signalCtx, msg, slog.Any("err", err))
+	return conn, nil
+}
 
-		return nil, fmt.Errorf("%s: %w", msg, err)
+// setupReadDB opens the read-only pool the reporting store methods use. It
+// runs after setupDB so the schema is already migrated; the reader never
+// migrates (it cannot write).
+func setupReadDB(signalCtx context.Context, dbc config.DatabaseConfig, logger *slog.Logger) (*sql.DB, error) {
+	reader, err := database.OpenReadOnly(
+		signalCtx,
+		dbc.Driver,
+		dbc.URI,
+		dbc.MaxOpenConns,
+		dbc.MaxIdleConns,
+		dbc.ConnMaxLifetime,
+	)
+	if err != nil {
+		logger.ErrorContext(signalCtx, "error opening read-only database connection", slog.Any("err", err))
+
+		return nil, fmt.Errorf("error opening read-only database connection: %w", err)
 	}
 
-	return conn, nil
+	return reader, nil
 }
 
 // logConfigSummary emits the operator-relevant config knobs at startup so
@@ -534,7 +743,11 @@ func setupDB(signalCtx context.Context, dbc config.DatabaseConfig, logger *slog.
 func logConfigSummary(ctx context.Context, logger *slog.Logger, cfg *config.Config) {
 	logger.InfoContext(ctx, "config parsed",
 		slog.String("app_env", cfg.AppEnvironment),
+		slog.String("log_level", cfg.LogLevel.String()),
 		slog.Bool("secure_cookies", cfg.SecureCookies()),
+		slog.String("hsts", cfg.StrictTransportSecurity()),
+		slog.String("http_redirect_port", cfg.HTTPRedirectPort),
+		slog.String("admin_addr", adminAddr(cfg)),
 		slog.Bool("registration_enabled", cfg.RegistrationEnabled),
 		slog.Bool("google_login_enabled", cfg.GoogleLoginEnabled()),
 	)
@@ -552,3 +765,86 @@ func listener(ctx context.Context, cfg *config.Config, logger *slog.Logger) (net
 
 	return ln, nil
 }
+
+// adminAddr is the admin listener's address for the config summary, empty
+// when the admin UI stays on the main listener.
+func adminAddr(cfg *config.Config) string {
+	if cfg.AdminPort == "" {
+		return ""
+	}
+
+	return net.JoinHostPort(cfg.AdminHost, cfg.AdminPort)
+}
+
+// sideListeners opens the optional listeners served beside the main one:
+// the HTTPS redirect and, when adminHandler is non-nil, the admin UI. If a
+// later one fails to open, the ones already open are closed again.
+func sideListeners(
+	ctx context.Context,
+	cfg *config.Config,
+	logger *slog.Logger,
+	adminHandler http.Handler,
+) ([]*sideListener, error) {
+	var sides []*sideListener
+	redirect, err := httpsRedirectListener(ctx, cfg, logger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HTTPS redirect listener: %w", err)
+	}
+	if redirect != nil {
+		sides = append(sides, redirect)
+	}
+	if adminHandler != nil {
+		adminLn, aErr := adminListener(ctx, cfg, logger, adminHandler)
+		if aErr != nil {
+			for _, side := range sides {
+				_ = side.ln.Close()
+			}
+
+			return nil, fmt.Errorf("error creating admin listener: %w", aErr)
+		}
+		sides = append(sides, adminLn)
+	}
+
+	return sides, nil
+}
+
+// adminListener opens the admin UI's own listener on ADMIN_HOST:ADMIN_PORT.
+func adminListener(
+	ctx context.Context,
+	cfg *config.Config,
+	logger *slog.Logger,
+	handler http.Handler,
+) (*sideListener, error) {
+	addr := net.JoinHostPort(cfg.AdminHost, cfg.AdminPort)
+	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+	if err != nil {
+		logger.ErrorContext(ctx, "error listening on "+addr, slog.Any("err", err))
+
+		return nil, fmt.Errorf("error listening on %s: %w", addr, err)
+	}
+
+	return &sideListener{ln: ln, handler: handler, role: adminRole, serving: "serving the admin UI"}, nil
+}
+
+// httpsRedirectListener opens the optional plain-HTTP listener on
+// HOST:HTTP_REDIRECT_PORT that sends every visitor to BASE_URL over HTTPS.
+// It returns nil when HTTP_REDIRECT_PORT is unset.
+func httpsRedirectListener(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*sideListener, error) {
+	if cfg.HTTPRedirectPort == "" {
+		return nil, nil //nolint:nilnil // nil listener means "no redirect listener"; not an error.
+	}
+	addr := net.JoinHostPort(cfg.Host, cfg.HTTPRedirectPort)
+	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+	if err != nil {
+		logger.ErrorContext(ctx, "error listening on "+addr, slog.Any("err", err))
+
+		return nil, fmt.Errorf("error listening on %s: %w", addr, err)
+	}
+
+	return &sideListener{
+		ln:      ln,
+		handler: server.NewHTTPSRedirect(cfg.BaseURL),
+		role:    "the HTTPS redirect",
+		serving: "redirecting plain HTTP to HTTPS",
+	}, nil
+}
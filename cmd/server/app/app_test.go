@@ -141,11 +141,14 @@ type stubRetentionSweep struct {
 	mu                         sync.Mutex
 	anonCalls                  int
 	gameCalls                  int
+	neverStartedCalls          int
 	auditCalls                 int
 	lastAnonDays               int
 	lastGameDays               int
+	lastNeverStartedMinutes    int
 	lastAuditDays              int
 	anonErr, gameErr, auditErr error
+	neverStartedErr            error
 }
 
 func (s *stubRetentionSweep) SweepStaleAnonymousPlayers(_ context.Context, days int) error {
@@ -168,6 +171,16 @@ func (s *stubRetentionSweep) SweepAbandonedGames(_ context.Context, days int) er
 	return s.gameErr
 }
 
+func (s *stubRetentionSweep) SweepNeverStartedGames(_ context.Context, minutes int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.neverStartedCalls++
+	s.lastNeverStartedMinutes = minutes
+
+	return s.neverStartedErr
+}
+
 func (s *stubRetentionSweep) SweepStaleAuditLog(_ context.Context, days int) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -192,6 +205,20 @@ func (s *stubRetentionSweep) GameCalls() int {
 	return s.gameCalls
 }
 
+func (s *stubRetentionSweep) NeverStartedCalls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.neverStartedCalls
+}
+
+func (s *stubRetentionSweep) LastNeverStartedMinutes() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lastNeverStartedMinutes
+}
+
 func (s *stubRetentionSweep) LastAnonDays() int {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -332,6 +359,53 @@ func TestRunTokenSweep_ContinuesAfterError(t *testing.T) {
 	<-done
 }
 
+// TestRunDBMaintenance_OncePerWindow pins the scheduling contract: inside an
+// open window the job runs on the first tick and not again until a full
+// window length has passed, and outside the window it never runs.
+func TestRunDBMaintenance_OncePerWindow(t *testing.T) {
+	t.Parallel()
+
+	run := func(t *testing.T, window config.QuietWindow) int {
+		t.Helper()
+		var mu sync.Mutex
+		calls := 0
+		maintain := func(context.Context) (database.MaintenanceResult, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+
+			return database.MaintenanceResult{}, errors.New("maintenance failed")
+		}
+
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		RunDBMaintenance(ctx, slog.New(slog.DiscardHandler), window, maintain, time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		return calls
+	}
+
+	t.Run("open all day runs once", func(t *testing.T) {
+		t.Parallel()
+		if got, want := run(t, config.QuietWindow{Start: 0, End: 24 * time.Hour}), 1; got != want {
+			t.Errorf("maintenance calls = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("closed window never runs", func(t *testing.T) {
+		t.Parallel()
+		h, m, _ := time.Now().Clock()
+		now := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+		start := (now + 6*time.Hour) % (24 * time.Hour)
+		window := config.QuietWindow{Start: start, End: start + time.Hour}
+		if got := run(t, window); got != 0 {
+			t.Errorf("maintenance calls = %d, want 0 outside %s", got, window)
+		}
+	})
+}
+
 // TestRunRetentionSweep_PassesConfiguredWindows pins that the helper wires the
 // production retention windows (the store package constants) into each sweep,
 // so the day counts have a single source of truth in Go rather than drifting
@@ -349,6 +423,9 @@ func TestRunRetentionSweep_PassesConfiguredWindows(t *testing.T) {
 	if got, want := retention.LastGameDays(), store.AbandonedGameDays; got != want {
 		t.Errorf("game sweep days = %d, want %d", got, want)
 	}
+	if got, want := retention.LastNeverStartedMinutes(), store.NeverStartedGameMinutes; got != want {
+		t.Errorf("never-started sweep minutes = %d, want %d", got, want)
+	}
 	if got, want := retention.LastAuditDays(), store.AdminAuditRetentionDays; got != want {
 		t.Errorf("audit sweep days = %d, want %d", got, want)
 	}
@@ -361,8 +438,9 @@ func TestRunRetentionSweep_RunsRemainingSweepsAfterEarlierError(t *testing.T) {
 	t.Parallel()
 
 	retention := &stubRetentionSweep{
-		anonErr: errors.New("anon sweep failed"),
-		gameErr: errors.New("game sweep failed"),
+		anonErr:         errors.New("anon sweep failed"),
+		gameErr:         errors.New("game sweep failed"),
+		neverStartedErr: errors.New("never-started sweep failed"),
 	}
 
 	RunRetentionSweep(t.Context(), slog.New(slog.DiscardHandler), retention)
@@ -373,6 +451,9 @@ func TestRunRetentionSweep_RunsRemainingSweepsAfterEarlierError(t *testing.T) {
 	if got, want := retention.GameCalls(), 1; got != want {
 		t.Errorf("game sweep calls = %d, want %d", got, want)
 	}
+	if got, want := retention.NeverStartedCalls(), 1; got != want {
+		t.Errorf("never-started sweep calls = %d, want %d", got, want)
+	}
 	if got, want := retention.AuditCalls(), 1; got != want {
 		t.Errorf("audit sweep calls = %d, want %d", got, want)
 	}
@@ -14,10 +14,13 @@ import (
 
 	"golang.org/x/term"
 
+	"github.com/starquake/topbanana/internal/admin"
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/demo"
 	"github.com/starquake/topbanana/internal/media"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/server"
 	"github.com/starquake/topbanana/internal/store"
 )
 
@@ -518,7 +521,9 @@ func SeedDemo(ctx context.Context, getenv func(string) string, stderr io.Writer)
 	}()
 
 	stores := store.New(conn, logger)
-	mediaSvc := media.NewService(stores.Media, cfg.MediaDir, cfg.MediaImageMaxBytes, cfg.MediaAudioMaxBytes, logger)
+	mediaSvc := media.NewService(
+		stores.Media, server.NewMediaStorage(cfg), cfg.MediaImageMaxBytes, cfg.MediaAudioMaxBytes, logger,
+	)
 
 	if err := demo.SeedIfEnabled(ctx, cfg, stores, mediaSvc, logger, archives); err != nil {
 		return fmt.Errorf(seedDemoWrap, err)
@@ -549,6 +554,55 @@ func readDemoArchives(dir string) ([][]byte, error) {
 	return archives, nil
 }
 
+// seedAdminID is the admin player migration 20260111110308_add_admin_player
+// creates; SeedQuiz attributes seeded quizzes to it, as cmd/seed-dev does.
+const seedAdminID int64 = 1
+
+// SeedQuiz imports the quiz JSON document at path (the admin import format)
+// in the given play mode, owned by the seeded admin, after migrating the
+// database. A quiz whose title is already taken is reported and skipped, so
+// re-running a seed script is a no-op. The server may be running against
+// the same database: this is one ordinary insert.
+func SeedQuiz(ctx context.Context, getenv func(string) string, stdout, stderr io.Writer, path, mode string) error {
+	logger := slog.New(slog.NewTextHandler(stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	cfg, err := config.Parse(getenv)
+	if err != nil {
+		return fmt.Errorf("seed: parse config: %w", err)
+	}
+	raw, err := os.ReadFile(path) //nolint:gosec // seeding the operator's chosen file is the point.
+	if err != nil {
+		return fmt.Errorf("seed: %w", err)
+	}
+
+	conn, err := setupDB(ctx, cfg.DatabaseConfig(), logger)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := conn.Close(); cerr != nil {
+			logger.ErrorContext(ctx, "error closing database connection", slog.Any("err", cerr))
+		}
+	}()
+
+	stores := store.New(conn, logger)
+	var result string
+	qz, err := admin.ImportQuizJSON(ctx, stores.Quizzes, string(raw), mode, seedAdminID)
+	switch {
+	case errors.Is(err, quiz.ErrSlugTaken):
+		result = "a quiz with this title already exists, skipped"
+	case err != nil:
+		return fmt.Errorf("seed %s: %w", path, err)
+	default:
+		result = fmt.Sprintf("seeded quiz %q (id %d)", qz.Title, qz.ID)
+	}
+	if _, err = fmt.Fprintf(stdout, "%s: %s\n", path, result); err != nil {
+		return fmt.Errorf("seed: write result: %w", err)
+	}
+
+	return nil
+}
+
 // readNewPassword prompts for a new password twice (input + confirmation)
 // and returns the password if the two reads match and the value falls within
 // the [auth.MinPasswordLength] / [auth.MaxPasswordLength] range. Length is
@@ -709,3 +763,16 @@ func Check(ctx context.Context, getenv func(string) string, stdout io.Writer) er
 
 	return nil
 }
+
+// PrintConfig parses the config and writes the resolved settings to stdout as
+// NAME=value lines, secrets redacted, then returns without touching the
+// database. Lets an operator see what the server would actually run with -
+// defaults, derived values and all - before starting it.
+func PrintConfig(getenv func(string) string, stdout io.Writer) error {
+	cfg, err := config.Parse(getenv)
+	if err != nil {
+		return fmt.Errorf(opWrap, "error parsing config", err)
+	}
+
+	return cfg.Print(stdout) //nolint:wrapcheck // Print already wraps its write error.
+}
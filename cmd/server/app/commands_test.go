@@ -4,13 +4,17 @@ import (
 	"bytes"
 	"database/sql"
 	"errors"
+	"io"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	_ "modernc.org/sqlite"
 
 	. "github.com/starquake/topbanana/cmd/server/app"
+	"github.com/starquake/topbanana/internal/admin"
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/database"
@@ -954,3 +958,150 @@ func TestResetPassword_ClosedStdin_ReturnsError(t *testing.T) {
 		t.Errorf("original password should still validate after empty stdin, err = %v", err)
 	}
 }
+
+func TestPrintConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("prints resolved settings", func(t *testing.T) {
+		t.Parallel()
+
+		getenv := func(key string) string {
+			return map[string]string{"APP_ENV": "development", "PORT": "9000"}[key]
+		}
+
+		var stdout bytes.Buffer
+		if err := PrintConfig(getenv, &stdout); err != nil {
+			t.Fatalf("PrintConfig err = %v, want nil", err)
+		}
+		for _, want := range []string{"APP_ENV=development\n", "PORT=9000\n", "SESSION_KEY=<redacted>\n"} {
+			if !strings.Contains(stdout.String(), want) {
+				t.Errorf("stdout = %q, want substring %q", stdout.String(), want)
+			}
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		getenv := func(key string) string {
+			return map[string]string{"APP_ENV": "development", "LOG_LEVEL": "loud"}[key]
+		}
+
+		var stdout bytes.Buffer
+		err := PrintConfig(getenv, &stdout)
+		if !errors.Is(err, config.ErrLogLevelInvalid) {
+			t.Errorf("PrintConfig err = %v, want %v", err, config.ErrLogLevelInvalid)
+		}
+		if stdout.Len() != 0 {
+			t.Errorf("stdout = %q, want empty on a config error", stdout.String())
+		}
+	})
+}
+
+// TestMigrate pins the explicit migration controls: status on a migrated
+// database lists nothing pending, down leaves exactly the newest migration
+// pending, up re-applies it, and an unknown action is rejected before the
+// config is read.
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	dbURI, cleanup := dbtest.SetupTestDB(t)
+	t.Cleanup(cleanup)
+
+	getenv := func(key string) string {
+		return map[string]string{"APP_ENV": "development", "DB_URI": dbURI}[key]
+	}
+	// pending returns the versions status lists as pending, newest last.
+	pending := func() []string {
+		t.Helper()
+
+		var stdout bytes.Buffer
+		if err := Migrate(t.Context(), getenv, &stdout, io.Discard, "status"); err != nil {
+			t.Fatalf("Migrate status err = %v, want nil", err)
+		}
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		if len(lines) < 2 {
+			t.Fatalf("status = %q, want a line per migration", stdout.String())
+		}
+		var out []string
+		for _, line := range lines {
+			if fields := strings.Fields(line); fields[1] == "pending" {
+				out = append(out, fields[0])
+			}
+		}
+		if last := strings.Fields(lines[len(lines)-1]); len(out) > 0 && out[len(out)-1] != last[0] {
+			t.Errorf("pending = %v, want the newest migration %s among them", out, last[0])
+		}
+
+		return out
+	}
+
+	if got := pending(); len(got) != 0 {
+		t.Errorf("pending on a migrated DB = %v, want none", got)
+	}
+
+	if err := Migrate(t.Context(), getenv, io.Discard, io.Discard, "down"); err != nil {
+		t.Fatalf("Migrate down err = %v, want nil", err)
+	}
+	if got := pending(); len(got) != 1 {
+		t.Errorf("pending after down = %v, want just the newest migration", got)
+	}
+
+	if err := Migrate(t.Context(), getenv, io.Discard, io.Discard, "up"); err != nil {
+		t.Fatalf("Migrate up err = %v, want nil", err)
+	}
+	if got := pending(); len(got) != 0 {
+		t.Errorf("pending after up = %v, want none", got)
+	}
+
+	err := Migrate(t.Context(), getenv, io.Discard, io.Discard, "redo")
+	if !errors.Is(err, ErrUnknownMigrateAction) {
+		t.Errorf("Migrate redo err = %v, want %v", err, ErrUnknownMigrateAction)
+	}
+}
+
+// TestSeedQuiz pins `server seed --file`: the first run imports the quiz,
+// a re-run reports it as already present and succeeds, and an invalid
+// document fails.
+func TestSeedQuiz(t *testing.T) {
+	t.Parallel()
+
+	dbURI, cleanup := dbtest.SetupTestDB(t)
+	t.Cleanup(cleanup)
+
+	getenv := func(key string) string {
+		return map[string]string{"APP_ENV": "development", "DB_URI": dbURI}[key]
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "quiz.json")
+	doc := `{"title": "Seeded Capitals", "description": "Seeded from a file", "questions": [{"text": "Capital of France?",` +
+		` "options": [{"text": "Paris", "correct": true}, {"text": "Lyon", "correct": false}]}]}`
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatalf("WriteFile err = %v", err)
+	}
+
+	var stdout bytes.Buffer
+	if err := SeedQuiz(t.Context(), getenv, &stdout, io.Discard, path, "solo"); err != nil {
+		t.Fatalf("SeedQuiz err = %v, want nil", err)
+	}
+	if got, want := stdout.String(), `seeded quiz "Seeded Capitals"`; !strings.Contains(got, want) {
+		t.Errorf("stdout = %q, want substring %q", got, want)
+	}
+
+	stdout.Reset()
+	if err := SeedQuiz(t.Context(), getenv, &stdout, io.Discard, path, "solo"); err != nil {
+		t.Fatalf("second SeedQuiz err = %v, want nil", err)
+	}
+	if got, want := stdout.String(), "already exists, skipped"; !strings.Contains(got, want) {
+		t.Errorf("second stdout = %q, want substring %q", got, want)
+	}
+
+	bad := filepath.Join(dir, "bad.json")
+	if err := os.WriteFile(bad, []byte(`{"title": "No questions", "extra": true}`), 0o600); err != nil {
+		t.Fatalf("WriteFile err = %v", err)
+	}
+	err := SeedQuiz(t.Context(), getenv, io.Discard, io.Discard, bad, "solo")
+	if !errors.Is(err, admin.ErrQuizImportInvalid) {
+		t.Errorf("SeedQuiz(bad) err = %v, want %v", err, admin.ErrQuizImportInvalid)
+	}
+}
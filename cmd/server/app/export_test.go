@@ -1,5 +1,12 @@
 package app
 
+import (
+	"time"
+
+	"github.com/starquake/topbanana/internal/bgtasks"
+	"github.com/starquake/topbanana/internal/health"
+)
+
 // Re-exports of the package-private ResetPassword sentinel errors so the
 // external test package (app_test) can match on them via [errors.Is]
 // without resorting to fragile string-substring assertions on err.Error().
@@ -44,6 +51,8 @@ var (
 	ErrSeedDemoArchiveNotSet = errSeedDemoArchiveNotSet
 	// ErrEmptyMediaDir re-exports errEmptyMediaDir for tests.
 	ErrEmptyMediaDir = errEmptyMediaDir
+	// ErrUnknownMigrateAction re-exports errUnknownMigrateAction for tests.
+	ErrUnknownMigrateAction = errUnknownMigrateAction
 )
 
 // BootstrapInitialAdmin exposes the unexported first-boot admin bootstrap so
@@ -61,6 +70,11 @@ var MkMediaDir = mkMediaDir
 // without standing up the full server (#472).
 var RunTokenSweep = runTokenSweep
 
+// RunDBMaintenance exposes the unexported maintenance-window loop so the
+// external app_test package can pin its once-per-window behaviour without a
+// real database.
+var RunDBMaintenance = runDBMaintenance
+
 // RunRetentionSweep exposes the unexported data-retention sweep helper so
 // the external app_test package can pin its warn-and-continue behaviour
 // without standing up the full server (#626).
@@ -84,3 +98,26 @@ type (
 // email-dispatch tracker before returning (and thus before Run closes the DB)
 // without standing up the full server (#740).
 var RunHTTPServer = runHTTPServer
+
+// NewShutdownPlan builds the unexported shutdown plan RunHTTPServer takes,
+// so the external app_test package can choose the drain, delay, and timeout.
+func NewShutdownPlan(
+	drain *health.Drain, drainDelay, timeout time.Duration, emailTasks *bgtasks.Tracker,
+) shutdownPlan {
+	return shutdownPlan{drain: drain, drainDelay: drainDelay, timeout: timeout, emailTasks: emailTasks}
+}
+
+// HTTPSRedirectListener exposes the optional redirect listener constructor so
+// the external app_test package can serve it through RunHTTPServer.
+var HTTPSRedirectListener = httpsRedirectListener
+
+// AdminListener exposes the admin UI listener constructor so the external
+// app_test package can serve it through RunHTTPServer.
+var AdminListener = adminListener
+
+// SideListener names the unexported listener type RunHTTPServer serves beside
+// the main one, so the external app_test package can build its slice.
+type SideListener = sideListener
+
+// SideAddr reports the address a side listener is bound to.
+func SideAddr(s *sideListener) string { return s.ln.Addr().String() }
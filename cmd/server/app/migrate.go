@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/starquake/topbanana/internal/config"
+	"github.com/starquake/topbanana/internal/database"
+)
+
+// Actions `server migrate` accepts.
+const (
+	migrateUp     = "up"
+	migrateDown   = "down"
+	migrateStatus = "status"
+)
+
+// errUnknownMigrateAction is returned by Migrate for an action other than
+// migrateUp, migrateDown or migrateStatus.
+var errUnknownMigrateAction = errors.New(`migrate action must be "up", "down" or "status"`)
+
+// migrateWrap is the error-wrap prefix for every Migrate failure path.
+const migrateWrap = "migrate: %w"
+
+// Migrate gives the operator explicit control over the schema: up applies
+// every pending migration (what the server also does on boot), down rolls
+// back the newest applied one, and status lists each migration with whether
+// it is applied. Logs go to stderr; the status table goes to stdout so it
+// can be piped. The server should not be running concurrently against the
+// same database for up or down.
+func Migrate(ctx context.Context, getenv func(string) string, stdout, stderr io.Writer, action string) error {
+	switch action {
+	case migrateUp, migrateDown, migrateStatus:
+	default:
+		return fmt.Errorf(migrateWrap, errUnknownMigrateAction)
+	}
+
+	logger := slog.New(slog.NewTextHandler(stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	cfg, err := config.Parse(getenv)
+	if err != nil {
+		return fmt.Errorf("migrate: parse config: %w", err)
+	}
+	conn, err := openDB(ctx, cfg.DatabaseConfig(), logger)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := conn.Close(); cerr != nil {
+			logger.ErrorContext(ctx, "error closing database connection", slog.Any("err", cerr))
+		}
+	}()
+
+	switch action {
+	case migrateUp:
+		err = database.Migrate(conn)
+	case migrateDown:
+		err = database.MigrateDown(conn)
+	default:
+		return printMigrationStatus(ctx, conn, stdout)
+	}
+	if err != nil {
+		return fmt.Errorf(migrateWrap, err)
+	}
+	logger.InfoContext(ctx, "migrate "+action+" ok")
+
+	return nil
+}
+
+// printMigrationStatus writes one "VERSION STATE NAME" line per migration,
+// with the applied time for applied ones.
+func printMigrationStatus(ctx context.Context, conn *sql.DB, stdout io.Writer) error {
+	statuses, err := database.MigrationStatus(ctx, conn)
+	if err != nil {
+		return fmt.Errorf(migrateWrap, err)
+	}
+	for _, st := range statuses {
+		state, at := "pending", ""
+		if st.Applied {
+			state, at = "applied", " "+st.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		if _, err = fmt.Fprintf(stdout, "%d %-7s %s%s\n", st.Version, state, st.Name, at); err != nil {
+			return fmt.Errorf("migrate: write status: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -7,11 +7,14 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"slices"
 	"time"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/starquake/topbanana/internal/bgtasks"
+	"github.com/starquake/topbanana/internal/config"
+	"github.com/starquake/topbanana/internal/health"
 )
 
 const (
@@ -22,15 +25,57 @@ const (
 	// CDN linger indefinitely and leak file descriptors. 120s is the conventional
 	// upper bound; long enough for legitimate keep-alive reuse, short enough to
 	// reclaim sockets from stale clients.
-	idleTimeout     = 120 * time.Second
-	shutdownTimeout = 5 * time.Second
+	idleTimeout = 120 * time.Second
 )
 
+// adminRole is the role of the admin UI's side listener. runHTTPServer
+// checks for it so the startup lines point admins at the right address.
+const adminRole = "the admin UI"
+
+// sideListener is an optional listener served alongside the main server:
+// the plain-HTTP HTTPS redirect (HTTP_REDIRECT_PORT) or the admin UI
+// (ADMIN_PORT). role names it in log lines and errors; serving says what it
+// does, for the startup line.
+type sideListener struct {
+	ln      net.Listener
+	handler http.Handler
+	role    string
+	serving string
+}
+
+// shutdownPlan is how runHTTPServer winds down once the shutdown signal
+// arrives. drain is started first, turning /healthz to 503 and ending the
+// SSE streams; the server keeps accepting requests for drainDelay so a load
+// balancer sees the 503 and stops routing here, then stops listening and
+// waits up to timeout for in-flight requests - an answer being submitted
+// finishes rather than being cut off - and up to timeout again for the
+// detached email dispatches in emailTasks.
+type shutdownPlan struct {
+	drain      *health.Drain
+	drainDelay time.Duration
+	timeout    time.Duration
+	emailTasks *bgtasks.Tracker
+}
+
+// newShutdownPlan builds the plan from the SHUTDOWN_* settings in cfg.
+func newShutdownPlan(cfg *config.Config, drain *health.Drain, emailTasks *bgtasks.Tracker) shutdownPlan {
+	return shutdownPlan{
+		drain:      drain,
+		drainDelay: cfg.ShutdownDrainDelay,
+		timeout:    cfg.ShutdownTimeout,
+		emailTasks: emailTasks,
+	}
+}
+
+// runHTTPServer serves srv on ln and each of sides on its own listener until
+// signalCtx is cancelled, then shuts them all down as plan describes. A
+// serve error on any listener stops them all.
 func runHTTPServer(
 	ctx, signalCtx context.Context,
 	ln net.Listener,
 	srv http.Handler,
-	emailTasks *bgtasks.Tracker,
+	sides []*sideListener,
+	plan shutdownPlan,
 	logger *slog.Logger,
 	writeTimeout time.Duration,
 ) error {
@@ -44,10 +89,37 @@ func runHTTPServer(
 
 	g, gCtx := errgroup.WithContext(signalCtx)
 
+	sideServers := make([]*http.Server, len(sides))
+	for i, side := range sides {
+		sideServer := &http.Server{
+			ReadHeaderTimeout: readHeaderTimeout,
+			ReadTimeout:       readTimeout,
+			WriteTimeout:      writeTimeout,
+			IdleTimeout:       idleTimeout,
+			Handler:           side.handler,
+		}
+		sideServers[i] = sideServer
+		g.Go(func() error {
+			addr := side.ln.Addr().String()
+			logger.InfoContext(gCtx, side.serving+" on "+addr, slog.String("addr", addr))
+			serveErr := sideServer.Serve(side.ln)
+			if serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+				msg := "error serving " + side.role
+				logger.ErrorContext(signalCtx, msg, slog.Any("err", serveErr))
+
+				return fmt.Errorf("%s: %w", msg, serveErr)
+			}
+
+			return nil
+		})
+	}
+
 	g.Go(func() error {
 		logger.InfoContext(gCtx, "listening on "+ln.Addr().String(), slog.String("addr", ln.Addr().String()))
 		addr := ln.Addr().String()
-		logger.InfoContext(gCtx, fmt.Sprintf("visit http://%s/admin to manage quizzes", addr))
+		if !slices.ContainsFunc(sides, func(side *sideListener) bool { return side.role == adminRole }) {
+			logger.InfoContext(gCtx, fmt.Sprintf("visit http://%s/admin to manage quizzes", addr))
+		}
 		logger.InfoContext(gCtx, fmt.Sprintf("visit http://%s/ to play", addr))
 		httpErr := httpServer.Serve(ln)
 		if httpErr != nil && !errors.Is(httpErr, http.ErrServerClosed) {
@@ -62,11 +134,29 @@ func runHTTPServer(
 
 	g.Go(func() error {
 		<-gCtx.Done()
-		// make a new context for the Shutdown
-		// use the root ctx to ensure shutdown has its own timeout even though signalCtx is already canceled
-		shutdownCtx, shutdownCancel := context.WithTimeout(ctx, shutdownTimeout)
+		plan.drain.Start()
+		// Only a real shutdown signal waits out the drain delay; after a
+		// serve error there is no traffic left to move elsewhere.
+		if plan.drainDelay > 0 && signalCtx.Err() != nil {
+			logger.InfoContext(ctx, "draining: health check reports 503 before the listener closes",
+				slog.Duration("delay", plan.drainDelay))
+			time.Sleep(plan.drainDelay)
+		}
+		logger.InfoContext(ctx, "shutting down: waiting for in-flight requests", slog.Duration("timeout", plan.timeout))
+		// The Shutdown bound is detached from ctx for the same reason as the
+		// drain bound below: at shutdown ctx may already be cancelled, and a
+		// bound that fires at once would cut in-flight requests off.
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.WithoutCancel(ctx), plan.timeout)
 		defer shutdownCancel()
 		shutdownErr := httpServer.Shutdown(shutdownCtx)
+		for i, sideServer := range sideServers {
+			// A side listener shares the main server's bound, so an admin
+			// request in flight gets the same chance to finish. A failed
+			// shutdown is logged but does not mask the main server's result.
+			if sErr := sideServer.Shutdown(shutdownCtx); sErr != nil {
+				logger.WarnContext(shutdownCtx, "error shutting down "+sides[i].role, slog.Any("err", sErr))
+			}
+		}
 		// Drain the detached email-dispatch goroutines AFTER Shutdown stops
 		// the listener and BEFORE Run's deferred conn.Close runs, so a
 		// dispatch can't write to a closed DB (#740, #741). The bound is
@@ -74,12 +164,12 @@ func runHTTPServer(
 		// cancelled (signal-driven, and the integration harness cancels the
 		// same ctx it passes to Run), so a plain WithTimeout(ctx, ...) would
 		// fire instantly and skip the wait. The dispatches carry per-send
-		// timeouts longer than shutdownTimeout, so a stuck SMTP must not pin
-		// shutdown - draining what it can within the bound and giving up is
-		// the right trade.
-		drainCtx, drainCancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		// timeouts longer than the shutdown timeout, so a stuck SMTP must not
+		// pin shutdown - draining what it can within the bound and giving up
+		// is the right trade.
+		drainCtx, drainCancel := context.WithTimeout(context.WithoutCancel(ctx), plan.timeout)
 		defer drainCancel()
-		if drainErr := emailTasks.Wait(drainCtx); drainErr != nil {
+		if drainErr := plan.emailTasks.Wait(drainCtx); drainErr != nil {
 			logger.WarnContext(ctx, "gave up waiting for background email dispatches", slog.Any("err", drainErr))
 		}
 		if shutdownErr != nil {
@@ -10,7 +10,9 @@ import (
 
 	. "github.com/starquake/topbanana/cmd/server/app"
 	"github.com/starquake/topbanana/internal/bgtasks"
+	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/health"
 )
 
 // TestRunHTTPServer_DrainsEmailTasksBeforeReturning pins the #740 / #741 fix:
@@ -64,7 +66,8 @@ func TestRunHTTPServer_DrainsEmailTasksBeforeReturning(t *testing.T) {
 		serveDone <- RunHTTPServer(
 			ctx, signalCtx, ln,
 			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }),
-			tasks,
+			nil,
+			NewShutdownPlan(nil, 0, 5*time.Second, tasks),
 			slog.New(slog.DiscardHandler),
 			10*time.Second,
 		)
@@ -108,3 +111,287 @@ func TestRunHTTPServer_DrainsEmailTasksBeforeReturning(t *testing.T) {
 		t.Error("PingContext after Close = nil, want an error (sanity: close really tears the connection down)")
 	}
 }
+
+// TestRunHTTPServer_ServesHTTPSRedirect pins the HTTP_REDIRECT_PORT listener:
+// it runs beside the main server, answers every request with a permanent
+// redirect to BASE_URL, and shuts down with it.
+func TestRunHTTPServer_ServesHTTPSRedirect(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen err = %v, want nil", err)
+	}
+	cfg := &config.Config{Host: "localhost", HTTPRedirectPort: "0", BaseURL: "https://quiz.example.com"}
+	redirect, err := HTTPSRedirectListener(ctx, cfg, slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("HTTPSRedirectListener err = %v, want nil", err)
+	}
+	if redirect == nil {
+		t.Fatal("HTTPSRedirectListener = nil, want a listener")
+	}
+
+	serveDone := make(chan error, 1)
+	go func() {
+		serveDone <- RunHTTPServer(
+			ctx, ctx, ln,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }),
+			[]*SideListener{redirect},
+			NewShutdownPlan(nil, 0, 5*time.Second, bgtasks.New()),
+			slog.New(slog.DiscardHandler),
+			10*time.Second,
+		)
+	}()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+SideAddr(redirect)+"/join?code=ABC234", nil)
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("client.Do err = %v, want nil", err)
+	}
+	if cerr := resp.Body.Close(); cerr != nil {
+		t.Errorf("Body.Close err = %v, want nil", cerr)
+	}
+	if got, want := resp.StatusCode, http.StatusPermanentRedirect; got != want {
+		t.Errorf("redirect status = %d, want %d", got, want)
+	}
+	if got, want := resp.Header.Get("Location"), "https://quiz.example.com/join?code=ABC234"; got != want {
+		t.Errorf("redirect Location = %q, want %q", got, want)
+	}
+
+	cancel()
+	select {
+	case err := <-serveDone:
+		if err != nil {
+			t.Fatalf("RunHTTPServer err = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunHTTPServer did not return after shutdown")
+	}
+}
+
+// TestRunHTTPServer_ServesAdminListener pins the ADMIN_PORT listener: it
+// runs beside the main server with its own handler and shuts down with it.
+func TestRunHTTPServer_ServesAdminListener(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen err = %v, want nil", err)
+	}
+	cfg := &config.Config{AdminHost: "localhost", AdminPort: "0"}
+	adminLn, err := AdminListener(ctx, cfg, slog.New(slog.DiscardHandler),
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusAccepted) }))
+	if err != nil {
+		t.Fatalf("AdminListener err = %v, want nil", err)
+	}
+
+	serveDone := make(chan error, 1)
+	go func() {
+		serveDone <- RunHTTPServer(
+			ctx, ctx, ln,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }),
+			[]*SideListener{adminLn},
+			NewShutdownPlan(nil, 0, 5*time.Second, bgtasks.New()),
+			slog.New(slog.DiscardHandler),
+			10*time.Second,
+		)
+	}()
+
+	for addr, want := range map[string]int{
+		ln.Addr().String(): http.StatusOK,
+		SideAddr(adminLn):  http.StatusAccepted,
+	} {
+		req, rErr := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+addr+"/admin", nil)
+		if rErr != nil {
+			t.Fatalf("NewRequest err = %v, want nil", rErr)
+		}
+		resp, rErr := http.DefaultClient.Do(req)
+		if rErr != nil {
+			t.Fatalf("client.Do(%s) err = %v, want nil", addr, rErr)
+		}
+		if cerr := resp.Body.Close(); cerr != nil {
+			t.Errorf("Body.Close err = %v, want nil", cerr)
+		}
+		if got := resp.StatusCode; got != want {
+			t.Errorf("GET %s/admin status = %d, want %d", addr, got, want)
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-serveDone:
+		if err != nil {
+			t.Fatalf("RunHTTPServer err = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunHTTPServer did not return after shutdown")
+	}
+	if _, err = (&net.Dialer{}).DialContext(t.Context(), "tcp", SideAddr(adminLn)); err == nil {
+		t.Error("dial admin listener after shutdown err = nil, want it closed")
+	}
+}
+
+// TestRunHTTPServer_FinishesInFlightRequests pins that a request already
+// being handled when the shutdown signal lands runs to completion and gets
+// its response, even though the root ctx is cancelled alongside the signal.
+func TestRunHTTPServer_FinishesInFlightRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancelRoot := context.WithCancel(t.Context())
+	defer cancelRoot()
+	signalCtx, stopSignal := context.WithCancel(ctx)
+	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen err = %v, want nil", err)
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusCreated)
+	})
+	serveDone := make(chan error, 1)
+	go func() {
+		serveDone <- RunHTTPServer(
+			ctx, signalCtx, ln, handler, nil,
+			NewShutdownPlan(health.NewDrain(), 0, 5*time.Second, bgtasks.New()),
+			slog.New(slog.DiscardHandler),
+			10*time.Second,
+		)
+	}()
+
+	type result struct {
+		status int
+		err    error
+	}
+	respDone := make(chan result, 1)
+	go func() {
+		req, rErr := http.NewRequestWithContext(context.WithoutCancel(ctx), http.MethodPost, "http://"+ln.Addr().String()+"/", nil)
+		if rErr != nil {
+			respDone <- result{err: rErr}
+
+			return
+		}
+		resp, rErr := http.DefaultClient.Do(req)
+		if rErr != nil {
+			respDone <- result{err: rErr}
+
+			return
+		}
+		_ = resp.Body.Close()
+		respDone <- result{status: resp.StatusCode}
+	}()
+
+	<-started
+	stopSignal()
+	cancelRoot()
+	select {
+	case err := <-serveDone:
+		t.Fatalf("RunHTTPServer returned with a request in flight: err = %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+
+	got := <-respDone
+	if got.err != nil {
+		t.Fatalf("in-flight request err = %v, want nil", got.err)
+	}
+	if want := http.StatusCreated; got.status != want {
+		t.Errorf("in-flight request status = %d, want %d", got.status, want)
+	}
+	select {
+	case err := <-serveDone:
+		if err != nil {
+			t.Fatalf("RunHTTPServer err = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunHTTPServer did not return after the request finished")
+	}
+}
+
+// TestRunHTTPServer_DrainsBeforeClosing pins the drain window: once the
+// signal lands, /healthz answers 503 while the listener stays open for the
+// drain delay, and an open stream wrapped by Drain.EndStreams ends rather
+// than holding shutdown until its timeout.
+func TestRunHTTPServer_DrainsBeforeClosing(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen err = %v, want nil", err)
+	}
+
+	drain := health.NewDrain()
+	streaming := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.Handle("GET /healthz", health.HandleHealthz(slog.New(slog.DiscardHandler), nil, drain))
+	mux.Handle("GET /stream", drain.EndStreams(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_ = http.NewResponseController(w).Flush()
+		close(streaming)
+		<-r.Context().Done()
+	})))
+	const drainDelay = 500 * time.Millisecond
+	serveDone := make(chan error, 1)
+	go func() {
+		serveDone <- RunHTTPServer(
+			ctx, ctx, ln, mux, nil,
+			// A timeout far past the test's bound: returning in time proves
+			// the stream ended on drain instead of being waited out.
+			NewShutdownPlan(drain, drainDelay, time.Minute, bgtasks.New()),
+			slog.New(slog.DiscardHandler),
+			10*time.Second,
+		)
+	}()
+
+	base := "http://" + ln.Addr().String()
+	streamReq, err := http.NewRequestWithContext(t.Context(), http.MethodGet, base+"/stream", nil)
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	streamResp, err := http.DefaultClient.Do(streamReq)
+	if err != nil {
+		t.Fatalf("stream request err = %v, want nil", err)
+	}
+	defer func() { _ = streamResp.Body.Close() }()
+	<-streaming
+
+	cancel()
+	// Wait for the drain to start, then probe inside the delay.
+	for !drain.Draining() {
+		time.Sleep(time.Millisecond)
+	}
+	healthReq, err := http.NewRequestWithContext(t.Context(), http.MethodGet, base+"/healthz", nil)
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	healthResp, err := http.DefaultClient.Do(healthReq)
+	if err != nil {
+		t.Fatalf("healthz during drain err = %v, want nil (the listener stays open for the delay)", err)
+	}
+	_ = healthResp.Body.Close()
+	if got, want := healthResp.StatusCode, http.StatusServiceUnavailable; got != want {
+		t.Errorf("healthz during drain status = %d, want %d", got, want)
+	}
+
+	select {
+	case err := <-serveDone:
+		if err != nil {
+			t.Fatalf("RunHTTPServer err = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunHTTPServer did not return; the open stream held shutdown")
+	}
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/starquake/topbanana/internal/config"
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// startTracing builds the OTEL_EXPORTER_OTLP_ENDPOINT tracer and starts its
+// export goroutine. Like the analytics emitter, the goroutine runs on a
+// context detached from the shutdown signal so spans of requests still
+// draining are exported; the returned stop func cancels it and waits for
+// the final flush. With no endpoint configured the tracer is nil, which
+// leaves every request untraced, and stop is a no-op.
+func startTracing(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*tracing.Tracer, func()) {
+	if cfg.OTelEndpoint == "" {
+		return nil, func() {}
+	}
+	exporter := tracing.NewOTLPExporter(cfg.OTelEndpoint, cfg.OTelServiceName)
+	tracer := tracing.NewTracer(exporter, cfg.OTelServiceName, logger)
+
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tracer.Run(runCtx)
+	}()
+	logger.InfoContext(ctx, "tracing enabled", slog.String("service", cfg.OTelServiceName))
+
+	return tracer, func() {
+		cancel()
+		<-done
+		if dropped := tracer.Dropped(); dropped > 0 {
+			logger.WarnContext(ctx, "trace spans dropped on a full queue", slog.Int64("dropped", dropped))
+		}
+	}
+}
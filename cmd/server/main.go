@@ -1,16 +1,23 @@
-// Application server is the main server for the application
+// Application server is the main server for the application. With no
+// arguments it serves; `server migrate up|down|status` and
+// `server seed --file quiz.json` are explicit operator entry points, and the
+// -flag modes (-check, -reset-password, ...) remain for recovery tasks.
 package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"slices"
 
 	_ "modernc.org/sqlite"
 
 	"github.com/starquake/topbanana/cmd/server/app"
 	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/quiz"
 )
 
 // modeFlags holds the parsed mode-flag pointers. At most one may be set; more
@@ -23,8 +30,28 @@ type modeFlags struct {
 	checkOnly        *bool
 	healthcheckOnly  *bool
 	seedDemo         *bool
+	printConfig      *bool
 }
 
+// Subcommands: `server serve` runs the server (also the default with no
+// subcommand), `server migrate up|down|status` drives the schema, and
+// `server seed --file quiz.json` imports a quiz.
+const (
+	cmdServe   = "serve"
+	cmdMigrate = "migrate"
+	cmdSeed    = "seed"
+)
+
+// Usage errors for the subcommand line, so a typo fails loudly instead of
+// silently starting the server.
+var (
+	errUnknownSubcommand = errors.New(`unknown subcommand; want "serve", "migrate" or "seed"`)
+	errSubcommandUsage   = errors.New(
+		"usage: server serve | server migrate up|down|status | server seed --file FILE [--mode solo|live]",
+	)
+	errSubcommandWithMode = errors.New("subcommands and mode flags are mutually exclusive")
+)
+
 func main() {
 	f := registerModeFlags()
 	flag.Parse()
@@ -33,7 +60,7 @@ func main() {
 
 	// Reject more than one mode flag: resolving by switch order would silently
 	// run a different recovery action than the operator asked for.
-	if tooManyModes(
+	modes := []bool{
 		*f.resetPasswordFor != "",
 		*f.promoteAdminFor != "",
 		*f.verifyEmailFor != "",
@@ -41,10 +68,12 @@ func main() {
 		*f.checkOnly,
 		*f.healthcheckOnly,
 		*f.seedDemo,
-	) {
+		*f.printConfig,
+	}
+	if tooManyModes(modes...) {
 		if _, err := fmt.Fprintln(os.Stderr,
 			"error: -reset-password, -promote-admin, -verify-email, -create-admin, -check,"+
-				" -healthcheck, and -seed-demo are mutually exclusive"); err != nil {
+				" -healthcheck, -seed-demo, and -print-config are mutually exclusive"); err != nil {
 			panic(err)
 		}
 
@@ -54,31 +83,81 @@ func main() {
 	database.SetupGoose()
 
 	var err error
+	if args := flag.Args(); len(args) > 0 {
+		err = errSubcommandWithMode
+		if !slices.Contains(modes, true) {
+			err = runSubcommand(ctx, args, os.Getenv, os.Stdout, os.Stderr)
+		}
+	} else {
+		err = runMode(ctx, f)
+	}
+
+	if err != nil {
+		if _, err2 := fmt.Fprintf(os.Stderr, "error: %v\n", err); err2 != nil {
+			panic(err2)
+		}
+
+		os.Exit(1)
+	}
+}
+
+// runMode runs the mode flag that is set, or the server when none is.
+func runMode(ctx context.Context, f modeFlags) error {
 	switch {
 	case *f.resetPasswordFor != "":
-		err = app.ResetPassword(ctx, os.Getenv, os.Stdin, os.Stdout, os.Stderr, *f.resetPasswordFor)
+		return app.ResetPassword(ctx, os.Getenv, os.Stdin, os.Stdout, os.Stderr, *f.resetPasswordFor)
 	case *f.promoteAdminFor != "":
-		err = app.PromoteAdmin(ctx, os.Getenv, os.Stdout, os.Stderr, *f.promoteAdminFor)
+		return app.PromoteAdmin(ctx, os.Getenv, os.Stdout, os.Stderr, *f.promoteAdminFor)
 	case *f.verifyEmailFor != "":
-		err = app.VerifyEmail(ctx, os.Getenv, os.Stdout, os.Stderr, *f.verifyEmailFor)
+		return app.VerifyEmail(ctx, os.Getenv, os.Stdout, os.Stderr, *f.verifyEmailFor)
 	case *f.createAdminFor != "":
-		err = app.CreateAdmin(ctx, os.Getenv, os.Stdin, os.Stdout, os.Stderr, *f.createAdminFor)
+		return app.CreateAdmin(ctx, os.Getenv, os.Stdin, os.Stdout, os.Stderr, *f.createAdminFor)
 	case *f.checkOnly:
-		err = app.Check(ctx, os.Getenv, os.Stdout)
+		return app.Check(ctx, os.Getenv, os.Stdout)
 	case *f.healthcheckOnly:
-		err = app.Healthcheck(ctx, os.Getenv)
+		return app.Healthcheck(ctx, os.Getenv)
 	case *f.seedDemo:
-		err = app.SeedDemo(ctx, os.Getenv, os.Stderr)
+		return app.SeedDemo(ctx, os.Getenv, os.Stderr)
+	case *f.printConfig:
+		return app.PrintConfig(os.Getenv, os.Stdout)
 	default:
-		err = app.Run(ctx, os.Getenv, os.Stdout, nil)
+		return app.Run(ctx, os.Getenv, os.Stdout, nil)
 	}
+}
 
-	if err != nil {
-		if _, err2 := fmt.Fprintf(os.Stderr, "error: %v\n", err); err2 != nil {
-			panic(err2)
+// runSubcommand dispatches the positional command line left after the global
+// flags. Usage errors are returned before anything touches the database.
+func runSubcommand(
+	ctx context.Context, args []string, getenv func(string) string, stdout, stderr io.Writer,
+) error {
+	switch args[0] {
+	case cmdServe:
+		if len(args) != 1 {
+			return errSubcommandUsage
 		}
 
-		os.Exit(1)
+		return app.Run(ctx, getenv, stdout, nil)
+	case cmdMigrate:
+		if len(args) != 2 { //nolint:mnd // "migrate" and its action.
+			return errSubcommandUsage
+		}
+
+		return app.Migrate(ctx, getenv, stdout, stderr, args[1])
+	case cmdSeed:
+		fs := flag.NewFlagSet(cmdSeed, flag.ContinueOnError)
+		fs.SetOutput(stderr)
+		file := fs.String("file", "", "quiz JSON file to import, in the admin import format")
+		mode := fs.String("mode", string(quiz.ModeSolo), "play mode for the imported quiz: solo or live")
+		if err := fs.Parse(args[1:]); err != nil {
+			return fmt.Errorf("%w: %w", errSubcommandUsage, err)
+		}
+		if *file == "" || fs.NArg() > 0 {
+			return errSubcommandUsage
+		}
+
+		return app.SeedQuiz(ctx, getenv, stdout, stderr, *file, *mode)
+	default:
+		return fmt.Errorf("%w: %q", errUnknownSubcommand, args[0])
 	}
 }
 
@@ -137,6 +216,12 @@ func registerModeFlags() modeFlags {
 				" The server should not be running concurrently against the same database."+
 				" Mutually exclusive with the other mode flags",
 		),
+		printConfig: flag.Bool(
+			"print-config",
+			false,
+			"print the resolved configuration (defaults applied, secrets redacted) as NAME=value"+
+				" lines and exit. Does not open the database. Mutually exclusive with the other mode flags",
+		),
 	}
 }
 
@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"errors"
+	"io"
+	"testing"
+)
 
 func TestTooManyModes(t *testing.T) {
 	t.Parallel()
@@ -25,3 +29,38 @@ func TestTooManyModes(t *testing.T) {
 		})
 	}
 }
+
+// TestRunSubcommand_Usage pins that a malformed subcommand line fails before
+// anything reads config or opens a database.
+func TestRunSubcommand_Usage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want error
+	}{
+		{name: "unknown subcommand", args: []string{"migrat"}, want: errUnknownSubcommand},
+		{name: "serve with extra args", args: []string{"serve", "now"}, want: errSubcommandUsage},
+		{name: "migrate without action", args: []string{"migrate"}, want: errSubcommandUsage},
+		{name: "migrate with two actions", args: []string{"migrate", "up", "down"}, want: errSubcommandUsage},
+		{name: "seed without file", args: []string{"seed"}, want: errSubcommandUsage},
+		{name: "seed with unknown flag", args: []string{"seed", "--dir", "quizzes"}, want: errSubcommandUsage},
+		{name: "seed with stray arg", args: []string{"seed", "--file", "a.json", "b.json"}, want: errSubcommandUsage},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(string) string {
+				t.Error("getenv called for a usage error")
+
+				return ""
+			}
+			err := runSubcommand(t.Context(), tc.args, getenv, io.Discard, io.Discard)
+			if !errors.Is(err, tc.want) {
+				t.Errorf("runSubcommand(%q) err = %v, want %v", tc.args, err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package main
+
+// ExportRun re-exports the command dispatcher so the external main_test
+// package can drive it without spawning a process.
+var ExportRun = run
@@ -0,0 +1,77 @@
+// topbananactl is the command-line companion for quiz content. Its one
+// command, `topbananactl quiz lint FILE...`, checks quiz JSON files against
+// the same rules the admin import applies and exits non-zero when any file
+// has a problem, so a quiz-content repository can gate merges on valid
+// quizzes before they ever reach the import endpoint. It needs no database
+// or configuration.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/starquake/topbanana/internal/admin"
+)
+
+// Exit codes: exitProblems when any file fails to read or lint, exitUsage for
+// a malformed command line.
+const (
+	exitOK       = 0
+	exitProblems = 1
+	exitUsage    = 2
+	// minLintArgs is "quiz", "lint", and at least one file.
+	minLintArgs = 3
+)
+
+// usage is printed to stderr for a malformed command line.
+const usage = `usage: topbananactl quiz lint FILE...
+
+Checks each quiz JSON file against the admin import rules. Problems are
+printed one per line as "FILE: problem"; the exit status is 1 when any file
+has a problem and 0 when every file would import cleanly.
+`
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run dispatches the command line and returns the process exit code.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) < minLintArgs || args[0] != "quiz" || args[1] != "lint" {
+		if _, err := fmt.Fprint(stderr, usage); err != nil {
+			panic(err)
+		}
+
+		return exitUsage
+	}
+
+	return lintFiles(ctx, args[2:], stdout, stderr)
+}
+
+// lintFiles lints every file, reporting each problem as "FILE: problem" on
+// stdout and each unreadable file on stderr, and keeps going after a failure
+// so one run lists everything to fix.
+func lintFiles(ctx context.Context, paths []string, stdout, stderr io.Writer) int {
+	code := exitOK
+	for _, path := range paths {
+		data, err := os.ReadFile(path) //nolint:gosec // linting the operator's chosen files is the point.
+		if err != nil {
+			if _, werr := fmt.Fprintf(stderr, "%s: %v\n", path, err); werr != nil {
+				panic(werr)
+			}
+			code = exitProblems
+
+			continue
+		}
+		for _, problem := range admin.LintQuizJSON(ctx, string(data)) {
+			if _, werr := fmt.Fprintf(stdout, "%s: %s\n", path, problem); werr != nil {
+				panic(werr)
+			}
+			code = exitProblems
+		}
+	}
+
+	return code
+}
@@ -0,0 +1,67 @@
+package main_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/cmd/topbananactl"
+)
+
+func TestRun_QuizLint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:     "valid file passes silently",
+			args:     []string{"quiz", "lint", "testdata/valid.json"},
+			wantCode: 0,
+		},
+		{
+			name:       "invalid file reports each problem",
+			args:       []string{"quiz", "lint", "testdata/valid.json", "testdata/invalid.json"},
+			wantCode:   1,
+			wantStdout: "testdata/invalid.json: description: Description is required\n",
+		},
+		{
+			name:       "unreadable file fails",
+			args:       []string{"quiz", "lint", "testdata/missing.json"},
+			wantCode:   1,
+			wantStderr: "testdata/missing.json: ",
+		},
+		{
+			name:       "missing file argument is a usage error",
+			args:       []string{"quiz", "lint"},
+			wantCode:   2,
+			wantStderr: "usage: topbananactl quiz lint FILE...",
+		},
+		{
+			name:       "unknown command is a usage error",
+			args:       []string{"quiz", "import", "testdata/valid.json"},
+			wantCode:   2,
+			wantStderr: "usage: topbananactl quiz lint FILE...",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+			if got, want := ExportRun(t.Context(), tt.args, &stdout, &stderr), tt.wantCode; got != want {
+				t.Errorf("exit code = %d, want %d", got, want)
+			}
+			if got, want := stdout.String(), tt.wantStdout; got != want {
+				t.Errorf("stdout = %q, want %q", got, want)
+			}
+			if !strings.HasPrefix(stderr.String(), tt.wantStderr) || (tt.wantStderr == "" && stderr.Len() > 0) {
+				t.Errorf("stderr = %q, want prefix %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
@@ -71,6 +71,32 @@ export class GameApp {
         // a submitAnswer POST throws (server 5xx, network drop). Cleared
         // on the next click or when a fresh question loads — see #179.
         this.submitError = false;
+        // The typed answer for a numeric question, bound to its input and
+        // cleared when a new question loads.
+        this.numericInput = '';
+        // The options picked so far on a select-all-that-apply question,
+        // sent together by submitMulti and cleared when a new question loads.
+        this.multiPicks = [];
+        // The confidence stake locked in on the current question, 0 until the
+        // player picks one; only offered on a quiz with question.confidenceWager.
+        // Seeded from the payload so a resume keeps the stake already placed.
+        this.wager = 0;
+        // Guards the stake buttons while the wager POST is in flight.
+        this.placingWager = false;
+        // The once-per-game "connection hiccup" pause. pauseUsed hides the
+        // button once it is spent (or the server says it was); pausedUntil is
+        // the server-clock ms the countdown bar holds still until, and
+        // timerPaused shows the "paused" chip while it does.
+        this.pauseUsed = false;
+        this.pausing = false;
+        this.pausedUntil = 0;
+        this.timerPaused = false;
+        // The current question's hint once the player has revealed it, with
+        // the percentage of the answer's points it costs; only offered when
+        // question.hasHint. takingHint guards the button while the POST runs.
+        this.hint = '';
+        this.hintPenalty = 0;
+        this.takingHint = false;
         // Retry-banner flag for a failed /next advance; cleared only on a
         // successful advance so the banner's Loading state survives a retry (#1166).
         this.advanceError = false;
@@ -911,6 +937,13 @@ export class GameApp {
         this.syncClockFrom(item);
         this.feedback = null;
         this.roundItem = null;
+        this.numericInput = '';
+        this.multiPicks = [];
+        this.wager = item.wager || 0;
+        this.hint = '';
+        this.hintPenalty = 0;
+        this.pausedUntil = 0;
+        this.timerPaused = false;
         this.question = item;
         if (typeof item.position === 'number') this.lastQuestionPosition = item.position;
         // Fire-and-forget so the read beat starts immediately while the
@@ -1027,8 +1060,13 @@ export class GameApp {
 
         this.timer = setInterval(() => {
             const now = this.serverTime();
-            const remaining = end - now;
-            this.progress = Math.max(0, (remaining / total) * 100);
+            // Hold the bar while the player's pause runs. The pause moved
+            // question.expiredAt out by its length, so once it ends the bar
+            // drains what was left against the original window.
+            this.timerPaused = now < this.pausedUntil;
+            if (this.timerPaused) return;
+            const remaining = new Date(this.question.expiredAt).getTime() - now;
+            this.progress = Math.min(100, Math.max(0, (remaining / total) * 100));
 
             if (this.progress <= 0) {
                 clearInterval(this.timer);
@@ -1110,7 +1148,97 @@ export class GameApp {
         }
     }
 
-    async submitAnswer(optionId) {
+    // submitNumeric answers a numeric question with the typed value. An
+    // empty or non-numeric input is ignored so a stray Enter does not burn
+    // the player's only answer.
+    async submitNumeric() {
+        const value = Number(String(this.numericInput).trim().replace(',', '.'));
+        if (String(this.numericInput).trim() === '' || !Number.isFinite(value)) return;
+        await this.submitAnswer(0, value);
+    }
+
+    // toggleMultiPick adds an option to, or removes it from, the pick set of
+    // a select-all-that-apply question. Nothing is sent until submitMulti.
+    toggleMultiPick(optionId) {
+        if (this.feedback || this.submittingAnswer) return;
+        const i = this.multiPicks.indexOf(optionId);
+        if (i === -1) this.multiPicks.push(optionId);
+        else this.multiPicks.splice(i, 1);
+    }
+
+    // submitMulti answers a select-all-that-apply question with every picked
+    // option. An empty pick set is ignored, like an empty numeric input.
+    async submitMulti() {
+        if (this.multiPicks.length === 0) return;
+        await this.submitAnswer(0, undefined, [...this.multiPicks]);
+    }
+
+    // placeWager locks in a confidence stake of 1-3 before answering. The
+    // first stake is final server-side, so a 409 (already placed, or the
+    // question already answered) just keeps the current state; any other
+    // failure is logged and the player answers at the default stake of 1.
+    async placeWager(stake) {
+        if (!this.question || !this.question.confidenceWager) return;
+        if (this.wager || this.placingWager || this.feedback || this.submittingAnswer) return;
+        this.placingWager = true;
+        try {
+            const res = await gameService.placeWager(this.gameId, this.question.id, stake);
+            this.wager = res.wager;
+        } catch (err) {
+            console.error('placeWager:', err);
+        } finally {
+            this.placingWager = false;
+        }
+    }
+
+    // pauseOnce spends the game's one pause on the current question after a
+    // connection hiccup: the server moves the deadline out by the extension
+    // and the countdown holds until it has passed. A 409 means the pause is
+    // already spent (perhaps on another device) or the question has closed,
+    // so the button just goes away; any other failure is logged.
+    async pauseOnce() {
+        if (!this.question || this.pauseUsed || this.pausing || this.revealing) return;
+        if (this.feedback || this.submittingAnswer) return;
+        this.pausing = true;
+        try {
+            const res = await gameService.pauseOnce(this.gameId, this.question.id);
+            this.pauseUsed = true;
+            this.pausedUntil = this.serverTime() + res.extensionSeconds * 1000;
+            this.question.expiredAt = res.expiredAt;
+        } catch (err) {
+            if (err && err.status === 409) {
+                this.pauseUsed = true;
+            } else {
+                console.error('pauseOnce:', err);
+            }
+        } finally {
+            this.pausing = false;
+        }
+    }
+
+    // takeHint reveals the current question's hint. The server marks it as
+    // taken, so the answer scores hintPenalty percent less; a 409 means the
+    // question has closed, so the button just goes away.
+    async takeHint() {
+        if (!this.question || !this.question.hasHint || this.hint || this.takingHint) return;
+        if (this.feedback || this.submittingAnswer) return;
+        this.takingHint = true;
+        try {
+            const res = await gameService.useHint(this.gameId, this.question.id);
+            this.hint = res.hint;
+            this.hintPenalty = res.penaltyPercent;
+        } catch (err) {
+            if (err && err.status === 409) {
+                this.question.hasHint = false;
+            } else {
+                console.error('takeHint:', err);
+            }
+        } finally {
+            this.takingHint = false;
+        }
+    }
+
+    async submitAnswer(optionId, numericValue, optionIds) {
         // Defence in depth (#444): no answer buttons render on the
         // round-summary card, but if a synthetic click ever reached here
         // mid-round-boundary the POST would 404 (the questionID is from
@@ -1142,7 +1270,7 @@ export class GameApp {
             this.timer = null;
         }
         try {
-            const fb = await gameService.submitAnswer(this.gameId, this.question.id, optionId, tappedAt);
+            const fb = await gameService.submitAnswer(this.gameId, this.question.id, optionId, tappedAt, numericValue, optionIds);
             // Track which option the player picked so the template can
             // keep the buttons visible during feedback and style the
             // pick separately from the correct option(s) — see #233.
@@ -1151,7 +1279,14 @@ export class GameApp {
             // Pick-result sting (#1088).
             this.audio.playEffect(fb.correct ? SFX.answerCorrect : SFX.answerWrong);
             this.score += fb.score || 0;
-            this.prefetchNextItem();
+            // The answer POST already issued the next item (the feedback
+            // pause fits inside its reveal delay, so the player loses no
+            // answer time); only fall back to /next when it carried none.
+            if (fb.next) {
+                this.nextItemPromise = Promise.resolve(fb.next);
+            } else {
+                this.prefetchNextItem();
+            }
         } catch (err) {
             // POST failed. The retry banner (#179) only makes sense
             // for transient failures the player can recover from by
@@ -1307,11 +1442,21 @@ export class GameApp {
     //      so the reveal (#233) wins post-pick.
     // Timed-out questions have no correctOptionIds (the server isn't
     // told about a timeout), so every option falls through to dim.
+    // A select-all question marks each option in the pick set, with the
+    // pick highlight while choosing and as a pick at the reveal; an
+    // unsent pick set is dropped on a timeout.
     optionStateClass(option, idx) {
+        const multi = !!this.question && this.question.kind === 'multi';
+        let pickedId = this.feedback ? this.feedback.pickedOptionId : null;
+        if (multi) {
+            const sent = !this.feedback || !this.feedback.timedOut;
+            pickedId = sent && this.multiPicks.includes(option.id) ? option.id : null;
+        }
         return optionStateClass(option, idx, {
             revealed: !!this.feedback,
             correctIds: this.feedback ? this.feedback.correctOptionIds || [] : [],
-            pickedId: this.feedback ? this.feedback.pickedOptionId : null,
+            pickedId,
+            highlightPick: multi,
         });
     }
 
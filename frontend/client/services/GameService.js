@@ -19,8 +19,16 @@ export class GameService {
         return jsonOrThrow(response);
     }
 
+    // A 429 means the server holds the next question back until a minimum
+    // interval since the previous one has passed; wait out its Retry-After
+    // and ask again rather than surfacing an error.
     async getNextQuestion(gameId) {
-        const response = await fetch(`/api/games/${gameId}/questions/next`);
+        let response = await fetch(`/api/games/${gameId}/questions/next`);
+        for (let attempt = 0; response.status === 429 && attempt < 3; attempt++) {
+            const seconds = parseInt(response.headers.get('Retry-After'), 10) || 1;
+            await new Promise(resolve => setTimeout(resolve, seconds * 1000));
+            response = await fetch(`/api/games/${gameId}/questions/next`);
+        }
         if (response.status === 404) {
             return null;
         }
@@ -39,12 +47,50 @@ export class GameService {
     // here so the server can refund the network-latency portion of
     // AnsweredAt instead of stamping commit time (#237). ISO-8601 so
     // the server's time.Time JSON decoder accepts it directly; the
-    // service-side clamp re-validates the value either way.
-    async submitAnswer(gameId, questionId, optionId, tappedAt) {
-        const response = await fetch(`/api/games/${gameId}/questions/${questionId}/answers`, {
+    // service-side clamp re-validates the value either way. A numeric
+    // question is answered with numericValue instead of an optionId, and a
+    // select-all-that-apply question with optionIds, every picked option.
+    // prefetch=true asks the server to issue the next item in the same
+    // round trip; it comes back as `next`, in the /next shape, absent when
+    // there is nothing left to serve.
+    async submitAnswer(gameId, questionId, optionId, tappedAt, numericValue, optionIds) {
+        let body = { optionId: optionId, tappedAt: tappedAt };
+        if (optionIds !== undefined) body = { optionIds: optionIds, tappedAt: tappedAt };
+        else if (numericValue !== undefined) body = { numericValue: numericValue, tappedAt: tappedAt };
+        const response = await fetch(`/api/games/${gameId}/questions/${questionId}/answers?prefetch=true`, {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(body)
+        });
+        return jsonOrThrow(response);
+    }
+
+    // placeWager locks in the confidence stake (1-3) on a question of a quiz
+    // that uses the wager, before it is answered. Returns { wager }.
+    async placeWager(gameId, questionId, wager) {
+        const response = await fetch(`/api/games/${gameId}/questions/${questionId}/wager`, {
             method: 'POST',
             headers: { 'Content-Type': 'application/json' },
-            body: JSON.stringify({ optionId: optionId, tappedAt: tappedAt })
+            body: JSON.stringify({ wager: wager })
+        });
+        return jsonOrThrow(response);
+    }
+
+    // pauseOnce spends the game's one "connection hiccup" pause on a
+    // question. Returns { expiredAt, extensionSeconds }: the player's new
+    // deadline and how long the pause holds the timer.
+    async pauseOnce(gameId, questionId) {
+        const response = await fetch(`/api/games/${gameId}/questions/${questionId}/pause-once`, {
+            method: 'POST'
+        });
+        return jsonOrThrow(response);
+    }
+
+    // useHint reveals a question's hint. Returns { hint, penaltyPercent }: the
+    // hint and the share of the answer's points it costs. Asking again is free.
+    async useHint(gameId, questionId) {
+        const response = await fetch(`/api/games/${gameId}/questions/${questionId}/hint`, {
+            method: 'POST'
         });
         return jsonOrThrow(response);
     }
@@ -26,7 +26,8 @@ export const STANDINGS_FLIP_DURATION = 450;
 // finished phases animate: the finished standings carry the last round's
 // roundScore so the bars grow into that final contribution. ownsRow, when
 // supplied, marks the viewer's own row (isMe) for highlighting; the host
-// surface omits it.
+// surface omits it. A late joiner's row keeps missedQuestions and lateJoin so
+// the surface can footnote it.
 export function buildStandingsRows(standings, { animate, ownsRow = null } = {}) {
     const maxTotal = Math.max(1, ...standings.map((s) => s.totalScore));
     const rows = standings.map((s) => ({
@@ -37,6 +38,8 @@ export function buildStandingsRows(standings, { animate, ownsRow = null } = {})
         preTotal: s.totalScore - s.roundScore,
         isMe: ownsRow ? ownsRow(s) : false,
         displayTotal: animate ? s.totalScore - s.roundScore : s.totalScore,
+        missedQuestions: s.missedQuestions || 0,
+        lateJoin: s.lateJoin || '',
     }));
 
     return { rows, maxTotal };
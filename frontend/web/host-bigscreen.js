@@ -58,6 +58,9 @@ function hostBigScreen(joinCode, hasQuiz) {
         // controls without flashing the link before the first state read
         // (no-flash hydration); applyState then keeps it in sync with each read.
         hasQuiz: !!hasQuiz,
+        // True while the roster holds the room's capacity and new players are
+        // turned away (state.roomFull); the lobby shows a "Room full" notice.
+        roomFull: false,
         question: null,
         // Hides the question image when its fetch fails. Reset only on a genuine
         // question change in applyState so a stale hide can't carry into the next
@@ -347,6 +350,7 @@ function hostBigScreen(joinCode, hasQuiz) {
             // tells "quiz armed" from the empty staging lobby so the template
             // shows the Start controls rather than the pick-a-live-quiz link.
             this.hasQuiz = state.quiz != null;
+            this.roomFull = state.roomFull === true;
             this.question = state.question ?? null;
             const questionId = this.question ? this.question.id : null;
             if (questionId !== this.lastQuestionId) {
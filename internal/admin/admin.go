@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"html/template"
 	"log/slog"
+	"math"
 	"net/http"
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
@@ -16,7 +18,10 @@ import (
 	"github.com/gosimple/slug"
 
 	"github.com/starquake/topbanana/internal/absurl"
+	"github.com/starquake/topbanana/internal/assets"
+	"github.com/starquake/topbanana/internal/audit"
 	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/envtag"
 	"github.com/starquake/topbanana/internal/game"
@@ -26,7 +31,6 @@ import (
 	"github.com/starquake/topbanana/internal/locale"
 	"github.com/starquake/topbanana/internal/media"
 	"github.com/starquake/topbanana/internal/quiz"
-	"github.com/starquake/topbanana/internal/reltime"
 	"github.com/starquake/topbanana/internal/render"
 	"github.com/starquake/topbanana/internal/version"
 	"github.com/starquake/topbanana/internal/web/tmpl"
@@ -34,7 +38,7 @@ import (
 
 // Validator is an interface for validating data.
 type Validator interface {
-	Valid(ctx context.Context) map[string]string
+	Valid(ctx context.Context) ValidationErrors
 }
 
 // baseLayout is the template name every admin page (and error page) executes.
@@ -102,21 +106,49 @@ type QuizData struct {
 	// straight from the domain constants so a future level addition
 	// only touches one place.
 	VisibilityOptions []string
-	Mode              string
+	Mode              quiz.Mode
 	// ModeOptions feeds the admin form's play-mode selector (MP-0 /
 	// #677) - pulled straight from the domain constants.
-	ModeOptions []string
+	ModeOptions []quiz.Mode
 	// Language is the advisory content-language label (#1115).
 	Language string
 	// LanguageOptions feeds the admin form's language selector (#1115).
 	LanguageOptions []string
+	// ShuffleQuestions and KeepOptionOrder back the form's randomization
+	// checkboxes; see the quiz.Quiz fields of the same names.
+	ShuffleQuestions bool
+	KeepOptionOrder  bool
+	// LateJoin and JoinDeadlineSeconds back the form's late-join settings;
+	// LateJoinOptions feeds its policy selector from the domain constants.
+	LateJoin            string
+	LateJoinOptions     []string
+	JoinDeadlineSeconds int
+	// MaxPlayers backs the form's room-capacity field (0 = server default).
+	MaxPlayers int
+	// ConfidenceWager backs the form's confidence-wager checkbox.
+	ConfidenceWager bool
+	// HostPaced backs the form's host-paced scoring checkbox.
+	HostPaced bool
+	// Scoring backs the form's scoring-mode selector; ScoringOptions feeds it
+	// from the domain constants.
+	Scoring        string
+	ScoringOptions []string
+	// WrongAnswerPenalty and ScoreFloor back the form's negative-marking
+	// fields.
+	WrongAnswerPenalty int
+	ScoreFloor         int
 	// PlayCount is the durable "times played" counter surfaced on the
 	// admin quiz list footer (#891).
 	PlayCount int64
 	// Published reports whether the quiz is finished and locked from content edits (#1192).
 	Published bool
+	// PublishedAt is when the quiz was last published; nil on a draft.
+	PublishedAt *time.Time
 	// CanUnpublish reports whether a published quiz may still be unpublished (no real plays yet); only the quiz-view handler computes it (#1192).
 	CanUnpublish bool
+	// Version is the quiz's optimistic-concurrency version, posted back by
+	// the edit form's hidden version field.
+	Version int64
 	// ActionVariant selects which action cluster the shared quiz_card
 	// partial renders ("admin" Edit/Delete vs. a future host variant);
 	// html/template has no block/yield, so the card picks a named
@@ -144,10 +176,35 @@ type QuestionData struct {
 	AudioMediaID int64
 	// AudioRepeat pre-checks the "repeat audio" checkbox; true makes the play
 	// surfaces replay the attached clip up to 3 times (#1073).
-	AudioRepeat           bool
+	AudioRepeat bool
+	// AfterQuestionID is the question this one must follow, or 0 when it is
+	// free to move. The form's "Follows" selector pre-selects it.
+	AfterQuestionID int64
+	// Explanation pre-fills the "why this is correct" textarea.
+	Explanation string
+	// Hint pre-fills the optional hint textarea.
+	Hint                  string
 	Position              int
 	TimeLimitSecondsValue string
 	Options               []*OptionData
+	// Stats is the question's answer statistics for its current stats epoch,
+	// shown as a difficulty badge on the quiz view. Nil when not loaded.
+	Stats *quiz.QuestionStats
+	// KeepStats pre-checks the edit form's "keep the answer statistics" box
+	// when a failed save is re-rendered. Unticked, a substantial edit starts
+	// the question's statistics afresh.
+	KeepStats bool
+	// Kind is the question kind, choice or numeric; KindOptions feeds its
+	// selector. NumericValue and the tolerances pre-fill a numeric
+	// question's answer-key inputs, empty for a choice question.
+	Kind           string
+	KindOptions    []string
+	NumericValue   string
+	ToleranceBelow string
+	ToleranceAbove string
+	// Version is the question's optimistic-concurrency version, posted back
+	// by the edit form's hidden version field.
+	Version int64
 }
 
 // CorrectCount reports how many of the question's options are marked
@@ -250,8 +307,22 @@ func quizDataFromQuiz(qz *quiz.Quiz) *QuizData {
 		ModeOptions:          quiz.ModeValues(),
 		Language:             language,
 		LanguageOptions:      quiz.LanguageValues(),
+		ShuffleQuestions:     qz.ShuffleQuestions,
+		KeepOptionOrder:      qz.KeepOptionOrder,
+		LateJoin:             quiz.NormalizedLateJoin(qz.LateJoin),
+		LateJoinOptions:      quiz.LateJoinValues(),
+		JoinDeadlineSeconds:  qz.JoinDeadlineSeconds,
+		MaxPlayers:           qz.MaxPlayers,
+		ConfidenceWager:      qz.ConfidenceWager,
+		HostPaced:            qz.HostPaced,
+		Scoring:              quiz.NormalizedScoring(qz.Scoring),
+		ScoringOptions:       quiz.ScoringValues(),
+		WrongAnswerPenalty:   qz.WrongAnswerPenalty,
+		ScoreFloor:           qz.ScoreFloor,
 		PlayCount:            qz.PlayCount,
 		Published:            qz.Published,
+		PublishedAt:          qz.PublishedAt,
+		Version:              qz.Version,
 		ActionVariant:        actionVariantAdmin,
 		Questions:            questionDataFromQuestions(qz.Questions),
 	}
@@ -282,7 +353,12 @@ func questionDataFromQuestion(q *quiz.Question) *QuestionData {
 		audioMediaID = *q.AudioMediaID
 	}
 
-	return &QuestionData{
+	var afterQuestionID int64
+	if q.AfterQuestionID != nil {
+		afterQuestionID = *q.AfterQuestionID
+	}
+
+	data := &QuestionData{
 		ID:                    q.ID,
 		QuizID:                q.QuizID,
 		RoundID:               q.RoundID,
@@ -290,10 +366,23 @@ func questionDataFromQuestion(q *quiz.Question) *QuestionData {
 		ImageMediaID:          mediaID,
 		AudioMediaID:          audioMediaID,
 		AudioRepeat:           q.AudioRepeat,
+		AfterQuestionID:       afterQuestionID,
+		Explanation:           q.Explanation,
+		Hint:                  q.Hint,
 		Position:              q.Position,
 		TimeLimitSecondsValue: timeLimit,
 		Options:               optionDataFromOptions(q.Options),
+		Kind:                  quiz.NormalizedKind(q.Kind),
+		KindOptions:           quiz.KindValues(),
+		Version:               q.Version,
+	}
+	if key := q.NumericKey(); key != nil {
+		data.NumericValue = quiz.FormatNumber(*key.NumericValue)
+		data.ToleranceBelow = quiz.FormatNumber(key.ToleranceBelow)
+		data.ToleranceAbove = quiz.FormatNumber(key.ToleranceAbove)
 	}
+
+	return data
 }
 
 func questionDataFromQuestions(questions []*quiz.Question) []*QuestionData {
@@ -342,9 +431,6 @@ func optionDataFromOptions(options []*quiz.Option) []*OptionData {
 // and replaces these placeholders (via adminPerRequestFuncs and the renderer's
 // own csrfToken binding) with implementations that read the request context,
 // CSRF manager, and request path, respectively.
-//
-// "humanizeTime" is a pure function of its argument, so it's registered with
-// its real implementation here - no per-request override needed.
 func parseTemplate(path string) *template.Template {
 	funcs := template.FuncMap{
 		"viewerName":        func() string { return "" },
@@ -357,14 +443,17 @@ func parseTemplate(path string) *template.Template {
 		"navSection":        func() string { return "" },
 		"isAdmin":           func() bool { return false },
 		"envTitleTag":       envtag.Get,
+		"asset":             assets.URL,
 		"versionLabel":      version.Label,
-		"humanizeTime":      reltime.Humanize,
 		"passwordMinLength": func() int { return auth.MinPasswordLength },
 		"add":               func(a, b int) int { return a + b },
 		// Parse-time placeholders for the shared client_footer's t/lang (#1115);
 		// render.Renderer rebinds them per request.
 		"t":    func(string) string { return "" },
 		"lang": func() string { return locale.LocaleEN },
+		// Parse-time placeholder for the topbar/footer branding; render.Renderer
+		// rebinds it per request.
+		"brand": func() branding.Branding { return branding.Branding{} },
 	}
 	// Partials are parsed alongside layouts so any page (or any HTMX-fragment
 	// handler) can {{template "name" .}} a shared block without re-listing it.
@@ -432,6 +521,32 @@ func render409(w http.ResponseWriter, r *http.Request, logger *slog.Logger, csrf
 	renderer.Render(w, r, http.StatusConflict, data)
 }
 
+// renderStaleUpdate renders the 409 page for a save refused with
+// [quiz.ErrStaleUpdate]: someone else saved the quiz or question after this
+// form was opened. It offers reloadURL, the edit form reopened on the current
+// version, and a way back to the quiz view.
+func renderStaleUpdate(
+	w http.ResponseWriter, r *http.Request, logger *slog.Logger, csrfMgr *csrf.Manager, reloadURL string, quizID int64,
+) {
+	renderer := render.New(
+		logger,
+		csrfMgr,
+		parseTemplate("admin/errors/stale.gohtml"),
+		baseLayout,
+		adminPerRequestFuncs,
+	)
+	data := struct {
+		Title     string
+		ReloadURL string
+		QuizID    int64
+	}{
+		Title:     "Edit conflict",
+		ReloadURL: reloadURL,
+		QuizID:    quizID,
+	}
+	renderer.Render(w, r, http.StatusConflict, data)
+}
+
 // render500 renders the 500 error page.
 // Should be used as the final handler in the chain and probably be followed by a return.
 func render500(w http.ResponseWriter, r *http.Request, logger *slog.Logger, csrfMgr *csrf.Manager) {
@@ -596,16 +711,16 @@ func questionByID(
 // On a parse error it renders a 400 page directly and returns
 // (nil, false); the caller should just return. On a validation error
 // it leaves the fields populated on qz so the caller can re-render the
-// form, and returns (fieldErrors, true) with a non-empty map keyed by
-// lowercased form-field name (title, description). On success it
-// returns (nil, true).
+// form, and returns (fieldErrors, true) with problems on the lowercased
+// form-field names (title, description). On success it returns
+// (nil, true).
 func fillQuizFromForm(
 	w http.ResponseWriter,
 	r *http.Request,
 	logger *slog.Logger,
 	csrfMgr *csrf.Manager,
 	qz *quiz.Quiz,
-) (map[string]string, bool) {
+) (ValidationErrors, bool) {
 	r.Body = http.MaxBytesReader(w, r.Body, maxFormSize)
 	err := r.ParseForm()
 	if err != nil {
@@ -615,6 +730,7 @@ func fillQuizFromForm(
 
 		return nil, false
 	}
+	qz.Version = formVersion(r, qz.Version)
 	qz.Title = r.PostFormValue("title")
 	qz.Slug = slug.Make(qz.Title)
 	qz.Description = r.PostFormValue("description")
@@ -645,7 +761,7 @@ func fillQuizFromForm(
 	// unrecognised value is passed through verbatim so Quiz.Valid
 	// surfaces an inline error.
 	if m := r.PostFormValue("mode"); m != "" {
-		qz.Mode = m
+		qz.Mode = quiz.Mode(m)
 	} else {
 		qz.Mode = quiz.ModeSolo
 	}
@@ -656,6 +772,41 @@ func fillQuizFromForm(
 	} else {
 		qz.Language = quiz.LanguageEN
 	}
+	// Randomization: the option shuffle is on unless its box is cleared, so
+	// the form posts shuffle_options and the quiz stores the opt-out.
+	qz.ShuffleQuestions = r.PostFormValue("shuffle_questions") != ""
+	qz.KeepOptionOrder = r.PostFormValue("shuffle_options") == ""
+	// Late joiners (live games). Defaults to skip when omitted; an
+	// unrecognised policy passes through so quizForm.Valid flags it. A blank
+	// deadline means none; garbage lands -1, which Valid rejects.
+	qz.LateJoin = quiz.NormalizedLateJoin(r.PostFormValue("late_join"))
+	qz.JoinDeadlineSeconds = 0
+	if raw := strings.TrimSpace(r.PostFormValue("join_deadline_seconds")); raw != "" {
+		n, parseErr := strconv.Atoi(raw)
+		if parseErr != nil {
+			n = -1
+		}
+		qz.JoinDeadlineSeconds = n
+	}
+	// Room capacity (live games). Blank defers to the server default; garbage
+	// lands -1, which Valid rejects.
+	qz.MaxPlayers = 0
+	if raw := strings.TrimSpace(r.PostFormValue("max_players")); raw != "" {
+		n, parseErr := strconv.Atoi(raw)
+		if parseErr != nil {
+			n = -1
+		}
+		qz.MaxPlayers = n
+	}
+	qz.ConfidenceWager = r.PostFormValue("confidence_wager") != ""
+	qz.HostPaced = r.PostFormValue("host_paced") != ""
+	// Defaults to time scoring when omitted; an unrecognised mode passes
+	// through so quizForm.Valid flags it.
+	qz.Scoring = quiz.NormalizedScoring(r.PostFormValue("scoring"))
+	// Negative marking. Blank means none and a zero floor; garbage lands
+	// outside each range, which Valid rejects.
+	qz.WrongAnswerPenalty = formInt(r, "wrong_answer_penalty", -1)
+	qz.ScoreFloor = formInt(r, "score_floor", 1)
 	if problems := (&quizForm{quiz: qz}).Valid(r.Context()); len(problems) > 0 {
 		return problems, true
 	}
@@ -663,6 +814,39 @@ func fillQuizFromForm(
 	return nil, true
 }
 
+// formInt reads an optional whole-number form field: blank is 0, and a
+// value that does not parse lands invalid, which the caller picks outside the
+// field's range so quizForm.Valid surfaces it inline.
+func formInt(r *http.Request, name string, invalid int) int {
+	raw := strings.TrimSpace(r.PostFormValue(name))
+	if raw == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return invalid
+	}
+
+	return n
+}
+
+// formVersion reads the edit form's hidden version field: the version of the
+// quiz or question the form was opened on, which the store checks so a save
+// over someone else's newer one is refused with [quiz.ErrStaleUpdate]. A
+// form without the field (older admin clients or curl probes) keeps loaded,
+// the version just read; one that does not parse lands 0, which no row has.
+func formVersion(r *http.Request, loaded int64) int64 {
+	if !r.PostForm.Has("version") {
+		return loaded
+	}
+	v, err := strconv.ParseInt(strings.TrimSpace(r.PostFormValue("version")), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
 // parseOptionalTimeLimit interprets the optional per-question
 // time_limit_seconds input. Blank -> nil (inherit the quiz default).
 // Garbage -> a non-nil pointer to 0, which Question.Valid catches and
@@ -731,7 +915,7 @@ func resolveQuestionAudio(
 // (nil, message) on any rejection. wantType pins the media kind so the image
 // picker cannot attach audio and vice versa.
 func resolveQuestionMediaOfType(
-	ctx context.Context, mediaStore QuestionMediaStore, quizID int64, raw, wantType string,
+	ctx context.Context, mediaStore QuestionMediaStore, quizID int64, raw string, wantType media.Type,
 	errInvalid, errNotInLibrary, errVerifyFailed string,
 ) (*int64, string) {
 	raw = strings.TrimSpace(raw)
@@ -758,12 +942,14 @@ func resolveQuestionMediaOfType(
 }
 
 // fillQuestionFromForm fills the question fields from the form values.
-// On a parse error it renders a 400 page directly and returns
-// (nil, false); the caller should just return. On a validation error
-// it leaves the fields populated on qs so the caller can re-render the
-// form, and returns (fieldErrors, true) with a non-empty map keyed by
-// lowercased form-field name (text, options). On success it returns
-// (nil, true).
+// On a parse error of the body itself it renders a 400 page directly and
+// returns (nil, false); the caller should just return. Every other problem
+// (a bad media pick, an unreadable field, a failed form rule) is collected
+// while the rest of the form is still read onto qs, so the caller can
+// re-render the form with everything the admin typed, and returns
+// (fieldErrors, true) with problems on the lowercased form-field names
+// (text, options). On success it returns (nil, true). live is whether the
+// question's quiz is hosted live.
 func fillQuestionFromForm(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -771,7 +957,8 @@ func fillQuestionFromForm(
 	csrfMgr *csrf.Manager,
 	mediaStore QuestionMediaStore,
 	qs *quiz.Question,
-) (map[string]string, bool) {
+	live bool,
+) (ValidationErrors, bool) {
 	r.Body = http.MaxBytesReader(w, r.Body, maxFormSize)
 	err := r.ParseForm()
 	if err != nil {
@@ -782,64 +969,118 @@ func fillQuestionFromForm(
 		return nil, false
 	}
 
+	var problems ValidationErrors
+	qs.Version = formVersion(r, qs.Version)
 	qs.Text = r.PostFormValue("text")
+	qs.Explanation = strings.TrimSpace(r.PostFormValue("explanation"))
+	qs.Hint = strings.TrimSpace(r.PostFormValue("hint"))
 	// Image picker (#937). An empty/absent image_media_id means "no image"
 	// (NULL); a non-empty value must name an image in this question's own
-	// quiz library, validated below.
+	// quiz library. A bad pick keeps the saved image on the re-rendered form.
 	mediaID, mediaErr := resolveQuestionImage(r.Context(), mediaStore, qs.QuizID, r.PostFormValue("image_media_id"))
 	if mediaErr != "" {
-		return map[string]string{"media": mediaErr}, true
+		problems.add("media", CodeInvalid, mediaErr)
+	} else {
+		qs.ImageMediaID = mediaID
 	}
-	qs.ImageMediaID = mediaID
 	// Audio picker (#1059). An empty/absent audio_media_id means "no audio"
 	// (NULL); a non-empty value must name audio in this question's own quiz
-	// library, validated below.
+	// library, as for the image.
 	audioID, audioErr := resolveQuestionAudio(r.Context(), mediaStore, qs.QuizID, r.PostFormValue("audio_media_id"))
 	if audioErr != "" {
-		return map[string]string{"audio": audioErr}, true
+		problems.add("audio", CodeInvalid, audioErr)
+	} else {
+		qs.AudioMediaID = audioID
 	}
-	qs.AudioMediaID = audioID
 	// An unchecked HTML checkbox sends no value; checked sends its value (#1073).
 	qs.AudioRepeat = r.PostFormValue("audio_repeat") != ""
+	// Ordering constraint. Blank means the question is free to move; the
+	// caller checks a named question against the quiz's follow candidates.
+	qs.AfterQuestionID = nil
+	afterID, err := handlers.IDFromString(r.PostFormValue("after_question_id"))
+	switch {
+	case err != nil:
+		problems.add("after", CodeInvalidChoice, "Pick a question from the list, or none")
+	case afterID != 0:
+		qs.AfterQuestionID = &afterID
+	default:
+		// Free to move.
+	}
 	// Optional per-question override (#99). Blank input clears any
 	// previous override (NULL -> inherit the quiz default); a parse
 	// failure lands a zero, which Question.Valid rejects with an
 	// inline range error rather than silently saving a bad value.
 	qs.TimeLimitSeconds = parseOptionalTimeLimit(r.PostFormValue("time_limit_seconds"))
+	qs.Kind = quiz.NormalizedKind(r.PostFormValue("kind"))
+	if qs.IsNumeric() {
+		if problem := fillNumericKeyFromForm(r, qs); problem != nil {
+			return append(problems, problem...), true
+		}
+	} else {
+		problems = append(problems, fillOptionsFromForm(r, qs)...)
+	}
 
-	newOptions := make([]*quiz.Option, 0, maxOptions)
+	return append(problems, (&questionForm{question: qs, live: live}).Valid(r.Context())...), true
+}
 
+// fillOptionsFromForm replaces qs's options with the option[i] rows of the
+// form, keeping each existing option's id so an edit updates it in place.
+// An option id that does not parse (the field is hidden, so only a tampered
+// or stale form sends one) is a problem on "options"; that row is kept as a
+// new option so its text survives the re-render.
+func fillOptionsFromForm(r *http.Request, qs *quiz.Question) ValidationErrors {
+	var problems ValidationErrors
+	newOptions := make([]*quiz.Option, 0, maxOptions)
 	for i := range maxOptions {
-		var op *quiz.Option
+		if !r.PostForm.Has(fmt.Sprintf("option[%d].text", i)) {
+			continue
+		}
+		op := &quiz.Option{QuestionID: qs.ID}
 		if i < len(qs.Options) {
 			op = qs.Options[i]
-		} else {
-			op = &quiz.Option{
-				QuestionID: qs.ID,
-			}
 		}
-		if r.PostForm.Has(fmt.Sprintf("option[%d].text", i)) {
-			op.ID, err = handlers.IDFromString(r.PostFormValue(fmt.Sprintf("option[%d].id", i)))
-			if err != nil {
-				msg := "error parsing optionID"
-				logger.ErrorContext(r.Context(), msg, slog.Any("err", err))
-				render400(w, r, logger, csrfMgr, msg)
-
-				return nil, false
-			}
-			op.Text = r.PostFormValue(fmt.Sprintf("option[%d].text", i))
-			op.Correct = r.PostFormValue(fmt.Sprintf("option[%d].correct", i)) == "on"
-
-			newOptions = append(newOptions, op)
+		id, err := handlers.IDFromString(r.PostFormValue(fmt.Sprintf("option[%d].id", i)))
+		if err != nil {
+			problems.add("options", CodeInvalid, "The options could not be read - reload the form and try again")
 		}
+		op.ID = id
+		op.Text = r.PostFormValue(fmt.Sprintf("option[%d].text", i))
+		op.Correct = r.PostFormValue(fmt.Sprintf("option[%d].correct", i)) == "on"
+		newOptions = append(newOptions, op)
 	}
 	qs.Options = newOptions
 
-	if problems := (&questionForm{question: qs}).Valid(r.Context()); len(problems) > 0 {
-		return problems, true
+	return problems
+}
+
+// fillNumericKeyFromForm replaces qs's options with the numeric answer key
+// read from the numeric_value and tolerance_below / tolerance_above fields,
+// keeping the existing key option's id so an edit updates it in place. A
+// blank tolerance means zero. Returns a field error when a value does not
+// parse.
+func fillNumericKeyFromForm(r *http.Request, qs *quiz.Question) ValidationErrors {
+	value, err := strconv.ParseFloat(strings.TrimSpace(r.PostFormValue("numeric_value")), 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return ValidationErrors{{Field: "numericvalue", Code: CodeInvalidNumber, Message: "The answer must be a number"}}
+	}
+	var tolerances [2]float64
+	for i, field := range []string{"tolerance_below", "tolerance_above"} {
+		raw := strings.TrimSpace(r.PostFormValue(field))
+		if raw == "" {
+			continue
+		}
+		if tolerances[i], err = strconv.ParseFloat(raw, 64); err != nil || math.IsNaN(tolerances[i]) {
+			return ValidationErrors{{Field: "tolerance", Code: CodeInvalidNumber, Message: "Tolerances must be numbers"}}
+		}
+	}
+	key := quiz.NewNumericKey(value, tolerances[0], tolerances[1])
+	key.QuestionID = qs.ID
+	if len(qs.Options) > 0 {
+		key.ID = qs.Options[0].ID
 	}
+	qs.Options = []*quiz.Option{key}
 
-	return nil, true
+	return nil
 }
 
 // storeQuiz persists qz via the appropriate Create/Update path. It does
@@ -887,6 +1128,13 @@ func storeQuestion(
 		}
 	} else {
 		err = quizStore.UpdateQuestion(r.Context(), qs)
+		if errors.Is(err, quiz.ErrStaleUpdate) {
+			renderStaleUpdate(w, r, logger, csrfMgr, fmt.Sprintf(
+				"/admin/quizzes/%d/questions/%d/edit", qs.QuizID, qs.ID,
+			), qs.QuizID)
+
+			return false
+		}
 		if err != nil {
 			logger.ErrorContext(r.Context(), "error updating question", slog.Any("err", err))
 			render500(w, r, logger, csrfMgr)
@@ -982,21 +1230,44 @@ func activeRoomCode(r *http.Request, logger *slog.Logger, sessions ActiveSession
 	return sess.JoinCode
 }
 
+// quizzesPerPage is the page size of the admin quiz list. The cards render two
+// to a row, so an even count keeps the last row of a full page complete.
+const quizzesPerPage = 24
+
+// quizListData backs the quizlist.gohtml template. The paging fields mirror
+// playersPageData so the two lists share one navigation markup.
+type quizListData struct {
+	Title      string
+	Quizzes    []*QuizData
+	Mode       string
+	Query      string
+	SoloURL    string
+	LiveURL    string
+	AllURL     string
+	ClearURL   string
+	Page       int
+	TotalPages int
+	TotalRows  int64
+	HasPrev    bool
+	HasNext    bool
+	PrevURL    string
+	NextURL    string
+	RangeStart int64
+	RangeEnd   int64
+}
+
 // HandleQuizList returns the quiz list page. The optional mode query param
 // filters the list by play mode (#851): "solo" or "live" keeps only quizzes of
 // that mode; anything else (including absent) shows all. The chosen mode is
 // passed to the template so it can mark the active Solo / Live / All filter tab.
+// The list is paged by ?page=N, [quizzesPerPage] cards at a time. A non-blank
+// ?q narrows it to the quizzes matching the search, best match first, within
+// the same role scope and mode filter.
 func HandleQuizList(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
 	renderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/quizlist.gohtml")
 
-	type quizListData struct {
-		Title   string
-		Quizzes []*QuizData
-		Mode    string
-	}
-
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		quizzes, ok := listQuizzesForViewer(w, r, logger, csrfMgr, quizStore)
+		data, ok := loadQuizListPage(w, r, logger, csrfMgr, quizStore)
 		if !ok {
 			return
 		}
@@ -1004,7 +1275,7 @@ func HandleQuizList(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.S
 		// Counts come from a separate aggregate query so the Quiz domain
 		// type doesn't have to carry a list-only field. A quiz with no
 		// questions is absent from the map; the lookup yields 0.
-		// A question added or deleted between this call and ListQuizzes
+		// A question added or deleted between this call and the page read
 		// above can produce a count that's off by one for a single render
 		// - acceptable for a read view; eventual consistency is fine.
 		counts, err := quizStore.QuestionCountsByQuiz(r.Context())
@@ -1022,29 +1293,181 @@ func HandleQuizList(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.S
 			return
 		}
 
-		// Filter by play mode in Go from the single ListQuizzes read so the
-		// same handler serves solo / live / all without a second query path
-		// (#851). Only the recognised modes filter; anything else shows all.
-		mode := r.URL.Query().Get("mode")
-		quizzes = filterQuizzesByMode(quizzes, mode)
-
-		qzd := quizDataFromQuizzes(quizzes)
-		for _, qd := range qzd {
+		for _, qd := range data.Quizzes {
 			qd.QuestionCount = counts[qd.ID]
 			qd.RoundCount = roundCounts[qd.ID]
 			attachCanEdit(r, qd)
 		}
 
-		data := quizListData{
-			Title:   "Admin Dashboard - Quiz List",
-			Quizzes: qzd,
-			Mode:    mode,
-		}
-
 		renderer.Render(w, r, http.StatusOK, data)
 	})
 }
 
+// loadQuizListPage runs the count + page queries for the admin quiz list,
+// scoped to the session player's role (#1207) and the ?mode filter (#851), and
+// builds the template data; a ?q search goes through [fetchQuizListPage]
+// instead. Like loadPlayersPage, a page past the end clamps to the last one.
+// On a store error or a missing player it renders 500 and returns ok=false.
+func loadQuizListPage(
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *slog.Logger,
+	csrfMgr *csrf.Manager,
+	quizStore quiz.Store,
+) (quizListData, bool) {
+	ctx := r.Context()
+	player, ok := auth.PlayerFromContext(ctx)
+	if !ok {
+		logger.ErrorContext(ctx, "missing player on context for quiz list")
+		render500(w, r, logger, csrfMgr)
+
+		return quizListData{}, false
+	}
+	// An Admin sees every quiz; a plain Host only their own (owner 0 is the
+	// store's "no scope").
+	ownerID := player.ID
+	if player.IsAdmin() {
+		ownerID = 0
+	}
+	// Only the recognised modes filter; anything else shows all.
+	mode := r.URL.Query().Get("mode")
+	filterMode := ""
+	if quiz.IsValidMode(mode) {
+		filterMode = mode
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+
+	pg, err := fetchQuizListPage(ctx, quizStore, quizListScope{
+		ownerID: ownerID,
+		mode:    filterMode,
+		query:   query,
+	}, parsePageParam(r.URL.Query().Get("page")))
+	if err != nil {
+		logger.ErrorContext(ctx, "error retrieving quizzes from store", slog.Any("err", err))
+		render500(w, r, logger, csrfMgr)
+
+		return quizListData{}, false
+	}
+	quizzes, total, page, totalPages := pg.quizzes, pg.total, pg.page, pg.totalPages
+	offset := int64(page-1) * quizzesPerPage
+
+	rangeStart := int64(0)
+	if len(quizzes) > 0 {
+		rangeStart = offset + 1
+	}
+
+	return quizListData{
+		Title:      "Admin Dashboard - Quiz List",
+		Quizzes:    quizDataFromQuizzes(quizzes),
+		Mode:       mode,
+		Query:      query,
+		SoloURL:    quizzesPageURL(string(quiz.ModeSolo), query, 0),
+		LiveURL:    quizzesPageURL(string(quiz.ModeLive), query, 0),
+		AllURL:     quizzesPageURL("", query, 0),
+		ClearURL:   quizzesPageURL(filterMode, "", 0),
+		Page:       page,
+		TotalPages: totalPages,
+		TotalRows:  total,
+		HasPrev:    page > 1,
+		HasNext:    page < totalPages,
+		PrevURL:    quizzesPageURL(filterMode, query, page-1),
+		NextURL:    quizzesPageURL(filterMode, query, page+1),
+		RangeStart: rangeStart,
+		RangeEnd:   offset + int64(len(quizzes)),
+	}, true
+}
+
+// quizListScope is what narrows the admin quiz list: the owner and mode
+// filters of [quiz.Store.ListQuizzesPage] and an optional search query.
+type quizListScope struct {
+	ownerID int64
+	mode    string
+	query   string
+}
+
+// quizListPage is one clamped page of the admin quiz list.
+type quizListPage struct {
+	quizzes    []*quiz.Quiz
+	total      int64
+	page       int
+	totalPages int
+}
+
+// fetchQuizListPage reads the requested page of the quiz list in scope,
+// clamped to the last page. Without a query that is a count then a page read;
+// a search returns its total with the page, so a page past the end costs a
+// second search for the last page.
+func fetchQuizListPage(
+	ctx context.Context, quizStore quiz.Store, scope quizListScope, page int,
+) (quizListPage, error) {
+	if scope.query == "" {
+		total, err := quizStore.CountQuizzes(ctx, scope.ownerID, scope.mode)
+		if err != nil {
+			return quizListPage{}, fmt.Errorf("count quizzes: %w", err)
+		}
+		totalPages := max(totalPagesFor(total, quizzesPerPage), 1)
+		page = min(page, totalPages)
+		quizzes, err := quizStore.ListQuizzesPage(
+			ctx, scope.ownerID, scope.mode, quizzesPerPage, int64(page-1)*quizzesPerPage,
+		)
+		if err != nil {
+			return quizListPage{}, fmt.Errorf("list quizzes page: %w", err)
+		}
+
+		return quizListPage{quizzes: quizzes, total: total, page: page, totalPages: totalPages}, nil
+	}
+
+	search := func(page int) ([]*quiz.Quiz, int64, error) {
+		quizzes, total, err := quizStore.SearchQuizzes(ctx, scope.query, quiz.SearchOptions{
+			OwnerID: scope.ownerID,
+			Mode:    scope.mode,
+			Limit:   quizzesPerPage,
+			Offset:  int64(page-1) * quizzesPerPage,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("search quizzes: %w", err)
+		}
+
+		return quizzes, total, nil
+	}
+	quizzes, total, err := search(page)
+	if err != nil {
+		return quizListPage{}, err
+	}
+	totalPages := max(totalPagesFor(total, quizzesPerPage), 1)
+	if page > totalPages {
+		page = totalPages
+		if quizzes, total, err = search(page); err != nil {
+			return quizListPage{}, err
+		}
+	}
+
+	return quizListPage{quizzes: quizzes, total: total, page: page, totalPages: totalPages}, nil
+}
+
+// quizzesPageURL composes an /admin/quizzes URL for the given page, keeping
+// the play-mode filter and search query so paging stays inside the active tab
+// and search. A page below 1 is left off. See playersPageURL for the encoding
+// rule.
+func quizzesPageURL(mode, query string, page int) string {
+	v := url.Values{}
+	if mode != "" {
+		v.Set("mode", mode)
+	}
+	if query != "" {
+		v.Set("q", query)
+	}
+	if page >= 1 {
+		v.Set("page", strconv.Itoa(page))
+	}
+	if len(v) == 0 {
+		return "/admin/quizzes"
+	}
+
+	return "/admin/quizzes?" + v.Encode()
+}
+
 // listQuizzesForViewer returns the quiz list scoped to the session player's
 // role (#1207): an Admin sees every quiz; a plain Host sees only their own. It
 // renders 500 and returns false on a store error or a missing player.
@@ -1082,28 +1505,6 @@ func listQuizzesForViewer(
 	return quizzes, true
 }
 
-// filterQuizzesByMode keeps only quizzes whose Mode matches the requested play
-// mode (#851). Only [quiz.ModeSolo] and [quiz.ModeLive] filter; any other value
-// (including "") returns the list unchanged so the "All" tab shows everything.
-// A quiz with an empty Mode is treated as solo, matching the store-layer default.
-func filterQuizzesByMode(quizzes []*quiz.Quiz, mode string) []*quiz.Quiz {
-	if mode != quiz.ModeSolo && mode != quiz.ModeLive {
-		return quizzes
-	}
-	filtered := make([]*quiz.Quiz, 0, len(quizzes))
-	for _, qz := range quizzes {
-		qm := qz.Mode
-		if qm == "" {
-			qm = quiz.ModeSolo
-		}
-		if qm == mode {
-			filtered = append(filtered, qz)
-		}
-	}
-
-	return filtered
-}
-
 // PlayerScoreData represents one row of the "Played by" table on the quiz
 // view page: a player who has finished every quiz question, alongside
 // their accumulated score (computed by the game service in the same way
@@ -1162,6 +1563,7 @@ func HandleQuizView(
 
 		quizData := quizDataFromQuiz(qz)
 		attachCanEdit(r, quizData)
+		attachQuestionStats(r, logger, quizStore, quizData)
 		if quizData.Published {
 			// A published quiz can be unpublished only until a real (non-preview) game has started (#1192).
 			hasPlays, err := quizStore.QuizHasRealPlays(r.Context(), id)
@@ -1183,6 +1585,23 @@ func HandleQuizView(
 	})
 }
 
+// attachQuestionStats fills each question's answer statistics for the quiz
+// view's difficulty badge. A lookup failure is logged and leaves the badges
+// off rather than failing the page; the statistics are informational.
+func attachQuestionStats(r *http.Request, logger *slog.Logger, quizStore quiz.Store, quizData *QuizData) {
+	stats, err := quizStore.QuestionStats(r.Context(), quizData.ID)
+	if err != nil {
+		logger.ErrorContext(r.Context(), "error loading question stats", slog.Any("err", err))
+
+		return
+	}
+	for _, q := range quizData.Questions {
+		if st, ok := stats[q.ID]; ok {
+			q.Stats = &st
+		}
+	}
+}
+
 // hostHasRunningGame reports whether the signed-in host already has a game in
 // flight, so the quiz view can gate the "Host live" confirm-and-restart prompt
 // (#853). A lookup failure is logged and degraded to false rather than failing
@@ -1361,7 +1780,7 @@ func mediaCardDataFromMedia(items []*media.Media) []MediaCardData {
 // mediaType, preserving order. The quiz view and the question pickers list
 // images and sounds separately, so the loaders split one ListMediaByQuiz read
 // (which returns every ready row) by type (#1059).
-func filterMediaByType(items []*media.Media, mediaType string) []*media.Media {
+func filterMediaByType(items []*media.Media, mediaType media.Type) []*media.Media {
 	filtered := make([]*media.Media, 0, len(items))
 	for _, m := range items {
 		if m.Type == mediaType {
@@ -1442,6 +1861,7 @@ func renderRoundsPartial(
 	}
 	quizData := quizDataFromQuiz(qz)
 	attachCanEdit(r, quizData)
+	attachQuestionStats(r, logger, quizStore, quizData)
 	renderer.RenderPartial(w, r, "questions_list", roundsPartialData{
 		Quiz:   quizData,
 		Rounds: buildRoundView(rounds, quizData.Questions),
@@ -1609,11 +2029,13 @@ type quizFormData struct {
 	Title       string
 	Quiz        *QuizData
 	Error       string
-	FieldErrors map[string]string
+	FieldErrors ValidationErrors
 }
 
 // HandleQuizSave saves the quiz to the database.
-func HandleQuizSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+func HandleQuizSave(
+	logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store, events audit.Store,
+) http.Handler {
 	formRenderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/quizform.gohtml")
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1626,6 +2048,7 @@ func HandleQuizSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.S
 
 		newQuiz := quizID == 0
 		var qz *quiz.Quiz
+		var before audit.Fields
 		if newQuiz {
 			// CREATE: stamp the session admin as the creator so the
 			// owner-gated mutating routes downstream can match (#281).
@@ -1638,6 +2061,7 @@ func HandleQuizSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.S
 			if qz, ok = requireEditableQuizOwner(w, r, logger, csrfMgr, quizStore, quizID); !ok {
 				return
 			}
+			before = quizAuditFields(qz)
 		}
 
 		fieldErrors, ok := fillQuizFromForm(w, r, logger, csrfMgr, qz)
@@ -1650,10 +2074,10 @@ func HandleQuizSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.S
 		}
 		if len(fieldErrors) > 0 {
 			// Domain-level validation failed. Re-render the same form
-			// at 400 with FieldErrors set; the template uses them to
+			// at 422 with FieldErrors set; the template uses them to
 			// decorate each invalid input and show the per-field
 			// message. Submitted values are preserved on qz.
-			formRenderer.Render(w, r, http.StatusBadRequest, quizFormData{
+			formRenderer.Render(w, r, http.StatusUnprocessableEntity, quizFormData{
 				Title:       title,
 				Quiz:        quizDataFromQuiz(qz),
 				FieldErrors: fieldErrors,
@@ -1667,6 +2091,12 @@ func HandleQuizSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.S
 
 			return
 		}
+		action := audit.ActionUpdate
+		if newQuiz {
+			action = audit.ActionCreate
+		}
+		recordAudit(r, logger, events, &audit.Event{EntityType: audit.EntityQuiz, EntityID: qz.ID, Action: action},
+			before, quizAuditFields(qz))
 
 		http.Redirect(w, r, fmt.Sprintf("/admin/quizzes/%d", qz.ID), http.StatusSeeOther)
 	})
@@ -1675,7 +2105,9 @@ func HandleQuizSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.S
 // renderQuizSaveError handles the storeQuiz failure paths for
 // HandleQuizSave. Split out so HandleQuizSave's main flow keeps a single
 // happy-path return. [quiz.ErrSlugTaken] re-renders the form at 409
-// with the submitted Title/Description preserved (#293); anything else
+// with the submitted Title/Description preserved (#293) and the conflict
+// shown inline under the title input, since the slug is derived from the
+// title and the title is what the host has to change; anything else
 // is treated as a genuine 500. pageTitle is the rendered <title> - the
 // caller picks it from quizFormCreateTitle / quizFormEditTitle based on
 // whether the POST landed on create or edit.
@@ -1685,11 +2117,20 @@ func renderQuizSaveError(
 	formRenderer *render.Renderer,
 	qz *quiz.Quiz, pageTitle string, err error,
 ) {
+	if errors.Is(err, quiz.ErrStaleUpdate) {
+		renderStaleUpdate(w, r, logger, csrfMgr, fmt.Sprintf("/admin/quizzes/%d/edit", qz.ID), qz.ID)
+
+		return
+	}
 	if errors.Is(err, quiz.ErrSlugTaken) {
 		formRenderer.Render(w, r, http.StatusConflict, quizFormData{
 			Title: pageTitle,
 			Quiz:  quizDataFromQuiz(qz),
-			Error: "A quiz with this title already exists - pick a different title (or rename the existing quiz).",
+			FieldErrors: ValidationErrors{{
+				Field:   "title",
+				Code:    CodeTaken,
+				Message: "A quiz with this title already exists - pick a different title (or rename the existing quiz).",
+			}},
 		})
 
 		return
@@ -1727,7 +2168,14 @@ type questionFormData struct {
 	// AudioLibrary is the question's own quiz sound library, newest first, for
 	// the audio-picker list (#1059). Empty when the quiz has no sounds yet.
 	AudioLibrary []MediaCardData
-	FieldErrors  map[string]string
+	// FollowOptions are the questions the "Follows" selector offers, the
+	// question's [quiz.FollowCandidates].
+	FollowOptions []*QuestionData
+	FieldErrors   ValidationErrors
+	// Draft is the session player's autosaved unsaved edit of the question,
+	// restored over the saved values when the form opens. Nil when there is
+	// none, and always nil on a new question.
+	Draft *QuestionDraftData
 }
 
 // HandleQuestionCreate creates a question. The round the question lands
@@ -1766,12 +2214,13 @@ func HandleQuestionCreate(
 		}
 
 		renderer.Render(w, r, http.StatusOK, questionFormData{
-			Title:        "Admin Dashboard - Question Create",
-			Quiz:         quizDataFromQuiz(qz),
-			Question:     &QuestionData{},
-			Round:        roundDataFromRound(rnd),
-			Library:      library,
-			AudioLibrary: audioLibrary,
+			Title:         "Admin Dashboard - Question Create",
+			Quiz:          quizDataFromQuiz(qz),
+			Question:      &QuestionData{},
+			Round:         roundDataFromRound(rnd),
+			Library:       library,
+			AudioLibrary:  audioLibrary,
+			FollowOptions: followOptions(qz, &quiz.Question{RoundID: rnd.ID}),
 		})
 	})
 }
@@ -1872,11 +2321,13 @@ func HandleQuestionEdit(
 		}
 
 		renderer.Render(w, r, http.StatusOK, questionFormData{
-			Title:        "Admin Dashboard - Question Edit",
-			Quiz:         quizDataFromQuiz(qz),
-			Question:     questionDataFromQuestion(qs),
-			Library:      library,
-			AudioLibrary: audioLibrary,
+			Title:         "Admin Dashboard - Question Edit",
+			Quiz:          quizDataFromQuiz(qz),
+			Question:      questionDataFromQuestion(qs),
+			Library:       library,
+			AudioLibrary:  audioLibrary,
+			FollowOptions: followOptions(qz, qs),
+			Draft:         questionDraftForForm(r, logger, quizStore, qs.ID),
 		})
 	})
 }
@@ -1895,18 +2346,26 @@ func HandleQuizSetMode(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore qui
 			return
 		}
 
-		if _, ok = requireEditableQuizOwner(w, r, logger, csrfMgr, quizStore, quizID); !ok {
+		var qz *quiz.Quiz
+		if qz, ok = requireEditableQuizOwner(w, r, logger, csrfMgr, quizStore, quizID); !ok {
 			return
 		}
 
-		mode := r.PathValue("mode")
-		if !quiz.IsValidMode(mode) {
+		mode, err := quiz.ParseMode(r.PathValue("mode"))
+		if err != nil {
 			render400(w, r, logger, csrfMgr, "invalid play mode")
 
 			return
 		}
+		// The live runner only plays single option picks.
+		if mode == quiz.ModeLive && slices.ContainsFunc(qz.Questions, notLivePlayable) {
+			render409(w, r, logger, csrfMgr,
+				"This quiz has numeric or select-all questions, which a live quiz cannot play. Remove them first.")
+
+			return
+		}
 
-		if err := quizStore.SetQuizMode(r.Context(), quizID, mode); err != nil {
+		if err = quizStore.SetQuizMode(r.Context(), quizID, mode); err != nil {
 			if errors.Is(err, quiz.ErrQuizNotFound) {
 				render404(w, r, logger, csrfMgr)
 
@@ -1922,14 +2381,14 @@ func HandleQuizSetMode(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore qui
 	})
 }
 
-// QuizMediaRemover drops a quiz's on-disk media directory (#1174).
+// QuizMediaRemover drops a quiz's stored media directory (#1174).
 type QuizMediaRemover interface {
-	RemoveQuizDir(quizID int64) error
+	RemoveQuizDir(ctx context.Context, quizID int64) error
 }
 
 // HandleQuizDelete deletes a quiz and all its questions and options.
 func HandleQuizDelete(
-	logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store, mediaSvc QuizMediaRemover,
+	logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store, mediaSvc QuizMediaRemover, events audit.Store,
 ) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var ok bool
@@ -1940,7 +2399,8 @@ func HandleQuizDelete(
 		}
 
 		// Delete is removal, not a content edit, so the publish lock does not apply here.
-		if _, ok = requireQuizOwner(w, r, logger, csrfMgr, quizStore, quizID); !ok {
+		var qz *quiz.Quiz
+		if qz, ok = requireQuizOwner(w, r, logger, csrfMgr, quizStore, quizID); !ok {
 			return
 		}
 
@@ -1955,10 +2415,13 @@ func HandleQuizDelete(
 
 			return
 		}
+		recordAudit(r, logger, events,
+			&audit.Event{EntityType: audit.EntityQuiz, EntityID: quizID, Action: audit.ActionDelete},
+			quizAuditFields(qz), nil)
 
 		// The cascade drops the media rows but not their files; unlink them
 		// best-effort without failing the already-committed delete.
-		if err := mediaSvc.RemoveQuizDir(quizID); err != nil {
+		if err := mediaSvc.RemoveQuizDir(r.Context(), quizID); err != nil {
 			logger.WarnContext(r.Context(), "failed to remove quiz media directory after delete",
 				slog.Int64("quiz_id", quizID), slog.Any("err", err))
 		}
@@ -2063,7 +2526,9 @@ func HandleQuestionMove(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore qu
 }
 
 // HandleQuestionDelete deletes a question and all its options.
-func HandleQuestionDelete(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+func HandleQuestionDelete(
+	logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store, events audit.Store,
+) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var ok bool
 
@@ -2084,7 +2549,8 @@ func HandleQuestionDelete(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore
 		// Reject cross-quiz deletes (#339); without this gate an admin
 		// who owns quizID could delete a question on a different quiz
 		// by mounting it on this URL.
-		if _, ok = questionByID(w, r, logger, csrfMgr, quizStore, quizID, questionID); !ok {
+		var qs *quiz.Question
+		if qs, ok = questionByID(w, r, logger, csrfMgr, quizStore, quizID, questionID); !ok {
 			return
 		}
 
@@ -2094,6 +2560,9 @@ func HandleQuestionDelete(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore
 
 			return
 		}
+		recordAudit(r, logger, events,
+			&audit.Event{EntityType: audit.EntityQuestion, EntityID: questionID, Action: audit.ActionDelete},
+			questionAuditFields(qs), nil)
 
 		// htmx removes the question row in place via an outerHTML swap; a
 		// plain form post falls back to the 303 reload of the quiz view.
@@ -2122,7 +2591,11 @@ type questionSaveCtx struct {
 
 // HandleQuestionSave saves a question.
 func HandleQuestionSave(
-	logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store, mediaStore QuestionMediaStore,
+	logger *slog.Logger,
+	csrfMgr *csrf.Manager,
+	quizStore quiz.Store,
+	mediaStore QuestionMediaStore,
+	events audit.Store,
 ) http.Handler {
 	formRenderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/questionform.gohtml")
 
@@ -2132,15 +2605,28 @@ func HandleQuestionSave(
 			return
 		}
 
-		fieldErrors, ok := fillQuestionFromForm(w, r, logger, csrfMgr, mediaStore, qctx.Question)
+		before := questionContent(qctx.Question)
+		var auditBefore audit.Fields
+		if !qctx.IsNew {
+			auditBefore = questionAuditFields(qctx.Question)
+		}
+		live := qctx.Quiz.Mode == quiz.ModeLive
+		fieldErrors, ok := fillQuestionFromForm(w, r, logger, csrfMgr, mediaStore, qctx.Question, live)
 		if !ok {
 			return
 		}
+		fieldErrors = addFollowProblem(fieldErrors, qctx.Quiz, qctx.Question)
 		if len(fieldErrors) > 0 {
 			renderQuestionForm(w, r, logger, csrfMgr, formRenderer, mediaStore, qctx, fieldErrors)
 
 			return
 		}
+		// A substantial edit resets the question's answer statistics unless
+		// the host ticked "keep", so the difficulty shown on the quiz view
+		// describes the question as it now reads.
+		if !qctx.IsNew && r.PostFormValue("keep_stats") == "" && quiz.SubstantiallyEdited(before, qctx.Question) {
+			qctx.Question.ResetStats = true
+		}
 
 		// New questions get their position assigned inside the store's
 		// txn-wrapped CreateQuestionAtNextPosition (#352) so the
@@ -2150,6 +2636,14 @@ func HandleQuestionSave(
 		if !storeQuestion(w, r, logger, csrfMgr, quizStore, qctx.Question) {
 			return
 		}
+		action := audit.ActionCreate
+		if !qctx.IsNew {
+			action = audit.ActionUpdate
+			discardQuestionDraft(r, logger, quizStore, qctx.Question.ID)
+		}
+		recordAudit(r, logger, events,
+			&audit.Event{EntityType: audit.EntityQuestion, EntityID: qctx.Question.ID, Action: action},
+			auditBefore, questionAuditFields(qctx.Question))
 
 		// strconv.FormatInt dodges gosec G710's open-redirect heuristic
 		// - the qz.ID came from a request parameter through
@@ -2158,6 +2652,37 @@ func HandleQuestionSave(
 	})
 }
 
+// followOptions maps the questions qs may follow in qz onto the form's
+// "Follows" selector entries.
+func followOptions(qz *quiz.Quiz, qs *quiz.Question) []*QuestionData {
+	return questionDataFromQuestions(quiz.FollowCandidates(qz.Questions, qs))
+}
+
+// addFollowProblem flags an AfterQuestionID that is not among the question's
+// [quiz.FollowCandidates], such as a question from another round or one that
+// moved below it since the form was opened, and returns fieldErrors with the
+// problem added under "after".
+func addFollowProblem(fieldErrors ValidationErrors, qz *quiz.Quiz, qs *quiz.Question) ValidationErrors {
+	if qs.AfterQuestionID == nil || quiz.CanFollow(qz.Questions, qs, *qs.AfterQuestionID) {
+		return fieldErrors
+	}
+	fieldErrors.add("after", CodeInvalidChoice, "Pick an earlier question from the same round")
+
+	return fieldErrors
+}
+
+// questionContent copies the question's text and options, the parts
+// [quiz.SubstantiallyEdited] compares, before fillQuestionFromForm overwrites
+// them in place.
+func questionContent(qs *quiz.Question) *quiz.Question {
+	c := &quiz.Question{Text: qs.Text, Options: make([]*quiz.Option, 0, len(qs.Options))}
+	for _, o := range qs.Options {
+		c.Options = append(c.Options, &quiz.Option{Text: o.Text, Correct: o.Correct})
+	}
+
+	return c
+}
+
 // loadQuestionForSave parses the quizID + questionID off the path,
 // applies the owner gate, and loads the existing question for an edit
 // (or stamps a fresh struct for a create). ok=false when any step
@@ -2239,9 +2764,9 @@ func roundFromForm(
 	return roundByID(w, r, logger, csrfMgr, quizStore, quizID, roundID)
 }
 
-// renderQuestionForm re-renders the question form after a validation
-// failure on save. The submitted Question + FieldErrors are preserved
-// so the admin can fix the offending fields without re-typing.
+// renderQuestionForm re-renders the question form at 422 after a
+// validation failure on save. The submitted Question + FieldErrors are
+// preserved so the admin can fix the offending fields without re-typing.
 func renderQuestionForm(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -2250,7 +2775,7 @@ func renderQuestionForm(
 	renderer *render.Renderer,
 	mediaStore QuestionMediaStore,
 	qctx *questionSaveCtx,
-	fieldErrors map[string]string,
+	fieldErrors ValidationErrors,
 ) {
 	title := "Admin Dashboard - Question Edit"
 	if qctx.IsNew {
@@ -2266,13 +2791,16 @@ func renderQuestionForm(
 	if !ok {
 		return
 	}
-	renderer.Render(w, r, http.StatusBadRequest, questionFormData{
-		Title:        title,
-		Quiz:         quizDataFromQuiz(qctx.Quiz),
-		Question:     questionDataFromQuestion(qctx.Question),
-		Round:        roundData,
-		Library:      library,
-		AudioLibrary: audioLibrary,
-		FieldErrors:  fieldErrors,
+	questionData := questionDataFromQuestion(qctx.Question)
+	questionData.KeepStats = r.PostFormValue("keep_stats") != ""
+	renderer.Render(w, r, http.StatusUnprocessableEntity, questionFormData{
+		Title:         title,
+		Quiz:          quizDataFromQuiz(qctx.Quiz),
+		Question:      questionData,
+		Round:         roundData,
+		Library:       library,
+		AudioLibrary:  audioLibrary,
+		FollowOptions: followOptions(qctx.Quiz, qctx.Question),
+		FieldErrors:   fieldErrors,
 	})
 }
@@ -72,7 +72,7 @@ func TestHandleQuizList(t *testing.T) {
 			t.Fatalf("got: %q, should contain: %q", got, want)
 		}
 		// Quiz One: 2 hr ago. Quiz Two: just now. Rendered inside the
-		// card's <time> element by humanizeTime - see quizlist.gohtml.
+		// card's <time> element by relTime - see quizlist.gohtml.
 		if got, want := body, "2 hr ago"; !strings.Contains(got, want) {
 			t.Errorf("body should contain relative time %q, got: %q", want, got)
 		}
@@ -251,6 +251,111 @@ func TestHandleQuizList_RendersPlayModeBadges(t *testing.T) {
 	}
 }
 
+func TestHandleQuizList_Paginates(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.DiscardHandler)
+	env := newAdminEnv(t)
+	for i := range 25 {
+		qz := ownedQuiz(fmt.Sprintf("Paged Quiz %02d", i+1), fmt.Sprintf("paged-%02d", i+1))
+		env.seedQuiz(t, qz)
+	}
+	live := ownedQuiz("Hosted Quiz", "hosted")
+	live.Mode = quiz.ModeLive
+	env.seedQuiz(t, live)
+
+	handler := HandleQuizList(logger, nil, env.quizzes)
+
+	tests := []struct {
+		name        string
+		target      string
+		wantCards   int
+		wantContain []string
+		wantAbsent  []string
+	}{
+		{
+			name:        "first page links to the next",
+			target:      "/admin/quizzes?mode=solo",
+			wantCards:   24,
+			wantContain: []string{"Page 1 of 2", `href="/admin/quizzes?mode=solo&amp;page=2"`},
+			wantAbsent:  []string{"&larr; Previous", "Hosted Quiz"},
+		},
+		{
+			name:        "last page links back",
+			target:      "/admin/quizzes?mode=solo&page=2",
+			wantCards:   1,
+			wantContain: []string{"Page 2 of 2", `href="/admin/quizzes?mode=solo&amp;page=1"`},
+			wantAbsent:  []string{"Next &rarr;"},
+		},
+		{
+			name:      "page past the end clamps to the last",
+			target:    "/admin/quizzes?mode=solo&page=99",
+			wantCards: 1,
+		},
+		{
+			name:       "single page has no navigation",
+			target:     "/admin/quizzes?mode=live",
+			wantCards:  1,
+			wantAbsent: []string{"data-quiz-pagination"},
+		},
+		{
+			name:      "search pages and tabs keep the query",
+			target:    "/admin/quizzes?q=paged",
+			wantCards: 24,
+			wantContain: []string{
+				"Page 1 of 2", `href="/admin/quizzes?page=2&amp;q=paged"`,
+				`href="/admin/quizzes?mode=live&amp;q=paged"`, `value="paged"`,
+			},
+			wantAbsent: []string{"Hosted Quiz"},
+		},
+		{
+			name:      "search page past the end clamps to the last",
+			target:    "/admin/quizzes?q=paged&page=99",
+			wantCards: 1,
+		},
+		{
+			name:        "search within a mode",
+			target:      "/admin/quizzes?mode=live&q=host",
+			wantCards:   1,
+			wantContain: []string{`name="mode" value="live"`, `href="/admin/quizzes?mode=live" class="btn-ghost" data-quiz-search-clear`},
+		},
+		{
+			name:        "search without matches",
+			target:      "/admin/quizzes?q=nothing",
+			wantCards:   0,
+			wantContain: []string{"data-quiz-search-empty"},
+			wantAbsent:  []string{"Create your first quiz"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tt.target, nil)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, withTestAdmin(req))
+
+			if got, want := rr.Code, http.StatusOK; got != want {
+				t.Fatalf("status = %d, want %d", got, want)
+			}
+			body := rr.Body.String()
+			if got, want := strings.Count(body, `<article id="quiz-card-`), tt.wantCards; got != want {
+				t.Errorf("quiz cards = %d, want %d", got, want)
+			}
+			for _, want := range tt.wantContain {
+				if !strings.Contains(body, want) {
+					t.Errorf("body should contain %q", want)
+				}
+			}
+			for _, absent := range tt.wantAbsent {
+				if strings.Contains(body, absent) {
+					t.Errorf("body should not contain %q", absent)
+				}
+			}
+		})
+	}
+}
+
 func TestHandleQuizList_ErrorHandling(t *testing.T) {
 	t.Parallel()
 
@@ -313,6 +418,35 @@ func TestHandleQuizView(t *testing.T) {
 		}
 	})
 
+	t.Run("shows when a published quiz went live", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		draft := env.seedQuiz(t, twoQuestionQuiz("Draft Quiz", "draft-quiz"))
+		live := env.seedQuiz(t, publishedTwoQuestionQuiz("Live Quiz", "live-quiz"))
+
+		handler := HandleQuizView(
+			logger, nil, env.quizzes, env.newGameService(), runningGameLookup{}, mediaLister{}, testUploadLimits(),
+		)
+		for _, tc := range []struct {
+			id   int64
+			want bool
+		}{{draft.ID, false}, {live.ID, true}} {
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/admin/quizzes/1", nil)
+			req.SetPathValue("quizID", strconv.FormatInt(tc.id, 10))
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, withTestAdmin(req))
+
+			if got, want := rr.Code, http.StatusOK; got != want {
+				t.Fatalf("quiz %d view status = %d, want %d", tc.id, got, want)
+			}
+			if got := strings.Contains(rr.Body.String(), `data-testid="quiz-published-at"`); got != tc.want {
+				t.Errorf("quiz %d view shows published-at = %v, want %v", tc.id, got, tc.want)
+			}
+		}
+	})
+
 	t.Run("renders the upload limits", func(t *testing.T) {
 		t.Parallel()
 
@@ -646,7 +780,7 @@ func TestHandleQuizSetMode(t *testing.T) {
 		handler := HandleQuizSetMode(logger, nil, env.quizzes)
 		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/quizzes/1/mode/live", nil)
 		req.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
-		req.SetPathValue("mode", quiz.ModeLive)
+		req.SetPathValue("mode", string(quiz.ModeLive))
 		rr := httptest.NewRecorder()
 
 		handler.ServeHTTP(rr, withTestAdmin(req))
@@ -701,7 +835,7 @@ func TestHandleQuizSetMode(t *testing.T) {
 		handler := HandleQuizSetMode(logger, nil, env.quizzes)
 		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/quizzes/999/mode/live", nil)
 		req.SetPathValue("quizID", "999")
-		req.SetPathValue("mode", quiz.ModeLive)
+		req.SetPathValue("mode", string(quiz.ModeLive))
 		rr := httptest.NewRecorder()
 
 		handler.ServeHTTP(rr, withTestAdmin(req))
@@ -820,7 +954,7 @@ func TestHandleQuizSave(t *testing.T) {
 
 		env := newAdminEnv(t)
 
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 
 		form := url.Values{
 			"title":       {"Quiz One"},
@@ -887,7 +1021,7 @@ func TestHandleQuizSave(t *testing.T) {
 			"description": {"First Updated"},
 		}
 
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -931,7 +1065,7 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 
 		env := newAdminEnv(t)
 
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/admin/quizzes/not-an-int/edit", nil)
 		req.SetPathValue("quizID", "not-an-int")
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -957,7 +1091,7 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 		env.seedQuiz(t, ownedQuiz("Q", "q"))
 		env.closeStore(t)
 
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/admin/quizzes/1/edit", nil)
 		req.SetPathValue("quizID", "1")
 		rr := httptest.NewRecorder()
@@ -977,7 +1111,7 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 
 		env := newAdminEnv(t)
 
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 		body := errReader{err: errors.New("simulated read error")}
 		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/quizzes", body)
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -1001,7 +1135,7 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 
 		env := newAdminEnv(t)
 
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 
 		form := url.Values{}
 
@@ -1020,7 +1154,7 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 		// with per-field error messages instead of a generic
 		// "validation errors" page. Assert the messages from the
 		// domain Valid map surface inline.
-		if got, want := rr.Code, http.StatusBadRequest; got != want {
+		if got, want := rr.Code, http.StatusUnprocessableEntity; got != want {
 			t.Fatalf("got status code %v, want %v, log:\n%v", got, want, buf.String())
 		}
 		body := rr.Body.String()
@@ -1051,7 +1185,7 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 			"description": {"First"},
 		}
 
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -1095,7 +1229,7 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 			"title":       {"Quiz One"},
 			"description": {"Duplicate"},
 		}
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -1113,6 +1247,9 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 		if got, want := rr.Body.String(), "A quiz with this title already exists"; !strings.Contains(got, want) {
 			t.Errorf("body should contain %q; body=%q", want, got)
 		}
+		if got, want := rr.Body.String(), `id="title-error"`; !strings.Contains(got, want) {
+			t.Errorf("slug conflict should render inline on the title field %q; body=%q", want, got)
+		}
 	})
 
 	t.Run("storing existing quiz fails", func(t *testing.T) {
@@ -1131,7 +1268,7 @@ func TestHandleQuizSave_ErrorHandling(t *testing.T) {
 			"description": {"First Updated"},
 		}
 
-		handler := HandleQuizSave(logger, nil, env.quizzes)
+		handler := HandleQuizSave(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -1432,7 +1569,7 @@ func TestQuestionEditSave_OptionIDsRoundTrip(t *testing.T) {
 	saveReq.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
 	saveReq.SetPathValue("questionID", strconv.FormatInt(original.ID, 10))
 	saveRec := httptest.NewRecorder()
-	HandleQuestionSave(logger, nil, env.quizzes, env.media).ServeHTTP(saveRec, withTestAdmin(saveReq))
+	HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit).ServeHTTP(saveRec, withTestAdmin(saveReq))
 
 	if got, want := saveRec.Code, http.StatusSeeOther; got != want {
 		t.Fatalf("save status = %d, want %d (body=%q)", got, want, saveRec.Body.String())
@@ -1568,7 +1705,7 @@ func TestHandleQuestionSave(t *testing.T) {
 		roundID := env.defaultRoundID(t, qz.ID)
 		mediaID := env.seedMedia(t, qz.ID)
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 
 		form := url.Values{
 			"text":           {"Question Four"},
@@ -1661,7 +1798,7 @@ func TestHandleQuestionSave(t *testing.T) {
 		question := qz.Questions[0]
 		mediaID := env.seedMedia(t, qz.ID)
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 
 		// Update the text and attach an image, keep the two existing options
 		// (by id) with their text changed, and append a brand-new option.
@@ -1738,7 +1875,7 @@ func TestHandleQuestionSave_Media(t *testing.T) {
 		foreignMediaID := env.seedMedia(t, other.ID)
 		question := qz.Questions[0]
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 
 		form := url.Values{
 			"text":           {question.Text},
@@ -1748,7 +1885,7 @@ func TestHandleQuestionSave_Media(t *testing.T) {
 		form.Add("option[0].text", question.Options[0].Text)
 		form.Add("option[0].correct", "on")
 		form.Add("option[1].id", strconv.FormatInt(question.Options[1].ID, 10))
-		form.Add("option[1].text", question.Options[1].Text)
+		form.Add("option[1].text", "An option edited alongside")
 
 		req := httptest.NewRequestWithContext(
 			t.Context(), http.MethodPost,
@@ -1762,12 +1899,16 @@ func TestHandleQuestionSave_Media(t *testing.T) {
 
 		handler.ServeHTTP(rr, withTestAdmin(req))
 
-		if got, want := rr.Code, http.StatusBadRequest; got != want {
+		if got, want := rr.Code, http.StatusUnprocessableEntity; got != want {
 			t.Fatalf("got status code %v, want %v", got, want)
 		}
 		if got, want := rr.Body.String(), "not in this quiz&#39;s library"; !strings.Contains(got, want) {
 			t.Errorf("body should contain the field error %q, got %q", want, got)
 		}
+		// The bad pick must not cost the admin the rest of the edit.
+		if got, want := rr.Body.String(), `value="An option edited alongside"`; !strings.Contains(got, want) {
+			t.Errorf("body should keep the edited option %q", want)
+		}
 		// The cross-quiz reference must NOT have been persisted.
 		stored, err := env.quizzes.GetQuestion(t.Context(), question.ID)
 		if err != nil {
@@ -1793,7 +1934,7 @@ func TestHandleQuestionSave_Media(t *testing.T) {
 			t.Fatalf("seed attach err = %v, want nil", err)
 		}
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 
 		form := url.Values{
 			"text":           {question.Text},
@@ -1830,6 +1971,138 @@ func TestHandleQuestionSave_Media(t *testing.T) {
 	})
 }
 
+// TestHandleQuestionSave_Follows covers the ordering constraint: an earlier
+// question of the same round saves as the one to follow, a later one is
+// rejected with a field error on the re-rendered form.
+func TestHandleQuestionSave_Follows(t *testing.T) {
+	t.Parallel()
+
+	save := func(t *testing.T, env *adminEnv, qz *quiz.Quiz, question *quiz.Question, afterID int64) *httptest.ResponseRecorder {
+		t.Helper()
+
+		form := url.Values{
+			"text":              {question.Text},
+			"after_question_id": {strconv.FormatInt(afterID, 10)},
+		}
+		form.Add("option[0].id", strconv.FormatInt(question.Options[0].ID, 10))
+		form.Add("option[0].text", question.Options[0].Text)
+		form.Add("option[0].correct", "on")
+		form.Add("option[1].id", strconv.FormatInt(question.Options[1].ID, 10))
+		form.Add("option[1].text", question.Options[1].Text)
+
+		req := httptest.NewRequestWithContext(
+			t.Context(), http.MethodPost,
+			fmt.Sprintf("/admin/quizzes/%d/questions/%d", qz.ID, question.ID),
+			strings.NewReader(form.Encode()),
+		)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
+		req.SetPathValue("questionID", strconv.FormatInt(question.ID, 10))
+		rr := httptest.NewRecorder()
+		HandleQuestionSave(slog.New(slog.DiscardHandler), nil, env.quizzes, env.media, env.audit).
+			ServeHTTP(rr, withTestAdmin(req))
+
+		return rr
+	}
+
+	t.Run("earlier question of the round is stored", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+		first, second := qz.Questions[0], qz.Questions[1]
+
+		if got, want := save(t, env, qz, second, first.ID).Code, http.StatusSeeOther; got != want {
+			t.Fatalf("got status code %v, want %v", got, want)
+		}
+		stored, err := env.quizzes.GetQuestion(t.Context(), second.ID)
+		if err != nil {
+			t.Fatalf("GetQuestion err = %v, want nil", err)
+		}
+		if stored.AfterQuestionID == nil || *stored.AfterQuestionID != first.ID {
+			t.Errorf("stored AfterQuestionID = %v, want %d", stored.AfterQuestionID, first.ID)
+		}
+	})
+
+	t.Run("later question is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+		first, second := qz.Questions[0], qz.Questions[1]
+
+		rr := save(t, env, qz, first, second.ID)
+		if got, want := rr.Code, http.StatusUnprocessableEntity; got != want {
+			t.Fatalf("got status code %v, want %v", got, want)
+		}
+		if !strings.Contains(rr.Body.String(), "Pick an earlier question from the same round") {
+			t.Error("re-rendered form is missing the follows field error")
+		}
+		stored, err := env.quizzes.GetQuestion(t.Context(), first.ID)
+		if err != nil {
+			t.Fatalf("GetQuestion err = %v, want nil", err)
+		}
+		if stored.AfterQuestionID != nil {
+			t.Errorf("stored AfterQuestionID = %d, want nil", *stored.AfterQuestionID)
+		}
+	})
+}
+
+// TestHandleQuestionSave_Explanation covers the explanation field: the
+// saved question stores it trimmed, and the edit form shows it again.
+func TestHandleQuestionSave_Explanation(t *testing.T) {
+	t.Parallel()
+
+	env := newAdminEnv(t)
+	qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+	question := qz.Questions[0]
+
+	form := url.Values{
+		"text":        {question.Text},
+		"explanation": {"  Paris has been the capital since 987.\n"},
+	}
+	form.Add("option[0].id", strconv.FormatInt(question.Options[0].ID, 10))
+	form.Add("option[0].text", question.Options[0].Text)
+	form.Add("option[0].correct", "on")
+	form.Add("option[1].id", strconv.FormatInt(question.Options[1].ID, 10))
+	form.Add("option[1].text", question.Options[1].Text)
+
+	req := httptest.NewRequestWithContext(
+		t.Context(), http.MethodPost,
+		fmt.Sprintf("/admin/quizzes/%d/questions/%d", qz.ID, question.ID),
+		strings.NewReader(form.Encode()),
+	)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
+	req.SetPathValue("questionID", strconv.FormatInt(question.ID, 10))
+	rr := httptest.NewRecorder()
+	HandleQuestionSave(slog.New(slog.DiscardHandler), nil, env.quizzes, env.media, env.audit).
+		ServeHTTP(rr, withTestAdmin(req))
+
+	if got, want := rr.Code, http.StatusSeeOther; got != want {
+		t.Fatalf("got status code %v, want %v", got, want)
+	}
+	stored, err := env.quizzes.GetQuestion(t.Context(), question.ID)
+	if err != nil {
+		t.Fatalf("GetQuestion err = %v, want nil", err)
+	}
+	if got, want := stored.Explanation, "Paris has been the capital since 987."; got != want {
+		t.Errorf("stored Explanation = %q, want %q", got, want)
+	}
+
+	edit := httptest.NewRequestWithContext(
+		t.Context(), http.MethodGet,
+		fmt.Sprintf("/admin/quizzes/%d/questions/%d/edit", qz.ID, question.ID), nil,
+	)
+	edit.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
+	edit.SetPathValue("questionID", strconv.FormatInt(question.ID, 10))
+	rr = httptest.NewRecorder()
+	HandleQuestionEdit(slog.New(slog.DiscardHandler), nil, env.quizzes, env.media).ServeHTTP(rr, withTestAdmin(edit))
+	if body := rr.Body.String(); !strings.Contains(body, ">"+stored.Explanation+"</textarea>") {
+		t.Error("edit form should pre-fill the explanation textarea")
+	}
+}
+
 // TestHandleQuestionEdit_Picker covers the image-picker rendering (#937): the
 // library thumbnails render when the quiz has images, the attached image is
 // pre-checked, and the empty-state hint shows when the quiz has none.
@@ -1907,6 +2180,12 @@ func TestHandleQuestionEdit_Picker(t *testing.T) {
 		if strings.Contains(body, "/thumb") {
 			t.Error("body should not render any thumbnails when the library is empty")
 		}
+		if want := `data-testid="question-image-upload"`; !strings.Contains(body, want) {
+			t.Errorf("body should offer the inline image upload %q", want)
+		}
+		if want := fmt.Sprintf("/admin/quizzes/%d/media", qz.ID); !strings.Contains(body, want) {
+			t.Errorf("inline upload should post to the quiz library %q", want)
+		}
 	})
 }
 
@@ -2035,7 +2314,7 @@ func TestHandleQuestionSave_Round(t *testing.T) {
 
 	postCreate := func(t *testing.T, env *adminEnv, quizID int64, form url.Values) *httptest.ResponseRecorder {
 		t.Helper()
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2152,7 +2431,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 
 		env := newAdminEnv(t)
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2182,7 +2461,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		env := newAdminEnv(t)
 		qz := env.seedQuiz(t, ownedQuiz("Quiz One", "quiz-one"))
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2213,7 +2492,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		env := newAdminEnv(t)
 		qz := env.seedQuiz(t, ownedQuiz("Quiz One", "quiz-one"))
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 		body := errReader{err: errors.New("simulated read error")}
 		req := httptest.NewRequestWithContext(
 			t.Context(),
@@ -2235,7 +2514,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		}
 	})
 
-	t.Run("parsing form optionID fails", func(t *testing.T) {
+	t.Run("an unreadable optionID re-renders the form with the input kept", func(t *testing.T) {
 		t.Parallel()
 
 		buf := bytes.Buffer{}
@@ -2245,7 +2524,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		qz := env.seedQuiz(t, ownedQuiz("Quiz One", "quiz-one"))
 		roundID := env.defaultRoundID(t, qz.ID)
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 
 		form := url.Values{
 			"text":           {""},
@@ -2267,11 +2546,13 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 
 		handler.ServeHTTP(rr, withTestAdmin(req))
 
-		if got, want := rr.Code, http.StatusBadRequest; got != want {
+		if got, want := rr.Code, http.StatusUnprocessableEntity; got != want {
 			t.Fatalf("got status code %v, want %v, log:\n%v", got, want, buf.String())
 		}
-		if got, want := rr.Body.String(), "error parsing optionID"; !strings.Contains(got, want) {
-			t.Fatalf("got: %v, should contain: %q", got, want)
+		for _, want := range []string{"The options could not be read", `value="Option 1"`} {
+			if got := rr.Body.String(); !strings.Contains(got, want) {
+				t.Errorf("body should contain %q", want)
+			}
 		}
 	})
 
@@ -2285,7 +2566,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		qz := env.seedQuiz(t, ownedQuiz("Quiz One", "quiz-one"))
 		roundID := env.defaultRoundID(t, qz.ID)
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 
 		form := url.Values{
 			"text":     {""},
@@ -2309,7 +2590,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		// 400 with the per-field error message inline. Asserting on
 		// the message + form field name pins both the FieldErrors map
 		// and the template wiring in one shot.
-		if got, want := rr.Code, http.StatusBadRequest; got != want {
+		if got, want := rr.Code, http.StatusUnprocessableEntity; got != want {
 			t.Fatalf("got status code %v, want %v, log:\n%v", got, want, buf.String())
 		}
 		body := rr.Body.String()
@@ -2344,7 +2625,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		form.Add("option[1].correct", "on")
 		form.Add("option[2].text", "Option 3")
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2382,7 +2663,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		form.Add("option[1].text", "Option 2")
 		form.Add("option[1].correct", "on")
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2409,7 +2690,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 
 		env := newAdminEnv(t)
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/quizzes/999/questions", nil)
 		req.SetPathValue("quizID", "999")
 		rr := httptest.NewRecorder()
@@ -2433,7 +2714,7 @@ func TestHandleQuestionSave_HandleError(t *testing.T) {
 		env := newAdminEnv(t)
 		qz := env.seedQuiz(t, ownedQuiz("Quiz One", "quiz-one"))
 
-		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media)
+		handler := HandleQuestionSave(logger, nil, env.quizzes, env.media, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2464,7 +2745,7 @@ func TestHandleQuizDelete(t *testing.T) {
 		env := newAdminEnv(t)
 		qz := env.seedQuiz(t, ownedQuiz("Q", "q"))
 
-		handler := HandleQuizDelete(logger, nil, env.quizzes, newMediaServiceOverTemp(t, env))
+		handler := HandleQuizDelete(logger, nil, env.quizzes, newMediaServiceOverTemp(t, env), env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(), http.MethodPost,
 			fmt.Sprintf("/admin/quizzes/%d/delete", qz.ID), nil,
@@ -2499,7 +2780,7 @@ func TestHandleQuizDelete_RemovesMediaFiles(t *testing.T) {
 	root := t.TempDir()
 	mediaSvc := media.NewService(
 		store.NewMediaStore(env.db, slog.New(slog.DiscardHandler)),
-		root, 10<<20, 20<<20, slog.New(slog.DiscardHandler),
+		media.NewLocalStorage(root), 10<<20, 20<<20, slog.New(slog.DiscardHandler),
 	)
 	if _, err := mediaSvc.StoreImage(
 		t.Context(), qz.ID, testAdminID, "pic.png", bytes.NewReader(tinyPNG(t)),
@@ -2512,7 +2793,7 @@ func TestHandleQuizDelete_RemovesMediaFiles(t *testing.T) {
 		t.Fatalf("quiz media dir not present before delete: %v", statErr)
 	}
 
-	handler := HandleQuizDelete(logger, nil, env.quizzes, mediaSvc)
+	handler := HandleQuizDelete(logger, nil, env.quizzes, mediaSvc, env.audit)
 	req := httptest.NewRequestWithContext(
 		t.Context(), http.MethodPost,
 		fmt.Sprintf("/admin/quizzes/%d/delete", qz.ID), nil,
@@ -2541,7 +2822,7 @@ func TestHandleQuizDelete_ErrorHandling(t *testing.T) {
 
 		env := newAdminEnv(t)
 
-		handler := HandleQuizDelete(logger, nil, env.quizzes, newMediaServiceOverTemp(t, env))
+		handler := HandleQuizDelete(logger, nil, env.quizzes, newMediaServiceOverTemp(t, env), env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(), http.MethodPost, "/admin/quizzes/not-an-int/delete", nil,
 		)
@@ -2565,7 +2846,7 @@ func TestHandleQuizDelete_ErrorHandling(t *testing.T) {
 
 		// requireQuizOwner runs first now (#281); a missing quiz
 		// surfaces from GetQuiz, not from the DeleteQuiz return path.
-		handler := HandleQuizDelete(logger, nil, env.quizzes, newMediaServiceOverTemp(t, env))
+		handler := HandleQuizDelete(logger, nil, env.quizzes, newMediaServiceOverTemp(t, env), env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(), http.MethodPost, "/admin/quizzes/999/delete", nil,
 		)
@@ -2589,7 +2870,7 @@ func TestHandleQuizDelete_ErrorHandling(t *testing.T) {
 		qz := env.seedQuiz(t, ownedQuiz("Q", "q"))
 		env.closeStore(t)
 
-		handler := HandleQuizDelete(logger, nil, env.quizzes, newMediaServiceOverTemp(t, env))
+		handler := HandleQuizDelete(logger, nil, env.quizzes, newMediaServiceOverTemp(t, env), env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(), http.MethodPost,
 			fmt.Sprintf("/admin/quizzes/%d/delete", qz.ID), nil,
@@ -2741,7 +3022,7 @@ func TestHandleQuestionDelete(t *testing.T) {
 		qz := env.seedQuiz(t, twoQuestionQuiz("Q", "q"))
 		question := qz.Questions[0]
 
-		handler := HandleQuestionDelete(logger, nil, env.quizzes)
+		handler := HandleQuestionDelete(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2777,7 +3058,7 @@ func TestHandleQuestionDelete_ErrorHandling(t *testing.T) {
 
 		env := newAdminEnv(t)
 
-		handler := HandleQuestionDelete(logger, nil, env.quizzes)
+		handler := HandleQuestionDelete(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2804,7 +3085,7 @@ func TestHandleQuestionDelete_ErrorHandling(t *testing.T) {
 		env := newAdminEnv(t)
 		qz := env.seedQuiz(t, ownedQuiz("Q", "q"))
 
-		handler := HandleQuestionDelete(logger, nil, env.quizzes)
+		handler := HandleQuestionDelete(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2834,7 +3115,7 @@ func TestHandleQuestionDelete_ErrorHandling(t *testing.T) {
 		// #339: HandleQuestionDelete loads the question first via
 		// questionByID to enforce the cross-quiz check, so a missing
 		// question surfaces from the load path rather than the delete path.
-		handler := HandleQuestionDelete(logger, nil, env.quizzes)
+		handler := HandleQuestionDelete(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -2863,7 +3144,7 @@ func TestHandleQuestionDelete_ErrorHandling(t *testing.T) {
 		question := qz.Questions[0]
 		env.closeStore(t)
 
-		handler := HandleQuestionDelete(logger, nil, env.quizzes)
+		handler := HandleQuestionDelete(logger, nil, env.quizzes, env.audit)
 		req := httptest.NewRequestWithContext(
 			t.Context(),
 			http.MethodPost,
@@ -3379,3 +3660,110 @@ func TestMediaCardDataDurationLabel(t *testing.T) {
 		})
 	}
 }
+
+// postStaleForm posts form to h as the test admin with the quizID (and, when
+// non-zero, questionID) path values set.
+func postStaleForm(
+	t *testing.T, h http.Handler, target string, quizID, questionID int64, form url.Values,
+) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("quizID", strconv.FormatInt(quizID, 10))
+	if questionID != 0 {
+		req.SetPathValue("questionID", strconv.FormatInt(questionID, 10))
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, withTestAdmin(req))
+
+	return rr
+}
+
+// TestHandleQuizSave_StaleVersion pins the optimistic-concurrency check on
+// the quiz form: the edit form carries the quiz's version, a save on the
+// current version lands, and a second save from a form opened on the same
+// (now old) version is refused with the 409 reload page and writes nothing.
+func TestHandleQuizSave_StaleVersion(t *testing.T) {
+	t.Parallel()
+
+	env := newAdminEnv(t)
+	qz := env.seedQuiz(t, ownedQuiz("Quiz One", "quiz-one"))
+	logger := slog.New(slog.DiscardHandler)
+
+	editReq := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/admin/quizzes/x/edit", nil)
+	editReq.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
+	edit := httptest.NewRecorder()
+	HandleQuizEdit(logger, nil, env.quizzes).ServeHTTP(edit, withTestAdmin(editReq))
+	opened := strconv.FormatInt(qz.Version, 10)
+	if want := `name="version" value="` + opened + `"`; !strings.Contains(edit.Body.String(), want) {
+		t.Fatalf("edit form has no %s field", want)
+	}
+
+	target := fmt.Sprintf("/admin/quizzes/%d", qz.ID)
+	save := HandleQuizSave(logger, nil, env.quizzes, env.audit)
+	form := func(title string) url.Values {
+		return url.Values{"title": {title}, "description": {"Updated"}, "version": {opened}}
+	}
+	first := postStaleForm(t, save, target, qz.ID, 0, form("First Save"))
+	if got, want := first.Code, http.StatusSeeOther; got != want {
+		t.Fatalf("first save status = %d, want %d", got, want)
+	}
+
+	second := postStaleForm(t, save, target, qz.ID, 0, form("Second Save"))
+	if got, want := second.Code, http.StatusConflict; got != want {
+		t.Fatalf("stale save status = %d, want %d", got, want)
+	}
+	if want := fmt.Sprintf(`href="/admin/quizzes/%d/edit"`, qz.ID); !strings.Contains(second.Body.String(), want) {
+		t.Errorf("stale save page has no reload link %s", want)
+	}
+	stored, err := env.quizzes.GetQuiz(t.Context(), qz.ID)
+	if err != nil {
+		t.Fatalf("GetQuiz err = %v, want nil", err)
+	}
+	if got, want := stored.Title, "First Save"; got != want {
+		t.Errorf("stored title = %q, want %q (the stale save must not land)", got, want)
+	}
+}
+
+// TestHandleQuestionSave_StaleVersion is the question-form twin of
+// TestHandleQuizSave_StaleVersion.
+func TestHandleQuestionSave_StaleVersion(t *testing.T) {
+	t.Parallel()
+
+	env := newAdminEnv(t)
+	qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+	qs := qz.Questions[0]
+	opened := strconv.FormatInt(qs.Version, 10)
+	form := func(text string) url.Values {
+		return url.Values{
+			"text":              {text},
+			"version":           {opened},
+			"option[0].text":    {"Yes"},
+			"option[0].correct": {"on"},
+			"option[1].text":    {"No"},
+		}
+	}
+
+	target := fmt.Sprintf("/admin/quizzes/%d/questions/%d", qz.ID, qs.ID)
+	save := HandleQuestionSave(slog.New(slog.DiscardHandler), nil, env.quizzes, env.media, env.audit)
+	first := postStaleForm(t, save, target, qz.ID, qs.ID, form("First save?"))
+	if got, want := first.Code, http.StatusSeeOther; got != want {
+		t.Fatalf("first save status = %d, want %d", got, want)
+	}
+
+	second := postStaleForm(t, save, target, qz.ID, qs.ID, form("Second save?"))
+	if got, want := second.Code, http.StatusConflict; got != want {
+		t.Fatalf("stale save status = %d, want %d", got, want)
+	}
+	if want := fmt.Sprintf(`href="%s/edit"`, target); !strings.Contains(second.Body.String(), want) {
+		t.Errorf("stale save page has no reload link %s", want)
+	}
+	stored, err := env.quizzes.GetQuestion(t.Context(), qs.ID)
+	if err != nil {
+		t.Fatalf("GetQuestion err = %v, want nil", err)
+	}
+	if got, want := stored.Text, "First save?"; got != want {
+		t.Errorf("stored text = %q, want %q (the stale save must not land)", got, want)
+	}
+}
@@ -0,0 +1,169 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/game"
+)
+
+// analyticsDefaultDays is how many days, up to and including today, the
+// analytics page covers when no range is given.
+const analyticsDefaultDays = 30
+
+// analyticsHardestLimit is how many of the hardest questions the analytics
+// page lists.
+const analyticsHardestLimit = 10
+
+// analyticsTimePrecision is what the analytics page rounds answer times to.
+const analyticsTimePrecision = 100 * time.Millisecond
+
+// errAnalyticsRange is the message for a date range the page cannot use.
+var errAnalyticsRange = errors.New("dates must look like 2026-01-31, with the start on or before the end")
+
+// PlayReporter builds the play report the analytics page renders.
+// *game.Service satisfies it.
+type PlayReporter interface {
+	GetPlayReport(ctx context.Context, from, to time.Time, hardest int) (*game.PlayReport, error)
+}
+
+// analyticsQuizRow is one quiz's row on the analytics page. AverageScore is
+// rounded to whole points and MedianAnswerTime to analyticsTimePrecision.
+type analyticsQuizRow struct {
+	QuizID           int64
+	Title            string
+	Plays            int
+	Finished         int
+	Answers          int
+	AverageScore     int
+	MedianAnswerTime time.Duration
+}
+
+// analyticsQuestionRow is one of the hardest questions on the analytics page.
+type analyticsQuestionRow struct {
+	QuizID         int64
+	QuizTitle      string
+	QuestionID     int64
+	Text           string
+	Answers        int
+	Correct        int
+	CorrectPercent int
+}
+
+// analyticsPageData backs analytics.gohtml. From and To fill the range form;
+// To is inclusive.
+type analyticsPageData struct {
+	Title   string
+	From    string
+	To      string
+	Quizzes []analyticsQuizRow
+	Hardest []analyticsQuestionRow
+	Error   string
+}
+
+// HandleAnalytics renders GET /admin/analytics: per-quiz play counts,
+// average scores and median answer times, and the questions with the lowest
+// correct rate, over the solo games started between ?from= and ?to= (both
+// YYYY-MM-DD in UTC, to inclusive; the last 30 days by default). Owner
+// previews are left out. Read-only and Admin-only; a range it cannot read
+// re-renders the form with an error and a 400.
+func HandleAnalytics(logger *slog.Logger, csrfMgr *csrf.Manager, reporter PlayReporter) http.Handler {
+	render := NewTemplateRenderer(logger, csrfMgr, "admin/pages/analytics.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := analyticsPageData{Title: "Admin Dashboard - Analytics"}
+		from, to, err := parseAnalyticsRange(r, time.Now().UTC())
+		if err != nil {
+			data.From = r.URL.Query().Get("from")
+			data.To = r.URL.Query().Get("to")
+			data.Error = err.Error()
+			render.Render(w, r, http.StatusBadRequest, data)
+
+			return
+		}
+		data.From = from.Format(time.DateOnly)
+		data.To = to.AddDate(0, 0, -1).Format(time.DateOnly)
+
+		report, err := reporter.GetPlayReport(r.Context(), from, to, analyticsHardestLimit)
+		if err != nil {
+			logger.ErrorContext(r.Context(), "error building play report", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+		data.Quizzes = analyticsQuizRows(report.Quizzes)
+		data.Hardest = analyticsQuestionRows(report.Hardest)
+		render.Render(w, r, http.StatusOK, data)
+	})
+}
+
+// parseAnalyticsRange reads ?from= and ?to= as UTC dates and returns the
+// half-open range [from, day after to). A missing from is analyticsDefaultDays
+// before to; a missing to is today.
+func parseAnalyticsRange(r *http.Request, now time.Time) (time.Time, time.Time, error) {
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if s := strings.TrimSpace(r.URL.Query().Get("to")); s != "" {
+		t, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: to %q", errAnalyticsRange, s)
+		}
+		to = t
+	}
+	from := to.AddDate(0, 0, 1-analyticsDefaultDays)
+	if s := strings.TrimSpace(r.URL.Query().Get("from")); s != "" {
+		t, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: from %q", errAnalyticsRange, s)
+		}
+		from = t
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errAnalyticsRange
+	}
+
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+// analyticsQuizRows flattens the report's quiz lines for the template.
+func analyticsQuizRows(quizzes []*game.QuizReport) []analyticsQuizRow {
+	rows := make([]analyticsQuizRow, 0, len(quizzes))
+	for _, q := range quizzes {
+		rows = append(rows, analyticsQuizRow{
+			QuizID:           q.QuizID,
+			Title:            q.QuizTitle,
+			Plays:            q.Plays,
+			Finished:         q.Finished,
+			Answers:          q.Answers,
+			AverageScore:     int(math.Round(q.AverageScore)),
+			MedianAnswerTime: q.MedianAnswerTime.Round(analyticsTimePrecision),
+		})
+	}
+
+	return rows
+}
+
+// analyticsQuestionRows flattens the report's hardest questions for the
+// template.
+func analyticsQuestionRows(questions []*game.QuestionReport) []analyticsQuestionRow {
+	rows := make([]analyticsQuestionRow, 0, len(questions))
+	for _, q := range questions {
+		rows = append(rows, analyticsQuestionRow{
+			QuizID:         q.QuizID,
+			QuizTitle:      q.QuizTitle,
+			QuestionID:     q.QuestionID,
+			Text:           q.Text,
+			Answers:        q.Answers,
+			Correct:        q.Correct,
+			CorrectPercent: q.CorrectPercent(),
+		})
+	}
+
+	return rows
+}
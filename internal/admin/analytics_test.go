@@ -0,0 +1,139 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// errPlayReport is the failure fakePlayReporter returns when told to.
+var errPlayReport = errors.New("report unavailable")
+
+// fakePlayReporter serves a fixed report and records the range it was asked
+// for.
+type fakePlayReporter struct {
+	report   *game.PlayReport
+	err      error
+	called   bool
+	from, to time.Time
+}
+
+func (f *fakePlayReporter) GetPlayReport(_ context.Context, from, to time.Time, _ int) (*game.PlayReport, error) {
+	f.called, f.from, f.to = true, from, to
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return f.report, nil
+}
+
+// TestHandleAnalytics pins the analytics page: the chosen days are asked
+// for as a half-open UTC range, the quiz and hardest-question rows render,
+// and a range it cannot read is a 400 that never reaches the report.
+func TestHandleAnalytics(t *testing.T) {
+	t.Parallel()
+
+	report := &game.PlayReport{
+		Quizzes: []*game.QuizReport{{
+			QuizPlayCount: game.QuizPlayCount{QuizID: 4, QuizTitle: "Capitals", Plays: 3, Finished: 2},
+			Answers:       9, AverageScore: 1234.4, MedianAnswerTime: 2340 * time.Millisecond,
+		}},
+		Hardest: []*game.QuestionReport{{
+			QuestionStats: quiz.QuestionStats{Answers: 3, Correct: 1},
+			QuizID:        4, QuizTitle: "Capitals", QuestionID: 12, Text: "Capital of Australia?",
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		reporter   *fakePlayReporter
+		wantStatus int
+		wantFrom   string
+		wantTo     string
+		wantBody   []string
+	}{
+		{
+			name: "renders the chosen days", query: "?from=2026-03-01&to=2026-03-31",
+			reporter: &fakePlayReporter{report: report}, wantStatus: http.StatusOK,
+			wantFrom: "2026-03-01", wantTo: "2026-04-01",
+			wantBody: []string{"Capitals", "1,234", "2.3s", "Capital of Australia?", "33%", `value="2026-03-31"`},
+		},
+		{
+			name: "a single day", query: "?from=2026-03-05&to=2026-03-05",
+			reporter: &fakePlayReporter{report: &game.PlayReport{}}, wantStatus: http.StatusOK,
+			wantFrom: "2026-03-05", wantTo: "2026-03-06", wantBody: []string{"No games were started"},
+		},
+		{
+			name: "an unreadable date", query: "?from=yesterday",
+			reporter: &fakePlayReporter{report: report}, wantStatus: http.StatusBadRequest,
+			wantBody: []string{"dates must look like", `value="yesterday"`},
+		},
+		{
+			name: "from after to", query: "?from=2026-03-02&to=2026-03-01",
+			reporter: &fakePlayReporter{report: report}, wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "report failure", query: "?from=2026-03-01&to=2026-03-31",
+			reporter: &fakePlayReporter{err: errPlayReport}, wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/admin/analytics"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			HandleAnalytics(slog.New(slog.DiscardHandler), nil, tt.reporter).ServeHTTP(rr, withTestAdmin(req))
+
+			if got := rr.Code; got != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", got, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusBadRequest && tt.reporter.called {
+				t.Error("report built for a range the page refused")
+			}
+			if tt.wantFrom != "" {
+				if got := tt.reporter.from.Format(time.DateOnly); got != tt.wantFrom {
+					t.Errorf("from = %s, want %s", got, tt.wantFrom)
+				}
+				if got := tt.reporter.to.Format(time.DateOnly); got != tt.wantTo {
+					t.Errorf("to = %s, want %s (the day after the last one shown)", got, tt.wantTo)
+				}
+			}
+			body := rr.Body.String()
+			for _, want := range tt.wantBody {
+				if !strings.Contains(body, want) {
+					t.Errorf("body missing %q", want)
+				}
+			}
+		})
+	}
+
+	t.Run("defaults to the last 30 days", func(t *testing.T) {
+		t.Parallel()
+
+		reporter := &fakePlayReporter{report: &game.PlayReport{}}
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/admin/analytics", nil)
+		rr := httptest.NewRecorder()
+		HandleAnalytics(slog.New(slog.DiscardHandler), nil, reporter).ServeHTTP(rr, withTestAdmin(req))
+
+		if got, want := rr.Code, http.StatusOK; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		if got, want := reporter.to.Sub(reporter.from), 30*24*time.Hour; got != want {
+			t.Errorf("range = %v, want %v", got, want)
+		}
+		if now := time.Now(); !reporter.from.Before(now) || !reporter.to.After(now) {
+			t.Errorf("range %v..%v does not cover now", reporter.from, reporter.to)
+		}
+	})
+}
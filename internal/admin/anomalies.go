@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/game"
+)
+
+// AnomalySource is the read side of game.AnomalyMonitor the anomalies page
+// renders.
+type AnomalySource interface {
+	Counts() map[game.AnomalyKind]int
+	Recent() []game.Anomaly
+}
+
+// anomalyCountRow is one row of the per-kind totals table.
+type anomalyCountRow struct {
+	Kind  game.AnomalyKind
+	Count int
+}
+
+// anomalyRow is one row of the recent-anomalies table. Gap is pre-rounded so
+// the template prints "-1.2s" rather than nanosecond noise.
+type anomalyRow struct {
+	Kind       game.AnomalyKind
+	GameID     string
+	PlayerID   int64
+	QuestionID int64
+	Gap        time.Duration
+	HasGap     bool
+	DetectedAt time.Time
+}
+
+// anomaliesPageData backs anomalies.gohtml.
+type anomaliesPageData struct {
+	Title    string
+	Counts   []anomalyCountRow
+	Recent   []anomalyRow
+	Capacity int
+}
+
+// HandleAnomalies renders GET /admin/anomalies: per-kind totals since the
+// process started and the most recent suspicious answers (early, humanly
+// impossible fast, repeated maximum scores). Read-only and Admin-only; the
+// counters are in memory, so a restart clears them.
+func HandleAnomalies(logger *slog.Logger, csrfMgr *csrf.Manager, source AnomalySource) http.Handler {
+	render := NewTemplateRenderer(logger, csrfMgr, "admin/pages/anomalies.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		counts := source.Counts()
+		countRows := make([]anomalyCountRow, 0, len(game.AnomalyKinds()))
+		for _, kind := range game.AnomalyKinds() {
+			countRows = append(countRows, anomalyCountRow{Kind: kind, Count: counts[kind]})
+		}
+
+		recent := source.Recent()
+		rows := make([]anomalyRow, 0, len(recent))
+		for _, a := range recent {
+			rows = append(rows, anomalyRow{
+				Kind:       a.Kind,
+				GameID:     a.GameID,
+				PlayerID:   a.PlayerID,
+				QuestionID: a.QuestionID,
+				Gap:        a.Gap.Round(time.Millisecond),
+				HasGap:     a.Kind != game.AnomalyRepeatedMaxScore,
+				DetectedAt: a.DetectedAt,
+			})
+		}
+
+		render.Render(w, r, http.StatusOK, anomaliesPageData{
+			Title:    "Admin Dashboard - Scoring anomalies",
+			Counts:   countRows,
+			Recent:   rows,
+			Capacity: game.AnomalyLogCapacity,
+		})
+	})
+}
@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/answerexport"
+)
+
+// HandleAnswerExport serves GET /admin/export/answers, the Admin-only
+// analytics feed: every recorded answer after ?since= as newline-delimited
+// JSON, joined with its quiz, question, option and player. A job syncs
+// incrementally by storing the cursor of the last line it processed and
+// passing it back as ?since=; without it the whole history streams. The
+// response is flushed batch by batch, so a large export neither buffers in
+// memory nor leaves the client waiting for the first byte.
+func HandleAnswerExport(logger *slog.Logger, exports answerexport.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		since, err := answerexport.ParseCursor(r.URL.Query().Get("since"))
+		if err != nil {
+			http.Error(w, "since must be a cursor from a previous export line", http.StatusBadRequest)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Cache-Control", "no-store")
+		rc := http.NewResponseController(w)
+		flush := func() {
+			if ferr := rc.Flush(); ferr != nil {
+				logger.DebugContext(r.Context(), "answer export flush failed", slog.Any("err", ferr))
+			}
+		}
+
+		written, err := answerexport.Write(r.Context(), w, flush, exports, since)
+		if err != nil {
+			logger.ErrorContext(
+				r.Context(), "error streaming answer export",
+				slog.Any("err", err), slog.Int("written", written),
+			)
+			if written == 0 {
+				// Nothing is on the wire yet, so the status can still say so.
+				w.Header().Del("Content-Type")
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+			}
+		}
+	})
+}
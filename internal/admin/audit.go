@@ -0,0 +1,202 @@
+package admin
+
+import (
+	"encoding/json"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/starquake/topbanana/internal/audit"
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// auditPageSize is how many of the most recent events the audit page lists.
+const auditPageSize = 100
+
+// auditEventRow is one row of the audit page.
+type auditEventRow struct {
+	ActorPlayerID    int64
+	ActorDisplayName string
+	EntityType       string
+	EntityID         int64
+	Action           string
+	Changes          []auditChangeRow
+	CreatedAt        time.Time
+}
+
+// auditChangeRow is one changed field of an audit event, its values as the
+// JSON the diff stored them in.
+type auditChangeRow struct {
+	Field string
+	From  string
+	To    string
+}
+
+// auditPageData backs audit.gohtml.
+type auditPageData struct {
+	Title    string
+	Events   []auditEventRow
+	PageSize int
+}
+
+// HandleAuditLog renders GET /admin/audit: the most recent quiz, question and
+// round mutations, newest first, each with who made it and the fields it
+// changed. Read-only and Admin-only.
+func HandleAuditLog(logger *slog.Logger, csrfMgr *csrf.Manager, events audit.Store) http.Handler {
+	render := NewTemplateRenderer(logger, csrfMgr, "admin/pages/audit.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recent, err := events.ListRecentEvents(r.Context(), auditPageSize)
+		if err != nil {
+			logger.ErrorContext(r.Context(), "error listing audit events", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		rows := make([]auditEventRow, 0, len(recent))
+		for _, e := range recent {
+			rows = append(rows, auditEventRow{
+				ActorPlayerID:    e.ActorPlayerID,
+				ActorDisplayName: e.ActorDisplayName,
+				EntityType:       e.EntityType,
+				EntityID:         e.EntityID,
+				Action:           e.Action,
+				Changes:          auditChangeRows(e.Diff),
+				CreatedAt:        e.CreatedAt,
+			})
+		}
+
+		render.Render(w, r, http.StatusOK, auditPageData{
+			Title:    "Admin Dashboard - Audit log",
+			Events:   rows,
+			PageSize: auditPageSize,
+		})
+	})
+}
+
+// auditChangeRows decodes a stored diff into rows sorted by field name. A
+// diff that does not decode shows no changes rather than failing the page.
+func auditChangeRows(diff string) []auditChangeRow {
+	var changes map[string]struct {
+		From json.RawMessage `json:"from"`
+		To   json.RawMessage `json:"to"`
+	}
+	if err := json.Unmarshal([]byte(diff), &changes); err != nil {
+		return nil
+	}
+
+	rows := make([]auditChangeRow, 0, len(changes))
+	for _, field := range slices.Sorted(maps.Keys(changes)) {
+		c := changes[field]
+		rows = append(rows, auditChangeRow{Field: field, From: string(c.From), To: string(c.To)})
+	}
+
+	return rows
+}
+
+// recordAudit stamps e with the signed-in actor and the diff of before and
+// after, and appends it to the audit log. Like writeAudit it only logs a
+// failure: the mutation it describes has already landed. An update that
+// changed no audited field is not recorded.
+func recordAudit(
+	r *http.Request, logger *slog.Logger, events audit.Store, e *audit.Event, before, after audit.Fields,
+) {
+	ctx := r.Context()
+	diff, err := audit.Diff(before, after)
+	if err != nil {
+		logger.ErrorContext(ctx, "error encoding audit diff",
+			slog.String("entity", e.EntityType), slog.Any("err", err))
+
+		return
+	}
+	if e.Action == audit.ActionUpdate && diff == "{}" {
+		return
+	}
+	e.Diff = diff
+	if p, ok := auth.PlayerFromContext(ctx); ok {
+		e.ActorPlayerID = p.ID
+	}
+	if err = events.RecordEvent(ctx, e); err != nil {
+		logger.ErrorContext(ctx, "error writing audit event",
+			slog.String("entity", e.EntityType),
+			slog.Int64("entity_id", e.EntityID),
+			slog.String("action", e.Action),
+			slog.Any("err", err))
+	}
+}
+
+// quizAuditFields is the quiz's audited snapshot: the settings the quiz form
+// edits.
+func quizAuditFields(qz *quiz.Quiz) audit.Fields {
+	return audit.Fields{
+		"title":               qz.Title,
+		"slug":                qz.Slug,
+		"description":         qz.Description,
+		"timeLimitSeconds":    qz.TimeLimitSeconds,
+		"visibility":          qz.Visibility,
+		"mode":                string(qz.Mode),
+		"language":            qz.Language,
+		"shuffleQuestions":    qz.ShuffleQuestions,
+		"keepOptionOrder":     qz.KeepOptionOrder,
+		"lateJoin":            qz.LateJoin,
+		"joinDeadlineSeconds": qz.JoinDeadlineSeconds,
+		"maxPlayers":          qz.MaxPlayers,
+		"confidenceWager":     qz.ConfidenceWager,
+		"hostPaced":           qz.HostPaced,
+		"scoring":             qz.Scoring,
+		"wrongAnswerPenalty":  qz.WrongAnswerPenalty,
+		"scoreFloor":          qz.ScoreFloor,
+	}
+}
+
+// questionAuditFields is the question's audited snapshot: what the question
+// form edits, with the options as their texts plus the correct ones, or a
+// numeric question's answer key as the print sheet words it.
+func questionAuditFields(qs *quiz.Question) audit.Fields {
+	fields := audit.Fields{
+		"text":             qs.Text,
+		"kind":             qs.Kind,
+		"roundId":          qs.RoundID,
+		"imageMediaId":     qs.ImageMediaID,
+		"audioMediaId":     qs.AudioMediaID,
+		"audioRepeat":      qs.AudioRepeat,
+		"afterQuestionId":  qs.AfterQuestionID,
+		"explanation":      qs.Explanation,
+		"hint":             qs.Hint,
+		"timeLimitSeconds": qs.TimeLimitSeconds,
+	}
+	if qs.Kind == quiz.KindNumeric {
+		if len(qs.Options) > 0 {
+			fields["answer"] = numericAnswerKey(qs.Options[0])
+		}
+
+		return fields
+	}
+	options := make([]string, 0, len(qs.Options))
+	correct := make([]string, 0, 1)
+	for _, o := range qs.Options {
+		options = append(options, o.Text)
+		if o.Correct {
+			correct = append(correct, o.Text)
+		}
+	}
+	fields["options"] = options
+	fields["correct"] = correct
+
+	return fields
+}
+
+// roundAuditFields is the round's audited snapshot.
+func roundAuditFields(rnd *quiz.Round) audit.Fields {
+	return audit.Fields{
+		"title":                   rnd.Title,
+		"summary":                 rnd.Summary,
+		"position":                rnd.Position,
+		"boundaryDurationSeconds": rnd.BoundaryDurationSeconds,
+	}
+}
@@ -0,0 +1,85 @@
+package admin_test
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/audit"
+)
+
+// TestHandleQuizSave_RecordsAudit pins the audit hooks on the quiz form and
+// the question delete: an edit records an update carrying only the changed
+// fields, a save that changes nothing records nothing, and a delete records
+// the removed question's last state. The audit page then lists them newest
+// first with the actor.
+func TestHandleQuizSave_RecordsAudit(t *testing.T) {
+	t.Parallel()
+
+	env := newAdminEnv(t)
+	qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+	logger := slog.New(slog.DiscardHandler)
+
+	save := HandleQuizSave(logger, nil, env.quizzes, env.audit)
+	target := fmt.Sprintf("/admin/quizzes/%d", qz.ID)
+	form := url.Values{
+		"title": {"Renamed"}, "description": {qz.Description},
+		"shuffle_options": {"on"}, "version": {"1"},
+	}
+	if rr := postStaleForm(t, save, target, qz.ID, 0, form); rr.Code != http.StatusSeeOther {
+		t.Fatalf("save status = %d, want %d", rr.Code, http.StatusSeeOther)
+	}
+	form.Set("version", "2")
+	if rr := postStaleForm(t, save, target, qz.ID, 0, form); rr.Code != http.StatusSeeOther {
+		t.Fatalf("unchanged save status = %d, want %d", rr.Code, http.StatusSeeOther)
+	}
+
+	qs := qz.Questions[0]
+	del := HandleQuestionDelete(logger, nil, env.quizzes, env.audit)
+	delTarget := fmt.Sprintf("/admin/quizzes/%d/questions/%d/delete", qz.ID, qs.ID)
+	if rr := postStaleForm(t, del, delTarget, qz.ID, qs.ID, url.Values{}); rr.Code != http.StatusSeeOther {
+		t.Fatalf("delete status = %d, want %d", rr.Code, http.StatusSeeOther)
+	}
+
+	events, err := env.audit.ListRecentEvents(t.Context(), 10)
+	if err != nil {
+		t.Fatalf("ListRecentEvents err = %v, want nil", err)
+	}
+	if got, want := len(events), 2; got != want {
+		t.Fatalf("audit events = %d, want %d (the unchanged save records nothing)", got, want)
+	}
+	deleted, updated := events[0], events[1]
+	if deleted.EntityType != audit.EntityQuestion || deleted.EntityID != qs.ID || deleted.Action != audit.ActionDelete {
+		t.Errorf("events[0] = %+v, want the question delete", deleted)
+	}
+	if !strings.Contains(deleted.Diff, `"text":{"from":`+strconv.Quote(qs.Text)+`}`) {
+		t.Errorf("delete diff = %s, want the question text as from only", deleted.Diff)
+	}
+	if updated.EntityType != audit.EntityQuiz || updated.Action != audit.ActionUpdate ||
+		updated.ActorPlayerID != testAdminID {
+		t.Errorf("events[1] = %+v, want the admin's quiz update", updated)
+	}
+	if got, want := updated.Diff,
+		`{"slug":{"from":"quiz-one","to":"renamed"},"title":{"from":"Quiz One","to":"Renamed"}}`; got != want {
+		t.Errorf("update diff = %s, want %s", got, want)
+	}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/admin/audit", nil)
+	rr := httptest.NewRecorder()
+	HandleAuditLog(logger, nil, env.audit).ServeHTTP(rr, withTestAdmin(req))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("audit page status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	body := rr.Body.String()
+	for _, want := range []string{fmt.Sprintf("quiz #%d", qz.ID), "&#34;Renamed&#34;", "delete"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("audit page missing %q", want)
+		}
+	}
+}
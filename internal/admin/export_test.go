@@ -50,14 +50,14 @@ const PlayersPerPage = playersPerPage
 // #36 can be tested without exporting the quizForm struct itself.
 // The form rules move with the form code; the rest of the codebase
 // has no business constructing a quizForm.
-func ValidateQuizForm(ctx context.Context, q *quiz.Quiz) map[string]string {
+func ValidateQuizForm(ctx context.Context, q *quiz.Quiz) ValidationErrors {
 	return (&quizForm{quiz: q}).Valid(ctx)
 }
 
 // ValidateRoundForm exposes the unexported roundForm.Valid behaviour so
 // the external admin_test package can pin the round-form validation
 // rules without exporting the roundForm struct (#444).
-func ValidateRoundForm(ctx context.Context, r *quiz.Round) map[string]string {
+func ValidateRoundForm(ctx context.Context, r *quiz.Round) ValidationErrors {
 	return (&roundForm{round: r}).Valid(ctx)
 }
 
@@ -133,10 +133,16 @@ var StripCodeFences = stripCodeFences
 // ValidateQuestionForm exposes the unexported questionForm.Valid
 // behaviour so the option-count and at-least-one-correct rules can be
 // tested directly without constructing a full quiz.
-func ValidateQuestionForm(ctx context.Context, q *quiz.Question) map[string]string {
+func ValidateQuestionForm(ctx context.Context, q *quiz.Question) ValidationErrors {
 	return (&questionForm{question: q}).Valid(ctx)
 }
 
+// ValidateLiveQuestionForm is [ValidateQuestionForm] for a question in a
+// live quiz.
+func ValidateLiveQuestionForm(ctx context.Context, q *quiz.Question) ValidationErrors {
+	return (&questionForm{question: q, live: true}).Valid(ctx)
+}
+
 // MaxOptions exposes the per-question option cap so tests can build a
 // payload one over the limit without hard-coding the value.
 const MaxOptions = maxOptions
@@ -185,3 +191,9 @@ const ArchiveFormatVersion = archiveFormatVersion
 // ArchiveExtForMedia exposes the unexported MIME-to-archive-extension mapper so
 // the export test can pin every branch without driving the full exporter.
 var ArchiveExtForMedia = archiveExtForMedia
+
+// ValidQuestion runs the unexported question-form rules against q, so the
+// schema test can check the published limits against the ones enforced.
+func ValidQuestion(q *quiz.Question) ValidationErrors {
+	return (&questionForm{question: q}).Valid(context.Background())
+}
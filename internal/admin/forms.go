@@ -3,30 +3,36 @@ package admin
 import (
 	"context"
 	"fmt"
+	"slices"
+	"unicode"
 
 	"github.com/starquake/topbanana/internal/quiz"
 )
 
+// maxExternalRefLength caps an author-supplied question reference. CMS ids,
+// slugs and UUIDs all fit comfortably.
+const maxExternalRefLength = 200
+
 // quizForm wraps a parsed [quiz.Quiz] for admin-form validation.
-// Problem-map keys match the lowercase form-field names the templates
+// Problem fields match the lowercase form-field names the templates
 // bind to so the handlers do not need a translation step.
 type quizForm struct {
 	quiz *quiz.Quiz
 }
 
 // Valid checks every form-level rule on the wrapped quiz, its
-// questions, and its options. An empty map means the form is valid.
-func (f *quizForm) Valid(ctx context.Context) map[string]string {
-	problems := make(map[string]string)
+// questions, and its options. An empty result means the form is valid.
+func (f *quizForm) Valid(ctx context.Context) ValidationErrors {
+	var problems ValidationErrors
 	q := f.quiz
 	if q.Title == "" {
-		problems["title"] = "Title is required"
+		problems.add("title", CodeRequired, "Title is required")
 	}
 	if q.Slug == "" {
-		problems["slug"] = "Slug is required"
+		problems.add("slug", CodeRequired, "Slug is required")
 	}
 	if q.Description == "" {
-		problems["description"] = "Description is required"
+		problems.add("description", CodeRequired, "Description is required")
 	}
 	// Only flag the time-limit range when the caller actually set a
 	// value; a zero TimeLimitSeconds means "unset" (the store layer
@@ -35,7 +41,7 @@ func (f *quizForm) Valid(ctx context.Context) map[string]string {
 	// JSON-import path both rely on.
 	if q.TimeLimitSeconds != 0 &&
 		(q.TimeLimitSeconds < quiz.MinTimeLimitSeconds || q.TimeLimitSeconds > quiz.MaxTimeLimitSeconds) {
-		problems["timelimitseconds"] = fmt.Sprintf(
+		problems.addf("timelimitseconds", CodeOutOfRange,
 			"Time limit must be between %d and %d seconds",
 			quiz.MinTimeLimitSeconds, quiz.MaxTimeLimitSeconds,
 		)
@@ -44,85 +50,144 @@ func (f *quizForm) Valid(ctx context.Context) map[string]string {
 	// flag genuinely unrecognised values so the admin form's selector
 	// can surface them inline.
 	if q.Visibility != "" && !quiz.IsValidVisibility(q.Visibility) {
-		problems["visibility"] = "Visibility must be one of: public, unlisted, private"
+		problems.add("visibility", CodeInvalidChoice, "Visibility must be one of: public, unlisted, private")
 	}
 	// An empty mode is treated as "solo" by the store; only flag
 	// genuinely unrecognised values so the admin form's selector can
 	// surface them inline (MP-0 / #677).
-	if q.Mode != "" && !quiz.IsValidMode(q.Mode) {
-		problems["mode"] = "Mode must be one of: solo, live"
+	switch {
+	case q.Mode != "" && !quiz.IsValidMode(string(q.Mode)):
+		problems.add("mode", CodeInvalidChoice, "Mode must be one of: solo, live")
+	case q.Mode == quiz.ModeLive && slices.ContainsFunc(q.Questions, notLivePlayable):
+		problems.add("mode", CodeNotLivePlayable, "A live quiz cannot have numeric or select-all questions")
+	default:
+		// Solo, or a live quiz of option picks only.
 	}
 	// Empty is treated as "en" by the store; only flag unrecognised values (#1115).
 	if q.Language != "" && !quiz.IsValidLanguage(q.Language) {
-		problems["language"] = "Language must be one of: en, nl"
+		problems.add("language", CodeInvalidChoice, "Language must be one of: en, nl")
+	}
+	// Empty is treated as "skip" by the store; only flag unrecognised values.
+	if q.LateJoin != "" && !quiz.IsValidLateJoin(q.LateJoin) {
+		problems.add("latejoin", CodeInvalidChoice, "Late joiners must be one of: skip, zero, closed")
+	}
+	// Empty is treated as "time" by the store; only flag unrecognised values.
+	if q.Scoring != "" && !quiz.IsValidScoring(q.Scoring) {
+		problems.add("scoring", CodeInvalidChoice, "Scoring must be one of: time, flat, streak")
+	}
+	if q.WrongAnswerPenalty < 0 || q.WrongAnswerPenalty > quiz.MaxWrongAnswerPenalty {
+		problems.addf("wronganswerpenalty", CodeOutOfRange,
+			"Wrong-answer penalty must be between 0 and %d", quiz.MaxWrongAnswerPenalty,
+		)
+	}
+	if q.ScoreFloor < quiz.MinScoreFloor || q.ScoreFloor > 0 {
+		problems.addf("scorefloor", CodeOutOfRange, "Lowest total must be between %d and 0", quiz.MinScoreFloor)
+	}
+	if q.JoinDeadlineSeconds < 0 || q.JoinDeadlineSeconds > quiz.MaxJoinDeadlineSeconds {
+		problems.addf("joindeadlineseconds", CodeOutOfRange,
+			"Join deadline must be between 0 and %d seconds", quiz.MaxJoinDeadlineSeconds,
+		)
 	}
-	addQuestionProblems(ctx, problems, q.Questions)
-	addRoundProblems(ctx, problems, q.Rounds)
+	if q.MaxPlayers < 0 || q.MaxPlayers > quiz.MaxPlayersLimit {
+		problems.addf("maxplayers", CodeOutOfRange, "Max players must be between 0 and %d", quiz.MaxPlayersLimit)
+	}
+	if q.ExternalRef != "" && !validExternalRef(q.ExternalRef) {
+		problems.addf("externalref", CodeInvalid,
+			"External reference must be at most %d characters, without spaces", maxExternalRefLength)
+	}
+	addQuestionProblems(ctx, &problems, q.Questions, q.Mode == quiz.ModeLive)
+	addRoundProblems(ctx, &problems, q.Rounds)
 
 	return problems
 }
 
+// validExternalRef reports whether ref is a usable quiz or question reference:
+// non-empty, at most maxExternalRefLength bytes, and free of whitespace and
+// control characters so it reads back unambiguously in a URL path.
+func validExternalRef(ref string) bool {
+	if ref == "" || len(ref) > maxExternalRefLength {
+		return false
+	}
+	for _, c := range ref {
+		if unicode.IsSpace(c) || !unicode.IsPrint(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // addQuestionProblems folds each question's (and its options')
-// field-level problems into problems under the question-indexed keys the
-// admin template binds to.
-func addQuestionProblems(ctx context.Context, problems map[string]string, questions []*quiz.Question) {
+// field-level problems into problems under question-indexed paths
+// ("questions[0].text", "questions[0].options[1].text"). live is whether
+// the quiz is hosted live.
+func addQuestionProblems(ctx context.Context, problems *ValidationErrors, questions []*quiz.Question, live bool) {
+	refs := make(map[string]bool, len(questions))
 	for qsIndex, question := range questions {
-		qf := &questionForm{question: question}
-		for k, v := range qf.Valid(ctx) {
-			problems[fmt.Sprintf("questions[%d][%s]", qsIndex, k)] = v
+		prefix := fmt.Sprintf("questions[%d]", qsIndex)
+		problems.nest(prefix, (&questionForm{question: question, live: live}).Valid(ctx))
+		if ref := question.ExternalRef; ref != "" {
+			if refs[ref] {
+				problems.add(prefix+".externalref", CodeTaken, "Another question already has this external reference")
+			}
+			refs[ref] = true
 		}
 		for oIndex, option := range question.Options {
-			of := &optionForm{option: option}
-			for k, v := range of.Valid(ctx) {
-				problems[fmt.Sprintf("questions[%d].options[%d][%s]", qsIndex, oIndex, k)] = v
-			}
+			problems.nest(fmt.Sprintf("%s.options[%d]", prefix, oIndex), (&optionForm{option: option}).Valid(ctx))
 		}
 	}
 }
 
 // addRoundProblems folds each round's field-level problems into problems
-// under the round-indexed keys. The JSON-import path populates q.Rounds,
+// under round-indexed paths ("rounds[0].title"). The JSON-import path populates q.Rounds,
 // so this is the only gate that range-checks an imported round's
 // boundary_duration_seconds before it reaches the DB CHECK (#554).
-func addRoundProblems(ctx context.Context, problems map[string]string, rounds []*quiz.Round) {
+func addRoundProblems(ctx context.Context, problems *ValidationErrors, rounds []*quiz.Round) {
 	for rIndex, round := range rounds {
-		rf := &roundForm{round: round}
-		for k, v := range rf.Valid(ctx) {
-			problems[fmt.Sprintf("rounds[%d][%s]", rIndex, k)] = v
-		}
+		problems.nest(fmt.Sprintf("rounds[%d]", rIndex), (&roundForm{round: round}).Valid(ctx))
 	}
 }
 
 // questionForm wraps a [quiz.Question] for the standalone
 // add-question / edit-question admin form. The quizForm above
-// composes it for the per-question rules embedded in a quiz save.
+// composes it for the per-question rules embedded in a quiz save. live
+// is set when the question belongs to a live quiz, whose hosted runner
+// only plays option picks.
 type questionForm struct {
 	question *quiz.Question
+	live     bool
 }
 
 // Valid checks the question's field-level rules. The store layer is
 // responsible for cross-row invariants (e.g. unique position per
 // quiz); this form is purely about input shape.
-func (f *questionForm) Valid(_ context.Context) map[string]string {
-	problems := make(map[string]string)
+func (f *questionForm) Valid(_ context.Context) ValidationErrors {
+	var problems ValidationErrors
 	q := f.question
 	if q.Text == "" {
-		problems["text"] = "Text is required"
+		problems.add("text", CodeRequired, "Text is required")
+	}
+	if q.Kind != "" && !quiz.IsValidKind(q.Kind) {
+		problems.add("kind", CodeInvalidChoice, "Kind must be one of: choice, multi, truefalse, numeric")
 	}
 	switch {
+	case q.IsNumeric():
+		addNumericProblems(&problems, q, f.live)
 	case len(q.Options) == 0:
-		problems["options"] = "Options are required"
+		problems.add("options", CodeRequired, "Options are required")
 	case len(q.Options) > maxOptions:
-		problems["options"] = fmt.Sprintf("A question may have at most %d options", maxOptions)
+		problems.addf("options", CodeTooMany, "A question may have at most %d options", maxOptions)
 	default:
-		// Option count is in range. Deliberately no correct-option
-		// check: a question where the player is meant to pick none is a
-		// supported shape.
+		addPickProblems(&problems, q, f.live)
+	}
+	if q.ExternalRef != "" && !validExternalRef(q.ExternalRef) {
+		problems.addf("externalref", CodeInvalid,
+			"External reference must be at most %d characters, without spaces", maxExternalRefLength)
 	}
 	if q.TimeLimitSeconds != nil {
 		v := *q.TimeLimitSeconds
 		if v < quiz.MinTimeLimitSeconds || v > quiz.MaxTimeLimitSeconds {
-			problems["timelimitseconds"] = fmt.Sprintf(
+			problems.addf("timelimitseconds", CodeOutOfRange,
 				"Time limit must be between %d and %d seconds, or blank to inherit the quiz default",
 				quiz.MinTimeLimitSeconds, quiz.MaxTimeLimitSeconds,
 			)
@@ -132,6 +197,59 @@ func (f *questionForm) Valid(_ context.Context) map[string]string {
 	return problems
 }
 
+// addNumericProblems checks a numeric question's answer key: exactly one
+// option carrying the value, with non-negative tolerances.
+func addNumericProblems(problems *ValidationErrors, q *quiz.Question, live bool) {
+	if live {
+		problems.add("kind", CodeNotLivePlayable, "A live quiz cannot have numeric questions")
+	}
+	key := q.NumericKey()
+	switch {
+	case len(q.Options) != 1 || key == nil:
+		problems.add("numericvalue", CodeRequired, "The answer is required")
+	case key.ToleranceBelow < 0 || key.ToleranceAbove < 0:
+		problems.add("tolerance", CodeOutOfRange, "Tolerances cannot be negative")
+	default:
+		// A well-formed key; zero tolerances (exact answers only) are fine.
+	}
+}
+
+// addPickProblems checks the correct options of a question whose option
+// count is in range. A multi-select question needs at least one correct
+// option to give credit for, and a true/false question exactly two options
+// with one correct. A plain choice question deliberately has no
+// minimum: one where the player is meant to pick none is a supported shape.
+// Neither a choice nor a multi-select question may mark every option
+// correct: any pick then scores, and it usually means the correct flags
+// were lost or set wholesale by an import.
+func addPickProblems(problems *ValidationErrors, q *quiz.Question, live bool) {
+	switch q.Kind {
+	case quiz.KindMulti:
+		if live {
+			problems.add("kind", CodeNotLivePlayable, "A live quiz cannot have select-all questions")
+		}
+		if q.CorrectCount() == 0 {
+			problems.add("options", CodeNoCorrectOption, "Mark at least one option correct")
+		}
+	case quiz.KindTrueFalse:
+		if len(q.Options) != 2 || q.CorrectCount() != 1 {
+			problems.add("options", CodeTrueFalseShape,
+				"A true/false question needs exactly two options, one of them correct")
+		}
+	default:
+		// Option count is in range and any number short of all is fine.
+	}
+	if q.Kind != quiz.KindTrueFalse && q.CorrectCount() == len(q.Options) {
+		problems.add("options", CodeAllCorrect, "Leave at least one option unmarked - every option is marked correct")
+	}
+}
+
+// notLivePlayable reports whether the live runner, which plays single option
+// picks only, cannot host q.
+func notLivePlayable(q *quiz.Question) bool {
+	return !q.IsSinglePick()
+}
+
 // optionForm wraps a [quiz.Option]; embedded in the per-question
 // rules a quiz save evaluates so the renderer can surface text
 // errors next to the option row.
@@ -140,10 +258,10 @@ type optionForm struct {
 }
 
 // Valid checks the option's field-level rules.
-func (f *optionForm) Valid(_ context.Context) map[string]string {
-	problems := make(map[string]string)
+func (f *optionForm) Valid(_ context.Context) ValidationErrors {
+	var problems ValidationErrors
 	if f.option.Text == "" {
-		problems["text"] = "Text is required"
+		problems.add("text", CodeRequired, "Text is required")
 	}
 
 	return problems
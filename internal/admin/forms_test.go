@@ -1,6 +1,7 @@
 package admin_test
 
 import (
+	"fmt"
 	"testing"
 
 	. "github.com/starquake/topbanana/internal/admin"
@@ -55,11 +56,11 @@ func TestQuizForm_Valid(t *testing.T) {
 				},
 			},
 			{
-				// Multi-correct, all-correct, and no-correct are all
-				// allowed - the admin UI offers a checkbox per option and a
-				// question where the player is meant to pick none is a
-				// legitimate shape (the "no correct option" valid case
-				// below pins this).
+				// Multi-correct and no-correct are both allowed - the admin
+				// UI offers a checkbox per option and a question where the
+				// player is meant to pick none is a legitimate shape (the
+				// "no correct option" valid case below pins this). Marking
+				// every option correct is not (see the invalid cases).
 				name: "valid quiz with multiple correct options on a question",
 				quiz: quiz.Quiz{
 					Title:       "Quiz multi-correct",
@@ -78,24 +79,6 @@ func TestQuizForm_Valid(t *testing.T) {
 					},
 				},
 			},
-			{
-				name: "valid quiz with all options correct",
-				quiz: quiz.Quiz{
-					Title:       "Quiz all-correct",
-					Slug:        "quiz-all-correct",
-					Description: "Quiz description",
-					Questions: []*quiz.Question{
-						{
-							Text: "Pick a colour",
-							Options: []*quiz.Option{
-								{Text: "red", Correct: true},
-								{Text: "blue", Correct: true},
-								{Text: "green", Correct: true},
-							},
-						},
-					},
-				},
-			},
 			{
 				// A question with no correct option is a supported shape
 				// (the player is meant to pick none); the admin quiz-import
@@ -123,8 +106,8 @@ func TestQuizForm_Valid(t *testing.T) {
 				t.Parallel()
 				if problems := ValidateQuizForm(t.Context(), &tc.quiz); len(problems) > 0 {
 					t.Errorf("quiz is not valid: %v", tc.quiz)
-					for k, v := range problems {
-						t.Errorf("  %s: %s", k, v)
+					for _, p := range problems {
+						t.Errorf("  %s: %s", p.Field, p.Message)
 					}
 				}
 			})
@@ -203,6 +186,25 @@ func TestQuizForm_Valid(t *testing.T) {
 					},
 				},
 			},
+			{
+				// Every option correct makes any pick score.
+				name: "quiz with all options correct",
+				quiz: quiz.Quiz{
+					Title:       "Quiz all-correct",
+					Slug:        "quiz-all-correct",
+					Description: "Quiz description",
+					Questions: []*quiz.Question{
+						{
+							Text: "Pick a colour",
+							Options: []*quiz.Option{
+								{Text: "red", Correct: true},
+								{Text: "blue", Correct: true},
+								{Text: "green", Correct: true},
+							},
+						},
+					},
+				},
+			},
 			{
 				name: "quiz with question with too many options",
 				quiz: quiz.Quiz{
@@ -285,7 +287,7 @@ func TestQuestionForm_Valid_OptionRules(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			problems := ValidateQuestionForm(t.Context(), &tc.question)
-			_, hasOptionProblem := problems["options"]
+			hasOptionProblem := problems.For("options") != ""
 			if got, want := !hasOptionProblem, tc.wantValid; got != want {
 				t.Errorf("options problem absent = %v, want %v (problems=%v)", got, want, problems)
 			}
@@ -293,6 +295,105 @@ func TestQuestionForm_Valid_OptionRules(t *testing.T) {
 	}
 }
 
+// TestQuestionForm_Valid_Numeric pins the numeric question rules: the answer
+// key is required, tolerances cannot be negative, and a live quiz rejects the
+// kind outright.
+func TestQuestionForm_Valid_Numeric(t *testing.T) {
+	t.Parallel()
+
+	numeric := func(options ...*quiz.Option) *quiz.Question {
+		return &quiz.Question{Text: "How many?", Kind: quiz.KindNumeric, Options: options}
+	}
+
+	tests := []struct {
+		name        string
+		question    *quiz.Question
+		live        bool
+		wantProblem string
+	}{
+		{name: "valid key", question: numeric(quiz.NewNumericKey(42, 2, 5))},
+		{name: "missing key", question: numeric(), wantProblem: "numericvalue"},
+		{name: "option without a value", question: numeric(&quiz.Option{Text: "42", Correct: true}), wantProblem: "numericvalue"},
+		{name: "negative tolerance", question: numeric(quiz.NewNumericKey(42, -1, 5)), wantProblem: "tolerance"},
+		{name: "unknown kind", question: &quiz.Question{Text: "?", Kind: "essay"}, wantProblem: "kind"},
+		{name: "live quiz", question: numeric(quiz.NewNumericKey(42, 2, 5)), live: true, wantProblem: "kind"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			validate := ValidateQuestionForm
+			if tc.live {
+				validate = ValidateLiveQuestionForm
+			}
+			problems := validate(t.Context(), tc.question)
+			if tc.wantProblem == "" {
+				if len(problems) > 0 {
+					t.Errorf("problems = %v, want none", problems)
+				}
+
+				return
+			}
+			if problems.For(tc.wantProblem) == "" {
+				t.Errorf("problems = %v, want a %q problem", problems, tc.wantProblem)
+			}
+		})
+	}
+}
+
+// TestQuestionForm_Valid_PickKinds pins the select-all and true/false rules:
+// a select-all question needs a correct option and stays out of live quizzes,
+// neither it nor a choice question may mark every option correct, and a
+// true/false question needs exactly two options with one correct.
+func TestQuestionForm_Valid_PickKinds(t *testing.T) {
+	t.Parallel()
+
+	question := func(kind string, correct ...bool) *quiz.Question {
+		q := &quiz.Question{Text: "Which?", Kind: kind}
+		for i, c := range correct {
+			q.Options = append(q.Options, &quiz.Option{Text: fmt.Sprintf("Option %d", i+1), Correct: c})
+		}
+
+		return q
+	}
+
+	tests := []struct {
+		name        string
+		question    *quiz.Question
+		live        bool
+		wantProblem string
+	}{
+		{name: "select-all with two correct", question: question(quiz.KindMulti, true, true, false)},
+		{name: "select-all with none correct", question: question(quiz.KindMulti, false, false), wantProblem: "options"},
+		{name: "select-all with all correct", question: question(quiz.KindMulti, true, true), wantProblem: "options"},
+		{name: "choice with all correct", question: question(quiz.KindChoice, true, true), wantProblem: "options"},
+		{name: "select-all on a live quiz", question: question(quiz.KindMulti, true, false), live: true, wantProblem: "kind"},
+		{name: "true/false", question: question(quiz.KindTrueFalse, false, true)},
+		{name: "true/false on a live quiz", question: question(quiz.KindTrueFalse, true, false), live: true},
+		{name: "true/false with three options", question: question(quiz.KindTrueFalse, true, false, false), wantProblem: "options"},
+		{name: "true/false with both correct", question: question(quiz.KindTrueFalse, true, true), wantProblem: "options"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			validate := ValidateQuestionForm
+			if tc.live {
+				validate = ValidateLiveQuestionForm
+			}
+			problems := validate(t.Context(), tc.question)
+			if tc.wantProblem == "" {
+				if len(problems) > 0 {
+					t.Errorf("problems = %v, want none", problems)
+				}
+
+				return
+			}
+			if problems.For(tc.wantProblem) == "" {
+				t.Errorf("problems = %v, want a %q problem", problems, tc.wantProblem)
+			}
+		})
+	}
+}
+
 // TestRoundForm_Valid_BoundaryDuration pins the #554 range check on the
 // optional per-round boundary-duration override: blank (nil) inherits,
 // in-range values pass, and out-of-range values surface keyed
@@ -321,7 +422,7 @@ func TestRoundForm_Valid_BoundaryDuration(t *testing.T) {
 
 			round := &quiz.Round{Title: "Round 1", BoundaryDurationSeconds: tc.duration}
 			problems := ValidateRoundForm(t.Context(), round)
-			_, hasProblem := problems["boundarydurationseconds"]
+			hasProblem := problems.For("boundarydurationseconds") != ""
 			if got, want := hasProblem, tc.wantProblem; got != want {
 				t.Errorf("boundarydurationseconds problem present = %v, want %v (problems=%v)", got, want, problems)
 			}
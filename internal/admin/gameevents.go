@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/handlers"
+)
+
+// gameEventsResponse is the GET /admin/games/{gameID}/events body.
+type gameEventsResponse struct {
+	GameID string              `json:"gameId"`
+	Events []*game.LoggedEvent `json:"events"`
+}
+
+// HandleGameEvents serves GET /admin/games/{gameID}/events, the Admin-only
+// debugging view of a game's event log as JSON: every game_created,
+// player_joined, question_served, answer_submitted and game_finished event,
+// oldest first. It is how a flaky client report - a create that hit the 409
+// and resumed, an answer the player swears they sent - is checked against
+// what the server actually saw. The log is written asynchronously, so the
+// last event of a game still being played can lag by a moment. An unknown
+// game answers with an empty log rather than a 404, since a game's log is
+// deleted along with the game.
+func HandleGameEvents(logger *slog.Logger, events game.EventLogReader) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gameID := r.PathValue("gameID")
+		logged, err := events.ListGameEvents(r.Context(), gameID)
+		if err != nil {
+			logger.ErrorContext(r.Context(), "error listing game events", slog.Any("err", err))
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Cache-Control", "no-store")
+		if err = handlers.EncodeJSON(w, http.StatusOK, gameEventsResponse{GameID: gameID, Events: logged}); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding game events", slog.Any("err", err))
+		}
+	})
+}
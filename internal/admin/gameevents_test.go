@@ -0,0 +1,104 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/analytics"
+	"github.com/starquake/topbanana/internal/game"
+)
+
+// errEventLog is the failure fakeEventLog returns when told to.
+var errEventLog = errors.New("event log unavailable")
+
+// fakeEventLog serves a fixed log for one game.
+type fakeEventLog struct {
+	gameID string
+	events []*game.LoggedEvent
+	err    error
+}
+
+func (f *fakeEventLog) ListGameEvents(_ context.Context, gameID string) ([]*game.LoggedEvent, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if gameID != f.gameID {
+		return []*game.LoggedEvent{}, nil
+	}
+
+	return f.events, nil
+}
+
+func TestHandleGameEvents(t *testing.T) {
+	t.Parallel()
+
+	log := &fakeEventLog{gameID: "g1", events: []*game.LoggedEvent{
+		{ID: 1, Event: analytics.Event{Type: analytics.TypeGameCreated, GameID: "g1"}},
+		{ID: 2, Event: analytics.Event{Type: analytics.TypePlayerJoined, GameID: "g1", PlayerID: 7}},
+	}}
+
+	tests := []struct {
+		name       string
+		log        *fakeEventLog
+		gameID     string
+		wantStatus int
+		wantTypes  []string
+	}{
+		{
+			name: "lists the game's events in order", log: log, gameID: "g1", wantStatus: http.StatusOK,
+			wantTypes: []string{analytics.TypeGameCreated, analytics.TypePlayerJoined},
+		},
+		{name: "unknown game is an empty log", log: log, gameID: "nope", wantStatus: http.StatusOK, wantTypes: []string{}},
+		{
+			name: "store failure is a 500", log: &fakeEventLog{err: errEventLog}, gameID: "g1",
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.Handle("GET /admin/games/{gameID}/events", HandleGameEvents(slog.New(slog.DiscardHandler), tt.log))
+			req := httptest.NewRequestWithContext(
+				t.Context(), http.MethodGet, "/admin/games/"+tt.gameID+"/events", nil,
+			)
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+
+			if got := rr.Code; got != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", got, tt.wantStatus)
+			}
+			if tt.wantTypes == nil {
+				return
+			}
+			var body struct {
+				GameID string `json:"gameId"`
+				Events []struct {
+					ID   int64  `json:"id"`
+					Type string `json:"type"`
+				} `json:"events"`
+			}
+			if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+				t.Fatalf("json.Unmarshal err = %v, want nil (body %q)", err, rr.Body.String())
+			}
+			if body.GameID != tt.gameID {
+				t.Errorf("gameId = %q, want %q", body.GameID, tt.gameID)
+			}
+			if got, want := len(body.Events), len(tt.wantTypes); got != want {
+				t.Fatalf("events = %d, want %d", got, want)
+			}
+			for i, e := range body.Events {
+				if e.Type != tt.wantTypes[i] {
+					t.Errorf("events[%d].type = %q, want %q", i, e.Type, tt.wantTypes[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package admin
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/handlers"
+)
+
+// GameReviewer loads a solo game for review, voids its questions and
+// toggles its public standings. *game.Service satisfies it.
+type GameReviewer interface {
+	GetGameForReview(ctx context.Context, gameID string) (*game.Game, *game.Results, error)
+	VoidQuestion(ctx context.Context, g *game.Game, questionID int64) error
+	SetResultsPublic(ctx context.Context, gameID string, public bool) error
+}
+
+// gameQuestionRow is one issued question on the game review page. Text is
+// empty when the quiz question was deleted since.
+type gameQuestionRow struct {
+	QuestionID int64
+	Text       string
+	Answers    int
+	Voided     bool
+}
+
+// gameScoreRow is one player's total on the game review page.
+type gameScoreRow struct {
+	PlayerID int64
+	Score    int
+	Winner   bool
+}
+
+// gameReviewPageData backs gamereview.gohtml.
+type gameReviewPageData struct {
+	Title     string
+	GameID    string
+	QuizID    int64
+	QuizTitle string
+	Preview   bool
+	// ResultsPublic and Finished drive the public standings toggle; the
+	// standings are only served once the game is finished.
+	ResultsPublic bool
+	Finished      bool
+	Questions     []gameQuestionRow
+	Scores        []gameScoreRow
+	Notice        string
+	Error         string
+}
+
+// HandleGameReview renders GET /admin/games/{gameID}: the game's issued
+// questions, each with a void button, and the recomputed player totals.
+// Hosts see only games of quizzes they created (Admins see every game);
+// anything else gets the same 404 as an unknown game.
+func HandleGameReview(
+	logger *slog.Logger,
+	csrfMgr *csrf.Manager,
+	reviewer GameReviewer,
+	flash *auth.SignedFlash,
+) http.Handler {
+	render := NewTemplateRenderer(logger, csrfMgr, "admin/pages/gamereview.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g, results, ok := loadReviewGame(w, r, logger, csrfMgr, reviewer)
+		if !ok {
+			return
+		}
+
+		data := gameReviewPageData{
+			Title:         "Admin Dashboard - Game " + g.ID,
+			GameID:        g.ID,
+			QuizID:        g.QuizID,
+			QuizTitle:     g.Quiz.Title,
+			Preview:       g.Preview,
+			ResultsPublic: g.ResultsPublic,
+			Finished:      g.Status == game.GameStatusFinished,
+			Questions:     gameQuestionRows(g),
+			Scores:        gameScoreRows(results),
+		}
+		if flash != nil {
+			if fr := flash.Read(w, r); fr.OK {
+				data.Notice = fr.Notice
+				data.Error = fr.Err
+			}
+		}
+		render.Render(w, r, http.StatusOK, data)
+	})
+}
+
+// HandleGameQuestionVoid handles POST /admin/games/{gameID}/questions/{questionID}/void:
+// it voids the question for that game, so its answers stop scoring in the
+// game's results and on the quiz leaderboard, then redirects back to the
+// review page, which shows the recomputed totals. Same access rule as
+// [HandleGameReview].
+func HandleGameQuestionVoid(
+	logger *slog.Logger,
+	csrfMgr *csrf.Manager,
+	reviewer GameReviewer,
+	flash *auth.SignedFlash,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		questionID, ok := handlers.ParseIDFromPath(w, r, logger, "questionID")
+		if !ok {
+			return
+		}
+		g, _, ok := loadReviewGame(w, r, logger, csrfMgr, reviewer)
+		if !ok {
+			return
+		}
+
+		err := reviewer.VoidQuestion(r.Context(), g, questionID)
+		switch {
+		case errors.Is(err, game.ErrQuestionNotInGame):
+			flash.SetError(w, "That question was never asked in this game.", 0)
+		case err != nil:
+			logger.ErrorContext(r.Context(), "error voiding game question", slog.Any("err", err))
+			flash.SetError(w, "Could not void the question. Nothing was changed; try again.", 0)
+		default:
+			logger.InfoContext(r.Context(), "game question voided",
+				slog.String("gameId", g.ID), slog.Int64("questionId", questionID))
+			flash.SetNotice(w, "Question voided. Its answers no longer score in this game.")
+		}
+
+		http.Redirect(w, r, "/admin/games/"+url.PathEscape(g.ID), http.StatusSeeOther)
+	})
+}
+
+// HandleGameResultsPublic handles POST /admin/games/{gameID}/results-public:
+// a non-empty "public" form value opts the game into the anonymous standings
+// at GET /api/games/{gameID}/results/public, an empty one takes it back out.
+// It redirects back to the review page. Same access rule as
+// [HandleGameReview].
+func HandleGameResultsPublic(
+	logger *slog.Logger,
+	csrfMgr *csrf.Manager,
+	reviewer GameReviewer,
+	flash *auth.SignedFlash,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g, _, ok := loadReviewGame(w, r, logger, csrfMgr, reviewer)
+		if !ok {
+			return
+		}
+
+		public := r.PostFormValue("public") != ""
+		if err := reviewer.SetResultsPublic(r.Context(), g.ID, public); err != nil {
+			logger.ErrorContext(r.Context(), "error setting game results visibility", slog.Any("err", err))
+			flash.SetError(w, "Could not change who can see the results. Nothing was changed; try again.", 0)
+		} else {
+			logger.InfoContext(r.Context(), "game results visibility changed",
+				slog.String("gameId", g.ID), slog.Bool("public", public))
+			if public {
+				flash.SetNotice(w, "Results are public. Anyone with the link sees the standings by name.")
+			} else {
+				flash.SetNotice(w, "Results are private again.")
+			}
+		}
+
+		http.Redirect(w, r, "/admin/games/"+url.PathEscape(g.ID), http.StatusSeeOther)
+	})
+}
+
+// loadReviewGame loads the {gameID} game for the review routes and applies
+// the creator-or-Admin rule, rendering a 404 for an unknown game or one the
+// caller may not review so the two are indistinguishable.
+func loadReviewGame(
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *slog.Logger,
+	csrfMgr *csrf.Manager,
+	reviewer GameReviewer,
+) (*game.Game, *game.Results, bool) {
+	g, results, err := reviewer.GetGameForReview(r.Context(), r.PathValue("gameID"))
+	if err != nil {
+		if errors.Is(err, game.ErrGameNotFound) {
+			render404(w, r, logger, csrfMgr)
+
+			return nil, nil, false
+		}
+		logger.ErrorContext(r.Context(), "error loading game for review", slog.Any("err", err))
+		render500(w, r, logger, csrfMgr)
+
+		return nil, nil, false
+	}
+	if !canEditQuiz(r, g.Quiz.CreatedByPlayerID) {
+		render404(w, r, logger, csrfMgr)
+
+		return nil, nil, false
+	}
+
+	return g, results, true
+}
+
+// gameQuestionRows lists the game's issued questions in issue order with
+// their quiz text.
+func gameQuestionRows(g *game.Game) []gameQuestionRow {
+	text := make(map[int64]string, len(g.Quiz.Questions))
+	for _, q := range g.Quiz.Questions {
+		text[q.ID] = q.Text
+	}
+
+	rows := make([]gameQuestionRow, 0, len(g.Questions))
+	for _, gq := range g.Questions {
+		rows = append(rows, gameQuestionRow{
+			QuestionID: gq.QuestionID,
+			Text:       text[gq.QuestionID],
+			Answers:    len(gq.Answers),
+			Voided:     gq.Voided,
+		})
+	}
+
+	return rows
+}
+
+// gameScoreRows orders the results by score descending, then player id.
+func gameScoreRows(results *game.Results) []gameScoreRow {
+	rows := make([]gameScoreRow, 0, len(results.PlayerScores))
+	for playerID, score := range results.PlayerScores {
+		rows = append(rows, gameScoreRow{PlayerID: playerID, Score: score, Winner: playerID == results.Winner})
+	}
+	slices.SortFunc(rows, func(a, b gameScoreRow) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.PlayerID, b.PlayerID)
+	})
+
+	return rows
+}
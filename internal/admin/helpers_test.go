@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/starquake/topbanana/internal/audit"
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/dbtest"
 	"github.com/starquake/topbanana/internal/game"
@@ -31,6 +32,7 @@ type adminEnv struct {
 	admin   auth.AdminPlayerStore
 	tokens  auth.VerifyTokenStore
 	service *game.Service
+	audit   audit.Store
 }
 
 // newAdminEnv opens a migrated dbtest DB, builds the real stores, and
@@ -58,6 +60,7 @@ func newAdminEnv(t *testing.T) *adminEnv {
 		admin:   stores.AdminPlayers,
 		tokens:  stores.VerifyTokens,
 		service: svc,
+		audit:   stores.Audit,
 	}
 }
 
@@ -19,7 +19,7 @@ import (
 func newDescriptionHandler(t *testing.T, env *adminEnv) http.Handler {
 	t.Helper()
 
-	svc := media.NewService(env.media, t.TempDir(), 1<<20, 1<<20, slog.New(slog.DiscardHandler))
+	svc := media.NewService(env.media, media.NewLocalStorage(t.TempDir()), 1<<20, 1<<20, slog.New(slog.DiscardHandler))
 
 	return HandleMediaDescriptionSave(slog.New(slog.DiscardHandler), newRoundsCSRF(), svc, env.quizzes)
 }
@@ -9,13 +9,16 @@ func navSection(path string) string {
 	switch {
 	case strings.HasPrefix(path, "/admin/quizzes"):
 		return "quizzes"
+	case strings.HasPrefix(path, "/admin/tournaments"):
+		return "tournaments"
 	case strings.HasPrefix(path, "/admin/players"):
 		return "players"
 	case strings.HasPrefix(path, "/admin/invites"):
 		return "invites"
 	case strings.HasPrefix(path, "/admin/email"):
 		return "email"
-	case strings.HasPrefix(path, "/admin/settings"):
+	case strings.HasPrefix(path, "/admin/settings"), strings.HasPrefix(path, "/admin/anomalies"),
+		strings.HasPrefix(path, "/admin/audit"), strings.HasPrefix(path, "/admin/analytics"):
 		return "settings"
 	default:
 		return ""
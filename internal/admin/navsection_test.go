@@ -20,6 +20,8 @@ func TestNavSection(t *testing.T) {
 		{name: "quiz detail", path: "/admin/quizzes/42", want: "quizzes"},
 		{name: "quiz new", path: "/admin/quizzes/new", want: "quizzes"},
 		{name: "quiz import", path: "/admin/quizzes/import", want: "quizzes"},
+		{name: "tournaments list", path: "/admin/tournaments", want: "tournaments"},
+		{name: "tournament detail", path: "/admin/tournaments/3", want: "tournaments"},
 		{name: "players list", path: "/admin/players", want: "players"},
 		{name: "player detail", path: "/admin/players/7", want: "players"},
 		{name: "invites list", path: "/admin/invites", want: "invites"},
@@ -28,6 +30,9 @@ func TestNavSection(t *testing.T) {
 		{name: "email test", path: "/admin/email/test", want: "email"},
 		{name: "settings", path: "/admin/settings", want: "settings"},
 		{name: "settings promote", path: "/admin/settings/promote", want: "settings"},
+		{name: "anomalies", path: "/admin/anomalies", want: "settings"},
+		{name: "audit log", path: "/admin/audit", want: "settings"},
+		{name: "analytics", path: "/admin/analytics", want: "settings"},
 		{name: "unknown section", path: "/admin/other", want: ""},
 	}
 
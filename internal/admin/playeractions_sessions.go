@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/session"
+)
+
+// HandlePlayerRevokeSessions handles POST /admin/players/{playerID}/sessions/revoke:
+// it signs the player out on every device, the Admin's answer to a lost
+// phone or a shared computer left signed in. Every session in the player's
+// device list is revoked and session_version is bumped, so cookies minted
+// before sessions were recorded go too. An Admin revoking their own
+// sessions is signed out of this one as well.
+func HandlePlayerRevokeSessions(
+	logger *slog.Logger,
+	store auth.AdminPlayerStore,
+	devices session.DeviceStore,
+	flash *auth.SignedFlash,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		playerID, ok := handlers.ParseIDFromPath(w, r, logger, "playerID")
+		if !ok {
+			return
+		}
+		actor, ok := requireAdminActor(w, r)
+		if !ok {
+			return
+		}
+		if _, ok = loadActionTarget(w, r, logger, store, playerID); !ok {
+			return
+		}
+
+		if _, err := devices.RevokeOtherSessions(r.Context(), playerID, ""); err != nil {
+			logger.ErrorContext(r.Context(), "error revoking player sessions", slog.Any("err", err))
+			flash.SetError(w, "Could not sign the player out. Try again.", 0)
+			redirectToPlayerDetail(w, r, playerID)
+
+			return
+		}
+		writeAudit(r.Context(), logger, store, actor.ID, playerID, auth.AdminActionSessionsRevoked, nil)
+		flash.SetNotice(w, "Player signed out on every device.")
+		redirectToPlayerDetail(w, r, playerID)
+	})
+}
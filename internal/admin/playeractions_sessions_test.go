@@ -0,0 +1,92 @@
+package admin_test
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/session"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// postRevokeSessions drives HandlePlayerRevokeSessions against the target.
+func postRevokeSessions(
+	t *testing.T, env *adminEnv, devices session.DeviceStore, targetID int64,
+) (*httptest.ResponseRecorder, auth.SignedFlashRead) {
+	t.Helper()
+	flash := newCredFlash(t)
+	handler := HandlePlayerRevokeSessions(slog.New(slog.DiscardHandler), env.admin, devices, flash)
+
+	id := strconv.FormatInt(targetID, 10)
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/players/"+id+"/sessions/revoke", nil)
+	req.SetPathValue("playerID", id)
+	req = req.WithContext(auth.WithPlayer(req.Context(), &auth.Player{ID: testAdminID, Role: auth.RoleAdmin}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec, readRoleFlash(t, flash, rec)
+}
+
+func TestHandlePlayerRevokeSessions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("signs the player out everywhere and audits", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		devices := store.NewPlayerStore(env.db, env.logger)
+		target := env.seedPlayer(t, "revoke-target")
+		for _, id := range []string{"phone", "laptop"} {
+			if err := devices.TouchSession(t.Context(), &session.Device{ID: id, PlayerID: target}); err != nil {
+				t.Fatalf("TouchSession err = %v, want nil", err)
+			}
+		}
+		before, err := env.players.GetPlayerByID(t.Context(), target)
+		if err != nil {
+			t.Fatalf("GetPlayerByID err = %v, want nil", err)
+		}
+
+		rec, flash := postRevokeSessions(t, env, devices, target)
+
+		if got, want := rec.Code, http.StatusSeeOther; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		if flash.Notice == "" || flash.Err != "" {
+			t.Errorf("flash = %+v, want a notice", flash)
+		}
+		left, err := devices.ListSessions(t.Context(), target, time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("ListSessions err = %v, want nil", err)
+		}
+		if len(left) != 0 {
+			t.Errorf("sessions left = %d, want 0", len(left))
+		}
+		after, err := env.players.GetPlayerByID(t.Context(), target)
+		if err != nil {
+			t.Fatalf("GetPlayerByID err = %v, want nil", err)
+		}
+		if after.SessionVersion != before.SessionVersion+1 {
+			t.Errorf("session version = %d, want %d", after.SessionVersion, before.SessionVersion+1)
+		}
+		entries := env.auditEntries(t, target)
+		if len(entries) != 1 || entries[0].Action != auth.AdminActionSessionsRevoked {
+			t.Errorf("audit = %+v, want one %q entry", entries, auth.AdminActionSessionsRevoked)
+		}
+	})
+
+	t.Run("unknown player is 404", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		rec, _ := postRevokeSessions(t, env, store.NewPlayerStore(env.db, env.logger), 9999)
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+}
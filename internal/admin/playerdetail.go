@@ -305,6 +305,12 @@ func adminActionLabel(action string) string {
 		return "Host removed"
 	case auth.AdminActionLiveQuizReset:
 		return "Live quiz play reset"
+	case auth.AdminActionSnapshotDownloaded:
+		return "Database snapshot downloaded"
+	case auth.AdminActionParticipantNotes:
+		return "Host notes set"
+	case auth.AdminActionSessionsRevoked:
+		return "Signed out everywhere"
 	default:
 		return action
 	}
@@ -334,6 +340,18 @@ func decodeAuditDetail(action, payload string) string {
 		}
 
 		return ""
+	case auth.AdminActionSnapshotDownloaded:
+		if b := fields["bytes"]; b != "" {
+			return b + " bytes"
+		}
+
+		return ""
+	case auth.AdminActionParticipantNotes:
+		if fields["notes"] == "" {
+			return "room " + fields["join_code"] + ": cleared"
+		}
+
+		return "room " + fields["join_code"] + ": " + fields["notes"]
 	case auth.AdminActionRoleChanged,
 		auth.AdminActionPromoteSuper, auth.AdminActionDemoteSuper,
 		auth.AdminActionPromoteAdmin, auth.AdminActionDemoteAdmin:
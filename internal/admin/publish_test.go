@@ -1,6 +1,7 @@
 package admin_test
 
 import (
+	"context"
 	"errors"
 	"log/slog"
 	"net/http"
@@ -175,7 +176,7 @@ func TestPublishedQuiz_EditLock(t *testing.T) {
 		env := newAdminEnv(t)
 		qz := env.seedQuiz(t, publishedTwoQuestionQuiz("Pub", "pub"))
 
-		handler := HandleQuizDelete(logger, nil, env.quizzes, noopMediaRemover{})
+		handler := HandleQuizDelete(logger, nil, env.quizzes, noopMediaRemover{}, env.audit)
 		rr := httptest.NewRecorder()
 		handler.ServeHTTP(rr, publishRequest(t, http.MethodPost, "/admin/quizzes/1/delete", qz.ID))
 
@@ -192,4 +193,4 @@ func TestPublishedQuiz_EditLock(t *testing.T) {
 // noopMediaRemover satisfies admin.QuizMediaRemover for the delete handler.
 type noopMediaRemover struct{}
 
-func (noopMediaRemover) RemoveQuizDir(_ int64) error { return nil }
+func (noopMediaRemover) RemoveQuizDir(_ context.Context, _ int64) error { return nil }
@@ -0,0 +1,116 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// QuestionDraftData is the autosaved draft the question form restores on
+// load: Data is the form's fields as a JSON object of field name to values,
+// and SavedAt when the editor last stored it.
+type QuestionDraftData struct {
+	Data    string
+	SavedAt string
+}
+
+// HandleQuestionDraftSave stores the question editor's in-progress form for
+// the session player, so a crashed browser does not lose a long edit. The
+// editor PUTs a form-encoded body carrying the CSRF token and a draft field
+// holding the form's fields as a JSON object of field name to values; each
+// autosave replaces the last. Only an existing question on a quiz the player
+// may edit takes a draft, and a body that is not such an object is a 400.
+// Responds 204 on success.
+func HandleQuestionDraftSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		quizID, ok := handlers.ParseIDFromPath(w, r, logger, "quizID")
+		if !ok {
+			return
+		}
+		questionID, ok := handlers.ParseIDFromPath(w, r, logger, "questionID")
+		if !ok {
+			return
+		}
+		qz, ok := requireEditableQuizOwner(w, r, logger, csrfMgr, quizStore, quizID)
+		if !ok {
+			return
+		}
+		if _, ok = questionByID(w, r, logger, csrfMgr, quizStore, qz.ID, questionID); !ok {
+			return
+		}
+		player, ok := auth.PlayerFromContext(r.Context())
+		if !ok {
+			render404(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxFormSize)
+		if err := r.ParseForm(); err != nil {
+			render400(w, r, logger, csrfMgr, "error parsing form")
+
+			return
+		}
+		data := r.PostFormValue("draft")
+		var fields map[string][]string
+		if err := json.Unmarshal([]byte(data), &fields); err != nil || fields == nil {
+			render400(w, r, logger, csrfMgr, "invalid draft")
+
+			return
+		}
+
+		if err := quizStore.SaveQuestionDraft(r.Context(), &quiz.QuestionDraft{
+			PlayerID:   player.ID,
+			QuestionID: questionID,
+			Data:       data,
+		}); err != nil {
+			logger.ErrorContext(r.Context(), "error saving question draft", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// questionDraftForForm returns the session player's autosaved draft of the
+// question for the edit form to restore, or nil when there is none. A lookup
+// failure is logged and degraded to "no draft" rather than failing the form:
+// the saved question still renders.
+func questionDraftForForm(r *http.Request, logger *slog.Logger, quizStore quiz.Store, questionID int64) *QuestionDraftData {
+	player, ok := auth.PlayerFromContext(r.Context())
+	if !ok || questionID == 0 {
+		return nil
+	}
+	d, err := quizStore.GetQuestionDraft(r.Context(), player.ID, questionID)
+	if err != nil {
+		if !errors.Is(err, quiz.ErrQuestionDraftNotFound) {
+			logger.ErrorContext(r.Context(), "error loading question draft", slog.Any("err", err))
+		}
+
+		return nil
+	}
+
+	return &QuestionDraftData{Data: d.Data, SavedAt: d.UpdatedAt.UTC().Format(time.RFC3339)}
+}
+
+// discardQuestionDraft drops the session player's draft of the question once
+// the question itself has saved. A failure is logged only: the save already
+// succeeded.
+func discardQuestionDraft(r *http.Request, logger *slog.Logger, quizStore quiz.Store, questionID int64) {
+	player, ok := auth.PlayerFromContext(r.Context())
+	if !ok {
+		return
+	}
+	if err := quizStore.DeleteQuestionDraft(r.Context(), player.ID, questionID); err != nil {
+		logger.ErrorContext(r.Context(), "error deleting question draft", slog.Any("err", err))
+	}
+}
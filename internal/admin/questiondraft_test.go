@@ -0,0 +1,187 @@
+package admin_test
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// putQuestionDraft drives HandleQuestionDraftSave with the form-encoded body
+// the editor's autosave sends.
+func putQuestionDraft(
+	t *testing.T, env *adminEnv, quizID, questionID int64, form url.Values,
+	actor func(*http.Request) *http.Request,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	handler := HandleQuestionDraftSave(slog.New(slog.DiscardHandler), nil, env.quizzes)
+
+	req := httptest.NewRequestWithContext(
+		t.Context(), http.MethodPut,
+		fmt.Sprintf("/admin/quizzes/%d/questions/%d/draft", quizID, questionID),
+		strings.NewReader(form.Encode()),
+	)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("quizID", strconv.FormatInt(quizID, 10))
+	req.SetPathValue("questionID", strconv.FormatInt(questionID, 10))
+	req = actor(req)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandleQuestionDraftSave(t *testing.T) {
+	t.Parallel()
+
+	const draft = `{"text":["Half-typed question"],"option[0].correct":["on"]}`
+
+	t.Run("stores the draft and the edit form restores it", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+		question := qz.Questions[0]
+
+		rec := putQuestionDraft(t, env, qz.ID, question.ID, url.Values{"draft": {draft}}, adminActor)
+		if got, want := rec.Code, http.StatusNoContent; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		stored, err := env.quizzes.GetQuestionDraft(t.Context(), testAdminID, question.ID)
+		if err != nil {
+			t.Fatalf("GetQuestionDraft err = %v, want nil", err)
+		}
+		if got, want := stored.Data, draft; got != want {
+			t.Errorf("stored draft = %q, want %q", got, want)
+		}
+
+		req := httptest.NewRequestWithContext(
+			t.Context(), http.MethodGet,
+			fmt.Sprintf("/admin/quizzes/%d/questions/%d/edit", qz.ID, question.ID), nil,
+		)
+		req.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
+		req.SetPathValue("questionID", strconv.FormatInt(question.ID, 10))
+		edit := httptest.NewRecorder()
+		HandleQuestionEdit(slog.New(slog.DiscardHandler), nil, env.quizzes, env.media).
+			ServeHTTP(edit, withTestAdmin(req))
+
+		if got, want := edit.Code, http.StatusOK; got != want {
+			t.Fatalf("edit status = %d, want %d", got, want)
+		}
+		body := edit.Body.String()
+		if !strings.Contains(body, "Restored your unsaved changes") {
+			t.Error("edit form does not announce the restored draft")
+		}
+		if !strings.Contains(body, "Half-typed question") {
+			t.Error("edit form does not carry the draft's fields")
+		}
+	})
+
+	t.Run("a later autosave replaces the draft", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+		question := qz.Questions[0]
+
+		putQuestionDraft(t, env, qz.ID, question.ID, url.Values{"draft": {draft}}, adminActor)
+		const later = `{"text":["Finished question"]}`
+		rec := putQuestionDraft(t, env, qz.ID, question.ID, url.Values{"draft": {later}}, adminActor)
+		if got, want := rec.Code, http.StatusNoContent; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		stored, err := env.quizzes.GetQuestionDraft(t.Context(), testAdminID, question.ID)
+		if err != nil {
+			t.Fatalf("GetQuestionDraft err = %v, want nil", err)
+		}
+		if got, want := stored.Data, later; got != want {
+			t.Errorf("stored draft = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("a draft that is not a field object is a 400", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+
+		for _, bad := range []string{"", "not json", "null", `["text"]`, `{"text":"not a list"}`} {
+			rec := putQuestionDraft(t, env, qz.ID, qz.Questions[0].ID, url.Values{"draft": {bad}}, adminActor)
+			if got, want := rec.Code, http.StatusBadRequest; got != want {
+				t.Errorf("draft %q: status = %d, want %d", bad, got, want)
+			}
+		}
+	})
+
+	t.Run("non-owner is an opaque 404", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+
+		rec := putQuestionDraft(t, env, qz.ID, qz.Questions[0].ID, url.Values{"draft": {draft}}, nonOwnerActor)
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("question from another quiz is a 404", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+		other := env.seedQuiz(t, twoQuestionQuiz("Quiz Two", "quiz-two"))
+
+		rec := putQuestionDraft(t, env, qz.ID, other.Questions[0].ID, url.Values{"draft": {draft}}, adminActor)
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("saving the question discards the draft", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz One", "quiz-one"))
+		question := qz.Questions[0]
+
+		putQuestionDraft(t, env, qz.ID, question.ID, url.Values{"draft": {draft}}, adminActor)
+
+		form := url.Values{"text": {question.Text}}
+		for i, o := range question.Options {
+			form.Add(fmt.Sprintf("option[%d].id", i), strconv.FormatInt(o.ID, 10))
+			form.Add(fmt.Sprintf("option[%d].text", i), o.Text)
+			if o.Correct {
+				form.Add(fmt.Sprintf("option[%d].correct", i), "on")
+			}
+		}
+		req := httptest.NewRequestWithContext(
+			t.Context(), http.MethodPost,
+			fmt.Sprintf("/admin/quizzes/%d/questions/%d", qz.ID, question.ID),
+			strings.NewReader(form.Encode()),
+		)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
+		req.SetPathValue("questionID", strconv.FormatInt(question.ID, 10))
+		rec := httptest.NewRecorder()
+		HandleQuestionSave(slog.New(slog.DiscardHandler), nil, env.quizzes, env.media, env.audit).
+			ServeHTTP(rec, withTestAdmin(req))
+
+		if got, want := rec.Code, http.StatusSeeOther; got != want {
+			t.Fatalf("save status = %d, want %d", got, want)
+		}
+		_, err := env.quizzes.GetQuestionDraft(t.Context(), testAdminID, question.ID)
+		if got, want := err, quiz.ErrQuestionDraftNotFound; !errors.Is(got, want) {
+			t.Errorf("GetQuestionDraft err = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/audit"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// upsertedQuestionResponse is the data of a successful question upsert: the
+// question's id and reference, its current version, and where it is edited
+// in the admin.
+type upsertedQuestionResponse struct {
+	ID          int64  `json:"id"`
+	ExternalRef string `json:"externalRef"`
+	Version     int64  `json:"version"`
+	URL         string `json:"url"`
+}
+
+// HandleQuestionUpsert creates or updates a question by the reference its
+// author keys it by (PUT /api/admin/quizzes/{slug}/questions/{externalRef}),
+// so a content pipeline can sync questions from a CMS without tracking our
+// ids. The body is one question in the JSON import shape. The first PUT of a
+// reference appends the question to the quiz's last round and answers 201;
+// later PUTs replace its content in place and answer 200, keeping its
+// position, round and media. Resending unchanged content writes nothing, so
+// a sync can replay its whole catalogue.
+//
+// Options are matched to the stored ones by order, so picks already recorded
+// keep pointing at the option in the same slot. A published quiz is locked
+// from edits here as in the admin pages (409).
+func HandleQuestionUpsert(logger *slog.Logger, quizStore quiz.Store, events audit.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		ref := r.PathValue("externalRef")
+		if !validExternalRef(ref) {
+			handlers.WriteError(w, r, http.StatusBadRequest,
+				fmt.Sprintf("external reference must be 1-%d printable characters", maxExternalRefLength))
+
+			return
+		}
+		qz, ok := upsertTargetQuiz(w, r, logger, quizStore)
+		if !ok {
+			return
+		}
+		in, err := handlers.DecodeJSON[quizImportQuestionPayload](w, r)
+		if err != nil {
+			handlers.WriteError(w, r, http.StatusBadRequest, "invalid question JSON")
+
+			return
+		}
+		if in.ExternalRef != "" && in.ExternalRef != ref {
+			handlers.WriteError(w, r, http.StatusBadRequest, "the body's externalRef does not match the path's")
+
+			return
+		}
+		qs := questionFromImportPayload(in, 0)
+		problems := (&questionForm{question: qs, live: qz.Mode == quiz.ModeLive}).Valid(ctx)
+		for i, o := range qs.Options {
+			problems.nest(fmt.Sprintf("options[%d]", i), (&optionForm{option: o}).Valid(ctx))
+		}
+		if len(problems) > 0 {
+			writeImportErrorJSON(w, http.StatusUnprocessableEntity, "the question is invalid", problems)
+
+			return
+		}
+
+		existing, err := quizStore.GetQuestionByExternalRef(ctx, qz.ID, ref)
+		switch {
+		case errors.Is(err, quiz.ErrQuestionNotFound):
+			qs.QuizID = qz.ID
+			qs.ExternalRef = ref
+			createUpsertedQuestion(w, r, logger, quizStore, events, qs)
+		case err != nil:
+			logger.ErrorContext(ctx, "error loading question by external ref", slog.Any("err", err))
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+		default:
+			updateUpsertedQuestion(w, r, logger, quizStore, events, existing, qs)
+		}
+	})
+}
+
+// upsertTargetQuiz resolves the {slug} path value to the quiz an upsert
+// writes to. ok=false when the quiz is missing (404), published (409), or
+// could not be loaded (500); the response is already written.
+func upsertTargetQuiz(
+	w http.ResponseWriter, r *http.Request, logger *slog.Logger, quizStore quiz.Store,
+) (*quiz.Quiz, bool) {
+	ctx := r.Context()
+	quizID, err := quizStore.GetQuizIDBySlug(ctx, r.PathValue("slug"))
+	if err == nil {
+		var qz *quiz.Quiz
+		if qz, err = quizStore.GetQuizMeta(ctx, quizID); err == nil {
+			if qz.Published {
+				handlers.WriteError(w, r, http.StatusConflict,
+					"the quiz is published and locked from edits; unpublish it first")
+
+				return nil, false
+			}
+
+			return qz, true
+		}
+	}
+	if errors.Is(err, quiz.ErrQuizNotFound) {
+		handlers.NotFound(w, r)
+
+		return nil, false
+	}
+	logger.ErrorContext(ctx, "error loading quiz for question upsert", slog.Any("err", err))
+	handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+	return nil, false
+}
+
+// createUpsertedQuestion appends qs to the end of its quiz, in the quiz's
+// last round so the rounds stay contiguous, and answers 201. A concurrent
+// PUT of the same reference that got there first answers 409; the caller
+// retries and takes the update path.
+func createUpsertedQuestion(
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *slog.Logger,
+	quizStore quiz.Store,
+	events audit.Store,
+	qs *quiz.Question,
+) {
+	ctx := r.Context()
+	rounds, err := quizStore.ListRoundsByQuiz(ctx, qs.QuizID)
+	if err != nil {
+		logger.ErrorContext(ctx, "error listing rounds for question upsert", slog.Any("err", err))
+		handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+		return
+	}
+	if len(rounds) > 0 {
+		qs.RoundID = rounds[len(rounds)-1].ID
+	}
+	if err = quizStore.CreateQuestionAtNextPosition(ctx, qs); err != nil {
+		if errors.Is(err, quiz.ErrExternalRefTaken) {
+			handlers.WriteError(w, r, http.StatusConflict, "the question was created concurrently; retry")
+
+			return
+		}
+		logger.ErrorContext(ctx, "error creating upserted question", slog.Any("err", err))
+		handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+		return
+	}
+	recordAudit(r, logger, events,
+		&audit.Event{EntityType: audit.EntityQuestion, EntityID: qs.ID, Action: audit.ActionCreate},
+		nil, questionAuditFields(qs))
+	writeUpsertedQuestion(w, r, logger, http.StatusCreated, qs)
+}
+
+// updateUpsertedQuestion replaces existing's content with in's and answers
+// 200. Unchanged content is not written, so replaying a sync neither bumps
+// the version nor logs an audit event. A substantial edit resets the
+// question's answer statistics, as the admin editor does by default.
+func updateUpsertedQuestion(
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *slog.Logger,
+	quizStore quiz.Store,
+	events audit.Store,
+	existing, in *quiz.Question,
+) {
+	ctx := r.Context()
+	before := questionContent(existing)
+	auditBefore := questionAuditFields(existing)
+
+	existing.Text = in.Text
+	existing.Kind = in.Kind
+	existing.TimeLimitSeconds = in.TimeLimitSeconds
+	existing.Explanation = in.Explanation
+	existing.Hint = in.Hint
+	for i, o := range in.Options {
+		if i < len(existing.Options) {
+			o.ID = existing.Options[i].ID
+		}
+	}
+	existing.Options = in.Options
+
+	auditAfter := questionAuditFields(existing)
+	if diff, err := audit.Diff(auditBefore, auditAfter); err == nil && diff == "{}" {
+		writeUpsertedQuestion(w, r, logger, http.StatusOK, existing)
+
+		return
+	}
+	existing.ResetStats = quiz.SubstantiallyEdited(before, existing)
+	if err := quizStore.UpdateQuestion(ctx, existing); err != nil {
+		if errors.Is(err, quiz.ErrStaleUpdate) {
+			handlers.WriteError(w, r, http.StatusConflict, "the question was changed concurrently; retry")
+
+			return
+		}
+		logger.ErrorContext(ctx, "error updating upserted question", slog.Any("err", err))
+		handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+		return
+	}
+	recordAudit(r, logger, events,
+		&audit.Event{EntityType: audit.EntityQuestion, EntityID: existing.ID, Action: audit.ActionUpdate},
+		auditBefore, auditAfter)
+	writeUpsertedQuestion(w, r, logger, http.StatusOK, existing)
+}
+
+// writeUpsertedQuestion writes the enveloped upsert result.
+func writeUpsertedQuestion(w http.ResponseWriter, r *http.Request, logger *slog.Logger, status int, qs *quiz.Question) {
+	res := upsertedQuestionResponse{
+		ID:          qs.ID,
+		ExternalRef: qs.ExternalRef,
+		Version:     qs.Version,
+		URL:         fmt.Sprintf("/admin/quizzes/%d/questions/%d/edit", qs.QuizID, qs.ID),
+	}
+	if err := handlers.WriteData(w, r, status, res); err != nil {
+		logger.ErrorContext(r.Context(), "error encoding question upsert response", slog.Any("err", err))
+	}
+}
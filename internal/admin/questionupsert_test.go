@@ -0,0 +1,161 @@
+package admin_test
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/handlers"
+)
+
+// upsertQuestion PUTs body to the question upsert handler for the quiz slug
+// and external reference, and returns the recorder.
+func upsertQuestion(t *testing.T, env *adminEnv, slug, ref, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	// Wrapped as the route is, so the response is enveloped.
+	handler := handlers.WithAPIShapes(HandleQuestionUpsert(slog.New(slog.DiscardHandler), env.quizzes, env.audit), false)
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPut,
+		"/api/admin/quizzes/"+slug+"/questions/"+url.PathEscape(ref), strings.NewReader(body))
+	req.SetPathValue("slug", slug)
+	req.SetPathValue("externalRef", ref)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	return rr
+}
+
+const upsertBody = `{"text": "Largest planet?", "options": [
+	{"text": "Jupiter", "correct": true}, {"text": "Mars", "correct": false}]}`
+
+func TestHandleQuestionUpsert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates then updates in place by reference", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Draft", "draft"))
+
+		rr := upsertQuestion(t, env, "draft", "cms-42", upsertBody)
+		if got, want := rr.Code, http.StatusCreated; got != want {
+			t.Fatalf("create status = %d, want %d; body %s", got, want, rr.Body)
+		}
+		var created struct {
+			Data struct {
+				ID          int64  `json:"id"`
+				ExternalRef string `json:"externalRef"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &created); err != nil {
+			t.Fatalf("decoding create response: %v", err)
+		}
+		if got, want := created.Data.ExternalRef, "cms-42"; got != want {
+			t.Errorf("externalRef = %q, want %q", got, want)
+		}
+		qs, err := env.quizzes.GetQuestionByExternalRef(t.Context(), qz.ID, "cms-42")
+		if err != nil {
+			t.Fatalf("GetQuestionByExternalRef err = %v", err)
+		}
+		if got, want := qs.Position, 3; got != want {
+			t.Errorf("Position = %d, want %d (appended)", got, want)
+		}
+		optionID := qs.Options[0].ID
+
+		rr = upsertQuestion(t, env, "draft", "cms-42", strings.Replace(upsertBody, "Largest", "Biggest", 1))
+		if got, want := rr.Code, http.StatusOK; got != want {
+			t.Fatalf("update status = %d, want %d; body %s", got, want, rr.Body)
+		}
+		updated, err := env.quizzes.GetQuestion(t.Context(), created.Data.ID)
+		if err != nil {
+			t.Fatalf("GetQuestion err = %v", err)
+		}
+		if got, want := updated.Text, "Biggest planet?"; got != want {
+			t.Errorf("Text = %q, want %q", got, want)
+		}
+		if got, want := updated.Options[0].ID, optionID; got != want {
+			t.Errorf("first option id = %d, want %d (kept by slot)", got, want)
+		}
+		questions, err := env.quizzes.ListQuestions(t.Context(), qz.ID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v", err)
+		}
+		if got, want := len(questions), 3; got != want {
+			t.Errorf("question count = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("unchanged content is not rewritten", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Draft", "draft"))
+		upsertQuestion(t, env, "draft", "same", upsertBody)
+		before, err := env.quizzes.GetQuestionByExternalRef(t.Context(), qz.ID, "same")
+		if err != nil {
+			t.Fatalf("GetQuestionByExternalRef err = %v", err)
+		}
+
+		rr := upsertQuestion(t, env, "draft", "same", upsertBody)
+		if got, want := rr.Code, http.StatusOK; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		after, err := env.quizzes.GetQuestion(t.Context(), before.ID)
+		if err != nil {
+			t.Fatalf("GetQuestion err = %v", err)
+		}
+		if after.Version != before.Version {
+			t.Errorf("Version = %d, want %d (no write)", after.Version, before.Version)
+		}
+		events, err := env.audit.ListRecentEvents(t.Context(), 10)
+		if err != nil {
+			t.Fatalf("ListRecentEvents err = %v", err)
+		}
+		if got, want := len(events), 1; got != want {
+			t.Errorf("audit events = %d, want %d (the create only)", got, want)
+		}
+	})
+
+	t.Run("rejects", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		env.seedQuiz(t, twoQuestionQuiz("Draft", "draft"))
+		env.seedQuiz(t, publishedTwoQuestionQuiz("Pub", "pub"))
+
+		for _, tc := range []struct {
+			name, slug, ref, body string
+			want                  int
+		}{
+			{"unknown quiz", "nope", "r1", upsertBody, http.StatusNotFound},
+			{"published quiz", "pub", "r1", upsertBody, http.StatusConflict},
+			{"invalid reference", "draft", "has space", upsertBody, http.StatusBadRequest},
+			{"malformed body", "draft", "r1", `{"text":`, http.StatusBadRequest},
+			{"unknown field", "draft", "r1", `{"text": "x", "bogus": 1}`, http.StatusBadRequest},
+			{"mismatched body reference", "draft", "r1", `{"text": "x", "externalRef": "r2"}`, http.StatusBadRequest},
+			{"invalid question", "draft", "r1", `{"text": "", "options": []}`, http.StatusUnprocessableEntity},
+		} {
+			rr := upsertQuestion(t, env, tc.slug, tc.ref, tc.body)
+			if rr.Code != tc.want {
+				t.Errorf("%s: status = %d, want %d", tc.name, rr.Code, tc.want)
+			}
+		}
+	})
+
+	t.Run("store error is a 500", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		env.closeStore(t)
+
+		rr := upsertQuestion(t, env, "draft", "r1", upsertBody)
+		if got, want := rr.Code, http.StatusInternalServerError; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+}
@@ -26,9 +26,37 @@ type quizArchiveManifest struct {
 	Mode             string `json:"mode"`
 	// Language is the advisory content-language label (#1115): "en" or "nl".
 	// Empty in older archives, which the importer defaults to English.
-	Language  string                `json:"language,omitempty"`
-	Questions []quizArchiveQuestion `json:"questions,omitempty"`
-	Rounds    []quizArchiveRound    `json:"rounds,omitempty"`
+	Language string `json:"language,omitempty"`
+	// ShuffleQuestions and KeepOptionOrder are the randomization modes;
+	// absent in older archives, which import with the defaults.
+	ShuffleQuestions bool `json:"shuffleQuestions,omitempty"`
+	KeepOptionOrder  bool `json:"keepOptionOrder,omitempty"`
+	// LateJoin and JoinDeadlineSeconds are the live-game late-join settings;
+	// absent in older archives and for the default, which import as "skip"
+	// with no deadline.
+	LateJoin            string `json:"lateJoin,omitempty"`
+	JoinDeadlineSeconds int    `json:"joinDeadlineSeconds,omitempty"`
+	// MaxPlayers is the live-room capacity override; absent in older
+	// archives and for the default, which import as 0 (server default).
+	MaxPlayers int `json:"maxPlayers,omitempty"`
+	// ConfidenceWager is the solo confidence-wager opt-in; absent in older
+	// archives, which import with it off.
+	ConfidenceWager bool `json:"confidenceWager,omitempty"`
+	// HostPaced is the host-paced scoring opt-in; absent in older archives,
+	// which import with it off.
+	HostPaced bool `json:"hostPaced,omitempty"`
+	// Scoring is the scoring mode; absent in older archives and for the
+	// default, which import as "time".
+	Scoring string `json:"scoring,omitempty"`
+	// WrongAnswerPenalty and ScoreFloor are the negative marking; absent in
+	// older archives and for the defaults, which import as 0.
+	WrongAnswerPenalty int `json:"wrongAnswerPenalty,omitempty"`
+	ScoreFloor         int `json:"scoreFloor,omitempty"`
+	// ExternalRef is the author's own id for the quiz; absent in older
+	// archives and for quizzes without one.
+	ExternalRef string                `json:"externalRef,omitempty"`
+	Questions   []quizArchiveQuestion `json:"questions,omitempty"`
+	Rounds      []quizArchiveRound    `json:"rounds,omitempty"`
 }
 
 // quizArchiveRound is one authored round in the manifest.
@@ -47,7 +75,14 @@ type quizArchiveQuestion struct {
 	TimeLimitSeconds *int                 `json:"timeLimitSeconds,omitempty"`
 	Image            *quizArchiveImageRef `json:"image,omitempty"`
 	Audio            *quizArchiveAudioRef `json:"audio,omitempty"`
-	Options          []quizArchiveOption  `json:"options"`
+	// Kind is empty for a choice question, and in archives written before
+	// question kinds; a numeric question carries its answer key in Answer.
+	Kind        string                    `json:"kind,omitempty"`
+	Answer      *quizArchiveNumericAnswer `json:"answer,omitempty"`
+	Options     []quizArchiveOption       `json:"options"`
+	Explanation string                    `json:"explanation,omitempty"`
+	Hint        string                    `json:"hint,omitempty"`
+	ExternalRef string                    `json:"externalRef,omitempty"`
 }
 
 // quizArchiveOption is one answer option in the manifest.
@@ -56,6 +91,14 @@ type quizArchiveOption struct {
 	Correct bool   `json:"correct"`
 }
 
+// quizArchiveNumericAnswer is a numeric question's answer key in the
+// manifest.
+type quizArchiveNumericAnswer struct {
+	Value          float64 `json:"value"`
+	ToleranceBelow float64 `json:"toleranceBelow,omitempty"`
+	ToleranceAbove float64 `json:"toleranceAbove,omitempty"`
+}
+
 // quizArchiveImageRef points at an image file stored in the archive. File is
 // the archive-relative path ("media/<id>.<ext>"); MIME is the stored content
 // type so the importer can re-register the media without re-sniffing.
@@ -0,0 +1,189 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gosimple/slug"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// maxDuplicateCopies caps how many "(copy N)" titles [HandleQuizDuplicate]
+// tries before giving up, so a quiz copied over and over cannot turn one
+// click into an unbounded run of slug-taken inserts.
+const maxDuplicateCopies = 20
+
+// errDuplicateCopiesExhausted is returned by duplicateQuiz when every copy
+// title up to maxDuplicateCopies is taken.
+var errDuplicateCopiesExhausted = errors.New("every copy title of the quiz is taken")
+
+// HandleQuizDuplicate deep-copies a quiz into a new draft owned by the session
+// player and redirects to it: the quiz settings, its rounds, and every
+// question with its options, so a weekly template can be reworked without
+// touching the original. The copy is titled "<title> (copy)", slug
+// "<slug>-copy", counting up ("(copy 2)", "-copy-2") while the slug is taken.
+// Like the JSON export, the copy carries no images or sounds (the media
+// library is per-quiz) and no ordering constraints between questions. The
+// copy is persisted through the regular create path, in one transaction.
+// Gated like the export: the creator or an Admin, anyone else gets a 404.
+func HandleQuizDuplicate(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		quizID, ok := handlers.ParseIDFromPath(w, r, logger, "quizID")
+		if !ok {
+			return
+		}
+
+		src, ok := requireQuizOwner(w, r, logger, csrfMgr, quizStore, quizID)
+		if !ok {
+			return
+		}
+		actor, ok := auth.PlayerFromContext(r.Context())
+		if !ok {
+			logger.ErrorContext(r.Context(), "missing player on context for quiz duplicate")
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		cp, err := duplicateQuiz(r.Context(), quizStore, src, actor.ID)
+		if err != nil {
+			if errors.Is(err, errDuplicateCopiesExhausted) {
+				render409(w, r, logger, csrfMgr,
+					"This quiz has too many copies already. Rename or delete one and try again.")
+
+				return
+			}
+			logger.ErrorContext(r.Context(), "error duplicating quiz", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		http.Redirect(w, r, "/admin/quizzes/"+strconv.FormatInt(cp.ID, 10), http.StatusSeeOther)
+	})
+}
+
+// duplicateQuiz stores a copy of src owned by ownerID under the first free
+// copy title and returns it.
+func duplicateQuiz(ctx context.Context, quizStore quiz.Store, src *quiz.Quiz, ownerID int64) (*quiz.Quiz, error) {
+	rounds, err := quizStore.ListRoundsByQuiz(ctx, src.ID)
+	if err != nil {
+		return nil, fmt.Errorf("loading rounds for quiz %d duplicate: %w", src.ID, err)
+	}
+
+	for n := 1; n <= maxDuplicateCopies; n++ {
+		// Rebuilt per attempt: a create stamps ids onto the quiz it is given.
+		cp := copyQuiz(src, rounds, duplicateTitle(src.Title, n), ownerID)
+		err = storeQuiz(ctx, quizStore, cp)
+		if errors.Is(err, quiz.ErrSlugTaken) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return cp, nil
+	}
+
+	return nil, errDuplicateCopiesExhausted
+}
+
+// duplicateTitle is the title of the n-th copy attempt; its slug is the
+// original's with a "-copy" (then "-copy-n") suffix.
+func duplicateTitle(title string, n int) string {
+	if n == 1 {
+		return title + " (copy)"
+	}
+
+	return fmt.Sprintf("%s (copy %d)", title, n)
+}
+
+// copyQuiz builds an unsaved draft with src's settings and content under the
+// given title. Every round is authored explicitly so the copy keeps the
+// original's round titles, summaries and boundary windows; each round carries
+// its questions, which keep their quiz-wide positions.
+func copyQuiz(src *quiz.Quiz, rounds []*quiz.Round, title string, ownerID int64) *quiz.Quiz {
+	cp := &quiz.Quiz{
+		Title:               title,
+		Slug:                slug.Make(title),
+		Description:         src.Description,
+		CreatedByPlayerID:   ownerID,
+		TimeLimitSeconds:    src.TimeLimitSeconds,
+		Visibility:          src.Visibility,
+		Mode:                src.Mode,
+		Language:            src.Language,
+		ShuffleQuestions:    src.ShuffleQuestions,
+		KeepOptionOrder:     src.KeepOptionOrder,
+		LateJoin:            src.LateJoin,
+		JoinDeadlineSeconds: src.JoinDeadlineSeconds,
+		MaxPlayers:          src.MaxPlayers,
+		ConfidenceWager:     src.ConfidenceWager,
+		HostPaced:           src.HostPaced,
+		Scoring:             src.Scoring,
+		WrongAnswerPenalty:  src.WrongAnswerPenalty,
+		ScoreFloor:          src.ScoreFloor,
+	}
+
+	byRound := make(map[int64][]*quiz.Question, len(rounds))
+	for _, q := range src.Questions {
+		byRound[q.RoundID] = append(byRound[q.RoundID], copyQuestion(q))
+	}
+
+	cp.Rounds = make([]*quiz.Round, 0, len(rounds))
+	for _, rnd := range rounds {
+		cp.Rounds = append(cp.Rounds, &quiz.Round{
+			Title:                   rnd.Title,
+			Summary:                 rnd.Summary,
+			BoundaryDurationSeconds: copyIntPtr(rnd.BoundaryDurationSeconds),
+			Questions:               byRound[rnd.ID],
+		})
+		cp.Questions = append(cp.Questions, byRound[rnd.ID]...)
+	}
+
+	return cp
+}
+
+// copyQuestion copies a question and its options without their ids, media
+// or ordering constraint.
+func copyQuestion(q *quiz.Question) *quiz.Question {
+	cp := &quiz.Question{
+		Text:             q.Text,
+		Kind:             q.Kind,
+		Position:         q.Position,
+		TimeLimitSeconds: copyIntPtr(q.TimeLimitSeconds),
+		Options:          make([]*quiz.Option, 0, len(q.Options)),
+	}
+	for _, o := range q.Options {
+		opt := &quiz.Option{
+			Text:           o.Text,
+			Correct:        o.Correct,
+			ToleranceBelow: o.ToleranceBelow,
+			ToleranceAbove: o.ToleranceAbove,
+		}
+		if o.NumericValue != nil {
+			v := *o.NumericValue
+			opt.NumericValue = &v
+		}
+		cp.Options = append(cp.Options, opt)
+	}
+
+	return cp
+}
+
+// copyIntPtr returns a pointer to a copy of *p, or nil.
+func copyIntPtr(p *int) *int {
+	if p == nil {
+		return nil
+	}
+	v := *p
+
+	return &v
+}
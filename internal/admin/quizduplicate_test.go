@@ -0,0 +1,211 @@
+package admin_test
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// twoRoundQuiz is a published quiz authored in two rounds, the second holding
+// a numeric question, so a duplicate has rounds, round settings and an answer
+// key to carry over.
+func twoRoundQuiz(title, slug string) *quiz.Quiz {
+	boundary := 12
+	limit := 25
+	qz := ownedQuiz(title, slug)
+	qz.Published = true
+	qz.ShuffleQuestions = true
+	qz.Rounds = []*quiz.Round{
+		{
+			Title: "Warm-up",
+			Questions: []*quiz.Question{{
+				Text:     "What is the capital of France?",
+				Position: 1,
+				Options: []*quiz.Option{
+					{Text: "Paris", Correct: true},
+					{Text: "London"},
+				},
+			}},
+		},
+		{
+			Title:                   "Finale",
+			Summary:                 "Numbers only",
+			BoundaryDurationSeconds: &boundary,
+			Questions: []*quiz.Question{{
+				Text:             "How many moons does Mars have?",
+				Kind:             quiz.KindNumeric,
+				Position:         2,
+				TimeLimitSeconds: &limit,
+				Options:          []*quiz.Option{quiz.NewNumericKey(2, 0, 1)},
+			}},
+		},
+	}
+
+	return qz
+}
+
+func duplicateRequest(t *testing.T, quizID int64, player *auth.Player) *http.Request {
+	t.Helper()
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/quizzes/1/duplicate", nil)
+	req.SetPathValue("quizID", strconv.FormatInt(quizID, 10))
+
+	return req.WithContext(auth.WithPlayer(req.Context(), player))
+}
+
+// duplicatedQuiz reads the quiz a duplicate redirected to.
+func duplicatedQuiz(t *testing.T, env *adminEnv, rr *httptest.ResponseRecorder) *quiz.Quiz {
+	t.Helper()
+
+	if got, want := rr.Code, http.StatusSeeOther; got != want {
+		t.Fatalf("status = %d, want %d", got, want)
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(rr.Header().Get("Location"), "/admin/quizzes/"), 10, 64)
+	if err != nil {
+		t.Fatalf("Location = %q, want /admin/quizzes/{id}", rr.Header().Get("Location"))
+	}
+	cp, err := env.quizzes.GetQuiz(t.Context(), id)
+	if err != nil {
+		t.Fatalf("GetQuiz(%d) err = %v, want nil", id, err)
+	}
+
+	return cp
+}
+
+func TestHandleQuizDuplicate(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.DiscardHandler)
+	admin := &auth.Player{ID: testAdminID, DisplayName: "admin", Role: auth.RoleAdmin}
+
+	t.Run("copies the quiz into a new draft", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		src := env.seedQuiz(t, twoRoundQuiz("Pub Quiz", "pub-quiz"))
+
+		rr := httptest.NewRecorder()
+		HandleQuizDuplicate(logger, nil, env.quizzes).ServeHTTP(rr, duplicateRequest(t, src.ID, admin))
+		cp := duplicatedQuiz(t, env, rr)
+
+		if cp.ID == src.ID {
+			t.Fatal("duplicate redirected to the original quiz")
+		}
+		if got, want := cp.Title, "Pub Quiz (copy)"; got != want {
+			t.Errorf("Title = %q, want %q", got, want)
+		}
+		if got, want := cp.Slug, "pub-quiz-copy"; got != want {
+			t.Errorf("Slug = %q, want %q", got, want)
+		}
+		if cp.Published {
+			t.Error("copy Published = true, want a draft")
+		}
+		if !cp.ShuffleQuestions {
+			t.Error("copy ShuffleQuestions = false, want the original's setting")
+		}
+		if got, want := len(cp.Questions), 2; got != want {
+			t.Fatalf("copy has %d questions, want %d", got, want)
+		}
+		if got, want := cp.Questions[0].Options[0].Text, "Paris"; got != want {
+			t.Errorf("first option = %q, want %q", got, want)
+		}
+		if key := cp.Questions[1].NumericKey(); key == nil || *key.NumericValue != 2 || key.ToleranceAbove != 1 {
+			t.Errorf("numeric key = %+v, want value 2 with tolerance above 1", key)
+		}
+		if got := cp.Questions[1].TimeLimitSeconds; got == nil || *got != 25 {
+			t.Errorf("question time limit = %v, want 25", got)
+		}
+
+		rounds, err := env.quizzes.ListRoundsByQuiz(t.Context(), cp.ID)
+		if err != nil {
+			t.Fatalf("ListRoundsByQuiz err = %v, want nil", err)
+		}
+		if got, want := len(rounds), 2; got != want {
+			t.Fatalf("copy has %d rounds, want %d", got, want)
+		}
+		if got, want := rounds[1].Title, "Finale"; got != want {
+			t.Errorf("second round title = %q, want %q", got, want)
+		}
+		if got := rounds[1].BoundaryDurationSeconds; got == nil || *got != 12 {
+			t.Errorf("second round boundary = %v, want 12", got)
+		}
+		if got, want := cp.Questions[1].RoundID, rounds[1].ID; got != want {
+			t.Errorf("numeric question round = %d, want the copied Finale round %d", got, want)
+		}
+
+		orig, err := env.quizzes.GetQuiz(t.Context(), src.ID)
+		if err != nil {
+			t.Fatalf("GetQuiz(original) err = %v, want nil", err)
+		}
+		if !orig.Published || len(orig.Questions) != 2 {
+			t.Errorf("original changed: published=%v questions=%d", orig.Published, len(orig.Questions))
+		}
+	})
+
+	t.Run("counts up while the copy slug is taken", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		src := env.seedQuiz(t, twoQuestionQuiz("Weekly", "weekly"))
+		env.seedQuiz(t, ownedQuiz("Weekly (copy)", "weekly-copy"))
+
+		rr := httptest.NewRecorder()
+		HandleQuizDuplicate(logger, nil, env.quizzes).ServeHTTP(rr, duplicateRequest(t, src.ID, admin))
+		cp := duplicatedQuiz(t, env, rr)
+
+		if got, want := cp.Slug, "weekly-copy-2"; got != want {
+			t.Errorf("Slug = %q, want %q", got, want)
+		}
+		if got, want := cp.Title, "Weekly (copy 2)"; got != want {
+			t.Errorf("Title = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("the copy belongs to the host who made it", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		hostID := env.seedHostPlayer(t, "host", "host@example.com")
+		qz := twoQuestionQuiz("Hosted", "hosted")
+		qz.CreatedByPlayerID = hostID
+		src := env.seedQuiz(t, qz)
+
+		host := &auth.Player{ID: hostID, DisplayName: "host", Role: auth.RoleHost}
+		rr := httptest.NewRecorder()
+		HandleQuizDuplicate(logger, nil, env.quizzes).ServeHTTP(rr, duplicateRequest(t, src.ID, host))
+		cp := duplicatedQuiz(t, env, rr)
+
+		if got, want := cp.CreatedByPlayerID, hostID; got != want {
+			t.Errorf("CreatedByPlayerID = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("another host's quiz is a 404", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		src := env.seedQuiz(t, twoQuestionQuiz("Private", "private"))
+		hostID := env.seedHostPlayer(t, "other", "other@example.com")
+
+		host := &auth.Player{ID: hostID, DisplayName: "other", Role: auth.RoleHost}
+		rr := httptest.NewRecorder()
+		HandleQuizDuplicate(logger, nil, env.quizzes).ServeHTTP(rr, duplicateRequest(t, src.ID, host))
+
+		if got, want := rr.Code, http.StatusNotFound; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		all, err := env.quizzes.ListQuizzes(t.Context())
+		if err != nil {
+			t.Fatalf("ListQuizzes err = %v, want nil", err)
+		}
+		if got, want := len(all), 1; got != want {
+			t.Errorf("quiz count = %d, want %d (no copy)", got, want)
+		}
+	})
+}
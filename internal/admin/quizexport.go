@@ -10,7 +10,6 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
-	"os"
 	"path"
 	"strconv"
 
@@ -26,11 +25,11 @@ const archiveDecimalBase = 10
 // MediaArchiver is the slice of the media service the exporter needs: resolve
 // a media row's metadata and open its stored full file for reading. Defined
 // consumer-side so the admin package depends only on the two reads it makes;
-// the concrete *media.Service satisfies it. Open returns the concrete
-// [os.File] the service hands back; the exporter only reads and closes it.
+// the concrete *media.Service satisfies it. The exporter only reads and
+// closes the reader Open hands back.
 type MediaArchiver interface {
 	Get(ctx context.Context, id int64) (*media.Media, error)
-	Open(relPath string) (*os.File, error)
+	Open(ctx context.Context, relPath string) (io.ReadCloser, error)
 }
 
 // archiveExtForMedia returns the archive filename extension for a stored media
@@ -70,16 +69,16 @@ func archiveMediaPath(m *media.Media) string {
 	return "media/" + strconv.FormatInt(m.ID, archiveDecimalBase) + archiveExtForMedia(m)
 }
 
-// quizSlugFilename returns the download filename for a quiz archive: its slug
-// plus ".zip", falling back to the quiz id when the slug is somehow empty so
-// the Content-Disposition header always names a file.
-func quizSlugFilename(qz *quiz.Quiz) string {
+// quizSlugFilename returns the download filename for a quiz export: its slug
+// plus ext (".zip" or ".json"), falling back to the quiz id when the slug is
+// somehow empty so the Content-Disposition header always names a file.
+func quizSlugFilename(qz *quiz.Quiz, ext string) string {
 	slug := qz.Slug
 	if slug == "" {
 		slug = "quiz-" + strconv.FormatInt(qz.ID, archiveDecimalBase)
 	}
 
-	return slug + ".zip"
+	return slug + ext
 }
 
 // defaultExportRoundTitle is the title the store stamps on every quiz's
@@ -130,13 +129,24 @@ func (b *manifestBuilder) build(
 	ctx context.Context, qz *quiz.Quiz, rounds []*quiz.Round,
 ) (quizArchiveManifest, error) {
 	manifest := quizArchiveManifest{
-		FormatVersion:    archiveFormatVersion,
-		Title:            qz.Title,
-		Description:      qz.Description,
-		TimeLimitSeconds: timeLimitPtr(qz.TimeLimitSeconds),
-		Visibility:       qz.Visibility,
-		Mode:             qz.Mode,
-		Language:         qz.Language,
+		FormatVersion:       archiveFormatVersion,
+		Title:               qz.Title,
+		Description:         qz.Description,
+		TimeLimitSeconds:    timeLimitPtr(qz.TimeLimitSeconds),
+		Visibility:          qz.Visibility,
+		Mode:                string(qz.Mode),
+		Language:            qz.Language,
+		ShuffleQuestions:    qz.ShuffleQuestions,
+		KeepOptionOrder:     qz.KeepOptionOrder,
+		LateJoin:            exportedLateJoin(qz.LateJoin),
+		JoinDeadlineSeconds: qz.JoinDeadlineSeconds,
+		MaxPlayers:          qz.MaxPlayers,
+		ConfidenceWager:     qz.ConfidenceWager,
+		HostPaced:           qz.HostPaced,
+		Scoring:             exportedScoring(qz.Scoring),
+		WrongAnswerPenalty:  qz.WrongAnswerPenalty,
+		ScoreFloor:          qz.ScoreFloor,
+		ExternalRef:         qz.ExternalRef,
 	}
 
 	byRound := make(map[int64][]*quiz.Question, len(rounds))
@@ -199,9 +209,21 @@ func (b *manifestBuilder) question(ctx context.Context, q *quiz.Question) (quizA
 		return quizArchiveQuestion{}, err
 	}
 
+	var kind string
+	var answer *quizArchiveNumericAnswer
 	options := make([]quizArchiveOption, 0, len(q.Options))
-	for _, o := range q.Options {
-		options = append(options, quizArchiveOption{Text: o.Text, Correct: o.Correct})
+	if key := q.NumericKey(); key != nil {
+		kind = quiz.KindNumeric
+		answer = &quizArchiveNumericAnswer{
+			Value:          *key.NumericValue,
+			ToleranceBelow: key.ToleranceBelow,
+			ToleranceAbove: key.ToleranceAbove,
+		}
+	} else {
+		kind = exportedKind(q.Kind)
+		for _, o := range q.Options {
+			options = append(options, quizArchiveOption{Text: o.Text, Correct: o.Correct})
+		}
 	}
 
 	return quizArchiveQuestion{
@@ -209,7 +231,12 @@ func (b *manifestBuilder) question(ctx context.Context, q *quiz.Question) (quizA
 		TimeLimitSeconds: q.TimeLimitSeconds,
 		Image:            imageRef,
 		Audio:            audioRef,
+		Kind:             kind,
+		Answer:           answer,
 		Options:          options,
+		Explanation:      q.Explanation,
+		Hint:             q.Hint,
+		ExternalRef:      q.ExternalRef,
 	}, nil
 }
 
@@ -292,7 +319,7 @@ func writeQuizArchive(
 	if err = writeManifestEntry(zw, manifest); err != nil {
 		return err
 	}
-	if err = writeMediaEntries(zw, mediaSvc, builder.media); err != nil {
+	if err = writeMediaEntries(ctx, zw, mediaSvc, builder.media); err != nil {
 		return err
 	}
 	if err = zw.Close(); err != nil {
@@ -322,9 +349,11 @@ func writeManifestEntry(zw *zip.Writer, manifest quizArchiveManifest) error {
 // writeMediaEntries copies each unique referenced media's full file into the
 // archive. Export the full file (m.Path), not the thumbnail: import
 // regenerates the thumbnail from the original.
-func writeMediaEntries(zw *zip.Writer, mediaSvc MediaArchiver, items map[int64]*media.Media) error {
+func writeMediaEntries(
+	ctx context.Context, zw *zip.Writer, mediaSvc MediaArchiver, items map[int64]*media.Media,
+) error {
 	for _, m := range items {
-		if err := writeMediaEntry(zw, mediaSvc, m); err != nil {
+		if err := writeMediaEntry(ctx, zw, mediaSvc, m); err != nil {
 			return err
 		}
 	}
@@ -334,8 +363,8 @@ func writeMediaEntries(zw *zip.Writer, mediaSvc MediaArchiver, items map[int64]*
 
 // writeMediaEntry copies one media file's bytes into the archive at its
 // id-keyed path.
-func writeMediaEntry(zw *zip.Writer, mediaSvc MediaArchiver, m *media.Media) error {
-	src, err := mediaSvc.Open(m.Path)
+func writeMediaEntry(ctx context.Context, zw *zip.Writer, mediaSvc MediaArchiver, m *media.Media) error {
+	src, err := mediaSvc.Open(ctx, m.Path)
 	if err != nil {
 		return fmt.Errorf("opening media %d file for export: %w", m.ID, err)
 	}
@@ -354,8 +383,9 @@ func writeMediaEntry(zw *zip.Writer, mediaSvc MediaArchiver, m *media.Media) err
 
 // HandleQuizExport returns the per-quiz export handler. It loads the quiz,
 // enforces the same creator-or-admin edit gate the quiz view applies, then
-// streams a .zip bundling the quiz manifest and its referenced media. The
-// archive is buffered before any header is written so a build failure returns
+// streams a .zip bundling the quiz manifest and its referenced media, or, with
+// ?format=json, the bare JSON document the paste/upload import accepts (see
+// [writeQuizJSON]). The archive is buffered before any header is written so a build failure returns
 // a clean 500 rather than a truncated body; quiz archives are admin-only and
 // size-bounded, so buffering in memory is acceptable.
 func HandleQuizExport(logger *slog.Logger, quizStore quiz.Store, mediaSvc MediaArchiver) http.Handler {
@@ -387,6 +417,12 @@ func HandleQuizExport(logger *slog.Logger, quizStore quiz.Store, mediaSvc MediaA
 			return
 		}
 
+		if r.URL.Query().Get("format") == exportFormatJSON {
+			serveQuizJSON(w, r, logger, quizStore, qz)
+
+			return
+		}
+
 		var buf bytes.Buffer
 		if err = writeQuizArchive(r.Context(), &buf, quizStore, mediaSvc, quizID); err != nil {
 			logger.ErrorContext(r.Context(), "error building quiz archive", slog.Any("err", err))
@@ -396,7 +432,7 @@ func HandleQuizExport(logger *slog.Logger, quizStore quiz.Store, mediaSvc MediaA
 		}
 
 		w.Header().Set("Content-Type", "application/zip")
-		w.Header().Set("Content-Disposition", "attachment; filename=\""+quizSlugFilename(qz)+"\"")
+		w.Header().Set("Content-Disposition", "attachment; filename=\""+quizSlugFilename(qz, ".zip")+"\"")
 		w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
 		if _, err = w.Write(buf.Bytes()); err != nil {
 			logger.ErrorContext(r.Context(), "error writing quiz archive response", slog.Any("err", err))
@@ -103,7 +103,7 @@ func newMediaServiceOverTemp(t *testing.T, e *adminEnv) *media.Service {
 
 	return media.NewService(
 		store.NewMediaStore(e.db, slog.New(slog.DiscardHandler)),
-		t.TempDir(),
+		media.NewLocalStorage(t.TempDir()),
 		10<<20,
 		20<<20,
 		slog.New(slog.DiscardHandler),
@@ -475,7 +475,7 @@ func assertManifestHeader(t *testing.T, manifest exportManifest) {
 	if got, want := manifest.Visibility, quiz.VisibilityPublic; got != want {
 		t.Errorf("Visibility = %q, want %q", got, want)
 	}
-	if got, want := manifest.Mode, quiz.ModeLive; got != want {
+	if got, want := manifest.Mode, string(quiz.ModeLive); got != want {
 		t.Errorf("Mode = %q, want %q", got, want)
 	}
 	if got, want := manifest.Language, quiz.LanguageNL; got != want {
@@ -0,0 +1,166 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// exportFormatJSON is the ?format= value that selects the JSON export on
+// GET /admin/quizzes/{quizID}/export instead of the default .zip archive.
+const exportFormatJSON = "json"
+
+// quizImportPayloadFromQuiz is the inverse of [quizFromImportPayload]: it maps
+// a stored quiz onto the import wire shape, so the JSON export re-imports
+// through the paste/upload import unchanged. Questions are emitted in position
+// order under their rounds, with the same flat-vs-rounds decision the archive
+// export makes ([isFlatQuiz]). Images and audio are left out: the import shape
+// has no media field (#937), so a quiz's media travels only in the .zip
+// archive export. Ordering constraints (AfterQuestionID) are left out too:
+// the wire shape has no question ids for them to point at.
+func quizImportPayloadFromQuiz(qz *quiz.Quiz, rounds []*quiz.Round) quizImportPayload {
+	timeLimit := qz.TimeLimitSeconds
+	payload := quizImportPayload{
+		Title:               qz.Title,
+		Description:         qz.Description,
+		Language:            qz.Language,
+		ShuffleQuestions:    qz.ShuffleQuestions,
+		KeepOptionOrder:     qz.KeepOptionOrder,
+		LateJoin:            exportedLateJoin(qz.LateJoin),
+		JoinDeadlineSeconds: qz.JoinDeadlineSeconds,
+		MaxPlayers:          qz.MaxPlayers,
+		ConfidenceWager:     qz.ConfidenceWager,
+		HostPaced:           qz.HostPaced,
+		Scoring:             exportedScoring(qz.Scoring),
+		WrongAnswerPenalty:  qz.WrongAnswerPenalty,
+		ScoreFloor:          qz.ScoreFloor,
+		TimeLimitSeconds:    &timeLimit,
+		ExternalRef:         qz.ExternalRef,
+	}
+
+	byRound := make(map[int64][]*quiz.Question, len(rounds))
+	for _, q := range qz.Questions {
+		byRound[q.RoundID] = append(byRound[q.RoundID], q)
+	}
+
+	if isFlatQuiz(rounds) {
+		payload.Questions = importQuestionsFromQuiz(byRound[rounds[0].ID])
+
+		return payload
+	}
+
+	payload.Rounds = make([]quizImportRoundPayload, 0, len(rounds))
+	for _, rnd := range rounds {
+		payload.Rounds = append(payload.Rounds, quizImportRoundPayload{
+			Title:                   rnd.Title,
+			Summary:                 rnd.Summary,
+			BoundaryDurationSeconds: rnd.BoundaryDurationSeconds,
+			Questions:               importQuestionsFromQuiz(byRound[rnd.ID]),
+		})
+	}
+
+	return payload
+}
+
+// importQuestionsFromQuiz maps a round's questions onto their import entries.
+func importQuestionsFromQuiz(questions []*quiz.Question) []quizImportQuestionPayload {
+	out := make([]quizImportQuestionPayload, 0, len(questions))
+	for _, q := range questions {
+		entry := quizImportQuestionPayload{
+			Text:             q.Text,
+			TimeLimitSeconds: q.TimeLimitSeconds,
+			Explanation:      q.Explanation,
+			Hint:             q.Hint,
+			ExternalRef:      q.ExternalRef,
+		}
+		if key := q.NumericKey(); key != nil {
+			entry.Kind = quiz.KindNumeric
+			entry.Answer = &quizImportNumericPayload{
+				Value:          *key.NumericValue,
+				ToleranceBelow: key.ToleranceBelow,
+				ToleranceAbove: key.ToleranceAbove,
+			}
+			out = append(out, entry)
+
+			continue
+		}
+		entry.Kind = exportedKind(q.Kind)
+		entry.Options = make([]quizImportOptionPayload, 0, len(q.Options))
+		for _, o := range q.Options {
+			entry.Options = append(entry.Options, quizImportOptionPayload{Text: o.Text, Correct: o.Correct})
+		}
+		out = append(out, entry)
+	}
+
+	return out
+}
+
+// writeQuizJSON loads the quiz's rounds and encodes the import-shaped JSON
+// document for it.
+func writeQuizJSON(ctx context.Context, quizStore quiz.Store, qz *quiz.Quiz) ([]byte, error) {
+	rounds, err := quizStore.ListRoundsByQuiz(ctx, qz.ID)
+	if err != nil {
+		return nil, fmt.Errorf("loading rounds for quiz %d export: %w", qz.ID, err)
+	}
+	out, err := json.MarshalIndent(quizImportPayloadFromQuiz(qz, rounds), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding quiz %d export: %w", qz.ID, err)
+	}
+
+	return out, nil
+}
+
+// serveQuizJSON writes the JSON export as a download named after the quiz
+// slug. The caller has already loaded the quiz and applied the edit gate.
+func serveQuizJSON(w http.ResponseWriter, r *http.Request, logger *slog.Logger, quizStore quiz.Store, qz *quiz.Quiz) {
+	out, err := writeQuizJSON(r.Context(), quizStore, qz)
+	if err != nil {
+		logger.ErrorContext(r.Context(), "error building quiz JSON export", slog.Any("err", err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+quizSlugFilename(qz, ".json")+"\"")
+	w.Header().Set("Content-Length", strconv.Itoa(len(out)))
+	if _, err = w.Write(out); err != nil {
+		logger.ErrorContext(r.Context(), "error writing quiz JSON export response", slog.Any("err", err))
+	}
+}
+
+// exportedKind leaves the default choice kind out of an export, so a choice
+// question exports exactly as it did before question kinds existed.
+func exportedKind(kind string) string {
+	if quiz.NormalizedKind(kind) == quiz.KindChoice {
+		return ""
+	}
+
+	return kind
+}
+
+// exportedLateJoin leaves the default late-join policy out of an export, so a
+// quiz that never changed it exports exactly as it did before the setting
+// existed; the importer maps the omission back to the default.
+func exportedLateJoin(lateJoin string) string {
+	if quiz.NormalizedLateJoin(lateJoin) == quiz.LateJoinSkip {
+		return ""
+	}
+
+	return lateJoin
+}
+
+// exportedScoring leaves the default scoring mode out of an export, as
+// [exportedLateJoin] does the late-join policy.
+func exportedScoring(scoring string) string {
+	if quiz.NormalizedScoring(scoring) == quiz.ScoringTime {
+		return ""
+	}
+
+	return scoring
+}
@@ -0,0 +1,101 @@
+package admin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/auth"
+)
+
+// TestHandleQuizExport_JSON pins the ?format=json export: a downloadable JSON
+// document in the import shape that carries the rounds, questions, options and
+// correct flags, explanations and external references in position order, and that decodes through the importer's
+// strict decode and validation unchanged, so it re-imports. An attached image
+// is left out (the import shape has no media field, #937) rather than breaking
+// the re-import.
+func TestHandleQuizExport_JSON(t *testing.T) {
+	t.Parallel()
+
+	env := newAdminEnv(t)
+	mediaSvc := newMediaServiceOverTemp(t, env)
+	seeded := roundedQuiz()
+	seeded.Rounds[0].Questions[0].Explanation = "Paris has been the capital since 987."
+	seeded.ExternalRef = "cms-capitals"
+	seeded.Rounds[0].Questions[0].ExternalRef = "cms-france"
+	qz := env.seedQuiz(t, seeded)
+	img, err := mediaSvc.StoreImage(t.Context(), qz.ID, testExportPlayerID, "pic.png", bytes.NewReader(tinyPNG(t)))
+	if err != nil {
+		t.Fatalf("StoreImage err = %v, want nil", err)
+	}
+	attachMedia(t, env, qz.Rounds[0].Questions[0], img.ID, 0, false)
+
+	req := exportRequest(t, qz.ID, &auth.Player{ID: testAdminID, Role: auth.RoleAdmin})
+	req.URL.RawQuery = "format=json"
+	rr := httptest.NewRecorder()
+	HandleQuizExport(env.logger, env.quizzes, mediaSvc).ServeHTTP(rr, req)
+
+	if got, want := rr.Code, http.StatusOK; got != want {
+		t.Fatalf("status = %d, want %d", got, want)
+	}
+	if got, want := rr.Header().Get("Content-Type"), "application/json"; got != want {
+		t.Errorf("Content-Type = %q, want %q", got, want)
+	}
+	if got, want := rr.Header().Get("Content-Disposition"), `attachment; filename="capitals.json"`; got != want {
+		t.Errorf("Content-Disposition = %q, want %q", got, want)
+	}
+
+	var payload QuizImportPayload
+	dec := json.NewDecoder(rr.Body)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&payload); err != nil {
+		t.Fatalf("decoding export err = %v, want nil", err)
+	}
+
+	if got, want := len(payload.Rounds), 2; got != want {
+		t.Fatalf("len(Rounds) = %d, want %d", got, want)
+	}
+	first := payload.Rounds[0]
+	if got, want := first.Questions[0].Text, "Capital of France?"; got != want {
+		t.Errorf("Rounds[0].Questions[0].Text = %q, want %q", got, want)
+	}
+	if got, want := first.Questions[1].Text, "Capital of Spain?"; got != want {
+		t.Errorf("Rounds[0].Questions[1].Text = %q, want %q", got, want)
+	}
+	if opt := first.Questions[0].Options[0]; opt.Text != "Paris" || !opt.Correct {
+		t.Errorf("Rounds[0].Questions[0].Options[0] = %+v, want Paris marked correct", opt)
+	}
+	if got, want := first.Questions[0].Explanation, "Paris has been the capital since 987."; got != want {
+		t.Errorf("Rounds[0].Questions[0].Explanation = %q, want %q", got, want)
+	}
+	if got, want := payload.ExternalRef, "cms-capitals"; got != want {
+		t.Errorf("ExternalRef = %q, want %q", got, want)
+	}
+	if got, want := first.Questions[0].ExternalRef, "cms-france"; got != want {
+		t.Errorf("Rounds[0].Questions[0].ExternalRef = %q, want %q", got, want)
+	}
+	if got := payload.Rounds[1].BoundaryDurationSeconds; got == nil || *got != 15 {
+		t.Errorf("Rounds[1].BoundaryDurationSeconds = %v, want 15", got)
+	}
+
+	reimported, err := QuizFromImportPayload(payload)
+	if err != nil {
+		t.Fatalf("QuizFromImportPayload err = %v, want nil", err)
+	}
+	reimported.Mode = qz.Mode
+	if problems := ValidateQuizForm(t.Context(), reimported); len(problems) > 0 {
+		t.Errorf("ValidateQuizForm problems = %v, want none", problems)
+	}
+	if got, want := reimported.TimeLimitSeconds, 12; got != want {
+		t.Errorf("re-imported TimeLimitSeconds = %d, want %d", got, want)
+	}
+	if got, want := reimported.Rounds[0].Questions[0].Explanation, first.Questions[0].Explanation; got != want {
+		t.Errorf("re-imported Explanation = %q, want %q", got, want)
+	}
+	if got, want := reimported.Rounds[0].Questions[0].ExternalRef, "cms-france"; got != want {
+		t.Errorf("re-imported ExternalRef = %q, want %q", got, want)
+	}
+}
@@ -1,11 +1,15 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"mime"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gosimple/slug"
@@ -13,6 +17,7 @@ import (
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/render"
 )
 
 // quizImportPayload mirrors the JSON shape an admin pastes into the import
@@ -27,11 +32,44 @@ type quizImportPayload struct {
 	// Optional - omitted maps to [quiz.LanguageEN]; an unrecognised value is
 	// surfaced by quizForm.Valid.
 	Language string `json:"language,omitempty"`
+	// ShuffleQuestions and KeepOptionOrder are the quiz's randomization
+	// modes. Optional - omitted leaves the question order positional and
+	// the option buttons shuffled, as for a new quiz in the admin form.
+	ShuffleQuestions bool `json:"shuffleQuestions,omitempty"`
+	KeepOptionOrder  bool `json:"keepOptionOrder,omitempty"`
+	// LateJoin and JoinDeadlineSeconds are the live-game late-join settings:
+	// "skip", "zero" or "closed", and the seconds after the start late joins
+	// stay open (0 for the whole game). Optional - omitted maps to "skip"
+	// with no deadline; an unrecognised value is surfaced by quizForm.Valid.
+	LateJoin            string `json:"lateJoin,omitempty"`
+	JoinDeadlineSeconds int    `json:"joinDeadlineSeconds,omitempty"`
+	// MaxPlayers caps how many players a live room admits for this quiz.
+	// Optional - omitted (0) defers to the server default.
+	MaxPlayers int `json:"maxPlayers,omitempty"`
+	// ConfidenceWager has solo players stake 1-3 on each answer before
+	// seeing the options. Optional - omitted leaves it off.
+	ConfidenceWager bool `json:"confidenceWager,omitempty"`
+	// HostPaced hides correctness and points from solo players until the
+	// quiz's host reveals the scores. Optional - omitted leaves it off.
+	HostPaced bool `json:"hostPaced,omitempty"`
+	// Scoring is how answers earn points in solo games: "time", "flat" or
+	// "streak". Optional - omitted maps to "time"; an unrecognised value is
+	// surfaced by quizForm.Valid.
+	Scoring string `json:"scoring,omitempty"`
+	// WrongAnswerPenalty is the points a wrong answer costs in solo games,
+	// 0-1000, and ScoreFloor the lowest a game total can fall to, at most 0.
+	// Optional - omitted leaves negative marking off and totals floored at 0.
+	WrongAnswerPenalty int `json:"wrongAnswerPenalty,omitempty"`
+	ScoreFloor         int `json:"scoreFloor,omitempty"`
 	// TimeLimitSeconds is the per-quiz default answer window (#99).
 	// Optional in the payload - omitted maps to
 	// [quiz.DefaultTimeLimitSeconds], matching the admin form's
 	// new-quiz default.
 	TimeLimitSeconds *int `json:"timeLimitSeconds,omitempty"`
+	// ExternalRef is the author's own id for the quiz, e.g. its CMS key.
+	// Optional; when present a re-import finds the quiz by it rather than
+	// by slug.
+	ExternalRef string `json:"externalRef,omitempty"`
 	// Questions and Rounds are mutually exclusive (#546). Supply
 	// Questions for a flat quiz (every question lands in the default
 	// round, the original behaviour) or Rounds to author named rounds
@@ -58,8 +96,22 @@ type quizImportQuestionPayload struct {
 	// TimeLimitSeconds overrides the quiz default for this question
 	// (#99). Optional - omitted means "inherit the quiz value at
 	// game time", same as leaving the admin form's field blank.
-	TimeLimitSeconds *int                      `json:"timeLimitSeconds,omitempty"`
-	Options          []quizImportOptionPayload `json:"options"`
+	TimeLimitSeconds *int `json:"timeLimitSeconds,omitempty"`
+	// Kind is "choice" (the default when omitted), "multi", "truefalse" or
+	// "numeric". A numeric question carries its answer key in Answer and no
+	// Options; Answer is ignored on the other kinds.
+	Kind    string                    `json:"kind,omitempty"`
+	Answer  *quizImportNumericPayload `json:"answer,omitempty"`
+	Options []quizImportOptionPayload `json:"options,omitempty"`
+	// Explanation is the optional "why this is correct" note shown to the
+	// player once they have answered.
+	Explanation string `json:"explanation,omitempty"`
+	// Hint is the optional nudge a player may take before answering, at the
+	// cost of part of the answer's points.
+	Hint string `json:"hint,omitempty"`
+	// ExternalRef is the author's own id for the question, unique within
+	// the quiz. Optional; a re-import pairs questions by it before text.
+	ExternalRef string `json:"externalRef,omitempty"`
 }
 
 type quizImportOptionPayload struct {
@@ -67,6 +119,15 @@ type quizImportOptionPayload struct {
 	Correct bool   `json:"correct"`
 }
 
+// quizImportNumericPayload is a numeric question's answer key; see
+// [quiz.NumericCredit] for how the tolerances score. Omitted tolerances
+// are zero: only the exact value scores from that side.
+type quizImportNumericPayload struct {
+	Value          float64 `json:"value"`
+	ToleranceBelow float64 `json:"toleranceBelow,omitempty"`
+	ToleranceAbove float64 `json:"toleranceAbove,omitempty"`
+}
+
 // quizImportExample is the JSON block rendered on the import page so the
 // admin can copy it into a chat with Claude (or any LLM), have it generate
 // a quiz, and paste the result back. Kept here as a const string rather
@@ -89,7 +150,8 @@ const quizImportExample = `{
             { "text": "Budapest",   "correct": false },
             { "text": "Prague",     "correct": true  },
             { "text": "Warsaw",     "correct": false }
-          ]
+          ],
+          "explanation": "Prague straddles the Vltava on its way north to the Elbe."
         },
         {
           "text": "Which of these is a capital city?",
@@ -130,18 +192,42 @@ const quizImportExample = `{
 // selector forces an explicit choice (#752). ModeOptions feeds the
 // selector with the recognised play modes.
 type quizImportPageData struct {
-	Title       string
-	JSON        string
-	Example     string
-	Error       string
+	Title   string
+	JSON    string
+	Example string
+	Error   string
+	// Problems lists the per-field validation failures in rule order, each
+	// with the path of the JSON field it belongs to, so an offline-authored
+	// file with several mistakes can be fixed in one pass. Empty unless Error
+	// is a validation failure.
+	Problems    ValidationErrors
 	Mode        string
-	ModeOptions []string
+	ModeOptions []quiz.Mode
 	// VisibilityOptions feeds the archive-import form's visibility override
 	// selector (#1113). It is empty on the paste-JSON render, which has no
 	// visibility selector; the template only iterates it inside the archive
 	// form. The archive form's default option ("use the archive's visibility")
 	// is rendered statically, so this is just the explicit overrides.
 	VisibilityOptions []string
+	// Reimport is the diff preview and merge/replace choice shown when the
+	// imported title collides with a quiz the session player can edit. Nil
+	// on every other render.
+	Reimport *quizReimport
+}
+
+// newQuizImportPageData is the import page re-rendered after a failed
+// submit, with the submitted JSON and play mode kept.
+func newQuizImportPageData(jsonText, mode, msg string, problems ValidationErrors) quizImportPageData {
+	return quizImportPageData{
+		Title:             "Admin Dashboard - Import Quiz",
+		JSON:              jsonText,
+		Example:           quizImportExample,
+		Error:             msg,
+		Problems:          problems,
+		Mode:              mode,
+		ModeOptions:       quiz.ModeValues(),
+		VisibilityOptions: quiz.VisibilityValues(),
+	}
 }
 
 // HandleQuizImportForm renders the JSON-import page. The textarea is empty
@@ -160,28 +246,16 @@ func HandleQuizImportForm(logger *slog.Logger, csrfMgr *csrf.Manager) http.Handl
 	})
 }
 
-// HandleQuizImportSave parses the JSON pasted into the import form, builds
-// a fresh quiz.Quiz from it, and persists via the existing store path so
+// HandleQuizImportSave parses the JSON pasted into the import form (or the
+// .json file uploaded alongside it), builds a fresh quiz.Quiz from it, and persists via the existing store path so
 // the resulting row is indistinguishable from one created via the regular
 // quiz form. Validation errors re-render the form with the submitted JSON
-// preserved so the admin can fix the payload without re-pasting.
+// preserved so the admin can fix the payload without re-pasting. A request
+// that accepts application/json gets the failed rules as structured JSON
+// instead, and a 201 with the new quiz's id on success.
 func HandleQuizImportSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
 	renderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/quizimport.gohtml")
-
-	renderStatus := func(w http.ResponseWriter, r *http.Request, status int, jsonText, mode, msg string) {
-		renderer.Render(w, r, status, quizImportPageData{
-			Title:             "Admin Dashboard - Import Quiz",
-			JSON:              jsonText,
-			Example:           quizImportExample,
-			Error:             msg,
-			Mode:              mode,
-			ModeOptions:       quiz.ModeValues(),
-			VisibilityOptions: quiz.VisibilityValues(),
-		})
-	}
-	renderErr := func(w http.ResponseWriter, r *http.Request, jsonText, mode, msg string) {
-		renderStatus(w, r, http.StatusBadRequest, jsonText, mode, msg)
-	}
+	renderErr := newImportErrRenderer(logger, csrfMgr)
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		parsed, ok := parseImportPayload(w, r, logger, renderErr)
@@ -196,14 +270,8 @@ func HandleQuizImportSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore
 		}
 
 		if err := storeQuiz(r.Context(), quizStore, parsed.Quiz); err != nil {
-			if errors.Is(err, quiz.ErrSlugTaken) {
-				// Same slug-derivation rule applies on the import path
-				// (#293): re-render at 409 with the JSON intact so the
-				// admin can rename and resubmit without re-pasting.
-				renderStatus(
-					w, r, http.StatusConflict, parsed.JSONText, parsed.Quiz.Mode,
-					"A quiz with this title already exists - change the title in the JSON and resubmit.",
-				)
+			if errors.Is(err, quiz.ErrSlugTaken) || errors.Is(err, quiz.ErrExternalRefTaken) {
+				renderQuizTaken(w, r, logger, csrfMgr, renderer, quizStore, parsed, err)
 
 				return
 			}
@@ -213,10 +281,73 @@ func HandleQuizImportSave(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore
 			return
 		}
 
-		http.Redirect(w, r, fmt.Sprintf("/admin/quizzes/%d", parsed.Quiz.ID), http.StatusSeeOther)
+		writeImportDone(w, r, http.StatusCreated, parsed.Quiz.ID)
 	})
 }
 
+// importErrRenderer reports a failed paste import: msg and, for a quiz the
+// form rules reject, the problems.
+type importErrRenderer func(
+	w http.ResponseWriter, r *http.Request, jsonText, mode, msg string, problems ...ValidationError,
+)
+
+// newImportErrRenderer returns the importErrRenderer shared by the import
+// and re-import handlers: the import page re-rendered at 400 with the
+// submitted JSON kept, or the JSON error for a request that wants one.
+func newImportErrRenderer(logger *slog.Logger, csrfMgr *csrf.Manager) importErrRenderer {
+	renderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/quizimport.gohtml")
+
+	return func(w http.ResponseWriter, r *http.Request, jsonText, mode, msg string, problems ...ValidationError) {
+		if wantsJSON(r) {
+			writeImportErrorJSON(w, http.StatusBadRequest, msg, problems)
+
+			return
+		}
+		renderer.Render(w, r, http.StatusBadRequest, newQuizImportPageData(jsonText, mode, msg, problems))
+	}
+}
+
+// renderQuizTaken re-renders the import page at 409 when the imported
+// title derives the slug of an existing quiz (#293), or its external
+// reference is an existing quiz's (err is [quiz.ErrExternalRefTaken]), with
+// the JSON intact so the admin can rename and resubmit without re-pasting.
+// When the session player can edit that quiz, the page also previews what
+// importing over it would change and offers to merge into it or replace it.
+// A JSON request gets the 409 with the title or reference flagged instead.
+func renderQuizTaken(
+	w http.ResponseWriter, r *http.Request, logger *slog.Logger, csrfMgr *csrf.Manager,
+	renderer *render.Renderer, quizStore quiz.Store, parsed parsedImport, err error,
+) {
+	field, what, fix := "title", "title", "change the title in the JSON"
+	if errors.Is(err, quiz.ErrExternalRefTaken) {
+		field, what, fix = "externalref", "external reference", "change the external reference in the JSON"
+	}
+	if wantsJSON(r) {
+		msg := "a quiz with this " + what + " already exists"
+		writeImportErrorJSON(w, http.StatusConflict, msg, ValidationErrors{{
+			Field: field, Code: CodeTaken, Message: "A quiz with this " + what + " already exists",
+		}})
+
+		return
+	}
+	reimport, err := loadQuizReimport(r, quizStore, parsed.Quiz)
+	if err != nil {
+		logger.ErrorContext(r.Context(), "error building re-import preview", slog.Any("err", err))
+		render500(w, r, logger, csrfMgr)
+
+		return
+	}
+
+	msg := "A quiz with this " + what + " already exists - " + fix + " and resubmit."
+	if reimport != nil {
+		msg = "A quiz with this " + what + " already exists - review the changes below and merge them into it or " +
+			"replace it, or " + fix + " and resubmit."
+	}
+	data := newQuizImportPageData(parsed.JSONText, string(parsed.Quiz.Mode), msg, nil)
+	data.Reimport = reimport
+	renderer.Render(w, r, http.StatusConflict, data)
+}
+
 // parsedImport holds the decoded + validated payload [parseImportPayload]
 // returns to [HandleQuizImportSave]. Bundled so the parser can return a
 // single struct (plus an ok flag) and stay under revive's
@@ -233,8 +364,7 @@ type parsedImport struct {
 // early-returns. Split out so [HandleQuizImportSave] stays under
 // revive's function-length and gocognit limits.
 func parseImportPayload(
-	w http.ResponseWriter, r *http.Request, logger *slog.Logger,
-	renderErr func(http.ResponseWriter, *http.Request, string, string, string),
+	w http.ResponseWriter, r *http.Request, logger *slog.Logger, renderErr importErrRenderer,
 ) (parsedImport, bool) {
 	r.Body = http.MaxBytesReader(w, r.Body, maxFormSize)
 	if err := r.ParseForm(); err != nil {
@@ -250,6 +380,18 @@ func parseImportPayload(
 	// way the regular quiz form does.
 	mode := r.PostFormValue("mode")
 	jsonText := r.PostFormValue("json")
+	if jsonText == "" {
+		// Nothing pasted: fall back to the uploaded file, for quizzes
+		// authored offline as a .json document.
+		text, err := readImportFile(r)
+		if err != nil {
+			logger.ErrorContext(r.Context(), "error reading import file", slog.Any("err", err))
+			renderErr(w, r, "", mode, "could not read the uploaded JSON file")
+
+			return parsedImport{}, false
+		}
+		jsonText = text
+	}
 	if !quiz.IsValidMode(mode) {
 		renderErr(w, r, stripCodeFences(jsonText), mode, "choose a play mode (solo or live) before importing")
 
@@ -257,7 +399,7 @@ func parseImportPayload(
 	}
 
 	if jsonText == "" {
-		renderErr(w, r, "", mode, "json field is required")
+		renderErr(w, r, "", mode, "paste the quiz JSON or choose a .json file to upload")
 
 		return parsedImport{}, false
 	}
@@ -265,29 +407,158 @@ func parseImportPayload(
 	// tolerate a pasted block by stripping the surrounding fences before decode.
 	jsonText = stripCodeFences(jsonText)
 
+	qz, msg, problems := decodeQuizImport(r.Context(), jsonText)
+	if msg != "" {
+		renderErr(w, r, jsonText, mode, msg, problems...)
+
+		return parsedImport{}, false
+	}
+	qz.Mode = quiz.Mode(mode)
+	// The JSON carries no mode, so quizForm.Valid could not check it above.
+	if qz.Mode == quiz.ModeLive && slices.ContainsFunc(qz.Questions, notLivePlayable) {
+		renderErr(w, r, jsonText, mode, "a live quiz cannot have numeric or select-all questions",
+			ValidationError{
+				Field:   "mode",
+				Code:    CodeNotLivePlayable,
+				Message: "A live quiz cannot have numeric or select-all questions",
+			})
+
+		return parsedImport{}, false
+	}
+
+	return parsedImport{JSONText: jsonText, Quiz: qz}, true
+}
+
+// decodeQuizImport is the lint pipeline shared by the import form and
+// [LintQuizJSON]: a strict decode (unknown fields are errors), the
+// payload-to-domain mapping, and the quiz form's field rules. On failure it
+// returns the headline message and, for field-rule failures, the problems;
+// msg is empty when the quiz is valid. The play mode is not part of the
+// JSON, so the caller checks and sets it.
+func decodeQuizImport(ctx context.Context, jsonText string) (*quiz.Quiz, string, ValidationErrors) {
 	var payload quizImportPayload
 	dec := json.NewDecoder(strings.NewReader(jsonText))
 	dec.DisallowUnknownFields()
 	if err := dec.Decode(&payload); err != nil {
-		renderErr(w, r, jsonText, mode, fmt.Sprintf("invalid JSON: %v", err))
-
-		return parsedImport{}, false
+		return nil, fmt.Sprintf("invalid JSON: %v", err), nil
 	}
 
 	qz, err := quizFromImportPayload(payload)
 	if err != nil {
-		renderErr(w, r, jsonText, mode, fmt.Sprintf("validation errors: %v", err))
+		return nil, fmt.Sprintf("validation errors: %v", err), nil
+	}
+	if problems := (&quizForm{quiz: qz}).Valid(ctx); len(problems) > 0 {
+		return nil, "validation errors: fix the problems below and resubmit", problems
+	}
 
-		return parsedImport{}, false
+	return qz, "", nil
+}
+
+// LintQuizJSON checks a quiz JSON document against the same rules the admin
+// import applies, without touching a database, so quiz-content repositories
+// can reject an invalid quiz before it is ever imported. It returns one line
+// per problem, nil when the document would import cleanly. A document
+// wrapped in a Markdown code fence is accepted, as on the import form.
+func LintQuizJSON(ctx context.Context, jsonText string) []string {
+	_, msg, problems := decodeQuizImport(ctx, stripCodeFences(jsonText))
+	if msg == "" {
+		return nil
+	}
+	if len(problems) > 0 {
+		return problems.Lines()
 	}
-	qz.Mode = mode
-	if problems := (&quizForm{quiz: qz}).Valid(r.Context()); len(problems) > 0 {
-		renderErr(w, r, jsonText, mode, fmt.Sprintf("validation errors: %v", problems))
 
-		return parsedImport{}, false
+	return []string{msg}
+}
+
+// ErrQuizImportInvalid is returned by [ImportQuizJSON] when the document
+// fails the import rules; the wrapped message lists the problems, and a
+// failure of the form rules also wraps their [ValidationErrors].
+var ErrQuizImportInvalid = errors.New("quiz JSON is not valid")
+
+// ImportQuizJSON creates a quiz from a quiz JSON document in the given play
+// mode, owned by createdBy, applying exactly the checks the admin import form
+// does. It backs `server seed --file`, so a demo or fixture quiz can be loaded
+// without a browser. A title whose slug is taken fails with
+// [quiz.ErrSlugTaken] and nothing is written.
+func ImportQuizJSON(
+	ctx context.Context, quizStore quiz.Store, jsonText, mode string, createdBy int64,
+) (*quiz.Quiz, error) {
+	if !quiz.IsValidMode(mode) {
+		return nil, fmt.Errorf("%w: unknown play mode %q", ErrQuizImportInvalid, mode)
+	}
+	qz, msg, problems := decodeQuizImport(ctx, stripCodeFences(jsonText))
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %w", ErrQuizImportInvalid, problems)
 	}
+	if msg != "" {
+		return nil, fmt.Errorf("%w: %s", ErrQuizImportInvalid, msg)
+	}
+	qz.Mode = quiz.Mode(mode)
+	if qz.Mode == quiz.ModeLive && slices.ContainsFunc(qz.Questions, notLivePlayable) {
+		return nil, fmt.Errorf("%w: a live quiz cannot have numeric or select-all questions", ErrQuizImportInvalid)
+	}
+	qz.CreatedByPlayerID = createdBy
 
-	return parsedImport{JSONText: jsonText, Quiz: qz}, true
+	if err := storeQuiz(ctx, quizStore, qz); err != nil {
+		return nil, err
+	}
+
+	return qz, nil
+}
+
+// importFileField is the multipart field the import form uploads a .json
+// quiz file under.
+const importFileField = "file"
+
+// readImportFile returns the contents of the uploaded import file, or ""
+// when the request is not multipart or carries no file. The body is already
+// capped at maxFormSize by [MaxImportFormMiddleware].
+func readImportFile(r *http.Request) (string, error) {
+	if r.MultipartForm == nil {
+		return "", nil
+	}
+	f, _, err := r.FormFile(importFileField)
+	if errors.Is(err, http.ErrMissingFile) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	b, err := io.ReadAll(io.LimitReader(f, maxFormSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// MaxImportFormMiddleware caps the import POST body at maxFormSize and, for
+// a multipart submission (the .json file upload), parses the form before
+// the CSRF middleware runs: csrf.Manager reads the token with
+// PostFormValue, which only sees multipart fields once they are parsed. A
+// url-encoded submission (pasted JSON) passes through untouched, so the
+// same route serves both. Mount in front of csrfMW, like
+// [MaxFormSizeMiddleware].
+func MaxImportFormMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxFormSize)
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if mediaType != "multipart/form-data" {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+		if err := r.ParseMultipartForm(maxFormSize); err != nil {
+			http.Error(w, "invalid or oversized upload", http.StatusBadRequest)
+
+			return
+		}
+		defer func() { _ = r.MultipartForm.RemoveAll() }()
+		next.ServeHTTP(w, r)
+	})
 }
 
 // stripCodeFences removes a single surrounding Markdown fenced code block
@@ -342,23 +613,7 @@ func quizFromImportPayload(p quizImportPayload) (*quiz.Quiz, error) {
 		return nil, errImportQuestionsOrRounds
 	}
 
-	// #99: honour the payload's per-quiz default when present; fall
-	// back to the project value so authors who don't care can omit
-	// the field entirely and still pass Quiz.Valid's range check.
-	timeLimit := quiz.DefaultTimeLimitSeconds
-	if p.TimeLimitSeconds != nil {
-		timeLimit = *p.TimeLimitSeconds
-	}
-	qz := &quiz.Quiz{
-		Title:            p.Title,
-		Slug:             slug.Make(p.Title),
-		Description:      p.Description,
-		TimeLimitSeconds: timeLimit,
-		// Empty maps to LanguageEN in the store; unrecognised is caught by
-		// quizForm.Valid (#1115).
-		Language: p.Language,
-	}
-
+	qz := quizSettingsFromImportPayload(p)
 	if len(p.Rounds) > 0 {
 		if err := fillQuizFromRounds(qz, p.Rounds); err != nil {
 			return nil, err
@@ -377,6 +632,38 @@ func quizFromImportPayload(p quizImportPayload) (*quiz.Quiz, error) {
 	return qz, nil
 }
 
+// quizSettingsFromImportPayload maps the payload's quiz-level fields onto a
+// quiz with no questions or rounds.
+func quizSettingsFromImportPayload(p quizImportPayload) *quiz.Quiz {
+	// #99: honour the payload's per-quiz default when present; fall
+	// back to the project value so authors who don't care can omit
+	// the field entirely and still pass Quiz.Valid's range check.
+	timeLimit := quiz.DefaultTimeLimitSeconds
+	if p.TimeLimitSeconds != nil {
+		timeLimit = *p.TimeLimitSeconds
+	}
+	return &quiz.Quiz{
+		Title:            p.Title,
+		Slug:             slug.Make(p.Title),
+		Description:      p.Description,
+		TimeLimitSeconds: timeLimit,
+		// Empty maps to LanguageEN in the store; unrecognised is caught by
+		// quizForm.Valid (#1115).
+		Language:            p.Language,
+		ShuffleQuestions:    p.ShuffleQuestions,
+		KeepOptionOrder:     p.KeepOptionOrder,
+		LateJoin:            p.LateJoin,
+		JoinDeadlineSeconds: p.JoinDeadlineSeconds,
+		MaxPlayers:          p.MaxPlayers,
+		ConfidenceWager:     p.ConfidenceWager,
+		HostPaced:           p.HostPaced,
+		Scoring:             p.Scoring,
+		WrongAnswerPenalty:  p.WrongAnswerPenalty,
+		ScoreFloor:          p.ScoreFloor,
+		ExternalRef:         p.ExternalRef,
+	}
+}
+
 // fillQuizFromRounds maps the authored rounds onto qz.Rounds and mirrors
 // every question onto qz.Questions with a quiz-wide 1..N position in
 // payload order, so the shared quizForm.Valid sees the full question set
@@ -424,6 +711,18 @@ func questionFromImportPayload(qIn quizImportQuestionPayload, position int) *qui
 		// nil -> "inherit the quiz default", the same semantics
 		// the admin form's blank input carries (#99).
 		TimeLimitSeconds: qIn.TimeLimitSeconds,
+		Kind:             quiz.NormalizedKind(qIn.Kind),
+		Explanation:      strings.TrimSpace(qIn.Explanation),
+		Hint:             strings.TrimSpace(qIn.Hint),
+		ExternalRef:      qIn.ExternalRef,
+	}
+	if qs.IsNumeric() {
+		// A missing answer leaves no options; quizForm.Valid reports it.
+		if a := qIn.Answer; a != nil {
+			qs.Options = []*quiz.Option{quiz.NewNumericKey(a.Value, a.ToleranceBelow, a.ToleranceAbove)}
+		}
+
+		return qs
 	}
 	qs.Options = make([]*quiz.Option, 0, len(qIn.Options))
 	for _, oIn := range qIn.Options {
@@ -2,10 +2,19 @@ package admin_test
 
 import (
 	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/quiz"
 )
 
 // TestQuizImportExampleParses is the golden test that the exact JSON
@@ -255,7 +264,7 @@ func TestQuizFromImportPayload_RoundBoundaryDurationRangeValidated(t *testing.T)
 	}
 
 	problems := admin.ValidateQuizForm(t.Context(), qz)
-	if _, ok := problems["rounds[0][boundarydurationseconds]"]; !ok {
+	if problems.For("rounds[0].boundarydurationseconds") == "" {
 		t.Errorf("problems = %v, want a rounds[0][boundarydurationseconds] key", problems)
 	}
 }
@@ -343,3 +352,195 @@ func TestStripCodeFences(t *testing.T) {
 		})
 	}
 }
+
+// TestLintQuizJSON pins the library face of the import lint: the on-screen
+// example (fenced, as an LLM returns it) is clean, while a decode failure, a
+// shape error and field-rule failures each come back as problem lines.
+func TestLintQuizJSON(t *testing.T) {
+	t.Parallel()
+
+	if got := admin.LintQuizJSON(t.Context(), "```json\n"+admin.QuizImportExample+"\n```"); got != nil {
+		t.Errorf("LintQuizJSON(example) = %v, want nil", got)
+	}
+
+	tests := []struct {
+		name string
+		json string
+		want []string
+	}{
+		{
+			name: "unknown field",
+			json: `{"title": "T", "colour": "red"}`,
+			want: []string{`invalid JSON: json: unknown field "colour"`},
+		},
+		{
+			name: "neither questions nor rounds",
+			json: `{"title": "T", "description": "D"}`,
+			want: []string{"validation errors: provide either a top-level questions array or a rounds array," +
+				" not both and not neither"},
+		},
+		{
+			name: "field rules",
+			json: `{"title": "", "description": "", "questions": [{"text": "Q",` +
+				` "options": [{"text": "A", "correct": true}, {"text": "B"}]}]}`,
+			want: []string{"title: Title is required", "slug: Slug is required", "description: Description is required"},
+		},
+		{
+			name: "every option correct",
+			json: `{"title": "T", "description": "D", "questions": [{"text": "Q",` +
+				` "options": [{"text": "A", "correct": true}, {"text": "B", "correct": true}]}]}`,
+			want: []string{"questions[0].options: Leave at least one option unmarked - every option is marked correct"},
+		},
+		{
+			name: "numeric question",
+			json: `{"title": "T", "description": "D", "questions": [{"text": "Q", "kind": "numeric",` +
+				` "answer": {"value": 42, "toleranceAbove": 8}}]}`,
+			want: nil,
+		},
+		{
+			name: "numeric question without an answer",
+			json: `{"title": "T", "description": "D", "questions": [{"text": "Q", "kind": "numeric"}]}`,
+			want: []string{"questions[0].numericvalue: The answer is required"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(tt.want, admin.LintQuizJSON(t.Context(), tt.json)); diff != "" {
+				t.Errorf("LintQuizJSON mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestImportQuizJSON pins the seed-from-file import: a valid document lands
+// as a quiz in the requested mode owned by createdBy, an invalid one fails
+// with [admin.ErrQuizImportInvalid], and a taken title with
+// [quiz.ErrSlugTaken].
+func TestImportQuizJSON(t *testing.T) {
+	t.Parallel()
+
+	env := newAdminEnv(t)
+
+	qz, err := admin.ImportQuizJSON(t.Context(), env.quizzes, reimportJSON, string(quiz.ModeLive), testAdminID)
+	if err != nil {
+		t.Fatalf("ImportQuizJSON err = %v, want nil", err)
+	}
+	stored, err := env.quizzes.GetQuiz(t.Context(), qz.ID)
+	if err != nil {
+		t.Fatalf("GetQuiz err = %v", err)
+	}
+	if stored.Title != "Capitals" || stored.Mode != quiz.ModeLive || stored.CreatedByPlayerID != testAdminID {
+		t.Errorf("stored = %q/%q/%d, want Capitals/live/%d",
+			stored.Title, stored.Mode, stored.CreatedByPlayerID, testAdminID)
+	}
+	if got, want := len(stored.Questions), 2; got != want {
+		t.Errorf("questions = %d, want %d", got, want)
+	}
+
+	_, err = admin.ImportQuizJSON(t.Context(), env.quizzes, reimportJSON, string(quiz.ModeSolo), testAdminID)
+	if !errors.Is(err, quiz.ErrSlugTaken) {
+		t.Errorf("re-import err = %v, want %v", err, quiz.ErrSlugTaken)
+	}
+	_, err = admin.ImportQuizJSON(t.Context(), env.quizzes, `{"title": ""}`, string(quiz.ModeSolo), testAdminID)
+	if !errors.Is(err, admin.ErrQuizImportInvalid) {
+		t.Errorf("invalid document err = %v, want %v", err, admin.ErrQuizImportInvalid)
+	}
+	_, err = admin.ImportQuizJSON(t.Context(), env.quizzes, reimportJSON, "team", testAdminID)
+	if !errors.Is(err, admin.ErrQuizImportInvalid) {
+		t.Errorf("unknown mode err = %v, want %v", err, admin.ErrQuizImportInvalid)
+	}
+
+	_, err = admin.ImportQuizJSON(t.Context(), env.quizzes,
+		`{"title": "Blank", "description": "d", "questions": [{"text": "", "options": [{"text": "A"}]}]}`,
+		string(quiz.ModeSolo), testAdminID)
+	var problems admin.ValidationErrors
+	if !errors.As(err, &problems) {
+		t.Fatalf("form-rule failure err = %v, want admin.ValidationErrors", err)
+	}
+	if got, want := problems.For("questions[0].text"), "Text is required"; got != want {
+		t.Errorf("questions[0].text problem = %q, want %q", got, want)
+	}
+}
+
+// TestHandleQuizImportSave_JSON pins the import's JSON face: a request that
+// accepts application/json gets the failed form rules as a structured error
+// and a created quiz as its id, instead of the HTML page and redirect.
+func TestHandleQuizImportSave_JSON(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.DiscardHandler)
+	post := func(t *testing.T, env *adminEnv, jsonText string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		form := url.Values{"json": {jsonText}, "mode": {string(quiz.ModeSolo)}}
+		req := httptest.NewRequestWithContext(
+			t.Context(), http.MethodPost, "/admin/quizzes/import", strings.NewReader(form.Encode()),
+		)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+		rr := httptest.NewRecorder()
+		admin.HandleQuizImportSave(logger, nil, env.quizzes).ServeHTTP(rr, withTestAdmin(req))
+
+		return rr
+	}
+
+	t.Run("failed rules are listed by field", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		rr := post(t, env,
+			`{"title": "", "description": "d", "questions": [{"text": "Q", "options": [{"text": ""}]}]}`)
+
+		if got, want := rr.Code, http.StatusBadRequest; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		var res struct {
+			Error struct {
+				Code   string                 `json:"code"`
+				Fields admin.ValidationErrors `json:"fields"`
+			} `json:"error"`
+		}
+		if err := json.NewDecoder(rr.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if got, want := res.Error.Code, "validationFailed"; got != want {
+			t.Errorf("code = %q, want %q", got, want)
+		}
+		want := admin.ValidationErrors{
+			{Field: "title", Code: admin.CodeRequired, Message: "Title is required"},
+			{Field: "slug", Code: admin.CodeRequired, Message: "Slug is required"},
+			{Field: "questions[0].options[0].text", Code: admin.CodeRequired, Message: "Text is required"},
+		}
+		if diff := cmp.Diff(want, res.Error.Fields); diff != "" {
+			t.Errorf("fields mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("a created quiz is returned by id", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		rr := post(t, env, reimportJSON)
+
+		if got, want := rr.Code, http.StatusCreated; got != want {
+			t.Fatalf("status = %d, want %d (body %s)", got, want, rr.Body.String())
+		}
+		var res struct {
+			Data struct {
+				ID  int64  `json:"id"`
+				URL string `json:"url"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(rr.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if _, err := env.quizzes.GetQuiz(t.Context(), res.Data.ID); err != nil {
+			t.Errorf("GetQuiz(%d) err = %v, want the imported quiz", res.Data.ID, err)
+		}
+		if got, want := res.Data.URL, "/admin/quizzes/"+strconv.FormatInt(res.Data.ID, 10); got != want {
+			t.Errorf("url = %q, want %q", got, want)
+		}
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/starquake/topbanana/internal/media"
 	"github.com/starquake/topbanana/internal/mediahttp"
 	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/ratelimit"
 )
 
 // manifestFileName is the archive entry holding the quiz manifest, written by
@@ -61,7 +62,7 @@ var ErrArchiveInvalidQuiz = errors.New("archive is not a valid quiz")
 // MediaImporter is the slice of the media service the archive importer needs:
 // restore an image / audio file from bytes (the pipeline validates and
 // re-encodes the untrusted bytes - that is the safety boundary), and remove a
-// quiz's whole on-disk media directory for the rollback path. Defined
+// quiz's whole stored media directory for the rollback path. Defined
 // consumer-side so the admin package depends only on what it calls; the concrete
 // *media.Service satisfies it.
 type MediaImporter interface {
@@ -69,7 +70,7 @@ type MediaImporter interface {
 	StoreAudio(
 		ctx context.Context, quizID, createdBy int64, durationMs int, description, filename string, r io.Reader,
 	) (*media.Media, error)
-	RemoveQuizDir(quizID int64) error
+	RemoveQuizDir(ctx context.Context, quizID int64) error
 }
 
 // ArchiveImportLimits bundles the size guards the importer applies to an
@@ -132,7 +133,7 @@ func ImportQuizArchive(
 	// time limit (which would otherwise hit a DB CHECK and surface as a 500) is
 	// rejected before anything is persisted.
 	if problems := (&quizForm{quiz: built.quiz}).Valid(ctx); len(problems) > 0 {
-		return nil, fmt.Errorf("%w: %v", ErrArchiveInvalidQuiz, problems)
+		return nil, fmt.Errorf("%w: %w", ErrArchiveInvalidQuiz, problems)
 	}
 
 	if err = importQuizWithMedia(ctx, logger, quizStore, mediaSvc, archive, built, creatorID); err != nil {
@@ -169,15 +170,24 @@ func HandleQuizImportArchive(
 	budget *mediahttp.UploadBudgetLimiter, limits ArchiveImportLimits,
 ) http.Handler {
 	renderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/quizimport.gohtml")
-	renderErr := func(w http.ResponseWriter, r *http.Request, status int, msg string) {
+	renderProblems := func(w http.ResponseWriter, r *http.Request, status int, msg string, problems ValidationErrors) {
+		if wantsJSON(r) {
+			writeImportErrorJSON(w, status, msg, problems)
+
+			return
+		}
 		renderer.Render(w, r, status, quizImportPageData{
 			Title:             "Admin Dashboard - Import Quiz",
 			Example:           quizImportExample,
 			Error:             msg,
+			Problems:          problems,
 			ModeOptions:       quiz.ModeValues(),
 			VisibilityOptions: quiz.VisibilityValues(),
 		})
 	}
+	renderErr := func(w http.ResponseWriter, r *http.Request, status int, msg string) {
+		renderProblems(w, r, status, msg, nil)
+	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		player, ok := auth.PlayerFromContext(r.Context())
@@ -212,7 +222,8 @@ func HandleQuizImportArchive(
 		// empty description, a question with no options) is rejected as a clear 400
 		// before anything is persisted.
 		if problems := (&quizForm{quiz: built.quiz}).Valid(r.Context()); len(problems) > 0 {
-			renderErr(w, r, http.StatusBadRequest, fmt.Sprintf("the archive is not a valid quiz: %v", problems))
+			renderProblems(w, r, http.StatusBadRequest,
+				"the archive is not a valid quiz", problems)
 
 			return
 		}
@@ -223,7 +234,9 @@ func HandleQuizImportArchive(
 		// the host's rate budget (mirrors the upload route's "a clear denial does
 		// not also spend the budget" rule, #988). Over budget is a clear "slow
 		// down" rather than a content error.
-		if allowed, _ := budget.Charge(player.ID, 1); !allowed {
+		allowed, _ := budget.Charge(player.ID, 1)
+		ratelimit.SetHeaders(w, budget.Status(player.ID))
+		if !allowed {
 			renderErr(w, r, http.StatusTooManyRequests, "import rate limit reached, slow down and try again shortly")
 
 			return
@@ -235,7 +248,7 @@ func HandleQuizImportArchive(
 			return
 		}
 
-		http.Redirect(w, r, fmt.Sprintf("/admin/quizzes/%d", built.quiz.ID), http.StatusSeeOther)
+		writeImportDone(w, r, http.StatusCreated, built.quiz.ID)
 	})
 }
 
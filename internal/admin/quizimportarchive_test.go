@@ -515,7 +515,7 @@ func TestHandleQuizImportArchive_VisibilityOverride(t *testing.T) {
 	handler := newImportHandler(t, env, mediaSvc, defaultImportLimits())
 
 	rr := httptest.NewRecorder()
-	handler.ServeHTTP(rr, importRequest(t, archiveBytes, quiz.VisibilityPrivate, quiz.ModeSolo, importAdmin()))
+	handler.ServeHTTP(rr, importRequest(t, archiveBytes, quiz.VisibilityPrivate, string(quiz.ModeSolo), importAdmin()))
 
 	if got, want := rr.Code, http.StatusSeeOther; got != want {
 		t.Fatalf("status = %d, want %d (body: %s)", got, want, rr.Body.String())
@@ -732,7 +732,7 @@ func TestHandleQuizImportArchive_RollbackOnMediaFailure(t *testing.T) {
 	root := t.TempDir()
 	mediaSvc := media.NewService(
 		store.NewMediaStore(env.db, slog.New(slog.DiscardHandler)),
-		root,
+		media.NewLocalStorage(root),
 		importTestImageMax,
 		importTestAudioMax,
 		slog.New(slog.DiscardHandler),
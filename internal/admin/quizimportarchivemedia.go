@@ -212,10 +212,21 @@ func quizFromArchiveManifest(
 		Description:      m.Description,
 		TimeLimitSeconds: timeLimit,
 		Visibility:       visibility,
-		Mode:             mode,
+		Mode:             quiz.Mode(mode),
 		// Empty (a pre-#1115 archive) maps to LanguageEN in the store.
-		Language:          m.Language,
-		CreatedByPlayerID: creatorID,
+		Language:            m.Language,
+		ShuffleQuestions:    m.ShuffleQuestions,
+		KeepOptionOrder:     m.KeepOptionOrder,
+		LateJoin:            m.LateJoin,
+		JoinDeadlineSeconds: m.JoinDeadlineSeconds,
+		MaxPlayers:          m.MaxPlayers,
+		ConfidenceWager:     m.ConfidenceWager,
+		HostPaced:           m.HostPaced,
+		Scoring:             m.Scoring,
+		WrongAnswerPenalty:  m.WrongAnswerPenalty,
+		ScoreFloor:          m.ScoreFloor,
+		ExternalRef:         m.ExternalRef,
+		CreatedByPlayerID:   creatorID,
 	}
 
 	if len(m.Rounds) > 0 {
@@ -296,10 +307,18 @@ func questionFromArchive(qIn quizArchiveQuestion, position int) (*quiz.Question,
 		Text:             qIn.Text,
 		Position:         position,
 		TimeLimitSeconds: qIn.TimeLimitSeconds,
+		Kind:             quiz.NormalizedKind(qIn.Kind),
+		Explanation:      qIn.Explanation,
+		Hint:             qIn.Hint,
+		ExternalRef:      qIn.ExternalRef,
 	}
 	qs.Options = make([]*quiz.Option, 0, len(qIn.Options))
-	for _, oIn := range qIn.Options {
-		qs.Options = append(qs.Options, &quiz.Option{Text: oIn.Text, Correct: oIn.Correct})
+	if a := qIn.Answer; qs.IsNumeric() && a != nil {
+		qs.Options = append(qs.Options, quiz.NewNumericKey(a.Value, a.ToleranceBelow, a.ToleranceAbove))
+	} else if !qs.IsNumeric() {
+		for _, oIn := range qIn.Options {
+			qs.Options = append(qs.Options, &quiz.Option{Text: oIn.Text, Correct: oIn.Correct})
+		}
 	}
 
 	if qIn.Image == nil && qIn.Audio == nil {
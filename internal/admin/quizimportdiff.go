@@ -0,0 +1,667 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// The re-import strategies offered when an imported quiz's title derives
+// the slug of a quiz the admin can edit, or its external reference is
+// one's. Merge updates the questions the
+// JSON shares with the quiz, adds its new ones and keeps the rest; replace
+// makes the quiz's questions match the JSON's, deleting the ones it lacks.
+const (
+	reimportMerge   = "merge"
+	reimportReplace = "replace"
+)
+
+// quizReimport backs the re-import preview on quizimport.gohtml: the quiz
+// the import collided with and what importing over it would change.
+type quizReimport struct {
+	QuizID    int64
+	QuizTitle string
+	Diff      quizImportDiff
+}
+
+// quizImportDiff is what re-importing a JSON document would change on an
+// existing quiz. Questions pair up by external reference, then by their
+// trimmed text, so an edited question text without a reference reads as
+// one question removed and another added.
+type quizImportDiff struct {
+	// Settings lists the quiz-level changes, one "field: old → new" line
+	// each.
+	Settings []string
+	// RoundsAdded lists the imported round titles the quiz has no round
+	// for; the re-import creates them.
+	RoundsAdded []string
+	// Added and Removed list the texts of the questions only the JSON or
+	// only the quiz has. Merge keeps the removed ones; replace deletes them.
+	Added   []string
+	Removed []string
+	// Changed lists the questions both have that differ in kind, time limit,
+	// explanation, hint or options.
+	Changed []questionImportDiff
+	// Unchanged counts the questions both have, identical.
+	Unchanged int
+}
+
+// questionImportDiff is one changed question: its text and one line per
+// difference.
+type questionImportDiff struct {
+	Text    string
+	Changes []string
+}
+
+// Empty reports whether the import would leave the quiz as it is.
+func (d quizImportDiff) Empty() bool {
+	return len(d.Settings)+len(d.RoundsAdded)+len(d.Added)+len(d.Removed)+len(d.Changed) == 0
+}
+
+// errReimportNotLivePlayable is returned when a merge into a live quiz
+// would keep a numeric or select-all question the JSON does not replace.
+var errReimportNotLivePlayable = errors.New("a live quiz cannot have numeric or select-all questions")
+
+// loadQuizReimport builds the re-import preview of imported over the quiz
+// that already holds its external reference or, failing that, its slug. It
+// returns nil when there is nothing the
+// session player may update: the quiz is gone, belongs to someone else, or
+// is published and so locked from edits (#1192).
+func loadQuizReimport(r *http.Request, quizStore quiz.Store, imported *quiz.Quiz) (*quizReimport, error) {
+	ctx := r.Context()
+	id, err := reimportTargetID(ctx, quizStore, imported)
+	if errors.Is(err, quiz.ErrQuizNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	existing, err := quizStore.GetQuiz(ctx, id)
+	if errors.Is(err, quiz.ErrQuizNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading quiz %d: %w", id, err)
+	}
+	if !canEditQuiz(r, existing.CreatedByPlayerID) || existing.Published {
+		return nil, nil
+	}
+	rounds, err := quizStore.ListRoundsByQuiz(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("loading rounds for quiz %d: %w", id, err)
+	}
+
+	return &quizReimport{
+		QuizID:    existing.ID,
+		QuizTitle: existing.Title,
+		Diff:      diffQuizImport(existing, rounds, imported),
+	}, nil
+}
+
+// reimportTargetID returns the id of the quiz an import collides with: the
+// one carrying its external reference when it has one, else the one holding
+// its slug.
+func reimportTargetID(ctx context.Context, quizStore quiz.Store, imported *quiz.Quiz) (int64, error) {
+	if ref := imported.ExternalRef; ref != "" {
+		id, err := quizStore.GetQuizIDByExternalRef(ctx, ref)
+		if !errors.Is(err, quiz.ErrQuizNotFound) {
+			if err != nil {
+				return 0, fmt.Errorf("looking up quiz by external ref %q: %w", ref, err)
+			}
+
+			return id, nil
+		}
+	}
+	id, err := quizStore.GetQuizIDBySlug(ctx, imported.Slug)
+	if err != nil && !errors.Is(err, quiz.ErrQuizNotFound) {
+		return 0, fmt.Errorf("looking up quiz %q: %w", imported.Slug, err)
+	}
+
+	return id, err
+}
+
+// HandleQuizReimport applies a re-import previewed by [HandleQuizImportSave]
+// to the quiz it collided with, using the strategy the admin picked (merge
+// or replace). Questions the JSON shares with the quiz keep their ids,
+// media and statistics, and options keep theirs where the text still
+// matches; the quiz keeps its owner, visibility and published state. Rounds
+// are matched by title: a new question lands in the round of the same
+// title, created when the quiz has none, and an existing question stays in
+// its round. Rounds are never deleted.
+func HandleQuizReimport(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+	renderErr := newImportErrRenderer(logger, csrfMgr)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		quizID, ok := handlers.ParseIDFromPath(w, r, logger, "quizID")
+		if !ok {
+			return
+		}
+		existing, ok := requireEditableQuizOwner(w, r, logger, csrfMgr, quizStore, quizID)
+		if !ok {
+			return
+		}
+		parsed, ok := parseImportPayload(w, r, logger, renderErr)
+		if !ok {
+			return
+		}
+
+		strategy := r.PostFormValue("strategy")
+		if strategy != reimportMerge && strategy != reimportReplace {
+			renderErr(w, r, parsed.JSONText, string(parsed.Quiz.Mode), "choose merge or replace to update the existing quiz")
+
+			return
+		}
+		sameRef := parsed.Quiz.ExternalRef != "" && parsed.Quiz.ExternalRef == existing.ExternalRef
+		if parsed.Quiz.Slug != existing.Slug && !sameRef {
+			renderErr(w, r, parsed.JSONText, string(parsed.Quiz.Mode),
+				"the JSON's title and external reference no longer match this quiz - "+
+					"submit it from the import form again")
+
+			return
+		}
+
+		err := applyQuizReimport(r.Context(), quizStore, existing, parsed.Quiz, strategy)
+		if errors.Is(err, errReimportNotLivePlayable) {
+			renderErr(w, r, parsed.JSONText, string(parsed.Quiz.Mode),
+				"the quiz keeps numeric or select-all questions, which a live quiz cannot have - replace instead of merge, or import it as solo")
+
+			return
+		}
+		if err != nil {
+			logger.ErrorContext(r.Context(), "error re-importing quiz", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		writeImportDone(w, r, http.StatusOK, quizID)
+	})
+}
+
+// normalizedImportQuiz puts a stored quiz through the JSON export and back
+// through the importer's mapping, so it compares field for field with an
+// imported quiz: ids, media and ordering constraints drop out, and
+// defaults read the way the importer fills them. Its questions come out in
+// the order of [exportOrderedQuestions]. The play mode is not part of the
+// JSON, so it is carried over as is.
+func normalizedImportQuiz(qz *quiz.Quiz, rounds []*quiz.Round) *quiz.Quiz {
+	payload := quizImportPayloadFromQuiz(qz, rounds)
+	normalized := quizSettingsFromImportPayload(payload)
+	normalized.Mode = qz.Mode
+
+	pos := 0
+	add := func(questions []quizImportQuestionPayload) {
+		for _, qIn := range questions {
+			pos++
+			normalized.Questions = append(normalized.Questions, questionFromImportPayload(qIn, pos))
+		}
+	}
+	add(payload.Questions)
+	for _, rIn := range payload.Rounds {
+		add(rIn.Questions)
+	}
+
+	return normalized
+}
+
+// exportOrderedQuestions returns the quiz's questions in the order the JSON
+// export lists them - round by round, by position within each round - so
+// index i lines up with question i of [normalizedImportQuiz].
+func exportOrderedQuestions(qz *quiz.Quiz, rounds []*quiz.Round) []*quiz.Question {
+	out := make([]*quiz.Question, 0, len(qz.Questions))
+	for _, rnd := range rounds {
+		for _, q := range qz.Questions {
+			if q.RoundID == rnd.ID {
+				out = append(out, q)
+			}
+		}
+	}
+
+	return out
+}
+
+// pairQuestions pairs each imported question with a current one: first by
+// external reference, then by trimmed text among the questions still free.
+// An imported question with a reference only pairs by text with a current
+// question that has none, so two different references never merge. For each
+// imported question the result holds its partner's index in current, or -1
+// when it has none.
+func pairQuestions(current, imported []*quiz.Question) []int {
+	byRef := make(map[string]int, len(current))
+	for j, q := range current {
+		if q.ExternalRef != "" {
+			byRef[q.ExternalRef] = j
+		}
+	}
+	pairs := make([]int, len(imported))
+	taken := make([]bool, len(current))
+	for i, in := range imported {
+		pairs[i] = -1
+		if j, ok := byRef[in.ExternalRef]; ok && in.ExternalRef != "" {
+			pairs[i] = j
+			taken[j] = true
+		}
+	}
+
+	free := make(map[string][]int, len(current))
+	for j, q := range current {
+		if !taken[j] {
+			key := strings.TrimSpace(q.Text)
+			free[key] = append(free[key], j)
+		}
+	}
+	for i, in := range imported {
+		if pairs[i] >= 0 {
+			continue
+		}
+		key := strings.TrimSpace(in.Text)
+		for k, j := range free[key] {
+			if in.ExternalRef == "" || current[j].ExternalRef == "" {
+				pairs[i] = j
+				free[key] = slices.Delete(free[key], k, k+1)
+
+				break
+			}
+		}
+	}
+
+	return pairs
+}
+
+// pairByText pairs each entry of b with the first not-yet-paired entry of
+// a whose trimmed text is the same. For each entry of b the result holds
+// its partner's index in a, or -1 when it has none.
+func pairByText[T any](a, b []T, text func(T) string) []int {
+	free := make(map[string][]int, len(a))
+	for i, v := range a {
+		key := strings.TrimSpace(text(v))
+		free[key] = append(free[key], i)
+	}
+	pairs := make([]int, len(b))
+	for i, v := range b {
+		key := strings.TrimSpace(text(v))
+		if idx := free[key]; len(idx) > 0 {
+			pairs[i] = idx[0]
+			free[key] = idx[1:]
+
+			continue
+		}
+		pairs[i] = -1
+	}
+
+	return pairs
+}
+
+func optionText(o *quiz.Option) string { return o.Text }
+
+// diffQuizImport compares the imported quiz with the stored one, normalized
+// through [normalizedImportQuiz].
+func diffQuizImport(existing *quiz.Quiz, rounds []*quiz.Round, imported *quiz.Quiz) quizImportDiff {
+	current := normalizedImportQuiz(existing, rounds)
+	diff := quizImportDiff{Settings: settingsChanges(current, imported)}
+
+	titles := make(map[string]bool, len(rounds))
+	for _, rnd := range rounds {
+		titles[strings.TrimSpace(rnd.Title)] = true
+	}
+	for _, rnd := range imported.Rounds {
+		if title := strings.TrimSpace(rnd.Title); !titles[title] {
+			titles[title] = true
+			diff.RoundsAdded = append(diff.RoundsAdded, rnd.Title)
+		}
+	}
+
+	pairs := pairQuestions(current.Questions, imported.Questions)
+	paired := make([]bool, len(current.Questions))
+	for i, in := range imported.Questions {
+		j := pairs[i]
+		if j < 0 {
+			diff.Added = append(diff.Added, in.Text)
+
+			continue
+		}
+		paired[j] = true
+		if changes := questionChanges(current.Questions[j], in); len(changes) > 0 {
+			diff.Changed = append(diff.Changed, questionImportDiff{Text: in.Text, Changes: changes})
+		} else {
+			diff.Unchanged++
+		}
+	}
+	for j, q := range current.Questions {
+		if !paired[j] {
+			diff.Removed = append(diff.Removed, q.Text)
+		}
+	}
+
+	return diff
+}
+
+// settingsChanges lists the quiz-level fields the import changes.
+func settingsChanges(current, imported *quiz.Quiz) []string {
+	_, _, curLang := quiz.NormalizedFields(current)
+	_, _, newLang := quiz.NormalizedFields(imported)
+
+	var out []string
+	change := func(field, from, to string) {
+		if from != to {
+			out = append(out, field+": "+from+" → "+to)
+		}
+	}
+	change("title", current.Title, imported.Title)
+	change("description", quoted(current.Description), quoted(imported.Description))
+	change("language", curLang, newLang)
+	change("play mode", string(current.Mode), string(imported.Mode))
+	change("time limit", seconds(current.TimeLimitSeconds), seconds(imported.TimeLimitSeconds))
+	change("shuffle questions", onOff(current.ShuffleQuestions), onOff(imported.ShuffleQuestions))
+	change("keep option order", onOff(current.KeepOptionOrder), onOff(imported.KeepOptionOrder))
+	change("late join", quiz.NormalizedLateJoin(current.LateJoin), quiz.NormalizedLateJoin(imported.LateJoin))
+	change("join deadline", seconds(current.JoinDeadlineSeconds), seconds(imported.JoinDeadlineSeconds))
+	change("max players", strconv.Itoa(current.MaxPlayers), strconv.Itoa(imported.MaxPlayers))
+	change("confidence wager", onOff(current.ConfidenceWager), onOff(imported.ConfidenceWager))
+	change("host-paced scoring", onOff(current.HostPaced), onOff(imported.HostPaced))
+	change("scoring", quiz.NormalizedScoring(current.Scoring), quiz.NormalizedScoring(imported.Scoring))
+	change("wrong-answer penalty", strconv.Itoa(current.WrongAnswerPenalty), strconv.Itoa(imported.WrongAnswerPenalty))
+	change("lowest total", strconv.Itoa(current.ScoreFloor), strconv.Itoa(imported.ScoreFloor))
+	if imported.ExternalRef != "" {
+		change("external reference", quoted(current.ExternalRef), quoted(imported.ExternalRef))
+	}
+
+	return out
+}
+
+// questionChanges lists how the imported question differs from the
+// current one: its kind, time limit, explanation, hint, and either the numeric answer
+// key or the options added, removed or flipped between right and wrong.
+func questionChanges(current, imported *quiz.Question) []string {
+	var out []string
+	if from, to := quiz.NormalizedKind(current.Kind), quiz.NormalizedKind(imported.Kind); from != to {
+		out = append(out, "kind: "+from+" → "+to)
+	}
+	if from, to := questionTimeLimit(current), questionTimeLimit(imported); from != to {
+		out = append(out, "time limit: "+from+" → "+to)
+	}
+	if current.Explanation != imported.Explanation {
+		out = append(out, "explanation: "+quoted(current.Explanation)+" → "+quoted(imported.Explanation))
+	}
+	if current.Hint != imported.Hint {
+		out = append(out, "hint: "+quoted(current.Hint)+" → "+quoted(imported.Hint))
+	}
+	if imported.ExternalRef != "" && current.ExternalRef != imported.ExternalRef {
+		out = append(out, "external reference: "+quoted(current.ExternalRef)+" → "+quoted(imported.ExternalRef))
+	}
+
+	curKey, newKey := current.NumericKey(), imported.NumericKey()
+	if curKey != nil || newKey != nil {
+		if from, to := numericKeyText(curKey), numericKeyText(newKey); from != to {
+			out = append(out, "answer: "+from+" → "+to)
+		}
+
+		return out
+	}
+
+	pairs := pairByText(current.Options, imported.Options, optionText)
+	paired := make([]bool, len(current.Options))
+	for i, o := range imported.Options {
+		j := pairs[i]
+		if j < 0 {
+			out = append(out, "added option "+optionLabel(o))
+
+			continue
+		}
+		paired[j] = true
+		if current.Options[j].Correct != o.Correct {
+			out = append(out, "option "+quoted(o.Text)+": "+rightWrong(current.Options[j].Correct)+" → "+rightWrong(o.Correct))
+		}
+	}
+	for j, o := range current.Options {
+		if !paired[j] {
+			out = append(out, "removed option "+optionLabel(o))
+		}
+	}
+
+	return out
+}
+
+// applyQuizReimport rewrites existing from the imported quiz with the given
+// strategy; see [HandleQuizReimport]. Questions are written at positions
+// above the quiz's current ones, so no write collides with a row that has
+// not moved yet, and then renumbered to [quiz.RenumberStep] steps. A merge
+// into a live quiz that would keep a question live play cannot run returns
+// [errReimportNotLivePlayable] before anything is written.
+func applyQuizReimport(ctx context.Context, quizStore quiz.Store, existing, imported *quiz.Quiz, strategy string) error {
+	rounds, err := quizStore.ListRoundsByQuiz(ctx, existing.ID)
+	if err != nil {
+		return fmt.Errorf("loading rounds for quiz %d: %w", existing.ID, err)
+	}
+	if len(rounds) == 0 {
+		return fmt.Errorf("quiz %d: %w", existing.ID, quiz.ErrRoundNotFound)
+	}
+
+	stored := exportOrderedQuestions(existing, rounds)
+	pairs := pairQuestions(normalizedImportQuiz(existing, rounds).Questions, imported.Questions)
+	paired := make([]bool, len(stored))
+	for _, j := range pairs {
+		if j >= 0 {
+			paired[j] = true
+		}
+	}
+	if strategy == reimportMerge && imported.Mode == quiz.ModeLive {
+		for j, q := range stored {
+			if !paired[j] && notLivePlayable(q) {
+				return errReimportNotLivePlayable
+			}
+		}
+	}
+
+	roundByTitle, err := ensureImportRounds(ctx, quizStore, existing.ID, rounds, imported.Rounds)
+	if err != nil {
+		return err
+	}
+	roundPos := make(map[int64]int, len(roundByTitle))
+	for _, rnd := range rounds {
+		roundPos[rnd.ID] = rnd.Position
+	}
+	for _, rnd := range roundByTitle {
+		roundPos[rnd.ID] = rnd.Position
+	}
+	importedRound := make(map[*quiz.Question]string, len(imported.Questions))
+	for _, rnd := range imported.Rounds {
+		for _, q := range rnd.Questions {
+			importedRound[q] = strings.TrimSpace(rnd.Title)
+		}
+	}
+
+	ordered := make([]*quiz.Question, 0, len(stored)+len(imported.Questions))
+	var added []*quiz.Question
+	for i, in := range imported.Questions {
+		if j := pairs[i]; j >= 0 {
+			updateQuestionFromImport(stored[j], in)
+			if strategy == reimportReplace {
+				ordered = append(ordered, stored[j])
+			}
+
+			continue
+		}
+		in.RoundID = rounds[0].ID
+		if rnd, ok := roundByTitle[importedRound[in]]; ok {
+			in.RoundID = rnd.ID
+		}
+		if strategy == reimportReplace {
+			ordered = append(ordered, in)
+		} else {
+			added = append(added, in)
+		}
+	}
+	if strategy == reimportMerge {
+		ordered = append(append(ordered, stored...), added...)
+	}
+	// Play order is round by round, so a question's position has to sort
+	// it inside its own round.
+	sort.SliceStable(ordered, func(a, b int) bool {
+		return roundPos[ordered[a].RoundID] < roundPos[ordered[b].RoundID]
+	})
+	base := 0
+	for _, q := range existing.Questions {
+		base = max(base, q.Position)
+	}
+	for i, q := range ordered {
+		q.Position = base + (i+1)*quiz.RenumberStep
+	}
+
+	copyImportSettings(existing, imported)
+	existing.Questions = ordered
+	if err = storeQuiz(ctx, quizStore, existing); err != nil {
+		return err
+	}
+	if err = quizStore.RenumberQuestions(ctx, existing.ID, ""); err != nil {
+		return fmt.Errorf("renumbering quiz %d: %w", existing.ID, err)
+	}
+
+	return nil
+}
+
+// copyImportSettings copies the quiz-level fields the JSON carries, plus
+// the play mode picked on the import form, onto the stored quiz. The slug,
+// owner, visibility and published state stay as they are, and so does the
+// external reference when the JSON carries none.
+func copyImportSettings(existing, imported *quiz.Quiz) {
+	existing.Title = imported.Title
+	existing.Description = imported.Description
+	existing.Language = imported.Language
+	existing.Mode = imported.Mode
+	existing.TimeLimitSeconds = imported.TimeLimitSeconds
+	existing.ShuffleQuestions = imported.ShuffleQuestions
+	existing.KeepOptionOrder = imported.KeepOptionOrder
+	existing.LateJoin = imported.LateJoin
+	existing.JoinDeadlineSeconds = imported.JoinDeadlineSeconds
+	existing.MaxPlayers = imported.MaxPlayers
+	existing.ConfidenceWager = imported.ConfidenceWager
+	existing.HostPaced = imported.HostPaced
+	existing.Scoring = imported.Scoring
+	existing.WrongAnswerPenalty = imported.WrongAnswerPenalty
+	existing.ScoreFloor = imported.ScoreFloor
+	if imported.ExternalRef != "" {
+		existing.ExternalRef = imported.ExternalRef
+	}
+}
+
+// ensureImportRounds maps every round title of the quiz, plus those of the
+// imported rounds, to its round, creating the imported rounds the quiz has
+// no round for after its last one. The first round with a title wins.
+func ensureImportRounds(
+	ctx context.Context, quizStore quiz.Store, quizID int64, rounds []*quiz.Round, imported []*quiz.Round,
+) (map[string]*quiz.Round, error) {
+	byTitle := make(map[string]*quiz.Round, len(rounds)+len(imported))
+	next := 0
+	for _, rnd := range rounds {
+		if title := strings.TrimSpace(rnd.Title); byTitle[title] == nil {
+			byTitle[title] = rnd
+		}
+		next = max(next, rnd.Position+1)
+	}
+	for _, in := range imported {
+		title := strings.TrimSpace(in.Title)
+		if byTitle[title] != nil {
+			continue
+		}
+		rnd := &quiz.Round{
+			QuizID:                  quizID,
+			Position:                next,
+			Title:                   in.Title,
+			Summary:                 in.Summary,
+			BoundaryDurationSeconds: in.BoundaryDurationSeconds,
+		}
+		if err := quizStore.CreateRound(ctx, rnd); err != nil {
+			return nil, fmt.Errorf("creating round %q: %w", in.Title, err)
+		}
+		byTitle[title] = rnd
+		next++
+	}
+
+	return byTitle, nil
+}
+
+// updateQuestionFromImport copies an imported question's content onto the
+// stored question it paired with, keeping the stored question's id, round,
+// media and ordering constraint, and its external reference when the import
+// carries none. Options keep their ids where the text
+// still matches; a numeric answer key keeps its id while the question stays
+// numeric.
+func updateQuestionFromImport(stored, imported *quiz.Question) {
+	if key := imported.NumericKey(); key != nil {
+		if storedKey := stored.NumericKey(); storedKey != nil {
+			key.ID = storedKey.ID
+		}
+	} else {
+		pairs := pairByText(stored.Options, imported.Options, optionText)
+		for i, o := range imported.Options {
+			if j := pairs[i]; j >= 0 {
+				o.ID = stored.Options[j].ID
+			}
+		}
+	}
+	stored.Text = imported.Text
+	stored.Kind = imported.Kind
+	stored.TimeLimitSeconds = imported.TimeLimitSeconds
+	stored.Explanation = imported.Explanation
+	stored.Hint = imported.Hint
+	stored.Options = imported.Options
+	if imported.ExternalRef != "" {
+		stored.ExternalRef = imported.ExternalRef
+	}
+}
+
+func questionTimeLimit(q *quiz.Question) string {
+	if q.TimeLimitSeconds == nil {
+		return "quiz default"
+	}
+
+	return seconds(*q.TimeLimitSeconds)
+}
+
+func numericKeyText(key *quiz.Option) string {
+	if key == nil || key.NumericValue == nil {
+		return "none"
+	}
+
+	return fmt.Sprintf("%s (-%s/+%s)", quiz.FormatNumber(*key.NumericValue),
+		quiz.FormatNumber(key.ToleranceBelow), quiz.FormatNumber(key.ToleranceAbove))
+}
+
+func optionLabel(o *quiz.Option) string {
+	if o.Correct {
+		return quoted(o.Text) + " (correct)"
+	}
+
+	return quoted(o.Text)
+}
+
+func quoted(s string) string { return strconv.Quote(s) }
+
+func seconds(n int) string { return strconv.Itoa(n) + "s" }
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+
+	return "off"
+}
+
+func rightWrong(correct bool) string {
+	if correct {
+		return "correct"
+	}
+
+	return "wrong"
+}
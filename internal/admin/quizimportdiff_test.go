@@ -0,0 +1,305 @@
+package admin_test
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// reimportJSON re-imports twoQuestionQuiz("Capitals", ...) with France's
+// options edited, Germany dropped and Italy added.
+const reimportJSON = `{
+  "title": "Capitals",
+  "description": "Updated",
+  "questions": [
+    {
+      "text": "What is the capital of France?",
+      "options": [
+        { "text": "Paris", "correct": true },
+        { "text": "Lyon", "correct": false }
+      ]
+    },
+    {
+      "text": "What is the capital of Italy?",
+      "options": [
+        { "text": "Rome", "correct": true },
+        { "text": "Milan", "correct": false }
+      ]
+    }
+  ]
+}`
+
+// postReimport posts the JSON and mode to path, with strategy when set and
+// the quizID path value when non-zero.
+func postReimport(t *testing.T, path string, quizID int64, strategy string) *http.Request {
+	t.Helper()
+
+	form := url.Values{"json": {reimportJSON}, "mode": {string(quiz.ModeSolo)}}
+	if strategy != "" {
+		form.Set("strategy", strategy)
+	}
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if quizID != 0 {
+		req.SetPathValue("quizID", strconv.FormatInt(quizID, 10))
+	}
+
+	return withTestAdmin(req)
+}
+
+func TestHandleQuizImportSave_ReimportPreview(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.DiscardHandler)
+
+	t.Run("an editable quiz gets a diff and the merge/replace choice", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Capitals", "capitals"))
+
+		rr := httptest.NewRecorder()
+		HandleQuizImportSave(logger, nil, env.quizzes).ServeHTTP(rr, postReimport(t, "/admin/quizzes/import", 0, ""))
+
+		if got, want := rr.Code, http.StatusConflict; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		body := rr.Body.String()
+		for _, want := range []string{
+			`data-testid="reimport-preview"`,
+			`description: &#34;seeded&#34; → &#34;Updated&#34;`,
+			"What is the capital of Italy?",
+			"What is the capital of Germany?",
+			`added option &#34;Lyon&#34;`,
+			`removed option &#34;London&#34;`,
+			`action="/admin/quizzes/` + strconv.FormatInt(qz.ID, 10) + `/import"`,
+		} {
+			if !strings.Contains(body, want) {
+				t.Errorf("preview body should contain %q", want)
+			}
+		}
+
+		listed, err := env.quizzes.ListQuestions(t.Context(), qz.ID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v", err)
+		}
+		if got, want := len(listed), 2; got != want {
+			t.Errorf("questions after preview = %d, want %d (unchanged)", got, want)
+		}
+	})
+
+	t.Run("a published quiz gets the plain conflict", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		env.seedQuiz(t, publishedTwoQuestionQuiz("Capitals", "capitals"))
+
+		rr := httptest.NewRecorder()
+		HandleQuizImportSave(logger, nil, env.quizzes).ServeHTTP(rr, postReimport(t, "/admin/quizzes/import", 0, ""))
+
+		if got, want := rr.Code, http.StatusConflict; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		body := rr.Body.String()
+		if !strings.Contains(body, "change the title in the JSON") {
+			t.Error("body should tell the admin to change the title")
+		}
+		if strings.Contains(body, `data-testid="reimport-preview"`) {
+			t.Error("a published quiz should not offer a re-import")
+		}
+	})
+}
+
+func TestHandleQuizReimport(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.DiscardHandler)
+
+	tests := []struct {
+		strategy string
+		want     []string
+	}{
+		{
+			strategy: "merge",
+			want: []string{
+				"What is the capital of France?",
+				"What is the capital of Germany?",
+				"What is the capital of Italy?",
+			},
+		},
+		{
+			strategy: "replace",
+			want:     []string{"What is the capital of France?", "What is the capital of Italy?"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			t.Parallel()
+
+			env := newAdminEnv(t)
+			qz := env.seedQuiz(t, twoQuestionQuiz("Capitals", "capitals"))
+			france := qz.Questions[0]
+
+			rr := httptest.NewRecorder()
+			HandleQuizReimport(logger, nil, env.quizzes).ServeHTTP(
+				rr, postReimport(t, "/admin/quizzes/1/import", qz.ID, tt.strategy),
+			)
+
+			if got, want := rr.Code, http.StatusSeeOther; got != want {
+				t.Fatalf("status = %d, want %d", got, want)
+			}
+			got, err := env.quizzes.GetQuiz(t.Context(), qz.ID)
+			if err != nil {
+				t.Fatalf("GetQuiz err = %v", err)
+			}
+			if got, want := got.Description, "Updated"; got != want {
+				t.Errorf("Description = %q, want %q", got, want)
+			}
+			texts := make([]string, 0, len(got.Questions))
+			positions := make([]int, 0, len(got.Questions))
+			for _, q := range got.Questions {
+				texts = append(texts, q.Text)
+				positions = append(positions, q.Position)
+			}
+			if !slices.Equal(texts, tt.want) {
+				t.Errorf("questions = %q, want %q", texts, tt.want)
+			}
+			for i, pos := range positions {
+				if want := (i + 1) * quiz.RenumberStep; pos != want {
+					t.Errorf("questions[%d].Position = %d, want %d", i, pos, want)
+				}
+			}
+
+			kept := got.Questions[0]
+			if kept.ID != france.ID {
+				t.Errorf("France question ID = %d, want %d (kept)", kept.ID, france.ID)
+			}
+			if got, want := len(kept.Options), 2; got != want {
+				t.Fatalf("France options = %d, want %d", got, want)
+			}
+			if kept.Options[0].ID != france.Options[0].ID || kept.Options[0].Text != "Paris" {
+				t.Errorf("Paris option = %+v, want ID %d kept", kept.Options[0], france.Options[0].ID)
+			}
+			if got, want := kept.Options[1].Text, "Lyon"; got != want {
+				t.Errorf("second option = %q, want %q", got, want)
+			}
+		})
+	}
+
+	t.Run("unknown strategy is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Capitals", "capitals"))
+
+		rr := httptest.NewRecorder()
+		HandleQuizReimport(logger, nil, env.quizzes).ServeHTTP(
+			rr, postReimport(t, "/admin/quizzes/1/import", qz.ID, "overwrite"),
+		)
+
+		if got, want := rr.Code, http.StatusBadRequest; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("a title for another quiz is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Other", "other"))
+
+		rr := httptest.NewRecorder()
+		HandleQuizReimport(logger, nil, env.quizzes).ServeHTTP(
+			rr, postReimport(t, "/admin/quizzes/1/import", qz.ID, "replace"),
+		)
+
+		if got, want := rr.Code, http.StatusBadRequest; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("published quiz is locked", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, publishedTwoQuestionQuiz("Capitals", "capitals"))
+
+		rr := httptest.NewRecorder()
+		HandleQuizReimport(logger, nil, env.quizzes).ServeHTTP(
+			rr, postReimport(t, "/admin/quizzes/1/import", qz.ID, "merge"),
+		)
+
+		if got, want := rr.Code, http.StatusConflict; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+}
+
+func TestHandleQuizReimport_ByExternalRef(t *testing.T) {
+	t.Parallel()
+
+	env := newAdminEnv(t)
+	seed := twoQuestionQuiz("Old title", "old-title")
+	seed.ExternalRef = "cms-capitals"
+	seed.Questions[0].ExternalRef = "cms-france"
+	qz := env.seedQuiz(t, seed)
+	france := qz.Questions[0]
+
+	// The title and the France question's text both changed; the references
+	// still tie them to the stored quiz and question.
+	const body = `{
+  "title": "Capitals",
+  "description": "Synced",
+  "externalRef": "cms-capitals",
+  "questions": [
+    {
+      "text": "Which city is the capital of France?",
+      "externalRef": "cms-france",
+      "options": [
+        { "text": "Paris", "correct": true },
+        { "text": "London", "correct": false }
+      ]
+    }
+  ]
+}`
+	form := url.Values{"json": {body}, "mode": {string(quiz.ModeSolo)}, "strategy": {"replace"}}
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/quizzes/1/import",
+		strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("quizID", strconv.FormatInt(qz.ID, 10))
+
+	rr := httptest.NewRecorder()
+	HandleQuizReimport(slog.New(slog.DiscardHandler), nil, env.quizzes).ServeHTTP(rr, withTestAdmin(req))
+
+	if got, want := rr.Code, http.StatusSeeOther; got != want {
+		t.Fatalf("status = %d, want %d; body %s", got, want, rr.Body)
+	}
+	got, err := env.quizzes.GetQuiz(t.Context(), qz.ID)
+	if err != nil {
+		t.Fatalf("GetQuiz err = %v", err)
+	}
+	if got, want := got.Title, "Capitals"; got != want {
+		t.Errorf("Title = %q, want %q", got, want)
+	}
+	if got, want := len(got.Questions), 1; got != want {
+		t.Fatalf("questions = %d, want %d", got, want)
+	}
+	kept := got.Questions[0]
+	if kept.ID != france.ID {
+		t.Errorf("France question ID = %d, want %d (paired by reference)", kept.ID, france.ID)
+	}
+	if got, want := kept.Text, "Which city is the capital of France?"; got != want {
+		t.Errorf("Text = %q, want %q", got, want)
+	}
+	if got, want := kept.ExternalRef, "cms-france"; got != want {
+		t.Errorf("ExternalRef = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/starquake/topbanana/internal/handlers"
+)
+
+// importValidationCode is the error code of an import rejected by the form
+// rules; its response lists them under fields.
+const importValidationCode = "validationFailed"
+
+// importErrorBody is the error of a JSON import response: the
+// [handlers.ErrorBody] code and message, plus the failed form rules when
+// the quiz itself is invalid.
+type importErrorBody struct {
+	Code    string           `json:"code"`
+	Message string           `json:"message"`
+	Fields  ValidationErrors `json:"fields,omitempty"`
+}
+
+// importErrorResponse is the [handlers.Envelope] shape with the richer
+// error body.
+type importErrorResponse struct {
+	Error importErrorBody `json:"error"`
+}
+
+// importedQuizResponse is the data of a successful JSON import: the quiz
+// and where its admin page lives.
+type importedQuizResponse struct {
+	ID  int64  `json:"id"`
+	URL string `json:"url"`
+}
+
+// wantsJSON reports whether the import request asked for a JSON response
+// in its Accept header, as a script driving the import does; the form
+// posts without one and gets the HTML page.
+func wantsJSON(r *http.Request) bool {
+	for part := range strings.SplitSeq(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == "application/json" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeImportErrorJSON writes a failed import as an enveloped JSON error.
+// The code is [importValidationCode] when problems are given, otherwise the
+// one [handlers.StatusCode] derives from status.
+func writeImportErrorJSON(w http.ResponseWriter, status int, msg string, problems ValidationErrors) {
+	code := handlers.StatusCode(status)
+	if len(problems) > 0 {
+		code = importValidationCode
+	}
+	// A write error means the client is gone; there is nobody to tell.
+	_ = handlers.EncodeJSON(w, status, importErrorResponse{
+		Error: importErrorBody{Code: code, Message: msg, Fields: problems},
+	})
+}
+
+// writeImportDone finishes a successful import: a redirect to the quiz for
+// the form, or the quiz id and page URL for a JSON request.
+func writeImportDone(w http.ResponseWriter, r *http.Request, status int, quizID int64) {
+	url := fmt.Sprintf("/admin/quizzes/%d", quizID)
+	if !wantsJSON(r) {
+		http.Redirect(w, r, url, http.StatusSeeOther)
+
+		return
+	}
+	_ = handlers.EncodeJSON(w, status, handlers.Envelope{Data: importedQuizResponse{ID: quizID, URL: url}})
+}
@@ -190,14 +190,15 @@ func rollbackImport(
 		logger.WarnContext(cleanup, "import rollback failed to delete quiz",
 			slog.Int64("quiz_id", quizID), slog.Any("err", err))
 	}
-	if err := mediaSvc.RemoveQuizDir(quizID); err != nil {
+	if err := mediaSvc.RemoveQuizDir(cleanup, quizID); err != nil {
 		logger.WarnContext(cleanup, "import rollback failed to remove quiz media directory",
 			slog.Int64("quiz_id", quizID), slog.Any("err", err))
 	}
 }
 
 // writeArchiveImportError maps an import failure to the right response. A slug
-// collision is a clear 409 with the rename guidance. An archive whose manifest
+// collision is a clear 409 with the rename guidance, as is an external
+// reference another quiz already carries. An archive whose manifest
 // references a media file the archive does not contain is a malformed client
 // upload (400), not a server fault - the rollback has already removed the
 // partial quiz. Everything else is logged and rendered as a 500 (the import
@@ -213,6 +214,12 @@ func writeArchiveImportError(
 			"a quiz with this title already exists - rename it on the source instance "+
 				"or delete the existing one here, then import again",
 		)
+	case errors.Is(err, quiz.ErrExternalRefTaken):
+		renderErr(
+			w, r, http.StatusConflict,
+			"a quiz with this external reference already exists - re-import onto it or delete it here, "+
+				"then import again",
+		)
 	case errors.Is(err, ErrArchiveMediaMissing):
 		renderErr(
 			w, r, http.StatusBadRequest,
@@ -0,0 +1,166 @@
+package admin
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/render"
+)
+
+// printLayout is the template the quiz print sheet executes in place of
+// [baseLayout]: a bare page with print styles and none of the admin chrome.
+const printLayout = "print.gohtml"
+
+// optionLetters labels a printed question's options in order. A question
+// has at most maxOptions options, well within the alphabet.
+const optionLetters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// QuizPrintData is the data for the printable quiz sheet: the questions
+// numbered through the whole quiz in play order, grouped by round, and the
+// same numbering again for the answer key on its own page.
+type QuizPrintData struct {
+	QuizID      int64
+	Title       string
+	Description string
+	Rounds      []PrintRound
+	// ShowRounds is set when the quiz has more than one round, so a
+	// single-round quiz prints without a lone round heading.
+	ShowRounds bool
+	Total      int
+}
+
+// PrintRound is one round of the print sheet.
+type PrintRound struct {
+	Title     string
+	Questions []PrintQuestion
+}
+
+// PrintQuestion is one numbered question of the print sheet. A numeric
+// question has no Options: the sheet leaves a line to write the number on.
+type PrintQuestion struct {
+	Number  int
+	Text    string
+	Numeric bool
+	Multi   bool
+	Options []PrintOption
+	// HasImage and HasAudio flag a question whose picture or sound the paper
+	// cannot carry, so the host knows to show or play it.
+	HasImage bool
+	HasAudio bool
+	// Answer is the answer key line: the correct options' letters and text,
+	// or the numeric answer with its accepted range.
+	Answer string
+}
+
+// PrintOption is one lettered option of a printed question.
+type PrintOption struct {
+	Letter string
+	Text   string
+}
+
+// HandleQuizPrint serves GET /admin/quizzes/{quizID}/print, a printer-friendly
+// page of the quiz for a paper fallback round. Questions are numbered 1..N
+// across the whole quiz in the order the JSON export lists them (round by
+// round), options are lettered, and the answer key follows on a separate
+// page. Gated like the quiz view: a non-owner non-admin gets a 404.
+func HandleQuizPrint(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+	renderer := render.New(
+		logger, csrfMgr, parseTemplate("admin/pages/quizprint.gohtml"), printLayout, adminPerRequestFuncs,
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := handlers.ParseIDFromPath(w, r, logger, "quizID")
+		if !ok {
+			return
+		}
+		qz, ok := requireQuizViewAccess(w, r, logger, csrfMgr, quizStore, id)
+		if !ok {
+			return
+		}
+		rounds, ok := loadRounds(w, r, logger, csrfMgr, quizStore, id)
+		if !ok {
+			return
+		}
+
+		renderer.Render(w, r, http.StatusOK, newQuizPrintData(qz, rounds))
+	})
+}
+
+// newQuizPrintData numbers the quiz's questions in [exportOrderedQuestions]
+// order and groups them by round, leaving out a round with no questions.
+func newQuizPrintData(qz *quiz.Quiz, rounds []*quiz.Round) QuizPrintData {
+	data := QuizPrintData{QuizID: qz.ID, Title: qz.Title, Description: qz.Description}
+	byRound := make(map[int64]*PrintRound, len(rounds))
+	order := make([]int64, 0, len(rounds))
+	for _, q := range exportOrderedQuestions(qz, rounds) {
+		pr, seen := byRound[q.RoundID]
+		if !seen {
+			pr = &PrintRound{}
+			byRound[q.RoundID] = pr
+			order = append(order, q.RoundID)
+		}
+		data.Total++
+		pr.Questions = append(pr.Questions, newPrintQuestion(data.Total, q))
+	}
+	for _, rnd := range rounds {
+		if pr, ok := byRound[rnd.ID]; ok {
+			pr.Title = rnd.Title
+		}
+	}
+	for _, roundID := range order {
+		data.Rounds = append(data.Rounds, *byRound[roundID])
+	}
+	data.ShowRounds = len(data.Rounds) > 1
+
+	return data
+}
+
+// newPrintQuestion builds the printed form of q as question number n.
+func newPrintQuestion(n int, q *quiz.Question) PrintQuestion {
+	pq := PrintQuestion{
+		Number:   n,
+		Text:     q.Text,
+		Numeric:  q.Kind == quiz.KindNumeric,
+		Multi:    q.Kind == quiz.KindMulti,
+		HasImage: q.ImageMediaID != nil,
+		HasAudio: q.AudioMediaID != nil,
+	}
+	if pq.Numeric {
+		if len(q.Options) > 0 {
+			pq.Answer = numericAnswerKey(q.Options[0])
+		}
+
+		return pq
+	}
+	var correct []string
+	for i, o := range q.Options {
+		letter := string(optionLetters[i%len(optionLetters)])
+		pq.Options = append(pq.Options, PrintOption{Letter: letter, Text: o.Text})
+		if o.Correct {
+			correct = append(correct, letter+". "+o.Text)
+		}
+	}
+	pq.Answer = strings.Join(correct, "; ")
+
+	return pq
+}
+
+// numericAnswerKey renders a numeric answer key as its value, followed by the
+// range of answers that still score when the key has a tolerance.
+func numericAnswerKey(key *quiz.Option) string {
+	if key.NumericValue == nil {
+		return key.Text
+	}
+	value := *key.NumericValue
+	answer := quiz.FormatNumber(value)
+	if key.ToleranceBelow == 0 && key.ToleranceAbove == 0 {
+		return answer
+	}
+
+	return answer + " (accepts " + quiz.FormatNumber(value-key.ToleranceBelow) +
+		" to " + quiz.FormatNumber(value+key.ToleranceAbove) + ")"
+}
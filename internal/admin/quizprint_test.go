@@ -0,0 +1,106 @@
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+func printRequest(t *testing.T, quizID int64, player *auth.Player) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(
+		t.Context(), http.MethodGet, "/admin/quizzes/"+strconv.FormatInt(quizID, 10)+"/print", nil,
+	)
+	req.SetPathValue("quizID", strconv.FormatInt(quizID, 10))
+
+	return req.WithContext(auth.WithPlayer(req.Context(), player))
+}
+
+// TestHandleQuizPrint pins the print sheet: questions are numbered 1..N
+// through the rounds in export order with lettered options, the answer key
+// repeats the numbering on its own page (a numeric key with its accepted
+// range), and a non-owner non-admin gets the quiz view's opaque 404.
+func TestHandleQuizPrint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("owner prints numbered sheet and answer key", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := roundedQuiz()
+		answer := 1889.0
+		qz.Rounds[1].Questions = append(qz.Rounds[1].Questions, &quiz.Question{
+			Text:     "Year the Eiffel Tower opened?",
+			Kind:     quiz.KindNumeric,
+			Position: 4,
+			Options: []*quiz.Option{
+				{Text: "1889", Correct: true, NumericValue: &answer, ToleranceBelow: 2, ToleranceAbove: 2},
+			},
+		})
+		qz = env.seedQuiz(t, qz)
+
+		rr := httptest.NewRecorder()
+		HandleQuizPrint(env.logger, nil, env.quizzes).ServeHTTP(
+			rr, printRequest(t, qz.ID, &auth.Player{ID: testAdminID, Role: auth.RoleAdmin}),
+		)
+
+		if got, want := rr.Code, http.StatusOK; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		body := rr.Body.String()
+		sheet, key, found := strings.Cut(body, `data-testid="print-answer-key"`)
+		if !found {
+			t.Fatalf("body has no answer key section:\n%s", body)
+		}
+		for _, want := range []string{
+			"<h2>Warm-up</h2>",
+			"<strong>1.</strong> Capital of France?",
+			"<strong>2.</strong> Capital of Spain?",
+			"<h2>Finish</h2>",
+			"<strong>3.</strong> Capital of Italy?",
+			"<strong>4.</strong> Year the Eiffel Tower opened?",
+			"<li>A. Paris</li>",
+			"<li>B. Lyon</li>",
+		} {
+			if !strings.Contains(sheet, want) {
+				t.Errorf("question sheet missing %q", want)
+			}
+		}
+		if strings.Contains(sheet, "accepts") {
+			t.Errorf("question sheet gives away the numeric answer:\n%s", sheet)
+		}
+		for _, want := range []string{
+			"<strong>1.</strong> A. Paris",
+			"<strong>2.</strong> A. Madrid",
+			"<strong>3.</strong> A. Rome",
+			"<strong>4.</strong> 1889 (accepts 1887 to 1891)",
+		} {
+			if !strings.Contains(key, want) {
+				t.Errorf("answer key missing %q", want)
+			}
+		}
+	})
+
+	t.Run("non-owner is an opaque 404", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, ownedQuiz("Owned", "owned-quiz"))
+
+		rr := httptest.NewRecorder()
+		HandleQuizPrint(env.logger, nil, env.quizzes).ServeHTTP(
+			rr, printRequest(t, qz.ID, &auth.Player{ID: nonOwnerID, Role: auth.RolePlayer}),
+		)
+
+		if got, want := rr.Code, http.StatusNotFound; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+	})
+}
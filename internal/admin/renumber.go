@@ -0,0 +1,105 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// quizRenumberData backs quizrenumber.gohtml: the before/after position
+// preview shown ahead of the renumber confirm.
+type quizRenumberData struct {
+	Title   string
+	Quiz    *QuizData
+	Changes []quiz.PositionChange
+	// Pending is how many questions the renumber would move; zero means
+	// the positions are already clean and the confirm is disabled.
+	Pending int
+	Step    int
+	// Plan is the preview's quiz.PlanFingerprint, posted back with the
+	// confirm so a plan changed in between is refused.
+	Plan string
+}
+
+// HandleQuizRenumberPreview renders the question order with each question's
+// current and renumbered position, so the host can check the result before
+// committing it.
+func HandleQuizRenumberPreview(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+	renderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/quizrenumber.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		quizID, ok := handlers.ParseIDFromPath(w, r, logger, "quizID")
+		if !ok {
+			return
+		}
+
+		qz, ok := requireEditableQuizOwner(w, r, logger, csrfMgr, quizStore, quizID)
+		if !ok {
+			return
+		}
+
+		changes := quiz.PlanRenumber(qz.Questions)
+		pending := 0
+		for _, c := range changes {
+			if c.Changed() {
+				pending++
+			}
+		}
+
+		quizData := quizDataFromQuiz(qz)
+		attachCanEdit(r, quizData)
+		renderer.Render(w, r, http.StatusOK, quizRenumberData{
+			Title:   "Admin Dashboard - Renumber Questions",
+			Quiz:    quizData,
+			Changes: changes,
+			Pending: pending,
+			Step:    quiz.RenumberStep,
+			Plan:    quiz.PlanFingerprint(changes),
+		})
+	})
+}
+
+// HandleQuizRenumber rewrites the quiz's question positions to clean
+// increments and redirects to the quiz view. The form carries the preview's
+// plan fingerprint: a missing one is a 400, and one the current questions no
+// longer produce (someone edited the quiz after the preview) is a 409 that
+// offers the preview again, with nothing written.
+func HandleQuizRenumber(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		quizID, ok := handlers.ParseIDFromPath(w, r, logger, "quizID")
+		if !ok {
+			return
+		}
+
+		if _, ok = requireEditableQuizOwner(w, r, logger, csrfMgr, quizStore, quizID); !ok {
+			return
+		}
+
+		plan := r.PostFormValue("plan")
+		if plan == "" {
+			render400(w, r, logger, csrfMgr, "Missing renumber plan.")
+
+			return
+		}
+
+		if err := quizStore.RenumberQuestions(r.Context(), quizID, plan); err != nil {
+			if errors.Is(err, quiz.ErrStaleUpdate) {
+				renderStaleUpdate(w, r, logger, csrfMgr, fmt.Sprintf("/admin/quizzes/%d/renumber", quizID), quizID)
+
+				return
+			}
+			logger.ErrorContext(r.Context(), "error renumbering questions", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		http.Redirect(w, r, "/admin/quizzes/"+strconv.FormatInt(quizID, 10), http.StatusSeeOther)
+	})
+}
@@ -0,0 +1,176 @@
+package admin_test
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+func TestHandleQuizRenumberPreview(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.DiscardHandler)
+
+	t.Run("lists current and renumbered positions without writing", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Draft", "draft"))
+
+		handler := HandleQuizRenumberPreview(logger, nil, env.quizzes)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, publishRequest(t, http.MethodGet, "/admin/quizzes/1/renumber", qz.ID))
+
+		if got, want := rr.Code, http.StatusOK; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		body := rr.Body.String()
+		for _, want := range []string{
+			"What is the capital of France?",
+			"2 of 2 questions change",
+			`action="/admin/quizzes/` + strconv.FormatInt(qz.ID, 10) + `/renumber"`,
+		} {
+			if !strings.Contains(body, want) {
+				t.Errorf("preview body should contain %q", want)
+			}
+		}
+
+		listed, err := env.quizzes.ListQuestions(t.Context(), qz.ID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v", err)
+		}
+		if got, want := listed[0].Position, 1; got != want {
+			t.Errorf("Position after preview = %d, want %d (unchanged)", got, want)
+		}
+	})
+
+	t.Run("published quiz is locked", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, publishedTwoQuestionQuiz("Pub", "pub"))
+
+		handler := HandleQuizRenumberPreview(logger, nil, env.quizzes)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, publishRequest(t, http.MethodGet, "/admin/quizzes/1/renumber", qz.ID))
+
+		if got, want := rr.Code, http.StatusConflict; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+}
+
+// renumberRequest is a POST /admin/quizzes/{quizID}/renumber carrying plan
+// as the confirm form's fingerprint.
+func renumberRequest(t *testing.T, quizID int64, plan string) *http.Request {
+	t.Helper()
+	form := url.Values{"plan": {plan}}
+	req := httptest.NewRequestWithContext(
+		t.Context(), http.MethodPost, "/admin/quizzes/1/renumber", strings.NewReader(form.Encode()),
+	)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("quizID", strconv.FormatInt(quizID, 10))
+
+	return withTestAdmin(req)
+}
+
+func TestHandleQuizRenumber(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.DiscardHandler)
+
+	// previewPlan is the fingerprint the preview renders for the quiz now.
+	previewPlan := func(t *testing.T, env *adminEnv, quizID int64) string {
+		t.Helper()
+		listed, err := env.quizzes.ListQuestions(t.Context(), quizID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v", err)
+		}
+
+		return quiz.PlanFingerprint(quiz.PlanRenumber(listed))
+	}
+	positions := func(t *testing.T, env *adminEnv, quizID int64) []int {
+		t.Helper()
+		listed, err := env.quizzes.ListQuestions(t.Context(), quizID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v", err)
+		}
+		got := make([]int, 0, len(listed))
+		for _, qs := range listed {
+			got = append(got, qs.Position)
+		}
+
+		return got
+	}
+
+	t.Run("applies the previewed plan and redirects to the quiz view", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Draft", "draft"))
+
+		handler := HandleQuizRenumber(logger, nil, env.quizzes)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, renumberRequest(t, qz.ID, previewPlan(t, env, qz.ID)))
+
+		if got, want := rr.Code, http.StatusSeeOther; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		if got, want := rr.Header().Get("Location"), "/admin/quizzes/"+strconv.FormatInt(qz.ID, 10); got != want {
+			t.Errorf("Location = %q, want %q", got, want)
+		}
+		if got, want := positions(t, env, qz.ID), []int{10, 20}; !slices.Equal(got, want) {
+			t.Errorf("positions = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("a plan changed since the preview is a conflict", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Draft", "draft"))
+		plan := previewPlan(t, env, qz.ID)
+		added := &quiz.Question{QuizID: qz.ID, Text: "Added after the preview", Position: 5}
+		if err := env.quizzes.CreateQuestion(t.Context(), added); err != nil {
+			t.Fatalf("CreateQuestion err = %v", err)
+		}
+
+		handler := HandleQuizRenumber(logger, nil, env.quizzes)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, renumberRequest(t, qz.ID, plan))
+
+		if got, want := rr.Code, http.StatusConflict; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		wantReload := `href="/admin/quizzes/` + strconv.FormatInt(qz.ID, 10) + `/renumber"`
+		if body := rr.Body.String(); !strings.Contains(body, wantReload) {
+			t.Errorf("conflict body should offer the preview again (%s)", wantReload)
+		}
+		if got, want := positions(t, env, qz.ID), []int{1, 2, 5}; !slices.Equal(got, want) {
+			t.Errorf("positions after a conflict = %v, want %v (unchanged)", got, want)
+		}
+	})
+
+	t.Run("missing plan is a bad request", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Draft", "draft"))
+
+		handler := HandleQuizRenumber(logger, nil, env.quizzes)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, renumberRequest(t, qz.ID, ""))
+
+		if got, want := rr.Code, http.StatusBadRequest; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+}
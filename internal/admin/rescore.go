@@ -0,0 +1,142 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/livesession"
+)
+
+// rescoreURL is the score recalculation page; the apply form redirects back
+// to it.
+const rescoreURL = "/admin/scores/recalculate"
+
+// ScoreRecalculator replays stored live-session scores through the current
+// scoring curve. *livesession.Rescorer satisfies it.
+type ScoreRecalculator interface {
+	Rescore(ctx context.Context, quizID int64, joinCode string, apply bool) (*livesession.RescoreReport, error)
+}
+
+// rescorePageData backs rescore.gohtml. QuizID and JoinCode echo the form so
+// the apply form resubmits exactly what the dry run showed. Report is nil
+// until a quiz is chosen.
+type rescorePageData struct {
+	Title    string
+	QuizID   string
+	JoinCode string
+	Report   *livesession.RescoreReport
+	Notice   string
+	Error    string
+}
+
+// HandleRescorePage renders GET /admin/scores/recalculate, the Admin-only
+// score recalculation tool. With ?quiz= set it runs a dry run for that quiz
+// (narrowed to one room by ?code=) and lists every stored live score the
+// current scoring curve would change, plus the affected game totals; nothing
+// is written. The apply form on the page posts to [HandleRescoreApply].
+func HandleRescorePage(
+	logger *slog.Logger,
+	csrfMgr *csrf.Manager,
+	recalc ScoreRecalculator,
+	flash *auth.SignedFlash,
+) http.Handler {
+	render := NewTemplateRenderer(logger, csrfMgr, "admin/pages/rescore.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := rescorePageData{
+			Title:    "Admin Dashboard - Recalculate scores",
+			QuizID:   strings.TrimSpace(r.URL.Query().Get("quiz")),
+			JoinCode: strings.TrimSpace(r.URL.Query().Get("code")),
+		}
+		if flash != nil {
+			if fr := flash.Read(w, r); fr.OK {
+				data.Notice = fr.Notice
+				data.Error = fr.Err
+			}
+		}
+
+		if data.QuizID != "" {
+			quizID, ok := parseRescoreQuizID(data.QuizID)
+			if !ok {
+				data.Error = rescoreQuizIDMessage
+				render.Render(w, r, http.StatusBadRequest, data)
+
+				return
+			}
+			var err error
+			data.Report, err = recalc.Rescore(r.Context(), quizID, data.JoinCode, false)
+			if err != nil {
+				logger.ErrorContext(r.Context(), "error running score recalculation dry run", slog.Any("err", err))
+				render500(w, r, logger, csrfMgr)
+
+				return
+			}
+		}
+		render.Render(w, r, http.StatusOK, data)
+	})
+}
+
+// HandleRescoreApply handles POST /admin/scores/recalculate: it recomputes
+// the chosen quiz's (or room's) stored live scores and writes the changes,
+// then redirects back to the page, whose fresh dry run should now be empty.
+// The outcome is reported in the flash.
+func HandleRescoreApply(logger *slog.Logger, recalc ScoreRecalculator, flash *auth.SignedFlash) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			logger.InfoContext(r.Context(), "score recalculation form parse failed", slog.Any("err", err))
+			flash.SetError(w, "Form was malformed or too large.", 0)
+			http.Redirect(w, r, rescoreURL, http.StatusSeeOther)
+
+			return
+		}
+
+		rawQuizID := strings.TrimSpace(r.PostFormValue("quiz_id"))
+		joinCode := strings.TrimSpace(r.PostFormValue("join_code"))
+		quizID, ok := parseRescoreQuizID(rawQuizID)
+		if !ok {
+			flash.SetError(w, rescoreQuizIDMessage, 0)
+			http.Redirect(w, r, rescoreURL, http.StatusSeeOther)
+
+			return
+		}
+
+		report, err := recalc.Rescore(r.Context(), quizID, joinCode, true)
+		switch {
+		case err != nil:
+			logger.ErrorContext(r.Context(), "error applying score recalculation", slog.Any("err", err))
+			flash.SetError(w, "Could not recalculate the scores. Nothing was changed; try again.", 0)
+		case len(report.Changes) == 0:
+			flash.SetNotice(w, "No stored scores needed changing.")
+		default:
+			flash.SetNotice(w, fmt.Sprintf("Recalculated %d scores.", len(report.Changes)))
+		}
+
+		target := url.Values{"quiz": {rawQuizID}}
+		if joinCode != "" {
+			target.Set("code", joinCode)
+		}
+		http.Redirect(w, r, rescoreURL+"?"+target.Encode(), http.StatusSeeOther)
+	})
+}
+
+// rescoreQuizIDMessage is shown for a missing or malformed quiz id on the
+// recalculation form.
+const rescoreQuizIDMessage = "Quiz ID must be a positive number."
+
+// parseRescoreQuizID parses the quiz id typed into the recalculation form,
+// reporting false for anything but a positive integer.
+func parseRescoreQuizID(raw string) (int64, bool) {
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+
+	return id, true
+}
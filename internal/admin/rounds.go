@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/starquake/topbanana/internal/audit"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/handlers"
 	"github.com/starquake/topbanana/internal/htmx"
@@ -24,7 +25,7 @@ type roundFormData struct {
 	Title       string
 	Quiz        *QuizData
 	Round       *RoundData
-	FieldErrors map[string]string
+	FieldErrors ValidationErrors
 	FormError   string
 }
 
@@ -319,7 +320,9 @@ func positionFromForm(
 // HandleRoundDelete removes a round. Owner-gated so only the quiz's
 // creator can drop one of its rounds. Deleting a round cascades to its
 // questions via the ON DELETE CASCADE on questions.round_id.
-func HandleRoundDelete(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store) http.Handler {
+func HandleRoundDelete(
+	logger *slog.Logger, csrfMgr *csrf.Manager, quizStore quiz.Store, events audit.Store,
+) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		quizID, ok := handlers.ParseIDFromPath(w, r, logger, "quizID")
 		if !ok {
@@ -339,7 +342,8 @@ func HandleRoundDelete(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore qui
 		// otherwise delete a round belonging to a different quiz by
 		// mounting the round's id on this URL. Mirrors the question
 		// IDOR gate (#339).
-		if _, ok = roundByID(w, r, logger, csrfMgr, quizStore, quizID, roundID); !ok {
+		rnd, ok := roundByID(w, r, logger, csrfMgr, quizStore, quizID, roundID)
+		if !ok {
 			return
 		}
 
@@ -354,6 +358,9 @@ func HandleRoundDelete(logger *slog.Logger, csrfMgr *csrf.Manager, quizStore qui
 
 			return
 		}
+		recordAudit(r, logger, events,
+			&audit.Event{EntityType: audit.EntityRound, EntityID: roundID, Action: audit.ActionDelete},
+			roundAuditFields(rnd), nil)
 
 		// htmx removes the round section in place via an outerHTML swap;
 		// its questions ride along inside the swapped fragment because the
@@ -496,14 +503,14 @@ func renderRoundForm(
 	r *http.Request,
 	renderer *render.Renderer,
 	gctx *roundSaveCtx,
-	fieldErrors map[string]string,
+	fieldErrors ValidationErrors,
 	formError string,
 ) {
 	title := "Admin Dashboard - Round Edit"
 	if gctx.IsNew {
 		title = "Admin Dashboard - Round Create"
 	}
-	status := http.StatusBadRequest
+	status := http.StatusUnprocessableEntity
 	if formError != "" && len(fieldErrors) == 0 {
 		status = http.StatusConflict
 	}
@@ -518,14 +525,14 @@ func renderRoundForm(
 
 // fillRoundFromForm reads the form into the supplied round struct. A
 // parse error renders a 400 and returns (nil, false); a validation
-// error returns a non-empty map + true; success returns (nil, true).
+// error returns the problems + true; success returns (nil, true).
 func fillRoundFromForm(
 	w http.ResponseWriter,
 	r *http.Request,
 	logger *slog.Logger,
 	csrfMgr *csrf.Manager,
 	g *quiz.Round,
-) (map[string]string, bool) {
+) (ValidationErrors, bool) {
 	r.Body = http.MaxBytesReader(w, r.Body, maxFormSize)
 	if err := r.ParseForm(); err != nil {
 		msg := "error parsing form"
@@ -611,17 +618,17 @@ type roundForm struct {
 	round *quiz.Round
 }
 
-// Valid checks every form-level rule on the wrapped round. An empty map
-// means the form is valid.
-func (f *roundForm) Valid(_ context.Context) map[string]string {
-	problems := map[string]string{}
+// Valid checks every form-level rule on the wrapped round. An empty
+// result means the form is valid.
+func (f *roundForm) Valid(_ context.Context) ValidationErrors {
+	var problems ValidationErrors
 	if f.round.Title == "" {
-		problems["title"] = "Give the round a name."
+		problems.add("title", CodeRequired, "Give the round a name.")
 	}
 	if f.round.BoundaryDurationSeconds != nil {
 		v := *f.round.BoundaryDurationSeconds
 		if v < quiz.MinTimeLimitSeconds || v > quiz.MaxTimeLimitSeconds {
-			problems["boundarydurationseconds"] = fmt.Sprintf(
+			problems.addf("boundarydurationseconds", CodeOutOfRange,
 				"Round-boundary duration must be between %d and %d seconds, or blank to inherit the quiz default",
 				quiz.MinTimeLimitSeconds, quiz.MaxTimeLimitSeconds,
 			)
@@ -287,7 +287,7 @@ func deleteRound(
 	actor func(*http.Request) *http.Request,
 ) *httptest.ResponseRecorder {
 	t.Helper()
-	handler := HandleRoundDelete(slog.New(slog.DiscardHandler), newRoundsCSRF(), env.quizzes)
+	handler := HandleRoundDelete(slog.New(slog.DiscardHandler), newRoundsCSRF(), env.quizzes, env.audit)
 
 	req := httptest.NewRequestWithContext(
 		t.Context(), http.MethodPost,
@@ -440,7 +440,7 @@ func TestHandleRoundSave(t *testing.T) {
 		rec := postRoundSave(
 			t, env, strconv.FormatInt(f.quiz.ID, 10), url.Values{"title": {""}}, adminActor,
 		)
-		if got, want := rec.Code, http.StatusBadRequest; got != want {
+		if got, want := rec.Code, http.StatusUnprocessableEntity; got != want {
 			t.Errorf("status = %d, want %d", got, want)
 		}
 	})
@@ -513,7 +513,7 @@ func TestHandleRoundSave(t *testing.T) {
 			t, env, strconv.FormatInt(f.quiz.ID, 10),
 			url.Values{"title": {"Bad Round"}, "boundary_duration_seconds": {"9999"}}, adminActor,
 		)
-		if got, want := rec.Code, http.StatusBadRequest; got != want {
+		if got, want := rec.Code, http.StatusUnprocessableEntity; got != want {
 			t.Errorf("status = %d, want %d", got, want)
 		}
 	})
@@ -0,0 +1,151 @@
+package admin
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// Field types of a [schemaField]. A checkbox is on when its form value is
+// the field's Value and off when the field is left out; an id is a row id
+// in decimal, blank for none.
+const (
+	schemaTypeString   = "string"
+	schemaTypeInteger  = "integer"
+	schemaTypeNumber   = "number"
+	schemaTypeEnum     = "enum"
+	schemaTypeID       = "id"
+	schemaTypeCheckbox = "checkbox"
+)
+
+// checkboxOn is the value the question form's checkboxes post when ticked.
+const checkboxOn = "on"
+
+// schemaField describes one input of an admin form: its form-field name,
+// its type and the limits the server-side validation applies to it.
+type schemaField struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+	Values   []string `json:"values,omitempty"`
+	Default  string   `json:"default,omitempty"`
+	Value    string   `json:"value,omitempty"`
+}
+
+// optionsSchema describes the option rows of the question form: row i posts
+// its fields as "option[i].<name>", for i below MaxCount.
+type optionsSchema struct {
+	NameFormat string        `json:"nameFormat"`
+	MinCount   int           `json:"minCount"`
+	MaxCount   int           `json:"maxCount"`
+	Fields     []schemaField `json:"fields"`
+}
+
+// kindSchema is the per-kind part of the question rules: which option counts
+// and how many correct options a kind accepts, or, for a numeric question,
+// the fields that replace the option rows. A nil bound is unchecked;
+// NotAllCorrect refuses a question with every option marked correct.
+type kindSchema struct {
+	Kind          string        `json:"kind"`
+	MinOptions    *int          `json:"minOptions,omitempty"`
+	MaxOptions    *int          `json:"maxOptions,omitempty"`
+	MinCorrect    *int          `json:"minCorrect,omitempty"`
+	MaxCorrect    *int          `json:"maxCorrect,omitempty"`
+	NotAllCorrect bool          `json:"notAllCorrect,omitempty"`
+	Fields        []schemaField `json:"fields,omitempty"`
+}
+
+// questionSchema is the GET /admin/api/schema/question body: the question
+// form's fields, its option rows, the per-kind rules and the validation
+// codes a rejected save reports.
+type questionSchema struct {
+	Fields  []schemaField `json:"fields"`
+	Options optionsSchema `json:"options"`
+	Kinds   []kindSchema  `json:"kinds"`
+	Codes   []string      `json:"codes"`
+}
+
+// bound returns a pointer to v, for the optional limits of a schema.
+func bound[T int | float64](v T) *T {
+	return &v
+}
+
+// newQuestionSchema builds the question form schema from the same limits
+// [questionForm.Valid] and [fillQuestionFromForm] enforce, so an external
+// editor reading it agrees with the server on what a save accepts.
+func newQuestionSchema() questionSchema {
+	minOptions := 1
+	// A multi-select question needs a correct option and an unmarked one.
+	multiMinOptions := 2
+	trueFalseOptions := 2
+
+	return questionSchema{
+		Fields: []schemaField{
+			{Name: "text", Type: schemaTypeString, Required: true},
+			{Name: "explanation", Type: schemaTypeString},
+			{Name: "hint", Type: schemaTypeString},
+			{Name: "kind", Type: schemaTypeEnum, Values: quiz.KindValues(), Default: quiz.KindChoice},
+			{
+				Name: "time_limit_seconds", Type: schemaTypeInteger,
+				Min: bound[float64](quiz.MinTimeLimitSeconds), Max: bound[float64](quiz.MaxTimeLimitSeconds),
+			},
+			{Name: "image_media_id", Type: schemaTypeID},
+			{Name: "audio_media_id", Type: schemaTypeID},
+			{Name: "audio_repeat", Type: schemaTypeCheckbox, Value: checkboxOn},
+			{Name: "after_question_id", Type: schemaTypeID},
+			{Name: "round_id", Type: schemaTypeID},
+			{Name: "version", Type: schemaTypeInteger},
+		},
+		Options: optionsSchema{
+			NameFormat: "option[%d]",
+			MinCount:   minOptions,
+			MaxCount:   maxOptions,
+			Fields: []schemaField{
+				{Name: "id", Type: schemaTypeID},
+				{Name: "text", Type: schemaTypeString, Required: true},
+				{Name: "correct", Type: schemaTypeCheckbox, Value: checkboxOn},
+			},
+		},
+		Kinds: []kindSchema{
+			{Kind: quiz.KindChoice, MinOptions: bound(minOptions), MaxOptions: bound(maxOptions), NotAllCorrect: true},
+			{
+				Kind:       quiz.KindMulti,
+				MinOptions: bound(multiMinOptions), MaxOptions: bound(maxOptions),
+				MinCorrect: bound(1), NotAllCorrect: true,
+			},
+			{
+				Kind:       quiz.KindTrueFalse,
+				MinOptions: bound(trueFalseOptions), MaxOptions: bound(trueFalseOptions),
+				MinCorrect: bound(1), MaxCorrect: bound(1),
+			},
+			{Kind: quiz.KindNumeric, Fields: []schemaField{
+				{Name: "numeric_value", Type: schemaTypeNumber, Required: true},
+				{Name: "tolerance_below", Type: schemaTypeNumber, Min: bound[float64](0), Default: "0"},
+				{Name: "tolerance_above", Type: schemaTypeNumber, Min: bound[float64](0), Default: "0"},
+			}},
+		},
+		Codes: []string{
+			CodeRequired, CodeOutOfRange, CodeInvalidChoice, CodeInvalidNumber, CodeTooMany,
+			CodeNoCorrectOption, CodeTrueFalseShape, CodeAllCorrect, CodeNotLivePlayable, CodeInvalid,
+		},
+	}
+}
+
+// HandleQuestionSchema serves the question form's field definitions as JSON
+// (GET /admin/api/schema/question), for authoring tools that build
+// questions outside the admin pages and want to validate them the way the
+// server will before posting. The schema only changes with a deploy, so it
+// is built once.
+func HandleQuestionSchema(logger *slog.Logger) http.Handler {
+	schema := newQuestionSchema()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := handlers.WriteData(w, r, http.StatusOK, schema); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding question schema", slog.Any("err", err))
+		}
+	})
+}
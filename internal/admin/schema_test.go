@@ -0,0 +1,121 @@
+package admin_test
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// schemaFieldDoc mirrors a field of the question schema.
+type schemaFieldDoc struct {
+	Name string   `json:"name"`
+	Min  *float64 `json:"min"`
+	Max  *float64 `json:"max"`
+}
+
+// questionSchemaDoc mirrors the parts of the question schema the test reads.
+type questionSchemaDoc struct {
+	Fields  []schemaFieldDoc `json:"fields"`
+	Options struct {
+		MaxCount int `json:"maxCount"`
+	} `json:"options"`
+	Kinds []struct {
+		Kind          string `json:"kind"`
+		MinOptions    *int   `json:"minOptions"`
+		MaxOptions    *int   `json:"maxOptions"`
+		MinCorrect    *int   `json:"minCorrect"`
+		NotAllCorrect bool   `json:"notAllCorrect"`
+	} `json:"kinds"`
+	Codes []string `json:"codes"`
+}
+
+// questionWithOptions builds a question of kind with n options, the first
+// correct of them marked correct.
+func questionWithOptions(kind string, n, correct int) *quiz.Question {
+	q := &quiz.Question{Text: "Q?", Kind: kind}
+	for i := range n {
+		q.Options = append(q.Options, &quiz.Option{Text: "o" + strconv.Itoa(i), Correct: i < correct})
+	}
+
+	return q
+}
+
+// TestHandleQuestionSchema pins that the published schema agrees with the
+// question form's validation: each kind's option bounds are accepted at the
+// limit and refused past it, every option correct is refused where the
+// schema says so, and the time-limit range is the enforced one.
+func TestHandleQuestionSchema(t *testing.T) {
+	t.Parallel()
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/admin/api/schema/question", nil)
+	HandleQuestionSchema(slog.New(slog.DiscardHandler)).ServeHTTP(rr, req)
+
+	if got, want := rr.Code, http.StatusOK; got != want {
+		t.Fatalf("status = %d, want %d", got, want)
+	}
+	var doc questionSchemaDoc
+	if err := json.NewDecoder(rr.Body).Decode(&doc); err != nil {
+		t.Fatalf("decode err = %v, want nil", err)
+	}
+
+	if got, want := len(doc.Kinds), len(quiz.KindValues()); got != want {
+		t.Fatalf("len(Kinds) = %d, want %d", got, want)
+	}
+	for _, k := range doc.Kinds {
+		if k.MaxOptions == nil {
+			continue
+		}
+		minCorrect := 0
+		if k.MinCorrect != nil {
+			minCorrect = *k.MinCorrect
+		}
+		for _, n := range []int{*k.MinOptions, *k.MaxOptions} {
+			correct := max(minCorrect, 1)
+			if k.NotAllCorrect && correct >= n {
+				correct = n - 1
+			}
+			if problems := ValidQuestion(questionWithOptions(k.Kind, n, correct)); len(problems) != 0 {
+				t.Errorf("%s with %d options: problems = %v, want none", k.Kind, n, problems)
+			}
+		}
+		if k.NotAllCorrect {
+			n := *k.MaxOptions
+			if problems := ValidQuestion(questionWithOptions(k.Kind, n, n)); len(problems) == 0 {
+				t.Errorf("%s with every option correct: no problems, want one", k.Kind)
+			}
+		}
+		if problems := ValidQuestion(questionWithOptions(k.Kind, *k.MaxOptions+1, 1)); len(problems) == 0 {
+			t.Errorf("%s with %d options: no problems, want one past the limit", k.Kind, *k.MaxOptions+1)
+		}
+		if minCorrect > 0 {
+			if problems := ValidQuestion(questionWithOptions(k.Kind, *k.MaxOptions, 0)); len(problems) == 0 {
+				t.Errorf("%s with no correct option: no problems, want one", k.Kind)
+			}
+		}
+	}
+	if got := doc.Options.MaxCount; got < 1 {
+		t.Errorf("Options.MaxCount = %d, want at least 1", got)
+	}
+
+	i := slices.IndexFunc(doc.Fields, func(f schemaFieldDoc) bool { return f.Name == "time_limit_seconds" })
+	if i < 0 {
+		t.Fatal("no time_limit_seconds field")
+	}
+	limit := doc.Fields[i]
+	if limit.Min == nil || limit.Max == nil ||
+		*limit.Min != quiz.MinTimeLimitSeconds || *limit.Max != quiz.MaxTimeLimitSeconds {
+		t.Errorf("time_limit_seconds bounds = %v..%v, want %d..%d",
+			limit.Min, limit.Max, quiz.MinTimeLimitSeconds, quiz.MaxTimeLimitSeconds)
+	}
+	if !slices.Contains(doc.Codes, CodeTrueFalseShape) {
+		t.Errorf("Codes = %v, want %q among them", doc.Codes, CodeTrueFalseShape)
+	}
+}
@@ -1,11 +1,14 @@
 package admin
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/csrf"
 )
 
@@ -20,12 +23,14 @@ type adminRow struct {
 }
 
 // settingsPageData backs settings.gohtml. Admins carries the current top-tier
-// Admins listed on the settings page.
+// Admins listed on the settings page; Branding pre-fills the branding form
+// with the stored (not defaulted) values.
 type settingsPageData struct {
-	Title  string
-	Admins []adminRow
-	Notice string
-	Error  string
+	Title    string
+	Admins   []adminRow
+	Branding branding.Branding
+	Notice   string
+	Error    string
 }
 
 // HandleSettings renders GET /admin/settings (#320/#538), the Admin-only
@@ -62,8 +67,9 @@ func HandleSettings(
 		}
 
 		data := settingsPageData{
-			Title:  "Admin Dashboard - Settings",
-			Admins: rows,
+			Title:    "Admin Dashboard - Settings",
+			Admins:   rows,
+			Branding: branding.FromContext(r.Context()),
 		}
 		if flash != nil {
 			if fr := flash.Read(w, r); fr.OK {
@@ -74,3 +80,43 @@ func HandleSettings(
 		render.Render(w, r, http.StatusOK, data)
 	})
 }
+
+// settingsURL is where the settings form handlers redirect back to.
+const settingsURL = "/admin/settings"
+
+// HandleBrandingSave handles POST /admin/settings/branding: the Admin-only
+// branding form. It stores the name, logo URL and primary color through svc
+// (which refreshes the cached branding every page reads) and redirects back
+// to the settings page with a flash. A blank field restores that field's
+// default; an invalid value is reported in the flash and nothing is stored.
+func HandleBrandingSave(logger *slog.Logger, svc *branding.Service, flash *auth.SignedFlash) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			logger.InfoContext(r.Context(), "branding form parse failed", slog.Any("err", err))
+			flash.SetError(w, "Form was malformed or too large.", 0)
+			http.Redirect(w, r, settingsURL, http.StatusSeeOther)
+
+			return
+		}
+
+		_, err := svc.Save(r.Context(), branding.Branding{
+			Name:         r.PostFormValue("name"),
+			LogoURL:      r.PostFormValue("logo_url"),
+			PrimaryColor: r.PostFormValue("primary_color"),
+		})
+		switch {
+		case errors.Is(err, branding.ErrNameTooLong):
+			flash.SetError(w, fmt.Sprintf("Name must be at most %d characters.", branding.MaxNameLength), 0)
+		case errors.Is(err, branding.ErrInvalidLogoURL):
+			flash.SetError(w, "Logo URL must be a path on this site, such as /media/logo.png.", 0)
+		case errors.Is(err, branding.ErrInvalidPrimaryColor):
+			flash.SetError(w, "Primary color must be a hex color like #ffd23f.", 0)
+		case err != nil:
+			logger.ErrorContext(r.Context(), "error saving branding", slog.Any("err", err))
+			flash.SetError(w, "Could not save the branding. Try again.", 0)
+		default:
+			flash.SetNotice(w, "Branding saved.")
+		}
+		http.Redirect(w, r, settingsURL, http.StatusSeeOther)
+	})
+}
@@ -0,0 +1,123 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/database"
+)
+
+// SnapshotCooldown is how long an Admin waits between two snapshot
+// downloads. A snapshot copies the whole database, so a script retrying in a
+// loop would otherwise turn the download into a disk and I/O hog.
+const SnapshotCooldown = 10 * time.Minute
+
+// snapshotContentType is the registered media type for an SQLite file.
+const snapshotContentType = "application/vnd.sqlite3"
+
+// Snapshotter writes a point-in-time copy of the database to a path that
+// does not exist yet. store.SnapshotStore satisfies it.
+type Snapshotter interface {
+	WriteSnapshot(ctx context.Context, path string) error
+}
+
+// HandleSnapshot serves GET /admin/snapshot.db, the Admin-only download of a
+// point-in-time copy of the database, so heavy ad-hoc analysis runs against a
+// local file instead of the live one. The copy is written to a temporary
+// directory, streamed as an attachment and deleted; it carries no password
+// hashes or tokens (see database.Snapshot). Each Admin gets one download per
+// [SnapshotCooldown]: a second request inside it answers 429 with
+// Retry-After, while a failed snapshot does not use up the window. Every
+// download is recorded in admin_audit against the downloading Admin and
+// logged. On Postgres, which has pg_dump for this, the route answers 501.
+func HandleSnapshot(
+	logger *slog.Logger, snapshots Snapshotter, limiter *PerTargetLimiter, audit auth.AdminPlayerStore,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		actor, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for database snapshot")
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+
+			return
+		}
+
+		wait, allowed, token := limiter.Allow(actor.ID)
+		if !allowed {
+			seconds := max(int((wait+time.Second-1)/time.Second), 1)
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			http.Error(w, "a snapshot was downloaded recently; try again later", http.StatusTooManyRequests)
+
+			return
+		}
+
+		dir, err := os.MkdirTemp("", "topbanana-snapshot-")
+		if err != nil {
+			limiter.Cancel(actor.ID, token)
+			logger.ErrorContext(ctx, "error creating snapshot directory", slog.Any("err", err))
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+
+			return
+		}
+		defer func() { _ = os.RemoveAll(dir) }()
+
+		f, size, err := writeSnapshot(ctx, snapshots, filepath.Join(dir, "snapshot.db"))
+		if err != nil {
+			limiter.Cancel(actor.ID, token)
+			if errors.Is(err, database.ErrSnapshotUnsupported) {
+				http.Error(w, "database snapshots are only available on SQLite", http.StatusNotImplemented)
+
+				return
+			}
+			logger.ErrorContext(ctx, "error writing database snapshot", slog.Any("err", err))
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+
+			return
+		}
+		defer func() { _ = f.Close() }()
+
+		writeAudit(ctx, logger, audit, actor.ID, actor.ID, auth.AdminActionSnapshotDownloaded,
+			map[string]string{"bytes": strconv.FormatInt(size, 10)})
+		logger.InfoContext(ctx, "database snapshot downloaded",
+			slog.Int64("actor_id", actor.ID), slog.Int64("bytes", size))
+
+		name := "topbanana-" + time.Now().UTC().Format("20060102T150405Z") + ".db"
+		w.Header().Set("Content-Type", snapshotContentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="`+name+`"`)
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.Header().Set("Cache-Control", "no-store")
+		if _, err = io.Copy(w, f); err != nil {
+			logger.ErrorContext(ctx, "error streaming database snapshot", slog.Any("err", err))
+		}
+	})
+}
+
+// writeSnapshot writes the snapshot to path and opens it for reading,
+// returning its size.
+func writeSnapshot(ctx context.Context, snapshots Snapshotter, path string) (*os.File, int64, error) {
+	if err := snapshots.WriteSnapshot(ctx, path); err != nil {
+		return nil, 0, fmt.Errorf("write snapshot: %w", err)
+	}
+	f, err := os.Open(path) //nolint:gosec // path is under a directory this handler just created.
+	if err != nil {
+		return nil, 0, fmt.Errorf("open snapshot: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+
+		return nil, 0, fmt.Errorf("stat snapshot: %w", err)
+	}
+
+	return f, info.Size(), nil
+}
@@ -0,0 +1,141 @@
+package admin_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/admin"
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// snapshotterFunc adapts a function to [Snapshotter].
+type snapshotterFunc func(ctx context.Context, path string) error
+
+func (f snapshotterFunc) WriteSnapshot(ctx context.Context, path string) error { return f(ctx, path) }
+
+// getSnapshot drives HandleSnapshot as the test Admin.
+func getSnapshot(
+	t *testing.T, env *adminEnv, snapshots Snapshotter, limiter *PerTargetLimiter,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	handler := HandleSnapshot(slog.New(slog.DiscardHandler), snapshots, limiter, env.admin)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/admin/snapshot.db", nil)
+	req = req.WithContext(auth.WithPlayer(req.Context(), &auth.Player{ID: testAdminID, Role: auth.RoleAdmin}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandleSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("streams a queryable copy and audits it", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		limiter := NewPerTargetLimiter(time.Minute)
+
+		rec := getSnapshot(t, env, store.NewSnapshotStore(env.db), limiter)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status = %d, want %d: %s", got, want, rec.Body.String())
+		}
+		if got, want := rec.Header().Get("Content-Type"), "application/vnd.sqlite3"; got != want {
+			t.Errorf("Content-Type = %q, want %q", got, want)
+		}
+		if got := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(got, `attachment; filename="topbanana-`) {
+			t.Errorf("Content-Disposition = %q, want a topbanana-*.db attachment", got)
+		}
+		if got, want := rec.Header().Get("Content-Length"), fmt.Sprint(rec.Body.Len()); got != want {
+			t.Errorf("Content-Length = %q, want %q", got, want)
+		}
+
+		path := filepath.Join(t.TempDir(), "download.db")
+		if err := os.WriteFile(path, rec.Body.Bytes(), 0o600); err != nil {
+			t.Fatalf("write download err = %v", err)
+		}
+		snap, err := sql.Open("sqlite", path)
+		if err != nil {
+			t.Fatalf("open download err = %v", err)
+		}
+		t.Cleanup(func() { _ = snap.Close() })
+		var admins int
+		if err = snap.QueryRowContext(t.Context(),
+			"SELECT COUNT(*) FROM players WHERE id = ?", testAdminID,
+		).Scan(&admins); err != nil || admins != 1 {
+			t.Errorf("admin rows in snapshot = %d (err %v), want 1", admins, err)
+		}
+
+		entries := env.auditEntries(t, testAdminID)
+		if len(entries) != 1 || entries[0].Action != auth.AdminActionSnapshotDownloaded {
+			t.Fatalf("audit entries = %+v, want one %s", entries, auth.AdminActionSnapshotDownloaded)
+		}
+	})
+
+	t.Run("a second download inside the cool-down is a 429", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		limiter := NewPerTargetLimiter(time.Minute)
+		snapshots := store.NewSnapshotStore(env.db)
+
+		if got, want := getSnapshot(t, env, snapshots, limiter).Code, http.StatusOK; got != want {
+			t.Fatalf("first status = %d, want %d", got, want)
+		}
+		rec := getSnapshot(t, env, snapshots, limiter)
+		if got, want := rec.Code, http.StatusTooManyRequests; got != want {
+			t.Fatalf("second status = %d, want %d", got, want)
+		}
+		if rec.Header().Get("Retry-After") == "" {
+			t.Error("Retry-After is empty, want the remaining wait")
+		}
+		if got, want := len(env.auditEntries(t, testAdminID)), 1; got != want {
+			t.Errorf("audit entries = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("a failed snapshot is a 500 and does not use the window", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		limiter := NewPerTargetLimiter(time.Minute)
+		failing := snapshotterFunc(func(context.Context, string) error { return errors.New("disk full") })
+
+		if got, want := getSnapshot(t, env, failing, limiter).Code, http.StatusInternalServerError; got != want {
+			t.Fatalf("status = %d, want %d", got, want)
+		}
+		if got := env.auditEntries(t, testAdminID); len(got) != 0 {
+			t.Errorf("audit entries = %+v, want none", got)
+		}
+		if got, want := getSnapshot(t, env, store.NewSnapshotStore(env.db), limiter).Code, http.StatusOK; got != want {
+			t.Errorf("retry status = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("Postgres is a 501", func(t *testing.T) {
+		t.Parallel()
+
+		env := newAdminEnv(t)
+		unsupported := snapshotterFunc(func(context.Context, string) error {
+			return fmt.Errorf("wrapped: %w", database.ErrSnapshotUnsupported)
+		})
+
+		if got, want := getSnapshot(t, env, unsupported, NewPerTargetLimiter(time.Minute)).Code,
+			http.StatusNotImplemented; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+}
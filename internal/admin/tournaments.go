@@ -0,0 +1,264 @@
+package admin
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/csrf"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/tournament"
+)
+
+// tournamentListData backs tournamentlist.gohtml.
+type tournamentListData struct {
+	Title       string
+	Tournaments []*tournament.Tournament
+}
+
+// tournamentFormData backs tournamentform.gohtml. FormTitle preserves the
+// submitted title on a validation re-render.
+type tournamentFormData struct {
+	Title     string
+	FormTitle string
+	Error     string
+}
+
+// tournamentViewData backs tournamentview.gohtml: the stages in play order,
+// the cumulative standings, and the viewer's solo quizzes that are not yet a
+// stage (the add-stage picker).
+type tournamentViewData struct {
+	Title      string
+	Tournament *tournament.Tournament
+	Standings  []tournament.Standing
+	Available  []*quiz.Quiz
+}
+
+// HandleTournamentList renders GET /admin/tournaments. Scoped like the quiz
+// list: an Admin sees every tournament, a Host only their own.
+func HandleTournamentList(logger *slog.Logger, csrfMgr *csrf.Manager, store tournament.Store) http.Handler {
+	renderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/tournamentlist.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		player, ok := auth.PlayerFromContext(r.Context())
+		if !ok {
+			logger.ErrorContext(r.Context(), "missing player on context for tournament list")
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		var (
+			tournaments []*tournament.Tournament
+			err         error
+		)
+		if player.IsAdmin() {
+			tournaments, err = store.ListTournaments(r.Context())
+		} else {
+			tournaments, err = store.ListTournamentsForOwner(r.Context(), player.ID)
+		}
+		if err != nil {
+			logger.ErrorContext(r.Context(), "error retrieving tournaments from store", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		renderer.Render(w, r, http.StatusOK, tournamentListData{
+			Title:       "Admin Dashboard - Tournaments",
+			Tournaments: tournaments,
+		})
+	})
+}
+
+// HandleTournamentCreate renders the new-tournament form. Stages are added
+// from the tournament page once it exists.
+func HandleTournamentCreate(logger *slog.Logger, csrfMgr *csrf.Manager) http.Handler {
+	renderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/tournamentform.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		renderer.Render(w, r, http.StatusOK, tournamentFormData{Title: tournamentFormTitle})
+	})
+}
+
+// HandleTournamentSave handles POST /admin/tournaments: it creates the
+// tournament owned by the session player, allocating its join code, and
+// redirects to the tournament page.
+func HandleTournamentSave(logger *slog.Logger, csrfMgr *csrf.Manager, service *tournament.Service) http.Handler {
+	formRenderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/tournamentform.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		player, ok := auth.PlayerFromContext(r.Context())
+		if !ok {
+			logger.ErrorContext(r.Context(), "missing player on context for tournament create")
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			render400(w, r, logger, csrfMgr, "Invalid form submission.")
+
+			return
+		}
+
+		title := r.PostFormValue("title")
+		t, err := service.Create(r.Context(), title, player.ID, nil)
+		if err != nil {
+			if errors.Is(err, tournament.ErrTitleRequired) {
+				formRenderer.Render(w, r, http.StatusBadRequest, tournamentFormData{
+					Title:     tournamentFormTitle,
+					FormTitle: title,
+					Error:     "Give the tournament a title.",
+				})
+
+				return
+			}
+			logger.ErrorContext(r.Context(), "error creating tournament", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		http.Redirect(w, r, "/admin/tournaments/"+strconv.FormatInt(t.ID, 10), http.StatusSeeOther)
+	})
+}
+
+// HandleTournamentView renders GET /admin/tournaments/{tournamentID}: the
+// join code, the stages, and the cumulative standings. Creator-or-Admin
+// only; anyone else gets the same opaque 404 an unknown id gives, matching
+// the quiz view.
+func HandleTournamentView(
+	logger *slog.Logger, csrfMgr *csrf.Manager, service *tournament.Service, quizStore quiz.Store,
+) http.Handler {
+	renderer := NewTemplateRenderer(logger, csrfMgr, "admin/pages/tournamentview.gohtml")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tournamentID, ok := handlers.ParseIDFromPath(w, r, logger, "tournamentID")
+		if !ok {
+			return
+		}
+
+		standings, err := service.Standings(r.Context(), tournamentID)
+		if err != nil {
+			if errors.Is(err, tournament.ErrTournamentNotFound) {
+				render404(w, r, logger, csrfMgr)
+
+				return
+			}
+			logger.ErrorContext(r.Context(), "error computing tournament standings", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+		t := standings.Tournament
+		if !canEditQuiz(r, t.CreatedByPlayerID) {
+			render404(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		quizzes, ok := listQuizzesForViewer(w, r, logger, csrfMgr, quizStore)
+		if !ok {
+			return
+		}
+
+		renderer.Render(w, r, http.StatusOK, tournamentViewData{
+			Title:      "Admin Dashboard - " + t.Title,
+			Tournament: t,
+			Standings:  standings.Entries,
+			Available:  availableStageQuizzes(quizzes, t.Stages),
+		})
+	})
+}
+
+// HandleTournamentAddStage handles POST /admin/tournaments/{tournamentID}/stages:
+// it appends the posted quiz_id as the tournament's next stage. The quiz must
+// be a solo quiz the session player may edit, the same set the picker offers.
+func HandleTournamentAddStage(
+	logger *slog.Logger, csrfMgr *csrf.Manager, service *tournament.Service,
+	store tournament.Store, quizStore quiz.Store,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tournamentID, ok := handlers.ParseIDFromPath(w, r, logger, "tournamentID")
+		if !ok {
+			return
+		}
+
+		t, err := store.GetTournament(r.Context(), tournamentID)
+		if err != nil {
+			if errors.Is(err, tournament.ErrTournamentNotFound) {
+				render404(w, r, logger, csrfMgr)
+
+				return
+			}
+			logger.ErrorContext(r.Context(), "error retrieving tournament", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+		if !canEditQuiz(r, t.CreatedByPlayerID) {
+			render404(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		quizID, err := strconv.ParseInt(strings.TrimSpace(r.PostFormValue("quiz_id")), 10, 64)
+		if err != nil {
+			render400(w, r, logger, csrfMgr, "Pick a quiz to add.")
+
+			return
+		}
+		qz, err := quizStore.GetQuiz(r.Context(), quizID)
+		if err != nil && !errors.Is(err, quiz.ErrQuizNotFound) {
+			logger.ErrorContext(r.Context(), "error retrieving quiz", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+		if qz == nil || qz.Mode == quiz.ModeLive || !canEditQuiz(r, qz.CreatedByPlayerID) {
+			render400(w, r, logger, csrfMgr, "Pick one of your solo quizzes.")
+
+			return
+		}
+
+		if err = service.AddStage(r.Context(), t.ID, qz.ID); err != nil {
+			if errors.Is(err, tournament.ErrStageExists) {
+				render409(w, r, logger, csrfMgr, "That quiz is already part of this tournament.")
+
+				return
+			}
+			logger.ErrorContext(r.Context(), "error adding tournament stage", slog.Any("err", err))
+			render500(w, r, logger, csrfMgr)
+
+			return
+		}
+
+		http.Redirect(w, r, "/admin/tournaments/"+strconv.FormatInt(t.ID, 10), http.StatusSeeOther)
+	})
+}
+
+// tournamentFormTitle is the page <title> of the create form, shared by the
+// GET and the validation re-render.
+const tournamentFormTitle = "Admin Dashboard - New Tournament"
+
+// availableStageQuizzes returns the solo quizzes not already staged, in the
+// order the quiz list shows them.
+func availableStageQuizzes(quizzes []*quiz.Quiz, stages []*tournament.Stage) []*quiz.Quiz {
+	staged := make(map[int64]bool, len(stages))
+	for _, st := range stages {
+		staged[st.QuizID] = true
+	}
+
+	out := make([]*quiz.Quiz, 0, len(quizzes))
+	for _, qz := range quizzes {
+		if qz.Mode != quiz.ModeLive && !staged[qz.ID] {
+			out = append(out, qz)
+		}
+	}
+
+	return out
+}
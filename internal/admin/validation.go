@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validation codes: the stable, machine-readable half of a
+// [ValidationError]. A client branches on (or translates) the code; the
+// message is the English text the admin pages show.
+const (
+	CodeRequired        = "required"
+	CodeOutOfRange      = "outOfRange"
+	CodeInvalidChoice   = "invalidChoice"
+	CodeInvalidNumber   = "invalidNumber"
+	CodeTooMany         = "tooMany"
+	CodeNoCorrectOption = "noCorrectOption"
+	CodeTrueFalseShape  = "trueFalseShape"
+	CodeAllCorrect      = "allCorrect"
+	CodeNotLivePlayable = "notLivePlayable"
+	CodeTaken           = "taken"
+	CodeInvalid         = "invalid"
+)
+
+// ValidationError is one failed form rule. Field is the path of the input
+// it belongs to: the lowercase form-field name the templates bind to
+// ("title", "timelimitseconds"), indexed and dotted for nested rows of an
+// imported quiz ("questions[0].options[1].text").
+type ValidationError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors is the result of a form's Valid, in rule order. An empty
+// slice means the form is valid. It is an error so an import can wrap it
+// and a caller can recover it with [errors.As].
+type ValidationErrors []ValidationError
+
+// add appends a problem with field, code and message.
+func (v *ValidationErrors) add(field, code, message string) {
+	*v = append(*v, ValidationError{Field: field, Code: code, Message: message})
+}
+
+// addf appends a problem whose message is formatted.
+func (v *ValidationErrors) addf(field, code, format string, args ...any) {
+	v.add(field, code, fmt.Sprintf(format, args...))
+}
+
+// nest appends every problem of inner under prefix, so a question's "text"
+// becomes "questions[0].text".
+func (v *ValidationErrors) nest(prefix string, inner ValidationErrors) {
+	for _, e := range inner {
+		e.Field = prefix + "." + e.Field
+		*v = append(*v, e)
+	}
+}
+
+// For returns the message of the first problem on field, or "" when the
+// field is valid. The form templates call it next to each input.
+func (v ValidationErrors) For(field string) string {
+	for _, e := range v {
+		if e.Field == field {
+			return e.Message
+		}
+	}
+
+	return ""
+}
+
+// Lines renders each problem as a "field: message" line, in rule order.
+func (v ValidationErrors) Lines() []string {
+	out := make([]string, 0, len(v))
+	for _, e := range v {
+		out = append(out, e.Field+": "+e.Message)
+	}
+
+	return out
+}
+
+// Error joins the problems' lines.
+func (v ValidationErrors) Error() string {
+	return strings.Join(v.Lines(), "; ")
+}
@@ -0,0 +1,258 @@
+// Package analytics ships gameplay events - a question served, an answer
+// submitted, a game finished - to an external sink as newline-delimited JSON,
+// so product analytics can follow play without reading the database. Events
+// are queued in memory and written by one background goroutine, so emitting
+// never blocks a request; when the queue is full the event is dropped and
+// counted rather than slowing play down.
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// Event types.
+const (
+	// TypeQuestionServed marks a question newly issued to a player.
+	TypeQuestionServed = "question_served"
+	// TypeAnswerSubmitted marks a recorded answer.
+	TypeAnswerSubmitted = "answer_submitted"
+	// TypeGameFinished marks a game reaching its terminal status: the answer
+	// to its final question landing, or the player ending it early.
+	TypeGameFinished = "game_finished"
+	// TypeGameCreated marks a new game. Like [TypePlayerJoined] it is
+	// recorded in the game event log only, never exported to ANALYTICS_SINK.
+	TypeGameCreated = "game_created"
+	// TypePlayerJoined marks a player joining a game.
+	TypePlayerJoined = "player_joined"
+)
+
+// Latency buckets for [LatencyBucket]. Coarse on purpose: the answer
+// latency is a product signal (was the question hard to read?), not a
+// timing measurement, and a bucket keeps the events groupable.
+const (
+	BucketUnder1s   = "<1s"
+	Bucket1to2s     = "1-2s"
+	Bucket2to5s     = "2-5s"
+	Bucket5to10s    = "5-10s"
+	BucketOver10s   = "10s+"
+	bucketEdge2s    = 2 * time.Second
+	bucketEdge5s    = 5 * time.Second
+	bucketEdge10s   = 10 * time.Second
+	queueSize       = 1024
+	batchSize       = 100
+	httpSinkTimeout = 10 * time.Second
+)
+
+// ErrCollectorStatus is returned by [HTTPSink.Send] when the collector
+// answers with a non-2xx status.
+var ErrCollectorStatus = errors.New("analytics collector returned an error status")
+
+// Event is one analytics record. Fields that do not apply to a type are
+// omitted from the JSON: QuestionID is unset on game_finished, Correct and
+// LatencyBucket are set only on answer_submitted, Status (finished or
+// abandoned) and Score only on game_finished.
+type Event struct {
+	Type          string    `json:"type"`
+	At            time.Time `json:"at"`
+	GameID        string    `json:"gameId"`
+	QuizID        int64     `json:"quizId"`
+	PlayerID      int64     `json:"playerId"`
+	QuestionID    int64     `json:"questionId,omitempty"`
+	Position      int       `json:"position,omitempty"`
+	Correct       *bool     `json:"correct,omitempty"`
+	LatencyBucket string    `json:"latencyBucket,omitempty"`
+	Status        string    `json:"status,omitempty"`
+	Score         *int      `json:"score,omitempty"`
+}
+
+// LatencyBucket maps the time from a question's answer window opening to the
+// player's answer onto one of the coarse buckets. A negative latency (an
+// answer clamped to the window start) counts as under a second.
+func LatencyBucket(d time.Duration) string {
+	switch {
+	case d < time.Second:
+		return BucketUnder1s
+	case d < bucketEdge2s:
+		return Bucket1to2s
+	case d < bucketEdge5s:
+		return Bucket2to5s
+	case d < bucketEdge10s:
+		return Bucket5to10s
+	default:
+		return BucketOver10s
+	}
+}
+
+// Sink delivers a batch of events. Send is only ever called from the
+// [Emitter]'s run loop, so implementations need not be safe for concurrent
+// use.
+type Sink interface {
+	Send(ctx context.Context, events []Event) error
+}
+
+// WriterSink writes each event as one JSON line to an io.Writer: os.Stdout
+// for the stdout sink, an append-mode file for the file sink.
+type WriterSink struct {
+	w io.Writer
+}
+
+// NewWriterSink returns a sink that writes JSON lines to w.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Send writes the batch, one event per line.
+func (s *WriterSink) Send(_ context.Context, events []Event) error {
+	body, err := encodeLines(events)
+	if err != nil {
+		return err
+	}
+	if _, err = s.w.Write(body); err != nil {
+		return fmt.Errorf("write analytics events: %w", err)
+	}
+
+	return nil
+}
+
+// HTTPSink POSTs each batch to a collector as application/x-ndjson.
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSink returns a sink that POSTs batches to url.
+func NewHTTPSink(url string) *HTTPSink {
+	return &HTTPSink{url: url, client: &http.Client{Timeout: httpSinkTimeout}}
+}
+
+// Send POSTs the batch. A non-2xx response is [ErrCollectorStatus]; the batch
+// is not retried.
+func (s *HTTPSink) Send(ctx context.Context, events []Event) error {
+	body, err := encodeLines(events)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build analytics request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post analytics events: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %d", ErrCollectorStatus, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// encodeLines renders events as newline-delimited JSON.
+func encodeLines(events []Event) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return nil, fmt.Errorf("encode analytics event: %w", err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Emitter queues events and hands them to its [Sink] in batches from
+// [Emitter.Run]. Emit is safe for concurrent use.
+type Emitter struct {
+	sink    Sink
+	logger  *slog.Logger
+	queue   chan Event
+	dropped atomic.Int64
+}
+
+// NewEmitter returns an emitter that delivers to sink. Nothing is sent until
+// [Emitter.Run] is started.
+func NewEmitter(sink Sink, logger *slog.Logger) *Emitter {
+	return &Emitter{sink: sink, logger: logger, queue: make(chan Event, queueSize)}
+}
+
+// Emit queues e without blocking. When the queue is full the event is
+// dropped; [Emitter.Dropped] counts them.
+func (e *Emitter) Emit(ev Event) {
+	select {
+	case e.queue <- ev:
+	default:
+		e.dropped.Add(1)
+	}
+}
+
+// Dropped reports how many events were dropped because the queue was full.
+func (e *Emitter) Dropped() int64 {
+	return e.dropped.Load()
+}
+
+// Run delivers queued events until ctx is done, then flushes whatever is
+// still queued and returns. A failed send is logged and the batch discarded:
+// analytics is best-effort and must not back up into gameplay.
+func (e *Emitter) Run(ctx context.Context) {
+	for {
+		select {
+		case ev := <-e.queue:
+			e.send(ctx, e.batch(ev))
+		case <-ctx.Done():
+			e.flush()
+
+			return
+		}
+	}
+}
+
+// batch collects first plus whatever else is already queued, up to
+// batchSize.
+func (e *Emitter) batch(first Event) []Event {
+	events := []Event{first}
+	for len(events) < batchSize {
+		select {
+		case ev := <-e.queue:
+			events = append(events, ev)
+		default:
+			return events
+		}
+	}
+
+	return events
+}
+
+// flush sends what is left in the queue after shutdown began, on a fresh
+// bounded context since the run context is already cancelled.
+func (e *Emitter) flush() {
+	ctx, cancel := context.WithTimeout(context.Background(), httpSinkTimeout)
+	defer cancel()
+	for {
+		select {
+		case ev := <-e.queue:
+			e.send(ctx, e.batch(ev))
+		default:
+			return
+		}
+	}
+}
+
+// send delivers one batch, logging a failure.
+func (e *Emitter) send(ctx context.Context, events []Event) {
+	if err := e.sink.Send(ctx, events); err != nil {
+		e.logger.WarnContext(ctx, "analytics sink send failed",
+			slog.Int("events", len(events)), slog.Any("err", err))
+	}
+}
@@ -0,0 +1,146 @@
+package analytics_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/analytics"
+)
+
+func TestLatencyBucket(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{-time.Second, BucketUnder1s},
+		{999 * time.Millisecond, BucketUnder1s},
+		{time.Second, Bucket1to2s},
+		{2 * time.Second, Bucket2to5s},
+		{9 * time.Second, Bucket5to10s},
+		{10 * time.Second, BucketOver10s},
+	}
+	for _, tt := range tests {
+		if got := LatencyBucket(tt.d); got != tt.want {
+			t.Errorf("LatencyBucket(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+// lockedBuffer is a bytes.Buffer safe to read while the emitter writes.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+// TestEmitter_FlushesOnShutdown pins that events queued before the run
+// context ends are still written, one JSON line each.
+func TestEmitter_FlushesOnShutdown(t *testing.T) {
+	t.Parallel()
+
+	var out lockedBuffer
+	em := NewEmitter(NewWriterSink(&out), slog.New(slog.DiscardHandler))
+	correct := true
+	em.Emit(Event{Type: TypeQuestionServed, GameID: "g1", QuizID: 1, PlayerID: 2, QuestionID: 3, Position: 1})
+	em.Emit(Event{
+		Type: TypeAnswerSubmitted, GameID: "g1", QuizID: 1, PlayerID: 2, QuestionID: 3,
+		Correct: &correct, LatencyBucket: BucketUnder1s,
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	em.Run(ctx)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("lines = %d, want %d (%q)", got, want, out.String())
+	}
+	var ev struct {
+		Type          string `json:"type"`
+		Correct       *bool  `json:"correct"`
+		LatencyBucket string `json:"latencyBucket"`
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &ev); err != nil {
+		t.Fatalf("json.Unmarshal err = %v, want nil", err)
+	}
+	if ev.Type != TypeAnswerSubmitted || ev.Correct == nil || !*ev.Correct || ev.LatencyBucket != BucketUnder1s {
+		t.Errorf("event = %+v, want a correct answer_submitted in %q", ev, BucketUnder1s)
+	}
+	if strings.Contains(lines[0], "correct") {
+		t.Errorf("question_served line = %q, want no correct field", lines[0])
+	}
+}
+
+// TestEmitter_DropsWhenFull pins that Emit never blocks: with nothing
+// draining the queue, events past its capacity are dropped and counted.
+func TestEmitter_DropsWhenFull(t *testing.T) {
+	t.Parallel()
+
+	em := NewEmitter(NewWriterSink(io.Discard), slog.New(slog.DiscardHandler))
+	const extra = 5
+	for range 1024 + extra {
+		em.Emit(Event{Type: TypeQuestionServed})
+	}
+	if got, want := em.Dropped(), int64(extra); got != want {
+		t.Errorf("Dropped = %d, want %d", got, want)
+	}
+}
+
+func TestHTTPSink_Send(t *testing.T) {
+	t.Parallel()
+
+	type request struct{ contentType, body string }
+	requests := make(chan request, 2)
+	var status atomic.Int32
+	status.Store(http.StatusAccepted)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{contentType: r.Header.Get("Content-Type"), body: string(body)}
+		w.WriteHeader(int(status.Load()))
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := NewHTTPSink(srv.URL)
+	if err := sink.Send(t.Context(), []Event{{Type: TypeGameFinished, GameID: "g1"}}); err != nil {
+		t.Fatalf("Send err = %v, want nil", err)
+	}
+	got := <-requests
+	gotType, gotBody := got.contentType, got.body
+	if got, want := gotType, "application/x-ndjson"; got != want {
+		t.Errorf("Content-Type = %q, want %q", got, want)
+	}
+	if !strings.Contains(gotBody, `"type":"game_finished"`) {
+		t.Errorf("body = %q, want a game_finished line", gotBody)
+	}
+
+	status.Store(http.StatusInternalServerError)
+	if err := sink.Send(t.Context(), []Event{{Type: TypeGameFinished}}); !errors.Is(err, ErrCollectorStatus) {
+		t.Errorf("Send err = %v, want ErrCollectorStatus", err)
+	}
+}
@@ -0,0 +1,195 @@
+// Package answerexport streams recorded answers - solo game picks and live
+// session picks - as newline-delimited JSON for analytics pipelines. Each line
+// carries a resume cursor, so a job that syncs incrementally passes the last
+// cursor it stored and receives only the answers recorded since.
+package answerexport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Answer sources.
+const (
+	// SourceSolo marks a pick from a solo game (game_answers).
+	SourceSolo = "solo"
+	// SourceLive marks a pick from a hosted live session (session_answers).
+	SourceLive = "live"
+)
+
+// BatchSize is how many answers [Write] reads per store call. Each batch is
+// written and flushed before the next is read, so an export of any size runs
+// in bounded memory.
+const BatchSize = 500
+
+// Answer is one recorded pick joined with the quiz, question, option and
+// player it belongs to. GameID is set on solo answers; JoinCode, GameSeq,
+// Score and ParticipantNotes on live ones. Score is nil on a solo answer (solo
+// scores are computed on read, not stored) and on a live pick that was never
+// scored. ParticipantNotes is the host's notes on the player in that session,
+// empty when the host wrote none.
+type Answer struct {
+	Source       string
+	ID           int64
+	AnsweredAt   time.Time
+	QuizID       int64
+	QuizTitle    string
+	QuestionID   int64
+	QuestionText string
+	OptionID     int64
+	OptionText   string
+	Correct      bool
+	PlayerID     int64
+	PlayerName   string
+	GameID       string
+	JoinCode     string
+	GameSeq      int64
+	Score        *int
+	// ParticipantNotes is set on live answers only.
+	ParticipantNotes string
+}
+
+// Store reads answers in id order for the export. Both methods return at most
+// limit answers with an id greater than afterID, oldest first.
+type Store interface {
+	// ListSoloAnswersAfter returns settled solo answers from real (non-preview)
+	// games.
+	ListSoloAnswersAfter(ctx context.Context, afterID int64, limit int) ([]*Answer, error)
+	// ListLiveAnswersAfter returns settled live-session answers: it stops
+	// short of any pick on a question that is still open, since that pick can
+	// still change.
+	ListLiveAnswersAfter(ctx context.Context, afterID int64, limit int) ([]*Answer, error)
+}
+
+// Cursor is the resume point of an export: the last solo and live answer ids
+// already delivered. The zero Cursor exports everything.
+type Cursor struct {
+	Solo int64
+	Live int64
+}
+
+// ErrInvalidCursor is returned by [ParseCursor] for a malformed cursor.
+var ErrInvalidCursor = errors.New("invalid answer export cursor")
+
+// ParseCursor parses the "<solo>.<live>" form [Cursor.String] produces. An
+// empty string is the zero cursor.
+func ParseCursor(raw string) (Cursor, error) {
+	if raw == "" {
+		return Cursor{}, nil
+	}
+	soloRaw, liveRaw, ok := strings.Cut(raw, ".")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+	solo, soloErr := strconv.ParseInt(soloRaw, 10, 64)
+	live, liveErr := strconv.ParseInt(liveRaw, 10, 64)
+	if soloErr != nil || liveErr != nil || solo < 0 || live < 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{Solo: solo, Live: live}, nil
+}
+
+// String formats the cursor as "<solo>.<live>", the form the export's
+// ?since= parameter accepts.
+func (c Cursor) String() string {
+	return strconv.FormatInt(c.Solo, 10) + "." + strconv.FormatInt(c.Live, 10)
+}
+
+// line is the JSON shape of one exported answer.
+type line struct {
+	Cursor       string    `json:"cursor"`
+	Source       string    `json:"source"`
+	AnswerID     int64     `json:"answerId"`
+	AnsweredAt   time.Time `json:"answeredAt"`
+	QuizID       int64     `json:"quizId"`
+	QuizTitle    string    `json:"quizTitle"`
+	QuestionID   int64     `json:"questionId"`
+	QuestionText string    `json:"questionText"`
+	OptionID     int64     `json:"optionId"`
+	OptionText   string    `json:"optionText"`
+	Correct      bool      `json:"correct"`
+	PlayerID     int64     `json:"playerId"`
+	PlayerName   string    `json:"playerName"`
+	GameID       string    `json:"gameId,omitempty"`
+	JoinCode     string    `json:"joinCode,omitempty"`
+	GameSeq      int64     `json:"gameSeq,omitempty"`
+	Score        *int      `json:"score,omitempty"`
+	// ParticipantNotes is omitted when empty, as on every solo line.
+	ParticipantNotes string `json:"participantNotes,omitempty"`
+}
+
+// Write streams every answer after since to w as one JSON object per line:
+// all solo answers first, then all live ones, each in id order. flush, when
+// non-nil, runs after every non-empty batch so the client sees progress on a
+// long export. Each line's cursor covers that line and everything before it, so a
+// client that stops part way resumes from the last line it kept. It returns
+// how many answers were written; on an error the lines already written stand.
+func Write(ctx context.Context, w io.Writer, flush func(), store Store, since Cursor) (int, error) {
+	enc := json.NewEncoder(w)
+	cursor := since
+	written := 0
+
+	sources := []struct {
+		list  func(context.Context, int64, int) ([]*Answer, error)
+		after *int64
+	}{
+		{list: store.ListSoloAnswersAfter, after: &cursor.Solo},
+		{list: store.ListLiveAnswersAfter, after: &cursor.Live},
+	}
+	for _, src := range sources {
+		for {
+			batch, err := src.list(ctx, *src.after, BatchSize)
+			if err != nil {
+				return written, fmt.Errorf("listing answers after %d: %w", *src.after, err)
+			}
+			for _, a := range batch {
+				*src.after = a.ID
+				if err = enc.Encode(newLine(a, cursor)); err != nil {
+					return written, fmt.Errorf("writing answer %d: %w", a.ID, err)
+				}
+				written++
+			}
+			if flush != nil && len(batch) > 0 {
+				flush()
+			}
+			if len(batch) < BatchSize {
+				break
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// newLine maps an answer onto its JSON line, stamped with the cursor that
+// resumes just after it.
+func newLine(a *Answer, cursor Cursor) line {
+	return line{
+		Cursor:       cursor.String(),
+		Source:       a.Source,
+		AnswerID:     a.ID,
+		AnsweredAt:   a.AnsweredAt.UTC(),
+		QuizID:       a.QuizID,
+		QuizTitle:    a.QuizTitle,
+		QuestionID:   a.QuestionID,
+		QuestionText: a.QuestionText,
+		OptionID:     a.OptionID,
+		OptionText:   a.OptionText,
+		Correct:      a.Correct,
+		PlayerID:     a.PlayerID,
+		PlayerName:   a.PlayerName,
+		GameID:       a.GameID,
+		JoinCode:     a.JoinCode,
+		GameSeq:      a.GameSeq,
+		Score:        a.Score,
+
+		ParticipantNotes: a.ParticipantNotes,
+	}
+}
@@ -0,0 +1,160 @@
+package answerexport_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/answerexport"
+)
+
+// fakeStore serves fixed solo and live answers, honouring afterID and limit
+// the way the SQL does.
+type fakeStore struct {
+	solo, live []*Answer
+	liveErr    error
+}
+
+func (f *fakeStore) ListSoloAnswersAfter(_ context.Context, afterID int64, limit int) ([]*Answer, error) {
+	return page(f.solo, afterID, limit), nil
+}
+
+func (f *fakeStore) ListLiveAnswersAfter(_ context.Context, afterID int64, limit int) ([]*Answer, error) {
+	if f.liveErr != nil {
+		return nil, f.liveErr
+	}
+
+	return page(f.live, afterID, limit), nil
+}
+
+func page(all []*Answer, afterID int64, limit int) []*Answer {
+	var out []*Answer
+	for _, a := range all {
+		if a.ID > afterID && len(out) < limit {
+			out = append(out, a)
+		}
+	}
+
+	return out
+}
+
+func answers(source string, n int) []*Answer {
+	out := make([]*Answer, 0, n)
+	for i := 1; i <= n; i++ {
+		out = append(out, &Answer{Source: source, ID: int64(i), AnsweredAt: time.Unix(int64(i), 0)})
+	}
+
+	return out
+}
+
+type exportedLine struct {
+	Cursor   string `json:"cursor"`
+	Source   string `json:"source"`
+	AnswerID int64  `json:"answerId"`
+	Score    *int   `json:"score"`
+}
+
+func decodeLines(t *testing.T, body string) []exportedLine {
+	t.Helper()
+	var out []exportedLine
+	for l := range strings.Lines(body) {
+		var got exportedLine
+		if err := json.Unmarshal([]byte(l), &got); err != nil {
+			t.Fatalf("line %q is not JSON: %v", l, err)
+		}
+		out = append(out, got)
+	}
+
+	return out
+}
+
+func TestParseCursor(t *testing.T) {
+	t.Parallel()
+
+	for raw, want := range map[string]Cursor{"": {}, "0.0": {}, "12.7": {Solo: 12, Live: 7}} {
+		got, err := ParseCursor(raw)
+		if err != nil || got != want {
+			t.Errorf("ParseCursor(%q) = %+v, %v, want %+v, nil", raw, got, err, want)
+		}
+		if raw != "" && got.String() != raw {
+			t.Errorf("ParseCursor(%q).String() = %q, want the input back", raw, got.String())
+		}
+	}
+	for _, raw := range []string{"12", "a.1", "1.b", "-1.0", "1.2.3"} {
+		if _, err := ParseCursor(raw); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("ParseCursor(%q) err = %v, want ErrInvalidCursor", raw, err)
+		}
+	}
+}
+
+// TestWrite_BatchesAndResumes exports more solo answers than fit in one batch
+// plus a few live ones, then resumes from a mid-stream cursor and checks only
+// the remainder comes back.
+func TestWrite_BatchesAndResumes(t *testing.T) {
+	t.Parallel()
+
+	score := 700
+	store := &fakeStore{solo: answers(SourceSolo, BatchSize+2), live: answers(SourceLive, 3)}
+	store.live[0].Score = &score
+
+	var buf bytes.Buffer
+	flushes := 0
+	written, err := Write(t.Context(), &buf, func() { flushes++ }, store, Cursor{})
+	if err != nil {
+		t.Fatalf("Write err = %v, want nil", err)
+	}
+	if got, want := written, BatchSize+5; got != want {
+		t.Errorf("written = %d, want %d", got, want)
+	}
+	if got, want := flushes, 3; got != want {
+		t.Errorf("flushes = %d, want %d (two solo batches, one live)", got, want)
+	}
+	lines := decodeLines(t, buf.String())
+	if got, want := len(lines), written; got != want {
+		t.Fatalf("len(lines) = %d, want %d", got, want)
+	}
+	if got, want := lines[0].Cursor, "1.0"; got != want {
+		t.Errorf("first cursor = %q, want %q", got, want)
+	}
+	firstLive := lines[BatchSize+2]
+	if firstLive.Source != SourceLive || firstLive.Cursor != "502.1" {
+		t.Errorf("first live line = %+v, want source live with cursor 502.1", firstLive)
+	}
+	if firstLive.Score == nil || *firstLive.Score != score {
+		t.Errorf("first live score = %v, want %d", firstLive.Score, score)
+	}
+	if lines[0].Score != nil {
+		t.Errorf("solo score = %d, want omitted", *lines[0].Score)
+	}
+
+	resume, err := ParseCursor(lines[BatchSize].Cursor)
+	if err != nil {
+		t.Fatalf("ParseCursor err = %v, want nil", err)
+	}
+	buf.Reset()
+	if written, err = Write(t.Context(), &buf, nil, store, resume); err != nil {
+		t.Fatalf("resumed Write err = %v, want nil", err)
+	}
+	if got, want := written, 4; got != want {
+		t.Errorf("resumed written = %d, want %d (one solo, three live)", got, want)
+	}
+}
+
+func TestWrite_StoreError(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("boom")
+	store := &fakeStore{solo: answers(SourceSolo, 2), liveErr: errBoom}
+	var buf bytes.Buffer
+	written, err := Write(t.Context(), &buf, nil, store, Cursor{})
+	if !errors.Is(err, errBoom) {
+		t.Errorf("Write err = %v, want wrapping %v", err, errBoom)
+	}
+	if got, want := written, 2; got != want {
+		t.Errorf("written = %d, want %d (the solo lines stand)", got, want)
+	}
+}
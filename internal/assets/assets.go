@@ -14,7 +14,10 @@ import (
 	"mime"
 	"net/http"
 	"os"
+	"path"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/envtag"
@@ -29,6 +32,8 @@ var staticFS embed.FS
 // only - see config.Parse), the on-disk directory is served instead so a
 // `make tailwind` regen is visible on the next request without a binary
 // restart. Mirrors the CLIENT_DIR override for the player-client half.
+// Every file is served with a content-hash ETag so a revalidation after a
+// deploy that left the file untouched is a 304.
 func Handler(cfg *config.Config) http.Handler {
 	// The distroless production image has no /etc/mime.types, so
 	// [mime.TypeByExtension](".woff2") would return empty and
@@ -39,7 +44,95 @@ func Handler(cfg *config.Config) http.Handler {
 	// string, and "font/woff2" is a constant valid one.
 	_ = mime.AddExtensionType(".woff2", "font/woff2")
 
-	return http.StripPrefix("/static", http.FileServer(http.FS(resolveStaticFS(cfg))))
+	fsys := resolveStaticFS(cfg)
+	files := http.StripPrefix("/static", http.FileServer(http.FS(fsys)))
+	tags := &etagCache{memoize: cfg.WebStaticDir == ""}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(path.Clean(r.URL.Path), "/static/")
+		if tag, ok := tags.get(fsys, name); ok {
+			setStaticCacheHeaders(w, r, tag)
+		}
+		files.ServeHTTP(w, r)
+	})
+}
+
+// staticImmutableCacheControl is sent when the request URL pins the exact
+// bytes via ?v=<etag>, so a later deploy that changes the file changes the URL
+// too. Unversioned URLs get staticRevalidateCacheControl: the browser keeps the
+// copy but revalidates it against the ETag, which is a cheap 304 after a
+// deploy that did not touch the file.
+const (
+	staticImmutableCacheControl  = "public, max-age=31536000, immutable"
+	staticRevalidateCacheControl = "public, no-cache"
+)
+
+// etagHexChars is the prefix length of the SHA-256 used as a static asset's
+// ETag and ?v= version token.
+const etagHexChars = 16
+
+// setStaticCacheHeaders sets the ETag and Cache-Control for a static asset
+// whose content hash is tag. [http.FileServer] reads the ETag back when
+// answering If-None-Match, and drops both headers again on an error response.
+func setStaticCacheHeaders(w http.ResponseWriter, r *http.Request, tag string) {
+	w.Header().Set("ETag", strconv.Quote(tag))
+	if r.URL.Query().Get("v") == tag {
+		w.Header().Set("Cache-Control", staticImmutableCacheControl)
+
+		return
+	}
+	w.Header().Set("Cache-Control", staticRevalidateCacheControl)
+}
+
+// URL returns the /static/ URL of the embedded asset name pinned to its
+// content hash with ?v=, which [Handler] serves as immutable: a deploy that
+// changes the file changes the URL too. Layout templates reference scripts
+// and stylesheets through it as {{asset "css/app.css"}}. A name with no
+// embedded file gets its plain URL. With WebStaticDir set the on-disk file
+// no longer matches an edited file's embedded hash, so it is revalidated as
+// an unversioned URL would be.
+func URL(name string) string {
+	u := "/static/" + name
+	if tag, ok := urlTags.get(embeddedStaticFS(), name); ok {
+		return u + "?v=" + tag
+	}
+
+	return u
+}
+
+// urlTags memoizes the embedded hashes [URL] stamps into page markup.
+var urlTags = &etagCache{memoize: true}
+
+// etagCache hands out per-file content hashes. The embedded tree cannot change
+// at runtime, so its hashes are memoized; an on-disk WebStaticDir is rehashed
+// per request so a `make tailwind` regen is picked up immediately.
+type etagCache struct {
+	memoize bool
+	tags    sync.Map
+}
+
+// get returns the content hash of name in fsys, or false when name is not a
+// readable regular file (a directory or a miss, which the file server answers
+// on its own).
+func (c *etagCache) get(fsys fs.FS, name string) (string, bool) {
+	if c.memoize {
+		if tag, ok := c.tags.Load(name); ok {
+			s, _ := tag.(string)
+
+			return s, true
+		}
+	}
+	b, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(b)
+	tag := hex.EncodeToString(sum[:])[:etagHexChars]
+	if c.memoize {
+		c.tags.Store(name, tag)
+	}
+
+	return tag, true
 }
 
 // ManifestHandler serves /manifest.webmanifest with the correct
@@ -166,6 +259,13 @@ func resolveStaticFS(cfg *config.Config) fs.FS {
 	if cfg.WebStaticDir != "" {
 		return os.DirFS(cfg.WebStaticDir)
 	}
+
+	return embeddedStaticFS()
+}
+
+// embeddedStaticFS is the committed static tree rooted at its files, the
+// layout [Handler] serves under /static/.
+func embeddedStaticFS() fs.FS {
 	fsys, err := fs.Sub(staticFS, "static")
 	if err != nil {
 		// fs.Sub on a static //go:embed path can only fail at build time;
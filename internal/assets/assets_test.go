@@ -71,6 +71,153 @@ func TestHandler_WebStaticDirServesOnDisk(t *testing.T) {
 	}
 }
 
+// TestHandler_ETagAndRevalidate pins the default cache policy: an unversioned
+// asset URL carries a content-hash ETag and must be revalidated, and a request
+// echoing that ETag back gets a bodyless 304.
+func TestHandler_ETagAndRevalidate(t *testing.T) {
+	t.Parallel()
+
+	h := assets.Handler(&config.Config{AppEnvironment: "development"})
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/static/css/app.css", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if got, want := rr.Code, http.StatusOK; got != want {
+		t.Fatalf("status = %d, want %d", got, want)
+	}
+	etag := rr.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("ETag is empty, want a content hash")
+	}
+	if got, want := rr.Header().Get("Cache-Control"), "public, no-cache"; got != want {
+		t.Errorf("Cache-Control = %q, want %q", got, want)
+	}
+
+	req = httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/static/css/app.css", nil)
+	req.Header.Set("If-None-Match", etag)
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if got, want := rr.Code, http.StatusNotModified; got != want {
+		t.Errorf("conditional status = %d, want %d", got, want)
+	}
+}
+
+// TestHandler_VersionedURLIsImmutable pins the long-lived policy: only a URL
+// whose ?v= matches the file's current ETag is marked immutable, so a stale or
+// made-up version token can never pin an old copy in the browser.
+func TestHandler_VersionedURLIsImmutable(t *testing.T) {
+	t.Parallel()
+
+	h := assets.Handler(&config.Config{AppEnvironment: "development"})
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/static/js/htmx.min.js", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	tag := strings.Trim(rr.Header().Get("ETag"), `"`)
+
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{name: "matching version", version: tag, want: "public, max-age=31536000, immutable"},
+		{name: "stale version", version: "0000000000000000", want: "public, no-cache"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(
+				t.Context(), http.MethodGet, "/static/js/htmx.min.js?v="+tt.version, nil,
+			)
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+
+			if got, want := rr.Header().Get("Cache-Control"), tt.want; got != want {
+				t.Errorf("Cache-Control = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// TestURL_PinsContentHash pins that the template asset URL carries the
+// version token the handler answers as immutable, and that a name with no
+// embedded file falls back to its plain URL.
+func TestURL_PinsContentHash(t *testing.T) {
+	t.Parallel()
+
+	h := assets.Handler(&config.Config{AppEnvironment: "development"})
+
+	target := assets.URL("css/app.css")
+	if !strings.HasPrefix(target, "/static/css/app.css?v=") {
+		t.Fatalf("URL(css/app.css) = %q, want a ?v= versioned /static/ URL", target)
+	}
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, target, nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	if got, want := rr.Header().Get("Cache-Control"), "public, max-age=31536000, immutable"; got != want {
+		t.Errorf("Cache-Control for %s = %q, want %q", target, got, want)
+	}
+
+	if got, want := assets.URL("css/missing.css"), "/static/css/missing.css"; got != want {
+		t.Errorf("URL(css/missing.css) = %q, want %q", got, want)
+	}
+}
+
+// TestHandler_DevModeETagTracksDisk pins the dev-loop invariant for the ETag:
+// with WebStaticDir set an on-disk edit changes the ETag on the next request,
+// so the browser never revalidates a stale copy into a 304.
+func TestHandler_DevModeETagTracksDisk(t *testing.T) {
+	t.Parallel()
+
+	staticDir := t.TempDir()
+	cssPath := filepath.Join(staticDir, "app.css")
+	if err := os.WriteFile(cssPath, []byte("/* v1 */"), 0o600); err != nil {
+		t.Fatalf("WriteFile v1 err = %v, want nil", err)
+	}
+
+	h := assets.Handler(&config.Config{AppEnvironment: "development", WebStaticDir: staticDir})
+	etagOf := func() string {
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/static/app.css", nil)
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+
+		return rr.Header().Get("ETag")
+	}
+
+	first := etagOf()
+	if err := os.WriteFile(cssPath, []byte("/* v2 changed */"), 0o600); err != nil {
+		t.Fatalf("WriteFile v2 err = %v, want nil", err)
+	}
+	if got, want := etagOf(), first; got == want {
+		t.Errorf("ETag did not change after on-disk edit; both = %q", got)
+	}
+}
+
+// TestHandler_MissingFileHasNoCacheHeaders pins that a 404 is not cacheable as
+// if it were an asset.
+func TestHandler_MissingFileHasNoCacheHeaders(t *testing.T) {
+	t.Parallel()
+
+	h := assets.Handler(&config.Config{AppEnvironment: "development"})
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/static/does-not-exist.css", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if got, want := rr.Code, http.StatusNotFound; got != want {
+		t.Fatalf("status = %d, want %d", got, want)
+	}
+	if got := rr.Header().Get("ETag"); got != "" {
+		t.Errorf("ETag = %q, want empty on a 404", got)
+	}
+	if got := rr.Header().Get("Cache-Control"); got != "" {
+		t.Errorf("Cache-Control = %q, want empty on a 404", got)
+	}
+}
+
 // TestServiceWorkerHandler_SubstitutesCacheVersion checks the
 // placeholder substitution: the served SW must not still contain the
 // __CACHE_VERSION__ token and must include a 12-char hex tag in its
@@ -1 +1 @@
-function ft(){return typeof window<"u"&&typeof window.matchMedia=="function"&&window.matchMedia("(prefers-reduced-motion: reduce)").matches}function b(n,e){if(ft()||typeof window>"u"||!window.anime){typeof e.onComplete=="function"&&e.onComplete();return}let t=window.anime;typeof t.animate=="function"?t.animate(n,e):typeof t=="function"?t({targets:n,...e}):typeof e.onComplete=="function"&&e.onComplete()}function N(n){if(!n)return null;let e=new Date(n).getTime();return Number.isFinite(e)?e-Date.now():null}function _(n){return Date.now()+n}function pt(n,e,t){let i=t-e;return i>0?F((n-e)/i*100):100}function O(n,e,t){let i=t-e;return i>0?F((t-n)/i*100):0}function F(n){return Math.max(0,Math.min(100,n))}function D(n,e){e.clearTimer();let t=n&&n.startedAt?new Date(n.startedAt).getTime():NaN,i=n&&n.expiresAt?new Date(n.expiresAt).getTime():NaN;if(!Number.isFinite(t)||!Number.isFinite(i)||i<=t){e.setRevealing(!1),e.setProgress(100);return}if(e.serverNow()<t){mt(t,i,e);return}B(t,i,e)}function mt(n,e,t){let i=t.serverNow();t.setRevealing(!0),t.setProgress(0);let r=()=>{let s=t.serverNow();if(s>=n){t.setProgress(100),t.clearTimer(),t.setRevealing(!1),B(n,e,t);return}t.setProgress(pt(s,i,n))};r(),t.setTimer(setInterval(r,100))}function B(n,e,t){t.clearTimer(),t.setRevealing(!1);let i=()=>{let r=O(t.serverNow(),n,e);t.setProgress(r),r<=0&&t.clearTimer()};i(),!(O(t.serverNow(),n,e)<=0)&&t.setTimer(setInterval(i,100))}function U(n,e){return Math.max(0,Math.ceil((e-n)/1e3))}function L(n){let e=Math.max(0,Math.floor(n)),t=Math.floor(e/60),i=e%60;return`${t}:${String(i).padStart(2,"0")}`}function G(n,e){e.clearTimer();let t=n?new Date(n).getTime():NaN;if(!Number.isFinite(t)){e.setRemaining(0);return}let i=()=>{let r=U(e.serverNow(),t);e.setRemaining(r),r<=0&&e.clearTimer()};i(),!(U(e.serverNow(),t)<=0)&&e.setTimer(setInterval(i,250))}function Q(n){return!n||typeof window>"u"||typeof Image!="function"?Promise.resolve():new Promise(e=>{let t=new Image;t.onload=()=>e(),t.onerror=()=>e(),t.src=n})}var $="tb.audioMuted";function k(){try{return window.localStorage.getItem($)==="1"}catch{return!1}}function j(n){try{window.localStorage.setItem($,n?"1":"0")}catch{}}var K=["mp3","m4a","ogg","wav"];var yt="/static/audio/silence.wav";function wt(){if(typeof navigator>"u")return!1;let n=navigator.userAgent||"";return/iPad|iPhone|iPod/.test(n)?!0:/Macintosh/.test(n)&&(navigator.maxTouchPoints||0)>1}function V(){let n=wt(),e=null,t=null;function i(){if(!n||e||typeof document>"u")return;e=document.createElement("audio"),e.src=yt,e.loop=!0,e.setAttribute("playsinline",""),e.setAttribute("aria-hidden","true"),e.style.display="none",document.body.appendChild(e);let s=e.play();s&&typeof s.catch=="function"&&s.catch(()=>{}),t=()=>{if(e)if(document.visibilityState==="hidden")e.pause();else{let l=e.play();l&&typeof l.catch=="function"&&l.catch(()=>{})}},document.addEventListener("visibilitychange",t)}function r(){t&&(document.removeEventListener("visibilitychange",t),t=null),e&&(e.pause(),e.remove(),e=null)}return{start:i,stop:r}}var p={roundStart:"round-start",questionShow:"question-show",answersShow:"answers-show",answerCorrect:"answer-correct",answerWrong:"answer-wrong",answerReveal:"answer-reveal"},gt={[p.roundStart]:"/static/audio/sfx/round-start.mp3",[p.questionShow]:"/static/audio/sfx/question-show.mp3",[p.answersShow]:"/static/audio/sfx/answers-show.mp3",[p.answerCorrect]:"/static/audio/sfx/answer-correct.mp3",[p.answerWrong]:"/static/audio/sfx/answer-wrong.mp3",[p.answerReveal]:"/static/audio/sfx/answer-reveal.mp3"},St=3,Tt=1e3,Ct=.5,At=8e3,It=12e3;function Y(){return typeof window<"u"&&window.Howl||null}function v(){return typeof window<"u"&&window.Howler||null}function z(){let n=v();n&&(n.autoSuspend=!1)}function vt(){let n=v(),e=n?n.ctx:null;return!e||e.state==="running"}function H(n){let e={},t=new Map,i=V(),r=null,s=null,l=0,c=null,m=!1,y=!1,d=null;function S(){return!!n.audioMuted}function tt(){let o=Y();if(o){z();for(let[a,u]of Object.entries(gt))e[a]||(e[a]=new o({src:[u],preload:!0,html5:!1,mute:S(),volume:Ct}))}}function E(){try{z();let o=v(),a=o?o.ctx:null;if(a&&typeof a.resume=="function"){let u=a.resume();u&&typeof u.catch=="function"&&u.catch(()=>{})}i.start(),m=!0}catch{}}function et(o){if(S())return;let a=e[o];if(a)try{a.play()}catch{}}function nt(o,a){if(S()){a();return}let u=e[o];if(!u){a();return}let h=l;try{u.once("end",()=>{h===l&&a()}),u.once("stop",()=>{h===l&&a()}),u.play()}catch{a()}}function it(o){let a=Y(),u=Array.isArray(o)?o:o&&Array.isArray(o.clips)?o.clips:[];if(!a||u.length===0)return y=!0,w(),Promise.resolve();let h=u.map(f=>new Promise(P=>{if(f==null||f.questionId==null||!f.audioUrl){P();return}let g={howl:null,loaded:!1,failed:!1,repeat:!!f.audioRepeat};t.set(f.questionId,g);let q=!1,I=()=>{q||(q=!0,clearTimeout(ht),P())},dt=new a({src:[f.audioUrl],format:K,preload:!0,html5:!1,mute:S(),onload:()=>{g.loaded=!0,g.failed=!1,I(),d===f.questionId&&w()},onloaderror:()=>{g.failed=!0,I(),d===f.questionId&&w()}});g.howl=dt;let ht=setTimeout(()=>{!g.loaded&&!g.failed&&(g.failed=!0),I(),d===f.questionId&&w()},At)}));w();let T=null,A=new Promise(f=>{T=setTimeout(f,It)});return Promise.race([Promise.all(h),A]).then(f=>(T!==null&&clearTimeout(T),y=!0,w(),f))}function x(o,a,u){let h=o.howl;if(!h)return;let T=()=>{if(a!==l||u<=1)return;let A=u-1;c=setTimeout(()=>{if(c=null,a===l){try{h.stop(),h.play()}catch{}x(o,a,A)}},Tt)};h.once("end",T)}function R(o,a){C(),l+=1;let u=l;s=o,r=o;let h=a.howl;if(!h){n.audioBlocked=!0;return}try{h.mute(S()),h.off("end"),h.stop(),h.play()}catch{n.audioBlocked=!0;return}n.audioBlocked=!m&&!vt(),a.repeat&&x(a,u,St)}function st(o){o==null||o===r||(d=o,w())}function w(){let o=d;if(o==null||o===r)return;let a=t.get(o);if(!a||!a.howl){y&&(n.audioBlocked=!0);return}if(a.failed){n.audioBlocked=!0;return}a.loaded&&R(o,a)}function ot(o){if(o==null)return;E();let a=t.get(o);if(!a||!a.howl){n.audioBlocked=!0;return}if(a.failed){n.audioBlocked=!0;return}if(n.audioBlocked=!1,a.loaded){R(o,a);return}d=o,r=null,w()}function C(){c!==null&&(clearTimeout(c),c=null)}function rt(){if(C(),l+=1,d=null,s!=null){let o=t.get(s);if(o&&o.howl)try{o.howl.off("end"),o.howl.stop()}catch{}s=null}}function at(){d=null}function lt(){let o=!n.audioMuted;n.audioMuted=o,j(o),ut(o)}function ut(o){for(let a of Object.values(e))try{a.mute(o)}catch{}for(let a of t.values())if(a.howl)try{a.howl.mute(o)}catch{}}function ct(){C(),l+=1,d=null,y=!1,i.stop();for(let o of t.values())if(o.howl)try{o.howl.unload()}catch{}t.clear(),s=null,r=null}return{preloadEffects:tt,unlock:E,playEffect:et,playEffectThen:nt,preloadClips:it,playClip:st,replayClip:ot,stopClip:rt,cancelPendingClip:at,toggleMute:lt,muted:S,teardown:ct,isUnlocked:()=>m}}function X(){return k()}function W(n,{animate:e,ownsRow:t=null}={}){let i=Math.max(1,...n.map(s=>s.totalScore));return{rows:n.map(s=>({playerId:s.playerId,displayName:s.displayName,rank:s.rank,total:s.totalScore,preTotal:s.totalScore-s.roundScore,isMe:t?t(s):!1,displayTotal:e?s.totalScore-s.roundScore:s.totalScore})),maxTotal:i}}function J(n,e,t=900){let i=typeof window<"u"?window.anime:null;if(!n.some(s=>s.total!==s.preTotal)||!i){n.forEach(s=>{s.displayTotal=s.total});return}n.forEach(s=>{let l={v:s.preTotal};e(l,{v:s.total,duration:t,ease:"outCubic",onUpdate:()=>{s.displayTotal=Math.round(l.v)},onComplete:()=>{s.displayTotal=s.total}})})}function Mt(n,e){let t=new Map(e.map((r,s)=>[String(r),s])),i=e.length;return[...n].sort((r,s)=>{let l=t.has(String(r.playerId))?t.get(String(r.playerId)):i,c=t.has(String(s.playerId))?t.get(String(s.playerId)):i;return l-c})}function Et(n){let e=new Map;return n&&n.querySelectorAll("[data-standings-row][data-player-id]").forEach(t=>{e.set(t.getAttribute("data-player-id"),t.getBoundingClientRect().top)}),e}function xt(n,e,t,i=450){!n||!e||e.size===0||n.querySelectorAll("[data-standings-row][data-player-id]").forEach(r=>{let s=r.getAttribute("data-player-id"),l=e.get(s);if(l===void 0)return;let c=l-r.getBoundingClientRect().top;c!==0&&(r.style.transform=`translateY(${c}px)`,t(r,{translateY:[c,0],duration:i,ease:"inOutQuad",onComplete:()=>{r.style.transform=""}}))})}function Rt(n){if(typeof window<"u"&&typeof window.requestAnimationFrame=="function"){window.requestAnimationFrame(()=>n());return}setTimeout(n,16)}function Z({rows:n,prevOrder:e,animate:t,runAnim:i,setBars:r,getBars:s,getContainer:l,afterRender:c,animateBars:m}){if(!t||!e||e.length===0){r(n),t&&m(s(),i);return}r(Mt(n,e)),c(()=>{let y=Et(l());r(n),m(s(),i),Rt(()=>xt(l(),y,i))})}var Pt=3,M=1e3,qt=3e4;function bt(n,e){return{joinCode:n,phase:"lobby",players:[],hasQuiz:!!e,question:null,imageError:!1,lastQuestionId:null,audioMuted:X(),audioBlocked:!1,audio:null,clipsPreloaded:!1,preloadInFlight:!1,roundStartPlayed:!1,lastAudioPhase:null,lastAudioQuestionId:null,answersShownQuestionId:null,round:null,clockOffset:0,progress:100,revealing:!1,connected:!1,connectionTrouble:!1,stateFailures:0,stateSeq:0,sessionGone:!1,starting:!1,startMessage:"",source:null,timer:null,reconnectTimer:null,reconnectDelay:M,startAt:null,startRemaining:0,startTimer:null,arming:!1,standingsBars:[],maxStandingsTotal:1,lastStandingsKey:null,lastStandingsOrder:null,rootEl:null,init(){this.rootEl=this.$root,this.audio=H(this),this.audio.preloadEffects(),this.refresh(),this.connect(),this.onVisible=()=>this.handleVisible(),document.addEventListener("visibilitychange",this.onVisible),window.addEventListener("beforeunload",()=>this.teardown())},connect(){this.clearReconnectTimer(),this.disconnect();let t=new EventSource(`/api/sessions/${encodeURIComponent(this.joinCode)}/events`);this.source=t,t.onopen=()=>{this.connected=!0,this.reconnectDelay=M},t.onmessage=()=>{this.connected=!0,this.reconnectDelay=M,this.refresh()},t.onerror=()=>{this.connected=!1,t.readyState===EventSource.CLOSED&&this.scheduleReconnect()}},scheduleReconnect(){if(this.reconnectTimer||this.sessionGone)return;let t=this.reconnectDelay;this.reconnectDelay=Math.min(this.reconnectDelay*2,qt),this.reconnectTimer=setTimeout(()=>{this.reconnectTimer=null,!this.sessionGone&&(this.refresh(),this.connect())},t)},clearReconnectTimer(){this.reconnectTimer&&(clearTimeout(this.reconnectTimer),this.reconnectTimer=null)},streamDropped(){return!this.source||this.source.readyState===EventSource.CLOSED},handleVisible(){document.visibilityState==="visible"&&(this.sessionGone||(this.refresh(),this.streamDropped()&&this.connect()))},disconnect(){this.clearReconnectTimer(),this.source&&(this.source.close(),this.source=null)},teardown(){this.disconnect(),this.stopCountdown(),this.stopStartCountdown(),this.onVisible&&document.removeEventListener("visibilitychange",this.onVisible),this.audio&&this.audio.teardown()},async refresh(){let t=++this.stateSeq;try{let i=await fetch(`/api/sessions/${encodeURIComponent(this.joinCode)}/state`,{headers:{Accept:"application/json"}});if(!i.ok){i.status===404?this.markSessionGone():this.noteStateFailure();return}let r=await i.json();if(t!==this.stateSeq)return;this.stateFailures=0,this.connectionTrouble=!1,this.applyState(r)}catch{this.noteStateFailure()}},noteStateFailure(){this.stateFailures+=1,this.stateFailures>=Pt&&(this.connectionTrouble=!0)},markSessionGone(){this.sessionGone||(this.sessionGone=!0,this.connectionTrouble=!1,this.disconnect(),this.stopCountdown(),this.stopStartCountdown())},applyState(t){this.phase=typeof t.phase=="string"?t.phase:"lobby",this.players=Array.isArray(t.players)?t.players:[],this.hasQuiz=t.quiz!=null,this.question=t.question??null;let i=this.question?this.question.id:null;i!==this.lastQuestionId&&(this.lastQuestionId=i,this.imageError=!1,this.question&&this.question.imageUrl&&Q(this.question.imageUrl),this.audio&&this.audio.stopClip(),this.audioBlocked=!1),this.round=t.round??null;let r=N(t.serverNow);r!==null&&(this.clockOffset=r),this.hasQuiz&&this.phase!=="lobby"&&!this.clipsPreloaded&&this.preloadGameAudio(),this.applyAudioCues(),this.phase==="question"&&this.question?this.startCountdown():(this.stopCountdown(),this.revealing=!1,this.progress=this.phase==="reveal"?0:100),this.syncStartCountdown(t),this.syncStandings(t)},applyAudioCues(){if(!this.audio)return;let t=this.question?this.question.id:null;if(!(this.phase===this.lastAudioPhase&&t===this.lastAudioQuestionId)){if(this.lastAudioPhase=this.phase,this.lastAudioQuestionId=t,this.phase==="round_intro"){this.roundStartPlayed?this.roundStartPlayed=!1:this.audio.playEffect(p.roundStart);return}if(this.phase==="question"&&this.question){this.audio.playEffectThen(p.questionShow,()=>{this.question.audioUrl&&this.audio.playClip(this.question.id)});return}this.phase==="reveal"&&(this.audio.cancelPendingClip(),this.audio.playEffect(p.answerReveal))}},syncStartCountdown(t){if(this.startAt=this.phase==="lobby"?t.startAt??null:null,!this.startAt){this.stopStartCountdown(),this.startRemaining=0;return}G(this.startAt,{serverNow:()=>this.serverTime(),setRemaining:i=>{this.startRemaining=i},setTimer:i=>{this.startTimer=i},clearTimer:()=>this.stopStartCountdown()})},stopStartCountdown(){this.startTimer&&(clearInterval(this.startTimer),this.startTimer=null)},armed(){return!!this.startAt},startCountdownLabel(){return`Starting in ${L(this.startRemaining)}`},showsPickQuizLink(){return this.phase==="lobby"&&!this.hasQuiz||this.phase==="intermission"},showsEndSession(){return this.phase!=="finished"},showsJoinHint(){return this.phase!=="lobby"&&this.phase!=="finished"},showsStandings(){return this.phase==="round_results"||this.phase==="intermission"||this.phase==="finished"},syncStandings(t){let i=Array.isArray(t.standings)?t.standings:null;if(!this.showsStandings()||!i){this.standingsBars=[],this.maxStandingsTotal=1,this.lastStandingsKey=null;return}let r=this.question?this.question.id:"none",s=`${this.phase}:${r}`;if(s===this.lastStandingsKey)return;this.lastStandingsKey=s;let l=this.showsStandings(),{rows:c,maxTotal:m}=W(i,{animate:l});this.maxStandingsTotal=m;let y=this.lastStandingsOrder;this.lastStandingsOrder=c.map(d=>String(d.playerId)),Z({rows:c,prevOrder:y,animate:l,runAnim:b,setBars:d=>{this.standingsBars=d},getBars:()=>this.standingsBars,getContainer:()=>this.standingsContainer(),afterRender:d=>this.$nextTick(d),animateBars:J})},standingsContainer(){return this.rootEl?this.rootEl.querySelector("[data-standings-bars]"):null},serverTime(){return _(this.clockOffset)},startCountdown(){D(this.question,{serverNow:()=>this.serverTime(),setProgress:t=>{this.progress=t},setRevealing:t=>{let i=this.revealing;this.revealing=t,!t&&i&&this.phase==="question"&&this.question&&this.answersShownQuestionId!==this.question.id&&(this.answersShownQuestionId=this.question.id,this.audio&&this.audio.playEffect(p.answersShow))},setTimer:t=>{this.timer=t},clearTimer:()=>this.stopCountdown()})},stopCountdown(){this.timer&&(clearInterval(this.timer),this.timer=null)},answeredCount(){return!this.question||!Array.isArray(this.question.answeredPlayerIds)?0:this.question.answeredPlayerIds.length},allAnswered(){return this.players.length>0&&this.answeredCount()>=this.players.length},displayNameFor(t){let i=this.players.find(r=>r.playerId===t);return i?i.displayName:"Player"},answerCorrectness(t){if(this.phase!=="reveal"||!this.question||!Array.isArray(this.question.answers))return null;let i=this.question.answers.find(r=>r.playerId===t);return!i||typeof i.correct!="boolean"?null:i.correct},isCorrectOption(t){return!this.question||!Array.isArray(this.question.correctOptionIds)?!1:this.question.correctOptionIds.includes(t)},playerCountLabel(){return`${this.players.filter(i=>i.isReady).length} / ${this.players.length} ready`},roundEyebrow(){return this.round&&this.round.number>0&&this.round.total>0?`Round ${this.round.number} of ${this.round.total}`:"Get ready"},roundTitle(){return this.round&&this.round.title?this.round.title:"Next round"},roundSummary(){return this.round&&this.round.summary?this.round.summary:""},async start(){this.audio&&(this.audio.unlock(),this.audio.playEffect(p.roundStart),this.roundStartPlayed=!0),this.preloadGameAudio(),this.starting=!0,this.startMessage="";try{(await fetch(`/host/${encodeURIComponent(this.joinCode)}/start`,{method:"POST",headers:{"Content-Type":"application/x-www-form-urlencoded"},body:new URLSearchParams({csrf_token:this.csrfToken()})})).ok||(this.startMessage="Could not start the game. Try again.")}catch{this.startMessage="Could not start the game. Try again."}finally{this.starting=!1}},async preloadGameAudio(){if(!this.audio||this.clipsPreloaded||this.preloadInFlight)return;this.preloadInFlight=!0;let t=null,i=!1;try{let r=await fetch(`/api/sessions/${encodeURIComponent(this.joinCode)}/audio`,{headers:{Accept:"application/json"}});r.ok&&(t=await r.json(),i=!0)}catch(r){console.warn("preloadGameAudio failed",r)}this.preloadInFlight=!1,i&&(this.clipsPreloaded=!0),await this.audio.preloadClips(t)},async armStart(){this.arming=!0,this.startMessage="";try{(await fetch(`/api/sessions/${encodeURIComponent(this.joinCode)}/arm-start`,{method:"POST",headers:{Accept:"application/json"}})).ok||(this.startMessage="Could not arm the countdown. Try again.")}catch{this.startMessage="Could not arm the countdown. Try again."}finally{this.arming=!1}},async cancelStart(){this.arming=!0,this.startMessage="";try{(await fetch(`/api/sessions/${encodeURIComponent(this.joinCode)}/cancel-start`,{method:"POST",headers:{Accept:"application/json"}})).ok||(this.startMessage="Could not cancel the countdown. Try again.")}catch{this.startMessage="Could not cancel the countdown. Try again."}finally{this.arming=!1}},replayAudio(){this.audio&&this.question&&this.audio.replayClip(this.question.id)},toggleMute(){this.audio&&this.audio.toggleMute()},csrfToken(){let t=this.$el.querySelector('input[name="csrf_token"]');return t?t.value:""}}}document.addEventListener("alpine:init",()=>{window.Alpine.data("hostBigScreen",bt)});
+function ft(){return typeof window<"u"&&typeof window.matchMedia=="function"&&window.matchMedia("(prefers-reduced-motion: reduce)").matches}function b(n,e){if(ft()||typeof window>"u"||!window.anime){typeof e.onComplete=="function"&&e.onComplete();return}let t=window.anime;typeof t.animate=="function"?t.animate(n,e):typeof t=="function"?t({targets:n,...e}):typeof e.onComplete=="function"&&e.onComplete()}function N(n){if(!n)return null;let e=new Date(n).getTime();return Number.isFinite(e)?e-Date.now():null}function _(n){return Date.now()+n}function pt(n,e,t){let i=t-e;return i>0?F((n-e)/i*100):100}function O(n,e,t){let i=t-e;return i>0?F((t-n)/i*100):0}function F(n){return Math.max(0,Math.min(100,n))}function D(n,e){e.clearTimer();let t=n&&n.startedAt?new Date(n.startedAt).getTime():NaN,i=n&&n.expiresAt?new Date(n.expiresAt).getTime():NaN;if(!Number.isFinite(t)||!Number.isFinite(i)||i<=t){e.setRevealing(!1),e.setProgress(100);return}if(e.serverNow()<t){mt(t,i,e);return}B(t,i,e)}function mt(n,e,t){let i=t.serverNow();t.setRevealing(!0),t.setProgress(0);let r=()=>{let s=t.serverNow();if(s>=n){t.setProgress(100),t.clearTimer(),t.setRevealing(!1),B(n,e,t);return}t.setProgress(pt(s,i,n))};r(),t.setTimer(setInterval(r,100))}function B(n,e,t){t.clearTimer(),t.setRevealing(!1);let i=()=>{let r=O(t.serverNow(),n,e);t.setProgress(r),r<=0&&t.clearTimer()};i(),!(O(t.serverNow(),n,e)<=0)&&t.setTimer(setInterval(i,100))}function U(n,e){return Math.max(0,Math.ceil((e-n)/1e3))}function L(n){let e=Math.max(0,Math.floor(n)),t=Math.floor(e/60),i=e%60;return`${t}:${String(i).padStart(2,"0")}`}function G(n,e){e.clearTimer();let t=n?new Date(n).getTime():NaN;if(!Number.isFinite(t)){e.setRemaining(0);return}let i=()=>{let r=U(e.serverNow(),t);e.setRemaining(r),r<=0&&e.clearTimer()};i(),!(U(e.serverNow(),t)<=0)&&e.setTimer(setInterval(i,250))}function Q(n){return!n||typeof window>"u"||typeof Image!="function"?Promise.resolve():new Promise(e=>{let t=new Image;t.onload=()=>e(),t.onerror=()=>e(),t.src=n})}var $="tb.audioMuted";function k(){try{return window.localStorage.getItem($)==="1"}catch{return!1}}function j(n){try{window.localStorage.setItem($,n?"1":"0")}catch{}}var K=["mp3","m4a","ogg","wav"];var yt="/static/audio/silence.wav";function wt(){if(typeof navigator>"u")return!1;let n=navigator.userAgent||"";return/iPad|iPhone|iPod/.test(n)?!0:/Macintosh/.test(n)&&(navigator.maxTouchPoints||0)>1}function V(){let n=wt(),e=null,t=null;function i(){if(!n||e||typeof document>"u")return;e=document.createElement("audio"),e.src=yt,e.loop=!0,e.setAttribute("playsinline",""),e.setAttribute("aria-hidden","true"),e.style.display="none",document.body.appendChild(e);let s=e.play();s&&typeof s.catch=="function"&&s.catch(()=>{}),t=()=>{if(e)if(document.visibilityState==="hidden")e.pause();else{let l=e.play();l&&typeof l.catch=="function"&&l.catch(()=>{})}},document.addEventListener("visibilitychange",t)}function r(){t&&(document.removeEventListener("visibilitychange",t),t=null),e&&(e.pause(),e.remove(),e=null)}return{start:i,stop:r}}var p={roundStart:"round-start",questionShow:"question-show",answersShow:"answers-show",answerCorrect:"answer-correct",answerWrong:"answer-wrong",answerReveal:"answer-reveal"},gt={[p.roundStart]:"/static/audio/sfx/round-start.mp3",[p.questionShow]:"/static/audio/sfx/question-show.mp3",[p.answersShow]:"/static/audio/sfx/answers-show.mp3",[p.answerCorrect]:"/static/audio/sfx/answer-correct.mp3",[p.answerWrong]:"/static/audio/sfx/answer-wrong.mp3",[p.answerReveal]:"/static/audio/sfx/answer-reveal.mp3"},St=3,Tt=1e3,Ct=.5,At=8e3,It=12e3;function Y(){return typeof window<"u"&&window.Howl||null}function v(){return typeof window<"u"&&window.Howler||null}function z(){let n=v();n&&(n.autoSuspend=!1)}function vt(){let n=v(),e=n?n.ctx:null;return!e||e.state==="running"}function H(n){let e={},t=new Map,i=V(),r=null,s=null,l=0,c=null,m=!1,y=!1,d=null;function S(){return!!n.audioMuted}function tt(){let o=Y();if(o){z();for(let[a,u]of Object.entries(gt))e[a]||(e[a]=new o({src:[u],preload:!0,html5:!1,mute:S(),volume:Ct}))}}function E(){try{z();let o=v(),a=o?o.ctx:null;if(a&&typeof a.resume=="function"){let u=a.resume();u&&typeof u.catch=="function"&&u.catch(()=>{})}i.start(),m=!0}catch{}}function et(o){if(S())return;let a=e[o];if(a)try{a.play()}catch{}}function nt(o,a){if(S()){a();return}let u=e[o];if(!u){a();return}let h=l;try{u.once("end",()=>{h===l&&a()}),u.once("stop",()=>{h===l&&a()}),u.play()}catch{a()}}function it(o){let a=Y(),u=Array.isArray(o)?o:o&&Array.isArray(o.clips)?o.clips:[];if(!a||u.length===0)return y=!0,w(),Promise.resolve();let h=u.map(f=>new Promise(P=>{if(f==null||f.questionId==null||!f.audioUrl){P();return}let g={howl:null,loaded:!1,failed:!1,repeat:!!f.audioRepeat};t.set(f.questionId,g);let q=!1,I=()=>{q||(q=!0,clearTimeout(ht),P())},dt=new a({src:[f.audioUrl],format:K,preload:!0,html5:!1,mute:S(),onload:()=>{g.loaded=!0,g.failed=!1,I(),d===f.questionId&&w()},onloaderror:()=>{g.failed=!0,I(),d===f.questionId&&w()}});g.howl=dt;let ht=setTimeout(()=>{!g.loaded&&!g.failed&&(g.failed=!0),I(),d===f.questionId&&w()},At)}));w();let T=null,A=new Promise(f=>{T=setTimeout(f,It)});return Promise.race([Promise.all(h),A]).then(f=>(T!==null&&clearTimeout(T),y=!0,w(),f))}function x(o,a,u){let h=o.howl;if(!h)return;let T=()=>{if(a!==l||u<=1)return;let A=u-1;c=setTimeout(()=>{if(c=null,a===l){try{h.stop(),h.play()}catch{}x(o,a,A)}},Tt)};h.once("end",T)}function R(o,a){C(),l+=1;let u=l;s=o,r=o;let h=a.howl;if(!h){n.audioBlocked=!0;return}try{h.mute(S()),h.off("end"),h.stop(),h.play()}catch{n.audioBlocked=!0;return}n.audioBlocked=!m&&!vt(),a.repeat&&x(a,u,St)}function st(o){o==null||o===r||(d=o,w())}function w(){let o=d;if(o==null||o===r)return;let a=t.get(o);if(!a||!a.howl){y&&(n.audioBlocked=!0);return}if(a.failed){n.audioBlocked=!0;return}a.loaded&&R(o,a)}function ot(o){if(o==null)return;E();let a=t.get(o);if(!a||!a.howl){n.audioBlocked=!0;return}if(a.failed){n.audioBlocked=!0;return}if(n.audioBlocked=!1,a.loaded){R(o,a);return}d=o,r=null,w()}function C(){c!==null&&(clearTimeout(c),c=null)}function rt(){if(C(),l+=1,d=null,s!=null){let o=t.get(s);if(o&&o.howl)try{o.howl.off("end"),o.howl.stop()}catch{}s=null}}function at(){d=null}function lt(){let o=!n.audioMuted;n.audioMuted=o,j(o),ut(o)}function ut(o){for(let a of Object.values(e))try{a.mute(o)}catch{}for(let a of t.values())if(a.howl)try{a.howl.mute(o)}catch{}}function ct(){C(),l+=1,d=null,y=!1,i.stop();for(let o of t.values())if(o.howl)try{o.howl.unload()}catch{}t.clear(),s=null,r=null}return{preloadEffects:tt,unlock:E,playEffect:et,playEffectThen:nt,preloadClips:it,playClip:st,replayClip:ot,stopClip:rt,cancelPendingClip:at,toggleMute:lt,muted:S,teardown:ct,isUnlocked:()=>m}}function X(){return k()}function W(n,{animate:e,ownsRow:t=null}={}){let i=Math.max(1,...n.map(s=>s.totalScore));return{rows:n.map(s=>({playerId:s.playerId,displayName:s.displayName,rank:s.rank,total:s.totalScore,preTotal:s.totalScore-s.roundScore,isMe:t?t(s):!1,displayTotal:e?s.totalScore-s.roundScore:s.totalScore,missedQuestions:s.missedQuestions||0,lateJoin:s.lateJoin||""})),maxTotal:i}}function J(n,e,t=900){let i=typeof window<"u"?window.anime:null;if(!n.some(s=>s.total!==s.preTotal)||!i){n.forEach(s=>{s.displayTotal=s.total});return}n.forEach(s=>{let l={v:s.preTotal};e(l,{v:s.total,duration:t,ease:"outCubic",onUpdate:()=>{s.displayTotal=Math.round(l.v)},onComplete:()=>{s.displayTotal=s.total}})})}function Mt(n,e){let t=new Map(e.map((r,s)=>[String(r),s])),i=e.length;return[...n].sort((r,s)=>{let l=t.has(String(r.playerId))?t.get(String(r.playerId)):i,c=t.has(String(s.playerId))?t.get(String(s.playerId)):i;return l-c})}function Et(n){let e=new Map;return n&&n.querySelectorAll("[data-standings-row][data-player-id]").forEach(t=>{e.set(t.getAttribute("data-player-id"),t.getBoundingClientRect().top)}),e}function xt(n,e,t,i=450){!n||!e||e.size===0||n.querySelectorAll("[data-standings-row][data-player-id]").forEach(r=>{let s=r.getAttribute("data-player-id"),l=e.get(s);if(l===void 0)return;let c=l-r.getBoundingClientRect().top;c!==0&&(r.style.transform=`translateY(${c}px)`,t(r,{translateY:[c,0],duration:i,ease:"inOutQuad",onComplete:()=>{r.style.transform=""}}))})}function Rt(n){if(typeof window<"u"&&typeof window.requestAnimationFrame=="function"){window.requestAnimationFrame(()=>n());return}setTimeout(n,16)}function Z({rows:n,prevOrder:e,animate:t,runAnim:i,setBars:r,getBars:s,getContainer:l,afterRender:c,animateBars:m}){if(!t||!e||e.length===0){r(n),t&&m(s(),i);return}r(Mt(n,e)),c(()=>{let y=Et(l());r(n),m(s(),i),Rt(()=>xt(l(),y,i))})}var Pt=3,M=1e3,qt=3e4;function bt(n,e){return{joinCode:n,phase:"lobby",players:[],hasQuiz:!!e,roomFull:!1,question:null,imageError:!1,lastQuestionId:null,audioMuted:X(),audioBlocked:!1,audio:null,clipsPreloaded:!1,preloadInFlight:!1,roundStartPlayed:!1,lastAudioPhase:null,lastAudioQuestionId:null,answersShownQuestionId:null,round:null,clockOffset:0,progress:100,revealing:!1,connected:!1,connectionTrouble:!1,stateFailures:0,stateSeq:0,sessionGone:!1,starting:!1,startMessage:"",source:null,timer:null,reconnectTimer:null,reconnectDelay:M,startAt:null,startRemaining:0,startTimer:null,arming:!1,standingsBars:[],maxStandingsTotal:1,lastStandingsKey:null,lastStandingsOrder:null,rootEl:null,init(){this.rootEl=this.$root,this.audio=H(this),this.audio.preloadEffects(),this.refresh(),this.connect(),this.onVisible=()=>this.handleVisible(),document.addEventListener("visibilitychange",this.onVisible),window.addEventListener("beforeunload",()=>this.teardown())},connect(){this.clearReconnectTimer(),this.disconnect();let t=new EventSource(`/api/sessions/${encodeURIComponent(this.joinCode)}/events`);this.source=t,t.onopen=()=>{this.connected=!0,this.reconnectDelay=M},t.onmessage=()=>{this.connected=!0,this.reconnectDelay=M,this.refresh()},t.onerror=()=>{this.connected=!1,t.readyState===EventSource.CLOSED&&this.scheduleReconnect()}},scheduleReconnect(){if(this.reconnectTimer||this.sessionGone)return;let t=this.reconnectDelay;this.reconnectDelay=Math.min(this.reconnectDelay*2,qt),this.reconnectTimer=setTimeout(()=>{this.reconnectTimer=null,!this.sessionGone&&(this.refresh(),this.connect())},t)},clearReconnectTimer(){this.reconnectTimer&&(clearTimeout(this.reconnectTimer),this.reconnectTimer=null)},streamDropped(){return!this.source||this.source.readyState===EventSource.CLOSED},handleVisible(){document.visibilityState==="visible"&&(this.sessionGone||(this.refresh(),this.streamDropped()&&this.connect()))},disconnect(){this.clearReconnectTimer(),this.source&&(this.source.close(),this.source=null)},teardown(){this.disconnect(),this.stopCountdown(),this.stopStartCountdown(),this.onVisible&&document.removeEventListener("visibilitychange",this.onVisible),this.audio&&this.audio.teardown()},async refresh(){let t=++this.stateSeq;try{let i=await fetch(`/api/sessions/${encodeURIComponent(this.joinCode)}/state`,{headers:{Accept:"application/json"}});if(!i.ok){i.status===404?this.markSessionGone():this.noteStateFailure();return}let r=await i.json();if(t!==this.stateSeq)return;this.stateFailures=0,this.connectionTrouble=!1,this.applyState(r)}catch{this.noteStateFailure()}},noteStateFailure(){this.stateFailures+=1,this.stateFailures>=Pt&&(this.connectionTrouble=!0)},markSessionGone(){this.sessionGone||(this.sessionGone=!0,this.connectionTrouble=!1,this.disconnect(),this.stopCountdown(),this.stopStartCountdown())},applyState(t){this.phase=typeof t.phase=="string"?t.phase:"lobby",this.players=Array.isArray(t.players)?t.players:[],this.hasQuiz=t.quiz!=null,this.roomFull=t.roomFull===!0,this.question=t.question??null;let i=this.question?this.question.id:null;i!==this.lastQuestionId&&(this.lastQuestionId=i,this.imageError=!1,this.question&&this.question.imageUrl&&Q(this.question.imageUrl),this.audio&&this.audio.stopClip(),this.audioBlocked=!1),this.round=t.round??null;let r=N(t.serverNow);r!==null&&(this.clockOffset=r),this.hasQuiz&&this.phase!=="lobby"&&!this.clipsPreloaded&&this.preloadGameAudio(),this.applyAudioCues(),this.phase==="question"&&this.question?this.startCountdown():(this.stopCountdown(),this.revealing=!1,this.progress=this.phase==="reveal"?0:100),this.syncStartCountdown(t),this.syncStandings(t)},applyAudioCues(){if(!this.audio)return;let t=this.question?this.question.id:null;if(!(this.phase===this.lastAudioPhase&&t===this.lastAudioQuestionId)){if(this.lastAudioPhase=this.phase,this.lastAudioQuestionId=t,this.phase==="round_intro"){this.roundStartPlayed?this.roundStartPlayed=!1:this.audio.playEffect(p.roundStart);return}if(this.phase==="question"&&this.question){this.audio.playEffectThen(p.questionShow,()=>{this.question.audioUrl&&this.audio.playClip(this.question.id)});return}this.phase==="reveal"&&(this.audio.cancelPendingClip(),this.audio.playEffect(p.answerReveal))}},syncStartCountdown(t){if(this.startAt=this.phase==="lobby"?t.startAt??null:null,!this.startAt){this.stopStartCountdown(),this.startRemaining=0;return}G(this.startAt,{serverNow:()=>this.serverTime(),setRemaining:i=>{this.startRemaining=i},setTimer:i=>{this.startTimer=i},clearTimer:()=>this.stopStartCountdown()})},stopStartCountdown(){this.startTimer&&(clearInterval(this.startTimer),this.startTimer=null)},armed(){return!!this.startAt},startCountdownLabel(){return`Starting in ${L(this.startRemaining)}`},showsPickQuizLink(){return this.phase==="lobby"&&!this.hasQuiz||this.phase==="intermission"},showsEndSession(){return this.phase!=="finished"},showsJoinHint(){return this.phase!=="lobby"&&this.phase!=="finished"},showsStandings(){return this.phase==="round_results"||this.phase==="intermission"||this.phase==="finished"},syncStandings(t){let i=Array.isArray(t.standings)?t.standings:null;if(!this.showsStandings()||!i){this.standingsBars=[],this.maxStandingsTotal=1,this.lastStandingsKey=null;return}let r=this.question?this.question.id:"none",s=`${this.phase}:${r}`;if(s===this.lastStandingsKey)return;this.lastStandingsKey=s;let l=this.showsStandings(),{rows:c,maxTotal:m}=W(i,{animate:l});this.maxStandingsTotal=m;let y=this.lastStandingsOrder;this.lastStandingsOrder=c.map(d=>String(d.playerId)),Z({rows:c,prevOrder:y,animate:l,runAnim:b,setBars:d=>{this.standingsBars=d},getBars:()=>this.standingsBars,getContainer:()=>this.standingsContainer(),afterRender:d=>this.$nextTick(d),animateBars:J})},standingsContainer(){return this.rootEl?this.rootEl.querySelector("[data-standings-bars]"):null},serverTime(){return _(this.clockOffset)},startCountdown(){D(this.question,{serverNow:()=>this.serverTime(),setProgress:t=>{this.progress=t},setRevealing:t=>{let i=this.revealing;this.revealing=t,!t&&i&&this.phase==="question"&&this.question&&this.answersShownQuestionId!==this.question.id&&(this.answersShownQuestionId=this.question.id,this.audio&&this.audio.playEffect(p.answersShow))},setTimer:t=>{this.timer=t},clearTimer:()=>this.stopCountdown()})},stopCountdown(){this.timer&&(clearInterval(this.timer),this.timer=null)},answeredCount(){return!this.question||!Array.isArray(this.question.answeredPlayerIds)?0:this.question.answeredPlayerIds.length},allAnswered(){return this.players.length>0&&this.answeredCount()>=this.players.length},displayNameFor(t){let i=this.players.find(r=>r.playerId===t);return i?i.displayName:"Player"},answerCorrectness(t){if(this.phase!=="reveal"||!this.question||!Array.isArray(this.question.answers))return null;let i=this.question.answers.find(r=>r.playerId===t);return!i||typeof i.correct!="boolean"?null:i.correct},isCorrectOption(t){return!this.question||!Array.isArray(this.question.correctOptionIds)?!1:this.question.correctOptionIds.includes(t)},playerCountLabel(){return`${this.players.filter(i=>i.isReady).length} / ${this.players.length} ready`},roundEyebrow(){return this.round&&this.round.number>0&&this.round.total>0?`Round ${this.round.number} of ${this.round.total}`:"Get ready"},roundTitle(){return this.round&&this.round.title?this.round.title:"Next round"},roundSummary(){return this.round&&this.round.summary?this.round.summary:""},async start(){this.audio&&(this.audio.unlock(),this.audio.playEffect(p.roundStart),this.roundStartPlayed=!0),this.preloadGameAudio(),this.starting=!0,this.startMessage="";try{(await fetch(`/host/${encodeURIComponent(this.joinCode)}/start`,{method:"POST",headers:{"Content-Type":"application/x-www-form-urlencoded"},body:new URLSearchParams({csrf_token:this.csrfToken()})})).ok||(this.startMessage="Could not start the game. Try again.")}catch{this.startMessage="Could not start the game. Try again."}finally{this.starting=!1}},async preloadGameAudio(){if(!this.audio||this.clipsPreloaded||this.preloadInFlight)return;this.preloadInFlight=!0;let t=null,i=!1;try{let r=await fetch(`/api/sessions/${encodeURIComponent(this.joinCode)}/audio`,{headers:{Accept:"application/json"}});r.ok&&(t=await r.json(),i=!0)}catch(r){console.warn("preloadGameAudio failed",r)}this.preloadInFlight=!1,i&&(this.clipsPreloaded=!0),await this.audio.preloadClips(t)},async armStart(){this.arming=!0,this.startMessage="";try{(await fetch(`/api/sessions/${encodeURIComponent(this.joinCode)}/arm-start`,{method:"POST",headers:{Accept:"application/json"}})).ok||(this.startMessage="Could not arm the countdown. Try again.")}catch{this.startMessage="Could not arm the countdown. Try again."}finally{this.arming=!1}},async cancelStart(){this.arming=!0,this.startMessage="";try{(await fetch(`/api/sessions/${encodeURIComponent(this.joinCode)}/cancel-start`,{method:"POST",headers:{Accept:"application/json"}})).ok||(this.startMessage="Could not cancel the countdown. Try again.")}catch{this.startMessage="Could not cancel the countdown. Try again."}finally{this.arming=!1}},replayAudio(){this.audio&&this.question&&this.audio.replayClip(this.question.id)},toggleMute(){this.audio&&this.audio.toggleMute()},csrfToken(){let t=this.$el.querySelector('input[name="csrf_token"]');return t?t.value:""}}}document.addEventListener("alpine:init",()=>{window.Alpine.data("hostBigScreen",bt)});
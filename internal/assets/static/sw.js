@@ -95,7 +95,11 @@ self.addEventListener('fetch', (event) => {
         return;
     }
 
+    // Pages pin scripts and stylesheets with ?v=<content hash>; the precache
+    // holds them under their bare URLs, and this worker's cache is already
+    // versioned over the same bytes, so match a /static/ asset on its path.
+    const ignoreSearch = url.pathname.startsWith('/static/');
     event.respondWith(
-        caches.match(req).then((cached) => cached || fetch(req)),
+        caches.match(req, { ignoreSearch }).then((cached) => cached || fetch(req)),
     );
 });
@@ -0,0 +1,91 @@
+// Package audit is the admin content audit log: who created, changed or
+// deleted which quiz, question or round, and which fields changed. The admin
+// handlers record an [Event] after each successful mutation; the admin audit
+// page lists the most recent ones.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Entity types an event can be about.
+const (
+	EntityQuiz     = "quiz"
+	EntityQuestion = "question"
+	EntityRound    = "round"
+)
+
+// Actions an event can record.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Event is one recorded mutation. ActorPlayerID is 0 once the actor's account
+// is gone, and ActorDisplayName is their current name, filled in on read
+// only. Diff is the JSON object [Diff] builds.
+type Event struct {
+	ID               int64
+	ActorPlayerID    int64
+	ActorDisplayName string
+	EntityType       string
+	EntityID         int64
+	Action           string
+	Diff             string
+	CreatedAt        time.Time
+}
+
+// Store persists the audit log.
+type Store interface {
+	// RecordEvent appends e, setting its ID and CreatedAt.
+	RecordEvent(ctx context.Context, e *Event) error
+	// ListRecentEvents returns up to limit events, newest first.
+	ListRecentEvents(ctx context.Context, limit int) ([]*Event, error)
+}
+
+// Change is one field's entry in a diff. From is absent on a create and To
+// on a delete.
+type Change struct {
+	From any `json:"from,omitempty"`
+	To   any `json:"to,omitempty"`
+}
+
+// Fields is an entity's audited fields by name, the snapshot [Diff] compares.
+// Values must encode as JSON.
+type Fields map[string]any
+
+// Diff encodes the fields that differ between before and after as a JSON
+// object of [Change]s keyed by field name. A nil before is a create, so every
+// field of after appears with only its To; a nil after is a delete, the
+// reverse. An update that changed nothing encodes as "{}".
+func Diff(before, after Fields) (string, error) {
+	changes := make(map[string]Change, max(len(before), len(after)))
+	for name, from := range before {
+		to, ok := after[name]
+		if !ok {
+			changes[name] = Change{From: from}
+
+			continue
+		}
+		if !reflect.DeepEqual(from, to) {
+			changes[name] = Change{From: from, To: to}
+		}
+	}
+	for name, to := range after {
+		if _, ok := before[name]; !ok {
+			changes[name] = Change{To: to}
+		}
+	}
+
+	bs, err := json.Marshal(changes)
+	if err != nil {
+		return "", fmt.Errorf("encode audit diff: %w", err)
+	}
+
+	return string(bs), nil
+}
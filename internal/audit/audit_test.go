@@ -0,0 +1,52 @@
+package audit_test
+
+import (
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/audit"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		before, after Fields
+		want          string
+	}{
+		{
+			name:  "create lists every field as to",
+			after: Fields{"title": "Capitals", "maxPlayers": 0},
+			want:  `{"maxPlayers":{"to":0},"title":{"to":"Capitals"}}`,
+		},
+		{
+			name:   "delete lists every field as from",
+			before: Fields{"title": "Capitals"},
+			want:   `{"title":{"from":"Capitals"}}`,
+		},
+		{
+			name:   "update keeps only the changed fields",
+			before: Fields{"title": "Capitals", "options": []string{"Paris", "Lyon"}, "mode": "solo"},
+			after:  Fields{"title": "Capitals", "options": []string{"Paris", "Nice"}, "mode": ""},
+			want:   `{"mode":{"from":"solo","to":""},"options":{"from":["Paris","Lyon"],"to":["Paris","Nice"]}}`,
+		},
+		{
+			name:   "unchanged update is empty",
+			before: Fields{"title": "Capitals"},
+			after:  Fields{"title": "Capitals"},
+			want:   `{}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Diff(tc.before, tc.after)
+			if err != nil {
+				t.Fatalf("Diff err = %v, want nil", err)
+			}
+			if got != tc.want {
+				t.Errorf("Diff = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
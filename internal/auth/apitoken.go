@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/starquake/topbanana/internal/handlers"
+)
+
+// bearerPrefix is the Authorization scheme the authoring API expects. The
+// scheme is matched case-insensitively, as RFC 9110 requires.
+const bearerPrefix = "bearer "
+
+// RequireAPIToken guards the programmatic authoring API (/api/admin/...):
+// the request must carry token as an "Authorization: Bearer" credential.
+// A missing or wrong token answers 401 with a Bearer challenge. An empty
+// token means the API is off, so every request 404s as an unknown route
+// would rather than admitting an empty credential.
+//
+// The request runs without a session player: the token is a machine
+// credential, not an account, so audit events it causes carry no actor.
+func RequireAPIToken(next http.Handler, token string) http.Handler {
+	// Both sides are hashed before the constant-time compare so the
+	// comparison does not leak the token's length either.
+	want := sha256.Sum256([]byte(token))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token == "" {
+			http.NotFound(w, r)
+
+			return
+		}
+		got, ok := bearerToken(r)
+		sum := sha256.Sum256([]byte(got))
+		if !ok || subtle.ConstantTimeCompare(want[:], sum[:]) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+			handlers.WriteError(w, r, http.StatusUnauthorized, "missing or invalid API token")
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken returns the credential of the request's Bearer Authorization
+// header, and false when there is none.
+func bearerToken(r *http.Request) (string, bool) {
+	h := r.Header.Get("Authorization")
+	if len(h) <= len(bearerPrefix) || !strings.EqualFold(h[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	token := strings.TrimSpace(h[len(bearerPrefix):])
+
+	return token, token != ""
+}
@@ -0,0 +1,52 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/auth"
+)
+
+func TestRequireAPIToken(t *testing.T) {
+	t.Parallel()
+
+	token := strings.Repeat("t", 32)
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tests := []struct {
+		name          string
+		token, header string
+		want          int
+	}{
+		{name: "valid token", token: token, header: "Bearer " + token, want: http.StatusNoContent},
+		{name: "scheme is case-insensitive", token: token, header: "bearer " + token, want: http.StatusNoContent},
+		{name: "wrong token", token: token, header: "Bearer " + strings.Repeat("x", 32), want: http.StatusUnauthorized},
+		{name: "token prefix", token: token, header: "Bearer " + token[:31], want: http.StatusUnauthorized},
+		{name: "missing header", token: token, want: http.StatusUnauthorized},
+		{name: "other scheme", token: token, header: "Basic " + token, want: http.StatusUnauthorized},
+		{name: "API off", header: "Bearer ", want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/api/admin/quizzes/q/questions/r", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rr := httptest.NewRecorder()
+			RequireAPIToken(ok, tt.token).ServeHTTP(rr, req)
+
+			if got, want := rr.Code, tt.want; got != want {
+				t.Errorf("status = %d, want %d", got, want)
+			}
+			if rr.Code == http.StatusUnauthorized && rr.Header().Get("WWW-Authenticate") == "" {
+				t.Error("401 is missing its WWW-Authenticate challenge")
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/starquake/topbanana/internal/bgtasks"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/locale"
+	"github.com/starquake/topbanana/internal/ratelimit"
 	"github.com/starquake/topbanana/internal/session"
 )
 
@@ -110,7 +111,10 @@ func HandleForgotSubmit(
 			return
 		}
 
-		if wait, allowed := limiter.Allow(limiter.ClientIP(r)); !allowed {
+		clientIP := limiter.ClientIP(r)
+		wait, allowed := limiter.Allow(clientIP)
+		ratelimit.SetHeaders(w, limiter.Status(clientIP))
+		if !allowed {
 			seconds := int((wait + time.Second - 1) / time.Second)
 			flash.SetError(w, locale.Translate(locale.Resolve(r), "common.slowDownSubmit"), seconds)
 			w.Header().Set("Retry-After", strconv.Itoa(seconds))
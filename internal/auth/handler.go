@@ -13,11 +13,14 @@ import (
 	"time"
 
 	"github.com/starquake/topbanana/internal/absurl"
+	"github.com/starquake/topbanana/internal/assets"
 	"github.com/starquake/topbanana/internal/bgtasks"
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/envtag"
 	"github.com/starquake/topbanana/internal/locale"
 	"github.com/starquake/topbanana/internal/mailer"
+	"github.com/starquake/topbanana/internal/ratelimit"
 	"github.com/starquake/topbanana/internal/render"
 	"github.com/starquake/topbanana/internal/session"
 	"github.com/starquake/topbanana/internal/version"
@@ -603,7 +606,9 @@ func HandleLoginSubmit(
 		// or not the submitted email exists - same shape the dummy-hash
 		// timing equalisation already gives the credential-check path.
 		clientIP := deps.Limiter.ClientIP(r)
-		if wait, allowed := deps.Limiter.Allow(clientIP); !allowed {
+		wait, allowed := deps.Limiter.Allow(clientIP)
+		ratelimit.SetHeaders(w, deps.Limiter.Status(clientIP))
+		if !allowed {
 			logger.WarnContext(r.Context(), "login blocked: rate limited",
 				slog.String(logIPKey, clientIP),
 				slog.Duration(logWaitKey, wait))
@@ -1029,6 +1034,7 @@ func parseTemplate(page string) *template.Template {
 		"csrfToken":      func() string { return "" },
 		"ogImage":        func() string { return "" },
 		"envTitleTag":    envtag.Get,
+		"asset":          assets.URL,
 		"versionLabel":   version.Label,
 		"viewerName":     func() string { return "" },
 		"isSignedIn":     func() bool { return false },
@@ -1041,13 +1047,13 @@ func parseTemplate(page string) *template.Template {
 		"t":      func(string) string { return "" },
 		"tCount": func(string, int) string { return "" },
 		"lang":   func() string { return locale.LocaleEN },
+		"brand":  func() branding.Branding { return branding.Branding{} },
 		"passwordHelp": func() string {
 			return fmt.Sprintf("Must be %d-%d characters.", MinPasswordLength, MaxPasswordLength)
 		},
 		"passwordMinLength": func() int { return MinPasswordLength },
 		// Placeholder so the shared components glob parses; this surface
 		// does not render quiz_card, which is the only user (#889).
-		"humanizeTime": func(time.Time) string { return "" },
 	}
 
 	return render.Parse(tmpl.FS, funcs, page, "components/*.gohtml", "auth/layouts/*.gohtml")
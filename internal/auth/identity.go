@@ -0,0 +1,109 @@
+package auth
+
+import "strconv"
+
+// Identity is the generated look of a player: a friendly nickname and an
+// avatar color (a "#rrggbb" hex string), both derived from the player id.
+type Identity struct {
+	Nickname string
+	Color    string
+}
+
+// IdentityGenerator derives a player's generated [Identity] from their id.
+// Implementations must be deterministic - the same id always yields the same
+// identity, across restarts and processes - so a visitor keeps one stable
+// face without anything extra being stored.
+type IdentityGenerator interface {
+	Identity(playerID int64) Identity
+}
+
+// AnimalIdentity is the default [IdentityGenerator]: an "Adjective Animal"
+// nickname (e.g. "Brave Banana") and a color from a fixed palette. The word
+// lists and palette are append-only; reordering or removing an entry would
+// change every existing player's identity.
+type AnimalIdentity struct{}
+
+// Identity implements [IdentityGenerator]. The id is run through a 64-bit
+// mixer first so consecutive ids land on unrelated names and colors.
+func (AnimalIdentity) Identity(playerID int64) Identity {
+	h := mixPlayerID(uint64(playerID)) //nolint:gosec // bit pattern only; the sign is irrelevant.
+	adjectives := uint64(len(identityAdjectives))
+	animals := uint64(len(identityAnimals))
+	colors := uint64(len(identityColors))
+
+	return Identity{
+		Nickname: identityAdjectives[h%adjectives] + " " + identityAnimals[(h/adjectives)%animals],
+		Color:    identityColors[(h>>identityColorShift)%colors],
+	}
+}
+
+// identityColorShift picks the color from the mixer's high bits so it varies
+// independently of the nickname, which consumes the low bits.
+const identityColorShift = 40
+
+// mixPlayerID is the splitmix64 finalizer: a cheap, well-distributed bijection
+// on 64-bit values.
+func mixPlayerID(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+
+	return x ^ (x >> 31)
+}
+
+// IdentityNicknames lists the display names to try, in order, when giving a
+// new anonymous player its generated nickname: the bare nickname, then the
+// nickname suffixed with the player id, which no other generated name can
+// take.
+func IdentityNicknames(identity Identity, playerID int64) []string {
+	return []string{identity.Nickname, identity.Nickname + " " + strconv.FormatInt(playerID, 10)}
+}
+
+// identityAdjectives is the title-cased, playful adjective list used by
+// AnimalIdentity. Append-only.
+//
+//nolint:gochecknoglobals // dictionary table; values never mutate.
+var identityAdjectives = []string{
+	"Brave", "Bouncy", "Breezy", "Bubbly", "Cheeky", "Cheerful", "Chipper",
+	"Clever", "Cosmic", "Cozy", "Curious", "Dapper", "Daring", "Dizzy",
+	"Dreamy", "Eager", "Electric", "Fancy", "Fearless", "Feisty", "Fluffy",
+	"Friendly", "Frosty", "Fuzzy", "Gentle", "Giddy", "Glittery", "Golden",
+	"Groovy", "Happy", "Hasty", "Heroic", "Humble", "Jazzy", "Jolly", "Jumpy",
+	"Kind", "Lively", "Lucky", "Mellow", "Merry", "Mighty", "Mystic", "Nifty",
+	"Nimble", "Noble", "Peppy", "Perky", "Plucky", "Polite", "Proud", "Quick",
+	"Quirky", "Radiant", "Rapid", "Rowdy", "Rusty", "Sassy", "Shiny", "Silly",
+	"Sleepy", "Sly", "Snappy", "Sneaky", "Sparkly", "Speedy", "Spicy", "Spooky",
+	"Sprightly", "Sunny", "Swift", "Tiny", "Toasty", "Trusty", "Valiant",
+	"Velvet", "Wacky", "Wandering", "Whimsical", "Wild", "Wise", "Witty",
+	"Wobbly", "Zany", "Zesty", "Zippy",
+}
+
+// identityAnimals is the title-cased animal list used by AnimalIdentity. The
+// banana mascot is counted among the animals. Append-only.
+//
+//nolint:gochecknoglobals // dictionary table; values never mutate.
+var identityAnimals = []string{
+	"Aardvark", "Alpaca", "Armadillo", "Axolotl", "Badger", "Banana", "Bat",
+	"Beaver", "Bison", "Buffalo", "Camel", "Capybara", "Cheetah", "Chinchilla",
+	"Cobra", "Corgi", "Coyote", "Crab", "Crane", "Dingo", "Dolphin", "Donkey",
+	"Dragonfly", "Duck", "Eagle", "Eel", "Elephant", "Falcon", "Ferret",
+	"Flamingo", "Fox", "Frog", "Gazelle", "Gecko", "Gibbon", "Giraffe", "Goat",
+	"Goose", "Gorilla", "Hamster", "Hare", "Hedgehog", "Heron", "Hippo",
+	"Hornet", "Hyena", "Ibis", "Iguana", "Jackal", "Jaguar", "Jellyfish",
+	"Kangaroo", "Kiwi", "Koala", "Lemur", "Leopard", "Llama", "Lobster",
+	"Lynx", "Manatee", "Meerkat", "Mole", "Mongoose", "Moose", "Narwhal",
+	"Newt", "Ocelot", "Octopus", "Otter", "Owl", "Panda", "Pangolin", "Parrot",
+	"Peacock", "Pelican", "Penguin", "Platypus", "Puffin", "Quokka", "Rabbit",
+	"Raccoon", "Raven", "Reindeer", "Salamander", "Seal", "Shark", "Sloth",
+	"Squid", "Squirrel", "Stork", "Tapir", "Tiger", "Toucan", "Turtle",
+	"Walrus", "Weasel", "Wolf", "Wombat", "Yak", "Zebra",
+}
+
+// identityColors is the avatar palette used by AnimalIdentity: saturated
+// mid-tones that read on both the light and dark themes. Append-only.
+//
+//nolint:gochecknoglobals // palette table; values never mutate.
+var identityColors = []string{
+	"#e4572e", "#f3a712", "#ffd23f", "#a8c256", "#3bb273", "#17bebb",
+	"#2e86ab", "#4d7cfe", "#7768ae", "#b56cd8", "#e15a97", "#ff7f50",
+}
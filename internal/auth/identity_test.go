@@ -0,0 +1,131 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/session"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+var (
+	nicknamePattern = regexp.MustCompile(`^[A-Z][a-z]+ [A-Z][a-z]+$`)
+	colorPattern    = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+)
+
+func TestAnimalIdentity_DeterministicShape(t *testing.T) {
+	t.Parallel()
+
+	gen := AnimalIdentity{}
+	for id := int64(1); id <= 200; id++ {
+		got := gen.Identity(id)
+		if again := gen.Identity(id); again != got {
+			t.Fatalf("Identity(%d) = %+v then %+v, want the same identity", id, got, again)
+		}
+		if !nicknamePattern.MatchString(got.Nickname) {
+			t.Errorf("Identity(%d).Nickname = %q, want \"Adjective Animal\"", id, got.Nickname)
+		}
+		if !colorPattern.MatchString(got.Color) {
+			t.Errorf("Identity(%d).Color = %q, want #rrggbb", id, got.Color)
+		}
+	}
+}
+
+// TestAnimalIdentity_Spread checks consecutive ids don't cluster on a handful
+// of nicknames or colors. The thresholds are loose on purpose: only a
+// degenerate mixer would fail them.
+func TestAnimalIdentity_Spread(t *testing.T) {
+	t.Parallel()
+
+	nicknames := make(map[string]struct{})
+	colors := make(map[string]struct{})
+	for id := int64(1); id <= 500; id++ {
+		got := AnimalIdentity{}.Identity(id)
+		nicknames[got.Nickname] = struct{}{}
+		colors[got.Color] = struct{}{}
+	}
+	if got := len(nicknames); got < 450 {
+		t.Errorf("distinct nicknames over 500 ids = %d, want >= 450", got)
+	}
+	if got := len(colors); got < 10 {
+		t.Errorf("distinct colors over 500 ids = %d, want >= 10", got)
+	}
+}
+
+func TestIdentityNicknames(t *testing.T) {
+	t.Parallel()
+
+	got := IdentityNicknames(Identity{Nickname: "Brave Banana"}, 42)
+	want := []string{"Brave Banana", "Brave Banana 42"}
+	if len(got) != len(want) {
+		t.Fatalf("IdentityNicknames = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("IdentityNicknames[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+// TestEnsurePlayer_GeneratedNickname mints two visitors. The first gets its
+// generated nickname as its display name; the second's nickname is taken up
+// front, so it falls back to the id-suffixed form. Neither counts as a
+// custom name.
+func TestEnsurePlayer_GeneratedNickname(t *testing.T) {
+	t.Parallel()
+
+	players := store.NewPlayerStore(dbtest.Open(t), discardLogger())
+	sessions := session.New([]byte("k"), true)
+	gen := AnimalIdentity{}
+
+	var seen *Player
+	next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		seen, _ = PlayerFromContext(r.Context())
+	})
+	mw := EnsurePlayer(next, players, gen, sessions, discardLogger())
+	mint := func() *Player {
+		t.Helper()
+		seen = nil
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes", nil)
+		mw.ServeHTTP(httptest.NewRecorder(), req)
+		if seen == nil {
+			t.Fatal("PlayerFromContext returned nil player")
+		}
+
+		return seen
+	}
+
+	first := mint()
+	if got, want := first.DisplayName, gen.Identity(first.ID).Nickname; got != want {
+		t.Errorf("first DisplayName = %q, want %q", got, want)
+	}
+	if first.HasCustomName() {
+		t.Error("first HasCustomName() = true, want false")
+	}
+
+	// Ids are sequential: the squatter takes first.ID+1, so the next visitor
+	// is first.ID+2. Occupy that id's nickname before it is minted.
+	nextID := first.ID + 2
+	squatter, err := players.CreateAnonymousPlayer(t.Context(), gen.Identity(nextID).Nickname)
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+	if got, want := squatter.ID, first.ID+1; got != want {
+		t.Fatalf("squatter id = %d, want %d (ids should be sequential)", got, want)
+	}
+
+	second := mint()
+	if got, want := second.ID, nextID; got != want {
+		t.Fatalf("second id = %d, want %d", got, want)
+	}
+	if got, want := second.DisplayName, IdentityNicknames(gen.Identity(second.ID), second.ID)[1]; got != want {
+		t.Errorf("second DisplayName = %q, want %q", got, want)
+	}
+	if second.HasCustomName() {
+		t.Error("second HasCustomName() = true, want false")
+	}
+}
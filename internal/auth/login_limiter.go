@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/starquake/topbanana/internal/ratelimit"
 	"github.com/starquake/topbanana/internal/request"
 )
 
@@ -148,6 +149,20 @@ func (l *LoginRateLimiter) Allow(ip string) (time.Duration, bool) {
 	return 0, true
 }
 
+// Status reports ip's standing without stamping the bucket: an attempt left,
+// or none until the cool-down from its last attempt runs out.
+func (l *LoginRateLimiter) Status(ip string) ratelimit.Status {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	prev, ok := l.last[ip]
+	if !ok {
+		return ratelimit.Cooldown(0)
+	}
+
+	return ratelimit.Cooldown(l.window - l.now().Sub(prev))
+}
+
 // accountFailures tracks one account's recent failed-login streak.
 type accountFailures struct {
 	count    int
@@ -33,8 +33,16 @@ const petnameMaxAttempts = 5
 // an existing players row, creating a fresh anonymous row when
 // necessary. Wrap /api/* routes that attribute work to a player;
 // static client assets are deliberately not wrapped so loading
-// index.html does not create a row.
-func EnsurePlayer(next http.Handler, players PlayerStore, sessions *session.Manager, logger *slog.Logger) http.Handler {
+// index.html does not create a row. A fresh row takes the nickname
+// identities derives from its id as its default display name; a nil
+// identities keeps the petname.
+func EnsurePlayer(
+	next http.Handler,
+	players PlayerStore,
+	identities IdentityGenerator,
+	sessions *session.Manager,
+	logger *slog.Logger,
+) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		player, err := loadSessionPlayer(r, players, sessions)
 		if err != nil && !errors.Is(err, ErrPlayerNotFound) {
@@ -52,6 +60,9 @@ func EnsurePlayer(next http.Handler, players PlayerStore, sessions *session.Mana
 		// Fall-through from loadSessionPlayer (no cookie or deleted row):
 		// the session cookie must be replaced before the next handler runs.
 		player, err = mintAnonymousPlayer(r.Context(), players)
+		if err == nil && identities != nil {
+			player = adoptGeneratedNickname(r.Context(), players, identities, player, logger)
+		}
 		if err != nil {
 			logger.ErrorContext(r.Context(), "error creating anonymous player", slog.Any("err", err))
 			http.Error(w, "internal error", http.StatusInternalServerError)
@@ -70,14 +81,15 @@ func EnsurePlayer(next http.Handler, players PlayerStore, sessions *session.Mana
 // referenced a deleted row OR the cookie's session_version stamp does
 // not match the row's current session_version (a password reset has
 // happened since the cookie was issued, so it must be treated as
-// invalidated). Other store failures bubble up as wrapped errors.
+// invalidated) OR the session was revoked from the device list. Other
+// store failures bubble up as wrapped errors.
 func loadSessionPlayer(r *http.Request, players PlayerStore, sessions *session.Manager) (*Player, error) {
-	playerID, sessionVersion, hasSession := sessions.Decode(r)
+	current, hasSession := sessions.Current(r)
 	if !hasSession {
 		return nil, ErrPlayerNotFound
 	}
 
-	player, err := players.GetPlayerByID(r.Context(), playerID)
+	player, err := players.GetPlayerByID(r.Context(), current.PlayerID)
 	if err != nil {
 		if errors.Is(err, ErrPlayerNotFound) {
 			return nil, ErrPlayerNotFound
@@ -85,11 +97,18 @@ func loadSessionPlayer(r *http.Request, players PlayerStore, sessions *session.M
 
 		return nil, fmt.Errorf("load player by id: %w", err)
 	}
-	if player.SessionVersion != sessionVersion {
+	if player.SessionVersion != current.SessionVersion {
 		// Reset bumped session_version after this cookie was minted -
 		// surface as not-found so the caller bounces through login.
 		return nil, ErrPlayerNotFound
 	}
+	active, err := sessions.Active(r, current, player.SessionRevocations)
+	if err != nil {
+		return nil, fmt.Errorf("check session: %w", err)
+	}
+	if !active {
+		return nil, ErrPlayerNotFound
+	}
 
 	return player, nil
 }
@@ -122,6 +141,30 @@ func mintAnonymousPlayer(ctx context.Context, players PlayerStore) (*Player, err
 	return player, nil
 }
 
+// adoptGeneratedNickname renames a freshly minted anonymous row from its
+// petname to the nickname identities derives from its id, falling back to the
+// id-suffixed form when another row already holds the bare nickname. The
+// petname row is kept as-is when every candidate is taken or the rename
+// fails: the visitor still has a valid, unique name either way.
+func adoptGeneratedNickname(
+	ctx context.Context, players PlayerStore, identities IdentityGenerator, player *Player, logger *slog.Logger,
+) *Player {
+	for _, name := range IdentityNicknames(identities.Identity(player.ID), player.ID) {
+		renamed, err := players.SetGeneratedDisplayName(ctx, player.ID, name)
+		if err == nil {
+			return renamed
+		}
+		if !errors.Is(err, ErrDisplayNameTaken) {
+			logger.WarnContext(ctx, "error setting generated nickname",
+				slog.Int64("player_id", player.ID), slog.Any("err", err))
+
+			return player
+		}
+	}
+
+	return player
+}
+
 // RequireGameHost gates the handler to Hosts and Admins - the dashboard +
 // own-game routes (#538). Unauthenticated requests 303 to /login; GET/HEAD
 // carry the original URI as ?next=<encoded> so the login flow can drop the
@@ -375,7 +375,7 @@ func TestEnsurePlayer_NoCookie_CreatesAnonymousAndSetsCookie(t *testing.T) {
 		w.WriteHeader(http.StatusTeapot)
 	})
 
-	mw := EnsurePlayer(next, players, sessions, discardLogger())
+	mw := EnsurePlayer(next, players, nil, sessions, discardLogger())
 
 	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes", nil)
 	rec := httptest.NewRecorder()
@@ -431,7 +431,7 @@ func TestEnsurePlayer_ValidCookie_ReusesExistingRow(t *testing.T) {
 		seenPlayer, _ = PlayerFromContext(r.Context())
 	})
 
-	mw := EnsurePlayer(next, players, sessions, discardLogger())
+	mw := EnsurePlayer(next, players, nil, sessions, discardLogger())
 
 	rec := httptest.NewRecorder()
 	sessions.Set(rec, existing.ID, 0)
@@ -464,7 +464,7 @@ func TestEnsurePlayer_DeletedPlayer_MintsNewAnonymous(t *testing.T) {
 		seenPlayer, _ = PlayerFromContext(r.Context())
 	})
 
-	mw := EnsurePlayer(next, players, sessions, discardLogger())
+	mw := EnsurePlayer(next, players, nil, sessions, discardLogger())
 
 	// Issue a cookie pointing at an ID that does not exist in the store.
 	rec := httptest.NewRecorder()
@@ -502,7 +502,7 @@ func TestEnsurePlayer_TwoCookielessRequests_TwoDistinctPlayers(t *testing.T) {
 		seenIDs = append(seenIDs, p.ID)
 	})
 
-	mw := EnsurePlayer(next, players, sessions, discardLogger())
+	mw := EnsurePlayer(next, players, nil, sessions, discardLogger())
 
 	for range 2 {
 		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes", nil)
@@ -563,7 +563,7 @@ func TestEnsurePlayer_PetnameCollision_Retries(t *testing.T) {
 		seenPlayer, _ = PlayerFromContext(r.Context())
 	})
 
-	mw := EnsurePlayer(next, fakeStore, sessions, discardLogger())
+	mw := EnsurePlayer(next, fakeStore, nil, sessions, discardLogger())
 
 	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes", nil)
 	rec := httptest.NewRecorder()
@@ -594,7 +594,7 @@ func TestEnsurePlayer_PetnameExhausted_FallsBackToXid(t *testing.T) {
 		seenPlayer, _ = PlayerFromContext(r.Context())
 	})
 
-	mw := EnsurePlayer(next, fakeStore, sessions, discardLogger())
+	mw := EnsurePlayer(next, fakeStore, nil, sessions, discardLogger())
 
 	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes", nil)
 	rec := httptest.NewRecorder()
@@ -619,7 +619,7 @@ func TestEnsurePlayer_CreateAnonymousNonCollisionError_500(t *testing.T) {
 		t.Error("next should not be called when CreateAnonymousPlayer returns a non-collision error")
 	})
 
-	mw := EnsurePlayer(next, fakeStore, sessions, discardLogger())
+	mw := EnsurePlayer(next, fakeStore, nil, sessions, discardLogger())
 
 	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes", nil)
 	rec := httptest.NewRecorder()
@@ -646,7 +646,7 @@ func TestEnsurePlayer_GetPlayerError_500(t *testing.T) {
 		t.Error("next should not be called on store error")
 	})
 
-	mw := EnsurePlayer(next, fakeStore, sessions, discardLogger())
+	mw := EnsurePlayer(next, fakeStore, nil, sessions, discardLogger())
 
 	rec := httptest.NewRecorder()
 	sessions.Set(rec, existing.ID, 0)
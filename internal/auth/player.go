@@ -59,6 +59,9 @@ type Player struct {
 	// cookie (which carries the version it was issued at) becomes
 	// invalid the moment the reset commits (#112).
 	SessionVersion int64
+	// SessionRevocations counts the player's individually revoked sessions;
+	// the session tracker rereads its revoked ids when it moves.
+	SessionRevocations int64
 	// ApprovedAt is nil until an admin clears the account to sign in (#1227).
 	// Admins are stamped automatically and every pre-existing row was backfilled,
 	// so nil only ever marks a registrant still waiting for approval.
@@ -330,6 +333,9 @@ const (
 	AdminActionPromoteAdmin       = "promote_admin"
 	AdminActionDemoteAdmin        = "demote_admin"
 	AdminActionLiveQuizReset      = "live_quiz_reset"
+	AdminActionSnapshotDownloaded = "snapshot_downloaded"
+	AdminActionParticipantNotes   = "participant_notes_set"
+	AdminActionSessionsRevoked    = "sessions_revoked"
 )
 
 // AdminPlayerStore is the read+write persistence interface the admin
@@ -448,6 +454,12 @@ type PlayerStore interface {
 	// Returns ErrDisplayNameTaken when the displayName collides on the UNIQUE
 	// index; the petname caller treats this as a retry signal.
 	CreateAnonymousPlayer(ctx context.Context, displayName string) (*Player, error)
+	// SetGeneratedDisplayName renames a freshly minted anonymous row to its
+	// generated nickname (see [IdentityGenerator]) without marking the name
+	// claimed, so the visitor is still nudged to pick their own.
+	// Returns ErrDisplayNameTaken on a UNIQUE collision and ErrPlayerNotFound
+	// when the row is gone, has credentials, or has already claimed a name.
+	SetGeneratedDisplayName(ctx context.Context, playerID int64, displayName string) (*Player, error)
 	// ClaimPlayer upgrades an anonymous row (password_hash IS NULL) in place
 	// with the supplied credentials and requested role. The store mirrors the
 	// "first password-bearing registrant becomes admin" promotion logic of
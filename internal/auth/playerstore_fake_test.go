@@ -84,6 +84,10 @@ func (s *fakePlayerStore) CreateAnonymousPlayer(_ context.Context, displayName s
 	return p, nil
 }
 
+func (*fakePlayerStore) SetGeneratedDisplayName(context.Context, int64, string) (*Player, error) {
+	return nil, errors.ErrUnsupported
+}
+
 // CreatePlayer mints a row so the failGet tests can seed a player and set
 // a session cookie before flipping the knob; role is irrelevant because
 // failGet short-circuits GetPlayerByID before any role check.
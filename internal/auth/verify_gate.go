@@ -12,6 +12,7 @@ import (
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/htmx"
 	"github.com/starquake/topbanana/internal/locale"
+	"github.com/starquake/topbanana/internal/ratelimit"
 	"github.com/starquake/topbanana/internal/request"
 	"github.com/starquake/topbanana/internal/session"
 )
@@ -161,7 +162,10 @@ func HandleVerifyResend(
 			return
 		}
 
-		if wait, allowed := limiter.Allow(limiter.ClientIP(r)); !allowed {
+		clientIP := limiter.ClientIP(r)
+		wait, allowed := limiter.Allow(clientIP)
+		ratelimit.SetHeaders(w, limiter.Status(clientIP))
+		if !allowed {
 			// Round sub-second remainders up so a 0.4s wait still
 			// reports as 1s rather than "now"; otherwise Retry-After:
 			// 0 (and ResendDisabled=false) lets a scripted client
@@ -275,3 +279,17 @@ func (l *VerifyResendLimiter) Allow(ip string) (time.Duration, bool) {
 
 	return 0, true
 }
+
+// Status reports ip's standing without stamping the bucket: a send left, or
+// none until the cool-down from its last send runs out.
+func (l *VerifyResendLimiter) Status(ip string) ratelimit.Status {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	prev, ok := l.last[ip]
+	if !ok {
+		return ratelimit.Cooldown(0)
+	}
+
+	return ratelimit.Cooldown(l.window - l.now().Sub(prev))
+}
@@ -0,0 +1,208 @@
+// Package branding holds the per-deployment branding an Admin sets from the
+// console: the name, logo and primary color shown on the player and admin
+// pages. Every field is optional; an unset field falls back to the built-in
+// Top Banana look, so a fresh deployment renders exactly as before.
+//
+// The [Service] caches the stored branding in memory and [Service.Middleware]
+// puts it on every request context, where the template renderers and the
+// /api/branding endpoint read it with [FromContext].
+package branding
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// DefaultName is the product name shown when no custom name is set.
+const DefaultName = "Top Banana!"
+
+// MaxNameLength caps the custom name in runes. The name renders in the top
+// bar and the footer wordmark, where a long string wraps the layout.
+const MaxNameLength = 40
+
+// maxLogoURLLength bounds the stored logo path.
+const maxLogoURLLength = 512
+
+var (
+	// ErrNameTooLong is returned by [Validate] for a name over [MaxNameLength].
+	ErrNameTooLong = errors.New("brand name is too long")
+
+	// ErrInvalidLogoURL is returned by [Validate] for a logo URL that is not a
+	// path on this site. The Content-Security-Policy only allows same-origin
+	// images, so an off-site logo would never load.
+	ErrInvalidLogoURL = errors.New("logo URL must be a path on this site, such as /media/...")
+
+	// ErrInvalidPrimaryColor is returned by [Validate] for a primary color
+	// that is not a #RRGGBB hex value.
+	ErrInvalidPrimaryColor = errors.New("primary color must be a hex color like #ffd23f")
+)
+
+// primaryColorPattern accepts exactly #RRGGBB. The value is written into a
+// style element, so the narrow shape is also what keeps it inert there.
+var primaryColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Branding is the per-deployment branding. The zero value means "all
+// defaults"; read [Branding.DisplayName] rather than Name when rendering.
+type Branding struct {
+	Name         string
+	LogoURL      string
+	PrimaryColor string
+}
+
+// DisplayName returns the custom name, or [DefaultName] when none is set.
+func (b Branding) DisplayName() string {
+	if b.Name == "" {
+		return DefaultName
+	}
+
+	return b.Name
+}
+
+// IsDefaultName reports whether no custom name is set, so the templates can
+// keep the styled Top Banana wordmark instead of plain text.
+func (b Branding) IsDefaultName() bool {
+	return b.Name == ""
+}
+
+// Normalize trims surrounding whitespace from every field and lowercases the
+// primary color, so equal inputs store equal values.
+func Normalize(b Branding) Branding {
+	return Branding{
+		Name:         strings.TrimSpace(b.Name),
+		LogoURL:      strings.TrimSpace(b.LogoURL),
+		PrimaryColor: strings.ToLower(strings.TrimSpace(b.PrimaryColor)),
+	}
+}
+
+// Validate checks a normalized [Branding]. Empty fields are always valid: they
+// select the default.
+func Validate(b Branding) error {
+	if utf8.RuneCountInString(b.Name) > MaxNameLength {
+		return ErrNameTooLong
+	}
+	if b.LogoURL != "" && !isSitePath(b.LogoURL) {
+		return ErrInvalidLogoURL
+	}
+	if b.PrimaryColor != "" && !primaryColorPattern.MatchString(b.PrimaryColor) {
+		return ErrInvalidPrimaryColor
+	}
+
+	return nil
+}
+
+// isSitePath reports whether s is an absolute path on this origin: it starts
+// with a single "/" (not the protocol-relative "//") and carries no scheme or
+// host.
+func isSitePath(s string) bool {
+	if len(s) > maxLogoURLLength || !strings.HasPrefix(s, "/") || strings.HasPrefix(s, "//") {
+		return false
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "" && u.Host == ""
+}
+
+// Store persists the branding. GetBranding returns the zero [Branding] when
+// nothing has been saved.
+type Store interface {
+	GetBranding(ctx context.Context) (Branding, error)
+	SaveBranding(ctx context.Context, b Branding) error
+}
+
+// Service reads and saves the branding through a [Store], caching the value
+// in memory: the branding is read on every page render and changes only when
+// an Admin saves the settings form, which refreshes the cache.
+type Service struct {
+	store  Store
+	logger *slog.Logger
+
+	mu     sync.RWMutex
+	cached *Branding
+}
+
+// NewService returns a Service backed by store.
+func NewService(store Store, logger *slog.Logger) *Service {
+	return &Service{store: store, logger: logger}
+}
+
+// Get returns the current branding. A store error is logged and the defaults
+// are returned uncached, so a transient failure renders the stock look rather
+// than breaking every page, and the next request retries the read.
+func (s *Service) Get(ctx context.Context) Branding {
+	s.mu.RLock()
+	cached := s.cached
+	s.mu.RUnlock()
+	if cached != nil {
+		return *cached
+	}
+
+	b, err := s.store.GetBranding(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "error loading branding", slog.Any("err", err))
+
+		return Branding{}
+	}
+	s.mu.Lock()
+	s.cached = &b
+	s.mu.Unlock()
+
+	return b
+}
+
+// Save normalizes and validates b, stores it and refreshes the cache. It
+// returns the stored value. A validation failure is one of the Err* values
+// above and leaves the stored branding unchanged.
+func (s *Service) Save(ctx context.Context, b Branding) (Branding, error) {
+	b = Normalize(b)
+	if err := Validate(b); err != nil {
+		return Branding{}, err
+	}
+	if err := s.store.SaveBranding(ctx, b); err != nil {
+		// Drop the cache: a partial write leaves the stored value unknown.
+		s.mu.Lock()
+		s.cached = nil
+		s.mu.Unlock()
+
+		return Branding{}, err
+	}
+	s.mu.Lock()
+	s.cached = &b
+	s.mu.Unlock()
+
+	return b, nil
+}
+
+// Middleware puts the current branding on every request context so the
+// template renderers and API handlers read it with [FromContext] without
+// taking the Service as a dependency.
+func (s *Service) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(WithContext(r.Context(), s.Get(r.Context()))))
+	})
+}
+
+// ctxKey is the unexported context-key type for the request branding.
+type ctxKey struct{}
+
+// WithContext returns a copy of ctx carrying b.
+func WithContext(ctx context.Context, b Branding) context.Context {
+	return context.WithValue(ctx, ctxKey{}, b)
+}
+
+// FromContext returns the branding on ctx, or the zero (all defaults)
+// [Branding] for a context that never passed through [Service.Middleware].
+func FromContext(ctx context.Context) Branding {
+	b, _ := ctx.Value(ctxKey{}).(Branding)
+
+	return b
+}
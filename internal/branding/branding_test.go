@@ -0,0 +1,173 @@
+package branding_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/branding"
+)
+
+var errStub = errors.New("stub error")
+
+// stubStore records reads and saves; err fails both.
+type stubStore struct {
+	stored Branding
+	reads  int
+	err    error
+}
+
+func (s *stubStore) GetBranding(context.Context) (Branding, error) {
+	s.reads++
+
+	return s.stored, s.err
+}
+
+func (s *stubStore) SaveBranding(_ context.Context, b Branding) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.stored = b
+
+	return nil
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		b    Branding
+		want error
+	}{
+		{"all defaults", Branding{}, nil},
+		{"all set", Branding{Name: "Quiz Club", LogoURL: "/media/logo.png", PrimaryColor: "#336699"}, nil},
+		{"name at the cap", Branding{Name: strings.Repeat("é", MaxNameLength)}, nil},
+		{"name over the cap", Branding{Name: strings.Repeat("a", MaxNameLength+1)}, ErrNameTooLong},
+		{"absolute logo URL", Branding{LogoURL: "https://cdn.example.com/logo.png"}, ErrInvalidLogoURL},
+		{"protocol-relative logo URL", Branding{LogoURL: "//cdn.example.com/logo.png"}, ErrInvalidLogoURL},
+		{"relative logo path", Branding{LogoURL: "logo.png"}, ErrInvalidLogoURL},
+		{"script logo URL", Branding{LogoURL: "javascript:alert(1)"}, ErrInvalidLogoURL},
+		{"short hex color", Branding{PrimaryColor: "#369"}, ErrInvalidPrimaryColor},
+		{"named color", Branding{PrimaryColor: "red"}, ErrInvalidPrimaryColor},
+		{"color with trailing CSS", Branding{PrimaryColor: "#336699;}"}, ErrInvalidPrimaryColor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Validate(tt.b); !errors.Is(got, tt.want) {
+				t.Errorf("Validate(%+v) = %v, want %v", tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBranding_DisplayName(t *testing.T) {
+	t.Parallel()
+
+	if got, want := (Branding{}).DisplayName(), DefaultName; got != want {
+		t.Errorf("zero DisplayName = %q, want %q", got, want)
+	}
+	if got, want := (Branding{Name: "Quiz Club"}).DisplayName(), "Quiz Club"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("caches the stored branding", func(t *testing.T) {
+		t.Parallel()
+
+		store := &stubStore{stored: Branding{Name: "Quiz Club"}}
+		svc := NewService(store, slog.New(slog.DiscardHandler))
+		for range 3 {
+			if got, want := svc.Get(t.Context()).Name, "Quiz Club"; got != want {
+				t.Errorf("Get().Name = %q, want %q", got, want)
+			}
+		}
+		if got, want := store.reads, 1; got != want {
+			t.Errorf("store reads = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("store error serves defaults and retries", func(t *testing.T) {
+		t.Parallel()
+
+		store := &stubStore{err: errStub}
+		svc := NewService(store, slog.New(slog.DiscardHandler))
+		if got := svc.Get(t.Context()); got != (Branding{}) {
+			t.Errorf("Get() = %+v, want the zero Branding", got)
+		}
+		svc.Get(t.Context())
+		if got, want := store.reads, 2; got != want {
+			t.Errorf("store reads = %d, want %d (errors are not cached)", got, want)
+		}
+	})
+}
+
+func TestService_Save(t *testing.T) {
+	t.Parallel()
+
+	t.Run("normalizes, stores and refreshes the cache", func(t *testing.T) {
+		t.Parallel()
+
+		store := &stubStore{}
+		svc := NewService(store, slog.New(slog.DiscardHandler))
+		svc.Get(t.Context())
+
+		got, err := svc.Save(t.Context(), Branding{Name: "  Quiz Club ", PrimaryColor: " #AABBCC"})
+		if err != nil {
+			t.Fatalf("Save err = %v, want nil", err)
+		}
+		want := Branding{Name: "Quiz Club", PrimaryColor: "#aabbcc"}
+		if got != want {
+			t.Errorf("Save = %+v, want %+v", got, want)
+		}
+		if store.stored != want {
+			t.Errorf("stored = %+v, want %+v", store.stored, want)
+		}
+		if got := svc.Get(t.Context()); got != want {
+			t.Errorf("Get after Save = %+v, want %+v", got, want)
+		}
+		if got, want := store.reads, 1; got != want {
+			t.Errorf("store reads = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("invalid branding is not stored", func(t *testing.T) {
+		t.Parallel()
+
+		store := &stubStore{}
+		svc := NewService(store, slog.New(slog.DiscardHandler))
+		if _, err := svc.Save(t.Context(), Branding{PrimaryColor: "red"}); !errors.Is(err, ErrInvalidPrimaryColor) {
+			t.Errorf("Save err = %v, want %v", err, ErrInvalidPrimaryColor)
+		}
+		if store.stored != (Branding{}) {
+			t.Errorf("stored = %+v, want nothing stored", store.stored)
+		}
+	})
+}
+
+func TestService_Middleware(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(&stubStore{stored: Branding{Name: "Quiz Club"}}, slog.New(slog.DiscardHandler))
+	var got Branding
+	h := svc.Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+	}))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if want := (Branding{Name: "Quiz Club"}); got != want {
+		t.Errorf("FromContext = %+v, want %+v", got, want)
+	}
+
+	if got := FromContext(t.Context()); got != (Branding{}) {
+		t.Errorf("FromContext on a bare context = %+v, want the zero Branding", got)
+	}
+}
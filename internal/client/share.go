@@ -10,6 +10,7 @@ import (
 	"os"
 
 	"github.com/starquake/topbanana/internal/absurl"
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/envtag"
 	"github.com/starquake/topbanana/internal/handlers"
@@ -133,11 +134,13 @@ func (s *ShellHandlers) render(w http.ResponseWriter, r *http.Request, name stri
 	loc := locale.Resolve(r)
 	data.Locale = loc
 	data.MessagesJSON = locale.MessagesJSON(loc)
+	brand := branding.FromContext(r.Context())
 	funcs := template.FuncMap{
 		"ogImage":     func() string { return absurl.BaseURL(r) + "/static/og-image.png" },
 		"envTitleTag": envtag.Get,
 		"t":           func(key string) string { return locale.Translate(loc, locale.MessageID(key)) },
 		"lang":        func() string { return loc },
+		"brand":       func() branding.Branding { return brand },
 	}
 	// partials/ holds the {{define}} blocks shared between index.html (solo) and
 	// join.html (live): round_intro.html ("round-intro-card"), standings_bars.html
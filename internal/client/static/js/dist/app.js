@@ -1,5 +1,5 @@
-var z=class extends Error{constructor(e,t,i){super(e),this.name="ApiError",this.status=t,this.body=i}};async function y(r){if(r.ok)return await r.json();let e="";try{e=await r.text()}catch{}let t=e.slice(0,200);throw new z(`HTTP ${r.status}: ${t}`,r.status,e)}var L=class{async getQuizzes(){let e=await fetch("/api/quizzes");return y(e)}async getQuizMeta(e){let t=await fetch(`/api/quizzes/${e}`);return t.status===404?null:y(t)}},R=new L;var q=class{async startGame(e,t=!1){let i={quizId:parseInt(e)};t&&(i.preview=!0);let n=await fetch("/api/games",{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify(i)});return y(n)}async getNextQuestion(e){let t=await fetch(`/api/games/${e}/questions/next`);return t.status===404?null:y(t)}async getMyGameForQuiz(e){let t=await fetch(`/api/quizzes/${e}/my-game`);return t.status===404?null:y(t)}async submitAnswer(e,t,i,n){let a=await fetch(`/api/games/${e}/questions/${t}/answers`,{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify({optionId:i,tappedAt:n})});return y(a)}async getResults(e){let t=await fetch(`/api/games/${e}/results`);return y(t)}async getAudioManifest(e){let t=await fetch(`/api/games/${e}/audio`);return y(t)}async markRoundSeen(e,t,i){let n=await fetch(`/api/games/${e}/rounds/${t}/seen/${i}`,{method:"POST"});if(n.ok)return;let a="";try{a=await n.text()}catch{}throw new z(`HTTP ${n.status}: ${a.slice(0,200)}`,n.status,a)}async getQuizLeaderboard(e){let t=await fetch(`/api/quizzes/${e}/leaderboard`);return y(t)}},f=new q;var Ae=/\{(\w+)\}/g;function Te(){return typeof window>"u"||!window.__I18N__?{}:window.__I18N__.messages||{}}function u(r,e){let t=Te(),i=Object.prototype.hasOwnProperty.call(t,r)?t[r]:r;return e&&(i=i.replace(Ae,(n,a)=>Object.prototype.hasOwnProperty.call(e,a)?String(e[a]):n)),i}function H(r){r.magic("t",()=>u)}async function Ee(r){try{return await r.clone().json()}catch{return{}}}var M=class{async getMe(){try{let e=await fetch("/api/players/me");return e.ok?await e.json():null}catch{return null}}async claimName(e){let t=(e||"").trim();if(t==="")return{ok:!1,status:400,kind:"empty",message:u("claim.enterName")};let i;try{i=await fetch("/api/players/me",{method:"PATCH",headers:{"Content-Type":"application/json"},body:JSON.stringify({displayName:t})})}catch{return{ok:!1,status:0,kind:"error",message:u("claim.saveError")}}if(i.status===200)return{ok:!0,player:await i.json()};if(i.status===409){let{code:n,message:a}=await Ee(i);return n==="already_claimed"?{ok:!1,status:409,kind:"already_claimed",message:a||u("claim.alreadyNamed")}:{ok:!1,status:409,kind:"taken",message:u("claim.nameTaken")}}return i.status===400?{ok:!1,status:400,kind:"empty",message:u("claim.enterName")}:{ok:!1,status:i.status,kind:"error",message:u("claim.saveError")}}},S=new M;function Ce(){return typeof window<"u"&&typeof window.matchMedia=="function"&&window.matchMedia("(prefers-reduced-motion: reduce)").matches}function O(r,e){if(Ce()||typeof window>"u"||!window.anime){typeof e.onComplete=="function"&&e.onComplete();return}let t=window.anime;typeof t.animate=="function"?t.animate(r,e):typeof t=="function"?t({targets:r,...e}):typeof e.onComplete=="function"&&e.onComplete()}function N(r,{rise:e=12,duration:t=380,ease:i="outQuad"}={}){r.style.opacity="0",r.style.transform=`translateY(${e}px)`,O(r,{opacity:[0,1],translateY:[e,0],duration:t,ease:i,onComplete:()=>{r.style.opacity="",r.style.transform=""}})}function V(r){if(!r)return null;let e=new Date(r).getTime();return Number.isFinite(e)?e-Date.now():null}function W(r){return Date.now()+r}var K=["btn-answer-tone-a","btn-answer-tone-b","btn-answer-tone-c","btn-answer-tone-d"];function Y(r,e,{revealed:t=!1,correctIds:i=[],pickedId:n=null,highlightPick:a=!1}={}){if(t)return i.includes(r.id)?"btn-answer-correct":n===r.id?"btn-answer-wrong":"btn-answer-dim";let o=K[e%K.length];return a&&n===r.id?`btn-answer ${o} bg-surface-2 ring-2 ring-accent`:`btn-answer ${o}`}function X(r){typeof document>"u"||(document.readyState==="loading"?document.addEventListener("DOMContentLoaded",r,{once:!0}):r())}var Pe="M17.472 14.382c-.297-.149-1.758-.867-2.03-.967-.273-.099-.471-.148-.67.15-.197.297-.767.966-.94 1.164-.173.199-.347.223-.644.075-.297-.15-1.255-.463-2.39-1.475-.883-.788-1.48-1.761-1.653-2.059-.173-.297-.018-.458.13-.606.134-.133.298-.347.446-.52.149-.174.198-.298.298-.497.099-.198.05-.371-.025-.52-.075-.149-.669-1.612-.916-2.207-.242-.579-.487-.5-.669-.51-.173-.008-.371-.01-.57-.01-.198 0-.52.074-.792.372-.272.297-1.04 1.016-1.04 2.479 0 1.462 1.065 2.875 1.213 3.074.149.198 2.096 3.2 5.077 4.487.709.306 1.262.489 1.694.625.712.227 1.36.195 1.871.118.571-.085 1.758-.719 2.006-1.413.248-.694.248-1.289.173-1.413-.074-.124-.272-.198-.57-.347m-5.421 7.403h-.004a9.87 9.87 0 01-5.031-1.378l-.361-.214-3.741.982.998-3.648-.235-.374a9.86 9.86 0 01-1.51-5.26c.001-5.45 4.436-9.884 9.888-9.884 2.64 0 5.122 1.03 6.988 2.898a9.825 9.825 0 012.893 6.994c-.003 5.45-4.437 9.884-9.885 9.884m8.413-18.297A11.815 11.815 0 0012.05 0C5.495 0 .16 5.335.157 11.892c0 2.096.547 4.142 1.588 5.945L.057 24l6.305-1.654a11.882 11.882 0 005.683 1.448h.005c6.554 0 11.89-5.335 11.893-11.893a11.821 11.821 0 00-3.48-8.413Z",Le="M11.944 0A12 12 0 0 0 0 12a12 12 0 0 0 12 12 12 12 0 0 0 12-12A12 12 0 0 0 12 0a12 12 0 0 0-.056 0zm4.962 7.224c.1-.002.321.023.465.14a.506.506 0 0 1 .171.325c.016.093.036.306.02.472-.18 1.898-.962 6.502-1.36 8.627-.168.9-.499 1.201-.82 1.23-.696.065-1.225-.46-1.9-.902-1.056-.693-1.653-1.124-2.678-1.8-1.185-.78-.417-1.21.258-1.91.177-.184 3.247-2.977 3.307-3.23.007-.032.014-.15-.056-.212s-.174-.041-.249-.024c-.106.024-1.793 1.14-5.061 3.345-.48.33-.913.49-1.302.48-.428-.008-1.252-.241-1.865-.44-.752-.245-1.349-.374-1.297-.789.027-.216.325-.437.893-.663 3.498-1.524 5.83-2.529 6.998-3.014 3.332-1.386 4.025-1.627 4.476-1.635z",Re="M12 0A12 12 0 0 0 0 12a12 12 0 0 0 12 12 12 12 0 0 0 12-12A12 12 0 0 0 12 0zm5.01 4.744c.688 0 1.25.561 1.25 1.249a1.25 1.25 0 0 1-2.498.056l-2.597-.547-.8 3.747c1.824.07 3.48.632 4.674 1.488.308-.309.73-.491 1.207-.491.968 0 1.754.786 1.754 1.754 0 .716-.435 1.333-1.01 1.614a3.111 3.111 0 0 1 .042.52c0 2.694-3.13 4.87-7.004 4.87-3.874 0-7.004-2.176-7.004-4.87 0-.183.015-.366.043-.534A1.748 1.748 0 0 1 4.028 12c0-.968.786-1.754 1.754-1.754.463 0 .898.196 1.207.49 1.207-.883 2.878-1.43 4.744-1.487l.885-4.182a.342.342 0 0 1 .14-.197.35.35 0 0 1 .238-.042l2.906.617a1.214 1.214 0 0 1 1.108-.701zM9.25 12C8.561 12 8 12.562 8 13.25c0 .687.561 1.248 1.25 1.248.687 0 1.248-.561 1.248-1.249 0-.688-.561-1.249-1.249-1.249zm5.5 0c-.687 0-1.248.561-1.248 1.25 0 .687.561 1.248 1.249 1.248.688 0 1.249-.561 1.249-1.249 0-.687-.562-1.249-1.25-1.249zm-5.466 3.99a.327.327 0 0 0-.231.094.33.33 0 0 0 0 .463c.842.842 2.484.913 2.961.913.477 0 2.105-.056 2.961-.913a.361.361 0 0 0 .029-.463.33.33 0 0 0-.464 0c-.547.533-1.684.73-2.512.73-.828 0-1.979-.196-2.512-.73a.326.326 0 0 0-.232-.095z",qe="M18.901 1.153h3.68l-8.04 9.19L24 22.846h-7.406l-5.8-7.584-6.638 7.584H.474l8.6-9.83L0 1.154h7.594l5.243 6.932ZM17.61 20.644h2.039L6.486 3.24H4.298Z",J=[{key:"whatsapp",label:"WhatsApp",bg:"#25D366",icon:Pe,href:({text:r,url:e})=>`https://wa.me/?text=${encodeURIComponent(Z(r,e))}`},{key:"telegram",label:"Telegram",bg:"#229ED9",icon:Le,href:({text:r,url:e})=>`https://t.me/share/url?url=${encodeURIComponent(e)}&text=${encodeURIComponent(r)}`},{key:"reddit",label:"Reddit",bg:"#FF4500",icon:Re,href:({text:r,url:e})=>`https://reddit.com/submit?url=${encodeURIComponent(e)}&title=${encodeURIComponent(r)}`},{key:"x",label:"X",bg:"#000000",icon:qe,href:({text:r,url:e})=>`https://twitter.com/intent/tweet?text=${encodeURIComponent(r)}&url=${encodeURIComponent(e)}`}];function Z(r,e){return r?`${r}
-${e}`:e}function A({title:r,text:e,url:t}){let i=Oe({title:r,text:e,url:t});document.body.appendChild(i),i.addEventListener("close",()=>i.remove(),{once:!0}),i.showModal()}function Me(){return typeof navigator<"u"&&typeof navigator.share=="function"}function Oe({title:r,text:e,url:t}){let i=document.createElement("dialog");return i.className="share-dialog fixed top-1/2 left-1/2 -translate-x-1/2 -translate-y-1/2 max-w-[600px] w-[calc(100%-2rem)] bg-surface text-text border border-accent-line rounded-lg shadow-2xl p-0 backdrop:bg-bg/80 backdrop:backdrop-blur-sm",i.innerHTML=`
+var A=class extends Error{constructor(e,t,r){super(e),this.name="ApiError",this.status=t,this.body=r}};async function p(i){if(i.ok)return await i.json();let e="";try{e=await i.text()}catch{}let t=e.slice(0,200);throw new A(`HTTP ${i.status}: ${t}`,i.status,e)}var q=class{async getQuizzes(){let e=await fetch("/api/quizzes");return p(e)}async getQuizMeta(e){let t=await fetch(`/api/quizzes/${e}`);return t.status===404?null:p(t)}},L=new q;var R=class{async startGame(e,t=!1){let r={quizId:parseInt(e)};t&&(r.preview=!0);let s=await fetch("/api/games",{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify(r)});return p(s)}async getNextQuestion(e){let t=await fetch(`/api/games/${e}/questions/next`);for(let r=0;t.status===429&&r<3;r++){let s=parseInt(t.headers.get("Retry-After"),10)||1;await new Promise(o=>setTimeout(o,s*1e3)),t=await fetch(`/api/games/${e}/questions/next`)}return t.status===404?null:p(t)}async getMyGameForQuiz(e){let t=await fetch(`/api/quizzes/${e}/my-game`);return t.status===404?null:p(t)}async submitAnswer(e,t,r,s,o,n){let l={optionId:r,tappedAt:s};n!==void 0?l={optionIds:n,tappedAt:s}:o!==void 0&&(l={numericValue:o,tappedAt:s});let f=await fetch(`/api/games/${e}/questions/${t}/answers?prefetch=true`,{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify(l)});return p(f)}async placeWager(e,t,r){let s=await fetch(`/api/games/${e}/questions/${t}/wager`,{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify({wager:r})});return p(s)}async pauseOnce(e,t){let r=await fetch(`/api/games/${e}/questions/${t}/pause-once`,{method:"POST"});return p(r)}async useHint(e,t){let r=await fetch(`/api/games/${e}/questions/${t}/hint`,{method:"POST"});return p(r)}async getResults(e){let t=await fetch(`/api/games/${e}/results`);return p(t)}async getAudioManifest(e){let t=await fetch(`/api/games/${e}/audio`);return p(t)}async markRoundSeen(e,t,r){let s=await fetch(`/api/games/${e}/rounds/${t}/seen/${r}`,{method:"POST"});if(s.ok)return;let o="";try{o=await s.text()}catch{}throw new A(`HTTP ${s.status}: ${o.slice(0,200)}`,s.status,o)}async getQuizLeaderboard(e){let t=await fetch(`/api/quizzes/${e}/leaderboard`);return p(t)}},h=new R;var ze=/\{(\w+)\}/g;function Te(){return typeof window>"u"||!window.__I18N__?{}:window.__I18N__.messages||{}}function c(i,e){let t=Te(),r=Object.prototype.hasOwnProperty.call(t,i)?t[i]:i;return e&&(r=r.replace(ze,(s,o)=>Object.prototype.hasOwnProperty.call(e,o)?String(e[o]):s)),r}function B(i){i.magic("t",()=>c)}async function Pe(i){try{return await i.clone().json()}catch{return{}}}var M=class{async getMe(){try{let e=await fetch("/api/players/me");return e.ok?await e.json():null}catch{return null}}async claimName(e){let t=(e||"").trim();if(t==="")return{ok:!1,status:400,kind:"empty",message:c("claim.enterName")};let r;try{r=await fetch("/api/players/me",{method:"PATCH",headers:{"Content-Type":"application/json"},body:JSON.stringify({displayName:t})})}catch{return{ok:!1,status:0,kind:"error",message:c("claim.saveError")}}if(r.status===200)return{ok:!0,player:await r.json()};if(r.status===409){let{code:s,message:o}=await Pe(r);return s==="already_claimed"?{ok:!1,status:409,kind:"already_claimed",message:o||c("claim.alreadyNamed")}:{ok:!1,status:409,kind:"taken",message:c("claim.nameTaken")}}return r.status===400?{ok:!1,status:400,kind:"empty",message:c("claim.enterName")}:{ok:!1,status:r.status,kind:"error",message:c("claim.saveError")}}},S=new M;function Ee(){return typeof window<"u"&&typeof window.matchMedia=="function"&&window.matchMedia("(prefers-reduced-motion: reduce)").matches}function O(i,e){if(Ee()||typeof window>"u"||!window.anime){typeof e.onComplete=="function"&&e.onComplete();return}let t=window.anime;typeof t.animate=="function"?t.animate(i,e):typeof t=="function"?t({targets:i,...e}):typeof e.onComplete=="function"&&e.onComplete()}function N(i,{rise:e=12,duration:t=380,ease:r="outQuad"}={}){i.style.opacity="0",i.style.transform=`translateY(${e}px)`,O(i,{opacity:[0,1],translateY:[e,0],duration:t,ease:r,onComplete:()=>{i.style.opacity="",i.style.transform=""}})}function W(i){if(!i)return null;let e=new Date(i).getTime();return Number.isFinite(e)?e-Date.now():null}function V(i){return Date.now()+i}var K=["btn-answer-tone-a","btn-answer-tone-b","btn-answer-tone-c","btn-answer-tone-d"];function Y(i,e,{revealed:t=!1,correctIds:r=[],pickedId:s=null,highlightPick:o=!1}={}){if(t)return r.includes(i.id)?"btn-answer-correct":s===i.id?"btn-answer-wrong":"btn-answer-dim";let n=K[e%K.length];return o&&s===i.id?`btn-answer ${n} bg-surface-2 ring-2 ring-accent`:`btn-answer ${n}`}function X(i){typeof document>"u"||(document.readyState==="loading"?document.addEventListener("DOMContentLoaded",i,{once:!0}):i())}var Ce="M17.472 14.382c-.297-.149-1.758-.867-2.03-.967-.273-.099-.471-.148-.67.15-.197.297-.767.966-.94 1.164-.173.199-.347.223-.644.075-.297-.15-1.255-.463-2.39-1.475-.883-.788-1.48-1.761-1.653-2.059-.173-.297-.018-.458.13-.606.134-.133.298-.347.446-.52.149-.174.198-.298.298-.497.099-.198.05-.371-.025-.52-.075-.149-.669-1.612-.916-2.207-.242-.579-.487-.5-.669-.51-.173-.008-.371-.01-.57-.01-.198 0-.52.074-.792.372-.272.297-1.04 1.016-1.04 2.479 0 1.462 1.065 2.875 1.213 3.074.149.198 2.096 3.2 5.077 4.487.709.306 1.262.489 1.694.625.712.227 1.36.195 1.871.118.571-.085 1.758-.719 2.006-1.413.248-.694.248-1.289.173-1.413-.074-.124-.272-.198-.57-.347m-5.421 7.403h-.004a9.87 9.87 0 01-5.031-1.378l-.361-.214-3.741.982.998-3.648-.235-.374a9.86 9.86 0 01-1.51-5.26c.001-5.45 4.436-9.884 9.888-9.884 2.64 0 5.122 1.03 6.988 2.898a9.825 9.825 0 012.893 6.994c-.003 5.45-4.437 9.884-9.885 9.884m8.413-18.297A11.815 11.815 0 0012.05 0C5.495 0 .16 5.335.157 11.892c0 2.096.547 4.142 1.588 5.945L.057 24l6.305-1.654a11.882 11.882 0 005.683 1.448h.005c6.554 0 11.89-5.335 11.893-11.893a11.821 11.821 0 00-3.48-8.413Z",qe="M11.944 0A12 12 0 0 0 0 12a12 12 0 0 0 12 12 12 12 0 0 0 12-12A12 12 0 0 0 12 0a12 12 0 0 0-.056 0zm4.962 7.224c.1-.002.321.023.465.14a.506.506 0 0 1 .171.325c.016.093.036.306.02.472-.18 1.898-.962 6.502-1.36 8.627-.168.9-.499 1.201-.82 1.23-.696.065-1.225-.46-1.9-.902-1.056-.693-1.653-1.124-2.678-1.8-1.185-.78-.417-1.21.258-1.91.177-.184 3.247-2.977 3.307-3.23.007-.032.014-.15-.056-.212s-.174-.041-.249-.024c-.106.024-1.793 1.14-5.061 3.345-.48.33-.913.49-1.302.48-.428-.008-1.252-.241-1.865-.44-.752-.245-1.349-.374-1.297-.789.027-.216.325-.437.893-.663 3.498-1.524 5.83-2.529 6.998-3.014 3.332-1.386 4.025-1.627 4.476-1.635z",Le="M12 0A12 12 0 0 0 0 12a12 12 0 0 0 12 12 12 12 0 0 0 12-12A12 12 0 0 0 12 0zm5.01 4.744c.688 0 1.25.561 1.25 1.249a1.25 1.25 0 0 1-2.498.056l-2.597-.547-.8 3.747c1.824.07 3.48.632 4.674 1.488.308-.309.73-.491 1.207-.491.968 0 1.754.786 1.754 1.754 0 .716-.435 1.333-1.01 1.614a3.111 3.111 0 0 1 .042.52c0 2.694-3.13 4.87-7.004 4.87-3.874 0-7.004-2.176-7.004-4.87 0-.183.015-.366.043-.534A1.748 1.748 0 0 1 4.028 12c0-.968.786-1.754 1.754-1.754.463 0 .898.196 1.207.49 1.207-.883 2.878-1.43 4.744-1.487l.885-4.182a.342.342 0 0 1 .14-.197.35.35 0 0 1 .238-.042l2.906.617a1.214 1.214 0 0 1 1.108-.701zM9.25 12C8.561 12 8 12.562 8 13.25c0 .687.561 1.248 1.25 1.248.687 0 1.248-.561 1.248-1.249 0-.688-.561-1.249-1.249-1.249zm5.5 0c-.687 0-1.248.561-1.248 1.25 0 .687.561 1.248 1.249 1.248.688 0 1.249-.561 1.249-1.249 0-.687-.562-1.249-1.25-1.249zm-5.466 3.99a.327.327 0 0 0-.231.094.33.33 0 0 0 0 .463c.842.842 2.484.913 2.961.913.477 0 2.105-.056 2.961-.913a.361.361 0 0 0 .029-.463.33.33 0 0 0-.464 0c-.547.533-1.684.73-2.512.73-.828 0-1.979-.196-2.512-.73a.326.326 0 0 0-.232-.095z",Re="M18.901 1.153h3.68l-8.04 9.19L24 22.846h-7.406l-5.8-7.584-6.638 7.584H.474l8.6-9.83L0 1.154h7.594l5.243 6.932ZM17.61 20.644h2.039L6.486 3.24H4.298Z",J=[{key:"whatsapp",label:"WhatsApp",bg:"#25D366",icon:Ce,href:({text:i,url:e})=>`https://wa.me/?text=${encodeURIComponent(Z(i,e))}`},{key:"telegram",label:"Telegram",bg:"#229ED9",icon:qe,href:({text:i,url:e})=>`https://t.me/share/url?url=${encodeURIComponent(e)}&text=${encodeURIComponent(i)}`},{key:"reddit",label:"Reddit",bg:"#FF4500",icon:Le,href:({text:i,url:e})=>`https://reddit.com/submit?url=${encodeURIComponent(e)}&title=${encodeURIComponent(i)}`},{key:"x",label:"X",bg:"#000000",icon:Re,href:({text:i,url:e})=>`https://twitter.com/intent/tweet?text=${encodeURIComponent(i)}&url=${encodeURIComponent(e)}`}];function Z(i,e){return i?`${i}
+${e}`:e}function z({title:i,text:e,url:t}){let r=Oe({title:i,text:e,url:t});document.body.appendChild(r),r.addEventListener("close",()=>r.remove(),{once:!0}),r.showModal()}function Me(){return typeof navigator<"u"&&typeof navigator.share=="function"}function Oe({title:i,text:e,url:t}){let r=document.createElement("dialog");return r.className="share-dialog fixed top-1/2 left-1/2 -translate-x-1/2 -translate-y-1/2 max-w-[600px] w-[calc(100%-2rem)] bg-surface text-text border border-accent-line rounded-lg shadow-2xl p-0 backdrop:bg-bg/80 backdrop:backdrop-blur-sm",r.innerHTML=`
         <header class="flex items-center justify-between px-6 py-5 border-b border-border-soft">
             <p class="font-display text-sm font-semibold uppercase tracking-[0.14em]">Share</p>
             <button type="button"
@@ -26,16 +26,16 @@ ${e}`:e}function A({title:r,text:e,url:t}){let i=Oe({title:r,text:e,url:t});docu
             <button type="button" data-share-close
                     class="inline-flex items-center justify-center min-h-[36px] px-3 py-2 border border-border rounded-sm bg-transparent text-text-dim text-xs uppercase font-semibold tracking-[0.14em] transition-colors hover:border-accent hover:text-text cursor-pointer">Close</button>
         </footer>
-    `,De(i,{title:r,text:e,url:t}),i}function Ne(){return J.map(r=>`
-        <a data-share-network="${r.key}"
+    `,$e(r,{title:i,text:e,url:t}),r}function Ne(){return J.map(i=>`
+        <a data-share-network="${i.key}"
            target="_blank" rel="noopener noreferrer"
-           aria-label="Share on ${r.label}"
+           aria-label="Share on ${i.label}"
            class="group flex flex-col items-center gap-2 no-underline focus-visible:outline-none">
             <span class="w-12 h-12 inline-flex items-center justify-center rounded-full text-white transition-transform group-hover:scale-110 group-focus-visible:scale-110 group-focus-visible:shadow-focus"
-                  style="background-color: ${r.bg};">
-                <svg viewBox="0 0 24 24" class="w-6 h-6" fill="currentColor" aria-hidden="true"><path d="${r.icon}"/></svg>
+                  style="background-color: ${i.bg};">
+                <svg viewBox="0 0 24 24" class="w-6 h-6" fill="currentColor" aria-hidden="true"><path d="${i.icon}"/></svg>
             </span>
-            <span class="text-[0.7rem] uppercase tracking-[0.1em] text-text-dim group-hover:text-text">${r.label}</span>
+            <span class="text-[0.7rem] uppercase tracking-[0.1em] text-text-dim group-hover:text-text">${i.label}</span>
         </a>
     `).join("")}function Qe(){return Me()?`
         <button type="button" data-share-native aria-label="More share options"
@@ -45,4 +45,4 @@ ${e}`:e}function A({title:r,text:e,url:t}){let i=Oe({title:r,text:e,url:t});docu
             </span>
             <span class="text-[0.7rem] uppercase tracking-[0.1em] text-text-dim group-hover:text-text">More</span>
         </button>
-    `:""}function De(r,{title:e,text:t,url:i}){r.querySelector("[data-share-link]").textContent=i,r.querySelectorAll("[data-share-close]").forEach(o=>{o.addEventListener("click",()=>r.close())}),r.addEventListener("click",o=>{o.target===r&&r.close()}),r.querySelectorAll("[data-share-network]").forEach(o=>{let d=J.find(m=>m.key===o.dataset.shareNetwork);d&&(o.href=d.href({text:t,url:i}))});let n=r.querySelector("[data-share-copy]");n&&n.addEventListener("click",async()=>{try{await navigator.clipboard.writeText(Z(t,i)),Q(r,"Link copied to clipboard.")}catch{Q(r,"Could not copy \u2014 select the link above and copy manually.")}});let a=r.querySelector("[data-share-native]");a&&a.addEventListener("click",async()=>{try{await navigator.share({title:e,text:t,url:i}),r.close()}catch(o){o&&o.name!=="AbortError"&&Q(r,"Native share unavailable \u2014 pick a network or copy the link.")}})}function Q(r,e){let t=r.querySelector("[data-share-feedback]");t&&(t.textContent=e,t.classList.remove("hidden"),setTimeout(()=>t.classList.add("hidden"),2500))}function $e(r=document){r.querySelectorAll("[data-share-trigger]:not([data-share-bound])").forEach(e=>{e.dataset.shareBound="true",e.addEventListener("click",()=>{let t=e.dataset.sharePath,i=new URL(t,window.location.origin).href;A({title:e.dataset.shareTitle||"Share",text:e.dataset.shareText||e.dataset.shareTitle||"",url:i})})})}X(()=>$e());function ee(r){return!r||typeof window>"u"||typeof Image!="function"?Promise.resolve():new Promise(e=>{let t=new Image;t.onload=()=>e(),t.onerror=()=>e(),t.src=r})}var te="tb.audioMuted";function re(){try{return window.localStorage.getItem(te)==="1"}catch{return!1}}function ie(r){try{window.localStorage.setItem(te,r?"1":"0")}catch{}}var ne=["mp3","m4a","ogg","wav"];var Fe="/static/audio/silence.wav";function _e(){if(typeof navigator>"u")return!1;let r=navigator.userAgent||"";return/iPad|iPhone|iPod/.test(r)?!0:/Macintosh/.test(r)&&(navigator.maxTouchPoints||0)>1}function se(){let r=_e(),e=null,t=null;function i(){if(!r||e||typeof document>"u")return;e=document.createElement("audio"),e.src=Fe,e.loop=!0,e.setAttribute("playsinline",""),e.setAttribute("aria-hidden","true"),e.style.display="none",document.body.appendChild(e);let a=e.play();a&&typeof a.catch=="function"&&a.catch(()=>{}),t=()=>{if(e)if(document.visibilityState==="hidden")e.pause();else{let o=e.play();o&&typeof o.catch=="function"&&o.catch(()=>{})}},document.addEventListener("visibilitychange",t)}function n(){t&&(document.removeEventListener("visibilitychange",t),t=null),e&&(e.pause(),e.remove(),e=null)}return{start:i,stop:n}}var w={roundStart:"round-start",questionShow:"question-show",answersShow:"answers-show",answerCorrect:"answer-correct",answerWrong:"answer-wrong",answerReveal:"answer-reveal"},Ue={[w.roundStart]:"/static/audio/sfx/round-start.mp3",[w.questionShow]:"/static/audio/sfx/question-show.mp3",[w.answersShow]:"/static/audio/sfx/answers-show.mp3",[w.answerCorrect]:"/static/audio/sfx/answer-correct.mp3",[w.answerWrong]:"/static/audio/sfx/answer-wrong.mp3",[w.answerReveal]:"/static/audio/sfx/answer-reveal.mp3"},Ge=3,je=1e3,Be=.5,He=8e3,Ve=12e3;function ae(){return typeof window<"u"&&window.Howl||null}function D(){return typeof window<"u"&&window.Howler||null}function oe(){let r=D();r&&(r.autoSuspend=!1)}function We(){let r=D(),e=r?r.ctx:null;return!e||e.state==="running"}function le(r){let e={},t=new Map,i=se(),n=null,a=null,o=0,d=null,m=!1,x=!1,g=null;function I(){return!!r.audioMuted}function fe(){let s=ae();if(s){oe();for(let[l,c]of Object.entries(Ue))e[l]||(e[l]=new s({src:[c],preload:!0,html5:!1,mute:I(),volume:Be}))}}function _(){try{oe();let s=D(),l=s?s.ctx:null;if(l&&typeof l.resume=="function"){let c=l.resume();c&&typeof c.catch=="function"&&c.catch(()=>{})}i.start(),m=!0}catch{}}function me(s){if(I())return;let l=e[s];if(l)try{l.play()}catch{}}function pe(s,l){if(I()){l();return}let c=e[s];if(!c){l();return}let h=o;try{c.once("end",()=>{h===o&&l()}),c.once("stop",()=>{h===o&&l()}),c.play()}catch{l()}}function we(s){let l=ae(),c=Array.isArray(s)?s:s&&Array.isArray(s.clips)?s.clips:[];if(!l||c.length===0)return x=!0,b(),Promise.resolve();let h=c.map(p=>new Promise(j=>{if(p==null||p.questionId==null||!p.audioUrl){j();return}let v={howl:null,loaded:!1,failed:!1,repeat:!!p.audioRepeat};t.set(p.questionId,v);let B=!1,P=()=>{B||(B=!0,clearTimeout(Se),j())},ze=new l({src:[p.audioUrl],format:ne,preload:!0,html5:!1,mute:I(),onload:()=>{v.loaded=!0,v.failed=!1,P(),g===p.questionId&&b()},onloaderror:()=>{v.failed=!0,P(),g===p.questionId&&b()}});v.howl=ze;let Se=setTimeout(()=>{!v.loaded&&!v.failed&&(v.failed=!0),P(),g===p.questionId&&b()},He)}));b();let k=null,C=new Promise(p=>{k=setTimeout(p,Ve)});return Promise.race([Promise.all(h),C]).then(p=>(k!==null&&clearTimeout(k),x=!0,b(),p))}function U(s,l,c){let h=s.howl;if(!h)return;let k=()=>{if(l!==o||c<=1)return;let C=c-1;d=setTimeout(()=>{if(d=null,l===o){try{h.stop(),h.play()}catch{}U(s,l,C)}},je)};h.once("end",k)}function G(s,l){E(),o+=1;let c=o;a=s,n=s;let h=l.howl;if(!h){r.audioBlocked=!0;return}try{h.mute(I()),h.off("end"),h.stop(),h.play()}catch{r.audioBlocked=!0;return}r.audioBlocked=!m&&!We(),l.repeat&&U(l,c,Ge)}function ye(s){s==null||s===n||(g=s,b())}function b(){let s=g;if(s==null||s===n)return;let l=t.get(s);if(!l||!l.howl){x&&(r.audioBlocked=!0);return}if(l.failed){r.audioBlocked=!0;return}l.loaded&&G(s,l)}function ge(s){if(s==null)return;_();let l=t.get(s);if(!l||!l.howl){r.audioBlocked=!0;return}if(l.failed){r.audioBlocked=!0;return}if(r.audioBlocked=!1,l.loaded){G(s,l);return}g=s,n=null,b()}function E(){d!==null&&(clearTimeout(d),d=null)}function be(){if(E(),o+=1,g=null,a!=null){let s=t.get(a);if(s&&s.howl)try{s.howl.off("end"),s.howl.stop()}catch{}a=null}}function ve(){g=null}function xe(){let s=!r.audioMuted;r.audioMuted=s,ie(s),Ie(s)}function Ie(s){for(let l of Object.values(e))try{l.mute(s)}catch{}for(let l of t.values())if(l.howl)try{l.howl.mute(s)}catch{}}function ke(){E(),o+=1,g=null,x=!1,i.stop();for(let s of t.values())if(s.howl)try{s.howl.unload()}catch{}t.clear(),a=null,n=null}return{preloadEffects:fe,unlock:_,playEffect:me,playEffectThen:pe,preloadClips:we,playClip:ye,replayClip:ge,stopClip:be,cancelPendingClip:ve,toggleMute:xe,muted:I,teardown:ke,isUnlocked:()=>m}}function ue(){return re()}var $=/^\/play\/.+-(\d+)\/?$/,T=class{constructor(){this.quizzes=[],this.quizzesError=!1,this.quizzesRetrying=!1,this.selectedQuizId=null,this.gameId=null,this.question=null,this.nextItemPromise=null,this.roundItem=null,this.lastQuestionPosition=0,this.roundContinueError=!1,this.continuingRound=!1,this.roundProgress=100,this.roundTimer=null,this.finished=!1,this.leaderboard=null,this.quizSlugId=null,this.feedback=null,this.submitError=!1,this.advanceError=!1,this.advancing=!1,this.progress=100,this.timer=null,this.imageError=!1,this.startError=null,this.deepLinkedQuiz=null,this.deepLinkUnavailable=!1,this.preview=!1,this.startStateResolved=!1,this.player=null,this.claimModalOpen=!1,this.submittingAnswer=!1,this.score=0,this.revealing=!1,this.revealTimer=null,this.clockOffset=0,this.audioMuted=ue(),this.audioBlocked=!1,this.audioLoading=!1,this.audio=null,this.roundStartPlayed=!1,this.firstItemAfterStart=!1,typeof window<"u"&&window.addEventListener("beforeunload",()=>{this.clearRoundTimer(),this.audio&&this.audio.teardown()})}async init(){this.audio=le(this),this.audio.preloadEffects();let[e,t]=await Promise.all([this.loadQuizzes(),S.getMe()]);if(this.player=t,this.isPreviewDeepLink()){await this.startPreviewGame();return}e&&await this.resolveStartState()}async loadQuizzes(){this.quizzesError=!1;try{return this.quizzes=await R.getQuizzes(),!0}catch(e){return console.error("loadQuizzes failed",e),this.quizzes=[],this.quizzesError=!0,!1}}async retryLoadQuizzes(){if(!this.quizzesRetrying){this.quizzesRetrying=!0;try{await this.loadQuizzes()&&await this.resolveStartState()}finally{this.quizzesRetrying=!1}}}async resolveStartState(){let e;try{e=await this.resolveDeepLinkedQuiz()}catch(i){console.warn("deep-link quiz meta fetch failed",i),this.quizzesError=!0,await this.resumeDeepLinkInProgress();return}e?(this.deepLinkedQuiz=e,this.selectedQuizId=e.id):this.hasDeepLinkPath()&&(this.deepLinkUnavailable=!0);let t=await this.checkAlreadyPlayed();await this.resumeInProgressGame(t)}async resumeInProgressGame(e){if(!(!e||e.completed!==!1)){this.gameId=e.gameId,await this.hydrateScoreFromResults(),this.preloadGameAudio({showLoading:!1});try{await this.nextQuestion()}catch(t){console.error("resume on init failed",t),this.gameId=null,this.question=null,this.roundItem=null}}}async resumeDeepLinkInProgress(){if(!this.hasDeepLinkPath())return;let e=this.deepLinkSlugId(),t;try{t=await f.getMyGameForQuiz(e)}catch(i){console.warn("deep-link resume probe failed",i);return}!t||t.completed!==!1||(this.quizSlugId=e,await this.resumeInProgressGame(t))}async hydrateScoreFromResults(){if(!(!this.gameId||!this.player))try{let e=await f.getResults(this.gameId),t=e&&e.playerScores;if(!Array.isArray(t))return;let i=t.find(n=>n.playerId===this.player.id);i&&(this.score=i.score)}catch(e){console.warn("hydrateScoreFromResults failed",e)}}hasCustomName(){return!!(this.player&&this.player.hasCustomName)}isAnonymous(){return!!(this.player&&this.player.isAnonymous)}isAuthenticated(){return!!(this.player&&this.player.isAuthenticated)}hasOffLeaderboardStanding(){return!this.leaderboard||!this.leaderboard.currentPlayer?!1:!this.leaderboard.entries.some(e=>e.isCurrentPlayer)}openClaimModal(){this.claimModalOpen=!0}closeClaimModal(){this.claimModalOpen=!1}async claimFromModal(e){let t=await S.claimName(e);if(t.ok){if(this.player=t.player,this.claimModalOpen=!1,this.finished&&this.quizSlugId)try{this.leaderboard=await f.getQuizLeaderboard(this.quizSlugId)}catch(i){console.warn("leaderboard re-fetch after claim failed; row will update on next load",i)}return t}if(t.kind==="already_claimed"){let i=await S.getMe();i&&(this.player=i),this.claimModalOpen=!1}return t}findDeepLinkedQuiz(){let e=window.location.pathname.match($);if(!e)return null;let t=parseInt(e[1],10);return this.quizzes.find(i=>i.id===t)||null}async resolveDeepLinkedQuiz(){let e=this.findDeepLinkedQuiz();if(e)return e;if(!this.hasDeepLinkPath())return null;let t=await R.getQuizMeta(this.deepLinkSlugId());return t?(this.quizzes=[...this.quizzes,t],t):null}hasDeepLinkPath(){return $.test(window.location.pathname)}isPreviewDeepLink(){return this.hasDeepLinkPath()?new URLSearchParams(window.location.search).get("preview")==="1":!1}deepLinkQuizId(){let e=window.location.pathname.match($);return e?parseInt(e[1],10):null}deepLinkSlugId(){return window.location.pathname.replace(/\/$/,"").replace(/^\/play\//,"")}async startPreviewGame(){this.preview=!0;let e=this.deepLinkQuizId();if(!e){this.deepLinkUnavailable=!0,this.startStateResolved=!0;return}this.quizSlugId=this.deepLinkSlugId(),await this.bootstrapGame({create:async()=>{try{let t=await f.startGame(e,!0);return this.startStateResolved=!0,t.id}catch(t){return t&&(t.status===403||t.status===404)?this.deepLinkUnavailable=!0:(console.error("startPreviewGame failed",t),this.startError=u("play.startPreviewError")),this.startStateResolved=!0,null}},failureCopy:u("play.startPreviewError"),showAudioLoading:!1,tearDownAudioOnFailure:!1})}slugIdFor(e){let t=this.quizzes.find(i=>i.id===parseInt(e));return t?`${t.slug}-${t.id}`:null}selectedQuiz(){return this.selectedQuizId&&this.quizzes.find(e=>e.id===parseInt(this.selectedQuizId))||null}shareCurrentQuiz(){let e=this.selectedQuiz();if(!e)return;let t=new URL(`/play/${e.slug}-${e.id}`,window.location.origin).href;A({title:e.title,text:u("play.shareQuizText",{title:e.title}),url:t})}shareCurrentResult(){if(!this.quizSlugId)return;let e=this.quizzes.find(a=>`${a.slug}-${a.id}`===this.quizSlugId),t=e?e.title:"Top Banana!",i=new URL(`/play/${this.quizSlugId}`,window.location.origin).href,n=this.scoreFromLeaderboard();A({title:t,text:u("play.shareResultText",{score:n,title:t}),url:i})}scoreFromLeaderboard(){if(this.leaderboard){let e=this.leaderboard.entries.find(t=>t.isCurrentPlayer);if(e)return e.score;if(this.leaderboard.currentPlayer)return this.leaderboard.currentPlayer.score}return this.score}async checkAlreadyPlayed(){this.startError=null;let e=this.slugIdFor(this.selectedQuizId);if(e&&(this.deepLinkUnavailable=!1),e!==this.quizSlugId&&(this.finished=!1,this.leaderboard=null,this.quizSlugId=null,this.startStateResolved=!1),!e)return this.startStateResolved=!0,null;let t=this.quizSlugId!==e;if(this.quizSlugId=e,t)try{this.leaderboard=await f.getQuizLeaderboard(e)}catch(n){console.warn("start-screen leaderboard fetch failed",n),this.leaderboard={quizId:0,entries:[],currentPlayer:null}}let i=await f.getMyGameForQuiz(e);return i&&i.completed&&(this.startError=u("play.alreadyCompleted"),this.finished=!0),this.startStateResolved=!0,i}async startGame(){this.audio.unlock(),this.audio.playEffect(w.roundStart),this.roundStartPlayed=!0,this.firstItemAfterStart=!0;let e=await this.checkAlreadyPlayed();if(this.startError)return;let t=this.slugIdFor(this.selectedQuizId);t&&(this.quizSlugId=t,await this.bootstrapGame({create:async()=>{if(e)return e.gameId;try{return(await f.startGame(this.selectedQuizId)).id}catch(i){if(i&&i.status===409){let n=await f.getMyGameForQuiz(t);return n?n.gameId:(console.error("startGame: 409 with no recoverable game",i),this.startError=u("play.startError"),null)}return console.error("startGame failed",i),this.startError=u("play.startError"),null}},failureCopy:u("play.startError"),showAudioLoading:!0,tearDownAudioOnFailure:!0}))}async bootstrapGame({create:e,failureCopy:t,showAudioLoading:i,tearDownAudioOnFailure:n}){this.score=0,this.roundItem=null,this.roundContinueError=!1,this.lastQuestionPosition=0;let a=await e();if(a){this.gameId=a,i?await this.preloadGameAudio():this.preloadGameAudio({showLoading:!1});try{await this.nextQuestion()}catch(o){console.error("bootstrapGame: first question fetch failed",o),this.gameId=null,this.question=null,this.roundItem=null,this.startError=t,n&&this.audio.teardown()}}}async preloadGameAudio({showLoading:e=!0}={}){if(!this.gameId)return;e&&(this.audioLoading=!0);let t=null;try{t=await f.getAudioManifest(this.gameId)}catch(i){console.warn("preloadGameAudio failed",i)}try{await this.audio.preloadClips(t)}finally{e&&(this.audioLoading=!1)}}prefetchNextItem(){this.nextItemPromise||!this.gameId||(this.nextItemPromise=f.getNextQuestion(this.gameId).catch(e=>(console.warn("prefetch next item failed",e),this.nextItemPromise=null,null)))}async nextQuestion(){this.timer&&(clearInterval(this.timer),this.timer=null),this.revealTimer&&(clearInterval(this.revealTimer),this.revealTimer=null),this.clearRoundTimer(),this.audio.stopClip(),this.revealing=!1,this.submitError=!1;let e;if(this.nextItemPromise&&(e=await this.nextItemPromise,this.nextItemPromise=null),e||(e=await f.getNextQuestion(this.gameId)),!e){this.feedback=null,this.finished=!0,this.audio.teardown();try{let t=await S.getMe();t&&(this.player=t)}catch(t){console.warn("finish /me refresh failed",t)}try{this.leaderboard=await f.getQuizLeaderboard(this.quizSlugId)}catch(t){console.warn("finish leaderboard fetch failed",t),this.leaderboard={quizId:0,entries:[],currentPlayer:null}}!this.isAuthenticated()&&!this.hasCustomName()&&this.openClaimModal();return}if(this.firstItemAfterStart&&(this.firstItemAfterStart=!1,e.type==="round_boundary"&&e.phase==="intro"||(this.roundStartPlayed=!1)),e.type==="round_boundary"){this.syncClockFrom(e),this.feedback=null,this.roundItem=e,e.phase==="intro"&&(this.roundStartPlayed?this.roundStartPlayed=!1:this.audio.playEffect(w.roundStart)),typeof e.score=="number"&&(this.score=e.score),this.startRoundCountdown();return}this.imageError=!1,this.syncClockFrom(e),this.feedback=null,this.roundItem=null,this.question=e,typeof e.position=="number"&&(this.lastQuestionPosition=e.position),e.imageUrl&&ee(e.imageUrl),this.audioBlocked=!1,this.audio.playEffectThen(w.questionShow,()=>{e.audioUrl&&this.audio.playClip(e.id)}),this.startRevealCountdown()}syncClockFrom(e){let t=V(e&&e.serverNow);t!==null&&(this.clockOffset=t)}serverTime(){return W(this.clockOffset)}startRevealCountdown(){let e=new Date(this.question.startedAt).getTime(),t=this.serverTime();if(t>=e){this.revealing=!1,this.startCountdown();return}let i=e-t;this.revealing=!0,this.progress=0,this.revealTimer=setInterval(()=>{let n=this.serverTime();if(n>=e){this.progress=100,clearInterval(this.revealTimer),this.revealTimer=null,this.revealing=!1,this.audio.playEffect(w.answersShow),this.startCountdown();return}this.progress=Math.min(100,(n-t)/i*100)},100)}animateRoundIntro(e){N(e)}animateRoundResults(e){N(e);let t=typeof window<"u"?window.anime:null,i=e.querySelectorAll("[data-recap-figure]");O(i,{opacity:[0,1],translateY:[10,0],duration:420,delay:t&&typeof t.stagger=="function"?t.stagger(120,{start:120}):120,ease:"outBack"})}startCountdown(){let e=new Date(this.question.startedAt).getTime(),t=new Date(this.question.expiredAt).getTime(),i=t-e;if(!Number.isFinite(i)||i<=0){this.progress=0,this.handleTimeout();return}this.progress=100,this.timer=setInterval(()=>{let n=this.serverTime(),a=t-n;this.progress=Math.max(0,a/i*100),this.progress<=0&&(clearInterval(this.timer),this.timer=null,this.handleTimeout())},100)}async handleTimeout(){this.feedback||this.submittingAnswer||(this.feedback={timedOut:!0,correct:!1,score:0},this.prefetchNextItem(),await this.resolveAndAdvance())}startRoundCountdown(){if(this.clearRoundTimer(),!this.roundItem||!this.roundItem.expiredAt)return;let e=new Date(this.roundItem.startedAt).getTime(),t=new Date(this.roundItem.expiredAt).getTime(),i=t-e;if(!Number.isFinite(i)||i<=0){this.roundProgress=0,this.continueRound();return}if(this.serverTime()>=t){this.roundProgress=0,this.continueRound();return}this.roundProgress=100,this.roundTimer=setInterval(()=>{let n=t-this.serverTime();this.roundProgress=Math.max(0,n/i*100),this.roundProgress<=0&&(this.clearRoundTimer(),this.continueRound())},100)}clearRoundTimer(){this.roundTimer&&(clearInterval(this.roundTimer),this.roundTimer=null)}async submitAnswer(e){if(this.roundItem||this.feedback||this.submittingAnswer)return;let t=new Date().toISOString();this.submitError=!1,this.submittingAnswer=!0,this.timer&&(clearInterval(this.timer),this.timer=null);try{let n=await f.submitAnswer(this.gameId,this.question.id,e,t);n.pickedOptionId=e,this.feedback=n,this.audio.playEffect(n.correct?w.answerCorrect:w.answerWrong),this.score+=n.score||0,this.prefetchNextItem()}catch(n){let a=n&&n.status,o=a===void 0||a>=500;if(console.error("submitAnswer:",n),o){this.submitError=!0,this.startCountdown();return}this.feedback={timedOut:!0,correct:!1,score:0},this.prefetchNextItem(),await this.resolveAndAdvance();return}finally{this.submittingAnswer=!1}let i=this.feedback.correct?2e3:3e3;await this.resolveAndAdvance(i)}async resolveAndAdvance(e=2e3){await new Promise(t=>setTimeout(t,e)),await this.advanceToNext()}async advanceToNext(){try{await this.nextQuestion(),this.advanceError=!1}catch(e){console.error("advanceToNext:",e),this.advanceError=!0}}async retryAdvance(){if(!this.advancing){this.advancing=!0;try{await this.advanceToNext()}finally{this.advancing=!1}}}async continueRound(){if(!(!this.roundItem||this.continuingRound)){this.clearRoundTimer(),this.continuingRound=!0,this.roundContinueError=!1;try{await f.markRoundSeen(this.gameId,this.roundItem.id,this.roundItem.phase),await this.nextQuestion()}catch(e){console.error("continueRound:",e),this.roundContinueError=!0}finally{this.continuingRound=!1}}}roundTitle(){return this.roundItem&&this.roundItem.title?this.roundItem.title:""}roundSummary(){return this.roundItem&&this.roundItem.summary?this.roundItem.summary:""}replayAudio(){this.question&&this.audio.replayClip(this.question.id)}toggleMute(){this.audio.toggleMute()}optionStateClass(e,t){return Y(e,t,{revealed:!!this.feedback,correctIds:this.feedback?this.feedback.correctOptionIds||[]:[],pickedId:this.feedback?this.feedback.pickedOptionId:null})}};function ce({initialValue:r="",cancelLabel:e="Cancel",submitLabel:t="Save",onSubmit:i,onCancel:n}={}){return{displayName:r,submitting:!1,error:"",cancelLabel:e,submitLabel:t,async submit(){if(this.submitting)return;let a=(this.displayName||"").trim();if(a===""){this.error=u("claim.enterName");return}this.submitting=!0,this.error="";try{let o=await i(a);if(!o||!o.ok){this.error=o&&o.message||u("claim.saveError");return}}finally{this.submitting=!1}},cancel(){this.submitting||typeof n=="function"&&n()}}}var Ke=["a[href]","button:not([disabled])","input:not([disabled])","select:not([disabled])","textarea:not([disabled])",'[tabindex]:not([tabindex="-1"])'].join(",");function de(r){return Array.from(r.querySelectorAll(Ke)).filter(e=>e.getClientRects().length>0)}function Ye(r){let e=null;function t(i){if(i.key!=="Tab")return;let n=de(r);if(n.length===0){i.preventDefault();return}let a=n[0],o=n[n.length-1],d=document.activeElement;i.shiftKey?(d===a||!r.contains(d))&&(i.preventDefault(),o.focus()):(d===o||!r.contains(d))&&(i.preventDefault(),a.focus())}return{activate(){e=document.activeElement,r.addEventListener("keydown",t);let i=r.querySelector("[data-autofocus]")||de(r)[0];i&&i.focus()},deactivate(){r.removeEventListener("keydown",t),e&&document.contains(e)&&typeof e.focus=="function"&&e.focus(),e=null}}}function he(r){r.directive("focus-trap",(e,{expression:t},{effect:i,evaluateLater:n,cleanup:a})=>{let o=Ye(e),d=n(t),m=!1;i(()=>{d(x=>{x&&!m?(m=!0,requestAnimationFrame(()=>{m&&o.activate()})):!x&&m&&(m=!1,o.deactivate())})}),a(()=>{m&&(m=!1,o.deactivate())})})}document.addEventListener("alpine:init",()=>{Alpine.data("gameApp",()=>new T),Alpine.data("claimNameForm",ce),he(Alpine),H(Alpine)});function F(){let r=window.visualViewport?window.visualViewport.height:window.innerHeight;document.documentElement.style.setProperty("--visual-viewport-height",`${r}px`)}F();window.visualViewport&&(window.visualViewport.addEventListener("resize",F),window.visualViewport.addEventListener("scroll",F));
+    `:""}function $e(i,{title:e,text:t,url:r}){i.querySelector("[data-share-link]").textContent=r,i.querySelectorAll("[data-share-close]").forEach(n=>{n.addEventListener("click",()=>i.close())}),i.addEventListener("click",n=>{n.target===i&&i.close()}),i.querySelectorAll("[data-share-network]").forEach(n=>{let l=J.find(f=>f.key===n.dataset.shareNetwork);l&&(n.href=l.href({text:t,url:r}))});let s=i.querySelector("[data-share-copy]");s&&s.addEventListener("click",async()=>{try{await navigator.clipboard.writeText(Z(t,r)),Q(i,"Link copied to clipboard.")}catch{Q(i,"Could not copy \u2014 select the link above and copy manually.")}});let o=i.querySelector("[data-share-native]");o&&o.addEventListener("click",async()=>{try{await navigator.share({title:e,text:t,url:r}),i.close()}catch(n){n&&n.name!=="AbortError"&&Q(i,"Native share unavailable \u2014 pick a network or copy the link.")}})}function Q(i,e){let t=i.querySelector("[data-share-feedback]");t&&(t.textContent=e,t.classList.remove("hidden"),setTimeout(()=>t.classList.add("hidden"),2500))}function De(i=document){i.querySelectorAll("[data-share-trigger]:not([data-share-bound])").forEach(e=>{e.dataset.shareBound="true",e.addEventListener("click",()=>{let t=e.dataset.sharePath,r=new URL(t,window.location.origin).href;z({title:e.dataset.shareTitle||"Share",text:e.dataset.shareText||e.dataset.shareTitle||"",url:r})})})}X(()=>De());function ee(i){return!i||typeof window>"u"||typeof Image!="function"?Promise.resolve():new Promise(e=>{let t=new Image;t.onload=()=>e(),t.onerror=()=>e(),t.src=i})}var te="tb.audioMuted";function ie(){try{return window.localStorage.getItem(te)==="1"}catch{return!1}}function re(i){try{window.localStorage.setItem(te,i?"1":"0")}catch{}}var se=["mp3","m4a","ogg","wav"];var Fe="/static/audio/silence.wav";function Ue(){if(typeof navigator>"u")return!1;let i=navigator.userAgent||"";return/iPad|iPhone|iPod/.test(i)?!0:/Macintosh/.test(i)&&(navigator.maxTouchPoints||0)>1}function ne(){let i=Ue(),e=null,t=null;function r(){if(!i||e||typeof document>"u")return;e=document.createElement("audio"),e.src=Fe,e.loop=!0,e.setAttribute("playsinline",""),e.setAttribute("aria-hidden","true"),e.style.display="none",document.body.appendChild(e);let o=e.play();o&&typeof o.catch=="function"&&o.catch(()=>{}),t=()=>{if(e)if(document.visibilityState==="hidden")e.pause();else{let n=e.play();n&&typeof n.catch=="function"&&n.catch(()=>{})}},document.addEventListener("visibilitychange",t)}function s(){t&&(document.removeEventListener("visibilitychange",t),t=null),e&&(e.pause(),e.remove(),e=null)}return{start:r,stop:s}}var y={roundStart:"round-start",questionShow:"question-show",answersShow:"answers-show",answerCorrect:"answer-correct",answerWrong:"answer-wrong",answerReveal:"answer-reveal"},_e={[y.roundStart]:"/static/audio/sfx/round-start.mp3",[y.questionShow]:"/static/audio/sfx/question-show.mp3",[y.answersShow]:"/static/audio/sfx/answers-show.mp3",[y.answerCorrect]:"/static/audio/sfx/answer-correct.mp3",[y.answerWrong]:"/static/audio/sfx/answer-wrong.mp3",[y.answerReveal]:"/static/audio/sfx/answer-reveal.mp3"},He=3,Ge=1e3,je=.5,Be=8e3,We=12e3;function ae(){return typeof window<"u"&&window.Howl||null}function $(){return typeof window<"u"&&window.Howler||null}function oe(){let i=$();i&&(i.autoSuspend=!1)}function Ve(){let i=$(),e=i?i.ctx:null;return!e||e.state==="running"}function ue(i){let e={},t=new Map,r=ne(),s=null,o=null,n=0,l=null,f=!1,x=!1,g=null;function I(){return!!i.audioMuted}function fe(){let a=ae();if(a){oe();for(let[u,d]of Object.entries(_e))e[u]||(e[u]=new a({src:[d],preload:!0,html5:!1,mute:I(),volume:je}))}}function U(){try{oe();let a=$(),u=a?a.ctx:null;if(u&&typeof u.resume=="function"){let d=u.resume();d&&typeof d.catch=="function"&&d.catch(()=>{})}r.start(),f=!0}catch{}}function me(a){if(I())return;let u=e[a];if(u)try{u.play()}catch{}}function pe(a,u){if(I()){u();return}let d=e[a];if(!d){u();return}let m=n;try{d.once("end",()=>{m===n&&u()}),d.once("stop",()=>{m===n&&u()}),d.play()}catch{u()}}function we(a){let u=ae(),d=Array.isArray(a)?a:a&&Array.isArray(a.clips)?a.clips:[];if(!u||d.length===0)return x=!0,b(),Promise.resolve();let m=d.map(w=>new Promise(G=>{if(w==null||w.questionId==null||!w.audioUrl){G();return}let v={howl:null,loaded:!1,failed:!1,repeat:!!w.audioRepeat};t.set(w.questionId,v);let j=!1,C=()=>{j||(j=!0,clearTimeout(Se),G())},Ae=new u({src:[w.audioUrl],format:se,preload:!0,html5:!1,mute:I(),onload:()=>{v.loaded=!0,v.failed=!1,C(),g===w.questionId&&b()},onloaderror:()=>{v.failed=!0,C(),g===w.questionId&&b()}});v.howl=Ae;let Se=setTimeout(()=>{!v.loaded&&!v.failed&&(v.failed=!0),C(),g===w.questionId&&b()},Be)}));b();let k=null,E=new Promise(w=>{k=setTimeout(w,We)});return Promise.race([Promise.all(m),E]).then(w=>(k!==null&&clearTimeout(k),x=!0,b(),w))}function _(a,u,d){let m=a.howl;if(!m)return;let k=()=>{if(u!==n||d<=1)return;let E=d-1;l=setTimeout(()=>{if(l=null,u===n){try{m.stop(),m.play()}catch{}_(a,u,E)}},Ge)};m.once("end",k)}function H(a,u){P(),n+=1;let d=n;o=a,s=a;let m=u.howl;if(!m){i.audioBlocked=!0;return}try{m.mute(I()),m.off("end"),m.stop(),m.play()}catch{i.audioBlocked=!0;return}i.audioBlocked=!f&&!Ve(),u.repeat&&_(u,d,He)}function ye(a){a==null||a===s||(g=a,b())}function b(){let a=g;if(a==null||a===s)return;let u=t.get(a);if(!u||!u.howl){x&&(i.audioBlocked=!0);return}if(u.failed){i.audioBlocked=!0;return}u.loaded&&H(a,u)}function ge(a){if(a==null)return;U();let u=t.get(a);if(!u||!u.howl){i.audioBlocked=!0;return}if(u.failed){i.audioBlocked=!0;return}if(i.audioBlocked=!1,u.loaded){H(a,u);return}g=a,s=null,b()}function P(){l!==null&&(clearTimeout(l),l=null)}function be(){if(P(),n+=1,g=null,o!=null){let a=t.get(o);if(a&&a.howl)try{a.howl.off("end"),a.howl.stop()}catch{}o=null}}function ve(){g=null}function xe(){let a=!i.audioMuted;i.audioMuted=a,re(a),Ie(a)}function Ie(a){for(let u of Object.values(e))try{u.mute(a)}catch{}for(let u of t.values())if(u.howl)try{u.howl.mute(a)}catch{}}function ke(){P(),n+=1,g=null,x=!1,r.stop();for(let a of t.values())if(a.howl)try{a.howl.unload()}catch{}t.clear(),o=null,s=null}return{preloadEffects:fe,unlock:U,playEffect:me,playEffectThen:pe,preloadClips:we,playClip:ye,replayClip:ge,stopClip:be,cancelPendingClip:ve,toggleMute:xe,muted:I,teardown:ke,isUnlocked:()=>f}}function le(){return ie()}var D=/^\/play\/.+-(\d+)\/?$/,T=class{constructor(){this.quizzes=[],this.quizzesError=!1,this.quizzesRetrying=!1,this.selectedQuizId=null,this.gameId=null,this.question=null,this.nextItemPromise=null,this.roundItem=null,this.lastQuestionPosition=0,this.roundContinueError=!1,this.continuingRound=!1,this.roundProgress=100,this.roundTimer=null,this.finished=!1,this.leaderboard=null,this.quizSlugId=null,this.feedback=null,this.submitError=!1,this.numericInput="",this.multiPicks=[],this.wager=0,this.placingWager=!1,this.pauseUsed=!1,this.pausing=!1,this.pausedUntil=0,this.timerPaused=!1,this.hint="",this.hintPenalty=0,this.takingHint=!1,this.advanceError=!1,this.advancing=!1,this.progress=100,this.timer=null,this.imageError=!1,this.startError=null,this.deepLinkedQuiz=null,this.deepLinkUnavailable=!1,this.preview=!1,this.startStateResolved=!1,this.player=null,this.claimModalOpen=!1,this.submittingAnswer=!1,this.score=0,this.revealing=!1,this.revealTimer=null,this.clockOffset=0,this.audioMuted=le(),this.audioBlocked=!1,this.audioLoading=!1,this.audio=null,this.roundStartPlayed=!1,this.firstItemAfterStart=!1,typeof window<"u"&&window.addEventListener("beforeunload",()=>{this.clearRoundTimer(),this.audio&&this.audio.teardown()})}async init(){this.audio=ue(this),this.audio.preloadEffects();let[e,t]=await Promise.all([this.loadQuizzes(),S.getMe()]);if(this.player=t,this.isPreviewDeepLink()){await this.startPreviewGame();return}e&&await this.resolveStartState()}async loadQuizzes(){this.quizzesError=!1;try{return this.quizzes=await L.getQuizzes(),!0}catch(e){return console.error("loadQuizzes failed",e),this.quizzes=[],this.quizzesError=!0,!1}}async retryLoadQuizzes(){if(!this.quizzesRetrying){this.quizzesRetrying=!0;try{await this.loadQuizzes()&&await this.resolveStartState()}finally{this.quizzesRetrying=!1}}}async resolveStartState(){let e;try{e=await this.resolveDeepLinkedQuiz()}catch(r){console.warn("deep-link quiz meta fetch failed",r),this.quizzesError=!0,await this.resumeDeepLinkInProgress();return}e?(this.deepLinkedQuiz=e,this.selectedQuizId=e.id):this.hasDeepLinkPath()&&(this.deepLinkUnavailable=!0);let t=await this.checkAlreadyPlayed();await this.resumeInProgressGame(t)}async resumeInProgressGame(e){if(!(!e||e.completed!==!1)){this.gameId=e.gameId,await this.hydrateScoreFromResults(),this.preloadGameAudio({showLoading:!1});try{await this.nextQuestion()}catch(t){console.error("resume on init failed",t),this.gameId=null,this.question=null,this.roundItem=null}}}async resumeDeepLinkInProgress(){if(!this.hasDeepLinkPath())return;let e=this.deepLinkSlugId(),t;try{t=await h.getMyGameForQuiz(e)}catch(r){console.warn("deep-link resume probe failed",r);return}!t||t.completed!==!1||(this.quizSlugId=e,await this.resumeInProgressGame(t))}async hydrateScoreFromResults(){if(!(!this.gameId||!this.player))try{let e=await h.getResults(this.gameId),t=e&&e.playerScores;if(!Array.isArray(t))return;let r=t.find(s=>s.playerId===this.player.id);r&&(this.score=r.score)}catch(e){console.warn("hydrateScoreFromResults failed",e)}}hasCustomName(){return!!(this.player&&this.player.hasCustomName)}isAnonymous(){return!!(this.player&&this.player.isAnonymous)}isAuthenticated(){return!!(this.player&&this.player.isAuthenticated)}hasOffLeaderboardStanding(){return!this.leaderboard||!this.leaderboard.currentPlayer?!1:!this.leaderboard.entries.some(e=>e.isCurrentPlayer)}openClaimModal(){this.claimModalOpen=!0}closeClaimModal(){this.claimModalOpen=!1}async claimFromModal(e){let t=await S.claimName(e);if(t.ok){if(this.player=t.player,this.claimModalOpen=!1,this.finished&&this.quizSlugId)try{this.leaderboard=await h.getQuizLeaderboard(this.quizSlugId)}catch(r){console.warn("leaderboard re-fetch after claim failed; row will update on next load",r)}return t}if(t.kind==="already_claimed"){let r=await S.getMe();r&&(this.player=r),this.claimModalOpen=!1}return t}findDeepLinkedQuiz(){let e=window.location.pathname.match(D);if(!e)return null;let t=parseInt(e[1],10);return this.quizzes.find(r=>r.id===t)||null}async resolveDeepLinkedQuiz(){let e=this.findDeepLinkedQuiz();if(e)return e;if(!this.hasDeepLinkPath())return null;let t=await L.getQuizMeta(this.deepLinkSlugId());return t?(this.quizzes=[...this.quizzes,t],t):null}hasDeepLinkPath(){return D.test(window.location.pathname)}isPreviewDeepLink(){return this.hasDeepLinkPath()?new URLSearchParams(window.location.search).get("preview")==="1":!1}deepLinkQuizId(){let e=window.location.pathname.match(D);return e?parseInt(e[1],10):null}deepLinkSlugId(){return window.location.pathname.replace(/\/$/,"").replace(/^\/play\//,"")}async startPreviewGame(){this.preview=!0;let e=this.deepLinkQuizId();if(!e){this.deepLinkUnavailable=!0,this.startStateResolved=!0;return}this.quizSlugId=this.deepLinkSlugId(),await this.bootstrapGame({create:async()=>{try{let t=await h.startGame(e,!0);return this.startStateResolved=!0,t.id}catch(t){return t&&(t.status===403||t.status===404)?this.deepLinkUnavailable=!0:(console.error("startPreviewGame failed",t),this.startError=c("play.startPreviewError")),this.startStateResolved=!0,null}},failureCopy:c("play.startPreviewError"),showAudioLoading:!1,tearDownAudioOnFailure:!1})}slugIdFor(e){let t=this.quizzes.find(r=>r.id===parseInt(e));return t?`${t.slug}-${t.id}`:null}selectedQuiz(){return this.selectedQuizId&&this.quizzes.find(e=>e.id===parseInt(this.selectedQuizId))||null}shareCurrentQuiz(){let e=this.selectedQuiz();if(!e)return;let t=new URL(`/play/${e.slug}-${e.id}`,window.location.origin).href;z({title:e.title,text:c("play.shareQuizText",{title:e.title}),url:t})}shareCurrentResult(){if(!this.quizSlugId)return;let e=this.quizzes.find(o=>`${o.slug}-${o.id}`===this.quizSlugId),t=e?e.title:"Top Banana!",r=new URL(`/play/${this.quizSlugId}`,window.location.origin).href,s=this.scoreFromLeaderboard();z({title:t,text:c("play.shareResultText",{score:s,title:t}),url:r})}scoreFromLeaderboard(){if(this.leaderboard){let e=this.leaderboard.entries.find(t=>t.isCurrentPlayer);if(e)return e.score;if(this.leaderboard.currentPlayer)return this.leaderboard.currentPlayer.score}return this.score}async checkAlreadyPlayed(){this.startError=null;let e=this.slugIdFor(this.selectedQuizId);if(e&&(this.deepLinkUnavailable=!1),e!==this.quizSlugId&&(this.finished=!1,this.leaderboard=null,this.quizSlugId=null,this.startStateResolved=!1),!e)return this.startStateResolved=!0,null;let t=this.quizSlugId!==e;if(this.quizSlugId=e,t)try{this.leaderboard=await h.getQuizLeaderboard(e)}catch(s){console.warn("start-screen leaderboard fetch failed",s),this.leaderboard={quizId:0,entries:[],currentPlayer:null}}let r=await h.getMyGameForQuiz(e);return r&&r.completed&&(this.startError=c("play.alreadyCompleted"),this.finished=!0),this.startStateResolved=!0,r}async startGame(){this.audio.unlock(),this.audio.playEffect(y.roundStart),this.roundStartPlayed=!0,this.firstItemAfterStart=!0;let e=await this.checkAlreadyPlayed();if(this.startError)return;let t=this.slugIdFor(this.selectedQuizId);t&&(this.quizSlugId=t,await this.bootstrapGame({create:async()=>{if(e)return e.gameId;try{return(await h.startGame(this.selectedQuizId)).id}catch(r){if(r&&r.status===409){let s=await h.getMyGameForQuiz(t);return s?s.gameId:(console.error("startGame: 409 with no recoverable game",r),this.startError=c("play.startError"),null)}return console.error("startGame failed",r),this.startError=c("play.startError"),null}},failureCopy:c("play.startError"),showAudioLoading:!0,tearDownAudioOnFailure:!0}))}async bootstrapGame({create:e,failureCopy:t,showAudioLoading:r,tearDownAudioOnFailure:s}){this.score=0,this.roundItem=null,this.roundContinueError=!1,this.lastQuestionPosition=0;let o=await e();if(o){this.gameId=o,r?await this.preloadGameAudio():this.preloadGameAudio({showLoading:!1});try{await this.nextQuestion()}catch(n){console.error("bootstrapGame: first question fetch failed",n),this.gameId=null,this.question=null,this.roundItem=null,this.startError=t,s&&this.audio.teardown()}}}async preloadGameAudio({showLoading:e=!0}={}){if(!this.gameId)return;e&&(this.audioLoading=!0);let t=null;try{t=await h.getAudioManifest(this.gameId)}catch(r){console.warn("preloadGameAudio failed",r)}try{await this.audio.preloadClips(t)}finally{e&&(this.audioLoading=!1)}}prefetchNextItem(){this.nextItemPromise||!this.gameId||(this.nextItemPromise=h.getNextQuestion(this.gameId).catch(e=>(console.warn("prefetch next item failed",e),this.nextItemPromise=null,null)))}async nextQuestion(){this.timer&&(clearInterval(this.timer),this.timer=null),this.revealTimer&&(clearInterval(this.revealTimer),this.revealTimer=null),this.clearRoundTimer(),this.audio.stopClip(),this.revealing=!1,this.submitError=!1;let e;if(this.nextItemPromise&&(e=await this.nextItemPromise,this.nextItemPromise=null),e||(e=await h.getNextQuestion(this.gameId)),!e){this.feedback=null,this.finished=!0,this.audio.teardown();try{let t=await S.getMe();t&&(this.player=t)}catch(t){console.warn("finish /me refresh failed",t)}try{this.leaderboard=await h.getQuizLeaderboard(this.quizSlugId)}catch(t){console.warn("finish leaderboard fetch failed",t),this.leaderboard={quizId:0,entries:[],currentPlayer:null}}!this.isAuthenticated()&&!this.hasCustomName()&&this.openClaimModal();return}if(this.firstItemAfterStart&&(this.firstItemAfterStart=!1,e.type==="round_boundary"&&e.phase==="intro"||(this.roundStartPlayed=!1)),e.type==="round_boundary"){this.syncClockFrom(e),this.feedback=null,this.roundItem=e,e.phase==="intro"&&(this.roundStartPlayed?this.roundStartPlayed=!1:this.audio.playEffect(y.roundStart)),typeof e.score=="number"&&(this.score=e.score),this.startRoundCountdown();return}this.imageError=!1,this.syncClockFrom(e),this.feedback=null,this.roundItem=null,this.numericInput="",this.multiPicks=[],this.wager=e.wager||0,this.hint="",this.hintPenalty=0,this.pausedUntil=0,this.timerPaused=!1,this.question=e,typeof e.position=="number"&&(this.lastQuestionPosition=e.position),e.imageUrl&&ee(e.imageUrl),this.audioBlocked=!1,this.audio.playEffectThen(y.questionShow,()=>{e.audioUrl&&this.audio.playClip(e.id)}),this.startRevealCountdown()}syncClockFrom(e){let t=W(e&&e.serverNow);t!==null&&(this.clockOffset=t)}serverTime(){return V(this.clockOffset)}startRevealCountdown(){let e=new Date(this.question.startedAt).getTime(),t=this.serverTime();if(t>=e){this.revealing=!1,this.startCountdown();return}let r=e-t;this.revealing=!0,this.progress=0,this.revealTimer=setInterval(()=>{let s=this.serverTime();if(s>=e){this.progress=100,clearInterval(this.revealTimer),this.revealTimer=null,this.revealing=!1,this.audio.playEffect(y.answersShow),this.startCountdown();return}this.progress=Math.min(100,(s-t)/r*100)},100)}animateRoundIntro(e){N(e)}animateRoundResults(e){N(e);let t=typeof window<"u"?window.anime:null,r=e.querySelectorAll("[data-recap-figure]");O(r,{opacity:[0,1],translateY:[10,0],duration:420,delay:t&&typeof t.stagger=="function"?t.stagger(120,{start:120}):120,ease:"outBack"})}startCountdown(){let e=new Date(this.question.startedAt).getTime(),r=new Date(this.question.expiredAt).getTime()-e;if(!Number.isFinite(r)||r<=0){this.progress=0,this.handleTimeout();return}this.progress=100,this.timer=setInterval(()=>{let s=this.serverTime();if(this.timerPaused=s<this.pausedUntil,this.timerPaused)return;let o=new Date(this.question.expiredAt).getTime()-s;this.progress=Math.min(100,Math.max(0,o/r*100)),this.progress<=0&&(clearInterval(this.timer),this.timer=null,this.handleTimeout())},100)}async handleTimeout(){this.feedback||this.submittingAnswer||(this.feedback={timedOut:!0,correct:!1,score:0},this.prefetchNextItem(),await this.resolveAndAdvance())}startRoundCountdown(){if(this.clearRoundTimer(),!this.roundItem||!this.roundItem.expiredAt)return;let e=new Date(this.roundItem.startedAt).getTime(),t=new Date(this.roundItem.expiredAt).getTime(),r=t-e;if(!Number.isFinite(r)||r<=0){this.roundProgress=0,this.continueRound();return}if(this.serverTime()>=t){this.roundProgress=0,this.continueRound();return}this.roundProgress=100,this.roundTimer=setInterval(()=>{let s=t-this.serverTime();this.roundProgress=Math.max(0,s/r*100),this.roundProgress<=0&&(this.clearRoundTimer(),this.continueRound())},100)}clearRoundTimer(){this.roundTimer&&(clearInterval(this.roundTimer),this.roundTimer=null)}async submitNumeric(){let e=Number(String(this.numericInput).trim().replace(",","."));String(this.numericInput).trim()===""||!Number.isFinite(e)||await this.submitAnswer(0,e)}toggleMultiPick(e){if(this.feedback||this.submittingAnswer)return;let t=this.multiPicks.indexOf(e);t===-1?this.multiPicks.push(e):this.multiPicks.splice(t,1)}async submitMulti(){this.multiPicks.length!==0&&await this.submitAnswer(0,void 0,[...this.multiPicks])}async placeWager(e){if(!(!this.question||!this.question.confidenceWager)&&!(this.wager||this.placingWager||this.feedback||this.submittingAnswer)){this.placingWager=!0;try{let t=await h.placeWager(this.gameId,this.question.id,e);this.wager=t.wager}catch(t){console.error("placeWager:",t)}finally{this.placingWager=!1}}}async pauseOnce(){if(!(!this.question||this.pauseUsed||this.pausing||this.revealing)&&!(this.feedback||this.submittingAnswer)){this.pausing=!0;try{let e=await h.pauseOnce(this.gameId,this.question.id);this.pauseUsed=!0,this.pausedUntil=this.serverTime()+e.extensionSeconds*1e3,this.question.expiredAt=e.expiredAt}catch(e){e&&e.status===409?this.pauseUsed=!0:console.error("pauseOnce:",e)}finally{this.pausing=!1}}}async takeHint(){if(!(!this.question||!this.question.hasHint||this.hint||this.takingHint)&&!(this.feedback||this.submittingAnswer)){this.takingHint=!0;try{let e=await h.useHint(this.gameId,this.question.id);this.hint=e.hint,this.hintPenalty=e.penaltyPercent}catch(e){e&&e.status===409?this.question.hasHint=!1:console.error("takeHint:",e)}finally{this.takingHint=!1}}}async submitAnswer(e,t,r){if(this.roundItem||this.feedback||this.submittingAnswer)return;let s=new Date().toISOString();this.submitError=!1,this.submittingAnswer=!0,this.timer&&(clearInterval(this.timer),this.timer=null);try{let n=await h.submitAnswer(this.gameId,this.question.id,e,s,t,r);n.pickedOptionId=e,this.feedback=n,this.audio.playEffect(n.correct?y.answerCorrect:y.answerWrong),this.score+=n.score||0,n.next?this.nextItemPromise=Promise.resolve(n.next):this.prefetchNextItem()}catch(n){let l=n&&n.status,f=l===void 0||l>=500;if(console.error("submitAnswer:",n),f){this.submitError=!0,this.startCountdown();return}this.feedback={timedOut:!0,correct:!1,score:0},this.prefetchNextItem(),await this.resolveAndAdvance();return}finally{this.submittingAnswer=!1}let o=this.feedback.correct?2e3:3e3;await this.resolveAndAdvance(o)}async resolveAndAdvance(e=2e3){await new Promise(t=>setTimeout(t,e)),await this.advanceToNext()}async advanceToNext(){try{await this.nextQuestion(),this.advanceError=!1}catch(e){console.error("advanceToNext:",e),this.advanceError=!0}}async retryAdvance(){if(!this.advancing){this.advancing=!0;try{await this.advanceToNext()}finally{this.advancing=!1}}}async continueRound(){if(!(!this.roundItem||this.continuingRound)){this.clearRoundTimer(),this.continuingRound=!0,this.roundContinueError=!1;try{await h.markRoundSeen(this.gameId,this.roundItem.id,this.roundItem.phase),await this.nextQuestion()}catch(e){console.error("continueRound:",e),this.roundContinueError=!0}finally{this.continuingRound=!1}}}roundTitle(){return this.roundItem&&this.roundItem.title?this.roundItem.title:""}roundSummary(){return this.roundItem&&this.roundItem.summary?this.roundItem.summary:""}replayAudio(){this.question&&this.audio.replayClip(this.question.id)}toggleMute(){this.audio.toggleMute()}optionStateClass(e,t){let r=!!this.question&&this.question.kind==="multi",s=this.feedback?this.feedback.pickedOptionId:null;return r&&(s=(!this.feedback||!this.feedback.timedOut)&&this.multiPicks.includes(e.id)?e.id:null),Y(e,t,{revealed:!!this.feedback,correctIds:this.feedback?this.feedback.correctOptionIds||[]:[],pickedId:s,highlightPick:r})}};function ce({initialValue:i="",cancelLabel:e="Cancel",submitLabel:t="Save",onSubmit:r,onCancel:s}={}){return{displayName:i,submitting:!1,error:"",cancelLabel:e,submitLabel:t,async submit(){if(this.submitting)return;let o=(this.displayName||"").trim();if(o===""){this.error=c("claim.enterName");return}this.submitting=!0,this.error="";try{let n=await r(o);if(!n||!n.ok){this.error=n&&n.message||c("claim.saveError");return}}finally{this.submitting=!1}},cancel(){this.submitting||typeof s=="function"&&s()}}}var Ke=["a[href]","button:not([disabled])","input:not([disabled])","select:not([disabled])","textarea:not([disabled])",'[tabindex]:not([tabindex="-1"])'].join(",");function de(i){return Array.from(i.querySelectorAll(Ke)).filter(e=>e.getClientRects().length>0)}function Ye(i){let e=null;function t(r){if(r.key!=="Tab")return;let s=de(i);if(s.length===0){r.preventDefault();return}let o=s[0],n=s[s.length-1],l=document.activeElement;r.shiftKey?(l===o||!i.contains(l))&&(r.preventDefault(),n.focus()):(l===n||!i.contains(l))&&(r.preventDefault(),o.focus())}return{activate(){e=document.activeElement,i.addEventListener("keydown",t);let r=i.querySelector("[data-autofocus]")||de(i)[0];r&&r.focus()},deactivate(){i.removeEventListener("keydown",t),e&&document.contains(e)&&typeof e.focus=="function"&&e.focus(),e=null}}}function he(i){i.directive("focus-trap",(e,{expression:t},{effect:r,evaluateLater:s,cleanup:o})=>{let n=Ye(e),l=s(t),f=!1;r(()=>{l(x=>{x&&!f?(f=!0,requestAnimationFrame(()=>{f&&n.activate()})):!x&&f&&(f=!1,n.deactivate())})}),o(()=>{f&&(f=!1,n.deactivate())})})}document.addEventListener("alpine:init",()=>{Alpine.data("gameApp",()=>new T),Alpine.data("claimNameForm",ce),he(Alpine),B(Alpine)});function F(){let i=window.visualViewport?window.visualViewport.height:window.innerHeight;document.documentElement.style.setProperty("--visual-viewport-height",`${i}px`)}F();window.visualViewport&&(window.visualViewport.addEventListener("resize",F),window.visualViewport.addEventListener("scroll",F));
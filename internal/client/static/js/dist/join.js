@@ -1 +1 @@
-var w=class extends Error{constructor(t,e,i){super(t),this.name="ApiError",this.status=e,this.body=i}};async function f(s){if(s.ok)return await s.json();let t="";try{t=await s.text()}catch{}let e=t.slice(0,200);throw new w(`HTTP ${s.status}: ${e}`,s.status,t)}var D=/\{(\w+)\}/g;function Q(){return typeof window>"u"||!window.__I18N__?{}:window.__I18N__.messages||{}}function o(s,t){let e=Q(),i=Object.prototype.hasOwnProperty.call(e,s)?e[s]:s;return t&&(i=i.replace(D,(r,n)=>Object.prototype.hasOwnProperty.call(t,n)?String(t[n]):r)),i}function k(s){s.magic("t",()=>o)}var g=class{async join(t){let e;try{e=await fetch(`/api/sessions/${encodeURIComponent(t)}/join`,{method:"POST",headers:{"Content-Type":"application/json"}})}catch{return{ok:!1,kind:"error",message:o("join.joinError")}}if(e.status===200){let i=await e.json();return{ok:!0,displayName:i.displayName,isReady:i.isReady}}return e.status===404?{ok:!1,kind:"notFound",message:o("join.noGameFound")}:e.status===409?{ok:!1,kind:"closed",message:o("join.gameStarted")}:e.status===403?{ok:!1,kind:"alreadyPlayed",message:o("join.alreadyPlayed")}:{ok:!1,kind:"error",message:o("join.joinError")}}async setReady(t,e){let i=await fetch(`/api/sessions/${encodeURIComponent(t)}/ready`,{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify({ready:e})});i.ok||await f(i)}async answer(t,e){let i=await fetch(`/api/sessions/${encodeURIComponent(t)}/answer`,{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify({optionId:e})});return i.status===204?{ok:!0}:i.status===409?{ok:!1,kind:"closed"}:(await f(i),{ok:!0})}leave(t){return navigator.sendBeacon(`/api/sessions/${encodeURIComponent(t)}/leave`)}async getState(t){let e=await fetch(`/api/sessions/${encodeURIComponent(t)}/state`);return e.status===404?null:f(e)}},d=new g;async function $(s){try{return await s.clone().json()}catch{return{}}}var S=class{async getMe(){try{let t=await fetch("/api/players/me");return t.ok?await t.json():null}catch{return null}}async claimName(t){let e=(t||"").trim();if(e==="")return{ok:!1,status:400,kind:"empty",message:o("claim.enterName")};let i;try{i=await fetch("/api/players/me",{method:"PATCH",headers:{"Content-Type":"application/json"},body:JSON.stringify({displayName:e})})}catch{return{ok:!1,status:0,kind:"error",message:o("claim.saveError")}}if(i.status===200)return{ok:!0,player:await i.json()};if(i.status===409){let{code:r,message:n}=await $(i);return r==="already_claimed"?{ok:!1,status:409,kind:"already_claimed",message:n||o("claim.alreadyNamed")}:{ok:!1,status:409,kind:"taken",message:o("claim.nameTaken")}}return i.status===400?{ok:!1,status:400,kind:"empty",message:o("claim.enterName")}:{ok:!1,status:i.status,kind:"error",message:o("claim.saveError")}}},m=new S;function U(){return typeof window<"u"&&typeof window.matchMedia=="function"&&window.matchMedia("(prefers-reduced-motion: reduce)").matches}function b(s,t){if(U()||typeof window>"u"||!window.anime){typeof t.onComplete=="function"&&t.onComplete();return}let e=window.anime;typeof e.animate=="function"?e.animate(s,t):typeof e=="function"?e({targets:s,...t}):typeof t.onComplete=="function"&&t.onComplete()}function T(s){if(!s)return null;let t=new Date(s).getTime();return Number.isFinite(t)?t-Date.now():null}function v(s){return Date.now()+s}function B(s,t,e){let i=e-t;return i>0?N((s-t)/i*100):100}function I(s,t,e){let i=e-t;return i>0?N((e-s)/i*100):0}function N(s){return Math.max(0,Math.min(100,s))}function R(s,t){t.clearTimer();let e=s&&s.startedAt?new Date(s.startedAt).getTime():NaN,i=s&&s.expiresAt?new Date(s.expiresAt).getTime():NaN;if(!Number.isFinite(e)||!Number.isFinite(i)||i<=e){t.setRevealing(!1),t.setProgress(100);return}if(t.serverNow()<e){W(e,i,t);return}C(e,i,t)}function W(s,t,e){let i=e.serverNow();e.setRevealing(!0),e.setProgress(0);let r=()=>{let n=e.serverNow();if(n>=s){e.setProgress(100),e.clearTimer(),e.setRevealing(!1),C(s,t,e);return}e.setProgress(B(n,i,s))};r(),e.setTimer(setInterval(r,100))}function C(s,t,e){e.clearTimer(),e.setRevealing(!1);let i=()=>{let r=I(e.serverNow(),s,t);e.setProgress(r),r<=0&&e.clearTimer()};i(),!(I(e.serverNow(),s,t)<=0)&&e.setTimer(setInterval(i,100))}function x(s,t){return Math.max(0,Math.ceil((t-s)/1e3))}function L(s){let t=Math.max(0,Math.floor(s)),e=Math.floor(t/60),i=t%60;return`${e}:${String(i).padStart(2,"0")}`}function O(s,t){t.clearTimer();let e=s?new Date(s).getTime():NaN;if(!Number.isFinite(e)){t.setRemaining(0);return}let i=()=>{let r=x(t.serverNow(),e);t.setRemaining(r),r<=0&&t.clearTimer()};i(),!(x(t.serverNow(),e)<=0)&&t.setTimer(setInterval(i,250))}function A(s,{animate:t,ownsRow:e=null}={}){let i=Math.max(1,...s.map(n=>n.totalScore));return{rows:s.map(n=>({playerId:n.playerId,displayName:n.displayName,rank:n.rank,total:n.totalScore,preTotal:n.totalScore-n.roundScore,isMe:e?e(n):!1,displayTotal:t?n.totalScore-n.roundScore:n.totalScore})),maxTotal:i}}function E(s,t,e=900){let i=typeof window<"u"?window.anime:null;if(!s.some(n=>n.total!==n.preTotal)||!i){s.forEach(n=>{n.displayTotal=n.total});return}s.forEach(n=>{let a={v:n.preTotal};t(a,{v:n.total,duration:e,ease:"outCubic",onUpdate:()=>{n.displayTotal=Math.round(a.v)},onComplete:()=>{n.displayTotal=n.total}})})}function G(s,t){let e=new Map(t.map((r,n)=>[String(r),n])),i=t.length;return[...s].sort((r,n)=>{let a=e.has(String(r.playerId))?e.get(String(r.playerId)):i,c=e.has(String(n.playerId))?e.get(String(n.playerId)):i;return a-c})}function K(s){let t=new Map;return s&&s.querySelectorAll("[data-standings-row][data-player-id]").forEach(e=>{t.set(e.getAttribute("data-player-id"),e.getBoundingClientRect().top)}),t}function H(s,t,e,i=450){!s||!t||t.size===0||s.querySelectorAll("[data-standings-row][data-player-id]").forEach(r=>{let n=r.getAttribute("data-player-id"),a=t.get(n);if(a===void 0)return;let c=a-r.getBoundingClientRect().top;c!==0&&(r.style.transform=`translateY(${c}px)`,e(r,{translateY:[c,0],duration:i,ease:"inOutQuad",onComplete:()=>{r.style.transform=""}}))})}function J(s){if(typeof window<"u"&&typeof window.requestAnimationFrame=="function"){window.requestAnimationFrame(()=>s());return}setTimeout(s,16)}function F({rows:s,prevOrder:t,animate:e,runAnim:i,setBars:r,getBars:n,getContainer:a,afterRender:c,animateBars:l}){if(!e||!t||t.length===0){r(s),e&&l(n(),i);return}r(G(s,t)),c(()=>{let u=K(a());r(s),l(n(),i),J(()=>H(a(),u,i))})}var j=["btn-answer-tone-a","btn-answer-tone-b","btn-answer-tone-c","btn-answer-tone-d"];function q(s,t,{revealed:e=!1,correctIds:i=[],pickedId:r=null,highlightPick:n=!1}={}){if(e)return i.includes(s.id)?"btn-answer-correct":r===s.id?"btn-answer-wrong":"btn-answer-dim";let a=j[t%j.length];return n&&r===s.id?`btn-answer ${a} bg-surface-2 ring-2 ring-accent`:`btn-answer ${a}`}var p="topbanana.session";function h(){try{window.localStorage.removeItem(p)}catch{}}function _(){let s;try{s=window.localStorage.getItem(p)}catch{return null}if(!s)return null;try{let t=JSON.parse(s);if(t&&typeof t.code=="string"&&t.code!=="")return{code:t.code}}catch{}return h(),null}var V=/^\/join\/([^/]+)\/?$/,Y=3;function z(s){try{window.localStorage.setItem(p,JSON.stringify({code:s}))}catch{}}var y=class{constructor(){this.step="code",this.code="",this.codeInput="",this.displayName="",this.myDisplayName="",this.myPlayerId=null,this.player=null,this.busy=!1,this.error="",this.state=null,this.isReady=!1,this.sessionClosed=!1,this.eventSource=null,this.onVisible=null,this.accountName=null,this.clockOffset=0,this.questionProgress=100,this.questionTimer=null,this.revealing=!1,this.currentQuestionId=null,this.pickedOptionId=null,this.submitting=!1,this.answerError=!1,this.standingsBars=[],this.maxStandingsTotal=1,this.lastStandingsKey=null,this.lastStandingsOrder=null,this.startAt=null,this.startRemaining=0,this.startTimer=null,this.connectionTrouble=!1,this.stateFailures=0,this.stateSeq=0,this.reconnecting=!1,this.exitConfirmOpen=!1,this.exiting=!1,this.leftSent=!1,this.wakeLock=null,this.wakeLockHeld=!1,this.wakeLockGen=0,this.rootEl=null}async init(){this.rootEl=this.$root,window.addEventListener("beforeunload",()=>this.sendLeave()),window.addEventListener("pagehide",()=>this.sendLeave()),this.onVisible=a=>this.handleVisible(a),document.addEventListener("visibilitychange",this.onVisible),window.addEventListener("pageshow",this.onVisible),window.addEventListener("focus",this.onVisible);let t=await m.getMe();t&&(this.player=t,this.myPlayerId=t.id,t.isAuthenticated&&t.hasCustomName&&(this.accountName=t.displayName));let e=V.exec(window.location.pathname),i=e?decodeURIComponent(e[1]).toUpperCase():"",r=_(),n=r&&(!i||r.code===i)?r.code:"";if(!(n&&await this.tryResume(n))&&i){if(this.code=i,this.accountName){await this.autoJoin();return}this.step="name"}}async landInLobby(t){this.myDisplayName=t.displayName,this.isReady=t.isReady,this.step="lobby",this.leftSent=!1,z(this.code),this.acquireWakeLock(),await this.refreshState(),this.subscribe()}async autoJoin(){this.busy=!0,this.error="";try{let t=await d.join(this.code);if(!t.ok){if(t.kind==="closed"){this.enterClosedState();return}this.error=t.message,this.step=t.kind==="notFound"?"code":"name",t.kind==="notFound"&&(this.codeInput=this.code);return}await this.landInLobby(t)}finally{this.busy=!1}}async tryResume(t){let e;try{e=await d.join(t)}catch{return!1}return e.ok?(this.code=t,await this.landInLobby(e),!0):(h(),!1)}submitCode(){let t=(this.codeInput||"").trim().toUpperCase();if(t===""){this.error=o("join.enterCode");return}if(this.error="",this.code=t,this.accountName){this.autoJoin();return}this.step="name"}async submitName(){if(this.busy)return;let t=(this.displayName||"").trim();if(t===""){this.error=o("claim.enterName");return}this.busy=!0,this.error="";try{if(!(await this.claimName(t)).ok)return;let i=await d.join(this.code);if(!i.ok){if(i.kind==="closed"){this.enterClosedState();return}this.error=i.message,i.kind==="notFound"&&(this.step="code",this.codeInput=this.code);return}await this.landInLobby(i)}finally{this.busy=!1}}enterClosedState(){this.step="lobby",this.sessionClosed=!0,this.error="",h()}async claimName(t){let e=await m.claimName(t);if(e.ok)return this.accountName=e.player.displayName,this.myPlayerId=e.player.id,{ok:!0};if(e.kind==="already_claimed"){let i=await m.getMe();return i&&(this.accountName=i.displayName,this.myPlayerId=i.id),{ok:!0}}return this.error=e.message,{ok:!1}}async toggleReady(){if(this.busy)return;let t=!this.isReady;this.busy=!0,this.error="",this.isReady=t;try{await d.setReady(this.code,t)}catch{this.isReady=!t,this.error=o("join.readyError");return}finally{this.busy=!1}await this.refreshState()}async refreshState(){let t=++this.stateSeq,e;try{e=await d.getState(this.code)}catch{this.stateFailures+=1,this.stateFailures>=Y&&(this.connectionTrouble=!0);return}if(t===this.stateSeq){if(e===null){this.sessionClosed=!0,this.releaseWakeLock(),this.closeStream(),this.clearQuestionTimer(),this.clearStartTimer(),h();return}this.stateFailures=0,this.connectionTrouble=!1,this.state=e,this.syncClockFrom(e),this.syncReadyFromState(),this.syncQuestionFromState(),this.syncStartCountdownFromState(),this.syncStandingsFromState(),this.syncWakeLockFromState(e)}}syncWakeLockFromState(t){if(t.phase==="intermission"||t.phase==="finished"){this.releaseWakeLock();return}this.acquireWakeLock()}syncStartCountdownFromState(){let t=this.state?this.state.phase:null;if(this.startAt=t==="lobby"?this.state.startAt??null:null,!this.startAt){this.clearStartTimer(),this.startRemaining=0;return}O(this.startAt,{serverNow:()=>this.serverTime(),setRemaining:e=>{this.startRemaining=e},setTimer:e=>{this.startTimer=e},clearTimer:()=>this.clearStartTimer()})}clearStartTimer(){this.startTimer&&(clearInterval(this.startTimer),this.startTimer=null)}startArmed(){return!!this.startAt}startCountdownLabel(){return o("join.startingIn",{time:L(this.startRemaining)})}lobbyTitle(){return this.state&&this.state.quiz?this.state.quiz.title:o("join.getReady")}lobbyHasQuiz(){return!!(this.state&&this.state.quiz)}syncClockFrom(t){let e=T(t&&t.serverNow);e!==null&&(this.clockOffset=e)}serverTime(){return v(this.clockOffset)}syncQuestionFromState(){let t=this.state?this.state.question:null,e=this.state?this.state.phase:null,i=t?t.id:null;if(i!==this.currentQuestionId&&(this.currentQuestionId=i,this.pickedOptionId=null,this.answerError=!1),e==="question"&&t&&!this.hasAnswered()){this.startCountdown(t);return}this.clearQuestionTimer(),this.revealing=!1,e==="reveal"&&(this.questionProgress=0)}showsStandings(){let t=this.state?this.state.phase:null;return t==="round_results"||t==="intermission"||t==="finished"}syncStandingsFromState(){let t=this.state?this.state.phase:null,e=this.state&&Array.isArray(this.state.standings)?this.state.standings:null;if(!this.showsStandings()||!e){this.standingsBars=[],this.maxStandingsTotal=1,this.lastStandingsKey=null;return}let i=this.state.question?this.state.question.id:"none",r=`${t}:${i}`;if(r===this.lastStandingsKey)return;this.lastStandingsKey=r;let n=this.showsStandings(),{rows:a,maxTotal:c}=A(e,{animate:n,ownsRow:u=>this.ownsRow(u)});this.maxStandingsTotal=c;let l=this.lastStandingsOrder;this.lastStandingsOrder=a.map(u=>String(u.playerId)),F({rows:a,prevOrder:l,animate:n,runAnim:b,setBars:u=>{this.standingsBars=u},getBars:()=>this.standingsBars,getContainer:()=>this.standingsContainer(),afterRender:u=>this.$nextTick(u),animateBars:E})}standingsContainer(){return this.rootEl?this.rootEl.querySelector('[data-testid="standings-bars"]'):null}startCountdown(t){R(t,{serverNow:()=>this.serverTime(),setProgress:e=>{this.questionProgress=e},setRevealing:e=>{this.revealing=e},setTimer:e=>{this.questionTimer=e},clearTimer:()=>this.clearQuestionTimer()})}clearQuestionTimer(){this.questionTimer&&(clearInterval(this.questionTimer),this.questionTimer=null)}syncReadyFromState(){if(this.busy||!this.state||!Array.isArray(this.state.players))return;let t=this.state.players.find(e=>this.ownsRow(e));t&&(this.isReady=t.isReady,this.myDisplayName=t.displayName)}handleVisible(t){if(document.visibilityState==="visible"&&!(this.step!=="lobby"||!this.code)){if(t&&t.type==="pageshow"&&t.persisted){this.resumeAfterRestore();return}this.sessionClosed||(this.refreshState(),this.streamDropped()&&this.subscribe(),this.acquireWakeLock())}}async resumeAfterRestore(){this.sessionClosed=!1,!await this.tryResume(this.code)&&(await this.refreshState(),this.streamDropped()&&this.subscribe(),this.acquireWakeLock())}streamDropped(){return!this.eventSource||this.eventSource.readyState===EventSource.CLOSED}async reconnectNow(){if(!this.reconnecting&&!(this.step!=="lobby"||!this.code)){this.reconnecting=!0;try{this.subscribe(),await this.refreshState()}finally{this.reconnecting=!1}}}subscribe(){if(this.closeStream(),typeof EventSource>"u")return;let t=`/api/sessions/${encodeURIComponent(this.code)}/events`,e=new EventSource(t);e.onmessage=()=>{this.refreshState()},e.onerror=()=>{e.readyState===EventSource.CLOSED&&(this.eventSource=null)},this.eventSource=e}closeStream(){this.eventSource&&(this.eventSource.close(),this.eventSource=null)}sendLeave(){this.closeStream(),this.clearQuestionTimer(),this.clearStartTimer(),this.releaseWakeLock(),this.step==="lobby"&&this.code&&!this.leftSent&&(this.leftSent=!0,d.leave(this.code))}async exitSession(){if(this.exiting)return;this.exiting=!0,this.closeStream(),this.clearQuestionTimer(),this.clearStartTimer(),this.releaseWakeLock(),h();let t=this.code;this.leftSent=!0;try{await fetch(`/api/sessions/${encodeURIComponent(t)}/leave`,{method:"POST",keepalive:!0})}catch{}this.exitConfirmOpen=!1,window.location.assign("/join")}acquireWakeLock(){if(typeof navigator>"u"||!("wakeLock"in navigator)||this.wakeLockHeld)return;this.wakeLockHeld=!0;let t=++this.wakeLockGen;navigator.wakeLock.request("screen").then(e=>{if(t!==this.wakeLockGen){e.release().catch(()=>{});return}this.wakeLock=e,e.addEventListener("release",()=>{t===this.wakeLockGen&&(this.wakeLock=null,this.wakeLockHeld=!1)})}).catch(()=>{t===this.wakeLockGen&&(this.wakeLockHeld=!1)})}releaseWakeLock(){this.wakeLockGen+=1,this.wakeLockHeld=!1;let t=this.wakeLock;this.wakeLock=null,t&&t.release().catch(()=>{})}isHost(t){return!!this.state&&t.playerId===this.state.hostId}isMe(t){return this.ownsRow(t)}ownsRow(t){return this.myPlayerId!==null?t.playerId===this.myPlayerId:t.displayName===this.myDisplayName}isAuthenticated(){return!!(this.player&&this.player.isAuthenticated)}inActiveQuestion(){let t=this.state?this.state.phase:null;return this.step==="lobby"&&(t==="question"||t==="reveal")}currentQuestion(){return this.state?this.state.question:null}currentRound(){return this.state?this.state.round:null}roundEyebrow(){let t=this.currentRound();return t&&t.number>0&&t.total>0?o("join.roundNof",{number:t.number,total:t.total}):o("join.getReady")}roundTitle(){let t=this.currentRound();return t&&t.title?t.title:o("join.nextRound")}roundSummary(){let t=this.currentRound();return t&&t.summary?t.summary:""}hasAnswered(){return this.pickedOptionId!==null}async submitAnswer(t){if(this.submitting||this.hasAnswered())return;let e=this.currentQuestion();if(!(!this.state||this.state.phase!=="question"||!e)){this.submitting=!0,this.answerError=!1,this.pickedOptionId=t,this.clearQuestionTimer();try{let i=await d.answer(this.code,t);if(!i.ok&&i.kind==="closed")return}catch{this.pickedOptionId=null,this.answerError=!0,this.startCountdown(e)}finally{this.submitting=!1}}}correctOptionIds(){let t=this.currentQuestion();return t&&Array.isArray(t.correctOptionIds)?t.correctOptionIds:[]}isRevealed(){return!!this.state&&this.state.phase==="reveal"}pickWasCorrect(){return this.pickedOptionId===null?!1:this.correctOptionIds().includes(this.pickedOptionId)}optionStateClass(t,e){return q(t,e,{revealed:this.isRevealed(),correctIds:this.correctOptionIds(),pickedId:this.pickedOptionId,highlightPick:!0})}};var X=["a[href]","button:not([disabled])","input:not([disabled])","select:not([disabled])","textarea:not([disabled])",'[tabindex]:not([tabindex="-1"])'].join(",");function P(s){return Array.from(s.querySelectorAll(X)).filter(t=>t.getClientRects().length>0)}function Z(s){let t=null;function e(i){if(i.key!=="Tab")return;let r=P(s);if(r.length===0){i.preventDefault();return}let n=r[0],a=r[r.length-1],c=document.activeElement;i.shiftKey?(c===n||!s.contains(c))&&(i.preventDefault(),a.focus()):(c===a||!s.contains(c))&&(i.preventDefault(),n.focus())}return{activate(){t=document.activeElement,s.addEventListener("keydown",e);let i=s.querySelector("[data-autofocus]")||P(s)[0];i&&i.focus()},deactivate(){s.removeEventListener("keydown",e),t&&document.contains(t)&&typeof t.focus=="function"&&t.focus(),t=null}}}function M(s){s.directive("focus-trap",(t,{expression:e},{effect:i,evaluateLater:r,cleanup:n})=>{let a=Z(t),c=r(e),l=!1;i(()=>{c(u=>{u&&!l?(l=!0,requestAnimationFrame(()=>{l&&a.activate()})):!u&&l&&(l=!1,a.deactivate())})}),n(()=>{l&&(l=!1,a.deactivate())})})}document.addEventListener("alpine:init",()=>{Alpine.data("joinApp",()=>new y),M(Alpine),k(Alpine)});
+var w=class extends Error{constructor(t,e,i){super(t),this.name="ApiError",this.status=e,this.body=i}};async function f(s){if(s.ok)return await s.json();let t="";try{t=await s.text()}catch{}let e=t.slice(0,200);throw new w(`HTTP ${s.status}: ${e}`,s.status,t)}var D=/\{(\w+)\}/g;function Q(){return typeof window>"u"||!window.__I18N__?{}:window.__I18N__.messages||{}}function o(s,t){let e=Q(),i=Object.prototype.hasOwnProperty.call(e,s)?e[s]:s;return t&&(i=i.replace(D,(r,n)=>Object.prototype.hasOwnProperty.call(t,n)?String(t[n]):r)),i}function k(s){s.magic("t",()=>o)}var g=class{async join(t){let e;try{e=await fetch(`/api/sessions/${encodeURIComponent(t)}/join`,{method:"POST",headers:{"Content-Type":"application/json"}})}catch{return{ok:!1,kind:"error",message:o("join.joinError")}}if(e.status===200){let i=await e.json();return{ok:!0,displayName:i.displayName,isReady:i.isReady}}return e.status===404?{ok:!1,kind:"notFound",message:o("join.noGameFound")}:e.status===409?{ok:!1,kind:"closed",message:o("join.gameStarted")}:e.status===403?{ok:!1,kind:"alreadyPlayed",message:o("join.alreadyPlayed")}:{ok:!1,kind:"error",message:o("join.joinError")}}async setReady(t,e){let i=await fetch(`/api/sessions/${encodeURIComponent(t)}/ready`,{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify({ready:e})});i.ok||await f(i)}async answer(t,e){let i=await fetch(`/api/sessions/${encodeURIComponent(t)}/answer`,{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify({optionId:e})});return i.status===204?{ok:!0}:i.status===409?{ok:!1,kind:"closed"}:(await f(i),{ok:!0})}leave(t){return navigator.sendBeacon(`/api/sessions/${encodeURIComponent(t)}/leave`)}async getState(t){let e=await fetch(`/api/sessions/${encodeURIComponent(t)}/state`);return e.status===404?null:f(e)}},d=new g;async function $(s){try{return await s.clone().json()}catch{return{}}}var S=class{async getMe(){try{let t=await fetch("/api/players/me");return t.ok?await t.json():null}catch{return null}}async claimName(t){let e=(t||"").trim();if(e==="")return{ok:!1,status:400,kind:"empty",message:o("claim.enterName")};let i;try{i=await fetch("/api/players/me",{method:"PATCH",headers:{"Content-Type":"application/json"},body:JSON.stringify({displayName:e})})}catch{return{ok:!1,status:0,kind:"error",message:o("claim.saveError")}}if(i.status===200)return{ok:!0,player:await i.json()};if(i.status===409){let{code:r,message:n}=await $(i);return r==="already_claimed"?{ok:!1,status:409,kind:"already_claimed",message:n||o("claim.alreadyNamed")}:{ok:!1,status:409,kind:"taken",message:o("claim.nameTaken")}}return i.status===400?{ok:!1,status:400,kind:"empty",message:o("claim.enterName")}:{ok:!1,status:i.status,kind:"error",message:o("claim.saveError")}}},m=new S;function U(){return typeof window<"u"&&typeof window.matchMedia=="function"&&window.matchMedia("(prefers-reduced-motion: reduce)").matches}function b(s,t){if(U()||typeof window>"u"||!window.anime){typeof t.onComplete=="function"&&t.onComplete();return}let e=window.anime;typeof e.animate=="function"?e.animate(s,t):typeof e=="function"?e({targets:s,...t}):typeof t.onComplete=="function"&&t.onComplete()}function T(s){if(!s)return null;let t=new Date(s).getTime();return Number.isFinite(t)?t-Date.now():null}function v(s){return Date.now()+s}function B(s,t,e){let i=e-t;return i>0?N((s-t)/i*100):100}function I(s,t,e){let i=e-t;return i>0?N((e-s)/i*100):0}function N(s){return Math.max(0,Math.min(100,s))}function R(s,t){t.clearTimer();let e=s&&s.startedAt?new Date(s.startedAt).getTime():NaN,i=s&&s.expiresAt?new Date(s.expiresAt).getTime():NaN;if(!Number.isFinite(e)||!Number.isFinite(i)||i<=e){t.setRevealing(!1),t.setProgress(100);return}if(t.serverNow()<e){W(e,i,t);return}C(e,i,t)}function W(s,t,e){let i=e.serverNow();e.setRevealing(!0),e.setProgress(0);let r=()=>{let n=e.serverNow();if(n>=s){e.setProgress(100),e.clearTimer(),e.setRevealing(!1),C(s,t,e);return}e.setProgress(B(n,i,s))};r(),e.setTimer(setInterval(r,100))}function C(s,t,e){e.clearTimer(),e.setRevealing(!1);let i=()=>{let r=I(e.serverNow(),s,t);e.setProgress(r),r<=0&&e.clearTimer()};i(),!(I(e.serverNow(),s,t)<=0)&&e.setTimer(setInterval(i,100))}function x(s,t){return Math.max(0,Math.ceil((t-s)/1e3))}function L(s){let t=Math.max(0,Math.floor(s)),e=Math.floor(t/60),i=t%60;return`${e}:${String(i).padStart(2,"0")}`}function O(s,t){t.clearTimer();let e=s?new Date(s).getTime():NaN;if(!Number.isFinite(e)){t.setRemaining(0);return}let i=()=>{let r=x(t.serverNow(),e);t.setRemaining(r),r<=0&&t.clearTimer()};i(),!(x(t.serverNow(),e)<=0)&&t.setTimer(setInterval(i,250))}function A(s,{animate:t,ownsRow:e=null}={}){let i=Math.max(1,...s.map(n=>n.totalScore));return{rows:s.map(n=>({playerId:n.playerId,displayName:n.displayName,rank:n.rank,total:n.totalScore,preTotal:n.totalScore-n.roundScore,isMe:e?e(n):!1,displayTotal:t?n.totalScore-n.roundScore:n.totalScore,missedQuestions:n.missedQuestions||0,lateJoin:n.lateJoin||""})),maxTotal:i}}function E(s,t,e=900){let i=typeof window<"u"?window.anime:null;if(!s.some(n=>n.total!==n.preTotal)||!i){s.forEach(n=>{n.displayTotal=n.total});return}s.forEach(n=>{let a={v:n.preTotal};t(a,{v:n.total,duration:e,ease:"outCubic",onUpdate:()=>{n.displayTotal=Math.round(a.v)},onComplete:()=>{n.displayTotal=n.total}})})}function G(s,t){let e=new Map(t.map((r,n)=>[String(r),n])),i=t.length;return[...s].sort((r,n)=>{let a=e.has(String(r.playerId))?e.get(String(r.playerId)):i,c=e.has(String(n.playerId))?e.get(String(n.playerId)):i;return a-c})}function K(s){let t=new Map;return s&&s.querySelectorAll("[data-standings-row][data-player-id]").forEach(e=>{t.set(e.getAttribute("data-player-id"),e.getBoundingClientRect().top)}),t}function H(s,t,e,i=450){!s||!t||t.size===0||s.querySelectorAll("[data-standings-row][data-player-id]").forEach(r=>{let n=r.getAttribute("data-player-id"),a=t.get(n);if(a===void 0)return;let c=a-r.getBoundingClientRect().top;c!==0&&(r.style.transform=`translateY(${c}px)`,e(r,{translateY:[c,0],duration:i,ease:"inOutQuad",onComplete:()=>{r.style.transform=""}}))})}function J(s){if(typeof window<"u"&&typeof window.requestAnimationFrame=="function"){window.requestAnimationFrame(()=>s());return}setTimeout(s,16)}function F({rows:s,prevOrder:t,animate:e,runAnim:i,setBars:r,getBars:n,getContainer:a,afterRender:c,animateBars:l}){if(!e||!t||t.length===0){r(s),e&&l(n(),i);return}r(G(s,t)),c(()=>{let u=K(a());r(s),l(n(),i),J(()=>H(a(),u,i))})}var j=["btn-answer-tone-a","btn-answer-tone-b","btn-answer-tone-c","btn-answer-tone-d"];function q(s,t,{revealed:e=!1,correctIds:i=[],pickedId:r=null,highlightPick:n=!1}={}){if(e)return i.includes(s.id)?"btn-answer-correct":r===s.id?"btn-answer-wrong":"btn-answer-dim";let a=j[t%j.length];return n&&r===s.id?`btn-answer ${a} bg-surface-2 ring-2 ring-accent`:`btn-answer ${a}`}var p="topbanana.session";function h(){try{window.localStorage.removeItem(p)}catch{}}function _(){let s;try{s=window.localStorage.getItem(p)}catch{return null}if(!s)return null;try{let t=JSON.parse(s);if(t&&typeof t.code=="string"&&t.code!=="")return{code:t.code}}catch{}return h(),null}var V=/^\/join\/([^/]+)\/?$/,Y=3;function z(s){try{window.localStorage.setItem(p,JSON.stringify({code:s}))}catch{}}var y=class{constructor(){this.step="code",this.code="",this.codeInput="",this.displayName="",this.myDisplayName="",this.myPlayerId=null,this.player=null,this.busy=!1,this.error="",this.state=null,this.isReady=!1,this.sessionClosed=!1,this.eventSource=null,this.onVisible=null,this.accountName=null,this.clockOffset=0,this.questionProgress=100,this.questionTimer=null,this.revealing=!1,this.currentQuestionId=null,this.pickedOptionId=null,this.submitting=!1,this.answerError=!1,this.standingsBars=[],this.maxStandingsTotal=1,this.lastStandingsKey=null,this.lastStandingsOrder=null,this.startAt=null,this.startRemaining=0,this.startTimer=null,this.connectionTrouble=!1,this.stateFailures=0,this.stateSeq=0,this.reconnecting=!1,this.exitConfirmOpen=!1,this.exiting=!1,this.leftSent=!1,this.wakeLock=null,this.wakeLockHeld=!1,this.wakeLockGen=0,this.rootEl=null}async init(){this.rootEl=this.$root,window.addEventListener("beforeunload",()=>this.sendLeave()),window.addEventListener("pagehide",()=>this.sendLeave()),this.onVisible=a=>this.handleVisible(a),document.addEventListener("visibilitychange",this.onVisible),window.addEventListener("pageshow",this.onVisible),window.addEventListener("focus",this.onVisible);let t=await m.getMe();t&&(this.player=t,this.myPlayerId=t.id,t.isAuthenticated&&t.hasCustomName&&(this.accountName=t.displayName));let e=V.exec(window.location.pathname),i=e?decodeURIComponent(e[1]).toUpperCase():"",r=_(),n=r&&(!i||r.code===i)?r.code:"";if(!(n&&await this.tryResume(n))&&i){if(this.code=i,this.accountName){await this.autoJoin();return}this.step="name"}}async landInLobby(t){this.myDisplayName=t.displayName,this.isReady=t.isReady,this.step="lobby",this.leftSent=!1,z(this.code),this.acquireWakeLock(),await this.refreshState(),this.subscribe()}async autoJoin(){this.busy=!0,this.error="";try{let t=await d.join(this.code);if(!t.ok){if(t.kind==="closed"){this.enterClosedState();return}this.error=t.message,this.step=t.kind==="notFound"?"code":"name",t.kind==="notFound"&&(this.codeInput=this.code);return}await this.landInLobby(t)}finally{this.busy=!1}}async tryResume(t){let e;try{e=await d.join(t)}catch{return!1}return e.ok?(this.code=t,await this.landInLobby(e),!0):(h(),!1)}submitCode(){let t=(this.codeInput||"").trim().toUpperCase();if(t===""){this.error=o("join.enterCode");return}if(this.error="",this.code=t,this.accountName){this.autoJoin();return}this.step="name"}async submitName(){if(this.busy)return;let t=(this.displayName||"").trim();if(t===""){this.error=o("claim.enterName");return}this.busy=!0,this.error="";try{if(!(await this.claimName(t)).ok)return;let i=await d.join(this.code);if(!i.ok){if(i.kind==="closed"){this.enterClosedState();return}this.error=i.message,i.kind==="notFound"&&(this.step="code",this.codeInput=this.code);return}await this.landInLobby(i)}finally{this.busy=!1}}enterClosedState(){this.step="lobby",this.sessionClosed=!0,this.error="",h()}async claimName(t){let e=await m.claimName(t);if(e.ok)return this.accountName=e.player.displayName,this.myPlayerId=e.player.id,{ok:!0};if(e.kind==="already_claimed"){let i=await m.getMe();return i&&(this.accountName=i.displayName,this.myPlayerId=i.id),{ok:!0}}return this.error=e.message,{ok:!1}}async toggleReady(){if(this.busy)return;let t=!this.isReady;this.busy=!0,this.error="",this.isReady=t;try{await d.setReady(this.code,t)}catch{this.isReady=!t,this.error=o("join.readyError");return}finally{this.busy=!1}await this.refreshState()}async refreshState(){let t=++this.stateSeq,e;try{e=await d.getState(this.code)}catch{this.stateFailures+=1,this.stateFailures>=Y&&(this.connectionTrouble=!0);return}if(t===this.stateSeq){if(e===null){this.sessionClosed=!0,this.releaseWakeLock(),this.closeStream(),this.clearQuestionTimer(),this.clearStartTimer(),h();return}this.stateFailures=0,this.connectionTrouble=!1,this.state=e,this.syncClockFrom(e),this.syncReadyFromState(),this.syncQuestionFromState(),this.syncStartCountdownFromState(),this.syncStandingsFromState(),this.syncWakeLockFromState(e)}}syncWakeLockFromState(t){if(t.phase==="intermission"||t.phase==="finished"){this.releaseWakeLock();return}this.acquireWakeLock()}syncStartCountdownFromState(){let t=this.state?this.state.phase:null;if(this.startAt=t==="lobby"?this.state.startAt??null:null,!this.startAt){this.clearStartTimer(),this.startRemaining=0;return}O(this.startAt,{serverNow:()=>this.serverTime(),setRemaining:e=>{this.startRemaining=e},setTimer:e=>{this.startTimer=e},clearTimer:()=>this.clearStartTimer()})}clearStartTimer(){this.startTimer&&(clearInterval(this.startTimer),this.startTimer=null)}startArmed(){return!!this.startAt}startCountdownLabel(){return o("join.startingIn",{time:L(this.startRemaining)})}lobbyTitle(){return this.state&&this.state.quiz?this.state.quiz.title:o("join.getReady")}lobbyHasQuiz(){return!!(this.state&&this.state.quiz)}syncClockFrom(t){let e=T(t&&t.serverNow);e!==null&&(this.clockOffset=e)}serverTime(){return v(this.clockOffset)}syncQuestionFromState(){let t=this.state?this.state.question:null,e=this.state?this.state.phase:null,i=t?t.id:null;if(i!==this.currentQuestionId&&(this.currentQuestionId=i,this.pickedOptionId=null,this.answerError=!1),e==="question"&&t&&!this.hasAnswered()){this.startCountdown(t);return}this.clearQuestionTimer(),this.revealing=!1,e==="reveal"&&(this.questionProgress=0)}showsStandings(){let t=this.state?this.state.phase:null;return t==="round_results"||t==="intermission"||t==="finished"}syncStandingsFromState(){let t=this.state?this.state.phase:null,e=this.state&&Array.isArray(this.state.standings)?this.state.standings:null;if(!this.showsStandings()||!e){this.standingsBars=[],this.maxStandingsTotal=1,this.lastStandingsKey=null;return}let i=this.state.question?this.state.question.id:"none",r=`${t}:${i}`;if(r===this.lastStandingsKey)return;this.lastStandingsKey=r;let n=this.showsStandings(),{rows:a,maxTotal:c}=A(e,{animate:n,ownsRow:u=>this.ownsRow(u)});this.maxStandingsTotal=c;let l=this.lastStandingsOrder;this.lastStandingsOrder=a.map(u=>String(u.playerId)),F({rows:a,prevOrder:l,animate:n,runAnim:b,setBars:u=>{this.standingsBars=u},getBars:()=>this.standingsBars,getContainer:()=>this.standingsContainer(),afterRender:u=>this.$nextTick(u),animateBars:E})}standingsContainer(){return this.rootEl?this.rootEl.querySelector('[data-testid="standings-bars"]'):null}startCountdown(t){R(t,{serverNow:()=>this.serverTime(),setProgress:e=>{this.questionProgress=e},setRevealing:e=>{this.revealing=e},setTimer:e=>{this.questionTimer=e},clearTimer:()=>this.clearQuestionTimer()})}clearQuestionTimer(){this.questionTimer&&(clearInterval(this.questionTimer),this.questionTimer=null)}syncReadyFromState(){if(this.busy||!this.state||!Array.isArray(this.state.players))return;let t=this.state.players.find(e=>this.ownsRow(e));t&&(this.isReady=t.isReady,this.myDisplayName=t.displayName)}handleVisible(t){if(document.visibilityState==="visible"&&!(this.step!=="lobby"||!this.code)){if(t&&t.type==="pageshow"&&t.persisted){this.resumeAfterRestore();return}this.sessionClosed||(this.refreshState(),this.streamDropped()&&this.subscribe(),this.acquireWakeLock())}}async resumeAfterRestore(){this.sessionClosed=!1,!await this.tryResume(this.code)&&(await this.refreshState(),this.streamDropped()&&this.subscribe(),this.acquireWakeLock())}streamDropped(){return!this.eventSource||this.eventSource.readyState===EventSource.CLOSED}async reconnectNow(){if(!this.reconnecting&&!(this.step!=="lobby"||!this.code)){this.reconnecting=!0;try{this.subscribe(),await this.refreshState()}finally{this.reconnecting=!1}}}subscribe(){if(this.closeStream(),typeof EventSource>"u")return;let t=`/api/sessions/${encodeURIComponent(this.code)}/events`,e=new EventSource(t);e.onmessage=()=>{this.refreshState()},e.onerror=()=>{e.readyState===EventSource.CLOSED&&(this.eventSource=null)},this.eventSource=e}closeStream(){this.eventSource&&(this.eventSource.close(),this.eventSource=null)}sendLeave(){this.closeStream(),this.clearQuestionTimer(),this.clearStartTimer(),this.releaseWakeLock(),this.step==="lobby"&&this.code&&!this.leftSent&&(this.leftSent=!0,d.leave(this.code))}async exitSession(){if(this.exiting)return;this.exiting=!0,this.closeStream(),this.clearQuestionTimer(),this.clearStartTimer(),this.releaseWakeLock(),h();let t=this.code;this.leftSent=!0;try{await fetch(`/api/sessions/${encodeURIComponent(t)}/leave`,{method:"POST",keepalive:!0})}catch{}this.exitConfirmOpen=!1,window.location.assign("/join")}acquireWakeLock(){if(typeof navigator>"u"||!("wakeLock"in navigator)||this.wakeLockHeld)return;this.wakeLockHeld=!0;let t=++this.wakeLockGen;navigator.wakeLock.request("screen").then(e=>{if(t!==this.wakeLockGen){e.release().catch(()=>{});return}this.wakeLock=e,e.addEventListener("release",()=>{t===this.wakeLockGen&&(this.wakeLock=null,this.wakeLockHeld=!1)})}).catch(()=>{t===this.wakeLockGen&&(this.wakeLockHeld=!1)})}releaseWakeLock(){this.wakeLockGen+=1,this.wakeLockHeld=!1;let t=this.wakeLock;this.wakeLock=null,t&&t.release().catch(()=>{})}isHost(t){return!!this.state&&t.playerId===this.state.hostId}isMe(t){return this.ownsRow(t)}ownsRow(t){return this.myPlayerId!==null?t.playerId===this.myPlayerId:t.displayName===this.myDisplayName}isAuthenticated(){return!!(this.player&&this.player.isAuthenticated)}inActiveQuestion(){let t=this.state?this.state.phase:null;return this.step==="lobby"&&(t==="question"||t==="reveal")}currentQuestion(){return this.state?this.state.question:null}currentRound(){return this.state?this.state.round:null}roundEyebrow(){let t=this.currentRound();return t&&t.number>0&&t.total>0?o("join.roundNof",{number:t.number,total:t.total}):o("join.getReady")}roundTitle(){let t=this.currentRound();return t&&t.title?t.title:o("join.nextRound")}roundSummary(){let t=this.currentRound();return t&&t.summary?t.summary:""}hasAnswered(){return this.pickedOptionId!==null}async submitAnswer(t){if(this.submitting||this.hasAnswered())return;let e=this.currentQuestion();if(!(!this.state||this.state.phase!=="question"||!e)){this.submitting=!0,this.answerError=!1,this.pickedOptionId=t,this.clearQuestionTimer();try{let i=await d.answer(this.code,t);if(!i.ok&&i.kind==="closed")return}catch{this.pickedOptionId=null,this.answerError=!0,this.startCountdown(e)}finally{this.submitting=!1}}}correctOptionIds(){let t=this.currentQuestion();return t&&Array.isArray(t.correctOptionIds)?t.correctOptionIds:[]}isRevealed(){return!!this.state&&this.state.phase==="reveal"}pickWasCorrect(){return this.pickedOptionId===null?!1:this.correctOptionIds().includes(this.pickedOptionId)}optionStateClass(t,e){return q(t,e,{revealed:this.isRevealed(),correctIds:this.correctOptionIds(),pickedId:this.pickedOptionId,highlightPick:!0})}};var X=["a[href]","button:not([disabled])","input:not([disabled])","select:not([disabled])","textarea:not([disabled])",'[tabindex]:not([tabindex="-1"])'].join(",");function P(s){return Array.from(s.querySelectorAll(X)).filter(t=>t.getClientRects().length>0)}function Z(s){let t=null;function e(i){if(i.key!=="Tab")return;let r=P(s);if(r.length===0){i.preventDefault();return}let n=r[0],a=r[r.length-1],c=document.activeElement;i.shiftKey?(c===n||!s.contains(c))&&(i.preventDefault(),a.focus()):(c===a||!s.contains(c))&&(i.preventDefault(),n.focus())}return{activate(){t=document.activeElement,s.addEventListener("keydown",e);let i=s.querySelector("[data-autofocus]")||P(s)[0];i&&i.focus()},deactivate(){s.removeEventListener("keydown",e),t&&document.contains(t)&&typeof t.focus=="function"&&t.focus(),t=null}}}function M(s){s.directive("focus-trap",(t,{expression:e},{effect:i,evaluateLater:r,cleanup:n})=>{let a=Z(t),c=r(e),l=!1;i(()=>{c(u=>{u&&!l?(l=!0,requestAnimationFrame(()=>{l&&a.activate()})):!u&&l&&(l=!1,a.deactivate())})}),n(()=>{l&&(l=!1,a.deactivate())})})}document.addEventListener("alpine:init",()=>{Alpine.data("joinApp",()=>new y),M(Alpine),k(Alpine)});
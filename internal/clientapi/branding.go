@@ -0,0 +1,28 @@
+package clientapi
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/branding"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// HandleBranding returns the deployment branding the request context carries
+// (see branding.Service.Middleware). It needs no player: the branding is
+// public, and a client fetches it before anything else on the page.
+func HandleBranding() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b := branding.FromContext(r.Context())
+		res := client.Branding{
+			Name:         b.DisplayName(),
+			LogoURL:      b.LogoURL,
+			PrimaryColor: b.PrimaryColor,
+		}
+		if err := handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger := handlers.LoggerFromContext(r.Context())
+			logger.ErrorContext(r.Context(), "error encoding branding response", slog.Any("err", err))
+		}
+	})
+}
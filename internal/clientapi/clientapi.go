@@ -20,6 +20,7 @@ import (
 	"github.com/starquake/topbanana/internal/handlers"
 	"github.com/starquake/topbanana/internal/leaderboard"
 	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/pkg/client"
 )
 
 // writeInternalError records an internal failure and writes a generic
@@ -33,15 +34,16 @@ import (
 // client sees is "internal error" with the appropriate status.
 func writeInternalError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, msg string, err error) {
 	logger.ErrorContext(r.Context(), msg, slog.Any("err", err))
-	http.Error(w, "internal error", http.StatusInternalServerError)
+	handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 }
 
-// writeClaimNameError writes a small JSON error body for the
-// PATCH /api/players/me handler. The client (PlayerService.claimName)
-// branches on `code` to differentiate "name already in use" from
-// "this account is already non-anonymous" (#289). Falls back to the
-// plain-text message on encode failure so the client at least sees a
-// status + body it can render.
+// writeClaimNameError writes the error for the PATCH /api/players/me
+// handler. The client (PlayerService.claimName) branches on `code` to
+// differentiate "name already in use" from "this account is already
+// non-anonymous" (#289). A legacy-shape request gets the pre-envelope
+// {code, message} body with the snake_case code the bundled client still
+// matches on; it falls back to the plain-text message on encode failure so
+// the client at least sees a status + body it can render.
 func writeClaimNameError(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -49,16 +51,31 @@ func writeClaimNameError(
 	status int,
 	code, message string,
 ) {
+	if handlers.Enveloped(r) {
+		handlers.WriteErrorCode(w, r, status, code, message)
+
+		return
+	}
+
 	body := struct {
 		Code    string `json:"code"`
 		Message string `json:"message"`
-	}{Code: code, Message: message}
+	}{Code: legacyClaimNameCodes[code], Message: message}
 	if err := handlers.EncodeJSON(w, status, body); err != nil {
 		logger.ErrorContext(r.Context(), "error encoding claimNameError", slog.Any("err", err))
 		http.Error(w, message, status)
 	}
 }
 
+// legacyClaimNameCodes maps the claim-name error codes to the snake_case
+// spelling of the pre-envelope response. Goes away with the legacy shapes.
+var legacyClaimNameCodes = map[string]string{
+	"displayNameTooLong":  "display_name_too_long",
+	"displayNameTaken":    "display_name_taken",
+	"alreadyClaimed":      "already_claimed",
+	"displayNameRequired": "display_name_required",
+}
+
 // gameRequest extracts the gameID path parameter and the session player
 // off the request. Every /api/games/{gameID}/* handler runs this gate
 // once at the top of its closure so the participant check (#272) and
@@ -74,7 +91,7 @@ func gameRequest(w http.ResponseWriter, r *http.Request, logger *slog.Logger) (s
 		// User-supplied 4xx - log at Info so the response carries the
 		// signal, not an alert-triggering ERROR (#369).
 		logger.InfoContext(r.Context(), "missing gameID in request path")
-		http.Error(w, "missing gameID", http.StatusBadRequest)
+		handlers.WriteError(w, r, http.StatusBadRequest, "missing gameID")
 
 		return "", 0, false
 	}
@@ -82,7 +99,7 @@ func gameRequest(w http.ResponseWriter, r *http.Request, logger *slog.Logger) (s
 	p, ok := auth.PlayerFromContext(r.Context())
 	if !ok {
 		logger.ErrorContext(r.Context(), "missing player on context for game request")
-		http.Error(w, "internal error", http.StatusInternalServerError)
+		handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 		return "", 0, false
 	}
@@ -90,33 +107,63 @@ func gameRequest(w http.ResponseWriter, r *http.Request, logger *slog.Logger) (s
 	return gameID, p.ID, true
 }
 
+// defaultQuizPageSize is the GET /api/quizzes page size when the request names
+// a page but no pageSize; maxQuizPageSize caps a larger pageSize.
+const (
+	defaultQuizPageSize = 20
+	maxQuizPageSize     = 100
+)
+
+// Errors returned by parseQuizListPage; their text is the 400 body.
+var (
+	errInvalidPage     = errors.New("page must be a positive integer")
+	errInvalidPageSize = errors.New("pageSize must be a positive integer")
+)
+
 // HandleQuizList returns a list of quizzes. Only visibility=public rows
 // surface - unlisted is link-only and private is gated per-request at
 // the GetQuiz path, neither of which fits a list (#103).
+//
+// With ?page= and/or ?pageSize= the response is one page of that list and the
+// envelope meta carries the total, page and pageSize so a client can render
+// its own navigation. Without either the whole list comes back, as before.
+// ?q= narrows the list to quizzes whose title, description or slug match
+// every word of it, best match first; a search is always paged, on page 1 of
+// [defaultQuizPageSize] unless the request says otherwise. Returns 400 for a page or pageSize that is not a positive integer.
 func HandleQuizList(logger *slog.Logger, quizStore quiz.Store) http.Handler {
-	type quizResponse struct {
-		ID          int64     `json:"id"`
-		Title       string    `json:"title"`
-		Slug        string    `json:"slug"`
-		Description string    `json:"description"`
-		CreatedAt   time.Time `json:"createdAt"`
-	}
-
-	type quizzesResponse []quizResponse
-
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var err error
+		pg, paged, err := parseQuizListPage(r)
+		if err != nil {
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 
-		quizzes, err := quizStore.ListPublicQuizzes(r.Context())
+			return
+		}
+
+		var (
+			quizzes []*quiz.Quiz
+			meta    client.ListMeta
+		)
+		query := strings.TrimSpace(r.URL.Query().Get("q"))
+		switch {
+		case query != "":
+			if !paged {
+				pg = quizListPage{page: 1, pageSize: defaultQuizPageSize}
+			}
+			quizzes, meta, err = searchPublicQuizzesPage(r.Context(), quizStore, query, pg)
+		case paged:
+			quizzes, meta, err = listPublicQuizzesPage(r.Context(), quizStore, pg)
+		default:
+			quizzes, err = quizStore.ListPublicQuizzes(r.Context())
+		}
 		if err != nil {
 			writeInternalError(w, r, logger, "error retrieving quizzes from store", err)
 
 			return
 		}
 
-		res := make(quizzesResponse, 0, len(quizzes))
+		res := make([]client.Quiz, 0, len(quizzes))
 		for _, qz := range quizzes {
-			qzr := quizResponse{
+			qzr := client.Quiz{
 				ID:          qz.ID,
 				Title:       qz.Title,
 				Slug:        qz.Slug,
@@ -125,16 +172,90 @@ func HandleQuizList(logger *slog.Logger, quizStore quiz.Store) http.Handler {
 			}
 			res = append(res, qzr)
 		}
+		meta.Count = len(res)
 
-		err = handlers.EncodeJSON(w, http.StatusOK, res)
+		err = handlers.WriteDataMeta(w, r, http.StatusOK, res, meta)
 		if err != nil {
-			logger.ErrorContext(r.Context(), "error encoding quizzesResponse", slog.Any("err", err))
+			logger.ErrorContext(r.Context(), "error encoding quiz list", slog.Any("err", err))
 
 			return
 		}
 	})
 }
 
+// quizListPage is a parsed GET /api/quizzes page request.
+type quizListPage struct {
+	page     int
+	pageSize int
+}
+
+// parseQuizListPage reads ?page= and ?pageSize=. paged is false when neither
+// is set, which keeps the unpaged response. A missing page defaults to 1, a
+// missing pageSize to [defaultQuizPageSize], and a pageSize above
+// [maxQuizPageSize] is clamped to it.
+func parseQuizListPage(r *http.Request) (quizListPage, bool, error) {
+	q := r.URL.Query()
+	rawPage, rawSize := q.Get("page"), q.Get("pageSize")
+	if rawPage == "" && rawSize == "" {
+		return quizListPage{}, false, nil
+	}
+
+	pg := quizListPage{page: 1, pageSize: defaultQuizPageSize}
+	if rawPage != "" {
+		n, err := strconv.Atoi(rawPage)
+		if err != nil || n < 1 {
+			return quizListPage{}, false, errInvalidPage
+		}
+		pg.page = n
+	}
+	if rawSize != "" {
+		n, err := strconv.Atoi(rawSize)
+		if err != nil || n < 1 {
+			return quizListPage{}, false, errInvalidPageSize
+		}
+		pg.pageSize = min(n, maxQuizPageSize)
+	}
+
+	return pg, true, nil
+}
+
+// listPublicQuizzesPage reads one page of the public quiz list plus its total
+// and returns them with the paging half of the list meta filled in. A page
+// past the end is an empty page, not an error, so a client that overshoots
+// still learns the total.
+func listPublicQuizzesPage(
+	ctx context.Context, quizStore quiz.Store, pg quizListPage,
+) ([]*quiz.Quiz, client.ListMeta, error) {
+	total, err := quizStore.CountPublicQuizzes(ctx)
+	if err != nil {
+		return nil, client.ListMeta{}, fmt.Errorf("count public quizzes: %w", err)
+	}
+	offset := int64(pg.page-1) * int64(pg.pageSize)
+	quizzes, err := quizStore.ListPublicQuizzesPage(ctx, int64(pg.pageSize), offset)
+	if err != nil {
+		return nil, client.ListMeta{}, fmt.Errorf("list public quizzes page: %w", err)
+	}
+
+	return quizzes, client.ListMeta{Total: total, Page: pg.page, PageSize: pg.pageSize}, nil
+}
+
+// searchPublicQuizzesPage is [listPublicQuizzesPage] for a ?q= search: one
+// page of the public quizzes matching query, with the match total.
+func searchPublicQuizzesPage(
+	ctx context.Context, quizStore quiz.Store, query string, pg quizListPage,
+) ([]*quiz.Quiz, client.ListMeta, error) {
+	quizzes, total, err := quizStore.SearchQuizzes(ctx, query, quiz.SearchOptions{
+		PublicOnly: true,
+		Limit:      int64(pg.pageSize),
+		Offset:     int64(pg.page-1) * int64(pg.pageSize),
+	})
+	if err != nil {
+		return nil, client.ListMeta{}, fmt.Errorf("search public quizzes: %w", err)
+	}
+
+	return quizzes, client.ListMeta{Total: total, Page: pg.page, PageSize: pg.pageSize}, nil
+}
+
 // canReadQuiz applies the #103 visibility gate. Public and unlisted are
 // reachable by anyone (unlisted requires guessing the slug+ID, which is
 // out of scope for this ticket); private requires an authenticated
@@ -147,7 +268,7 @@ func canReadQuiz(w http.ResponseWriter, r *http.Request, visibility string) bool
 	}
 	p, ok := auth.PlayerFromContext(r.Context())
 	if !ok || p.IsAnonymous() {
-		http.NotFound(w, r)
+		handlers.NotFound(w, r)
 
 		return false
 	}
@@ -167,7 +288,7 @@ func gateQuizRead(
 	visibility, err := service.GetQuizVisibility(r.Context(), quizID)
 	if err != nil {
 		if errors.Is(err, quiz.ErrQuizNotFound) {
-			http.NotFound(w, r)
+			handlers.NotFound(w, r)
 
 			return false
 		}
@@ -190,7 +311,7 @@ func gatePreviewOwner(
 	qz, err := service.GetQuiz(r.Context(), quizID)
 	if err != nil {
 		if errors.Is(err, quiz.ErrQuizNotFound) {
-			http.NotFound(w, r)
+			handlers.NotFound(w, r)
 
 			return nil, false
 		}
@@ -200,7 +321,7 @@ func gatePreviewOwner(
 	}
 
 	if !player.IsAdmin() && player.ID != qz.CreatedByPlayerID {
-		http.NotFound(w, r)
+		handlers.NotFound(w, r)
 
 		return nil, false
 	}
@@ -227,32 +348,8 @@ func mediaURL(mediaID *int64) string {
 // N - is carried separately on currentPlayer below (#181).
 const leaderboardLimit = 10
 
-// quizLeaderboardEntryResponse is one row of the leaderboard wire shape.
-// Declared at package scope so both HandleQuizLeaderboard and
-// HandleQuizLeaderboardStream can build it.
-//
-// InProgress is true when the player is still mid-quiz: Score may be a
-// running partial total (#244) or zero if the player has clicked Start
-// but not yet submitted their first answer (#335).
-type quizLeaderboardEntryResponse struct {
-	PlayerID        int64  `json:"playerId"`
-	DisplayName     string `json:"displayName"`
-	Score           int    `json:"score"`
-	Rank            int    `json:"rank"`
-	IsCurrentPlayer bool   `json:"isCurrentPlayer"`
-	InProgress      bool   `json:"inProgress"`
-}
-
-// quizLeaderboardResponse is the full leaderboard wire shape. The SSE
-// endpoint sends one of these per event; the REST endpoint sends one.
-type quizLeaderboardResponse struct {
-	QuizID        int64                          `json:"quizId"`
-	Entries       []quizLeaderboardEntryResponse `json:"entries"`
-	CurrentPlayer *quizLeaderboardEntryResponse  `json:"currentPlayer"`
-}
-
-func toEntryResponse(e game.LeaderboardEntry) quizLeaderboardEntryResponse {
-	return quizLeaderboardEntryResponse{
+func toEntryResponse(e game.LeaderboardEntry) client.LeaderboardEntry {
+	return client.LeaderboardEntry{
 		PlayerID:        e.PlayerID,
 		DisplayName:     e.DisplayName,
 		Score:           e.Score,
@@ -272,18 +369,18 @@ func fetchQuizLeaderboard(
 	ctx context.Context,
 	service *game.Service,
 	quizID, playerID int64,
-) (quizLeaderboardResponse, error) {
+) (client.Leaderboard, error) {
 	result, err := service.GetQuizLeaderboard(ctx, quizID, playerID, leaderboardLimit)
 	if err != nil {
-		return quizLeaderboardResponse{}, fmt.Errorf("fetch quiz leaderboard: %w", err)
+		return client.Leaderboard{}, fmt.Errorf("fetch quiz leaderboard: %w", err)
 	}
 
-	respEntries := make([]quizLeaderboardEntryResponse, 0, len(result.Entries))
+	respEntries := make([]client.LeaderboardEntry, 0, len(result.Entries))
 	for _, e := range result.Entries {
 		respEntries = append(respEntries, toEntryResponse(e))
 	}
 
-	res := quizLeaderboardResponse{QuizID: quizID, Entries: respEntries}
+	res := client.Leaderboard{QuizID: quizID, Entries: respEntries}
 	if result.CurrentPlayer != nil {
 		cp := toEntryResponse(*result.CurrentPlayer)
 		res.CurrentPlayer = &cp
@@ -303,7 +400,7 @@ func writeQuizLeaderboardError(
 	err error,
 ) {
 	if errors.Is(err, quiz.ErrQuizNotFound) {
-		http.NotFound(w, r)
+		handlers.NotFound(w, r)
 
 		return
 	}
@@ -322,7 +419,7 @@ func HandleQuizMeta(logger *slog.Logger, service *game.Service) http.Handler {
 		Slug        string    `json:"slug"`
 		Description string    `json:"description"`
 		CreatedAt   time.Time `json:"createdAt"`
-		Mode        string    `json:"mode"`
+		Mode        quiz.Mode `json:"mode"`
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -336,7 +433,7 @@ func HandleQuizMeta(logger *slog.Logger, service *game.Service) http.Handler {
 		qz, err := service.GetQuizMeta(ctx, quizID)
 		if err != nil {
 			if errors.Is(err, quiz.ErrQuizNotFound) {
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 
 				return
 			}
@@ -348,7 +445,7 @@ func HandleQuizMeta(logger *slog.Logger, service *game.Service) http.Handler {
 		// Draft and live quizzes are not solo-deep-link playable; 404 keeps
 		// them indistinguishable from a missing quiz (#1192/#677).
 		if !qz.Published || qz.Mode == quiz.ModeLive {
-			http.NotFound(w, r)
+			handlers.NotFound(w, r)
 
 			return
 		}
@@ -366,7 +463,7 @@ func HandleQuizMeta(logger *slog.Logger, service *game.Service) http.Handler {
 			Mode:        qz.Mode,
 		}
 
-		if err := handlers.EncodeJSON(w, http.StatusOK, res); err != nil {
+		if err := handlers.WriteData(w, r, http.StatusOK, res); err != nil {
 			logger.ErrorContext(ctx, "error encoding quizMetaResponse", slog.Any("err", err))
 
 			return
@@ -402,7 +499,7 @@ func HandleQuizLeaderboard(logger *slog.Logger, service *game.Service) http.Hand
 			// EnsurePlayer middleware should have populated this; reaching
 			// here means the route was wired without it.
 			logger.ErrorContext(ctx, "missing player on context for quiz leaderboard")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -414,7 +511,7 @@ func HandleQuizLeaderboard(logger *slog.Logger, service *game.Service) http.Hand
 			return
 		}
 
-		if err := handlers.EncodeJSON(w, http.StatusOK, res); err != nil {
+		if err := handlers.WriteData(w, r, http.StatusOK, res); err != nil {
 			logger.ErrorContext(ctx, "error encoding leaderboardResponse", slog.Any("err", err))
 
 			return
@@ -439,7 +536,7 @@ type leaderboardStreamer struct {
 // `data:` frame and flushes. Returns false on any write/flush failure
 // (client disconnected, broken pipe, encoding error) so the caller can
 // exit the stream loop cleanly.
-func (s *leaderboardStreamer) writeEvent(ctx context.Context, res quizLeaderboardResponse) bool {
+func (s *leaderboardStreamer) writeEvent(ctx context.Context, res client.Leaderboard) bool {
 	payload, err := json.Marshal(res)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "error marshalling leaderboard event", slog.Any("err", err))
@@ -530,7 +627,7 @@ func HandleQuizLeaderboardStream(
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for leaderboard stream")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -639,24 +736,14 @@ func (s *leaderboardStreamer) run(ctx context.Context, events <-chan struct{}) {
 // resolve.
 // Returns 500 if an error occurs.
 func HandleCreateGame(logger *slog.Logger, service *game.Service) http.Handler {
-	type createGameRequest struct {
-		QuizID int64 `json:"quizId"`
-		// Preview requests an owner preview game that stays off the leaderboard (#1192).
-		Preview bool `json:"preview"`
-	}
-
-	type createGameResponse struct {
-		ID string `json:"id"`
-	}
-
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		var err error
-		var req createGameRequest
-		req, err = handlers.DecodeJSON[createGameRequest](w, r)
+		var req client.CreateGameRequest
+		req, err = handlers.DecodeJSON[client.CreateGameRequest](w, r)
 		if err != nil {
-			logger.ErrorContext(ctx, "error decoding createGameRequest", slog.Any("err", err))
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			logger.ErrorContext(ctx, "error decoding create game request", slog.Any("err", err))
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 
 			return
 		}
@@ -666,7 +753,7 @@ func HandleCreateGame(logger *slog.Logger, service *game.Service) http.Handler {
 			// EnsurePlayer middleware should have populated this; reaching
 			// here means the route was wired without it.
 			logger.ErrorContext(ctx, "missing player on context for create game")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -685,32 +772,59 @@ func HandleCreateGame(logger *slog.Logger, service *game.Service) http.Handler {
 		} else {
 			g, err = service.CreateGame(ctx, req.QuizID, player.ID, false)
 		}
+		if errors.Is(err, game.ErrGameAlreadyExists) {
+			writeExistingGame(w, r, logger, service, req.QuizID, player.ID)
+
+			return
+		}
 		if err != nil {
 			writeCreateGameError(w, r, logger, err)
 
 			return
 		}
-		res := createGameResponse{ID: g.ID}
+		res := client.CreateGameResponse{ID: g.ID}
 
 		w.Header().Set("Location", fmt.Sprintf("/play/game/%v", g.ID))
-		err = handlers.EncodeJSON(w, http.StatusCreated, res)
+		err = handlers.WriteData(w, r, http.StatusCreated, res)
 		if err != nil {
-			logger.ErrorContext(r.Context(), "error encoding createGameResponse", slog.Any("err", err))
+			logger.ErrorContext(r.Context(), "error encoding create game response", slog.Any("err", err))
 
 			return
 		}
 	})
 }
 
+// writeExistingGame answers a create that lost to an existing game (a retry
+// or a two-tab race) with a 409 carrying the existing game's ID and
+// Location, so the client resumes it instead of guessing (#287). When the
+// existing game cannot be resolved (e.g. it is an owner preview, which never
+// resumes) it falls back to the plain 409.
+func writeExistingGame(
+	w http.ResponseWriter, r *http.Request, logger *slog.Logger, service *game.Service, quizID, playerID int64,
+) {
+	g, err := service.GetGameForPlayerOnQuiz(r.Context(), playerID, quizID)
+	if err != nil {
+		logger.InfoContext(r.Context(), "existing game not resolvable after create conflict", slog.Any("err", err))
+		writeCreateGameError(w, r, logger, game.ErrGameAlreadyExists)
+
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/play/game/%v", g.ID))
+	if err = handlers.WriteData(w, r, http.StatusConflict, client.CreateGameResponse{ID: g.ID}); err != nil {
+		logger.ErrorContext(r.Context(), "error encoding existing game response", slog.Any("err", err))
+	}
+}
+
 // writeCreateGameError maps a [game.Service.CreateGame] failure to the right HTTP status: 404 (opaque), 403 (disallowed preview), 409 (existing game), else 500.
 func writeCreateGameError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, err error) {
 	switch {
 	case errors.Is(err, quiz.ErrQuizNotFound):
-		http.NotFound(w, r)
+		handlers.NotFound(w, r)
 	case errors.Is(err, game.ErrPreviewNotAllowed):
-		http.Error(w, "this quiz cannot be previewed", http.StatusForbidden)
+		handlers.WriteError(w, r, http.StatusForbidden, "this quiz cannot be previewed")
 	case errors.Is(err, game.ErrGameAlreadyExists):
-		http.Error(w, err.Error(), http.StatusConflict)
+		handlers.WriteError(w, r, http.StatusConflict, err.Error())
 	default:
 		writeInternalError(w, r, logger, "error creating game", err)
 	}
@@ -720,13 +834,9 @@ func writeCreateGameError(w http.ResponseWriter, r *http.Request, logger *slog.L
 // continue an existing game based on the response. `completed` is true
 // only when every question has been issued AND none is in its answer
 // window, so a reload on the final question resumes there instead of
-// jumping to the post-game leaderboard (#310).
+// jumping to the post-game leaderboard (#310). A finished or abandoned game
+// is always completed.
 func HandleGameForQuiz(logger *slog.Logger, service *game.Service) http.Handler {
-	type gameForQuizResponse struct {
-		GameID    string `json:"gameId"`
-		Completed bool   `json:"completed"`
-	}
-
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
@@ -742,7 +852,7 @@ func HandleGameForQuiz(logger *slog.Logger, service *game.Service) http.Handler
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for game-for-quiz")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -750,7 +860,7 @@ func HandleGameForQuiz(logger *slog.Logger, service *game.Service) http.Handler
 		g, err := service.GetGameForPlayerOnQuiz(ctx, player.ID, quizID)
 		if err != nil {
 			if errors.Is(err, quiz.ErrQuizNotFound) || errors.Is(err, game.ErrGameNotFound) {
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 
 				return
 			}
@@ -759,91 +869,16 @@ func HandleGameForQuiz(logger *slog.Logger, service *game.Service) http.Handler
 			return
 		}
 
-		res := gameForQuizResponse{GameID: g.ID, Completed: g.IsCompleted() && !g.HasOpenQuestion()}
+		res := client.GameForQuiz{GameID: g.ID, Completed: g.IsFinished() || (g.IsCompleted() && !g.HasOpenQuestion())}
 
-		if err = handlers.EncodeJSON(w, http.StatusOK, res); err != nil {
-			logger.ErrorContext(ctx, "error encoding gameForQuizResponse", slog.Any("err", err))
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(ctx, "error encoding game for quiz", slog.Any("err", err))
 
 			return
 		}
 	})
 }
 
-// nextOptionResponse is one option on the `type=question` /next
-// variant. Hoisted to package scope so the handler can stay under the
-// revive function-length budget (#167 slice 2).
-type nextOptionResponse struct {
-	ID   int64  `json:"id"`
-	Text string `json:"text"`
-}
-
-// nextQuestionResponse is the wire shape for the `type=question`
-// /next variant. Position/Total drive the HUD chip (#253); ServerNow
-// drives the client clock-offset correction (#180).
-type nextQuestionResponse struct {
-	Type        string               `json:"type"`
-	ID          int64                `json:"id"`
-	Text        string               `json:"text"`
-	ImageURL    string               `json:"imageUrl,omitempty"`
-	AudioURL    string               `json:"audioUrl,omitempty"`
-	AudioRepeat bool                 `json:"audioRepeat,omitempty"`
-	Options     []nextOptionResponse `json:"options"`
-	StartedAt   time.Time            `json:"startedAt"`
-	ExpiredAt   time.Time            `json:"expiredAt"`
-	ServerNow   time.Time            `json:"serverNow"`
-	Position    int                  `json:"position"`
-	Total       int                  `json:"total"`
-	// RoundNumber/RoundTotal place the question's round within the quiz,
-	// and RoundPosition/RoundQuestions place the question within that
-	// round, for the gameplay header's "Round N of M" heading and its
-	// per-round "Q n / m" chip.
-	RoundNumber    int `json:"roundNumber"`
-	RoundTotal     int `json:"roundTotal"`
-	RoundPosition  int `json:"roundPosition"`
-	RoundQuestions int `json:"roundQuestions"`
-}
-
-// nextRoundIntroResponse is the wire shape for the intro phase of the
-// `type=round_boundary` /next variant (#548). It is emitted before a
-// round's first question and carries the round title + summary so the
-// client can show what is coming. StartedAt/ExpiredAt bound a countdown
-// one quiz-default answer duration long so the client auto-advances the
-// card when it expires; Total keeps the HUD chip rendering across the
-// boundary. No score is carried at the intro.
-type nextRoundIntroResponse struct {
-	Type      string    `json:"type"`
-	Phase     string    `json:"phase"`
-	ID        int64     `json:"id"`
-	Title     string    `json:"title"`
-	Summary   string    `json:"summary"`
-	StartedAt time.Time `json:"startedAt"`
-	ExpiredAt time.Time `json:"expiredAt"`
-	ServerNow time.Time `json:"serverNow"`
-	Total     int       `json:"total"`
-}
-
-// nextRoundResultsResponse is the wire shape for the results phase of
-// the `type=round_boundary` /next variant (#548). It is emitted after a
-// round's questions and carries the player's own recap for the round:
-// Score is the running game total, RoundScore the points earned for
-// this round, and RoundCorrect of RoundQuestions the questions answered
-// correctly in this round. There is deliberately no cross-player
-// leaderboard here - the recap is self-referential only.
-type nextRoundResultsResponse struct {
-	Type           string    `json:"type"`
-	Phase          string    `json:"phase"`
-	ID             int64     `json:"id"`
-	Title          string    `json:"title"`
-	Score          int       `json:"score"`
-	RoundScore     int       `json:"roundScore"`
-	RoundCorrect   int       `json:"roundCorrect"`
-	RoundQuestions int       `json:"roundQuestions"`
-	StartedAt      time.Time `json:"startedAt"`
-	ExpiredAt      time.Time `json:"expiredAt"`
-	ServerNow      time.Time `json:"serverNow"`
-	Total          int       `json:"total"`
-}
-
 // HandleQuestionNext returns the next item in the play sequence as a
 // tagged union (`type: "question"` | `"round_boundary"`). Total counts
 // quiz questions, not items, so a round boundary does not bump the HUD
@@ -864,43 +899,60 @@ func HandleQuestionNext(logger *slog.Logger, service *game.Service) http.Handler
 			return
 		}
 
-		if item.Type == game.ItemTypeRoundBoundary {
-			writeRoundBoundaryItem(w, r, logger, item)
-
-			return
+		res := nextItemResponse(r.Context(), gameID, item, service.Now())
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding next item", slog.Any("err", err))
 		}
-		writeQuestionItem(w, r, logger, gameID, item.Question)
 	})
 }
 
+// nextItemResponse projects a [game.Item] onto its /next wire variant. Shared
+// by HandleQuestionNext and the prefetch on [HandleAnswerPost] so both hand
+// out the same shape for the same item.
+func nextItemResponse(ctx context.Context, gameID string, item *game.Item, now time.Time) any {
+	if item.Type == game.ItemTypeRoundBoundary {
+		return roundBoundaryResponse(item, now)
+	}
+
+	return questionResponse(ctx, gameID, item.Question, now)
+}
+
 // writeGetNextError translates the sentinels returned by
 // [game.Service.GetNext] into the right HTTP status so HandleQuestionNext
 // stays under revive's function-length limit. Errors that should be
-// observable to the player (missing game, exhausted quiz) map to 404;
+// observable to the player (missing game, exhausted quiz) map to 404, a
+// finished or abandoned game with questions left to 410, a question asked
+// for inside the minimum interval to 429 with Retry-After in whole seconds;
 // anything else surfaces as a generic 500 with the wrapped error in
 // the operator log (#274).
 func writeGetNextError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, err error) {
+	var tooSoon *game.TooSoonError
 	switch {
 	case errors.Is(err, game.ErrGameNotFound),
 		errors.Is(err, quiz.ErrQuizNotFound),
 		errors.Is(err, game.ErrNoMoreQuestions):
-		http.NotFound(w, r)
+		handlers.NotFound(w, r)
+	case errors.Is(err, game.ErrGameFinished):
+		handlers.WriteError(w, r, http.StatusGone, err.Error())
+	case errors.As(err, &tooSoon):
+		seconds := max(int((tooSoon.RetryAfter+time.Second-1)/time.Second), 1)
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		handlers.WriteError(w, r, http.StatusTooManyRequests, game.ErrTooSoon.Error())
 	default:
 		writeInternalError(w, r, logger, "error retrieving next item", err)
 	}
 }
 
-// writeRoundBoundaryItem encodes a round-boundary-variant /next
-// response. Split out of HandleQuestionNext to keep that handler under
-// the function-length limit; the round-boundary path has no shuffle so
+// roundBoundaryResponse builds a round-boundary-variant /next
+// response. The round-boundary path has no shuffle so
 // the helper is a thin field projection (the auto-advance window is
 // computed in the service alongside the question window). The intro and
 // results phases (#548) project different fields, so each gets its own
-// response struct.
-func writeRoundBoundaryItem(w http.ResponseWriter, r *http.Request, logger *slog.Logger, item *game.Item) {
-	var res any
+// response struct. now is the service clock, so serverNow shares a clock
+// with the window it is compared against.
+func roundBoundaryResponse(item *game.Item, now time.Time) any {
 	if item.Phase == game.RoundPhaseResults {
-		res = nextRoundResultsResponse{
+		return client.RoundResults{
 			Type:           string(game.ItemTypeRoundBoundary),
 			Phase:          string(game.RoundPhaseResults),
 			ID:             item.Round.ID,
@@ -909,67 +961,76 @@ func writeRoundBoundaryItem(w http.ResponseWriter, r *http.Request, logger *slog
 			RoundScore:     item.RoundScore,
 			RoundCorrect:   item.RoundCorrect,
 			RoundQuestions: item.RoundQuestions,
+			ScoresHidden:   item.ScoresHidden,
 			StartedAt:      item.StartedAt,
 			ExpiredAt:      item.ExpiredAt,
-			ServerNow:      time.Now().UTC(),
+			ServerNow:      now.UTC(),
 			Total:          item.Total,
 		}
-	} else {
-		res = nextRoundIntroResponse{
-			Type:      string(game.ItemTypeRoundBoundary),
-			Phase:     string(game.RoundPhaseIntro),
-			ID:        item.Round.ID,
-			Title:     item.Round.Title,
-			Summary:   item.Round.Summary,
-			StartedAt: item.StartedAt,
-			ExpiredAt: item.ExpiredAt,
-			ServerNow: time.Now().UTC(),
-			Total:     item.Total,
-		}
 	}
-	if err := handlers.EncodeJSON(w, http.StatusOK, res); err != nil {
-		logger.ErrorContext(r.Context(), "error encoding round boundary item", slog.Any("err", err))
+
+	return client.RoundIntro{
+		Type:      string(game.ItemTypeRoundBoundary),
+		Phase:     string(game.RoundPhaseIntro),
+		ID:        item.Round.ID,
+		Title:     item.Round.Title,
+		Summary:   item.Round.Summary,
+		StartedAt: item.StartedAt,
+		ExpiredAt: item.ExpiredAt,
+		ServerNow: now.UTC(),
+		Total:     item.Total,
 	}
 }
 
-// writeQuestionItem encodes a question-variant /next response. The
+// questionResponse builds a question-variant /next response. The
 // per-game stable shuffle of the option buttons (#297) is applied
 // here so a reload returns the same layout for the same (game,
 // question) pair; two players answering the same question in
-// different games see different orders.
-func writeQuestionItem(
-	w http.ResponseWriter, r *http.Request, logger *slog.Logger, gameID string, gq *game.Question,
-) {
-	resOptions := make([]nextOptionResponse, len(gq.QuizQuestion.Options))
-	for i, o := range gq.QuizQuestion.Options {
-		resOptions[i] = nextOptionResponse{ID: o.ID, Text: o.Text}
+// different games see different orders. A quiz that keeps its option
+// order, or a true/false question, skips the shuffle; a pinned
+// [ShuffleSeedHeader] replaces the game id as the seed. now is the
+// service clock, as in [roundBoundaryResponse].
+func questionResponse(ctx context.Context, gameID string, gq *game.Question, now time.Time) client.Question {
+	// A numeric question's only option is its answer key; never send it.
+	resOptions := make([]client.Option, 0, len(gq.QuizQuestion.Options))
+	if !gq.QuizQuestion.IsNumeric() {
+		for _, o := range gq.QuizQuestion.Options {
+			resOptions = append(resOptions, client.Option{ID: o.ID, Text: o.Text})
+		}
 	}
-	shuffleBySeed(gameID, gq.QuestionID, len(resOptions), func(i, j int) {
-		resOptions[i], resOptions[j] = resOptions[j], resOptions[i]
-	})
-
-	res := nextQuestionResponse{
-		Type:           string(game.ItemTypeQuestion),
-		ID:             gq.QuizQuestion.ID,
-		Text:           gq.QuizQuestion.Text,
-		ImageURL:       mediaURL(gq.QuizQuestion.ImageMediaID),
-		AudioURL:       mediaURL(gq.QuizQuestion.AudioMediaID),
-		AudioRepeat:    gq.QuizQuestion.AudioRepeat,
-		Options:        resOptions,
-		StartedAt:      gq.StartedAt,
-		ExpiredAt:      gq.ExpiredAt,
-		ServerNow:      time.Now().UTC(),
-		Position:       gq.Position,
-		Total:          gq.Total,
-		RoundNumber:    gq.RoundNumber,
-		RoundTotal:     gq.RoundTotal,
-		RoundPosition:  gq.RoundPosition,
-		RoundQuestions: gq.RoundQuestions,
+	// True/false reads naturally only in the order it was written.
+	if !gq.KeepOptionOrder && gq.QuizQuestion.Kind != quiz.KindTrueFalse {
+		shuffleBySeed(shuffleScope(ctx, gameID), gq.QuestionID, len(resOptions), func(i, j int) {
+			resOptions[i], resOptions[j] = resOptions[j], resOptions[i]
+		})
 	}
 
-	if err := handlers.EncodeJSON(w, http.StatusOK, res); err != nil {
-		logger.ErrorContext(r.Context(), "error encoding question item", slog.Any("err", err))
+	res := client.Question{
+		Type:            string(game.ItemTypeQuestion),
+		ID:              gq.QuizQuestion.ID,
+		Kind:            quiz.NormalizedKind(gq.QuizQuestion.Kind),
+		Text:            gq.QuizQuestion.Text,
+		ImageURL:        mediaURL(gq.QuizQuestion.ImageMediaID),
+		AudioURL:        mediaURL(gq.QuizQuestion.AudioMediaID),
+		AudioRepeat:     gq.QuizQuestion.AudioRepeat,
+		Options:         resOptions,
+		StartedAt:       gq.StartedAt,
+		ExpiredAt:       gq.ExpiredAt,
+		ServerNow:       now.UTC(),
+		Position:        gq.Position,
+		Total:           gq.Total,
+		RoundNumber:     gq.RoundNumber,
+		RoundTotal:      gq.RoundTotal,
+		RoundPosition:   gq.RoundPosition,
+		RoundQuestions:  gq.RoundQuestions,
+		ConfidenceWager: gq.ConfidenceWager,
+		HasHint:         gq.QuizQuestion.Hint != "",
 	}
+	if gq.Wager != nil {
+		res.Wager = *gq.Wager
+	}
+
+	return res
 }
 
 // audioClipResponse is one entry in the audio-manifest wire shape: the
@@ -1033,7 +1094,7 @@ func HandleGameAudio(logger *slog.Logger, service *game.Service) http.Handler {
 			return
 		}
 
-		if err = handlers.EncodeJSON(w, http.StatusOK, newAudioManifestResponse(questions)); err != nil {
+		if err = handlers.WriteData(w, r, http.StatusOK, newAudioManifestResponse(questions)); err != nil {
 			logger.ErrorContext(r.Context(), "error encoding audio manifest", slog.Any("err", err))
 		}
 	})
@@ -1062,9 +1123,9 @@ func HandleRoundSeen(logger *slog.Logger, service *game.Service) http.Handler {
 		if err := service.MarkRoundSeen(r.Context(), gameID, playerID, roundID, phase); err != nil {
 			switch {
 			case errors.Is(err, game.ErrInvalidRoundPhase):
-				http.Error(w, err.Error(), http.StatusBadRequest)
+				handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 			case errors.Is(err, game.ErrGameNotFound), errors.Is(err, quiz.ErrRoundNotFound):
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 			default:
 				writeInternalError(w, r, logger, "error marking round seen", err)
 			}
@@ -1083,7 +1144,7 @@ func HandleRoundSeen(logger *slog.Logger, service *game.Service) http.Handler {
 // quiz question was not populated (defensive; shouldn't happen in the
 // production code path).
 func correctOptionIDsFromAnswer(a *game.Answer) []int64 {
-	if a.Question == nil || a.Question.QuizQuestion == nil {
+	if a.Question == nil || a.Question.QuizQuestion == nil || a.Question.QuizQuestion.IsNumeric() {
 		return nil
 	}
 	var ids []int64
@@ -1096,51 +1157,111 @@ func correctOptionIDsFromAnswer(a *game.Answer) []int64 {
 	return ids
 }
 
+// answerExplanation is the "why this is correct" note of the answered
+// question, empty when it has none.
+func answerExplanation(a *game.Answer) string {
+	if a.Question == nil || a.Question.QuizQuestion == nil {
+		return ""
+	}
+
+	return a.Question.QuizQuestion.Explanation
+}
+
+// answerStreak is the streak and multiplier the answer response reports:
+// both zero unless the quiz scores streaks.
+func answerStreak(a *game.Answer) (int, float64) {
+	if a.Scoring != quiz.ScoringStreak {
+		return 0, 0
+	}
+
+	return a.Streak, game.StreakMultiplier(a.Streak)
+}
+
 // writeSubmitAnswerError maps the sentinels returned by
 // [game.Service.SubmitAnswer] to the right HTTP status. Pulled out of
 // HandleAnswerPost so the handler stays under revive's
 // function-length limit.
 //   - ErrGameNotFound / ErrQuestionNotInGame -> 404
-//   - ErrOptionNotInQuestion -> 400
+//   - ErrOptionNotInQuestion / ErrAnswerKindMismatch -> 400
 //   - ErrAnswerAlreadyRecorded -> 409 (double-tap / retry; #353)
 //   - ErrAnswerWindowClosed -> 409 (answer arrived too late; #1163)
+//   - ErrGameFinished -> 410 (the game was finished or abandoned)
 //   - anything else -> 500 via writeInternalError
 func writeSubmitAnswerError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, err error) {
 	switch {
 	case errors.Is(err, game.ErrGameNotFound), errors.Is(err, game.ErrQuestionNotInGame):
-		http.NotFound(w, r)
-	case errors.Is(err, game.ErrOptionNotInQuestion):
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		handlers.NotFound(w, r)
+	case errors.Is(err, game.ErrOptionNotInQuestion), errors.Is(err, game.ErrAnswerKindMismatch):
+		handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 	case errors.Is(err, game.ErrAnswerAlreadyRecorded), errors.Is(err, game.ErrAnswerWindowClosed):
-		http.Error(w, err.Error(), http.StatusConflict)
+		handlers.WriteError(w, r, http.StatusConflict, err.Error())
+	case errors.Is(err, game.ErrGameFinished):
+		handlers.WriteError(w, r, http.StatusGone, err.Error())
 	default:
 		writeInternalError(w, r, logger, "error submitting answer", err)
 	}
 }
 
-// HandleAnswerPost handles the submission of an answer for a game question.
-// It decodes the request body, extracts game and question IDs from the path,
-// and uses the game service to submit the answer.
-func HandleAnswerPost(logger *slog.Logger, service *game.Service) http.Handler {
-	// TappedAt is what the client claims as the moment of the tap; the
-	// service clamps it to [question.StartedAt, time.Now()] so an
-	// honest player on a slow link doesn't get scored late by accident
-	// (#237). Missing/zero falls back to the server's now on the
-	// service side.
-	type answerRequest struct {
-		OptionID int64     `json:"optionId"`
-		TappedAt time.Time `json:"tappedAt"`
+// submitAnswerRequest routes the decoded answer body to the service entry
+// point for its shape: a typed number, a multi-select pick set, or a single
+// option.
+func submitAnswerRequest(
+	ctx context.Context, service *game.Service, gameID string, playerID, questionID int64, req client.AnswerRequest,
+) (*game.Answer, error) {
+	switch {
+	case req.NumericValue != nil:
+		return service.SubmitNumericAnswer(ctx, gameID, playerID, questionID, *req.NumericValue, req.TappedAt)
+	case req.OptionIDs != nil:
+		return service.SubmitMultiAnswer(ctx, gameID, playerID, questionID, req.OptionIDs, req.TappedAt)
+	default:
+		return service.SubmitAnswer(ctx, gameID, playerID, questionID, req.OptionID, req.TappedAt)
+	}
+}
+
+// prefetchNext issues the player's next item the way [HandleQuestionNext]
+// would and returns it encoded, or nil when there is none to hand out. The
+// answer is already recorded by then, so a failure here only drops the
+// prefetch: the client falls back to GET .../questions/next, which reports
+// the exhausted or finished game the usual way, and waits out a minimum
+// question interval the prefetch arrived inside.
+func prefetchNext(
+	ctx context.Context, logger *slog.Logger, service *game.Service, gameID string, playerID int64,
+) json.RawMessage {
+	item, err := service.GetNext(ctx, gameID, playerID)
+	if err != nil {
+		if !errors.Is(err, game.ErrNoMoreQuestions) && !errors.Is(err, game.ErrGameFinished) &&
+			!errors.Is(err, game.ErrTooSoon) {
+			logger.WarnContext(ctx, "error prefetching next item", slog.Any("err", err))
+		}
+
+		return nil
 	}
+	next, err := json.Marshal(nextItemResponse(ctx, gameID, item, service.Now()))
+	if err != nil {
+		logger.ErrorContext(ctx, "error encoding prefetched item", slog.Any("err", err))
 
-	// CorrectOptionIDs always carries the question's correct option set
-	// so the client can light up the right answer after a wrong pick
-	// (#233) without branching on Correct.
-	type answerResponse struct {
-		Correct          bool    `json:"correct"`
-		Score            int     `json:"score"`
-		CorrectOptionIDs []int64 `json:"correctOptionIds"`
+		return nil
 	}
 
+	return next
+}
+
+// HandleAnswerPost handles the submission of an answer for a game question.
+// It decodes the request body, extracts game and question IDs from the path,
+// and uses the game service to submit the answer: optionId for a single pick,
+// optionIds for a multi-select question, numericValue for a numeric one.
+//
+// With ?prefetch=true the response also carries the next item (see
+// [client.AnswerResponse]), registered at that moment, saving the client the
+// round-trip to /next. The next question's clock starts then, reveal delay
+// included, so a client only asks for it when it moves straight on: with no
+// review pause between the feedback and the next question, or one that fits
+// inside the reveal delay.
+//
+// On a host-paced quiz whose host has not revealed the game's scores yet the
+// response is a [client.HiddenAnswerResponse] instead: the outcome arrives
+// on GET /api/games/{gameID}/events once the host reveals it.
+func HandleAnswerPost(logger *slog.Logger, service *game.Service) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		gameID, playerID, ok := gameRequest(w, r, logger)
 		if !ok {
@@ -1152,54 +1273,196 @@ func HandleAnswerPost(logger *slog.Logger, service *game.Service) http.Handler {
 			return
 		}
 
-		req, err := handlers.DecodeJSON[answerRequest](w, r)
+		req, err := handlers.DecodeJSON[client.AnswerRequest](w, r)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 
 			return
 		}
 
-		a, err := service.SubmitAnswer(r.Context(), gameID, playerID, questionID, req.OptionID, req.TappedAt)
+		a, err := submitAnswerRequest(r.Context(), service, gameID, playerID, questionID, req)
 		if err != nil {
 			writeSubmitAnswerError(w, r, logger, err)
 
 			return
 		}
 
+		if a.ScoresHidden {
+			writeHiddenAnswer(w, r, logger, service, gameID, playerID, a)
+
+			return
+		}
+
 		score := service.CalculateScore(r.Context(), a)
 
-		res := answerResponse{
-			Correct:          a.Option.Correct,
+		res := client.AnswerResponse{
+			Correct:          a.IsCorrect(),
 			Score:            score,
 			CorrectOptionIDs: correctOptionIDsFromAnswer(a),
+			Explanation:      answerExplanation(a),
+		}
+		if a.NumericValue != nil {
+			res.CorrectValue = a.Option.NumericValue
+		}
+		if a.Wager != nil {
+			res.Wager = *a.Wager
+		}
+		res.Streak, res.Multiplier = answerStreak(a)
+		if r.URL.Query().Get("prefetch") == "true" {
+			res.Next = prefetchNext(r.Context(), logger, service, gameID, playerID)
+		}
+
+		err = handlers.WriteData(w, r, http.StatusOK, res)
+		if err != nil {
+			logger.ErrorContext(r.Context(), "error encoding answer response", slog.Any("err", err))
+
+			return
+		}
+	})
+}
+
+// HandleWagerPost locks in the player's confidence stake on a question
+// before they answer it, on a quiz that uses the wager. A stake outside
+// 1..3 is a 400; a quiz without the wager, a second stake, or a question
+// already answered or past its window is a 409; a finished game is a 410.
+// Non-participants get a 404, as in [HandleAnswerPost].
+func HandleWagerPost(logger *slog.Logger, service *game.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gameID, playerID, ok := gameRequest(w, r, logger)
+		if !ok {
+			return
 		}
 
-		err = handlers.EncodeJSON(w, http.StatusOK, res)
+		questionID, ok := handlers.ParseIDFromPath(w, r, logger, "questionID")
+		if !ok {
+			return
+		}
+
+		req, err := handlers.DecodeJSON[client.WagerRequest](w, r)
 		if err != nil {
-			logger.ErrorContext(r.Context(), "error encoding answerResponse", slog.Any("err", err))
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 
 			return
 		}
+
+		if err = service.PlaceWager(r.Context(), gameID, playerID, questionID, req.Wager); err != nil {
+			switch {
+			case errors.Is(err, game.ErrInvalidWager):
+				handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
+			case errors.Is(err, game.ErrWagerNotOffered), errors.Is(err, game.ErrWagerAlreadyPlaced):
+				handlers.WriteError(w, r, http.StatusConflict, err.Error())
+			default:
+				writeSubmitAnswerError(w, r, logger, err)
+			}
+
+			return
+		}
+
+		if err = handlers.WriteData(w, r, http.StatusOK, client.WagerResponse{Wager: req.Wager}); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding wager response", slog.Any("err", err))
+		}
+	})
+}
+
+// HandlePauseOncePost spends the player's one "connection hiccup" pause of
+// the game on a question and returns their extended deadline for it. A second
+// pause in the game, or a question already answered or past its window, is a
+// 409; a finished game is a 410. Non-participants get a 404, as in
+// [HandleAnswerPost].
+func HandlePauseOncePost(logger *slog.Logger, service *game.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gameID, playerID, ok := gameRequest(w, r, logger)
+		if !ok {
+			return
+		}
+
+		questionID, ok := handlers.ParseIDFromPath(w, r, logger, "questionID")
+		if !ok {
+			return
+		}
+
+		expiredAt, err := service.PauseOnce(r.Context(), gameID, playerID, questionID)
+		if err != nil {
+			if errors.Is(err, game.ErrPauseAlreadyUsed) {
+				handlers.WriteError(w, r, http.StatusConflict, err.Error())
+			} else {
+				writeSubmitAnswerError(w, r, logger, err)
+			}
+
+			return
+		}
+
+		res := client.PauseResponse{
+			ExpiredAt:        expiredAt,
+			ExtensionSeconds: int(game.PauseExtension / time.Second),
+		}
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding pause response", slog.Any("err", err))
+		}
+	})
+}
+
+// HandleHintPost returns a question's hint and marks it as taken, so the
+// player's answer to it scores the configured percentage less. Asking again
+// returns the same hint at no extra cost. A question without a hint, or one
+// already answered or past its window, is a 409; a finished game is a 410.
+// Non-participants get a 404, as in [HandleAnswerPost].
+func HandleHintPost(logger *slog.Logger, service *game.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gameID, playerID, ok := gameRequest(w, r, logger)
+		if !ok {
+			return
+		}
+
+		questionID, ok := handlers.ParseIDFromPath(w, r, logger, "questionID")
+		if !ok {
+			return
+		}
+
+		hint, err := service.UseHint(r.Context(), gameID, playerID, questionID)
+		if err != nil {
+			if errors.Is(err, game.ErrHintNotOffered) {
+				handlers.WriteError(w, r, http.StatusConflict, err.Error())
+			} else {
+				writeSubmitAnswerError(w, r, logger, err)
+			}
+
+			return
+		}
+
+		res := client.HintResponse{Hint: hint, PenaltyPercent: service.HintPenalty()}
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding hint response", slog.Any("err", err))
+		}
 	})
 }
 
 // playerResponse is the JSON shape for GET and PATCH /api/players/me. The three
 // flags are independent: isAnonymous (credential-less guest), isAuthenticated
 // (signed-in account), and hasCustomName (picked their own name) can mix, e.g. a
-// renamed guest is hasCustomName and still isAnonymous.
+// renamed guest is hasCustomName and still isAnonymous. nickname and
+// avatarColor are the identity generated from the id; they never change, even
+// after a rename, so the client can always draw the same avatar.
 type playerResponse struct {
 	ID              int64  `json:"id"`
 	DisplayName     string `json:"displayName"`
+	Nickname        string `json:"nickname"`
+	AvatarColor     string `json:"avatarColor"`
 	IsAnonymous     bool   `json:"isAnonymous"`
 	HasCustomName   bool   `json:"hasCustomName"`
 	IsAuthenticated bool   `json:"isAuthenticated"`
 }
 
-// newPlayerResponse projects an auth.Player onto the wire format.
-func newPlayerResponse(p *auth.Player) playerResponse {
+// newPlayerResponse projects an auth.Player and its generated identity onto
+// the wire format.
+func newPlayerResponse(p *auth.Player, identities auth.IdentityGenerator) playerResponse {
+	identity := identities.Identity(p.ID)
+
 	return playerResponse{
 		ID:              p.ID,
 		DisplayName:     p.DisplayName,
+		Nickname:        identity.Nickname,
+		AvatarColor:     identity.Color,
 		IsAnonymous:     p.IsAnonymous(),
 		HasCustomName:   p.HasCustomName(),
 		IsAuthenticated: p.IsAuthenticated(),
@@ -1214,19 +1477,20 @@ func newPlayerResponse(p *auth.Player) playerResponse {
 // anonymous, but a claimed-but-passwordless visitor still is - callers
 // that care about "did this player choose this name" should look at
 // hasCustomName, not isAnonymous. The displayName is shown verbatim so a
-// fresh petname can be displayed as-is until the player renames.
-func HandlePlayerGetMe(logger *slog.Logger) http.Handler {
+// fresh visitor's generated nickname can be displayed as-is until the player
+// renames; nickname and avatarColor come from identities.
+func HandlePlayerGetMe(logger *slog.Logger, identities auth.IdentityGenerator) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
 		current, ok := auth.PlayerFromContext(ctx)
 		if !ok {
-			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			handlers.WriteError(w, r, http.StatusUnauthorized, "unauthenticated")
 
 			return
 		}
 
-		if err := handlers.EncodeJSON(w, http.StatusOK, newPlayerResponse(current)); err != nil {
+		if err := handlers.WriteData(w, r, http.StatusOK, newPlayerResponse(current, identities)); err != nil {
 			logger.ErrorContext(ctx, "error encoding playerResponse", slog.Any("err", err))
 
 			return
@@ -1240,7 +1504,7 @@ func HandlePlayerGetMe(logger *slog.Logger) http.Handler {
 // displayName-taken and already-claimed-via-register; the distinct
 // messages let the client tell them apart.
 func HandlePlayerClaimName(
-	logger *slog.Logger, players auth.PlayerStore, gameService *game.Service,
+	logger *slog.Logger, players auth.PlayerStore, gameService *game.Service, identities auth.IdentityGenerator,
 ) http.Handler {
 	type claimNameRequest struct {
 		DisplayName string `json:"displayName"`
@@ -1251,7 +1515,7 @@ func HandlePlayerClaimName(
 
 		current, ok := auth.PlayerFromContext(ctx)
 		if !ok {
-			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			handlers.WriteError(w, r, http.StatusUnauthorized, "unauthenticated")
 
 			return
 		}
@@ -1259,19 +1523,19 @@ func HandlePlayerClaimName(
 		req, err := handlers.DecodeJSON[claimNameRequest](w, r)
 		if err != nil {
 			logger.ErrorContext(ctx, "error decoding claimNameRequest", slog.Any("err", err))
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 
 			return
 		}
 		trimmed := strings.TrimSpace(req.DisplayName)
 		if trimmed == "" {
-			http.Error(w, "display name is required", http.StatusBadRequest)
+			handlers.WriteError(w, r, http.StatusBadRequest, "display name is required")
 
 			return
 		}
 		if utf8.RuneCountInString(trimmed) > auth.MaxDisplayNameLength {
 			writeClaimNameError(w, r, logger,
-				http.StatusBadRequest, "display_name_too_long",
+				http.StatusBadRequest, "displayNameTooLong",
 				fmt.Sprintf("display name must be at most %d characters", auth.MaxDisplayNameLength))
 
 			return
@@ -1282,7 +1546,7 @@ func HandlePlayerClaimName(
 			switch {
 			case errors.Is(err, auth.ErrDisplayNameTaken):
 				writeClaimNameError(w, r, logger,
-					http.StatusConflict, "display_name_taken", "display name already taken")
+					http.StatusConflict, "displayNameTaken", "display name already taken")
 			case errors.Is(err, auth.ErrPlayerNotAnonymous):
 				// #289: distinct code so the JS can tell "name in use
 				// by someone else" from "this account already has a
@@ -1290,10 +1554,10 @@ func HandlePlayerClaimName(
 				// the client should re-fetch /me and dismiss the
 				// modal, not show "name is taken".
 				writeClaimNameError(w, r, logger,
-					http.StatusConflict, "already_claimed", "display name already set for this account")
+					http.StatusConflict, "alreadyClaimed", "display name already set for this account")
 			case errors.Is(err, auth.ErrDisplayNameEmpty):
 				writeClaimNameError(w, r, logger,
-					http.StatusBadRequest, "display_name_required", "display name is required")
+					http.StatusBadRequest, "displayNameRequired", "display name is required")
 			default:
 				writeInternalError(w, r, logger, "error updating player displayName", err)
 			}
@@ -1311,7 +1575,7 @@ func HandlePlayerClaimName(
 				slog.Int64("playerId", current.ID), slog.Any("err", perr))
 		}
 
-		if err = handlers.EncodeJSON(w, http.StatusOK, newPlayerResponse(updated)); err != nil {
+		if err = handlers.WriteData(w, r, http.StatusOK, newPlayerResponse(updated, identities)); err != nil {
 			logger.ErrorContext(ctx, "error encoding playerResponse", slog.Any("err", err))
 
 			return
@@ -1319,20 +1583,40 @@ func HandlePlayerClaimName(
 	})
 }
 
-// HandleGameResults returns the results of a game based on its ID.
-func HandleGameResults(logger *slog.Logger, service *game.Service) http.Handler {
-	type playerScoreResponse struct {
-		PlayerID int64 `json:"playerId"`
-		Score    int   `json:"score"`
-	}
+// HandleFinishGame ends the caller's game: finished when every question was
+// already played, abandoned when the player quits early. Idempotent - a
+// second call returns the status the game first reached. Afterwards the game
+// answers new question and answer requests with 410 Gone.
+func HandleFinishGame(logger *slog.Logger, service *game.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gameID, playerID, ok := gameRequest(w, r, logger)
+		if !ok {
+			return
+		}
 
-	type resultsResponse struct {
-		GameID string `json:"gameId"`
-		Winner string `json:"winner"`
+		g, err := service.FinishGame(r.Context(), gameID, playerID)
+		if err != nil {
+			if errors.Is(err, game.ErrGameNotFound) {
+				handlers.NotFound(w, r)
 
-		PlayerScores []playerScoreResponse `json:"playerScores"`
-	}
+				return
+			}
+			writeInternalError(w, r, logger, "error finishing game", err)
+
+			return
+		}
+
+		res := client.FinishGameResponse{ID: g.ID, Status: string(g.Status), FinishedAt: g.FinishedAt}
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding finish game response", slog.Any("err", err))
+		}
+	})
+}
 
+// HandleGameResults returns the results of a game based on its ID. A
+// host-paced game whose scores are still held back is a 409 until its host
+// reveals them.
+func HandleGameResults(logger *slog.Logger, service *game.Service) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		gameID, playerID, ok := gameRequest(w, r, logger)
 		if !ok {
@@ -1344,7 +1628,12 @@ func HandleGameResults(logger *slog.Logger, service *game.Service) http.Handler
 			if errors.Is(err, game.ErrGameNotFound) {
 				// User-supplied bad ID - Info, not Error (#369).
 				logger.InfoContext(r.Context(), "game not found", slog.Any("err", err))
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
+
+				return
+			}
+			if errors.Is(err, game.ErrScoresHidden) {
+				handlers.WriteError(w, r, http.StatusConflict, err.Error())
 
 				return
 			}
@@ -1353,16 +1642,16 @@ func HandleGameResults(logger *slog.Logger, service *game.Service) http.Handler
 			return
 		}
 
-		psr := make([]playerScoreResponse, 0, len(results.PlayerScores))
+		psr := make([]client.PlayerScore, 0, len(results.PlayerScores))
 		for psKey, psVal := range results.PlayerScores {
-			psr = append(psr, playerScoreResponse{
+			psr = append(psr, client.PlayerScore{
 				PlayerID: psKey,
 				Score:    psVal,
 			})
 		}
 		// Map iteration is randomized; sort for a deterministic wire order
 		// (score desc, then player id asc).
-		slices.SortFunc(psr, func(a, b playerScoreResponse) int {
+		slices.SortFunc(psr, func(a, b client.PlayerScore) int {
 			if c := cmp.Compare(b.Score, a.Score); c != 0 {
 				return c
 			}
@@ -1373,17 +1662,103 @@ func HandleGameResults(logger *slog.Logger, service *game.Service) http.Handler
 		if results.Winner != 0 {
 			winner = strconv.FormatInt(results.Winner, decimalBase)
 		}
-		res := resultsResponse{
-			GameID:       gameID,
-			Winner:       winner,
-			PlayerScores: psr,
+		res := client.Results{
+			GameID:            gameID,
+			Winner:            winner,
+			PlayerScores:      psr,
+			VoidedQuestionIDs: results.VoidedQuestionIDs,
+			Status:            string(results.Status),
+			FinishedAt:        results.FinishedAt,
+		}
+
+		err = handlers.WriteData(w, r, http.StatusOK, res)
+		if err != nil {
+			logger.ErrorContext(r.Context(), "error encoding results", slog.Any("err", err))
+
+			return
+		}
+	})
+}
+
+// HandlePublicResults returns the anonymized standings of a finished game
+// whose host made its results public. It is unauthenticated, so it carries
+// display names and scores only, and a game that is missing, unfinished or
+// private is a plain 404.
+func HandlePublicResults(logger *slog.Logger, service *game.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gameID := r.PathValue("gameID")
+		if gameID == "" {
+			logger.InfoContext(r.Context(), "missing gameID in request path")
+			handlers.WriteError(w, r, http.StatusBadRequest, "missing gameID")
+
+			return
+		}
+
+		pub, err := service.GetPublicResults(r.Context(), gameID)
+		if err != nil {
+			if errors.Is(err, game.ErrGameNotFound) {
+				logger.InfoContext(r.Context(), "public results not found", slog.Any("err", err))
+				handlers.NotFound(w, r)
+
+				return
+			}
+			writeInternalError(w, r, logger, "error retrieving public results", err)
+
+			return
+		}
+
+		standings := make([]client.PublicStanding, 0, len(pub.Standings))
+		for _, s := range pub.Standings {
+			standings = append(standings, client.PublicStanding{
+				Rank:        s.Rank,
+				DisplayName: s.DisplayName,
+				Score:       s.Score,
+			})
+		}
+		res := client.PublicResults{GameID: pub.GameID, FinishedAt: pub.FinishedAt, Standings: standings}
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding public results", slog.Any("err", err))
+		}
+	})
+}
+
+// HandleCompareResults returns the player's score in the game next to the
+// quiz's historical average and the player's percentile among the others who
+// completed it, for the results screen's "You beat N% of players" line.
+func HandleCompareResults(logger *slog.Logger, service *game.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gameID, playerID, ok := gameRequest(w, r, logger)
+		if !ok {
+			return
 		}
 
-		err = handlers.EncodeJSON(w, http.StatusOK, res)
+		cmpRes, err := service.CompareResults(r.Context(), gameID, playerID)
 		if err != nil {
-			logger.ErrorContext(r.Context(), "error encoding resultsResponse", slog.Any("err", err))
+			if errors.Is(err, game.ErrGameNotFound) {
+				logger.InfoContext(r.Context(), "game not found", slog.Any("err", err))
+				handlers.NotFound(w, r)
+
+				return
+			}
+			if errors.Is(err, game.ErrScoresHidden) {
+				handlers.WriteError(w, r, http.StatusConflict, err.Error())
+
+				return
+			}
+			writeInternalError(w, r, logger, "error comparing game results", err)
 
 			return
 		}
+
+		res := client.ResultsComparison{
+			GameID:          cmpRes.GameID,
+			Score:           cmpRes.Score,
+			AverageScore:    cmpRes.Average,
+			Percentile:      cmpRes.Percentile,
+			ComparedPlayers: cmpRes.Compared,
+		}
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding results comparison", slog.Any("err", err))
+		}
 	})
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -14,7 +15,9 @@ import (
 	"github.com/starquake/topbanana/internal/auth"
 	. "github.com/starquake/topbanana/internal/clientapi"
 	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/handlers"
 	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/pkg/client"
 )
 
 // withPlayer returns ctx annotated with an authenticated player. Use it
@@ -55,6 +58,115 @@ func TestHandleQuizList(t *testing.T) {
 		}
 	})
 
+	t.Run("returns one page with the total in meta", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		for i := range 3 {
+			env.seedQuiz(t, twoQuestionQuiz(fmt.Sprintf("Quiz %d", i+1), fmt.Sprintf("quiz-%d", i+1)))
+		}
+
+		handler := handlers.WithAPIShapes(HandleQuizList(env.logger, env.quizzes), false)
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes?page=2&pageSize=2", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v", got, want)
+		}
+
+		var result struct {
+			Data []client.Quiz   `json:"data"`
+			Meta client.ListMeta `json:"meta"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if got, want := len(result.Data), 1; got != want {
+			t.Fatalf("len(quizzes) = %v, want %v", got, want)
+		}
+		if got, want := result.Meta, (client.ListMeta{Count: 1, Total: 3, Page: 2, PageSize: 2}); got != want {
+			t.Errorf("meta = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("searches with q, paged by default", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		env.seedQuiz(t, twoQuestionQuiz("Capitals of Europe", "capitals"))
+		env.seedQuiz(t, twoQuestionQuiz("Rivers", "rivers"))
+
+		handler := handlers.WithAPIShapes(HandleQuizList(env.logger, env.quizzes), false)
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes?q=+Capit+", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v", got, want)
+		}
+
+		var result struct {
+			Data []client.Quiz   `json:"data"`
+			Meta client.ListMeta `json:"meta"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if got, want := len(result.Data), 1; got != want {
+			t.Fatalf("len(quizzes) = %v, want %v", got, want)
+		}
+		if got, want := result.Data[0].Title, "Capitals of Europe"; got != want {
+			t.Errorf("quiz title = %q, want %q", got, want)
+		}
+		if got, want := result.Meta, (client.ListMeta{Count: 1, Total: 1, Page: 1, PageSize: 20}); got != want {
+			t.Errorf("meta = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("clamps pageSize to the maximum", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		handler := handlers.WithAPIShapes(HandleQuizList(env.logger, env.quizzes), false)
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes?pageSize=5000", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		var result struct {
+			Meta client.ListMeta `json:"meta"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if got, want := result.Meta.PageSize, 100; got != want {
+			t.Errorf("meta.pageSize = %d, want %d", got, want)
+		}
+		if got, want := result.Meta.Page, 1; got != want {
+			t.Errorf("meta.page = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("returns 400 on a bad page", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		handler := HandleQuizList(env.logger, env.quizzes)
+
+		for _, query := range []string{"page=0", "page=abc", "pageSize=-1"} {
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/quizzes?"+query, nil)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if got, want := rec.Code, http.StatusBadRequest; got != want {
+				t.Errorf("%s: status code = %v, want %v", query, got, want)
+			}
+		}
+	})
+
 	t.Run("returns 500 on store error", func(t *testing.T) {
 		t.Parallel()
 
@@ -195,8 +307,10 @@ func TestHandleCreateGame_AlreadyExists(t *testing.T) {
 	playerID := env.seedPlayer(t, "creator-dup")
 
 	// First game claims the (player, quiz) slot; the second create must
-	// surface 409 via the UNIQUE(player_id, quiz_id) guard.
-	if _, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false); err != nil {
+	// surface 409 via the UNIQUE(player_id, quiz_id) guard, naming the
+	// existing game so the client can resume it.
+	existing, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+	if err != nil {
 		t.Fatalf("seed CreateGame err = %v, want nil", err)
 	}
 
@@ -212,6 +326,18 @@ func TestHandleCreateGame_AlreadyExists(t *testing.T) {
 	if got, want := rec.Code, http.StatusConflict; got != want {
 		t.Errorf("status code = %v, want %v", got, want)
 	}
+	var res struct {
+		ID string `json:"id"`
+	}
+	if err = json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("json.Unmarshal err = %v, want nil (body=%q)", err, rec.Body.String())
+	}
+	if got, want := res.ID, existing.ID; got != want {
+		t.Errorf("conflict game id = %q, want %q", got, want)
+	}
+	if got, want := rec.Header().Get("Location"), "/play/game/"+existing.ID; got != want {
+		t.Errorf("Location = %q, want %q", got, want)
+	}
 }
 
 func TestHandleCreateGame_Preview(t *testing.T) {
@@ -1099,6 +1225,440 @@ func TestHandleAnswerPost(t *testing.T) {
 	})
 }
 
+// TestHandleAnswerPost_Prefetch pins ?prefetch=true: the answer response
+// carries the next question, already issued, and the last answer carries
+// none.
+func TestHandleAnswerPost_Prefetch(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	qz := env.seedQuiz(t, twoQuestionQuiz("Quiz", "quiz"))
+	playerID := env.seedPlayer(t, "answer-prefetch")
+
+	g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	if _, err = env.service.GetNext(t.Context(), g.ID, playerID); err != nil {
+		t.Fatalf("GetNext err = %v, want nil", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(
+		"POST /api/games/{gameID}/questions/{questionID}/answers",
+		HandleAnswerPost(env.logger, env.service),
+	)
+	answer := func(t *testing.T, index int) client.AnswerResponse {
+		t.Helper()
+
+		questionID, optionID := correctOptionID(t, qz, index)
+		req := httptest.NewRequestWithContext(
+			withPlayer(t.Context(), playerID), http.MethodPost,
+			fmt.Sprintf("/api/games/%s/questions/%d/answers?prefetch=true", g.ID, questionID),
+			strings.NewReader(fmt.Sprintf(`{"optionId": %d}`, optionID)),
+		)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+		var res client.AnswerResponse
+		if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+
+		return res
+	}
+
+	first := answer(t, 0)
+	var next client.Question
+	if err = json.Unmarshal(first.Next, &next); err != nil {
+		t.Fatalf("decode next = %s, err = %v, want a question", first.Next, err)
+	}
+	if got, want := next.Type, client.ItemTypeQuestion; got != want {
+		t.Errorf("next type = %q, want %q", got, want)
+	}
+	if got, want := next.ID, qz.Questions[1].ID; got != want {
+		t.Errorf("next question = %d, want %d", got, want)
+	}
+	if got, want := next.Position, 2; got != want {
+		t.Errorf("next position = %d, want %d", got, want)
+	}
+
+	// The prefetch registered the question, so it can be answered without
+	// a /next call.
+	if last := answer(t, 1); last.Next != nil {
+		t.Errorf("last answer next = %s, want none", last.Next)
+	}
+}
+
+// TestHandleAnswerPost_Explanation pins that the answer response carries
+// the question's explanation once the answer is recorded, and leaves the
+// field out for a question without one.
+func TestHandleAnswerPost_Explanation(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	seeded := twoQuestionQuiz("Quiz", "quiz")
+	seeded.Questions[0].Explanation = "Paris has been the capital since 987."
+	qz := env.seedQuiz(t, seeded)
+	playerID := env.seedPlayer(t, "answer-explanation")
+
+	g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(
+		"POST /api/games/{gameID}/questions/{questionID}/answers",
+		HandleAnswerPost(env.logger, env.service),
+	)
+	answer := func(t *testing.T, index int) string {
+		t.Helper()
+
+		if _, err := env.service.GetNext(t.Context(), g.ID, playerID); err != nil {
+			t.Fatalf("GetNext err = %v, want nil", err)
+		}
+		questionID, optionID := correctOptionID(t, qz, index)
+		req := httptest.NewRequestWithContext(
+			withPlayer(t.Context(), playerID), http.MethodPost,
+			fmt.Sprintf("/api/games/%s/questions/%d/answers", g.ID, questionID),
+			strings.NewReader(fmt.Sprintf(`{"optionId": %d}`, optionID)),
+		)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+
+		return rec.Body.String()
+	}
+
+	var res client.AnswerResponse
+	if err = json.Unmarshal([]byte(answer(t, 0)), &res); err != nil {
+		t.Fatalf("decode err = %v, want nil", err)
+	}
+	if got, want := res.Explanation, qz.Questions[0].Explanation; got != want {
+		t.Errorf("explanation = %q, want %q", got, want)
+	}
+	if body := answer(t, 1); strings.Contains(body, `"explanation"`) {
+		t.Errorf("answer without an explanation = %s, want no explanation field", body)
+	}
+}
+
+// TestHandleQuestionNext_TooSoon pins the minimum question interval on the
+// wire: a new question asked for inside it answers 429 with the wait in
+// whole seconds as Retry-After.
+func TestHandleQuestionNext_TooSoon(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	env.service.SetMinQuestionInterval(time.Hour)
+	qz := env.seedQuiz(t, twoQuestionQuiz("Quiz", "quiz"))
+	playerID := env.seedPlayer(t, "too-soon")
+
+	g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	if _, err = env.service.GetNext(t.Context(), g.ID, playerID); err != nil {
+		t.Fatalf("GetNext err = %v, want nil", err)
+	}
+	questionID, optionID := correctOptionID(t, qz, 0)
+	if _, err = env.service.SubmitAnswer(t.Context(), g.ID, playerID, questionID, optionID, time.Time{}); err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+
+	req := httptest.NewRequestWithContext(
+		withPlayer(t.Context(), playerID), http.MethodGet, "/api/games/"+g.ID+"/questions/next", nil,
+	)
+	req.SetPathValue("gameID", g.ID)
+	rec := httptest.NewRecorder()
+	HandleQuestionNext(env.logger, env.service).ServeHTTP(rec, req)
+
+	if got, want := rec.Code, http.StatusTooManyRequests; got != want {
+		t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+	}
+	retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+	if err != nil || retryAfter < 3590 || retryAfter > 3600 {
+		t.Errorf("Retry-After = %q, want about 3600 seconds", rec.Header().Get("Retry-After"))
+	}
+}
+
+// TestHandleWagerPost pins the wager endpoint's status mapping: an
+// out-of-range stake is a 400, the first stake is echoed back, a second is a
+// 409, the answer reports the stake it was scored with, and a quiz without
+// the wager refuses one with a 409.
+func TestHandleWagerPost(t *testing.T) {
+	t.Parallel()
+
+	post := func(t *testing.T, env *testEnv, playerID int64, path, body string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		mux := http.NewServeMux()
+		mux.Handle(
+			"POST /api/games/{gameID}/questions/{questionID}/wager",
+			HandleWagerPost(env.logger, env.service),
+		)
+		mux.Handle(
+			"POST /api/games/{gameID}/questions/{questionID}/answers",
+			HandleAnswerPost(env.logger, env.service),
+		)
+		req := httptest.NewRequestWithContext(
+			withPlayer(t.Context(), playerID), http.MethodPost, path, strings.NewReader(body),
+		)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("stakes once and scores the answer with it", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		wagered := twoQuestionQuiz("Wager", "wager")
+		wagered.ConfidenceWager = true
+		qz := env.seedQuiz(t, wagered)
+		playerID := env.seedPlayer(t, "wager-ok")
+
+		g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		if _, err = env.service.GetNext(t.Context(), g.ID, playerID); err != nil {
+			t.Fatalf("GetNext err = %v, want nil", err)
+		}
+		questionID, _ := correctOptionID(t, qz, 0)
+		wagerPath := fmt.Sprintf("/api/games/%s/questions/%d/wager", g.ID, questionID)
+
+		if rec := post(t, env, playerID, wagerPath, `{"wager": 4}`); rec.Code != http.StatusBadRequest {
+			t.Errorf("out-of-range stake status = %v, want %v", rec.Code, http.StatusBadRequest)
+		}
+
+		rec := post(t, env, playerID, wagerPath, `{"wager": 2}`)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+		var res client.WagerResponse
+		if err = json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if got, want := res.Wager, 2; got != want {
+			t.Errorf("wager = %d, want %d", got, want)
+		}
+
+		if rec = post(t, env, playerID, wagerPath, `{"wager": 3}`); rec.Code != http.StatusConflict {
+			t.Errorf("second stake status = %v, want %v", rec.Code, http.StatusConflict)
+		}
+
+		rec = post(t, env, playerID,
+			fmt.Sprintf("/api/games/%s/questions/%d/answers", g.ID, questionID),
+			fmt.Sprintf(`{"optionId": %d}`, wrongOptionID(t, qz, 0)),
+		)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("answer status code = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+		var answer client.AnswerResponse
+		if err = json.NewDecoder(rec.Body).Decode(&answer); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if got, want := answer.Wager, 2; got != want {
+			t.Errorf("answer wager = %d, want %d", got, want)
+		}
+		if answer.Score >= 0 {
+			t.Errorf("answer score = %d, want negative for a wrong staked answer", answer.Score)
+		}
+	})
+
+	t.Run("returns 409 when the quiz does not use the wager", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz", "quiz"))
+		playerID := env.seedPlayer(t, "wager-off")
+
+		g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		if _, err = env.service.GetNext(t.Context(), g.ID, playerID); err != nil {
+			t.Fatalf("GetNext err = %v, want nil", err)
+		}
+		questionID, _ := correctOptionID(t, qz, 0)
+
+		rec := post(t, env, playerID, fmt.Sprintf("/api/games/%s/questions/%d/wager", g.ID, questionID), `{"wager": 2}`)
+		if got, want := rec.Code, http.StatusConflict; got != want {
+			t.Errorf("status code = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("returns 404 when game not found", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		playerID := env.seedPlayer(t, "wager-nogame")
+
+		rec := post(t, env, playerID, "/api/games/missing/questions/1/wager", `{"wager": 2}`)
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status code = %v, want %v", got, want)
+		}
+	})
+}
+
+// TestHandlePauseOncePost pins the pause endpoint: the first pause of a game
+// returns the extended deadline, a second is a 409, and a missing game is a
+// 404.
+func TestHandlePauseOncePost(t *testing.T) {
+	t.Parallel()
+
+	post := func(t *testing.T, env *testEnv, playerID int64, path string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		mux := http.NewServeMux()
+		mux.Handle(
+			"POST /api/games/{gameID}/questions/{questionID}/pause-once",
+			HandlePauseOncePost(env.logger, env.service),
+		)
+		req := httptest.NewRequestWithContext(withPlayer(t.Context(), playerID), http.MethodPost, path, nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("pauses once per game", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Pause", "pause"))
+		playerID := env.seedPlayer(t, "pause-ok")
+
+		g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		item, err := env.service.GetNext(t.Context(), g.ID, playerID)
+		if err != nil {
+			t.Fatalf("GetNext err = %v, want nil", err)
+		}
+		pausePath := fmt.Sprintf("/api/games/%s/questions/%d/pause-once", g.ID, item.Question.QuestionID)
+
+		rec := post(t, env, playerID, pausePath)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+		var res client.PauseResponse
+		if err = json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if want := item.Question.ExpiredAt.Add(game.PauseExtension); !res.ExpiredAt.Equal(want) {
+			t.Errorf("expiredAt = %v, want %v", res.ExpiredAt, want)
+		}
+		if got, want := res.ExtensionSeconds, int(game.PauseExtension/time.Second); got != want {
+			t.Errorf("extensionSeconds = %d, want %d", got, want)
+		}
+
+		if rec = post(t, env, playerID, pausePath); rec.Code != http.StatusConflict {
+			t.Errorf("second pause status = %v, want %v", rec.Code, http.StatusConflict)
+		}
+	})
+
+	t.Run("returns 404 when game not found", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		playerID := env.seedPlayer(t, "pause-nogame")
+
+		rec := post(t, env, playerID, "/api/games/missing/questions/1/pause-once")
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status code = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestHandleHintPost(t *testing.T) {
+	t.Parallel()
+
+	post := func(t *testing.T, env *testEnv, playerID int64, path string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		mux := http.NewServeMux()
+		mux.Handle("POST /api/games/{gameID}/questions/{questionID}/hint", HandleHintPost(env.logger, env.service))
+		req := httptest.NewRequestWithContext(withPlayer(t.Context(), playerID), http.MethodPost, path, nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		return rec
+	}
+	// startGame issues the first question of qz to a new player's game and
+	// returns the player and the hint path for it.
+	startGame := func(t *testing.T, env *testEnv, qz *quiz.Quiz, name string) (int64, string) {
+		t.Helper()
+
+		playerID := env.seedPlayer(t, name)
+		g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		item, err := env.service.GetNext(t.Context(), g.ID, playerID)
+		if err != nil {
+			t.Fatalf("GetNext err = %v, want nil", err)
+		}
+
+		return playerID, fmt.Sprintf("/api/games/%s/questions/%d/hint", g.ID, item.Question.QuestionID)
+	}
+
+	t.Run("returns the hint and its penalty", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		hinted := twoQuestionQuiz("Hint", "hint")
+		for _, q := range hinted.Questions {
+			q.Hint = "It is on a river."
+		}
+		playerID, hintPath := startGame(t, env, env.seedQuiz(t, hinted), "hint-ok")
+
+		for range 2 {
+			rec := post(t, env, playerID, hintPath)
+			if got, want := rec.Code, http.StatusOK; got != want {
+				t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+			}
+			var res client.HintResponse
+			if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+				t.Fatalf("decode err = %v, want nil", err)
+			}
+			if res.Hint != "It is on a river." || res.PenaltyPercent != game.DefaultHintPenalty {
+				t.Errorf("response = %+v, want the hint at %d%%", res, game.DefaultHintPenalty)
+			}
+		}
+	})
+
+	t.Run("returns 409 when the question has no hint", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		playerID, hintPath := startGame(t, env, env.seedQuiz(t, twoQuestionQuiz("No hint", "no-hint")), "hint-none")
+
+		if got, want := post(t, env, playerID, hintPath).Code, http.StatusConflict; got != want {
+			t.Errorf("status code = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("returns 404 when game not found", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		playerID := env.seedPlayer(t, "hint-nogame")
+
+		rec := post(t, env, playerID, "/api/games/missing/questions/1/hint")
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status code = %v, want %v", got, want)
+		}
+	})
+}
+
 func TestHandleGameResults(t *testing.T) {
 	t.Parallel()
 
@@ -1237,6 +1797,248 @@ func TestHandleGameResults(t *testing.T) {
 	})
 }
 
+func TestHandleFinishGame(t *testing.T) {
+	t.Parallel()
+
+	t.Run("abandons the game and answers 410 afterwards", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Finish Early", "finish-early"))
+		playerID := env.seedPlayer(t, "finish-early")
+
+		ctx := t.Context()
+		g, err := env.service.CreateGame(ctx, qz.ID, playerID, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("POST /api/games/{gameID}/finish", HandleFinishGame(env.logger, env.service))
+		mux.Handle("GET /api/games/{gameID}/questions/next", HandleQuestionNext(env.logger, env.service))
+
+		req := httptest.NewRequestWithContext(
+			withPlayer(ctx, playerID), http.MethodPost, "/api/games/"+g.ID+"/finish", nil,
+		)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+		var res client.FinishGameResponse
+		if err = json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if got, want := res.Status, string(game.GameStatusAbandoned); got != want {
+			t.Errorf("status = %q, want %q", got, want)
+		}
+		if res.FinishedAt == nil {
+			t.Error("finishedAt = nil, want the finish time")
+		}
+
+		req = httptest.NewRequestWithContext(
+			withPlayer(ctx, playerID), http.MethodGet, "/api/games/"+g.ID+"/questions/next", nil,
+		)
+		rec = httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		if got, want := rec.Code, http.StatusGone; got != want {
+			t.Errorf("next status code = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("returns 404 for a stranger", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Finish Stranger", "finish-stranger"))
+		owner := env.seedPlayer(t, "finish-owner")
+		stranger := env.seedPlayer(t, "finish-stranger")
+
+		g, err := env.service.CreateGame(t.Context(), qz.ID, owner, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("POST /api/games/{gameID}/finish", HandleFinishGame(env.logger, env.service))
+
+		req := httptest.NewRequestWithContext(
+			withPlayer(t.Context(), stranger), http.MethodPost, "/api/games/"+g.ID+"/finish", nil,
+		)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status code = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestHandleCompareResults(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	qz := env.seedQuiz(t, twoQuestionQuiz("Results Compare", "results-compare"))
+	ctx := t.Context()
+
+	// alice answers both questions right, bob both wrong: alice beats the
+	// one other player who completed the quiz.
+	play := func(playerID int64, right bool) string {
+		t.Helper()
+		g, err := env.service.CreateGame(ctx, qz.ID, playerID, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		for i := range 2 {
+			if _, gerr := env.service.GetNext(ctx, g.ID, playerID); gerr != nil {
+				t.Fatalf("GetNext(%d) err = %v, want nil", i, gerr)
+			}
+			qID, opt := correctOptionID(t, qz, i)
+			if !right {
+				opt = wrongOptionID(t, qz, i)
+			}
+			if _, serr := env.service.SubmitAnswer(ctx, g.ID, playerID, qID, opt, time.Time{}); serr != nil {
+				t.Fatalf("SubmitAnswer(%d) err = %v, want nil", i, serr)
+			}
+		}
+
+		return g.ID
+	}
+	alice := env.seedPlayer(t, "alice-compare")
+	bob := env.seedPlayer(t, "bob-compare")
+	aliceGame := play(alice, true)
+	play(bob, false)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/games/{gameID}/results/compare", HandleCompareResults(env.logger, env.service))
+
+	t.Run("compares the player's score with the others", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequestWithContext(
+			withPlayer(ctx, alice), http.MethodGet, "/api/games/"+aliceGame+"/results/compare", nil,
+		)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status = %d, want %d (body=%q)", got, want, rec.Body.String())
+		}
+
+		var body client.ResultsComparison
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("decode err = %v", err)
+		}
+		if body.Score <= 0 {
+			t.Errorf("score = %d, want > 0", body.Score)
+		}
+		if got, want := body.ComparedPlayers, 1; got != want {
+			t.Errorf("comparedPlayers = %d, want %d", got, want)
+		}
+		if got, want := body.Percentile, 100; got != want {
+			t.Errorf("percentile = %d, want %d", got, want)
+		}
+		if got, want := body.AverageScore, 0.0; got != want {
+			t.Errorf("averageScore = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("non-participant is a 404", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequestWithContext(
+			withPlayer(ctx, bob), http.MethodGet, "/api/games/"+aliceGame+"/results/compare", nil,
+		)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+}
+
+func TestHandlePublicResults(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	qz := env.seedQuiz(t, twoQuestionQuiz("Public Results", "public-results"))
+	ctx := t.Context()
+
+	// finish plays both questions right and finishes the game.
+	finish := func(playerID int64) string {
+		t.Helper()
+		g, err := env.service.CreateGame(ctx, qz.ID, playerID, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		for i := range 2 {
+			if _, gerr := env.service.GetNext(ctx, g.ID, playerID); gerr != nil {
+				t.Fatalf("GetNext(%d) err = %v, want nil", i, gerr)
+			}
+			qID, opt := correctOptionID(t, qz, i)
+			if _, serr := env.service.SubmitAnswer(ctx, g.ID, playerID, qID, opt, time.Time{}); serr != nil {
+				t.Fatalf("SubmitAnswer(%d) err = %v, want nil", i, serr)
+			}
+		}
+		if _, ferr := env.service.FinishGame(ctx, g.ID, playerID); ferr != nil {
+			t.Fatalf("FinishGame err = %v, want nil", ferr)
+		}
+
+		return g.ID
+	}
+	publicGame := finish(env.seedPlayer(t, "alice-public"))
+	privateGame := finish(env.seedPlayer(t, "bob-private"))
+	if err := env.service.SetResultsPublic(ctx, publicGame, true); err != nil {
+		t.Fatalf("SetResultsPublic err = %v, want nil", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/games/{gameID}/results/public", HandlePublicResults(env.logger, env.service))
+
+	t.Run("serves names and scores without a player", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/games/"+publicGame+"/results/public", nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status = %d, want %d (body=%q)", got, want, rec.Body.String())
+		}
+		if strings.Contains(rec.Body.String(), "playerId") {
+			t.Errorf("body = %q, should not carry player IDs", rec.Body.String())
+		}
+
+		var body client.PublicResults
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("decode err = %v", err)
+		}
+		if got, want := len(body.Standings), 1; got != want {
+			t.Fatalf("len(standings) = %d, want %d", got, want)
+		}
+		if got, want := body.Standings[0].DisplayName, "alice-public"; got != want {
+			t.Errorf("displayName = %q, want %q", got, want)
+		}
+		if got, want := body.Standings[0].Rank, 1; got != want {
+			t.Errorf("rank = %d, want %d", got, want)
+		}
+		if body.Standings[0].Score <= 0 {
+			t.Errorf("score = %d, want > 0", body.Standings[0].Score)
+		}
+	})
+
+	t.Run("a game left private is a 404", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/games/"+privateGame+"/results/public", nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+	})
+}
+
 // resultsTestPlayerScore mirrors one game-results playerScores entry.
 type resultsTestPlayerScore struct {
 	PlayerID int64 `json:"playerId"`
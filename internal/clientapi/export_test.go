@@ -1,6 +1,33 @@
 package clientapi
 
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/starquake/topbanana/internal/livesession"
+)
+
 // ExportShuffleBySeed exposes the unexported shuffleBySeed helper so
 // the external clientapi_test package can pin its determinism and
 // permutation contracts without becoming a whitebox test.
 var ExportShuffleBySeed = shuffleBySeed
+
+// ExportNewIdempotencyCacheWithClock re-exports newIdempotencyCacheWithClock
+// so a test can expire stored responses by advancing an injected clock rather
+// than sleeping past the TTL.
+var ExportNewIdempotencyCacheWithClock = newIdempotencyCacheWithClock
+
+// ExportRunSessionEventStream drives the session SSE stream loop over events
+// into w until events closes or ctx ends, so a test can pin the write
+// batching without a hub or a server. The heartbeat is pushed out of reach.
+func ExportRunSessionEventStream(ctx context.Context, w http.ResponseWriter, events <-chan livesession.Tick) {
+	s := &sessionEventStreamer{
+		w:                 w,
+		rc:                http.NewResponseController(w),
+		logger:            slog.New(slog.DiscardHandler),
+		heartbeatInterval: time.Hour,
+	}
+	s.run(ctx, events)
+}
@@ -0,0 +1,73 @@
+package clientapi
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// HandleGameHistory returns a handler for GET /api/players/me/games: the
+// calling player's past games, newest first, each with its quiz, when it
+// was played, the player's score and their rank on the quiz leaderboard,
+// for the "Your previous games" screen. The list is always paged, with
+// ?page= and ?pageSize= read as for GET /api/quizzes, and the envelope meta
+// carries the total. Owner previews are left out. Returns 400 for a page or
+// pageSize that is not a positive integer.
+func HandleGameHistory(logger *slog.Logger, service *game.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		current, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			handlers.WriteError(w, r, http.StatusUnauthorized, "unauthenticated")
+
+			return
+		}
+
+		pg, paged, err := parseQuizListPage(r)
+		if err != nil {
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
+
+			return
+		}
+		if !paged {
+			pg = quizListPage{page: 1, pageSize: defaultQuizPageSize}
+		}
+
+		entries, total, err := service.GetGameHistory(
+			ctx, current.ID, int64(pg.pageSize), int64(pg.page-1)*int64(pg.pageSize),
+		)
+		if err != nil {
+			writeInternalError(w, r, logger, "error retrieving game history", err)
+
+			return
+		}
+
+		res := make([]client.PlayedGame, 0, len(entries))
+		for _, e := range entries {
+			res = append(res, client.PlayedGame{
+				GameID:        e.GameID,
+				QuizID:        e.QuizID,
+				QuizTitle:     e.QuizTitle,
+				QuizSlug:      e.QuizSlug,
+				PlayedAt:      e.CreatedAt,
+				FinishedAt:    e.FinishedAt,
+				Status:        string(e.Status),
+				Score:         e.Score,
+				Rank:          e.Rank,
+				AnsweredCount: e.AnsweredCount,
+				QuestionCount: e.QuestionCount,
+				ScoresHidden:  e.ScoresHidden,
+			})
+		}
+		meta := client.ListMeta{Count: len(res), Total: total, Page: pg.page, PageSize: pg.pageSize}
+
+		if err = handlers.WriteDataMeta(w, r, http.StatusOK, res, meta); err != nil {
+			logger.ErrorContext(ctx, "error encoding game history", slog.Any("err", err))
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package clientapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/clientapi"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// TestHandleGameHistory pins GET /api/players/me/games: the caller's games
+// newest first with their score and leaderboard rank, paged with the total
+// in the meta, and a bad page refused.
+func TestHandleGameHistory(t *testing.T) {
+	t.Parallel()
+
+	get := func(t *testing.T, env *testEnv, playerID int64, query string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req := httptest.NewRequestWithContext(
+			withPlayer(t.Context(), playerID), http.MethodGet, "/api/players/me/games"+query, nil,
+		)
+		rec := httptest.NewRecorder()
+		handlers.WithAPIShapes(HandleGameHistory(env.logger, env.service), false).ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("lists the player's games with score and rank", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		first := env.seedQuiz(t, twoQuestionQuiz("First", "first"))
+		second := env.seedQuiz(t, twoQuestionQuiz("Second", "second"))
+		playerID := env.seedPlayer(t, "history")
+		rivalID := env.seedPlayer(t, "rival")
+
+		// On the first quiz a rival does better; the second is played alone.
+		env.playCorrectly(t, first, rivalID, 2)
+		firstGame := env.playCorrectly(t, first, playerID, 1)
+		secondGame := env.playCorrectly(t, second, playerID, 2)
+
+		rec := get(t, env, playerID, "")
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+		var res struct {
+			Data []client.PlayedGame `json:"data"`
+			Meta client.ListMeta     `json:"meta"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if got, want := res.Meta.Total, int64(2); got != want {
+			t.Errorf("meta.total = %d, want %d", got, want)
+		}
+		if got, want := len(res.Data), 2; got != want {
+			t.Fatalf("len(data) = %d, want %d", got, want)
+		}
+		latest, earlier := res.Data[0], res.Data[1]
+		if latest.GameID != secondGame || earlier.GameID != firstGame {
+			t.Fatalf("games = %s, %s, want %s, %s (newest first)", latest.GameID, earlier.GameID, secondGame, firstGame)
+		}
+		if latest.QuizTitle != "Second" || latest.Rank != 1 || latest.Score <= 0 || latest.AnsweredCount != 2 {
+			t.Errorf("latest = %+v, want Second ranked 1 with a score and 2 answers", latest)
+		}
+		if earlier.Rank != 2 || earlier.Score <= 0 || earlier.QuestionCount != 2 {
+			t.Errorf("earlier = %+v, want ranked 2 behind the rival with a score", earlier)
+		}
+
+		rec = get(t, env, playerID, "?page=2&pageSize=1")
+		if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatalf("decode page 2 err = %v, want nil", err)
+		}
+		if len(res.Data) != 1 || res.Data[0].GameID != firstGame || res.Meta.Page != 2 {
+			t.Errorf("page 2 = %+v (meta %+v), want the first game alone", res.Data, res.Meta)
+		}
+	})
+
+	t.Run("returns an empty list for a player without games", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		playerID := env.seedPlayer(t, "newcomer")
+
+		rec := get(t, env, playerID, "")
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("status code = %v, want %v", got, want)
+		}
+		var res struct {
+			Data []client.PlayedGame `json:"data"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if res.Data == nil || len(res.Data) != 0 {
+			t.Errorf("data = %v, want an empty list", res.Data)
+		}
+	})
+
+	t.Run("returns 400 for a bad page", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		playerID := env.seedPlayer(t, "bad-page")
+
+		if rec := get(t, env, playerID, "?page=0"); rec.Code != http.StatusBadRequest {
+			t.Errorf("status code = %v, want %v", rec.Code, http.StatusBadRequest)
+		}
+	})
+}
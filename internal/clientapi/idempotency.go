@@ -0,0 +1,272 @@
+package clientapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/handlers"
+)
+
+// IdempotencyKeyHeader is the request header a client sets to make a retried
+// POST safe: the first response for a key is stored and replayed verbatim for
+// every retry that carries the same key and body.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotentReplayedHeader marks a response served from the cache rather than
+// by running the handler again, so a client (and a test) can tell the two
+// apart.
+const idempotentReplayedHeader = "Idempotent-Replayed"
+
+// DefaultIdempotencyTTL is how long a stored response stays replayable. It
+// only has to outlive a client's retry loop, not the game.
+const DefaultIdempotencyTTL = 10 * time.Minute
+
+// maxIdempotencyKeyLen bounds the key so a hostile client cannot pin large
+// strings in the cache map.
+const maxIdempotencyKeyLen = 255
+
+// maxIdempotentBodySize matches the /api/* JSON body cap in handlers.DecodeJSON;
+// the body is buffered here first so it can be hashed and handed on intact.
+const maxIdempotentBodySize = 64 * 1024
+
+// IdempotencyCache stores the response to each keyed POST for a TTL so a
+// retry after a network error replays the original outcome instead of
+// creating a second game or re-submitting an answer. Entries are scoped to
+// the player, method and path, so two players (or two endpoints) may reuse
+// the same key independently. Concurrency-safe; expired entries are pruned
+// on every Begin so memory stays proportional to recent traffic (same
+// prune-on-each-call shape as mediahttp.UploadBudgetLimiter).
+type IdempotencyCache struct {
+	mu      sync.Mutex
+	entries map[idempotencyScope]*idempotencyEntry
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// idempotencyScope is the cache key: one client key per player per endpoint.
+// The response shape is deliberately not part of it, so a retry that flips
+// [handlers.EnvelopeHeader] still finds the original and cannot run the POST
+// a second time.
+type idempotencyScope struct {
+	playerID int64
+	method   string
+	path     string
+	key      string
+}
+
+// idempotencyEntry is one keyed request: pending until the handler finishes,
+// then the stored response. bodyHash detects a key reused for a different
+// request, and enveloped the response shape the stored body was written in.
+type idempotencyEntry struct {
+	bodyHash  [sha256.Size]byte
+	enveloped bool
+	pending   bool
+	status    int
+	header    http.Header
+	body      []byte
+	expiresAt time.Time
+}
+
+// NewIdempotencyCache returns a cache holding responses for ttl, using
+// [time.Now] as the clock.
+func NewIdempotencyCache(ttl time.Duration) *IdempotencyCache {
+	return newIdempotencyCacheWithClock(ttl, time.Now)
+}
+
+func newIdempotencyCacheWithClock(ttl time.Duration, now func() time.Time) *IdempotencyCache {
+	return &IdempotencyCache{
+		entries: map[idempotencyScope]*idempotencyEntry{},
+		ttl:     ttl,
+		now:     now,
+	}
+}
+
+// begin claims scope for a new request with the given body hash and response
+// shape. It returns the existing entry when the scope is already known (the
+// caller decides between replay, in-flight and mismatch), or nil after
+// recording a pending entry the caller must later finish or abandon.
+func (c *IdempotencyCache) begin(
+	scope idempotencyScope, bodyHash [sha256.Size]byte, enveloped bool,
+) *idempotencyEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.prune(now)
+
+	if e, ok := c.entries[scope]; ok {
+		snapshot := *e
+
+		return &snapshot
+	}
+	c.entries[scope] = &idempotencyEntry{
+		bodyHash: bodyHash, enveloped: enveloped, pending: true, expiresAt: now.Add(c.ttl),
+	}
+
+	return nil
+}
+
+// finish stores the handler's response under scope and restarts its TTL.
+func (c *IdempotencyCache) finish(scope idempotencyScope, status int, header http.Header, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[scope]
+	if !ok {
+		return
+	}
+	e.pending = false
+	e.status = status
+	e.header = header
+	e.body = body
+	e.expiresAt = c.now().Add(c.ttl)
+}
+
+// abandon forgets scope so the next retry runs the handler again. Used for
+// server errors, which a client is expected to retry.
+func (c *IdempotencyCache) abandon(scope idempotencyScope) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, scope)
+}
+
+// prune drops every entry whose TTL has passed.
+func (c *IdempotencyCache) prune(now time.Time) {
+	for scope, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, scope)
+		}
+	}
+}
+
+// replayedHeaders is the subset of response headers stored with an entry.
+// Session cookies are deliberately excluded: a replay must not re-issue a
+// Set-Cookie minted for the original request.
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+// WithIdempotency wraps a POST handler so a request carrying an
+// [IdempotencyKeyHeader] runs at most once per key. A retry with the same key
+// and body gets the stored status, headers and body; the same key with a
+// different body, or asking for the other response shape than the original
+// did, is a 422 (the POST is never run twice), and a retry that lands while the original is still
+// running is a 409. Requests without the header pass straight through, and a
+// 5xx is not stored so the client's retry gets a fresh attempt. Must run
+// inside EnsurePlayer: the key is scoped to the player on the context.
+func WithIdempotency(cache *IdempotencyCache, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+		ctx := r.Context()
+		logger := handlers.LoggerFromContext(ctx)
+
+		if len(key) > maxIdempotencyKeyLen {
+			handlers.WriteError(w, r, http.StatusBadRequest, "Idempotency-Key is too long")
+
+			return
+		}
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for idempotent request")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+		if err != nil {
+			handlers.WriteError(w, r, http.StatusRequestEntityTooLarge, "request body too large")
+
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		scope := idempotencyScope{playerID: player.ID, method: r.Method, path: r.URL.Path, key: key}
+		bodyHash := sha256.Sum256(body)
+		if existing := cache.begin(scope, bodyHash, handlers.Enveloped(r)); existing != nil {
+			replayIdempotent(w, r, existing, bodyHash)
+
+			return
+		}
+
+		rec := &idempotencyRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		if rec.status >= http.StatusInternalServerError {
+			cache.abandon(scope)
+
+			return
+		}
+		header := http.Header{}
+		for _, name := range replayedHeaders {
+			if v := w.Header().Get(name); v != "" {
+				header.Set(name, v)
+			}
+		}
+		cache.finish(scope, rec.status, header, rec.body.Bytes())
+		logger.DebugContext(ctx, "stored idempotent response", slog.Int("status", rec.status))
+	})
+}
+
+// replayIdempotent answers a request whose key is already known: a 409 while
+// the original is in flight, a 422 when the body or the response shape
+// differs from the original, otherwise the stored response.
+func replayIdempotent(w http.ResponseWriter, r *http.Request, e *idempotencyEntry, bodyHash [sha256.Size]byte) {
+	if e.bodyHash != bodyHash {
+		handlers.WriteError(w, r, http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request")
+
+		return
+	}
+	if e.enveloped != handlers.Enveloped(r) {
+		handlers.WriteError(w, r, http.StatusUnprocessableEntity,
+			"Idempotency-Key was already used with a different "+handlers.EnvelopeHeader)
+
+		return
+	}
+	if e.pending {
+		handlers.WriteError(w, r, http.StatusConflict, "a request with this Idempotency-Key is still in progress")
+
+		return
+	}
+	for name, values := range e.header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(e.status)
+	_, _ = w.Write(e.body)
+}
+
+// idempotencyRecorder passes the response through to the client while keeping
+// a copy of the status and body for the cache.
+type idempotencyRecorder struct {
+	http.ResponseWriter
+
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rec *idempotencyRecorder) WriteHeader(status int) {
+	if !rec.wroteHeader {
+		rec.status = status
+		rec.wroteHeader = true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *idempotencyRecorder) Write(b []byte) (int, error) {
+	rec.wroteHeader = true
+	rec.body.Write(b)
+
+	return rec.ResponseWriter.Write(b)
+}
@@ -0,0 +1,222 @@
+package clientapi_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/clientapi"
+	"github.com/starquake/topbanana/internal/handlers"
+)
+
+// countingCreateHandler stands in for a game-creating POST: every run mints a
+// new id, so a replay is observable as the same id coming back.
+func countingCreateHandler(calls *atomic.Int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", fmt.Sprintf("/play/game/g%d", n))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"id":"g%d"}`, n)
+	})
+}
+
+func serveIdempotent(t *testing.T, h http.Handler, playerID int64, key, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(
+		withPlayer(t.Context(), playerID), http.MethodPost, "/api/games", strings.NewReader(body),
+	)
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func TestWithIdempotency_ReplaysStoredResponse(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+	h := WithIdempotency(NewIdempotencyCache(time.Minute), countingCreateHandler(&calls))
+
+	first := serveIdempotent(t, h, 1, "k1", `{"quizId":1}`)
+	retry := serveIdempotent(t, h, 1, "k1", `{"quizId":1}`)
+
+	if got, want := calls.Load(), int64(1); got != want {
+		t.Errorf("handler calls = %d, want %d (retry must not run the handler)", got, want)
+	}
+	if got, want := retry.Code, first.Code; got != want {
+		t.Errorf("retry status = %d, want %d", got, want)
+	}
+	if got, want := retry.Body.String(), first.Body.String(); got != want {
+		t.Errorf("retry body = %q, want %q", got, want)
+	}
+	if got, want := retry.Header().Get("Location"), first.Header().Get("Location"); got != want {
+		t.Errorf("retry Location = %q, want %q", got, want)
+	}
+	if got, want := retry.Header().Get("Idempotent-Replayed"), "true"; got != want {
+		t.Errorf("retry Idempotent-Replayed = %q, want %q", got, want)
+	}
+	if got := first.Header().Get("Idempotent-Replayed"); got != "" {
+		t.Errorf("first Idempotent-Replayed = %q, want empty", got)
+	}
+}
+
+func TestWithIdempotency_MismatchedBodyIsRejected(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+	h := WithIdempotency(NewIdempotencyCache(time.Minute), countingCreateHandler(&calls))
+
+	serveIdempotent(t, h, 1, "k1", `{"quizId":1}`)
+	rr := serveIdempotent(t, h, 1, "k1", `{"quizId":2}`)
+
+	if got, want := rr.Code, http.StatusUnprocessableEntity; got != want {
+		t.Errorf("status = %d, want %d", got, want)
+	}
+	if got, want := calls.Load(), int64(1); got != want {
+		t.Errorf("handler calls = %d, want %d", got, want)
+	}
+}
+
+func TestWithIdempotency_OtherShapeIsRejected(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+	h := handlers.WithAPIShapes(
+		WithIdempotency(NewIdempotencyCache(time.Minute), countingCreateHandler(&calls)), true,
+	)
+	serve := func(envelope string) *httptest.ResponseRecorder {
+		req := httptest.NewRequestWithContext(
+			withPlayer(t.Context(), 1), http.MethodPost, "/api/games", strings.NewReader(`{"quizId":1}`),
+		)
+		req.Header.Set(IdempotencyKeyHeader, "k1")
+		req.Header.Set(handlers.EnvelopeHeader, envelope)
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	serve("false")
+	rr := serve("true")
+
+	if got, want := rr.Code, http.StatusUnprocessableEntity; got != want {
+		t.Errorf("status = %d, want %d", got, want)
+	}
+	if got, want := calls.Load(), int64(1); got != want {
+		t.Errorf("handler calls = %d, want %d (a shape flip must not create a second game)", got, want)
+	}
+	if got, want := serve("false").Header().Get("Idempotent-Replayed"), "true"; got != want {
+		t.Errorf("retry in the original shape Idempotent-Replayed = %q, want %q", got, want)
+	}
+}
+
+func TestWithIdempotency_InFlightRetryConflicts(t *testing.T) {
+	t.Parallel()
+
+	cache := NewIdempotencyCache(time.Minute)
+	var inner http.Handler
+	var retry *httptest.ResponseRecorder
+	// The retry is issued from inside the original request, so it is
+	// guaranteed to land while the original is still pending.
+	inner = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		retry = serveIdempotent(t, WithIdempotency(cache, inner), 1, "k1", `{}`)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	serveIdempotent(t, WithIdempotency(cache, inner), 1, "k1", `{}`)
+
+	if got, want := retry.Code, http.StatusConflict; got != want {
+		t.Errorf("in-flight retry status = %d, want %d", got, want)
+	}
+}
+
+func TestWithIdempotency_ScopedPerPlayer(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+	h := WithIdempotency(NewIdempotencyCache(time.Minute), countingCreateHandler(&calls))
+
+	a := serveIdempotent(t, h, 1, "shared", `{}`)
+	b := serveIdempotent(t, h, 2, "shared", `{}`)
+
+	if got, want := calls.Load(), int64(2); got != want {
+		t.Errorf("handler calls = %d, want %d (keys must not cross players)", got, want)
+	}
+	if got, want := b.Body.String(), a.Body.String(); got == want {
+		t.Errorf("player 2 body = %q, want a fresh response", got)
+	}
+}
+
+func TestWithIdempotency_WithoutKeyPassesThrough(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+	h := WithIdempotency(NewIdempotencyCache(time.Minute), countingCreateHandler(&calls))
+
+	serveIdempotent(t, h, 1, "", `{}`)
+	serveIdempotent(t, h, 1, "", `{}`)
+
+	if got, want := calls.Load(), int64(2); got != want {
+		t.Errorf("handler calls = %d, want %d", got, want)
+	}
+}
+
+func TestWithIdempotency_ServerErrorIsNotStored(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+	h := WithIdempotency(NewIdempotencyCache(time.Minute), http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	serveIdempotent(t, h, 1, "k1", `{}`)
+	rr := serveIdempotent(t, h, 1, "k1", `{}`)
+
+	if got, want := rr.Code, http.StatusCreated; got != want {
+		t.Errorf("retry after 5xx status = %d, want %d (a 5xx must be retryable)", got, want)
+	}
+}
+
+func TestWithIdempotency_ExpiresAfterTTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var calls atomic.Int64
+	cache := ExportNewIdempotencyCacheWithClock(time.Minute, func() time.Time { return now })
+	h := WithIdempotency(cache, countingCreateHandler(&calls))
+
+	serveIdempotent(t, h, 1, "k1", `{}`)
+	now = now.Add(time.Minute)
+	serveIdempotent(t, h, 1, "k1", `{}`)
+
+	if got, want := calls.Load(), int64(2); got != want {
+		t.Errorf("handler calls = %d, want %d (an expired key runs the handler again)", got, want)
+	}
+}
+
+func TestWithIdempotency_KeyTooLong(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+	h := WithIdempotency(NewIdempotencyCache(time.Minute), countingCreateHandler(&calls))
+
+	rr := serveIdempotent(t, h, 1, strings.Repeat("k", 256), `{}`)
+
+	if got, want := rr.Code, http.StatusBadRequest; got != want {
+		t.Errorf("status = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,40 @@
+package clientapi
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/ratelimit"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// HandleLimits reports the caller's standing against every rate limiter the
+// routes registered: per-IP cool-downs on the caller's address, upload and
+// import budgets on the session player. Probing charges nothing, so a client
+// can check before it retries instead of spending an attempt on a 429.
+func HandleLimits(logger *slog.Logger, limits *ratelimit.Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		player, ok := auth.PlayerFromContext(r.Context())
+		if !ok {
+			handlers.WriteError(w, r, http.StatusUnauthorized, "unauthenticated")
+
+			return
+		}
+
+		entries := limits.Snapshot(r, player.ID)
+		res := client.Limits{Limits: make([]client.RateLimit, len(entries))}
+		for i, e := range entries {
+			res.Limits[i] = client.RateLimit{
+				Name:         e.Name,
+				Limit:        e.Limit,
+				Remaining:    e.Remaining,
+				ResetSeconds: e.ResetSeconds(),
+			}
+		}
+		if err := handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding limits response", slog.Any("err", err))
+		}
+	})
+}
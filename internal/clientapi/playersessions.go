@@ -0,0 +1,127 @@
+package clientapi
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/session"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// sessionListWindow is how far back GET /api/players/me/sessions looks: a
+// session not seen for longer has outlived its cookie.
+const sessionListWindow = session.MaxAge * time.Second
+
+// HandlePlayerSessionList returns a handler for GET /api/players/me/sessions:
+// the devices the calling player is signed in on, most recently used first,
+// with the one making the request marked current. A session signed in
+// before sessions were recorded is not listed until the player signs in
+// again.
+func HandlePlayerSessionList(logger *slog.Logger, devices session.DeviceStore, sessions *session.Manager) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			handlers.WriteError(w, r, http.StatusUnauthorized, "unauthenticated")
+
+			return
+		}
+		current, _ := sessions.Current(r)
+
+		list, err := devices.ListSessions(ctx, player.ID, time.Now().Add(-sessionListWindow))
+		if err != nil {
+			writeInternalError(w, r, logger, "error listing player sessions", err)
+
+			return
+		}
+
+		res := make([]client.PlayerSession, 0, len(list))
+		for _, d := range list {
+			res = append(res, client.PlayerSession{
+				ID:         d.ID,
+				UserAgent:  d.UserAgent,
+				IPAddress:  d.IPAddress,
+				CreatedAt:  d.CreatedAt,
+				LastSeenAt: d.LastSeenAt,
+				Current:    current.ID != "" && d.ID == current.ID,
+			})
+		}
+
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(ctx, "error encoding player sessions", slog.Any("err", err))
+		}
+	})
+}
+
+// HandlePlayerSessionRevoke returns a handler for
+// DELETE /api/players/me/sessions/{sessionID}: it signs the calling player
+// out of one of their sessions, which is refused from its next request on.
+// Revoking the current session also clears its cookie. Returns 204, or 404
+// when the id is not a live session of the player.
+func HandlePlayerSessionRevoke(
+	logger *slog.Logger, devices session.DeviceStore, sessions *session.Manager,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			handlers.WriteError(w, r, http.StatusUnauthorized, "unauthenticated")
+
+			return
+		}
+
+		id := r.PathValue("sessionID")
+		if err := devices.RevokeSession(ctx, player.ID, id); err != nil {
+			if errors.Is(err, session.ErrSessionNotFound) {
+				handlers.NotFound(w, r)
+
+				return
+			}
+			writeInternalError(w, r, logger, "error revoking player session", err)
+
+			return
+		}
+		if current, ok := sessions.Current(r); ok && current.ID == id {
+			sessions.Clear(w)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandlePlayerSessionRevokeOthers returns a handler for
+// DELETE /api/players/me/sessions: it signs the calling player out
+// everywhere but the session making the request. Every other cookie is
+// invalidated, including ones minted before sessions were recorded, and the
+// current cookie is re-issued so it stays signed in. Returns 204.
+func HandlePlayerSessionRevokeOthers(
+	logger *slog.Logger, devices session.DeviceStore, sessions *session.Manager,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			handlers.WriteError(w, r, http.StatusUnauthorized, "unauthenticated")
+
+			return
+		}
+		current, _ := sessions.Current(r)
+
+		version, err := devices.RevokeOtherSessions(ctx, player.ID, current.ID)
+		if err != nil {
+			writeInternalError(w, r, logger, "error revoking other player sessions", err)
+
+			return
+		}
+		sessions.Renew(w, r, player.ID, version)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
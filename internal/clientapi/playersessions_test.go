@@ -0,0 +1,94 @@
+package clientapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/auth"
+	. "github.com/starquake/topbanana/internal/clientapi"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/session"
+	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// TestPlayerSessionHandlers pins /api/players/me/sessions: the list marks the
+// session making the request current, revoking an unknown id is a 404, and
+// revoking the current session clears its cookie.
+func TestPlayerSessionHandlers(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	devices := store.NewPlayerStore(env.db, env.logger)
+	sessions := session.New([]byte("test-key"), false)
+	playerID := env.seedPlayer(t, "sessions")
+
+	// signedIn returns a request for method and target carrying a freshly
+	// minted, recorded session of the player, with that session's id.
+	signedIn := func(t *testing.T, method, target string) (*http.Request, string) {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		sessions.Set(rec, playerID, 0)
+		req := httptest.NewRequestWithContext(
+			auth.WithPlayer(t.Context(), &auth.Player{ID: playerID}), method, target, nil,
+		)
+		for _, c := range rec.Result().Cookies() {
+			req.AddCookie(c)
+		}
+		s, _ := sessions.Current(req)
+		if err := devices.TouchSession(t.Context(), &session.Device{ID: s.ID, PlayerID: playerID}); err != nil {
+			t.Fatalf("TouchSession err = %v, want nil", err)
+		}
+
+		return req, s.ID
+	}
+
+	_, otherID := signedIn(t, http.MethodGet, "/")
+	req, currentID := signedIn(t, http.MethodGet, "/api/players/me/sessions")
+	rec := httptest.NewRecorder()
+	handlers.WithAPIShapes(HandlePlayerSessionList(env.logger, devices, sessions), false).ServeHTTP(rec, req)
+	if got, want := rec.Code, http.StatusOK; got != want {
+		t.Fatalf("list status = %d, want %d", got, want)
+	}
+	var res struct {
+		Data []client.PlayerSession `json:"data"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+		t.Fatalf("decode err = %v, want nil", err)
+	}
+	current := map[string]bool{}
+	for _, s := range res.Data {
+		current[s.ID] = s.Current
+	}
+	if len(current) != 2 || !current[currentID] || current[otherID] {
+		t.Errorf("sessions = %+v, want %s current and %s not", res.Data, currentID, otherID)
+	}
+
+	revoke := func(t *testing.T, req *http.Request, id string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req.SetPathValue("sessionID", id)
+		rec := httptest.NewRecorder()
+		handlers.WithAPIShapes(HandlePlayerSessionRevoke(env.logger, devices, sessions), false).ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	req, _ = signedIn(t, http.MethodDelete, "/api/players/me/sessions/nope")
+	if got, want := revoke(t, req, "nope").Code, http.StatusNotFound; got != want {
+		t.Errorf("unknown session status = %d, want %d", got, want)
+	}
+
+	req, currentID = signedIn(t, http.MethodDelete, "/api/players/me/sessions/current")
+	rec = revoke(t, req, currentID)
+	if got, want := rec.Code, http.StatusNoContent; got != want {
+		t.Fatalf("revoke current status = %d, want %d", got, want)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != session.CookieName || cookies[0].MaxAge >= 0 {
+		t.Errorf("cookies = %+v, want the session cookie cleared", cookies)
+	}
+}
@@ -0,0 +1,283 @@
+package clientapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/leaderboard"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// writeHiddenAnswer writes the answer response for an answer recorded while
+// its host-paced game's scores are held back: only the stake and, with
+// ?prefetch=true, the next item. The outcome follows on the game's event
+// stream once the host reveals it.
+func writeHiddenAnswer(
+	w http.ResponseWriter, r *http.Request, logger *slog.Logger,
+	service *game.Service, gameID string, playerID int64, a *game.Answer,
+) {
+	res := client.HiddenAnswerResponse{ScoresHidden: true}
+	if a.Wager != nil {
+		res.Wager = *a.Wager
+	}
+	if r.URL.Query().Get("prefetch") == "true" {
+		res.Next = prefetchNext(r.Context(), logger, service, gameID, playerID)
+	}
+
+	if err := handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+		logger.ErrorContext(r.Context(), "error encoding answer response", slog.Any("err", err))
+	}
+}
+
+// revealResponse maps a revealed game's answers to the wire shape, scoring
+// each the way [HandleAnswerPost] would have.
+func revealResponse(ctx context.Context, service *game.Service, rv *game.Reveal) client.Reveal {
+	res := client.Reveal{GameID: rv.GameID, Answers: make([]client.RevealedAnswer, 0, len(rv.Answers))}
+	for _, a := range rv.Answers {
+		ra := client.RevealedAnswer{
+			QuestionID:       a.Question.QuestionID,
+			Correct:          a.IsCorrect(),
+			Score:            service.CalculateScore(ctx, a),
+			CorrectOptionIDs: correctOptionIDsFromAnswer(a),
+			Explanation:      answerExplanation(a),
+		}
+		if a.NumericValue != nil {
+			ra.CorrectValue = a.Option.NumericValue
+		}
+		if a.Wager != nil {
+			ra.Wager = *a.Wager
+		}
+		ra.Streak, ra.Multiplier = answerStreak(a)
+		res.Score += ra.Score
+		res.Answers = append(res.Answers, ra)
+	}
+	res.Score = game.FloorTotal(res.Score, rv.Answers...)
+
+	return res
+}
+
+// HandleRevealScores reveals the held-back scores of a game of a host-paced
+// quiz (POST /api/games/{gameID}/reveal). Only the quiz's creator or an
+// Admin may reveal; anyone else gets the same 404 as an unknown game, so
+// the game's existence does not leak. A quiz that is not host-paced is a
+// 409. Revealing an already revealed game succeeds again.
+func HandleRevealScores(logger *slog.Logger, service *game.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for reveal")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+
+		gameID := r.PathValue("gameID")
+		g, _, err := service.GetGameForReview(ctx, gameID)
+		if err != nil {
+			if errors.Is(err, game.ErrGameNotFound) {
+				handlers.NotFound(w, r)
+
+				return
+			}
+			writeInternalError(w, r, logger, "error loading game for reveal", err)
+
+			return
+		}
+		if !player.IsAdmin() && player.ID != g.Quiz.CreatedByPlayerID {
+			handlers.NotFound(w, r)
+
+			return
+		}
+
+		if err = service.RevealScores(ctx, g); err != nil {
+			if errors.Is(err, game.ErrRevealNotOffered) {
+				handlers.WriteError(w, r, http.StatusConflict, err.Error())
+
+				return
+			}
+			writeInternalError(w, r, logger, "error revealing game scores", err)
+
+			return
+		}
+
+		res := client.RevealResponse{GameID: g.ID, Revealed: true}
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(ctx, "error encoding reveal response", slog.Any("err", err))
+		}
+	})
+}
+
+// revealStreamer holds the per-connection state of the game event stream.
+type revealStreamer struct {
+	w                 http.ResponseWriter
+	rc                *http.ResponseController
+	logger            *slog.Logger
+	service           *game.Service
+	gameID            string
+	playerID          int64
+	heartbeatInterval time.Duration
+}
+
+// writeReveal writes the player's outcomes as a single `reveal` SSE frame
+// and flushes. Returns false on any write/flush/encode failure so the
+// caller can exit the stream loop cleanly.
+func (s *revealStreamer) writeReveal(ctx context.Context, rv *game.Reveal) bool {
+	payload, err := json.Marshal(revealResponse(ctx, s.service, rv))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "error marshalling reveal event", slog.Any("err", err))
+
+		return false
+	}
+	if _, err := fmt.Fprintf(s.w, "event: reveal\ndata: %s\n\n", payload); err != nil {
+		return false
+	}
+	if err := s.rc.Flush(); err != nil {
+		return false
+	}
+
+	return true
+}
+
+// writeHeartbeat writes a single SSE comment frame (`:\n\n`) and flushes,
+// keeping an idle stream from being torn down by an intermediate proxy.
+func (s *revealStreamer) writeHeartbeat() bool {
+	if _, err := fmt.Fprint(s.w, ":\n\n"); err != nil {
+		return false
+	}
+	if err := s.rc.Flush(); err != nil {
+		return false
+	}
+
+	return true
+}
+
+// run waits for the host's reveal: every leaderboard tick for the game's
+// quiz re-reads the game, and once its scores are out the reveal frame is
+// written and the stream ends. Heartbeats keep the connection warm while
+// the host takes their time.
+func (s *revealStreamer) run(ctx context.Context, events <-chan struct{}) {
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+			rv, err := s.service.GetReveal(ctx, s.gameID, s.playerID)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "error refreshing reveal for SSE", slog.Any("err", err))
+
+				return
+			}
+			if rv.Hidden {
+				continue
+			}
+			s.writeReveal(ctx, rv)
+
+			return
+		case <-heartbeat.C:
+			if !s.writeHeartbeat() {
+				return
+			}
+		}
+	}
+}
+
+// HandleGameEventsStream streams the player's outcomes in a game over SSE
+// (GET /api/games/{gameID}/events). A game whose scores are out - any game
+// of a quiz that is not host-paced, or one its host revealed - gets a single
+// `reveal` frame and the stream ends; a held-back one stays open on
+// heartbeats until the host reveals, then gets the frame. Non-participants
+// get a 404, as for the game's results. A reconnect resends the reveal, so
+// a client that missed it loses nothing.
+//
+// heartbeatInterval is clamped like [HandleQuizLeaderboardStream]'s.
+func HandleGameEventsStream(
+	logger *slog.Logger, service *game.Service, hub *leaderboard.Hub, heartbeatInterval time.Duration,
+) http.Handler {
+	heartbeatInterval = clampHeartbeat(heartbeatInterval, DefaultLeaderboardHeartbeatInterval)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		gameID, playerID, ok := gameRequest(w, r, logger)
+		if !ok {
+			return
+		}
+
+		// Gate BEFORE any header write so a non-participant still gets a
+		// proper 404 rather than a half-open text/event-stream.
+		rv, err := service.GetReveal(ctx, gameID, playerID)
+		if err != nil {
+			if errors.Is(err, game.ErrGameNotFound) {
+				handlers.NotFound(w, r)
+
+				return
+			}
+			writeInternalError(w, r, logger, "error loading reveal", err)
+
+			return
+		}
+
+		events, unsubscribe := hub.Subscribe(rv.QuizID)
+		defer unsubscribe()
+
+		// Re-read after subscribing so a reveal landing between the gate
+		// and the subscription is not missed.
+		if rv.Hidden {
+			if rv, err = service.GetReveal(ctx, gameID, playerID); err != nil {
+				writeInternalError(w, r, logger, "error loading reveal", err)
+
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+
+		rc := http.NewResponseController(w)
+		// Clear the per-request write deadline so the HTTP server's
+		// WriteTimeout does not kill this long-lived response (same fix as
+		// the leaderboard stream).
+		if derr := rc.SetWriteDeadline(time.Time{}); derr != nil {
+			logger.WarnContext(ctx, "could not clear SSE write deadline", slog.Any("err", derr))
+		}
+
+		streamer := &revealStreamer{
+			w:                 w,
+			rc:                rc,
+			logger:            logger,
+			service:           service,
+			gameID:            gameID,
+			playerID:          playerID,
+			heartbeatInterval: heartbeatInterval,
+		}
+
+		if !rv.Hidden {
+			streamer.writeReveal(ctx, rv)
+
+			return
+		}
+		if !streamer.writeHeartbeat() {
+			return
+		}
+
+		streamer.run(ctx, events)
+	})
+}
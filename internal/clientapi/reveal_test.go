@@ -0,0 +1,226 @@
+package clientapi_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/clientapi"
+	"github.com/starquake/topbanana/internal/leaderboard"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// seedHostPacedGame seeds a host-paced two-question quiz and a game for a
+// fresh player with the first question issued, returning the quiz, the
+// player and the game id.
+func seedHostPacedGame(t *testing.T, env *testEnv) (*quiz.Quiz, int64, string) {
+	t.Helper()
+
+	paced := twoQuestionQuiz("Paced", "paced")
+	paced.HostPaced = true
+	qz := env.seedQuiz(t, paced)
+	playerID := env.seedPlayer(t, "paced-player")
+
+	g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	if _, err = env.service.GetNext(t.Context(), g.ID, playerID); err != nil {
+		t.Fatalf("GetNext err = %v, want nil", err)
+	}
+
+	return qz, playerID, g.ID
+}
+
+// TestHandleRevealScores pins the host-paced API: the answer response leaves
+// the outcome out, the results are a 409 until the reveal, only the quiz's
+// creator may reveal, and after the reveal the results are readable.
+func TestHandleRevealScores(t *testing.T) {
+	t.Parallel()
+
+	serve := func(t *testing.T, env *testEnv, playerID int64, method, path, body string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		mux := http.NewServeMux()
+		mux.Handle(
+			"POST /api/games/{gameID}/questions/{questionID}/answers",
+			HandleAnswerPost(env.logger, env.service),
+		)
+		mux.Handle("GET /api/games/{gameID}/results", HandleGameResults(env.logger, env.service))
+		mux.Handle("POST /api/games/{gameID}/reveal", HandleRevealScores(env.logger, env.service))
+		req := httptest.NewRequestWithContext(
+			withPlayer(t.Context(), playerID), method, path, strings.NewReader(body),
+		)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("holds the outcome back until the host reveals it", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		qz, playerID, gameID := seedHostPacedGame(t, env)
+		questionID, optionID := correctOptionID(t, qz, 0)
+
+		rec := serve(t, env, playerID, http.MethodPost,
+			fmt.Sprintf("/api/games/%s/questions/%d/answers", gameID, questionID),
+			fmt.Sprintf(`{"optionId": %d}`, optionID),
+		)
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("answer status code = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+		var answer map[string]any
+		if err := json.NewDecoder(rec.Body).Decode(&answer); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if answer["scoresHidden"] != true {
+			t.Errorf("scoresHidden = %v, want true", answer["scoresHidden"])
+		}
+		for _, key := range []string{"correct", "score", "correctOptionIds"} {
+			if _, ok := answer[key]; ok {
+				t.Errorf("answer response carries %q, want it held back", key)
+			}
+		}
+
+		resultsPath := fmt.Sprintf("/api/games/%s/results", gameID)
+		if rec = serve(t, env, playerID, http.MethodGet, resultsPath, ""); rec.Code != http.StatusConflict {
+			t.Errorf("results status before the reveal = %v, want %v", rec.Code, http.StatusConflict)
+		}
+
+		revealPath := fmt.Sprintf("/api/games/%s/reveal", gameID)
+		if rec = serve(t, env, playerID, http.MethodPost, revealPath, ""); rec.Code != http.StatusNotFound {
+			t.Errorf("reveal by the player status = %v, want %v", rec.Code, http.StatusNotFound)
+		}
+		rec = serve(t, env, seededAdminID, http.MethodPost, revealPath, "")
+		if got, want := rec.Code, http.StatusOK; got != want {
+			t.Fatalf("reveal status = %v, want %v (body %s)", got, want, rec.Body.String())
+		}
+		var res client.RevealResponse
+		if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if res.GameID != gameID || !res.Revealed {
+			t.Errorf("reveal response = %+v, want game %s revealed", res, gameID)
+		}
+
+		if rec = serve(t, env, playerID, http.MethodGet, resultsPath, ""); rec.Code != http.StatusOK {
+			t.Errorf("results status after the reveal = %v, want %v", rec.Code, http.StatusOK)
+		}
+	})
+
+	t.Run("returns 409 when the quiz is not host-paced", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		qz := env.seedQuiz(t, twoQuestionQuiz("Quiz", "quiz"))
+		playerID := env.seedPlayer(t, "unpaced")
+		g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+
+		rec := serve(t, env, seededAdminID, http.MethodPost, fmt.Sprintf("/api/games/%s/reveal", g.ID), "")
+		if got, want := rec.Code, http.StatusConflict; got != want {
+			t.Errorf("status code = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("returns 404 when game not found", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+
+		rec := serve(t, env, seededAdminID, http.MethodPost, "/api/games/missing/reveal", "")
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("status code = %v, want %v", got, want)
+		}
+	})
+}
+
+// TestHandleGameEventsStream pins that a waiting player receives their
+// outcomes on the event stream as soon as the host reveals them.
+func TestHandleGameEventsStream(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	hub := leaderboard.NewHub()
+	env.service.SetLeaderboardPublisher(hub)
+	qz, playerID, gameID := seedHostPacedGame(t, env)
+	questionID, optionID := correctOptionID(t, qz, 0)
+	if _, err := env.service.SubmitAnswer(t.Context(), gameID, playerID, questionID, optionID, time.Time{}); err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/games/{gameID}/events", HandleGameEventsStream(env.logger, env.service, hub, time.Hour))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.ServeHTTP(w, r.WithContext(withPlayer(r.Context(), playerID)))
+	}))
+	t.Cleanup(srv.Close)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL+"/api/games/"+gameID+"/events", nil)
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("Do err = %v, want nil", err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			t.Errorf("resp.Body.Close err = %v, want nil", cerr)
+		}
+	}()
+	if got, want := resp.Header.Get("Content-Type"), "text/event-stream"; got != want {
+		t.Fatalf("Content-Type = %q, want %q", got, want)
+	}
+
+	// The stream opens on a heartbeat while the scores are held back.
+	lines := bufio.NewReader(resp.Body)
+	if line, rerr := lines.ReadString('\n'); rerr != nil || line != ":\n" {
+		t.Fatalf("first line = %q, %v, want the heartbeat", line, rerr)
+	}
+
+	g, _, err := env.service.GetGameForReview(t.Context(), gameID)
+	if err != nil {
+		t.Fatalf("GetGameForReview err = %v, want nil", err)
+	}
+	if err = env.service.RevealScores(t.Context(), g); err != nil {
+		t.Fatalf("RevealScores err = %v, want nil", err)
+	}
+
+	var event, data string
+	for data == "" {
+		line, rerr := lines.ReadString('\n')
+		if rerr != nil {
+			t.Fatalf("reading the stream err = %v, want the reveal event", rerr)
+		}
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event: "))
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	if event != "reveal" {
+		t.Errorf("event = %q, want %q", event, "reveal")
+	}
+	var rv client.Reveal
+	if err = json.Unmarshal([]byte(data), &rv); err != nil {
+		t.Fatalf("decode err = %v, want nil", err)
+	}
+	if len(rv.Answers) != 1 || !rv.Answers[0].Correct || rv.Answers[0].QuestionID != questionID {
+		t.Fatalf("revealed answers = %+v, want the one correct answer", rv.Answers)
+	}
+	if rv.Score <= 0 || rv.Score != rv.Answers[0].Score {
+		t.Errorf("revealed score = %d, want the answer's positive score %d", rv.Score, rv.Answers[0].Score)
+	}
+}
@@ -40,7 +40,7 @@ func HandleSessionCreate(service *livesession.Service) http.Handler {
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session create")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -49,14 +49,14 @@ func HandleSessionCreate(service *livesession.Service) http.Handler {
 		// signed-in Player gets a 403 - the create endpoint's existence is
 		// not secret, unlike the admin surface.
 		if !player.CanHost() {
-			http.Error(w, "forbidden", http.StatusForbidden)
+			handlers.WriteError(w, r, http.StatusForbidden, "forbidden")
 
 			return
 		}
 
 		req, err := handlers.DecodeJSON[createRequest](w, r)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 
 			return
 		}
@@ -68,7 +68,7 @@ func HandleSessionCreate(service *livesession.Service) http.Handler {
 				errors.Is(err, livesession.ErrNotLiveQuiz),
 				errors.Is(err, livesession.ErrQuizNotOwned):
 				// Missing, solo, or not-owned-by-a-non-admin all 404 so the id stays opaque (#1207).
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 			default:
 				writeInternalError(w, r, logger, "error creating session", err)
 			}
@@ -76,7 +76,7 @@ func HandleSessionCreate(service *livesession.Service) http.Handler {
 			return
 		}
 
-		if err = handlers.EncodeJSON(w, http.StatusCreated, createResponse{JoinCode: sess.JoinCode}); err != nil {
+		if err = handlers.WriteData(w, r, http.StatusCreated, createResponse{JoinCode: sess.JoinCode}); err != nil {
 			logger.ErrorContext(ctx, "error encoding session create response", slog.Any("err", err))
 		}
 	})
@@ -89,7 +89,8 @@ func HandleSessionCreate(service *livesession.Service) http.Handler {
 // response echoes that current name straight off the context player. Returns
 // 404 when the join code is unknown and 409 when the room is closed - a
 // terminally finished room rejects joins, but a latecomer may join a live game
-// at any phase (#836).
+// at any phase (#836) - when the quiz's late-join policy turns a new player
+// away from a game under way, or when the room is full.
 func HandleSessionJoin(service *livesession.Service) http.Handler {
 	type joinResponse struct {
 		DisplayName string `json:"displayName"`
@@ -103,7 +104,7 @@ func HandleSessionJoin(service *livesession.Service) http.Handler {
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session join")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -113,9 +114,13 @@ func HandleSessionJoin(service *livesession.Service) http.Handler {
 		if err != nil {
 			switch {
 			case errors.Is(err, livesession.ErrSessionNotFound):
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 			case errors.Is(err, livesession.ErrLobbyClosed):
-				http.Error(w, "this room is closed", http.StatusConflict)
+				handlers.WriteError(w, r, http.StatusConflict, "this room is closed")
+			case errors.Is(err, livesession.ErrJoinClosed):
+				handlers.WriteError(w, r, http.StatusConflict, "this game is closed to late joiners")
+			case errors.Is(err, livesession.ErrGameFull):
+				handlers.WriteError(w, r, http.StatusConflict, "this game is full")
 			default:
 				writeInternalError(w, r, logger, "error joining session", err)
 			}
@@ -124,7 +129,7 @@ func HandleSessionJoin(service *livesession.Service) http.Handler {
 		}
 
 		res := joinResponse{DisplayName: player.DisplayName, IsReady: joined.IsReady}
-		if err = handlers.EncodeJSON(w, http.StatusOK, res); err != nil {
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
 			logger.ErrorContext(ctx, "error encoding session join response", slog.Any("err", err))
 		}
 	})
@@ -146,7 +151,7 @@ func HandleSessionReady(service *livesession.Service) http.Handler {
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session ready")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -154,14 +159,14 @@ func HandleSessionReady(service *livesession.Service) http.Handler {
 
 		req, err := handlers.DecodeJSON[readyRequest](w, r)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 
 			return
 		}
 
 		if err = service.SetReady(ctx, r.PathValue("code"), player.ID, req.Ready); err != nil {
 			if errors.Is(err, livesession.ErrSessionNotFound) || errors.Is(err, livesession.ErrNotParticipant) {
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 
 				return
 			}
@@ -194,7 +199,7 @@ func hostSessionAction(
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session "+what)
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -205,11 +210,11 @@ func hostSessionAction(
 		case err == nil, errors.Is(err, idempotent):
 			w.WriteHeader(http.StatusNoContent)
 		case errors.Is(err, livesession.ErrSessionNotFound):
-			http.NotFound(w, r)
+			handlers.NotFound(w, r)
 		case errors.Is(err, livesession.ErrNotHost):
-			http.Error(w, "forbidden", http.StatusForbidden)
+			handlers.WriteError(w, r, http.StatusForbidden, "forbidden")
 		case errors.Is(err, livesession.ErrNoQuizToStart):
-			http.Error(w, "this room has no quiz to start", http.StatusConflict)
+			handlers.WriteError(w, r, http.StatusConflict, "this room has no quiz to start")
 		default:
 			writeInternalError(w, r, logger, "error on session "+what, err)
 		}
@@ -246,6 +251,292 @@ func HandleSessionCancelStart(service *livesession.Service) http.Handler {
 	return hostSessionAction("cancel-start", livesession.ErrNotInLobby, service.CancelStart)
 }
 
+// HandleSessionExtendQuestion is the host "extend" control: it adds the
+// requested number of seconds to the open question's answer deadline for every
+// participant and returns the new deadline; the service's tick makes every
+// surface re-read the state and redraw its countdown. Only the host may call
+// it. Returns 200 with the new deadline, 400 for a malformed body or an
+// out-of-range extension, 403 when the caller is not the host, 404 for an
+// unknown code, and 409 when the question is no longer open (the runner closed
+// it first, or the id names an earlier question).
+func HandleSessionExtendQuestion(service *livesession.Service) http.Handler {
+	type extendRequest struct {
+		Seconds int `json:"seconds"`
+	}
+	type extendResponse struct {
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := handlers.LoggerFromContext(ctx)
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for session extend")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+		logger = logger.With(slog.Int64("player", player.ID))
+
+		questionID, ok := handlers.ParseIDFromPath(w, r, logger, "questionID")
+		if !ok {
+			return
+		}
+		req, err := handlers.DecodeJSON[extendRequest](w, r)
+		if err != nil {
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		by := time.Duration(req.Seconds) * time.Second
+		expiresAt, err := service.ExtendQuestion(ctx, r.PathValue("code"), player.ID, questionID, by, time.Now().UTC())
+		switch {
+		case err == nil:
+			if err = handlers.WriteData(w, r, http.StatusOK, extendResponse{ExpiresAt: expiresAt}); err != nil {
+				logger.ErrorContext(ctx, "error encoding session extend response", slog.Any("err", err))
+			}
+		case errors.Is(err, livesession.ErrInvalidExtension):
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
+		case errors.Is(err, livesession.ErrSessionNotFound):
+			handlers.NotFound(w, r)
+		case errors.Is(err, livesession.ErrNotHost):
+			handlers.WriteError(w, r, http.StatusForbidden, "forbidden")
+		case errors.Is(err, livesession.ErrQuestionNotOpen):
+			handlers.WriteError(w, r, http.StatusConflict, "no question is open for answers")
+		default:
+			writeInternalError(w, r, logger, "error extending session question", err)
+		}
+	})
+}
+
+// HandleSessionAdvance is the host "next" control: it moves the room on from
+// the round intro, the reveal or the round results without waiting for the
+// beat - the only way on from them while the host paces the room. The body
+// names the screen being advanced from ({"phase": "reveal", "questionId": 7};
+// questionId is omitted on the round screens) so a double press from two host
+// devices moves the room on once. Only the host may call it. Returns 204 on
+// success, 400 for a malformed body, 403 when the caller is not the host, 404
+// for an unknown code, 422 for a phase with no "next", and 409 with the
+// current session state when the room has already moved on.
+func HandleSessionAdvance(service *livesession.Service) http.Handler {
+	type advanceRequest struct {
+		Phase      string `json:"phase"`
+		QuestionID int64  `json:"questionId"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := handlers.LoggerFromContext(ctx)
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for session advance")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+		logger = logger.With(slog.Int64("player", player.ID))
+
+		req, err := handlers.DecodeJSON[advanceRequest](w, r)
+		if err != nil {
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		code := r.PathValue("code")
+		err = service.Advance(ctx, code, player.ID, livesession.Phase(req.Phase), req.QuestionID)
+		switch {
+		case err == nil:
+			w.WriteHeader(http.StatusNoContent)
+		case errors.Is(err, livesession.ErrSessionNotFound):
+			handlers.NotFound(w, r)
+		case errors.Is(err, livesession.ErrNotHost):
+			handlers.WriteError(w, r, http.StatusForbidden, "forbidden")
+		case errors.Is(err, livesession.ErrNothingToAdvance):
+			handlers.WriteError(w, r, http.StatusUnprocessableEntity, "the room cannot be advanced from that phase")
+		case errors.Is(err, livesession.ErrSessionMoved):
+			writeSessionMoved(w, r, logger, service, code, player.ID)
+		default:
+			writeInternalError(w, r, logger, "error on session advance", err)
+		}
+	})
+}
+
+// writeSessionMoved answers a host control that lost the race with another
+// device or the runner: 409 carrying the current session state, so the host
+// screen catches up without a second round trip.
+func writeSessionMoved(
+	w http.ResponseWriter, r *http.Request, logger *slog.Logger, service *livesession.Service, code string, hostID int64,
+) {
+	ctx := r.Context()
+	state, err := service.GetSessionState(ctx, code, hostID)
+	if err != nil {
+		writeInternalError(w, r, logger, "error retrieving session state", err)
+
+		return
+	}
+	if err = handlers.WriteData(w, r, http.StatusConflict, newSessionStateResponse(state)); err != nil {
+		logger.ErrorContext(ctx, "error encoding session state response", slog.Any("err", err))
+	}
+}
+
+// HandleSessionCloseAnswers is the host "close answering" control: it ends the
+// open question's answer window now, and the runner scores the picks and
+// reveals the answer. Only the host may call it. Returns 204 on success, 403
+// when the caller is not the host, 404 for an unknown code, and 204
+// (idempotent no-op) when no question is open any more.
+func HandleSessionCloseAnswers(service *livesession.Service) http.Handler {
+	return hostSessionAction("close-answers", livesession.ErrQuestionNotOpen,
+		func(ctx context.Context, code string, playerID int64) error {
+			return service.CloseAnswers(ctx, code, playerID, time.Now().UTC())
+		})
+}
+
+// HandleSessionPacing is the host "pacing" control: {"hostAdvance": true}
+// holds the room on the round intro, the reveal and the round results until
+// the host advances it, and false hands the pacing back to the beats. Only
+// the host may call it. Returns 204 on success, 400 for a malformed body, 403
+// when the caller is not the host, and 404 for an unknown or closed room.
+func HandleSessionPacing(service *livesession.Service) http.Handler {
+	type pacingRequest struct {
+		HostAdvance bool `json:"hostAdvance"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := handlers.LoggerFromContext(ctx)
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for session pacing")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+		logger = logger.With(slog.Int64("player", player.ID))
+
+		req, err := handlers.DecodeJSON[pacingRequest](w, r)
+		if err != nil {
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		err = service.SetHostAdvance(ctx, r.PathValue("code"), player.ID, req.HostAdvance)
+		switch {
+		case err == nil:
+			w.WriteHeader(http.StatusNoContent)
+		case errors.Is(err, livesession.ErrSessionNotFound):
+			handlers.NotFound(w, r)
+		case errors.Is(err, livesession.ErrNotHost):
+			handlers.WriteError(w, r, http.StatusForbidden, "forbidden")
+		default:
+			writeInternalError(w, r, logger, "error setting session pacing", err)
+		}
+	})
+}
+
+// HandleSessionReorderQuestions is the host "reorder" control: it sets the
+// play order of the game's remaining questions to the listed ids, each round's
+// questions staying in their round; the service's tick makes every surface
+// re-read the state. Only the host may call it. Returns 204 on success, 400 for
+// a malformed body or a list that is not exactly the remaining questions, 403
+// when the caller is not the host, 404 for an unknown code, and 409 when the
+// room has no quiz or the game moved on while the request was in flight.
+func HandleSessionReorderQuestions(service *livesession.Service) http.Handler {
+	type reorderRequest struct {
+		QuestionIDs []int64 `json:"questionIds"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := handlers.LoggerFromContext(ctx)
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for session reorder")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+		logger = logger.With(slog.Int64("player", player.ID))
+
+		req, err := handlers.DecodeJSON[reorderRequest](w, r)
+		if err != nil {
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		err = service.ReorderQuestions(ctx, r.PathValue("code"), player.ID, req.QuestionIDs)
+		if errors.Is(err, livesession.ErrInvalidQuestionOrder) {
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
+
+			return
+		}
+		writePlanResult(w, r, logger, "reorder", err)
+	})
+}
+
+// HandleSessionSkipQuestion is the host "skip" control: it drops one of the
+// game's remaining questions from the game; the service's tick makes every
+// surface re-read the state. Only the host may call it. Returns 204 on
+// success, 403 when the caller is not the host, 404 for an unknown code, and
+// 409 when the question is not among the remaining ones, the room has no
+// quiz, or the game moved on while the request was in flight.
+func HandleSessionSkipQuestion(service *livesession.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := handlers.LoggerFromContext(ctx)
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for session skip")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+		logger = logger.With(slog.Int64("player", player.ID))
+
+		questionID, ok := handlers.ParseIDFromPath(w, r, logger, "questionID")
+		if !ok {
+			return
+		}
+
+		err := service.SkipQuestion(ctx, r.PathValue("code"), player.ID, questionID)
+		if errors.Is(err, livesession.ErrQuestionNotRemaining) {
+			handlers.WriteError(w, r, http.StatusConflict, err.Error())
+
+			return
+		}
+		writePlanResult(w, r, logger, "skip", err)
+	})
+}
+
+// writePlanResult maps the outcome of a host plan control shared by reorder
+// and skip onto the response: 204 on success, 404, 403, 409 for a room with no
+// quiz or a game that moved on, and a logged 500 otherwise.
+func writePlanResult(w http.ResponseWriter, r *http.Request, logger *slog.Logger, action string, err error) {
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, livesession.ErrSessionNotFound):
+		handlers.NotFound(w, r)
+	case errors.Is(err, livesession.ErrNotHost):
+		handlers.WriteError(w, r, http.StatusForbidden, "forbidden")
+	case errors.Is(err, livesession.ErrNoQuizToStart):
+		handlers.WriteError(w, r, http.StatusConflict, "no quiz picked")
+	case errors.Is(err, livesession.ErrPlanMoved):
+		handlers.WriteError(w, r, http.StatusConflict, err.Error())
+	default:
+		writeInternalError(w, r, logger, "error on session "+action, err)
+	}
+}
+
 // HandleSessionAnswer records the calling participant's pick for the session's
 // current question. The answer is timestamped on the server (the request body
 // carries only the chosen option) so scoring uses the server clock. Returns
@@ -264,7 +555,7 @@ func HandleSessionAnswer(service *livesession.Service) http.Handler {
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session answer")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -272,7 +563,7 @@ func HandleSessionAnswer(service *livesession.Service) http.Handler {
 
 		req, err := handlers.DecodeJSON[answerRequest](w, r)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			handlers.WriteError(w, r, http.StatusBadRequest, err.Error())
 
 			return
 		}
@@ -282,9 +573,9 @@ func HandleSessionAnswer(service *livesession.Service) http.Handler {
 		case err == nil:
 			w.WriteHeader(http.StatusNoContent)
 		case errors.Is(err, livesession.ErrSessionNotFound), errors.Is(err, livesession.ErrNotParticipant):
-			http.NotFound(w, r)
+			handlers.NotFound(w, r)
 		case errors.Is(err, livesession.ErrQuestionNotOpen):
-			http.Error(w, "no question is open for answers", http.StatusConflict)
+			handlers.WriteError(w, r, http.StatusConflict, "no question is open for answers")
 		default:
 			writeInternalError(w, r, logger, "error recording session answer", err)
 		}
@@ -307,7 +598,7 @@ func HandleSessionLeave(service *livesession.Service) http.Handler {
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session leave")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -318,7 +609,7 @@ func HandleSessionLeave(service *livesession.Service) http.Handler {
 		case err == nil:
 			w.WriteHeader(http.StatusNoContent)
 		case errors.Is(err, livesession.ErrSessionNotFound), errors.Is(err, livesession.ErrNotParticipant):
-			http.NotFound(w, r)
+			handlers.NotFound(w, r)
 		default:
 			writeInternalError(w, r, logger, "error leaving session", err)
 		}
@@ -333,6 +624,9 @@ type sessionPlayerResponse struct {
 	PlayerID    int64  `json:"playerId"`
 	DisplayName string `json:"displayName"`
 	IsReady     bool   `json:"isReady"`
+	// Notes is the host's notes on the player, only ever set on the host's
+	// own read; omitted otherwise.
+	Notes string `json:"notes,omitempty"`
 }
 
 // sessionQuizResponse is the quiz metadata the lobby renders. Deliberately
@@ -377,6 +671,10 @@ type sessionStateResponse struct {
 	// surface renders the same "Starting in M:SS" off startAt minus serverNow,
 	// so a skewed device clock cannot desync the countdown.
 	StartAt *time.Time `json:"startAt,omitempty"`
+	// HostAdvance is true while the host paces the room: the round intro,
+	// reveal and round results stay up until the host presses next, so a
+	// surface shows no auto-advance countdown on them.
+	HostAdvance bool `json:"hostAdvance,omitempty"`
 	// Question is the live question (round_intro carries no question yet; the
 	// question and reveal phases do). Options never carry a correct flag
 	// before reveal - correctOptionIds below is populated only at reveal.
@@ -398,6 +696,23 @@ type sessionStateResponse struct {
 	// Present in every in-game phase; omitted in the lobby, where no game has
 	// scored yet.
 	Self *sessionSelfResponse `json:"self,omitempty"`
+	// MaxPlayers is the room's capacity, omitted when there is no cap.
+	// RoomFull is true once the roster holds it and new players are turned
+	// away; the host screen shows a "room full" notice off it.
+	MaxPlayers int  `json:"maxPlayers,omitempty"`
+	RoomFull   bool `json:"roomFull,omitempty"`
+	// Upcoming lists the questions still to be played in the game, in play
+	// order, for the host's reorder and skip controls. Sent to the host alone;
+	// omitted for every other viewer and once nothing is left to play.
+	Upcoming []sessionUpcomingResponse `json:"upcoming,omitempty"`
+}
+
+// sessionUpcomingResponse is one question still to be played, named just
+// enough for the host to pick it out of a list.
+type sessionUpcomingResponse struct {
+	ID      int64  `json:"id"`
+	RoundID int64  `json:"roundId"`
+	Text    string `json:"text"`
 }
 
 // sessionSelfResponse is the viewing player's own per-game state, computed for
@@ -423,12 +738,18 @@ type sessionRoundResponse struct {
 // finished; in the finished phase it carries the last round's score so the bar
 // graph can animate that final contribution (0 for a player absent from the
 // last round). totalScore is their cumulative session score; rank is 1-indexed.
+// A late joiner also carries missedQuestions, the questions that had closed
+// before they joined, and lateJoin, the quiz's policy for them ("skip" or
+// "zero"), which the results footnote words; both are omitted for everyone
+// who was there from the start.
 type sessionStandingResponse struct {
-	PlayerID    int64  `json:"playerId"`
-	DisplayName string `json:"displayName"`
-	RoundScore  int    `json:"roundScore"`
-	TotalScore  int    `json:"totalScore"`
-	Rank        int    `json:"rank"`
+	PlayerID        int64  `json:"playerId"`
+	DisplayName     string `json:"displayName"`
+	RoundScore      int    `json:"roundScore"`
+	TotalScore      int    `json:"totalScore"`
+	Rank            int    `json:"rank"`
+	MissedQuestions int    `json:"missedQuestions,omitempty"`
+	LateJoin        string `json:"lateJoin,omitempty"`
 }
 
 // sessionOptionResponse is one answer option. correct is surfaced ONLY in the
@@ -491,7 +812,7 @@ func HandleSessionState(service *livesession.Service) http.Handler {
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session state")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -500,7 +821,7 @@ func HandleSessionState(service *livesession.Service) http.Handler {
 		state, err := service.GetSessionState(ctx, r.PathValue("code"), player.ID)
 		if err != nil {
 			if errors.Is(err, livesession.ErrSessionNotFound) || errors.Is(err, livesession.ErrNotParticipant) {
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 
 				return
 			}
@@ -509,7 +830,7 @@ func HandleSessionState(service *livesession.Service) http.Handler {
 			return
 		}
 
-		if err = handlers.EncodeJSON(w, http.StatusOK, newSessionStateResponse(state)); err != nil {
+		if err = handlers.WriteData(w, r, http.StatusOK, newSessionStateResponse(state)); err != nil {
 			logger.ErrorContext(ctx, "error encoding session state response", slog.Any("err", err))
 		}
 	})
@@ -533,7 +854,7 @@ func HandleSessionAudio(service *livesession.Service) http.Handler {
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session audio")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -542,7 +863,7 @@ func HandleSessionAudio(service *livesession.Service) http.Handler {
 		state, err := service.GetSessionState(ctx, r.PathValue("code"), player.ID)
 		if err != nil {
 			if errors.Is(err, livesession.ErrSessionNotFound) || errors.Is(err, livesession.ErrNotParticipant) {
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 
 				return
 			}
@@ -556,7 +877,7 @@ func HandleSessionAudio(service *livesession.Service) http.Handler {
 		// stranger gets above, so the response never distinguishes a non-host
 		// participant from an unknown code.
 		if state.Session.HostPlayerID != player.ID {
-			http.NotFound(w, r)
+			handlers.NotFound(w, r)
 
 			return
 		}
@@ -566,7 +887,7 @@ func HandleSessionAudio(service *livesession.Service) http.Handler {
 			questions = state.Quiz.Questions
 		}
 
-		if err = handlers.EncodeJSON(w, http.StatusOK, newAudioManifestResponse(questions)); err != nil {
+		if err = handlers.WriteData(w, r, http.StatusOK, newAudioManifestResponse(questions)); err != nil {
 			logger.ErrorContext(ctx, "error encoding session audio manifest", slog.Any("err", err))
 		}
 	})
@@ -581,24 +902,43 @@ func newSessionStateResponse(state *livesession.SessionState) sessionStateRespon
 			PlayerID:    p.PlayerID,
 			DisplayName: p.DisplayName,
 			IsReady:     p.IsReady,
+			Notes:       p.Notes,
 		})
 	}
 
 	return sessionStateResponse{
-		JoinCode:  state.Session.JoinCode,
-		Phase:     string(state.Session.Phase),
-		HostID:    state.Session.HostPlayerID,
-		Players:   players,
-		Quiz:      newSessionQuizResponse(state),
-		ServerNow: time.Now().UTC(),
-		StartAt:   state.Session.StartAt,
-		Question:  newSessionQuestionResponse(state),
-		Standings: newSessionStandingsResponse(state),
-		Round:     newSessionRoundResponse(state),
-		Self:      newSessionSelfResponse(state),
+		JoinCode:    state.Session.JoinCode,
+		Phase:       string(state.Session.Phase),
+		HostID:      state.Session.HostPlayerID,
+		Players:     players,
+		Quiz:        newSessionQuizResponse(state),
+		ServerNow:   time.Now().UTC(),
+		StartAt:     state.Session.StartAt,
+		HostAdvance: state.Session.HostAdvance,
+		Question:    newSessionQuestionResponse(state),
+		Standings:   newSessionStandingsResponse(state),
+		Round:       newSessionRoundResponse(state),
+		Self:        newSessionSelfResponse(state),
+		MaxPlayers:  state.MaxPlayers,
+		RoomFull:    state.RoomFull,
+		Upcoming:    newSessionUpcomingResponse(state),
 	}
 }
 
+// newSessionUpcomingResponse projects the host's upcoming questions onto the
+// wire shape, nil when there are none so the field is omitted.
+func newSessionUpcomingResponse(state *livesession.SessionState) []sessionUpcomingResponse {
+	if len(state.Upcoming) == 0 {
+		return nil
+	}
+	upcoming := make([]sessionUpcomingResponse, 0, len(state.Upcoming))
+	for _, q := range state.Upcoming {
+		upcoming = append(upcoming, sessionUpcomingResponse{ID: q.ID, RoundID: q.RoundID, Text: q.Text})
+	}
+
+	return upcoming
+}
+
 // newSessionSelfResponse projects the viewing player's own per-game state onto
 // the wire shape: their running score for the live answer-pad HUD (#956).
 // Returns nil in the lobby, where no game has scored yet, so the field is
@@ -650,15 +990,24 @@ func newSessionStandingsResponse(state *livesession.SessionState) []sessionStand
 	if len(state.Standings) == 0 {
 		return nil
 	}
+	lateJoin := quiz.LateJoinSkip
+	if state.Quiz != nil {
+		lateJoin = quiz.NormalizedLateJoin(state.Quiz.LateJoin)
+	}
 	standings := make([]sessionStandingResponse, 0, len(state.Standings))
 	for _, st := range state.Standings {
-		standings = append(standings, sessionStandingResponse{
-			PlayerID:    st.PlayerID,
-			DisplayName: st.DisplayName,
-			RoundScore:  st.RoundScore,
-			TotalScore:  st.TotalScore,
-			Rank:        st.Rank,
-		})
+		res := sessionStandingResponse{
+			PlayerID:        st.PlayerID,
+			DisplayName:     st.DisplayName,
+			RoundScore:      st.RoundScore,
+			TotalScore:      st.TotalScore,
+			Rank:            st.Rank,
+			MissedQuestions: st.MissedQuestions,
+		}
+		if st.MissedQuestions > 0 {
+			res.LateJoin = lateJoin
+		}
+		standings = append(standings, res)
 	}
 
 	return standings
@@ -684,15 +1033,18 @@ func questionPosition(qz *quiz.Quiz, questionID int64) int {
 // session sees the same order across reconnects, two sessions of the same quiz
 // differ, and a maker who lists the answer first cannot game the layout.
 // Scoring and reveal key off option id, not position, so reordering the display
-// set here leaves them untouched.
-func shuffledSessionOptions(sessionID string, q *quiz.Question) []sessionOptionResponse {
+// set here leaves them untouched. keepOrder, a quiz's KeepOptionOrder, skips
+// the shuffle and sends the options as written.
+func shuffledSessionOptions(sessionID string, q *quiz.Question, keepOrder bool) []sessionOptionResponse {
 	options := make([]sessionOptionResponse, 0, len(q.Options))
 	for _, o := range q.Options {
 		options = append(options, sessionOptionResponse{ID: o.ID, Text: o.Text})
 	}
-	shuffleBySeed(sessionID, q.ID, len(options), func(i, j int) {
-		options[i], options[j] = options[j], options[i]
-	})
+	if !keepOrder {
+		shuffleBySeed(sessionID, q.ID, len(options), func(i, j int) {
+			options[i], options[j] = options[j], options[i]
+		})
+	}
 
 	return options
 }
@@ -706,7 +1058,7 @@ func newSessionQuestionResponse(state *livesession.SessionState) *sessionQuestio
 	}
 	q := state.CurrentQuestion
 
-	options := shuffledSessionOptions(state.Session.ID, q)
+	options := shuffledSessionOptions(state.Session.ID, q, state.Quiz != nil && state.Quiz.KeepOptionOrder)
 
 	// The roster already excludes players who have left, so a left player's
 	// pick drops out of the answered-order badges here (MP-10) without
@@ -791,6 +1143,13 @@ type sessionEventResponse struct {
 // value; the heartbeat regression test passes a shorter interval.
 const DefaultSessionEventHeartbeatInterval = 25 * time.Second
 
+// sessionEventBatchWindow is how long the session SSE stream holds a tick
+// before writing it, so a burst of publishes (a room of players answering
+// within a few milliseconds of each other) reaches each connection as one
+// write and one flush carrying the latest version, instead of one per
+// publish. Short enough that a client cannot tell it from an immediate push.
+const sessionEventBatchWindow = 50 * time.Millisecond
+
 // sessionEventStreamer bundles the per-request dependencies of the session
 // SSE stream. Mirrors leaderboardStreamer so the two share the same flush /
 // heartbeat / write-deadline handling.
@@ -837,23 +1196,47 @@ func (s *sessionEventStreamer) writeHeartbeat() bool {
 	return true
 }
 
-// run drains the hub channel and writes one SSE frame per tick until the
-// client disconnects or the channel closes. The heartbeat ticker emits a
+// run drains the hub channel until the client disconnects or the channel
+// closes, batching writes: the first tick after a quiet spell opens a
+// [sessionEventBatchWindow], later ticks in the window replace it, and one
+// SSE frame carrying the latest tick is written when the window closes. Each
+// tick is a "re-GET" signal, so the skipped versions lose nothing. A stream
+// that ends with a batch open still writes the tick it held, so the last
+// state before the channel closes is not lost. The heartbeat ticker emits a
 // no-op comment frame every s.heartbeatInterval to keep an idle connection
 // warm.
 func (s *sessionEventStreamer) run(ctx context.Context, events <-chan livesession.Tick) {
 	heartbeat := time.NewTicker(s.heartbeatInterval)
 	defer heartbeat.Stop()
 
+	var (
+		pending livesession.Tick
+		batch   <-chan time.Time
+	)
+	flushPending := func() {
+		if batch != nil {
+			s.writeTick(ctx, pending)
+		}
+	}
 	for {
 		select {
 		case <-ctx.Done():
+			flushPending()
+
 			return
 		case tick, ok := <-events:
 			if !ok {
+				flushPending()
+
 				return
 			}
-			if !s.writeTick(ctx, tick) {
+			pending = tick
+			if batch == nil {
+				batch = time.After(sessionEventBatchWindow)
+			}
+		case <-batch:
+			batch = nil
+			if !s.writeTick(ctx, pending) {
 				return
 			}
 		case <-heartbeat.C:
@@ -921,7 +1304,7 @@ func HandleSessionEvents(
 		player, ok := auth.PlayerFromContext(ctx)
 		if !ok {
 			logger.ErrorContext(ctx, "missing player on context for session events")
-			http.Error(w, "internal error", http.StatusInternalServerError)
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
 
 			return
 		}
@@ -933,7 +1316,7 @@ func HandleSessionEvents(
 		view, err := service.AuthorizeView(ctx, r.PathValue("code"), player.ID)
 		if err != nil {
 			if errors.Is(err, livesession.ErrSessionNotFound) || errors.Is(err, livesession.ErrNotParticipant) {
-				http.NotFound(w, r)
+				handlers.NotFound(w, r)
 
 				return
 			}
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -445,3 +446,75 @@ func getRequestWithPlayer(t *testing.T, playerID int64, target string) *http.Req
 
 	return httptest.NewRequestWithContext(ctx, http.MethodGet, target, nil)
 }
+
+// sessionEventFrames returns the versions of the `data:` frames a session
+// event stream wrote to rec, in order.
+func sessionEventFrames(t *testing.T, rec *httptest.ResponseRecorder) []uint64 {
+	t.Helper()
+	var versions []uint64
+	for line := range strings.Lines(rec.Body.String()) {
+		payload, ok := strings.CutPrefix(strings.TrimSpace(line), "data: ")
+		if !ok {
+			continue
+		}
+		var tick livesession.Tick
+		if err := json.Unmarshal([]byte(payload), &tick); err != nil {
+			t.Fatalf("decode frame %q: %v", payload, err)
+		}
+		versions = append(versions, tick.Version)
+	}
+
+	return versions
+}
+
+// TestSessionEventStream_Batching pins the stream's write batching: a burst of
+// ticks is written as one frame carrying the latest, and a tick still held by
+// an open batch is written when the stream ends rather than dropped.
+func TestSessionEventStream_Batching(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a burst ending in a close writes the latest tick once", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		events := make(chan livesession.Tick)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			ExportRunSessionEventStream(t.Context(), rec, events)
+		}()
+
+		for v := range uint64(3) {
+			events <- livesession.Tick{Version: v + 1, Phase: livesession.PhaseQuestion}
+		}
+		close(events)
+		<-done
+
+		frames := sessionEventFrames(t, rec)
+		if len(frames) == 0 || frames[len(frames)-1] != 3 {
+			t.Fatalf("frames = %v, want the burst to end on version 3", frames)
+		}
+		if len(frames) == 3 {
+			t.Errorf("frames = %v, want the burst batched into fewer writes than ticks", frames)
+		}
+	})
+
+	t.Run("a tick held when the client leaves is still written", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		events := make(chan livesession.Tick)
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			ExportRunSessionEventStream(ctx, rec, events)
+		}()
+
+		events <- livesession.Tick{Version: 7, Phase: livesession.PhaseFinished}
+		cancel()
+		<-done
+
+		if got, want := sessionEventFrames(t, rec), []uint64{7}; !slices.Equal(got, want) {
+			t.Errorf("frames = %v, want %v", got, want)
+		}
+	})
+}
@@ -1,11 +1,48 @@
 package clientapi
 
 import (
+	"context"
 	"encoding/binary"
 	"hash/fnv"
 	"math/rand/v2"
+	"net/http"
 )
 
+// ShuffleSeedHeader is the test-only request header that pins the option
+// shuffle of a solo game: its value replaces the game id as the shuffle
+// scope, so an end-to-end run sees the same option layout every time even
+// though every run mints fresh game ids. Honoured only behind
+// [WithShuffleSeed], which the server mounts when SHUFFLE_SEED_HEADER_ENABLED
+// is on; everywhere else the header is ignored.
+const ShuffleSeedHeader = "X-Shuffle-Seed"
+
+// shuffleSeedKey is the context key [WithShuffleSeed] stores the pinned seed
+// under.
+type shuffleSeedKey struct{}
+
+// WithShuffleSeed carries a non-empty [ShuffleSeedHeader] into the request
+// context for the question handler to shuffle by. The live-session option
+// order is shared by the host screen and every player in the room, so one
+// client's header must not reorder it; only the solo path reads the seed.
+func WithShuffleSeed(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if seed := r.Header.Get(ShuffleSeedHeader); seed != "" {
+			r = r.WithContext(context.WithValue(r.Context(), shuffleSeedKey{}, seed))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// shuffleScope returns the seed pinned by [WithShuffleSeed], or scopeID when
+// the request carries none.
+func shuffleScope(ctx context.Context, scopeID string) string {
+	if seed, ok := ctx.Value(shuffleSeedKey{}).(string); ok {
+		return seed
+	}
+
+	return scopeID
+}
+
 // shuffleOptionsSeed derives a deterministic uint64 seed from a scope ID
 // and question ID. The shuffle of the option buttons (#297) is stable
 // per (scope, question) so a player who reloads mid-question sees the
@@ -1,11 +1,16 @@
 package clientapi_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"slices"
 	"testing"
 
 	. "github.com/starquake/topbanana/internal/clientapi"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/pkg/client"
 )
 
 // TestShuffleBySeed_Deterministic pins the contract both play surfaces
@@ -72,3 +77,76 @@ func TestShuffleBySeed_DifferentScopesDiffer(t *testing.T) {
 		t.Errorf("shuffleBySeed across %d scope IDs produced only %d distinct orders, want >= 2", trials, len(seen))
 	}
 }
+
+// TestWithShuffleSeed pins the test-only seed override: behind WithShuffleSeed
+// every game sent the same ShuffleSeedHeader gets the same option layout,
+// while the bare handler ignores the header and keeps shuffling per game. Six
+// options give 720 layouts, so four games sharing one by chance is below
+// 1e-8.
+func TestWithShuffleSeed(t *testing.T) {
+	t.Parallel()
+
+	layouts := func(t *testing.T, pinned bool) map[string]struct{} {
+		t.Helper()
+
+		env := newTestEnv(t)
+		h := HandleQuestionNext(env.logger, env.service)
+		if pinned {
+			h = WithShuffleSeed(h)
+		}
+		qz := twoQuestionQuiz("Seeded", "seeded")
+		qz.Questions = qz.Questions[:1]
+		for _, text := range []string{"Madrid", "Rome", "Vienna", "Oslo"} {
+			qz.Questions[0].Options = append(qz.Questions[0].Options, &quiz.Option{Text: text})
+		}
+		env.seedQuiz(t, qz)
+
+		mux := http.NewServeMux()
+		mux.Handle("GET /api/games/{gameID}/questions/next", h)
+		seen := make(map[string]struct{})
+		for i := range 4 {
+			playerID := env.seedPlayer(t, fmt.Sprintf("seeded-%d", i))
+			g, err := env.service.CreateGame(t.Context(), qz.ID, playerID, false)
+			if err != nil {
+				t.Fatalf("CreateGame err = %v, want nil", err)
+			}
+			req := httptest.NewRequestWithContext(
+				withPlayer(t.Context(), playerID), http.MethodGet,
+				fmt.Sprintf("/api/games/%s/questions/next", g.ID), nil,
+			)
+			req.Header.Set(ShuffleSeedHeader, "e2e")
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+			if got, want := rec.Code, http.StatusOK; got != want {
+				t.Fatalf("status code = %v, want %v (body %s)", got, want, rec.Body.String())
+			}
+			var q client.Question
+			if err = json.NewDecoder(rec.Body).Decode(&q); err != nil {
+				t.Fatalf("decode err = %v, want nil", err)
+			}
+			var texts []string
+			for _, o := range q.Options {
+				texts = append(texts, o.Text)
+			}
+			seen[fmt.Sprint(texts)] = struct{}{}
+		}
+
+		return seen
+	}
+
+	t.Run("the header pins the layout across games", func(t *testing.T) {
+		t.Parallel()
+
+		if got := layouts(t, true); len(got) != 1 {
+			t.Errorf("pinned games produced %d layouts, want 1: %v", len(got), got)
+		}
+	})
+
+	t.Run("without the middleware the header is ignored", func(t *testing.T) {
+		t.Parallel()
+
+		if got := layouts(t, false); len(got) < 2 {
+			t.Errorf("unpinned games produced %d layout, want a per-game shuffle", len(got))
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package clientapi
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/tournament"
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// HandleTournamentJoin adds the calling player to the tournament with the
+// path's join code and returns the tournament with its stages, so the client
+// can list the quizzes to play. Joining twice is harmless. An unknown code is
+// a 404.
+func HandleTournamentJoin(service *tournament.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := handlers.LoggerFromContext(ctx)
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for tournament join")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+		logger = logger.With(slog.Int64("player", player.ID))
+
+		t, err := service.Join(ctx, r.PathValue("code"), player.ID)
+		if err != nil {
+			if errors.Is(err, tournament.ErrTournamentNotFound) {
+				handlers.NotFound(w, r)
+
+				return
+			}
+			writeInternalError(w, r, logger, "error joining tournament", err)
+
+			return
+		}
+
+		if err = handlers.WriteData(w, r, http.StatusOK, tournamentResponse(t)); err != nil {
+			logger.ErrorContext(ctx, "error encoding tournament join response", slog.Any("err", err))
+		}
+	})
+}
+
+// HandleTournamentStandings returns the cumulative standings for the
+// tournament with the path's join code. Like the quiz leaderboard it is
+// readable by anyone holding the code; the caller's own row is flagged so the
+// client can highlight it. An unknown code is a 404.
+func HandleTournamentStandings(service *tournament.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := handlers.LoggerFromContext(ctx)
+
+		player, ok := auth.PlayerFromContext(ctx)
+		if !ok {
+			logger.ErrorContext(ctx, "missing player on context for tournament standings")
+			handlers.WriteError(w, r, http.StatusInternalServerError, "internal error")
+
+			return
+		}
+
+		standings, err := service.StandingsByJoinCode(ctx, r.PathValue("code"))
+		if err != nil {
+			if errors.Is(err, tournament.ErrTournamentNotFound) {
+				handlers.NotFound(w, r)
+
+				return
+			}
+			writeInternalError(w, r, logger, "error computing tournament standings", err)
+
+			return
+		}
+
+		res := client.TournamentStandings{
+			Tournament: tournamentResponse(standings.Tournament),
+			Entries:    make([]client.TournamentStanding, 0, len(standings.Entries)),
+		}
+		for _, e := range standings.Entries {
+			stages := make([]client.TournamentStageResult, 0, len(e.Stages))
+			for _, st := range e.Stages {
+				stages = append(stages, client.TournamentStageResult{Played: st.Played, Score: st.Score})
+			}
+			res.Entries = append(res.Entries, client.TournamentStanding{
+				Rank:            e.Rank,
+				PlayerID:        e.PlayerID,
+				DisplayName:     e.DisplayName,
+				Total:           e.Total,
+				StagesPlayed:    e.StagesPlayed,
+				Stages:          stages,
+				IsCurrentPlayer: e.PlayerID == player.ID,
+			})
+		}
+
+		if err = handlers.WriteData(w, r, http.StatusOK, res); err != nil {
+			logger.ErrorContext(ctx, "error encoding tournament standings response", slog.Any("err", err))
+		}
+	})
+}
+
+func tournamentResponse(t *tournament.Tournament) client.Tournament {
+	stages := make([]client.TournamentStage, 0, len(t.Stages))
+	for _, st := range t.Stages {
+		stages = append(stages, client.TournamentStage{
+			Position:  st.Position,
+			QuizID:    st.QuizID,
+			QuizTitle: st.QuizTitle,
+			QuizSlug:  st.QuizSlug,
+		})
+	}
+
+	return client.Tournament{ID: t.ID, Title: t.Title, JoinCode: t.JoinCode, Stages: stages}
+}
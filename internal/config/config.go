@@ -6,7 +6,9 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -31,11 +33,26 @@ var ErrSessionKeyRequired = errors.New("SESSION_KEY must be set")
 // enforced rather than trusting any non-empty value (#782).
 var ErrSessionKeyTooShort = fmt.Errorf("SESSION_KEY must be at least %d bytes", sessionKeyByteLength)
 
+// ErrAdminAPITokenTooShort is returned when ADMIN_API_TOKEN is set but is
+// shorter than the session key minimum. The token alone authorizes writes to
+// any quiz, so it is held to the same length rule as SESSION_KEY.
+var ErrAdminAPITokenTooShort = fmt.Errorf("ADMIN_API_TOKEN must be at least %d bytes", sessionKeyByteLength)
+
 // ErrRevealDelayNegative is returned when REVEAL_DELAY parses to a negative
 // duration. The reveal beat sits in the future on every question, so a
 // negative value would silently break the gameplay timing contract.
 var ErrRevealDelayNegative = errors.New("REVEAL_DELAY must not be negative")
 
+// ErrMinQuestionIntervalNegative is returned when MIN_QUESTION_INTERVAL parses
+// to a negative duration. Zero already means "no minimum", so a negative value
+// is meaningless.
+var ErrMinQuestionIntervalNegative = errors.New("MIN_QUESTION_INTERVAL must not be negative")
+
+// ErrHintPenaltyPercentRange is returned when HINT_PENALTY_PERCENT is not a
+// percentage from 0 to 100. A larger penalty would take a hinted correct
+// answer below zero.
+var ErrHintPenaltyPercentRange = errors.New("HINT_PENALTY_PERCENT must be between 0 and 100")
+
 // ErrLoginCooldownNegative is returned when LOGIN_COOLDOWN parses to a
 // negative duration. The cooldown is a minimum gap between POST /login
 // attempts, so a negative value is meaningless; reject it rather than
@@ -66,6 +83,11 @@ var ErrSessionStartCountdownNegative = errors.New("SESSION_START_COUNTDOWN must
 // players) before the runner closes it, so a negative value is meaningless.
 var ErrSessionIdleCloseNegative = errors.New("SESSION_IDLE_CLOSE must not be negative")
 
+// ErrSessionMaxPlayersNegative is returned when SESSION_MAX_PLAYERS parses to a
+// negative integer. It caps how many players one live room admits; zero means
+// no cap, so a negative value is meaningless.
+var ErrSessionMaxPlayersNegative = errors.New("SESSION_MAX_PLAYERS must not be negative")
+
 // ErrMediaUploadBudgetNegative is returned when MEDIA_UPLOAD_BUDGET parses to a
 // negative integer. It is the per-host file allowance over the rolling window,
 // so a negative value is meaningless; zero is allowed and disables the limiter
@@ -128,6 +150,31 @@ var ErrSMTPPortInvalid = errors.New("SMTP_PORT must be an integer in 1..65535")
 // leaking into the logs).
 var ErrSMTPAuthIncomplete = errors.New("SMTP_USERNAME and SMTP_PASSWORD must both be set or both empty")
 
+// ErrHSTSMaxAgeNegative is returned when HSTS_MAX_AGE parses to a negative
+// duration. It becomes the header's max-age, so a negative value is
+// meaningless; turn HSTS off with HSTS_ENABLED=false instead.
+var ErrHSTSMaxAgeNegative = errors.New("HSTS_MAX_AGE must not be negative")
+
+// ErrHTTPRedirectNeedsHTTPSBaseURL is returned when HTTP_REDIRECT_PORT is set
+// without an https BASE_URL. The redirect listener sends every visitor to
+// BASE_URL rather than to the request's Host header, which a client controls,
+// so it has nowhere safe to point without one.
+var ErrHTTPRedirectNeedsHTTPSBaseURL = errors.New("HTTP_REDIRECT_PORT requires BASE_URL to be an https:// URL")
+
+// ErrHTTPRedirectPortClash is returned when HTTP_REDIRECT_PORT names the same
+// port as PORT; the two listeners cannot share it.
+var ErrHTTPRedirectPortClash = errors.New("HTTP_REDIRECT_PORT must differ from PORT")
+
+// ErrAdminHostWithoutPort is returned when ADMIN_HOST is set without
+// ADMIN_PORT. The host alone would silently change nothing - the admin UI
+// would stay on the public listener - which is the opposite of what an
+// operator setting it wants.
+var ErrAdminHostWithoutPort = errors.New("ADMIN_HOST requires ADMIN_PORT")
+
+// ErrAdminPortClash is returned when ADMIN_PORT names the same port as PORT
+// or HTTP_REDIRECT_PORT; the listeners cannot share it.
+var ErrAdminPortClash = errors.New("ADMIN_PORT must differ from PORT and HTTP_REDIRECT_PORT")
+
 // ErrSMTPAuthOverCleartext is returned when SMTP credentials are
 // configured but SMTP_TLS is false, which would send the username and
 // password as PLAIN auth over an unencrypted connection. The local
@@ -135,6 +182,48 @@ var ErrSMTPAuthIncomplete = errors.New("SMTP_USERNAME and SMTP_PASSWORD must bot
 var ErrSMTPAuthOverCleartext = errors.New(
 	"smtp_username and smtp_password require smtp_tls=true; refusing to send credentials over cleartext")
 
+// ErrAnalyticsSinkInvalid is returned when ANALYTICS_SINK is set to anything
+// other than "stdout", "file:<path>", or an http(s):// collector URL.
+var ErrAnalyticsSinkInvalid = errors.New(
+	`ANALYTICS_SINK must be "stdout", "file:<path>", or an http(s):// URL`)
+
+// ErrOTelEndpointInvalid is returned when OTEL_EXPORTER_OTLP_ENDPOINT is set
+// to anything other than an absolute http(s):// URL.
+var ErrOTelEndpointInvalid = errors.New("OTEL_EXPORTER_OTLP_ENDPOINT must be an http(s):// URL")
+
+// ErrShutdownTimeoutInvalid is returned when SHUTDOWN_TIMEOUT parses to zero
+// or a negative duration: shutdown needs some budget to drain in-flight
+// requests.
+var ErrShutdownTimeoutInvalid = errors.New("SHUTDOWN_TIMEOUT must be positive")
+
+// ErrShutdownDrainDelayNegative is returned when SHUTDOWN_DRAIN_DELAY parses
+// to a negative duration.
+var ErrShutdownDrainDelayNegative = errors.New("SHUTDOWN_DRAIN_DELAY must not be negative")
+
+// ErrMediaStorageInvalid is returned when MEDIA_STORAGE is set to anything
+// other than "local" or "s3".
+var ErrMediaStorageInvalid = errors.New(`MEDIA_STORAGE must be "local" or "s3"`)
+
+// ErrMediaS3ConfigIncomplete is returned when MEDIA_STORAGE=s3 lacks the
+// endpoint, bucket, or credentials it needs to reach the bucket.
+var ErrMediaS3ConfigIncomplete = errors.New("MEDIA_STORAGE=s3 configuration is incomplete")
+
+// ErrMediaS3URLInvalid is returned when MEDIA_S3_ENDPOINT or
+// MEDIA_S3_PUBLIC_URL is not an http(s) URL with a host.
+var ErrMediaS3URLInvalid = errors.New("MEDIA_S3_ENDPOINT and MEDIA_S3_PUBLIC_URL must be http(s) URLs")
+
+// ErrLogLevelInvalid is returned when LOG_LEVEL is set to anything other
+// than "debug", "info", "warn" or "error".
+var ErrLogLevelInvalid = errors.New(`LOG_LEVEL must be "debug", "info", "warn" or "error"`)
+
+// ErrDBMaintenanceWindowInvalid is returned when DB_MAINTENANCE_WINDOW is set
+// to anything other than a "HH:MM-HH:MM" span with distinct ends.
+var ErrDBMaintenanceWindowInvalid = errors.New(`DB_MAINTENANCE_WINDOW must be "HH:MM-HH:MM"`)
+
+// ErrShuffleSeedHeaderInProduction is returned when SHUFFLE_SEED_HEADER_ENABLED
+// is set in production, where a client must never choose its own option order.
+var ErrShuffleSeedHeaderInProduction = errors.New("SHUFFLE_SEED_HEADER_ENABLED must not be set in production")
+
 const (
 	// AppEnvironmentDefault is the default application environment.
 	AppEnvironmentDefault = "development"
@@ -142,6 +231,10 @@ const (
 	// behaviours flip on this (see [Config.SecureCookies] and the DB_URI /
 	// SESSION_KEY validation in [Parse]).
 	AppEnvironmentProduction = "production"
+	// HSTSMaxAgeDefault is the Strict-Transport-Security max-age used when
+	// HSTS_MAX_AGE is unset: one year, the value browsers' preload lists
+	// expect.
+	HSTSMaxAgeDefault = 365 * 24 * time.Hour
 	// ClientDirDefault specifies the default directory for the player-client static files.
 	ClientDirDefault = ""
 	// WebStaticDirDefault is the default override for the shared static-asset
@@ -150,6 +243,9 @@ const (
 	// `make tailwind` regens visible without a binary restart, mirroring
 	// CLIENT_DIR for the player-client half.
 	WebStaticDirDefault = ""
+	// OTelServiceNameDefault is the service.name traces are reported under
+	// when OTEL_SERVICE_NAME is unset.
+	OTelServiceNameDefault = "topbanana"
 
 	// MediaDirDefault is the default filesystem directory for uploaded media
 	// (#936). Dev writes into ./media in the working directory; staging and
@@ -158,13 +254,36 @@ const (
 	// on every container restart.
 	MediaDirDefault = "./media"
 
+	// MediaStorageLocal and MediaStorageS3 are the MEDIA_STORAGE values:
+	// uploaded media under MediaDir (the default) or in an S3-compatible
+	// bucket.
+	MediaStorageLocal = "local"
+	MediaStorageS3    = "s3"
+
+	// MediaS3RegionDefault is the signing region when MEDIA_S3_REGION is
+	// unset. Self-hosted S3-compatible stores generally accept it.
+	MediaS3RegionDefault = "us-east-1"
+
+	// LogLevelDefault is the minimum level logged when LOG_LEVEL is unset.
+	// Debug keeps every environment's logs as verbose as they were before
+	// the level became configurable.
+	LogLevelDefault = slog.LevelDebug
+
 	// HostDefault is the default host to listen on. Can be an IP address or hostname.
 	HostDefault = "localhost"
+	// AdminHostDefault is the interface the separate admin listener binds
+	// when ADMIN_PORT is set without ADMIN_HOST: loopback, so splitting the
+	// admin UI off never exposes it wider than the operator asked.
+	AdminHostDefault = "localhost"
 	// PortDefault is the default port to listen on.
 	PortDefault = "8080"
 
-	// DBDriverDefault is the default database driver. Currently, only sqlite is supported.
+	// DBDriverDefault is the default database driver, used for any DB_URI
+	// that is not a Postgres URL.
 	DBDriverDefault = "sqlite"
+	// DBDriverPostgres is the driver a postgres:// or postgresql:// DB_URI
+	// selects.
+	DBDriverPostgres = "postgres"
 	// DBURIDefault is the default database URI. Default is topbanana.sqlite in the current directory.
 	DBURIDefault = "file:topbanana.sqlite?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=synchronous(NORMAL)&_pragma=busy_timeout(5000)&_txlock=immediate"
 	// DBMaxOpenConnsDefault is the default maximum number of open database connections.
@@ -181,6 +300,11 @@ const (
 	// field into auth.NewLoginRateLimiter.
 	LoginCooldownDefault = 3 * time.Second
 
+	// ShutdownTimeoutDefault is how long shutdown waits for in-flight
+	// requests, and then for background email dispatches, when
+	// SHUTDOWN_TIMEOUT is unset.
+	ShutdownTimeoutDefault = 5 * time.Second
+
 	// MediaUploadBudgetDefault is the default per-host file allowance over
 	// MediaUploadBudgetWindow (#988). Set generously for a real host folder
 	// upload (the per-request cap is 10 files, so this is six full batches a
@@ -229,6 +353,10 @@ const (
 	// import budget is measured over.
 	MediaImportBudgetWindowDefault = time.Minute
 
+	// HintPenaltyPercentDefault is the default share of an answer's points a
+	// hint costs: half, enough that a confident player skips it.
+	HintPenaltyPercentDefault = 50
+
 	// sessionKeyByteLength is the length in bytes of an ephemeral session key generated for development.
 	sessionKeyByteLength = 32
 )
@@ -240,6 +368,10 @@ type Config struct {
 	Host string
 	Port string
 
+	// LogLevel is the minimum level the server logs (LOG_LEVEL). Defaults to
+	// [LogLevelDefault].
+	LogLevel slog.Level
+
 	DBDriver string
 	DBURI    string
 
@@ -253,6 +385,25 @@ type Config struct {
 	// has no writable app FS by default. Created at startup if missing.
 	MediaDir string
 
+	// MediaStorage selects where uploaded media files live (MEDIA_STORAGE):
+	// MediaStorageLocal (the default) writes under MediaDir, MediaStorageS3
+	// puts them in the bucket the MediaS3* fields describe.
+	MediaStorage string
+	// MediaS3Endpoint / MediaS3Region / MediaS3Bucket / MediaS3AccessKeyID /
+	// MediaS3SecretAccessKey address and authenticate the bucket when
+	// MediaStorage is MediaStorageS3. MediaS3PathStyle (MEDIA_S3_PATH_STYLE)
+	// addresses the bucket in the URL path rather than the host name, which
+	// most self-hosted stores need. MediaS3PublicURL, when set, is a base URL
+	// the objects are publicly readable from; a public quiz's media is then
+	// redirected there instead of proxied.
+	MediaS3Endpoint        *url.URL
+	MediaS3Region          string
+	MediaS3Bucket          string
+	MediaS3AccessKeyID     string
+	MediaS3SecretAccessKey string
+	MediaS3PathStyle       bool
+	MediaS3PublicURL       string
+
 	ClientDir string
 
 	// WebStaticDir overrides the on-disk path served at /static/ for the
@@ -264,6 +415,12 @@ type Config struct {
 
 	SessionKey string
 
+	// AdminAPIToken is the bearer token the programmatic authoring API
+	// (/api/admin/...) accepts, for content pipelines that sync quizzes from
+	// elsewhere. Empty, the default, leaves the API off and its routes 404.
+	// Parsed from ADMIN_API_TOKEN.
+	AdminAPIToken string
+
 	// AdminEmails is the allowlist of email addresses promoted to admin once the
 	// address is proven verified (at email-verify-token consume or OAuth callback),
 	// not at registration. Parsed from the comma-separated ADMIN_EMAILS env var.
@@ -293,6 +450,20 @@ type Config struct {
 	// only driver today.
 	ProfileEnabled bool
 
+	// APILegacyShapes keeps the /api responses in their pre-envelope shapes -
+	// bare JSON values and plain-text errors - for clients that have not
+	// moved to the data/meta/error envelope yet. Defaults to true while the
+	// bundled player client still reads the old shapes; a request opts into
+	// the envelope regardless with the X-Api-Envelope header. Parsed from
+	// API_LEGACY_SHAPES via strconv.ParseBool.
+	APILegacyShapes bool
+
+	// ShuffleSeedHeader lets a request pin the per-game option shuffle with
+	// the X-Shuffle-Seed header, so the Playwright suite sees the same option
+	// layout on every run. Test-only: off by default, and Parse refuses it in
+	// production. Parsed from SHUFFLE_SEED_HEADER_ENABLED via strconv.ParseBool.
+	ShuffleSeedHeader bool
+
 	// DemoSeedArchiveDir is the directory the seed-demo command reads quiz
 	// archive zips from (DEMO_SEED_ARCHIVE_DIR). The files come from the demo
 	// deployment's bind mount rather than being embedded. Only consumed by
@@ -310,6 +481,18 @@ type Config struct {
 	// few hundred ms to speed up runs without losing the visual reveal phase.
 	RevealDelay time.Duration
 
+	// MinQuestionInterval is the least time the server lets pass between
+	// issuing one question of a solo game and the next, so a bot cannot blast
+	// through a quiz in a second; a request inside it answers 429 with
+	// Retry-After. Zero, the default, disables the check. Parsed from the
+	// MIN_QUESTION_INTERVAL env var via time.ParseDuration.
+	MinQuestionInterval time.Duration
+
+	// HintPenaltyPercent is the share of an answer's points a player loses
+	// for taking the question's hint, 0 to 100. Parsed from the
+	// HINT_PENALTY_PERCENT env var; defaults to HintPenaltyPercentDefault.
+	HintPenaltyPercent int
+
 	// SessionRunnerBeat overrides the live-session runner's round-intro,
 	// reveal, and between-rounds beats (MP-5 / #682). Zero means "use the
 	// built-in defaults" (3s / 4s / 6s). Parsed from the SESSION_RUNNER_BEAT
@@ -351,6 +534,12 @@ type Config struct {
 	// suites shrink it so an idle-close spec does not wait the production window.
 	SessionIdleClose time.Duration
 
+	// SessionMaxPlayers caps how many players one hosted room admits, so a
+	// large uncontrolled game cannot swamp the session hub. A quiz's own
+	// max_players overrides it for games of that quiz. Zero (the default)
+	// means no cap. Parsed from the SESSION_MAX_PLAYERS env var.
+	SessionMaxPlayers int
+
 	// LoginCooldown is the per-IP minimum gap between POST /login attempts,
 	// passed into auth.NewLoginRateLimiter (#494). Defaults to 3s (mirrors
 	// auth.loginCooldown via LoginCooldownDefault). Parsed from the
@@ -451,13 +640,142 @@ type Config struct {
 	BaseURL string
 
 	// TrustedProxyCIDRs is the parsed allow-list of upstream reverse
-	// proxies whose X-Forwarded-For header the per-IP rate limiters
-	// should honour. Empty (the default) means "no proxy in front" -
-	// XFF is ignored entirely and limiters bucket on RemoteAddr only,
-	// which is the only fail-secure default when the binary is
-	// exposed directly. Parsed from the TRUSTED_PROXY_IPS env var as
-	// a comma-separated CIDR list; see #463.
+	// proxies whose X-Forwarded-For and X-Forwarded-Proto headers are
+	// honoured: by the per-IP rate limiters, by the request log's client
+	// address, and by the Secure flag on cookies issued over a proxied
+	// HTTPS connection. Empty (the default) means "no proxy in front" -
+	// the headers are ignored entirely and everything keys on the direct
+	// peer, which is the only fail-secure default when the binary is
+	// exposed directly. Parsed from the TRUSTED_PROXIES env var (or its
+	// older name TRUSTED_PROXY_IPS) as a comma-separated CIDR list; see
+	// #463.
 	TrustedProxyCIDRs []*net.IPNet
+
+	// SecureCookiesOverride pins the Secure attribute on issued cookies
+	// regardless of APP_ENV, for a deployment whose environment name does
+	// not match its transport (a development build served over TLS, or a
+	// staging box on a plain-HTTP LAN). Nil when SECURE_COOKIES is unset,
+	// leaving [Config.SecureCookies] to its per-environment default.
+	SecureCookiesOverride *bool
+
+	// HSTSDisabled turns the Strict-Transport-Security header off even when
+	// cookies are Secure (HSTS_ENABLED=false), for a deployment that still
+	// needs plain HTTP on some hostname under the same domain.
+	HSTSDisabled bool
+	// HSTSMaxAge is the header's max-age (HSTS_MAX_AGE, a Go duration). Zero
+	// means [HSTSMaxAgeDefault].
+	HSTSMaxAge time.Duration
+	// HSTSExcludeSubdomains drops includeSubDomains from the header
+	// (HSTS_INCLUDE_SUBDOMAINS=false), for a domain whose other subdomains
+	// are not all served over HTTPS.
+	HSTSExcludeSubdomains bool
+
+	// HTTPRedirectPort, when set, opens a second plain-HTTP listener on
+	// HOST at this port that permanently redirects every request to the
+	// same path under BASE_URL, for a self-hosted box whose TLS terminator
+	// only forwards port 443. Empty (the default) means no redirect
+	// listener.
+	HTTPRedirectPort string
+
+	// AdminPort, when set, moves the admin UI (/admin and everything under
+	// it) onto its own listener at AdminHost:AdminPort; the public listener
+	// then answers those paths with 404. Empty (the default) serves the
+	// admin UI on the public listener as before.
+	AdminPort string
+	// AdminHost is the interface the admin listener binds (ADMIN_HOST).
+	// Defaults to [AdminHostDefault]; only meaningful with AdminPort.
+	AdminHost string
+
+	// AnalyticsSink names where gameplay analytics events go (ANALYTICS_SINK):
+	// "stdout", "file:<path>" for an append-only JSON-lines file, or an
+	// http(s):// collector URL that receives NDJSON POSTs. Empty (the
+	// default) emits nothing.
+	AnalyticsSink string
+
+	// OTelEndpoint is the OTLP/HTTP collector base URL traces are exported
+	// to (OTEL_EXPORTER_OTLP_ENDPOINT); spans are POSTed to its /v1/traces
+	// path. Empty (the default) disables tracing.
+	OTelEndpoint string
+	// OTelServiceName is the service.name spans are reported under
+	// (OTEL_SERVICE_NAME), defaulting to "topbanana".
+	OTelServiceName string
+
+	// ShutdownTimeout bounds each wait of a graceful shutdown: the in-flight
+	// requests, then the background email dispatches (SHUTDOWN_TIMEOUT).
+	// Defaults to [ShutdownTimeoutDefault].
+	ShutdownTimeout time.Duration
+	// ShutdownDrainDelay is how long the server keeps accepting requests
+	// after a shutdown signal, answering /healthz with 503, before it stops
+	// listening (SHUTDOWN_DRAIN_DELAY). It gives a load balancer polling the
+	// health check time to take the instance out of rotation. Zero (the
+	// default) stops listening at once.
+	ShutdownDrainDelay time.Duration
+
+	// DBMaintenanceWindow is the daily quiet window the database maintenance
+	// job (PRAGMA optimize plus an incremental vacuum) runs in, parsed from
+	// DB_MAINTENANCE_WINDOW as "HH:MM-HH:MM" in the server's local time. Nil
+	// (the default) disables the job.
+	DBMaintenanceWindow *QuietWindow
+}
+
+// QuietWindow is a daily span of wall-clock time, Start inclusive and End
+// exclusive, each an offset from local midnight. An End before Start wraps
+// past midnight, so "23:00-02:00" covers the small hours.
+type QuietWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Contains reports whether t's local wall-clock time falls in the window.
+func (w QuietWindow) Contains(t time.Time) bool {
+	h, m, s := t.Clock()
+	offset := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+	if w.Start <= w.End {
+		return offset >= w.Start && offset < w.End
+	}
+
+	return offset >= w.Start || offset < w.End
+}
+
+// Length is how long the window stays open each day.
+func (w QuietWindow) Length() time.Duration {
+	if w.Start <= w.End {
+		return w.End - w.Start
+	}
+
+	return 24*time.Hour - w.Start + w.End
+}
+
+// String renders the window back in its DB_MAINTENANCE_WINDOW form.
+func (w QuietWindow) String() string {
+	clock := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+	}
+
+	return clock(w.Start) + "-" + clock(w.End)
+}
+
+// parseQuietWindow parses a DB_MAINTENANCE_WINDOW value. Empty yields nil (no
+// window); anything but two distinct "HH:MM" times joined by "-" is
+// [ErrDBMaintenanceWindowInvalid].
+func parseQuietWindow(val string) (*QuietWindow, error) {
+	if val == "" {
+		return nil, nil //nolint:nilnil // nil window means "maintenance disabled"; not an error.
+	}
+	from, to, ok := strings.Cut(val, "-")
+	if !ok {
+		return nil, fmt.Errorf("%w: got %q", ErrDBMaintenanceWindowInvalid, val)
+	}
+	start, startErr := time.Parse("15:04", strings.TrimSpace(from))
+	end, endErr := time.Parse("15:04", strings.TrimSpace(to))
+	if startErr != nil || endErr != nil || start.Equal(end) {
+		return nil, fmt.Errorf("%w: got %q", ErrDBMaintenanceWindowInvalid, val)
+	}
+	sinceMidnight := func(t time.Time) time.Duration {
+		return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+
+	return &QuietWindow{Start: sinceMidnight(start), End: sinceMidnight(end)}, nil
 }
 
 // DatabaseConfig holds only the database settings setupDB needs. The
@@ -509,12 +827,26 @@ func ParseDatabase(getenv func(string) string) (DatabaseConfig, error) {
 	return dbc, nil
 }
 
+// dbDriverForURI picks the database driver from the DB_URI scheme: a
+// postgres:// or postgresql:// URL selects Postgres, anything else - the
+// file: and :memory: SQLite DSNs - stays on SQLite.
+func dbDriverForURI(uri string) string {
+	scheme, _, found := strings.Cut(uri, "://")
+	if found && (strings.EqualFold(scheme, "postgres") || strings.EqualFold(scheme, "postgresql")) {
+		return DBDriverPostgres
+	}
+
+	return DBDriverDefault
+}
+
 // resolveDatabaseConfig applies the DB_URI fallback / production guard and
-// the pool-setting env overrides onto dbc. Shared by [Parse] and
+// the pool-setting env overrides onto dbc. The driver follows the DB_URI
+// scheme (see [dbDriverForURI]). Shared by [Parse] and
 // [ParseDatabase] so the two cannot drift.
 func resolveDatabaseConfig(getenv func(string) string, appEnvironment string, dbc *DatabaseConfig) error {
 	if val := getenv("DB_URI"); val != "" {
 		dbc.URI = val
+		dbc.Driver = dbDriverForURI(val)
 	}
 	if appEnvironment == AppEnvironmentProduction && getenv("DB_URI") == "" {
 		return ErrDBURINotSetInProduction
@@ -567,10 +899,35 @@ func (c *Config) SMTPConfigured() bool {
 // a non-production deploy (#340). Unset is intentionally fail-secure -
 // Parse leaves AppEnvironment as the empty string when APP_ENV is unset
 // so a bare-binary boot in a production-like context defaults to Secure.
+// SECURE_COOKIES, when set, overrides the per-environment default either way.
 func (c *Config) SecureCookies() bool {
+	if c.SecureCookiesOverride != nil {
+		return *c.SecureCookiesOverride
+	}
+
 	return c.AppEnvironment != AppEnvironmentDefault
 }
 
+// StrictTransportSecurity returns the Strict-Transport-Security header value,
+// or the empty string when none should be sent. HSTS follows
+// [Config.SecureCookies]: a deployment issuing non-Secure cookies is being
+// served over plain HTTP somewhere, and pinning HTTPS would lock that out.
+func (c *Config) StrictTransportSecurity() string {
+	if !c.SecureCookies() || c.HSTSDisabled {
+		return ""
+	}
+	maxAge := c.HSTSMaxAge
+	if maxAge == 0 {
+		maxAge = HSTSMaxAgeDefault
+	}
+	v := "max-age=" + strconv.FormatInt(int64(maxAge/time.Second), 10)
+	if !c.HSTSExcludeSubdomains {
+		v += "; includeSubDomains"
+	}
+
+	return v
+}
+
 // EnvTitleTag returns a bracketed environment label for page titles,
 // or the empty string on production. Renders as e.g. "[staging] " so a
 // templated title can prefix it unconditionally and the production case
@@ -598,13 +955,16 @@ func defaultConfig() Config {
 		MediaDir:                MediaDirDefault,
 		Host:                    HostDefault,
 		Port:                    PortDefault,
+		LogLevel:                LogLevelDefault,
 		DBDriver:                DBDriverDefault,
 		DBURI:                   DBURIDefault,
 		DBMaxOpenConns:          DBMaxOpenConnsDefault,
 		DBMaxIdleConns:          DBMaxIdleConnsDefault,
 		DBConnMaxLifetime:       DBConnMaxLifetimeDefault,
 		ProfileEnabled:          true,
+		APILegacyShapes:         true,
 		LoginCooldown:           LoginCooldownDefault,
+		ShutdownTimeout:         ShutdownTimeoutDefault,
 		MediaUploadBudget:       MediaUploadBudgetDefault,
 		MediaUploadBudgetWindow: MediaUploadBudgetWindowDefault,
 		MediaQuizImageLimit:     MediaQuizImageLimitDefault,
@@ -613,6 +973,7 @@ func defaultConfig() Config {
 		MediaImportMaxBytes:     MediaImportMaxBytesDefault,
 		MediaImportBudget:       MediaImportBudgetDefault,
 		MediaImportBudgetWindow: MediaImportBudgetWindowDefault,
+		HintPenaltyPercent:      HintPenaltyPercentDefault,
 	}
 }
 
@@ -636,6 +997,13 @@ func Parse(getenv func(string) string) (*Config, error) {
 	if val := getenv("PORT"); val != "" {
 		c.Port = val
 	}
+	if val := getenv("LOG_LEVEL"); val != "" {
+		level, err := parseLogLevel(val)
+		if err != nil {
+			return nil, err
+		}
+		c.LogLevel = level
+	}
 	if c.AppEnvironment == "development" {
 		if val := getenv("CLIENT_DIR"); val != "" {
 			c.ClientDir = val
@@ -647,6 +1015,9 @@ func Parse(getenv func(string) string) (*Config, error) {
 	if val := getenv("MEDIA_DIR"); val != "" {
 		c.MediaDir = val
 	}
+	if err := parseMediaStorageConfig(getenv, &c); err != nil {
+		return nil, err
+	}
 
 	if err := c.applyDatabaseConfig(getenv); err != nil {
 		return nil, err
@@ -655,6 +1026,9 @@ func Parse(getenv func(string) string) (*Config, error) {
 	if err := parseTypedEnvVars(getenv, &c); err != nil {
 		return nil, err
 	}
+	if err := parseShuffleSeedHeader(getenv, &c); err != nil {
+		return nil, err
+	}
 
 	key, err := resolveSessionKey(getenv("SESSION_KEY"), c.AppEnvironment)
 	if err != nil {
@@ -662,6 +1036,11 @@ func Parse(getenv func(string) string) (*Config, error) {
 	}
 	c.SessionKey = key
 
+	c.AdminAPIToken = getenv("ADMIN_API_TOKEN")
+	if c.AdminAPIToken != "" && len(c.AdminAPIToken) < sessionKeyByteLength {
+		return nil, fmt.Errorf("%w (got %d)", ErrAdminAPITokenTooShort, len(c.AdminAPIToken))
+	}
+
 	c.AdminEmails = parseAdminEmails(getenv("ADMIN_EMAILS"))
 
 	c.GoogleClientID = getenv("GOOGLE_CLIENT_ID")
@@ -682,14 +1061,169 @@ func Parse(getenv func(string) string) (*Config, error) {
 
 	c.BaseURL = strings.TrimRight(getenv("BASE_URL"), "/")
 
-	c.TrustedProxyCIDRs, err = request.ParseTrustedProxyCIDRs(getenv("TRUSTED_PROXY_IPS"))
+	// TRUSTED_PROXIES wins; TRUSTED_PROXY_IPS is its older name, still
+	// read so existing deployments keep their allow-list.
+	proxiesKey := "TRUSTED_PROXIES"
+	proxies := getenv(proxiesKey)
+	if proxies == "" {
+		proxiesKey = "TRUSTED_PROXY_IPS"
+		proxies = getenv(proxiesKey)
+	}
+	c.TrustedProxyCIDRs, err = request.ParseTrustedProxyCIDRs(proxies)
 	if err != nil {
-		return nil, fmt.Errorf("invalid TRUSTED_PROXY_IPS: %w", err)
+		return nil, fmt.Errorf("invalid %s: %w", proxiesKey, err)
+	}
+
+	if err = parseTransportSecurity(getenv, &c); err != nil {
+		return nil, err
+	}
+	if err = parseAdminListener(getenv, &c); err != nil {
+		return nil, err
+	}
+
+	c.AnalyticsSink = getenv("ANALYTICS_SINK")
+	if !validAnalyticsSink(c.AnalyticsSink) {
+		return nil, fmt.Errorf("%w: got %q", ErrAnalyticsSinkInvalid, c.AnalyticsSink)
+	}
+
+	c.OTelEndpoint = getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	if c.OTelEndpoint != "" {
+		if u, uErr := url.Parse(c.OTelEndpoint); uErr != nil || !isHTTPURL(u) {
+			return nil, fmt.Errorf("%w: got %q", ErrOTelEndpointInvalid, c.OTelEndpoint)
+		}
+	}
+	c.OTelServiceName = getenv("OTEL_SERVICE_NAME")
+	if c.OTelServiceName == "" {
+		c.OTelServiceName = OTelServiceNameDefault
+	}
+
+	if err = parseShutdown(getenv, &c); err != nil {
+		return nil, err
+	}
+
+	if c.DBMaintenanceWindow, err = parseQuietWindow(getenv("DB_MAINTENANCE_WINDOW")); err != nil {
+		return nil, err
 	}
 
 	return &c, nil
 }
 
+// parseLogLevel maps a LOG_LEVEL value, in any case, onto its slog level.
+func parseLogLevel(val string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(val)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return 0, fmt.Errorf("%w: got %q", ErrLogLevelInvalid, val)
+	}
+}
+
+// parseShutdown reads SHUTDOWN_TIMEOUT and SHUTDOWN_DRAIN_DELAY into c.
+func parseShutdown(getenv func(string) string, c *Config) error {
+	if err := parseNonNegativeDuration(
+		getenv, "SHUTDOWN_TIMEOUT", ErrShutdownTimeoutInvalid, &c.ShutdownTimeout,
+	); err != nil {
+		return err
+	}
+	if c.ShutdownTimeout == 0 {
+		return fmt.Errorf("%w: %q", ErrShutdownTimeoutInvalid, getenv("SHUTDOWN_TIMEOUT"))
+	}
+
+	return parseNonNegativeDuration(
+		getenv, "SHUTDOWN_DRAIN_DELAY", ErrShutdownDrainDelayNegative, &c.ShutdownDrainDelay,
+	)
+}
+
+// validAnalyticsSink reports whether sink is empty or one of the ANALYTICS_SINK
+// forms: "stdout", "file:" with a path, or an http(s) URL with a host.
+func validAnalyticsSink(sink string) bool {
+	switch {
+	case sink == "" || sink == "stdout":
+		return true
+	case strings.HasPrefix(sink, "file:"):
+		return strings.TrimPrefix(sink, "file:") != ""
+	case strings.HasPrefix(sink, "http://") || strings.HasPrefix(sink, "https://"):
+		u, err := url.Parse(sink)
+
+		return err == nil && u.Host != ""
+	default:
+		return false
+	}
+}
+
+// parseTransportSecurity reads the cookie, HSTS, and HTTP-redirect knobs into
+// c. It runs after BASE_URL and PORT are resolved, since the redirect
+// listener is validated against both.
+func parseTransportSecurity(getenv func(string) string, c *Config) error {
+	if val := getenv("SECURE_COOKIES"); val != "" {
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("invalid SECURE_COOKIES: %q, err: %w", val, err)
+		}
+		c.SecureCookiesOverride = &b
+	}
+
+	if val := getenv("HSTS_ENABLED"); val != "" {
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("invalid HSTS_ENABLED: %q, err: %w", val, err)
+		}
+		c.HSTSDisabled = !b
+	}
+	if val := getenv("HSTS_INCLUDE_SUBDOMAINS"); val != "" {
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("invalid HSTS_INCLUDE_SUBDOMAINS: %q, err: %w", val, err)
+		}
+		c.HSTSExcludeSubdomains = !b
+	}
+	if err := parseNonNegativeDuration(getenv, "HSTS_MAX_AGE", ErrHSTSMaxAgeNegative, &c.HSTSMaxAge); err != nil {
+		return err
+	}
+
+	c.HTTPRedirectPort = getenv("HTTP_REDIRECT_PORT")
+	if c.HTTPRedirectPort == "" {
+		return nil
+	}
+	if !strings.HasPrefix(c.BaseURL, "https://") {
+		return ErrHTTPRedirectNeedsHTTPSBaseURL
+	}
+	if c.HTTPRedirectPort == c.Port {
+		return fmt.Errorf("%w: both are %q", ErrHTTPRedirectPortClash, c.Port)
+	}
+
+	return nil
+}
+
+// parseAdminListener reads ADMIN_PORT and ADMIN_HOST. It runs after
+// parseTransportSecurity so the port can be checked against
+// HTTP_REDIRECT_PORT as well as PORT.
+func parseAdminListener(getenv func(string) string, c *Config) error {
+	c.AdminPort = getenv("ADMIN_PORT")
+	c.AdminHost = getenv("ADMIN_HOST")
+	if c.AdminPort == "" {
+		if c.AdminHost != "" {
+			return ErrAdminHostWithoutPort
+		}
+
+		return nil
+	}
+	if c.AdminHost == "" {
+		c.AdminHost = AdminHostDefault
+	}
+	if c.AdminPort == c.Port || c.AdminPort == c.HTTPRedirectPort {
+		return fmt.Errorf("%w: got %q", ErrAdminPortClash, c.AdminPort)
+	}
+
+	return nil
+}
+
 // GoogleLoginEnabled reports whether all three Google OAuth env vars are
 // populated. The Google sign-in routes only register when this returns
 // true; the login template hides the button as well. Lets a deployment
@@ -747,10 +1281,28 @@ func parseTypedEnvVars(getenv func(string) string, c *Config) error {
 		c.DemoMode = b
 	}
 
+	if val := getenv("API_LEGACY_SHAPES"); val != "" {
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("invalid API_LEGACY_SHAPES: %q, err: %w", val, err)
+		}
+		c.APILegacyShapes = b
+	}
+
 	if err := parseNonNegativeDuration(getenv, "REVEAL_DELAY", ErrRevealDelayNegative, &c.RevealDelay); err != nil {
 		return err
 	}
 
+	if err := parseNonNegativeDuration(
+		getenv, "MIN_QUESTION_INTERVAL", ErrMinQuestionIntervalNegative, &c.MinQuestionInterval,
+	); err != nil {
+		return err
+	}
+
+	if err := parseHintPenaltyPercent(getenv, c); err != nil {
+		return err
+	}
+
 	if err := parseNonNegativeDuration(
 		getenv, "SESSION_RUNNER_BEAT", ErrSessionRunnerBeatNegative, &c.SessionRunnerBeat,
 	); err != nil {
@@ -781,6 +1333,12 @@ func parseTypedEnvVars(getenv func(string) string, c *Config) error {
 		return err
 	}
 
+	if err := parseNonNegativeInt(
+		getenv, "SESSION_MAX_PLAYERS", ErrSessionMaxPlayersNegative, &c.SessionMaxPlayers,
+	); err != nil {
+		return err
+	}
+
 	if err := parseNonNegativeDuration(
 		getenv, "LOGIN_COOLDOWN", ErrLoginCooldownNegative, &c.LoginCooldown,
 	); err != nil {
@@ -790,6 +1348,40 @@ func parseTypedEnvVars(getenv func(string) string, c *Config) error {
 	return parseMediaUploadLimits(getenv, c)
 }
 
+// parseShuffleSeedHeader reads SHUFFLE_SEED_HEADER_ENABLED into c, refusing it
+// in production so a deploy cannot leak the test-only seed override.
+func parseShuffleSeedHeader(getenv func(string) string, c *Config) error {
+	val := getenv("SHUFFLE_SEED_HEADER_ENABLED")
+	if val == "" {
+		return nil
+	}
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		return fmt.Errorf("invalid SHUFFLE_SEED_HEADER_ENABLED: %q, err: %w", val, err)
+	}
+	if b && c.IsProduction() {
+		return ErrShuffleSeedHeaderInProduction
+	}
+	c.ShuffleSeedHeader = b
+
+	return nil
+}
+
+// parseHintPenaltyPercent reads HINT_PENALTY_PERCENT into c, refusing a value
+// outside 0..100.
+func parseHintPenaltyPercent(getenv func(string) string, c *Config) error {
+	if err := parseNonNegativeInt(
+		getenv, "HINT_PENALTY_PERCENT", ErrHintPenaltyPercentRange, &c.HintPenaltyPercent,
+	); err != nil {
+		return err
+	}
+	if c.HintPenaltyPercent > 100 {
+		return fmt.Errorf("%w: %d", ErrHintPenaltyPercentRange, c.HintPenaltyPercent)
+	}
+
+	return nil
+}
+
 // parseMediaUploadLimits reads the upload-backstop env vars (#988) into c: the
 // per-host file budget and its window, plus the per-quiz library ceiling. Split
 // out of parseTypedEnvVars so that function stays within the function-length
@@ -1000,6 +1592,65 @@ func parseSMTPConfig(getenv func(string) string, c *Config) error {
 	return nil
 }
 
+// parseMediaStorageConfig reads MEDIA_STORAGE and, for the s3 backend, the
+// MEDIA_S3_* vars into c. Local storage ignores the S3 block; an s3 backend
+// missing its endpoint, bucket, or either credential is rejected with
+// ErrMediaS3ConfigIncomplete rather than failing on the first upload.
+func parseMediaStorageConfig(getenv func(string) string, c *Config) error {
+	c.MediaStorage = MediaStorageLocal
+	if val := getenv("MEDIA_STORAGE"); val != "" {
+		c.MediaStorage = val
+	}
+	switch c.MediaStorage {
+	case MediaStorageLocal:
+		return nil
+	case MediaStorageS3:
+	default:
+		return fmt.Errorf("%w: got %q", ErrMediaStorageInvalid, c.MediaStorage)
+	}
+
+	c.MediaS3Region = MediaS3RegionDefault
+	if val := getenv("MEDIA_S3_REGION"); val != "" {
+		c.MediaS3Region = val
+	}
+	c.MediaS3Bucket = getenv("MEDIA_S3_BUCKET")
+	c.MediaS3AccessKeyID = getenv("MEDIA_S3_ACCESS_KEY_ID")
+	c.MediaS3SecretAccessKey = getenv("MEDIA_S3_SECRET_ACCESS_KEY")
+	c.MediaS3PublicURL = strings.TrimRight(getenv("MEDIA_S3_PUBLIC_URL"), "/")
+	if val := getenv("MEDIA_S3_PATH_STYLE"); val != "" {
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("invalid MEDIA_S3_PATH_STYLE: %q, err: %w", val, err)
+		}
+		c.MediaS3PathStyle = b
+	}
+
+	endpoint := getenv("MEDIA_S3_ENDPOINT")
+	if endpoint == "" || c.MediaS3Bucket == "" || c.MediaS3AccessKeyID == "" || c.MediaS3SecretAccessKey == "" {
+		return fmt.Errorf(
+			"%w: set MEDIA_S3_ENDPOINT, MEDIA_S3_BUCKET, MEDIA_S3_ACCESS_KEY_ID, and MEDIA_S3_SECRET_ACCESS_KEY",
+			ErrMediaS3ConfigIncomplete,
+		)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || !isHTTPURL(u) {
+		return fmt.Errorf("%w: MEDIA_S3_ENDPOINT=%q", ErrMediaS3URLInvalid, endpoint)
+	}
+	c.MediaS3Endpoint = u
+	if c.MediaS3PublicURL != "" {
+		if pu, pErr := url.Parse(c.MediaS3PublicURL); pErr != nil || !isHTTPURL(pu) {
+			return fmt.Errorf("%w: MEDIA_S3_PUBLIC_URL=%q", ErrMediaS3URLInvalid, c.MediaS3PublicURL)
+		}
+	}
+
+	return nil
+}
+
+// isHTTPURL reports whether u is an absolute http(s) URL with a host.
+func isHTTPURL(u *url.URL) bool {
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
 // parseAdminEmails splits a comma-separated list, trims whitespace,
 // lowercases each entry, and drops empty entries. Lowercasing matches
 // how the register handler normalises the form value before comparing,
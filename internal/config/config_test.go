@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"errors"
+	"log/slog"
 	"slices"
 	"strings"
 	"testing"
@@ -358,6 +359,24 @@ func TestParse(t *testing.T) {
 		}
 	})
 
+	t.Run("short ADMIN_API_TOKEN is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		getenv := func(key string) string {
+			envs := map[string]string{
+				"SESSION_KEY":     "test-session-key-test-session-key",
+				"ADMIN_API_TOKEN": "too-short",
+			}
+
+			return envs[key]
+		}
+
+		_, err := Parse(getenv)
+		if got, want := err, ErrAdminAPITokenTooShort; !errors.Is(got, want) {
+			t.Fatalf("Parse() err = %v, want %v", got, want)
+		}
+	})
+
 	t.Run("minimum-length SESSION_KEY is accepted", func(t *testing.T) {
 		t.Parallel()
 
@@ -492,6 +511,111 @@ func TestParse_LoginApprovalRequired(t *testing.T) {
 	})
 }
 
+func TestParse_APILegacyShapes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid values", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name  string
+			value string
+			want  bool
+		}{
+			{"unset defaults to true", "", true},
+			{"true string", "true", true},
+			{"false string", "false", false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				getenv := func(key string) string {
+					switch key {
+					case "API_LEGACY_SHAPES":
+						return tt.value
+					case "APP_ENV":
+						return "development"
+					}
+
+					return ""
+				}
+
+				c, err := Parse(getenv)
+				if err != nil {
+					t.Fatalf("Parse() err = %v, want nil", err)
+				}
+				if got, want := c.APILegacyShapes, tt.want; got != want {
+					t.Errorf("APILegacyShapes = %v, want %v", got, want)
+				}
+			})
+		}
+	})
+
+	t.Run("invalid value returns error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Parse(getenvFailure("API_LEGACY_SHAPES", "maybe"))
+		if err == nil {
+			t.Fatal("Parse() with invalid API_LEGACY_SHAPES: err = nil, want non-nil")
+		}
+		if got, want := err.Error(), "invalid API_LEGACY_SHAPES"; !strings.Contains(got, want) {
+			t.Errorf("err.Error() = %q, should contain %q", got, want)
+		}
+	})
+}
+
+func TestParse_ShuffleSeedHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("off by default, on when set outside production", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			value string
+			want  bool
+		}{{"", false}, {"true", true}, {"false", false}} {
+			c, err := Parse(func(key string) string {
+				return map[string]string{"APP_ENV": "development", "SHUFFLE_SEED_HEADER_ENABLED": tt.value}[key]
+			})
+			if err != nil {
+				t.Fatalf("Parse(%q) err = %v, want nil", tt.value, err)
+			}
+			if got := c.ShuffleSeedHeader; got != tt.want {
+				t.Errorf("Parse(%q) ShuffleSeedHeader = %v, want %v", tt.value, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("refused in production", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Parse(func(key string) string {
+			return map[string]string{
+				"APP_ENV":                     "production",
+				"DB_URI":                      "file:test.sqlite",
+				"SESSION_KEY":                 "test-session-key-test-session-key",
+				"SHUFFLE_SEED_HEADER_ENABLED": "true",
+			}[key]
+		})
+		if !errors.Is(err, ErrShuffleSeedHeaderInProduction) {
+			t.Errorf("Parse() err = %v, want ErrShuffleSeedHeaderInProduction", err)
+		}
+	})
+
+	t.Run("invalid value returns error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Parse(getenvFailure("SHUFFLE_SEED_HEADER_ENABLED", "maybe"))
+		if err == nil {
+			t.Fatal("Parse() with invalid SHUFFLE_SEED_HEADER_ENABLED: err = nil, want non-nil")
+		}
+		if got, want := err.Error(), "invalid SHUFFLE_SEED_HEADER_ENABLED"; !strings.Contains(got, want) {
+			t.Errorf("err.Error() = %q, should contain %q", got, want)
+		}
+	})
+}
+
 func TestParse_RegistrationEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -805,6 +929,94 @@ func TestParse_RevealDelay(t *testing.T) {
 	})
 }
 
+func TestParse_MinQuestionInterval(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unset disables the check", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := Parse(getenvFailure("MIN_QUESTION_INTERVAL", ""))
+		if err != nil {
+			t.Fatalf("Parse() err = %v, want nil", err)
+		}
+		if got := c.MinQuestionInterval; got != 0 {
+			t.Errorf("MinQuestionInterval = %v, want 0", got)
+		}
+	})
+
+	t.Run("duration parses", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := Parse(getenvFailure("MIN_QUESTION_INTERVAL", "1500ms"))
+		if err != nil {
+			t.Fatalf("Parse() err = %v, want nil", err)
+		}
+		if got, want := c.MinQuestionInterval, 1500*time.Millisecond; got != want {
+			t.Errorf("MinQuestionInterval = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unparseable value returns error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Parse(getenvFailure("MIN_QUESTION_INTERVAL", "soon"))
+		if err == nil {
+			t.Fatal("Parse() with invalid MIN_QUESTION_INTERVAL: err = nil, want non-nil")
+		}
+		if got, want := err.Error(), "invalid MIN_QUESTION_INTERVAL"; !strings.Contains(got, want) {
+			t.Errorf("err.Error() = %q, should contain %q", got, want)
+		}
+	})
+
+	t.Run("negative value returns error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Parse(getenvFailure("MIN_QUESTION_INTERVAL", "-1s"))
+		if got, want := err, ErrMinQuestionIntervalNegative; !errors.Is(got, want) {
+			t.Errorf("err = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestParse_HintPenaltyPercent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unset keeps the default", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := Parse(getenvFailure("HINT_PENALTY_PERCENT", ""))
+		if err != nil {
+			t.Fatalf("Parse() err = %v, want nil", err)
+		}
+		if got, want := c.HintPenaltyPercent, HintPenaltyPercentDefault; got != want {
+			t.Errorf("HintPenaltyPercent = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("percentage parses", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := Parse(getenvFailure("HINT_PENALTY_PERCENT", "25"))
+		if err != nil {
+			t.Fatalf("Parse() err = %v, want nil", err)
+		}
+		if got, want := c.HintPenaltyPercent, 25; got != want {
+			t.Errorf("HintPenaltyPercent = %d, want %d", got, want)
+		}
+	})
+
+	for _, val := range []string{"-1", "101"} {
+		t.Run("out of range "+val+" returns error", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(getenvFailure("HINT_PENALTY_PERCENT", val))
+			if got, want := err, ErrHintPenaltyPercentRange; !errors.Is(got, want) {
+				t.Errorf("err = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestParse_SessionRunnerBeat(t *testing.T) {
 	t.Parallel()
 
@@ -1060,6 +1272,69 @@ func TestParse_SessionIdleClose(t *testing.T) {
 	})
 }
 
+func TestParse_SessionMaxPlayers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid values", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name  string
+			value string
+			want  int
+		}{
+			{"unset defaults to no cap", "", 0},
+			{"explicit zero parses", "0", 0},
+			{"50 parses", "50", 50},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				getenv := func(key string) string {
+					if key == "SESSION_MAX_PLAYERS" {
+						return tt.value
+					}
+					if key == "APP_ENV" {
+						return "development"
+					}
+
+					return ""
+				}
+
+				c, err := Parse(getenv)
+				if err != nil {
+					t.Fatalf("Parse() err = %v, want nil", err)
+				}
+				if got, want := c.SessionMaxPlayers, tt.want; got != want {
+					t.Errorf("SessionMaxPlayers = %v, want %v", got, want)
+				}
+			})
+		}
+	})
+
+	t.Run("unparseable value returns error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Parse(getenvFailure("SESSION_MAX_PLAYERS", "lots"))
+		if err == nil {
+			t.Fatal("Parse() with invalid SESSION_MAX_PLAYERS: err = nil, want non-nil")
+		}
+		if got, want := err.Error(), "invalid SESSION_MAX_PLAYERS"; !strings.Contains(got, want) {
+			t.Errorf("err.Error() = %q, should contain %q", got, want)
+		}
+	})
+
+	t.Run("negative value returns error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Parse(getenvFailure("SESSION_MAX_PLAYERS", "-1"))
+		if got, want := err, ErrSessionMaxPlayersNegative; !errors.Is(got, want) {
+			t.Errorf("err = %v, want %v", got, want)
+		}
+	})
+}
+
 func TestParse_LoginCooldown(t *testing.T) {
 	t.Parallel()
 
@@ -1395,6 +1670,103 @@ func TestParse_MediaImportBudgetWindow(t *testing.T) {
 	})
 }
 
+func TestParse_MediaStorage(t *testing.T) {
+	t.Parallel()
+
+	s3Env := func(overrides map[string]string) func(string) string {
+		envs := map[string]string{
+			"APP_ENV":                    "development",
+			"MEDIA_STORAGE":              "s3",
+			"MEDIA_S3_ENDPOINT":          "http://minio:9000",
+			"MEDIA_S3_BUCKET":            "topbanana-media",
+			"MEDIA_S3_ACCESS_KEY_ID":     "key-id",
+			"MEDIA_S3_SECRET_ACCESS_KEY": "secret",
+		}
+		for k, v := range overrides {
+			envs[k] = v
+		}
+
+		return func(key string) string { return envs[key] }
+	}
+
+	t.Run("unset defaults to local", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := Parse(func(key string) string {
+			if key == "APP_ENV" {
+				return "development"
+			}
+
+			return ""
+		})
+		if err != nil {
+			t.Fatalf("Parse() err = %v, want nil", err)
+		}
+		if got, want := c.MediaStorage, MediaStorageLocal; got != want {
+			t.Errorf("MediaStorage = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("full s3 block wires through", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := Parse(s3Env(map[string]string{
+			"MEDIA_S3_PATH_STYLE": "true",
+			"MEDIA_S3_PUBLIC_URL": "https://cdn.example.test/media/",
+		}))
+		if err != nil {
+			t.Fatalf("Parse() err = %v, want nil", err)
+		}
+		if got, want := c.MediaStorage, MediaStorageS3; got != want {
+			t.Errorf("MediaStorage = %q, want %q", got, want)
+		}
+		if got, want := c.MediaS3Endpoint.String(), "http://minio:9000"; got != want {
+			t.Errorf("MediaS3Endpoint = %q, want %q", got, want)
+		}
+		if got, want := c.MediaS3Region, MediaS3RegionDefault; got != want {
+			t.Errorf("MediaS3Region = %q, want %q", got, want)
+		}
+		if got, want := c.MediaS3PathStyle, true; got != want {
+			t.Errorf("MediaS3PathStyle = %v, want %v", got, want)
+		}
+		if got, want := c.MediaS3PublicURL, "https://cdn.example.test/media"; got != want {
+			t.Errorf("MediaS3PublicURL = %q, want %q", got, want)
+		}
+	})
+
+	tests := map[string]struct {
+		getenv func(string) string
+		want   error
+	}{
+		"unknown backend": {
+			getenv: getenvFailure("MEDIA_STORAGE", "gcs"),
+			want:   ErrMediaStorageInvalid,
+		},
+		"s3 without credentials": {
+			getenv: s3Env(map[string]string{"MEDIA_S3_SECRET_ACCESS_KEY": ""}),
+			want:   ErrMediaS3ConfigIncomplete,
+		},
+		"s3 endpoint without scheme": {
+			getenv: s3Env(map[string]string{"MEDIA_S3_ENDPOINT": "minio:9000"}),
+			want:   ErrMediaS3URLInvalid,
+		},
+		"s3 public url not http": {
+			getenv: s3Env(map[string]string{"MEDIA_S3_PUBLIC_URL": "ftp://cdn.example.test"}),
+			want:   ErrMediaS3URLInvalid,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tc.getenv)
+			if got, want := err, tc.want; !errors.Is(got, want) {
+				t.Errorf("err = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestParse_AdminEmails(t *testing.T) {
 	t.Parallel()
 
@@ -1554,14 +1926,44 @@ func TestParseDatabase(t *testing.T) {
 		}
 	})
 
-	t.Run("pool env overrides are honored", func(t *testing.T) {
+	t.Run("driver follows the DB_URI scheme", func(t *testing.T) {
 		t.Parallel()
 
-		envs := map[string]string{
-			"DB_MAX_OPEN_CONNS":    "100",
-			"DB_MAX_IDLE_CONNS":    "200",
-			"DB_CONN_MAX_LIFETIME": "10m",
-		}
+		tests := []struct {
+			uri, want string
+		}{
+			{"postgres://tb:secret@db:5432/topbanana?sslmode=disable", DBDriverPostgres},
+			{"postgresql://db/topbanana", DBDriverPostgres},
+			{"POSTGRES://db/topbanana", DBDriverPostgres},
+			{"file:override.sqlite?_pragma=foreign_keys(1)", DBDriverDefault},
+			{":memory:", DBDriverDefault},
+		}
+		for _, tt := range tests {
+			getenv := func(k string) string {
+				if k == "DB_URI" {
+					return tt.uri
+				}
+
+				return ""
+			}
+			dbc, err := ParseDatabase(getenv)
+			if err != nil {
+				t.Fatalf("ParseDatabase(%q) err = %v, want nil", tt.uri, err)
+			}
+			if got := dbc.Driver; got != tt.want {
+				t.Errorf("Driver for %q = %q, want %q", tt.uri, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("pool env overrides are honored", func(t *testing.T) {
+		t.Parallel()
+
+		envs := map[string]string{
+			"DB_MAX_OPEN_CONNS":    "100",
+			"DB_MAX_IDLE_CONNS":    "200",
+			"DB_CONN_MAX_LIFETIME": "10m",
+		}
 		getenv := func(key string) string { return envs[key] }
 		dbc, err := ParseDatabase(getenv)
 		if err != nil {
@@ -1995,6 +2397,48 @@ func TestParse_TrustedProxyCIDRs(t *testing.T) {
 		}
 	})
 
+	t.Run("TRUSTED_PROXIES wins over the older name", func(t *testing.T) {
+		t.Parallel()
+
+		getenv := func(key string) string {
+			envs := map[string]string{
+				"APP_ENV":           "development",
+				"TRUSTED_PROXIES":   "10.0.0.0/8,127.0.0.1/32",
+				"TRUSTED_PROXY_IPS": "127.0.0.1/32",
+				"SESSION_KEY":       "test-session-key-test-session-key",
+			}
+
+			return envs[key]
+		}
+		c, err := Parse(getenv)
+		if err != nil {
+			t.Fatalf("Parse() err = %v, want nil", err)
+		}
+		if got, want := len(c.TrustedProxyCIDRs), 2; got != want {
+			t.Errorf("len(TrustedProxyCIDRs) = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("invalid TRUSTED_PROXIES names the variable", func(t *testing.T) {
+		t.Parallel()
+
+		getenv := func(key string) string {
+			envs := map[string]string{
+				"APP_ENV":         "development",
+				"TRUSTED_PROXIES": "not-a-cidr",
+			}
+
+			return envs[key]
+		}
+		_, err := Parse(getenv)
+		if err == nil {
+			t.Fatal("Parse() err = nil, want non-nil")
+		}
+		if got, want := err.Error(), "invalid TRUSTED_PROXIES"; !strings.Contains(got, want) {
+			t.Errorf("err.Error() = %q, should contain %q", got, want)
+		}
+	})
+
 	t.Run("invalid CIDR returns wrapped error", func(t *testing.T) {
 		t.Parallel()
 
@@ -2048,6 +2492,445 @@ func TestConfig_SecureCookies(t *testing.T) {
 	}
 }
 
+// TestConfig_SecureCookiesOverride pins SECURE_COOKIES: set either way it
+// beats the per-environment default, and an unparseable value fails boot.
+func TestConfig_SecureCookiesOverride(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		env   string
+		value string
+		want  bool
+	}{
+		{name: "development forced secure", env: "development", value: "true", want: true},
+		{name: "production forced plain", env: "production", value: "false", want: false},
+		{name: "unset keeps the env default", env: "development", value: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			envs := map[string]string{
+				"APP_ENV":        tt.env,
+				"SESSION_KEY":    "test-session-key-test-session-key",
+				"DB_URI":         "file:test.sqlite",
+				"SECURE_COOKIES": tt.value,
+			}
+			c, err := Parse(func(key string) string { return envs[key] })
+			if err != nil {
+				t.Fatalf("Parse() err = %v, want nil", err)
+			}
+			if got, want := c.SecureCookies(), tt.want; got != want {
+				t.Errorf("SecureCookies() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+
+		envs := map[string]string{"APP_ENV": "development", "SECURE_COOKIES": "sometimes"}
+		_, err := Parse(func(key string) string { return envs[key] })
+		if err == nil || !strings.Contains(err.Error(), "invalid SECURE_COOKIES") {
+			t.Errorf("Parse() err = %v, want an invalid SECURE_COOKIES error", err)
+		}
+	})
+}
+
+func TestConfig_StrictTransportSecurity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		envs map[string]string
+		want string
+	}{
+		{
+			name: "default is one year with subdomains",
+			envs: map[string]string{"APP_ENV": "staging"},
+			want: "max-age=31536000; includeSubDomains",
+		},
+		{
+			name: "development sends none",
+			envs: map[string]string{"APP_ENV": "development"},
+			want: "",
+		},
+		{
+			name: "tuned max-age without subdomains",
+			envs: map[string]string{"APP_ENV": "staging", "HSTS_MAX_AGE": "24h", "HSTS_INCLUDE_SUBDOMAINS": "false"},
+			want: "max-age=86400",
+		},
+		{
+			name: "disabled",
+			envs: map[string]string{"APP_ENV": "staging", "HSTS_ENABLED": "false"},
+			want: "",
+		},
+		{
+			name: "follows SECURE_COOKIES on development",
+			envs: map[string]string{"APP_ENV": "development", "SECURE_COOKIES": "true"},
+			want: "max-age=31536000; includeSubDomains",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.envs["SESSION_KEY"] = "test-session-key-test-session-key"
+			c, err := Parse(func(key string) string { return tt.envs[key] })
+			if err != nil {
+				t.Fatalf("Parse() err = %v, want nil", err)
+			}
+			if got, want := c.StrictTransportSecurity(), tt.want; got != want {
+				t.Errorf("StrictTransportSecurity() = %q, want %q", got, want)
+			}
+		})
+	}
+
+	t.Run("negative max-age", func(t *testing.T) {
+		t.Parallel()
+
+		envs := map[string]string{"APP_ENV": "development", "HSTS_MAX_AGE": "-1h"}
+		if _, err := Parse(func(key string) string { return envs[key] }); !errors.Is(err, ErrHSTSMaxAgeNegative) {
+			t.Errorf("Parse() err = %v, want %v", err, ErrHSTSMaxAgeNegative)
+		}
+	})
+}
+
+func TestConfig_HTTPRedirectPort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		envs    map[string]string
+		wantErr error
+	}{
+		{
+			name: "https base URL",
+			envs: map[string]string{"HTTP_REDIRECT_PORT": "8081", "BASE_URL": "https://quiz.example.com"},
+		},
+		{
+			name:    "missing base URL",
+			envs:    map[string]string{"HTTP_REDIRECT_PORT": "8081"},
+			wantErr: ErrHTTPRedirectNeedsHTTPSBaseURL,
+		},
+		{
+			name:    "plain http base URL",
+			envs:    map[string]string{"HTTP_REDIRECT_PORT": "8081", "BASE_URL": "http://quiz.example.com"},
+			wantErr: ErrHTTPRedirectNeedsHTTPSBaseURL,
+		},
+		{
+			name:    "same port as the server",
+			envs:    map[string]string{"HTTP_REDIRECT_PORT": "8080", "BASE_URL": "https://quiz.example.com"},
+			wantErr: ErrHTTPRedirectPortClash,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.envs["APP_ENV"] = "development"
+			c, err := Parse(func(key string) string { return tt.envs[key] })
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if got, want := c.HTTPRedirectPort, "8081"; got != want {
+					t.Errorf("HTTPRedirectPort = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_AdminListener(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		envs     map[string]string
+		wantPort string
+		wantHost string
+		wantErr  error
+	}{
+		{name: "unset keeps admin on the public listener", envs: map[string]string{}},
+		{
+			name:     "port alone binds loopback",
+			envs:     map[string]string{"ADMIN_PORT": "9090"},
+			wantPort: "9090",
+			wantHost: AdminHostDefault,
+		},
+		{
+			name:     "explicit host",
+			envs:     map[string]string{"ADMIN_PORT": "9090", "ADMIN_HOST": "10.8.0.1"},
+			wantPort: "9090",
+			wantHost: "10.8.0.1",
+		},
+		{
+			name:    "host without port",
+			envs:    map[string]string{"ADMIN_HOST": "10.8.0.1"},
+			wantErr: ErrAdminHostWithoutPort,
+		},
+		{
+			name:    "same port as the server",
+			envs:    map[string]string{"ADMIN_PORT": "8080"},
+			wantErr: ErrAdminPortClash,
+		},
+		{
+			name: "same port as the redirect listener",
+			envs: map[string]string{
+				"ADMIN_PORT": "8081", "HTTP_REDIRECT_PORT": "8081", "BASE_URL": "https://quiz.example.com",
+			},
+			wantErr: ErrAdminPortClash,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.envs["APP_ENV"] = "development"
+			c, err := Parse(func(key string) string { return tt.envs[key] })
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if c.AdminPort != tt.wantPort || c.AdminHost != tt.wantHost {
+				t.Errorf("AdminPort, AdminHost = %q, %q, want %q, %q", c.AdminPort, c.AdminHost, tt.wantPort, tt.wantHost)
+			}
+		})
+	}
+}
+
+func TestConfig_AnalyticsSink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		sink    string
+		wantErr error
+	}{
+		{sink: ""},
+		{sink: "stdout"},
+		{sink: "file:/var/log/topbanana/analytics.jsonl"},
+		{sink: "https://collector.example.com/events"},
+		{sink: "file:", wantErr: ErrAnalyticsSinkInvalid},
+		{sink: "https://", wantErr: ErrAnalyticsSinkInvalid},
+		{sink: "stderr", wantErr: ErrAnalyticsSinkInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sink, func(t *testing.T) {
+			t.Parallel()
+
+			envs := map[string]string{"APP_ENV": "development", "ANALYTICS_SINK": tt.sink}
+			c, err := Parse(func(key string) string { return envs[key] })
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && c.AnalyticsSink != tt.sink {
+				t.Errorf("AnalyticsSink = %q, want %q", c.AnalyticsSink, tt.sink)
+			}
+		})
+	}
+}
+
+func TestConfig_OTel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		service     string
+		wantService string
+		wantErr     error
+	}{
+		{name: "unset", wantService: OTelServiceNameDefault},
+		{name: "collector", endpoint: "http://otel-collector:4318", wantService: OTelServiceNameDefault},
+		{name: "service name", endpoint: "https://otlp.example.com", service: "quiz-eu", wantService: "quiz-eu"},
+		{name: "no scheme", endpoint: "otel-collector:4318", wantErr: ErrOTelEndpointInvalid},
+		{name: "grpc scheme", endpoint: "grpc://otel-collector:4317", wantErr: ErrOTelEndpointInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			envs := map[string]string{
+				"APP_ENV":                     "development",
+				"OTEL_EXPORTER_OTLP_ENDPOINT": tt.endpoint,
+				"OTEL_SERVICE_NAME":           tt.service,
+			}
+			c, err := Parse(func(key string) string { return envs[key] })
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if c.OTelEndpoint != tt.endpoint {
+				t.Errorf("OTelEndpoint = %q, want %q", c.OTelEndpoint, tt.endpoint)
+			}
+			if c.OTelServiceName != tt.wantService {
+				t.Errorf("OTelServiceName = %q, want %q", c.OTelServiceName, tt.wantService)
+			}
+		})
+	}
+}
+
+func TestConfig_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		timeout        string
+		drainDelay     string
+		wantTimeout    time.Duration
+		wantDrainDelay time.Duration
+		wantErr        error
+	}{
+		{name: "defaults", wantTimeout: ShutdownTimeoutDefault},
+		{name: "set", timeout: "30s", drainDelay: "5s", wantTimeout: 30 * time.Second, wantDrainDelay: 5 * time.Second},
+		{name: "zero timeout", timeout: "0s", wantErr: ErrShutdownTimeoutInvalid},
+		{name: "negative timeout", timeout: "-1s", wantErr: ErrShutdownTimeoutInvalid},
+		{name: "negative drain delay", drainDelay: "-1s", wantErr: ErrShutdownDrainDelayNegative},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			envs := map[string]string{
+				"APP_ENV":              "development",
+				"SHUTDOWN_TIMEOUT":     tt.timeout,
+				"SHUTDOWN_DRAIN_DELAY": tt.drainDelay,
+			}
+			c, err := Parse(func(key string) string { return envs[key] })
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if c.ShutdownTimeout != tt.wantTimeout {
+				t.Errorf("ShutdownTimeout = %v, want %v", c.ShutdownTimeout, tt.wantTimeout)
+			}
+			if c.ShutdownDrainDelay != tt.wantDrainDelay {
+				t.Errorf("ShutdownDrainDelay = %v, want %v", c.ShutdownDrainDelay, tt.wantDrainDelay)
+			}
+		})
+	}
+}
+
+func TestConfig_LogLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    slog.Level
+		wantErr error
+	}{
+		{name: "default", want: LogLevelDefault},
+		{name: "info", value: "info", want: slog.LevelInfo},
+		{name: "case-insensitive", value: "WARN", want: slog.LevelWarn},
+		{name: "error", value: "error", want: slog.LevelError},
+		{name: "unknown", value: "verbose", wantErr: ErrLogLevelInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			envs := map[string]string{"APP_ENV": "development", "LOG_LEVEL": tt.value}
+			c, err := Parse(func(key string) string { return envs[key] })
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if c.LogLevel != tt.want {
+				t.Errorf("LogLevel = %v, want %v", c.LogLevel, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_DBMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		window  string
+		want    string
+		wantErr error
+	}{
+		{window: ""},
+		{window: "03:00-05:00", want: "03:00-05:00"},
+		{window: "23:30 - 01:15", want: "23:30-01:15"},
+		{window: "03:00", wantErr: ErrDBMaintenanceWindowInvalid},
+		{window: "03:00-03:00", wantErr: ErrDBMaintenanceWindowInvalid},
+		{window: "25:00-05:00", wantErr: ErrDBMaintenanceWindowInvalid},
+		{window: "3am-5am", wantErr: ErrDBMaintenanceWindowInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window, func(t *testing.T) {
+			t.Parallel()
+
+			envs := map[string]string{"APP_ENV": "development", "DB_MAINTENANCE_WINDOW": tt.window}
+			c, err := Parse(func(key string) string { return envs[key] })
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.want == "" {
+				if c.DBMaintenanceWindow != nil {
+					t.Errorf("DBMaintenanceWindow = %v, want nil", c.DBMaintenanceWindow)
+				}
+
+				return
+			}
+			if c.DBMaintenanceWindow == nil {
+				t.Fatalf("DBMaintenanceWindow = nil, want %s", tt.want)
+			}
+			if got := c.DBMaintenanceWindow.String(); got != tt.want {
+				t.Errorf("DBMaintenanceWindow = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuietWindow_Contains(t *testing.T) {
+	t.Parallel()
+
+	at := func(h, m int) time.Time { return time.Date(2026, 1, 1, h, m, 0, 0, time.Local) }
+	day := QuietWindow{Start: 3 * time.Hour, End: 5 * time.Hour}
+	night := QuietWindow{Start: 23 * time.Hour, End: 2 * time.Hour}
+
+	tests := []struct {
+		name   string
+		window QuietWindow
+		t      time.Time
+		want   bool
+	}{
+		{name: "start is inside", window: day, t: at(3, 0), want: true},
+		{name: "end is outside", window: day, t: at(5, 0)},
+		{name: "before", window: day, t: at(2, 59)},
+		{name: "wrapping late", window: night, t: at(23, 30), want: true},
+		{name: "wrapping early", window: night, t: at(1, 0), want: true},
+		{name: "wrapping outside", window: night, t: at(12, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.window.Contains(tt.t); got != tt.want {
+				t.Errorf("%s.Contains(%s) = %t, want %t", tt.window, tt.t.Format("15:04"), got, tt.want)
+			}
+		})
+	}
+	if got, want := night.Length(), 3*time.Hour; got != want {
+		t.Errorf("%s.Length() = %v, want %v", night, got, want)
+	}
+}
+
 func TestConfig_EnvTitleTag(t *testing.T) {
 	t.Parallel()
 
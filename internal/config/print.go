@@ -0,0 +1,156 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// redacted stands in for a secret's value in [Config.Settings]. An unset
+// secret prints empty, so the output still shows whether one is configured.
+const redacted = "<redacted>"
+
+// Setting is one resolved configuration value, keyed by the environment
+// variable that sets it.
+type Setting struct {
+	Name  string
+	Value string
+}
+
+// Settings lists the resolved configuration - defaults filled in, derived
+// values such as the effective SECURE_COOKIES applied - keyed by
+// environment variable. Secrets (SESSION_KEY, passwords, client secrets) are
+// redacted. Backs the -print-config diagnostic mode.
+func (c *Config) Settings() []Setting {
+	var maintenance string
+	if c.DBMaintenanceWindow != nil {
+		maintenance = c.DBMaintenanceWindow.String()
+	}
+	hstsMaxAge := c.HSTSMaxAge
+	if hstsMaxAge == 0 {
+		hstsMaxAge = HSTSMaxAgeDefault
+	}
+	proxies := make([]string, 0, len(c.TrustedProxyCIDRs))
+	for _, cidr := range c.TrustedProxyCIDRs {
+		proxies = append(proxies, cidr.String())
+	}
+	var s3Endpoint string
+	if c.MediaS3Endpoint != nil {
+		s3Endpoint = c.MediaS3Endpoint.String()
+	}
+
+	return []Setting{
+		{"APP_ENV", c.AppEnvironment},
+		{"HOST", c.Host},
+		{"PORT", c.Port},
+		{"LOG_LEVEL", strings.ToLower(c.LogLevel.String())},
+		{"BASE_URL", c.BaseURL},
+		{"DB_URI", c.DBURI},
+		{"DB_MAX_OPEN_CONNS", strconv.Itoa(c.DBMaxOpenConns)},
+		{"DB_MAX_IDLE_CONNS", strconv.Itoa(c.DBMaxIdleConns)},
+		{"DB_CONN_MAX_LIFETIME", c.DBConnMaxLifetime.String()},
+		{"DB_MAINTENANCE_WINDOW", maintenance},
+		{"CLIENT_DIR", c.ClientDir},
+		{"WEB_STATIC_DIR", c.WebStaticDir},
+		{"SESSION_KEY", secret(c.SessionKey)},
+		{"ADMIN_API_TOKEN", secret(c.AdminAPIToken)},
+		{"SECURE_COOKIES", strconv.FormatBool(c.SecureCookies())},
+		{"HSTS_ENABLED", strconv.FormatBool(!c.HSTSDisabled)},
+		{"HSTS_MAX_AGE", hstsMaxAge.String()},
+		{"HSTS_INCLUDE_SUBDOMAINS", strconv.FormatBool(!c.HSTSExcludeSubdomains)},
+		{"HTTP_REDIRECT_PORT", c.HTTPRedirectPort},
+		{"ADMIN_PORT", c.AdminPort},
+		{"ADMIN_HOST", c.AdminHost},
+		{"TRUSTED_PROXIES", strings.Join(proxies, ",")},
+		{"ADMIN_EMAILS", strings.Join(c.AdminEmails, ",")},
+		{"INITIAL_ADMIN_EMAIL", c.InitialAdminEmail},
+		{"INITIAL_ADMIN_PASSWORD", secret(c.InitialAdminPassword)},
+		{"REGISTRATION_ENABLED", strconv.FormatBool(c.RegistrationEnabled)},
+		{"LOGIN_APPROVAL_REQUIRED", strconv.FormatBool(c.LoginApprovalRequired)},
+		{"LOGIN_COOLDOWN", c.LoginCooldown.String()},
+		{"DEMO_MODE_ENABLED", strconv.FormatBool(c.DemoMode)},
+		{"DEMO_SEED_ARCHIVE_DIR", c.DemoSeedArchiveDir},
+		{"API_LEGACY_SHAPES", strconv.FormatBool(c.APILegacyShapes)},
+		{"SHUFFLE_SEED_HEADER_ENABLED", strconv.FormatBool(c.ShuffleSeedHeader)},
+		{"GOOGLE_CLIENT_ID", c.GoogleClientID},
+		{"GOOGLE_CLIENT_SECRET", secret(c.GoogleClientSecret)},
+		{"GOOGLE_REDIRECT_URL", c.GoogleRedirectURL},
+		{"GOOGLE_ISSUER_URL", c.GoogleIssuerURL},
+		{"SMTP_HOST", c.SMTPHost},
+		{"SMTP_PORT", portString(c.SMTPPort)},
+		{"SMTP_USERNAME", c.SMTPUsername},
+		{"SMTP_PASSWORD", secret(c.SMTPPassword)},
+		{"SMTP_FROM", c.SMTPFrom},
+		{"SMTP_TLS", strconv.FormatBool(c.SMTPTLS)},
+		{"MEDIA_STORAGE", c.MediaStorage},
+		{"MEDIA_DIR", c.MediaDir},
+		{"MEDIA_S3_ENDPOINT", s3Endpoint},
+		{"MEDIA_S3_REGION", c.MediaS3Region},
+		{"MEDIA_S3_BUCKET", c.MediaS3Bucket},
+		{"MEDIA_S3_ACCESS_KEY_ID", c.MediaS3AccessKeyID},
+		{"MEDIA_S3_SECRET_ACCESS_KEY", secret(c.MediaS3SecretAccessKey)},
+		{"MEDIA_S3_PATH_STYLE", strconv.FormatBool(c.MediaS3PathStyle)},
+		{"MEDIA_S3_PUBLIC_URL", c.MediaS3PublicURL},
+		{"MEDIA_UPLOAD_BUDGET", strconv.Itoa(c.MediaUploadBudget)},
+		{"MEDIA_UPLOAD_BUDGET_WINDOW", c.MediaUploadBudgetWindow.String()},
+		{"MEDIA_QUIZ_IMAGE_LIMIT", strconv.Itoa(c.MediaQuizImageLimit)},
+		{"MEDIA_AUDIO_MAX_BYTES", strconv.FormatInt(c.MediaAudioMaxBytes, 10)},
+		{"MEDIA_IMAGE_MAX_BYTES", strconv.FormatInt(c.MediaImageMaxBytes, 10)},
+		{"MEDIA_IMPORT_MAX_BYTES", strconv.FormatInt(c.MediaImportMaxBytes, 10)},
+		{"MEDIA_IMPORT_BUDGET", strconv.Itoa(c.MediaImportBudget)},
+		{"MEDIA_IMPORT_BUDGET_WINDOW", c.MediaImportBudgetWindow.String()},
+		{"REVEAL_DELAY", durationOrDefault(c.RevealDelay)},
+		{"MIN_QUESTION_INTERVAL", c.MinQuestionInterval.String()},
+		{"HINT_PENALTY_PERCENT", strconv.Itoa(c.HintPenaltyPercent)},
+		{"SESSION_RUNNER_BEAT", durationOrDefault(c.SessionRunnerBeat)},
+		{"SESSION_REVEAL_BEAT", durationOrDefault(c.SessionRevealBeat)},
+		{"SESSION_ROUND_INTRO_BEAT", durationOrDefault(c.SessionRoundIntroBeat)},
+		{"SESSION_START_COUNTDOWN", durationOrDefault(c.SessionStartCountdown)},
+		{"SESSION_IDLE_CLOSE", durationOrDefault(c.SessionIdleClose)},
+		{"SESSION_MAX_PLAYERS", strconv.Itoa(c.SessionMaxPlayers)},
+		{"ANALYTICS_SINK", c.AnalyticsSink},
+		{"OTEL_EXPORTER_OTLP_ENDPOINT", c.OTelEndpoint},
+		{"OTEL_SERVICE_NAME", c.OTelServiceName},
+		{"SHUTDOWN_TIMEOUT", c.ShutdownTimeout.String()},
+		{"SHUTDOWN_DRAIN_DELAY", c.ShutdownDrainDelay.String()},
+	}
+}
+
+// Print writes [Config.Settings] to w as NAME=value lines.
+func (c *Config) Print(w io.Writer) error {
+	for _, s := range c.Settings() {
+		if _, err := fmt.Fprintf(w, "%s=%s\n", s.Name, s.Value); err != nil {
+			return fmt.Errorf("writing config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func secret(v string) string {
+	if v == "" {
+		return ""
+	}
+
+	return redacted
+}
+
+func portString(port int) string {
+	if port == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(port)
+}
+
+// durationOrDefault renders a duration whose zero value means "the built-in
+// default", which lives with the consumer rather than in config.
+func durationOrDefault(d time.Duration) string {
+	if d == 0 {
+		return "default"
+	}
+
+	return d.String()
+}
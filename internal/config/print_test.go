@@ -0,0 +1,56 @@
+package config_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/config"
+)
+
+func TestConfig_Print(t *testing.T) {
+	t.Parallel()
+
+	envs := map[string]string{
+		"APP_ENV":       "production",
+		"PORT":          "9000",
+		"DB_URI":        "file:prod.sqlite",
+		"LOG_LEVEL":     "warn",
+		"SESSION_KEY":   "test-session-key-test-session-key",
+		"SMTP_HOST":     "smtp.example.com",
+		"SMTP_PORT":     "587",
+		"SMTP_FROM":     "quiz@example.com",
+		"SMTP_USERNAME": "quiz",
+		"SMTP_PASSWORD": "hunter2",
+	}
+	c, err := Parse(func(key string) string { return envs[key] })
+	if err != nil {
+		t.Fatalf("Parse() err = %v", err)
+	}
+
+	var out bytes.Buffer
+	if err = c.Print(&out); err != nil {
+		t.Fatalf("Print() err = %v", err)
+	}
+	got := out.String()
+
+	for _, want := range []string{
+		"PORT=9000\n",
+		"LOG_LEVEL=warn\n",
+		"SESSION_KEY=<redacted>\n",
+		"SMTP_PASSWORD=<redacted>\n",
+		"GOOGLE_CLIENT_SECRET=\n",
+		"SECURE_COOKIES=true\n",
+		"HSTS_MAX_AGE=" + HSTSMaxAgeDefault.String() + "\n",
+		"SHUTDOWN_TIMEOUT=" + ShutdownTimeoutDefault.String() + "\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Print() output missing %q", want)
+		}
+	}
+	for _, secret := range []string{envs["SESSION_KEY"], envs["SMTP_PASSWORD"]} {
+		if strings.Contains(got, secret) {
+			t.Errorf("Print() output leaks secret %q", secret)
+		}
+	}
+}
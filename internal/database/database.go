@@ -6,7 +6,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io/fs"
 	"net/url"
+	"path"
 	"strings"
 	"sync"
 	"time"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/starquake/topbanana/internal/db"
 	"github.com/starquake/topbanana/internal/migrations"
+	"github.com/starquake/topbanana/internal/tracing"
 )
 
 // sqliteDriverName is the registered modernc.org/sqlite driver name. Pragma
@@ -81,12 +84,25 @@ func SetupGoose() {
 // Open opens a database connection. For the sqlite driver it first validates
 // that the DSN carries the pragmas the application depends on (see
 // [validateSQLitePragmas]); an operator who overrides DB_URI without them gets a
-// clear boot failure instead of silently losing FK enforcement (#790).
+// clear boot failure instead of silently losing FK enforcement (#790). A
+// sqlite database is then switched to WAL (see [ensureWAL]). The
+// [PostgresDriverName] driver gets a pgx pool that translates the shared
+// queries (see [openPostgres]).
 func Open(
-	_ context.Context,
+	ctx context.Context,
 	driver, uri string,
 	dbMaxOpenConns, dbMaxIdleConns int,
 	dbConnMaxLifetime time.Duration,
+) (*sql.DB, error) {
+	return open(ctx, driver, uri, false, dbMaxOpenConns, dbMaxIdleConns, dbConnMaxLifetime)
+}
+
+func open(
+	ctx context.Context,
+	driver, uri string,
+	readOnly bool,
+	dbMaxOpenConns, dbMaxIdleConns int,
+	dbConnMaxLifetime time.Duration,
 ) (*sql.DB, error) {
 	if driver == sqliteDriverName {
 		if err := validateSQLitePragmas(uri); err != nil {
@@ -96,7 +112,11 @@ func Open(
 
 	var err error
 	var conn *sql.DB
-	conn, err = sql.Open(driver, uri)
+	if driver == PostgresDriverName {
+		conn, err = openPostgres(uri, readOnly)
+	} else {
+		conn, err = sql.Open(driver, uri)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error opening database: %w", err)
 	}
@@ -105,9 +125,58 @@ func Open(
 	conn.SetMaxIdleConns(dbMaxIdleConns)
 	conn.SetConnMaxLifetime(dbConnMaxLifetime)
 
+	// The reader cannot change the journal mode (query_only), and has no
+	// need to: the writer's Open has already set it in the file.
+	if driver == sqliteDriverName && !readOnly {
+		if err = ensureWAL(ctx, conn, uri); err != nil {
+			_ = conn.Close()
+
+			return nil, err
+		}
+	}
+
 	return conn, nil
 }
 
+// OpenReadOnly opens a second pool for reporting reads (leaderboards, stats,
+// exports) so they stop queueing behind gameplay writes on the primary pool.
+// For the sqlite driver the DSN is rewritten by [readOnlySQLiteDSN]; with WAL
+// the reader sees every committed write without blocking the writer. The DSN
+// must name a file: an in-memory database would open a second, empty one.
+// Postgres readers open with default_transaction_read_only instead.
+func OpenReadOnly(
+	ctx context.Context,
+	driver, uri string,
+	dbMaxOpenConns, dbMaxIdleConns int,
+	dbConnMaxLifetime time.Duration,
+) (*sql.DB, error) {
+	if driver == sqliteDriverName {
+		roURI, err := readOnlySQLiteDSN(uri)
+		if err != nil {
+			return nil, err
+		}
+		uri = roURI
+	}
+
+	return open(ctx, driver, uri, true, dbMaxOpenConns, dbMaxIdleConns, dbConnMaxLifetime)
+}
+
+// readOnlySQLiteDSN derives the reader DSN from the primary one. It adds
+// query_only so a write routed here by mistake fails loudly, and drops
+// _txlock: an immediate transaction takes the write lock, which is exactly
+// the contention the reader exists to avoid.
+func readOnlySQLiteDSN(uri string) (string, error) {
+	base, rawQuery, _ := strings.Cut(uri, "?")
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("parsing DB_URI query string: %w", err)
+	}
+	values.Del("_txlock")
+	values.Add("_pragma", "query_only(1)")
+
+	return base + "?" + values.Encode(), nil
+}
+
 // validateSQLitePragmas fails fast when a sqlite DSN omits a pragma in
 // [requiredSQLitePragmas]. The query string after the first '?' is parsed the
 // same way the driver does (url.ParseQuery, _pragma values prefix-matched
@@ -152,8 +221,22 @@ func hasPragma(pragmas []string, name string) bool {
 // Migrate runs database migrations against conn. Safe for concurrent callers:
 // goose.Up reads goose's package-level state (the migration registry, the
 // dialect, the BaseFS) which is not goroutine-safe, so we serialise. See the
-// migrateMu comment above for why this is necessary.
+// migrateMu comment above for why this is necessary. The goose globals are
+// SQLite's, so a Postgres pool migrates through its own provider instead,
+// which shares no state and needs no lock.
 func Migrate(conn *sql.DB) error {
+	if IsPostgres(conn) {
+		provider, err := NewMigrationProvider(conn)
+		if err != nil {
+			return err
+		}
+		if _, err = provider.Up(context.Background()); err != nil {
+			return fmt.Errorf("error running migrations: %w", err)
+		}
+
+		return nil
+	}
+
 	migrateMu.Lock()
 	defer migrateMu.Unlock()
 
@@ -164,6 +247,88 @@ func Migrate(conn *sql.DB) error {
 	return nil
 }
 
+// MigrateDown rolls back the most recently applied migration. It is the
+// operator's undo for a bad deploy (`server migrate down`), not something the
+// server does on its own; serialised with [Migrate] for the same reason.
+func MigrateDown(conn *sql.DB) error {
+	if IsPostgres(conn) {
+		provider, err := NewMigrationProvider(conn)
+		if err != nil {
+			return err
+		}
+		if _, err = provider.Down(context.Background()); err != nil {
+			return fmt.Errorf("error rolling back migration: %w", err)
+		}
+
+		return nil
+	}
+
+	migrateMu.Lock()
+	defer migrateMu.Unlock()
+
+	if err := goose.Down(conn, "."); err != nil {
+		return fmt.Errorf("error rolling back migration: %w", err)
+	}
+
+	return nil
+}
+
+// NewMigrationProvider returns a goose provider over the migrations that
+// match conn's dialect: the SQLite history in [migrations.FS], or the
+// postgres/ variant for a pool [IsPostgres] recognises.
+func NewMigrationProvider(conn *sql.DB) (*goose.Provider, error) {
+	dialect, fsys := goose.DialectSQLite3, fs.FS(migrations.FS)
+	if IsPostgres(conn) {
+		sub, err := fs.Sub(migrations.PostgresFS, "postgres")
+		if err != nil {
+			return nil, fmt.Errorf("error loading migrations: %w", err)
+		}
+		dialect, fsys = goose.DialectPostgres, sub
+	}
+
+	provider, err := goose.NewProvider(dialect, conn, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("error loading migrations: %w", err)
+	}
+
+	return provider, nil
+}
+
+// MigrationState is one embedded migration and whether the database has it.
+type MigrationState struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// MigrationStatus lists every embedded migration, oldest first, with whether
+// it has been applied to conn. It goes through a goose provider rather than
+// goose's globals because only the provider reports status as data instead
+// of log lines; it only reads, so it needs no [migrateMu].
+func MigrationStatus(ctx context.Context, conn *sql.DB) ([]MigrationState, error) {
+	provider, err := NewMigrationProvider(conn)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading migration status: %w", err)
+	}
+
+	out := make([]MigrationState, 0, len(statuses))
+	for _, st := range statuses {
+		out = append(out, MigrationState{
+			Version:   st.Source.Version,
+			Name:      path.Base(st.Source.Path),
+			Applied:   st.State == goose.StateApplied,
+			AppliedAt: st.AppliedAt,
+		})
+	}
+
+	return out, nil
+}
+
 // MustRowsAffected returns the number of rows affected by res, panicking if the driver returns an error.
 func MustRowsAffected(res sql.Result) int64 {
 	rows, err := res.RowsAffected()
@@ -174,14 +339,41 @@ func MustRowsAffected(res sql.Result) int64 {
 	return rows
 }
 
-// ExecTx is a helper to run queries within a transaction.
+// ExecTx is a helper to run queries within a transaction. A transaction that
+// fails with SQLITE_BUSY - busy_timeout ran out while another writer held
+// the lock - is rolled back and re-run up to busyRetries times with a
+// doubling pause, so a burst of concurrent answers does not surface as
+// "database is locked". fn must therefore be safe to run again: it sees a
+// fresh transaction each time, and nothing it wrote before the failure
+// survives the rollback.
 func ExecTx(ctx context.Context, conn *sql.DB, fn func(*db.Queries) error) error {
+	delay := busyRetryBaseDelay
+	for attempt := 0; ; attempt++ {
+		err := execTx(ctx, conn, fn)
+		if err == nil || attempt == busyRetries || !isSQLiteBusy(err) {
+			return err
+		}
+		poolMetrics.Add("busy_retries", 1)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return err
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+func execTx(ctx context.Context, conn *sql.DB, fn func(*db.Queries) error) error {
 	var err error
 	tx, err := conn.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	q := db.New(tx)
+	q := db.New(tracing.WrapDB(tx))
 	err = fn(q)
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
@@ -2,10 +2,16 @@ package database_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
 
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/db"
+	"github.com/starquake/topbanana/internal/dbtest"
 )
 
 func TestValidateSQLitePragmas(t *testing.T) {
@@ -63,3 +69,106 @@ func TestValidateSQLitePragmas(t *testing.T) {
 		}
 	})
 }
+
+func TestReadOnlySQLiteDSN(t *testing.T) {
+	t.Parallel()
+
+	got, err := database.ExportReadOnlySQLiteDSN(config.DBURIDefault)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if strings.Contains(got, "_txlock") {
+		t.Errorf("reader DSN %q should drop _txlock", got)
+	}
+	if err = database.ExportValidateSQLitePragmas(got); err != nil {
+		t.Errorf("reader DSN fails pragma validation: %v", err)
+	}
+	if want := "query_only%281%29"; !strings.Contains(got, want) {
+		t.Errorf("reader DSN %q should contain %q", got, want)
+	}
+}
+
+func TestOpenReadOnly(t *testing.T) {
+	t.Parallel()
+
+	database.SetupGoose()
+	dsn, cleanup := dbtest.SetupTestDB(t)
+	t.Cleanup(cleanup)
+
+	writer, err := database.Open(t.Context(), "sqlite", dsn, 1, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("Open err = %v", err)
+	}
+	t.Cleanup(func() { _ = writer.Close() })
+	reader, err := database.OpenReadOnly(t.Context(), "sqlite", dsn, 1, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("OpenReadOnly err = %v", err)
+	}
+	t.Cleanup(func() { _ = reader.Close() })
+
+	q := db.New(writer)
+	if _, err = q.CreateAnonymousPlayer(t.Context(), "reader-probe"); err != nil {
+		t.Fatalf("CreateAnonymousPlayer on writer err = %v", err)
+	}
+
+	// A committed write on the primary is visible to the reader.
+	if _, err = db.New(reader).GetPlayerByDisplayName(t.Context(), "reader-probe"); err != nil {
+		t.Errorf("reader lookup err = %v, want nil", err)
+	}
+	if _, err = db.New(reader).CreateAnonymousPlayer(t.Context(), "rejected"); err == nil {
+		t.Error("write through the reader succeeded, want a query_only error")
+	}
+}
+
+// TestMigrateDown_MigrationStatus pins the explicit migration controls: a
+// migrated database reports every migration applied, a rollback leaves the
+// newest one pending, and migrating again re-applies it.
+func TestMigrateDown_MigrationStatus(t *testing.T) {
+	t.Parallel()
+
+	database.SetupGoose()
+	conn := dbtest.Open(t)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	statuses, err := database.MigrationStatus(t.Context(), conn)
+	if err != nil {
+		t.Fatalf("MigrationStatus err = %v", err)
+	}
+	if len(statuses) == 0 {
+		t.Fatal("MigrationStatus returned no migrations")
+	}
+	for _, st := range statuses {
+		if !st.Applied {
+			t.Errorf("migration %d (%s) pending on a migrated database", st.Version, st.Name)
+		}
+	}
+	newest := statuses[len(statuses)-1]
+	if !strings.HasSuffix(newest.Name, ".sql") {
+		t.Errorf("Name = %q, want the migration file name", newest.Name)
+	}
+
+	if err = database.MigrateDown(conn); err != nil {
+		t.Fatalf("MigrateDown err = %v", err)
+	}
+	statuses, err = database.MigrationStatus(t.Context(), conn)
+	if err != nil {
+		t.Fatalf("MigrationStatus after down err = %v", err)
+	}
+	if got := statuses[len(statuses)-1]; got.Version != newest.Version || got.Applied {
+		t.Errorf("newest after down = %+v, want version %d pending", got, newest.Version)
+	}
+	if got := statuses[len(statuses)-2]; !got.Applied {
+		t.Errorf("second-newest after down = %+v, want applied", got)
+	}
+
+	if err = database.Migrate(conn); err != nil {
+		t.Fatalf("Migrate err = %v", err)
+	}
+	statuses, err = database.MigrationStatus(t.Context(), conn)
+	if err != nil {
+		t.Fatalf("MigrationStatus after up err = %v", err)
+	}
+	if got := statuses[len(statuses)-1]; !got.Applied {
+		t.Errorf("newest after up = %+v, want applied", got)
+	}
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+)
+
+// ErrNoDatabaseFile is returned by [FileDiskUsage] for a database with no
+// local file: Postgres, or an in-memory SQLite.
+var ErrNoDatabaseFile = errors.New("database has no local file")
+
+// DiskUsage is the space on the filesystem holding the SQLite file.
+type DiskUsage struct {
+	Path       string
+	FreeBytes  uint64
+	TotalBytes uint64
+}
+
+// FileDiskUsage reports how much room the SQLite file behind conn has left
+// to grow. The path comes from SQLite itself (PRAGMA database_list), so it is
+// the file the driver opened whatever form the DSN took. On a platform
+// without statfs it returns an error wrapping [errors.ErrUnsupported].
+func FileDiskUsage(ctx context.Context, conn *sql.DB) (DiskUsage, error) {
+	if IsPostgres(conn) {
+		return DiskUsage{}, ErrNoDatabaseFile
+	}
+	path, err := mainFile(ctx, conn)
+	if err != nil {
+		return DiskUsage{}, err
+	}
+	if path == "" {
+		return DiskUsage{}, ErrNoDatabaseFile
+	}
+	free, total, err := statFS(filepath.Dir(path))
+	if err != nil {
+		return DiskUsage{Path: path}, fmt.Errorf("error reading filesystem stats: %w", err)
+	}
+
+	return DiskUsage{Path: path, FreeBytes: free, TotalBytes: total}, nil
+}
+
+// mainFile is the path of the main schema's file, "" for an in-memory
+// database.
+func mainFile(ctx context.Context, conn *sql.DB) (string, error) {
+	rows, err := conn.QueryContext(ctx, "PRAGMA database_list")
+	if err != nil {
+		return "", fmt.Errorf("error listing databases: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var (
+			seq        int64
+			name, file string
+		)
+		if err = rows.Scan(&seq, &name, &file); err != nil {
+			return "", fmt.Errorf("error scanning database list: %w", err)
+		}
+		if name == "main" {
+			return file, nil
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return "", fmt.Errorf("error listing databases: %w", err)
+	}
+
+	return "", nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux && !darwin
+
+package database
+
+import (
+	"errors"
+	"fmt"
+)
+
+// statFS is unsupported where syscall has no Statfs.
+func statFS(dir string) (free, total uint64, err error) {
+	return 0, 0, fmt.Errorf("statfs %s: %w", dir, errors.ErrUnsupported)
+}
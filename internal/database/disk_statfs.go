@@ -0,0 +1,21 @@
+//go:build linux || darwin
+
+package database
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// statFS returns the bytes available to an unprivileged writer and the
+// total size of the filesystem holding dir.
+func statFS(dir string) (free, total uint64, err error) {
+	var st syscall.Statfs_t
+	if err = syscall.Statfs(dir, &st); err != nil {
+		return 0, 0, fmt.Errorf("statfs %s: %w", dir, err)
+	}
+	//nolint:gosec,unconvert // Bsize is signed on some platforms; a block size is never negative.
+	bsize := uint64(st.Bsize)
+
+	return uint64(st.Bavail) * bsize, uint64(st.Blocks) * bsize, nil
+}
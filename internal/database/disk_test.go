@@ -0,0 +1,38 @@
+package database_test
+
+import (
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestFileDiskUsage pins that the disk report follows the file SQLite opened
+// and that an in-memory database has none.
+func TestFileDiskUsage(t *testing.T) {
+	t.Parallel()
+
+	database.SetupGoose()
+	usage, err := database.FileDiskUsage(t.Context(), dbtest.Open(t))
+	if err != nil {
+		t.Fatalf("FileDiskUsage err = %v, want nil", err)
+	}
+	if got, want := filepath.Base(usage.Path), "test.sqlite"; got != want {
+		t.Errorf("Path = %q, want a file named %q", usage.Path, want)
+	}
+	if usage.TotalBytes == 0 || usage.FreeBytes > usage.TotalBytes {
+		t.Errorf("FreeBytes = %d, TotalBytes = %d, want free <= total and total > 0", usage.FreeBytes, usage.TotalBytes)
+	}
+
+	mem, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("open in-memory db err = %v", err)
+	}
+	t.Cleanup(func() { _ = mem.Close() })
+	if _, err = database.FileDiskUsage(t.Context(), mem); !errors.Is(err, database.ErrNoDatabaseFile) {
+		t.Errorf("FileDiskUsage(:memory:) err = %v, want %v", err, database.ErrNoDatabaseFile)
+	}
+}
@@ -4,3 +4,20 @@ package database
 // helper so the external database_test package can pin the DB_URI pragma
 // validation (#790) without exporting it from the package.
 var ExportValidateSQLitePragmas = validateSQLitePragmas
+
+// ExportReadOnlySQLiteDSN exposes readOnlySQLiteDSN for the reader-DSN tests.
+var ExportReadOnlySQLiteDSN = readOnlySQLiteDSN
+
+// ExportRebindPostgres exposes rebindPostgres for the placeholder tests.
+var ExportRebindPostgres = rebindPostgres
+
+// ExportPostgresQuery exposes postgresQuery so the tests can pin which
+// queries are swapped for a Postgres override.
+var ExportPostgresQuery = postgresQuery
+
+// ExportPostgresOverrides exposes the parsed override table.
+var ExportPostgresOverrides = postgresOverrides
+
+// ExportSnapshotScrub exposes the snapshot scrub table so a test can check it
+// covers every credential column in the schema.
+var ExportSnapshotScrub = snapshotScrub
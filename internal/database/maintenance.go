@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"expvar"
+	"fmt"
+	"time"
+)
+
+// autoVacuumIncremental is SQLite's PRAGMA auto_vacuum value for INCREMENTAL
+// mode, where free pages stay in the file until PRAGMA incremental_vacuum
+// hands them back.
+const autoVacuumIncremental = 2
+
+// maintenanceMetrics publishes the maintenance job's counters under
+// "db_maintenance" on the process's expvar registry: runs and failures, the
+// last run's duration and reclaimed bytes, and the reclaimed-bytes total.
+// expvar names are process-wide and publishing one twice panics, so the map
+// is created once here rather than per [Maintain] caller.
+//
+//nolint:gochecknoglobals // expvar's registry is process-global by design.
+var maintenanceMetrics = expvar.NewMap("db_maintenance")
+
+// MaintenanceResult reports one [Maintain] pass: how long it took, how many
+// bytes the database file shrank by, and whether it had to run the one-off
+// full VACUUM that switches the file to incremental auto-vacuum.
+type MaintenanceResult struct {
+	Duration       time.Duration
+	ReclaimedBytes int64
+	FullVacuum     bool
+}
+
+// Maintain runs the periodic SQLite upkeep on conn: it hands free pages back
+// to the filesystem and refreshes the query planner's statistics with PRAGMA
+// optimize. Free pages are reclaimed with PRAGMA incremental_vacuum once the
+// file is in incremental auto-vacuum mode; a file that is not yet (every
+// database created before this job existed) is switched over with a single
+// full VACUUM, which blocks writers for its duration and so belongs in a quiet
+// window. All statements run on one pooled connection, since auto_vacuum and
+// VACUUM must see each other. The outcome is recorded in the db_maintenance
+// expvar map whether or not it succeeds.
+func Maintain(ctx context.Context, conn *sql.DB) (MaintenanceResult, error) {
+	start := time.Now()
+	res, err := maintain(ctx, conn)
+	res.Duration = time.Since(start)
+
+	maintenanceMetrics.Add("runs", 1)
+	if err != nil {
+		maintenanceMetrics.Add("failures", 1)
+
+		return res, err
+	}
+	lastDuration := new(expvar.Int)
+	lastDuration.Set(res.Duration.Milliseconds())
+	maintenanceMetrics.Set("last_duration_ms", lastDuration)
+	lastReclaimed := new(expvar.Int)
+	lastReclaimed.Set(res.ReclaimedBytes)
+	maintenanceMetrics.Set("last_reclaimed_bytes", lastReclaimed)
+	maintenanceMetrics.Add("reclaimed_bytes_total", res.ReclaimedBytes)
+
+	return res, nil
+}
+
+func maintain(ctx context.Context, pool *sql.DB) (MaintenanceResult, error) {
+	var res MaintenanceResult
+	conn, err := pool.Conn(ctx)
+	if err != nil {
+		return res, fmt.Errorf("error acquiring maintenance connection: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	before, err := fileBytes(ctx, conn)
+	if err != nil {
+		return res, err
+	}
+
+	var mode int
+	if err = conn.QueryRowContext(ctx, "PRAGMA auto_vacuum").Scan(&mode); err != nil {
+		return res, fmt.Errorf("error reading auto_vacuum mode: %w", err)
+	}
+	if mode == autoVacuumIncremental {
+		_, err = conn.ExecContext(ctx, "PRAGMA incremental_vacuum")
+	} else {
+		res.FullVacuum = true
+		if _, err = conn.ExecContext(ctx, "PRAGMA auto_vacuum = INCREMENTAL"); err == nil {
+			_, err = conn.ExecContext(ctx, "VACUUM")
+		}
+	}
+	if err != nil {
+		return res, fmt.Errorf("error vacuuming database: %w", err)
+	}
+	if _, err = conn.ExecContext(ctx, "PRAGMA optimize"); err != nil {
+		return res, fmt.Errorf("error optimizing database: %w", err)
+	}
+
+	after, err := fileBytes(ctx, conn)
+	if err != nil {
+		return res, err
+	}
+	res.ReclaimedBytes = max(before-after, 0)
+
+	return res, nil
+}
+
+// fileBytes is the database file's size as SQLite sees it: its page count
+// times its page size, free pages included.
+func fileBytes(ctx context.Context, conn *sql.Conn) (int64, error) {
+	var pages, pageSize int64
+	if err := conn.QueryRowContext(ctx, "PRAGMA page_count").Scan(&pages); err != nil {
+		return 0, fmt.Errorf("error reading page count: %w", err)
+	}
+	if err := conn.QueryRowContext(ctx, "PRAGMA page_size").Scan(&pageSize); err != nil {
+		return 0, fmt.Errorf("error reading page size: %w", err)
+	}
+
+	return pages * pageSize, nil
+}
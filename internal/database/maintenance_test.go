@@ -0,0 +1,63 @@
+package database_test
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestMaintain pins the two passes: the first switches a file that predates
+// the job to incremental auto-vacuum with a full VACUUM, and a later pass
+// hands freed pages back with an incremental vacuum, shrinking the file and
+// recording both runs in the db_maintenance expvar map.
+func TestMaintain(t *testing.T) {
+	t.Parallel()
+
+	database.SetupGoose()
+	conn := dbtest.Open(t)
+	ctx := t.Context()
+
+	first, err := database.Maintain(ctx, conn)
+	if err != nil {
+		t.Fatalf("first Maintain err = %v, want nil", err)
+	}
+	if !first.FullVacuum {
+		t.Error("first Maintain FullVacuum = false, want true on a file not yet in incremental mode")
+	}
+	var mode int
+	if err = conn.QueryRowContext(ctx, "PRAGMA auto_vacuum").Scan(&mode); err != nil {
+		t.Fatalf("read auto_vacuum err = %v", err)
+	}
+	if got, want := mode, 2; got != want {
+		t.Errorf("auto_vacuum after first pass = %d, want %d (incremental)", got, want)
+	}
+
+	// Fill then drop a table so the file carries free pages to reclaim.
+	if _, err = conn.ExecContext(ctx, `CREATE TABLE scratch (blob BLOB);
+		WITH RECURSIVE n(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 200)
+		INSERT INTO scratch SELECT randomblob(4096) FROM n;
+		DROP TABLE scratch;`); err != nil {
+		t.Fatalf("seed free pages err = %v", err)
+	}
+
+	second, err := database.Maintain(ctx, conn)
+	if err != nil {
+		t.Fatalf("second Maintain err = %v, want nil", err)
+	}
+	if second.FullVacuum {
+		t.Error("second Maintain FullVacuum = true, want an incremental vacuum")
+	}
+	if second.ReclaimedBytes <= 0 {
+		t.Errorf("second Maintain ReclaimedBytes = %d, want > 0", second.ReclaimedBytes)
+	}
+
+	metrics, ok := expvar.Get("db_maintenance").(*expvar.Map)
+	if !ok {
+		t.Fatal("db_maintenance expvar map not published")
+	}
+	if runs, _ := metrics.Get("runs").(*expvar.Int); runs == nil || runs.Value() < 2 {
+		t.Errorf("db_maintenance runs = %v, want at least 2", metrics.Get("runs"))
+	}
+}
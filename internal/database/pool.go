@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"expvar"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// sqliteBusy is SQLite's primary SQLITE_BUSY result code. Extended codes
+	// such as SQLITE_BUSY_SNAPSHOT carry it in their low byte.
+	sqliteBusy = 5
+	// sqlitePrimaryCodeMask selects the primary result code from an extended one.
+	sqlitePrimaryCodeMask = 0xff
+
+	// busyRetries is how many times [ExecTx] re-runs a transaction that
+	// failed with SQLITE_BUSY. busy_timeout has already waited inside the
+	// driver by then, so a couple of extra attempts cover a burst of writers
+	// without hiding a lock that is genuinely stuck.
+	busyRetries = 3
+	// busyRetryBaseDelay is the pause before the first retry; each further
+	// retry doubles it.
+	busyRetryBaseDelay = 20 * time.Millisecond
+)
+
+// poolMetrics publishes the connection pools under "db_pool" on the
+// process's expvar registry, next to db_maintenance: one entry per pool
+// registered with [PublishPoolStats] holding its live [sql.DBStats], plus
+// "busy_retries", the count of transactions [ExecTx] re-ran after
+// SQLITE_BUSY.
+//
+//nolint:gochecknoglobals // expvar's registry is process-global by design.
+var poolMetrics = expvar.NewMap("db_pool")
+
+// PublishPoolStats exposes conn's [sql.DBStats] under name in the db_pool
+// expvar map, read fresh on every scrape of /admin/metrics. Publishing the
+// same name again replaces the earlier pool, so a process that reopens its
+// database (or a test suite that boots several servers) does not panic.
+func PublishPoolStats(name string, conn *sql.DB) {
+	poolMetrics.Set(name, expvar.Func(func() any { return conn.Stats() }))
+}
+
+// isSQLiteBusy reports whether err is SQLITE_BUSY or one of its extended
+// codes. It matches on the driver error's Code method rather than the
+// modernc type so this package stays free of the driver import.
+func isSQLiteBusy(err error) bool {
+	var coded interface{ Code() int }
+
+	return errors.As(err, &coded) && coded.Code()&sqlitePrimaryCodeMask == sqliteBusy
+}
+
+// ensureWAL switches a SQLite database to write-ahead logging unless the DSN
+// picks a journal mode itself. WAL lets readers run alongside the single
+// writer, which is most of what keeps concurrent play clear of "database is
+// locked". Unlike busy_timeout, the journal mode is stored in the database
+// file, so setting it once through any pooled connection covers every
+// connection opened after. An in-memory database reports "memory" and stays
+// as it is.
+func ensureWAL(ctx context.Context, conn *sql.DB, uri string) error {
+	_, rawQuery, _ := strings.Cut(uri, "?")
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return fmt.Errorf("parsing DB_URI query string: %w", err)
+	}
+	if hasPragma(values["_pragma"], "journal_mode") {
+		return nil
+	}
+
+	var mode string
+	if err = conn.QueryRowContext(ctx, "PRAGMA journal_mode = WAL").Scan(&mode); err != nil {
+		return fmt.Errorf("error enabling WAL journal mode: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/db"
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// codedErr stands in for the driver's error type, which exposes its SQLite
+// result code through Code().
+type codedErr int
+
+func (e codedErr) Error() string { return fmt.Sprintf("sqlite error %d", int(e)) }
+func (e codedErr) Code() int     { return int(e) }
+
+const (
+	sqliteBusy         codedErr = 5
+	sqliteBusySnapshot codedErr = 517
+	sqliteConstraint   codedErr = 19
+)
+
+func TestExecTx_RetriesBusy(t *testing.T) {
+	t.Parallel()
+
+	conn := dbtest.OpenUnmigrated(t)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	tests := []struct {
+		name      string
+		failures  []error
+		wantCalls int
+		wantErr   error
+	}{
+		{"succeeds after busy", []error{sqliteBusy, fmt.Errorf("wrapped: %w", sqliteBusySnapshot)}, 3, nil},
+		{"gives up after the retries", []error{sqliteBusy, sqliteBusy, sqliteBusy, sqliteBusy, sqliteBusy}, 4, sqliteBusy},
+		{"other errors are not retried", []error{sqliteConstraint}, 1, sqliteConstraint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			err := database.ExecTx(t.Context(), conn, func(*db.Queries) error {
+				calls++
+				if calls <= len(tt.failures) {
+					return tt.failures[calls-1]
+				}
+
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ExecTx err = %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("fn ran %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestExecTx_StopsRetryingOnCancel(t *testing.T) {
+	t.Parallel()
+
+	conn := dbtest.OpenUnmigrated(t)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ctx, cancel := context.WithCancel(t.Context())
+	calls := 0
+	err := database.ExecTx(ctx, conn, func(*db.Queries) error {
+		calls++
+		cancel()
+
+		return sqliteBusy
+	})
+	if !errors.Is(err, sqliteBusy) {
+		t.Errorf("ExecTx err = %v, want the busy error", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn ran %d times after cancel, want 1", calls)
+	}
+}
+
+func TestOpen_EnablesWAL(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("integration: needs a real database")
+	}
+
+	tests := []struct {
+		name, pragmas, want string
+	}{
+		{"switches to WAL by default", "", "wal"},
+		{"keeps a journal mode the DSN sets", "&_pragma=journal_mode(DELETE)", "delete"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dsn := "file:" + filepath.Join(t.TempDir(), "wal.sqlite") +
+				"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)" + tt.pragmas
+			conn, err := database.Open(t.Context(), "sqlite", dsn, 1, 1, time.Minute)
+			if err != nil {
+				t.Fatalf("Open err = %v", err)
+			}
+			t.Cleanup(func() { _ = conn.Close() })
+
+			var mode string
+			if err = conn.QueryRowContext(t.Context(), "PRAGMA journal_mode").Scan(&mode); err != nil {
+				t.Fatalf("PRAGMA journal_mode err = %v", err)
+			}
+			if mode != tt.want {
+				t.Errorf("journal_mode = %q, want %q", mode, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublishPoolStats(t *testing.T) {
+	t.Parallel()
+
+	conn := dbtest.OpenUnmigrated(t)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	database.PublishPoolStats("test-pool", conn)
+	pools, ok := expvar.Get("db_pool").(*expvar.Map)
+	if !ok {
+		t.Fatal("db_pool is not published as an expvar map")
+	}
+	got := pools.Get("test-pool")
+	if got == nil {
+		t.Fatal("db_pool has no test-pool entry")
+	}
+	if s := got.String(); !strings.Contains(s, `"MaxOpenConnections":1`) {
+		t.Errorf("test-pool = %s, want the pool's DBStats", s)
+	}
+}
@@ -0,0 +1,255 @@
+package database
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/starquake/topbanana/internal/queries/postgres"
+)
+
+// PostgresDriverName is the driver name config selects for a postgres:// or
+// postgresql:// DB_URI. It is not a registered database/sql driver: [Open]
+// builds the pool from [postgresConnector] so every query goes through
+// [postgresQuery] on its way to pgx.
+const PostgresDriverName = "postgres"
+
+// errPostgresDriverOpen is returned by postgresDriver.Open; database/sql only
+// calls it for pools opened by name, and Postgres pools never are.
+var errPostgresDriverOpen = errors.New("postgres pools are opened through database.Open")
+
+// IsPostgres reports whether conn was opened by [Open] for the postgres
+// driver. Migrations and the schema store pick their goose dialect from it.
+func IsPostgres(conn *sql.DB) bool {
+	_, ok := conn.Driver().(postgresDriver)
+
+	return ok
+}
+
+// openPostgres opens a pgx-backed pool for uri. The session time zone is
+// pinned to UTC so TIMESTAMP columns hold the same wall-clock values the
+// SQLite schema stores; readOnly makes every transaction read-only, the
+// Postgres counterpart of the SQLite reader's query_only pragma.
+func openPostgres(uri string, readOnly bool) (*sql.DB, error) {
+	cfg, err := pgx.ParseConfig(uri)
+	if err != nil {
+		return nil, fmt.Errorf("parsing postgres DB_URI: %w", err)
+	}
+	cfg.RuntimeParams["timezone"] = "UTC"
+	if readOnly {
+		cfg.RuntimeParams["default_transaction_read_only"] = "on"
+	}
+
+	return sql.OpenDB(&postgresConnector{inner: stdlib.GetConnector(*cfg)}), nil
+}
+
+// postgresConnector hands database/sql connections that rewrite the shared
+// SQLite-dialect queries for Postgres before pgx sees them.
+type postgresConnector struct {
+	inner driver.Connector
+}
+
+func (c *postgresConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.inner.Connect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to postgres: %w", err)
+	}
+
+	return &postgresConn{Conn: conn.(*stdlib.Conn)}, nil //nolint:forcetypeassert // stdlib's connector only returns *stdlib.Conn.
+}
+
+func (c *postgresConnector) Driver() driver.Driver {
+	return postgresDriver{}
+}
+
+// postgresDriver identifies Postgres pools to [IsPostgres].
+type postgresDriver struct{}
+
+func (postgresDriver) Open(string) (driver.Conn, error) {
+	return nil, errPostgresDriverOpen
+}
+
+// postgresConn is a pgx connection whose query entry points run the query
+// through [postgresQuery]. Everything else - transactions, pings, session
+// resets, argument conversion - is pgx's own.
+type postgresConn struct {
+	*stdlib.Conn
+}
+
+func (c *postgresConn) Prepare(query string) (driver.Stmt, error) {
+	return c.Conn.Prepare(postgresQuery(query)) //nolint:wrapcheck // driver errors pass through database/sql unchanged.
+}
+
+func (c *postgresConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.Conn.PrepareContext(ctx, postgresQuery(query)) //nolint:wrapcheck // driver errors pass through database/sql unchanged.
+}
+
+func (c *postgresConn) ExecContext(
+	ctx context.Context,
+	query string,
+	args []driver.NamedValue,
+) (driver.Result, error) {
+	return c.Conn.ExecContext(ctx, postgresQuery(query), args) //nolint:wrapcheck // driver errors pass through database/sql unchanged.
+}
+
+func (c *postgresConn) QueryContext(
+	ctx context.Context,
+	query string,
+	args []driver.NamedValue,
+) (driver.Rows, error) {
+	return c.Conn.QueryContext(ctx, postgresQuery(query), args) //nolint:wrapcheck // driver errors pass through database/sql unchanged.
+}
+
+// postgresQuery translates a query written for SQLite into Postgres. A sqlc
+// query with an override in internal/queries/postgres is swapped for it
+// wholesale; anything else only has its placeholders rebound by
+// [rebindPostgres].
+func postgresQuery(query string) string {
+	if name, ok := sqlcQueryName(query); ok {
+		if override, found := postgresOverrides()[name]; found {
+			return override
+		}
+	}
+
+	return rebindPostgres(query)
+}
+
+// sqlcQueryName returns X from the "-- name: X :kind" header sqlc puts on
+// the first line of every generated query.
+func sqlcQueryName(query string) (string, bool) {
+	rest, ok := strings.CutPrefix(query, "-- name: ")
+	if !ok {
+		return "", false
+	}
+	name, _, _ := strings.Cut(rest, " ")
+
+	return name, name != ""
+}
+
+// postgresOverrides parses the embedded override files once into a map from
+// query name to its Postgres text, header comment included. A malformed
+// embed is a build defect, so it panics rather than degrading to the SQLite
+// text at runtime.
+//
+//nolint:gochecknoglobals // lazily-built immutable lookup table over an embedded FS.
+var postgresOverrides = sync.OnceValue(func() map[string]string {
+	overrides, err := parseQueryOverrides(postgres.FS)
+	if err != nil {
+		panic(err)
+	}
+
+	return overrides
+})
+
+// parseQueryOverrides splits every .sql file in fsys on its "-- name:"
+// headers, the same layout sqlc reads.
+func parseQueryOverrides(fsys fs.FS) (map[string]string, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("listing query overrides: %w", err)
+	}
+
+	overrides := make(map[string]string)
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("reading query override %s: %w", file, err)
+		}
+
+		var name string
+		var body strings.Builder
+		flush := func() {
+			if name != "" {
+				overrides[name] = strings.TrimSpace(body.String())
+			}
+			body.Reset()
+		}
+		scanner := bufio.NewScanner(strings.NewReader(string(data)))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if next, ok := sqlcQueryName(line); ok {
+				flush()
+				name = next
+			}
+			body.WriteString(line)
+			body.WriteByte('\n')
+		}
+		if err = scanner.Err(); err != nil {
+			return nil, fmt.Errorf("scanning query override %s: %w", file, err)
+		}
+		flush()
+	}
+
+	return overrides, nil
+}
+
+// rebindPostgres rewrites SQLite placeholders as Postgres ones: ?N becomes
+// $N, and a bare ? takes the next number after the largest seen so far,
+// which is how SQLite numbers it too - so a sqlc query mixing the two forms
+// keeps its argument order. String literals, quoted identifiers and
+// comments are copied through untouched.
+func rebindPostgres(query string) string {
+	var b strings.Builder
+	b.Grow(len(query) + len(query)/8) //nolint:mnd // headroom for two-digit placeholders.
+
+	highest := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(query[i+1:], c)
+			if end < 0 {
+				b.WriteString(query[i:])
+
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+2])
+			i += end + 1
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				b.WriteString(query[i:])
+
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+1])
+			i += end
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				b.WriteString(query[i:])
+
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+4])
+			i += end + 3
+		case c == '?':
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			n := highest + 1
+			if j > i+1 {
+				n, _ = strconv.Atoi(query[i+1 : j])
+			}
+			highest = max(highest, n)
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			i = j - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package database_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/database"
+)
+
+func TestRebindPostgres(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name, query, want string
+	}{
+		{"bare placeholders count up", "SELECT * FROM t WHERE a = ? AND b = ?", "SELECT * FROM t WHERE a = $1 AND b = $2"},
+		{"numbered placeholders keep their number", "WHERE a = ?2 AND b = ?1 AND c = ?2", "WHERE a = $2 AND b = $1 AND c = $2"},
+		{
+			// sqlc mixes the forms when only some params are named; SQLite
+			// numbers a bare ? one past the largest seen so far.
+			"bare after numbered continues from the largest",
+			"VALUES (?, ?, CAST(?3 AS TEXT), CAST(?4 AS TEXT), ?)",
+			"VALUES ($1, $2, CAST($3 AS TEXT), CAST($4 AS TEXT), $5)",
+		},
+		{"ten and up", "?10 ?", "$10 $11"},
+		{"string literal untouched", "SELECT '?', 'it''s ?' WHERE a = ?", "SELECT '?', 'it''s ?' WHERE a = $1"},
+		{"quoted identifier untouched", `SELECT "a?" FROM t WHERE b = ?`, `SELECT "a?" FROM t WHERE b = $1`},
+		{"line comment untouched", "-- name: X :one\n-- why?\nSELECT ?", "-- name: X :one\n-- why?\nSELECT $1"},
+		{"block comment untouched", "SELECT /* ? */ ?", "SELECT /* ? */ $1"},
+		{"no placeholders", "SELECT 1", "SELECT 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := database.ExportRebindPostgres(tt.query); got != tt.want {
+				t.Errorf("rebindPostgres(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostgresQuery_Overrides(t *testing.T) {
+	t.Parallel()
+
+	overrides := database.ExportPostgresOverrides()
+	if len(overrides) == 0 {
+		t.Fatal("no Postgres query overrides parsed, want the embedded set")
+	}
+
+	t.Run("a sqlc query with an override is swapped", func(t *testing.T) {
+		t.Parallel()
+
+		got := database.ExportPostgresQuery("-- name: CreateAnswer :one\nINSERT INTO game_answers VALUES (?1)")
+		if got != overrides["CreateAnswer"] {
+			t.Errorf("postgresQuery(CreateAnswer) = %q, want the override", got)
+		}
+	})
+
+	t.Run("a query without one is only rebound", func(t *testing.T) {
+		t.Parallel()
+
+		const query = "-- name: GetQuiz :one\nSELECT id FROM quizzes WHERE id = ?"
+		if got, want := database.ExportPostgresQuery(query), "-- name: GetQuiz :one\nSELECT id FROM quizzes WHERE id = $1"; got != want {
+			t.Errorf("postgresQuery = %q, want %q", got, want)
+		}
+	})
+
+	// Each override replaces a query sqlc generates from internal/queries,
+	// so a rename or removal there must not leave the override stranded.
+	t.Run("every override names a shared query", func(t *testing.T) {
+		t.Parallel()
+
+		files, err := filepath.Glob(filepath.Join("..", "queries", "*.sql"))
+		if err != nil {
+			t.Fatalf("Glob err = %v", err)
+		}
+		var shared strings.Builder
+		for _, f := range files {
+			data, rerr := os.ReadFile(f)
+			if rerr != nil {
+				t.Fatalf("ReadFile(%s) err = %v", f, rerr)
+			}
+			shared.Write(data)
+		}
+		for name, query := range overrides {
+			header := regexp.MustCompile(`(?m)^-- name: ` + name + ` (:\w+)`)
+			m := header.FindStringSubmatch(shared.String())
+			if m == nil {
+				t.Errorf("override %s has no query of that name in internal/queries", name)
+
+				continue
+			}
+			if !strings.HasPrefix(query, "-- name: "+name+" "+m[1]) {
+				t.Errorf("override %s header = %q, want kind %s", name, strings.SplitN(query, "\n", 2)[0], m[1])
+			}
+			if strings.Contains(query, "?") {
+				t.Errorf("override %s contains a ?, want $N placeholders only", name)
+			}
+		}
+	})
+}
+
+// TestOpen_PostgresDriver pins that the postgres driver builds a pool that
+// IsPostgres recognises. Opening does not dial, so no server is needed.
+func TestOpen_PostgresDriver(t *testing.T) {
+	t.Parallel()
+
+	conn, err := database.Open(t.Context(), database.PostgresDriverName,
+		"postgres://topbanana@localhost:5432/topbanana?sslmode=disable", 1, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("Open err = %v, want nil", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	if !database.IsPostgres(conn) {
+		t.Error("IsPostgres = false for a postgres pool, want true")
+	}
+
+	if _, err = database.Open(t.Context(), database.PostgresDriverName, "postgres://:bad port", 1, 1, time.Minute); err == nil {
+		t.Error("Open err = nil for an unparsable URI, want an error")
+	}
+
+	sqliteConn, err := database.Open(t.Context(), "sqlite", ":memory:?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", 1, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("Open sqlite err = %v, want nil", err)
+	}
+	t.Cleanup(func() { _ = sqliteConn.Close() })
+	if database.IsPostgres(sqliteConn) {
+		t.Error("IsPostgres = true for a sqlite pool, want false")
+	}
+}
@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+)
+
+// ErrSnapshotUnsupported is returned by [Snapshot] for a Postgres pool, whose
+// point-in-time copies are the job of pg_dump, not of the application.
+var ErrSnapshotUnsupported = errors.New("database snapshots are only supported on SQLite")
+
+// snapshotScrub maps each table holding credentials or sign-in data to the
+// statement that scrubs it in a snapshot. A snapshot is handed to analysts to
+// query on their own machines, and none of them needs a password hash, a live
+// token, an invite link, a signed-in device (its session id, address and user
+// agent) or an identity-provider subject for that. The player rows themselves
+// stay, since every game and answer points at one. Keyed by table so a test
+// can require every table with a token, hash or secret column to be listed.
+//
+//nolint:gochecknoglobals // Fixed statement table, read-only after init.
+var snapshotScrub = map[string]string{
+	"players":               "UPDATE players SET password_hash = NULL WHERE password_hash IS NOT NULL",
+	"email_verify_tokens":   "DELETE FROM email_verify_tokens",
+	"password_reset_tokens": "DELETE FROM password_reset_tokens",
+	"invites":               "DELETE FROM invites",
+	"player_sessions":       "DELETE FROM player_sessions",
+	"player_identities":     "DELETE FROM player_identities",
+}
+
+// Snapshot writes a point-in-time copy of the SQLite database behind conn to
+// path, which must not exist yet. The copy is taken with VACUUM INTO, a
+// single read transaction that sees one consistent state of the database
+// without blocking writers, so conn can be the read-only pool. The copy is
+// then scrubbed of credentials (see snapshotScrub) and vacuumed again so the
+// scrubbed values do not linger in free pages. A copy that fails its scrub is
+// removed; callers should still give path a directory of its own, since a
+// failed VACUUM INTO can leave a partial file behind.
+func Snapshot(ctx context.Context, conn *sql.DB, path string) error {
+	if IsPostgres(conn) {
+		return ErrSnapshotUnsupported
+	}
+	if err := vacuumInto(ctx, conn, path); err != nil {
+		return err
+	}
+	if err := scrubSnapshot(ctx, path); err != nil {
+		_ = os.Remove(path)
+
+		return err
+	}
+
+	return nil
+}
+
+// vacuumInto runs VACUUM INTO on a connection of its own. SQLite refuses it
+// under the read-only pool's query_only pragma even though the source file is
+// only read, so the pragma is lifted on that one connection, which is then
+// discarded rather than handed back to the pool writable.
+func vacuumInto(ctx context.Context, pool *sql.DB, path string) error {
+	conn, err := pool.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("error acquiring snapshot connection: %w", err)
+	}
+	defer func() {
+		_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		_ = conn.Close()
+	}()
+
+	if _, err = conn.ExecContext(ctx, "PRAGMA query_only = 0"); err != nil {
+		return fmt.Errorf("error preparing snapshot connection: %w", err)
+	}
+	if _, err = conn.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("error copying database: %w", err)
+	}
+
+	return nil
+}
+
+// scrubSnapshot runs snapshotScrub against the copy at path on a connection of
+// its own, then compacts the file.
+func scrubSnapshot(ctx context.Context, path string) error {
+	snap, err := sql.Open(sqliteDriverName, path)
+	if err != nil {
+		return fmt.Errorf("error opening snapshot: %w", err)
+	}
+	defer func() { _ = snap.Close() }()
+
+	for _, table := range slices.Sorted(maps.Keys(snapshotScrub)) {
+		if _, err = snap.ExecContext(ctx, snapshotScrub[table]); err != nil {
+			return fmt.Errorf("error scrubbing snapshot: %w", err)
+		}
+	}
+	if _, err = snap.ExecContext(ctx, "VACUUM"); err != nil {
+		return fmt.Errorf("error compacting snapshot: %w", err)
+	}
+
+	return nil
+}
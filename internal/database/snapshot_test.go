@@ -0,0 +1,142 @@
+package database_test
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/db"
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestSnapshot pins that a snapshot taken through the read-only pool is a
+// full, openable copy of the database with the credentials and sign-in data
+// scrubbed.
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	database.SetupGoose()
+	dsn, cleanup := dbtest.SetupTestDB(t)
+	t.Cleanup(cleanup)
+
+	writer, err := database.Open(t.Context(), "sqlite", dsn, 1, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("Open err = %v", err)
+	}
+	t.Cleanup(func() { _ = writer.Close() })
+	reader, err := database.OpenReadOnly(t.Context(), "sqlite", dsn, 1, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("OpenReadOnly err = %v", err)
+	}
+	t.Cleanup(func() { _ = reader.Close() })
+
+	p, err := db.New(writer).CreateAnonymousPlayer(t.Context(), "snapshot-probe")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v", err)
+	}
+	if _, err = writer.ExecContext(t.Context(),
+		"UPDATE players SET email = 'probe@example.com', password_hash = 'secret' WHERE id = ?", p.ID,
+	); err != nil {
+		t.Fatalf("set password_hash err = %v", err)
+	}
+	for _, stmt := range []string{
+		`INSERT INTO invites (email, token_hash, expires_at)
+		 VALUES ('invitee@example.com', 'invite-hash', '2099-01-01 00:00:00')`,
+		`INSERT INTO player_sessions (id, player_id, user_agent, ip_address)
+		 VALUES ('live-session', ?1, 'probe-agent', '203.0.113.7')`,
+		`INSERT INTO player_identities (player_id, provider, subject) VALUES (?1, 'google', 'probe-subject')`,
+	} {
+		if _, err = writer.ExecContext(t.Context(), stmt, p.ID); err != nil {
+			t.Fatalf("seed sign-in data err = %v", err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot.db")
+	if err = database.Snapshot(t.Context(), reader, path); err != nil {
+		t.Fatalf("Snapshot err = %v, want nil", err)
+	}
+
+	snap, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("open snapshot err = %v", err)
+	}
+	t.Cleanup(func() { _ = snap.Close() })
+
+	var (
+		email string
+		hash  sql.NullString
+	)
+	if err = snap.QueryRowContext(t.Context(),
+		"SELECT email, password_hash FROM players WHERE id = ?", p.ID,
+	).Scan(&email, &hash); err != nil {
+		t.Fatalf("read player from snapshot err = %v", err)
+	}
+	if got, want := email, "probe@example.com"; got != want {
+		t.Errorf("snapshot email = %q, want %q", got, want)
+	}
+	if hash.Valid {
+		t.Errorf("snapshot password_hash = %q, want NULL", hash.String)
+	}
+
+	for _, table := range []string{"invites", "player_sessions", "player_identities"} {
+		var n int
+		if err = snap.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM "+table).Scan(&n); err != nil {
+			t.Fatalf("count %s in snapshot err = %v", table, err)
+		}
+		if n != 0 {
+			t.Errorf("snapshot %s rows = %d, want 0", table, n)
+		}
+	}
+
+	// The live database keeps its credentials.
+	if err = writer.QueryRowContext(t.Context(),
+		"SELECT password_hash FROM players WHERE id = ?", p.ID,
+	).Scan(&hash); err != nil || hash.String != "secret" {
+		t.Errorf("live password_hash = %v (err %v), want secret", hash, err)
+	}
+
+	if err = database.Snapshot(t.Context(), reader, path); err == nil {
+		t.Error("Snapshot over an existing file err = nil, want an error")
+	}
+}
+
+// TestSnapshotScrub_CoversCredentialColumns fails when a migration adds a
+// table with a token, hash or secret column that the snapshot scrub does not
+// know about, so the new column cannot reach an analyst's copy unnoticed.
+func TestSnapshotScrub_CoversCredentialColumns(t *testing.T) {
+	t.Parallel()
+
+	database.SetupGoose()
+	conn := dbtest.Open(t)
+	rows, err := conn.QueryContext(t.Context(), `
+		SELECT m.name, c.name
+		FROM sqlite_master m
+		         JOIN pragma_table_info(m.name) c
+		WHERE m.type = 'table'
+		  AND (c.name LIKE '%token%' OR c.name LIKE '%hash%' OR c.name LIKE '%secret%')
+		ORDER BY m.name, c.name`)
+	if err != nil {
+		t.Fatalf("list credential columns err = %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	found := 0
+	for rows.Next() {
+		var table, column string
+		if err = rows.Scan(&table, &column); err != nil {
+			t.Fatalf("scan err = %v", err)
+		}
+		found++
+		if _, ok := database.ExportSnapshotScrub[table]; !ok {
+			t.Errorf("%s.%s looks like a credential but %s is not scrubbed from snapshots", table, column, table)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		t.Fatalf("rows err = %v", err)
+	}
+	if found == 0 {
+		t.Fatal("found no credential columns, want at least players.password_hash")
+	}
+}
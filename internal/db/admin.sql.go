@@ -91,7 +91,7 @@ VALUES (
     ?4,
     1
 )
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 type CreatePlayerByAdminParams struct {
@@ -132,13 +132,14 @@ func (q *Queries) CreatePlayerByAdmin(ctx context.Context, arg CreatePlayerByAdm
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
 
 const getPlayerWithOnboardingState = `-- name: GetPlayerWithOnboardingState :one
 SELECT
-    p.id, p.display_name, p.email, p.password_hash, p.role, p.created_at, p.display_name_claimed, p.email_verified_at, p.session_version, p.role_changed_at, p.approved_at,
+    p.id, p.display_name, p.email, p.password_hash, p.role, p.created_at, p.display_name_claimed, p.email_verified_at, p.session_version, p.role_changed_at, p.approved_at, p.session_revocations,
     EXISTS (SELECT 1 FROM player_identities pi WHERE pi.player_id = p.id) AS has_oauth,
     CAST(COALESCE(
         (SELECT pi.provider FROM player_identities pi WHERE pi.player_id = p.id ORDER BY pi.provider LIMIT 1),
@@ -169,6 +170,7 @@ type GetPlayerWithOnboardingStateRow struct {
 	SessionVersion     int64
 	RoleChangedAt      sql.NullTime
 	ApprovedAt         sql.NullTime
+	SessionRevocations int64
 	HasOauth           bool
 	OauthProvider      string
 	OnboardingState    string
@@ -193,6 +195,7 @@ func (q *Queries) GetPlayerWithOnboardingState(ctx context.Context, id int64) (G
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 		&i.HasOauth,
 		&i.OauthProvider,
 		&i.OnboardingState,
@@ -232,6 +235,40 @@ func (q *Queries) InsertAdminAudit(ctx context.Context, arg InsertAdminAuditPara
 	return err
 }
 
+const insertAuditEvent = `-- name: InsertAuditEvent :one
+INSERT INTO audit_events (actor_player_id, entity_type, entity_id, action, diff)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, created_at
+`
+
+type InsertAuditEventParams struct {
+	ActorPlayerID sql.NullInt64
+	EntityType    string
+	EntityID      int64
+	Action        string
+	Diff          string
+}
+
+type InsertAuditEventRow struct {
+	ID        int64
+	CreatedAt time.Time
+}
+
+// Records one admin content mutation. diff is the JSON the writer encodes;
+// created_at falls through to the column default, as on admin_audit.
+func (q *Queries) InsertAuditEvent(ctx context.Context, arg InsertAuditEventParams) (InsertAuditEventRow, error) {
+	row := q.db.QueryRowContext(ctx, insertAuditEvent,
+		arg.ActorPlayerID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Action,
+		arg.Diff,
+	)
+	var i InsertAuditEventRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
 const listAdminAuditForTarget = `-- name: ListAdminAuditForTarget :many
 SELECT
     a.id,
@@ -299,9 +336,112 @@ func (q *Queries) ListAdminAuditForTarget(ctx context.Context, arg ListAdminAudi
 	return items, nil
 }
 
+const listLiveAnswersForExport = `-- name: ListLiveAnswersForExport :many
+SELECT sa.id,
+       s.join_code,
+       sa.game_seq,
+       sa.answered_at,
+       sa.player_id,
+       CAST(p.display_name AS TEXT) AS display_name,
+       q.quiz_id,
+       CAST(qz.title AS TEXT)       AS quiz_title,
+       sa.question_id,
+       CAST(q.text AS TEXT)         AS question_text,
+       sa.option_id,
+       CAST(o.text AS TEXT)         AS option_text,
+       o.is_correct,
+       sa.score,
+       CAST(COALESCE(sp.notes, '') AS TEXT) AS participant_notes
+FROM session_answers sa
+         JOIN sessions s ON s.id = sa.session_id
+         JOIN questions q ON q.id = sa.question_id
+         JOIN quizzes qz ON qz.id = q.quiz_id
+         JOIN options o ON o.id = sa.option_id
+         JOIN players p ON p.id = sa.player_id
+         LEFT JOIN session_players sp ON sp.session_id = sa.session_id AND sp.player_id = sa.player_id
+WHERE sa.id > ?1
+  AND sa.id < COALESCE((SELECT MIN(pending.id)
+                        FROM session_answers pending
+                                 JOIN sessions ps ON ps.id = pending.session_id
+                        WHERE pending.score IS NULL
+                          AND ps.current_question_id = pending.question_id
+                          AND ps.game_seq = pending.game_seq), 9223372036854775807)
+ORDER BY sa.id
+LIMIT ?2
+`
+
+type ListLiveAnswersForExportParams struct {
+	AfterID  int64
+	RowLimit int64
+}
+
+type ListLiveAnswersForExportRow struct {
+	ID               int64
+	JoinCode         string
+	GameSeq          int64
+	AnsweredAt       time.Time
+	PlayerID         int64
+	DisplayName      string
+	QuizID           int64
+	QuizTitle        string
+	QuestionID       int64
+	QuestionText     string
+	OptionID         int64
+	OptionText       string
+	IsCorrect        bool
+	Score            sql.NullInt64
+	ParticipantNotes string
+}
+
+// Live-session answers for the analytics export, oldest first, resuming after
+// the given session_answers id. A pick on a question that is still open can be
+// overwritten and is unscored, so the export stops short of the oldest such
+// pick: everything returned is settled and the id stays a safe resume cursor.
+// Unscored picks on a question the room has moved past (an abandoned game) are
+// settled too and come back with a NULL score. participant_notes carries the
+// host's notes on the picker in that session.
+func (q *Queries) ListLiveAnswersForExport(ctx context.Context, arg ListLiveAnswersForExportParams) ([]ListLiveAnswersForExportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLiveAnswersForExport, arg.AfterID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLiveAnswersForExportRow
+	for rows.Next() {
+		var i ListLiveAnswersForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.JoinCode,
+			&i.GameSeq,
+			&i.AnsweredAt,
+			&i.PlayerID,
+			&i.DisplayName,
+			&i.QuizID,
+			&i.QuizTitle,
+			&i.QuestionID,
+			&i.QuestionText,
+			&i.OptionID,
+			&i.OptionText,
+			&i.IsCorrect,
+			&i.Score,
+			&i.ParticipantNotes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPlayersByOnboardingState = `-- name: ListPlayersByOnboardingState :many
 SELECT
-    p.id, p.display_name, p.email, p.password_hash, p.role, p.created_at, p.display_name_claimed, p.email_verified_at, p.session_version, p.role_changed_at, p.approved_at,
+    p.id, p.display_name, p.email, p.password_hash, p.role, p.created_at, p.display_name_claimed, p.email_verified_at, p.session_version, p.role_changed_at, p.approved_at, p.session_revocations,
     EXISTS (SELECT 1 FROM player_identities pi WHERE pi.player_id = p.id) AS has_oauth,
     CAST(COALESCE(
         (SELECT pi.provider FROM player_identities pi WHERE pi.player_id = p.id ORDER BY pi.provider LIMIT 1),
@@ -348,6 +488,7 @@ type ListPlayersByOnboardingStateRow struct {
 	SessionVersion     int64
 	RoleChangedAt      sql.NullTime
 	ApprovedAt         sql.NullTime
+	SessionRevocations int64
 	HasOauth           bool
 	OauthProvider      string
 	OnboardingState    string
@@ -381,6 +522,7 @@ func (q *Queries) ListPlayersByOnboardingState(ctx context.Context, arg ListPlay
 			&i.SessionVersion,
 			&i.RoleChangedAt,
 			&i.ApprovedAt,
+			&i.SessionRevocations,
 			&i.HasOauth,
 			&i.OauthProvider,
 			&i.OnboardingState,
@@ -398,6 +540,67 @@ func (q *Queries) ListPlayersByOnboardingState(ctx context.Context, arg ListPlay
 	return items, nil
 }
 
+const listRecentAuditEvents = `-- name: ListRecentAuditEvents :many
+SELECT e.id,
+       e.actor_player_id,
+       CAST(COALESCE(p.display_name, '') AS TEXT) AS actor_display_name,
+       e.entity_type,
+       e.entity_id,
+       e.action,
+       e.diff,
+       e.created_at
+FROM audit_events e
+         LEFT JOIN players p ON p.id = e.actor_player_id
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT ?1
+`
+
+type ListRecentAuditEventsRow struct {
+	ID               int64
+	ActorPlayerID    sql.NullInt64
+	ActorDisplayName string
+	EntityType       string
+	EntityID         int64
+	Action           string
+	Diff             string
+	CreatedAt        time.Time
+}
+
+// The most recent content mutations, newest first, with the actor's current
+// display name (empty once the account is gone). Backs the admin audit page;
+// audit_events_created_at_idx serves the ORDER BY and LIMIT.
+func (q *Queries) ListRecentAuditEvents(ctx context.Context, rowLimit int64) ([]ListRecentAuditEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentAuditEvents, rowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecentAuditEventsRow
+	for rows.Next() {
+		var i ListRecentAuditEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorPlayerID,
+			&i.ActorDisplayName,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.Diff,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRecentFinishedGamesForPlayer = `-- name: ListRecentFinishedGamesForPlayer :many
 SELECT
     g.id        AS game_id,
@@ -462,6 +665,92 @@ func (q *Queries) ListRecentFinishedGamesForPlayer(ctx context.Context, arg List
 	return items, nil
 }
 
+const listSoloAnswersForExport = `-- name: ListSoloAnswersForExport :many
+SELECT ga.id,
+       ga.game_id,
+       ga.answered_at,
+       ga.player_id,
+       CAST(p.display_name AS TEXT) AS display_name,
+       q.quiz_id,
+       CAST(qz.title AS TEXT)       AS quiz_title,
+       gq.question_id,
+       CAST(q.text AS TEXT)         AS question_text,
+       ga.option_id,
+       CAST(o.text AS TEXT)         AS option_text,
+       o.is_correct
+FROM game_answers ga
+         JOIN games g ON g.id = ga.game_id
+         JOIN game_questions gq ON gq.id = ga.game_question_id
+         JOIN questions q ON q.id = gq.question_id
+         JOIN quizzes qz ON qz.id = q.quiz_id
+         JOIN options o ON o.id = ga.option_id
+         JOIN players p ON p.id = ga.player_id
+WHERE ga.id > ?1
+  AND g.is_preview = 0
+ORDER BY ga.id
+LIMIT ?2
+`
+
+type ListSoloAnswersForExportParams struct {
+	AfterID  int64
+	RowLimit int64
+}
+
+type ListSoloAnswersForExportRow struct {
+	ID           int64
+	GameID       string
+	AnsweredAt   time.Time
+	PlayerID     int64
+	DisplayName  string
+	QuizID       int64
+	QuizTitle    string
+	QuestionID   int64
+	QuestionText string
+	OptionID     int64
+	OptionText   string
+	IsCorrect    bool
+}
+
+// Solo answers for the analytics export, oldest first, resuming after the
+// given game_answers id. Joined with the quiz, question, option and player so
+// each row stands alone. game_answers rows are never updated once written, so
+// the id is a stable resume cursor. Preview games are left out.
+func (q *Queries) ListSoloAnswersForExport(ctx context.Context, arg ListSoloAnswersForExportParams) ([]ListSoloAnswersForExportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSoloAnswersForExport, arg.AfterID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSoloAnswersForExportRow
+	for rows.Next() {
+		var i ListSoloAnswersForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.AnsweredAt,
+			&i.PlayerID,
+			&i.DisplayName,
+			&i.QuizID,
+			&i.QuizTitle,
+			&i.QuestionID,
+			&i.QuestionText,
+			&i.OptionID,
+			&i.OptionText,
+			&i.IsCorrect,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setPlayerEmail = `-- name: SetPlayerEmail :execrows
 UPDATE players
 SET email = ?1,
@@ -0,0 +1,71 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: drafts.sql
+
+package db
+
+import (
+	"context"
+)
+
+const deleteQuestionDraft = `-- name: DeleteQuestionDraft :exec
+DELETE
+FROM question_drafts
+WHERE player_id = ?
+  AND question_id = ?
+`
+
+type DeleteQuestionDraftParams struct {
+	PlayerID   int64
+	QuestionID int64
+}
+
+// Drops the player's draft once the question is saved.
+func (q *Queries) DeleteQuestionDraft(ctx context.Context, arg DeleteQuestionDraftParams) error {
+	_, err := q.db.ExecContext(ctx, deleteQuestionDraft, arg.PlayerID, arg.QuestionID)
+	return err
+}
+
+const getQuestionDraft = `-- name: GetQuestionDraft :one
+SELECT player_id, question_id, data, updated_at
+FROM question_drafts
+WHERE player_id = ?
+  AND question_id = ?
+`
+
+type GetQuestionDraftParams struct {
+	PlayerID   int64
+	QuestionID int64
+}
+
+// The player's unsaved draft of a question, if the editor autosaved one.
+func (q *Queries) GetQuestionDraft(ctx context.Context, arg GetQuestionDraftParams) (QuestionDraft, error) {
+	row := q.db.QueryRowContext(ctx, getQuestionDraft, arg.PlayerID, arg.QuestionID)
+	var i QuestionDraft
+	err := row.Scan(
+		&i.PlayerID,
+		&i.QuestionID,
+		&i.Data,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertQuestionDraft = `-- name: UpsertQuestionDraft :exec
+INSERT INTO question_drafts (player_id, question_id, data)
+VALUES (?1, ?2, ?3)
+ON CONFLICT (player_id, question_id) DO UPDATE SET data = excluded.data, updated_at = CURRENT_TIMESTAMP
+`
+
+type UpsertQuestionDraftParams struct {
+	PlayerID   int64
+	QuestionID int64
+	Data       string
+}
+
+// Each autosave replaces the player's previous draft of the question.
+func (q *Queries) UpsertQuestionDraft(ctx context.Context, arg UpsertQuestionDraftParams) error {
+	_, err := q.db.ExecContext(ctx, upsertQuestionDraft, arg.PlayerID, arg.QuestionID, arg.Data)
+	return err
+}
@@ -10,12 +10,77 @@ import (
 	"database/sql"
 	"strings"
 	"time"
+
+	"github.com/starquake/topbanana/internal/game"
 )
 
+const countPlayerGameHistory = `-- name: CountPlayerGameHistory :one
+SELECT COUNT(*)
+FROM game_participants gp
+         JOIN games g ON g.id = gp.game_id
+WHERE gp.player_id = ?
+  AND g.is_preview = 0
+`
+
+// The total behind ListPlayerGameHistory, for the page navigation.
+func (q *Queries) CountPlayerGameHistory(ctx context.Context, playerID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPlayerGameHistory, playerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAnswer = `-- name: CreateAnswer :one
-INSERT INTO game_answers (game_id, player_id, game_question_id, option_id, answered_at)
-VALUES (?, ?, ?, ?, ?)
-RETURNING id, game_id, player_id, game_question_id, option_id, answered_at
+INSERT INTO game_answers (game_id, player_id, game_question_id, option_id, answered_at, elapsed_ms, numeric_value, paused_ms,
+                          stats_epoch, wager, hint_penalty, scoring, streak, wrong_answer_penalty,
+                          score_floor)
+VALUES (?1,
+        ?2,
+        ?3,
+        ?4,
+        ?5,
+        ?6,
+        ?7,
+        ?8,
+        (SELECT q.stats_epoch
+         FROM game_questions gq
+                  JOIN questions q ON q.id = gq.question_id
+         WHERE gq.id = ?3),
+        (SELECT CASE WHEN qz.confidence_wager THEN COALESCE(gq.wager, 1) END
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = ?3),
+        (SELECT CASE WHEN EXISTS (SELECT 1
+                                  FROM game_hints gh
+                                  WHERE gh.game_question_id = ?3
+                                    AND gh.player_id = ?2)
+                         THEN CAST(?9 AS INTEGER)
+                     ELSE 0 END),
+        (SELECT qz.scoring
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = ?3),
+        CASE WHEN CAST(?10 AS INTEGER) = 0 THEN 0
+             ELSE 1 + COALESCE((SELECT pa.streak
+                                FROM game_answers pa
+                                WHERE pa.player_id = ?2
+                                  AND pa.game_question_id = (SELECT MAX(pq.id)
+                                                             FROM game_questions pq
+                                                             WHERE pq.game_id = ?1
+                                                               AND pq.id < ?3)), 0) END,
+        (SELECT qz.wrong_answer_penalty
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = ?3),
+        (SELECT qz.score_floor
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = ?3))
+RETURNING id, game_id, player_id, game_question_id, option_id, answered_at, stats_epoch, elapsed_ms, numeric_value, wager, paused_ms, hint_penalty, scoring, streak, wrong_answer_penalty, score_floor
 `
 
 type CreateAnswerParams struct {
@@ -24,6 +89,11 @@ type CreateAnswerParams struct {
 	GameQuestionID int64
 	OptionID       int64
 	AnsweredAt     time.Time
+	ElapsedMs      sql.NullInt64
+	NumericValue   sql.NullFloat64
+	PausedMs       int64
+	HintPenalty    int64
+	Correct        int64
 }
 
 // answered_at is passed in from the handler instead of being SQLite's
@@ -31,7 +101,20 @@ type CreateAnswerParams struct {
 // and clamps it to [question.started_at, time.Now()] before this
 // INSERT runs, so an honest player on a slow link gets the network
 // latency refunded instead of being scored late, and a malicious or
-// clock-skewed client can't claim a time outside that window.
+// clock-skewed client can't claim a time outside that window. stats_epoch is
+// copied from the question so the per-question statistics only count picks
+// made since its last reset. elapsed_ms is the service's monotonic
+// window-open-to-answer measurement, NULL when it has none. numeric_value is
+// the number typed for a numeric question, NULL on a multiple-choice pick.
+// wager is copied from the question too: the stake the player locked in, or 1
+// when the quiz uses the confidence wager and they placed none; NULL on a quiz
+// without it. paused_ms is the part of the player's once-per-game pause that
+// fell before the answer, 0 unless they paused this question. hint_penalty is
+// the given penalty when the player took the question's hint, 0 otherwise.
+// scoring, wrong_answer_penalty and score_floor are copied from the quiz.
+// streak extends the player's streak on the
+// question issued just before this one when correct is 1, and is 0 otherwise;
+// an unanswered previous question starts the run over at 1.
 func (q *Queries) CreateAnswer(ctx context.Context, arg CreateAnswerParams) (GameAnswer, error) {
 	row := q.db.QueryRowContext(ctx, createAnswer,
 		arg.GameID,
@@ -39,6 +122,11 @@ func (q *Queries) CreateAnswer(ctx context.Context, arg CreateAnswerParams) (Gam
 		arg.GameQuestionID,
 		arg.OptionID,
 		arg.AnsweredAt,
+		arg.ElapsedMs,
+		arg.NumericValue,
+		arg.PausedMs,
+		arg.HintPenalty,
+		arg.Correct,
 	)
 	var i GameAnswer
 	err := row.Scan(
@@ -48,14 +136,41 @@ func (q *Queries) CreateAnswer(ctx context.Context, arg CreateAnswerParams) (Gam
 		&i.GameQuestionID,
 		&i.OptionID,
 		&i.AnsweredAt,
+		&i.StatsEpoch,
+		&i.ElapsedMs,
+		&i.NumericValue,
+		&i.Wager,
+		&i.PausedMs,
+		&i.HintPenalty,
+		&i.Scoring,
+		&i.Streak,
+		&i.WrongAnswerPenalty,
+		&i.ScoreFloor,
 	)
 	return i, err
 }
 
+const createAnswerOption = `-- name: CreateAnswerOption :exec
+INSERT INTO game_answer_options (game_answer_id, option_id)
+VALUES (?, ?)
+`
+
+type CreateAnswerOptionParams struct {
+	GameAnswerID int64
+	OptionID     int64
+}
+
+// Records one option of a multi-select answer's pick set. The answer row
+// itself carries one of the picks in option_id.
+func (q *Queries) CreateAnswerOption(ctx context.Context, arg CreateAnswerOptionParams) error {
+	_, err := q.db.ExecContext(ctx, createAnswerOption, arg.GameAnswerID, arg.OptionID)
+	return err
+}
+
 const createGame = `-- name: CreateGame :one
 INSERT INTO games (id, quiz_id, is_preview)
 VALUES (?, ?, ?)
-RETURNING id, quiz_id, created_at, started_at, is_preview
+RETURNING id, quiz_id, created_at, started_at, is_preview, status, finished_at, results_public, revealed_at
 `
 
 type CreateGameParams struct {
@@ -74,15 +189,44 @@ func (q *Queries) CreateGame(ctx context.Context, arg CreateGameParams) (Game, e
 		&i.CreatedAt,
 		&i.StartedAt,
 		&i.IsPreview,
+		&i.Status,
+		&i.FinishedAt,
+		&i.ResultsPublic,
+		&i.RevealedAt,
 	)
 	return i, err
 }
 
+const createGameEvent = `-- name: CreateGameEvent :execrows
+INSERT INTO game_events (game_id, type, payload)
+SELECT g.id, CAST(?1 AS TEXT), CAST(?2 AS TEXT)
+FROM games g
+WHERE g.id = ?3
+`
+
+type CreateGameEventParams struct {
+	Type    string
+	Payload string
+	GameID  string
+}
+
+// Appends one event to the game's log. The game id is read back from games so
+// an event that outlived its game - the game was reset while the event sat in
+// the delivery queue - inserts nothing instead of failing the batch on the
+// foreign key: zero rows affected means the game is gone.
+func (q *Queries) CreateGameEvent(ctx context.Context, arg CreateGameEventParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createGameEvent, arg.Type, arg.Payload, arg.GameID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createGameQuestion = `-- name: CreateGameQuestion :one
 INSERT INTO game_questions (game_id, question_id, started_at, expired_at)
 VALUES (?, ?, CAST(?3 AS TEXT), CAST(?4 AS TEXT))
 ON CONFLICT (game_id, question_id) DO NOTHING
-RETURNING id, game_id, question_id, started_at, expired_at
+RETURNING id, game_id, question_id, started_at, expired_at, voided_at, wager
 `
 
 type CreateGameQuestionParams struct {
@@ -119,6 +263,8 @@ func (q *Queries) CreateGameQuestion(ctx context.Context, arg CreateGameQuestion
 		&i.QuestionID,
 		&i.StartedAt,
 		&i.ExpiredAt,
+		&i.VoidedAt,
+		&i.Wager,
 	)
 	return i, err
 }
@@ -126,7 +272,7 @@ func (q *Queries) CreateGameQuestion(ctx context.Context, arg CreateGameQuestion
 const createParticipant = `-- name: CreateParticipant :one
 INSERT INTO game_participants (game_id, player_id, quiz_id)
 VALUES (?, ?, ?)
-RETURNING id, game_id, player_id, quiz_id, joined_at
+RETURNING id, game_id, player_id, quiz_id, joined_at, paused_question_id, paused_at
 `
 
 type CreateParticipantParams struct {
@@ -148,6 +294,8 @@ func (q *Queries) CreateParticipant(ctx context.Context, arg CreateParticipantPa
 		&i.PlayerID,
 		&i.QuizID,
 		&i.JoinedAt,
+		&i.PausedQuestionID,
+		&i.PausedAt,
 	)
 	return i, err
 }
@@ -284,8 +432,32 @@ func (q *Queries) DeleteGamesByIDs(ctx context.Context, ids []string) error {
 	return err
 }
 
+const finishGame = `-- name: FinishGame :execrows
+UPDATE games
+SET status      = ?1,
+    finished_at = CURRENT_TIMESTAMP
+WHERE id = ?2
+  AND finished_at IS NULL
+`
+
+type FinishGameParams struct {
+	Status game.GameStatus
+	ID     string
+}
+
+// Moves a game to a terminal status (finished or abandoned) and stamps
+// finished_at. The finished_at IS NULL guard makes the first transition win:
+// zero rows affected means the game was already over (or does not exist).
+func (q *Queries) FinishGame(ctx context.Context, arg FinishGameParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, finishGame, arg.Status, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getGame = `-- name: GetGame :one
-SELECT id, quiz_id, created_at, started_at, is_preview
+SELECT id, quiz_id, created_at, started_at, is_preview, status, finished_at, results_public, revealed_at
 FROM games
 WHERE id = ?
 `
@@ -299,12 +471,17 @@ func (q *Queries) GetGame(ctx context.Context, id string) (Game, error) {
 		&i.CreatedAt,
 		&i.StartedAt,
 		&i.IsPreview,
+		&i.Status,
+		&i.FinishedAt,
+		&i.ResultsPublic,
+		&i.RevealedAt,
 	)
 	return i, err
 }
 
 const getGameByPlayerAndQuiz = `-- name: GetGameByPlayerAndQuiz :one
-SELECT g.id, g.quiz_id, g.created_at, g.started_at, g.is_preview
+SELECT g.id, g.quiz_id, g.created_at, g.started_at, g.is_preview, g.status, g.finished_at, g.results_public,
+       g.revealed_at
 FROM games g
          JOIN game_participants gp ON gp.game_id = g.id
 WHERE gp.player_id = ?
@@ -331,12 +508,16 @@ func (q *Queries) GetGameByPlayerAndQuiz(ctx context.Context, arg GetGameByPlaye
 		&i.CreatedAt,
 		&i.StartedAt,
 		&i.IsPreview,
+		&i.Status,
+		&i.FinishedAt,
+		&i.ResultsPublic,
+		&i.RevealedAt,
 	)
 	return i, err
 }
 
 const getGameQuestionByGameAndQuestion = `-- name: GetGameQuestionByGameAndQuestion :one
-SELECT id, game_id, question_id, started_at, expired_at
+SELECT id, game_id, question_id, started_at, expired_at, voided_at, wager
 FROM game_questions
 WHERE game_id = ? AND question_id = ?
 `
@@ -358,12 +539,56 @@ func (q *Queries) GetGameQuestionByGameAndQuestion(ctx context.Context, arg GetG
 		&i.QuestionID,
 		&i.StartedAt,
 		&i.ExpiredAt,
+		&i.VoidedAt,
+		&i.Wager,
+	)
+	return i, err
+}
+
+const getNextUnaskedQuestion = `-- name: GetNextUnaskedQuestion :one
+SELECT q.id, q.quiz_id, q.round_id, q.text, q.position, q.time_limit_seconds, q.image_media_id, q.audio_media_id, q.audio_repeat, q.stats_epoch, q.kind, q.after_question_id, q.version, q.explanation, q.hint, q.external_ref
+FROM questions q
+         JOIN games g ON g.quiz_id = q.quiz_id
+WHERE g.id = ?1
+  AND NOT EXISTS (SELECT 1
+                  FROM game_questions gq
+                  WHERE gq.game_id = ?1
+                    AND gq.question_id = q.id)
+ORDER BY q.position, q.id
+LIMIT 1
+`
+
+// The lowest-position question of the game's quiz that has not been issued
+// to the game yet, so game.Service.GetNextQuestion can pick the next question
+// without loading the whole quiz. No rows means every question was asked.
+// The id makes the order total, as in ListQuestionsByQuizID, so this path
+// and the ones that walk the whole quiz can never pick different questions.
+func (q *Queries) GetNextUnaskedQuestion(ctx context.Context, gameID string) (Question, error) {
+	row := q.db.QueryRowContext(ctx, getNextUnaskedQuestion, gameID)
+	var i Question
+	err := row.Scan(
+		&i.ID,
+		&i.QuizID,
+		&i.RoundID,
+		&i.Text,
+		&i.Position,
+		&i.TimeLimitSeconds,
+		&i.ImageMediaID,
+		&i.AudioMediaID,
+		&i.AudioRepeat,
+		&i.StatsEpoch,
+		&i.Kind,
+		&i.AfterQuestionID,
+		&i.Version,
+		&i.Explanation,
+		&i.Hint,
+		&i.ExternalRef,
 	)
 	return i, err
 }
 
 const getPlayer = `-- name: GetPlayer :one
-SELECT id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+SELECT id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 FROM players
 WHERE id = ?
 `
@@ -383,12 +608,14 @@ func (q *Queries) GetPlayer(ctx context.Context, id int64) (Player, error) {
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
 
 const getRealGameByPlayerAndQuiz = `-- name: GetRealGameByPlayerAndQuiz :one
-SELECT g.id, g.quiz_id, g.created_at, g.started_at, g.is_preview
+SELECT g.id, g.quiz_id, g.created_at, g.started_at, g.is_preview, g.status, g.finished_at, g.results_public,
+       g.revealed_at
 FROM games g
          JOIN game_participants gp ON gp.game_id = g.id
 WHERE gp.player_id = ?
@@ -414,32 +641,76 @@ func (q *Queries) GetRealGameByPlayerAndQuiz(ctx context.Context, arg GetRealGam
 		&i.CreatedAt,
 		&i.StartedAt,
 		&i.IsPreview,
+		&i.Status,
+		&i.FinishedAt,
+		&i.ResultsPublic,
+		&i.RevealedAt,
 	)
 	return i, err
 }
 
 const listAnswersByGameID = `-- name: ListAnswersByGameID :many
-SELECT id, game_id, player_id, game_question_id, option_id, answered_at
-FROM game_answers
-WHERE game_id = ?
-ORDER BY game_question_id
+SELECT ga.id, ga.game_id, ga.player_id, ga.game_question_id, ga.option_id, ga.answered_at, ga.stats_epoch, ga.elapsed_ms, ga.numeric_value, ga.wager, ga.paused_ms, ga.hint_penalty, ga.scoring, ga.streak, ga.wrong_answer_penalty, ga.score_floor,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND po.is_correct) AS picked_correct,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND NOT po.is_correct) AS picked_wrong,
+       (SELECT COUNT(*)
+        FROM options co
+                 JOIN options ao ON ao.question_id = co.question_id
+        WHERE ao.id = ga.option_id
+          AND co.is_correct) AS correct_options
+FROM game_answers ga
+WHERE ga.game_id = ?
+ORDER BY ga.game_question_id
 `
 
+type ListAnswersByGameIDRow struct {
+	ID                 int64
+	GameID             string
+	PlayerID           int64
+	GameQuestionID     int64
+	OptionID           int64
+	AnsweredAt         time.Time
+	StatsEpoch         int64
+	ElapsedMs          sql.NullInt64
+	NumericValue       sql.NullFloat64
+	Wager              sql.NullInt64
+	PausedMs           int64
+	HintPenalty        int64
+	Scoring            string
+	Streak             int64
+	WrongAnswerPenalty int64
+	ScoreFloor         int64
+	PickedCorrect      int64
+	PickedWrong        int64
+	CorrectOptions     int64
+}
+
 // Returns every game_answer for a given game, ordered by
 // game_question_id so callers can partition rows per question in a
 // single pass. Replaces the N+1 pattern of calling
-// ListAnswersByGameQuestionID once per issued question (#356); the
-// UNIQUE(game_id, player_id, game_question_id) constraint serves as
-// the index for the game_id filter.
-func (q *Queries) ListAnswersByGameID(ctx context.Context, gameID string) ([]GameAnswer, error) {
+// ListAnswersByGameQuestionID once per issued question (#356);
+// game_answers_game_id_question_idx serves both the game_id filter and
+// the ORDER BY. picked_correct and picked_wrong tally a
+// multi-select answer's picks (game_answer_options) against their options,
+// and correct_options counts the question's correct options; both picked
+// counts are 0 on a single-pick answer.
+func (q *Queries) ListAnswersByGameID(ctx context.Context, gameID string) ([]ListAnswersByGameIDRow, error) {
 	rows, err := q.db.QueryContext(ctx, listAnswersByGameID, gameID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GameAnswer
+	var items []ListAnswersByGameIDRow
 	for rows.Next() {
-		var i GameAnswer
+		var i ListAnswersByGameIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.GameID,
@@ -447,6 +718,19 @@ func (q *Queries) ListAnswersByGameID(ctx context.Context, gameID string) ([]Gam
 			&i.GameQuestionID,
 			&i.OptionID,
 			&i.AnsweredAt,
+			&i.StatsEpoch,
+			&i.ElapsedMs,
+			&i.NumericValue,
+			&i.Wager,
+			&i.PausedMs,
+			&i.HintPenalty,
+			&i.Scoring,
+			&i.Streak,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.PickedCorrect,
+			&i.PickedWrong,
+			&i.CorrectOptions,
 		); err != nil {
 			return nil, err
 		}
@@ -462,7 +746,7 @@ func (q *Queries) ListAnswersByGameID(ctx context.Context, gameID string) ([]Gam
 }
 
 const listAnswersByGameQuestionID = `-- name: ListAnswersByGameQuestionID :many
-SELECT id, game_id, player_id, game_question_id, option_id, answered_at
+SELECT id, game_id, player_id, game_question_id, option_id, answered_at, stats_epoch, elapsed_ms, numeric_value, wager, paused_ms, hint_penalty, scoring, streak, wrong_answer_penalty, score_floor
 FROM game_answers
 WHERE game_question_id = ?
 `
@@ -483,6 +767,151 @@ func (q *Queries) ListAnswersByGameQuestionID(ctx context.Context, gameQuestionI
 			&i.GameQuestionID,
 			&i.OptionID,
 			&i.AnsweredAt,
+			&i.StatsEpoch,
+			&i.ElapsedMs,
+			&i.NumericValue,
+			&i.Wager,
+			&i.PausedMs,
+			&i.HintPenalty,
+			&i.Scoring,
+			&i.Streak,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAnswersForPlayReport = `-- name: ListAnswersForPlayReport :many
+SELECT g.id                 AS game_id,
+       g.quiz_id            AS quiz_id,
+       gq.question_id       AS question_id,
+       q.text               AS question_text,
+       ga.player_id         AS player_id,
+       gq.started_at        AS question_started_at,
+       gq.expired_at        AS question_expired_at,
+       ga.answered_at       AS answered_at,
+       ga.elapsed_ms        AS elapsed_ms,
+       ga.numeric_value     AS numeric_value,
+       ga.wager             AS wager,
+       ga.paused_ms         AS paused_ms,
+       ga.hint_penalty      AS hint_penalty,
+       ga.scoring           AS scoring,
+       ga.streak            AS streak,
+       ga.wrong_answer_penalty AS wrong_answer_penalty,
+       ga.score_floor       AS score_floor,
+       o.is_correct         AS is_correct,
+       o.numeric_value      AS key_value,
+       o.tolerance_below    AS tolerance_below,
+       o.tolerance_above    AS tolerance_above,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND po.is_correct) AS picked_correct,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND NOT po.is_correct) AS picked_wrong,
+       (SELECT COUNT(*)
+        FROM options co
+        WHERE co.question_id = o.question_id
+          AND co.is_correct) AS correct_options
+FROM game_answers ga
+         JOIN games g ON g.id = ga.game_id
+         JOIN game_questions gq ON gq.id = ga.game_question_id
+         JOIN questions q ON q.id = gq.question_id
+         JOIN options o ON o.id = ga.option_id
+WHERE g.is_preview = 0
+  AND gq.voided_at IS NULL
+  AND g.created_at >= CAST(?1 AS TEXT)
+  AND g.created_at < CAST(?2 AS TEXT)
+ORDER BY ga.id
+`
+
+type ListAnswersForPlayReportParams struct {
+	CreatedFrom string
+	CreatedTo   string
+}
+
+type ListAnswersForPlayReportRow struct {
+	GameID             string
+	QuizID             int64
+	QuestionID         int64
+	QuestionText       string
+	PlayerID           int64
+	QuestionStartedAt  time.Time
+	QuestionExpiredAt  time.Time
+	AnsweredAt         time.Time
+	ElapsedMs          sql.NullInt64
+	NumericValue       sql.NullFloat64
+	Wager              sql.NullInt64
+	PausedMs           int64
+	HintPenalty        int64
+	Scoring            string
+	Streak             int64
+	WrongAnswerPenalty int64
+	ScoreFloor         int64
+	IsCorrect          bool
+	KeyValue           sql.NullFloat64
+	ToleranceBelow     float64
+	ToleranceAbove     float64
+	PickedCorrect      int64
+	PickedWrong        int64
+	CorrectOptions     int64
+}
+
+// Every answer given in a real game started in [created_from, created_to),
+// with the scoring inputs ListAnswersForQuizLeaderboard reads plus the game,
+// quiz and question it belongs to, so the Go layer can work out average
+// scores, per-question correct rates and answer times with the scoring
+// formula itself. Answers to a voided question are left out; they score
+// nothing. Unlike the leaderboard, a host-paced quiz's unrevealed answers
+// count: the report is for admins, not players.
+func (q *Queries) ListAnswersForPlayReport(ctx context.Context, arg ListAnswersForPlayReportParams) ([]ListAnswersForPlayReportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAnswersForPlayReport, arg.CreatedFrom, arg.CreatedTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAnswersForPlayReportRow
+	for rows.Next() {
+		var i ListAnswersForPlayReportRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.QuizID,
+			&i.QuestionID,
+			&i.QuestionText,
+			&i.PlayerID,
+			&i.QuestionStartedAt,
+			&i.QuestionExpiredAt,
+			&i.AnsweredAt,
+			&i.ElapsedMs,
+			&i.NumericValue,
+			&i.Wager,
+			&i.PausedMs,
+			&i.HintPenalty,
+			&i.Scoring,
+			&i.Streak,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.IsCorrect,
+			&i.KeyValue,
+			&i.ToleranceBelow,
+			&i.ToleranceAbove,
+			&i.PickedCorrect,
+			&i.PickedWrong,
+			&i.CorrectOptions,
 		); err != nil {
 			return nil, err
 		}
@@ -498,12 +927,39 @@ func (q *Queries) ListAnswersByGameQuestionID(ctx context.Context, gameQuestionI
 }
 
 const listAnswersForQuizLeaderboard = `-- name: ListAnswersForQuizLeaderboard :many
-SELECT ga.player_id        AS player_id,
+SELECT ga.game_id          AS game_id,
+       ga.player_id        AS player_id,
        p.display_name           AS display_name,
        gq.started_at        AS question_started_at,
        gq.expired_at        AS question_expired_at,
        ga.answered_at       AS answered_at,
+       ga.elapsed_ms        AS elapsed_ms,
+       ga.numeric_value     AS numeric_value,
+       ga.wager             AS wager,
+       ga.paused_ms         AS paused_ms,
+       ga.hint_penalty      AS hint_penalty,
+       ga.scoring           AS scoring,
+       ga.streak            AS streak,
+       ga.wrong_answer_penalty AS wrong_answer_penalty,
+       ga.score_floor       AS score_floor,
        o.is_correct         AS is_correct,
+       o.numeric_value      AS key_value,
+       o.tolerance_below    AS tolerance_below,
+       o.tolerance_above    AS tolerance_above,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND po.is_correct) AS picked_correct,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND NOT po.is_correct) AS picked_wrong,
+       (SELECT COUNT(*)
+        FROM options co
+        WHERE co.question_id = o.question_id
+          AND co.is_correct) AS correct_options,
        CASE WHEN (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id) > 0
              AND (SELECT COUNT(*) FROM game_questions gqc WHERE gqc.game_id = g.id) >=
                  (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id)
@@ -515,16 +971,35 @@ FROM game_answers ga
          JOIN players p ON p.id = ga.player_id
 WHERE g.quiz_id = ?
   AND g.is_preview = 0
+  AND gq.voided_at IS NULL
+  AND (g.revealed_at IS NOT NULL
+    OR (SELECT qz.host_paced FROM quizzes qz WHERE qz.id = g.quiz_id) = 0)
 `
 
 type ListAnswersForQuizLeaderboardRow struct {
-	PlayerID          int64
-	DisplayName       string
-	QuestionStartedAt time.Time
-	QuestionExpiredAt time.Time
-	AnsweredAt        time.Time
-	IsCorrect         bool
-	IsCompleted       int64
+	GameID             string
+	PlayerID           int64
+	DisplayName        string
+	QuestionStartedAt  time.Time
+	QuestionExpiredAt  time.Time
+	AnsweredAt         time.Time
+	ElapsedMs          sql.NullInt64
+	NumericValue       sql.NullFloat64
+	Wager              sql.NullInt64
+	PausedMs           int64
+	HintPenalty        int64
+	Scoring            string
+	Streak             int64
+	WrongAnswerPenalty int64
+	ScoreFloor         int64
+	IsCorrect          bool
+	KeyValue           sql.NullFloat64
+	ToleranceBelow     float64
+	ToleranceAbove     float64
+	PickedCorrect      int64
+	PickedWrong        int64
+	CorrectOptions     int64
+	IsCompleted        int64
 }
 
 // Selects the per-answer scoring inputs for every game of the given
@@ -538,6 +1013,17 @@ type ListAnswersForQuizLeaderboardRow struct {
 // question has been issued (game_questions rows >= quiz questions
 // count). The Go layer collapses one row per (player, game) into a
 // single LeaderboardEntry with the per-player Completed flag.
+//
+// picked_correct, picked_wrong and correct_options tally a multi-select
+// answer as ListAnswersByGameID does, wager is the confidence stake the answer
+// is scored with, paused_ms the paused time taken off it, hint_penalty the
+// percentage its hint cost, scoring and streak the quiz's scoring mode and
+// the run of correct answers it was recorded under, and wrong_answer_penalty
+// and score_floor the negative marking it was recorded under. Answers to a
+// question voided for its game are left out; they score nothing there. So are
+// the answers of a host-paced quiz's game until its host reveals the scores.
+// game_id lets the Go layer hold each game's total at its score floor before
+// summing a player's games.
 func (q *Queries) ListAnswersForQuizLeaderboard(ctx context.Context, quizID int64) ([]ListAnswersForQuizLeaderboardRow, error) {
 	rows, err := q.db.QueryContext(ctx, listAnswersForQuizLeaderboard, quizID)
 	if err != nil {
@@ -548,12 +1034,28 @@ func (q *Queries) ListAnswersForQuizLeaderboard(ctx context.Context, quizID int6
 	for rows.Next() {
 		var i ListAnswersForQuizLeaderboardRow
 		if err := rows.Scan(
+			&i.GameID,
 			&i.PlayerID,
 			&i.DisplayName,
 			&i.QuestionStartedAt,
 			&i.QuestionExpiredAt,
 			&i.AnsweredAt,
+			&i.ElapsedMs,
+			&i.NumericValue,
+			&i.Wager,
+			&i.PausedMs,
+			&i.HintPenalty,
+			&i.Scoring,
+			&i.Streak,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
 			&i.IsCorrect,
+			&i.KeyValue,
+			&i.ToleranceBelow,
+			&i.ToleranceAbove,
+			&i.PickedCorrect,
+			&i.PickedWrong,
+			&i.CorrectOptions,
 			&i.IsCompleted,
 		); err != nil {
 			return nil, err
@@ -569,6 +1071,49 @@ func (q *Queries) ListAnswersForQuizLeaderboard(ctx context.Context, quizID int6
 	return items, nil
 }
 
+const listGameEventsByGameID = `-- name: ListGameEventsByGameID :many
+SELECT id, type, payload, recorded_at
+FROM game_events
+WHERE game_id = ?
+ORDER BY id
+`
+
+type ListGameEventsByGameIDRow struct {
+	ID         int64
+	Type       string
+	Payload    string
+	RecordedAt time.Time
+}
+
+// Lists the game's event log in the order it was recorded.
+func (q *Queries) ListGameEventsByGameID(ctx context.Context, gameID string) ([]ListGameEventsByGameIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listGameEventsByGameID, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGameEventsByGameIDRow
+	for rows.Next() {
+		var i ListGameEventsByGameIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.Payload,
+			&i.RecordedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listGameIDsForPlayerOnQuiz = `-- name: ListGameIDsForPlayerOnQuiz :many
 SELECT g.id
 FROM games g
@@ -679,7 +1224,7 @@ func (q *Queries) ListGameQuestionIDsForQuestion(ctx context.Context, questionID
 }
 
 const listGameQuestionsByGameID = `-- name: ListGameQuestionsByGameID :many
-SELECT id, game_id, question_id, started_at, expired_at
+SELECT id, game_id, question_id, started_at, expired_at, voided_at, wager
 FROM game_questions
 WHERE game_id = ?
 ORDER BY id
@@ -703,6 +1248,8 @@ func (q *Queries) ListGameQuestionsByGameID(ctx context.Context, gameID string)
 			&i.QuestionID,
 			&i.StartedAt,
 			&i.ExpiredAt,
+			&i.VoidedAt,
+			&i.Wager,
 		); err != nil {
 			return nil, err
 		}
@@ -717,8 +1264,45 @@ func (q *Queries) ListGameQuestionsByGameID(ctx context.Context, gameID string)
 	return items, nil
 }
 
+const listParticipantNamesByGameID = `-- name: ListParticipantNamesByGameID :many
+SELECT gp.player_id, p.display_name
+FROM game_participants gp
+         JOIN players p ON p.id = gp.player_id
+WHERE gp.game_id = ?
+`
+
+type ListParticipantNamesByGameIDRow struct {
+	PlayerID    int64
+	DisplayName string
+}
+
+// Lists each participant's display name for the public standings, which show
+// names only.
+func (q *Queries) ListParticipantNamesByGameID(ctx context.Context, gameID string) ([]ListParticipantNamesByGameIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listParticipantNamesByGameID, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListParticipantNamesByGameIDRow
+	for rows.Next() {
+		var i ListParticipantNamesByGameIDRow
+		if err := rows.Scan(&i.PlayerID, &i.DisplayName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listParticipantsByGameID = `-- name: ListParticipantsByGameID :many
-SELECT id, game_id, player_id, quiz_id, joined_at
+SELECT id, game_id, player_id, quiz_id, joined_at, paused_question_id, paused_at
 FROM game_participants
 WHERE game_id = ?
 `
@@ -738,6 +1322,8 @@ func (q *Queries) ListParticipantsByGameID(ctx context.Context, gameID string) (
 			&i.PlayerID,
 			&i.QuizID,
 			&i.JoinedAt,
+			&i.PausedQuestionID,
+			&i.PausedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -759,7 +1345,7 @@ SELECT gp.player_id AS player_id,
              AND (SELECT COUNT(*) FROM game_questions gqc WHERE gqc.game_id = gp.game_id) >=
                  (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id)
             THEN 1 ELSE 0 END AS is_completed,
-       CASE WHEN EXISTS (
+       CASE WHEN g.finished_at IS NOT NULL OR EXISTS (
               SELECT 1 FROM game_questions gq
               WHERE gq.game_id = gp.game_id
                 AND gq.expired_at < CAST(?1 AS TEXT)
@@ -787,7 +1373,8 @@ type ListParticipantsForQuizLeaderboardRow struct {
 
 // One row per player joined to the quiz, flagged with is_completed
 // (every quiz question issued) and is_stale (#336: latest
-// game_question unanswered and expired before stale_before).
+// game_question unanswered and expired before stale_before, or the game
+// already finished or abandoned, so it never shows as in progress).
 // Canonical entry set per #335 so a joined-but-unanswered player
 // still appears at 0.
 // Joins through `games` so the WHERE filters on games.quiz_id (NOT
@@ -826,6 +1413,87 @@ func (q *Queries) ListParticipantsForQuizLeaderboard(ctx context.Context, arg Li
 	return items, nil
 }
 
+const listPlayerGameHistory = `-- name: ListPlayerGameHistory :many
+SELECT g.id               AS game_id,
+       g.quiz_id          AS quiz_id,
+       qz.title           AS quiz_title,
+       qz.slug            AS quiz_slug,
+       g.created_at       AS created_at,
+       g.finished_at      AS finished_at,
+       g.status           AS status,
+       CASE WHEN qz.host_paced <> 0 AND g.revealed_at IS NULL THEN 1 ELSE 0 END AS scores_hidden,
+       (SELECT COUNT(*)
+        FROM game_answers ga
+        WHERE ga.game_id = g.id
+          AND ga.player_id = gp.player_id) AS answered_count,
+       (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id) AS question_count
+FROM game_participants gp
+         JOIN games g    ON g.id = gp.game_id
+         JOIN quizzes qz ON qz.id = g.quiz_id
+WHERE gp.player_id = ?1
+  AND g.is_preview = 0
+ORDER BY g.created_at DESC, g.id DESC
+LIMIT ?3 OFFSET ?2
+`
+
+type ListPlayerGameHistoryParams struct {
+	PlayerID  int64
+	RowOffset int64
+	RowLimit  int64
+}
+
+type ListPlayerGameHistoryRow struct {
+	GameID        string
+	QuizID        int64
+	QuizTitle     string
+	QuizSlug      string
+	CreatedAt     time.Time
+	FinishedAt    sql.NullTime
+	Status        game.GameStatus
+	ScoresHidden  int64
+	AnsweredCount int64
+	QuestionCount int64
+}
+
+// One page of the player's past games, newest first, for the "Your previous
+// games" screen: each game's quiz, lifecycle, and how far the player got.
+// Owner previews are left out, as they are from every other player-facing
+// total. scores_hidden flags a host-paced quiz's game whose host has not
+// revealed the scores yet, so the Go layer leaves its score and rank out.
+func (q *Queries) ListPlayerGameHistory(ctx context.Context, arg ListPlayerGameHistoryParams) ([]ListPlayerGameHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPlayerGameHistory, arg.PlayerID, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPlayerGameHistoryRow
+	for rows.Next() {
+		var i ListPlayerGameHistoryRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.QuizID,
+			&i.QuizTitle,
+			&i.QuizSlug,
+			&i.CreatedAt,
+			&i.FinishedAt,
+			&i.Status,
+			&i.ScoresHidden,
+			&i.AnsweredCount,
+			&i.QuestionCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listQuizIDsForPlayer = `-- name: ListQuizIDsForPlayer :many
 SELECT DISTINCT gp.quiz_id
 FROM game_participants gp
@@ -866,6 +1534,65 @@ func (q *Queries) ListQuizIDsForPlayer(ctx context.Context, playerID int64) ([]i
 	return items, nil
 }
 
+const listQuizPlayReport = `-- name: ListQuizPlayReport :many
+SELECT g.quiz_id                                                         AS quiz_id,
+       qz.title                                                          AS quiz_title,
+       COUNT(*)                                                          AS play_count,
+       CAST(SUM(CASE WHEN g.status = 'finished' THEN 1 ELSE 0 END) AS INTEGER) AS finished_count
+FROM games g
+         JOIN quizzes qz ON qz.id = g.quiz_id
+WHERE g.is_preview = 0
+  AND g.created_at >= CAST(?1 AS TEXT)
+  AND g.created_at < CAST(?2 AS TEXT)
+GROUP BY g.quiz_id, qz.title
+ORDER BY play_count DESC, g.quiz_id
+`
+
+type ListQuizPlayReportParams struct {
+	CreatedFrom string
+	CreatedTo   string
+}
+
+type ListQuizPlayReportRow struct {
+	QuizID        int64
+	QuizTitle     string
+	PlayCount     int64
+	FinishedCount int64
+}
+
+// Per-quiz play counts for the admin analytics page: how many real games of
+// each quiz were started in [created_from, created_to), and how many of them
+// finished. Quizzes without a game in the range are left out. The bounds are
+// bound as CURRENT_TIMESTAMP-format text for the same reason as
+// ListParticipantsForQuizLeaderboard's stale_before (#789).
+func (q *Queries) ListQuizPlayReport(ctx context.Context, arg ListQuizPlayReportParams) ([]ListQuizPlayReportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listQuizPlayReport, arg.CreatedFrom, arg.CreatedTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListQuizPlayReportRow
+	for rows.Next() {
+		var i ListQuizPlayReportRow
+		if err := rows.Scan(
+			&i.QuizID,
+			&i.QuizTitle,
+			&i.PlayCount,
+			&i.FinishedCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSeenRoundPhasesByGame = `-- name: ListSeenRoundPhasesByGame :many
 SELECT round_id, phase
 FROM game_seen_rounds
@@ -925,6 +1652,63 @@ func (q *Queries) MarkRoundSeen(ctx context.Context, arg MarkRoundSeenParams) er
 	return err
 }
 
+const pauseParticipantOnce = `-- name: PauseParticipantOnce :execrows
+UPDATE game_participants
+SET paused_question_id = CAST(?1 AS INTEGER),
+    paused_at          = ?2
+WHERE game_id = ?3
+  AND player_id = ?4
+  AND paused_question_id IS NULL
+`
+
+type PauseParticipantOnceParams struct {
+	QuestionID int64
+	PausedAt   sql.NullTime
+	GameID     string
+	PlayerID   int64
+}
+
+// Spends the player's one pause of the game on an issued question. The
+// paused_question_id IS NULL guard makes it once per participation: zero rows
+// affected means the pause was already used.
+func (q *Queries) PauseParticipantOnce(ctx context.Context, arg PauseParticipantOnceParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, pauseParticipantOnce,
+		arg.QuestionID,
+		arg.PausedAt,
+		arg.GameID,
+		arg.PlayerID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const placeGameQuestionWager = `-- name: PlaceGameQuestionWager :execrows
+UPDATE game_questions
+SET wager = CAST(?1 AS INTEGER)
+WHERE game_id = ?2
+  AND question_id = ?3
+  AND wager IS NULL
+`
+
+type PlaceGameQuestionWagerParams struct {
+	Wager      int64
+	GameID     string
+	QuestionID int64
+}
+
+// Locks in the player's confidence stake on an issued question. The
+// wager IS NULL guard makes the first stake final: zero rows affected means
+// one was already placed (or the question was never issued to the game).
+func (q *Queries) PlaceGameQuestionWager(ctx context.Context, arg PlaceGameQuestionWagerParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, placeGameQuestionWager, arg.Wager, arg.GameID, arg.QuestionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const reattributeGameAnswers = `-- name: ReattributeGameAnswers :execrows
 UPDATE game_answers
 SET player_id = ?1
@@ -996,6 +1780,63 @@ func (q *Queries) ReattributeGameParticipants(ctx context.Context, arg Reattribu
 	return result.RowsAffected()
 }
 
+const recordGameHint = `-- name: RecordGameHint :exec
+INSERT INTO game_hints (game_question_id, player_id)
+VALUES (?, ?)
+ON CONFLICT DO NOTHING
+`
+
+type RecordGameHintParams struct {
+	GameQuestionID int64
+	PlayerID       int64
+}
+
+// Marks the hint of an issued question as taken by the player. Asking again
+// keeps the first row, so a hint costs its penalty once.
+func (q *Queries) RecordGameHint(ctx context.Context, arg RecordGameHintParams) error {
+	_, err := q.db.ExecContext(ctx, recordGameHint, arg.GameQuestionID, arg.PlayerID)
+	return err
+}
+
+const revealGame = `-- name: RevealGame :execrows
+UPDATE games
+SET revealed_at = CURRENT_TIMESTAMP
+WHERE id = ?1
+  AND revealed_at IS NULL
+`
+
+// Stamps revealed_at on a host-paced game the first time its host reveals the
+// scores. Zero rows affected means the game does not exist or was already
+// revealed.
+func (q *Queries) RevealGame(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revealGame, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setGameResultsPublic = `-- name: SetGameResultsPublic :execrows
+UPDATE games
+SET results_public = ?1
+WHERE id = ?2
+`
+
+type SetGameResultsPublicParams struct {
+	ResultsPublic int64
+	ID            string
+}
+
+// Turns the game's public, anonymized standings on or off. Zero rows affected
+// means the game does not exist.
+func (q *Queries) SetGameResultsPublic(ctx context.Context, arg SetGameResultsPublicParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setGameResultsPublic, arg.ResultsPublic, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const startGame = `-- name: StartGame :execresult
 UPDATE games
 SET started_at = CURRENT_TIMESTAMP
@@ -1005,3 +1846,26 @@ WHERE id = ?
 func (q *Queries) StartGame(ctx context.Context, id string) (sql.Result, error) {
 	return q.db.ExecContext(ctx, startGame, id)
 }
+
+const voidGameQuestion = `-- name: VoidGameQuestion :execrows
+UPDATE game_questions
+SET voided_at = COALESCE(voided_at, CURRENT_TIMESTAMP)
+WHERE game_id = ?
+  AND question_id = ?
+`
+
+type VoidGameQuestionParams struct {
+	GameID     string
+	QuestionID int64
+}
+
+// Voids an issued question for one game so its answers stop scoring there.
+// COALESCE keeps the first voided_at on a repeat call; zero rows affected
+// means the question was never issued to the game.
+func (q *Queries) VoidGameQuestion(ctx context.Context, arg VoidGameQuestionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, voidGameQuestion, arg.GameID, arg.QuestionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
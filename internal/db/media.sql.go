@@ -8,6 +8,8 @@ package db
 import (
 	"context"
 	"database/sql"
+
+	"github.com/starquake/topbanana/internal/media"
 )
 
 const countMediaByQuizAndType = `-- name: CountMediaByQuizAndType :one
@@ -20,7 +22,7 @@ WHERE quiz_id = ?1
 
 type CountMediaByQuizAndTypeParams struct {
 	QuizID int64
-	Type   string
+	Type   media.Type
 }
 
 // Returns the number of ready media rows of one type for a quiz, so each upload
@@ -63,7 +65,7 @@ RETURNING id, quiz_id, type, mime, path, thumb_path, width, height, size_bytes,
 
 type CreateMediaParams struct {
 	QuizID            int64
-	Type              string
+	Type              media.Type
 	Mime              string
 	Path              string
 	ThumbPath         sql.NullString
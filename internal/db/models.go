@@ -7,6 +7,10 @@ package db
 import (
 	"database/sql"
 	"time"
+
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/media"
+	"github.com/starquake/topbanana/internal/quiz"
 )
 
 type AdminAudit struct {
@@ -18,6 +22,16 @@ type AdminAudit struct {
 	CreatedAt      time.Time
 }
 
+type AuditEvent struct {
+	ID            int64
+	ActorPlayerID sql.NullInt64
+	EntityType    string
+	EntityID      int64
+	Action        string
+	Diff          string
+	CreatedAt     time.Time
+}
+
 type EmailVerifyToken struct {
 	TokenHash    string
 	PlayerID     int64
@@ -28,28 +42,63 @@ type EmailVerifyToken struct {
 }
 
 type Game struct {
-	ID        string
-	QuizID    int64
-	CreatedAt time.Time
-	StartedAt sql.NullTime
-	IsPreview int64
+	ID            string
+	QuizID        int64
+	CreatedAt     time.Time
+	StartedAt     sql.NullTime
+	IsPreview     int64
+	Status        game.GameStatus
+	FinishedAt    sql.NullTime
+	ResultsPublic int64
+	RevealedAt    sql.NullTime
 }
 
 type GameAnswer struct {
-	ID             int64
-	GameID         string
-	PlayerID       int64
+	ID                 int64
+	GameID             string
+	PlayerID           int64
+	GameQuestionID     int64
+	OptionID           int64
+	AnsweredAt         time.Time
+	StatsEpoch         int64
+	ElapsedMs          sql.NullInt64
+	NumericValue       sql.NullFloat64
+	Wager              sql.NullInt64
+	PausedMs           int64
+	HintPenalty        int64
+	Scoring            string
+	Streak             int64
+	WrongAnswerPenalty int64
+	ScoreFloor         int64
+}
+
+type GameAnswerOption struct {
+	GameAnswerID int64
+	OptionID     int64
+}
+
+type GameEvent struct {
+	ID         int64
+	GameID     string
+	Type       string
+	RecordedAt time.Time
+	Payload    string
+}
+
+type GameHint struct {
 	GameQuestionID int64
-	OptionID       int64
-	AnsweredAt     time.Time
+	PlayerID       int64
+	UsedAt         time.Time
 }
 
 type GameParticipant struct {
-	ID       int64
-	GameID   string
-	PlayerID int64
-	QuizID   int64
-	JoinedAt time.Time
+	ID               int64
+	GameID           string
+	PlayerID         int64
+	QuizID           int64
+	JoinedAt         time.Time
+	PausedQuestionID sql.NullInt64
+	PausedAt         sql.NullTime
 }
 
 type GameQuestion struct {
@@ -58,6 +107,8 @@ type GameQuestion struct {
 	QuestionID int64
 	StartedAt  time.Time
 	ExpiredAt  time.Time
+	VoidedAt   sql.NullTime
+	Wager      sql.NullInt64
 }
 
 type GameSeenRound struct {
@@ -82,7 +133,7 @@ type Invite struct {
 type Medium struct {
 	ID                int64
 	QuizID            int64
-	Type              string
+	Type              media.Type
 	Mime              string
 	Path              string
 	ThumbPath         sql.NullString
@@ -99,10 +150,13 @@ type Medium struct {
 }
 
 type Option struct {
-	ID         int64
-	QuestionID int64
-	Text       string
-	IsCorrect  bool
+	ID             int64
+	QuestionID     int64
+	Text           string
+	IsCorrect      bool
+	NumericValue   sql.NullFloat64
+	ToleranceBelow float64
+	ToleranceAbove float64
 }
 
 type PasswordResetToken struct {
@@ -125,6 +179,7 @@ type Player struct {
 	SessionVersion     int64
 	RoleChangedAt      sql.NullTime
 	ApprovedAt         sql.NullTime
+	SessionRevocations int64
 }
 
 type PlayerIdentity struct {
@@ -135,6 +190,16 @@ type PlayerIdentity struct {
 	CreatedAt time.Time
 }
 
+type PlayerSession struct {
+	ID         string
+	PlayerID   int64
+	UserAgent  string
+	IpAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  sql.NullTime
+}
+
 type Question struct {
 	ID               int64
 	QuizID           int64
@@ -145,22 +210,55 @@ type Question struct {
 	ImageMediaID     sql.NullInt64
 	AudioMediaID     sql.NullInt64
 	AudioRepeat      int64
+	StatsEpoch       int64
+	Kind             string
+	AfterQuestionID  sql.NullInt64
+	Version          int64
+	Explanation      string
+	Hint             string
+	ExternalRef      sql.NullString
+}
+
+type QuestionDraft struct {
+	PlayerID   int64
+	QuestionID int64
+	Data       string
+	UpdatedAt  time.Time
 }
 
 type Quiz struct {
-	ID                int64
-	Title             string
-	Slug              string
-	Description       string
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
-	CreatedByPlayerID int64
-	TimeLimitSeconds  int64
-	Visibility        string
-	Mode              string
-	PlayCount         int64
-	Published         int64
-	Language          string
+	ID                  int64
+	Title               string
+	Slug                string
+	Description         string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	CreatedByPlayerID   int64
+	TimeLimitSeconds    int64
+	Visibility          string
+	Mode                quiz.Mode
+	PlayCount           int64
+	Published           int64
+	Language            string
+	ShuffleQuestions    int64
+	KeepOptionOrder     int64
+	LateJoin            string
+	JoinDeadlineSeconds int64
+	MaxPlayers          int64
+	ConfidenceWager     int64
+	PublishedAt         sql.NullTime
+	Version             int64
+	HostPaced           int64
+	Scoring             string
+	WrongAnswerPenalty  int64
+	ScoreFloor          int64
+	ExternalRef         sql.NullString
+}
+
+type QuizzesFt struct {
+	Title       string
+	Description string
+	Slug        string
 }
 
 type Round struct {
@@ -190,17 +288,30 @@ type Session struct {
 	FinishedAt        sql.NullTime
 	HostLastSeenAt    sql.NullTime
 	StartAt           sql.NullTime
+	HostAdvance       int64
+	AnswersClosedAt   sql.NullTime
 }
 
 type SessionAnswer struct {
-	ID         int64
-	SessionID  string
-	QuestionID int64
-	PlayerID   int64
-	OptionID   int64
-	AnsweredAt time.Time
-	Score      sql.NullInt64
-	GameSeq    int64
+	ID              int64
+	SessionID       string
+	QuestionID      int64
+	PlayerID        int64
+	OptionID        int64
+	AnsweredAt      time.Time
+	Score           sql.NullInt64
+	GameSeq         int64
+	WindowStartedAt sql.NullTime
+	WindowExpiresAt sql.NullTime
+	StatsEpoch      int64
+}
+
+type SessionLateJoin struct {
+	SessionID       string
+	PlayerID        int64
+	GameSeq         int64
+	MissedQuestions int64
+	JoinedAt        time.Time
 }
 
 type SessionPlayer struct {
@@ -211,4 +322,40 @@ type SessionPlayer struct {
 	JoinedAt   time.Time
 	LastSeenAt time.Time
 	LeftAt     sql.NullTime
+	Notes      string
+}
+
+type SessionQuestionPlan struct {
+	SessionID  string
+	GameSeq    int64
+	QuestionID int64
+	Position   int64
+	Skipped    int64
+}
+
+type Setting struct {
+	Key       string
+	Value     string
+	UpdatedAt time.Time
+}
+
+type Tournament struct {
+	ID                int64
+	Title             string
+	JoinCode          string
+	CreatedByPlayerID sql.NullInt64
+	CreatedAt         time.Time
+}
+
+type TournamentPlayer struct {
+	TournamentID int64
+	PlayerID     int64
+	JoinedAt     time.Time
+}
+
+type TournamentStage struct {
+	TournamentID int64
+	QuizID       int64
+	Position     int64
+	AddedAt      time.Time
 }
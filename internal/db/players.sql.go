@@ -16,7 +16,7 @@ const adminRenamePlayer = `-- name: AdminRenamePlayer :one
 UPDATE players
 SET display_name = ?1
 WHERE id = ?2
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 type AdminRenamePlayerParams struct {
@@ -50,10 +50,28 @@ func (q *Queries) AdminRenamePlayer(ctx context.Context, arg AdminRenamePlayerPa
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
 
+const bumpSessionVersion = `-- name: BumpSessionVersion :one
+UPDATE players
+SET session_version = session_version + 1
+WHERE id = ?
+RETURNING session_version
+`
+
+// Bumps session_version, which invalidates every session cookie the player
+// holds, including those minted before sessions were recorded, and returns
+// the new value for re-issuing the cookie that stays.
+func (q *Queries) BumpSessionVersion(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, bumpSessionVersion, id)
+	var session_version int64
+	err := row.Scan(&session_version)
+	return session_version, err
+}
+
 const claimPlayer = `-- name: ClaimPlayer :one
 UPDATE players
 SET display_name = ?1,
@@ -93,7 +111,7 @@ SET display_name = ?1,
 WHERE players.id = ?5
   AND players.password_hash IS NULL
   AND players.email IS NULL
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 type ClaimPlayerParams struct {
@@ -149,6 +167,7 @@ func (q *Queries) ClaimPlayer(ctx context.Context, arg ClaimPlayerParams) (Playe
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
@@ -187,7 +206,7 @@ SET email = ?1,
 WHERE players.id = ?2
   AND players.password_hash IS NULL
   AND players.email IS NULL
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 type ClaimPlayerForOAuthParams struct {
@@ -230,6 +249,7 @@ func (q *Queries) ClaimPlayerForOAuth(ctx context.Context, arg ClaimPlayerForOAu
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
@@ -306,7 +326,7 @@ func (q *Queries) ConsumePasswordResetToken(ctx context.Context, arg ConsumePass
 const createAnonymousPlayer = `-- name: CreateAnonymousPlayer :one
 INSERT INTO players (display_name, role)
 VALUES (?1, 'player')
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 // Used by the EnsurePlayer middleware to back a fresh visitor with a real
@@ -333,6 +353,7 @@ func (q *Queries) CreateAnonymousPlayer(ctx context.Context, displayName string)
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
@@ -422,7 +443,7 @@ VALUES (
     END,
     1
 )
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 type CreatePlayerFromOAuthParams struct {
@@ -460,6 +481,7 @@ func (q *Queries) CreatePlayerFromOAuth(ctx context.Context, arg CreatePlayerFro
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
@@ -499,7 +521,7 @@ VALUES (
     END,
     1
 )
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 type CreatePlayerWithCredentialsParams struct {
@@ -558,6 +580,7 @@ func (q *Queries) CreatePlayerWithCredentials(ctx context.Context, arg CreatePla
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
@@ -664,7 +687,7 @@ func (q *Queries) GetPasswordResetToken(ctx context.Context, tokenHash string) (
 }
 
 const getPlayerByDisplayName = `-- name: GetPlayerByDisplayName :one
-SELECT id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+SELECT id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 FROM players
 WHERE display_name = ?
 LIMIT 1
@@ -685,12 +708,13 @@ func (q *Queries) GetPlayerByDisplayName(ctx context.Context, displayName string
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
 
 const getPlayerByEmail = `-- name: GetPlayerByEmail :one
-SELECT id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+SELECT id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 FROM players
 WHERE email = ?
 LIMIT 1
@@ -715,12 +739,13 @@ func (q *Queries) GetPlayerByEmail(ctx context.Context, email sql.NullString) (P
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
 
 const getPlayerByProviderSubject = `-- name: GetPlayerByProviderSubject :one
-SELECT p.id, p.display_name, p.email, p.password_hash, p.role, p.created_at, p.display_name_claimed, p.email_verified_at, p.session_version, p.role_changed_at, p.approved_at
+SELECT p.id, p.display_name, p.email, p.password_hash, p.role, p.created_at, p.display_name_claimed, p.email_verified_at, p.session_version, p.role_changed_at, p.approved_at, p.session_revocations
 FROM players p
 JOIN player_identities pi ON pi.player_id = p.id
 WHERE pi.provider = ? AND pi.subject = ?
@@ -751,6 +776,7 @@ func (q *Queries) GetPlayerByProviderSubject(ctx context.Context, arg GetPlayerB
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
@@ -769,6 +795,19 @@ func (q *Queries) HasAnyAdmin(ctx context.Context) (bool, error) {
 	return has_admin, err
 }
 
+const incrementSessionRevocations = `-- name: IncrementSessionRevocations :exec
+UPDATE players
+SET session_revocations = session_revocations + 1
+WHERE id = ?
+`
+
+// Bumps players.session_revocations after a session is revoked, so every
+// process's cached list of the player's revoked ids is reread.
+func (q *Queries) IncrementSessionRevocations(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, incrementSessionRevocations, id)
+	return err
+}
+
 const linkProviderIdentity = `-- name: LinkProviderIdentity :exec
 INSERT INTO player_identities (player_id, provider, subject)
 VALUES (?, ?, ?)
@@ -936,6 +975,85 @@ func (q *Queries) ListPlayerFinishStats(ctx context.Context, playerIds []int64)
 	return items, nil
 }
 
+const listPlayerSessions = `-- name: ListPlayerSessions :many
+SELECT id, user_agent, ip_address, created_at, last_seen_at
+FROM player_sessions
+WHERE player_id = ?
+  AND revoked_at IS NULL
+ORDER BY last_seen_at DESC, id
+`
+
+type ListPlayerSessionsRow struct {
+	ID         string
+	UserAgent  string
+	IpAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
+
+// The player's sessions that have not been revoked, most recently used
+// first.
+func (q *Queries) ListPlayerSessions(ctx context.Context, playerID int64) ([]ListPlayerSessionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPlayerSessions, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPlayerSessionsRow
+	for rows.Next() {
+		var i ListPlayerSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.CreatedAt,
+			&i.LastSeenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRevokedPlayerSessionIDs = `-- name: ListRevokedPlayerSessionIDs :many
+SELECT id
+FROM player_sessions
+WHERE player_id = ?
+  AND revoked_at IS NOT NULL
+`
+
+// The ids of the player's revoked sessions, which the auth middleware
+// caches and refuses.
+func (q *Queries) ListRevokedPlayerSessionIDs(ctx context.Context, playerID int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listRevokedPlayerSessionIDs, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markPlayerEmailVerifiedIfNew = `-- name: MarkPlayerEmailVerifiedIfNew :execrows
 UPDATE players
 SET email_verified_at = CURRENT_TIMESTAMP
@@ -957,7 +1075,7 @@ UPDATE players
 SET display_name = ?1,
     display_name_claimed = 1
 WHERE id = ?2
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 type RenamePlayerParams struct {
@@ -992,6 +1110,7 @@ func (q *Queries) RenamePlayer(ctx context.Context, arg RenamePlayerParams) (Pla
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
@@ -1022,6 +1141,89 @@ func (q *Queries) ResetPlayerPassword(ctx context.Context, arg ResetPlayerPasswo
 	return result.RowsAffected()
 }
 
+const revokePlayerSession = `-- name: RevokePlayerSession :execrows
+UPDATE player_sessions
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE id = ?1
+  AND player_id = ?2
+  AND revoked_at IS NULL
+`
+
+type RevokePlayerSessionParams struct {
+	ID       string
+	PlayerID int64
+}
+
+// Marks one of the player's sessions revoked. Zero rows means the id is not
+// a live session of this player.
+func (q *Queries) RevokePlayerSession(ctx context.Context, arg RevokePlayerSessionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokePlayerSession, arg.ID, arg.PlayerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokePlayerSessionsExcept = `-- name: RevokePlayerSessionsExcept :exec
+UPDATE player_sessions
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE player_id = ?1
+  AND id <> ?2
+  AND revoked_at IS NULL
+`
+
+type RevokePlayerSessionsExceptParams struct {
+	PlayerID int64
+	KeepID   string
+}
+
+// Marks every live session of the player revoked but the one with id
+// keep_id; an empty keep_id revokes them all.
+func (q *Queries) RevokePlayerSessionsExcept(ctx context.Context, arg RevokePlayerSessionsExceptParams) error {
+	_, err := q.db.ExecContext(ctx, revokePlayerSessionsExcept, arg.PlayerID, arg.KeepID)
+	return err
+}
+
+const setGeneratedDisplayName = `-- name: SetGeneratedDisplayName :one
+UPDATE players
+SET display_name = ?1
+WHERE id = ?2
+  AND password_hash IS NULL
+  AND display_name_claimed = 0
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
+`
+
+type SetGeneratedDisplayNameParams struct {
+	DisplayName string
+	ID          int64
+}
+
+// Gives a freshly minted anonymous row its generated nickname (derived from the
+// new id, so it cannot be chosen before the INSERT). Guarded on the row still
+// being anonymous and unclaimed so it never overwrites a name the visitor
+// picked; display_name_claimed stays 0 because the visitor did not choose it.
+// sql.ErrNoRows means the guard filtered the row out; a UNIQUE constraint
+// failure means the nickname is taken.
+func (q *Queries) SetGeneratedDisplayName(ctx context.Context, arg SetGeneratedDisplayNameParams) (Player, error) {
+	row := q.db.QueryRowContext(ctx, setGeneratedDisplayName, arg.DisplayName, arg.ID)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.DisplayName,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Role,
+		&i.CreatedAt,
+		&i.DisplayNameClaimed,
+		&i.EmailVerifiedAt,
+		&i.SessionVersion,
+		&i.RoleChangedAt,
+		&i.ApprovedAt,
+		&i.SessionRevocations,
+	)
+	return i, err
+}
+
 const setPlayerApprovedNow = `-- name: SetPlayerApprovedNow :execrows
 UPDATE players
 SET approved_at = CURRENT_TIMESTAMP
@@ -1146,12 +1348,42 @@ func (q *Queries) SwapPlayerEmail(ctx context.Context, arg SwapPlayerEmailParams
 	return result.RowsAffected()
 }
 
+const touchPlayerSession = `-- name: TouchPlayerSession :exec
+INSERT INTO player_sessions (id, player_id, user_agent, ip_address)
+VALUES (?1, ?2, ?3, ?4)
+ON CONFLICT (id) DO UPDATE SET user_agent   = excluded.user_agent,
+                               ip_address   = excluded.ip_address,
+                               last_seen_at = CURRENT_TIMESTAMP
+WHERE player_sessions.player_id = excluded.player_id
+`
+
+type TouchPlayerSessionParams struct {
+	ID        string
+	PlayerID  int64
+	UserAgent string
+	IpAddress string
+}
+
+// Records a sighting of a session cookie: the first one inserts the device
+// row, later ones refresh its user agent, address and last_seen_at. The
+// update leaves revoked_at alone, so a revoked session never comes back, and
+// only touches a row of the same player.
+func (q *Queries) TouchPlayerSession(ctx context.Context, arg TouchPlayerSessionParams) error {
+	_, err := q.db.ExecContext(ctx, touchPlayerSession,
+		arg.ID,
+		arg.PlayerID,
+		arg.UserAgent,
+		arg.IpAddress,
+	)
+	return err
+}
+
 const updatePlayerDisplayName = `-- name: UpdatePlayerDisplayName :one
 UPDATE players
 SET display_name = ?1,
     display_name_claimed = 1
 WHERE id = ?2 AND password_hash IS NULL
-RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at
+RETURNING id, display_name, email, password_hash, role, created_at, display_name_claimed, email_verified_at, session_version, role_changed_at, approved_at, session_revocations
 `
 
 type UpdatePlayerDisplayNameParams struct {
@@ -1186,6 +1418,7 @@ func (q *Queries) UpdatePlayerDisplayName(ctx context.Context, arg UpdatePlayerD
 		&i.SessionVersion,
 		&i.RoleChangedAt,
 		&i.ApprovedAt,
+		&i.SessionRevocations,
 	)
 	return i, err
 }
@@ -10,8 +10,23 @@ import (
 	"database/sql"
 	"strings"
 	"time"
+
+	"github.com/starquake/topbanana/internal/quiz"
 )
 
+const bumpQuestionStatsEpoch = `-- name: BumpQuestionStatsEpoch :execresult
+UPDATE questions
+SET stats_epoch = stats_epoch + 1
+WHERE id = ?
+`
+
+// Starts a fresh statistics epoch for a question whose text or options were
+// substantially edited, so ListQuestionStatsByQuizID stops counting picks made
+// against the old wording. The picks themselves are kept.
+func (q *Queries) BumpQuestionStatsEpoch(ctx context.Context, id int64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, bumpQuestionStatsEpoch, id)
+}
+
 const bumpQuizPlayCountForGame = `-- name: BumpQuizPlayCountForGame :exec
 UPDATE quizzes
 SET play_count = play_count + 1
@@ -44,34 +59,116 @@ func (q *Queries) BumpQuizPlayCountForSession(ctx context.Context, id string) er
 	return err
 }
 
+const countPublicQuizzes = `-- name: CountPublicQuizzes :one
+SELECT COUNT(*)
+FROM quizzes q
+WHERE q.visibility = 'public'
+  AND q.mode = 'solo'
+  AND q.published = 1
+`
+
+// Total rows ListPublicQuizzes would return; the API reports it in the list
+// meta so a client can render its own page navigation.
+func (q *Queries) CountPublicQuizzes(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPublicQuizzes)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countQuizzesPage = `-- name: CountQuizzesPage :one
+SELECT COUNT(*)
+FROM quizzes q
+WHERE (CAST(?1 AS INTEGER) = 0 OR q.created_by_player_id = CAST(?1 AS INTEGER))
+  AND (CAST(?2 AS TEXT) = '' OR q.mode = CAST(?2 AS TEXT))
+`
+
+type CountQuizzesPageParams struct {
+	OwnerID int64
+	Mode    string
+}
+
+// Total rows matching the ListQuizzesPage filter; powers the page count on
+// the admin quiz list.
+func (q *Queries) CountQuizzesPage(ctx context.Context, arg CountQuizzesPageParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countQuizzesPage, arg.OwnerID, arg.Mode)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchQuizzes = `-- name: CountSearchQuizzes :one
+SELECT COUNT(*)
+FROM quizzes_fts(CAST(?1 AS TEXT)) f
+         JOIN quizzes q ON q.id = f.rowid
+WHERE (CAST(?2 AS INTEGER) = 0 OR q.created_by_player_id = CAST(?2 AS INTEGER))
+  AND (CAST(?3 AS TEXT) = '' OR q.mode = CAST(?3 AS TEXT))
+  AND (CAST(?4 AS INTEGER) = 0
+    OR (q.visibility = 'public' AND q.mode = 'solo' AND q.published = 1))
+`
+
+type CountSearchQuizzesParams struct {
+	Query      string
+	OwnerID    int64
+	Mode       string
+	PublicOnly int64
+}
+
+// Total rows matching the SearchQuizzes filter, for the page navigation.
+func (q *Queries) CountSearchQuizzes(ctx context.Context, arg CountSearchQuizzesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchQuizzes,
+		arg.Query,
+		arg.OwnerID,
+		arg.Mode,
+		arg.PublicOnly,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createOption = `-- name: CreateOption :one
-INSERT INTO options (question_id, text, is_correct)
-VALUES (?, ?, ?)
-RETURNING id, question_id, text, is_correct
+INSERT INTO options (question_id, text, is_correct, numeric_value, tolerance_below, tolerance_above)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, question_id, text, is_correct, numeric_value, tolerance_below, tolerance_above
 `
 
 type CreateOptionParams struct {
-	QuestionID int64
-	Text       string
-	IsCorrect  bool
+	QuestionID     int64
+	Text           string
+	IsCorrect      bool
+	NumericValue   sql.NullFloat64
+	ToleranceBelow float64
+	ToleranceAbove float64
 }
 
 func (q *Queries) CreateOption(ctx context.Context, arg CreateOptionParams) (Option, error) {
-	row := q.db.QueryRowContext(ctx, createOption, arg.QuestionID, arg.Text, arg.IsCorrect)
+	row := q.db.QueryRowContext(ctx, createOption,
+		arg.QuestionID,
+		arg.Text,
+		arg.IsCorrect,
+		arg.NumericValue,
+		arg.ToleranceBelow,
+		arg.ToleranceAbove,
+	)
 	var i Option
 	err := row.Scan(
 		&i.ID,
 		&i.QuestionID,
 		&i.Text,
 		&i.IsCorrect,
+		&i.NumericValue,
+		&i.ToleranceBelow,
+		&i.ToleranceAbove,
 	)
 	return i, err
 }
 
 const createQuestion = `-- name: CreateQuestion :one
-INSERT INTO questions (quiz_id, round_id, text, position, image_media_id, audio_media_id, audio_repeat, time_limit_seconds)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, quiz_id, round_id, text, position, time_limit_seconds, image_media_id, audio_media_id, audio_repeat
+INSERT INTO questions (quiz_id, round_id, text, position, image_media_id, audio_media_id, audio_repeat, time_limit_seconds, kind,
+                       after_question_id, explanation, hint, external_ref)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, quiz_id, round_id, text, position, time_limit_seconds, image_media_id, audio_media_id, audio_repeat, stats_epoch, kind, after_question_id, version, explanation, hint, external_ref
 `
 
 type CreateQuestionParams struct {
@@ -83,6 +180,11 @@ type CreateQuestionParams struct {
 	AudioMediaID     sql.NullInt64
 	AudioRepeat      int64
 	TimeLimitSeconds sql.NullInt64
+	Kind             string
+	AfterQuestionID  sql.NullInt64
+	Explanation      string
+	Hint             string
+	ExternalRef      sql.NullString
 }
 
 func (q *Queries) CreateQuestion(ctx context.Context, arg CreateQuestionParams) (Question, error) {
@@ -95,6 +197,11 @@ func (q *Queries) CreateQuestion(ctx context.Context, arg CreateQuestionParams)
 		arg.AudioMediaID,
 		arg.AudioRepeat,
 		arg.TimeLimitSeconds,
+		arg.Kind,
+		arg.AfterQuestionID,
+		arg.Explanation,
+		arg.Hint,
+		arg.ExternalRef,
 	)
 	var i Question
 	err := row.Scan(
@@ -107,32 +214,60 @@ func (q *Queries) CreateQuestion(ctx context.Context, arg CreateQuestionParams)
 		&i.ImageMediaID,
 		&i.AudioMediaID,
 		&i.AudioRepeat,
+		&i.StatsEpoch,
+		&i.Kind,
+		&i.AfterQuestionID,
+		&i.Version,
+		&i.Explanation,
+		&i.Hint,
+		&i.ExternalRef,
 	)
 	return i, err
 }
 
 const createQuiz = `-- name: CreateQuiz :one
-INSERT INTO quizzes (title, slug, description, created_by_player_id, time_limit_seconds, visibility, mode, language, published, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
-RETURNING id, title, slug, description, created_at, updated_at, created_by_player_id, time_limit_seconds, visibility, mode, play_count, published, language
+INSERT INTO quizzes (title, slug, description, created_by_player_id, time_limit_seconds, visibility, mode, language, published,
+                     shuffle_questions, keep_option_order, late_join, join_deadline_seconds, max_players,
+                     confidence_wager, host_paced, scoring, wrong_answer_penalty, score_floor, external_ref,
+                     updated_at, published_at)
+VALUES (?1, ?2, ?3, ?4,
+        ?5, ?6, ?7, ?8,
+        ?9, ?10, ?11, ?12,
+        ?13, ?14, ?15,
+        ?16, ?17, ?18,
+        ?19, ?20, CURRENT_TIMESTAMP,
+        CASE WHEN ?9 = 1 THEN CURRENT_TIMESTAMP END)
+RETURNING id, title, slug, description, created_at, updated_at, created_by_player_id, time_limit_seconds, visibility, mode, play_count, published, language, shuffle_questions, keep_option_order, late_join, join_deadline_seconds, max_players, confidence_wager, published_at, version, host_paced, scoring, wrong_answer_penalty, score_floor, external_ref
 `
 
 type CreateQuizParams struct {
-	Title             string
-	Slug              string
-	Description       string
-	CreatedByPlayerID int64
-	TimeLimitSeconds  int64
-	Visibility        string
-	Mode              string
-	Language          string
-	Published         int64
+	Title               string
+	Slug                string
+	Description         string
+	CreatedByPlayerID   int64
+	TimeLimitSeconds    int64
+	Visibility          string
+	Mode                quiz.Mode
+	Language            string
+	Published           int64
+	ShuffleQuestions    int64
+	KeepOptionOrder     int64
+	LateJoin            string
+	JoinDeadlineSeconds int64
+	MaxPlayers          int64
+	ConfidenceWager     int64
+	HostPaced           int64
+	Scoring             string
+	WrongAnswerPenalty  int64
+	ScoreFloor          int64
+	ExternalRef         sql.NullString
 }
 
 // created_by_player_id is NOT NULL with an FK to players.id (migration
 // 20260520200000 / #281). [QuizStore.CreateQuiz] short-circuits with
 // ErrCreatorRequired when the caller forgot to stamp the session
-// admin, so the FK constraint is the second line of defence.
+// admin, so the FK constraint is the second line of defence. A quiz created
+// already published (fixtures, importers) gets its published_at stamped here.
 func (q *Queries) CreateQuiz(ctx context.Context, arg CreateQuizParams) (Quiz, error) {
 	row := q.db.QueryRowContext(ctx, createQuiz,
 		arg.Title,
@@ -144,6 +279,17 @@ func (q *Queries) CreateQuiz(ctx context.Context, arg CreateQuizParams) (Quiz, e
 		arg.Mode,
 		arg.Language,
 		arg.Published,
+		arg.ShuffleQuestions,
+		arg.KeepOptionOrder,
+		arg.LateJoin,
+		arg.JoinDeadlineSeconds,
+		arg.MaxPlayers,
+		arg.ConfidenceWager,
+		arg.HostPaced,
+		arg.Scoring,
+		arg.WrongAnswerPenalty,
+		arg.ScoreFloor,
+		arg.ExternalRef,
 	)
 	var i Quiz
 	err := row.Scan(
@@ -160,6 +306,19 @@ func (q *Queries) CreateQuiz(ctx context.Context, arg CreateQuizParams) (Quiz, e
 		&i.PlayCount,
 		&i.Published,
 		&i.Language,
+		&i.ShuffleQuestions,
+		&i.KeepOptionOrder,
+		&i.LateJoin,
+		&i.JoinDeadlineSeconds,
+		&i.MaxPlayers,
+		&i.ConfidenceWager,
+		&i.PublishedAt,
+		&i.Version,
+		&i.HostPaced,
+		&i.Scoring,
+		&i.WrongAnswerPenalty,
+		&i.ScoreFloor,
+		&i.ExternalRef,
 	)
 	return i, err
 }
@@ -200,7 +359,7 @@ func (q *Queries) DeleteQuiz(ctx context.Context, id int64) (sql.Result, error)
 }
 
 const getOption = `-- name: GetOption :one
-SELECT id, question_id, text, is_correct
+SELECT id, question_id, text, is_correct, numeric_value, tolerance_below, tolerance_above
 FROM options
 WHERE id = ?
 LIMIT 1
@@ -214,12 +373,15 @@ func (q *Queries) GetOption(ctx context.Context, id int64) (Option, error) {
 		&i.QuestionID,
 		&i.Text,
 		&i.IsCorrect,
+		&i.NumericValue,
+		&i.ToleranceBelow,
+		&i.ToleranceAbove,
 	)
 	return i, err
 }
 
 const getOptionsByIDs = `-- name: GetOptionsByIDs :many
-SELECT id, question_id, text, is_correct
+SELECT id, question_id, text, is_correct, numeric_value, tolerance_below, tolerance_above
 FROM options
 WHERE id IN (/*SLICE:ids*/?)
 `
@@ -248,6 +410,9 @@ func (q *Queries) GetOptionsByIDs(ctx context.Context, ids []int64) ([]Option, e
 			&i.QuestionID,
 			&i.Text,
 			&i.IsCorrect,
+			&i.NumericValue,
+			&i.ToleranceBelow,
+			&i.ToleranceAbove,
 		); err != nil {
 			return nil, err
 		}
@@ -263,7 +428,7 @@ func (q *Queries) GetOptionsByIDs(ctx context.Context, ids []int64) ([]Option, e
 }
 
 const getQuestion = `-- name: GetQuestion :one
-SELECT id, quiz_id, round_id, text, position, time_limit_seconds, image_media_id, audio_media_id, audio_repeat
+SELECT id, quiz_id, round_id, text, position, time_limit_seconds, image_media_id, audio_media_id, audio_repeat, stats_epoch, kind, after_question_id, version, explanation, hint, external_ref
 FROM questions
 WHERE id = ?
 LIMIT 1
@@ -282,6 +447,101 @@ func (q *Queries) GetQuestion(ctx context.Context, id int64) (Question, error) {
 		&i.ImageMediaID,
 		&i.AudioMediaID,
 		&i.AudioRepeat,
+		&i.StatsEpoch,
+		&i.Kind,
+		&i.AfterQuestionID,
+		&i.Version,
+		&i.Explanation,
+		&i.Hint,
+		&i.ExternalRef,
+	)
+	return i, err
+}
+
+const getQuestionByExternalRef = `-- name: GetQuestionByExternalRef :one
+SELECT id, quiz_id, round_id, text, position, time_limit_seconds, image_media_id, audio_media_id, audio_repeat, stats_epoch, kind, after_question_id, version, explanation, hint, external_ref
+FROM questions
+WHERE quiz_id = ?
+  AND external_ref = ?
+LIMIT 1
+`
+
+type GetQuestionByExternalRefParams struct {
+	QuizID      int64
+	ExternalRef sql.NullString
+}
+
+// Looks a question up by the author-supplied reference the authoring API
+// keys it by; references are unique per quiz only.
+func (q *Queries) GetQuestionByExternalRef(ctx context.Context, arg GetQuestionByExternalRefParams) (Question, error) {
+	row := q.db.QueryRowContext(ctx, getQuestionByExternalRef, arg.QuizID, arg.ExternalRef)
+	var i Question
+	err := row.Scan(
+		&i.ID,
+		&i.QuizID,
+		&i.RoundID,
+		&i.Text,
+		&i.Position,
+		&i.TimeLimitSeconds,
+		&i.ImageMediaID,
+		&i.AudioMediaID,
+		&i.AudioRepeat,
+		&i.StatsEpoch,
+		&i.Kind,
+		&i.AfterQuestionID,
+		&i.Version,
+		&i.Explanation,
+		&i.Hint,
+		&i.ExternalRef,
+	)
+	return i, err
+}
+
+const getQuestionProgress = `-- name: GetQuestionProgress :one
+SELECT (SELECT COUNT(*) FROM questions a WHERE a.quiz_id = q.quiz_id)                 AS total,
+       (SELECT COUNT(DISTINCT a.round_id) FROM questions a WHERE a.quiz_id = q.quiz_id) AS round_total,
+       (SELECT COUNT(DISTINCT a.round_id)
+        FROM questions a
+        WHERE a.quiz_id = q.quiz_id
+          AND a.position <= (SELECT MIN(b.position)
+                             FROM questions b
+                             WHERE b.quiz_id = q.quiz_id
+                               AND b.round_id = q.round_id))                         AS round_number,
+       (SELECT COUNT(*)
+        FROM questions a
+        WHERE a.quiz_id = q.quiz_id
+          AND a.round_id = q.round_id
+          AND a.position <= q.position)                                               AS round_position,
+       (SELECT COUNT(*)
+        FROM questions a
+        WHERE a.quiz_id = q.quiz_id
+          AND a.round_id = q.round_id)                                                AS round_questions
+FROM questions q
+WHERE q.id = ?
+`
+
+type GetQuestionProgressRow struct {
+	Total          int64
+	RoundTotal     int64
+	RoundNumber    int64
+	RoundPosition  int64
+	RoundQuestions int64
+}
+
+// Places one question within its quiz for the gameplay header without loading
+// the quiz: the quiz's question count, the round count, the question's round
+// number, and its position and sibling count within that round. Rounds are
+// numbered by the position of their first question, matching
+// quiz.QuestionRoundProgress.
+func (q *Queries) GetQuestionProgress(ctx context.Context, id int64) (GetQuestionProgressRow, error) {
+	row := q.db.QueryRowContext(ctx, getQuestionProgress, id)
+	var i GetQuestionProgressRow
+	err := row.Scan(
+		&i.Total,
+		&i.RoundTotal,
+		&i.RoundNumber,
+		&i.RoundPosition,
+		&i.RoundQuestions,
 	)
 	return i, err
 }
@@ -298,8 +558,21 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
+       q.published_at,
+       q.version,
        p.display_name AS created_by_display_name
 FROM quizzes q
          JOIN players p ON p.id = q.created_by_player_id
@@ -317,10 +590,23 @@ type GetQuizRow struct {
 	CreatedByPlayerID    int64
 	TimeLimitSeconds     int64
 	Visibility           string
-	Mode                 string
+	Mode                 quiz.Mode
 	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
 	PlayCount            int64
 	Published            int64
+	PublishedAt          sql.NullTime
+	Version              int64
 	CreatedByDisplayName string
 }
 
@@ -342,13 +628,57 @@ func (q *Queries) GetQuiz(ctx context.Context, id int64) (GetQuizRow, error) {
 		&i.Visibility,
 		&i.Mode,
 		&i.Language,
+		&i.ShuffleQuestions,
+		&i.KeepOptionOrder,
+		&i.LateJoin,
+		&i.JoinDeadlineSeconds,
+		&i.MaxPlayers,
+		&i.ConfidenceWager,
+		&i.HostPaced,
+		&i.Scoring,
+		&i.WrongAnswerPenalty,
+		&i.ScoreFloor,
+		&i.ExternalRef,
 		&i.PlayCount,
 		&i.Published,
+		&i.PublishedAt,
+		&i.Version,
 		&i.CreatedByDisplayName,
 	)
 	return i, err
 }
 
+const getQuizIDByExternalRef = `-- name: GetQuizIDByExternalRef :one
+SELECT id
+FROM quizzes
+WHERE external_ref = ?
+`
+
+// Resolves an author-supplied quiz reference to its quiz ID, so a re-import
+// finds the quiz it came from even after its title and slug changed.
+func (q *Queries) GetQuizIDByExternalRef(ctx context.Context, externalRef sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getQuizIDByExternalRef, externalRef)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getQuizIDBySlug = `-- name: GetQuizIDBySlug :one
+SELECT id
+FROM quizzes
+WHERE slug = ?
+`
+
+// Resolves a slug to its quiz ID. Used by the JSON import to find the
+// quiz a re-imported document collides with, so it can offer a diff
+// against it instead of a bare slug conflict.
+func (q *Queries) GetQuizIDBySlug(ctx context.Context, slug string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getQuizIDBySlug, slug)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getQuizVisibility = `-- name: GetQuizVisibility :one
 SELECT visibility
 FROM quizzes
@@ -378,6 +708,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -398,8 +739,19 @@ type ListLiveQuizzesRow struct {
 	CreatedByPlayerID    int64
 	TimeLimitSeconds     int64
 	Visibility           string
-	Mode                 string
+	Mode                 quiz.Mode
 	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
 	PlayCount            int64
 	Published            int64
 	CreatedByDisplayName string
@@ -430,6 +782,17 @@ func (q *Queries) ListLiveQuizzes(ctx context.Context) ([]ListLiveQuizzesRow, er
 			&i.Visibility,
 			&i.Mode,
 			&i.Language,
+			&i.ShuffleQuestions,
+			&i.KeepOptionOrder,
+			&i.LateJoin,
+			&i.JoinDeadlineSeconds,
+			&i.MaxPlayers,
+			&i.ConfidenceWager,
+			&i.HostPaced,
+			&i.Scoring,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.ExternalRef,
 			&i.PlayCount,
 			&i.Published,
 			&i.CreatedByDisplayName,
@@ -459,6 +822,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -480,8 +854,19 @@ type ListLiveQuizzesForOwnerRow struct {
 	CreatedByPlayerID    int64
 	TimeLimitSeconds     int64
 	Visibility           string
-	Mode                 string
+	Mode                 quiz.Mode
 	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
 	PlayCount            int64
 	Published            int64
 	CreatedByDisplayName string
@@ -511,6 +896,17 @@ func (q *Queries) ListLiveQuizzesForOwner(ctx context.Context, createdByPlayerID
 			&i.Visibility,
 			&i.Mode,
 			&i.Language,
+			&i.ShuffleQuestions,
+			&i.KeepOptionOrder,
+			&i.LateJoin,
+			&i.JoinDeadlineSeconds,
+			&i.MaxPlayers,
+			&i.ConfidenceWager,
+			&i.HostPaced,
+			&i.Scoring,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.ExternalRef,
 			&i.PlayCount,
 			&i.Published,
 			&i.CreatedByDisplayName,
@@ -558,7 +954,7 @@ func (q *Queries) ListOptionIDsByQuestionID(ctx context.Context, questionID int6
 }
 
 const listOptionsByQuestionID = `-- name: ListOptionsByQuestionID :many
-SELECT id, question_id, text, is_correct
+SELECT id, question_id, text, is_correct, numeric_value, tolerance_below, tolerance_above
 FROM options
 WHERE question_id = ?
 `
@@ -577,6 +973,9 @@ func (q *Queries) ListOptionsByQuestionID(ctx context.Context, questionID int64)
 			&i.QuestionID,
 			&i.Text,
 			&i.IsCorrect,
+			&i.NumericValue,
+			&i.ToleranceBelow,
+			&i.ToleranceAbove,
 		); err != nil {
 			return nil, err
 		}
@@ -592,7 +991,7 @@ func (q *Queries) ListOptionsByQuestionID(ctx context.Context, questionID int64)
 }
 
 const listOptionsByQuizID = `-- name: ListOptionsByQuizID :many
-SELECT o.id, o.question_id, o.text, o.is_correct
+SELECT o.id, o.question_id, o.text, o.is_correct, o.numeric_value, o.tolerance_below, o.tolerance_above
 FROM options o
          JOIN questions q ON q.id = o.question_id
 WHERE q.quiz_id = ?
@@ -615,6 +1014,9 @@ func (q *Queries) ListOptionsByQuizID(ctx context.Context, quizID int64) ([]Opti
 			&i.QuestionID,
 			&i.Text,
 			&i.IsCorrect,
+			&i.NumericValue,
+			&i.ToleranceBelow,
+			&i.ToleranceAbove,
 		); err != nil {
 			return nil, err
 		}
@@ -641,6 +1043,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -662,8 +1075,19 @@ type ListPublicQuizzesRow struct {
 	CreatedByPlayerID    int64
 	TimeLimitSeconds     int64
 	Visibility           string
-	Mode                 string
+	Mode                 quiz.Mode
 	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
 	PlayCount            int64
 	Published            int64
 	CreatedByDisplayName string
@@ -696,6 +1120,136 @@ func (q *Queries) ListPublicQuizzes(ctx context.Context) ([]ListPublicQuizzesRow
 			&i.Visibility,
 			&i.Mode,
 			&i.Language,
+			&i.ShuffleQuestions,
+			&i.KeepOptionOrder,
+			&i.LateJoin,
+			&i.JoinDeadlineSeconds,
+			&i.MaxPlayers,
+			&i.ConfidenceWager,
+			&i.HostPaced,
+			&i.Scoring,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.ExternalRef,
+			&i.PlayCount,
+			&i.Published,
+			&i.CreatedByDisplayName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPublicQuizzesPage = `-- name: ListPublicQuizzesPage :many
+SELECT q.id,
+       q.title,
+       q.slug,
+       q.description,
+       q.created_at,
+       q.updated_at,
+       q.created_by_player_id,
+       q.time_limit_seconds,
+       q.visibility,
+       q.mode,
+       q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
+       q.play_count,
+       q.published,
+       p.display_name AS created_by_display_name
+FROM quizzes q
+         JOIN players p ON p.id = q.created_by_player_id
+WHERE q.visibility = 'public'
+  AND q.mode = 'solo'
+  AND q.published = 1
+ORDER BY q.updated_at DESC, q.id DESC
+LIMIT ?2 OFFSET ?1
+`
+
+type ListPublicQuizzesPageParams struct {
+	RowOffset int64
+	RowLimit  int64
+}
+
+type ListPublicQuizzesPageRow struct {
+	ID                   int64
+	Title                string
+	Slug                 string
+	Description          string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	CreatedByPlayerID    int64
+	TimeLimitSeconds     int64
+	Visibility           string
+	Mode                 quiz.Mode
+	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
+	PlayCount            int64
+	Published            int64
+	CreatedByDisplayName string
+}
+
+// Paged variant of ListPublicQuizzes for GET /api/quizzes?page=&pageSize=.
+// Same visibility / mode / published filters and order.
+func (q *Queries) ListPublicQuizzesPage(ctx context.Context, arg ListPublicQuizzesPageParams) ([]ListPublicQuizzesPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPublicQuizzesPage, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPublicQuizzesPageRow
+	for rows.Next() {
+		var i ListPublicQuizzesPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CreatedByPlayerID,
+			&i.TimeLimitSeconds,
+			&i.Visibility,
+			&i.Mode,
+			&i.Language,
+			&i.ShuffleQuestions,
+			&i.KeepOptionOrder,
+			&i.LateJoin,
+			&i.JoinDeadlineSeconds,
+			&i.MaxPlayers,
+			&i.ConfidenceWager,
+			&i.HostPaced,
+			&i.Scoring,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.ExternalRef,
 			&i.PlayCount,
 			&i.Published,
 			&i.CreatedByDisplayName,
@@ -717,7 +1271,7 @@ const listQuestionIDsByQuizID = `-- name: ListQuestionIDsByQuizID :many
 SELECT id
 FROM questions
 WHERE quiz_id = ?
-ORDER BY position
+ORDER BY position, id
 `
 
 func (q *Queries) ListQuestionIDsByQuizID(ctx context.Context, quizID int64) ([]int64, error) {
@@ -778,11 +1332,72 @@ func (q *Queries) ListQuestionIDsByRoundID(ctx context.Context, roundID int64) (
 	return items, nil
 }
 
+const listQuestionStatsByQuizID = `-- name: ListQuestionStatsByQuizID :many
+SELECT q.id                                             AS question_id,
+       CAST(COUNT(a.option_id) AS INTEGER)              AS answer_count,
+       CAST(COALESCE(SUM(CASE
+                             WHEN a.numeric_value IS NULL THEN o.is_correct
+                             WHEN a.numeric_value = o.numeric_value THEN 1
+                             WHEN a.numeric_value > o.numeric_value - o.tolerance_below
+                                 AND a.numeric_value < o.numeric_value + o.tolerance_above THEN 1
+                             ELSE 0 END), 0) AS INTEGER) AS correct_count
+FROM questions q
+         LEFT JOIN (SELECT gq.question_id, ga.option_id, ga.stats_epoch, ga.numeric_value
+                    FROM game_answers ga
+                             JOIN game_questions gq ON gq.id = ga.game_question_id
+                             JOIN games g ON g.id = ga.game_id
+                    WHERE g.is_preview = 0
+                    UNION ALL
+                    SELECT sa.question_id, sa.option_id, sa.stats_epoch, NULL
+                    FROM session_answers sa) a
+                   ON a.question_id = q.id AND a.stats_epoch = q.stats_epoch
+         LEFT JOIN options o ON o.id = a.option_id
+WHERE q.quiz_id = ?
+GROUP BY q.id
+ORDER BY q.position
+`
+
+type ListQuestionStatsByQuizIDRow struct {
+	QuestionID   int64
+	AnswerCount  int64
+	CorrectCount int64
+}
+
+// Per-question answer statistics for the quiz view: how many picks each
+// question has drawn and how many were correct, across real (non-preview)
+// solo games and hosted live games. Only picks stamped with the question's
+// current stats_epoch count. Anchored on questions with LEFT JOINs so an
+// unanswered question still comes back with zero counts. A numeric answer
+// counts as correct when it scores anything: within the key's tolerance, or
+// exact.
+func (q *Queries) ListQuestionStatsByQuizID(ctx context.Context, quizID int64) ([]ListQuestionStatsByQuizIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listQuestionStatsByQuizID, quizID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListQuestionStatsByQuizIDRow
+	for rows.Next() {
+		var i ListQuestionStatsByQuizIDRow
+		if err := rows.Scan(&i.QuestionID, &i.AnswerCount, &i.CorrectCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listQuestionsByQuizID = `-- name: ListQuestionsByQuizID :many
-SELECT id, quiz_id, round_id, text, position, time_limit_seconds, image_media_id, audio_media_id, audio_repeat
+SELECT id, quiz_id, round_id, text, position, time_limit_seconds, image_media_id, audio_media_id, audio_repeat, stats_epoch, kind, after_question_id, version, explanation, hint, external_ref
 FROM questions
 WHERE quiz_id = ?
-ORDER BY position
+ORDER BY position, id
 `
 
 func (q *Queries) ListQuestionsByQuizID(ctx context.Context, quizID int64) ([]Question, error) {
@@ -804,6 +1419,13 @@ func (q *Queries) ListQuestionsByQuizID(ctx context.Context, quizID int64) ([]Qu
 			&i.ImageMediaID,
 			&i.AudioMediaID,
 			&i.AudioRepeat,
+			&i.StatsEpoch,
+			&i.Kind,
+			&i.AfterQuestionID,
+			&i.Version,
+			&i.Explanation,
+			&i.Hint,
+			&i.ExternalRef,
 		); err != nil {
 			return nil, err
 		}
@@ -830,6 +1452,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -848,8 +1481,19 @@ type ListQuizzesRow struct {
 	CreatedByPlayerID    int64
 	TimeLimitSeconds     int64
 	Visibility           string
-	Mode                 string
+	Mode                 quiz.Mode
 	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
 	PlayCount            int64
 	Published            int64
 	CreatedByDisplayName string
@@ -889,6 +1533,17 @@ func (q *Queries) ListQuizzes(ctx context.Context) ([]ListQuizzesRow, error) {
 			&i.Visibility,
 			&i.Mode,
 			&i.Language,
+			&i.ShuffleQuestions,
+			&i.KeepOptionOrder,
+			&i.LateJoin,
+			&i.JoinDeadlineSeconds,
+			&i.MaxPlayers,
+			&i.ConfidenceWager,
+			&i.HostPaced,
+			&i.Scoring,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.ExternalRef,
 			&i.PlayCount,
 			&i.Published,
 			&i.CreatedByDisplayName,
@@ -918,6 +1573,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -937,8 +1603,19 @@ type ListQuizzesForOwnerRow struct {
 	CreatedByPlayerID    int64
 	TimeLimitSeconds     int64
 	Visibility           string
-	Mode                 string
+	Mode                 quiz.Mode
 	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
 	PlayCount            int64
 	Published            int64
 	CreatedByDisplayName string
@@ -968,6 +1645,145 @@ func (q *Queries) ListQuizzesForOwner(ctx context.Context, createdByPlayerID int
 			&i.Visibility,
 			&i.Mode,
 			&i.Language,
+			&i.ShuffleQuestions,
+			&i.KeepOptionOrder,
+			&i.LateJoin,
+			&i.JoinDeadlineSeconds,
+			&i.MaxPlayers,
+			&i.ConfidenceWager,
+			&i.HostPaced,
+			&i.Scoring,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.ExternalRef,
+			&i.PlayCount,
+			&i.Published,
+			&i.CreatedByDisplayName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listQuizzesPage = `-- name: ListQuizzesPage :many
+SELECT q.id,
+       q.title,
+       q.slug,
+       q.description,
+       q.created_at,
+       q.updated_at,
+       q.created_by_player_id,
+       q.time_limit_seconds,
+       q.visibility,
+       q.mode,
+       q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
+       q.play_count,
+       q.published,
+       p.display_name AS created_by_display_name
+FROM quizzes q
+         JOIN players p ON p.id = q.created_by_player_id
+WHERE (CAST(?1 AS INTEGER) = 0 OR q.created_by_player_id = CAST(?1 AS INTEGER))
+  AND (CAST(?2 AS TEXT) = '' OR q.mode = CAST(?2 AS TEXT))
+ORDER BY q.updated_at DESC, q.id DESC
+LIMIT ?4 OFFSET ?3
+`
+
+type ListQuizzesPageParams struct {
+	OwnerID   int64
+	Mode      string
+	RowOffset int64
+	RowLimit  int64
+}
+
+type ListQuizzesPageRow struct {
+	ID                   int64
+	Title                string
+	Slug                 string
+	Description          string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	CreatedByPlayerID    int64
+	TimeLimitSeconds     int64
+	Visibility           string
+	Mode                 quiz.Mode
+	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
+	PlayCount            int64
+	Published            int64
+	CreatedByDisplayName string
+}
+
+// Paged variant of ListQuizzes / ListQuizzesForOwner for the admin quiz list.
+// owner_id = 0 disables the owner scope (an Admin's view); mode = ” disables
+// the play-mode filter (the "All" tab). Same shape and order as ListQuizzes so
+// a page boundary never reshuffles rows; CountQuizzesPage totals the same
+// filter for the page navigation.
+func (q *Queries) ListQuizzesPage(ctx context.Context, arg ListQuizzesPageParams) ([]ListQuizzesPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listQuizzesPage,
+		arg.OwnerID,
+		arg.Mode,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListQuizzesPageRow
+	for rows.Next() {
+		var i ListQuizzesPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CreatedByPlayerID,
+			&i.TimeLimitSeconds,
+			&i.Visibility,
+			&i.Mode,
+			&i.Language,
+			&i.ShuffleQuestions,
+			&i.KeepOptionOrder,
+			&i.LateJoin,
+			&i.JoinDeadlineSeconds,
+			&i.MaxPlayers,
+			&i.ConfidenceWager,
+			&i.HostPaced,
+			&i.Scoring,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.ExternalRef,
 			&i.PlayCount,
 			&i.Published,
 			&i.CreatedByDisplayName,
@@ -1058,6 +1874,20 @@ func (q *Queries) QuestionCountsByQuiz(ctx context.Context) ([]QuestionCountsByQ
 	return items, nil
 }
 
+const questionVersion = `-- name: QuestionVersion :one
+SELECT version
+FROM questions
+WHERE id = ?
+`
+
+// The current optimistic-concurrency version of a question; see QuizVersion.
+func (q *Queries) QuestionVersion(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, questionVersion, id)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
 const quizExists = `-- name: QuizExists :one
 SELECT EXISTS(SELECT 1 FROM quizzes WHERE id = ?) AS quiz_exists
 `
@@ -1087,6 +1917,156 @@ func (q *Queries) QuizHasRealPlays(ctx context.Context, quizID int64) (bool, err
 	return has_plays, err
 }
 
+const quizVersion = `-- name: QuizVersion :one
+SELECT version
+FROM quizzes
+WHERE id = ?
+`
+
+// The current optimistic-concurrency version of a quiz, read after an
+// UpdateQuiz that changed nothing to tell a stale write from a missing row.
+func (q *Queries) QuizVersion(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, quizVersion, id)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
+const searchQuizzes = `-- name: SearchQuizzes :many
+SELECT q.id,
+       q.title,
+       q.slug,
+       q.description,
+       q.created_at,
+       q.updated_at,
+       q.created_by_player_id,
+       q.time_limit_seconds,
+       q.visibility,
+       q.mode,
+       q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
+       q.play_count,
+       q.published,
+       p.display_name AS created_by_display_name
+FROM quizzes_fts(CAST(?1 AS TEXT)) f
+         JOIN quizzes q ON q.id = f.rowid
+         JOIN players p ON p.id = q.created_by_player_id
+WHERE (CAST(?2 AS INTEGER) = 0 OR q.created_by_player_id = CAST(?2 AS INTEGER))
+  AND (CAST(?3 AS TEXT) = '' OR q.mode = CAST(?3 AS TEXT))
+  AND (CAST(?4 AS INTEGER) = 0
+    OR (q.visibility = 'public' AND q.mode = 'solo' AND q.published = 1))
+ORDER BY f.rank, q.updated_at DESC, q.id DESC
+LIMIT ?6 OFFSET ?5
+`
+
+type SearchQuizzesParams struct {
+	Query      string
+	OwnerID    int64
+	Mode       string
+	PublicOnly int64
+	RowOffset  int64
+	RowLimit   int64
+}
+
+type SearchQuizzesRow struct {
+	ID                   int64
+	Title                string
+	Slug                 string
+	Description          string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	CreatedByPlayerID    int64
+	TimeLimitSeconds     int64
+	Visibility           string
+	Mode                 quiz.Mode
+	Language             string
+	ShuffleQuestions     int64
+	KeepOptionOrder      int64
+	LateJoin             string
+	JoinDeadlineSeconds  int64
+	MaxPlayers           int64
+	ConfidenceWager      int64
+	HostPaced            int64
+	Scoring              string
+	WrongAnswerPenalty   int64
+	ScoreFloor           int64
+	ExternalRef          sql.NullString
+	PlayCount            int64
+	Published            int64
+	CreatedByDisplayName string
+}
+
+// One page of the quizzes whose title, description or slug match query, best
+// match first. query is an FTS5 expression the store builds from the search
+// box: lowercased terms, each a prefix ("cap* euro*"), all required. owner_id
+// and mode scope it as in ListQuizzesPage; public_only = 1 further keeps it
+// to what ListPublicQuizzes shows. CountSearchQuizzes totals the same filter.
+func (q *Queries) SearchQuizzes(ctx context.Context, arg SearchQuizzesParams) ([]SearchQuizzesRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchQuizzes,
+		arg.Query,
+		arg.OwnerID,
+		arg.Mode,
+		arg.PublicOnly,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchQuizzesRow
+	for rows.Next() {
+		var i SearchQuizzesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CreatedByPlayerID,
+			&i.TimeLimitSeconds,
+			&i.Visibility,
+			&i.Mode,
+			&i.Language,
+			&i.ShuffleQuestions,
+			&i.KeepOptionOrder,
+			&i.LateJoin,
+			&i.JoinDeadlineSeconds,
+			&i.MaxPlayers,
+			&i.ConfidenceWager,
+			&i.HostPaced,
+			&i.Scoring,
+			&i.WrongAnswerPenalty,
+			&i.ScoreFloor,
+			&i.ExternalRef,
+			&i.PlayCount,
+			&i.Published,
+			&i.CreatedByDisplayName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setQuestionMedia = `-- name: SetQuestionMedia :execresult
 UPDATE questions
 SET image_media_id = ?,
@@ -1118,9 +2098,14 @@ func (q *Queries) SetQuestionMedia(ctx context.Context, arg SetQuestionMediaPara
 
 const setQuizPublished = `-- name: SetQuizPublished :execresult
 UPDATE quizzes
-SET published  = ?,
-    updated_at = CURRENT_TIMESTAMP
-WHERE id = ?
+SET published    = ?1,
+    published_at = CASE
+                       WHEN ?1 = 0 THEN NULL
+                       WHEN published = 1 THEN published_at
+                       ELSE CURRENT_TIMESTAMP
+        END,
+    updated_at   = CURRENT_TIMESTAMP
+WHERE id = ?2
 `
 
 type SetQuizPublishedParams struct {
@@ -1129,14 +2114,16 @@ type SetQuizPublishedParams struct {
 }
 
 // Flips just the published flag without touching the question tree, so it cannot clobber a concurrent edit (#1192). Modelled on UpdateQuizMode.
+// published_at is stamped when a draft is published, kept when an already-published quiz is published again, and cleared on unpublish.
 func (q *Queries) SetQuizPublished(ctx context.Context, arg SetQuizPublishedParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, setQuizPublished, arg.Published, arg.ID)
 }
 
 const unpublishQuizIfUnplayed = `-- name: UnpublishQuizIfUnplayed :execresult
 UPDATE quizzes
-SET published  = 0,
-    updated_at = CURRENT_TIMESTAMP
+SET published    = 0,
+    published_at = NULL,
+    updated_at   = CURRENT_TIMESTAMP
 WHERE quizzes.id = ?
   AND NOT EXISTS (
       SELECT 1 FROM games WHERE quiz_id = quizzes.id AND is_preview = 0
@@ -1153,16 +2140,22 @@ func (q *Queries) UnpublishQuizIfUnplayed(ctx context.Context, id int64) (sql.Re
 const updateOption = `-- name: UpdateOption :execresult
 UPDATE options
 SET text = ?,
-    is_correct = ?
+    is_correct = ?,
+    numeric_value = ?,
+    tolerance_below = ?,
+    tolerance_above = ?
 WHERE id = ?
   AND question_id = ?
 `
 
 type UpdateOptionParams struct {
-	Text       string
-	IsCorrect  bool
-	ID         int64
-	QuestionID int64
+	Text           string
+	IsCorrect      bool
+	NumericValue   sql.NullFloat64
+	ToleranceBelow float64
+	ToleranceAbove float64
+	ID             int64
+	QuestionID     int64
 }
 
 // Scoped by question_id to keep the ownership boundary (#1165).
@@ -1170,6 +2163,9 @@ func (q *Queries) UpdateOption(ctx context.Context, arg UpdateOptionParams) (sql
 	return q.db.ExecContext(ctx, updateOption,
 		arg.Text,
 		arg.IsCorrect,
+		arg.NumericValue,
+		arg.ToleranceBelow,
+		arg.ToleranceAbove,
 		arg.ID,
 		arg.QuestionID,
 	)
@@ -1182,8 +2178,15 @@ SET text               = ?,
     image_media_id     = ?,
     audio_media_id     = ?,
     audio_repeat       = ?,
-    time_limit_seconds = ?
+    time_limit_seconds = ?,
+    kind               = ?,
+    after_question_id  = ?,
+    explanation        = ?,
+    hint               = ?,
+    external_ref       = ?,
+    version            = version + 1
 WHERE id = ?
+  AND version = ?
 `
 
 type UpdateQuestionParams struct {
@@ -1193,9 +2196,17 @@ type UpdateQuestionParams struct {
 	AudioMediaID     sql.NullInt64
 	AudioRepeat      int64
 	TimeLimitSeconds sql.NullInt64
+	Kind             string
+	AfterQuestionID  sql.NullInt64
+	Explanation      string
+	Hint             string
+	ExternalRef      sql.NullString
 	ID               int64
+	Version          int64
 }
 
+// Version-checked like UpdateQuiz; QuestionVersion tells a stale write from a
+// missing row.
 func (q *Queries) UpdateQuestion(ctx context.Context, arg UpdateQuestionParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, updateQuestion,
 		arg.Text,
@@ -1204,7 +2215,13 @@ func (q *Queries) UpdateQuestion(ctx context.Context, arg UpdateQuestionParams)
 		arg.AudioMediaID,
 		arg.AudioRepeat,
 		arg.TimeLimitSeconds,
+		arg.Kind,
+		arg.AfterQuestionID,
+		arg.Explanation,
+		arg.Hint,
+		arg.ExternalRef,
 		arg.ID,
+		arg.Version,
 	)
 }
 
@@ -1228,28 +2245,56 @@ func (q *Queries) UpdateQuestionPosition(ctx context.Context, arg UpdateQuestion
 
 const updateQuiz = `-- name: UpdateQuiz :execresult
 UPDATE quizzes
-SET title              = ?,
-    slug               = ?,
-    description        = ?,
-    time_limit_seconds = ?,
-    visibility         = ?,
-    mode               = ?,
-    language           = ?,
-    updated_at         = CURRENT_TIMESTAMP
+SET title                 = ?,
+    slug                  = ?,
+    description           = ?,
+    time_limit_seconds    = ?,
+    visibility            = ?,
+    mode                  = ?,
+    language              = ?,
+    shuffle_questions     = ?,
+    keep_option_order     = ?,
+    late_join             = ?,
+    join_deadline_seconds = ?,
+    max_players           = ?,
+    confidence_wager      = ?,
+    host_paced            = ?,
+    scoring               = ?,
+    wrong_answer_penalty  = ?,
+    score_floor           = ?,
+    external_ref          = ?,
+    updated_at            = CURRENT_TIMESTAMP,
+    version               = version + 1
 WHERE id = ?
+  AND version = ?
 `
 
 type UpdateQuizParams struct {
-	Title            string
-	Slug             string
-	Description      string
-	TimeLimitSeconds int64
-	Visibility       string
-	Mode             string
-	Language         string
-	ID               int64
+	Title               string
+	Slug                string
+	Description         string
+	TimeLimitSeconds    int64
+	Visibility          string
+	Mode                quiz.Mode
+	Language            string
+	ShuffleQuestions    int64
+	KeepOptionOrder     int64
+	LateJoin            string
+	JoinDeadlineSeconds int64
+	MaxPlayers          int64
+	ConfidenceWager     int64
+	HostPaced           int64
+	Scoring             string
+	WrongAnswerPenalty  int64
+	ScoreFloor          int64
+	ExternalRef         sql.NullString
+	ID                  int64
+	Version             int64
 }
 
+// Applies only while the row still has the version the caller read, and bumps
+// it; no row affected means the quiz is gone or was changed in between
+// (QuizVersion tells the two apart).
 func (q *Queries) UpdateQuiz(ctx context.Context, arg UpdateQuizParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, updateQuiz,
 		arg.Title,
@@ -1259,25 +2304,40 @@ func (q *Queries) UpdateQuiz(ctx context.Context, arg UpdateQuizParams) (sql.Res
 		arg.Visibility,
 		arg.Mode,
 		arg.Language,
+		arg.ShuffleQuestions,
+		arg.KeepOptionOrder,
+		arg.LateJoin,
+		arg.JoinDeadlineSeconds,
+		arg.MaxPlayers,
+		arg.ConfidenceWager,
+		arg.HostPaced,
+		arg.Scoring,
+		arg.WrongAnswerPenalty,
+		arg.ScoreFloor,
+		arg.ExternalRef,
 		arg.ID,
+		arg.Version,
 	)
 }
 
 const updateQuizMode = `-- name: UpdateQuizMode :execresult
 UPDATE quizzes
 SET mode       = ?,
-    updated_at = CURRENT_TIMESTAMP
+    updated_at = CURRENT_TIMESTAMP,
+    version    = version + 1
 WHERE id = ?
 `
 
 type UpdateQuizModeParams struct {
-	Mode string
+	Mode quiz.Mode
 	ID   int64
 }
 
 // Flips just the play mode without touching the question tree, so the
 // solo/live toggle (#830) cannot clobber a concurrent question edit the way
-// the full UpdateQuiz would.
+// the full UpdateQuiz would. It bumps the version all the same, since the
+// edit form carries the mode: a form opened before the toggle must not save
+// the old mode back over it.
 func (q *Queries) UpdateQuizMode(ctx context.Context, arg UpdateQuizModeParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, updateQuizMode, arg.Mode, arg.ID)
 }
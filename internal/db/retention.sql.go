@@ -187,6 +187,46 @@ func (q *Queries) ListGameIDsForPlayers(ctx context.Context, playerIds []int64)
 	return items, nil
 }
 
+const listNeverStartedGameIDs = `-- name: ListNeverStartedGameIDs :many
+SELECT g.id
+FROM games g
+WHERE g.status = 'in_progress'
+  AND g.created_at < datetime('now', '-' || CAST(?1 AS INTEGER) || ' minutes')
+  AND NOT EXISTS (SELECT 1 FROM game_questions gq WHERE gq.game_id = g.id)
+  AND NOT EXISTS (SELECT 1 FROM game_answers ga WHERE ga.game_id = g.id)
+`
+
+// Lists ids of in-progress games created more than the given number of
+// minutes ago that never saw any play: no question was ever issued and no
+// answer recorded. These are the games a double-submitted create leaves
+// behind (the 409 race) or a player who opened a quiz and walked away; they
+// hold nothing worth keeping, so they are pruned long before the 30-day
+// abandoned-game window. As with the day windows, the cutoff is computed in
+// SQL (datetime('now', '-<minutes> minutes')) so the comparison stays in the
+// CURRENT_TIMESTAMP text encoding rows are minted with.
+func (q *Queries) ListNeverStartedGameIDs(ctx context.Context, minutes int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listNeverStartedGameIDs, minutes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStaleAnonymousPlayerIDs = `-- name: ListStaleAnonymousPlayerIDs :many
 SELECT p.id
 FROM players p
@@ -48,6 +48,28 @@ func (q *Queries) CancelSessionStart(ctx context.Context, id string) (sql.Result
 	return q.db.ExecContext(ctx, cancelSessionStart, id)
 }
 
+const closeSessionAnswers = `-- name: CloseSessionAnswers :execresult
+UPDATE sessions
+SET answers_closed_at = ?1
+WHERE id = ?2
+  AND phase = 'question'
+  AND current_question_id = ?3
+  AND answers_closed_at IS NULL
+`
+
+type CloseSessionAnswersParams struct {
+	AnswersClosedAt   sql.NullTime
+	ID                string
+	CurrentQuestionID sql.NullInt64
+}
+
+// Closes the open question's answering early (the host "close answering"
+// control), leaving question_expires_at as the window the picks are scored
+// against. Scoped like ExtendSessionQuestion; a second close matches no row.
+func (q *Queries) CloseSessionAnswers(ctx context.Context, arg CloseSessionAnswersParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, closeSessionAnswers, arg.AnswersClosedAt, arg.ID, arg.CurrentQuestionID)
+}
+
 const countActivePlayersForSession = `-- name: CountActivePlayersForSession :one
 SELECT count(*) AS active_count
 FROM session_players sp
@@ -140,7 +162,7 @@ func (q *Queries) CountSessionAnswersForQuestion(ctx context.Context, arg CountS
 const createSession = `-- name: CreateSession :one
 INSERT INTO sessions (id, quiz_id, host_player_id, join_code)
 VALUES (?1, ?2, ?3, ?4)
-RETURNING id, quiz_id, host_player_id, join_code, phase, game_seq, current_round_id, current_question_id, question_started_at, question_expires_at, created_at, started_at, finished_at, host_last_seen_at, start_at
+RETURNING id, quiz_id, host_player_id, join_code, phase, game_seq, current_round_id, current_question_id, question_started_at, question_expires_at, created_at, started_at, finished_at, host_last_seen_at, start_at, host_advance, answers_closed_at
 `
 
 type CreateSessionParams struct {
@@ -181,12 +203,37 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 		&i.FinishedAt,
 		&i.HostLastSeenAt,
 		&i.StartAt,
+		&i.HostAdvance,
+		&i.AnswersClosedAt,
 	)
 	return i, err
 }
 
+const extendSessionQuestion = `-- name: ExtendSessionQuestion :execresult
+UPDATE sessions
+SET question_expires_at = ?1
+WHERE id = ?2
+  AND phase = 'question'
+  AND current_question_id = ?3
+  AND answers_closed_at IS NULL
+`
+
+type ExtendSessionQuestionParams struct {
+	QuestionExpiresAt sql.NullTime
+	ID                string
+	CurrentQuestionID sql.NullInt64
+}
+
+// Moves the open question's answer deadline (the host "extend" control).
+// Scoped to the question phase and the question the host extended, so an
+// extend that loses the race with the runner closing the question (or one sent
+// from a stale tab for an earlier question) matches no row.
+func (q *Queries) ExtendSessionQuestion(ctx context.Context, arg ExtendSessionQuestionParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, extendSessionQuestion, arg.QuestionExpiresAt, arg.ID, arg.CurrentQuestionID)
+}
+
 const getActiveSessionForHost = `-- name: GetActiveSessionForHost :one
-SELECT id, quiz_id, host_player_id, join_code, phase, game_seq, current_round_id, current_question_id, question_started_at, question_expires_at, created_at, started_at, finished_at, host_last_seen_at, start_at
+SELECT id, quiz_id, host_player_id, join_code, phase, game_seq, current_round_id, current_question_id, question_started_at, question_expires_at, created_at, started_at, finished_at, host_last_seen_at, start_at, host_advance, answers_closed_at
 FROM sessions
 WHERE host_player_id = ?
   AND phase != 'finished'
@@ -219,12 +266,14 @@ func (q *Queries) GetActiveSessionForHost(ctx context.Context, hostPlayerID int6
 		&i.FinishedAt,
 		&i.HostLastSeenAt,
 		&i.StartAt,
+		&i.HostAdvance,
+		&i.AnswersClosedAt,
 	)
 	return i, err
 }
 
 const getSession = `-- name: GetSession :one
-SELECT id, quiz_id, host_player_id, join_code, phase, game_seq, current_round_id, current_question_id, question_started_at, question_expires_at, created_at, started_at, finished_at, host_last_seen_at, start_at
+SELECT id, quiz_id, host_player_id, join_code, phase, game_seq, current_round_id, current_question_id, question_started_at, question_expires_at, created_at, started_at, finished_at, host_last_seen_at, start_at, host_advance, answers_closed_at
 FROM sessions
 WHERE id = ?
 `
@@ -248,12 +297,14 @@ func (q *Queries) GetSession(ctx context.Context, id string) (Session, error) {
 		&i.FinishedAt,
 		&i.HostLastSeenAt,
 		&i.StartAt,
+		&i.HostAdvance,
+		&i.AnswersClosedAt,
 	)
 	return i, err
 }
 
 const getSessionByJoinCode = `-- name: GetSessionByJoinCode :one
-SELECT id, quiz_id, host_player_id, join_code, phase, game_seq, current_round_id, current_question_id, question_started_at, question_expires_at, created_at, started_at, finished_at, host_last_seen_at, start_at
+SELECT id, quiz_id, host_player_id, join_code, phase, game_seq, current_round_id, current_question_id, question_started_at, question_expires_at, created_at, started_at, finished_at, host_last_seen_at, start_at, host_advance, answers_closed_at
 FROM sessions
 WHERE join_code = ?
 `
@@ -279,12 +330,14 @@ func (q *Queries) GetSessionByJoinCode(ctx context.Context, joinCode string) (Se
 		&i.FinishedAt,
 		&i.HostLastSeenAt,
 		&i.StartAt,
+		&i.HostAdvance,
+		&i.AnswersClosedAt,
 	)
 	return i, err
 }
 
 const getSessionPlayer = `-- name: GetSessionPlayer :one
-SELECT id, session_id, player_id, is_ready, joined_at, last_seen_at, left_at
+SELECT id, session_id, player_id, is_ready, joined_at, last_seen_at, left_at, notes
 FROM session_players
 WHERE session_id = ?
   AND player_id = ?
@@ -306,6 +359,7 @@ func (q *Queries) GetSessionPlayer(ctx context.Context, arg GetSessionPlayerPara
 		&i.JoinedAt,
 		&i.LastSeenAt,
 		&i.LeftAt,
+		&i.Notes,
 	)
 	return i, err
 }
@@ -337,6 +391,33 @@ func (q *Queries) GetSessionPlayerScore(ctx context.Context, arg GetSessionPlaye
 	return total_score, err
 }
 
+const insertSessionLateJoin = `-- name: InsertSessionLateJoin :exec
+INSERT INTO session_late_joins (session_id, player_id, game_seq, missed_questions)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (session_id, player_id, game_seq) DO NOTHING
+`
+
+type InsertSessionLateJoinParams struct {
+	SessionID       string
+	PlayerID        int64
+	GameSeq         int64
+	MissedQuestions int64
+}
+
+// Records that a player joined the room's current game after it started, with
+// how many questions had already closed. Keyed on the game (game_seq), so the
+// mark does not follow the player into the next game; DO NOTHING keeps the
+// first join's count should the same game record the player twice.
+func (q *Queries) InsertSessionLateJoin(ctx context.Context, arg InsertSessionLateJoinParams) error {
+	_, err := q.db.ExecContext(ctx, insertSessionLateJoin,
+		arg.SessionID,
+		arg.PlayerID,
+		arg.GameSeq,
+		arg.MissedQuestions,
+	)
+	return err
+}
+
 const joinCodeExists = `-- name: JoinCodeExists :one
 SELECT EXISTS(SELECT 1 FROM sessions WHERE join_code = ?) AS code_exists
 `
@@ -458,6 +539,86 @@ func (q *Queries) ListLiveSessionIDs(ctx context.Context) ([]string, error) {
 	return items, nil
 }
 
+const listScoredSessionAnswersForQuiz = `-- name: ListScoredSessionAnswersForQuiz :many
+SELECT sa.id,
+       sa.session_id,
+       s.join_code,
+       sa.game_seq,
+       sa.question_id,
+       sa.player_id,
+       CAST(p.display_name AS TEXT) AS display_name,
+       sa.answered_at,
+       sa.score,
+       sa.window_started_at,
+       sa.window_expires_at,
+       o.is_correct
+FROM session_answers sa
+         JOIN sessions s ON s.id = sa.session_id
+         JOIN questions q ON q.id = sa.question_id
+         JOIN options o ON o.id = sa.option_id
+         JOIN players p ON p.id = sa.player_id
+WHERE q.quiz_id = ?1
+  AND sa.score IS NOT NULL
+ORDER BY s.join_code, sa.game_seq, sa.question_id, sa.answered_at, sa.id
+`
+
+type ListScoredSessionAnswersForQuizRow struct {
+	ID              int64
+	SessionID       string
+	JoinCode        string
+	GameSeq         int64
+	QuestionID      int64
+	PlayerID        int64
+	DisplayName     string
+	AnsweredAt      time.Time
+	Score           sql.NullInt64
+	WindowStartedAt sql.NullTime
+	WindowExpiresAt sql.NullTime
+	IsCorrect       bool
+}
+
+// Every scored live pick on the quiz's questions, across every room and game
+// that ran it, for the score recalculation tool. Scoped by the question's quiz
+// rather than sessions.quiz_id, which moves when a room switches quizzes between
+// games. is_correct is the chosen option's CURRENT flag, so a corrected answer
+// key is picked up; the window columns are NULL on picks scored before the
+// window was recorded.
+func (q *Queries) ListScoredSessionAnswersForQuiz(ctx context.Context, quizID int64) ([]ListScoredSessionAnswersForQuizRow, error) {
+	rows, err := q.db.QueryContext(ctx, listScoredSessionAnswersForQuiz, quizID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScoredSessionAnswersForQuizRow
+	for rows.Next() {
+		var i ListScoredSessionAnswersForQuizRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.JoinCode,
+			&i.GameSeq,
+			&i.QuestionID,
+			&i.PlayerID,
+			&i.DisplayName,
+			&i.AnsweredAt,
+			&i.Score,
+			&i.WindowStartedAt,
+			&i.WindowExpiresAt,
+			&i.IsCorrect,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSessionAnswersForQuestion = `-- name: ListSessionAnswersForQuestion :many
 SELECT sa.player_id,
        sa.option_id,
@@ -526,7 +687,10 @@ func (q *Queries) ListSessionAnswersForQuestion(ctx context.Context, arg ListSes
 const listSessionFinalStandings = `-- name: ListSessionFinalStandings :many
 SELECT sp.player_id                              AS player_id,
        CAST(p.display_name AS TEXT)              AS display_name,
-       CAST(COALESCE(SUM(sa.score), 0) AS INTEGER) AS total_score
+       CAST(COALESCE(SUM(sa.score), 0) AS INTEGER) AS total_score,
+       CAST(COALESCE((SELECT lj.missed_questions FROM session_late_joins lj
+                      WHERE lj.session_id = sp.session_id AND lj.player_id = sp.player_id
+                        AND lj.game_seq = (SELECT s.game_seq FROM sessions s WHERE s.id = sp.session_id)), 0) AS INTEGER) AS missed_questions
 FROM session_players sp
          JOIN players p ON p.id = sp.player_id
          LEFT JOIN session_answers sa
@@ -542,9 +706,10 @@ ORDER BY total_score DESC, p.display_name
 `
 
 type ListSessionFinalStandingsRow struct {
-	PlayerID    int64
-	DisplayName string
-	TotalScore  int64
+	PlayerID        int64
+	DisplayName     string
+	TotalScore      int64
+	MissedQuestions int64
 }
 
 // Per-player final standings for a session: cumulative score across the whole
@@ -557,7 +722,9 @@ type ListSessionFinalStandingsRow struct {
 // board is a stable record of everyone who played, so a player who answered and
 // then closed their browser keeps their score across a TV refresh (#766).
 // Someone who left during the lobby/intro without ever answering never played
-// and so does not appear.
+// and so does not appear. missed_questions is how many questions had closed
+// before the player joined this game late (0 for everyone who was there from
+// the start), for the results footnote.
 func (q *Queries) ListSessionFinalStandings(ctx context.Context, sessionID string) ([]ListSessionFinalStandingsRow, error) {
 	rows, err := q.db.QueryContext(ctx, listSessionFinalStandings, sessionID)
 	if err != nil {
@@ -567,7 +734,12 @@ func (q *Queries) ListSessionFinalStandings(ctx context.Context, sessionID strin
 	var items []ListSessionFinalStandingsRow
 	for rows.Next() {
 		var i ListSessionFinalStandingsRow
-		if err := rows.Scan(&i.PlayerID, &i.DisplayName, &i.TotalScore); err != nil {
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.DisplayName,
+			&i.TotalScore,
+			&i.MissedQuestions,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -589,7 +761,8 @@ SELECT sp.id,
        sp.is_ready,
        sp.joined_at,
        sp.last_seen_at,
-       sp.left_at
+       sp.left_at,
+       sp.notes
 FROM session_players sp
          JOIN players p ON p.id = sp.player_id
 WHERE sp.session_id = ?
@@ -606,6 +779,7 @@ type ListSessionPlayersRow struct {
 	JoinedAt    time.Time
 	LastSeenAt  time.Time
 	LeftAt      sql.NullTime
+	Notes       string
 }
 
 // The lobby roster in join order. Excludes players who have left (left_at
@@ -630,6 +804,7 @@ func (q *Queries) ListSessionPlayers(ctx context.Context, sessionID string) ([]L
 			&i.JoinedAt,
 			&i.LastSeenAt,
 			&i.LeftAt,
+			&i.Notes,
 		); err != nil {
 			return nil, err
 		}
@@ -644,11 +819,58 @@ func (q *Queries) ListSessionPlayers(ctx context.Context, sessionID string) ([]L
 	return items, nil
 }
 
+const listSessionQuestionPlan = `-- name: ListSessionQuestionPlan :many
+SELECT question_id, position, skipped
+FROM session_question_plan
+WHERE session_id = ?
+  AND game_seq = ?
+ORDER BY position, question_id
+`
+
+type ListSessionQuestionPlanParams struct {
+	SessionID string
+	GameSeq   int64
+}
+
+type ListSessionQuestionPlanRow struct {
+	QuestionID int64
+	Position   int64
+	Skipped    int64
+}
+
+// The host's reorders and skips for the room's game game_seq, in reorder
+// order. The runner applies them over the quiz's own play order.
+func (q *Queries) ListSessionQuestionPlan(ctx context.Context, arg ListSessionQuestionPlanParams) ([]ListSessionQuestionPlanRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionQuestionPlan, arg.SessionID, arg.GameSeq)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSessionQuestionPlanRow
+	for rows.Next() {
+		var i ListSessionQuestionPlanRow
+		if err := rows.Scan(&i.QuestionID, &i.Position, &i.Skipped); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSessionStandings = `-- name: ListSessionStandings :many
 SELECT sp.player_id                 AS player_id,
        CAST(p.display_name AS TEXT) AS display_name,
        CAST(COALESCE(SUM(CASE WHEN q.round_id = ?1 THEN sa.score END), 0) AS INTEGER) AS round_score,
-       CAST(COALESCE(SUM(sa.score), 0) AS INTEGER)                                                      AS total_score
+       CAST(COALESCE(SUM(sa.score), 0) AS INTEGER)                                                      AS total_score,
+       CAST(COALESCE((SELECT lj.missed_questions FROM session_late_joins lj
+                      WHERE lj.session_id = sp.session_id AND lj.player_id = sp.player_id
+                        AND lj.game_seq = (SELECT s.game_seq FROM sessions s WHERE s.id = sp.session_id)), 0) AS INTEGER) AS missed_questions
 FROM session_players sp
          JOIN players p ON p.id = sp.player_id
          LEFT JOIN session_answers sa
@@ -670,10 +892,11 @@ type ListSessionStandingsParams struct {
 }
 
 type ListSessionStandingsRow struct {
-	PlayerID    int64
-	DisplayName string
-	RoundScore  int64
-	TotalScore  int64
+	PlayerID        int64
+	DisplayName     string
+	RoundScore      int64
+	TotalScore      int64
+	MissedQuestions int64
 }
 
 // Per-player standings for a session: the score the player earned in the given
@@ -689,7 +912,9 @@ type ListSessionStandingsRow struct {
 // answer): the standings are a stable record of everyone who played, so a player
 // who answered and then closed their browser keeps their score on the board
 // across a TV refresh (#766). Someone who left during the lobby/intro without
-// ever answering never played and so does not appear.
+// ever answering never played and so does not appear. missed_questions is how
+// many questions had closed before a late joiner joined this game (0 for
+// everyone who was there from the start).
 func (q *Queries) ListSessionStandings(ctx context.Context, arg ListSessionStandingsParams) ([]ListSessionStandingsRow, error) {
 	rows, err := q.db.QueryContext(ctx, listSessionStandings, arg.RoundID, arg.SessionID)
 	if err != nil {
@@ -704,6 +929,7 @@ func (q *Queries) ListSessionStandings(ctx context.Context, arg ListSessionStand
 			&i.DisplayName,
 			&i.RoundScore,
 			&i.TotalScore,
+			&i.MissedQuestions,
 		); err != nil {
 			return nil, err
 		}
@@ -752,6 +978,7 @@ SET quiz_id             = ?1,
     current_question_id = NULL,
     question_started_at = NULL,
     question_expires_at = NULL,
+    answers_closed_at   = NULL,
     started_at          = NULL,
     finished_at         = NULL,
     start_at            = NULL
@@ -823,26 +1050,33 @@ func (q *Queries) ResetSessionPlayersReady(ctx context.Context, sessionID string
 
 const setSessionAnswerScore = `-- name: SetSessionAnswerScore :exec
 UPDATE session_answers
-SET score = ?1
-WHERE session_id = ?2
-  AND question_id = ?3
-  AND player_id = ?4
-  AND game_seq = (SELECT s.game_seq FROM sessions s WHERE s.id = ?2)
+SET score             = ?1,
+    window_started_at = ?2,
+    window_expires_at = ?3
+WHERE session_id = ?4
+  AND question_id = ?5
+  AND player_id = ?6
+  AND game_seq = (SELECT s.game_seq FROM sessions s WHERE s.id = ?4)
 `
 
 type SetSessionAnswerScoreParams struct {
-	Score      sql.NullInt64
-	SessionID  string
-	QuestionID int64
-	PlayerID   int64
+	Score           sql.NullInt64
+	WindowStartedAt sql.NullTime
+	WindowExpiresAt sql.NullTime
+	SessionID       string
+	QuestionID      int64
+	PlayerID        int64
 }
 
 // Writes the computed score for one pick at question close, scoped to the room's
 // current game (#836) so scoring a re-run does not overwrite the previous game's
-// recorded score for the same question/player.
+// recorded score for the same question/player. The answer window the score was
+// computed against is stored alongside it so a later recalculation can replay it.
 func (q *Queries) SetSessionAnswerScore(ctx context.Context, arg SetSessionAnswerScoreParams) error {
 	_, err := q.db.ExecContext(ctx, setSessionAnswerScore,
 		arg.Score,
+		arg.WindowStartedAt,
+		arg.WindowExpiresAt,
 		arg.SessionID,
 		arg.QuestionID,
 		arg.PlayerID,
@@ -856,6 +1090,7 @@ SET phase               = 'finished',
     current_question_id = NULL,
     question_started_at = NULL,
     question_expires_at = NULL,
+    answers_closed_at   = NULL,
     finished_at         = CURRENT_TIMESTAMP
 WHERE id = ?
 `
@@ -867,12 +1102,31 @@ func (q *Queries) SetSessionFinished(ctx context.Context, id string) error {
 	return err
 }
 
+const setSessionHostAdvance = `-- name: SetSessionHostAdvance :execresult
+UPDATE sessions
+SET host_advance = ?1
+WHERE id = ?2
+  AND phase <> 'finished'
+`
+
+type SetSessionHostAdvanceParams struct {
+	HostAdvance int64
+	ID          string
+}
+
+// Turns the host's pacing of the room on or off (the host "pacing" control).
+// A closed room matches no row.
+func (q *Queries) SetSessionHostAdvance(ctx context.Context, arg SetSessionHostAdvanceParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, setSessionHostAdvance, arg.HostAdvance, arg.ID)
+}
+
 const setSessionIntermission = `-- name: SetSessionIntermission :execresult
 UPDATE sessions
 SET phase               = 'intermission',
     current_question_id = NULL,
     question_started_at = NULL,
     question_expires_at = NULL,
+    answers_closed_at   = NULL,
     finished_at         = CURRENT_TIMESTAMP
 WHERE id = ?
   AND phase NOT IN ('intermission', 'finished')
@@ -890,6 +1144,27 @@ func (q *Queries) SetSessionIntermission(ctx context.Context, id string) (sql.Re
 	return q.db.ExecContext(ctx, setSessionIntermission, id)
 }
 
+const setSessionPlayerNotes = `-- name: SetSessionPlayerNotes :execresult
+UPDATE session_players
+SET notes = ?
+WHERE session_id = ?
+  AND player_id = ?
+`
+
+type SetSessionPlayerNotesParams struct {
+	Notes     string
+	SessionID string
+	PlayerID  int64
+}
+
+// Replaces the host's notes on one participant. Unlike the ready toggle it
+// leaves last_seen_at alone: the host wrote it, not the player. A player who
+// has left keeps their row, so their notes can still be edited for the
+// export; the execresult maps zero rows to "not a participant".
+func (q *Queries) SetSessionPlayerNotes(ctx context.Context, arg SetSessionPlayerNotesParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, setSessionPlayerNotes, arg.Notes, arg.SessionID, arg.PlayerID)
+}
+
 const setSessionPlayerReady = `-- name: SetSessionPlayerReady :execresult
 UPDATE session_players
 SET is_ready     = ?,
@@ -918,7 +1193,8 @@ SET phase               = 'question',
     current_round_id    = ?1,
     current_question_id = ?2,
     question_started_at = ?3,
-    question_expires_at = ?4
+    question_expires_at = ?4,
+    answers_closed_at   = NULL
 WHERE id = ?5
   AND phase = ?6
 `
@@ -974,7 +1250,8 @@ SET phase               = 'round_intro',
     current_round_id    = ?1,
     current_question_id = NULL,
     question_started_at = NULL,
-    question_expires_at = NULL
+    question_expires_at = NULL,
+    answers_closed_at   = NULL
 WHERE id = ?2
   AND phase = ?3
 `
@@ -998,7 +1275,8 @@ UPDATE sessions
 SET phase               = 'round_results',
     current_question_id = NULL,
     question_started_at = NULL,
-    question_expires_at = NULL
+    question_expires_at = NULL,
+    answers_closed_at   = NULL
 WHERE id = ?1
   AND phase = ?2
 `
@@ -1017,6 +1295,25 @@ func (q *Queries) SetSessionRoundResults(ctx context.Context, arg SetSessionRoun
 	return q.db.ExecContext(ctx, setSessionRoundResults, arg.ID, arg.ExpectedPhase)
 }
 
+const skipSessionQuestion = `-- name: SkipSessionQuestion :exec
+INSERT INTO session_question_plan (session_id, game_seq, question_id, skipped)
+VALUES (?, ?, ?, 1)
+ON CONFLICT (session_id, game_seq, question_id) DO UPDATE SET skipped = 1
+`
+
+type SkipSessionQuestionParams struct {
+	SessionID  string
+	GameSeq    int64
+	QuestionID int64
+}
+
+// Drops a remaining question from the room's game game_seq. Skipping it
+// again is a no-op.
+func (q *Queries) SkipSessionQuestion(ctx context.Context, arg SkipSessionQuestionParams) error {
+	_, err := q.db.ExecContext(ctx, skipSessionQuestion, arg.SessionID, arg.GameSeq, arg.QuestionID)
+	return err
+}
+
 const startSession = `-- name: StartSession :execresult
 UPDATE sessions
 SET started_at = CURRENT_TIMESTAMP
@@ -1069,14 +1366,33 @@ func (q *Queries) TouchSessionPlayerLastSeen(ctx context.Context, arg TouchSessi
 	return q.db.ExecContext(ctx, touchSessionPlayerLastSeen, arg.PlayerID, arg.JoinCode)
 }
 
+const updateSessionAnswerScore = `-- name: UpdateSessionAnswerScore :exec
+UPDATE session_answers
+SET score = ?1
+WHERE id = ?2
+`
+
+type UpdateSessionAnswerScoreParams struct {
+	Score sql.NullInt64
+	ID    int64
+}
+
+// Overwrites one stored pick's score by id. Used by the score recalculation
+// tool when applying a recomputed score; the window is left as recorded.
+func (q *Queries) UpdateSessionAnswerScore(ctx context.Context, arg UpdateSessionAnswerScoreParams) error {
+	_, err := q.db.ExecContext(ctx, updateSessionAnswerScore, arg.Score, arg.ID)
+	return err
+}
+
 const upsertSessionAnswer = `-- name: UpsertSessionAnswer :exec
-INSERT INTO session_answers (session_id, question_id, player_id, option_id, answered_at, game_seq)
+INSERT INTO session_answers (session_id, question_id, player_id, option_id, answered_at, game_seq, stats_epoch)
 VALUES (?1,
         ?2,
         ?3,
         ?4,
         ?5,
-        (SELECT game_seq FROM sessions WHERE id = ?1))
+        (SELECT game_seq FROM sessions WHERE id = ?1),
+        (SELECT stats_epoch FROM questions WHERE id = ?2))
 ON CONFLICT (session_id, question_id, player_id, game_seq)
     DO UPDATE SET option_id   = excluded.option_id,
                   answered_at = excluded.answered_at
@@ -1097,6 +1413,8 @@ type UpsertSessionAnswerParams struct {
 // player_id, game_seq): a re-submit within the same game overwrites the option
 // and timestamp rather than duplicating, so a double-tap before close is the
 // last pick rather than an error. score stays NULL until the question closes.
+// stats_epoch is copied from the question so the per-question statistics
+// only count picks made since its last reset.
 func (q *Queries) UpsertSessionAnswer(ctx context.Context, arg UpsertSessionAnswerParams) error {
 	_, err := q.db.ExecContext(ctx, upsertSessionAnswer,
 		arg.SessionID,
@@ -1114,7 +1432,7 @@ VALUES (?, ?)
 ON CONFLICT (session_id, player_id)
     DO UPDATE SET left_at      = NULL,
                   last_seen_at = CURRENT_TIMESTAMP
-RETURNING id, session_id, player_id, is_ready, joined_at, last_seen_at, left_at
+RETURNING id, session_id, player_id, is_ready, joined_at, last_seen_at, left_at, notes
 `
 
 type UpsertSessionPlayerParams struct {
@@ -1139,6 +1457,33 @@ func (q *Queries) UpsertSessionPlayer(ctx context.Context, arg UpsertSessionPlay
 		&i.JoinedAt,
 		&i.LastSeenAt,
 		&i.LeftAt,
+		&i.Notes,
 	)
 	return i, err
 }
+
+const upsertSessionQuestionPosition = `-- name: UpsertSessionQuestionPosition :exec
+INSERT INTO session_question_plan (session_id, game_seq, question_id, position)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (session_id, game_seq, question_id) DO UPDATE SET position = excluded.position
+`
+
+type UpsertSessionQuestionPositionParams struct {
+	SessionID  string
+	GameSeq    int64
+	QuestionID int64
+	Position   int64
+}
+
+// Places a remaining question of the room's game game_seq at position in the
+// host's new order. Leaves skipped alone: only questions still in play are
+// reordered.
+func (q *Queries) UpsertSessionQuestionPosition(ctx context.Context, arg UpsertSessionQuestionPositionParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSessionQuestionPosition,
+		arg.SessionID,
+		arg.GameSeq,
+		arg.QuestionID,
+		arg.Position,
+	)
+	return err
+}
@@ -0,0 +1,291 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: tournaments.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const addTournamentPlayer = `-- name: AddTournamentPlayer :exec
+INSERT INTO tournament_players (tournament_id, player_id)
+VALUES (?, ?)
+ON CONFLICT (tournament_id, player_id) DO NOTHING
+`
+
+type AddTournamentPlayerParams struct {
+	TournamentID int64
+	PlayerID     int64
+}
+
+// Idempotent join: a second join with the same code keeps the original
+// joined_at.
+func (q *Queries) AddTournamentPlayer(ctx context.Context, arg AddTournamentPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, addTournamentPlayer, arg.TournamentID, arg.PlayerID)
+	return err
+}
+
+const addTournamentStage = `-- name: AddTournamentStage :exec
+INSERT INTO tournament_stages (tournament_id, quiz_id, position)
+VALUES (
+    ?1,
+    ?2,
+    (SELECT COALESCE(MAX(position), 0) + 1 FROM tournament_stages WHERE tournament_id = ?1)
+)
+`
+
+type AddTournamentStageParams struct {
+	TournamentID int64
+	QuizID       int64
+}
+
+// Appends the quiz as the tournament's next stage. The position is computed
+// in the same statement so two concurrent appends cannot pick the same slot
+// silently: the loser trips UNIQUE (tournament_id, position) instead.
+func (q *Queries) AddTournamentStage(ctx context.Context, arg AddTournamentStageParams) error {
+	_, err := q.db.ExecContext(ctx, addTournamentStage, arg.TournamentID, arg.QuizID)
+	return err
+}
+
+const createTournament = `-- name: CreateTournament :one
+INSERT INTO tournaments (title, join_code, created_by_player_id)
+VALUES (?1, ?2, ?3)
+RETURNING id, title, join_code, created_by_player_id, created_at
+`
+
+type CreateTournamentParams struct {
+	Title             string
+	JoinCode          string
+	CreatedByPlayerID sql.NullInt64
+}
+
+// Inserts a tournament with the join code the service allocated. A UNIQUE
+// violation on join_code is the loser of the probe race; the store maps it to
+// tournament.ErrJoinCodeUnavailable.
+func (q *Queries) CreateTournament(ctx context.Context, arg CreateTournamentParams) (Tournament, error) {
+	row := q.db.QueryRowContext(ctx, createTournament, arg.Title, arg.JoinCode, arg.CreatedByPlayerID)
+	var i Tournament
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.JoinCode,
+		&i.CreatedByPlayerID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTournament = `-- name: GetTournament :one
+SELECT id, title, join_code, created_by_player_id, created_at
+FROM tournaments
+WHERE id = ?
+`
+
+func (q *Queries) GetTournament(ctx context.Context, id int64) (Tournament, error) {
+	row := q.db.QueryRowContext(ctx, getTournament, id)
+	var i Tournament
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.JoinCode,
+		&i.CreatedByPlayerID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTournamentByJoinCode = `-- name: GetTournamentByJoinCode :one
+SELECT id, title, join_code, created_by_player_id, created_at
+FROM tournaments
+WHERE join_code = ?
+`
+
+func (q *Queries) GetTournamentByJoinCode(ctx context.Context, joinCode string) (Tournament, error) {
+	row := q.db.QueryRowContext(ctx, getTournamentByJoinCode, joinCode)
+	var i Tournament
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.JoinCode,
+		&i.CreatedByPlayerID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listTournamentPlayers = `-- name: ListTournamentPlayers :many
+SELECT tp.player_id    AS player_id,
+       p.display_name  AS display_name,
+       tp.joined_at    AS joined_at
+FROM tournament_players tp
+         JOIN players p ON p.id = tp.player_id
+WHERE tp.tournament_id = ?
+ORDER BY tp.joined_at, tp.player_id
+`
+
+type ListTournamentPlayersRow struct {
+	PlayerID    int64
+	DisplayName string
+	JoinedAt    time.Time
+}
+
+// Members in join order with their current display name, so a rename shows up
+// in the standings.
+func (q *Queries) ListTournamentPlayers(ctx context.Context, tournamentID int64) ([]ListTournamentPlayersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTournamentPlayers, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTournamentPlayersRow
+	for rows.Next() {
+		var i ListTournamentPlayersRow
+		if err := rows.Scan(&i.PlayerID, &i.DisplayName, &i.JoinedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTournamentStages = `-- name: ListTournamentStages :many
+SELECT ts.quiz_id  AS quiz_id,
+       ts.position AS position,
+       q.title     AS quiz_title,
+       q.slug      AS quiz_slug
+FROM tournament_stages ts
+         JOIN quizzes q ON q.id = ts.quiz_id
+WHERE ts.tournament_id = ?
+ORDER BY ts.position
+`
+
+type ListTournamentStagesRow struct {
+	QuizID    int64
+	Position  int64
+	QuizTitle string
+	QuizSlug  string
+}
+
+// The tournament's quizzes in play order, with the quiz fields the standings
+// and admin views show.
+func (q *Queries) ListTournamentStages(ctx context.Context, tournamentID int64) ([]ListTournamentStagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTournamentStages, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTournamentStagesRow
+	for rows.Next() {
+		var i ListTournamentStagesRow
+		if err := rows.Scan(
+			&i.QuizID,
+			&i.Position,
+			&i.QuizTitle,
+			&i.QuizSlug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTournaments = `-- name: ListTournaments :many
+SELECT id, title, join_code, created_by_player_id, created_at
+FROM tournaments
+ORDER BY created_at DESC, id DESC
+`
+
+// Every tournament, newest first, for an Admin's tournament list.
+func (q *Queries) ListTournaments(ctx context.Context) ([]Tournament, error) {
+	rows, err := q.db.QueryContext(ctx, listTournaments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tournament
+	for rows.Next() {
+		var i Tournament
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.JoinCode,
+			&i.CreatedByPlayerID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTournamentsForOwner = `-- name: ListTournamentsForOwner :many
+SELECT id, title, join_code, created_by_player_id, created_at
+FROM tournaments
+WHERE created_by_player_id = ?
+ORDER BY created_at DESC, id DESC
+`
+
+// A Host's own tournaments, newest first, mirroring ListQuizzesForOwner.
+func (q *Queries) ListTournamentsForOwner(ctx context.Context, createdByPlayerID sql.NullInt64) ([]Tournament, error) {
+	rows, err := q.db.QueryContext(ctx, listTournamentsForOwner, createdByPlayerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tournament
+	for rows.Next() {
+		var i Tournament
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.JoinCode,
+			&i.CreatedByPlayerID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tournamentJoinCodeExists = `-- name: TournamentJoinCodeExists :one
+SELECT EXISTS (SELECT 1 FROM tournaments WHERE join_code = ?) AS code_exists
+`
+
+func (q *Queries) TournamentJoinCodeExists(ctx context.Context, joinCode string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, tournamentJoinCodeExists, joinCode)
+	var code_exists bool
+	err := row.Scan(&code_exists)
+	return code_exists, err
+}
@@ -55,7 +55,7 @@ func TestSeedIfEnabled(t *testing.T) {
 
 	logger := slog.New(slog.DiscardHandler)
 	stores := store.New(dbtest.Open(t), logger)
-	mediaSvc := media.NewService(stores.Media, t.TempDir(),
+	mediaSvc := media.NewService(stores.Media, media.NewLocalStorage(t.TempDir()),
 		config.MediaImageMaxBytesDefault, config.MediaAudioMaxBytesDefault, logger)
 	cfg := demoTestConfig()
 	cfg.DemoMode = true
@@ -147,7 +147,7 @@ func TestSeedIfEnabled_CorruptArchiveNoPartialSeed(t *testing.T) {
 
 	logger := slog.New(slog.DiscardHandler)
 	stores := store.New(dbtest.Open(t), logger)
-	mediaSvc := media.NewService(stores.Media, t.TempDir(),
+	mediaSvc := media.NewService(stores.Media, media.NewLocalStorage(t.TempDir()),
 		config.MediaImageMaxBytesDefault, config.MediaAudioMaxBytesDefault, logger)
 	cfg := demoTestConfig()
 	cfg.DemoMode = true
@@ -180,7 +180,7 @@ func TestSeedIfEnabled_SkipsPreExistingPoolName(t *testing.T) {
 
 	logger := slog.New(slog.DiscardHandler)
 	stores := store.New(dbtest.Open(t), logger)
-	mediaSvc := media.NewService(stores.Media, t.TempDir(),
+	mediaSvc := media.NewService(stores.Media, media.NewLocalStorage(t.TempDir()),
 		config.MediaImageMaxBytesDefault, config.MediaAudioMaxBytesDefault, logger)
 	cfg := demoTestConfig()
 	cfg.DemoMode = true
@@ -214,7 +214,7 @@ func TestSeedIfEnabled_NoopWhenDisabled(t *testing.T) {
 
 	logger := slog.New(slog.DiscardHandler)
 	stores := store.New(dbtest.Open(t), logger)
-	mediaSvc := media.NewService(stores.Media, t.TempDir(),
+	mediaSvc := media.NewService(stores.Media, media.NewLocalStorage(t.TempDir()),
 		config.MediaImageMaxBytesDefault, config.MediaAudioMaxBytesDefault, logger)
 
 	if err := demo.SeedIfEnabled(t.Context(), demoTestConfig(), stores, mediaSvc, logger, nil); err != nil {
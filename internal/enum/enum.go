@@ -0,0 +1,112 @@
+// Package enum validates the string-valued enums of the domain types - a
+// game's status, a quiz's play mode, a media row's type - at every boundary
+// they cross. A domain type declares its closed set of values once with
+// [New] and delegates its Scan, Value, MarshalJSON and UnmarshalJSON methods
+// to the [Set], so a value outside the set fails when it is read from the
+// database, bound to a query or decoded from a request body, instead of being
+// silently stored and handed on.
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrInvalid is wrapped by every error a [Set] returns for a value outside
+// its set, so callers can map it to a 400 with [errors.Is].
+var ErrInvalid = errors.New("invalid value")
+
+// Set is the closed set of values of the string enum T. The zero Set accepts
+// nothing; build one with [New].
+type Set[T ~string] struct {
+	name   string
+	values []T
+}
+
+// New returns the Set holding values, in order. name labels the enum in
+// error messages ("game status", "play mode").
+func New[T ~string](name string, values ...T) Set[T] {
+	return Set[T]{name: name, values: slices.Clone(values)}
+}
+
+// Values returns the set's values in the order [New] was given them.
+func (s Set[T]) Values() []T {
+	return slices.Clone(s.values)
+}
+
+// Valid reports whether v is in the set.
+func (s Set[T]) Valid(v T) bool {
+	return slices.Contains(s.values, v)
+}
+
+// Parse returns raw as a T, or an error wrapping [ErrInvalid] when it is not
+// in the set.
+func (s Set[T]) Parse(raw string) (T, error) {
+	v := T(raw)
+	if !s.Valid(v) {
+		return "", fmt.Errorf("%w for %s: %q", ErrInvalid, s.name, raw)
+	}
+
+	return v, nil
+}
+
+// Scan implements the body of a [database/sql.Scanner] for *dst. The enum
+// columns are NOT NULL TEXT, so a NULL or a non-text value is an error.
+func (s Set[T]) Scan(dst *T, src any) error {
+	var raw string
+	switch v := src.(type) {
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return fmt.Errorf("%w for %s: cannot scan %T", ErrInvalid, s.name, src)
+	}
+	v, err := s.Parse(raw)
+	if err != nil {
+		return err
+	}
+	*dst = v
+
+	return nil
+}
+
+// Value implements the body of a [driver.Valuer] for v.
+func (s Set[T]) Value(v T) (driver.Value, error) {
+	if !s.Valid(v) {
+		return nil, fmt.Errorf("%w for %s: %q", ErrInvalid, s.name, string(v))
+	}
+
+	return string(v), nil
+}
+
+// EncodeJSON implements the body of a [json.Marshaler] for v.
+func (s Set[T]) EncodeJSON(v T) ([]byte, error) {
+	if !s.Valid(v) {
+		return nil, fmt.Errorf("%w for %s: %q", ErrInvalid, s.name, string(v))
+	}
+	b, err := json.Marshal(string(v))
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s: %w", s.name, err)
+	}
+
+	return b, nil
+}
+
+// DecodeJSON implements the body of a [json.Unmarshaler] for *dst.
+func (s Set[T]) DecodeJSON(dst *T, data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%w for %s: %w", ErrInvalid, s.name, err)
+	}
+	v, err := s.Parse(raw)
+	if err != nil {
+		return err
+	}
+	*dst = v
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/enum"
+)
+
+type color string
+
+const (
+	red  color = "red"
+	blue color = "blue"
+)
+
+//nolint:gochecknoglobals // Test fixture, read-only.
+var colors = New("color", red, blue)
+
+// paint round-trips colors through JSON the way a domain type delegates.
+type paint struct {
+	C color `json:"c"`
+}
+
+func (p paint) MarshalJSON() ([]byte, error) {
+	c, err := colors.EncodeJSON(p.C)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte(`{"c":`), c...), '}'), nil
+}
+
+func (p *paint) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		C json.RawMessage `json:"c"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return colors.DecodeJSON(&p.C, raw.C)
+}
+
+func TestSet_ValuesAndValid(t *testing.T) {
+	t.Parallel()
+
+	if got, want := colors.Values(), []color{red, blue}; !slices.Equal(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	colors.Values()[0] = "green"
+	if !colors.Valid(red) {
+		t.Error("mutating the Values() slice changed the set")
+	}
+	for _, c := range []color{"", "green", "RED"} {
+		if colors.Valid(c) {
+			t.Errorf("Valid(%q) = true, want false", c)
+		}
+	}
+	var zero Set[color]
+	if zero.Valid(red) {
+		t.Error("the zero Set accepted a value")
+	}
+}
+
+func TestSet_Parse(t *testing.T) {
+	t.Parallel()
+
+	got, err := colors.Parse("blue")
+	if err != nil || got != blue {
+		t.Errorf("Parse(blue) = %q, %v, want %q, nil", got, err, blue)
+	}
+	if _, err = colors.Parse("green"); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Parse(green) err = %v, want ErrInvalid", err)
+	}
+}
+
+func TestSet_ScanAndValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     any
+		want    color
+		wantErr bool
+	}{
+		{name: "string", src: "red", want: red},
+		{name: "bytes", src: []byte("blue"), want: blue},
+		{name: "unknown", src: "green", wantErr: true},
+		{name: "null", src: nil, wantErr: true},
+		{name: "integer", src: int64(1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var c color
+			err := colors.Scan(&c, tt.src)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalid) {
+					t.Errorf("Scan(%v) err = %v, want ErrInvalid", tt.src, err)
+				}
+
+				return
+			}
+			if err != nil || c != tt.want {
+				t.Errorf("Scan(%v) = %q, %v, want %q, nil", tt.src, c, err, tt.want)
+			}
+		})
+	}
+
+	if v, err := colors.Value(red); err != nil || v != "red" {
+		t.Errorf("Value(red) = %v, %v, want red, nil", v, err)
+	}
+	if _, err := colors.Value(""); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Value(\"\") err = %v, want ErrInvalid", err)
+	}
+}
+
+func TestSet_JSON(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(paint{C: blue})
+	if err != nil || string(b) != `{"c":"blue"}` {
+		t.Errorf("Marshal = %s, %v, want {\"c\":\"blue\"}, nil", b, err)
+	}
+	if _, err = json.Marshal(paint{C: "green"}); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Marshal(green) err = %v, want ErrInvalid", err)
+	}
+
+	var p paint
+	if err = json.Unmarshal([]byte(`{"c":"red"}`), &p); err != nil || p.C != red {
+		t.Errorf("Unmarshal(red) = %q, %v, want %q, nil", p.C, err, red)
+	}
+	for _, in := range []string{`{"c":"green"}`, `{"c":7}`} {
+		if err = json.Unmarshal([]byte(in), &p); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Unmarshal(%s) err = %v, want ErrInvalid", in, err)
+		}
+	}
+}
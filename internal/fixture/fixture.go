@@ -0,0 +1,218 @@
+// Package fixture builds quizzes and games for tests, so a suite states the
+// shape it needs ("three questions of four options") instead of spelling out
+// deep Quiz/Question/Option literals.
+//
+//	qz := fixture.Quiz().WithQuestions(3).WithOptions(4).Build()
+//
+// Everything a builder leaves to chance, such as which option is correct, is
+// drawn from a seeded generator, so the same builder calls always produce
+// the same values. Every Build returns a fresh tree: seeding one into a
+// store, which stamps IDs onto it, never leaks into the next.
+package fixture
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/gosimple/slug"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// SeededAdminID is the admin player inserted by migration
+// 20260111110308_add_admin_player.sql. Built quizzes are attributed to it
+// by default so they satisfy the NOT NULL created_by_player_id column.
+const SeededAdminID int64 = 1
+
+// DefaultSeed is the seed a builder draws from unless WithSeed says
+// otherwise.
+const DefaultSeed uint64 = 1
+
+// QuizBuilder configures a [quiz.Quiz]. The zero configuration from [Quiz]
+// is a published public solo quiz with one question of two options.
+type QuizBuilder struct {
+	id        int64
+	title     string
+	slug      string
+	creatorID int64
+	mode      quiz.Mode
+	published bool
+	shuffle   bool
+	questions int
+	options   int
+	seed      uint64
+	numbered  bool
+}
+
+// Quiz starts a quiz builder.
+func Quiz() *QuizBuilder {
+	return &QuizBuilder{
+		title:     "Fixture Quiz",
+		slug:      "fixture-quiz",
+		creatorID: SeededAdminID,
+		mode:      quiz.ModeSolo,
+		published: true,
+		questions: 1,
+		options:   2,
+		seed:      DefaultSeed,
+	}
+}
+
+// WithID sets the quiz ID, for tests that never store the quiz.
+func (b *QuizBuilder) WithID(id int64) *QuizBuilder {
+	b.id = id
+
+	return b
+}
+
+// WithTitle sets the title and derives the slug from it; a later WithSlug
+// overrides the slug.
+func (b *QuizBuilder) WithTitle(title string) *QuizBuilder {
+	b.title = title
+	b.slug = slug.Make(title)
+
+	return b
+}
+
+// WithSlug sets the slug.
+func (b *QuizBuilder) WithSlug(s string) *QuizBuilder {
+	b.slug = s
+
+	return b
+}
+
+// WithCreator attributes the quiz to the given player.
+func (b *QuizBuilder) WithCreator(playerID int64) *QuizBuilder {
+	b.creatorID = playerID
+
+	return b
+}
+
+// WithQuestions sets how many questions the quiz has.
+func (b *QuizBuilder) WithQuestions(n int) *QuizBuilder {
+	b.questions = n
+
+	return b
+}
+
+// WithOptions sets how many options each question has. Exactly one of them
+// is correct.
+func (b *QuizBuilder) WithOptions(n int) *QuizBuilder {
+	b.options = n
+
+	return b
+}
+
+// WithSeed sets the seed the correct options are drawn from.
+func (b *QuizBuilder) WithSeed(seed uint64) *QuizBuilder {
+	b.seed = seed
+
+	return b
+}
+
+// Live makes the quiz a hosted live quiz instead of a solo one.
+func (b *QuizBuilder) Live() *QuizBuilder {
+	b.mode = quiz.ModeLive
+
+	return b
+}
+
+// Draft leaves the quiz unpublished, so only its owner can preview it.
+func (b *QuizBuilder) Draft() *QuizBuilder {
+	b.published = false
+
+	return b
+}
+
+// Shuffled turns on the per-game question shuffle.
+func (b *QuizBuilder) Shuffled() *QuizBuilder {
+	b.shuffle = true
+
+	return b
+}
+
+// Numbered gives the questions and options IDs, for tests that use the quiz
+// without storing it: questions are numbered 1..n and options 1..m across
+// the whole quiz, in order. A stored quiz gets its IDs from the store.
+func (b *QuizBuilder) Numbered() *QuizBuilder {
+	b.numbered = true
+
+	return b
+}
+
+// Build returns the configured quiz. Questions sit at positions 10, 20, 30,
+// ... (see [quiz.RenumberStep]), read "Question 1", "Question 2", ... and
+// carry options "Option A", "Option B", ...
+func (b *QuizBuilder) Build() *quiz.Quiz {
+	// G404: fixtures must be reproducible, which crypto/rand cannot seed.
+	rng := rand.New(rand.NewPCG(b.seed, ^b.seed)) //nolint:gosec // deterministic test data, not a security boundary
+
+	qz := &quiz.Quiz{
+		ID:                b.id,
+		Title:             b.title,
+		Slug:              b.slug,
+		Description:       "Built by fixture.Quiz",
+		CreatedByPlayerID: b.creatorID,
+		TimeLimitSeconds:  quiz.DefaultTimeLimitSeconds,
+		Visibility:        quiz.VisibilityPublic,
+		Mode:              b.mode,
+		Published:         b.published,
+		ShuffleQuestions:  b.shuffle,
+		Questions:         make([]*quiz.Question, 0, b.questions),
+	}
+	var optionID int64
+	for i := range b.questions {
+		qs := &quiz.Question{
+			QuizID:   qz.ID,
+			Text:     fmt.Sprintf("Question %d", i+1),
+			Position: (i + 1) * quiz.RenumberStep,
+			Options:  make([]*quiz.Option, 0, b.options),
+		}
+		if b.numbered {
+			qs.ID = int64(i + 1)
+		}
+		correct := -1
+		if b.options > 0 {
+			correct = rng.IntN(b.options)
+		}
+		for j := range b.options {
+			op := &quiz.Option{
+				QuestionID: qs.ID,
+				Text:       fmt.Sprintf("Option %c", 'A'+rune(j)),
+				Correct:    j == correct,
+			}
+			if b.numbered {
+				optionID++
+				op.ID = optionID
+			}
+			qs.Options = append(qs.Options, op)
+		}
+		qz.Questions = append(qz.Questions, qs)
+	}
+
+	return qz
+}
+
+// CorrectOption returns the question's correct option, or nil when it has
+// none.
+func CorrectOption(qs *quiz.Question) *quiz.Option {
+	for _, op := range qs.Options {
+		if op.Correct {
+			return op
+		}
+	}
+
+	return nil
+}
+
+// WrongOption returns the question's first incorrect option, or nil when
+// every option is correct.
+func WrongOption(qs *quiz.Question) *quiz.Option {
+	for _, op := range qs.Options {
+		if !op.Correct {
+			return op
+		}
+	}
+
+	return nil
+}
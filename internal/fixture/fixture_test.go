@@ -0,0 +1,110 @@
+package fixture_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/starquake/topbanana/internal/fixture"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+func TestQuiz(t *testing.T) {
+	t.Parallel()
+
+	t.Run("builds the configured shape with one correct option each", func(t *testing.T) {
+		t.Parallel()
+
+		qz := fixture.Quiz().WithTitle("Capitals of Europe").WithQuestions(3).WithOptions(4).Build()
+		if got, want := qz.Slug, "capitals-of-europe"; got != want {
+			t.Errorf("Slug = %q, want %q", got, want)
+		}
+		if got, want := len(qz.Questions), 3; got != want {
+			t.Fatalf("question count = %d, want %d", got, want)
+		}
+		for i, qs := range qz.Questions {
+			if got, want := qs.Position, (i+1)*quiz.RenumberStep; got != want {
+				t.Errorf("question %d position = %d, want %d", i, got, want)
+			}
+			if got, want := len(qs.Options), 4; got != want {
+				t.Errorf("question %d option count = %d, want %d", i, got, want)
+			}
+			correct := 0
+			for _, op := range qs.Options {
+				if op.Correct {
+					correct++
+				}
+			}
+			if got, want := correct, 1; got != want {
+				t.Errorf("question %d correct options = %d, want %d", i, got, want)
+			}
+		}
+	})
+
+	t.Run("same seed builds the same quiz, fresh each time", func(t *testing.T) {
+		t.Parallel()
+
+		b := fixture.Quiz().WithQuestions(5).WithOptions(4).WithSeed(42)
+		first, second := b.Build(), b.Build()
+		if diff := cmp.Diff(first, second); diff != "" {
+			t.Errorf("Build mismatch (-first +second):\n%s", diff)
+		}
+		if first.Questions[0] == second.Questions[0] {
+			t.Error("Build shares question pointers between calls, want a fresh tree")
+		}
+	})
+
+	t.Run("numbered quiz carries IDs", func(t *testing.T) {
+		t.Parallel()
+
+		qz := fixture.Quiz().WithID(7).WithQuestions(2).WithOptions(3).Numbered().Build()
+		second := qz.Questions[1]
+		if got, want := second.ID, int64(2); got != want {
+			t.Errorf("second question ID = %d, want %d", got, want)
+		}
+		if got, want := second.QuizID, int64(7); got != want {
+			t.Errorf("second question QuizID = %d, want %d", got, want)
+		}
+		if got, want := second.Options[0].ID, int64(4); got != want {
+			t.Errorf("first option of second question ID = %d, want %d", got, want)
+		}
+		if got, want := second.Options[0].QuestionID, second.ID; got != want {
+			t.Errorf("option QuestionID = %d, want %d", got, want)
+		}
+	})
+}
+
+func TestGame(t *testing.T) {
+	t.Parallel()
+
+	qz := fixture.Quiz().WithQuestions(3).Numbered().Build()
+	g := fixture.Game(qz).
+		WithID("g1").
+		WithPlayers(1, 2).
+		Answer(1, 0, true, 2*time.Second).
+		Answer(2, 1, false, 0).
+		Build()
+
+	if got, want := len(g.Participants), 2; got != want {
+		t.Fatalf("participant count = %d, want %d", got, want)
+	}
+	if got, want := len(g.Questions), 2; got != want {
+		t.Fatalf("issued question count = %d, want %d (up to the last answered)", got, want)
+	}
+	second := g.Questions[1]
+	if got, want := second.StartedAt, fixture.Epoch.Add(fixture.DefaultWindow); !got.Equal(want) {
+		t.Errorf("second question StartedAt = %v, want %v", got, want)
+	}
+
+	first := g.Questions[0].Answers
+	if len(first) != 1 || !first[0].IsCorrect() || first[0].PlayerID != 1 {
+		t.Fatalf("first question answers = %+v, want one correct answer by player 1", first)
+	}
+	if got, want := first[0].AnsweredAt, fixture.Epoch.Add(2*time.Second); !got.Equal(want) {
+		t.Errorf("AnsweredAt = %v, want %v", got, want)
+	}
+	if wrong := second.Answers; len(wrong) != 1 || wrong[0].IsCorrect() {
+		t.Errorf("second question answers = %+v, want one wrong answer", wrong)
+	}
+}
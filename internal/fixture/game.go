@@ -0,0 +1,145 @@
+package fixture
+
+import (
+	"time"
+
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// Epoch is when a built game starts unless StartingAt says otherwise. A
+// fixed instant keeps scores and timings reproducible.
+var Epoch = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// DefaultWindow is each built question's answer window unless WithWindow
+// says otherwise; it matches [quiz.DefaultTimeLimitSeconds].
+const DefaultWindow = quiz.DefaultTimeLimitSeconds * time.Second
+
+// GameBuilder configures an in-memory [game.Game] over a built quiz, for
+// service tests that stub the store rather than play a game through it.
+// The quiz should be [QuizBuilder.Numbered] so answers can name options.
+type GameBuilder struct {
+	id      string
+	qz      *quiz.Quiz
+	players []int64
+	start   time.Time
+	window  time.Duration
+	asked   int
+	answers []answerSpec
+}
+
+// answerSpec is one Answer call, applied at Build.
+type answerSpec struct {
+	playerID int64
+	question int
+	correct  bool
+	after    time.Duration
+}
+
+// Game starts a game builder over qz.
+func Game(qz *quiz.Quiz) *GameBuilder {
+	return &GameBuilder{id: "fixture-game", qz: qz, start: Epoch, window: DefaultWindow}
+}
+
+// WithID sets the game ID.
+func (b *GameBuilder) WithID(id string) *GameBuilder {
+	b.id = id
+
+	return b
+}
+
+// WithPlayers adds the given players as participants.
+func (b *GameBuilder) WithPlayers(playerIDs ...int64) *GameBuilder {
+	b.players = append(b.players, playerIDs...)
+
+	return b
+}
+
+// StartingAt sets when the first question's answer window opens.
+func (b *GameBuilder) StartingAt(t time.Time) *GameBuilder {
+	b.start = t
+
+	return b
+}
+
+// WithWindow sets each question's answer window.
+func (b *GameBuilder) WithWindow(d time.Duration) *GameBuilder {
+	b.window = d
+
+	return b
+}
+
+// Asked issues the quiz's first n questions to the game, answered or not.
+// Answering a question issues it and every question before it anyway.
+func (b *GameBuilder) Asked(n int) *GameBuilder {
+	b.asked = max(b.asked, n)
+
+	return b
+}
+
+// Answer records the player answering the question at the zero-based index
+// in the quiz, after the given time into its window, with its correct
+// option or its first wrong one.
+func (b *GameBuilder) Answer(playerID int64, question int, correct bool, after time.Duration) *GameBuilder {
+	b.answers = append(b.answers, answerSpec{playerID: playerID, question: question, correct: correct, after: after})
+
+	return b.Asked(question + 1)
+}
+
+// Build returns the configured game. Questions are issued back to back,
+// each window opening as the previous one closes.
+func (b *GameBuilder) Build() *game.Game {
+	startedAt := b.start
+	g := &game.Game{
+		ID:        b.id,
+		QuizID:    b.qz.ID,
+		Quiz:      b.qz,
+		CreatedAt: b.start,
+		StartedAt: &startedAt,
+		Status:    game.GameStatusInProgress,
+	}
+	for _, playerID := range b.players {
+		g.Participants = append(g.Participants, &game.Participant{
+			GameID:   g.ID,
+			PlayerID: playerID,
+			QuizID:   g.QuizID,
+			JoinedAt: b.start,
+		})
+	}
+	for i := range min(b.asked, len(b.qz.Questions)) {
+		qs := b.qz.Questions[i]
+		opens := b.start.Add(time.Duration(i) * b.window)
+		g.Questions = append(g.Questions, &game.Question{
+			ID:           int64(i + 1),
+			GameID:       g.ID,
+			QuestionID:   qs.ID,
+			QuizQuestion: qs,
+			StartedAt:    opens,
+			ExpiredAt:    opens.Add(b.window),
+		})
+	}
+	for _, spec := range b.answers {
+		if spec.question >= len(g.Questions) {
+			continue
+		}
+		gq := g.Questions[spec.question]
+		op := WrongOption(gq.QuizQuestion)
+		if spec.correct {
+			op = CorrectOption(gq.QuizQuestion)
+		}
+		a := &game.Answer{
+			GameID:     g.ID,
+			PlayerID:   spec.playerID,
+			QuestionID: gq.ID,
+			Question:   gq,
+			AnsweredAt: gq.StartedAt.Add(spec.after),
+		}
+		if op != nil {
+			a.OptionID = op.ID
+			a.Option = op
+		}
+		gq.Answers = append(gq.Answers, a)
+	}
+
+	return g
+}
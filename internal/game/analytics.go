@@ -0,0 +1,109 @@
+package game
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/starquake/topbanana/internal/analytics"
+)
+
+// emitting reports whether an event about g goes anywhere: the game event
+// log takes every game, the analytics sink all but previews. Callers check it
+// before doing work, like scoring, that only an event needs.
+func (s *Service) emitting(g *Game) bool {
+	return s.eventLog != nil || (s.analytics != nil && !g.Preview)
+}
+
+// emit hands ev to the game event log and, unless g is an owner preview, to
+// the analytics sink.
+func (s *Service) emit(g *Game, ev analytics.Event) {
+	if s.eventLog != nil {
+		s.eventLog.Emit(ev)
+	}
+	if s.analytics != nil && !g.Preview {
+		s.analytics.Emit(ev)
+	}
+}
+
+// logGameCreated records a new game and its first participant joining it.
+// The two go to the game event log only: they are not analytics events.
+func (s *Service) logGameCreated(g *Game, playerID int64) {
+	if s.eventLog == nil {
+		return
+	}
+	at := time.Now().UTC()
+	s.eventLog.Emit(analytics.Event{
+		Type: analytics.TypeGameCreated, At: at, GameID: g.ID, QuizID: g.QuizID, PlayerID: playerID,
+	})
+	s.eventLog.Emit(analytics.Event{
+		Type: analytics.TypePlayerJoined, At: at, GameID: g.ID, QuizID: g.QuizID, PlayerID: playerID,
+	})
+}
+
+// emitQuestionServed reports a newly issued question. Not called for a
+// resumed or concurrently issued question, so each question is served once
+// per game.
+func (s *Service) emitQuestionServed(g *Game, playerID int64, gq *Question) {
+	if !s.emitting(g) {
+		return
+	}
+	s.emit(g, analytics.Event{
+		Type:       analytics.TypeQuestionServed,
+		At:         time.Now().UTC(),
+		GameID:     g.ID,
+		QuizID:     g.QuizID,
+		PlayerID:   playerID,
+		QuestionID: gq.QuestionID,
+		Position:   gq.Position,
+	})
+}
+
+// emitAnswerAnalytics reports a just-recorded answer and, when that answer
+// finished the game, the finished game.
+func (s *Service) emitAnswerAnalytics(ctx context.Context, g *Game, a *Answer, finished bool) {
+	if !s.emitting(g) {
+		return
+	}
+	correct := a.IsCorrect()
+	s.emit(g, analytics.Event{
+		Type:          analytics.TypeAnswerSubmitted,
+		At:            time.Now().UTC(),
+		GameID:        g.ID,
+		QuizID:        g.QuizID,
+		PlayerID:      a.PlayerID,
+		QuestionID:    a.Question.QuestionID,
+		Correct:       &correct,
+		LatencyBucket: analytics.LatencyBucket(answerLatency(a)),
+	})
+	if !finished {
+		return
+	}
+	a.Question.Answers = append(a.Question.Answers, a)
+	g.Status = GameStatusFinished
+	s.emitGameFinished(ctx, g, a.PlayerID)
+}
+
+// emitGameFinished reports a game reaching its terminal status, with the
+// player's total. Best-effort like [Service.checkAnswerAnomalies]: a scoring
+// failure is logged and the event skipped.
+func (s *Service) emitGameFinished(ctx context.Context, g *Game, playerID int64) {
+	if !s.emitting(g) {
+		return
+	}
+	score, err := s.computeGameScore(ctx, g, playerID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "error scoring finished game for analytics", slog.Any("err", err))
+
+		return
+	}
+	s.emit(g, analytics.Event{
+		Type:     analytics.TypeGameFinished,
+		At:       time.Now().UTC(),
+		GameID:   g.ID,
+		QuizID:   g.QuizID,
+		PlayerID: playerID,
+		Status:   string(g.Status),
+		Score:    &score,
+	})
+}
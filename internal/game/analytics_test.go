@@ -0,0 +1,183 @@
+package game_test
+
+import (
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/analytics"
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// recordingEmitter keeps every emitted event in order.
+type recordingEmitter struct {
+	mu     sync.Mutex
+	events []analytics.Event
+}
+
+func (r *recordingEmitter) Emit(e analytics.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+// TestService_EmitsAnalytics plays a whole game and pins the event stream:
+// each question is served then answered, and the final answer is followed
+// by one game_finished carrying the player's total.
+func TestService_EmitsAnalytics(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := newTestQuiz(t)
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	rec := &recordingEmitter{}
+	svc.SetAnalyticsEmitter(rec)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("failed to create game: %v", err)
+	}
+	var want []string
+	for i := range testQuiz.Questions {
+		gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+		if err != nil {
+			t.Fatalf("GetNextQuestion %d err = %v, want nil", i, err)
+		}
+		// A reload resumes the open question without serving it again.
+		if _, err = svc.GetNextQuestion(ctx, g.ID, 1); err != nil {
+			t.Fatalf("GetNextQuestion resume %d err = %v, want nil", i, err)
+		}
+		correct := gq.QuizQuestion.Options[0]
+		if _, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, correct.ID, time.Time{}); err != nil {
+			t.Fatalf("SubmitAnswer %d err = %v, want nil", i, err)
+		}
+		want = append(want, analytics.TypeQuestionServed, analytics.TypeAnswerSubmitted)
+	}
+	want = append(want, analytics.TypeGameFinished)
+
+	if got := len(rec.events); got != len(want) {
+		t.Fatalf("events = %d, want %d (%+v)", got, len(want), rec.events)
+	}
+	for i, e := range rec.events {
+		if e.Type != want[i] {
+			t.Errorf("events[%d].Type = %q, want %q", i, e.Type, want[i])
+		}
+		if e.GameID != g.ID || e.QuizID != testQuiz.ID || e.PlayerID != 1 {
+			t.Errorf("events[%d] = %+v, want game %s on quiz %d for player 1", i, e, g.ID, testQuiz.ID)
+		}
+	}
+	answered := rec.events[1]
+	if answered.Correct == nil || !*answered.Correct {
+		t.Errorf("answer_submitted Correct = %v, want true", answered.Correct)
+	}
+	if got, want := answered.LatencyBucket, analytics.BucketUnder1s; got != want {
+		t.Errorf("answer_submitted LatencyBucket = %q, want %q", got, want)
+	}
+	if finished := rec.events[len(rec.events)-1]; finished.Score == nil || *finished.Score <= 0 {
+		t.Errorf("game_finished Score = %v, want a positive total", finished.Score)
+	}
+}
+
+// TestService_PreviewGameEmitsNoAnalytics pins that an owner's preview play
+// stays out of the export, as it stays off the leaderboard.
+func TestService_PreviewGameEmitsNoAnalytics(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := newTestQuiz(t)
+	testQuiz.Published = false
+	testQuiz.Mode = quiz.ModeSolo
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	rec := &recordingEmitter{}
+	svc.SetAnalyticsEmitter(rec)
+
+	g, err := svc.CreatePreviewGame(ctx, testQuiz, 1)
+	if err != nil {
+		t.Fatalf("CreatePreviewGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	if _, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{}); err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	if got := len(rec.events); got != 0 {
+		t.Errorf("events = %d, want 0 (%+v)", got, rec.events)
+	}
+}
+
+// TestService_EventLogRecordsEveryGame pins the game event log's stream: it
+// opens with game_created and player_joined, carries the same served,
+// answered and finished events as the analytics export, and - unlike the
+// export - covers an owner's preview play too.
+func TestService_EventLogRecordsEveryGame(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := newTestQuiz(t)
+	testQuiz.Published = false
+	testQuiz.Mode = quiz.ModeSolo
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	exported := &recordingEmitter{}
+	svc.SetAnalyticsEmitter(exported)
+	logged := &recordingEmitter{}
+	svc.SetEventLog(logged)
+
+	g, err := svc.CreatePreviewGame(ctx, testQuiz, 1)
+	if err != nil {
+		t.Fatalf("CreatePreviewGame err = %v, want nil", err)
+	}
+	want := []string{analytics.TypeGameCreated, analytics.TypePlayerJoined}
+	for i := range testQuiz.Questions {
+		gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+		if err != nil {
+			t.Fatalf("GetNextQuestion %d err = %v, want nil", i, err)
+		}
+		if _, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{}); err != nil {
+			t.Fatalf("SubmitAnswer %d err = %v, want nil", i, err)
+		}
+		want = append(want, analytics.TypeQuestionServed, analytics.TypeAnswerSubmitted)
+	}
+	want = append(want, analytics.TypeGameFinished)
+
+	if got := len(logged.events); got != len(want) {
+		t.Fatalf("logged events = %d, want %d (%+v)", got, len(want), logged.events)
+	}
+	for i, e := range logged.events {
+		if e.Type != want[i] {
+			t.Errorf("logged events[%d].Type = %q, want %q", i, e.Type, want[i])
+		}
+		if e.GameID != g.ID || e.PlayerID != 1 {
+			t.Errorf("logged events[%d] = %+v, want game %s for player 1", i, e, g.ID)
+		}
+	}
+	if got := len(exported.events); got != 0 {
+		t.Errorf("exported events = %d, want 0 for a preview (%+v)", got, exported.events)
+	}
+}
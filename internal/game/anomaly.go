@@ -0,0 +1,258 @@
+package game
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// AnomalyKind names one suspicious answer pattern. The set is groundwork for
+// anti-cheat: nothing is rejected on the strength of an anomaly, it is only
+// logged and counted so an Admin can spot a pattern.
+type AnomalyKind string
+
+const (
+	// AnomalyEarlyAnswer is an answer that reached the server before the
+	// question's StartedAt, i.e. before the options were revealed.
+	AnomalyEarlyAnswer AnomalyKind = "early_answer"
+	// AnomalyFastAnswer is an answer that reached the server within
+	// fastAnswerThreshold of the reveal, faster than a human can read the
+	// options and tap.
+	AnomalyFastAnswer AnomalyKind = "fast_answer"
+	// AnomalyRepeatedMaxScore is a player scoring maxPoints on
+	// maxScoreRepeatThreshold or more questions of the same game. It is
+	// recorded once per game and player, on the answer that reaches the
+	// threshold.
+	AnomalyRepeatedMaxScore AnomalyKind = "repeated_max_score"
+)
+
+// anomalyMetrics publishes the per-kind anomaly totals under "game_anomalies"
+// on the process's expvar registry, next to game_pacing, so a metrics scrape
+// sees them as well as the admin page. The [AnomalyMonitor] counts are per
+// [Service] and reset with the process; this map is process-wide, which is
+// why it lives here rather than on the monitor.
+//
+//nolint:gochecknoglobals // expvar's registry is process-global by design.
+var anomalyMetrics = expvar.NewMap("game_anomalies")
+
+// AnomalyKinds lists every kind in display order, so a counter table renders
+// a zero row for a kind that has not fired yet.
+func AnomalyKinds() []AnomalyKind {
+	return []AnomalyKind{AnomalyEarlyAnswer, AnomalyFastAnswer, AnomalyRepeatedMaxScore}
+}
+
+const (
+	// fastAnswerThreshold is the reveal-to-receipt gap below which an answer
+	// counts as humanly impossible.
+	fastAnswerThreshold = 100 * time.Millisecond
+	// maxScoreRepeatThreshold is how many maxPoints answers in one game make
+	// a player's run suspicious.
+	maxScoreRepeatThreshold = 3
+	// AnomalyLogCapacity bounds the recent-anomalies ring the admin page
+	// lists.
+	AnomalyLogCapacity = 50
+)
+
+// Anomaly is one detected occurrence.
+type Anomaly struct {
+	Kind       AnomalyKind
+	GameID     string
+	PlayerID   int64
+	QuestionID int64
+	// Gap is the reveal-to-receipt time for the timing kinds (negative for
+	// an early answer) and zero for AnomalyRepeatedMaxScore.
+	Gap        time.Duration
+	DetectedAt time.Time
+}
+
+// AnomalyMonitor counts anomalies per kind since process start and keeps the
+// most recent ones in a bounded ring, oldest overwritten first. In-memory by
+// design: it is a diagnostic aid, not an audit trail. Safe for concurrent use.
+type AnomalyMonitor struct {
+	now func() time.Time
+
+	mu     sync.Mutex
+	counts map[AnomalyKind]int
+	recent []Anomaly
+	next   int
+}
+
+// NewAnomalyMonitor returns an empty monitor using [time.Now] as the clock.
+func NewAnomalyMonitor() *AnomalyMonitor {
+	return newAnomalyMonitorWithClock(time.Now)
+}
+
+func newAnomalyMonitorWithClock(now func() time.Time) *AnomalyMonitor {
+	return &AnomalyMonitor{
+		now:    now,
+		counts: make(map[AnomalyKind]int),
+		recent: make([]Anomaly, 0, AnomalyLogCapacity),
+	}
+}
+
+// Record counts a and appends it to the recent ring, stamping DetectedAt.
+func (m *AnomalyMonitor) Record(a Anomaly) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a.DetectedAt = m.now()
+	m.counts[a.Kind]++
+	if len(m.recent) < AnomalyLogCapacity {
+		m.recent = append(m.recent, a)
+
+		return
+	}
+	m.recent[m.next] = a
+	m.next = (m.next + 1) % AnomalyLogCapacity
+}
+
+// Counts returns a copy of the per-kind totals.
+func (m *AnomalyMonitor) Counts() map[AnomalyKind]int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return maps.Clone(m.counts)
+}
+
+// Recent returns the buffered anomalies, newest first, as a fresh copy.
+func (m *AnomalyMonitor) Recent() []Anomaly {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]Anomaly, 0, len(m.recent))
+	// Once the ring has wrapped, m.next is the oldest slot, so walking
+	// backwards from it visits newest to oldest.
+	for i := range len(m.recent) {
+		idx := (m.next - 1 - i + 2*len(m.recent)) % len(m.recent)
+		out = append(out, m.recent[idx])
+	}
+
+	return out
+}
+
+// timingAnomaly classifies the gap between the question's reveal and the
+// moment the server received the answer. The server receipt time is used
+// rather than the client's tappedAt, which a client controls.
+func timingAnomaly(startedAt, receivedAt time.Time) (AnomalyKind, bool) {
+	gap := receivedAt.Sub(startedAt)
+	switch {
+	case gap < 0:
+		return AnomalyEarlyAnswer, true
+	case gap < fastAnswerThreshold:
+		return AnomalyFastAnswer, true
+	default:
+		return "", false
+	}
+}
+
+// Anomalies returns the service's anomaly monitor for the admin page.
+func (s *Service) Anomalies() *AnomalyMonitor {
+	return s.anomalies
+}
+
+// checkAnswerAnomalies inspects a just-recorded answer for the suspicious
+// patterns above, logging and recording each hit. Best-effort: a lookup
+// failure is logged and swallowed so the answer path never fails on it. An
+// owner's preview game is skipped; it never reaches the leaderboard.
+func (s *Service) checkAnswerAnomalies(ctx context.Context, g *Game, a *Answer, receivedAt time.Time) {
+	if g.Preview {
+		return
+	}
+	if kind, ok := timingAnomaly(a.Question.StartedAt, receivedAt); ok {
+		s.recordAnomaly(ctx, Anomaly{
+			Kind:       kind,
+			GameID:     g.ID,
+			PlayerID:   a.PlayerID,
+			QuestionID: a.QuestionID,
+			Gap:        receivedAt.Sub(a.Question.StartedAt),
+		})
+	}
+
+	// The unwagered time-curve points, whatever the quiz's scoring mode: a
+	// stake or a streak multiplies a fast answer past maxPoints, and flat
+	// scoring awards it for any correct answer, without either being a
+	// perfect one.
+	if (timeScorer{logger: s.logger}).Points(ctx, a) < maxPoints {
+		return
+	}
+	maxed, err := s.countPriorMaxScores(ctx, g, a.PlayerID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "error counting max scores for anomaly check", slog.Any("err", err))
+
+		return
+	}
+	// Only the answer that reaches the threshold records it; later perfect
+	// answers in the same game would otherwise flag the same run again.
+	if maxed+1 == maxScoreRepeatThreshold {
+		s.recordAnomaly(ctx, Anomaly{
+			Kind:       AnomalyRepeatedMaxScore,
+			GameID:     g.ID,
+			PlayerID:   a.PlayerID,
+			QuestionID: a.QuestionID,
+		})
+	}
+}
+
+// countPriorMaxScores counts playerID's answers already in g (loaded before
+// the new answer was written) that scored maxPoints.
+func (s *Service) countPriorMaxScores(ctx context.Context, g *Game, playerID int64) (int, error) {
+	var optionIDs []int64
+	for _, gq := range g.Questions {
+		for _, ga := range gq.Answers {
+			if ga.PlayerID == playerID {
+				optionIDs = append(optionIDs, ga.OptionID)
+			}
+		}
+	}
+	if len(optionIDs) == 0 {
+		return 0, nil
+	}
+	options, err := s.quizStore.GetOptionsByIDs(ctx, optionIDs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get options: %w", err)
+	}
+	optionsByID := make(map[int64]*quiz.Option, len(options))
+	for _, o := range options {
+		optionsByID[o.ID] = o
+	}
+
+	n := 0
+	for _, gq := range g.Questions {
+		for _, ga := range gq.Answers {
+			if ga.PlayerID != playerID {
+				continue
+			}
+			o := optionsByID[ga.OptionID]
+			if o == nil {
+				continue
+			}
+			// Only full credit counts: a numeric answer near the key, or a
+			// pick set that missed a correct option, is not a perfect one.
+			credit := answerCredit(&Answer{Option: o, NumericValue: ga.NumericValue, Tally: ga.Tally})
+			score := scoreAnswerCurve(ctx, s.logger, credit > 0, gq.StartedAt, gq.ExpiredAt, ga.AnsweredAt)
+			if credit == 1 && score >= maxPoints {
+				n++
+			}
+		}
+	}
+
+	return n, nil
+}
+
+func (s *Service) recordAnomaly(ctx context.Context, a Anomaly) {
+	s.logger.WarnContext(ctx, "scoring anomaly",
+		slog.String("kind", string(a.Kind)),
+		slog.String("game", a.GameID),
+		slog.Int64("player", a.PlayerID),
+		slog.Int64("question", a.QuestionID),
+		slog.Duration("gap", a.Gap),
+	)
+	s.anomalies.Record(a)
+	anomalyMetrics.Add(string(a.Kind), 1)
+}
@@ -0,0 +1,138 @@
+package game_test
+
+import (
+	"expvar"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+func TestTimingAnomaly(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		received time.Time
+		wantKind AnomalyKind
+		wantOK   bool
+	}{
+		{name: "before reveal", received: startedAt.Add(-time.Second), wantKind: AnomalyEarlyAnswer, wantOK: true},
+		{name: "at reveal", received: startedAt, wantKind: AnomalyFastAnswer, wantOK: true},
+		{name: "under 100ms", received: startedAt.Add(99 * time.Millisecond), wantKind: AnomalyFastAnswer, wantOK: true},
+		{name: "at 100ms", received: startedAt.Add(100 * time.Millisecond), wantOK: false},
+		{name: "human pace", received: startedAt.Add(2 * time.Second), wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, ok := ExportTimingAnomaly(startedAt, tt.received)
+			if got, want := ok, tt.wantOK; got != want {
+				t.Fatalf("ok = %v, want %v", got, want)
+			}
+			if got, want := kind, tt.wantKind; got != want {
+				t.Errorf("kind = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAnomalyMonitor_RecentIsNewestFirstAndBounded(t *testing.T) {
+	t.Parallel()
+
+	m := ExportNewAnomalyMonitorWithClock(time.Now)
+	total := AnomalyLogCapacity + 5
+	for i := range total {
+		m.Record(Anomaly{Kind: AnomalyFastAnswer, QuestionID: int64(i)})
+	}
+
+	recent := m.Recent()
+	if got, want := len(recent), AnomalyLogCapacity; got != want {
+		t.Fatalf("len(Recent) = %d, want %d", got, want)
+	}
+	if got, want := recent[0].QuestionID, int64(total-1); got != want {
+		t.Errorf("Recent[0].QuestionID = %d, want %d (newest first)", got, want)
+	}
+	if got, want := recent[len(recent)-1].QuestionID, int64(total-AnomalyLogCapacity); got != want {
+		t.Errorf("Recent[last].QuestionID = %d, want %d (oldest kept)", got, want)
+	}
+	if got, want := m.Counts()[AnomalyFastAnswer], total; got != want {
+		t.Errorf("Counts[fast] = %d, want %d (counts are not bounded by the ring)", got, want)
+	}
+}
+
+// TestService_SubmitAnswer_RecordsAnomalies answers every question the moment
+// it is issued, before the reveal delay elapses: each answer is an early
+// arrival, and each scores maxPoints, so the third one trips the repeated
+// maximum score check and the later ones do not trip it again. The totals
+// also reach the game_anomalies expvar map.
+func TestService_SubmitAnswer_RecordsAnomalies(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := newTestQuiz(t)
+	testQuiz.Questions = append(testQuiz.Questions,
+		&quiz.Question{Text: "2 + 2?", Position: 100, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+		&quiz.Question{Text: "3 + 3?", Position: 110, Options: []*quiz.Option{{Text: "6", Correct: true}, {Text: "7"}}},
+	)
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("failed to create game: %v", err)
+	}
+	for i := range testQuiz.Questions {
+		gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+		if err != nil {
+			t.Fatalf("GetNextQuestion %d err = %v, want nil", i, err)
+		}
+		correct := gq.QuizQuestion.Options[0]
+		if _, err := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, correct.ID, time.Time{}); err != nil {
+			t.Fatalf("SubmitAnswer %d err = %v, want nil", i, err)
+		}
+	}
+
+	counts := svc.Anomalies().Counts()
+	if got, want := counts[AnomalyEarlyAnswer], len(testQuiz.Questions); got != want {
+		t.Errorf("early_answer count = %d, want %d", got, want)
+	}
+	if got, want := counts[AnomalyRepeatedMaxScore], 1; got != want {
+		t.Errorf("repeated_max_score count = %d, want %d", got, want)
+	}
+	var repeated []Anomaly
+	for _, a := range svc.Anomalies().Recent() {
+		if a.Kind == AnomalyRepeatedMaxScore {
+			repeated = append(repeated, a)
+		}
+	}
+	if len(repeated) != 1 {
+		t.Fatalf("recent repeated_max_score anomalies = %d, want 1", len(repeated))
+	}
+	if got, want := repeated[0].GameID, g.ID; got != want {
+		t.Errorf("repeated_max_score anomaly game = %q, want %q", got, want)
+	}
+	if got, want := repeated[0].QuestionID, testQuiz.Questions[2].ID; got != want {
+		t.Errorf("repeated_max_score anomaly question = %d, want %d (the third perfect answer)", got, want)
+	}
+
+	metrics, ok := expvar.Get("game_anomalies").(*expvar.Map)
+	if !ok {
+		t.Fatal("game_anomalies expvar map not published")
+	}
+	if got, _ := metrics.Get(string(AnomalyRepeatedMaxScore)).(*expvar.Int); got == nil || got.Value() < 1 {
+		t.Errorf("game_anomalies %s = %v, want at least 1", AnomalyRepeatedMaxScore, got)
+	}
+}
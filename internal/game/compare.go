@@ -0,0 +1,84 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// percentScale turns a fraction of players into a whole percentage.
+const percentScale = 100
+
+// ResultsComparison sets a player's score in one game against everyone else
+// who completed the same quiz. Average is the mean total of those players
+// and Percentile the share of them, as a whole percentage, who scored
+// strictly less than Score. Compared counts them; when it is 0 there is
+// nobody to compare against and Average and Percentile are 0.
+type ResultsComparison struct {
+	GameID     string
+	Score      int
+	Average    float64
+	Percentile int
+	Compared   int
+}
+
+// CompareResults returns the player's score in the game alongside the quiz's
+// historical average and the player's percentile among the other players who
+// completed it. The comparison pool is the quiz leaderboard's completed solo
+// participants, scored the same way, so previews and hosted live sessions do
+// not count. Like [Service.GetResults], a non-participant gets
+// [ErrGameNotFound] and a game whose scores are held back [ErrScoresHidden].
+func (s *Service) CompareResults(ctx context.Context, gameID string, playerID int64) (*ResultsComparison, error) {
+	ctx, span := tracing.Start(ctx, "game.CompareResults", tracing.String("game.id", gameID))
+	defer span.End()
+
+	g, err := s.store.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf(errGetGameFmt, err)
+	}
+	if !hasParticipant(g, playerID) {
+		return nil, ErrGameNotFound
+	}
+	hidden, err := s.scoresHidden(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	if hidden {
+		return nil, ErrScoresHidden
+	}
+	results, err := s.computeResults(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := s.store.ListParticipantsForQuizLeaderboard(ctx, g.QuizID, s.now().Add(-s.stalePeriod))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quiz participants: %w", err)
+	}
+	totals, err := s.answerTotals(ctx, g.QuizID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ResultsComparison{GameID: g.ID, Score: results.PlayerScores[playerID]}
+	var sum, below int
+	for _, p := range participants {
+		if !p.IsCompleted || p.PlayerID == playerID {
+			continue
+		}
+		total := totals[p.PlayerID]
+		sum += total
+		if total < res.Score {
+			below++
+		}
+		res.Compared++
+	}
+	if res.Compared > 0 {
+		res.Average = float64(sum) / float64(res.Compared)
+		res.Percentile = int(math.Round(float64(below) * percentScale / float64(res.Compared)))
+	}
+
+	return res, nil
+}
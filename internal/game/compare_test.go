@@ -0,0 +1,87 @@
+package game_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/fixture"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// compareStubs builds a service whose game "g1" has player 1 answering one
+// question correctly at the start of its window (1000 points), over a quiz
+// whose leaderboard answers are the given rows.
+func compareStubs(answers []*LeaderboardAnswer) *Service {
+	qz := fixture.Quiz().WithID(7).Numbered().Build()
+	g := fixture.Game(qz).WithID("g1").WithPlayers(1).Answer(1, 0, true, 0).Build()
+
+	return NewService(
+		stubStore{
+			getGame: func(_ context.Context, _ string) (*Game, error) { return g, nil },
+			listAnswersForQuizLeaderboard: func(_ context.Context, _ int64) ([]*LeaderboardAnswer, error) {
+				return answers, nil
+			},
+		},
+		stubQuizStore{
+			getOptionsByIDs: func(_ context.Context, _ []int64) ([]*quiz.Option, error) {
+				return qz.Questions[0].Options, nil
+			},
+		},
+		slog.New(slog.DiscardHandler),
+	)
+}
+
+func TestService_CompareResults(t *testing.T) {
+	t.Parallel()
+
+	t.Run("averages and ranks against the other completed players", func(t *testing.T) {
+		t.Parallel()
+
+		svc := compareStubs([]*LeaderboardAnswer{
+			makeAnswer(1, "me", true),
+			makeAnswer(2, "bob", false),
+			makeAnswer(3, "carol", false),
+			makeAnswer(4, "dave", true),
+			makeAnswer(4, "dave", true),
+			makeAnswerCompleted(5, "erin", false, false),
+		})
+
+		got, err := svc.CompareResults(t.Context(), "g1", 1)
+		if err != nil {
+			t.Fatalf("CompareResults err = %v, want nil", err)
+		}
+		want := ResultsComparison{GameID: "g1", Score: 1000, Average: 2000.0 / 3, Percentile: 67, Compared: 3}
+		if *got != want {
+			t.Errorf("CompareResults = %+v, want %+v", *got, want)
+		}
+	})
+
+	t.Run("nobody else completed the quiz", func(t *testing.T) {
+		t.Parallel()
+
+		svc := compareStubs([]*LeaderboardAnswer{makeAnswer(1, "me", true)})
+
+		got, err := svc.CompareResults(t.Context(), "g1", 1)
+		if err != nil {
+			t.Fatalf("CompareResults err = %v, want nil", err)
+		}
+		want := ResultsComparison{GameID: "g1", Score: 1000}
+		if *got != want {
+			t.Errorf("CompareResults = %+v, want %+v", *got, want)
+		}
+	})
+
+	t.Run("non-participant gets ErrGameNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		svc := compareStubs(nil)
+
+		_, err := svc.CompareResults(t.Context(), "g1", 99)
+		if got, want := err, ErrGameNotFound; !errors.Is(got, want) {
+			t.Errorf("CompareResults err = %v, want %v", got, want)
+		}
+	})
+}
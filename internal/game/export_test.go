@@ -18,6 +18,7 @@ var (
 	ExportResolveRoundBoundaryWindow = resolveRoundBoundaryWindow
 	ExportDefaultExpiration          = defaultExpiration
 	ExportScoreAnswerCurve           = scoreAnswerCurve
+	ExportPlayOrder                  = playOrder
 )
 
 // ExportRoundSlot is the test-visible projection of the unexported
@@ -66,7 +67,7 @@ const (
 // resulting StartedAt/ExpiredAt so a test can assert the boundary window
 // is always positive, even when the quiz default time limit is zero.
 func ExportIntroBoundaryWindow(qz *quiz.Quiz) (startedAt, expiredAt time.Time) {
-	svc := &Service{}
+	svc := &Service{now: time.Now}
 	item, err := svc.buildRoundBoundaryItem(
 		context.Background(), &Game{}, qz, 0, &quiz.Round{}, RoundPhaseIntro,
 	)
@@ -76,3 +77,10 @@ func ExportIntroBoundaryWindow(qz *quiz.Quiz) (startedAt, expiredAt time.Time) {
 
 	return item.StartedAt, item.ExpiredAt
 }
+
+// ExportTimingAnomaly and ExportNewAnomalyMonitorWithClock re-export the
+// anomaly classifier and the clock-injected monitor constructor.
+var (
+	ExportTimingAnomaly              = timingAnomaly
+	ExportNewAnomalyMonitorWithClock = newAnomalyMonitorWithClock
+)
@@ -4,13 +4,17 @@ package game
 import (
 	"cmp"
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"slices"
 	"strings"
 	"time"
 
+	"github.com/starquake/topbanana/internal/analytics"
+	"github.com/starquake/topbanana/internal/enum"
 	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/tracing"
 )
 
 const defaultLeaderboardLimit = 10
@@ -47,37 +51,141 @@ var (
 	ErrNoMoreQuestions = errors.New("no more questions")
 
 	// ErrQuestionNotInGame is returned by [Service.SubmitAnswer] when the
-	// question being answered does not belong to the supplied game.
+	// question being answered does not belong to the supplied game, and by
+	// [Service.VoidQuestion] for a question never issued to it.
 	ErrQuestionNotInGame = errors.New("question not in game")
 
 	// ErrOptionNotInQuestion is returned by [Service.SubmitAnswer] when
 	// the option being submitted does not belong to the supplied question.
 	ErrOptionNotInQuestion = errors.New("option does not belong to question")
 
+	// ErrAnswerKindMismatch is returned by [Service.SubmitAnswer] and
+	// [Service.SubmitNumericAnswer] when the answer's shape does not match
+	// the question's kind: an option pick for a numeric question, or a
+	// number for a choice question. Handlers map it to 400.
+	ErrAnswerKindMismatch = errors.New("answer does not match the question kind")
+
 	// ErrAnswerWindowClosed is returned by [Service.SubmitAnswer] for an
 	// answer arriving past ExpiredAt plus the latency grace; it scores
 	// nothing, so it is rejected not recorded (#1163). Handlers map it to 409.
 	ErrAnswerWindowClosed = errors.New("answer window closed")
 
+	// ErrGameFinished is returned by [Service.GetNext],
+	// [Service.GetNextQuestion] and [Service.SubmitAnswer] when the game has
+	// already finished or been abandoned: it issues no new questions and
+	// takes no new answers. Handlers map it to 410.
+	ErrGameFinished = errors.New("game is finished")
+
 	// ErrStartingGameNoRowsAffected is returned by [GameStore.StartGame]
 	// when the UPDATE matched no rows - i.e. the game does not exist.
 	ErrStartingGameNoRowsAffected = errors.New("no rows affected when starting game")
 
+	// ErrWagerNotOffered is returned by [Service.PlaceWager] when the game's
+	// quiz does not use the confidence wager. Handlers map it to 409.
+	ErrWagerNotOffered = errors.New("quiz does not use the confidence wager")
+
+	// ErrInvalidWager is returned by [Service.PlaceWager] for a stake outside
+	// [MinWager]..[MaxWager]. Handlers map it to 400.
+	ErrInvalidWager = errors.New("wager out of range")
+
+	// ErrWagerAlreadyPlaced is returned by [Service.PlaceWager] when the
+	// player already locked in a stake on the question: the first one is
+	// final. Handlers map it to 409.
+	ErrWagerAlreadyPlaced = errors.New("wager already placed for this question")
+
+	// ErrPauseAlreadyUsed is returned by [Service.PauseOnce] when the player
+	// already spent their one pause of the game. Handlers map it to 409.
+	ErrPauseAlreadyUsed = errors.New("pause already used in this game")
+
+	// ErrHintNotOffered is returned by [Service.UseHint] when the question
+	// has no hint. Handlers map it to 409.
+	ErrHintNotOffered = errors.New("question has no hint")
+
 	// ErrInvalidRoundPhase is returned by [Service.MarkRoundSeen] when
 	// the phase is not one of the recognised round boundary phases
 	// (#548). Handlers map it to 400.
 	ErrInvalidRoundPhase = errors.New("invalid round phase")
+
+	// ErrTooSoon is matched by the [*TooSoonError] [Service.GetNext] and
+	// [Service.GetNextQuestion] return when a new question is requested
+	// inside the minimum interval. Handlers map it to 429.
+	ErrTooSoon = errors.New("next question requested too soon")
+
+	// ErrScoresHidden is returned by [Service.GetResults] and
+	// [Service.CompareResults] for a game of a host-paced quiz whose host
+	// has not revealed the scores yet. Handlers map it to 409.
+	ErrScoresHidden = errors.New("scores not revealed yet")
+
+	// ErrRevealNotOffered is returned by [Service.RevealScores] when the
+	// game's quiz is not host-paced, so there is nothing held back to
+	// reveal. Handlers map it to 409.
+	ErrRevealNotOffered = errors.New("quiz is not host-paced")
 )
 
+// GameStatus is a game's lifecycle state.
+type GameStatus string
+
+const (
+	// GameStatusInProgress is a game still being played.
+	GameStatusInProgress GameStatus = "in_progress"
+	// GameStatusFinished is a game whose last question was answered, or that
+	// the player ended after every question had been issued.
+	GameStatusFinished GameStatus = "finished"
+	// GameStatusAbandoned is a game the player ended before reaching the
+	// last question.
+	GameStatusAbandoned GameStatus = "abandoned"
+)
+
+// gameStatuses is the closed set of game lifecycle states.
+//
+//nolint:gochecknoglobals // Fixed value set, read-only after init.
+var gameStatuses = enum.New("game status", GameStatusInProgress, GameStatusFinished, GameStatusAbandoned)
+
+// Valid reports whether s is one of the recognised lifecycle states.
+func (s GameStatus) Valid() bool {
+	return gameStatuses.Valid(s)
+}
+
+// Scan implements [database/sql.Scanner], rejecting an unknown state.
+func (s *GameStatus) Scan(src any) error {
+	return gameStatuses.Scan(s, src) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// Value implements [driver.Valuer], rejecting an unknown state.
+func (s GameStatus) Value() (driver.Value, error) {
+	return gameStatuses.Value(s) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (s GameStatus) MarshalJSON() ([]byte, error) {
+	return gameStatuses.EncodeJSON(s) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (s *GameStatus) UnmarshalJSON(data []byte) error {
+	return gameStatuses.DecodeJSON(s, data) //nolint:wrapcheck // enum errors carry their own context.
+}
+
 // Game represents a game. It is an instance of a quiz being played by a player.
 type Game struct {
 	ID     string
 	QuizID int64
 	Quiz   *quiz.Quiz
 	// Preview marks an owner preview game that stays off the leaderboard and play_count (#1192).
-	Preview      bool
-	CreatedAt    time.Time
-	StartedAt    *time.Time
+	Preview   bool
+	CreatedAt time.Time
+	StartedAt *time.Time
+	// Status is the lifecycle state; FinishedAt is set once it is finished
+	// or abandoned.
+	Status     GameStatus
+	FinishedAt *time.Time
+	// ResultsPublic opts the finished game into the anonymous public
+	// standings; off by default.
+	ResultsPublic bool
+	// RevealedAt is when the host revealed the scores of a game of a
+	// host-paced quiz ([Service.RevealScores]); nil until then, and on
+	// every game of a quiz that is not host-paced.
+	RevealedAt   *time.Time
 	Questions    []*Question
 	Participants []*Participant
 }
@@ -101,6 +209,11 @@ type Participant struct {
 	PlayerID int64
 	QuizID   int64
 	JoinedAt time.Time
+	// PausedQuestionID and PausedAt record the player's one pause of the game
+	// ([Service.PauseOnce]): the quiz question whose timer they paused and
+	// when the pause began. Zero and nil until it is used.
+	PausedQuestionID int64
+	PausedAt         *time.Time
 }
 
 // ItemType discriminates the variants of [Item] returned by
@@ -177,6 +290,10 @@ type Item struct {
 	RoundScore     int
 	RoundCorrect   int
 	RoundQuestions int
+
+	// ScoresHidden marks a results-phase item of a host-paced game whose
+	// scores are still held back: Score and the recap are left at zero.
+	ScoresHidden bool
 }
 
 // Question represents a question in a game. It references a quiz question.
@@ -187,7 +304,10 @@ type Question struct {
 	QuizQuestion *quiz.Question
 	StartedAt    time.Time
 	ExpiredAt    time.Time
-	Answers      []*Answer
+	// Voided marks a question a host voided for this game after it turned
+	// out to be wrong; its answers stay recorded but score nothing.
+	Voided  bool
+	Answers []*Answer
 	// Position is the 1-indexed ordinal of this question in the
 	// game's issued sequence ("Q 3 of 4"). Populated by
 	// [Service.GetNextQuestion]; zero on Questions loaded from the
@@ -206,6 +326,17 @@ type Question struct {
 	RoundTotal     int
 	RoundPosition  int
 	RoundQuestions int
+	// KeepOptionOrder carries the quiz's [quiz.Quiz.KeepOptionOrder] so the
+	// client API lays the options out as written instead of shuffling them.
+	// Populated alongside Position; false on store-loaded Questions.
+	KeepOptionOrder bool
+	// ConfidenceWager carries the quiz's [quiz.Quiz.ConfidenceWager] so the
+	// client asks for a stake before the options. Populated alongside
+	// Position; false on store-loaded Questions.
+	ConfidenceWager bool
+	// Wager is the stake the player locked in with [Service.PlaceWager], nil
+	// until they place one.
+	Wager *int
 }
 
 // Answer represents an answer for a question. Answers are recorded for a specific game and player.
@@ -218,6 +349,60 @@ type Answer struct {
 	OptionID   int64
 	Option     *quiz.Option
 	AnsweredAt time.Time
+	// ElapsedMs is the server's monotonic measurement, in milliseconds, from
+	// the question's answer window opening to the answer arriving, less the
+	// tappedAt latency refund. Negative for an answer before the reveal. Nil
+	// when the serving process did not measure it (a restart in between, or
+	// an answer recorded before the measurement existed); scoring then falls
+	// back to AnsweredAt.
+	ElapsedMs *int64
+	// NumericValue is the number typed for a numeric question, nil for an
+	// option pick. A numeric answer's Option is the question's answer key.
+	NumericValue *float64
+	// OptionIDs is the full pick set of a multi-select answer, nil for a
+	// single pick; OptionID and Option are its first pick. Set on submit
+	// only: a store-loaded answer carries just its Tally.
+	OptionIDs []int64
+	// Tally counts a multi-select answer's picks against its question, nil
+	// for a single pick. Scoring reads it instead of Option.Correct.
+	Tally *quiz.PickTally
+	// Wager is the confidence stake the answer is scored with, copied from
+	// its question when recorded; nil on a quiz without the wager.
+	Wager *int
+	// PausedMs is the part of the player's pause ([Service.PauseOnce]) that
+	// fell between the window opening and the answer, in milliseconds.
+	// Scoring takes it off the answer's time. Zero on unpaused questions.
+	PausedMs int64
+	// HintPenalty is the percentage taken off the answer's points for the
+	// hint the player took ([Service.UseHint]). Goes into
+	// [Store.CreateAnswer] as the service's configured penalty and comes back
+	// as the one recorded: zero unless the player took the hint.
+	HintPenalty int
+	// Scoring is the quiz's scoring mode ([quiz.Quiz.Scoring]) the answer is
+	// scored under, copied from the quiz when recorded so a later change to
+	// the quiz never rescores it. Empty scores as [quiz.ScoringTime].
+	Scoring string
+	// Streak is the player's run of consecutive correct answers in the game
+	// as of this one, this one included: zero for a wrong answer. Recorded by
+	// [Store.CreateAnswer]; the streak scoring mode multiplies by it.
+	Streak int
+	// WrongAnswerPenalty is the points the answer loses when wrong, and
+	// ScoreFloor the lowest the player's game total can fall to; both copied
+	// from the quiz ([quiz.Quiz.WrongAnswerPenalty], [quiz.Quiz.ScoreFloor])
+	// when recorded.
+	WrongAnswerPenalty int
+	ScoreFloor         int
+	// ScoresHidden marks an answer recorded on a host-paced quiz before its
+	// host revealed the game's scores: the player is not told whether it
+	// was right or what it scored until the reveal. Set on submit only.
+	ScoresHidden bool
+}
+
+// IsCorrect reports whether a scores anything on correctness: the picked
+// option is correct, a numeric answer lands within the key's tolerance, or
+// a multi-select answer's correct picks outweigh its wrong ones.
+func (a *Answer) IsCorrect() bool {
+	return answerCredit(a) > 0
 }
 
 // Results represents the accumulated score for each player in a game.
@@ -229,6 +414,14 @@ type Results struct {
 
 	// PlayerScores maps a player's ID to their accumulated CalculateScore in the game.
 	PlayerScores map[int64]int
+
+	// VoidedQuestionIDs lists the quiz question IDs voided for this game, in
+	// issue order. Their answers are left out of PlayerScores.
+	VoidedQuestionIDs []int64
+
+	// Status and FinishedAt mirror the game's lifecycle.
+	Status     GameStatus
+	FinishedAt *time.Time
 }
 
 // LeaderboardAnswer is a flat row for the per-quiz leaderboard. It
@@ -238,13 +431,35 @@ type Results struct {
 // longer reads it - the store-level test pins the completion
 // predicate on it.
 type LeaderboardAnswer struct {
+	GameID            string
 	PlayerID          int64
 	DisplayName       string
 	QuestionStartedAt time.Time
 	QuestionExpiredAt time.Time
 	AnsweredAt        time.Time
+	ElapsedMs         *int64
 	Correct           bool
 	IsCompleted       bool
+	// NumericValue is the number typed for a numeric question, and NumericKey
+	// that question's answer key option; both nil for an option pick.
+	NumericValue *float64
+	NumericKey   *quiz.Option
+	// Tally counts a multi-select answer's picks, nil for a single pick.
+	Tally *quiz.PickTally
+	// Wager is the answer's confidence stake, nil without one.
+	Wager *int
+	// PausedMs is the paused time taken off the answer's time.
+	PausedMs int64
+	// HintPenalty is the percentage the answer lost to a hint.
+	HintPenalty int
+	// Scoring and Streak are the scoring mode and run of correct answers the
+	// answer was recorded under.
+	Scoring string
+	Streak  int
+	// WrongAnswerPenalty and ScoreFloor are the negative marking the answer
+	// was recorded under.
+	WrongAnswerPenalty int
+	ScoreFloor         int
 }
 
 // LeaderboardParticipant is the minimum needed to surface a player on
@@ -327,6 +542,10 @@ type Store interface {
 	// durable hit counter cannot drift from the games-become-completed
 	// transition that fires alongside the final question.
 	CreateQuestion(ctx context.Context, gq *Question, completesGame bool) error
+	// GetNextUnaskedQuestion returns the lowest-position question of the
+	// game's quiz, with its options, that has not been issued to the game
+	// yet. Returns [ErrNoMoreQuestions] when every question was issued.
+	GetNextUnaskedQuestion(ctx context.Context, gameID string) (*quiz.Question, error)
 	CreateAnswer(ctx context.Context, a *Answer) error
 	// ListAnswersForQuizLeaderboard returns one row per game_answer for
 	// every game (finished or in-progress) of the given quiz, joined with
@@ -353,6 +572,16 @@ type Store interface {
 	// fan out a leaderboard republish on every quiz the player appears
 	// on.
 	ListQuizIDsForPlayer(ctx context.Context, playerID int64) ([]int64, error)
+	// ListPlayerGameHistory returns one page of the player's non-preview
+	// games, newest first, and the total number of them.
+	ListPlayerGameHistory(ctx context.Context, playerID, limit, offset int64) ([]*PlayedGame, int64, error)
+	// ListQuizPlayReport returns the play counts of every quiz with a
+	// non-preview game started in [from, to), most played first.
+	ListQuizPlayReport(ctx context.Context, from, to time.Time) ([]*QuizPlayCount, error)
+	// ListAnswersForPlayReport returns every answer, with its scoring
+	// inputs, given in a non-preview game started in [from, to). Answers
+	// to voided questions are left out.
+	ListAnswersForPlayReport(ctx context.Context, from, to time.Time) ([]*ReportAnswer, error)
 	// MarkRoundSeen records that the player has acknowledged the given
 	// phase of the round boundary in the given game (#548). Idempotent:
 	// a second call with the same (gameID, roundID, phase) is a no-op.
@@ -362,6 +591,35 @@ type Store interface {
 	// round-walking iterator in [Service.GetNext] uses this set to skip
 	// past seen round boundary phases (#548).
 	ListSeenRoundPhasesByGame(ctx context.Context, gameID string) ([]SeenRoundPhase, error)
+	// VoidQuestion marks the quiz question as voided for the given game so
+	// its answers stop scoring there. Idempotent: voiding it again keeps the
+	// first void. Returns [ErrQuestionNotInGame] when the question was never
+	// issued to the game.
+	VoidQuestion(ctx context.Context, gameID string, questionID int64) error
+	// PlaceWager locks in the confidence stake on the quiz question issued
+	// to the given game. Returns [ErrWagerAlreadyPlaced] when a stake is
+	// already there.
+	PlaceWager(ctx context.Context, gameID string, questionID int64, wager int) error
+	// PauseParticipant spends the player's one pause of the game on the
+	// given quiz question, stamped at pausedAt. Returns [ErrPauseAlreadyUsed]
+	// when the participation already has one.
+	PauseParticipant(ctx context.Context, gameID string, playerID, questionID int64, pausedAt time.Time) error
+	// RecordHint marks the hint of the issued question gameQuestionID as
+	// taken by the player. Idempotent: taking it again keeps the first.
+	RecordHint(ctx context.Context, gameQuestionID, playerID int64) error
+	// FinishGame moves the game to the terminal status (finished or
+	// abandoned) and stamps FinishedAt. Reports false when the game was
+	// already over, leaving its first status in place.
+	FinishGame(ctx context.Context, gameID string, status GameStatus) (bool, error)
+	// SetResultsPublic turns the game's public standings on or off. Returns
+	// [ErrGameNotFound] when the game does not exist.
+	SetResultsPublic(ctx context.Context, gameID string, public bool) error
+	// RevealGame stamps the game's RevealedAt. Reports false when it was
+	// already revealed, leaving the first stamp in place.
+	RevealGame(ctx context.Context, gameID string) (bool, error)
+	// ListParticipantNames maps each participant's player ID to their
+	// display name.
+	ListParticipantNames(ctx context.Context, gameID string) (map[int64]string, error)
 }
 
 // SeenRoundPhase is one acknowledged round boundary phase: the round
@@ -380,6 +638,29 @@ type LeaderboardPublisher interface {
 	Publish(quizID int64)
 }
 
+// AnalyticsEmitter is the seam Service uses to export gameplay analytics
+// events. Implemented by *analytics.Emitter in production; nil-by-default
+// like [LeaderboardPublisher]. Emit must not block.
+type AnalyticsEmitter interface {
+	Emit(e analytics.Event)
+}
+
+// LoggedEvent is one entry of a game's event log: the event as the service
+// emitted it, plus the entry's sequence number in the log and the time it
+// was stored.
+type LoggedEvent struct {
+	analytics.Event
+
+	ID         int64     `json:"id"`
+	RecordedAt time.Time `json:"recordedAt"`
+}
+
+// EventLogReader reads a game's event log, oldest entry first. Implemented
+// by *store.GameEventStore.
+type EventLogReader interface {
+	ListGameEvents(ctx context.Context, gameID string) ([]*LoggedEvent, error)
+}
+
 // hasParticipant reports whether playerID is one of the game's
 // participants. Used by the service entry points to gate gameID-keyed
 // reads and writes on participant membership (#272) so a stranger who
@@ -408,6 +689,13 @@ func (g *Game) IsCompleted() bool {
 	return len(g.Questions) >= len(g.Quiz.Questions) && len(g.Quiz.Questions) > 0
 }
 
+// IsFinished reports whether the game reached a terminal status (finished
+// or abandoned). Unlike [Game.IsCompleted] it needs no quiz: it is the
+// stored lifecycle, not a count of issued questions.
+func (g *Game) IsFinished() bool {
+	return g.FinishedAt != nil
+}
+
 // HasOpenQuestion reports whether the most recently issued question for
 // this game is still resumable: unanswered, with the answer window not
 // yet closed. The HTTP resume probe (/my-game, #310) treats a game with
@@ -535,6 +823,8 @@ func resumeCandidate(g *Game, qz *quiz.Quiz) *Question {
 	resumed.QuizQuestion = qq
 	resumed.Position = len(g.Questions)
 	resumed.Total = len(qz.Questions)
+	resumed.KeepOptionOrder = qz.KeepOptionOrder
+	resumed.ConfidenceWager = qz.ConfidenceWager
 	applyRoundProgress(&resumed, qz)
 
 	return &resumed
@@ -561,6 +851,9 @@ func findQuizQuestion(qz *quiz.Quiz, questionID int64) *quiz.Question {
 func (s *Service) GetQuizLeaderboard(
 	ctx context.Context, quizID, currentPlayerID int64, limit int,
 ) (*LeaderboardResult, error) {
+	ctx, span := tracing.Start(ctx, "game.GetQuizLeaderboard", tracing.Int64("quiz.id", quizID))
+	defer span.End()
+
 	if limit <= 0 {
 		limit = defaultLeaderboardLimit
 	}
@@ -581,30 +874,14 @@ func (s *Service) GetQuizLeaderboard(
 	// have not submitted an answer yet. The answers query below only
 	// contributes per-row scoring inputs that roll up into each entry's
 	// running total.
-	participants, err := s.store.ListParticipantsForQuizLeaderboard(ctx, quizID, time.Now().Add(-s.stalePeriod))
+	participants, err := s.store.ListParticipantsForQuizLeaderboard(ctx, quizID, s.now().Add(-s.stalePeriod))
 	if err != nil {
 		return nil, fmt.Errorf("failed to list leaderboard participants: %w", err)
 	}
 
-	rows, err := s.store.ListAnswersForQuizLeaderboard(ctx, quizID)
+	playerTotals, err := s.answerTotals(ctx, quizID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list leaderboard answers: %w", err)
-	}
-
-	playerTotals := make(map[int64]int)
-	for _, r := range rows {
-		// Synthesise just enough of an *Answer / *Question / *quiz.Option
-		// for CalculateScore. The formula touches only Option.Correct,
-		// Question.StartedAt, Question.ExpiredAt, and Answer.AnsweredAt.
-		a := &Answer{
-			AnsweredAt: r.AnsweredAt,
-			Question: &Question{
-				StartedAt: r.QuestionStartedAt,
-				ExpiredAt: r.QuestionExpiredAt,
-			},
-			Option: &quiz.Option{Correct: r.Correct},
-		}
-		playerTotals[r.PlayerID] += s.CalculateScore(ctx, a)
+		return nil, err
 	}
 
 	entries := leaderboardEntries(participants, playerTotals, currentPlayerID)
@@ -646,6 +923,94 @@ func leaderboardEntries(
 	return entries
 }
 
+// QuizScoreTotals returns the running total of every player with a
+// non-preview game on the quiz, keyed by player id. A player who joined but
+// has not answered yet maps to 0, matching their leaderboard entry. The
+// tournament standings sum these across a tournament's quizzes.
+func (s *Service) QuizScoreTotals(ctx context.Context, quizID int64) (map[int64]int, error) {
+	ctx, span := tracing.Start(ctx, "game.QuizScoreTotals", tracing.Int64("quiz.id", quizID))
+	defer span.End()
+
+	participants, err := s.store.ListParticipantsForQuizLeaderboard(ctx, quizID, s.now().Add(-s.stalePeriod))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quiz participants: %w", err)
+	}
+
+	totals, err := s.answerTotals(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range participants {
+		if _, ok := totals[p.PlayerID]; !ok {
+			totals[p.PlayerID] = 0
+		}
+	}
+
+	return totals, nil
+}
+
+// answerTotals scores every answer on the quiz's non-preview games and sums
+// them per player. Each game's total is held at its score floor
+// ([FloorTotal]) before it is added, as the game results and the play report
+// hold it, so a game below the floor cannot eat into another game's points.
+// Players without an answer are absent from the map.
+func (s *Service) answerTotals(ctx context.Context, quizID int64) (map[int64]int, error) {
+	rows, err := s.store.ListAnswersForQuizLeaderboard(ctx, quizID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leaderboard answers: %w", err)
+	}
+
+	plays := make(map[playKey]*playTotal)
+	for _, r := range rows {
+		a := r.scoringAnswer()
+		key := playKey{gameID: r.GameID, playerID: r.PlayerID}
+		if plays[key] == nil {
+			plays[key] = &playTotal{quizID: quizID}
+		}
+		plays[key].score += s.CalculateScore(ctx, a)
+		plays[key].last = a
+	}
+
+	playerTotals := make(map[int64]int)
+	for key, p := range plays {
+		playerTotals[key.playerID] += FloorTotal(p.score, p.last)
+	}
+
+	return playerTotals, nil
+}
+
+// scoringAnswer synthesises just enough of an *Answer / *Question /
+// *quiz.Option for CalculateScore. The formula touches only the Option,
+// Question.StartedAt, Question.ExpiredAt, Answer.AnsweredAt,
+// Answer.ElapsedMs, Answer.NumericValue, Answer.Tally, Answer.Wager,
+// Answer.PausedMs, Answer.HintPenalty, Answer.Scoring, Answer.Streak,
+// Answer.WrongAnswerPenalty and Answer.ScoreFloor.
+func (r *LeaderboardAnswer) scoringAnswer() *Answer {
+	a := &Answer{
+		AnsweredAt:         r.AnsweredAt,
+		ElapsedMs:          r.ElapsedMs,
+		NumericValue:       r.NumericValue,
+		Tally:              r.Tally,
+		Wager:              r.Wager,
+		PausedMs:           r.PausedMs,
+		HintPenalty:        r.HintPenalty,
+		Scoring:            r.Scoring,
+		Streak:             r.Streak,
+		WrongAnswerPenalty: r.WrongAnswerPenalty,
+		ScoreFloor:         r.ScoreFloor,
+		Question: &Question{
+			StartedAt: r.QuestionStartedAt,
+			ExpiredAt: r.QuestionExpiredAt,
+		},
+		Option: &quiz.Option{Correct: r.Correct},
+	}
+	if r.NumericKey != nil {
+		a.Option = r.NumericKey
+	}
+
+	return a
+}
+
 // finalizeLeaderboardInPlace stamps 1-indexed rank on every entry, extracts the
 // current player's standing from the full ordering (so a player outside
 // the visible top-N still gets a Rank that matches their global position),
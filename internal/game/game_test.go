@@ -31,6 +31,9 @@ type stubStore struct {
 	listQuizIDsForPlayer               func(ctx context.Context, playerID int64) ([]int64, error)
 	markRoundSeen                      func(ctx context.Context, gameID string, roundID int64, phase RoundPhase) error
 	listSeenRoundPhasesByGame          func(ctx context.Context, gameID string) ([]SeenRoundPhase, error)
+	listParticipantNames               func(ctx context.Context, gameID string) (map[int64]string, error)
+	listQuizPlayReport                 func(ctx context.Context, from, to time.Time) ([]*QuizPlayCount, error)
+	listAnswersForPlayReport           func(ctx context.Context, from, to time.Time) ([]*ReportAnswer, error)
 }
 
 func (stubStore) Ping(_ context.Context) error { return nil }
@@ -70,6 +73,51 @@ func (stubStore) StartGame(_ context.Context, _ string) error                 {
 func (stubStore) CreateParticipant(_ context.Context, _ *Participant) error   { return errStub }
 func (stubStore) CreateQuestion(_ context.Context, _ *Question, _ bool) error { return errStub }
 func (stubStore) CreateAnswer(_ context.Context, _ *Answer) error             { return errStub }
+func (stubStore) VoidQuestion(_ context.Context, _ string, _ int64) error     { return errStub }
+func (stubStore) PlaceWager(_ context.Context, _ string, _ int64, _ int) error {
+	return errStub
+}
+func (stubStore) PauseParticipant(_ context.Context, _ string, _, _ int64, _ time.Time) error {
+	return errStub
+}
+func (stubStore) RecordHint(_ context.Context, _, _ int64) error { return errStub }
+func (stubStore) FinishGame(_ context.Context, _ string, _ GameStatus) (bool, error) {
+	return false, errStub
+}
+func (stubStore) SetResultsPublic(_ context.Context, _ string, _ bool) error { return errStub }
+func (stubStore) RevealGame(_ context.Context, _ string) (bool, error)       { return false, errStub }
+
+func (stubStore) ListPlayerGameHistory(_ context.Context, _, _, _ int64) ([]*PlayedGame, int64, error) {
+	return nil, 0, errStub
+}
+
+func (s stubStore) ListQuizPlayReport(ctx context.Context, from, to time.Time) ([]*QuizPlayCount, error) {
+	if s.listQuizPlayReport == nil {
+		return nil, errStub
+	}
+
+	return s.listQuizPlayReport(ctx, from, to)
+}
+
+func (s stubStore) ListAnswersForPlayReport(ctx context.Context, from, to time.Time) ([]*ReportAnswer, error) {
+	if s.listAnswersForPlayReport == nil {
+		return nil, errStub
+	}
+
+	return s.listAnswersForPlayReport(ctx, from, to)
+}
+
+func (s stubStore) ListParticipantNames(ctx context.Context, gameID string) (map[int64]string, error) {
+	if s.listParticipantNames == nil {
+		return nil, errStub
+	}
+
+	return s.listParticipantNames(ctx, gameID)
+}
+
+func (stubStore) GetNextUnaskedQuestion(_ context.Context, _ string) (*quiz.Question, error) {
+	return nil, errStub
+}
 
 func (s stubStore) ListAnswersForQuizLeaderboard(
 	ctx context.Context, quizID int64,
@@ -161,11 +209,12 @@ func (s stubStore) ListSeenRoundPhasesByGame(
 	return s.listSeenRoundPhasesByGame(ctx, gameID)
 }
 
-// stubQuizStore satisfies quiz.Store for service-level tests. Only GetQuiz
-// and QuizExists are overridable since the leaderboard/reset paths never
-// reach the other methods.
+// stubQuizStore satisfies quiz.Store for service-level tests. Only GetQuiz,
+// GetQuizMeta and QuizExists are overridable since the leaderboard/reset
+// paths never reach the other methods.
 type stubQuizStore struct {
 	getQuiz         func(ctx context.Context, id int64) (*quiz.Quiz, error)
+	getQuizMeta     func(ctx context.Context, id int64) (*quiz.Quiz, error)
 	quizExists      func(ctx context.Context, id int64) (bool, error)
 	getOptionsByIDs func(ctx context.Context, ids []int64) ([]*quiz.Option, error)
 }
@@ -188,6 +237,26 @@ func (stubQuizStore) ListLiveQuizzesForOwner(_ context.Context, _ int64) ([]*qui
 	return nil, errStub
 }
 
+func (stubQuizStore) ListQuizzesPage(_ context.Context, _ int64, _ string, _, _ int64) ([]*quiz.Quiz, error) {
+	return nil, errStub
+}
+
+func (stubQuizStore) CountQuizzes(_ context.Context, _ int64, _ string) (int64, error) {
+	return 0, errStub
+}
+
+func (stubQuizStore) ListPublicQuizzesPage(_ context.Context, _, _ int64) ([]*quiz.Quiz, error) {
+	return nil, errStub
+}
+
+func (stubQuizStore) CountPublicQuizzes(_ context.Context) (int64, error) {
+	return 0, errStub
+}
+
+func (stubQuizStore) SearchQuizzes(_ context.Context, _ string, _ quiz.SearchOptions) ([]*quiz.Quiz, int64, error) {
+	return nil, 0, errStub
+}
+
 func (stubQuizStore) QuestionCountsByQuiz(_ context.Context) (map[int64]int, error) {
 	return nil, errStub
 }
@@ -212,17 +281,29 @@ func (s stubQuizStore) QuizExists(ctx context.Context, id int64) (bool, error) {
 	return s.quizExists(ctx, id)
 }
 
-func (stubQuizStore) GetQuizMeta(_ context.Context, _ int64) (*quiz.Quiz, error) {
-	return nil, errStub
+func (s stubQuizStore) GetQuizMeta(ctx context.Context, id int64) (*quiz.Quiz, error) {
+	if s.getQuizMeta == nil {
+		return nil, errStub
+	}
+
+	return s.getQuizMeta(ctx, id)
 }
 
 func (stubQuizStore) GetQuizVisibility(_ context.Context, _ int64) (string, error) {
 	return "", errStub
 }
-func (stubQuizStore) CreateQuiz(_ context.Context, _ *quiz.Quiz) error       { return errStub }
-func (stubQuizStore) UpdateQuiz(_ context.Context, _ *quiz.Quiz) error       { return errStub }
-func (stubQuizStore) DeleteQuiz(_ context.Context, _ int64) error            { return errStub }
-func (stubQuizStore) SetQuizMode(_ context.Context, _ int64, _ string) error { return errStub }
+
+func (stubQuizStore) GetQuizIDByExternalRef(_ context.Context, _ string) (int64, error) {
+	return 0, errStub
+}
+
+func (stubQuizStore) GetQuizIDBySlug(_ context.Context, _ string) (int64, error) {
+	return 0, errStub
+}
+func (stubQuizStore) CreateQuiz(_ context.Context, _ *quiz.Quiz) error          { return errStub }
+func (stubQuizStore) UpdateQuiz(_ context.Context, _ *quiz.Quiz) error          { return errStub }
+func (stubQuizStore) DeleteQuiz(_ context.Context, _ int64) error               { return errStub }
+func (stubQuizStore) SetQuizMode(_ context.Context, _ int64, _ quiz.Mode) error { return errStub }
 func (stubQuizStore) SetQuizPublished(_ context.Context, _ int64, _ bool) error {
 	return errStub
 }
@@ -256,6 +337,14 @@ func (stubQuizStore) GetQuestion(_ context.Context, _ int64) (*quiz.Question, er
 	return nil, errStub
 }
 
+func (stubQuizStore) GetQuestionByExternalRef(_ context.Context, _ int64, _ string) (*quiz.Question, error) {
+	return nil, errStub
+}
+
+func (stubQuizStore) GetQuestionProgress(_ context.Context, _ int64) (*quiz.QuestionProgress, error) {
+	return nil, errStub
+}
+
 func (stubQuizStore) GetOption(_ context.Context, _ int64) (*quiz.Option, error) {
 	return nil, errStub
 }
@@ -302,6 +391,26 @@ func (stubQuizStore) MoveQuestionToPosition(_ context.Context, _, _, _ int64, _
 	return errStub
 }
 
+func (stubQuizStore) QuestionStats(_ context.Context, _ int64) (map[int64]quiz.QuestionStats, error) {
+	return nil, errStub
+}
+
+func (stubQuizStore) RenumberQuestions(_ context.Context, _ int64, _ string) error {
+	return errStub
+}
+
+func (stubQuizStore) GetQuestionDraft(_ context.Context, _, _ int64) (*quiz.QuestionDraft, error) {
+	return nil, errStub
+}
+
+func (stubQuizStore) SaveQuestionDraft(_ context.Context, _ *quiz.QuestionDraft) error {
+	return errStub
+}
+
+func (stubQuizStore) DeleteQuestionDraft(_ context.Context, _, _ int64) error {
+	return errStub
+}
+
 func TestGame_IsCompleted(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,81 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// DefaultHintPenalty is the percentage of an answer's points a hint costs
+// until [Service.SetHintPenalty] says otherwise.
+const DefaultHintPenalty = 50
+
+// applyHintPenalty takes a's hint penalty share off points. A wrong answer
+// scores zero already, so the penalty only ever shrinks a correct one.
+func applyHintPenalty(points int, a *Answer) int {
+	return points * (100 - a.HintPenalty) / 100
+}
+
+// SetHintPenalty sets the percentage of an answer's points taken off when
+// the player took the question's hint. Answers already recorded keep the
+// penalty they were recorded with. Same startup-only rule as
+// [Service.SetRevealDelay].
+func (s *Service) SetHintPenalty(percent int) {
+	s.hintPenalty = percent
+}
+
+// HintPenalty reports the percentage a hint takes off an answer's points.
+func (s *Service) HintPenalty() int {
+	return s.hintPenalty
+}
+
+// UseHint returns the hint of an issued question and marks it as taken by
+// the player, so their answer to it loses [Service.HintPenalty] percent of
+// its points. Asking again returns the same hint at no extra cost. A
+// question without a hint is [ErrHintNotOffered]; one already answered is
+// [ErrAnswerAlreadyRecorded], and one past its answer window is
+// [ErrAnswerWindowClosed]. Non-participants get [ErrGameNotFound], the same
+// gate as [Service.SubmitAnswer].
+func (s *Service) UseHint(ctx context.Context, gameID string, playerID, questionID int64) (string, error) {
+	ctx, span := tracing.Start(ctx, "game.UseHint", tracing.String("game.id", gameID))
+	defer span.End()
+
+	g, err := s.store.GetGame(ctx, gameID)
+	if err != nil {
+		return "", fmt.Errorf(errGetGameFmt, err)
+	}
+	if !hasParticipant(g, playerID) {
+		return "", ErrGameNotFound
+	}
+	if g.IsFinished() {
+		return "", ErrGameFinished
+	}
+
+	idx := slices.IndexFunc(g.Questions, func(gq *Question) bool { return gq.QuestionID == questionID })
+	if idx < 0 {
+		return "", fmt.Errorf("question %d not found in game %s: %w", questionID, gameID, ErrQuestionNotInGame)
+	}
+	question := g.Questions[idx]
+	if slices.ContainsFunc(question.Answers, func(a *Answer) bool { return a.PlayerID == playerID }) {
+		return "", ErrAnswerAlreadyRecorded
+	}
+	if s.now().After(question.ExpiredAt.Add(lateAnswerGrace)) {
+		return "", ErrAnswerWindowClosed
+	}
+
+	qq, err := s.quizStore.GetQuestion(ctx, questionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get question %d: %w", questionID, err)
+	}
+	if qq.Hint == "" {
+		return "", ErrHintNotOffered
+	}
+
+	if err = s.store.RecordHint(ctx, question.ID, playerID); err != nil {
+		return "", fmt.Errorf("failed to record hint: %w", err)
+	}
+
+	return qq.Hint, nil
+}
@@ -0,0 +1,134 @@
+package game_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// TestCalculateScore_HintPenalty pins that the hint penalty takes its share
+// off the points before the confidence wager scales them.
+func TestCalculateScore_HintPenalty(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 8, 26, 12, 0, 0, 0, time.UTC)
+	svc := NewService(stubStore{}, nil, slog.New(slog.DiscardHandler))
+	wager := 2
+	a := &Answer{
+		Question:    &Question{StartedAt: startedAt, ExpiredAt: startedAt.Add(10 * time.Second)},
+		Option:      &quiz.Option{Correct: true},
+		AnsweredAt:  startedAt.Add(2 * time.Second),
+		HintPenalty: 25,
+		Wager:       &wager,
+	}
+	if got, want := svc.CalculateScore(t.Context(), a), 1200; got != want {
+		t.Errorf("CalculateScore() = %d, want %d", got, want)
+	}
+}
+
+// TestService_UseHint pins the hint end to end: it returns the question's
+// hint, asking twice costs the penalty once, only the hinted answer loses it,
+// the game results and the quiz leaderboard agree, and a question without a
+// hint or already answered is refused.
+func TestService_UseHint(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Nudge",
+		Slug:              "nudge",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		Questions: []*quiz.Question{
+			{
+				Text: "2 + 2?", Hint: "Count your thumbs twice.", Position: 10,
+				Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}},
+			},
+			{Text: "3 + 3?", Position: 20, Options: []*quiz.Option{{Text: "6", Correct: true}, {Text: "7"}}},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	now := time.Date(2026, 8, 26, 12, 0, 0, 0, time.UTC)
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+	svc.SetClock(func() time.Time { return now })
+	svc.SetHintPenalty(40)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+
+	if _, err = svc.UseHint(ctx, g.ID, 2, gq.QuizQuestion.ID); !errors.Is(err, ErrGameNotFound) {
+		t.Errorf("UseHint by a non-participant err = %v, want %v", err, ErrGameNotFound)
+	}
+	for range 2 {
+		hint, herr := svc.UseHint(ctx, g.ID, 1, gq.QuizQuestion.ID)
+		if herr != nil {
+			t.Fatalf("UseHint err = %v, want nil", herr)
+		}
+		if got, want := hint, "Count your thumbs twice."; got != want {
+			t.Errorf("UseHint = %q, want %q", got, want)
+		}
+	}
+
+	a, err := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	if got, want := a.HintPenalty, 40; got != want {
+		t.Errorf("hinted answer HintPenalty = %d, want %d", got, want)
+	}
+	if got, want := svc.CalculateScore(ctx, a), 600; got != want {
+		t.Errorf("hinted answer scored %d, want %d", got, want)
+	}
+	if _, err = svc.UseHint(ctx, g.ID, 1, gq.QuizQuestion.ID); !errors.Is(err, ErrAnswerAlreadyRecorded) {
+		t.Errorf("UseHint after answering err = %v, want %v", err, ErrAnswerAlreadyRecorded)
+	}
+
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("second GetNextQuestion err = %v, want nil", err)
+	}
+	if _, err = svc.UseHint(ctx, g.ID, 1, gq.QuizQuestion.ID); !errors.Is(err, ErrHintNotOffered) {
+		t.Errorf("UseHint without a hint err = %v, want %v", err, ErrHintNotOffered)
+	}
+	a, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("second SubmitAnswer err = %v, want nil", err)
+	}
+	if got := a.HintPenalty; got != 0 {
+		t.Errorf("unhinted answer HintPenalty = %d, want 0", got)
+	}
+
+	results, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetResults err = %v, want nil", err)
+	}
+	if got, want := results.PlayerScores[1], 1600; got != want {
+		t.Errorf("results score = %d, want %d", got, want)
+	}
+	board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+	if err != nil {
+		t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+	}
+	if len(board.Entries) != 1 || board.Entries[0].Score != 1600 {
+		t.Errorf("leaderboard = %+v, want one entry scoring 1600", board.Entries)
+	}
+}
@@ -0,0 +1,71 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// PlayedGame is one of a player's past games, as the store lists it for the
+// game history: the quiz it was a game of, its lifecycle, and how many of
+// the quiz's questions the player answered. ScoresHidden marks a game of a
+// host-paced quiz whose host has not revealed the scores yet.
+type PlayedGame struct {
+	GameID        string
+	QuizID        int64
+	QuizTitle     string
+	QuizSlug      string
+	CreatedAt     time.Time
+	FinishedAt    *time.Time
+	Status        GameStatus
+	ScoresHidden  bool
+	AnsweredCount int
+	QuestionCount int
+}
+
+// GameHistoryEntry is a [PlayedGame] with the player's standing on its quiz:
+// their score and their rank on the quiz leaderboard. Both are 0 while the
+// game's scores are held back.
+type GameHistoryEntry struct {
+	PlayedGame
+
+	Score int
+	Rank  int
+}
+
+// GetGameHistory returns one page of the player's past games, newest first,
+// with their score and leaderboard rank in each, and the total number of
+// games. Owner previews are left out. A player has one real game per quiz,
+// so the score is their quiz leaderboard total and the rank the position
+// the leaderboard shows them at.
+func (s *Service) GetGameHistory(
+	ctx context.Context, playerID int64, limit, offset int64,
+) ([]*GameHistoryEntry, int64, error) {
+	ctx, span := tracing.Start(ctx, "game.GetGameHistory", tracing.Int64("player.id", playerID))
+	defer span.End()
+
+	played, total, err := s.store.ListPlayerGameHistory(ctx, playerID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list game history: %w", err)
+	}
+
+	entries := make([]*GameHistoryEntry, 0, len(played))
+	for _, pg := range played {
+		entry := &GameHistoryEntry{PlayedGame: *pg}
+		if !pg.ScoresHidden {
+			board, err := s.GetQuizLeaderboard(ctx, pg.QuizID, playerID, 1)
+			if err != nil {
+				return nil, 0, fmt.Errorf("failed to get standing on quiz %d: %w", pg.QuizID, err)
+			}
+			if cp := board.CurrentPlayer; cp != nil {
+				entry.Score = cp.Score
+				entry.Rank = cp.Rank
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,128 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// FinishGame ends the game for the player: finished when every quiz question
+// was already issued and none is still open, abandoned otherwise. Idempotent:
+// a game that is already over is returned unchanged, with the status it first
+// reached. Non-participants get [ErrGameNotFound], as on every gameID-keyed
+// entry point (#272).
+func (s *Service) FinishGame(ctx context.Context, gameID string, playerID int64) (*Game, error) {
+	ctx, span := tracing.Start(ctx, "game.FinishGame", tracing.String("game.id", gameID))
+	defer span.End()
+
+	g, _, err := s.loadGameForPlayer(ctx, gameID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if g.IsFinished() {
+		return g, nil
+	}
+
+	status := GameStatusAbandoned
+	if g.IsCompleted() && !g.HasOpenQuestion() {
+		status = GameStatusFinished
+	}
+	transitioned, err := s.store.FinishGame(ctx, gameID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to finish game: %w", err)
+	}
+
+	// Re-read for FinishedAt, and for the status a concurrent finish may
+	// have set first.
+	finished, err := s.store.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf(errGetGameFmt, err)
+	}
+	if transitioned {
+		s.emitGameFinished(ctx, finished, playerID)
+		// The player's in-progress dot goes out with the game.
+		if s.leaderboardPublisher != nil && !g.Preview {
+			s.leaderboardPublisher.Publish(g.QuizID)
+		}
+	}
+
+	return finished, nil
+}
+
+// finishOnLastAnswer finishes the game when a just-recorded answer completes
+// its last quiz question - every participant has now answered it - reporting
+// whether this call made the transition. Best
+// effort like [Service.checkAnswerAnomalies]: the answer is already stored,
+// so a failure is logged and the game is finished later, by the next call
+// that finds nothing left to ask.
+func (s *Service) finishOnLastAnswer(ctx context.Context, g *Game, a *Answer) bool {
+	if g.Questions[len(g.Questions)-1] != a.Question {
+		return false
+	}
+	progress, err := s.quizStore.GetQuestionProgress(ctx, a.Question.QuestionID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "error reading quiz progress to finish game", slog.Any("err", err))
+
+		return false
+	}
+	if len(g.Questions) < progress.Total || !allParticipantsAnswered(g, a) {
+		return false
+	}
+
+	return s.finish(ctx, g, GameStatusFinished)
+}
+
+// allParticipantsAnswered reports whether, counting the just-recorded a,
+// every participant has answered a's question. In a shared game the first
+// answer to the last question must not lock the others out of theirs.
+func allParticipantsAnswered(g *Game, a *Answer) bool {
+	for _, p := range g.Participants {
+		if p.PlayerID == a.PlayerID {
+			continue
+		}
+		if !slices.ContainsFunc(a.Question.Answers, func(qa *Answer) bool { return qa.PlayerID == p.PlayerID }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// finishExhausted finishes a game whose play sequence just ran out, which
+// covers a last question that expired unanswered. Best effort.
+func (s *Service) finishExhausted(ctx context.Context, g *Game) {
+	if g.IsFinished() {
+		return
+	}
+	s.finish(ctx, g, GameStatusFinished)
+}
+
+// finish records the terminal status, logging a failure, and reports
+// whether this call made the transition.
+func (s *Service) finish(ctx context.Context, g *Game, status GameStatus) bool {
+	transitioned, err := s.store.FinishGame(ctx, g.ID, status)
+	if err != nil {
+		s.logger.WarnContext(ctx, "error finishing game", slog.String("gameId", g.ID), slog.Any("err", err))
+
+		return false
+	}
+
+	return transitioned
+}
+
+// finishedGameAnswerErr is SubmitAnswer's refusal on a finished game. A
+// retry of an answer that was recorded keeps its [ErrAnswerAlreadyRecorded]
+// so the client's double-tap recovery (#353) still holds for the answer that
+// finished the game; anything else is [ErrGameFinished].
+func finishedGameAnswerErr(question *Question, playerID int64) error {
+	for _, a := range question.Answers {
+		if a.PlayerID == playerID {
+			return ErrAnswerAlreadyRecorded
+		}
+	}
+
+	return ErrGameFinished
+}
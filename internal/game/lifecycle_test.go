@@ -0,0 +1,177 @@
+package game_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// newLifecycleService seeds newTestQuiz and a second player, and returns a
+// service over them.
+func newLifecycleService(t *testing.T) (*Service, *quiz.Quiz, *store.GameStore) {
+	t.Helper()
+
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := newTestQuiz(t)
+	if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	insertPlayer2 := `INSERT INTO players (id, display_name, email, created_at) VALUES (2, 'player2', 'player2@test.com', CURRENT_TIMESTAMP)`
+	if _, err := db.ExecContext(t.Context(), insertPlayer2); err != nil {
+		t.Fatalf("failed to insert player 2: %v", err)
+	}
+
+	return NewService(gameStore, quizStore, slog.Default()), testQuiz, gameStore
+}
+
+func TestService_FinishesOnLastAnswer(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	svc, testQuiz, _ := newLifecycleService(t)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	var last *Question
+	for i := range testQuiz.Questions {
+		if last, err = svc.GetNextQuestion(ctx, g.ID, 1); err != nil {
+			t.Fatalf("GetNextQuestion %d err = %v, want nil", i, err)
+		}
+		if _, err = svc.SubmitAnswer(ctx, g.ID, 1, last.QuizQuestion.ID, last.QuizQuestion.Options[0].ID, time.Time{}); err != nil {
+			t.Fatalf("SubmitAnswer %d err = %v, want nil", i, err)
+		}
+	}
+
+	results, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetResults err = %v, want nil", err)
+	}
+	if got, want := results.Status, GameStatusFinished; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+	if results.FinishedAt == nil {
+		t.Error("FinishedAt = nil, want the finish time")
+	}
+
+	// A retry of the final answer keeps its 409 (#353).
+	_, err = svc.SubmitAnswer(ctx, g.ID, 1, last.QuizQuestion.ID, last.QuizQuestion.Options[0].ID, time.Time{})
+	if !errors.Is(err, ErrAnswerAlreadyRecorded) {
+		t.Errorf("SubmitAnswer retry err = %v, want %v", err, ErrAnswerAlreadyRecorded)
+	}
+	if _, err = svc.GetNextQuestion(ctx, g.ID, 1); !errors.Is(err, ErrNoMoreQuestions) {
+		t.Errorf("GetNextQuestion err = %v, want %v", err, ErrNoMoreQuestions)
+	}
+}
+
+func TestService_FinishGame(t *testing.T) {
+	t.Parallel()
+
+	t.Run("abandons a game with questions left", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		svc, testQuiz, _ := newLifecycleService(t)
+
+		g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+		if err != nil {
+			t.Fatalf("GetNextQuestion err = %v, want nil", err)
+		}
+
+		finished, err := svc.FinishGame(ctx, g.ID, 1)
+		if err != nil {
+			t.Fatalf("FinishGame err = %v, want nil", err)
+		}
+		if got, want := finished.Status, GameStatusAbandoned; got != want {
+			t.Errorf("Status = %q, want %q", got, want)
+		}
+		if finished.FinishedAt == nil {
+			t.Error("FinishedAt = nil, want the finish time")
+		}
+
+		if _, err = svc.GetNextQuestion(ctx, g.ID, 1); !errors.Is(err, ErrGameFinished) {
+			t.Errorf("GetNextQuestion err = %v, want %v", err, ErrGameFinished)
+		}
+		if _, err = svc.GetNext(ctx, g.ID, 1); !errors.Is(err, ErrGameFinished) {
+			t.Errorf("GetNext err = %v, want %v", err, ErrGameFinished)
+		}
+		_, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{})
+		if !errors.Is(err, ErrGameFinished) {
+			t.Errorf("SubmitAnswer err = %v, want %v", err, ErrGameFinished)
+		}
+
+		// Idempotent: the second call reports the status first reached.
+		again, err := svc.FinishGame(ctx, g.ID, 1)
+		if err != nil {
+			t.Fatalf("second FinishGame err = %v, want nil", err)
+		}
+		if got, want := again.Status, GameStatusAbandoned; got != want {
+			t.Errorf("second Status = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("non-participant gets ErrGameNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		svc, testQuiz, _ := newLifecycleService(t)
+
+		g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+		if err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		if _, err = svc.FinishGame(ctx, g.ID, 2); !errors.Is(err, ErrGameNotFound) {
+			t.Errorf("FinishGame err = %v, want %v", err, ErrGameNotFound)
+		}
+	})
+}
+
+// TestService_SharedGameFinishesAfterEveryAnswer pins that the first answer
+// to the last question of a shared game does not lock out the others.
+func TestService_SharedGameFinishesAfterEveryAnswer(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	svc, testQuiz, gameStore := newLifecycleService(t)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	if err = gameStore.CreateParticipant(ctx, &Participant{GameID: g.ID, PlayerID: 2, QuizID: testQuiz.ID}); err != nil {
+		t.Fatalf("CreateParticipant err = %v, want nil", err)
+	}
+	for i := range testQuiz.Questions {
+		gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+		if err != nil {
+			t.Fatalf("GetNextQuestion %d err = %v, want nil", i, err)
+		}
+		for _, pid := range []int64{1, 2} {
+			if _, err = svc.SubmitAnswer(ctx, g.ID, pid, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{}); err != nil {
+				t.Fatalf("SubmitAnswer %d for player %d err = %v, want nil", i, pid, err)
+			}
+		}
+	}
+
+	results, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetResults err = %v, want nil", err)
+	}
+	if got, want := results.Status, GameStatusFinished; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+}
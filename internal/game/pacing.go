@@ -0,0 +1,68 @@
+package game
+
+import (
+	"expvar"
+	"fmt"
+	"time"
+)
+
+// pacingMetrics publishes the inter-question pacing counters under
+// "game_pacing" on the process's expvar registry, next to db_pool and
+// db_maintenance: too_soon counts the next-question requests turned away for
+// arriving inside the minimum interval. expvar names are process-wide and
+// publishing one twice panics, so the map is created once here rather than
+// per [Service].
+//
+//nolint:gochecknoglobals // expvar's registry is process-global by design.
+var pacingMetrics = expvar.NewMap("game_pacing")
+
+// TooSoonError is returned by [Service.GetNext] and
+// [Service.GetNextQuestion] when a new question is requested before the
+// minimum interval since the previous one has passed. RetryAfter is how long
+// the caller must wait. It matches [ErrTooSoon] with [errors.Is]; handlers
+// map it to 429 with a Retry-After header.
+type TooSoonError struct {
+	RetryAfter time.Duration
+}
+
+// Error reports the remaining wait.
+func (e *TooSoonError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrTooSoon, e.RetryAfter)
+}
+
+// Unwrap lets [errors.Is] match [ErrTooSoon].
+func (e *TooSoonError) Unwrap() error {
+	return ErrTooSoon
+}
+
+// SetMinQuestionInterval sets the least time between issuing one question
+// of a game and the next, so a bot cannot blast through a quiz in a second.
+// Zero, the default, disables the check. Resuming the question already in
+// flight and round boundaries are never held back; only a new question is.
+// Same startup-only rule as [Service.SetRevealDelay].
+func (s *Service) SetMinQuestionInterval(d time.Duration) {
+	s.minQuestionInterval = d
+}
+
+// checkPacing returns a [*TooSoonError] when g's latest question was issued
+// less than the minimum interval ago, counting the rejection in the
+// game_pacing metrics. A question's issue time is its StartedAt less the
+// reveal delay, which [Service.issueQuestion] added on top of it.
+func (s *Service) checkPacing(g *Game) error {
+	if s.minQuestionInterval <= 0 || len(g.Questions) == 0 {
+		return nil
+	}
+	var latest time.Time
+	for _, gq := range g.Questions {
+		if gq.StartedAt.After(latest) {
+			latest = gq.StartedAt
+		}
+	}
+	wait := latest.Add(-s.revealDelay).Add(s.minQuestionInterval).Sub(s.now())
+	if wait <= 0 {
+		return nil
+	}
+	pacingMetrics.Add("too_soon", 1)
+
+	return &TooSoonError{RetryAfter: wait}
+}
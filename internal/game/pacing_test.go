@@ -0,0 +1,89 @@
+package game_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// TestService_MinQuestionInterval pins the pacing gate: a new question inside
+// the interval since the previous one was issued is refused with the
+// remaining wait, resuming the open question is not, and once the interval
+// has passed the next question is issued.
+func TestService_MinQuestionInterval(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Pacing",
+		Slug:              "pacing",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		Questions: []*quiz.Question{
+			{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+			{Text: "3 + 3?", Position: 20, Options: []*quiz.Option{{Text: "6", Correct: true}, {Text: "7"}}},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(time.Second)
+	svc.SetMinQuestionInterval(5 * time.Second)
+	svc.SetClock(func() time.Time { return now })
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	first, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("first GetNextQuestion err = %v, want nil (no previous question to pace against)", err)
+	}
+
+	now = now.Add(2 * time.Second)
+	optionID := first.QuizQuestion.Options[0].ID
+	if _, err = svc.SubmitAnswer(ctx, g.ID, 1, first.QuizQuestion.ID, optionID, time.Time{}); err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	_, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	var tooSoon *TooSoonError
+	if !errors.As(err, &tooSoon) || !errors.Is(err, ErrTooSoon) {
+		t.Fatalf("GetNextQuestion 2s after the first err = %v, want a *TooSoonError matching ErrTooSoon", err)
+	}
+	if got, want := tooSoon.RetryAfter, 3*time.Second; got != want {
+		t.Errorf("RetryAfter = %v, want %v (interval measured from issue, not from the reveal)", got, want)
+	}
+	if _, err = svc.GetNext(ctx, g.ID, 1); !errors.Is(err, ErrTooSoon) {
+		t.Errorf("GetNext 2s after the first err = %v, want %v", err, ErrTooSoon)
+	}
+
+	now = now.Add(3 * time.Second)
+	second, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion once the interval passed err = %v, want nil", err)
+	}
+	if second.QuizQuestion.ID == first.QuizQuestion.ID {
+		t.Errorf("second question = %d, want a new one", second.QuizQuestion.ID)
+	}
+
+	// The open question is resumed at once, however soon the reload.
+	resumed, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("resuming GetNextQuestion err = %v, want nil", err)
+	}
+	if resumed.QuizQuestion.ID != second.QuizQuestion.ID {
+		t.Errorf("resumed question = %d, want %d", resumed.QuizQuestion.ID, second.QuizQuestion.ID)
+	}
+}
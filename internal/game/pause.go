@@ -0,0 +1,107 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// PauseExtension is how long the once-per-game pause holds a player's timer:
+// enough to ride out a dropped mobile connection, too short to look an answer
+// up.
+const PauseExtension = 10 * time.Second
+
+// PauseOnce spends the player's one "connection hiccup" pause of the game on
+// an issued question and returns their new answer deadline, [PauseExtension]
+// past the question's ExpiredAt. The pause starts now, or at the window
+// opening when pressed during the read beat, and the time it holds is taken
+// off the answer's time when it is scored, so the pause neither costs nor
+// earns points on its own.
+//
+// The question must be open: one already answered is
+// [ErrAnswerAlreadyRecorded] and one past ExpiredAt is
+// [ErrAnswerWindowClosed]. A second pause in the same game is
+// [ErrPauseAlreadyUsed]. Non-participants get [ErrGameNotFound], the same gate
+// as [Service.SubmitAnswer].
+func (s *Service) PauseOnce(ctx context.Context, gameID string, playerID, questionID int64) (time.Time, error) {
+	ctx, span := tracing.Start(ctx, "game.PauseOnce", tracing.String("game.id", gameID))
+	defer span.End()
+
+	g, err := s.store.GetGame(ctx, gameID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errGetGameFmt, err)
+	}
+	p := participant(g, playerID)
+	if p == nil {
+		return time.Time{}, ErrGameNotFound
+	}
+	if g.IsFinished() {
+		return time.Time{}, ErrGameFinished
+	}
+	if p.PausedAt != nil {
+		return time.Time{}, ErrPauseAlreadyUsed
+	}
+
+	idx := slices.IndexFunc(g.Questions, func(gq *Question) bool { return gq.QuestionID == questionID })
+	if idx < 0 {
+		return time.Time{}, fmt.Errorf("question %d not found in game %s: %w", questionID, gameID, ErrQuestionNotInGame)
+	}
+	question := g.Questions[idx]
+	if slices.ContainsFunc(question.Answers, func(a *Answer) bool { return a.PlayerID == playerID }) {
+		return time.Time{}, ErrAnswerAlreadyRecorded
+	}
+	now := s.now()
+	if now.After(question.ExpiredAt) {
+		return time.Time{}, ErrAnswerWindowClosed
+	}
+
+	pausedAt := now
+	if pausedAt.Before(question.StartedAt) {
+		pausedAt = question.StartedAt
+	}
+	if err = s.store.PauseParticipant(ctx, gameID, playerID, questionID, pausedAt); err != nil {
+		if errors.Is(err, ErrPauseAlreadyUsed) {
+			return time.Time{}, ErrPauseAlreadyUsed
+		}
+
+		return time.Time{}, fmt.Errorf("failed to pause: %w", err)
+	}
+
+	return question.ExpiredAt.Add(PauseExtension), nil
+}
+
+// participant returns g's participant row for playerID, nil when the player
+// is not in the game.
+func participant(g *Game, playerID int64) *Participant {
+	idx := slices.IndexFunc(g.Participants, func(p *Participant) bool { return p.PlayerID == playerID })
+	if idx < 0 {
+		return nil
+	}
+
+	return g.Participants[idx]
+}
+
+// pauseFor is how far p's pause moves the answer deadline of question: the
+// full [PauseExtension] on the question they paused, zero on any other.
+func pauseFor(p *Participant, question *Question) time.Duration {
+	if p == nil || p.PausedAt == nil || p.PausedQuestionID != question.QuestionID {
+		return 0
+	}
+
+	return PauseExtension
+}
+
+// stampPausedMs sets a.PausedMs to the part of p's pause that fell between
+// the answer window opening and the answer: all of it for an answer after
+// the pause ran out, the time already paused for one during it.
+func stampPausedMs(p *Participant, a *Answer) {
+	if pauseFor(p, a.Question) == 0 {
+		return
+	}
+	answeredAt := a.Question.StartedAt.Add(answerLatency(a))
+	a.PausedMs = min(max(answeredAt.Sub(*p.PausedAt), 0), PauseExtension).Milliseconds()
+}
@@ -0,0 +1,198 @@
+package game_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// TestCalculateScore_PausedMs pins that paused time is taken off the answer's
+// time: an answer 8s into a 10s window with 4s of it paused scores as if it
+// landed at 4s.
+func TestCalculateScore_PausedMs(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 8, 17, 12, 0, 0, 0, time.UTC)
+	svc := NewService(stubStore{}, nil, slog.New(slog.DiscardHandler))
+	a := &Answer{
+		Question:   &Question{StartedAt: startedAt, ExpiredAt: startedAt.Add(10 * time.Second)},
+		Option:     &quiz.Option{Correct: true},
+		AnsweredAt: startedAt.Add(8 * time.Second),
+		PausedMs:   4000,
+	}
+	if got, want := svc.CalculateScore(t.Context(), a), 600; got != want {
+		t.Errorf("CalculateScore() = %d, want %d", got, want)
+	}
+}
+
+// TestService_PauseOnce pins the pause end to end: it extends the paused
+// question's deadline by PauseExtension, an answer in the extension is
+// accepted and scored without the paused time, the game results and the quiz
+// leaderboard agree on it, and a second pause in the game is refused.
+func TestService_PauseOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Hiccup",
+		Slug:              "hiccup",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		Questions: []*quiz.Question{
+			{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+			{Text: "3 + 3?", Position: 20, Options: []*quiz.Option{{Text: "6", Correct: true}, {Text: "7"}}},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	now := time.Date(2026, 8, 17, 12, 0, 0, 0, time.UTC)
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+	svc.SetClock(func() time.Time { return now })
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	window := gq.ExpiredAt.Sub(gq.StartedAt)
+
+	if _, err = svc.PauseOnce(ctx, g.ID, 2, gq.QuizQuestion.ID); !errors.Is(err, ErrGameNotFound) {
+		t.Errorf("PauseOnce by a non-participant err = %v, want %v", err, ErrGameNotFound)
+	}
+	now = now.Add(2 * time.Second)
+	deadline, err := svc.PauseOnce(ctx, g.ID, 1, gq.QuizQuestion.ID)
+	if err != nil {
+		t.Fatalf("PauseOnce err = %v, want nil", err)
+	}
+	if want := gq.ExpiredAt.Add(PauseExtension); !deadline.Equal(want) {
+		t.Errorf("PauseOnce deadline = %v, want %v", deadline, want)
+	}
+	if _, err = svc.PauseOnce(ctx, g.ID, 1, gq.QuizQuestion.ID); !errors.Is(err, ErrPauseAlreadyUsed) {
+		t.Errorf("second PauseOnce err = %v, want %v", err, ErrPauseAlreadyUsed)
+	}
+
+	// Past the original window but inside the extension: accepted, with the
+	// whole pause taken off.
+	now = gq.ExpiredAt.Add(PauseExtension / 2)
+	a, err := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("SubmitAnswer in the extension err = %v, want nil", err)
+	}
+	if got, want := a.PausedMs, PauseExtension.Milliseconds(); got != want {
+		t.Errorf("answer PausedMs = %d, want %d", got, want)
+	}
+	first := svc.CalculateScore(ctx, a)
+	latency := window + PauseExtension/2 - PauseExtension
+	if want := int(1000 - latency.Seconds()/window.Seconds()*1000); first != want {
+		t.Errorf("paused answer scored %d, want %d", first, want)
+	}
+
+	// The pause is spent: the next question keeps its plain deadline.
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("second GetNextQuestion err = %v, want nil", err)
+	}
+	if _, err = svc.PauseOnce(ctx, g.ID, 1, gq.QuizQuestion.ID); !errors.Is(err, ErrPauseAlreadyUsed) {
+		t.Errorf("PauseOnce on a later question err = %v, want %v", err, ErrPauseAlreadyUsed)
+	}
+	now = gq.ExpiredAt.Add(PauseExtension / 2)
+	if _, err = svc.SubmitAnswer(
+		ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{},
+	); !errors.Is(err, ErrAnswerWindowClosed) {
+		t.Errorf("late SubmitAnswer on an unpaused question err = %v, want %v", err, ErrAnswerWindowClosed)
+	}
+
+	results, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetResults err = %v, want nil", err)
+	}
+	if got := results.PlayerScores[1]; got != first {
+		t.Errorf("results score = %d, want %d", got, first)
+	}
+	board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+	if err != nil {
+		t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+	}
+	if len(board.Entries) != 1 || board.Entries[0].Score != first {
+		t.Errorf("leaderboard = %+v, want one entry scoring %d", board.Entries, first)
+	}
+}
+
+// TestService_PauseOnce_ClosedQuestion pins that the pause only applies to an
+// open question: one already answered or past its window is refused without
+// spending it.
+func TestService_PauseOnce_ClosedQuestion(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Closed",
+		Slug:              "closed",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		Questions: []*quiz.Question{
+			{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+			{Text: "3 + 3?", Position: 20, Options: []*quiz.Option{{Text: "6", Correct: true}, {Text: "7"}}},
+			{Text: "4 + 4?", Position: 30, Options: []*quiz.Option{{Text: "8", Correct: true}, {Text: "9"}}},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	now := time.Date(2026, 8, 17, 12, 0, 0, 0, time.UTC)
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+	svc.SetClock(func() time.Time { return now })
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	if _, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{}); err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	if _, err = svc.PauseOnce(ctx, g.ID, 1, gq.QuizQuestion.ID); !errors.Is(err, ErrAnswerAlreadyRecorded) {
+		t.Errorf("PauseOnce after answering err = %v, want %v", err, ErrAnswerAlreadyRecorded)
+	}
+
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("second GetNextQuestion err = %v, want nil", err)
+	}
+	now = gq.ExpiredAt.Add(time.Second)
+	if _, err = svc.PauseOnce(ctx, g.ID, 1, gq.QuizQuestion.ID); !errors.Is(err, ErrAnswerWindowClosed) {
+		t.Errorf("PauseOnce past the window err = %v, want %v", err, ErrAnswerWindowClosed)
+	}
+
+	// Neither refusal spent the pause.
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("third GetNextQuestion err = %v, want nil", err)
+	}
+	if _, err = svc.PauseOnce(ctx, g.ID, 1, gq.QuizQuestion.ID); err != nil {
+		t.Errorf("PauseOnce on an open question err = %v, want nil", err)
+	}
+}
@@ -0,0 +1,26 @@
+package game
+
+// applyWrongAnswerPenalty takes a's wrong-answer penalty off points when a
+// is wrong, on top of any stake it lost. A correct answer keeps its points,
+// even one too late to earn anything, as in [applyWager]; a question left
+// unanswered has no answer to penalise.
+func applyWrongAnswerPenalty(points int, a *Answer) int {
+	if a.WrongAnswerPenalty == 0 || a.IsCorrect() {
+		return points
+	}
+
+	return points - a.WrongAnswerPenalty
+}
+
+// FloorTotal holds a player's game total at the score floor their answers in
+// the game were recorded under ([Answer.ScoreFloor]), so wrong-answer
+// penalties and lost stakes never take it lower. The answers share the floor
+// of their quiz, so any one of them will do; with none the total is left as
+// is.
+func FloorTotal(total int, answers ...*Answer) int {
+	if len(answers) == 0 {
+		return total
+	}
+
+	return max(total, answers[len(answers)-1].ScoreFloor)
+}
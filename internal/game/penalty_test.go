@@ -0,0 +1,248 @@
+package game_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// TestCalculateScore_WrongAnswerPenalty pins how negative marking meets time
+// scoring: a wrong answer loses the penalty wherever it lands in the window,
+// a correct one keeps its time points, a correct one too late to earn
+// anything is not penalised, and a lost stake is penalised on top.
+func TestCalculateScore_WrongAnswerPenalty(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 8, 29, 12, 0, 0, 0, time.UTC)
+	expiredAt := startedAt.Add(10 * time.Second)
+	halfway := startedAt.Add(5 * time.Second)
+	wager := func(n int) *int { return &n }
+
+	tests := []struct {
+		name       string
+		correct    bool
+		answeredAt time.Time
+		penalty    int
+		wager      *int
+		want       int
+	}{
+		{name: "no penalty, wrong", answeredAt: halfway, want: 0},
+		{name: "correct halfway", correct: true, answeredAt: halfway, penalty: 200, want: 500},
+		{name: "wrong at the start", answeredAt: startedAt, penalty: 200, want: -200},
+		{name: "wrong halfway", answeredAt: halfway, penalty: 200, want: -200},
+		{name: "correct but late", correct: true, answeredAt: expiredAt.Add(time.Second), penalty: 200, want: 0},
+		{name: "wrong at stake 2", answeredAt: halfway, penalty: 200, wager: wager(2), want: -700},
+	}
+	svc := NewService(stubStore{}, nil, slog.New(slog.DiscardHandler))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &Answer{
+				Question:           &Question{StartedAt: startedAt, ExpiredAt: expiredAt},
+				Option:             &quiz.Option{Correct: tt.correct},
+				AnsweredAt:         tt.answeredAt,
+				Wager:              tt.wager,
+				WrongAnswerPenalty: tt.penalty,
+			}
+			if got := svc.CalculateScore(t.Context(), a); got != tt.want {
+				t.Errorf("CalculateScore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloorTotal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		total   int
+		answers []*Answer
+		want    int
+	}{
+		{name: "no answers", total: -300, want: -300},
+		{name: "floored at zero", total: -300, answers: []*Answer{{}}, want: 0},
+		{name: "above a negative floor", total: -300, answers: []*Answer{{ScoreFloor: -500}}, want: -300},
+		{name: "held at a negative floor", total: -800, answers: []*Answer{{ScoreFloor: -500}}, want: -500},
+		{name: "positive total", total: 700, answers: []*Answer{{}}, want: 700},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := FloorTotal(tt.total, tt.answers...); got != tt.want {
+				t.Errorf("FloorTotal(%d) = %d, want %d", tt.total, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestService_WrongAnswerPenalty_Floor pins negative marking end to end: the
+// penalty is recorded with each answer and costs it points, while the game
+// results and the quiz leaderboard both hold the total at the quiz's floor.
+func TestService_WrongAnswerPenalty_Floor(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name  string
+		floor int
+		want  int
+	}{
+		{name: "floored at zero", floor: 0, want: 0},
+		{name: "negative floor", floor: -250, want: -250},
+		{name: "above the floor", floor: -1000, want: -600},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			db := dbtest.Open(t)
+			quizStore := store.NewQuizStore(db, slog.Default())
+			gameStore := store.NewGameStore(db, slog.Default())
+
+			testQuiz := &quiz.Quiz{
+				Title:              "Negative marking",
+				Slug:               "negative-marking",
+				CreatedByPlayerID:  seededAdminID,
+				Published:          true,
+				WrongAnswerPenalty: 300,
+				ScoreFloor:         tt.floor,
+				Questions: []*quiz.Question{
+					{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+					{Text: "3 + 3?", Position: 20, Options: []*quiz.Option{{Text: "6", Correct: true}, {Text: "7"}}},
+				},
+			}
+			if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+				t.Fatalf("failed to create quiz: %v", err)
+			}
+			svc := NewService(gameStore, quizStore, slog.Default())
+			svc.SetRevealDelay(0)
+
+			g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+			if err != nil {
+				t.Fatalf("CreateGame err = %v, want nil", err)
+			}
+			for range testQuiz.Questions {
+				gq, nerr := svc.GetNextQuestion(ctx, g.ID, 1)
+				if nerr != nil {
+					t.Fatalf("GetNextQuestion err = %v, want nil", nerr)
+				}
+				wrong := gq.QuizQuestion.Options[1]
+				a, serr := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, wrong.ID, time.Time{})
+				if serr != nil {
+					t.Fatalf("SubmitAnswer err = %v, want nil", serr)
+				}
+				if got, want := svc.CalculateScore(ctx, a), -300; got != want {
+					t.Errorf("wrong answer scored %d, want %d", got, want)
+				}
+			}
+
+			results, err := svc.GetResults(ctx, g.ID, 1)
+			if err != nil {
+				t.Fatalf("GetResults err = %v, want nil", err)
+			}
+			if got := results.PlayerScores[1]; got != tt.want {
+				t.Errorf("results score = %d, want %d", got, tt.want)
+			}
+			board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+			if err != nil {
+				t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+			}
+			if len(board.Entries) != 1 {
+				t.Fatalf("leaderboard entries = %d, want 1", len(board.Entries))
+			}
+			if got := board.Entries[0].Score; got != tt.want {
+				t.Errorf("leaderboard score = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	// Each game is held at the floor on its own before a player's games are
+	// summed, as the game results hold it: a game at -300 with floor 0 counts
+	// as 0, so it cannot eat into the 500 a second game earned.
+	t.Run("floored per game", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		db := dbtest.Open(t)
+		quizStore := store.NewQuizStore(db, slog.Default())
+		gameStore := store.NewGameStore(db, slog.Default())
+
+		testQuiz := &quiz.Quiz{
+			Title:              "Negative marking, twice",
+			Slug:               "negative-marking-twice",
+			CreatedByPlayerID:  seededAdminID,
+			Published:          true,
+			WrongAnswerPenalty: 300,
+			Questions: []*quiz.Question{
+				{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+			},
+		}
+		if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+			t.Fatalf("failed to create quiz: %v", err)
+		}
+		now := time.Date(2026, 8, 29, 12, 0, 0, 0, time.UTC)
+		svc := NewService(gameStore, quizStore, slog.Default())
+		svc.SetRevealDelay(0)
+		svc.SetClock(func() time.Time { return now })
+
+		for i, tc := range []struct {
+			option int
+			want   int
+		}{
+			{option: 1, want: -300},
+			{option: 0, want: 500},
+		} {
+			g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+			if err != nil {
+				t.Fatalf("CreateGame err = %v, want nil", err)
+			}
+			gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+			if err != nil {
+				t.Fatalf("GetNextQuestion err = %v, want nil", err)
+			}
+			// Halfway through the window, where a correct pick earns half.
+			now = gq.StartedAt.Add(gq.ExpiredAt.Sub(gq.StartedAt) / 2)
+			a, err := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[tc.option].ID, now)
+			if err != nil {
+				t.Fatalf("SubmitAnswer err = %v, want nil", err)
+			}
+			if got := svc.CalculateScore(ctx, a); got != tc.want {
+				t.Fatalf("answer scored %d, want %d", got, tc.want)
+			}
+			now = now.Add(time.Minute)
+			if i > 0 {
+				continue
+			}
+			// A player holds one solo game per quiz; drop the first game's
+			// participant row so the same player can play the quiz again.
+			if _, err = db.ExecContext(ctx, "DELETE FROM game_participants WHERE game_id = ?", g.ID); err != nil {
+				t.Fatalf("delete participant err = %v, want nil", err)
+			}
+		}
+
+		board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+		if err != nil {
+			t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+		}
+		if len(board.Entries) != 1 {
+			t.Fatalf("leaderboard entries = %d, want 1", len(board.Entries))
+		}
+		if got, want := board.Entries[0].Score, 500; got != want {
+			t.Errorf("leaderboard score over two games = %d, want %d", got, want)
+		}
+		totals, err := svc.QuizScoreTotals(ctx, testQuiz.ID)
+		if err != nil {
+			t.Fatalf("QuizScoreTotals err = %v, want nil", err)
+		}
+		if got, want := totals[1], 500; got != want {
+			t.Errorf("QuizScoreTotals over two games = %d, want %d", got, want)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package game
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// PublicStanding is one row of a game's public standings: a display name
+// and score, with no player ID.
+type PublicStanding struct {
+	Rank        int
+	DisplayName string
+	Score       int
+}
+
+// PublicResults is the anonymized view of a finished game's results, shown
+// without sign-in once the host opts the game in. Standings run from the
+// highest score down; players on the same score are ordered by name and
+// each still gets their own Rank.
+type PublicResults struct {
+	GameID     string
+	FinishedAt *time.Time
+	Standings  []PublicStanding
+}
+
+// SetResultsPublic opts the game's results into, or out of, the public
+// standings. There is no participant gate: the caller checks the Host owns
+// the game's quiz.
+func (s *Service) SetResultsPublic(ctx context.Context, gameID string, public bool) error {
+	ctx, span := tracing.Start(ctx, "game.SetResultsPublic", tracing.String("game.id", gameID))
+	defer span.End()
+
+	if err := s.store.SetResultsPublic(ctx, gameID, public); err != nil {
+		return fmt.Errorf("failed to set results visibility: %w", err)
+	}
+
+	return nil
+}
+
+// GetPublicResults returns the anonymized standings of a finished game whose
+// host made its results public. A game that is not finished or not public
+// reads as [ErrGameNotFound], so the endpoint does not reveal which game IDs
+// exist.
+func (s *Service) GetPublicResults(ctx context.Context, gameID string) (*PublicResults, error) {
+	ctx, span := tracing.Start(ctx, "game.GetPublicResults", tracing.String("game.id", gameID))
+	defer span.End()
+
+	g, err := s.store.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf(errGetGameFmt, err)
+	}
+	if !g.ResultsPublic || g.Status != GameStatusFinished {
+		return nil, ErrGameNotFound
+	}
+
+	results, err := s.computeResults(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	names, err := s.store.ListParticipantNames(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participant names: %w", err)
+	}
+
+	standings := make([]PublicStanding, 0, len(g.Participants))
+	for _, p := range g.Participants {
+		standings = append(standings, PublicStanding{
+			DisplayName: names[p.PlayerID],
+			Score:       results.PlayerScores[p.PlayerID],
+		})
+	}
+	slices.SortStableFunc(standings, func(a, b PublicStanding) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.DisplayName, b.DisplayName)
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+
+	return &PublicResults{GameID: g.ID, FinishedAt: g.FinishedAt, Standings: standings}, nil
+}
@@ -0,0 +1,96 @@
+package game_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/starquake/topbanana/internal/fixture"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// publicResultsStubs builds a service whose game "g1" has players 1 and 3
+// answering correctly (1000 points each) and player 2 answering wrong, with
+// the given lifecycle status and public flag.
+func publicResultsStubs(status GameStatus, public bool) *Service {
+	qz := fixture.Quiz().WithID(7).Numbered().Build()
+	g := fixture.Game(qz).WithID("g1").WithPlayers(1, 2, 3).
+		Answer(1, 0, true, 0).
+		Answer(2, 0, false, 0).
+		Answer(3, 0, true, 0).
+		Build()
+	g.Status = status
+	g.ResultsPublic = public
+	if status == GameStatusFinished {
+		finished := fixture.Epoch.Add(time.Minute)
+		g.FinishedAt = &finished
+	}
+
+	return NewService(
+		stubStore{
+			getGame: func(_ context.Context, _ string) (*Game, error) { return g, nil },
+			listParticipantNames: func(_ context.Context, _ string) (map[int64]string, error) {
+				return map[int64]string{1: "zoe", 2: "bob", 3: "amy"}, nil
+			},
+		},
+		stubQuizStore{
+			getOptionsByIDs: func(_ context.Context, _ []int64) ([]*quiz.Option, error) {
+				return qz.Questions[0].Options, nil
+			},
+		},
+		slog.New(slog.DiscardHandler),
+	)
+}
+
+func TestService_GetPublicResults(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ranks by score then name, without player IDs", func(t *testing.T) {
+		t.Parallel()
+
+		svc := publicResultsStubs(GameStatusFinished, true)
+
+		got, err := svc.GetPublicResults(t.Context(), "g1")
+		if err != nil {
+			t.Fatalf("GetPublicResults err = %v, want nil", err)
+		}
+		want := []PublicStanding{
+			{Rank: 1, DisplayName: "amy", Score: 1000},
+			{Rank: 2, DisplayName: "zoe", Score: 1000},
+			{Rank: 3, DisplayName: "bob", Score: 0},
+		}
+		if diff := cmp.Diff(want, got.Standings); diff != "" {
+			t.Errorf("Standings mismatch (-want +got):\n%s", diff)
+		}
+		if got.FinishedAt == nil {
+			t.Error("FinishedAt = nil, want the finish time")
+		}
+	})
+
+	t.Run("a game that is not public is not found", func(t *testing.T) {
+		t.Parallel()
+
+		svc := publicResultsStubs(GameStatusFinished, false)
+
+		_, err := svc.GetPublicResults(t.Context(), "g1")
+		if got, want := err, ErrGameNotFound; !errors.Is(got, want) {
+			t.Errorf("GetPublicResults err = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("a public game still in progress is not found", func(t *testing.T) {
+		t.Parallel()
+
+		svc := publicResultsStubs(GameStatusInProgress, true)
+
+		_, err := svc.GetPublicResults(t.Context(), "g1")
+		if got, want := err, ErrGameNotFound; !errors.Is(got, want) {
+			t.Errorf("GetPublicResults err = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package game
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// QuizPlayCount is how often a quiz was played in a report's date range:
+// the games started and how many of them finished.
+type QuizPlayCount struct {
+	QuizID    int64
+	QuizTitle string
+	Plays     int
+	Finished  int
+}
+
+// ReportAnswer is a [LeaderboardAnswer] with the game, quiz and question it
+// was given in, as the store lists it for the play report.
+type ReportAnswer struct {
+	LeaderboardAnswer
+
+	GameID       string
+	QuizID       int64
+	QuestionID   int64
+	QuestionText string
+}
+
+// QuizReport is a quiz's line of the play report: its play counts, the
+// average score of its games and the median time its answers took.
+// AverageScore counts a game without answers as 0; MedianAnswerTime is 0
+// when nothing was answered.
+type QuizReport struct {
+	QuizPlayCount
+
+	Answers          int
+	AverageScore     float64
+	MedianAnswerTime time.Duration
+}
+
+// QuestionReport is one of the play report's hardest questions: the
+// question, its quiz and how its answers in the date range went.
+type QuestionReport struct {
+	quiz.QuestionStats
+
+	QuizID     int64
+	QuizTitle  string
+	QuestionID int64
+	Text       string
+}
+
+// PlayReport is the admin analytics page's view of the games started in
+// [From, To): per-quiz play counts, average scores and median answer times,
+// and the questions with the lowest correct rate.
+type PlayReport struct {
+	From    time.Time
+	To      time.Time
+	Quizzes []*QuizReport
+	Hardest []*QuestionReport
+}
+
+// GetPlayReport builds the [PlayReport] for the non-preview games started in
+// [from, to), listing at most hardest of the questions with the lowest
+// correct rate. Scores come from [Service.CalculateScore] and correctness
+// from [Answer.IsCorrect], so the report agrees with the leaderboards on
+// both; answers to voided questions are left out.
+func (s *Service) GetPlayReport(ctx context.Context, from, to time.Time, hardest int) (*PlayReport, error) {
+	ctx, span := tracing.Start(ctx, "game.GetPlayReport")
+	defer span.End()
+
+	counts, err := s.store.ListQuizPlayReport(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quiz play counts: %w", err)
+	}
+	answers, err := s.store.ListAnswersForPlayReport(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list report answers: %w", err)
+	}
+
+	// Each play's total is held at its score floor before it joins the quiz's
+	// sum, as on the results page.
+	plays := make(map[playKey]*playTotal)
+	times := make(map[int64][]time.Duration)
+	questions := make(map[int64]*QuestionReport)
+	for _, r := range answers {
+		a := r.scoringAnswer()
+		key := playKey{gameID: r.GameID, playerID: r.PlayerID}
+		if plays[key] == nil {
+			plays[key] = &playTotal{quizID: r.QuizID}
+		}
+		plays[key].score += s.CalculateScore(ctx, a)
+		plays[key].last = a
+		times[r.QuizID] = append(times[r.QuizID], answerLatency(a))
+
+		q, ok := questions[r.QuestionID]
+		if !ok {
+			q = &QuestionReport{QuizID: r.QuizID, QuestionID: r.QuestionID, Text: r.QuestionText}
+			questions[r.QuestionID] = q
+		}
+		q.Answers++
+		if a.IsCorrect() {
+			q.Correct++
+		}
+	}
+
+	scores := make(map[int64]int)
+	for _, p := range plays {
+		scores[p.quizID] += FloorTotal(p.score, p.last)
+	}
+
+	report := &PlayReport{From: from, To: to, Quizzes: make([]*QuizReport, 0, len(counts))}
+	titles := make(map[int64]string, len(counts))
+	for _, c := range counts {
+		titles[c.QuizID] = c.QuizTitle
+		qr := &QuizReport{QuizPlayCount: *c, Answers: len(times[c.QuizID])}
+		if c.Plays > 0 {
+			qr.AverageScore = float64(scores[c.QuizID]) / float64(c.Plays)
+		}
+		qr.MedianAnswerTime = medianDuration(times[c.QuizID])
+		report.Quizzes = append(report.Quizzes, qr)
+	}
+	report.Hardest = hardestQuestions(questions, titles, hardest)
+
+	return report, nil
+}
+
+// playKey is one player's play of one game in the report.
+type playKey struct {
+	gameID   string
+	playerID int64
+}
+
+// playTotal is the running score of a [playKey].
+type playTotal struct {
+	quizID int64
+	score  int
+	last   *Answer
+}
+
+// hardestQuestions returns up to limit of the questions with the lowest
+// correct rate, with their quiz titles filled in. Among equal rates the more
+// answered question comes first, as the rate says more about it.
+func hardestQuestions(questions map[int64]*QuestionReport, titles map[int64]string, limit int) []*QuestionReport {
+	hardest := make([]*QuestionReport, 0, len(questions))
+	for _, q := range questions {
+		q.QuizTitle = titles[q.QuizID]
+		hardest = append(hardest, q)
+	}
+	slices.SortFunc(hardest, func(a, b *QuestionReport) int {
+		// Compare Correct/Answers without rounding: a.C/a.A < b.C/b.A.
+		if c := cmp.Compare(a.Correct*b.Answers, b.Correct*a.Answers); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Answers, a.Answers); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.QuestionID, b.QuestionID)
+	})
+
+	return hardest[:min(limit, len(hardest))]
+}
+
+// medianDuration returns the median of ds, the mean of the middle two for
+// an even count, or 0 for none. ds is sorted in place.
+func medianDuration(ds []time.Duration) time.Duration {
+	if len(ds) == 0 {
+		return 0
+	}
+	slices.Sort(ds)
+	mid := len(ds) / halves
+	if len(ds)%halves == 1 {
+		return ds[mid]
+	}
+
+	return (ds[mid-1] + ds[mid]) / halves
+}
+
+// halves splits a sorted list at its middle.
+const halves = 2
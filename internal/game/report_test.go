@@ -0,0 +1,83 @@
+package game_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// reportAnswer builds a report row for the question of the quiz, answered
+// after latency into a ten-second window.
+func reportAnswer(quizID, questionID int64, correct bool, latency time.Duration) *ReportAnswer {
+	a := makeAnswer(1, "", correct)
+	a.AnsweredAt = a.QuestionStartedAt.Add(latency)
+
+	return &ReportAnswer{LeaderboardAnswer: *a, QuizID: quizID, QuestionID: questionID, QuestionText: "Q?"}
+}
+
+func TestService_GetPlayReport(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	counts := []*QuizPlayCount{
+		{QuizID: 1, QuizTitle: "One", Plays: 2, Finished: 1},
+		{QuizID: 2, QuizTitle: "Two", Plays: 1, Finished: 1},
+		{QuizID: 3, QuizTitle: "Three", Plays: 1},
+	}
+	answers := []*ReportAnswer{
+		reportAnswer(1, 10, true, 0),
+		reportAnswer(1, 10, false, 2*time.Second),
+		reportAnswer(1, 11, false, 4*time.Second),
+		reportAnswer(2, 20, false, time.Second),
+		reportAnswer(2, 20, false, 3*time.Second),
+	}
+	var gotFrom, gotTo time.Time
+	svc := NewService(
+		stubStore{
+			listQuizPlayReport: func(_ context.Context, start, end time.Time) ([]*QuizPlayCount, error) {
+				gotFrom, gotTo = start, end
+
+				return counts, nil
+			},
+			listAnswersForPlayReport: func(_ context.Context, _, _ time.Time) ([]*ReportAnswer, error) {
+				return answers, nil
+			},
+		},
+		stubQuizStore{},
+		slog.New(slog.DiscardHandler),
+	)
+
+	got, err := svc.GetPlayReport(t.Context(), from, to, 2)
+	if err != nil {
+		t.Fatalf("GetPlayReport err = %v, want nil", err)
+	}
+	if !gotFrom.Equal(from) || !gotTo.Equal(to) {
+		t.Errorf("store range = %v..%v, want %v..%v", gotFrom, gotTo, from, to)
+	}
+
+	want := &PlayReport{
+		From: from,
+		To:   to,
+		Quizzes: []*QuizReport{
+			{QuizPlayCount: *counts[0], Answers: 3, AverageScore: 500, MedianAnswerTime: 2 * time.Second},
+			{QuizPlayCount: *counts[1], Answers: 2, AverageScore: 0, MedianAnswerTime: 2 * time.Second},
+			{QuizPlayCount: *counts[2]},
+		},
+		// Both never answered correctly; the more answered one leads, and
+		// the half-right question 10 falls past the limit.
+		Hardest: []*QuestionReport{
+			{QuestionStats: quiz.QuestionStats{Answers: 2}, QuizID: 2, QuizTitle: "Two", QuestionID: 20, Text: "Q?"},
+			{QuestionStats: quiz.QuestionStats{Answers: 1}, QuizID: 1, QuizTitle: "One", QuestionID: 11, Text: "Q?"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetPlayReport mismatch (-want +got):\n%s", diff)
+	}
+}
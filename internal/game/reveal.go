@@ -0,0 +1,118 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// Reveal is what a player of a game may see of their own scoring. While a
+// host-paced game's scores are held back it is Hidden and carries no
+// answers; otherwise Answers are the player's scored answers, in the order
+// their questions were issued, each with its Question (quiz question
+// attached) and Option set so it can be scored and its correct options shown.
+// Answers to voided questions are left out.
+type Reveal struct {
+	GameID  string
+	QuizID  int64
+	Hidden  bool
+	Answers []*Answer
+}
+
+// RevealScores reveals the held-back scores of a game of a host-paced quiz:
+// from then on its answers report their outcome, its results can be read,
+// and its players count on the quiz leaderboard with their real totals.
+// There is no participant gate: the caller checks the Host owns the game's
+// quiz, as for [Service.SetResultsPublic]. g must have its Quiz attached
+// ([Service.GetGameForReview] does). Returns [ErrRevealNotOffered] when the
+// quiz is not host-paced. Revealing twice is a no-op.
+//
+// The reveal republishes the quiz leaderboard, whose tick also wakes the
+// game's event stream so waiting players receive their outcomes.
+func (s *Service) RevealScores(ctx context.Context, g *Game) error {
+	ctx, span := tracing.Start(ctx, "game.RevealScores", tracing.String("game.id", g.ID))
+	defer span.End()
+
+	if g.Quiz == nil || !g.Quiz.HostPaced {
+		return ErrRevealNotOffered
+	}
+
+	revealed, err := s.store.RevealGame(ctx, g.ID)
+	if err != nil {
+		return fmt.Errorf("failed to reveal game scores: %w", err)
+	}
+	if !revealed {
+		return nil
+	}
+	if s.leaderboardPublisher != nil {
+		s.leaderboardPublisher.Publish(g.QuizID)
+	}
+
+	return nil
+}
+
+// GetReveal returns what the player may see of their own scoring in the
+// game: nothing yet while a host-paced game's scores are held back, their
+// scored answers otherwise. Like [Service.GetResults], a non-participant
+// gets [ErrGameNotFound].
+func (s *Service) GetReveal(ctx context.Context, gameID string, playerID int64) (*Reveal, error) {
+	ctx, span := tracing.Start(ctx, "game.GetReveal", tracing.String("game.id", gameID))
+	defer span.End()
+
+	g, qz, err := s.loadGameForPlayer(ctx, gameID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &Reveal{GameID: g.ID, QuizID: g.QuizID}
+	if scoresHeldBack(g, qz) {
+		rv.Hidden = true
+
+		return rv, nil
+	}
+
+	questions := make(map[int64]*quiz.Question, len(qz.Questions))
+	options := make(map[int64]*quiz.Option)
+	for _, q := range qz.Questions {
+		questions[q.ID] = q
+		for _, o := range q.Options {
+			options[o.ID] = o
+		}
+	}
+	for _, ga := range collectPlayerAnswers(g, playerID, nil) {
+		ga.Question.QuizQuestion = questions[ga.Question.QuestionID]
+		ga.Option = options[ga.OptionID]
+		// A deleted question or option leaves nothing to score or show.
+		if ga.Question.QuizQuestion == nil || ga.Option == nil {
+			continue
+		}
+		rv.Answers = append(rv.Answers, ga)
+	}
+
+	return rv, nil
+}
+
+// scoresHidden reports whether g's scores are still held back, reading its
+// quiz row when g has no Quiz attached.
+func (s *Service) scoresHidden(ctx context.Context, g *Game) (bool, error) {
+	if g.RevealedAt != nil {
+		return false, nil
+	}
+	qz := g.Quiz
+	if qz == nil {
+		var err error
+		if qz, err = s.quizStore.GetQuizMeta(ctx, g.QuizID); err != nil {
+			return false, fmt.Errorf("failed to get quiz: %w", err)
+		}
+	}
+
+	return qz.HostPaced, nil
+}
+
+// scoresHeldBack reports whether a game of qz has its scores held back: the
+// quiz is host-paced and the host has not revealed this game yet.
+func scoresHeldBack(g *Game, qz *quiz.Quiz) bool {
+	return qz.HostPaced && g.RevealedAt == nil
+}
@@ -0,0 +1,181 @@
+package game_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// TestService_RevealScores pins the host-paced flow end to end: answers
+// are recorded with their outcome held back, the results stay closed and
+// the quiz leaderboard does not count them until the reveal, and the reveal
+// opens both and hands the player their scored answers.
+func TestService_RevealScores(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Paced",
+		Slug:              "paced",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		HostPaced:         true,
+		Questions: []*quiz.Question{
+			{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+			{Text: "3 + 3?", Position: 20, Options: []*quiz.Option{{Text: "6", Correct: true}, {Text: "7"}}},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	for i := range testQuiz.Questions {
+		gq, qerr := svc.GetNextQuestion(ctx, g.ID, 1)
+		if qerr != nil {
+			t.Fatalf("GetNextQuestion %d err = %v, want nil", i, qerr)
+		}
+		// Right on the first question, wrong on the second.
+		a, aerr := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[i].ID, time.Time{})
+		if aerr != nil {
+			t.Fatalf("SubmitAnswer %d err = %v, want nil", i, aerr)
+		}
+		if !a.ScoresHidden {
+			t.Errorf("answer %d ScoresHidden = false, want true before the reveal", i)
+		}
+	}
+
+	if _, err = svc.GetResults(ctx, g.ID, 1); !errors.Is(err, ErrScoresHidden) {
+		t.Errorf("GetResults err = %v, want %v", err, ErrScoresHidden)
+	}
+	rv, err := svc.GetReveal(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetReveal err = %v, want nil", err)
+	}
+	if !rv.Hidden || len(rv.Answers) != 0 {
+		t.Errorf("GetReveal = hidden %v with %d answers, want hidden with none", rv.Hidden, len(rv.Answers))
+	}
+	if _, err = svc.GetReveal(ctx, g.ID, 2); !errors.Is(err, ErrGameNotFound) {
+		t.Errorf("GetReveal by a non-participant err = %v, want %v", err, ErrGameNotFound)
+	}
+	board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+	if err != nil {
+		t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+	}
+	// The player is listed, but their held-back answers do not count yet.
+	if len(board.Entries) != 1 || board.Entries[0].Score != 0 {
+		t.Errorf("leaderboard before the reveal = %+v, want one entry scoring 0", board.Entries)
+	}
+
+	review, _, err := svc.GetGameForReview(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("GetGameForReview err = %v, want nil", err)
+	}
+	if err = svc.RevealScores(ctx, review); err != nil {
+		t.Fatalf("RevealScores err = %v, want nil", err)
+	}
+	if err = svc.RevealScores(ctx, review); err != nil {
+		t.Errorf("second RevealScores err = %v, want nil", err)
+	}
+
+	rv, err = svc.GetReveal(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetReveal after the reveal err = %v, want nil", err)
+	}
+	if rv.Hidden {
+		t.Error("GetReveal Hidden = true after the reveal, want false")
+	}
+	if len(rv.Answers) != 2 {
+		t.Fatalf("revealed answers = %d, want 2", len(rv.Answers))
+	}
+	if !rv.Answers[0].IsCorrect() || rv.Answers[1].IsCorrect() {
+		t.Errorf("revealed correctness = %v, %v, want true, false", rv.Answers[0].IsCorrect(), rv.Answers[1].IsCorrect())
+	}
+	want := svc.CalculateScore(ctx, rv.Answers[0])
+	results, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetResults after the reveal err = %v, want nil", err)
+	}
+	if got := results.PlayerScores[1]; got != want || want == 0 {
+		t.Errorf("results score = %d, want %d (non-zero)", got, want)
+	}
+	board, err = svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+	if err != nil {
+		t.Fatalf("GetQuizLeaderboard after the reveal err = %v, want nil", err)
+	}
+	if len(board.Entries) != 1 || board.Entries[0].Score != want {
+		t.Errorf("leaderboard after the reveal = %+v, want one entry scoring %d", board.Entries, want)
+	}
+}
+
+// TestService_RevealScores_NotOffered pins that a quiz without host pacing
+// refuses a reveal and never holds its scores back.
+func TestService_RevealScores_NotOffered(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Unpaced",
+		Slug:              "unpaced",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		Questions: []*quiz.Question{
+			{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	a, err := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	if a.ScoresHidden {
+		t.Error("answer ScoresHidden = true, want false on a quiz without host pacing")
+	}
+
+	review, _, err := svc.GetGameForReview(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("GetGameForReview err = %v, want nil", err)
+	}
+	if err = svc.RevealScores(ctx, review); !errors.Is(err, ErrRevealNotOffered) {
+		t.Errorf("RevealScores err = %v, want %v", err, ErrRevealNotOffered)
+	}
+	rv, err := svc.GetReveal(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetReveal err = %v, want nil", err)
+	}
+	if rv.Hidden || len(rv.Answers) != 1 {
+		t.Errorf("GetReveal = hidden %v with %d answers, want revealed with 1", rv.Hidden, len(rv.Answers))
+	}
+}
@@ -0,0 +1,85 @@
+package game
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// Streak scoring: each correct answer in a row past the first adds
+// streakStep to the multiplier, up to maxStreakMultiplier.
+const (
+	streakStep          = 0.5
+	maxStreakMultiplier = 3.0
+)
+
+// Scorer is a scoring strategy: it turns an answer into its points before the
+// hint penalty and the confidence wager. A quiz picks one with its scoring
+// mode ([quiz.Quiz.Scoring]), which each answer carries as [Answer.Scoring].
+type Scorer interface {
+	Points(ctx context.Context, a *Answer) int
+}
+
+// scorerFor returns the [Scorer] for a scoring mode; an empty or unknown mode
+// scores on time, as every answer did before the modes existed.
+func (s *Service) scorerFor(mode string) Scorer {
+	switch mode {
+	case quiz.ScoringFlat:
+		return flatScorer{}
+	case quiz.ScoringStreak:
+		return streakScorer{timeScorer{logger: s.logger}}
+	default:
+		return timeScorer{logger: s.logger}
+	}
+}
+
+// timeScorer is [quiz.ScoringTime]: the time curve scaled by the answer's
+// credit, never above maxPoints.
+type timeScorer struct {
+	logger *slog.Logger
+}
+
+// Points implements [Scorer].
+func (t timeScorer) Points(ctx context.Context, a *Answer) int {
+	answeredAt := a.Question.StartedAt.Add(answerLatency(a))
+	credit := answerCredit(a)
+	points := scoreAnswerCurve(ctx, t.logger, credit > 0, a.Question.StartedAt, a.Question.ExpiredAt, answeredAt)
+
+	return int(float64(points) * credit)
+}
+
+// flatScorer is [quiz.ScoringFlat]: maxPoints scaled by the answer's credit
+// for any answer inside the window, however quickly it came.
+type flatScorer struct{}
+
+// Points implements [Scorer].
+func (flatScorer) Points(_ context.Context, a *Answer) int {
+	if answerLatency(a) > a.Question.ExpiredAt.Sub(a.Question.StartedAt) {
+		return 0
+	}
+
+	return int(float64(maxPoints) * answerCredit(a))
+}
+
+// streakScorer is [quiz.ScoringStreak]: the time curve multiplied by
+// [StreakMultiplier] of the answer's streak.
+type streakScorer struct {
+	time timeScorer
+}
+
+// Points implements [Scorer].
+func (t streakScorer) Points(ctx context.Context, a *Answer) int {
+	return int(float64(t.time.Points(ctx, a)) * StreakMultiplier(a.Streak))
+}
+
+// StreakMultiplier is the multiplier a run of streak consecutive correct
+// answers earns under [quiz.ScoringStreak]: 1 for the first, rising by half
+// for each one after it, up to 3.
+func StreakMultiplier(streak int) float64 {
+	if streak <= 1 {
+		return 1
+	}
+
+	return min(1+streakStep*float64(streak-1), maxStreakMultiplier)
+}
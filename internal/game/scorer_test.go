@@ -0,0 +1,68 @@
+package game_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// TestCalculateScore_Scoring pins each scoring mode on an answer halfway
+// through its window: time scoring halves the points, flat scoring keeps them
+// whole, streak scoring multiplies the time points by the run, and every mode
+// scores a wrong or late answer zero.
+func TestCalculateScore_Scoring(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 8, 28, 12, 0, 0, 0, time.UTC)
+	expiredAt := startedAt.Add(10 * time.Second)
+	halfway := startedAt.Add(5 * time.Second)
+
+	tests := []struct {
+		name       string
+		scoring    string
+		correct    bool
+		answeredAt time.Time
+		streak     int
+		want       int
+	}{
+		{name: "unset scores on time", correct: true, answeredAt: halfway, streak: 1, want: 500},
+		{name: "time", scoring: quiz.ScoringTime, correct: true, answeredAt: halfway, streak: 4, want: 500},
+		{name: "flat", scoring: quiz.ScoringFlat, correct: true, answeredAt: halfway, streak: 1, want: 1000},
+		{name: "flat, wrong", scoring: quiz.ScoringFlat, answeredAt: halfway, want: 0},
+		{name: "flat, late", scoring: quiz.ScoringFlat, correct: true, answeredAt: expiredAt.Add(time.Second), want: 0},
+		{name: "streak of 1", scoring: quiz.ScoringStreak, correct: true, answeredAt: halfway, streak: 1, want: 500},
+		{name: "streak of 3", scoring: quiz.ScoringStreak, correct: true, answeredAt: halfway, streak: 3, want: 1000},
+		{name: "streak capped", scoring: quiz.ScoringStreak, correct: true, answeredAt: halfway, streak: 10, want: 1500},
+		{name: "streak, wrong", scoring: quiz.ScoringStreak, answeredAt: halfway, want: 0},
+	}
+	svc := NewService(stubStore{}, nil, slog.New(slog.DiscardHandler))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &Answer{
+				Question:   &Question{StartedAt: startedAt, ExpiredAt: expiredAt},
+				Option:     &quiz.Option{Correct: tt.correct},
+				AnsweredAt: tt.answeredAt,
+				Scoring:    tt.scoring,
+				Streak:     tt.streak,
+			}
+			if got := svc.CalculateScore(t.Context(), a); got != tt.want {
+				t.Errorf("CalculateScore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreakMultiplier(t *testing.T) {
+	t.Parallel()
+
+	for streak, want := range map[int]float64{0: 1, 1: 1, 2: 1.5, 3: 2, 5: 3, 9: 3} {
+		if got := StreakMultiplier(streak); got != want {
+			t.Errorf("StreakMultiplier(%d) = %v, want %v", streak, got, want)
+		}
+	}
+}
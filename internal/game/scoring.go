@@ -4,6 +4,8 @@ import (
 	"context"
 	"log/slog"
 	"time"
+
+	"github.com/starquake/topbanana/internal/quiz"
 )
 
 // maxPoints is the score awarded for a correct answer landing exactly at
@@ -11,9 +13,35 @@ import (
 // the window's end.
 const maxPoints = 1000
 
-// CalculateScore calculates the score for a given answer.
+// CalculateScore calculates the score for a given answer. The quiz's scoring
+// mode, as recorded on the answer, picks the [Scorer] that gives its points.
+// The answer's position in the window comes from its monotonic ElapsedMs when
+// the server measured one, so a wall-clock step between serving and answering
+// cannot skew the score; otherwise from AnsweredAt. An answer helped by a hint
+// loses its penalty share (see [applyHintPenalty]), one carrying a confidence
+// stake is then scaled by it (see [applyWager]), and a wrong answer on a quiz
+// with negative marking loses its penalty last (see
+// [applyWrongAnswerPenalty]), so it can score below zero. Game totals are
+// held at the quiz's floor by [FloorTotal].
 func (s *Service) CalculateScore(ctx context.Context, a *Answer) int {
-	return scoreAnswerCurve(ctx, s.logger, a.Option.Correct, a.Question.StartedAt, a.Question.ExpiredAt, a.AnsweredAt)
+	return applyWrongAnswerPenalty(applyWager(applyHintPenalty(s.scorerFor(a.Scoring).Points(ctx, a), a), a), a)
+}
+
+// answerCredit is the share of the time curve a earns: all or nothing for an
+// option pick, [quiz.NumericCredit] against the answer key for a number, and
+// [quiz.MultiCredit] for a multi-select pick set.
+func answerCredit(a *Answer) float64 {
+	if a.Tally != nil {
+		return quiz.MultiCredit(*a.Tally)
+	}
+	if a.NumericValue != nil {
+		return quiz.NumericCredit(a.Option, *a.NumericValue)
+	}
+	if a.Option.Correct {
+		return 1
+	}
+
+	return 0
 }
 
 // ScoreAnswer scores a pick from its timing primitives, letting the
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/tracing"
 )
 
 const (
@@ -43,8 +45,15 @@ type Service struct {
 	quizStore            quiz.Store
 	logger               *slog.Logger
 	leaderboardPublisher LeaderboardPublisher
+	analytics            AnalyticsEmitter
+	eventLog             AnalyticsEmitter
+	anomalies            *AnomalyMonitor
+	clock                *answerClock
+	now                  func() time.Time
 	revealDelay          time.Duration
 	stalePeriod          time.Duration
+	minQuestionInterval  time.Duration
+	hintPenalty          int
 }
 
 // NewService initializes and returns a new instance of Service with the provided game and quiz stores.
@@ -53,8 +62,12 @@ func NewService(gameStore Store, quizStore quiz.Store, logger *slog.Logger) *Ser
 		store:       gameStore,
 		quizStore:   quizStore,
 		logger:      logger,
+		anomalies:   NewAnomalyMonitor(),
+		clock:       newAnswerClock(),
+		now:         time.Now,
 		revealDelay: defaultRevealDelay,
 		stalePeriod: defaultStalePeriod,
+		hintPenalty: DefaultHintPenalty,
 	}
 }
 
@@ -110,6 +123,20 @@ func (s *Service) SetRevealDelay(d time.Duration) {
 	s.revealDelay = d
 }
 
+// SetClock overrides the service's clock, [time.Now] by default. Question
+// windows, answer receipt times and the in-progress cutoff all read it, so
+// a fixed clock makes a played game's timestamps and scores reproducible.
+// Tests only; same startup-only rule as [Service.SetRevealDelay].
+func (s *Service) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// Now reads the service clock. Handlers stamp serverNow with it so the
+// client's skew correction compares like with like.
+func (s *Service) Now() time.Time {
+	return s.now()
+}
+
 // SetLeaderboardPublisher wires a publisher invoked on every successful
 // SubmitAnswer so SSE subscribers (or any other listener) learn about
 // score changes. Optional - Service works fine without one.
@@ -123,6 +150,23 @@ func (s *Service) SetLeaderboardPublisher(p LeaderboardPublisher) {
 	s.leaderboardPublisher = p
 }
 
+// SetAnalyticsEmitter wires the sink for question_served, answer_submitted
+// and game_finished events. Optional - without one nothing is emitted.
+// Preview games never emit. Same startup-only rule as
+// [Service.SetLeaderboardPublisher].
+func (s *Service) SetAnalyticsEmitter(e AnalyticsEmitter) {
+	s.analytics = e
+}
+
+// SetEventLog wires the game event log, which persists every game's
+// game_created, player_joined, question_served, answer_submitted and
+// game_finished events for replay and debugging. Unlike the analytics sink it
+// also takes owner previews. Optional - without one nothing is logged. Same
+// startup-only rule as [Service.SetLeaderboardPublisher].
+func (s *Service) SetEventLog(e AnalyticsEmitter) {
+	s.eventLog = e
+}
+
 // PublishLeaderboardForPlayer fans out a leaderboard tick on every
 // quiz where the given player has at least one answer. The claim-name
 // flow calls this after a successful rename so all SSE subscribers see
@@ -133,6 +177,9 @@ func (s *Service) SetLeaderboardPublisher(p LeaderboardPublisher) {
 // are best-effort (the publisher is nil-tolerant and the Publish call
 // itself never returns).
 func (s *Service) PublishLeaderboardForPlayer(ctx context.Context, playerID int64) error {
+	ctx, span := tracing.Start(ctx, "game.PublishLeaderboardForPlayer", tracing.Int64("player.id", playerID))
+	defer span.End()
+
 	if s.leaderboardPublisher == nil {
 		return nil
 	}
@@ -154,6 +201,9 @@ func (s *Service) PublishLeaderboardForPlayer(ctx context.Context, playerID int6
 //
 //nolint:revive // preview selects the preview-play path (a distinct create flow), not a behavioural mode switch inside one flow.
 func (s *Service) CreateGame(ctx context.Context, quizID, playerID int64, preview bool) (*Game, error) {
+	ctx, span := tracing.Start(ctx, "game.CreateGame", tracing.Int64("quiz.id", quizID))
+	defer span.End()
+
 	qz, err := s.quizStore.GetQuiz(ctx, quizID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get quiz: %w", err)
@@ -196,6 +246,7 @@ func (s *Service) CreateGame(ctx context.Context, quizID, playerID int64, previe
 		return nil, fmt.Errorf("failed to create game and participant: %w", err)
 	}
 	g.Quiz = qz
+	s.logGameCreated(g, playerID)
 
 	// Repaint subscribers so the new participant appears on the live
 	// leaderboard at score 0 / in-progress immediately (#335). Without
@@ -217,6 +268,9 @@ func (s *Service) CreateGame(ctx context.Context, quizID, playerID int64, previe
 // Returns [ErrGameNotFound] when the player has no game for the quiz, and
 // [quiz.ErrQuizNotFound] when the quiz itself does not exist.
 func (s *Service) GetGameForPlayerOnQuiz(ctx context.Context, playerID, quizID int64) (*Game, error) {
+	ctx, span := tracing.Start(ctx, "game.GetGameForPlayerOnQuiz", tracing.Int64("quiz.id", quizID))
+	defer span.End()
+
 	// Verify the quiz exists first so callers can map ErrQuizNotFound to
 	// a 404 distinct from "no game yet".
 	qz, err := s.quizStore.GetQuiz(ctx, quizID)
@@ -242,6 +296,9 @@ func (s *Service) GetGameForPlayerOnQuiz(ctx context.Context, playerID, quizID i
 // [quiz.ErrQuizNotFound]. The questions carry AudioMediaID/AudioRepeat in
 // position order; the caller filters to the audio-bearing ones.
 func (s *Service) GetAudioManifest(ctx context.Context, gameID string, playerID int64) ([]*quiz.Question, error) {
+	ctx, span := tracing.Start(ctx, "game.GetAudioManifest", tracing.String("game.id", gameID))
+	defer span.End()
+
 	_, qz, err := s.loadGameForPlayer(ctx, gameID, playerID)
 	if err != nil {
 		return nil, err
@@ -258,6 +315,9 @@ func (s *Service) GetAudioManifest(ctx context.Context, gameID string, playerID
 // Returns [quiz.ErrQuizNotFound] when the quiz does not exist so the admin
 // route can map it to a 404.
 func (s *Service) ResetGamesForPlayerOnQuiz(ctx context.Context, playerID, quizID int64) error {
+	ctx, span := tracing.Start(ctx, "game.ResetGamesForPlayerOnQuiz", tracing.Int64("quiz.id", quizID))
+	defer span.End()
+
 	// Existence-only check: we don't need the quiz's questions or options,
 	// so use QuizExists to skip the per-question/per-option fan-out reads
 	// GetQuiz performs.
@@ -281,7 +341,13 @@ func (s *Service) ResetGamesForPlayerOnQuiz(ctx context.Context, playerID, quizI
 // open: an unanswered question whose ExpiredAt is still in the future
 // is returned with its original StartedAt/ExpiredAt anchor, so a
 // reload resumes on the same question without restarting the timer.
+// Running out of questions finishes the game; a finished game resumes
+// nothing and an abandoned one, or one with questions still unasked,
+// returns [ErrGameFinished].
 func (s *Service) GetNextQuestion(ctx context.Context, gameID string, playerID int64) (*Question, error) {
+	ctx, span := tracing.Start(ctx, "game.GetNextQuestion", tracing.String("game.id", gameID))
+	defer span.End()
+
 	// Get the game
 	g, err := s.store.GetGame(ctx, gameID)
 	if err != nil {
@@ -295,43 +361,64 @@ func (s *Service) GetNextQuestion(ctx context.Context, gameID string, playerID i
 		return nil, ErrGameNotFound
 	}
 
-	// Get the quiz
-	qz, err := s.quizStore.GetQuiz(ctx, g.QuizID)
+	// Only the quiz row is read: the next question and its placement come
+	// from targeted store queries, so a request costs the same however
+	// long the quiz is.
+	qz, err := s.quizStore.GetQuizMeta(ctx, g.QuizID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get quiz: %w", err)
 	}
 
-	g.Quiz = qz
+	if g.Status == GameStatusAbandoned {
+		return nil, ErrGameFinished
+	}
+
+	// A shuffled quiz needs its whole question list to know this game's
+	// order; a positional one keeps to the targeted queries.
+	var order []*quiz.Question
+	if qz.ShuffleQuestions {
+		questions, listErr := s.quizStore.ListQuestions(ctx, g.QuizID)
+		if listErr != nil {
+			return nil, fmt.Errorf("failed to list questions: %w", listErr)
+		}
+		order = playOrder(g.ID, questions)
+	}
 
 	// Resume path: when the latest issued game_question is unanswered
 	// and the answer window is still open, hand back the same row so a
 	// reload doesn't skip the question.
-	if gq := resumeCandidate(g, qz); gq != nil {
-		return gq, nil
+	resumed, err := s.resumeOpenQuestion(ctx, g, order)
+	if err != nil {
+		return nil, err
 	}
+	if resumed != nil {
+		resumed.KeepOptionOrder = qz.KeepOptionOrder
+		resumed.ConfidenceWager = qz.ConfidenceWager
 
-	askedQuestions := make(map[int64]bool)
-	for _, gqs := range g.Questions {
-		askedQuestions[gqs.QuestionID] = true
+		return resumed, nil
 	}
 
-	var nextQuestion *quiz.Question
-	for _, q := range qz.Questions {
-		if !askedQuestions[q.ID] {
-			nextQuestion = q
+	nextQuestion, err := s.nextUnaskedQuestion(ctx, g, order)
+	if err != nil {
+		if errors.Is(err, ErrNoMoreQuestions) {
+			s.finishExhausted(ctx, g)
 
-			break
+			return nil, ErrNoMoreQuestions
 		}
-	}
 
-	if nextQuestion == nil {
-		return nil, ErrNoMoreQuestions
+		return nil, fmt.Errorf("failed to get next question: %w", err)
+	}
+	if g.IsFinished() {
+		return nil, ErrGameFinished
+	}
+	if err = s.checkPacing(g); err != nil {
+		return nil, err
 	}
 
 	// The answer window (StartedAt -> ExpiredAt) is anchored at now +
 	// revealDelay, not "now" - the reveal delay gives the player a brief
 	// beat to read the question before the option buttons appear (#247).
-	revealAt := time.Now().Add(s.revealDelay)
+	revealAt := s.now().Add(s.revealDelay)
 	gq := &Question{
 		GameID:       gameID,
 		QuestionID:   nextQuestion.ID,
@@ -341,10 +428,13 @@ func (s *Service) GetNextQuestion(ctx context.Context, gameID string, playerID i
 		// Position counts the newly-issued question itself, so it's
 		// the prior asked count + 1 (the player just received this
 		// question; previous answers were the N-1 before it).
-		Position: len(g.Questions) + 1,
-		Total:    len(qz.Questions),
+		Position:        len(g.Questions) + 1,
+		KeepOptionOrder: qz.KeepOptionOrder,
+		ConfidenceWager: qz.ConfidenceWager,
+	}
+	if err = s.stampQuestionProgress(ctx, gq, order); err != nil {
+		return nil, err
 	}
-	applyRoundProgress(gq, qz)
 	if err = s.store.CreateQuestion(ctx, gq, completesGame(gq)); err != nil {
 		if errors.Is(err, ErrQuestionAlreadyIssued) {
 			return gq, nil
@@ -352,10 +442,99 @@ func (s *Service) GetNextQuestion(ctx context.Context, gameID string, playerID i
 
 		return nil, fmt.Errorf("failed to record game question: %w", err)
 	}
+	s.clock.open(gq, s.now())
+	s.emitQuestionServed(g, playerID, gq)
 
 	return gq, nil
 }
 
+// resumeOpenQuestion is [resumeCandidate] for [Service.GetNextQuestion],
+// which has no loaded quiz: the open question and its placement are read by
+// id, or taken from order on a shuffled quiz. Returns nil when the caller
+// should advance instead, including when the open question was deleted from
+// the quiz mid-game or the game is finished.
+func (s *Service) resumeOpenQuestion(ctx context.Context, g *Game, order []*quiz.Question) (*Question, error) {
+	if g.IsFinished() || !g.HasOpenQuestion() {
+		return nil, nil //nolint:nilnil // nil question means "advance"
+	}
+	latest := g.Questions[len(g.Questions)-1]
+	qq, err := s.quizStore.GetQuestion(ctx, latest.QuestionID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuestionNotFound) {
+			return nil, nil //nolint:nilnil // deleted mid-game: advance
+		}
+
+		return nil, fmt.Errorf("failed to get open question: %w", err)
+	}
+	if qq.QuizID != g.QuizID {
+		return nil, nil //nolint:nilnil // not on this quiz: advance
+	}
+
+	resumed := *latest
+	resumed.QuizQuestion = qq
+	resumed.Position = len(g.Questions)
+	if err = s.stampQuestionProgress(ctx, &resumed, order); err != nil {
+		return nil, err
+	}
+
+	return &resumed, nil
+}
+
+// nextUnaskedQuestion picks the question [Service.GetNextQuestion] issues
+// next: the first of order not yet asked on a shuffled quiz, else the
+// lowest-position unasked question from the store. Returns
+// [ErrNoMoreQuestions] when every question was asked.
+func (s *Service) nextUnaskedQuestion(ctx context.Context, g *Game, order []*quiz.Question) (*quiz.Question, error) {
+	if order == nil {
+		q, err := s.store.GetNextUnaskedQuestion(ctx, g.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get next unasked question: %w", err)
+		}
+
+		return q, nil
+	}
+	asked := make(map[int64]bool, len(g.Questions))
+	for _, gq := range g.Questions {
+		asked[gq.QuestionID] = true
+	}
+	for _, q := range order {
+		if !asked[q.ID] {
+			return q, nil
+		}
+	}
+
+	return nil, ErrNoMoreQuestions
+}
+
+// stampQuestionProgress stamps Total and the round placement onto gq: from
+// the shuffled order when there is one, so "question n of m" in a round
+// counts in play order, else from the store's aggregate.
+func (s *Service) stampQuestionProgress(ctx context.Context, gq *Question, order []*quiz.Question) error {
+	if order == nil {
+		return s.applyQuestionProgress(ctx, gq)
+	}
+	gq.Total = len(order)
+	applyRoundProgress(gq, &quiz.Quiz{Questions: order})
+
+	return nil
+}
+
+// applyQuestionProgress stamps Total and the round placement onto gq from
+// the store's aggregate, the query-backed counterpart of applyRoundProgress.
+func (s *Service) applyQuestionProgress(ctx context.Context, gq *Question) error {
+	p, err := s.quizStore.GetQuestionProgress(ctx, gq.QuestionID)
+	if err != nil {
+		return fmt.Errorf("failed to get question progress: %w", err)
+	}
+	gq.Total = p.Total
+	gq.RoundNumber = p.RoundNumber
+	gq.RoundTotal = p.RoundTotal
+	gq.RoundPosition = p.RoundPosition
+	gq.RoundQuestions = p.RoundQuestions
+
+	return nil
+}
+
 // applyRoundProgress stamps the question's round placement (Round N of M, plus
 // its position within the round) onto gq from the quiz's questions, for the
 // gameplay header.
@@ -379,18 +558,32 @@ func completesGame(gq *Question) bool {
 // short-circuits: an unanswered question still inside its answer
 // window is handed back unchanged so a reload does not skip ahead.
 // Returns [ErrNoMoreQuestions] when nothing is left (kept for legacy
-// reasons; it covers items, not just questions).
+// reasons; it covers items, not just questions) and finishes the game.
+// A finished game still serves its outstanding round boundaries (the
+// final round's results) but no question: that is [ErrGameFinished], as
+// is anything on an abandoned game.
 func (s *Service) GetNext(ctx context.Context, gameID string, playerID int64) (*Item, error) {
+	ctx, span := tracing.Start(ctx, "game.GetNext", tracing.String("game.id", gameID))
+	defer span.End()
+
 	g, qz, err := s.loadGameForPlayer(ctx, gameID, playerID)
 	if err != nil {
 		return nil, err
 	}
+	if g.Status == GameStatusAbandoned {
+		return nil, ErrGameFinished
+	}
+	// Play a shuffled quiz in this game's order from here on: the slot
+	// walk, the resume check and the round progress all read qz.Questions.
+	if qz.ShuffleQuestions {
+		qz.Questions = playOrder(g.ID, qz.Questions)
+	}
 
 	// Resume path: keep the player on an in-flight question through a
 	// reload, matching GetNextQuestion's semantics. A break is never
 	// "in flight" - it is either seen or unseen - so the resume path
 	// only fires for questions.
-	if gq := resumeCandidate(g, qz); gq != nil {
+	if gq := resumeCandidate(g, qz); gq != nil && !g.IsFinished() {
 		return &Item{Type: ItemTypeQuestion, Question: gq}, nil
 	}
 
@@ -417,13 +610,21 @@ func (s *Service) GetNext(ctx context.Context, gameID string, playerID int64) (*
 	case slotKindRoundBoundary:
 		return s.buildRoundBoundaryItem(ctx, g, qz, playerID, next.round, next.phase)
 	case slotKindQuestion:
-		gq, qErr := s.issueQuestion(ctx, gameID, qz, next.question, len(g.Questions))
+		if g.IsFinished() {
+			return nil, ErrGameFinished
+		}
+		if err = s.checkPacing(g); err != nil {
+			return nil, err
+		}
+		gq, qErr := s.issueQuestion(ctx, g, playerID, qz, next.question)
 		if qErr != nil {
 			return nil, qErr
 		}
 
 		return &Item{Type: ItemTypeQuestion, Question: gq}, nil
 	default:
+		s.finishExhausted(ctx, g)
+
 		return nil, ErrNoMoreQuestions
 	}
 }
@@ -433,6 +634,9 @@ func (s *Service) GetNext(ctx context.Context, gameID string, playerID int64) (*
 // round by id, so an admin who reorders rounds while a player has a
 // round boundary on screen will not re-show it.
 func (s *Service) MarkRoundSeen(ctx context.Context, gameID string, playerID, roundID int64, phase RoundPhase) error {
+	ctx, span := tracing.Start(ctx, "game.MarkRoundSeen", tracing.String("game.id", gameID))
+	defer span.End()
+
 	if !phase.Valid() {
 		return ErrInvalidRoundPhase
 	}
@@ -464,12 +668,95 @@ func (s *Service) MarkRoundSeen(ctx context.Context, gameID string, playerID, ro
 // (ExpiredAt plus the latency grace) are rejected with
 // ErrAnswerWindowClosed; otherwise tappedAt is refunded up to
 // maxLatencyRefund so a slow link is not penalised but a client cannot
-// claim the window start (#237, #1163).
+// claim the window start (#237, #1163). The answer to the last question
+// finishes the game; a finished game rejects new answers with
+// [ErrGameFinished]. A numeric question takes [Service.SubmitNumericAnswer]
+// and a multi-select one [Service.SubmitMultiAnswer] instead; either rejects
+// a single pick with [ErrAnswerKindMismatch]. A player who paused the question
+// with [Service.PauseOnce] has [PauseExtension] longer to answer it.
 func (s *Service) SubmitAnswer(
 	ctx context.Context,
 	gameID string,
 	playerID, questionID, optionID int64,
 	tappedAt time.Time,
+) (*Answer, error) {
+	ctx, span := tracing.Start(ctx, "game.SubmitAnswer", tracing.String("game.id", gameID))
+	defer span.End()
+
+	return s.submitAnswer(ctx, gameID, playerID, questionID, answerPick{optionID: optionID}, tappedAt)
+}
+
+// SubmitNumericAnswer records a player's typed number for a numeric
+// question, against the question's answer key option. Everything else -
+// window, latency refund, finishing - is as for [Service.SubmitAnswer]; a
+// choice question rejects a number with [ErrAnswerKindMismatch].
+func (s *Service) SubmitNumericAnswer(
+	ctx context.Context,
+	gameID string,
+	playerID, questionID int64,
+	value float64,
+	tappedAt time.Time,
+) (*Answer, error) {
+	ctx, span := tracing.Start(ctx, "game.SubmitNumericAnswer", tracing.String("game.id", gameID))
+	defer span.End()
+
+	return s.submitAnswer(ctx, gameID, playerID, questionID, answerPick{numericValue: &value}, tappedAt)
+}
+
+// SubmitMultiAnswer records a player's pick set for a multi-select question,
+// scored with partial credit (see [quiz.MultiCredit]). A repeated option ID
+// counts once; an ID outside the question, or an empty set, is
+// [ErrOptionNotInQuestion], and any other kind of question rejects a pick set
+// with [ErrAnswerKindMismatch]. Everything else is as for
+// [Service.SubmitAnswer].
+func (s *Service) SubmitMultiAnswer(
+	ctx context.Context,
+	gameID string,
+	playerID, questionID int64,
+	optionIDs []int64,
+	tappedAt time.Time,
+) (*Answer, error) {
+	ctx, span := tracing.Start(ctx, "game.SubmitMultiAnswer", tracing.String("game.id", gameID))
+	defer span.End()
+
+	picked := slices.Clone(optionIDs)
+	slices.Sort(picked)
+	if picked == nil {
+		picked = []int64{}
+	}
+
+	return s.submitAnswer(ctx, gameID, playerID, questionID, answerPick{optionIDs: slices.Compact(picked)}, tappedAt)
+}
+
+// answerPick is the shape of a submitted answer: one option, a typed number
+// for a numeric question, or a pick set for a multi-select one.
+type answerPick struct {
+	optionID     int64
+	numericValue *float64
+	optionIDs    []int64
+}
+
+// kind is the question kind the pick's shape answers. A single option pick
+// answers both single-pick kinds; it reports KindChoice.
+func (p answerPick) kind() string {
+	switch {
+	case p.numericValue != nil:
+		return quiz.KindNumeric
+	case p.optionIDs != nil:
+		return quiz.KindMulti
+	default:
+		return quiz.KindChoice
+	}
+}
+
+// submitAnswer is the shared body of [Service.SubmitAnswer],
+// [Service.SubmitNumericAnswer] and [Service.SubmitMultiAnswer].
+func (s *Service) submitAnswer(
+	ctx context.Context,
+	gameID string,
+	playerID, questionID int64,
+	pick answerPick,
+	tappedAt time.Time,
 ) (*Answer, error) {
 	g, err := s.store.GetGame(ctx, gameID)
 	if err != nil {
@@ -480,30 +767,49 @@ func (s *Service) SubmitAnswer(
 	// answer-post path previously trusted the (gameID, playerID) pair the
 	// caller supplied, so a third party could land an answer row in
 	// someone else's game.
-	if !hasParticipant(g, playerID) {
+	p := participant(g, playerID)
+	if p == nil {
 		return nil, ErrGameNotFound
 	}
+	hidden, err := s.scoresHidden(ctx, g)
+	if err != nil {
+		return nil, err
+	}
 
-	question, option, err := s.resolveAnswerTarget(ctx, g, gameID, questionID, optionID)
+	question, option, err := s.resolveAnswerTarget(ctx, g, gameID, questionID, pick)
 	if err != nil {
 		return nil, err
 	}
+	if g.IsFinished() {
+		return nil, finishedGameAnswerErr(question, playerID)
+	}
 
 	// Reject an answer that lands past the window; it scores nothing (#1163).
-	now := time.Now()
-	if now.After(question.ExpiredAt.Add(lateAnswerGrace)) {
+	// The player's pause, if spent on this question, moves their deadline.
+	now := s.now()
+	if now.After(question.ExpiredAt.Add(pauseFor(p, question) + lateAnswerGrace)) {
 		return nil, ErrAnswerWindowClosed
 	}
 
 	a := &Answer{
-		GameID:     gameID,
-		PlayerID:   playerID,
-		QuestionID: question.ID,
-		Question:   question,
-		OptionID:   optionID,
-		Option:     option,
-		AnsweredAt: clampTappedAt(tappedAt, now, maxLatencyRefund),
-	}
+		GameID:       gameID,
+		PlayerID:     playerID,
+		QuestionID:   question.ID,
+		Question:     question,
+		OptionID:     option.ID,
+		Option:       option,
+		AnsweredAt:   clampTappedAt(tappedAt, now, maxLatencyRefund),
+		NumericValue: pick.numericValue,
+		OptionIDs:    pick.optionIDs,
+		HintPenalty:  s.hintPenalty,
+		ScoresHidden: hidden,
+	}
+	if pick.optionIDs != nil {
+		tally := quiz.TallyPicks(question.QuizQuestion.Options, pick.optionIDs)
+		a.Tally = &tally
+	}
+	s.measureElapsed(a, now)
+	stampPausedMs(p, a)
 
 	if err = s.store.CreateAnswer(ctx, a); err != nil {
 		// Pass ErrAnswerAlreadyRecorded through unwrapped so the
@@ -516,6 +822,10 @@ func (s *Service) SubmitAnswer(
 		return nil, fmt.Errorf("failed to create answer: %w", err)
 	}
 
+	s.checkAnswerAnomalies(ctx, g, a, now)
+	finished := s.finishOnLastAnswer(ctx, g, a)
+	s.emitAnswerAnalytics(ctx, g, a, finished)
+
 	// Signal SSE subscribers that the leaderboard has moved. Non-blocking
 	// (the hub buffers one event per subscriber and drops on backpressure),
 	// so this never delays the answer-submit response.
@@ -529,8 +839,12 @@ func (s *Service) SubmitAnswer(
 // GetResults calculates the accumulated score for each player in a game and
 // returns the results. Requires playerID for the participant gate (#272);
 // non-participants get ErrGameNotFound so the gameID itself can't be used
-// to read the score map of a game the caller is not in.
+// to read the score map of a game the caller is not in. A game of a
+// host-paced quiz returns [ErrScoresHidden] until its host reveals it.
 func (s *Service) GetResults(ctx context.Context, gameID string, playerID int64) (*Results, error) {
+	ctx, span := tracing.Start(ctx, "game.GetResults", tracing.String("game.id", gameID))
+	defer span.End()
+
 	g, err := s.store.GetGame(ctx, gameID)
 	if err != nil {
 		return nil, fmt.Errorf(errGetGameFmt, err)
@@ -539,10 +853,74 @@ func (s *Service) GetResults(ctx context.Context, gameID string, playerID int64)
 	if !hasParticipant(g, playerID) {
 		return nil, ErrGameNotFound
 	}
+	hidden, err := s.scoresHidden(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	if hidden {
+		return nil, ErrScoresHidden
+	}
+
+	return s.computeResults(ctx, g)
+}
+
+// GetGameForReview loads a game with its quiz attached and its current
+// results, for the admin game page. There is no participant gate: the
+// caller checks the Host owns the game's quiz.
+func (s *Service) GetGameForReview(ctx context.Context, gameID string) (*Game, *Results, error) {
+	ctx, span := tracing.Start(ctx, "game.GetGameForReview", tracing.String("game.id", gameID))
+	defer span.End()
+
+	g, err := s.store.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGetGameFmt, err)
+	}
+
+	qz, err := s.quizStore.GetQuiz(ctx, g.QuizID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get quiz: %w", err)
+	}
+	g.Quiz = qz
+
+	results, err := s.computeResults(ctx, g)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return g, results, nil
+}
+
+// VoidQuestion voids an issued question for the game after it turned out to
+// be wrong: its answers stay recorded but stop scoring, in the game's results
+// and on the quiz leaderboard, which is republished so watchers see the
+// recomputed standings. Voiding twice is a no-op. Returns
+// [ErrQuestionNotInGame] when the question was never issued to the game.
+func (s *Service) VoidQuestion(ctx context.Context, g *Game, questionID int64) error {
+	ctx, span := tracing.Start(ctx, "game.VoidQuestion", tracing.String("game.id", g.ID))
+	defer span.End()
+
+	if err := s.store.VoidQuestion(ctx, g.ID, questionID); err != nil {
+		return fmt.Errorf("failed to void question: %w", err)
+	}
+	if s.leaderboardPublisher != nil && !g.Preview {
+		s.leaderboardPublisher.Publish(g.QuizID)
+	}
+
+	return nil
+}
 
+// computeResults scores every answer in the loaded game, skipping questions
+// voided for it, and picks the winner.
+func (s *Service) computeResults(ctx context.Context, g *Game) (*Results, error) {
 	// Collect all option IDs needed across all answers in one pass.
 	var optionIDs []int64
+	voided := make([]int64, 0)
 	for _, gqs := range g.Questions {
+		if gqs.Voided {
+			voided = append(voided, gqs.QuestionID)
+
+			continue
+		}
 		for _, ga := range gqs.Answers {
 			optionIDs = append(optionIDs, ga.OptionID)
 		}
@@ -559,7 +937,11 @@ func (s *Service) GetResults(ctx context.Context, gameID string, playerID int64)
 	}
 
 	plsMap := make(map[int64]int, len(g.Participants))
+	lastAnswer := make(map[int64]*Answer, len(g.Participants))
 	for _, gqs := range g.Questions {
+		if gqs.Voided {
+			continue
+		}
 		for _, ga := range gqs.Answers {
 			ga.Question = gqs
 			ga.Option = optionsByID[ga.OptionID]
@@ -569,8 +951,12 @@ func (s *Service) GetResults(ctx context.Context, gameID string, playerID int64)
 				continue
 			}
 			plsMap[ga.PlayerID] += s.CalculateScore(ctx, ga)
+			lastAnswer[ga.PlayerID] = ga
 		}
 	}
+	for playerID, score := range plsMap {
+		plsMap[playerID] = FloorTotal(score, lastAnswer[playerID])
+	}
 
 	// Seed at 0 so an all-wrong run leaves Winner == 0 (no winner) rather than
 	// crowning a zero-score player.
@@ -585,7 +971,14 @@ func (s *Service) GetResults(ctx context.Context, gameID string, playerID int64)
 		}
 	}
 
-	return &Results{GameID: g.ID, Winner: winner, PlayerScores: plsMap}, nil
+	return &Results{
+		GameID:            g.ID,
+		Winner:            winner,
+		PlayerScores:      plsMap,
+		VoidedQuestionIDs: voided,
+		Status:            g.Status,
+		FinishedAt:        g.FinishedAt,
+	}, nil
 }
 
 // CreatePreviewGame creates an owner preview game from an already-loaded quiz: a
@@ -593,6 +986,9 @@ func (s *Service) GetResults(ctx context.Context, gameID string, playerID int64)
 // quiz returns [ErrPreviewNotAllowed]; any prior game for the pair is reset first
 // so re-previewing works. Ownership is enforced by the caller.
 func (s *Service) CreatePreviewGame(ctx context.Context, qz *quiz.Quiz, playerID int64) (*Game, error) {
+	ctx, span := tracing.Start(ctx, "game.CreatePreviewGame", tracing.Int64("quiz.id", qz.ID))
+	defer span.End()
+
 	// Solo drafts only. Rejecting a published quiz is load-bearing: the reset below would otherwise hard-delete the requester's real game and destroy their leaderboard entry (#1192).
 	if qz.Mode != quiz.ModeSolo || qz.Published {
 		return nil, ErrPreviewNotAllowed
@@ -608,19 +1004,23 @@ func (s *Service) CreatePreviewGame(ctx context.Context, qz *quiz.Quiz, playerID
 		return nil, fmt.Errorf("failed to create preview game and participant: %w", err)
 	}
 	g.Quiz = qz
+	s.logGameCreated(g, playerID)
 
 	return g, nil
 }
 
 // resolveAnswerTarget finds the issued game_question for the supplied
-// questionID and the option for the supplied optionID, loading the
-// option set in one round-trip so [Service.SubmitAnswer] can also
-// surface the correct options on a wrong-pick reveal (#233). Returns
-// [ErrQuestionNotInGame] or [ErrOptionNotInQuestion] when the lookup
-// misses; pulled out of SubmitAnswer to keep it under revive's
+// questionID and the option the pick resolves to, loading the option set in
+// one round-trip so [Service.SubmitAnswer] can also surface the correct
+// options on a wrong-pick reveal (#233). A numeric answer resolves to the
+// question's answer key, and a multi-select pick set to its first option
+// once every picked ID is checked against the question. Returns
+// [ErrQuestionNotInGame] or [ErrOptionNotInQuestion] when the lookup misses
+// and [ErrAnswerKindMismatch] when the pick's shape does not match the
+// question's kind; pulled out of SubmitAnswer to keep it under revive's
 // function-length cap.
 func (s *Service) resolveAnswerTarget(
-	ctx context.Context, g *Game, gameID string, questionID, optionID int64,
+	ctx context.Context, g *Game, gameID string, questionID int64, pick answerPick,
 ) (*Question, *quiz.Option, error) {
 	var question *Question
 	for _, qs := range g.Questions {
@@ -650,7 +1050,33 @@ func (s *Service) resolveAnswerTarget(
 	}
 	question.QuizQuestion = quizQuestion
 
-	for _, o := range quizQuestion.Options {
+	kind := quiz.NormalizedKind(quizQuestion.Kind)
+	if quizQuestion.IsSinglePick() {
+		kind = quiz.KindChoice
+	}
+	if pick.kind() != kind {
+		return nil, nil, fmt.Errorf("question %d: %w", question.QuestionID, ErrAnswerKindMismatch)
+	}
+	switch kind {
+	case quiz.KindNumeric:
+		if key := quizQuestion.NumericKey(); key != nil {
+			return question, key, nil
+		}
+
+		return nil, nil, fmt.Errorf(
+			"numeric question %d has no answer key: %w", question.QuestionID, ErrOptionNotInQuestion,
+		)
+	case quiz.KindMulti:
+		return resolvePickSet(question, pick.optionIDs)
+	default:
+		return resolvePickedOption(question, pick.optionID)
+	}
+}
+
+// resolvePickedOption returns the question's option with optionID, or
+// [ErrOptionNotInQuestion].
+func resolvePickedOption(question *Question, optionID int64) (*Question, *quiz.Option, error) {
+	for _, o := range question.QuizQuestion.Options {
 		if o.ID == optionID {
 			return question, o, nil
 		}
@@ -664,6 +1090,29 @@ func (s *Service) resolveAnswerTarget(
 	)
 }
 
+// resolvePickSet checks every picked ID against the question and returns the
+// first picked option, the one the answer row references. An empty pick set
+// is [ErrOptionNotInQuestion]: there is no option to reference.
+func resolvePickSet(question *Question, optionIDs []int64) (*Question, *quiz.Option, error) {
+	if len(optionIDs) == 0 {
+		return nil, nil, fmt.Errorf(
+			"empty pick set for question %d: %w", question.QuestionID, ErrOptionNotInQuestion,
+		)
+	}
+	var first *quiz.Option
+	for _, id := range optionIDs {
+		_, o, err := resolvePickedOption(question, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if first == nil {
+			first = o
+		}
+	}
+
+	return question, first, nil
+}
+
 // loadGameForPlayer is the entry-point gate shared by [Service.GetNext]
 // and the new MarkBreakSeen flow. It loads the game, applies the #272
 // participant check (non-participants get ErrGameNotFound so the gameID
@@ -695,17 +1144,19 @@ func (s *Service) loadGameForPlayer(
 // [Service.GetNextQuestion] exactly so the two entry points stay
 // behavior-equivalent on the question path (#167 slice 2 / #247).
 func (s *Service) issueQuestion(
-	ctx context.Context, gameID string, qz *quiz.Quiz, q *quiz.Question, askedCount int,
+	ctx context.Context, g *Game, playerID int64, qz *quiz.Quiz, q *quiz.Question,
 ) (*Question, error) {
-	revealAt := time.Now().Add(s.revealDelay)
+	revealAt := s.now().Add(s.revealDelay)
 	gq := &Question{
-		GameID:       gameID,
-		QuestionID:   q.ID,
-		QuizQuestion: q,
-		StartedAt:    revealAt,
-		ExpiredAt:    revealAt.Add(resolveAnswerWindow(q, qz)),
-		Position:     askedCount + 1,
-		Total:        len(qz.Questions),
+		GameID:          g.ID,
+		QuestionID:      q.ID,
+		QuizQuestion:    q,
+		StartedAt:       revealAt,
+		ExpiredAt:       revealAt.Add(resolveAnswerWindow(q, qz)),
+		Position:        len(g.Questions) + 1,
+		Total:           len(qz.Questions),
+		KeepOptionOrder: qz.KeepOptionOrder,
+		ConfidenceWager: qz.ConfidenceWager,
 	}
 	applyRoundProgress(gq, qz)
 	if err := s.store.CreateQuestion(ctx, gq, completesGame(gq)); err != nil {
@@ -715,6 +1166,8 @@ func (s *Service) issueQuestion(
 
 		return nil, fmt.Errorf("failed to record game question: %w", err)
 	}
+	s.clock.open(gq, s.now())
+	s.emitQuestionServed(g, playerID, gq)
 
 	return gq, nil
 }
@@ -729,14 +1182,15 @@ func (s *Service) issueQuestion(
 func (s *Service) computeGameScore(ctx context.Context, g *Game, playerID int64) (int, error) {
 	result, err := s.scoreAnswers(ctx, g, playerID, nil)
 
-	return result.Score, err
+	return result.Total, err
 }
 
 // scoreResult is the outcome of [Service.scoreAnswers]: the summed
-// points and the number of correctly answered questions over the scored
-// answer set.
+// points, that sum held at the score floor ([FloorTotal]) for a game total,
+// and the number of correctly answered questions over the scored answer set.
 type scoreResult struct {
 	Score   int
+	Total   int
 	Correct int
 }
 
@@ -774,25 +1228,27 @@ func (s *Service) scoreAnswers(
 		if ga.Option == nil {
 			continue
 		}
-		if ga.Option.Correct {
+		if ga.IsCorrect() {
 			result.Correct++
 		}
 		result.Score += s.CalculateScore(ctx, ga)
 	}
+	result.Total = FloorTotal(result.Score, answers...)
 
 	return result, nil
 }
 
 // collectPlayerAnswers gathers the player's answers across the game's
 // issued questions, attaching each answer's owning question so
-// [Service.CalculateScore] can read the timing window. When include is
-// non-nil, only answers to questions it accepts are returned.
+// [Service.CalculateScore] can read the timing window. Answers to voided
+// questions are left out. When include is non-nil, only answers to
+// questions it accepts are returned.
 func collectPlayerAnswers(
 	g *Game, playerID int64, include func(questionID int64) bool,
 ) []*Answer {
 	var answers []*Answer
 	for _, gq := range g.Questions {
-		if include != nil && !include(gq.QuestionID) {
+		if gq.Voided || (include != nil && !include(gq.QuestionID)) {
 			continue
 		}
 		for _, ga := range gq.Answers {
@@ -814,7 +1270,7 @@ func collectPlayerAnswers(
 func (s *Service) buildRoundBoundaryItem(
 	ctx context.Context, g *Game, qz *quiz.Quiz, playerID int64, round *quiz.Round, phase RoundPhase,
 ) (*Item, error) {
-	startedAt := time.Now()
+	startedAt := s.now()
 	item := &Item{
 		Type:      ItemTypeRoundBoundary,
 		Round:     round,
@@ -826,6 +1282,12 @@ func (s *Service) buildRoundBoundaryItem(
 	if phase != RoundPhaseResults {
 		return item, nil
 	}
+	// A host-paced game keeps the running score and recap back too.
+	if scoresHeldBack(g, qz) {
+		item.ScoresHidden = true
+
+		return item, nil
+	}
 
 	score, err := s.computeGameScore(ctx, g, playerID)
 	if err != nil {
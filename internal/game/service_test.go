@@ -893,6 +893,9 @@ func TestService_GetResults(t *testing.T) {
 			},
 		}
 		qs := stubQuizStore{
+			getQuizMeta: func(_ context.Context, _ int64) (*quiz.Quiz, error) {
+				return &quiz.Quiz{}, nil
+			},
 			getOptionsByIDs: func(_ context.Context, _ []int64) ([]*quiz.Option, error) {
 				return nil, nil
 			},
@@ -910,6 +913,80 @@ func TestService_GetResults(t *testing.T) {
 	})
 }
 
+// TestService_VoidQuestion pins a host's void: the voided question's answers
+// drop out of the game's results and the quiz leaderboard, the results list
+// the void, and a question never issued to the game is rejected.
+func TestService_VoidQuestion(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := newTestQuiz(t)
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+
+	svc := NewService(gameStore, quizStore, slog.Default())
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("failed to create game: %v", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("failed to get next question: %v", err)
+	}
+	correctOption := gq.QuizQuestion.Options[0]
+	if _, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, correctOption.ID, time.Time{}); err != nil {
+		t.Fatalf("failed to submit answer: %v", err)
+	}
+
+	before, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("failed to get results: %v", err)
+	}
+	if before.PlayerScores[1] == 0 {
+		t.Fatal("score before the void = 0, want a scored correct answer")
+	}
+
+	if err = svc.VoidQuestion(ctx, g, gq.QuizQuestion.ID); err != nil {
+		t.Fatalf("VoidQuestion err = %v, want nil", err)
+	}
+	if err = svc.VoidQuestion(ctx, g, gq.QuizQuestion.ID); err != nil {
+		t.Errorf("second VoidQuestion err = %v, want nil", err)
+	}
+
+	after, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("failed to get results: %v", err)
+	}
+	if got := after.PlayerScores[1]; got != 0 {
+		t.Errorf("score after the void = %d, want 0", got)
+	}
+	if diff := cmp.Diff([]int64{gq.QuizQuestion.ID}, after.VoidedQuestionIDs); diff != "" {
+		t.Errorf("VoidedQuestionIDs mismatch (-want +got):\n%s", diff)
+	}
+
+	board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 0)
+	if err != nil {
+		t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+	}
+	for _, e := range board.Entries {
+		if e.Score != 0 {
+			t.Errorf("leaderboard entry %+v, want score 0 after the void", e)
+		}
+	}
+
+	unissued := testQuiz.Questions[len(testQuiz.Questions)-1].ID
+	if err = svc.VoidQuestion(ctx, g, unissued); !errors.Is(err, ErrQuestionNotInGame) {
+		t.Errorf("VoidQuestion on an unissued question err = %v, want %v", err, ErrQuestionNotInGame)
+	}
+}
+
 func TestService_GetNextQuestion(t *testing.T) {
 	t.Parallel()
 
@@ -1952,3 +2029,214 @@ func countGameQuestionRows(ctx context.Context, t *testing.T, db *sql.DB, gameID
 
 	return n
 }
+
+// TestService_SubmitNumericAnswer pins the numeric question flow end to end:
+// a pick is refused, a typed number is recorded against the answer key and
+// scores its credit share of the time curve, and the game results and the
+// quiz leaderboard agree on that score.
+func TestService_SubmitNumericAnswer(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Numbers",
+		Slug:              "numbers",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		Questions: []*quiz.Question{
+			{
+				Text:     "How tall is the Eiffel Tower, in metres?",
+				Position: 10,
+				Kind:     quiz.KindNumeric,
+				Options:  []*quiz.Option{quiz.NewNumericKey(330, 30, 70)},
+			},
+			{
+				Text:     "What is the capital of France?",
+				Position: 20,
+				Options:  []*quiz.Option{{Text: "Paris", Correct: true}, {Text: "London"}},
+			},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	if !gq.QuizQuestion.IsNumeric() {
+		t.Fatalf("Kind = %q, want %q", gq.QuizQuestion.Kind, quiz.KindNumeric)
+	}
+	key := gq.QuizQuestion.NumericKey()
+
+	_, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, key.ID, time.Time{})
+	if !errors.Is(err, ErrAnswerKindMismatch) {
+		t.Fatalf("SubmitAnswer on a numeric question err = %v, want %v", err, ErrAnswerKindMismatch)
+	}
+
+	// 365 is halfway to the upper tolerance edge: half credit.
+	a, err := svc.SubmitNumericAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, 365, time.Time{})
+	if err != nil {
+		t.Fatalf("SubmitNumericAnswer err = %v, want nil", err)
+	}
+	if got, want := a.OptionID, key.ID; got != want {
+		t.Errorf("OptionID = %d, want the key option %d", got, want)
+	}
+	if !a.IsCorrect() {
+		t.Error("IsCorrect() = false, want true within the tolerance")
+	}
+	score := svc.CalculateScore(ctx, a)
+	if score <= 0 || score > 500 {
+		t.Errorf("CalculateScore() = %d, want at most half of a full correct answer", score)
+	}
+
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("second GetNextQuestion err = %v, want nil", err)
+	}
+	_, err = svc.SubmitNumericAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, 1, time.Time{})
+	if !errors.Is(err, ErrAnswerKindMismatch) {
+		t.Errorf("SubmitNumericAnswer on a choice question err = %v, want %v", err, ErrAnswerKindMismatch)
+	}
+
+	results, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetResults err = %v, want nil", err)
+	}
+	if got, want := results.PlayerScores[1], score; got != want {
+		t.Errorf("results score = %d, want %d", got, want)
+	}
+	board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+	if err != nil {
+		t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+	}
+	if len(board.Entries) != 1 {
+		t.Fatalf("leaderboard entries = %d, want 1", len(board.Entries))
+	}
+	if got, want := board.Entries[0].Score, score; got != want {
+		t.Errorf("leaderboard score = %d, want %d", got, want)
+	}
+}
+
+// TestService_SubmitMultiAnswer pins the select-all-that-apply flow end to
+// end: a single pick is refused, a pick set with a stray option is refused,
+// a partly right pick set is recorded and scores its credit share of the
+// time curve, and the game results and the quiz leaderboard agree on that
+// score after reloading the picks from the store.
+func TestService_SubmitMultiAnswer(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Planets",
+		Slug:              "planets",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		Questions: []*quiz.Question{
+			{
+				Text:     "Which of these are gas giants?",
+				Position: 10,
+				Kind:     quiz.KindMulti,
+				Options: []*quiz.Option{
+					{Text: "Jupiter", Correct: true},
+					{Text: "Saturn", Correct: true},
+					{Text: "Mars"},
+					{Text: "Venus"},
+				},
+			},
+			{
+				Text:     "Pluto is a planet.",
+				Position: 20,
+				Kind:     quiz.KindTrueFalse,
+				Options:  []*quiz.Option{{Text: "True"}, {Text: "False", Correct: true}},
+			},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	opts := gq.QuizQuestion.Options
+	jupiter, saturn, mars := opts[0].ID, opts[1].ID, opts[2].ID
+
+	_, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, jupiter, time.Time{})
+	if !errors.Is(err, ErrAnswerKindMismatch) {
+		t.Fatalf("SubmitAnswer on a multi question err = %v, want %v", err, ErrAnswerKindMismatch)
+	}
+	_, err = svc.SubmitMultiAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, []int64{jupiter, -1}, time.Time{})
+	if !errors.Is(err, ErrOptionNotInQuestion) {
+		t.Fatalf("SubmitMultiAnswer with a stray option err = %v, want %v", err, ErrOptionNotInQuestion)
+	}
+	_, err = svc.SubmitMultiAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, []int64{}, time.Time{})
+	if !errors.Is(err, ErrOptionNotInQuestion) {
+		t.Fatalf("SubmitMultiAnswer with no picks err = %v, want %v", err, ErrOptionNotInQuestion)
+	}
+
+	// Both gas giants plus Mars: two shares earned, one taken back.
+	a, err := svc.SubmitMultiAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, []int64{saturn, mars, jupiter, saturn}, time.Time{})
+	if err != nil {
+		t.Fatalf("SubmitMultiAnswer err = %v, want nil", err)
+	}
+	if got, want := *a.Tally, (quiz.PickTally{Correct: 2, Wrong: 1, CorrectTotal: 2}); got != want {
+		t.Errorf("Tally = %+v, want %+v", got, want)
+	}
+	if !a.IsCorrect() {
+		t.Error("IsCorrect() = false, want true for a pick set earning partial credit")
+	}
+	score := svc.CalculateScore(ctx, a)
+	if score <= 0 || score > 500 {
+		t.Errorf("CalculateScore() = %d, want at most half of a full correct answer", score)
+	}
+
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("second GetNextQuestion err = %v, want nil", err)
+	}
+	_, err = svc.SubmitMultiAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, []int64{gq.QuizQuestion.Options[1].ID}, time.Time{})
+	if !errors.Is(err, ErrAnswerKindMismatch) {
+		t.Errorf("SubmitMultiAnswer on a true/false question err = %v, want %v", err, ErrAnswerKindMismatch)
+	}
+
+	results, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetResults err = %v, want nil", err)
+	}
+	if got, want := results.PlayerScores[1], score; got != want {
+		t.Errorf("results score = %d, want %d", got, want)
+	}
+	board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+	if err != nil {
+		t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+	}
+	if len(board.Entries) != 1 {
+		t.Fatalf("leaderboard entries = %d, want 1", len(board.Entries))
+	}
+	if got, want := board.Entries[0].Score, score; got != want {
+		t.Errorf("leaderboard score = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+package game
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// playOrder returns the quiz's questions in the order gameID plays them when
+// the quiz shuffles its questions: each round's questions are permuted among
+// the slots that round holds, so rounds keep their order and their intro and
+// recap cards still frame the right questions. The permutation is seeded from
+// (gameID, round), so every call for the same game - a reload, a reconnect, a
+// restarted server - yields the same sequence, while two games of the quiz
+// differ. A question with an [quiz.Question.AfterQuestionID] in its own
+// round moves as one block with the question it follows, so the pair is
+// still played back to back. questions is taken in position order and left
+// untouched.
+func playOrder(gameID string, questions []*quiz.Question) []*quiz.Question {
+	slots := make(map[int64][]int)
+	for i, q := range questions {
+		slots[q.RoundID] = append(slots[q.RoundID], i)
+	}
+
+	ordered := make([]*quiz.Question, len(questions))
+	for roundID, idx := range slots {
+		round := make([]*quiz.Question, len(idx))
+		for i, at := range idx {
+			round[i] = questions[at]
+		}
+		blocks := followBlocks(round)
+		seed := playOrderSeed(gameID, roundID)
+		// G404: deterministic by design - the same game must replay the same
+		// order, which crypto/rand cannot seed. Nothing secret is at stake.
+		rng := rand.New(rand.NewPCG(seed, ^seed)) //nolint:gosec // deterministic shuffle, not a security boundary
+		rng.Shuffle(len(blocks), func(i, j int) { blocks[i], blocks[j] = blocks[j], blocks[i] })
+		round = slices.Concat(blocks...)
+		for i, at := range idx {
+			ordered[at] = round[i]
+		}
+	}
+
+	return ordered
+}
+
+// followBlocks splits one round's questions, taken in position order, into
+// the units the shuffle moves: each question joins the block of the question
+// it follows, directly or through a chain, and keeps its position order
+// inside the block. A constraint naming a question outside the round is
+// ignored, as rounds keep their order anyway.
+func followBlocks(round []*quiz.Question) [][]*quiz.Question {
+	after := make(map[int64]int64, len(round))
+	for _, q := range round {
+		if q.AfterQuestionID != nil {
+			after[q.ID] = *q.AfterQuestionID
+		}
+	}
+	inRound := make(map[int64]bool, len(round))
+	for _, q := range round {
+		inRound[q.ID] = true
+	}
+	// root walks the chain up to the first question that follows nothing in
+	// the round. The step bound stops a cycle the editor never writes.
+	root := func(id int64) int64 {
+		for range round {
+			prev, ok := after[id]
+			if !ok || !inRound[prev] || prev == id {
+				break
+			}
+			id = prev
+		}
+
+		return id
+	}
+
+	var blocks [][]*quiz.Question
+	blockOf := make(map[int64]int, len(round))
+	for _, q := range round {
+		r := root(q.ID)
+		at, ok := blockOf[r]
+		if !ok {
+			at = len(blocks)
+			blockOf[r] = at
+			blocks = append(blocks, nil)
+		}
+		blocks[at] = append(blocks[at], q)
+	}
+
+	return blocks
+}
+
+// playOrderSeed derives the shuffle seed for one round of one game with
+// FNV-64a, the same construction the client API uses for the option layout.
+func playOrderSeed(gameID string, roundID int64) uint64 {
+	h := fnv.New64a()
+	// hash.Hash.Write never returns an error, nor does binary.Write into it.
+	_, _ = h.Write([]byte(gameID))
+	_, _ = h.Write([]byte{'#'})
+	_ = binary.Write(h, binary.LittleEndian, roundID)
+
+	return h.Sum64()
+}
@@ -0,0 +1,148 @@
+package game_test
+
+import (
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/fixture"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// questionIDs projects questions onto their IDs for order comparisons.
+func questionIDs(questions []*quiz.Question) []int64 {
+	ids := make([]int64, len(questions))
+	for i, q := range questions {
+		ids[i] = q.ID
+	}
+
+	return ids
+}
+
+func TestPlayOrder(t *testing.T) {
+	t.Parallel()
+
+	// Two rounds of six: the permutation must stay inside each round's slots.
+	questions := make([]*quiz.Question, 12)
+	for i := range questions {
+		questions[i] = &quiz.Question{ID: int64(i + 1), RoundID: int64(i/6 + 1)}
+	}
+	original := questionIDs(questions)
+
+	got := questionIDs(ExportPlayOrder("game-a", questions))
+	if again := questionIDs(ExportPlayOrder("game-a", questions)); !slices.Equal(got, again) {
+		t.Errorf("playOrder is not stable for one game: %v then %v", got, again)
+	}
+	if !slices.Equal(questionIDs(questions), original) {
+		t.Error("playOrder reordered its input")
+	}
+	for i, id := range got {
+		if wantRound, gotRound := int64(i/6+1), (id-1)/6+1; gotRound != wantRound {
+			t.Errorf("slot %d holds question %d from round %d, want round %d", i, id, gotRound, wantRound)
+		}
+	}
+	sorted := slices.Clone(got)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, original) {
+		t.Errorf("playOrder = %v, want a permutation of %v", got, original)
+	}
+
+	// Twelve questions leave a 1 in (6!)^2 chance that every game matches;
+	// over a handful of games some must differ.
+	differs := false
+	for _, id := range []string{"game-b", "game-c", "game-d", "game-e"} {
+		if !slices.Equal(got, questionIDs(ExportPlayOrder(id, questions))) {
+			differs = true
+
+			break
+		}
+	}
+	if !differs {
+		t.Error("every game plays the same order, want the order seeded per game")
+	}
+}
+
+// TestPlayOrder_KeepsFollowersTogether pins that a question with an
+// AfterQuestionID in its round is played straight after the question it
+// follows, chains included, in every game's order.
+func TestPlayOrder_KeepsFollowersTogether(t *testing.T) {
+	t.Parallel()
+
+	// One round of eight: 3 follows 2, 4 follows 3, and 8 follows 6.
+	after := map[int64]int64{3: 2, 4: 3, 8: 6}
+	questions := make([]*quiz.Question, 8)
+	for i := range questions {
+		id := int64(i + 1)
+		questions[i] = &quiz.Question{ID: id, RoundID: 1}
+		if prev, ok := after[id]; ok {
+			questions[i].AfterQuestionID = &prev
+		}
+	}
+
+	for _, gameID := range []string{"game-a", "game-b", "game-c", "game-d", "game-e"} {
+		got := questionIDs(ExportPlayOrder(gameID, questions))
+		for _, block := range [][]int64{{2, 3, 4}, {6, 8}} {
+			start := slices.Index(got, block[0])
+			if start < 0 || start+len(block) > len(got) || !slices.Equal(got[start:start+len(block)], block) {
+				t.Errorf("%s: order %v does not play %v back to back", gameID, got, block)
+			}
+		}
+	}
+}
+
+// TestService_ShuffledQuizPlaysGameOrder pins that a quiz with
+// ShuffleQuestions serves its questions in the game's play order, and that a
+// quiz with KeepOptionOrder flags the questions it issues.
+func TestService_ShuffledQuizPlaysGameOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := fixture.Quiz().WithTitle("Shuffled").WithQuestions(6).Shuffled().Build()
+	testQuiz.KeepOptionOrder = true
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	stored, err := quizStore.GetQuiz(ctx, testQuiz.ID)
+	if err != nil {
+		t.Fatalf("GetQuiz err = %v, want nil", err)
+	}
+	if !stored.ShuffleQuestions || !stored.KeepOptionOrder {
+		t.Fatalf("stored flags = (%t, %t), want both set", stored.ShuffleQuestions, stored.KeepOptionOrder)
+	}
+
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+
+	var served []int64
+	for range stored.Questions {
+		gq, qErr := svc.GetNextQuestion(ctx, g.ID, 1)
+		if qErr != nil {
+			t.Fatalf("GetNextQuestion err = %v, want nil", qErr)
+		}
+		if !gq.KeepOptionOrder {
+			t.Errorf("question %d: KeepOptionOrder = false, want true", gq.QuizQuestion.ID)
+		}
+		served = append(served, gq.QuizQuestion.ID)
+		if _, aErr := svc.SubmitAnswer(
+			ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{},
+		); aErr != nil {
+			t.Fatalf("SubmitAnswer err = %v, want nil", aErr)
+		}
+	}
+
+	if want := questionIDs(ExportPlayOrder(g.ID, stored.Questions)); !slices.Equal(served, want) {
+		t.Errorf("served order = %v, want the game's play order %v", served, want)
+	}
+}
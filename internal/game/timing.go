@@ -0,0 +1,80 @@
+package game
+
+import (
+	"maps"
+	"sync"
+	"time"
+)
+
+// answerClock remembers when each issued question's answer window opened, as
+// an in-process [time.Time] that still carries its monotonic clock reading,
+// so the answer's latency can be measured without comparing two wall-clock
+// reads an NTP step may have moved apart. The persisted StartedAt loses the
+// monotonic reading (and its sub-second part), so the measurement only exists
+// while the serving process is up; after a restart the answer falls back to
+// wall-clock scoring. Safe for concurrent use.
+type answerClock struct {
+	mu      sync.Mutex
+	windows map[int64]answerWindow
+}
+
+// answerWindow is one issued question's window, keyed in [answerClock] by
+// game question id.
+type answerWindow struct {
+	startedAt time.Time
+	expiredAt time.Time
+}
+
+func newAnswerClock() *answerClock {
+	return &answerClock{windows: make(map[int64]answerWindow)}
+}
+
+// open records gq's window as of now. gq must be the in-process Question the
+// service just issued, not a store-loaded one. Windows closed for longer than
+// lateAnswerGrace can no longer take an answer, so they are pruned here.
+func (c *answerClock) open(gq *Question, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	maps.DeleteFunc(c.windows, func(_ int64, w answerWindow) bool {
+		return now.After(w.expiredAt.Add(lateAnswerGrace))
+	})
+	c.windows[gq.ID] = answerWindow{startedAt: gq.StartedAt, expiredAt: gq.ExpiredAt}
+}
+
+// elapsedMs measures, in milliseconds, from the window of the game question
+// gameQuestionID opening to answeredAt, which must carry a monotonic reading.
+// Reports false when this process did not issue the question.
+func (c *answerClock) elapsedMs(gameQuestionID int64, answeredAt time.Time) (int64, bool) {
+	c.mu.Lock()
+	w, ok := c.windows[gameQuestionID]
+	c.mu.Unlock()
+	if !ok {
+		return 0, false
+	}
+
+	return answeredAt.Sub(w.startedAt).Milliseconds(), true
+}
+
+// measureElapsed stamps a.ElapsedMs for an answer received at now. The
+// tappedAt latency refund is the wall-clock gap between now and the clamped
+// a.AnsweredAt; it is bounded by maxLatencyRefund, so it is taken off the
+// monotonic receipt time as-is.
+func (s *Service) measureElapsed(a *Answer, now time.Time) {
+	answeredAt := now.Add(-now.Sub(a.AnsweredAt))
+	if ms, ok := s.clock.elapsedMs(a.Question.ID, answeredAt); ok {
+		a.ElapsedMs = &ms
+	}
+}
+
+// answerLatency is a's time from its question's window opening: the
+// monotonic ElapsedMs when measured, else the wall-clock AnsweredAt gap,
+// less any time the player's pause held the clock (a.PausedMs).
+func answerLatency(a *Answer) time.Duration {
+	paused := time.Duration(a.PausedMs) * time.Millisecond
+	if a.ElapsedMs != nil {
+		return time.Duration(*a.ElapsedMs)*time.Millisecond - paused
+	}
+
+	return a.AnsweredAt.Sub(a.Question.StartedAt) - paused
+}
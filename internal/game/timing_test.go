@@ -0,0 +1,124 @@
+package game_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// TestCalculateScore_PrefersElapsedMs pins that a measured ElapsedMs, not
+// the wall-clock AnsweredAt, places the answer in the window, so a clock
+// step between serving and answering cannot skew the score.
+func TestCalculateScore_PrefersElapsedMs(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now()
+	expiredAt := startedAt.Add(10 * time.Second)
+	halfway := int64(5000)
+
+	tests := []struct {
+		name       string
+		answeredAt time.Time
+		elapsedMs  *int64
+		want       int
+	}{
+		{
+			name:       "elapsed wins over a wall clock stepped back",
+			answeredAt: startedAt.Add(-time.Hour),
+			elapsedMs:  &halfway,
+			want:       500,
+		},
+		{
+			name:       "elapsed wins over a wall clock stepped forward",
+			answeredAt: startedAt.Add(time.Hour),
+			elapsedMs:  &halfway,
+			want:       500,
+		},
+		{
+			name:       "no elapsed falls back to the wall clock",
+			answeredAt: startedAt.Add(2 * time.Second),
+			want:       800,
+		},
+	}
+	svc := NewService(nil, nil, slog.New(slog.DiscardHandler))
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			a := &Answer{
+				Question:   &Question{StartedAt: startedAt, ExpiredAt: expiredAt},
+				Option:     &quiz.Option{Correct: true},
+				AnsweredAt: tc.answeredAt,
+				ElapsedMs:  tc.elapsedMs,
+			}
+			if got, want := svc.CalculateScore(t.Context(), a), tc.want; got != want {
+				t.Errorf("CalculateScore() = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+// TestService_SubmitAnswerRecordsElapsedMs pins that an answer to a question
+// this process issued is stored with its measured latency, and one to a
+// question issued before a restart is stored without it.
+func TestService_SubmitAnswerRecordsElapsedMs(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := newTestQuiz(t)
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	a, err := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	if a.ElapsedMs == nil || *a.ElapsedMs < 0 || *a.ElapsedMs > time.Second.Milliseconds() {
+		t.Errorf("ElapsedMs = %v, want a measured sub-second latency", a.ElapsedMs)
+	}
+
+	// A fresh service stands in for a restarted process: it did not issue
+	// the next question, so it has nothing to measure against.
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("second GetNextQuestion err = %v, want nil", err)
+	}
+	restarted := NewService(gameStore, quizStore, slog.Default())
+	a, err = restarted.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("restarted SubmitAnswer err = %v, want nil", err)
+	}
+	if a.ElapsedMs != nil {
+		t.Errorf("restarted ElapsedMs = %d, want nil", *a.ElapsedMs)
+	}
+
+	stored, err := gameStore.GetGame(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("GetGame err = %v, want nil", err)
+	}
+	if got := stored.Questions[0].Answers[0].ElapsedMs; got == nil {
+		t.Error("stored ElapsedMs = nil, want the measured latency")
+	}
+	if got := stored.Questions[1].Answers[0].ElapsedMs; got != nil {
+		t.Errorf("stored restarted ElapsedMs = %d, want nil", *got)
+	}
+}
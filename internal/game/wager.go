@@ -0,0 +1,97 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/starquake/topbanana/internal/tracing"
+)
+
+// The confidence stake a player may place on a question of a quiz with
+// [quiz.Quiz.ConfidenceWager] on. A player who answers without placing one is
+// scored at MinWager.
+const (
+	MinWager = 1
+	MaxWager = 3
+)
+
+// wagerStakePoints is what one unit of stake costs on a wrong answer. A
+// correct answer earns its points times the stake, so at a quarter of
+// maxPoints a full-confidence miss (750) still costs less than a fast
+// full-confidence hit earns.
+const wagerStakePoints = maxPoints / 4
+
+// applyWager scales points by a's confidence stake: a correct answer earns
+// points times the stake, and a wrong one loses wagerStakePoints per unit
+// staked. An answer without a stake keeps its points. A correct answer too
+// late to earn anything stays at zero rather than being penalised.
+func applyWager(points int, a *Answer) int {
+	if a.Wager == nil {
+		return points
+	}
+	if a.IsCorrect() {
+		return points * *a.Wager
+	}
+
+	return -*a.Wager * wagerStakePoints
+}
+
+// PlaceWager locks in the player's confidence stake on an issued question,
+// before they answer it. The stake must be [MinWager]..[MaxWager] (else
+// [ErrInvalidWager]) and the quiz must use the wager (else
+// [ErrWagerNotOffered]). The first stake is final: a second is
+// [ErrWagerAlreadyPlaced], a question already answered is
+// [ErrAnswerAlreadyRecorded], and one past its answer window is
+// [ErrAnswerWindowClosed]. Non-participants get [ErrGameNotFound], the same
+// gate as [Service.SubmitAnswer].
+func (s *Service) PlaceWager(ctx context.Context, gameID string, playerID, questionID int64, wager int) error {
+	ctx, span := tracing.Start(ctx, "game.PlaceWager", tracing.String("game.id", gameID))
+	defer span.End()
+
+	if wager < MinWager || wager > MaxWager {
+		return fmt.Errorf("wager %d: %w", wager, ErrInvalidWager)
+	}
+
+	g, err := s.store.GetGame(ctx, gameID)
+	if err != nil {
+		return fmt.Errorf(errGetGameFmt, err)
+	}
+	if !hasParticipant(g, playerID) {
+		return ErrGameNotFound
+	}
+	if g.IsFinished() {
+		return ErrGameFinished
+	}
+
+	qz, err := s.quizStore.GetQuizMeta(ctx, g.QuizID)
+	if err != nil {
+		return fmt.Errorf("failed to get quiz: %w", err)
+	}
+	if !qz.ConfidenceWager {
+		return ErrWagerNotOffered
+	}
+
+	idx := slices.IndexFunc(g.Questions, func(gq *Question) bool { return gq.QuestionID == questionID })
+	if idx < 0 {
+		return fmt.Errorf("question %d not found in game %s: %w", questionID, gameID, ErrQuestionNotInGame)
+	}
+	question := g.Questions[idx]
+	if slices.ContainsFunc(question.Answers, func(a *Answer) bool { return a.PlayerID == playerID }) {
+		return ErrAnswerAlreadyRecorded
+	}
+	if s.now().After(question.ExpiredAt.Add(lateAnswerGrace)) {
+		return ErrAnswerWindowClosed
+	}
+
+	if err = s.store.PlaceWager(ctx, gameID, questionID, wager); err != nil {
+		if errors.Is(err, ErrWagerAlreadyPlaced) {
+			return ErrWagerAlreadyPlaced
+		}
+
+		return fmt.Errorf("failed to place wager: %w", err)
+	}
+
+	return nil
+}
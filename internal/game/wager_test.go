@@ -0,0 +1,224 @@
+package game_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+// TestCalculateScore_Wager pins how a confidence stake scales a score: a
+// correct answer earns its points times the stake, a wrong one loses a
+// quarter of maxPoints per unit staked, a correct answer too late to earn
+// anything is not penalised, and no stake leaves the score alone.
+func TestCalculateScore_Wager(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 8, 14, 12, 0, 0, 0, time.UTC)
+	expiredAt := startedAt.Add(10 * time.Second)
+	wager := func(n int) *int { return &n }
+
+	tests := []struct {
+		name       string
+		correct    bool
+		answeredAt time.Time
+		wager      *int
+		want       int
+	}{
+		{name: "no stake, correct", correct: true, answeredAt: startedAt, want: 1000},
+		{name: "no stake, wrong", answeredAt: startedAt, want: 0},
+		{name: "stake 1, correct", correct: true, answeredAt: startedAt, wager: wager(1), want: 1000},
+		{name: "stake 3, correct halfway", correct: true, answeredAt: startedAt.Add(5 * time.Second), wager: wager(3), want: 1500},
+		{name: "stake 1, wrong", answeredAt: startedAt, wager: wager(1), want: -250},
+		{name: "stake 3, wrong", answeredAt: startedAt, wager: wager(3), want: -750},
+		{name: "stake 2, correct but late", correct: true, answeredAt: expiredAt.Add(time.Second), wager: wager(2), want: 0},
+	}
+	svc := NewService(stubStore{}, nil, slog.New(slog.DiscardHandler))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &Answer{
+				Question:   &Question{StartedAt: startedAt, ExpiredAt: expiredAt},
+				Option:     &quiz.Option{Correct: tt.correct},
+				AnsweredAt: tt.answeredAt,
+				Wager:      tt.wager,
+			}
+			if got := svc.CalculateScore(t.Context(), a); got != tt.want {
+				t.Errorf("CalculateScore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestService_PlaceWager pins the wager flow end to end: a stake out of range
+// is refused, the first stake on a question is final, an answer is scored
+// with the stake it was placed under (or 1 when none was placed), and the
+// game results and the quiz leaderboard agree on the wagered total.
+func TestService_PlaceWager(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Confidence",
+		Slug:              "confidence",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		ConfidenceWager:   true,
+		Questions: []*quiz.Question{
+			{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+			{Text: "3 + 3?", Position: 20, Options: []*quiz.Option{{Text: "6", Correct: true}, {Text: "7"}}},
+			{Text: "4 + 4?", Position: 30, Options: []*quiz.Option{{Text: "8", Correct: true}, {Text: "9"}}},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+
+	// Question 1: stake 3 on the right answer.
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	if !gq.ConfidenceWager {
+		t.Error("ConfidenceWager = false, want true on a wagering quiz's question")
+	}
+	if err = svc.PlaceWager(ctx, g.ID, 1, gq.QuizQuestion.ID, MaxWager+1); !errors.Is(err, ErrInvalidWager) {
+		t.Errorf("PlaceWager(%d) err = %v, want %v", MaxWager+1, err, ErrInvalidWager)
+	}
+	if err = svc.PlaceWager(ctx, g.ID, 2, gq.QuizQuestion.ID, 2); !errors.Is(err, ErrGameNotFound) {
+		t.Errorf("PlaceWager by a non-participant err = %v, want %v", err, ErrGameNotFound)
+	}
+	if err = svc.PlaceWager(ctx, g.ID, 1, gq.QuizQuestion.ID, 3); err != nil {
+		t.Fatalf("PlaceWager err = %v, want nil", err)
+	}
+	if err = svc.PlaceWager(ctx, g.ID, 1, gq.QuizQuestion.ID, 1); !errors.Is(err, ErrWagerAlreadyPlaced) {
+		t.Errorf("second PlaceWager err = %v, want %v", err, ErrWagerAlreadyPlaced)
+	}
+	a, err := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[0].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	if a.Wager == nil || *a.Wager != 3 {
+		t.Fatalf("answer Wager = %v, want 3", a.Wager)
+	}
+	first := svc.CalculateScore(ctx, a)
+	if first <= 2*1000 {
+		t.Errorf("CalculateScore() = %d, want above 2000 for a fast tripled answer", first)
+	}
+	if err = svc.PlaceWager(ctx, g.ID, 1, gq.QuizQuestion.ID, 1); !errors.Is(err, ErrAnswerAlreadyRecorded) {
+		t.Errorf("PlaceWager after answering err = %v, want %v", err, ErrAnswerAlreadyRecorded)
+	}
+
+	// Question 2: stake 2 on the wrong answer.
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("second GetNextQuestion err = %v, want nil", err)
+	}
+	if err = svc.PlaceWager(ctx, g.ID, 1, gq.QuizQuestion.ID, 2); err != nil {
+		t.Fatalf("second PlaceWager err = %v, want nil", err)
+	}
+	a, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[1].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("second SubmitAnswer err = %v, want nil", err)
+	}
+	if got, want := svc.CalculateScore(ctx, a), -500; got != want {
+		t.Errorf("wrong answer at stake 2 scored %d, want %d", got, want)
+	}
+
+	// Question 3: no stake placed, wrong answer, scored at the minimum.
+	gq, err = svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("third GetNextQuestion err = %v, want nil", err)
+	}
+	a, err = svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[1].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("third SubmitAnswer err = %v, want nil", err)
+	}
+	if a.Wager == nil || *a.Wager != MinWager {
+		t.Fatalf("unwagered answer Wager = %v, want %d", a.Wager, MinWager)
+	}
+
+	want := first - 500 - 250
+	results, err := svc.GetResults(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetResults err = %v, want nil", err)
+	}
+	if got := results.PlayerScores[1]; got != want {
+		t.Errorf("results score = %d, want %d", got, want)
+	}
+	board, err := svc.GetQuizLeaderboard(ctx, testQuiz.ID, 1, 10)
+	if err != nil {
+		t.Fatalf("GetQuizLeaderboard err = %v, want nil", err)
+	}
+	if len(board.Entries) != 1 {
+		t.Fatalf("leaderboard entries = %d, want 1", len(board.Entries))
+	}
+	if got := board.Entries[0].Score; got != want {
+		t.Errorf("leaderboard score = %d, want %d", got, want)
+	}
+}
+
+// TestService_PlaceWager_NotOffered pins that a quiz without the wager
+// refuses a stake and scores its answers as before.
+func TestService_PlaceWager_NotOffered(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := store.NewQuizStore(db, slog.Default())
+	gameStore := store.NewGameStore(db, slog.Default())
+
+	testQuiz := &quiz.Quiz{
+		Title:             "Plain",
+		Slug:              "plain",
+		CreatedByPlayerID: seededAdminID,
+		Published:         true,
+		Questions: []*quiz.Question{
+			{Text: "2 + 2?", Position: 10, Options: []*quiz.Option{{Text: "4", Correct: true}, {Text: "5"}}},
+		},
+	}
+	if err := quizStore.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	svc := NewService(gameStore, quizStore, slog.Default())
+	svc.SetRevealDelay(0)
+
+	g, err := svc.CreateGame(ctx, testQuiz.ID, 1, false)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	gq, err := svc.GetNextQuestion(ctx, g.ID, 1)
+	if err != nil {
+		t.Fatalf("GetNextQuestion err = %v, want nil", err)
+	}
+	if err = svc.PlaceWager(ctx, g.ID, 1, gq.QuizQuestion.ID, 2); !errors.Is(err, ErrWagerNotOffered) {
+		t.Errorf("PlaceWager err = %v, want %v", err, ErrWagerNotOffered)
+	}
+	a, err := svc.SubmitAnswer(ctx, g.ID, 1, gq.QuizQuestion.ID, gq.QuizQuestion.Options[1].ID, time.Time{})
+	if err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	if a.Wager != nil {
+		t.Errorf("answer Wager = %d, want nil", *a.Wager)
+	}
+	if got, want := svc.CalculateScore(ctx, a), 0; got != want {
+		t.Errorf("CalculateScore() = %d, want %d", got, want)
+	}
+}
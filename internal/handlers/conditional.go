@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// etagHexChars is the prefix length of the SHA-256 of a response body used
+// as its ETag, the same length the static assets use.
+const etagHexChars = 16
+
+// WithCacheControl wraps next so its responses carry cacheControl as their
+// Cache-Control header, the route's default policy. A handler that sets its
+// own replaces it.
+func WithCacheControl(next http.Handler, cacheControl string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", cacheControl)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// WithETag wraps a GET handler whose body only changes when the data behind
+// it does (a list a client polls) so an unchanged response costs a 304
+// instead of the body. The 200 response is buffered and tagged with a hash
+// of its bytes; a request whose If-None-Match carries that tag gets a 304
+// with no body. Because the tag is the content, two shapes of the same
+// data (see [WithAPIShapes]) never share one, and the response varies on
+// [EnvelopeHeader] so a cache keeps them apart. cacheControl is set as in
+// [WithCacheControl]; "private, no-cache" makes a browser revalidate on
+// every poll. Any other status, and a non-GET, is passed through unbuffered
+// and untagged. Not for streams: the whole body is held until the handler
+// returns.
+func WithETag(next http.Handler, cacheControl string) http.Handler {
+	return WithCacheControl(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+		w.Header().Add("Vary", EnvelopeHeader)
+
+		rec := &etagRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		if rec.passthrough {
+			return
+		}
+
+		sum := sha256.Sum256(rec.body.Bytes())
+		tag := strconv.Quote(hex.EncodeToString(sum[:])[:etagHexChars])
+		w.Header().Set("ETag", tag)
+		if etagMatches(r.Header.Get("If-None-Match"), tag) {
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(rec.body.Bytes())
+	}), cacheControl)
+}
+
+// etagMatches reports whether an If-None-Match header value names tag, using
+// the weak comparison RFC 9110 prescribes for it: a W/ prefix is ignored and
+// "*" matches any current representation.
+func etagMatches(ifNoneMatch, tag string) bool {
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// etagRecorder holds a 200 response's body back for [WithETag] to hash. Any
+// other status switches it to passthrough: the status and everything written
+// after go straight to the client.
+type etagRecorder struct {
+	http.ResponseWriter
+
+	wroteHeader bool
+	passthrough bool
+	body        bytes.Buffer
+}
+
+func (rec *etagRecorder) WriteHeader(status int) {
+	if rec.wroteHeader {
+		return
+	}
+	rec.wroteHeader = true
+	if status != http.StatusOK {
+		rec.passthrough = true
+		rec.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (rec *etagRecorder) Write(b []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	if rec.passthrough {
+		return rec.ResponseWriter.Write(b)
+	}
+
+	return rec.body.Write(b)
+}
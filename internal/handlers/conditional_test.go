@@ -0,0 +1,106 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/handlers"
+)
+
+// TestWithETag pins the conditional GET: a 200 is tagged with a content hash
+// and the route's Cache-Control, a matching If-None-Match (strong, weak, in a
+// list, or "*") gets a bodiless 304, a changed body gets a new tag, and an
+// error response passes through untagged.
+func TestWithETag(t *testing.T) {
+	t.Parallel()
+
+	body := `["a"]`
+	h := WithETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("fail") {
+			http.Error(w, "boom", http.StatusInternalServerError)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}), "private, no-cache")
+	serve := func(target, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		return w
+	}
+
+	first := serve("/api/x", "")
+	tag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || first.Body.String() != body {
+		t.Fatalf("first GET = %d %q, want 200 %q", first.Code, first.Body.String(), body)
+	}
+	if tag == "" {
+		t.Fatal("first GET has no ETag")
+	}
+	if got, want := first.Header().Get("Cache-Control"), "private, no-cache"; got != want {
+		t.Errorf("Cache-Control = %q, want %q", got, want)
+	}
+	if got, want := first.Header().Get("Vary"), EnvelopeHeader; got != want {
+		t.Errorf("Vary = %q, want %q", got, want)
+	}
+
+	for _, ifNoneMatch := range []string{tag, "W/" + tag, `"stale", ` + tag, "*"} {
+		w := serve("/api/x", ifNoneMatch)
+		if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+			t.Errorf("If-None-Match %s = %d with %d body bytes, want a bodiless 304", ifNoneMatch, w.Code, w.Body.Len())
+		}
+		if got := w.Header().Get("ETag"); got != tag {
+			t.Errorf("If-None-Match %s ETag = %q, want %q", ifNoneMatch, got, tag)
+		}
+	}
+
+	body = `["a","b"]`
+	changed := serve("/api/x", tag)
+	if changed.Code != http.StatusOK || changed.Body.String() != body {
+		t.Errorf("GET after a change = %d %q, want 200 %q", changed.Code, changed.Body.String(), body)
+	}
+	if got := changed.Header().Get("ETag"); got == tag || got == "" {
+		t.Errorf("ETag after a change = %q, want a new tag", got)
+	}
+
+	failed := serve("/api/x?fail", tag)
+	if failed.Code != http.StatusInternalServerError {
+		t.Errorf("failing GET status = %d, want %d", failed.Code, http.StatusInternalServerError)
+	}
+	if got := failed.Header().Get("ETag"); got != "" {
+		t.Errorf("failing GET ETag = %q, want none", got)
+	}
+}
+
+func TestWithCacheControl(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    string
+	}{
+		{"route default", func(http.ResponseWriter, *http.Request) {}, "no-store"},
+		{"handler override", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Cache-Control", "public, max-age=60")
+		}, "public, max-age=60"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			WithCacheControl(tt.handler, "no-store").ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/x", nil))
+			if got := w.Header().Get("Cache-Control"); got != tt.want {
+				t.Errorf("Cache-Control = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
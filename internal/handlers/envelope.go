@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EnvelopeHeader is the request header a client sets (to any
+// [strconv.ParseBool] true value) to receive the enveloped response shape
+// while the server still defaults to the legacy shapes for clients that have
+// not migrated.
+const EnvelopeHeader = "X-Api-Envelope"
+
+// Envelope is the response shape of every /api JSON endpoint: the payload
+// under data, optional response metadata (counts and the like) under meta, and
+// on failure an error object instead of data.
+type Envelope struct {
+	Data  any        `json:"data,omitempty"`
+	Meta  any        `json:"meta,omitempty"`
+	Error *ErrorBody `json:"error,omitempty"`
+}
+
+// ErrorBody is the error half of an [Envelope]. Code is a stable camelCase
+// identifier a client can branch on; Message is short human-readable text,
+// free of internals.
+type ErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// envelopeCtxKey is the unexported context-key type marking a request that
+// gets the enveloped shape.
+type envelopeCtxKey struct{}
+
+// WithAPIShapes wraps an /api handler so its responses use the [Envelope]
+// shape. When legacy is true (the migration compatibility flag) a request
+// keeps the old shapes - bare JSON values and plain-text errors - unless it
+// opts in with [EnvelopeHeader].
+func WithAPIShapes(next http.Handler, legacy bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		optIn, _ := strconv.ParseBool(r.Header.Get(EnvelopeHeader))
+		if !legacy || optIn {
+			r = r.WithContext(context.WithValue(r.Context(), envelopeCtxKey{}, true))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Enveloped reports whether the response to r uses the [Envelope] shape. A
+// request that never passed through [WithAPIShapes] (the HTML surfaces, unit
+// tests calling a handler directly) gets the legacy shape.
+func Enveloped(r *http.Request) bool {
+	on, _ := r.Context().Value(envelopeCtxKey{}).(bool)
+
+	return on
+}
+
+// WriteData writes v as a successful response: wrapped as the data of an
+// [Envelope], or bare for a legacy-shape request.
+func WriteData[T any](w http.ResponseWriter, r *http.Request, statusCode int, v T) error {
+	if !Enveloped(r) {
+		return EncodeJSON(w, statusCode, v)
+	}
+
+	return EncodeJSON(w, statusCode, Envelope{Data: v})
+}
+
+// WriteDataMeta is [WriteData] with response metadata. The legacy shape has
+// nowhere to carry meta, so a legacy-shape request gets v alone.
+func WriteDataMeta[T any](w http.ResponseWriter, r *http.Request, statusCode int, v T, meta any) error {
+	if !Enveloped(r) {
+		return EncodeJSON(w, statusCode, v)
+	}
+
+	return EncodeJSON(w, statusCode, Envelope{Data: v, Meta: meta})
+}
+
+// WriteError writes an error response whose code is derived from the status
+// (404 is "notFound", 500 is "internalServerError"). A legacy-shape request
+// gets message as plain text, as [http.Error] writes it.
+func WriteError(w http.ResponseWriter, r *http.Request, statusCode int, message string) {
+	WriteErrorCode(w, r, statusCode, StatusCode(statusCode), message)
+}
+
+// WriteErrorCode is [WriteError] with an explicit code, for an endpoint whose
+// clients branch on finer distinctions than the status.
+func WriteErrorCode(w http.ResponseWriter, r *http.Request, statusCode int, code, message string) {
+	if !Enveloped(r) {
+		http.Error(w, message, statusCode)
+
+		return
+	}
+
+	// Encoding two strings cannot fail; a write error means the client is
+	// gone and there is nobody left to tell.
+	_ = EncodeJSON(w, statusCode, Envelope{Error: &ErrorBody{Code: code, Message: message}})
+}
+
+// NotFound writes a 404. The legacy shape matches [http.NotFound] byte for
+// byte so migrating a handler does not change what old clients see.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	if !Enveloped(r) {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	WriteError(w, r, http.StatusNotFound, "not found")
+}
+
+// StatusCode returns the camelCase error code for an HTTP status, built from
+// its [http.StatusText]: "Too Many Requests" becomes "tooManyRequests".
+func StatusCode(statusCode int) string {
+	words := strings.FieldsFunc(http.StatusText(statusCode), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+	if len(words) == 0 {
+		return "error"
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.ToLower(words[0]))
+	for _, word := range words[1:] {
+		b.WriteString(strings.ToUpper(word[:1]) + strings.ToLower(word[1:]))
+	}
+
+	return b.String()
+}
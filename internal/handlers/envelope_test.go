@@ -0,0 +1,165 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/handlers"
+)
+
+// serveShaped runs h behind WithAPIShapes and returns the recorded response.
+func serveShaped(t *testing.T, legacy bool, optIn string, h http.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, "/api/x", nil)
+	if optIn != "" {
+		r.Header.Set(EnvelopeHeader, optIn)
+	}
+	w := httptest.NewRecorder()
+	WithAPIShapes(h, legacy).ServeHTTP(w, r)
+
+	return w
+}
+
+func TestWithAPIShapes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		legacy bool
+		optIn  string
+		want   bool
+	}{
+		{"envelope by default once legacy is off", false, "", true},
+		{"legacy flag keeps the old shape", true, "", false},
+		{"header opts in under the legacy flag", true, "1", true},
+		{"false header does not opt in", true, "false", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got bool
+			serveShaped(t, tt.legacy, tt.optIn, func(_ http.ResponseWriter, r *http.Request) {
+				got = Enveloped(r)
+			})
+			if got != tt.want {
+				t.Errorf("Enveloped = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unwrapped request is legacy", func(t *testing.T) {
+		t.Parallel()
+
+		if Enveloped(httptest.NewRequest(http.MethodGet, "/", nil)) {
+			t.Error("Enveloped = true for a request outside WithAPIShapes, want false")
+		}
+	})
+}
+
+func TestWriteData(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		Name string `json:"name"`
+	}
+
+	t.Run("envelope carries data and meta", func(t *testing.T) {
+		t.Parallel()
+
+		w := serveShaped(t, false, "", func(w http.ResponseWriter, r *http.Request) {
+			if err := WriteDataMeta(w, r, http.StatusOK, []item{{Name: "a"}}, map[string]int{"count": 1}); err != nil {
+				t.Errorf("WriteDataMeta err = %v, want nil", err)
+			}
+		})
+		if got, want := strings.TrimSpace(w.Body.String()), `{"data":[{"name":"a"}],"meta":{"count":1}}`; got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("legacy writes the bare value", func(t *testing.T) {
+		t.Parallel()
+
+		w := serveShaped(t, true, "", func(w http.ResponseWriter, r *http.Request) {
+			if err := WriteDataMeta(w, r, http.StatusCreated, item{Name: "a"}, map[string]int{"count": 1}); err != nil {
+				t.Errorf("WriteDataMeta err = %v, want nil", err)
+			}
+		})
+		if got, want := w.Code, http.StatusCreated; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		if got, want := strings.TrimSpace(w.Body.String()), `{"name":"a"}`; got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+	})
+}
+
+func TestWriteError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("envelope carries code and message", func(t *testing.T) {
+		t.Parallel()
+
+		w := serveShaped(t, false, "", func(w http.ResponseWriter, r *http.Request) {
+			WriteError(w, r, http.StatusConflict, "already answered")
+		})
+		if got, want := w.Code, http.StatusConflict; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		var env Envelope
+		if err := json.NewDecoder(w.Body).Decode(&env); err != nil {
+			t.Fatalf("decode err = %v, want nil", err)
+		}
+		if env.Error == nil {
+			t.Fatal("envelope error = nil, want set")
+		}
+		if got, want := *env.Error, (ErrorBody{Code: "conflict", Message: "already answered"}); got != want {
+			t.Errorf("error = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("legacy writes plain text", func(t *testing.T) {
+		t.Parallel()
+
+		w := serveShaped(t, true, "", func(w http.ResponseWriter, r *http.Request) {
+			WriteError(w, r, http.StatusConflict, "already answered")
+		})
+		if got, want := w.Body.String(), "already answered\n"; got != want {
+			t.Errorf("body = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("legacy NotFound matches http.NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		w := serveShaped(t, true, "", NotFound)
+		want := httptest.NewRecorder()
+		http.NotFound(want, httptest.NewRequest(http.MethodGet, "/", nil))
+		if got, want := w.Body.String(), want.Body.String(); got != want {
+			t.Errorf("body = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestStatusCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status int
+		want   string
+	}{
+		{http.StatusBadRequest, "badRequest"},
+		{http.StatusNotFound, "notFound"},
+		{http.StatusTooManyRequests, "tooManyRequests"},
+		{http.StatusInternalServerError, "internalServerError"},
+		{599, "error"},
+	}
+	for _, tt := range tests {
+		if got := StatusCode(tt.status); got != tt.want {
+			t.Errorf("StatusCode(%d) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+}
@@ -72,7 +72,7 @@ func ParseIDFromSlugPath(w http.ResponseWriter, r *http.Request, logger *slog.Lo
 	if err != nil {
 		msg := "error parsing " + s
 		logger.ErrorContext(r.Context(), msg, slog.Any("err", err))
-		http.Error(w, msg, http.StatusBadRequest)
+		WriteError(w, r, http.StatusBadRequest, msg)
 
 		return 0, false
 	}
@@ -97,7 +97,7 @@ func ParseIDFromPath(w http.ResponseWriter, r *http.Request, logger *slog.Logger
 	if err != nil || id <= 0 {
 		msg := "error parsing " + s
 		logger.ErrorContext(r.Context(), msg, slog.String("value", pathValue), slog.Any("err", err))
-		http.Error(w, msg, http.StatusBadRequest)
+		WriteError(w, r, http.StatusBadRequest, msg)
 
 		return 0, false
 	}
@@ -0,0 +1,207 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/store"
+)
+
+const (
+	// runnerStaleBeats is how many beat intervals the runner may miss
+	// before the detail report calls it unhealthy.
+	runnerStaleBeats = 3
+	// minRunnerStale keeps a shrunk beat interval (the e2e suite's) from
+	// flagging one slow tick as a stuck runner.
+	minRunnerStale = 10 * time.Second
+	// minDiskFreeRatio is the share of the SQLite file's filesystem that
+	// must be free for the disk to report healthy.
+	minDiskFreeRatio = 0.05
+)
+
+// Heartbeat is a background loop the detail report watches: the
+// live-session runner, which beats once per tick.
+type Heartbeat interface {
+	LastBeat() time.Time
+	BeatInterval() time.Duration
+}
+
+// SubscriberCounter is a pub/sub hub whose open subscriptions the detail
+// report counts.
+type SubscriberCounter interface {
+	Subscribers() int
+}
+
+// DetailSources are the in-process components [HandleHealthzDetail] reports
+// on besides the database. A nil Runner or empty Hubs leaves that part of the
+// report out.
+type DetailSources struct {
+	Runner Heartbeat
+	Hubs   map[string]SubscriberCounter
+}
+
+// detailReport is the /healthz/detail body. Status is rolled up the way
+// /readyz rolls up its checks.
+type detailReport struct {
+	Status     string          `json:"status"`
+	Database   databaseDetail  `json:"database"`
+	Migrations migrationDetail `json:"migrations"`
+	Runner     *runnerDetail   `json:"runner,omitempty"`
+	Hubs       map[string]int  `json:"hubs,omitempty"`
+	Disk       *diskDetail     `json:"disk,omitempty"`
+}
+
+type databaseDetail struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+}
+
+type migrationDetail struct {
+	Status  string `json:"status"`
+	Version int64  `json:"version"`
+}
+
+type runnerDetail struct {
+	Status     string    `json:"status"`
+	LastBeat   time.Time `json:"lastBeat,omitzero"`
+	IntervalMs int64     `json:"intervalMs"`
+}
+
+type diskDetail struct {
+	Status     string `json:"status"`
+	FreeBytes  uint64 `json:"freeBytes"`
+	TotalBytes uint64 `json:"totalBytes"`
+}
+
+// HandleHealthzDetail serves the component-level health report for
+// dashboards and alerting: the database ping latency, the applied migration
+// version, the live-session runner's last heartbeat, the open SSE subscribers
+// per hub, and the free space on the SQLite file's filesystem (left out on
+// Postgres). The database and migrations are required, as on /readyz: either
+// failing answers 503 "unavailable". A stale runner or a nearly full disk
+// reports "degraded" at 200. While draining it still reports, as 503
+// "draining". Unlike the probes it is mounted behind the admin gate, but
+// failure detail is still only logged.
+func HandleHealthzDetail(logger *slog.Logger, stores *store.Stores, drain *Drain, src DetailSources) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		res := detailReport{Status: statusOK}
+		fail := func(optional bool) {
+			if !optional {
+				res.Status = statusUnavailable
+			} else if res.Status == statusOK {
+				res.Status = statusDegraded
+			}
+		}
+
+		res.Database = checkDatabase(ctx, logger, stores, fail)
+		res.Migrations = checkMigrations(ctx, logger, stores, fail)
+		if src.Runner != nil {
+			res.Runner = checkRunner(src.Runner, time.Now(), fail)
+		}
+		if len(src.Hubs) > 0 {
+			res.Hubs = make(map[string]int, len(src.Hubs))
+			for name, hub := range src.Hubs {
+				res.Hubs[name] = hub.Subscribers()
+			}
+		}
+		res.Disk = checkDisk(ctx, logger, stores, fail)
+
+		httpStatus := http.StatusOK
+		if drain.Draining() {
+			res.Status = statusDraining
+		}
+		if res.Status == statusUnavailable || res.Status == statusDraining {
+			httpStatus = http.StatusServiceUnavailable
+		}
+		if err := handlers.EncodeJSON(w, httpStatus, res); err != nil {
+			logger.ErrorContext(ctx, "error encoding health detail response", slog.Any("err", err))
+		}
+	}
+}
+
+// checkDatabase times a ping of the primary pool.
+func checkDatabase(
+	ctx context.Context, logger *slog.Logger, stores *store.Stores, fail func(optional bool),
+) databaseDetail {
+	start := time.Now()
+	err := stores.Quizzes.Ping(ctx)
+	d := databaseDetail{Status: checkHealthy, LatencyMs: float64(time.Since(start).Microseconds()) / 1000}
+	if err != nil {
+		logger.ErrorContext(ctx, "health detail database ping failed", slog.Any("err", err))
+		d.Status = checkUnhealthy
+		fail(false)
+	}
+
+	return d
+}
+
+// checkMigrations reads the applied migration version and whether any
+// embedded migration is still to run.
+func checkMigrations(
+	ctx context.Context, logger *slog.Logger, stores *store.Stores, fail func(optional bool),
+) migrationDetail {
+	version, err := stores.Schema.MigrationVersion(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "health detail migration version failed", slog.Any("err", err))
+		fail(false)
+
+		return migrationDetail{Status: checkUnhealthy}
+	}
+	d := migrationDetail{Status: checkHealthy, Version: version}
+	if pending, pErr := stores.Schema.HasPendingMigrations(ctx); pErr != nil {
+		logger.ErrorContext(ctx, "health detail migration check failed", slog.Any("err", pErr))
+		d.Status = checkUnhealthy
+		fail(false)
+	} else if pending {
+		d.Status = checkPending
+		fail(false)
+	}
+
+	return d
+}
+
+// checkRunner calls the runner unhealthy once it has gone
+// [runnerStaleBeats] intervals (and at least [minRunnerStale]) without a
+// beat, and pending before its first.
+func checkRunner(hb Heartbeat, now time.Time, fail func(optional bool)) *runnerDetail {
+	interval := hb.BeatInterval()
+	d := &runnerDetail{Status: checkHealthy, LastBeat: hb.LastBeat(), IntervalMs: interval.Milliseconds()}
+	switch {
+	case d.LastBeat.IsZero():
+		d.Status = checkPending
+	case now.Sub(d.LastBeat) > max(runnerStaleBeats*interval, minRunnerStale):
+		d.Status = checkUnhealthy
+		fail(true)
+	}
+
+	return d
+}
+
+// checkDisk reports the free space beside the SQLite file, or nil when there
+// is no file (Postgres, in-memory) or the platform cannot say.
+func checkDisk(ctx context.Context, logger *slog.Logger, stores *store.Stores, fail func(optional bool)) *diskDetail {
+	usage, err := stores.Schema.DiskUsage(ctx)
+	switch {
+	case errors.Is(err, database.ErrNoDatabaseFile), errors.Is(err, errors.ErrUnsupported):
+		return nil
+	case err != nil:
+		logger.ErrorContext(ctx, "health detail disk usage failed", slog.Any("err", err))
+		fail(true)
+
+		return &diskDetail{Status: checkUnhealthy}
+	}
+	d := &diskDetail{Status: checkHealthy, FreeBytes: usage.FreeBytes, TotalBytes: usage.TotalBytes}
+	if usage.TotalBytes > 0 && float64(usage.FreeBytes) < minDiskFreeRatio*float64(usage.TotalBytes) {
+		d.Status = checkUnhealthy
+		fail(true)
+	}
+
+	return d
+}
@@ -2,16 +2,81 @@
 package health
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
+	"sync"
 
 	"github.com/starquake/topbanana/internal/handlers"
 	"github.com/starquake/topbanana/internal/store"
 	"github.com/starquake/topbanana/internal/version"
 )
 
-// HandleHealthz returns a handler that serves health check responses.
-func HandleHealthz(logger *slog.Logger, stores *store.Stores) http.HandlerFunc {
+// Drain is the server's shutdown state. Once [Drain.Start] is called the
+// health check answers 503 "draining", so a load balancer stops sending new
+// traffic while in-flight requests finish, and streams wrapped by
+// [Drain.EndStreams] are ended so they do not hold the shutdown open. A nil
+// *Drain never drains.
+type Drain struct {
+	once sync.Once
+	done chan struct{}
+}
+
+// NewDrain returns a Drain that has not started.
+func NewDrain() *Drain {
+	return &Drain{done: make(chan struct{})}
+}
+
+// Start marks the server as draining. Calls after the first are no-ops.
+func (d *Drain) Start() {
+	if d == nil {
+		return
+	}
+	d.once.Do(func() { close(d.done) })
+}
+
+// Draining reports whether [Drain.Start] has been called.
+func (d *Drain) Draining() bool {
+	if d == nil {
+		return false
+	}
+	select {
+	case <-d.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// EndStreams wraps a long-lived streaming handler (an SSE endpoint) so its
+// request context is cancelled when draining starts. The stream's own
+// ctx.Done case then returns, and the client's EventSource reconnects -
+// to another instance once this one is out of rotation. With a nil Drain
+// next is returned unchanged.
+func (d *Drain) EndStreams(next http.Handler) http.Handler {
+	if d == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		go func() {
+			select {
+			case <-d.done:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// HandleHealthz returns a handler that serves health check responses. While
+// drain is draining it answers 503 with status "draining" without touching
+// the database. It predates the split [HandleLivez] and [HandleReadyz] probes
+// and stays for the healthcheck subcommand and existing monitors.
+func HandleHealthz(logger *slog.Logger, stores *store.Stores, drain *Drain) http.HandlerFunc {
 	type healthStatus struct {
 		Status  string            `json:"status"`
 		Checks  map[string]string `json:"checks,omitempty"`
@@ -21,6 +86,14 @@ func HandleHealthz(logger *slog.Logger, stores *store.Stores) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
+		if drain.Draining() {
+			if err := handlers.EncodeJSON(w, http.StatusServiceUnavailable, healthStatus{Status: "draining"}); err != nil {
+				logger.ErrorContext(ctx, "error encoding health response", slog.Any("err", err))
+			}
+
+			return
+		}
+
 		httpStatus := http.StatusOK
 		health := healthStatus{
 			Status: "ok",
@@ -44,6 +117,112 @@ func HandleHealthz(logger *slog.Logger, stores *store.Stores) http.HandlerFunc {
 	}
 }
 
+// Check is one dependency probe in the /readyz report, keyed by Name in its
+// checks. Run returns nil when the dependency is usable. A failed required
+// check takes the instance out of rotation (503); a failed Optional one is
+// reported but leaves it serving, as "degraded".
+type Check struct {
+	Name     string
+	Run      func(ctx context.Context) error
+	Optional bool
+}
+
+// Readiness statuses: the overall status of a /readyz report, and the value
+// of each of its checks.
+const (
+	statusOK          = "ok"
+	statusDegraded    = "degraded"
+	statusUnavailable = "unavailable"
+	statusDraining    = "draining"
+
+	checkHealthy   = "healthy"
+	checkUnhealthy = "unhealthy"
+	checkPending   = "pending"
+)
+
+// readiness is the /livez and /readyz body: the overall status and, for
+// /readyz, each sub-check by name.
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// HandleLivez answers whether the process is up: always 200 "ok", without
+// touching the database, and still 200 while draining. A failing liveness
+// probe means the process needs restarting; a dependency being down is
+// [HandleReadyz]'s concern.
+func HandleLivez(logger *slog.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := handlers.EncodeJSON(w, http.StatusOK, readiness{Status: statusOK}); err != nil {
+			logger.ErrorContext(r.Context(), "error encoding liveness response", slog.Any("err", err))
+		}
+	}
+}
+
+// HandleReadyz answers whether the instance should receive traffic. It pings
+// the database and checks every embedded migration is applied, then runs the
+// extra checks; the body names each check's result. Any required check
+// failing answers 503 "unavailable", only optional ones failing 200
+// "degraded". While drain is draining it answers 503 "draining" without
+// running any check. Failure detail is logged, never returned: the probe is
+// unauthenticated and a driver error can carry the DB path.
+func HandleReadyz(logger *slog.Logger, stores *store.Stores, drain *Drain, checks ...Check) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if drain.Draining() {
+			if err := handlers.EncodeJSON(w, http.StatusServiceUnavailable, readiness{Status: statusDraining}); err != nil {
+				logger.ErrorContext(ctx, "error encoding readiness response", slog.Any("err", err))
+			}
+
+			return
+		}
+
+		res := readiness{Status: statusOK, Checks: make(map[string]string, len(checks)+2)}
+		fail := func(optional bool) {
+			if !optional {
+				res.Status = statusUnavailable
+			} else if res.Status == statusOK {
+				res.Status = statusDegraded
+			}
+		}
+
+		res.Checks["database"] = checkHealthy
+		if err := stores.Quizzes.Ping(ctx); err != nil {
+			logger.ErrorContext(ctx, "readiness database ping failed", slog.Any("err", err))
+			res.Checks["database"] = checkUnhealthy
+			fail(false)
+		}
+
+		res.Checks["migrations"] = checkHealthy
+		if pending, err := stores.Schema.HasPendingMigrations(ctx); err != nil {
+			logger.ErrorContext(ctx, "readiness migration check failed", slog.Any("err", err))
+			res.Checks["migrations"] = checkUnhealthy
+			fail(false)
+		} else if pending {
+			res.Checks["migrations"] = checkPending
+			fail(false)
+		}
+
+		for _, c := range checks {
+			res.Checks[c.Name] = checkHealthy
+			if err := c.Run(ctx); err != nil {
+				logger.ErrorContext(ctx, "readiness check failed", slog.String("check", c.Name), slog.Any("err", err))
+				res.Checks[c.Name] = checkUnhealthy
+				fail(c.Optional)
+			}
+		}
+
+		httpStatus := http.StatusOK
+		if res.Status == statusUnavailable {
+			httpStatus = http.StatusServiceUnavailable
+		}
+		if err := handlers.EncodeJSON(w, httpStatus, res); err != nil {
+			logger.ErrorContext(ctx, "error encoding readiness response", slog.Any("err", err))
+		}
+	}
+}
+
 // HandleVersion serves the build stamp as JSON for uptime checks and
 // humans. Unauthenticated and side-effect free: it exposes only the
 // environment plus the version/commit/date already shown in the admin
@@ -1,13 +1,16 @@
 package health_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"maps"
 	"net/http"
 	"net/http/httptest"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/starquake/topbanana/internal/dbtest"
 	. "github.com/starquake/topbanana/internal/health"
@@ -21,13 +24,15 @@ type healthzResponse struct {
 }
 
 // serveHealthz drives the real HandleHealthz handler against the given stores
-// and decodes the response body.
-func serveHealthz(t *testing.T, stores *store.Stores) (*httptest.ResponseRecorder, healthzResponse) {
+// and drain, and decodes the response body.
+func serveHealthz(
+	t *testing.T, stores *store.Stores, drain *Drain,
+) (*httptest.ResponseRecorder, healthzResponse) {
 	t.Helper()
 
 	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/healthz", nil)
 	w := httptest.NewRecorder()
-	HandleHealthz(slog.New(slog.DiscardHandler), stores)(w, req)
+	HandleHealthz(slog.New(slog.DiscardHandler), stores, drain)(w, req)
 
 	var res healthzResponse
 	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
@@ -64,7 +69,7 @@ func TestHandleHealthz_OKWhenDatabaseHealthy(t *testing.T) {
 
 	stores := store.New(dbtest.Open(t), slog.New(slog.DiscardHandler))
 
-	w, res := serveHealthz(t, stores)
+	w, res := serveHealthz(t, stores, nil)
 
 	if got, want := w.Code, http.StatusOK; got != want {
 		t.Errorf("status = %d, want %d", got, want)
@@ -88,7 +93,7 @@ func TestHandleHealthz_DegradedWhenDatabasePingFails(t *testing.T) {
 		t.Fatalf("db.Close err = %v, want nil", err)
 	}
 
-	w, res := serveHealthz(t, stores)
+	w, res := serveHealthz(t, stores, nil)
 
 	if got, want := w.Code, http.StatusServiceUnavailable; got != want {
 		t.Errorf("status = %d, want %d", got, want)
@@ -101,3 +106,370 @@ func TestHandleHealthz_DegradedWhenDatabasePingFails(t *testing.T) {
 		t.Errorf("checks.database = %q, want exactly %q (no leaked detail)", got, want)
 	}
 }
+
+func TestHandleHealthz_ServiceUnavailableWhileDraining(t *testing.T) {
+	t.Parallel()
+
+	drain := NewDrain()
+	drain.Start()
+	// No stores: a draining health check must answer without the database.
+	w, res := serveHealthz(t, nil, drain)
+
+	if got, want := w.Code, http.StatusServiceUnavailable; got != want {
+		t.Errorf("status = %d, want %d", got, want)
+	}
+	if got, want := res.Status, "draining"; got != want {
+		t.Errorf("body status = %q, want %q", got, want)
+	}
+}
+
+// TestDrain_EndStreams pins that a wrapped stream's context is cancelled
+// when draining starts, and that a nil Drain passes the handler through.
+func TestDrain_EndStreams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ends the stream on Start", func(t *testing.T) {
+		t.Parallel()
+
+		drain := NewDrain()
+		streaming := make(chan struct{})
+		h := drain.EndStreams(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			close(streaming)
+			<-r.Context().Done()
+		}))
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil))
+		}()
+
+		<-streaming
+		drain.Start()
+		drain.Start()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("stream still open after Start")
+		}
+		if !drain.Draining() {
+			t.Error("Draining = false after Start, want true")
+		}
+	})
+
+	t.Run("a nil Drain never drains", func(t *testing.T) {
+		t.Parallel()
+
+		var drain *Drain
+		drain.Start()
+		if drain.Draining() {
+			t.Error("nil Drain Draining = true, want false")
+		}
+		next := http.NotFoundHandler()
+		rec := httptest.NewRecorder()
+		drain.EndStreams(next).ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil))
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("code = %d, want %d", got, want)
+		}
+	})
+}
+
+// serveReadyz drives HandleReadyz like serveHealthz drives HandleHealthz.
+func serveReadyz(
+	t *testing.T, stores *store.Stores, drain *Drain, checks ...Check,
+) (*httptest.ResponseRecorder, healthzResponse) {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/readyz", nil)
+	w := httptest.NewRecorder()
+	HandleReadyz(slog.New(slog.DiscardHandler), stores, drain, checks...)(w, req)
+
+	var res healthzResponse
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatalf("decode response err = %v, want nil", err)
+	}
+
+	return w, res
+}
+
+func TestHandleLivez_OKWithoutDependencies(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/livez", nil)
+	w := httptest.NewRecorder()
+	HandleLivez(slog.New(slog.DiscardHandler))(w, req)
+
+	if got, want := w.Code, http.StatusOK; got != want {
+		t.Errorf("status = %d, want %d", got, want)
+	}
+	var res healthzResponse
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatalf("decode response err = %v, want nil", err)
+	}
+	if got, want := res.Status, "ok"; got != want {
+		t.Errorf("body status = %q, want %q", got, want)
+	}
+}
+
+// TestHandleReadyz pins the readiness report: each sub-check by name, 503
+// when a required check fails or a migration is pending, 200 "degraded"
+// when only an optional one does, and "draining" before any check runs.
+func TestHandleReadyz(t *testing.T) {
+	t.Parallel()
+
+	errDown := errors.New("down")
+	ok := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errDown }
+
+	tests := []struct {
+		name       string
+		checks     []Check
+		wantCode   int
+		wantStatus string
+		wantChecks map[string]string
+	}{
+		{
+			name:       "all healthy",
+			checks:     []Check{{Name: "media", Run: ok, Optional: true}},
+			wantCode:   http.StatusOK,
+			wantStatus: "ok",
+			wantChecks: map[string]string{"database": "healthy", "migrations": "healthy", "media": "healthy"},
+		},
+		{
+			name:       "optional check down",
+			checks:     []Check{{Name: "media", Run: down, Optional: true}},
+			wantCode:   http.StatusOK,
+			wantStatus: "degraded",
+			wantChecks: map[string]string{"database": "healthy", "migrations": "healthy", "media": "unhealthy"},
+		},
+		{
+			name: "required check down",
+			checks: []Check{
+				{Name: "media", Run: down, Optional: true},
+				{Name: "queue", Run: down},
+			},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "unavailable",
+			wantChecks: map[string]string{
+				"database": "healthy", "migrations": "healthy", "media": "unhealthy", "queue": "unhealthy",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stores := store.New(dbtest.Open(t), slog.New(slog.DiscardHandler))
+			w, res := serveReadyz(t, stores, nil, tt.checks...)
+
+			if got := w.Code; got != tt.wantCode {
+				t.Errorf("status = %d, want %d", got, tt.wantCode)
+			}
+			if got := res.Status; got != tt.wantStatus {
+				t.Errorf("body status = %q, want %q", got, tt.wantStatus)
+			}
+			if !maps.Equal(res.Checks, tt.wantChecks) {
+				t.Errorf("checks = %v, want %v", res.Checks, tt.wantChecks)
+			}
+		})
+	}
+
+	t.Run("pending migration", func(t *testing.T) {
+		t.Parallel()
+
+		db := dbtest.Open(t)
+		if _, err := db.ExecContext(t.Context(),
+			`DELETE FROM goose_db_version WHERE version_id = (SELECT MAX(version_id) FROM goose_db_version)`,
+		); err != nil {
+			t.Fatalf("failed to rewind goose version: %v", err)
+		}
+		w, res := serveReadyz(t, store.New(db, slog.New(slog.DiscardHandler)), nil)
+
+		if got, want := w.Code, http.StatusServiceUnavailable; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		if got, want := res.Checks["migrations"], "pending"; got != want {
+			t.Errorf("checks.migrations = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("database down", func(t *testing.T) {
+		t.Parallel()
+
+		db := dbtest.Open(t)
+		if err := db.Close(); err != nil {
+			t.Fatalf("db.Close err = %v, want nil", err)
+		}
+		w, res := serveReadyz(t, store.New(db, slog.New(slog.DiscardHandler)), nil)
+
+		if got, want := w.Code, http.StatusServiceUnavailable; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		if got, want := res.Checks["database"], "unhealthy"; got != want {
+			t.Errorf("checks.database = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("draining skips the checks", func(t *testing.T) {
+		t.Parallel()
+
+		drain := NewDrain()
+		drain.Start()
+		w, res := serveReadyz(t, nil, drain)
+
+		if got, want := w.Code, http.StatusServiceUnavailable; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		if got, want := res.Status, "draining"; got != want {
+			t.Errorf("body status = %q, want %q", got, want)
+		}
+	})
+}
+
+// fakeHeartbeat is a [Heartbeat] frozen at one beat.
+type fakeHeartbeat struct {
+	last     time.Time
+	interval time.Duration
+}
+
+func (f fakeHeartbeat) LastBeat() time.Time         { return f.last }
+func (f fakeHeartbeat) BeatInterval() time.Duration { return f.interval }
+
+// fakeHub is a [SubscriberCounter] with a fixed count.
+type fakeHub int
+
+func (f fakeHub) Subscribers() int { return int(f) }
+
+// detailResponse mirrors the JSON the /healthz/detail handler emits.
+type detailResponse struct {
+	Status   string `json:"status"`
+	Database struct {
+		Status    string   `json:"status"`
+		LatencyMs *float64 `json:"latencyMs"`
+	} `json:"database"`
+	Migrations struct {
+		Status  string `json:"status"`
+		Version int64  `json:"version"`
+	} `json:"migrations"`
+	Runner *struct {
+		Status string `json:"status"`
+	} `json:"runner"`
+	Hubs map[string]int `json:"hubs"`
+	Disk *struct {
+		Status     string `json:"status"`
+		FreeBytes  uint64 `json:"freeBytes"`
+		TotalBytes uint64 `json:"totalBytes"`
+	} `json:"disk"`
+}
+
+// serveDetail drives the real HandleHealthzDetail handler and decodes the
+// response body.
+func serveDetail(
+	t *testing.T, stores *store.Stores, drain *Drain, src DetailSources,
+) (*httptest.ResponseRecorder, detailResponse) {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/healthz/detail", nil)
+	w := httptest.NewRecorder()
+	HandleHealthzDetail(slog.New(slog.DiscardHandler), stores, drain, src)(w, req)
+
+	var res detailResponse
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatalf("decode response err = %v, want nil", err)
+	}
+
+	return w, res
+}
+
+func TestHandleHealthzDetail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports every component", func(t *testing.T) {
+		t.Parallel()
+
+		stores := store.New(dbtest.Open(t), slog.New(slog.DiscardHandler))
+		w, res := serveDetail(t, stores, nil, DetailSources{
+			Runner: fakeHeartbeat{last: time.Now(), interval: time.Second},
+			Hubs:   map[string]SubscriberCounter{"leaderboard": fakeHub(2), "sessions": fakeHub(0)},
+		})
+
+		if got, want := w.Code, http.StatusOK; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		if got, want := res.Status, "ok"; got != want {
+			t.Errorf("body status = %q, want %q", got, want)
+		}
+		if res.Database.Status != "healthy" || res.Database.LatencyMs == nil {
+			t.Errorf("database = %+v, want healthy with a latency", res.Database)
+		}
+		if res.Migrations.Status != "healthy" || res.Migrations.Version == 0 {
+			t.Errorf("migrations = %+v, want healthy at a non-zero version", res.Migrations)
+		}
+		if res.Runner == nil || res.Runner.Status != "healthy" {
+			t.Errorf("runner = %+v, want healthy", res.Runner)
+		}
+		if want := map[string]int{"leaderboard": 2, "sessions": 0}; !maps.Equal(res.Hubs, want) {
+			t.Errorf("hubs = %v, want %v", res.Hubs, want)
+		}
+		if res.Disk == nil || res.Disk.Status == "" || res.Disk.TotalBytes == 0 {
+			t.Errorf("disk = %+v, want the SQLite file's filesystem", res.Disk)
+		}
+	})
+
+	t.Run("stale runner degrades", func(t *testing.T) {
+		t.Parallel()
+
+		stores := store.New(dbtest.Open(t), slog.New(slog.DiscardHandler))
+		w, res := serveDetail(t, stores, nil, DetailSources{
+			Runner: fakeHeartbeat{last: time.Now().Add(-time.Hour), interval: time.Second},
+		})
+
+		if got, want := w.Code, http.StatusOK; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		if got, want := res.Status, "degraded"; got != want {
+			t.Errorf("body status = %q, want %q", got, want)
+		}
+		if res.Runner == nil || res.Runner.Status != "unhealthy" {
+			t.Errorf("runner = %+v, want unhealthy", res.Runner)
+		}
+		if res.Hubs != nil {
+			t.Errorf("hubs = %v, want none without sources", res.Hubs)
+		}
+	})
+
+	t.Run("database down", func(t *testing.T) {
+		t.Parallel()
+
+		db := dbtest.Open(t)
+		if err := db.Close(); err != nil {
+			t.Fatalf("db.Close err = %v, want nil", err)
+		}
+		w, res := serveDetail(t, store.New(db, slog.New(slog.DiscardHandler)), nil, DetailSources{})
+
+		if got, want := w.Code, http.StatusServiceUnavailable; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		if got, want := res.Database.Status, "unhealthy"; got != want {
+			t.Errorf("database status = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("draining still reports", func(t *testing.T) {
+		t.Parallel()
+
+		drain := NewDrain()
+		drain.Start()
+		stores := store.New(dbtest.Open(t), slog.New(slog.DiscardHandler))
+		w, res := serveDetail(t, stores, drain, DetailSources{})
+
+		if got, want := w.Code, http.StatusServiceUnavailable; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		if got, want := res.Status, "draining"; got != want {
+			t.Errorf("body status = %q, want %q", got, want)
+		}
+		if got, want := res.Database.Status, "healthy"; got != want {
+			t.Errorf("database status = %q, want %q", got, want)
+		}
+	})
+}
@@ -11,14 +11,16 @@ import (
 	"html/template"
 	"io/fs"
 	"log/slog"
+	"maps"
 	"net/http"
 	"time"
 
 	"github.com/starquake/topbanana/internal/absurl"
+	"github.com/starquake/topbanana/internal/assets"
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/envtag"
 	"github.com/starquake/topbanana/internal/locale"
 	"github.com/starquake/topbanana/internal/quiz"
-	"github.com/starquake/topbanana/internal/reltime"
 	"github.com/starquake/topbanana/internal/version"
 	"github.com/starquake/topbanana/internal/web/tmpl"
 )
@@ -267,14 +269,17 @@ func executeTemplate(
 		viewerName = rc.viewer.DisplayName
 	}
 	loc := locale.Resolve(r)
-	funcs := template.FuncMap{
+	brand := branding.FromContext(r.Context())
+	funcs := locale.TemplateFuncs(loc)
+	maps.Copy(funcs, template.FuncMap{
 		"ogImage":    func() string { return absurl.BaseURL(r) + "/static/og-image.png" },
 		"viewerName": func() string { return viewerName },
 		"isSignedIn": func() bool { return rc.viewer != nil },
 		"demoMode":   func() bool { return rc.demoMode },
 		"t":          func(key string) string { return locale.Translate(loc, locale.MessageID(key)) },
 		"lang":       func() string { return loc },
-	}
+		"brand":      func() branding.Branding { return brand },
+	})
 	if rc.csrfToken != nil {
 		funcs["csrfToken"] = func() string { return rc.csrfToken(w, r) }
 	}
@@ -312,6 +317,7 @@ func parseTemplate(page string) *template.Template {
 		"ogImage":        func() string { return "" },
 		"csrfToken":      func() string { return "" },
 		"envTitleTag":    envtag.Get,
+		"asset":          assets.URL,
 		"versionLabel":   version.Label,
 		"viewerName":     func() string { return "" },
 		"isSignedIn":     func() bool { return false },
@@ -320,16 +326,16 @@ func parseTemplate(page string) *template.Template {
 		"navSection":     func() string { return "" },
 		"logoHref":       func() string { return "/" },
 		"profileHref":    func() string { return "/profile" },
-		// reltime.Humanize re-reads the clock on each call, so binding it once
-		// at parse time is safe (#927).
-		"humanizeTime": reltime.Humanize,
 		// Rebound per request by executeTemplate from cfg.DemoMode; this
 		// parse-time placeholder keeps the template parseable.
 		"demoMode": func() bool { return false },
-		// Parse-time placeholders; executeTemplate rebinds t/lang per request.
-		"t":    func(string) string { return "" },
-		"lang": func() string { return locale.LocaleEN },
+		// Parse-time placeholders; executeTemplate rebinds t/lang/brand per
+		// request.
+		"t":     func(string) string { return "" },
+		"lang":  func() string { return locale.LocaleEN },
+		"brand": func() branding.Branding { return branding.Branding{} },
 	}
+	maps.Copy(funcs, locale.TemplateFuncs(locale.LocaleEN))
 	base := template.Must(
 		template.New("").Funcs(funcs).ParseFS(tmplFS(), "components/*.gohtml", "home/layouts/*.gohtml"),
 	)
@@ -15,13 +15,13 @@ import (
 	"strings"
 
 	"github.com/starquake/topbanana/internal/absurl"
+	"github.com/starquake/topbanana/internal/assets"
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/envtag"
 	"github.com/starquake/topbanana/internal/livesession"
 	"github.com/starquake/topbanana/internal/qrcode"
 	"github.com/starquake/topbanana/internal/quiz"
-	"github.com/starquake/topbanana/internal/reltime"
 	"github.com/starquake/topbanana/internal/render"
 	"github.com/starquake/topbanana/internal/web/tmpl"
 )
@@ -69,6 +69,9 @@ type BigScreenData struct {
 	// QuestionCount is shown as lobby metadata; the lobby never leaks
 	// question text (the no-spoiler guarantee).
 	QuestionCount int
+	// MaxNotesLength caps the roster's player-notes input, matching the
+	// server-side limit.
+	MaxNotesLength int
 }
 
 // Handlers serves the host big-screen page and the host start control.
@@ -161,6 +164,7 @@ func (h *Handlers) BigScreen(w http.ResponseWriter, r *http.Request) {
 		JoinURL:          joinURL,
 		JoinEntryDisplay: joinEntry,
 		QRSVG:            qrMarkup,
+		MaxNotesLength:   livesession.MaxPlayerNotesLength,
 	}
 	// An empty room (#836) has no quiz yet: leave the quiz metadata zero-valued so
 	// the lobby renders the staging state rather than naming a quiz.
@@ -181,13 +185,12 @@ func (h *Handlers) BigScreen(w http.ResponseWriter, r *http.Request) {
 // The host/layouts/*.gohtml glob pulls in every host layout (base.gohtml and
 // page.gohtml), so this FuncMap must register every func any host layout uses
 // and stay in sync with parsePickerTemplate's - else adding a func to one
-// layout panics the other tree at parse. humanizeTime is registered here for
-// that reason even though bigscreen does not call it.
+// layout panics the other tree at parse.
 func parseTemplate(path string) *template.Template {
 	funcs := template.FuncMap{
-		"envTitleTag":  envtag.Get,
-		"csrfToken":    func() string { return "" },
-		"humanizeTime": reltime.Humanize,
+		"envTitleTag": envtag.Get,
+		"asset":       assets.URL,
+		"csrfToken":   func() string { return "" },
 	}
 
 	return render.Parse(tmpl.FS, funcs, path, "host/layouts/*.gohtml")
@@ -195,16 +198,17 @@ func parseTemplate(path string) *template.Template {
 
 // parsePickerTemplate parses the host layouts plus the shared quiz-card,
 // modal-manager, and restart-modal partials and the named page. It registers
-// the same funcs as parseTemplate (the shared quiz_card partial calls
-// humanizeTime). Only those three partials are parsed (not the whole
-// components/ glob): the footer and topbar partials reference funcs
+// the same funcs as parseTemplate (the shared quiz_card partial's relTime
+// comes from render.Parse). Only those three partials are parsed (not the
+// whole components/ glob): the footer and topbar partials reference funcs
 // (isSignedIn, isAdmin) this page does not provide; the three listed here use
-// only csrfToken/humanizeTime, so they are safe to add to the narrowed list.
+// only csrfToken and the formatting funcs, so they are safe to add to the
+// narrowed list.
 func parsePickerTemplate(path string) *template.Template {
 	funcs := template.FuncMap{
-		"envTitleTag":  envtag.Get,
-		"csrfToken":    func() string { return "" },
-		"humanizeTime": reltime.Humanize,
+		"envTitleTag": envtag.Get,
+		"asset":       assets.URL,
+		"csrfToken":   func() string { return "" },
 	}
 
 	return render.Parse(tmpl.FS, funcs, path,
@@ -0,0 +1,57 @@
+package host
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/livesession"
+)
+
+// PlayerNotes handles POST /host/{code}/players/{playerID}/notes - the host
+// control that saves the host's notes on one participant ("table 4, name
+// pending"). It reads the posted notes, stores them through
+// [livesession.Service.SetPlayerNotes] (which audit-logs the change), then
+// 303-redirects the host back to the big screen, whose roster shows them.
+// Notes past [livesession.MaxPlayerNotesLength] answer 400. A foreign or
+// unknown code, and a player who never joined, all 404 so the code stays
+// opaque to a host who does not own it.
+func (h *Handlers) PlayerNotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	player, ok := auth.PlayerFromContext(ctx)
+	if !ok {
+		h.logger.ErrorContext(ctx, "missing player on context for host player notes")
+		http.Error(w, msgInternalError, http.StatusInternalServerError)
+
+		return
+	}
+
+	code := r.PathValue("code")
+	playerID, err := handlers.IDFromString(r.PathValue("playerID"))
+	if err != nil {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	err = h.service.SetPlayerNotes(ctx, code, player.ID, playerID, r.FormValue("notes"))
+	switch {
+	case err == nil:
+		// code is the server-minted path value, never request input, so the
+		// redirect back to the lobby is same-origin.
+		dest := hostScreenPathPrefix + code
+		http.Redirect(w, r, dest, http.StatusSeeOther) //nolint:gosec // code is server-generated, not user input.
+	case errors.Is(err, livesession.ErrNotesTooLong):
+		http.Error(w, "notes are too long", http.StatusBadRequest)
+	case errors.Is(err, livesession.ErrSessionNotFound),
+		errors.Is(err, livesession.ErrNotHost),
+		errors.Is(err, livesession.ErrNotParticipant):
+		http.NotFound(w, r)
+	default:
+		h.logger.ErrorContext(ctx, "error setting host player notes", slog.Any("err", err))
+		http.Error(w, msgInternalError, http.StatusInternalServerError)
+	}
+}
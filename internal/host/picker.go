@@ -22,7 +22,7 @@ type quizCardData struct {
 	QuestionCount int
 	RoundCount    int
 	PlayCount     int64
-	Mode          string
+	Mode          quiz.Mode
 	ActionVariant string
 	// HostHasRunningGame gates the card's action (#889): true swaps the plain
 	// "Host this" form for a "Change quiz" button that opens the confirm-and-
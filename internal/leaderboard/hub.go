@@ -63,6 +63,19 @@ func (h *Hub) Subscribe(quizID int64) (<-chan struct{}, func()) {
 	return ch, unsubscribe
 }
 
+// Subscribers returns the number of open subscriptions across every quiz,
+// for the health detail report.
+func (h *Hub) Subscribers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	n := 0
+	for _, set := range h.subs {
+		n += len(set)
+	}
+
+	return n
+}
+
 // Publish fires a non-blocking tick to every active subscriber of the
 // given quiz. If a subscriber's buffer is full, the event is dropped on
 // the floor; the subscriber will see the next event and re-fetch.
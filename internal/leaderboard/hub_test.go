@@ -135,3 +135,22 @@ func TestHub_ConcurrentPublishAndSubscribe(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestHub_Subscribers(t *testing.T) {
+	t.Parallel()
+
+	h := NewHub()
+	_, unsubA := h.Subscribe(1)
+	_, unsubB := h.Subscribe(1)
+	_, unsubC := h.Subscribe(2)
+	defer unsubC()
+	if got, want := h.Subscribers(), 3; got != want {
+		t.Errorf("Subscribers() = %d, want %d", got, want)
+	}
+	unsubA()
+	unsubB()
+	unsubB()
+	if got, want := h.Subscribers(), 1; got != want {
+		t.Errorf("Subscribers() after unsubscribing = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+package livesession
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// roomCapacity returns the most players the room admits while it plays qz:
+// the quiz's own MaxPlayers when set, otherwise the service default from
+// [Service.SetMaxPlayers]. Zero means no cap. qz is nil for an empty room.
+func (s *Service) roomCapacity(qz *quiz.Quiz) int {
+	if qz != nil && qz.MaxPlayers > 0 {
+		return qz.MaxPlayers
+	}
+
+	return s.maxPlayers
+}
+
+// admitToRoom applies the room's capacity to a player joining the session. A
+// player already on the active roster is reconnecting and always gets back in;
+// anyone else is turned away with [ErrGameFull] once the roster holds the cap.
+// It reports full=true when admitting the player fills the room, so Join can
+// tell the host.
+//
+// The roster is read before the insert, so two joins racing for the last slot
+// can both land; the cap is there to keep a room from swamping the hub, and one
+// player over it does not.
+func (s *Service) admitToRoom(ctx context.Context, sess *Session, playerID int64) (bool, error) {
+	if slices.ContainsFunc(sess.Players, func(p *Player) bool { return p.PlayerID == playerID }) {
+		return false, nil
+	}
+
+	var qz *quiz.Quiz
+	if sess.QuizID != nil {
+		var err error
+		if qz, err = s.quizzes.GetQuiz(ctx, *sess.QuizID); err != nil {
+			return false, fmt.Errorf("failed to load quiz for room capacity: %w", err)
+		}
+	}
+	limit := s.roomCapacity(qz)
+	if limit <= 0 {
+		return false, nil
+	}
+	if len(sess.Players) >= limit {
+		s.logger.InfoContext(ctx, "live session join rejected: room full",
+			slog.String(logJoinCodeKey, sess.JoinCode),
+			slog.Int64(logPlayerKey, playerID),
+			slog.Int(logCapacityKey, limit))
+
+		return false, ErrGameFull
+	}
+
+	return len(sess.Players)+1 == limit, nil
+}
@@ -16,10 +16,11 @@ var ExportNewServiceWithCodeGen = newServiceWithCodeGen
 // handler's disconnect cleanup) actually drops the entry rather than
 // leaking it. Test-only; not part of the production API.
 func ExportHubSubscriberCount(h *Hub, code string) int {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+	sh := h.shard(code)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
 
-	return len(h.subs[code])
+	return len(sh.subs[code])
 }
 
 // ExportHubHasVersion reports whether the hub still holds a version entry for
@@ -27,10 +28,11 @@ func ExportHubSubscriberCount(h *Hub, code string) int {
 // (the entry evicted) rather than pinned for the process lifetime. Test-only;
 // not part of the production API.
 func ExportHubHasVersion(h *Hub, code string) bool {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+	sh := h.shard(code)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
 
-	_, ok := h.versions[code]
+	_, ok := sh.versions[code]
 
 	return ok
 }
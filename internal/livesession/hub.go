@@ -1,6 +1,9 @@
 package livesession
 
-import "sync"
+import (
+	"hash/fnv"
+	"sync"
+)
 
 // Tick is the minimal payload the session event channel fans out on every
 // state change. It deliberately carries NO game data - no roster, quiz, or
@@ -17,19 +20,37 @@ type Tick struct {
 // Hub fans out per-session ticks to in-process subscribers and owns the
 // monotonic per-session version counter. Safe for concurrent use. Each
 // subscriber gets one buffered slot so a slow reader never blocks Publish;
-// a dropped tick is harmless because the subscriber re-GETs the full state
-// on the next receive (the side-channel carries no data of its own).
+// when the slot is still full the stale tick is replaced by the new one, so a
+// lagging subscriber resyncs straight to the latest version and phase (the
+// side-channel carries no data of its own, so nothing in between is lost).
 //
 // This mirrors leaderboard.Hub. It is keyed by join code (a string) rather
 // than quiz id, and the published value is a Tick rather than a bare
 // struct{} because the session channel reports a {version, phase} on each
 // transition while the leaderboard channel only signals "refetch".
 //
+// Codes are spread over hubShardCount independently locked shards, so a
+// fan-out to one room does not stall Publish and Subscribe for rooms on
+// other shards. Sharding does nothing for the room itself: its whole
+// subscriber set sits under one lock, and a Publish to it costs O(its
+// subscribers), which BenchmarkHub_Publish1kSubscribers measures for a
+// 1k-player room.
+//
 // The version counter lives here, in memory: MP-2 needs no DB version
 // column. Versions persist for a code even while it has no subscribers, so
 // a client that reconnects sees a version at least as high as the last one
 // it saw.
 type Hub struct {
+	shards [hubShardCount]hubShard
+}
+
+// hubShardCount is the number of independently locked shards a Hub splits
+// its sessions over. A power of two keeps the modulo a mask.
+const hubShardCount = 32
+
+// hubShard is one lock domain of a Hub: the subscribers and version counters
+// of every code that hashes to it.
+type hubShard struct {
 	mu       sync.Mutex
 	subs     map[string]map[chan Tick]struct{}
 	versions map[string]uint64
@@ -37,10 +58,21 @@ type Hub struct {
 
 // NewHub returns a fresh Hub with no subscribers and no versions.
 func NewHub() *Hub {
-	return &Hub{
-		subs:     make(map[string]map[chan Tick]struct{}),
-		versions: make(map[string]uint64),
+	h := &Hub{}
+	for i := range h.shards {
+		h.shards[i].subs = make(map[string]map[chan Tick]struct{})
+		h.shards[i].versions = make(map[string]uint64)
 	}
+
+	return h
+}
+
+// shard returns the shard owning code (FNV-1a over the code bytes).
+func (h *Hub) shard(code string) *hubShard {
+	f := fnv.New32a()
+	_, _ = f.Write([]byte(code))
+
+	return &h.shards[f.Sum32()%hubShardCount]
 }
 
 // Subscribe registers a receiver for the given session join code and
@@ -51,30 +83,31 @@ func NewHub() *Hub {
 // pins memory on long-lived sessions.
 //
 // The channel is buffered (capacity 1). If a Publish lands while the
-// previous tick is still unread, the new one is dropped; the subscriber
-// re-GETs the current state on every receive, so a dropped tick is a
-// coalesced repaint, not lost data.
+// previous tick is still unread, the unread tick is replaced by the new one;
+// the subscriber re-GETs the current state on every receive, so a replaced
+// tick is a coalesced repaint, not lost data.
 func (h *Hub) Subscribe(code string) (<-chan Tick, uint64, func()) {
+	sh := h.shard(code)
 	ch := make(chan Tick, 1)
-	h.mu.Lock()
-	set, ok := h.subs[code]
+	sh.mu.Lock()
+	set, ok := sh.subs[code]
 	if !ok {
 		set = make(map[chan Tick]struct{})
-		h.subs[code] = set
+		sh.subs[code] = set
 	}
 	set[ch] = struct{}{}
-	version := h.versions[code]
-	h.mu.Unlock()
+	version := sh.versions[code]
+	sh.mu.Unlock()
 
 	var once sync.Once
 	unsubscribe := func() {
 		once.Do(func() {
-			h.mu.Lock()
-			defer h.mu.Unlock()
-			if existing, ok := h.subs[code]; ok {
+			sh.mu.Lock()
+			defer sh.mu.Unlock()
+			if existing, ok := sh.subs[code]; ok {
 				delete(existing, ch)
 				if len(existing) == 0 {
-					delete(h.subs, code)
+					delete(sh.subs, code)
 				}
 			}
 			// Close under the lock so a concurrent Publish (which writes
@@ -86,11 +119,28 @@ func (h *Hub) Subscribe(code string) (<-chan Tick, uint64, func()) {
 	return ch, version, unsubscribe
 }
 
+// Subscribers returns the number of open subscriptions across every
+// session, for the health detail report. Each shard is counted under its own
+// lock, so the total is a moment-by-moment sum rather than a snapshot.
+func (h *Hub) Subscribers() int {
+	n := 0
+	for i := range h.shards {
+		sh := &h.shards[i]
+		sh.mu.Lock()
+		for _, set := range sh.subs {
+			n += len(set)
+		}
+		sh.mu.Unlock()
+	}
+
+	return n
+}
+
 // Forget drops the session's version counter once it has reached a terminal
 // state and no client can produce more ticks for it. Without this a code's
 // versions entry would live for the whole process lifetime, since unsubscribe
-// only clears subs. Safe for concurrent use: it runs under the hub mutex, the
-// same lock Publish and Subscribe take.
+// only clears subs. Safe for concurrent use: it runs under the shard mutex,
+// the same lock Publish and Subscribe take.
 //
 // Eviction is safe at finish: a client that reconnects after Forget
 // re-subscribes (recreating the entry) and Subscribe hands it version 0, which
@@ -98,35 +148,43 @@ func (h *Hub) Subscribe(code string) (<-chan Tick, uint64, func()) {
 // Forget only after the final Publish, so the finished tick still carries the
 // last real version.
 func (h *Hub) Forget(code string) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+	sh := h.shard(code)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
 
-	delete(h.versions, code)
+	delete(sh.versions, code)
 }
 
 // Publish bumps the session's version counter, then fires a non-blocking
 // tick carrying the new version and the given phase to every active
 // subscriber of the code. Returns the published Tick. If a subscriber's
-// buffer is full the tick is dropped on the floor; the subscriber sees the
-// next tick and re-GETs.
+// buffer still holds an unread tick, that tick is swapped for the new one so
+// the subscriber's next receive is the latest state.
 //
-// The whole operation runs under the hub mutex so close-channel (in
+// The whole operation runs under the shard mutex so close-channel (in
 // unsubscribe) and chan-send never overlap, and the version increment is
-// atomic with the fan-out. Sends are non-blocking (buffer = 1, select
-// default), so the worst case is O(subscribers) - fine for the small
-// per-session subscriber set we expect.
+// atomic with the fan-out. Publish is the only sender, so once the stale tick
+// is drained the follow-up send cannot block. The cost is O(subscribers of
+// this code); other sessions are untouched (see
+// BenchmarkHub_Publish1kSubscribers).
 func (h *Hub) Publish(code string, phase Phase) Tick {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+	sh := h.shard(code)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
 
-	h.versions[code]++
-	tick := Tick{Version: h.versions[code], Phase: phase}
+	sh.versions[code]++
+	tick := Tick{Version: sh.versions[code], Phase: phase}
 
-	for ch := range h.subs[code] {
+	for ch := range sh.subs[code] {
 		select {
 		case ch <- tick:
 		default:
-			// buffer full; coalesced on the receive side.
+			select {
+			case <-ch:
+			default:
+				// the reader took the stale tick in the meantime.
+			}
+			ch <- tick
 		}
 	}
 
@@ -1,6 +1,7 @@
 package livesession_test
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -134,18 +135,21 @@ func TestHub_CoalescesBackPressure(t *testing.T) {
 	defer unsub()
 
 	// Three publishes with the subscriber never draining: buffer is 1 so
-	// two get dropped, but exactly one tick is readable - carrying the
-	// latest version.
-	h.Publish("ROOM01", PhaseLobby)
+	// two are replaced, and exactly one tick is readable - carrying the
+	// latest version and phase, so the lagging reader resyncs to now.
 	h.Publish("ROOM01", PhaseLobby)
 	h.Publish("ROOM01", PhaseLobby)
+	h.Publish("ROOM01", PhaseFinished)
 
 	if got, want := len(ch), 1; got != want {
 		t.Fatalf("subscriber buffer len = %d, want %d (Publish should coalesce, not block)", got, want)
 	}
 	tick := <-ch
-	if got, want := tick.Version, uint64(1); got != want {
-		t.Errorf("coalesced tick version = %d, want %d (first publish wins the buffer slot)", got, want)
+	if got, want := tick.Version, uint64(3); got != want {
+		t.Errorf("coalesced tick version = %d, want %d (latest publish wins the buffer slot)", got, want)
+	}
+	if got, want := tick.Phase, PhaseFinished; got != want {
+		t.Errorf("coalesced tick phase = %q, want %q", got, want)
 	}
 }
 
@@ -222,3 +226,57 @@ func TestHub_ConcurrentPublishAndSubscribe(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+// BenchmarkHub_Publish1kSubscribers measures one fan-out to a 1k-player room
+// whose readers drain concurrently, alongside 31 idle rooms that share the
+// hub.
+func BenchmarkHub_Publish1kSubscribers(b *testing.B) {
+	const (
+		subscribers = 1000
+		code        = "BIGROOM"
+	)
+
+	h := NewHub()
+	for i := range 31 {
+		_, _, unsub := h.Subscribe(fmt.Sprintf("IDLE%02d", i))
+		b.Cleanup(unsub)
+	}
+
+	var wg sync.WaitGroup
+	unsubs := make([]func(), 0, subscribers)
+	for range subscribers {
+		ch, _, unsub := h.Subscribe(code)
+		unsubs = append(unsubs, unsub)
+		wg.Go(func() {
+			for range ch {
+				// drain; a real subscriber would re-GET the state here.
+			}
+		})
+	}
+	b.Cleanup(func() {
+		for _, unsub := range unsubs {
+			unsub()
+		}
+		wg.Wait()
+	})
+
+	for b.Loop() {
+		h.Publish(code, PhaseQuestion)
+	}
+}
+
+func TestHub_Subscribers(t *testing.T) {
+	t.Parallel()
+
+	h := NewHub()
+	_, _, unsubA := h.Subscribe("ROOM01")
+	_, _, unsubB := h.Subscribe("ROOM02")
+	defer unsubB()
+	if got, want := h.Subscribers(), 2; got != want {
+		t.Errorf("Subscribers() = %d, want %d", got, want)
+	}
+	unsubA()
+	if got, want := h.Subscribers(), 1; got != want {
+		t.Errorf("Subscribers() after unsubscribing = %d, want %d", got, want)
+	}
+}
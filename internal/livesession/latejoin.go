@@ -0,0 +1,116 @@
+package livesession
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// gameUnderway reports whether the room's current game has started and not
+// yet ended: the phases between the first round's intro and intermission. A
+// join in any of them is a late join.
+func gameUnderway(phase Phase) bool {
+	switch phase {
+	case PhaseRoundIntro, PhaseQuestion, PhaseReveal, PhaseRoundResults:
+		return true
+	default:
+		return false
+	}
+}
+
+// admitLateJoiner applies the quiz's late-join policy to a player joining the
+// session. It reports late=true with the number of questions that already
+// closed when the player is new to a game under way and the policy lets them
+// in, and [ErrJoinClosed] when the policy turns them away. A join outside a
+// game, or by a player already on the roster (a reconnect), is not late.
+func (s *Service) admitLateJoiner(ctx context.Context, sess *Session, playerID int64) (int, bool, error) {
+	if !gameUnderway(sess.Phase) || sess.QuizID == nil {
+		return 0, false, nil
+	}
+	joined, err := s.store.HasJoined(ctx, sess.ID, playerID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to check session roster: %w", err)
+	}
+	if joined {
+		return 0, false, nil
+	}
+
+	qz, err := s.quizzes.GetQuiz(ctx, *sess.QuizID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to load quiz for late join: %w", err)
+	}
+	if lateJoinClosed(qz, sess, time.Now()) {
+		s.logger.InfoContext(ctx, "live session join rejected: late joins closed",
+			slog.String(logJoinCodeKey, sess.JoinCode),
+			slog.Int64(logPlayerKey, playerID),
+			slog.String(logLateJoinKey, quiz.NormalizedLateJoin(qz.LateJoin)))
+
+		return 0, false, ErrJoinClosed
+	}
+
+	plan, err := gamePlan(ctx, s.store, sess, qz)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return plan.closedQuestions(sess), true, nil
+}
+
+// lateJoinClosed reports whether the quiz turns a new player away from a game
+// under way at now: its policy is [quiz.LateJoinClosed], or its join deadline
+// has passed since the game started.
+func lateJoinClosed(qz *quiz.Quiz, sess *Session, now time.Time) bool {
+	if quiz.NormalizedLateJoin(qz.LateJoin) == quiz.LateJoinClosed {
+		return true
+	}
+	if qz.JoinDeadlineSeconds <= 0 || sess.StartedAt == nil {
+		return false
+	}
+	deadline := sess.StartedAt.Add(time.Duration(qz.JoinDeadlineSeconds) * time.Second)
+
+	return now.After(deadline)
+}
+
+// closedQuestions counts the questions whose answer window has closed by the
+// session's current beat, in play order: every question of the rounds before
+// the current one, then the current round's questions before the open one. A
+// question in reveal has closed; one still in its answer window has not, so a
+// player joining then can still answer it. At round_results the whole round
+// has closed.
+func (p questionPlan) closedQuestions(sess *Session) int {
+	if sess.CurrentRoundID == nil {
+		return 0
+	}
+	closed := 0
+	for _, roundID := range p.rounds {
+		questions := p.questionsByRnd[roundID]
+		if roundID != *sess.CurrentRoundID {
+			closed += len(questions)
+
+			continue
+		}
+		if sess.Phase == PhaseRoundResults {
+			return closed + len(questions)
+		}
+		if sess.CurrentQuestionID == nil {
+			return closed
+		}
+		for _, q := range questions {
+			if q.ID == *sess.CurrentQuestionID {
+				if sess.Phase == PhaseReveal {
+					closed++
+				}
+
+				return closed
+			}
+			closed++
+		}
+
+		return closed
+	}
+
+	return closed
+}
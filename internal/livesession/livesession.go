@@ -64,6 +64,20 @@ var (
 	// phase (#836); only a closed room rejects joins. Handlers map it to 409.
 	ErrLobbyClosed = errors.New("session room is closed")
 
+	// ErrJoinClosed is returned by [Service.Join] when a new player tries to
+	// join a game already under way and the quiz's late-join policy turns them
+	// away: the policy is [quiz.LateJoinClosed], or the quiz's join deadline
+	// has passed. A player already on the roster is reconnecting and is never
+	// turned away. Handlers map it to 409.
+	ErrJoinClosed = errors.New("game is closed to late joiners")
+
+	// ErrGameFull is returned by [Service.Join] when a new player tries to
+	// join a room whose active roster already holds its capacity: the quiz's
+	// max players, or the server-wide default ([Service.SetMaxPlayers]). A
+	// player already on the roster is reconnecting and is never turned away.
+	// Handlers map it to 409.
+	ErrGameFull = errors.New("game is full")
+
 	// ErrNotInLobby is returned by [Service.ArmStart] / [Service.CancelStart]
 	// when the session has already left the lobby, so the last-call countdown
 	// can only be armed or cancelled while the game has not begun. Handlers
@@ -84,6 +98,11 @@ var (
 	// phantom game (started but stuck in the lobby with no plan to run).
 	// Handlers map it to 409.
 	ErrNoQuizToStart = errors.New("session has no quiz to start")
+
+	// ErrInvalidExtension is returned by [Service.ExtendQuestion] when the
+	// requested extension is not positive or exceeds [MaxQuestionExtension].
+	// Handlers map it to 400.
+	ErrInvalidExtension = errors.New("extension must be between 1 second and 5 minutes")
 )
 
 // Phase is the server-authoritative state-machine label for a session.
@@ -127,6 +146,10 @@ const (
 	// The e2e suite shrinks it via SESSION_START_COUNTDOWN so a spec does not
 	// pay the production dwell time.
 	DefaultStartCountdown = 60 * time.Second
+	// MaxQuestionExtension caps a single host extend of the open question's
+	// answer window. It is a "the projector froze" control, not a pause: a
+	// host who needs longer extends again.
+	MaxQuestionExtension = 5 * time.Minute
 	// DefaultIdleCloseTimeout is how long a room may sit with its host gone (no
 	// host heartbeat) AND no active players before the runner closes it as idle
 	// (#836). Hosting is now session-first: a host opens a room up front and may
@@ -175,6 +198,10 @@ type Session struct {
 	// per-game answer read scopes to it and a re-run of the same quiz is scored
 	// independently of the previous game.
 	GameSeq int64
+	// HostAdvance is set when the host paces the room (see
+	// [Service.SetHostAdvance]): the runner holds each beat-gated screen until
+	// the host moves it on.
+	HostAdvance bool
 	// CurrentRoundID / CurrentQuestionID point at the question the runner
 	// is currently driving; nil in the lobby and once finished.
 	CurrentRoundID    *int64
@@ -185,9 +212,13 @@ type Session struct {
 	// QuestionExpiresAt minus the server clock, never their own wall clock.
 	QuestionStartedAt *time.Time
 	QuestionExpiresAt *time.Time
-	CreatedAt         time.Time
-	StartedAt         *time.Time
-	FinishedAt        *time.Time
+	// AnswersClosedAt is set once the host closes the current question's
+	// answering early (see [Service.CloseAnswers]). It stops new answers only;
+	// the picks are still scored against QuestionStartedAt..QuestionExpiresAt.
+	AnswersClosedAt *time.Time
+	CreatedAt       time.Time
+	StartedAt       *time.Time
+	FinishedAt      *time.Time
 	// StartAt is the absolute server deadline of an armed last-call countdown
 	// (#735): nil when no countdown is armed. The runner starts the game on the
 	// first lobby tick at or after StartAt; the state read surfaces it so every
@@ -214,6 +245,10 @@ type Player struct {
 	IsReady     bool
 	JoinedAt    time.Time
 	LastSeenAt  time.Time
+	// Notes is the host's jotting against this player (see
+	// [Service.SetPlayerNotes]). [Service.GetSessionState] clears it for any
+	// viewer but the host.
+	Notes string
 }
 
 // SessionState is the authoritative read returned by
@@ -256,6 +291,18 @@ type SessionState struct {
 	// off Standings. 0 in the lobby (no game yet) and when the viewer has not
 	// scored.
 	ViewerScore int
+	// MaxPlayers is the room's capacity while it plays Quiz: the quiz's own
+	// max players, or the server-wide default. 0 means no cap.
+	MaxPlayers int
+	// RoomFull is true while the active roster holds MaxPlayers, so new
+	// players are being turned away with [ErrGameFull]. The host screen shows
+	// it; the join that fills the room publishes the tick that surfaces it.
+	RoomFull bool
+	// Upcoming lists the questions still to be played in the current game, in
+	// play order, for the host's reorder and skip controls
+	// ([Service.ReorderQuestions], [Service.SkipQuestion]). Populated for the
+	// host alone; nil for every other viewer and once the game has ended.
+	Upcoming []*quiz.Question
 }
 
 // RoundInfo describes the round shown on the round_intro screen (#748): its
@@ -283,6 +330,10 @@ type Standing struct {
 	RoundScore  int
 	TotalScore  int
 	Rank        int
+	// MissedQuestions is how many questions had closed before the player
+	// joined the game late; 0 for everyone who was there from the start. The
+	// results footnote words it by the quiz's late-join policy.
+	MissedQuestions int
 }
 
 // Store is the persistence surface the live-session domain needs. Defined
@@ -313,10 +364,23 @@ type Store interface {
 	// join players and select the current players.display_name, so a rename
 	// propagates everywhere. The returned Player carries no name.
 	AddPlayer(ctx context.Context, sessionID string, playerID int64) (*Player, error)
+	// HasJoined reports whether the player holds a roster row in the session,
+	// including one marked left: such a player re-joining is a reconnect, which
+	// the late-join policy never turns away.
+	HasJoined(ctx context.Context, sessionID string, playerID int64) (bool, error)
+	// RecordLateJoin records that the player joined the session's current game
+	// (gameSeq) after it started, with how many questions had already closed.
+	// A second record for the same game keeps the first count.
+	RecordLateJoin(ctx context.Context, sessionID string, playerID, gameSeq int64, missed int) error
 	// SetReady toggles a participant's ready flag. Returns
 	// [ErrNotParticipant] when the player has no roster row in the
 	// session.
 	SetReady(ctx context.Context, sessionID string, playerID int64, ready bool) error
+	// SetPlayerNotes replaces the host's notes on a participant and records
+	// the change in the admin audit log against the participant, with
+	// hostPlayerID as the actor, in one transaction. Returns
+	// [ErrNotParticipant] when the player has no roster row in the session.
+	SetPlayerNotes(ctx context.Context, sessionID, joinCode string, hostPlayerID, playerID int64, notes string) error
 	// GetSessionByID resolves a session by its primary key with the roster
 	// populated. The runner works in session ids (its in-memory bookkeeping
 	// is keyed by id), while the lobby paths work in join codes. Returns
@@ -335,6 +399,27 @@ type Store interface {
 	// armed countdown. Returns [ErrNotInLobby] when the session has already
 	// left the lobby.
 	CancelStart(ctx context.Context, sessionID string) error
+	// ExtendQuestion moves the answer deadline of the session's open question
+	// to expiresAt. Returns [ErrQuestionNotOpen] when the session is no longer
+	// in the question phase for questionID (the runner closed it first).
+	ExtendQuestion(ctx context.Context, sessionID string, questionID int64, expiresAt time.Time) error
+	// CloseAnswers stops answers to the session's open question at closedAt
+	// without moving its answer deadline. Returns [ErrQuestionNotOpen] when
+	// questionID is no longer open for answers.
+	CloseAnswers(ctx context.Context, sessionID string, questionID int64, closedAt time.Time) error
+	// SetHostAdvance turns the host's pacing of the session on or off.
+	// Returns [ErrSessionNotFound] when the session is unknown or closed.
+	SetHostAdvance(ctx context.Context, sessionID string, on bool) error
+	// ListQuestionPlan returns the host's reorders and skips of one game of
+	// the session (see [PlanChange]), empty for a game played as written.
+	ListQuestionPlan(ctx context.Context, sessionID string, gameSeq int64) ([]*PlanChange, error)
+	// ReorderQuestions ranks questionIDs, in that order, past every earlier
+	// reorder of the session's current game. Returns [ErrPlanMoved] when the
+	// session is no longer at the same point as sess (see [Session.SamePoint]).
+	ReorderQuestions(ctx context.Context, sess *Session, questionIDs []int64) error
+	// SkipQuestion drops questionID from the session's current game. Returns
+	// [ErrPlanMoved] under the same rule as ReorderQuestions.
+	SkipQuestion(ctx context.Context, sess *Session, questionID int64) error
 	// EnterRoundIntro moves the session into the round_intro phase for the
 	// given round, clearing the per-question runner columns. Optimistic write
 	// against expected (the phase the caller loaded): reports false when no row
@@ -415,8 +500,12 @@ type Store interface {
 	// answered order, with the chosen option's correctness, for scoring at
 	// close and the answered-order view.
 	ListAnswers(ctx context.Context, sessionID string, questionID int64) ([]*SessionAnswer, error)
-	// SetAnswerScore writes the computed score for one pick at close.
-	SetAnswerScore(ctx context.Context, sessionID string, questionID, playerID int64, score int) error
+	// SetAnswerScore writes the computed score for one pick at close, with
+	// the answer window it was scored against so a later recalculation can
+	// replay it.
+	SetAnswerScore(
+		ctx context.Context, sessionID string, questionID, playerID int64, score int, startedAt, expiresAt time.Time,
+	) error
 	// ListLiveSessionIDs returns the ids of every session not yet finished,
 	// in creation order, so the runner can scan active rooms each beat.
 	ListLiveSessionIDs(ctx context.Context) ([]string, error)
@@ -488,6 +577,10 @@ type Advancer interface {
 	// enters the new game's first round. Safe to call more than once; a room not
 	// in the lobby is a no-op.
 	Rearm(ctx context.Context, sessionID string)
+	// Next makes the session's next transition at once for a host control
+	// ([Service.Advance], [Service.CloseAnswers]), without waiting for the
+	// beat. A session that has left expected meanwhile is a no-op.
+	Next(ctx context.Context, sessionID string, expected Phase)
 }
 
 // Service orchestrates the live-session use cases over the store layer and
@@ -504,6 +597,9 @@ type Service struct {
 	// deadline. Zero falls back to DefaultStartCountdown so a service built
 	// without SetStartCountdown still arms a sane 60s countdown.
 	startCountdown time.Duration
+	// maxPlayers is the room capacity for a quiz that sets none of its own.
+	// Zero means no cap.
+	maxPlayers int
 }
 
 // joinCodeAttempts caps how many distinct codes the generator tries
@@ -568,6 +664,13 @@ func (s *Service) SetStartCountdown(d time.Duration) {
 	s.startCountdown = d
 }
 
+// SetMaxPlayers sets the room capacity [Service.Join] enforces for a quiz that
+// does not set its own max players. Zero or negative means no cap. Same
+// startup-only contract as [Service.SetPublisher].
+func (s *Service) SetMaxPlayers(n int) {
+	s.maxPlayers = max(n, 0)
+}
+
 // hostableQuizErr reports why qz cannot be hosted live by the requester, or nil
 // when it can: it must be a live quiz (else [ErrNotLiveQuiz]), and per-host
 // isolation (#1207, overriding #677 for non-admins) means the requester must
@@ -648,8 +751,12 @@ func (s *Service) CreateSession(
 // the roster row; the displayed name comes from the players join on the
 // roster/standings reads, so a rename propagates everywhere. Returns
 // [ErrSessionNotFound] when the code resolves to no session and
-// [ErrLobbyClosed] only when the room is terminally closed (finished); a
-// latecomer may join a live game at any phase (#836).
+// [ErrLobbyClosed] only when the room is terminally closed (finished). A
+// latecomer may join a live game at any phase (#836) unless the quiz's
+// late-join policy says otherwise, which is [ErrJoinClosed]; an admitted
+// latecomer has the questions they missed recorded for the results footnote.
+// A new player is turned away with [ErrGameFull] once the room holds its
+// capacity; a player already on the roster always gets back in.
 func (s *Service) Join(ctx context.Context, joinCode string, playerID int64) (*Player, error) {
 	sess, err := s.store.GetSessionByJoinCode(ctx, normalizeJoinCode(joinCode))
 	if err != nil {
@@ -670,17 +777,38 @@ func (s *Service) Join(ctx context.Context, joinCode string, playerID int64) (*P
 		return nil, ErrLobbyClosed
 	}
 
+	missed, late, err := s.admitLateJoiner(ctx, sess, playerID)
+	if err != nil {
+		return nil, err
+	}
+	fills, err := s.admitToRoom(ctx, sess, playerID)
+	if err != nil {
+		return nil, err
+	}
+
 	player, err := s.store.AddPlayer(ctx, sess.ID, playerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add session player: %w", err)
 	}
+	if late {
+		if err = s.store.RecordLateJoin(ctx, sess.ID, playerID, sess.GameSeq, missed); err != nil {
+			return nil, fmt.Errorf("failed to record late join: %w", err)
+		}
+	}
 
-	// A new roster row changes the lobby, so signal subscribers to re-GET.
+	// A new roster row changes the lobby, so signal subscribers to re-GET. The
+	// join that fills the room rides the same tick: the host's re-read sees
+	// SessionState.RoomFull.
 	s.publish(sess.JoinCode, sess.Phase)
 
 	s.logger.InfoContext(ctx, "player joined live session",
 		slog.String(logJoinCodeKey, sess.JoinCode),
 		slog.Int64(logPlayerKey, playerID))
+	if fills {
+		s.logger.InfoContext(ctx, "live session room full",
+			slog.String(logJoinCodeKey, sess.JoinCode),
+			slog.Int(logCapacityKey, len(sess.Players)+1))
+	}
 
 	return player, nil
 }
@@ -867,6 +995,51 @@ func (s *Service) CancelStart(ctx context.Context, joinCode string, hostPlayerID
 	return nil
 }
 
+// ExtendQuestion is the host "extend" control: it pushes the open question's
+// answer deadline back by the given duration for every participant, then
+// publishes a tick so every surface re-reads the state and redraws its
+// countdown from the new deadline. Answers already in keep their pick; all
+// picks are scored at close against the extended window. It returns the new
+// deadline. Errors: [ErrInvalidExtension] for a non-positive or over-cap
+// duration, [ErrSessionNotFound], [ErrNotHost], and [ErrQuestionNotOpen] when
+// questionID is not the question currently open for answers (including one
+// whose window has already run out).
+func (s *Service) ExtendQuestion(
+	ctx context.Context, joinCode string, hostPlayerID, questionID int64, by time.Duration, now time.Time,
+) (time.Time, error) {
+	if by <= 0 || by > MaxQuestionExtension {
+		return time.Time{}, ErrInvalidExtension
+	}
+	sess, err := s.store.GetSessionByJoinCode(ctx, normalizeJoinCode(joinCode))
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errGetSessionByCodeFmt, err)
+	}
+	if sess.HostPlayerID != hostPlayerID {
+		s.logNonHostAttempt(ctx, "extendQuestion", sess.JoinCode, hostPlayerID)
+
+		return time.Time{}, ErrNotHost
+	}
+	if sess.Phase != PhaseQuestion || sess.CurrentQuestionID == nil || *sess.CurrentQuestionID != questionID ||
+		sess.QuestionExpiresAt == nil || !now.Before(*sess.QuestionExpiresAt) || sess.AnswersClosedAt != nil {
+		return time.Time{}, ErrQuestionNotOpen
+	}
+
+	deadline := sess.QuestionExpiresAt.Add(by)
+	if err = s.store.ExtendQuestion(ctx, sess.ID, questionID, deadline); err != nil {
+		return time.Time{}, fmt.Errorf("failed to extend session question: %w", err)
+	}
+
+	s.publish(sess.JoinCode, PhaseQuestion)
+
+	s.logger.InfoContext(ctx, "live session question extended",
+		slog.String(logJoinCodeKey, sess.JoinCode),
+		slog.Int64(logHostKey, hostPlayerID),
+		slog.Int64(logQuestionKey, questionID),
+		slog.Time(logDeadlineKey, deadline))
+
+	return deadline, nil
+}
+
 // SubmitAnswer records the caller's pick for the session's current question.
 // The pick is validated against the live question (the option must belong to
 // it and the answer window must be open) and stored without its correctness
@@ -896,6 +1069,11 @@ func (s *Service) SubmitAnswer(
 
 		return ErrQuestionNotOpen
 	}
+	if sess.AnswersClosedAt != nil {
+		s.logAnswerNotOpen(ctx, sess, playerID, "closed-by-host")
+
+		return ErrQuestionNotOpen
+	}
 	// The window opens at StartedAt (after the read beat) and closes at
 	// ExpiresAt; a pick outside [StartedAt, ExpiresAt] is rejected, so a client
 	// cannot pre-submit during the read beat.
@@ -949,10 +1127,24 @@ func (s *Service) GetSessionState(ctx context.Context, joinCode string, playerID
 		return nil, ErrNotParticipant
 	}
 
+	// The host's notes on the roster are for the host alone; every other
+	// viewer reads the roster without them.
+	if sess.HostPlayerID != playerID {
+		for _, p := range sess.Players {
+			p.Notes = ""
+		}
+	}
+
 	state := &SessionState{Session: sess, Revealed: sess.Phase == PhaseReveal}
-	if state.Quiz, err = s.lobbyQuiz(ctx, sess); err != nil {
+	var plan questionPlan
+	if state.Quiz, plan, err = s.lobbyQuiz(ctx, sess); err != nil {
 		return nil, err
 	}
+	if sess.HostPlayerID == playerID {
+		state.Upcoming = plan.remaining(sess)
+	}
+	state.MaxPlayers = s.roomCapacity(state.Quiz)
+	state.RoomFull = state.MaxPlayers > 0 && len(sess.Players) >= state.MaxPlayers
 
 	if err = s.populateInGame(ctx, state); err != nil {
 		return nil, err
@@ -1061,21 +1253,27 @@ func (s *Service) Leave(ctx context.Context, joinCode string, playerID int64) er
 	return nil
 }
 
-// lobbyQuiz loads the room's quiz for the session state, or (nil, nil) for an empty
-// room (no quiz picked yet, #836): the lobby renders the staging state and the
-// in-game / standings / round-intro populators are all no-ops in that phase.
-//
-//nolint:nilnil // (nil, nil) is the deliberate "no quiz yet" result for an empty room.
-func (s *Service) lobbyQuiz(ctx context.Context, sess *Session) (*quiz.Quiz, error) {
+// lobbyQuiz loads the room's quiz for the session state, or a nil quiz for an
+// empty room (no quiz picked yet, #836): the lobby renders the staging state
+// and the in-game / standings / round-intro populators are all no-ops in that
+// phase. The quiz's questions come back as the current game plays them, with
+// the host's reorders and skips applied, so question numbering follows the
+// plan; the plan itself is returned for the host's upcoming list.
+func (s *Service) lobbyQuiz(ctx context.Context, sess *Session) (*quiz.Quiz, questionPlan, error) {
 	if sess.QuizID == nil {
-		return nil, nil
+		return nil, questionPlan{}, nil
 	}
 	qz, err := s.quizzes.GetQuiz(ctx, *sess.QuizID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get quiz for session state: %w", err)
+		return nil, questionPlan{}, fmt.Errorf("failed to get quiz for session state: %w", err)
+	}
+	plan, err := gamePlan(ctx, s.store, sess, qz)
+	if err != nil {
+		return nil, questionPlan{}, err
 	}
+	qz.Questions = plan.questions()
 
-	return qz, nil
+	return qz, plan, nil
 }
 
 // currentQuizQuestion loads the quiz question the session is currently
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"log/slog"
 	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -23,6 +25,10 @@ var joinCodePattern = regexp.MustCompile(`^[ABCDEFGHJKMNPQRSTUVWXYZ23456789]{6}$
 // CreateSession quiz argument and Session.QuizID in tests.
 func quizIDPtr(id int64) *int64 { return &id }
 
+// idPtr returns a pointer to a round or question id, for the runner columns
+// (Session.CurrentRoundID / CurrentQuestionID) in tests.
+func idPtr(id int64) *int64 { return &id }
+
 func TestGenerateJoinCode_Shape(t *testing.T) {
 	t.Parallel()
 
@@ -83,11 +89,19 @@ type fakeStore struct {
 	// assert a roster row was (or was not) written.
 	addedPlayerIDs []int64
 
+	// rosterIDs are the players HasJoined reports as already on the roster,
+	// and lateJoins records each RecordLateJoin as player id -> missed count.
+	rosterIDs []int64
+	lateJoins map[int64]int
+
 	setReadyErr error
 
 	// markLeftErr is what MarkPlayerLeft reports, so a test can drive the
 	// not-a-participant branch of Leave without a real roster row.
 	markLeftErr error
+
+	// notes records each SetPlayerNotes as player id -> notes.
+	notes map[int64]string
 }
 
 func (*fakeStore) Ping(context.Context) error { return nil }
@@ -141,10 +155,41 @@ func (f *fakeStore) AddPlayer(_ context.Context, _ string, playerID int64) (*Pla
 	return &Player{PlayerID: playerID}, nil
 }
 
+func (f *fakeStore) HasJoined(_ context.Context, _ string, playerID int64) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Contains(f.rosterIDs, playerID), nil
+}
+
+func (f *fakeStore) RecordLateJoin(_ context.Context, _ string, playerID, _ int64, missed int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.lateJoins == nil {
+		f.lateJoins = make(map[int64]int)
+	}
+	f.lateJoins[playerID] = missed
+
+	return nil
+}
+
 func (f *fakeStore) SetReady(context.Context, string, int64, bool) error {
 	return f.setReadyErr
 }
 
+func (f *fakeStore) SetPlayerNotes(_ context.Context, _, _ string, _, playerID int64, notes string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.notes == nil {
+		f.notes = make(map[int64]string)
+	}
+	f.notes[playerID] = notes
+
+	return nil
+}
+
 // The runner-facing Store methods below are exercised by the runner's
 // integration tests against a real DB; this fault-injection double only
 // covers the lobby service paths, so they return ErrUnsupported to fail
@@ -173,6 +218,26 @@ func (*fakeStore) CancelStart(context.Context, string) error {
 	return errors.ErrUnsupported
 }
 
+func (*fakeStore) ExtendQuestion(context.Context, string, int64, time.Time) error {
+	return errors.ErrUnsupported
+}
+
+func (*fakeStore) CloseAnswers(context.Context, string, int64, time.Time) error {
+	return errors.ErrUnsupported
+}
+
+func (*fakeStore) SetHostAdvance(context.Context, string, bool) error { return errors.ErrUnsupported }
+
+func (*fakeStore) ListQuestionPlan(context.Context, string, int64) ([]*PlanChange, error) {
+	return nil, nil
+}
+
+func (*fakeStore) ReorderQuestions(context.Context, *Session, []int64) error {
+	return errors.ErrUnsupported
+}
+
+func (*fakeStore) SkipQuestion(context.Context, *Session, int64) error { return errors.ErrUnsupported }
+
 func (*fakeStore) EnterRoundIntro(context.Context, string, Phase, int64) (bool, error) {
 	return false, errors.ErrUnsupported
 }
@@ -230,7 +295,7 @@ func (*fakeStore) ListAnswers(context.Context, string, int64) ([]*SessionAnswer,
 	return nil, errors.ErrUnsupported
 }
 
-func (*fakeStore) SetAnswerScore(context.Context, string, int64, int64, int) error {
+func (*fakeStore) SetAnswerScore(context.Context, string, int64, int64, int, time.Time, time.Time) error {
 	return errors.ErrUnsupported
 }
 
@@ -343,7 +408,7 @@ func TestService_Join_AllowsLatecomerMidGame(t *testing.T) {
 	store := &fakeStore{
 		session: &Session{ID: "s1", QuizID: quizIDPtr(7), JoinCode: "ROOM12", Phase: PhaseQuestion},
 	}
-	svc := NewService(store, &fakeQuiz{}, slog.Default())
+	svc := NewService(store, &fakeQuiz{quiz: &quiz.Quiz{ID: 7}}, slog.Default())
 	svc.SetPublisher(&spyPublisher{})
 
 	if _, err := svc.Join(t.Context(), "ROOM12", 5); err != nil {
@@ -354,6 +419,165 @@ func TestService_Join_AllowsLatecomerMidGame(t *testing.T) {
 	}
 }
 
+// lateJoinQuiz is a two-round live quiz of two questions each, for the
+// late-join tests: questions 1 and 2 play in round 10, 3 and 4 in round 20.
+func lateJoinQuiz(lateJoin string, deadlineSeconds int) *quiz.Quiz {
+	return &quiz.Quiz{
+		ID:                  7,
+		LateJoin:            lateJoin,
+		JoinDeadlineSeconds: deadlineSeconds,
+		Questions: []*quiz.Question{
+			{ID: 1, RoundID: 10, Position: 1},
+			{ID: 2, RoundID: 10, Position: 2},
+			{ID: 3, RoundID: 20, Position: 3},
+			{ID: 4, RoundID: 20, Position: 4},
+		},
+	}
+}
+
+// TestService_Join_RecordsMissedQuestions pins what a latecomer is marked
+// with: the questions whose window closed before they joined. A question in
+// reveal has closed; one still open has not, since they can still answer it.
+func TestService_Join_RecordsMissedQuestions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		phase    Phase
+		round    *int64
+		question *int64
+		want     int
+	}{
+		{name: "first round intro", phase: PhaseRoundIntro, round: idPtr(10), want: 0},
+		{name: "first question open", phase: PhaseQuestion, round: idPtr(10), question: idPtr(1), want: 0},
+		{name: "second question in reveal", phase: PhaseReveal, round: idPtr(10), question: idPtr(2), want: 2},
+		{name: "first round results", phase: PhaseRoundResults, round: idPtr(10), want: 2},
+		{name: "last question open", phase: PhaseQuestion, round: idPtr(20), question: idPtr(4), want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &fakeStore{session: &Session{
+				ID: "s1", QuizID: quizIDPtr(7), JoinCode: "ROOM12", Phase: tt.phase, GameSeq: 1,
+				CurrentRoundID: tt.round, CurrentQuestionID: tt.question,
+			}}
+			svc := NewService(store, &fakeQuiz{quiz: lateJoinQuiz(quiz.LateJoinZero, 0)}, slog.Default())
+
+			if _, err := svc.Join(t.Context(), "ROOM12", 5); err != nil {
+				t.Fatalf("Join err = %v, want nil", err)
+			}
+			got, ok := store.lateJoins[5]
+			if !ok {
+				t.Fatal("Join recorded no late join, want one")
+			}
+			if got != tt.want {
+				t.Errorf("missed questions = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestService_Join_LateJoinPolicy pins the gate: a closed policy or a passed
+// join deadline turns a new player away from a game under way before the
+// roster write, while a player already on the roster (a reconnect) and a join
+// in the lobby always get in.
+func TestService_Join_LateJoinPolicy(t *testing.T) {
+	t.Parallel()
+
+	startedLongAgo := time.Now().Add(-10 * time.Minute)
+	startedJustNow := time.Now()
+	tests := []struct {
+		name      string
+		qz        *quiz.Quiz
+		phase     Phase
+		startedAt *time.Time
+		rostered  bool
+		wantErr   error
+		wantLate  bool
+	}{
+		{name: "closed policy", qz: lateJoinQuiz(quiz.LateJoinClosed, 0), phase: PhaseQuestion, wantErr: ErrJoinClosed},
+		{
+			name: "deadline passed", qz: lateJoinQuiz(quiz.LateJoinSkip, 60), phase: PhaseQuestion,
+			startedAt: &startedLongAgo, wantErr: ErrJoinClosed,
+		},
+		{
+			name: "within the deadline", qz: lateJoinQuiz(quiz.LateJoinSkip, 60), phase: PhaseQuestion,
+			startedAt: &startedJustNow, wantLate: true,
+		},
+		{name: "reconnect under a closed policy", qz: lateJoinQuiz(quiz.LateJoinClosed, 0), phase: PhaseQuestion, rostered: true},
+		{name: "lobby under a closed policy", qz: lateJoinQuiz(quiz.LateJoinClosed, 0), phase: PhaseLobby},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &fakeStore{session: &Session{
+				ID: "s1", QuizID: quizIDPtr(7), JoinCode: "ROOM12", Phase: tt.phase, GameSeq: 1,
+				CurrentRoundID: idPtr(10), CurrentQuestionID: idPtr(1), StartedAt: tt.startedAt,
+			}}
+			if tt.rostered {
+				store.rosterIDs = []int64{5}
+			}
+			svc := NewService(store, &fakeQuiz{quiz: tt.qz}, slog.Default())
+
+			_, err := svc.Join(t.Context(), "ROOM12", 5)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Join err = %v, want %v", err, tt.wantErr)
+			}
+			if wantAdded := tt.wantErr == nil; (len(store.addedPlayerIDs) == 1) != wantAdded {
+				t.Errorf("addedPlayerIDs = %v, want a roster write: %t", store.addedPlayerIDs, wantAdded)
+			}
+			if _, late := store.lateJoins[5]; late != tt.wantLate {
+				t.Errorf("late join recorded = %t, want %t", late, tt.wantLate)
+			}
+		})
+	}
+}
+
+// TestService_Join_RoomCapacity pins the capacity gate: once the active roster
+// holds the room's cap a new player gets ErrGameFull before the roster write,
+// a player already on the roster still gets back in, and the quiz's own
+// MaxPlayers wins over the service default.
+func TestService_Join_RoomCapacity(t *testing.T) {
+	t.Parallel()
+
+	roster := []*Player{{PlayerID: 1}, {PlayerID: 2}}
+	tests := []struct {
+		name       string
+		quizMax    int
+		serviceMax int
+		playerID   int64
+		wantErr    error
+	}{
+		{name: "no cap", playerID: 5},
+		{name: "service default full", serviceMax: 2, playerID: 5, wantErr: ErrGameFull},
+		{name: "service default with room", serviceMax: 3, playerID: 5},
+		{name: "quiz cap overrides default", quizMax: 2, serviceMax: 10, playerID: 5, wantErr: ErrGameFull},
+		{name: "quiz cap raises default", quizMax: 3, serviceMax: 2, playerID: 5},
+		{name: "reconnect into a full room", serviceMax: 2, playerID: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &fakeStore{session: &Session{
+				ID: "s1", QuizID: quizIDPtr(7), JoinCode: "ROOM12", Phase: PhaseLobby, Players: roster,
+			}}
+			svc := NewService(store, &fakeQuiz{quiz: &quiz.Quiz{ID: 7, MaxPlayers: tt.quizMax}}, slog.Default())
+			svc.SetMaxPlayers(tt.serviceMax)
+
+			_, err := svc.Join(t.Context(), "ROOM12", tt.playerID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Join err = %v, want %v", err, tt.wantErr)
+			}
+			if wantAdded := tt.wantErr == nil; (len(store.addedPlayerIDs) == 1) != wantAdded {
+				t.Errorf("addedPlayerIDs = %v, want a roster write: %t", store.addedPlayerIDs, wantAdded)
+			}
+		})
+	}
+}
+
 // TestService_Join_RejectsFinishedRoom pins that the only closed state is the
 // terminal finished room (#836): a Join attempt there returns ErrLobbyClosed
 // before touching the roster.
@@ -494,6 +718,53 @@ func TestService_Leave_NotParticipant(t *testing.T) {
 	}
 }
 
+func TestService_SetPlayerNotes(t *testing.T) {
+	t.Parallel()
+
+	const hostID, playerID = 1, 5
+	for _, tc := range []struct {
+		name      string
+		caller    int64
+		notes     string
+		want      error
+		wantNotes string
+	}{
+		{"host, trimmed", hostID, "  table 4, name pending ", nil, "table 4, name pending"},
+		{"host clears", hostID, " ", nil, ""},
+		{"non-host", playerID, "mine now", ErrNotHost, ""},
+		{"too long", hostID, strings.Repeat("é", MaxPlayerNotesLength+1), ErrNotesTooLong, ""},
+		{"at the limit", hostID, strings.Repeat("é", MaxPlayerNotesLength), nil, strings.Repeat("é", MaxPlayerNotesLength)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &fakeStore{session: &Session{
+				ID: "s1", JoinCode: "ROOM12", Phase: PhaseLobby, HostPlayerID: hostID,
+			}}
+			spy := &spyPublisher{}
+			svc := NewService(store, &fakeQuiz{}, slog.Default())
+			svc.SetPublisher(spy)
+
+			err := svc.SetPlayerNotes(t.Context(), "room12", tc.caller, playerID, tc.notes)
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("SetPlayerNotes err = %v, want %v", err, tc.want)
+			}
+			notes, stored := store.notes[playerID]
+			if got, want := stored, tc.want == nil; got != want {
+				t.Fatalf("notes stored = %v, want %v", got, want)
+			}
+			if got, want := notes, tc.wantNotes; got != want {
+				t.Errorf("stored notes = %q, want %q", got, want)
+			}
+			spy.mu.Lock()
+			defer spy.mu.Unlock()
+			if got, want := len(spy.codes), len(store.notes); got != want {
+				t.Errorf("publish count = %d, want %d (only a saved note publishes)", got, want)
+			}
+		})
+	}
+}
+
 func TestService_Leave_SessionNotFound(t *testing.T) {
 	t.Parallel()
 
@@ -640,6 +911,81 @@ func TestService_SubmitAnswer_RespectsWindowBounds(t *testing.T) {
 	}
 }
 
+// TestService_ExtendQuestion_MovesDeadline drives the host "extend" control:
+// the open question's deadline moves for everyone, so an answer after the
+// original deadline is accepted and the runner does not close the question at
+// the original deadline. Non-hosts, out-of-range extensions, and a stale
+// question id are rejected without touching the deadline.
+func TestService_ExtendQuestion_MovesDeadline(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.June, 5, 12, 0, 0, 0, time.UTC)
+	h := newRunnerHarness(t, start, [][]bool{{true}})
+	ctx := t.Context()
+
+	if err := h.service.Start(ctx, h.code, 1); err != nil {
+		t.Fatalf("Start err = %v, want nil", err)
+	}
+	h.clock.advance(runnerCfg.RoundIntroBeat)
+	h.tick(ctx)
+	q := h.reload(t)
+	if got, want := q.Phase, PhaseQuestion; got != want {
+		t.Fatalf("phase after intro beat = %q, want %q", got, want)
+	}
+	questionID, expiresAt := *q.CurrentQuestionID, *q.QuestionExpiresAt
+	now := h.clock.Now()
+
+	for _, tc := range []struct {
+		name       string
+		host       int64
+		questionID int64
+		by         time.Duration
+		want       error
+	}{
+		{"non-host", h.players[0], questionID, 30 * time.Second, ErrNotHost},
+		{"zero extension", 1, questionID, 0, ErrInvalidExtension},
+		{"over the cap", 1, questionID, MaxQuestionExtension + time.Second, ErrInvalidExtension},
+		{"stale question id", 1, questionID + 1000, 30 * time.Second, ErrQuestionNotOpen},
+	} {
+		if _, err := h.service.ExtendQuestion(ctx, h.code, tc.host, tc.questionID, tc.by, now); !errors.Is(err, tc.want) {
+			t.Errorf("ExtendQuestion(%s) err = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+	if got := *h.reload(t).QuestionExpiresAt; !got.Equal(expiresAt) {
+		t.Fatalf("deadline after rejected extends = %v, want %v", got, expiresAt)
+	}
+
+	got, err := h.service.ExtendQuestion(ctx, h.code, 1, questionID, 30*time.Second, now)
+	if err != nil {
+		t.Fatalf("ExtendQuestion err = %v, want nil", err)
+	}
+	if want := expiresAt.Add(30 * time.Second); !got.Equal(want) {
+		t.Errorf("ExtendQuestion deadline = %v, want %v", got, want)
+	}
+	if got, want := *h.reload(t).QuestionExpiresAt, expiresAt.Add(30*time.Second); !got.Equal(want) {
+		t.Errorf("stored deadline = %v, want %v", got, want)
+	}
+
+	afterOriginal := expiresAt.Add(time.Second)
+	optRight := correctOptionID(ctx, t, h.service, h.code, h.players[0])
+	if err = h.service.SubmitAnswer(ctx, h.code, h.players[0], optRight, afterOriginal); err != nil {
+		t.Errorf("SubmitAnswer after the original deadline err = %v, want nil", err)
+	}
+	h.clock.advance(afterOriginal.Sub(h.clock.Now()))
+	h.tick(ctx)
+	if got, want := h.reload(t).Phase, PhaseQuestion; got != want {
+		t.Errorf("phase after the original deadline = %q, want %q", got, want)
+	}
+
+	h.clock.advance(30 * time.Second)
+	h.tick(ctx)
+	if _, err = h.service.ExtendQuestion(ctx, h.code, 1, questionID, 30*time.Second, h.clock.Now()); !errors.Is(
+		err, ErrQuestionNotOpen,
+	) {
+		t.Errorf("ExtendQuestion after close err = %v, want %v", err, ErrQuestionNotOpen)
+	}
+}
+
 // TestService_GetSessionState_QuestionPhaseWithoutQuiz pins the nil-quiz guard
 // in populateInGame (#1122): a quiz-less room that somehow sits in the question
 // phase (an unusual re-arm-race state) must read cleanly rather than
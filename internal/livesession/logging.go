@@ -24,6 +24,9 @@ const (
 	logReadyKey    = "ready"
 	logDeadlineKey = "deadline"
 	logReasonKey   = "reason"
+	logLateJoinKey = "lateJoin"
+	logCapacityKey = "capacity"
+	logPacingKey   = "hostAdvance"
 )
 
 // logNonHostAttempt logs an Info line for a non-host caller trying a
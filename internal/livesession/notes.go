@@ -0,0 +1,59 @@
+package livesession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxPlayerNotesLength caps the host's notes on one participant, in
+// characters. A note is a jotting ("table 4, name pending"), not a document.
+const MaxPlayerNotesLength = 200
+
+// ErrNotesTooLong is returned by [Service.SetPlayerNotes] when the notes run
+// past [MaxPlayerNotesLength]. Handlers map it to 400.
+var ErrNotesTooLong = errors.New("player notes are too long")
+
+// SetPlayerNotes replaces the host's notes on a participant of the session
+// identified by join code. Host-gated like [Service.Start]: returns
+// [ErrNotHost] when the caller is not the session's host,
+// [ErrSessionNotFound] for an unknown code, and [ErrNotParticipant] when the
+// player never joined. A player who has since left keeps their roster row,
+// so their notes can still be corrected for the results export. The notes
+// are trimmed; empty clears them. Every change lands in the admin audit log
+// against the participant.
+func (s *Service) SetPlayerNotes(
+	ctx context.Context, joinCode string, hostPlayerID, playerID int64, notes string,
+) error {
+	notes = strings.TrimSpace(notes)
+	if utf8.RuneCountInString(notes) > MaxPlayerNotesLength {
+		return ErrNotesTooLong
+	}
+
+	sess, err := s.store.GetSessionByJoinCode(ctx, normalizeJoinCode(joinCode))
+	if err != nil {
+		return fmt.Errorf(errGetSessionByCodeFmt, err)
+	}
+	if sess.HostPlayerID != hostPlayerID {
+		s.logNonHostAttempt(ctx, "setPlayerNotes", sess.JoinCode, hostPlayerID)
+
+		return ErrNotHost
+	}
+
+	if err = s.store.SetPlayerNotes(ctx, sess.ID, sess.JoinCode, hostPlayerID, playerID, notes); err != nil {
+		return fmt.Errorf("failed to set player notes: %w", err)
+	}
+
+	// The host's roster read carries the notes, so signal a re-GET.
+	s.publish(sess.JoinCode, sess.Phase)
+
+	s.logger.InfoContext(ctx, "host set player notes",
+		slog.String(logJoinCodeKey, sess.JoinCode),
+		slog.Int64(logHostKey, hostPlayerID),
+		slog.Int64(logPlayerKey, playerID))
+
+	return nil
+}
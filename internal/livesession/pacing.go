@@ -0,0 +1,156 @@
+package livesession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// ErrNothingToAdvance is returned by [Service.Advance] when the host asks to
+// move on from a screen that has no "next": anything but the round intro, the
+// reveal or the round results.
+var ErrNothingToAdvance = errors.New("session is not waiting to advance")
+
+// ErrSessionMoved is returned by [Service.Advance] when the room is no longer
+// on the screen the host advanced from: another host device, or the beat,
+// moved it on first. Acting anyway would skip a screen nobody saw.
+var ErrSessionMoved = errors.New("session has moved on")
+
+// advanceTimeout bounds the detached transition [Service.Advance] and
+// [Service.CloseAnswers] hand to the runner, for the same reason as
+// [beginTimeout].
+const advanceTimeout = 10 * time.Second
+
+// SetHostAdvance is the host "pacing" control: with on, the runner holds the
+// room on the round intro, the reveal and the round results until the host
+// calls [Service.Advance], instead of moving on after each beat. Questions
+// still close on their own when the window runs out or everyone has answered;
+// [Service.CloseAnswers] closes one early. The setting belongs to the room,
+// so it carries over to the next game. Errors: [ErrSessionNotFound] (also for
+// a closed room) and [ErrNotHost].
+func (s *Service) SetHostAdvance(ctx context.Context, joinCode string, hostPlayerID int64, on bool) error {
+	sess, err := s.hostSession(ctx, joinCode, hostPlayerID, "setHostAdvance")
+	if err != nil {
+		return err
+	}
+	if err = s.store.SetHostAdvance(ctx, sess.ID, on); err != nil {
+		return fmt.Errorf("failed to set session host advance: %w", err)
+	}
+
+	s.publish(sess.JoinCode, sess.Phase)
+
+	s.logger.InfoContext(ctx, "live session pacing changed",
+		slog.String(logJoinCodeKey, sess.JoinCode),
+		slog.Int64(logHostKey, hostPlayerID),
+		slog.Bool(logPacingKey, on))
+
+	return nil
+}
+
+// Advance is the host "next" control: it moves the room on from the round
+// intro to the round's first question, from the reveal to the next question
+// (or the round results, or the end of the game), and from the round results
+// to the next round, without waiting for the beat. It works whether or not
+// the room is under host pacing. expected and questionID name the screen the
+// host is looking at (questionID is the revealed question, 0 on the round
+// screens), so a second press from another device cannot move the room on
+// twice. Errors: [ErrSessionNotFound], [ErrNotHost], [ErrNothingToAdvance]
+// when expected has no "next", and [ErrSessionMoved] when the room is no
+// longer on that screen.
+func (s *Service) Advance(
+	ctx context.Context, joinCode string, hostPlayerID int64, expected Phase, questionID int64,
+) error {
+	sess, err := s.hostSession(ctx, joinCode, hostPlayerID, "advance")
+	if err != nil {
+		return err
+	}
+	switch expected {
+	case PhaseRoundIntro, PhaseReveal, PhaseRoundResults:
+	default:
+		return ErrNothingToAdvance
+	}
+	var current int64
+	if sess.CurrentQuestionID != nil {
+		current = *sess.CurrentQuestionID
+	}
+	if sess.Phase != expected || current != questionID {
+		s.logger.InfoContext(ctx, "live session advance rejected: moved on",
+			slog.String(logJoinCodeKey, sess.JoinCode),
+			slog.Int64(logHostKey, hostPlayerID),
+			slog.String(logPhaseKey, string(sess.Phase)),
+			slog.String("expectedPhase", string(expected)))
+
+		return ErrSessionMoved
+	}
+
+	s.logger.InfoContext(ctx, "live session advanced by host",
+		slog.String(logJoinCodeKey, sess.JoinCode),
+		slog.Int64(logHostKey, hostPlayerID),
+		slog.String(logPhaseKey, string(sess.Phase)))
+
+	s.next(ctx, sess)
+
+	return nil
+}
+
+// CloseAnswers is the host "close answering" control: it stops answers to the
+// open question now for every participant, and the runner scores the picks and
+// reveals the answer. The answer deadline is left alone, so each pick scores
+// exactly as it would have had the window run out. Errors:
+// [ErrSessionNotFound], [ErrNotHost], and [ErrQuestionNotOpen] when no
+// question is open for answers.
+func (s *Service) CloseAnswers(ctx context.Context, joinCode string, hostPlayerID int64, now time.Time) error {
+	sess, err := s.hostSession(ctx, joinCode, hostPlayerID, "closeAnswers")
+	if err != nil {
+		return err
+	}
+	if sess.Phase != PhaseQuestion || sess.CurrentQuestionID == nil || sess.AnswersClosedAt != nil ||
+		sess.QuestionExpiresAt == nil || !now.Before(*sess.QuestionExpiresAt) {
+		return ErrQuestionNotOpen
+	}
+
+	if err = s.store.CloseAnswers(ctx, sess.ID, *sess.CurrentQuestionID, now); err != nil {
+		return fmt.Errorf("failed to close session question: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "live session answers closed by host",
+		slog.String(logJoinCodeKey, sess.JoinCode),
+		slog.Int64(logHostKey, hostPlayerID),
+		slog.Int64(logQuestionKey, *sess.CurrentQuestionID))
+
+	s.next(ctx, sess)
+
+	return nil
+}
+
+// hostSession loads the session behind joinCode for a host control, action
+// naming it in the non-host log line.
+func (s *Service) hostSession(
+	ctx context.Context, joinCode string, hostPlayerID int64, action string,
+) (*Session, error) {
+	sess, err := s.store.GetSessionByJoinCode(ctx, normalizeJoinCode(joinCode))
+	if err != nil {
+		return nil, fmt.Errorf(errGetSessionByCodeFmt, err)
+	}
+	if sess.HostPlayerID != hostPlayerID {
+		s.logNonHostAttempt(ctx, action, sess.JoinCode, hostPlayerID)
+
+		return nil, ErrNotHost
+	}
+
+	return sess, nil
+}
+
+// next hands the room to the runner to make its next transition at once,
+// detached from the request so a host disconnect cannot abandon it midway.
+// The runner's next tick is the backstop if this fails.
+func (s *Service) next(ctx context.Context, sess *Session) {
+	if s.advancer == nil {
+		return
+	}
+	nextCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), advanceTimeout)
+	defer cancel()
+	s.advancer.Next(nextCtx, sess.ID, sess.Phase)
+}
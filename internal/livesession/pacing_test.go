@@ -0,0 +1,174 @@
+package livesession_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/livesession"
+)
+
+// TestService_HostPacing drives a game whose first round has two questions
+// under host pacing: the beats no longer move the room on, the host's next
+// does (once, however often it is pressed), the host's close ends a question
+// early, only the host may use the controls, and handing the pacing back lets
+// the beats run again.
+func TestService_HostPacing(t *testing.T) {
+	t.Parallel()
+
+	const hostID int64 = 1
+
+	start := time.Date(2026, time.September, 1, 12, 0, 0, 0, time.UTC)
+	h := newRunnerHarness(t, start, [][]bool{{true, true}, {true}})
+	ctx := t.Context()
+
+	if err := h.service.SetHostAdvance(ctx, h.code, h.players[0], true); !errors.Is(err, ErrNotHost) {
+		t.Errorf("SetHostAdvance by a player err = %v, want %v", err, ErrNotHost)
+	}
+	if err := h.service.SetHostAdvance(ctx, h.code, hostID, true); err != nil {
+		t.Fatalf("SetHostAdvance err = %v, want nil", err)
+	}
+	if !h.reload(t).HostAdvance {
+		t.Fatal("HostAdvance = false after SetHostAdvance(true)")
+	}
+	if err := h.service.Advance(ctx, h.code, hostID, PhaseLobby, 0); !errors.Is(err, ErrNothingToAdvance) {
+		t.Errorf("Advance in the lobby err = %v, want %v", err, ErrNothingToAdvance)
+	}
+
+	if err := h.service.Start(ctx, h.code, hostID); err != nil {
+		t.Fatalf("Start err = %v, want nil", err)
+	}
+	h.clock.advance(10 * runnerCfg.RoundIntroBeat)
+	h.tick(ctx)
+	if got, want := h.phase(t), PhaseRoundIntro; got != want {
+		t.Fatalf("phase long after the intro beat = %q, want %q (held)", got, want)
+	}
+	if err := h.service.Advance(ctx, h.code, h.players[0], PhaseRoundIntro, 0); !errors.Is(err, ErrNotHost) {
+		t.Errorf("Advance by a player err = %v, want %v", err, ErrNotHost)
+	}
+	if err := h.service.Advance(ctx, h.code, hostID, PhaseRoundIntro, 0); err != nil {
+		t.Fatalf("Advance from the intro err = %v, want nil", err)
+	}
+	first := h.reload(t)
+	if first.Phase != PhaseQuestion || first.CurrentQuestionID == nil {
+		t.Fatalf("after Advance: phase %q, question %v, want a question", first.Phase, first.CurrentQuestionID)
+	}
+	if err := h.service.Advance(ctx, h.code, hostID, PhaseRoundIntro, 0); !errors.Is(err, ErrSessionMoved) {
+		t.Errorf("second Advance from the intro err = %v, want %v", err, ErrSessionMoved)
+	}
+	if got := h.reload(t).CurrentQuestionID; got == nil || *got != *first.CurrentQuestionID {
+		t.Fatalf("question after a second Advance = %v, want %d (unchanged)", got, *first.CurrentQuestionID)
+	}
+	err := h.service.Advance(ctx, h.code, hostID, PhaseQuestion, *first.CurrentQuestionID)
+	if !errors.Is(err, ErrNothingToAdvance) {
+		t.Errorf("Advance with a question open err = %v, want %v", err, ErrNothingToAdvance)
+	}
+
+	// Past the read beat, well inside the answer window.
+	h.clock.advance(runnerCfg.QuestionReadBeat + time.Second)
+	if err := h.service.CloseAnswers(ctx, h.code, h.players[0], h.clock.Now()); !errors.Is(err, ErrNotHost) {
+		t.Errorf("CloseAnswers by a player err = %v, want %v", err, ErrNotHost)
+	}
+	if err := h.service.CloseAnswers(ctx, h.code, hostID, h.clock.Now()); err != nil {
+		t.Fatalf("CloseAnswers err = %v, want nil", err)
+	}
+	if got, want := h.phase(t), PhaseReveal; got != want {
+		t.Fatalf("phase after CloseAnswers = %q, want %q", got, want)
+	}
+	if err := h.service.CloseAnswers(ctx, h.code, hostID, h.clock.Now()); !errors.Is(err, ErrQuestionNotOpen) {
+		t.Errorf("CloseAnswers twice err = %v, want %v", err, ErrQuestionNotOpen)
+	}
+
+	h.clock.advance(10 * runnerCfg.RevealBeat)
+	h.tick(ctx)
+	if got, want := h.phase(t), PhaseReveal; got != want {
+		t.Fatalf("phase long after the reveal beat = %q, want %q (held)", got, want)
+	}
+	if err := h.service.Advance(ctx, h.code, hostID, PhaseReveal, *first.CurrentQuestionID); err != nil {
+		t.Fatalf("Advance from the reveal err = %v, want nil", err)
+	}
+	second := h.reload(t)
+	if second.Phase != PhaseQuestion || *second.CurrentQuestionID == *first.CurrentQuestionID {
+		t.Fatalf("after Advance: phase %q, question %v, want the next question", second.Phase, second.CurrentQuestionID)
+	}
+
+	if err := h.service.SetHostAdvance(ctx, h.code, hostID, false); err != nil {
+		t.Fatalf("SetHostAdvance(false) err = %v, want nil", err)
+	}
+	h.clock.advance(11 * time.Second)
+	h.tick(ctx)
+	h.clock.advance(runnerCfg.RevealBeat)
+	h.tick(ctx)
+	if got, want := h.phase(t), PhaseRoundResults; got != want {
+		t.Errorf("phase after the reveal beat with the pacing handed back = %q, want %q", got, want)
+	}
+}
+
+// TestRunner_CloseAnswersKeepsScore pins that the host closing answering early
+// only stops new answers: a pick made before the close scores the same as the
+// identical pick in a game where the window ran out on its own.
+func TestRunner_CloseAnswersKeepsScore(t *testing.T) {
+	t.Parallel()
+
+	const hostID int64 = 1
+
+	start := time.Date(2026, time.September, 2, 12, 0, 0, 0, time.UTC)
+	scores := make(map[bool]int, 2)
+	for _, closeEarly := range []bool{false, true} {
+		h := newRunnerHarness(t, start, [][]bool{{true}})
+		ctx := t.Context()
+
+		if err := h.service.Start(ctx, h.code, hostID); err != nil {
+			t.Fatalf("Start err = %v, want nil", err)
+		}
+		h.clock.advance(runnerCfg.RoundIntroBeat)
+		h.tick(ctx)
+		question := h.reload(t)
+		if question.Phase != PhaseQuestion {
+			t.Fatalf("phase after the intro beat = %q, want %q", question.Phase, PhaseQuestion)
+		}
+
+		h.clock.advance(runnerCfg.QuestionReadBeat + 2*time.Second)
+		answeredAt := h.clock.Now()
+		optRight := correctOptionID(ctx, t, h.service, h.code, h.players[0])
+		if err := h.service.SubmitAnswer(ctx, h.code, h.players[0], optRight, answeredAt); err != nil {
+			t.Fatalf("SubmitAnswer err = %v, want nil", err)
+		}
+
+		h.clock.advance(time.Second)
+		if closeEarly {
+			if err := h.service.CloseAnswers(ctx, h.code, hostID, h.clock.Now()); err != nil {
+				t.Fatalf("CloseAnswers err = %v, want nil", err)
+			}
+			err := h.service.SubmitAnswer(ctx, h.code, h.players[1], optRight, h.clock.Now())
+			if !errors.Is(err, ErrQuestionNotOpen) {
+				t.Errorf("SubmitAnswer after CloseAnswers err = %v, want %v", err, ErrQuestionNotOpen)
+			}
+		} else {
+			h.clock.advance(question.QuestionExpiresAt.Sub(h.clock.Now()))
+			h.tick(ctx)
+		}
+		if got, want := h.phase(t), PhaseReveal; got != want {
+			t.Fatalf("phase after the question closed (early %t) = %q, want %q", closeEarly, got, want)
+		}
+		if got := h.reload(t).QuestionExpiresAt; !got.Equal(*question.QuestionExpiresAt) {
+			t.Errorf("QuestionExpiresAt after the close (early %t) = %v, want %v", closeEarly, got,
+				question.QuestionExpiresAt)
+		}
+
+		state, err := h.service.GetSessionState(ctx, h.code, h.players[0])
+		if err != nil {
+			t.Fatalf("GetSessionState err = %v, want nil", err)
+		}
+		if len(state.Answers) != 1 || state.Answers[0].Score == nil {
+			t.Fatalf("reveal answers (early %t) = %+v, want one scored pick", closeEarly, state.Answers)
+		}
+		scores[closeEarly] = *state.Answers[0].Score
+		if got, want := scores[closeEarly], scoreAt(question, answeredAt); got != want {
+			t.Errorf("score (early %t) = %d, want %d", closeEarly, got, want)
+		}
+	}
+	if scores[true] != scores[false] {
+		t.Errorf("score with an early close = %d, want %d (as without)", scores[true], scores[false])
+	}
+}
@@ -0,0 +1,250 @@
+package livesession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+var (
+	// ErrInvalidQuestionOrder is returned by [Service.ReorderQuestions] when
+	// the new order is not exactly the game's remaining questions, each once.
+	// Handlers map it to 400.
+	ErrInvalidQuestionOrder = errors.New("order must list every remaining question once")
+
+	// ErrQuestionNotRemaining is returned by [Service.SkipQuestion] when the
+	// question is not among the game's remaining questions: it is open, has
+	// already been played or skipped, or is not on the quiz. Handlers map it
+	// to 409.
+	ErrQuestionNotRemaining = errors.New("question is not among the remaining questions")
+
+	// ErrPlanMoved is returned by [Store.ReorderQuestions] and
+	// [Store.SkipQuestion] when the runner moved the game on between the host
+	// loading the remaining questions and the change landing, so the change
+	// may name a question that is no longer remaining. Handlers map it to 409;
+	// the host re-reads the state and tries again.
+	ErrPlanMoved = errors.New("game moved on while its plan was being changed")
+)
+
+// PlanChange is one question the host reordered or skipped in a game (see
+// [Service.ReorderQuestions] and [Service.SkipQuestion]). Position ranks the
+// reordered questions of a round against each other, 0 for a question only
+// skipped; a skipped question is left out of the game whatever its position.
+type PlanChange struct {
+	QuestionID int64
+	Position   int
+	Skipped    bool
+}
+
+// SamePoint reports whether other is at the same point of the same game as s:
+// the game, phase, round and question all match. The store checks it before
+// applying a plan change, so a change made against one beat never lands on the
+// next.
+func (s *Session) SamePoint(other *Session) bool {
+	return s.GameSeq == other.GameSeq && s.Phase == other.Phase &&
+		equalID(s.CurrentRoundID, other.CurrentRoundID) && equalID(s.CurrentQuestionID, other.CurrentQuestionID)
+}
+
+func equalID(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// applyPlanChanges applies the host's changes to questions, taken in play
+// order: skipped questions drop out, and within each round the reordered
+// questions trade the places they hold by position. A reorder ranks its
+// questions past every earlier change, so the questions already played keep
+// their places.
+func applyPlanChanges(questions []*quiz.Question, changes []*PlanChange) []*quiz.Question {
+	if len(changes) == 0 {
+		return questions
+	}
+	rank := make(map[int64]int, len(changes))
+	skipped := make(map[int64]bool)
+	for _, c := range changes {
+		switch {
+		case c.Skipped:
+			skipped[c.QuestionID] = true
+		case c.Position > 0:
+			rank[c.QuestionID] = c.Position
+		}
+	}
+
+	kept := slices.DeleteFunc(questions, func(q *quiz.Question) bool { return skipped[q.ID] })
+	slots := make(map[int64][]int)
+	for i, q := range kept {
+		if _, ok := rank[q.ID]; ok {
+			slots[q.RoundID] = append(slots[q.RoundID], i)
+		}
+	}
+	for _, idx := range slots {
+		moved := make([]*quiz.Question, len(idx))
+		for i, at := range idx {
+			moved[i] = kept[at]
+		}
+		slices.SortStableFunc(moved, func(a, b *quiz.Question) int { return rank[a.ID] - rank[b.ID] })
+		for i, at := range idx {
+			kept[at] = moved[i]
+		}
+	}
+
+	return kept
+}
+
+// questions returns the plan's questions in play order.
+func (p questionPlan) questions() []*quiz.Question {
+	var out []*quiz.Question
+	for _, roundID := range p.rounds {
+		out = append(out, p.questionsByRnd[roundID]...)
+	}
+
+	return out
+}
+
+// remaining lists the questions still to be played after the session's
+// current beat, in play order: all of them in the lobby, the current round's
+// from its intro, those after the open or revealed question, and the later
+// rounds' after round_results. A game that has ended has none.
+func (p questionPlan) remaining(sess *Session) []*quiz.Question {
+	if sess.Phase == PhaseIntermission || sess.Phase == PhaseFinished {
+		return nil
+	}
+	var out []*quiz.Question
+	reached := sess.CurrentRoundID == nil
+	for _, roundID := range p.rounds {
+		questions := p.questionsByRnd[roundID]
+		if !reached {
+			if roundID != *sess.CurrentRoundID {
+				continue
+			}
+			reached = true
+			if sess.Phase == PhaseRoundResults {
+				continue
+			}
+			if sess.CurrentQuestionID != nil {
+				current := slices.IndexFunc(questions, func(q *quiz.Question) bool {
+					return q.ID == *sess.CurrentQuestionID
+				})
+				questions = questions[current+1:]
+			}
+		}
+		out = append(out, questions...)
+	}
+
+	return out
+}
+
+// gamePlan projects qz into the play plan of the session's current game, with
+// the host's reorders and skips applied.
+func gamePlan(ctx context.Context, store Store, sess *Session, qz *quiz.Quiz) (questionPlan, error) {
+	changes, err := store.ListQuestionPlan(ctx, sess.ID, sess.GameSeq)
+	if err != nil {
+		return questionPlan{}, fmt.Errorf("failed to list session question plan: %w", err)
+	}
+
+	return newQuestionPlan(qz, changes), nil
+}
+
+// ReorderQuestions is the host "reorder" control: it sets the play order of
+// the game's remaining questions (see [SessionState.Upcoming]) to
+// questionIDs, then publishes a tick so every surface re-reads the state.
+// Rounds keep their order and questions stay in their round, so questionIDs
+// orders each round's remaining questions among themselves. Errors:
+// [ErrSessionNotFound], [ErrNotHost], [ErrNoQuizToStart] for a room with no
+// quiz, [ErrInvalidQuestionOrder] when questionIDs is not exactly the
+// remaining questions, and [ErrPlanMoved] when the game moved on meanwhile.
+func (s *Service) ReorderQuestions(
+	ctx context.Context, joinCode string, hostPlayerID int64, questionIDs []int64,
+) error {
+	sess, plan, err := s.hostPlan(ctx, joinCode, hostPlayerID, "reorderQuestions")
+	if err != nil {
+		return err
+	}
+	remaining := plan.remaining(sess)
+	if len(remaining) == 0 || len(questionIDs) != len(remaining) {
+		return ErrInvalidQuestionOrder
+	}
+	listed := make(map[int64]bool, len(questionIDs))
+	for _, id := range questionIDs {
+		listed[id] = true
+	}
+	for _, q := range remaining {
+		if !listed[q.ID] {
+			return ErrInvalidQuestionOrder
+		}
+	}
+
+	if err = s.store.ReorderQuestions(ctx, sess, questionIDs); err != nil {
+		return fmt.Errorf("failed to reorder session questions: %w", err)
+	}
+
+	s.publish(sess.JoinCode, sess.Phase)
+
+	s.logger.InfoContext(ctx, "live session questions reordered",
+		slog.String(logJoinCodeKey, sess.JoinCode),
+		slog.Int64(logHostKey, hostPlayerID),
+		slog.Int("questions", len(questionIDs)))
+
+	return nil
+}
+
+// SkipQuestion is the host "skip" control: it drops one of the game's
+// remaining questions from the game, then publishes a tick so every surface
+// re-reads the state. A round left with nothing to ask is skipped whole, as in
+// [newQuestionPlan]. Errors: [ErrSessionNotFound], [ErrNotHost],
+// [ErrNoQuizToStart] for a room with no quiz, [ErrQuestionNotRemaining] when
+// the question is not among the remaining ones, and [ErrPlanMoved] when the
+// game moved on meanwhile.
+func (s *Service) SkipQuestion(ctx context.Context, joinCode string, hostPlayerID, questionID int64) error {
+	sess, plan, err := s.hostPlan(ctx, joinCode, hostPlayerID, "skipQuestion")
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(plan.remaining(sess), func(q *quiz.Question) bool { return q.ID == questionID }) {
+		return ErrQuestionNotRemaining
+	}
+
+	if err = s.store.SkipQuestion(ctx, sess, questionID); err != nil {
+		return fmt.Errorf("failed to skip session question: %w", err)
+	}
+
+	s.publish(sess.JoinCode, sess.Phase)
+
+	s.logger.InfoContext(ctx, "live session question skipped",
+		slog.String(logJoinCodeKey, sess.JoinCode),
+		slog.Int64(logHostKey, hostPlayerID),
+		slog.Int64(logQuestionKey, questionID))
+
+	return nil
+}
+
+// hostPlan loads the session behind joinCode and its current game's plan for
+// a host plan control, action naming it in the non-host log line.
+func (s *Service) hostPlan(
+	ctx context.Context, joinCode string, hostPlayerID int64, action string,
+) (*Session, questionPlan, error) {
+	sess, err := s.hostSession(ctx, joinCode, hostPlayerID, action)
+	if err != nil {
+		return nil, questionPlan{}, err
+	}
+	if sess.QuizID == nil {
+		return nil, questionPlan{}, ErrNoQuizToStart
+	}
+	qz, err := s.quizzes.GetQuiz(ctx, *sess.QuizID)
+	if err != nil {
+		return nil, questionPlan{}, fmt.Errorf("failed to get quiz for session plan: %w", err)
+	}
+	plan, err := gamePlan(ctx, s.store, sess, qz)
+	if err != nil {
+		return nil, questionPlan{}, err
+	}
+
+	return sess, plan, nil
+}
@@ -0,0 +1,145 @@
+package livesession_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/livesession"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// upcomingIDs reads the viewer's upcoming question ids off the session state.
+func upcomingIDs(t *testing.T, h *runnerHarness, viewer int64) []int64 {
+	t.Helper()
+	state, err := h.service.GetSessionState(t.Context(), h.code, viewer)
+	if err != nil {
+		t.Fatalf("GetSessionState err = %v, want nil", err)
+	}
+	ids := make([]int64, 0, len(state.Upcoming))
+	for _, q := range state.Upcoming {
+		ids = append(ids, q.ID)
+	}
+
+	return ids
+}
+
+// playQuestion drives the runner into the question phase and asserts it
+// issued want.
+func playQuestion(t *testing.T, h *runnerHarness, beat time.Duration, want int64) {
+	t.Helper()
+	h.clock.advance(beat)
+	h.tick(t.Context())
+	sess := h.reload(t)
+	if sess.Phase != PhaseQuestion || sess.CurrentQuestionID == nil || *sess.CurrentQuestionID != want {
+		t.Fatalf("after the beat: phase %q, question %v, want question %d", sess.Phase, sess.CurrentQuestionID, want)
+	}
+}
+
+// TestService_ReorderAndSkipQuestions drives a two-round game (three
+// questions, then two) through host reorders and skips: the host alone sees
+// the upcoming list, a bad order or an unknown question is refused, the runner
+// issues the questions in the new order and never the skipped ones, and a
+// round whose questions were all skipped is left out.
+func TestService_ReorderAndSkipQuestions(t *testing.T) {
+	t.Parallel()
+
+	const hostID int64 = 1
+
+	start := time.Date(2026, time.August, 27, 12, 0, 0, 0, time.UTC)
+	h := newRunnerHarness(t, start, [][]bool{{true, true, true}, {true, true}, {true}})
+	ctx := t.Context()
+
+	q := upcomingIDs(t, h, hostID)
+	if got, want := len(q), 6; got != want {
+		t.Fatalf("host upcoming = %d questions, want %d", got, want)
+	}
+	if got := upcomingIDs(t, h, h.players[0]); len(got) != 0 {
+		t.Errorf("player upcoming = %v, want none", got)
+	}
+
+	order := []int64{q[2], q[0], q[1], q[4], q[3], q[5]}
+	if err := h.service.ReorderQuestions(ctx, h.code, h.players[0], order); !errors.Is(err, ErrNotHost) {
+		t.Errorf("ReorderQuestions by a player err = %v, want %v", err, ErrNotHost)
+	}
+	if err := h.service.ReorderQuestions(ctx, h.code, hostID, order[:5]); !errors.Is(err, ErrInvalidQuestionOrder) {
+		t.Errorf("ReorderQuestions missing a question err = %v, want %v", err, ErrInvalidQuestionOrder)
+	}
+	if err := h.service.ReorderQuestions(ctx, h.code, hostID, order); err != nil {
+		t.Fatalf("ReorderQuestions err = %v, want nil", err)
+	}
+	if err := h.service.SkipQuestion(ctx, h.code, hostID, q[5]); err != nil {
+		t.Fatalf("SkipQuestion err = %v, want nil", err)
+	}
+	if err := h.service.SkipQuestion(ctx, h.code, hostID, q[5]); !errors.Is(err, ErrQuestionNotRemaining) {
+		t.Errorf("SkipQuestion twice err = %v, want %v", err, ErrQuestionNotRemaining)
+	}
+	if got, want := upcomingIDs(t, h, hostID), order[:5]; !slices.Equal(got, want) {
+		t.Errorf("upcoming after reorder and skip = %v, want %v", got, want)
+	}
+
+	if err := h.service.Start(ctx, h.code, hostID); err != nil {
+		t.Fatalf("Start err = %v, want nil", err)
+	}
+	playQuestion(t, h, runnerCfg.RoundIntroBeat, q[2])
+	if err := h.service.SkipQuestion(ctx, h.code, hostID, q[2]); !errors.Is(err, ErrQuestionNotRemaining) {
+		t.Errorf("SkipQuestion of the open question err = %v, want %v", err, ErrQuestionNotRemaining)
+	}
+	if err := h.service.ReorderQuestions(ctx, h.code, hostID, []int64{q[1], q[0], q[4], q[3]}); err != nil {
+		t.Fatalf("ReorderQuestions mid-round err = %v, want nil", err)
+	}
+	if err := h.service.SkipQuestion(ctx, h.code, hostID, q[3]); err != nil {
+		t.Fatalf("SkipQuestion mid-game err = %v, want nil", err)
+	}
+	if got, want := upcomingIDs(t, h, hostID), []int64{q[1], q[0], q[4]}; !slices.Equal(got, want) {
+		t.Errorf("upcoming mid-round = %v, want %v", got, want)
+	}
+
+	// The open question is now the first of four the game plays.
+	state, err := h.service.GetSessionState(ctx, h.code, hostID)
+	if err != nil {
+		t.Fatalf("GetSessionState err = %v, want nil", err)
+	}
+	if got, want := questionIDs(state.Quiz.Questions), []int64{q[2], q[1], q[0], q[4]}; !slices.Equal(got, want) {
+		t.Errorf("state quiz questions = %v, want %v", got, want)
+	}
+
+	h.clock.advance(11 * time.Second)
+	h.tick(ctx)
+	playQuestion(t, h, runnerCfg.RevealBeat, q[1])
+	h.clock.advance(11 * time.Second)
+	h.tick(ctx)
+	playQuestion(t, h, runnerCfg.RevealBeat, q[0])
+	h.clock.advance(11 * time.Second)
+	h.tick(ctx)
+	h.clock.advance(runnerCfg.RevealBeat)
+	h.tick(ctx)
+	if got, want := h.phase(t), PhaseRoundResults; got != want {
+		t.Fatalf("phase after round one = %q, want %q", got, want)
+	}
+	h.clock.advance(runnerCfg.RoundResultsBeat)
+	h.tick(ctx)
+	playQuestion(t, h, runnerCfg.RoundIntroBeat, q[4])
+
+	// The third round lost its only question, so round two is the last.
+	h.clock.advance(11 * time.Second)
+	h.tick(ctx)
+	h.clock.advance(runnerCfg.RevealBeat)
+	h.tick(ctx)
+	if got, want := h.phase(t), PhaseIntermission; got != want {
+		t.Errorf("phase after round two = %q, want %q", got, want)
+	}
+	if got := upcomingIDs(t, h, hostID); len(got) != 0 {
+		t.Errorf("upcoming after the game = %v, want none", got)
+	}
+}
+
+func questionIDs(questions []*quiz.Question) []int64 {
+	ids := make([]int64, 0, len(questions))
+	for _, q := range questions {
+		ids = append(ids, q.ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,162 @@
+package livesession
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// ScoredAnswer is one scored live pick as the score recalculation reads it:
+// the stored score, the answer window it was scored against, and the chosen
+// option's current correctness (so a corrected answer key is picked up).
+// WindowStartedAt and WindowExpiresAt are nil on picks scored before the
+// window was recorded; those cannot be recomputed.
+type ScoredAnswer struct {
+	ID              int64
+	SessionID       string
+	JoinCode        string
+	GameSeq         int64
+	QuestionID      int64
+	PlayerID        int64
+	DisplayName     string
+	AnsweredAt      time.Time
+	Correct         bool
+	Score           int
+	WindowStartedAt *time.Time
+	WindowExpiresAt *time.Time
+}
+
+// RescoreStore is the slice of the store the score recalculation needs: read
+// every scored pick on a quiz and write recomputed scores back.
+type RescoreStore interface {
+	// ListScoredAnswersForQuiz returns every scored live pick on the quiz's
+	// questions, across every room and game that ran it, grouped by room,
+	// game and question.
+	ListScoredAnswersForQuiz(ctx context.Context, quizID int64) ([]*ScoredAnswer, error)
+	// UpdateAnswerScores overwrites the stored score of each answer id in
+	// scores, all in one transaction.
+	UpdateAnswerScores(ctx context.Context, scores map[int64]int) error
+}
+
+// ScoreChange is one pick whose recomputed score differs from the stored one.
+type ScoreChange struct {
+	Answer   *ScoredAnswer
+	NewScore int
+}
+
+// TotalChange is one player's game total before and after the recalculation,
+// listed only when it moves.
+type TotalChange struct {
+	JoinCode    string
+	GameSeq     int64
+	PlayerID    int64
+	DisplayName string
+	OldTotal    int
+	NewTotal    int
+}
+
+// RescoreReport is the outcome of one recalculation: how many picks were
+// replayed, how many could not be (no recorded window), and the per-pick and
+// per-player differences. Applied is true when the changes were written.
+type RescoreReport struct {
+	QuizID         int64
+	JoinCode       string
+	Checked        int
+	Unrecomputable int
+	Changes        []ScoreChange
+	Totals         []TotalChange
+	Applied        bool
+}
+
+// Rescorer recomputes stored live-session scores from the recorded picks
+// under the current scoring curve, for when a scoring bug is fixed after
+// games were played. Solo games need no counterpart: their results are
+// computed from the recorded answers on every read.
+type Rescorer struct {
+	store  RescoreStore
+	scorer Scorer
+	logger *slog.Logger
+}
+
+// NewRescorer wires a Rescorer over store and scorer (the same scorer the
+// runner uses at question close).
+func NewRescorer(store RescoreStore, scorer Scorer, logger *slog.Logger) *Rescorer {
+	return &Rescorer{store: store, scorer: scorer, logger: logger}
+}
+
+// Rescore replays every scored pick on quizID (narrowed to one room when
+// joinCode is non-empty) through the current scorer and reports what would
+// change. With apply false nothing is written (the dry run); with apply true
+// the changed scores are written in one transaction. Standings and
+// leaderboards sum the stored scores, so they follow on the next read.
+func (r *Rescorer) Rescore(ctx context.Context, quizID int64, joinCode string, apply bool) (*RescoreReport, error) {
+	joinCode = normalizeJoinCode(joinCode)
+	answers, err := r.store.ListScoredAnswersForQuiz(ctx, quizID)
+	if err != nil {
+		return nil, fmt.Errorf("listing scored answers for quiz %d: %w", quizID, err)
+	}
+
+	report := &RescoreReport{QuizID: quizID, JoinCode: joinCode}
+	type gameKey struct {
+		joinCode string
+		gameSeq  int64
+		playerID int64
+	}
+	totals := make(map[gameKey]*TotalChange)
+	var order []gameKey
+	for _, a := range answers {
+		if joinCode != "" && a.JoinCode != joinCode {
+			continue
+		}
+		if a.WindowStartedAt == nil || a.WindowExpiresAt == nil {
+			report.Unrecomputable++
+
+			continue
+		}
+		report.Checked++
+
+		newScore := r.scorer.ScoreAnswer(ctx, a.Correct, *a.WindowStartedAt, *a.WindowExpiresAt, a.AnsweredAt)
+		key := gameKey{joinCode: a.JoinCode, gameSeq: a.GameSeq, playerID: a.PlayerID}
+		total, ok := totals[key]
+		if !ok {
+			total = &TotalChange{
+				JoinCode: a.JoinCode, GameSeq: a.GameSeq, PlayerID: a.PlayerID, DisplayName: a.DisplayName,
+			}
+			totals[key] = total
+			order = append(order, key)
+		}
+		total.OldTotal += a.Score
+		total.NewTotal += newScore
+		if newScore != a.Score {
+			report.Changes = append(report.Changes, ScoreChange{Answer: a, NewScore: newScore})
+		}
+	}
+	for _, key := range order {
+		if t := totals[key]; t.OldTotal != t.NewTotal {
+			report.Totals = append(report.Totals, *t)
+		}
+	}
+
+	if !apply || len(report.Changes) == 0 {
+		return report, nil
+	}
+
+	scores := make(map[int64]int, len(report.Changes))
+	for _, c := range report.Changes {
+		scores[c.Answer.ID] = c.NewScore
+	}
+	if err = r.store.UpdateAnswerScores(ctx, scores); err != nil {
+		return nil, fmt.Errorf("applying recomputed scores for quiz %d: %w", quizID, err)
+	}
+	report.Applied = true
+	r.logger.InfoContext(
+		ctx,
+		"live session scores recalculated",
+		slog.Int64(logQuizKey, quizID),
+		slog.String(logJoinCodeKey, joinCode),
+		slog.Int("changed", len(report.Changes)),
+	)
+
+	return report, nil
+}
@@ -0,0 +1,142 @@
+package livesession_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/game"
+	. "github.com/starquake/topbanana/internal/livesession"
+)
+
+// TestRescorer_DryRunThenApply plays one question with both players answering
+// correctly, then simulates a scoring bug by overwriting the first player's
+// stored score and erasing the second player's recorded window. The dry run
+// reports the first pick's correction (and its total) without writing it and
+// counts the second as not recomputable; the apply writes the correction, after
+// which a second dry run finds nothing left to change.
+func TestRescorer_DryRunThenApply(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.June, 5, 12, 0, 0, 0, time.UTC)
+	h := newRunnerHarness(t, start, [][]bool{{true}})
+	ctx := t.Context()
+	first, second := h.players[0], h.players[1]
+
+	if err := h.service.Start(ctx, h.code, 1); err != nil {
+		t.Fatalf("Start err = %v, want nil", err)
+	}
+	h.clock.advance(runnerCfg.RoundIntroBeat)
+	h.tick(ctx)
+	optRight := correctOptionID(ctx, t, h.service, h.code, first)
+	h.clock.advance(2 * time.Second)
+	for _, p := range []int64{first, second} {
+		if err := h.service.SubmitAnswer(ctx, h.code, p, optRight, h.clock.Now()); err != nil {
+			t.Fatalf("SubmitAnswer(%d) err = %v, want nil", p, err)
+		}
+	}
+	h.tick(ctx)
+	if got, want := h.phase(t), PhaseReveal; got != want {
+		t.Fatalf("phase after both answered = %q, want %q", got, want)
+	}
+
+	sess, err := h.store.GetSessionByJoinCode(ctx, h.code)
+	if err != nil {
+		t.Fatalf("GetSessionByJoinCode err = %v, want nil", err)
+	}
+	answers, err := h.store.ListScoredAnswersForQuiz(ctx, *sess.QuizID)
+	if err != nil {
+		t.Fatalf("ListScoredAnswersForQuiz err = %v, want nil", err)
+	}
+	var wantScore int
+	for _, a := range answers {
+		if a.PlayerID == first {
+			wantScore = a.Score
+		}
+	}
+	if wantScore <= 0 {
+		t.Fatalf("first player's stored score = %d, want > 0", wantScore)
+	}
+
+	if _, err = h.db.ExecContext(
+		ctx, "UPDATE session_answers SET score = 1 WHERE session_id = ? AND player_id = ?", sess.ID, first,
+	); err != nil {
+		t.Fatalf("corrupt score err = %v, want nil", err)
+	}
+	if _, err = h.db.ExecContext(
+		ctx,
+		"UPDATE session_answers SET window_started_at = NULL, window_expires_at = NULL "+
+			"WHERE session_id = ? AND player_id = ?",
+		sess.ID, second,
+	); err != nil {
+		t.Fatalf("erase window err = %v, want nil", err)
+	}
+
+	logger := slog.New(slog.DiscardHandler)
+	rescorer := NewRescorer(h.store, game.NewService(nil, nil, logger), logger)
+
+	dry, err := rescorer.Rescore(ctx, *sess.QuizID, "", false)
+	if err != nil {
+		t.Fatalf("dry run err = %v, want nil", err)
+	}
+	if got, want := dry.Checked, 1; got != want {
+		t.Errorf("dry run Checked = %d, want %d", got, want)
+	}
+	if got, want := dry.Unrecomputable, 1; got != want {
+		t.Errorf("dry run Unrecomputable = %d, want %d", got, want)
+	}
+	if got, want := len(dry.Changes), 1; got != want {
+		t.Fatalf("dry run len(Changes) = %d, want %d", got, want)
+	}
+	if c := dry.Changes[0]; c.Answer.PlayerID != first || c.Answer.Score != 1 || c.NewScore != wantScore {
+		t.Errorf("dry run change = player %d %d -> %d, want player %d 1 -> %d",
+			c.Answer.PlayerID, c.Answer.Score, c.NewScore, first, wantScore)
+	}
+	if got, want := len(dry.Totals), 1; got != want {
+		t.Fatalf("dry run len(Totals) = %d, want %d", got, want)
+	}
+	if dry.Applied {
+		t.Error("dry run Applied = true, want false")
+	}
+	if got := playerScore(t, h, sess.ID, first); got != 1 {
+		t.Errorf("score after dry run = %d, want 1 (unchanged)", got)
+	}
+
+	applied, err := rescorer.Rescore(ctx, *sess.QuizID, h.code, true)
+	if err != nil {
+		t.Fatalf("apply err = %v, want nil", err)
+	}
+	if !applied.Applied {
+		t.Error("apply Applied = false, want true")
+	}
+	if got := playerScore(t, h, sess.ID, first); got != wantScore {
+		t.Errorf("score after apply = %d, want %d", got, wantScore)
+	}
+
+	again, err := rescorer.Rescore(ctx, *sess.QuizID, "", false)
+	if err != nil {
+		t.Fatalf("second dry run err = %v, want nil", err)
+	}
+	if got := len(again.Changes); got != 0 {
+		t.Errorf("second dry run len(Changes) = %d, want 0", got)
+	}
+
+	other, err := rescorer.Rescore(ctx, *sess.QuizID, "OTHER2", false)
+	if err != nil {
+		t.Fatalf("other room dry run err = %v, want nil", err)
+	}
+	if got := other.Checked + other.Unrecomputable; got != 0 {
+		t.Errorf("other room picks = %d, want 0", got)
+	}
+}
+
+// playerScore reads one player's current-game total through the store.
+func playerScore(t *testing.T, h *runnerHarness, sessionID string, playerID int64) int {
+	t.Helper()
+	score, err := h.store.GetSessionPlayerScore(t.Context(), sessionID, playerID)
+	if err != nil {
+		t.Fatalf("GetSessionPlayerScore err = %v, want nil", err)
+	}
+
+	return score
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/starquake/topbanana/internal/quiz"
@@ -128,6 +129,10 @@ type Runner struct {
 	// beat-gated phase (round_intro or reveal), so the beat is measured from
 	// the transition rather than persisted.
 	phaseSince map[string]time.Time
+
+	// lastBeat is the wall-clock Unix nanoseconds at which Run last
+	// finished a tick, for the health detail report.
+	lastBeat atomic.Int64
 }
 
 // NewRunner builds a runner over the live-session store, quiz reader, tick
@@ -166,10 +171,29 @@ func (r *Runner) Run(ctx context.Context) {
 			return
 		case <-ticker.C:
 			r.tick(ctx, r.clock.Now())
+			r.lastBeat.Store(time.Now().UnixNano())
 		}
 	}
 }
 
+// LastBeat returns the wall-clock time Run last finished a tick, or the zero
+// time before the first. A loop that is alive beats every [Runner.BeatInterval];
+// one stuck in a tick (a hung store call) stops beating, which the health
+// detail report surfaces.
+func (r *Runner) LastBeat() time.Time {
+	nanos := r.lastBeat.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, nanos)
+}
+
+// BeatInterval returns how often Run ticks.
+func (r *Runner) BeatInterval() time.Duration {
+	return r.cfg.BeatInterval
+}
+
 // Begin is the host "Start now" path: it drives the just-started session out
 // of the lobby into its first round_intro at once, rather than waiting for the
 // next beat to notice it. Start has already marked the session started. A
@@ -201,6 +225,37 @@ func (r *Runner) Rearm(ctx context.Context, sessionID string) {
 	r.Begin(ctx, sessionID)
 }
 
+// Next is the host "next" and "close answering" path: it makes the session's
+// next transition at once, skipping what is left of the beat - or, for a
+// question, closing it if its window has run out, as [Service.CloseAnswers]
+// has just made it. A session no longer in expected (the beat won the race,
+// or a double click) is a no-op.
+func (r *Runner) Next(ctx context.Context, sessionID string, expected Phase) {
+	now := r.clock.Now()
+	sess, err := r.store.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		r.logger.WarnContext(ctx, "runner failed to load session for next",
+			slog.String(logSessionKey, sessionID), slog.Any("err", err))
+
+		return
+	}
+	if sess.Phase != expected {
+		return
+	}
+	switch sess.Phase {
+	case PhaseRoundIntro:
+		r.leaveRoundIntro(ctx, sess, now)
+	case PhaseQuestion:
+		r.advanceQuestion(ctx, sess, now)
+	case PhaseReveal:
+		r.leaveReveal(ctx, sess, now)
+	case PhaseRoundResults:
+		r.leaveRoundResults(ctx, sess, now)
+	default:
+		// The lobby, intermission and finished have no host-driven next step.
+	}
+}
+
 // tick scans every live session once and advances each. Exported to tests as
 // Tick via export_test.
 func (r *Runner) tick(ctx context.Context, now time.Time) {
@@ -298,10 +353,13 @@ func (r *Runner) advanceLobby(ctx context.Context, sess *Session, now time.Time)
 // advanceRoundIntro issues the round's first question once the round_intro
 // beat has elapsed.
 func (r *Runner) advanceRoundIntro(ctx context.Context, sess *Session, now time.Time) {
-	if now.Sub(r.phaseEnteredAt(sess.ID, now)) < r.cfg.RoundIntroBeat {
-		return
+	if r.beatDue(sess, now, r.cfg.RoundIntroBeat) {
+		r.leaveRoundIntro(ctx, sess, now)
 	}
+}
 
+// leaveRoundIntro issues the round's first question.
+func (r *Runner) leaveRoundIntro(ctx context.Context, sess *Session, now time.Time) {
 	plan, err := r.loadPlan(ctx, sess)
 	if err != nil {
 		return
@@ -317,15 +375,16 @@ func (r *Runner) advanceRoundIntro(ctx context.Context, sess *Session, now time.
 }
 
 // advanceQuestion closes the current question when every active player has
-// answered (early close) or the answer window has expired (timeout close),
-// scoring the picks and moving into the reveal phase.
+// answered (early close), the host has closed answering, or the answer window
+// has expired (timeout close), scoring the picks and moving into the reveal
+// phase.
 func (r *Runner) advanceQuestion(ctx context.Context, sess *Session, now time.Time) {
 	if sess.CurrentQuestionID == nil || sess.QuestionExpiresAt == nil {
 		return
 	}
 
-	timedOut := !now.Before(*sess.QuestionExpiresAt)
-	if !timedOut && !r.allActiveAnswered(ctx, sess, now) {
+	closed := sess.AnswersClosedAt != nil || !now.Before(*sess.QuestionExpiresAt)
+	if !closed && !r.allActiveAnswered(ctx, sess, now) {
 		return
 	}
 
@@ -354,10 +413,13 @@ func (r *Runner) advanceQuestion(ctx context.Context, sess *Session, now time.Ti
 // finishes directly, so the game ends on a single final-standings screen rather
 // than showing "Scores so far" back-to-back with "Final scores".
 func (r *Runner) advanceReveal(ctx context.Context, sess *Session, now time.Time) {
-	if now.Sub(r.phaseEnteredAt(sess.ID, now)) < r.cfg.RevealBeat {
-		return
+	if r.beatDue(sess, now, r.cfg.RevealBeat) {
+		r.leaveReveal(ctx, sess, now)
 	}
+}
 
+// leaveReveal moves on from the reveal; see advanceReveal.
+func (r *Runner) leaveReveal(ctx context.Context, sess *Session, now time.Time) {
 	plan, err := r.loadPlan(ctx, sess)
 	if err != nil {
 		return
@@ -380,10 +442,13 @@ func (r *Runner) advanceReveal(ctx context.Context, sess *Session, now time.Time
 // the round_results beat has elapsed: into the next round's intro, or finish
 // when the round just shown was the last.
 func (r *Runner) advanceRoundResults(ctx context.Context, sess *Session, now time.Time) {
-	if now.Sub(r.phaseEnteredAt(sess.ID, now)) < r.cfg.RoundResultsBeat {
-		return
+	if r.beatDue(sess, now, r.cfg.RoundResultsBeat) {
+		r.leaveRoundResults(ctx, sess, now)
 	}
+}
 
+// leaveRoundResults moves on from the between-rounds standings screen.
+func (r *Runner) leaveRoundResults(ctx context.Context, sess *Session, now time.Time) {
 	plan, err := r.loadPlan(ctx, sess)
 	if err != nil {
 		return
@@ -391,6 +456,13 @@ func (r *Runner) advanceRoundResults(ctx context.Context, sess *Session, now tim
 	r.advanceAfterRound(ctx, sess, plan, now)
 }
 
+// beatDue reports whether the session has shown its current beat-gated screen
+// for beat. A room under host pacing never comes due: it waits for the host's
+// [Runner.Next].
+func (r *Runner) beatDue(sess *Session, now time.Time, beat time.Duration) bool {
+	return !sess.HostAdvance && now.Sub(r.phaseEnteredAt(sess.ID, now)) >= beat
+}
+
 // advanceAfterRound moves into the next round's intro, or finishes the
 // session when the current round was the last. Reached from round_results
 // (the normal between-rounds path) and from a round that had no questions to
@@ -649,7 +721,9 @@ func (r *Runner) scoreQuestion(ctx context.Context, sess *Session) {
 	}
 	for _, a := range answers {
 		score := r.scorer.ScoreAnswer(ctx, a.Correct, *sess.QuestionStartedAt, *sess.QuestionExpiresAt, a.AnsweredAt)
-		if err := r.store.SetAnswerScore(ctx, sess.ID, *sess.CurrentQuestionID, a.PlayerID, score); err != nil {
+		if err := r.store.SetAnswerScore(
+			ctx, sess.ID, *sess.CurrentQuestionID, a.PlayerID, score, *sess.QuestionStartedAt, *sess.QuestionExpiresAt,
+		); err != nil {
 			r.logger.WarnContext(
 				ctx,
 				"runner failed to set answer score",
@@ -731,7 +805,7 @@ func (r *Runner) loadPlan(ctx context.Context, sess *Session) (questionPlan, err
 		return questionPlan{}, fmt.Errorf("failed to load quiz for runner: %w", err)
 	}
 
-	return newQuestionPlan(qz), nil
+	return gamePlan(ctx, r.store, sess, qz)
 }
 
 func (r *Runner) publish(code string, phase Phase) {
@@ -797,15 +871,18 @@ type questionPlan struct {
 // The plan is derived from questions, so a round with no questions never
 // appears and its intro is never shown in a live session. This is intentional
 // (#803): a live round with nothing to ask would be a dead beat, unlike the
-// solo path which can show an empty round's intro.
-func newQuestionPlan(qz *quiz.Quiz) questionPlan {
+// solo path which can show an empty round's intro. The same holds for a round
+// whose every question the host skipped: changes are the host's reorders and
+// skips of the game (see [applyPlanChanges]), nil for a quiz played as
+// written.
+func newQuestionPlan(qz *quiz.Quiz, changes []*PlanChange) questionPlan {
 	plan := questionPlan{questionsByRnd: make(map[int64][]*quiz.Question)}
 	seen := make(map[int64]struct{})
 	questions := append([]*quiz.Question(nil), qz.Questions...)
 	slices.SortStableFunc(questions, func(a, b *quiz.Question) int {
 		return a.Position - b.Position
 	})
-	for _, q := range questions {
+	for _, q := range applyPlanChanges(questions, changes) {
 		if _, ok := seen[q.RoundID]; !ok {
 			seen[q.RoundID] = struct{}{}
 			plan.rounds = append(plan.rounds, q.RoundID)
@@ -0,0 +1,131 @@
+package locale
+
+import (
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// hoursPerDay is the bucket size for switching from hours to days in
+// [RelativeTimeSince].
+const hoursPerDay = 24
+
+// digitGroup is how many digits [FormatNumber] puts between separators.
+const digitGroup = 3
+
+// monthsNL are the Dutch month abbreviations, January first. English uses
+// Go's own "Jan".."Dec".
+//
+//nolint:gochecknoglobals // immutable lookup table.
+var monthsNL = [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"}
+
+// TemplateFuncs returns the formatting template funcs bound to loc:
+// relTime ("3 hr ago"), formatDate, formatDateTime and formatNumber
+// (thousands separators). render.Renderer binds them per request from the
+// resolved locale; a surface parsing its own tree registers them with
+// [LocaleEN] at parse time.
+func TemplateFuncs(loc string) template.FuncMap {
+	return template.FuncMap{
+		"relTime":        func(t time.Time) string { return RelativeTime(loc, t) },
+		"formatDate":     func(t time.Time) string { return FormatDate(loc, t) },
+		"formatDateTime": func(t time.Time) string { return FormatDateTime(loc, t) },
+		"formatNumber":   func(n any) string { return formatAnyNumber(loc, n) },
+	}
+}
+
+// RelativeTime returns a coarse relative-time string for t in loc (e.g.
+// "3 hr ago"), measured against the current time.
+func RelativeTime(loc string, t time.Time) string {
+	return RelativeTimeSince(loc, time.Now(), t)
+}
+
+// RelativeTimeSince is the pure relative-time formatter, with the reference
+// "now" passed in rather than read from the clock, so a test can pass a fixed
+// now instead of racing [time.Now] against scheduling jitter (#666).
+func RelativeTimeSince(loc string, now, t time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return Translate(loc, "time.justNow")
+	case d < time.Hour:
+		return countAgo(loc, "time.minuteAgo", "time.minutesAgo", int(d.Minutes()))
+	case d < hoursPerDay*time.Hour:
+		return countAgo(loc, "time.hourAgo", "time.hoursAgo", int(d.Hours()))
+	default:
+		return countAgo(loc, "time.dayAgo", "time.daysAgo", int(d.Hours()/hoursPerDay))
+	}
+}
+
+// countAgo picks the singular or plural message for n.
+func countAgo(loc string, one, many MessageID, n int) string {
+	if n == 1 {
+		return Translate(loc, one)
+	}
+
+	return TranslateCount(loc, many, n)
+}
+
+// FormatDate formats t as a short date in loc: "Jan 2, 2006" in English,
+// "2 jan 2006" in Dutch.
+func FormatDate(loc string, t time.Time) string {
+	if loc == LocaleNL {
+		return strconv.Itoa(t.Day()) + " " + monthsNL[t.Month()-1] + " " + strconv.Itoa(t.Year())
+	}
+
+	return t.Format("Jan 2, 2006")
+}
+
+// FormatDateTime formats t as [FormatDate] followed by the 24-hour time.
+func FormatDateTime(loc string, t time.Time) string {
+	return FormatDate(loc, t) + " " + t.Format("15:04")
+}
+
+// FormatNumber formats n with the thousands separator of loc: "1,234,567"
+// in English, "1.234.567" in Dutch.
+func FormatNumber(loc string, n int64) string {
+	sep := ","
+	if loc == LocaleNL {
+		sep = "."
+	}
+
+	digits := strconv.FormatInt(n, 10)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if len(digits) <= digitGroup {
+		return sign + digits
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	head := len(digits) % digitGroup
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += digitGroup {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(digits[i : i+digitGroup])
+	}
+
+	return b.String()
+}
+
+// formatAnyNumber adapts [FormatNumber] to the integer types template data
+// carries (counts are int, scores and IDs int64); anything else prints as is.
+func formatAnyNumber(loc string, n any) string {
+	switch v := n.(type) {
+	case int:
+		return FormatNumber(loc, int64(v))
+	case int32:
+		return FormatNumber(loc, int64(v))
+	case int64:
+		return FormatNumber(loc, v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,116 @@
+package locale_test
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/locale"
+)
+
+func TestRelativeTimeSince(t *testing.T) {
+	t.Parallel()
+
+	// A fixed reference time keeps this deterministic: RelativeTimeSince
+	// takes "now" rather than reading the clock, so there is no
+	// scheduling-jitter window for a paused subtest to cross a bucket
+	// boundary (#666).
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		t      time.Time
+		wantEN string
+		wantNL string
+	}{
+		{"just now (5s ago)", now.Add(-5 * time.Second), "just now", "zojuist"},
+		{"1 minute ago", now.Add(-1 * time.Minute), "1 min ago", "1 min geleden"},
+		{"5 minutes ago", now.Add(-5 * time.Minute), "5 min ago", "5 min geleden"},
+		{"1 hour ago", now.Add(-1 * time.Hour), "1 hr ago", "1 uur geleden"},
+		{"3 hours ago", now.Add(-3 * time.Hour), "3 hr ago", "3 uur geleden"},
+		{"1 day ago", now.Add(-24 * time.Hour), "1 day ago", "1 dag geleden"},
+		{"5 days ago", now.Add(-5 * 24 * time.Hour), "5 days ago", "5 dagen geleden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := RelativeTimeSince(LocaleEN, now, tt.t); got != tt.wantEN {
+				t.Errorf("RelativeTimeSince(en) = %q, want %q", got, tt.wantEN)
+			}
+			if got := RelativeTimeSince(LocaleNL, now, tt.t); got != tt.wantNL {
+				t.Errorf("RelativeTimeSince(nl) = %q, want %q", got, tt.wantNL)
+			}
+		})
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2026, 3, 7, 9, 5, 0, 0, time.UTC)
+	tests := []struct {
+		loc, wantDate, wantDateTime string
+	}{
+		{LocaleEN, "Mar 7, 2026", "Mar 7, 2026 09:05"},
+		{LocaleNL, "7 mrt 2026", "7 mrt 2026 09:05"},
+		{"fr", "Mar 7, 2026", "Mar 7, 2026 09:05"},
+	}
+	for _, tt := range tests {
+		if got := FormatDate(tt.loc, ts); got != tt.wantDate {
+			t.Errorf("FormatDate(%s) = %q, want %q", tt.loc, got, tt.wantDate)
+		}
+		if got := FormatDateTime(tt.loc, ts); got != tt.wantDateTime {
+			t.Errorf("FormatDateTime(%s) = %q, want %q", tt.loc, got, tt.wantDateTime)
+		}
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n              int64
+		wantEN, wantNL string
+	}{
+		{0, "0", "0"},
+		{999, "999", "999"},
+		{1000, "1,000", "1.000"},
+		{12345, "12,345", "12.345"},
+		{1234567, "1,234,567", "1.234.567"},
+		{-1500, "-1,500", "-1.500"},
+		{-250, "-250", "-250"},
+	}
+	for _, tt := range tests {
+		if got := FormatNumber(LocaleEN, tt.n); got != tt.wantEN {
+			t.Errorf("FormatNumber(en, %d) = %q, want %q", tt.n, got, tt.wantEN)
+		}
+		if got := FormatNumber(LocaleNL, tt.n); got != tt.wantNL {
+			t.Errorf("FormatNumber(nl, %d) = %q, want %q", tt.n, got, tt.wantNL)
+		}
+	}
+}
+
+// TestTemplateFuncs pins that the funcs bind the locale and accept the
+// integer widths template data carries.
+func TestTemplateFuncs(t *testing.T) {
+	t.Parallel()
+
+	tpl := template.Must(template.New("").Funcs(TemplateFuncs(LocaleNL)).Parse(
+		`{{formatNumber .Int}}|{{formatNumber .Int64}}|{{formatDate .When}}|{{relTime .When}}`,
+	))
+	data := struct {
+		Int   int
+		Int64 int64
+		When  time.Time
+	}{Int: 4200, Int64: 1234567, When: time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute err = %v", err)
+	}
+	if got := buf.String(); !bytes.HasPrefix(buf.Bytes(), []byte("4.200|1.234.567|1 dec 2020|")) ||
+		!bytes.HasSuffix(buf.Bytes(), []byte("dagen geleden")) {
+		t.Errorf("rendered %q, want Dutch-formatted values", got)
+	}
+}
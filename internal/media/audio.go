@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -65,7 +66,7 @@ const (
 )
 
 // StoreAudio persists an already-browser-playable audio upload as-is under
-// <root>/<quizID>/<id><ext>, recording a media row with the sniffed MIME, byte
+// the <quizID>/<id><ext> key, recording a media row with the sniffed MIME, byte
 // size, sha256, the caller-supplied duration, a description label, and the
 // original upload filename (base name, length-capped) as OriginalFilename for the
 // library tooltip (#1137). Audio is not decoded or transcoded server-side: the
@@ -114,10 +115,10 @@ func (s *Service) StoreAudio(
 	}
 
 	quizDir := strconv.FormatInt(quizID, decimalBase)
-	relPath := filepath.Join(quizDir, strconv.FormatInt(row.ID, decimalBase)+ext)
+	relPath := path.Join(quizDir, strconv.FormatInt(row.ID, decimalBase)+ext)
 	// Audio has no thumbnail, so a single blob is committed and ThumbPath stays
 	// empty (NULL).
-	if err = s.writeAndCommit(ctx, row.ID, quizDir, []fileBlob{{relPath: relPath, data: raw}}); err != nil {
+	if err = s.writeAndCommit(ctx, row.ID, []fileBlob{{relPath: relPath, mime: mime, data: raw}}); err != nil {
 		return nil, err
 	}
 
@@ -326,7 +326,7 @@ func TestServiceStoreAudioRejectsOverCap(t *testing.T) {
 	quizID := seedQuiz(t, db, "media-svc-audio-overcap")
 	root := t.TempDir()
 	const tinyCap int64 = 8
-	svc := NewService(store.NewMediaStore(db, slog.Default()), root, testImageMaxBytes, tinyCap, slog.Default())
+	svc := NewService(store.NewMediaStore(db, slog.Default()), NewLocalStorage(root), testImageMaxBytes, tinyCap, slog.Default())
 
 	payload := append([]byte("ID3"), bytes.Repeat([]byte{0x00}, 64)...)
 	_, err := svc.StoreAudio(t.Context(), quizID, seededAdminID, 1000, "", "clip.mp3", bytes.NewReader(payload))
@@ -351,7 +351,7 @@ func TestServiceStoreAudioHugeCap(t *testing.T) {
 
 	quizID := seedQuiz(t, db, "media-svc-audio-hugecap")
 	root := t.TempDir()
-	svc := NewService(store.NewMediaStore(db, slog.Default()), root, testImageMaxBytes, math.MaxInt64, slog.Default())
+	svc := NewService(store.NewMediaStore(db, slog.Default()), NewLocalStorage(root), testImageMaxBytes, math.MaxInt64, slog.Default())
 
 	m, err := svc.StoreAudio(t.Context(), quizID, seededAdminID, 1000, "", "clip.mp3", bytes.NewReader(mp3ID3()))
 	if err != nil {
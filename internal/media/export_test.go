@@ -26,3 +26,7 @@ var ExportDefaultDescription = defaultDescription
 // ExportSanitizeFilename re-exports the unexported upload-filename sanitizer for
 // tests.
 var ExportSanitizeFilename = sanitizeFilename
+
+// ExportSignS3Request re-exports the unexported Signature Version 4 signer for
+// tests.
+var ExportSignS3Request = signS3Request
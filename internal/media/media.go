@@ -2,19 +2,61 @@ package media
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"time"
+
+	"github.com/starquake/topbanana/internal/enum"
+)
+
+// Type is the media.type discriminator. The column is type-discriminated
+// (image|video|audio) so the same table and per-quiz directory carry audio
+// and video without a schema change; Type's Scan and Value reject anything
+// else, so a bad type never reaches the row.
+type Type string
+
+const (
+	// TypeImage is the media.type value for an image row.
+	TypeImage Type = "image"
+	// TypeVideo is the media.type value reserved for video rows; nothing
+	// uploads one yet.
+	TypeVideo Type = "video"
+	// TypeAudio is the media.type value for an audio row (#1059). Audio is
+	// stored as-is (no server-side transcoding); only already-browser-playable
+	// formats are accepted.
+	TypeAudio Type = "audio"
 )
 
-// TypeImage is the media.type value for an image row. The column is
-// type-discriminated (image|video|audio) so the same table and per-quiz
-// directory carry audio and video without a schema change.
-const TypeImage = "image"
+// types is the closed set of media types, matching the DB CHECK.
+//
+//nolint:gochecknoglobals // Fixed value set, read-only after init.
+var types = enum.New("media type", TypeImage, TypeVideo, TypeAudio)
 
-// TypeAudio is the media.type value for an audio row (#1059). Audio is stored
-// as-is (no server-side transcoding); only already-browser-playable formats are
-// accepted.
-const TypeAudio = "audio"
+// ParseType returns raw as a Type, or an error wrapping [enum.ErrInvalid]
+// when it is not a recognised media type.
+func ParseType(raw string) (Type, error) {
+	return types.Parse(raw) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// Scan implements [database/sql.Scanner].
+func (t *Type) Scan(src any) error {
+	return types.Scan(t, src) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// Value implements [driver.Valuer].
+func (t Type) Value() (driver.Value, error) {
+	return types.Value(t) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (t Type) MarshalJSON() ([]byte, error) {
+	return types.EncodeJSON(t) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (t *Type) UnmarshalJSON(data []byte) error {
+	return types.DecodeJSON(t, data) //nolint:wrapcheck // enum errors carry their own context.
+}
 
 // ErrMediaNotFound is returned when a media id does not name a row.
 var ErrMediaNotFound = errors.New("media not found")
@@ -31,7 +73,7 @@ var ErrPathEscapesRoot = errors.New("media path escapes root")
 type Media struct {
 	ID        int64
 	QuizID    int64
-	Type      string
+	Type      Type
 	MIME      string
 	Path      string
 	ThumbPath string
@@ -97,5 +139,5 @@ type Store interface {
 	DeleteMedia(ctx context.Context, id int64) error
 	// CountMediaByQuizAndType returns the number of ready media rows of
 	// mediaType for quizID, for the per-type library ceiling.
-	CountMediaByQuizAndType(ctx context.Context, quizID int64, mediaType string) (int64, error)
+	CountMediaByQuizAndType(ctx context.Context, quizID int64, mediaType Type) (int64, error)
 }
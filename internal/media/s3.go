@@ -0,0 +1,411 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrS3Status is returned when an S3-compatible endpoint answers a request
+// with an unexpected status code.
+var ErrS3Status = errors.New("unexpected S3 response status")
+
+// S3 request signing constants (AWS Signature Version 4).
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	s3DateFormat    = "20060102T150405Z"
+	s3DayFormat     = "20060102"
+	s3RequestSuffix = "aws4_request"
+
+	// s3RequestTimeout bounds one request to the bucket, generous enough for
+	// a capped upload over a slow link.
+	s3RequestTimeout = 60 * time.Second
+
+	// s3ErrorBodyLimit caps how much of an error response is read into the
+	// returned error, enough for the S3 <Error><Code> without trusting an
+	// arbitrarily large body.
+	s3ErrorBodyLimit = 1 << 10
+)
+
+// S3Config is how [S3Storage] reaches its bucket.
+type S3Config struct {
+	// Endpoint is the service base URL, e.g. https://s3.eu-west-1.amazonaws.com
+	// or http://minio:9000 for a self-hosted S3-compatible store.
+	Endpoint *url.URL
+	// Region is the signing region; S3-compatible stores usually accept
+	// "us-east-1".
+	Region string
+	Bucket string
+	// AccessKeyID and SecretAccessKey are the static credentials requests
+	// are signed with.
+	AccessKeyID     string
+	SecretAccessKey string
+	// PathStyle addresses the bucket as <endpoint>/<bucket>/<key> instead of
+	// <bucket>.<endpoint-host>/<key>. Most self-hosted stores need it.
+	PathStyle bool
+	// PublicURL, when set, is a base URL the bucket's objects are readable
+	// from without credentials (a public bucket or a CDN in front of it).
+	// Empty keeps every read going through the app.
+	PublicURL string
+}
+
+// S3Storage keeps media as objects in an S3-compatible bucket. Requests are
+// signed with AWS Signature Version 4 over the configured static
+// credentials; no SDK is involved, since the four object operations are all
+// the media library needs.
+type S3Storage struct {
+	cfg    S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewS3Storage returns a Storage over the configured bucket.
+func NewS3Storage(cfg S3Config) *S3Storage {
+	return &S3Storage{cfg: cfg, client: &http.Client{Timeout: s3RequestTimeout}, now: time.Now}
+}
+
+// Put uploads the object in a single PUT. The body is read into memory first
+// so the request carries its length and payload hash; uploads are capped well
+// below the single-PUT limit, so a multipart upload is never needed.
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("reading media object: %w", err)
+	}
+
+	req, err := s.newRequest(ctx, http.MethodPut, key, nil, body)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := s.do(req, body)
+	if err != nil {
+		return fmt.Errorf("putting media object %q: %w", key, err)
+	}
+	defer closeBody(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("putting media object %q: %w", key, s3StatusErr(resp))
+	}
+
+	return nil
+}
+
+// Get opens the object for streaming. The caller closes the returned body.
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting media object %q: %w", key, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		closeBody(resp)
+
+		return nil, fmt.Errorf("%w: %q", ErrObjectNotFound, key)
+	default:
+		defer closeBody(resp)
+
+		return nil, fmt.Errorf("getting media object %q: %w", key, s3StatusErr(resp))
+	}
+}
+
+// Delete removes the object. S3 answers a delete of a missing key with 204,
+// and a 404 from a stricter compatible store is treated the same way.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return fmt.Errorf("deleting media object %q: %w", key, err)
+	}
+	defer closeBody(resp)
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("deleting media object %q: %w", key, s3StatusErr(resp))
+	}
+}
+
+// s3ListResult is the slice of a ListObjectsV2 response DeletePrefix reads.
+type s3ListResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// DeletePrefix lists the objects under prefix page by page (ListObjectsV2)
+// and deletes each one. Stopping at the first failure leaves the rest for a
+// retry rather than reporting a partial success.
+func (s *S3Storage) DeletePrefix(ctx context.Context, prefix string) error {
+	prefix = strings.TrimSuffix(prefix, "/") + "/"
+	token := ""
+	for {
+		page, err := s.list(ctx, prefix, token)
+		if err != nil {
+			return err
+		}
+		for _, obj := range page.Contents {
+			if err = s.Delete(ctx, obj.Key); err != nil {
+				return err
+			}
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return nil
+		}
+		token = page.NextContinuationToken
+	}
+}
+
+// list fetches one ListObjectsV2 page of the keys under prefix.
+func (s *S3Storage) list(ctx context.Context, prefix, token string) (*s3ListResult, error) {
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	if token != "" {
+		query.Set("continuation-token", token)
+	}
+	req, err := s.newRequest(ctx, http.MethodGet, "", query, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing media objects under %q: %w", prefix, err)
+	}
+	defer closeBody(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing media objects under %q: %w", prefix, s3StatusErr(resp))
+	}
+	var page s3ListResult
+	if err = xml.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("decoding media object listing: %w", err)
+	}
+
+	return &page, nil
+}
+
+// URL returns the object's address under PublicURL, or "" when no public
+// base is configured.
+func (s *S3Storage) URL(key string) string {
+	if s.cfg.PublicURL == "" {
+		return ""
+	}
+
+	return strings.TrimRight(s.cfg.PublicURL, "/") + "/" + s3EscapePath(key)
+}
+
+// Ping checks that the bucket answers a HeadBucket with the configured
+// credentials, for the readiness probe.
+func (s *S3Storage) Ping(ctx context.Context) error {
+	req, err := s.newRequest(ctx, http.MethodHead, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return fmt.Errorf("checking media bucket: %w", err)
+	}
+	defer closeBody(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("checking media bucket: %w", s3StatusErr(resp))
+	}
+
+	return nil
+}
+
+// newRequest builds an unsigned request for key (or the bucket itself when
+// key is empty) with the given query.
+func (s *S3Storage) newRequest(
+	ctx context.Context, method, key string, query url.Values, body []byte,
+) (*http.Request, error) {
+	u := *s.cfg.Endpoint
+	basePath := strings.TrimRight(u.Path, "/")
+	switch {
+	case s.cfg.PathStyle && key == "":
+		u.Path = basePath + "/" + s.cfg.Bucket
+	case s.cfg.PathStyle:
+		u.Path = basePath + "/" + s.cfg.Bucket + "/" + key
+	default:
+		u.Host = s.cfg.Bucket + "." + u.Host
+		u.Path = basePath + "/" + key
+	}
+	// Put the signature's own encoding on the wire so the path S3 sees is the
+	// path that was signed.
+	u.RawPath = s3EscapePath(u.Path)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("building S3 request: %w", err)
+	}
+
+	return req, nil
+}
+
+// do signs req over body and sends it.
+func (s *S3Storage) do(req *http.Request, body []byte) (*http.Response, error) {
+	sum := sha256.Sum256(body)
+	signS3Request(req, hex.EncodeToString(sum[:]), s.cfg, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending S3 request: %w", err)
+	}
+
+	return resp, nil
+}
+
+// signS3Request adds the x-amz-date, x-amz-content-sha256 and Authorization
+// headers of an AWS Signature Version 4 request. Every header already on the
+// request is signed along with the host.
+func signS3Request(req *http.Request, payloadHash string, cfg S3Config, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(s3DateFormat)
+	day := now.Format(s3DayFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		trimmed := make([]string, len(values))
+		for i, v := range values {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3EscapePath(req.URL.Path),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := day + "/" + cfg.Region + "/" + s3Service + "/" + s3RequestSuffix
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+cfg.SecretAccessKey), day)
+	key = hmacSHA256(key, cfg.Region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, s3RequestSuffix)
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", s3Algorithm+
+		" Credential="+cfg.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+signature)
+}
+
+// hmacSHA256 returns HMAC-SHA256(key, data).
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// s3CanonicalQuery renders the query sorted by key with every key and value
+// URI-encoded, as the signature's canonical request requires.
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := slices.Clone(query[k])
+		slices.Sort(values)
+		for _, v := range values {
+			parts = append(parts, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// s3EscapePath URI-encodes a path, leaving its slashes in place.
+func s3EscapePath(p string) string {
+	return s3Escape(p, false)
+}
+
+// s3Escape URI-encodes s the way Signature Version 4 expects: every byte
+// outside the unreserved set becomes %XX, and a slash is encoded only when
+// encodeSlash is set.
+func s3Escape(s string, encodeSlash bool) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := range len(s) {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hexDigits[c>>4])
+			b.WriteByte(hexDigits[c&0x0f])
+		}
+	}
+
+	return b.String()
+}
+
+// s3StatusErr describes an unexpected response, including the start of its
+// body where S3 puts the error code.
+func s3StatusErr(resp *http.Response) error {
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, s3ErrorBodyLimit))
+
+	return fmt.Errorf("%w: %s: %s", ErrS3Status, resp.Status, strings.TrimSpace(string(snippet)))
+}
+
+// closeBody drains and closes a response body so the connection can be
+// reused.
+func closeBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
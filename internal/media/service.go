@@ -1,12 +1,14 @@
 package media
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -42,25 +44,24 @@ const (
 )
 
 // Service processes an upload through the pipeline, persists the resulting jpeg
-// files under a per-quiz directory below root, and records a media row. It is
-// the single place that ties the pure pipeline to the filesystem and the store.
+// files under a per-quiz prefix in storage, and records a media row. It is the
+// single place that ties the pure pipeline to the file storage and the store.
 type Service struct {
 	store         Store
-	root          string
+	storage       Storage
 	imageMaxBytes int64
 	audioMaxBytes int64
 	logger        *slog.Logger
 }
 
-// NewService returns a media Service writing files under root and recording
-// rows through store. root is the configured media directory; the caller
-// ensures it exists at startup. imageMaxBytes caps a stored image upload's raw
-// size and audioMaxBytes caps a stored audio upload's raw size; zero or negative
-// means no cap.
-func NewService(store Store, root string, imageMaxBytes, audioMaxBytes int64, logger *slog.Logger) *Service {
+// NewService returns a media Service writing files to storage and recording
+// rows through store. imageMaxBytes caps a stored image upload's raw size and
+// audioMaxBytes caps a stored audio upload's raw size; zero or negative means
+// no cap.
+func NewService(store Store, storage Storage, imageMaxBytes, audioMaxBytes int64, logger *slog.Logger) *Service {
 	return &Service{
 		store:         store,
-		root:          root,
+		storage:       storage,
 		imageMaxBytes: imageMaxBytes,
 		audioMaxBytes: audioMaxBytes,
 		logger:        logger,
@@ -68,7 +69,7 @@ func NewService(store Store, root string, imageMaxBytes, audioMaxBytes int64, lo
 }
 
 // StoreImage processes the upload into a normalised jpeg full image plus
-// thumbnail, writes both under <root>/<quizID>/, inserts the media row with the
+// thumbnail, writes both under the <quizID>/ prefix, inserts the media row with the
 // relative paths and metadata, and returns the stored Media. createdBy is the
 // uploading player; filename is the client-supplied upload name, stored (base
 // name, length-capped) as the row's OriginalFilename so the library can show it
@@ -80,8 +81,9 @@ func NewService(store Store, root string, imageMaxBytes, audioMaxBytes int64, lo
 // after the paths commit but before the flip leaves a hidden row the sweep
 // later drops rather than a file visible in the host's library. A failure at
 // any step removes the just-written files and the row so a failed upload leaves
-// no orphans. The stored Path / ThumbPath are relative to root so a later root
-// remount does not strand the references.
+// no orphans. The stored Path / ThumbPath are storage keys relative to the
+// media root, so a later root remount or a move to another backend does not
+// strand the references.
 func (s *Service) StoreImage(
 	ctx context.Context, quizID, createdBy int64, filename string, r io.Reader,
 ) (*Media, error) {
@@ -103,7 +105,7 @@ func (s *Service) StoreImage(
 	quizDir := strconv.FormatInt(quizID, decimalBase)
 
 	// Path / ThumbPath are left empty here and filled in by UpdateMediaPaths
-	// below, once the assigned id names the on-disk files (<quizID>/<id>.jpg).
+	// below, once the assigned id names the stored files (<quizID>/<id>.jpg).
 	row, err := s.store.CreateMedia(ctx, &Media{
 		QuizID:            quizID,
 		Type:              TypeImage,
@@ -119,11 +121,11 @@ func (s *Service) StoreImage(
 		return nil, fmt.Errorf("creating media row: %w", err)
 	}
 
-	relFull := filepath.Join(quizDir, strconv.FormatInt(row.ID, decimalBase)+fullSuffix)
-	relThumb := filepath.Join(quizDir, strconv.FormatInt(row.ID, decimalBase)+thumbSuffix)
-	if err = s.writeAndCommit(ctx, row.ID, quizDir, []fileBlob{
-		{relPath: relFull, data: processed.Full},
-		{relPath: relThumb, data: processed.Thumb},
+	relFull := path.Join(quizDir, strconv.FormatInt(row.ID, decimalBase)+fullSuffix)
+	relThumb := path.Join(quizDir, strconv.FormatInt(row.ID, decimalBase)+thumbSuffix)
+	if err = s.writeAndCommit(ctx, row.ID, []fileBlob{
+		{relPath: relFull, mime: processed.MIME, data: processed.Full},
+		{relPath: relThumb, mime: processed.MIME, data: processed.Thumb},
 	}); err != nil {
 		return nil, err
 	}
@@ -195,24 +197,23 @@ func (s *Service) Delete(ctx context.Context, id int64) error {
 		return fmt.Errorf("deleting media row: %w", err)
 	}
 
-	s.removeFile(m.Path)
+	s.removeFile(ctx, m.Path)
 	if m.ThumbPath != "" {
-		s.removeFile(m.ThumbPath)
+		s.removeFile(ctx, m.ThumbPath)
 	}
 
 	return nil
 }
 
-// RemoveQuizDir removes a quiz's entire on-disk media directory
-// (<root>/<quizID>/) best-effort. It is the filesystem half of dropping a
-// quiz's library: the quiz-delete cascade removes the media rows, but nothing
-// unlinks their files, so a failed import's rollback calls this to leave no
-// orphaned files behind (#1113). A missing directory is not an error. The path
-// is the same id-keyed subdir StoreImage / StoreAudio write into, joined to the
-// confined root, so a caller cannot reach outside the media tree.
-func (s *Service) RemoveQuizDir(quizID int64) error {
-	dir := filepath.Join(s.root, strconv.FormatInt(quizID, decimalBase))
-	if err := os.RemoveAll(dir); err != nil {
+// RemoveQuizDir removes every stored file under a quiz's <quizID>/ prefix
+// best-effort. It is the storage half of dropping a quiz's library: the
+// quiz-delete cascade removes the media rows, but nothing unlinks their files,
+// so a failed import's rollback calls this to leave no orphaned files behind
+// (#1113). A missing directory is not an error. The prefix is the same id-keyed
+// one StoreImage / StoreAudio write into, so a caller cannot reach outside the
+// media tree.
+func (s *Service) RemoveQuizDir(ctx context.Context, quizID int64) error {
+	if err := s.storage.DeletePrefix(ctx, strconv.FormatInt(quizID, decimalBase)); err != nil {
 		return fmt.Errorf("removing quiz media directory: %w", err)
 	}
 
@@ -235,9 +236,9 @@ func (s *Service) SweepStaleNotReady(ctx context.Context) (int, error) {
 
 	deleted := 0
 	for _, m := range stale {
-		s.removeFile(m.Path)
+		s.removeFile(ctx, m.Path)
 		if m.ThumbPath != "" {
-			s.removeFile(m.ThumbPath)
+			s.removeFile(ctx, m.ThumbPath)
 		}
 		switch derr := s.store.DeleteMedia(ctx, m.ID); {
 		case derr == nil:
@@ -291,7 +292,7 @@ func (s *Service) ListByQuiz(ctx context.Context, quizID int64) ([]*Media, error
 // Each upload route uses it to enforce a per-type library ceiling before storing
 // a new batch: an image upload counts only images and an audio upload counts only
 // audio, so the two kinds never draw down each other's cap (#988, #1059).
-func (s *Service) CountByQuizAndType(ctx context.Context, quizID int64, mediaType string) (int64, error) {
+func (s *Service) CountByQuizAndType(ctx context.Context, quizID int64, mediaType Type) (int64, error) {
 	n, err := s.store.CountMediaByQuizAndType(ctx, quizID, mediaType)
 	if err != nil {
 		return 0, fmt.Errorf("counting media by quiz and type: %w", err)
@@ -301,50 +302,44 @@ func (s *Service) CountByQuizAndType(ctx context.Context, quizID int64, mediaTyp
 }
 
 // Open opens a stored media file for reading by its root-relative path. The
-// caller closes the returned file. The path is confined to root: a relPath that
-// would escape the media root (a traversal via "..") is rejected with
-// ErrPathEscapesRoot rather than opened, so a corrupt or hostile DB value
-// cannot read an arbitrary file.
-func (s *Service) Open(relPath string) (*os.File, error) {
-	resolved, err := s.resolve(relPath)
-	if err != nil {
-		return nil, err
-	}
-	// resolve confines the path to root via filepath.Rel, so the variable open
-	// cannot reach outside the media directory.
-	f, err := os.Open(resolved) //nolint:gosec // path confined to root by resolve
+// caller closes the returned reader. With local storage the reader is the
+// seekable [os.File]; a path that would escape the media root (a traversal via
+// "..") is rejected with ErrPathEscapesRoot rather than opened, so a corrupt or
+// hostile DB value cannot read an arbitrary file.
+func (s *Service) Open(ctx context.Context, relPath string) (io.ReadCloser, error) {
+	rc, err := s.storage.Get(ctx, relPath)
 	if err != nil {
 		return nil, fmt.Errorf("opening media file: %w", err)
 	}
 
-	return f, nil
+	return rc, nil
+}
+
+// URL returns an address a browser can fetch the stored file from directly,
+// or "" when the file is only reachable through the serving handlers.
+func (s *Service) URL(relPath string) string {
+	return s.storage.URL(relPath)
 }
 
 // fileBlob is one root-relative file the two-phase commit writes: an image
 // passes a full + thumb pair, audio passes a single file.
 type fileBlob struct {
 	relPath string
+	mime    string
 	data    []byte
 }
 
-// writeAndCommit creates the per-quiz directory, writes every file in blobs,
-// records the resulting paths on the row, and flips the row ready -- the
-// two-phase commit's file + DB work after the not-ready insert. blobs[0] is the
-// primary file (recorded as Path); blobs[1], when present, is the thumbnail
-// (recorded as ThumbPath). A failure at any step tears the just-written files
-// and the still-hidden row back down so a failed upload leaves nothing behind
-// (#992, #998).
-func (s *Service) writeAndCommit(ctx context.Context, id int64, quizDir string, blobs []fileBlob) error {
-	// Create the per-quiz directory only after the insert succeeds, so a quiz
-	// whose first upload fails at the insert is not left with a stray empty dir
-	// (#998).
-	if mkErr := os.MkdirAll(filepath.Join(s.root, quizDir), dirPerm); mkErr != nil {
-		s.cleanupRow(context.WithoutCancel(ctx), id)
-
-		return fmt.Errorf("creating quiz media directory: %w", mkErr)
-	}
-
-	if err := s.writeFiles(blobs); err != nil {
+// writeAndCommit writes every file in blobs, records the resulting paths on the
+// row, and flips the row ready -- the two-phase commit's file + DB work after
+// the not-ready insert. blobs[0] is the primary file (recorded as Path);
+// blobs[1], when present, is the thumbnail (recorded as ThumbPath). A failure
+// at any step tears the just-written files and the still-hidden row back down
+// so a failed upload leaves nothing behind (#992, #998).
+func (s *Service) writeAndCommit(ctx context.Context, id int64, blobs []fileBlob) error {
+	// The per-quiz directory of local storage is created by the first write,
+	// which only happens after the insert succeeds, so a quiz whose first
+	// upload fails at the insert is not left with a stray empty dir (#998).
+	if err := s.writeFiles(ctx, blobs); err != nil {
 		s.cleanupRow(context.WithoutCancel(ctx), id)
 
 		return err
@@ -377,29 +372,22 @@ func (s *Service) writeAndCommit(ctx context.Context, id int64, quizDir string,
 	return nil
 }
 
-// writeFiles writes every blob to its root-relative path using a
-// temp-then-rename pattern so a SIGKILL or crash mid-write can't leave a row
-// pointing at a half-written or missing file. Each blob is written under
-// <name>.tmp, then atomically renamed into place. A failure at any step removes
-// every file written so far so the upload's invariant ("all files exist or
-// none do") holds and a failed upload leaves nothing behind.
-func (s *Service) writeFiles(blobs []fileBlob) error {
+// writeFiles puts every blob into storage, which never exposes a half-written
+// object under its key. A failure at any step removes every file written so
+// far so the upload's invariant ("all files exist or none do") holds and a
+// failed upload leaves nothing behind. The writes run under a cancel-immune
+// context: a cancel is observed at the DB steps around them, and a write cut
+// off halfway would only leave more to clean up.
+func (s *Service) writeFiles(ctx context.Context, blobs []fileBlob) error {
+	writeCtx := context.WithoutCancel(ctx)
 	written := make([]string, 0, len(blobs))
 
 	for _, b := range blobs {
-		tmp := b.relPath + ".tmp"
-		if err := os.WriteFile(filepath.Join(s.root, tmp), b.data, filePerm); err != nil {
-			s.removeFiles(written)
-			s.removeFile(tmp)
+		if err := s.storage.Put(writeCtx, b.relPath, bytes.NewReader(b.data), b.mime); err != nil {
+			s.removeFiles(writeCtx, written)
 
 			return fmt.Errorf("writing media file %q: %w", b.relPath, err)
 		}
-		if err := os.Rename(filepath.Join(s.root, tmp), filepath.Join(s.root, b.relPath)); err != nil {
-			s.removeFiles(written)
-			s.removeFile(tmp)
-
-			return fmt.Errorf("publishing media file %q: %w", b.relPath, err)
-		}
 		written = append(written, b.relPath)
 	}
 
@@ -407,9 +395,9 @@ func (s *Service) writeFiles(blobs []fileBlob) error {
 }
 
 // removeFiles unlinks every root-relative path best-effort.
-func (s *Service) removeFiles(relPaths []string) {
+func (s *Service) removeFiles(ctx context.Context, relPaths []string) {
 	for _, p := range relPaths {
-		s.removeFile(p)
+		s.removeFile(ctx, p)
 	}
 }
 
@@ -420,7 +408,7 @@ func (s *Service) removeFiles(relPaths []string) {
 func (s *Service) cleanupRowAndFiles(ctx context.Context, id int64, blobs []fileBlob) {
 	cleanup := context.WithoutCancel(ctx)
 	for _, b := range blobs {
-		s.removeFile(b.relPath)
+		s.removeFile(cleanup, b.relPath)
 	}
 	s.cleanupRow(cleanup, id)
 }
@@ -435,27 +423,14 @@ func (s *Service) cleanupRow(ctx context.Context, id int64) {
 	}
 }
 
-// resolve joins a root-relative path to root and confirms it stays under root.
-// A path that climbs out via ".." yields ErrPathEscapesRoot. Returns the
-// absolute on-disk path on success.
-func (s *Service) resolve(relPath string) (string, error) {
-	joined := filepath.Join(s.root, relPath)
-	rel, err := filepath.Rel(s.root, joined)
-	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
-		return "", fmt.Errorf("%w: %q", ErrPathEscapesRoot, relPath)
-	}
-
-	return joined, nil
-}
-
 // removeFile unlinks a root-relative path best-effort. A missing file is not an
 // error (the cleanup tooling reconciles); any other failure is logged.
-func (s *Service) removeFile(relPath string) {
+func (s *Service) removeFile(ctx context.Context, relPath string) {
 	if relPath == "" {
 		return
 	}
-	if err := os.Remove(filepath.Join(s.root, relPath)); err != nil && !os.IsNotExist(err) {
-		s.logger.Error("failed to remove media file",
+	if err := s.storage.Delete(ctx, relPath); err != nil {
+		s.logger.ErrorContext(ctx, "failed to remove media file",
 			slog.String("path", relPath), slog.Any("err", err))
 	}
 }
@@ -58,7 +58,7 @@ func newServiceWithQuiz(t *testing.T) fixture {
 	root := t.TempDir()
 	svc := NewService(
 		store.NewMediaStore(db, slog.Default()),
-		root,
+		NewLocalStorage(root),
 		testImageMaxBytes,
 		testAudioMaxBytes,
 		slog.Default(),
@@ -249,7 +249,7 @@ func TestServiceStoreImageOverCap(t *testing.T) {
 	quizID := seedQuiz(t, db, "media-svc-image-overcap")
 	root := t.TempDir()
 	const tinyCap int64 = 8
-	svc := NewService(store.NewMediaStore(db, slog.Default()), root, tinyCap, testAudioMaxBytes, slog.Default())
+	svc := NewService(store.NewMediaStore(db, slog.Default()), NewLocalStorage(root), tinyCap, testAudioMaxBytes, slog.Default())
 
 	_, err := svc.StoreImage(t.Context(), quizID, seededAdminID, "pic.png", bytes.NewReader(pngUpload(t, 64, 64)))
 	if got, want := err, ErrUploadTooLarge; !errors.Is(got, want) {
@@ -346,7 +346,7 @@ func TestServiceStoreCancelledMidFlightCleansUp(t *testing.T) {
 	innerStore := store.NewMediaStore(db, slog.Default())
 	ctx, cancel := context.WithCancel(t.Context())
 	wrapped := &cancelOnUpdatePathsStore{Store: innerStore, cancel: cancel}
-	svc := NewService(wrapped, root, testImageMaxBytes, testAudioMaxBytes, slog.Default())
+	svc := NewService(wrapped, NewLocalStorage(root), testImageMaxBytes, testAudioMaxBytes, slog.Default())
 
 	_, err := svc.StoreImage(ctx, quizID, seededAdminID, "pic.png", bytes.NewReader(pngUpload(t, 64, 64)))
 	if err == nil {
@@ -404,7 +404,7 @@ func TestServiceStoreCancelledAfterPathsLeavesNothing(t *testing.T) {
 	innerStore := store.NewMediaStore(db, slog.Default())
 	ctx, cancel := context.WithCancel(t.Context())
 	wrapped := &cancelAfterPathsStore{Store: innerStore, cancel: cancel}
-	svc := NewService(wrapped, root, testImageMaxBytes, testAudioMaxBytes, slog.Default())
+	svc := NewService(wrapped, NewLocalStorage(root), testImageMaxBytes, testAudioMaxBytes, slog.Default())
 
 	_, err := svc.StoreImage(ctx, quizID, seededAdminID, "pic.png", bytes.NewReader(pngUpload(t, 64, 64)))
 	if err == nil {
@@ -461,7 +461,7 @@ func TestServiceStoreCreateMediaFailureLeavesNoDir(t *testing.T) {
 	root := t.TempDir()
 	svc := NewService(
 		store.NewMediaStore(db, slog.Default()),
-		root,
+		NewLocalStorage(root),
 		testImageMaxBytes,
 		testAudioMaxBytes,
 		slog.Default(),
@@ -605,7 +605,7 @@ func TestServiceOpenRoundTrip(t *testing.T) {
 		t.Fatalf("StoreImage err = %v, want nil", err)
 	}
 
-	f, err := fx.svc.Open(m.Path)
+	f, err := fx.svc.Open(t.Context(), m.Path)
 	if err != nil {
 		t.Fatalf("Open err = %v, want nil", err)
 	}
@@ -632,7 +632,7 @@ func TestServiceOpenRejectsTraversal(t *testing.T) {
 
 	fx := newServiceWithQuiz(t)
 
-	if _, err := fx.svc.Open("../../etc/passwd"); !errors.Is(err, ErrPathEscapesRoot) {
+	if _, err := fx.svc.Open(t.Context(), "../../etc/passwd"); !errors.Is(err, ErrPathEscapesRoot) {
 		t.Errorf("Open(traversal) err = %v, want ErrPathEscapesRoot", err)
 	}
 }
@@ -793,7 +793,7 @@ func TestService_RemoveQuizDir(t *testing.T) {
 		t.Fatalf("quiz dir stat err = %v, want it to exist after an upload", err)
 	}
 
-	if err := fx.svc.RemoveQuizDir(fx.quizID); err != nil {
+	if err := fx.svc.RemoveQuizDir(t.Context(), fx.quizID); err != nil {
 		t.Fatalf("RemoveQuizDir err = %v, want nil", err)
 	}
 	if _, err := os.Stat(quizDir); !os.IsNotExist(err) {
@@ -801,7 +801,7 @@ func TestService_RemoveQuizDir(t *testing.T) {
 	}
 
 	// Removing an absent directory (a quiz that never had an upload) is a no-op.
-	if err := fx.svc.RemoveQuizDir(fx.quizID + 999); err != nil {
+	if err := fx.svc.RemoveQuizDir(t.Context(), fx.quizID+999); err != nil {
 		t.Errorf("RemoveQuizDir(absent) err = %v, want nil", err)
 	}
 }
@@ -0,0 +1,189 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrObjectNotFound is returned by a [Storage] Get when no object is stored
+// under the key.
+var ErrObjectNotFound = errors.New("media object not found")
+
+// ErrStorageRootNotDir is returned by [LocalStorage.Ping] when the media root
+// exists but is not a directory.
+var ErrStorageRootNotDir = errors.New("media root is not a directory")
+
+// Storage is where the bytes of stored media live, as opposed to [Store],
+// which records the rows describing them. Keys are the slash-separated
+// root-relative paths recorded on a row (<quizID>/<id>.jpg), so a row reads
+// the same whichever backend holds its files. The local directory
+// ([LocalStorage]) is the default; [S3Storage] puts the files in an
+// S3-compatible bucket instead.
+type Storage interface {
+	// Put stores the object under key, replacing any existing one. A reader
+	// of the key never sees a partially written object.
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Get opens the object under key for reading, or returns
+	// ErrObjectNotFound. The caller closes the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object under key. A missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix removes every object whose key sits under the prefix
+	// directory, for dropping a whole quiz's files at once.
+	DeletePrefix(ctx context.Context, prefix string) error
+	// URL returns an address a browser can fetch the object from directly,
+	// or "" when the object is only reachable through the app.
+	URL(key string) string
+	// Ping reports whether the backend is reachable, for the readiness probe.
+	Ping(ctx context.Context) error
+}
+
+// LocalStorage keeps media as files under a root directory, one
+// subdirectory per quiz. It is the default backend.
+type LocalStorage struct {
+	root string
+}
+
+// NewLocalStorage returns a Storage writing files under root. The caller
+// ensures root exists at startup; per-quiz subdirectories are created on
+// first write.
+func NewLocalStorage(root string) *LocalStorage {
+	return &LocalStorage{root: root}
+}
+
+// Put writes the object with a temp-then-rename pattern so a SIGKILL or crash
+// mid-write can't leave a half-written file under the key: the bytes land in
+// <key>.tmp first and are atomically renamed into place.
+func (l *LocalStorage) Put(_ context.Context, key string, r io.Reader, _ string) error {
+	path, err := l.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return fmt.Errorf("creating media directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err = writeFile(tmp, r); err != nil {
+		_ = os.Remove(tmp)
+
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+
+		return fmt.Errorf("publishing media file: %w", err)
+	}
+
+	return nil
+}
+
+// writeFile copies r into a new file at path.
+func writeFile(path string, r io.Reader) error {
+	// path is confined to the media root by resolve.
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, filePerm) //nolint:gosec // path confined to root by resolve
+	if err != nil {
+		return fmt.Errorf("creating media file: %w", err)
+	}
+	if _, err = io.Copy(f, r); err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("writing media file: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("closing media file: %w", err)
+	}
+
+	return nil
+}
+
+// Get opens the file under key. The returned reader is the [os.File] itself,
+// so a caller can seek it for range requests. A key that would escape the
+// root (a traversal via "..") is rejected with ErrPathEscapesRoot rather than
+// opened, so a corrupt or hostile DB value cannot read an arbitrary file.
+func (l *LocalStorage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+	// resolve confines the path to root via filepath.Rel, so the variable open
+	// cannot reach outside the media directory.
+	f, err := os.Open(path) //nolint:gosec // path confined to root by resolve
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %q", ErrObjectNotFound, key)
+		}
+
+		return nil, fmt.Errorf("opening media file: %w", err)
+	}
+
+	return f, nil
+}
+
+// Delete unlinks the file under key. A missing file is not an error.
+func (l *LocalStorage) Delete(_ context.Context, key string) error {
+	path, err := l.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing media file: %w", err)
+	}
+
+	return nil
+}
+
+// DeletePrefix removes the directory under prefix and everything in it. A
+// missing directory is not an error. The root itself is never removed.
+func (l *LocalStorage) DeletePrefix(_ context.Context, prefix string) error {
+	path, err := l.resolve(prefix)
+	if err != nil {
+		return err
+	}
+	if filepath.Clean(path) == filepath.Clean(l.root) {
+		return fmt.Errorf("%w: refusing to remove the media root", ErrPathEscapesRoot)
+	}
+	if err = os.RemoveAll(path); err != nil {
+		return fmt.Errorf("removing media directory: %w", err)
+	}
+
+	return nil
+}
+
+// URL returns "": local files are only reachable through the serving
+// handlers, which apply the owning quiz's access rule.
+func (*LocalStorage) URL(string) string {
+	return ""
+}
+
+// Ping reports whether the root directory is there to write under, for the
+// readiness probe.
+func (l *LocalStorage) Ping(context.Context) error {
+	info, err := os.Stat(l.root)
+	if err != nil {
+		return fmt.Errorf("checking media directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("checking media directory %q: %w", l.root, ErrStorageRootNotDir)
+	}
+
+	return nil
+}
+
+// resolve joins a slash-separated key to root and confirms it stays under
+// root. A key that climbs out via ".." yields ErrPathEscapesRoot. Returns the
+// on-disk path on success.
+func (l *LocalStorage) resolve(key string) (string, error) {
+	joined := filepath.Join(l.root, filepath.FromSlash(key))
+	rel, err := filepath.Rel(l.root, joined)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %q", ErrPathEscapesRoot, key)
+	}
+
+	return joined, nil
+}
@@ -0,0 +1,113 @@
+package media_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/media"
+)
+
+func TestLocalStorage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("put then get round-trips without leaving a temp file", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+		st := NewLocalStorage(root)
+		if err := st.Put(t.Context(), "7/12.jpg", strings.NewReader("jpeg bytes"), "image/jpeg"); err != nil {
+			t.Fatalf("Put err = %v, want nil", err)
+		}
+
+		rc, err := st.Get(t.Context(), "7/12.jpg")
+		if err != nil {
+			t.Fatalf("Get err = %v, want nil", err)
+		}
+		got, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatalf("read err = %v, want nil", err)
+		}
+		if want := "jpeg bytes"; string(got) != want {
+			t.Errorf("Get = %q, want %q", got, want)
+		}
+		if _, ok := rc.(io.Seeker); !ok {
+			t.Error("Get reader is not seekable, want the file itself")
+		}
+		if _, err = os.Stat(filepath.Join(root, "7", "12.jpg.tmp")); !os.IsNotExist(err) {
+			t.Errorf("temp file stat err = %v, want not-exist", err)
+		}
+		if got := st.URL("7/12.jpg"); got != "" {
+			t.Errorf("URL = %q, want empty", got)
+		}
+	})
+
+	t.Run("ping checks the root directory", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+		if err := NewLocalStorage(root).Ping(t.Context()); err != nil {
+			t.Errorf("Ping err = %v, want nil", err)
+		}
+		if err := NewLocalStorage(filepath.Join(root, "missing")).Ping(t.Context()); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("Ping on a missing root err = %v, want os.ErrNotExist", err)
+		}
+		file := filepath.Join(root, "file")
+		if err := os.WriteFile(file, nil, 0o600); err != nil {
+			t.Fatalf("WriteFile err = %v", err)
+		}
+		if err := NewLocalStorage(file).Ping(t.Context()); !errors.Is(err, ErrStorageRootNotDir) {
+			t.Errorf("Ping on a file root err = %v, want ErrStorageRootNotDir", err)
+		}
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		t.Parallel()
+
+		st := NewLocalStorage(t.TempDir())
+		if _, err := st.Get(t.Context(), "7/404.jpg"); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("Get err = %v, want ErrObjectNotFound", err)
+		}
+		if err := st.Delete(t.Context(), "7/404.jpg"); err != nil {
+			t.Errorf("Delete err = %v, want nil", err)
+		}
+	})
+
+	t.Run("keys cannot escape the root", func(t *testing.T) {
+		t.Parallel()
+
+		st := NewLocalStorage(t.TempDir())
+		if err := st.Put(t.Context(), "../evil", strings.NewReader("x"), ""); !errors.Is(err, ErrPathEscapesRoot) {
+			t.Errorf("Put err = %v, want ErrPathEscapesRoot", err)
+		}
+		if err := st.DeletePrefix(t.Context(), "."); !errors.Is(err, ErrPathEscapesRoot) {
+			t.Errorf("DeletePrefix(root) err = %v, want ErrPathEscapesRoot", err)
+		}
+	})
+
+	t.Run("delete prefix drops the quiz directory only", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+		st := NewLocalStorage(root)
+		for _, key := range []string{"7/1.jpg", "7/1-thumb.jpg", "8/2.jpg"} {
+			if err := st.Put(t.Context(), key, strings.NewReader("x"), ""); err != nil {
+				t.Fatalf("Put(%q) err = %v, want nil", key, err)
+			}
+		}
+
+		if err := st.DeletePrefix(t.Context(), "7"); err != nil {
+			t.Fatalf("DeletePrefix err = %v, want nil", err)
+		}
+		if _, err := os.Stat(filepath.Join(root, "7")); !os.IsNotExist(err) {
+			t.Errorf("quiz 7 dir stat err = %v, want not-exist", err)
+		}
+		if _, err := os.Stat(filepath.Join(root, "8", "2.jpg")); err != nil {
+			t.Errorf("quiz 8 file stat err = %v, want it kept", err)
+		}
+	})
+}
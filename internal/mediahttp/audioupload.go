@@ -14,6 +14,7 @@ import (
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/handlers"
 	"github.com/starquake/topbanana/internal/media"
+	"github.com/starquake/topbanana/internal/ratelimit"
 )
 
 const (
@@ -85,7 +86,9 @@ func HandleAudioUpload(
 			return
 		}
 
-		if allowed, retryAfter := budget.Charge(player.ID, 1); !allowed {
+		allowed, retryAfter := budget.Charge(player.ID, 1)
+		ratelimit.SetHeaders(w, budget.Status(player.ID))
+		if !allowed {
 			writeRateLimited(w, retryAfter)
 
 			return
@@ -32,3 +32,11 @@ func UploadBudgetLimiterEntryCount(l *UploadBudgetLimiter) int {
 
 	return len(l.charges)
 }
+
+// NewImageFetcherWithClient exposes the client-injected fetcher constructor so
+// tests can fetch from an httptest server on loopback, which the production
+// dialer guard refuses.
+var NewImageFetcherWithClient = newImageFetcher
+
+// PageImage exposes the unexported page-metadata scan for tests.
+var PageImage = pageImage
@@ -0,0 +1,307 @@
+package mediahttp
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/starquake/topbanana/internal/media"
+)
+
+// Errors returned by [ImageFetcher.Fetch]. Each is a host-recoverable input
+// problem (a typo'd or unreachable URL, a page with no image) rather than a
+// server fault, so the from-URL handler maps all of them to a 400.
+var (
+	// ErrInvalidFetchURL is returned for a URL that is not an absolute http or
+	// https address, or that carries credentials.
+	ErrInvalidFetchURL = errors.New("not an http or https URL")
+	// ErrFetchBlocked is returned when the URL resolves to a loopback, private,
+	// link-local or otherwise non-public address. The fetcher runs with the
+	// server's network access, so it must not become a way to probe the
+	// deployment's own network.
+	ErrFetchBlocked = errors.New("address not allowed")
+	// ErrFetchFailed is returned when the remote server could not be reached
+	// or answered with a non-2xx status.
+	ErrFetchFailed = errors.New("could not fetch URL")
+	// ErrNoImageFound is returned when the URL is neither an image nor an HTML
+	// page naming one in its og:image / twitter:image metadata.
+	ErrNoImageFound = errors.New("no image found at URL")
+)
+
+const (
+	// fetchTimeout bounds one whole fetch, page and image together, so a slow
+	// remote server cannot pin the request.
+	fetchTimeout = 15 * time.Second
+
+	// fetchDialTimeout bounds the TCP connect to the remote server.
+	fetchDialTimeout = 5 * time.Second
+
+	// maxFetchRedirects caps the redirect chain; each hop is re-checked by the
+	// dialer guard.
+	maxFetchRedirects = 3
+
+	// maxPageBytes caps how much of an HTML page is read looking for its
+	// metadata. The og:/twitter: tags live in <head>, well within this.
+	maxPageBytes = 1 << 20
+
+	// maxAltLen bounds the alt text taken from page metadata before the media
+	// service's own description cap applies.
+	maxAltLen = 200
+
+	// fetchUserAgent identifies the fetcher to the remote server.
+	fetchUserAgent = "TopBanana-ImageFetcher/1.0"
+)
+
+// metaTagRe matches a <meta> tag; metaAttrRe pulls its attributes. A regexp
+// scan rather than a full HTML parse: the fetcher only needs a handful of
+// head tags, and a page that hides them in odd markup simply yields no image.
+var (
+	metaTagRe  = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	metaAttrRe = regexp.MustCompile(`(?is)([a-z:_-]+)\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+)`)
+	titleRe    = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+)
+
+// FetchedImage is the result of [ImageFetcher.Fetch]: the raw image bytes,
+// ready for the media pipeline, plus a filename and alt text to prefill the
+// stored row with.
+type FetchedImage struct {
+	// Data is the image body, at most the fetcher's byte cap. It is not yet
+	// validated as an image; the media pipeline does that.
+	Data []byte
+	// Filename is the last path segment of the image URL, used as the stored
+	// row's original filename.
+	Filename string
+	// Alt is the alt text taken from the page metadata (og:image:alt, then
+	// twitter:image:alt, then the page title), or the filename without its
+	// extension for a direct image link. Empty when neither yields anything.
+	Alt string
+}
+
+// ImageFetcher is the server-side image proxy behind question authoring from a
+// URL: it fetches a remote image (or an HTML page and the image its metadata
+// names) on the host's behalf so the browser never has to, and so the stored
+// copy is local rather than a hotlink that can change or vanish mid-quiz.
+//
+// Every connection goes through a dialer that refuses non-public addresses,
+// redirects included, and responses are read through a byte cap.
+type ImageFetcher struct {
+	client   *http.Client
+	maxBytes int64
+}
+
+// NewImageFetcher returns an ImageFetcher that reads at most maxBytes of an
+// image (zero or less means [media.MaxUploadBytes]) and refuses to connect to
+// any non-public address.
+func NewImageFetcher(maxBytes int64) *ImageFetcher {
+	if maxBytes <= 0 {
+		maxBytes = media.MaxUploadBytes
+	}
+	dialer := &net.Dialer{Timeout: fetchDialTimeout, Control: publicAddressOnly}
+	transport := &http.Transport{
+		// No proxy: a proxy dial would hide the real destination from the
+		// address guard.
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   fetchDialTimeout,
+		ResponseHeaderTimeout: fetchTimeout,
+		MaxIdleConns:          1,
+		IdleConnTimeout:       fetchTimeout,
+	}
+
+	return newImageFetcher(&http.Client{Transport: transport}, maxBytes)
+}
+
+// newImageFetcher wraps client with the fetcher's timeout and redirect policy.
+// Tests pass a client without the address guard so they can fetch from an
+// httptest server on loopback.
+func newImageFetcher(client *http.Client, maxBytes int64) *ImageFetcher {
+	c := *client
+	c.Timeout = fetchTimeout
+	c.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxFetchRedirects {
+			return fmt.Errorf("%w: too many redirects", ErrFetchFailed)
+		}
+		if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+			return ErrInvalidFetchURL
+		}
+
+		return nil
+	}
+
+	return &ImageFetcher{client: &c, maxBytes: maxBytes}
+}
+
+// Fetch downloads the image at rawURL. A URL answering with an image is used
+// as-is; one answering with an HTML page is searched for an og:image /
+// twitter:image, which is then fetched (one level only). The returned Data is
+// not validated beyond its Content-Type; the caller runs it through the media
+// pipeline.
+func (f *ImageFetcher) Fetch(ctx context.Context, rawURL string) (*FetchedImage, error) {
+	u, err := parseFetchURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	body, mediaType, err := f.get(ctx, u, max(f.maxBytes, int64(maxPageBytes)))
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(mediaType, "image/") {
+		return imageResult(u, body, ""), nil
+	}
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, ErrNoImageFound
+	}
+
+	page := string(body[:min(len(body), maxPageBytes)])
+	imageRef, alt := pageImage(page)
+	if imageRef == "" {
+		return nil, ErrNoImageFound
+	}
+	imageURL, err := u.Parse(html.UnescapeString(imageRef))
+	if err != nil {
+		return nil, ErrNoImageFound
+	}
+	if imageURL, err = parseFetchURL(imageURL.String()); err != nil {
+		return nil, err
+	}
+
+	body, mediaType, err = f.get(ctx, imageURL, f.maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(mediaType, "image/") {
+		return nil, ErrNoImageFound
+	}
+
+	return imageResult(imageURL, body, alt), nil
+}
+
+// imageResult builds the FetchedImage for an image body fetched from u. An
+// empty alt falls back to the filename without its extension.
+func imageResult(u *url.URL, body []byte, alt string) *FetchedImage {
+	filename := path.Base(u.Path)
+	if filename == "." || filename == "/" {
+		filename = ""
+	}
+	if alt == "" {
+		alt = strings.TrimSuffix(filename, path.Ext(filename))
+	}
+
+	return &FetchedImage{Data: body, Filename: filename, Alt: alt}
+}
+
+// get fetches u and returns at most limit+1 bytes of its body (one past the
+// cap, so the media pipeline still sees an oversized image as too large rather
+// than as a truncated one) along with the response's bare media type.
+func (f *ImageFetcher) get(ctx context.Context, u *url.URL, limit int64) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrInvalidFetchURL, err)
+	}
+	req.Header.Set("User-Agent", fetchUserAgent)
+	req.Header.Set("Accept", "image/*,text/html;q=0.9")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		if errors.Is(err, ErrFetchBlocked) || errors.Is(err, ErrInvalidFetchURL) {
+			return nil, "", err //nolint:wrapcheck // already one of this package's sentinels.
+		}
+
+		return nil, "", fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, "", fmt.Errorf("%w: status %d", ErrFetchFailed, resp.StatusCode)
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: reading body: %w", ErrFetchFailed, err)
+	}
+
+	return body, strings.ToLower(mediaType), nil
+}
+
+// parseFetchURL accepts only an absolute http(s) URL with a host and no
+// userinfo.
+func parseFetchURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return nil, ErrInvalidFetchURL
+	}
+
+	return u, nil
+}
+
+// publicAddressOnly is the dialer Control hook: it runs after DNS resolution,
+// on the exact address about to be dialled, so a hostname that resolves to an
+// internal address (or a redirect to one) is refused just like a literal IP.
+func publicAddressOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFetchBlocked, err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return ErrFetchBlocked
+	}
+
+	return nil
+}
+
+// isPublicIP reports whether ip is a globally routable unicast address.
+func isPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsUnspecified()
+}
+
+// pageImage returns the image a page's metadata names (og:image, then
+// og:image:url, then twitter:image) and the best alt text for it (og:image:alt,
+// then twitter:image:alt, then og:title, then <title>). Both are empty when the
+// page names no image.
+func pageImage(page string) (imageRef, alt string) {
+	meta := map[string]string{}
+	for _, tag := range metaTagRe.FindAllString(page, -1) {
+		attrs := map[string]string{}
+		for _, m := range metaAttrRe.FindAllStringSubmatch(tag, -1) {
+			attrs[strings.ToLower(m[1])] = strings.TrimSpace(strings.Trim(m[2], `"'`))
+		}
+		key := strings.ToLower(cmp.Or(attrs["property"], attrs["name"]))
+		if _, seen := meta[key]; key != "" && !seen {
+			meta[key] = attrs["content"]
+		}
+	}
+
+	imageRef = cmp.Or(meta["og:image"], meta["og:image:url"], meta["twitter:image"])
+	if imageRef == "" {
+		return "", ""
+	}
+
+	alt = cmp.Or(meta["og:image:alt"], meta["twitter:image:alt"], meta["og:title"])
+	if alt == "" {
+		if m := titleRe.FindStringSubmatch(page); m != nil {
+			alt = m[1]
+		}
+	}
+	alt = strings.Join(strings.Fields(html.UnescapeString(alt)), " ")
+	if r := []rune(alt); len(r) > maxAltLen {
+		alt = strings.TrimSpace(string(r[:maxAltLen]))
+	}
+
+	return strings.TrimSpace(imageRef), alt
+}
@@ -0,0 +1,154 @@
+package mediahttp_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/mediahttp"
+)
+
+func TestPageImage(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name      string
+		page      string
+		wantImage string
+		wantAlt   string
+	}{
+		{
+			name: "og image with alt",
+			page: `<head><meta property="og:image" content="/a.png">` +
+				`<meta property="og:image:alt" content="A red &amp; blue flag"></head>`,
+			wantImage: "/a.png",
+			wantAlt:   "A red & blue flag",
+		},
+		{
+			name:      "twitter image falls back to og title",
+			page:      `<meta name="twitter:image" content='https://cdn.example/b.jpg'><meta property="og:title" content="Flags">`,
+			wantImage: "https://cdn.example/b.jpg",
+			wantAlt:   "Flags",
+		},
+		{
+			name:      "attribute order and case do not matter",
+			page:      `<META CONTENT="c.png" PROPERTY="og:image"><title> The   Title </title>`,
+			wantImage: "c.png",
+			wantAlt:   "The Title",
+		},
+		{
+			name: "no image",
+			page: `<title>Nothing here</title><meta name="description" content="x">`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotImage, gotAlt := mediahttp.PageImage(tc.page)
+			if gotImage != tc.wantImage || gotAlt != tc.wantAlt {
+				t.Errorf("PageImage() = (%q, %q), want (%q, %q)", gotImage, gotAlt, tc.wantImage, tc.wantAlt)
+			}
+		})
+	}
+}
+
+func TestImageFetcher_Fetch(t *testing.T) {
+	t.Parallel()
+
+	var pic bytes.Buffer
+	if err := png.Encode(&pic, image.NewRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatalf("png.Encode err = %v, want nil", err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/img/flag.png", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(pic.Bytes())
+	})
+	mux.HandleFunc("/page", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<html><head><meta property="og:image" content="/img/flag.png">` +
+			`<meta property="og:image:alt" content="The flag of Japan"></head></html>`))
+	})
+	mux.HandleFunc("/bare", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<html><head><title>No preview</title></head></html>`))
+	})
+	mux.HandleFunc("/text", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("hello"))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	fetcher := mediahttp.NewImageFetcherWithClient(srv.Client(), 1<<20)
+
+	t.Run("direct image uses the filename as alt", func(t *testing.T) {
+		t.Parallel()
+		got, err := fetcher.Fetch(t.Context(), srv.URL+"/img/flag.png")
+		if err != nil {
+			t.Fatalf("Fetch err = %v, want nil", err)
+		}
+		if !bytes.Equal(got.Data, pic.Bytes()) {
+			t.Errorf("Data = %d bytes, want the served %d", len(got.Data), pic.Len())
+		}
+		if got.Filename != "flag.png" || got.Alt != "flag" {
+			t.Errorf("Filename, Alt = %q, %q, want %q, %q", got.Filename, got.Alt, "flag.png", "flag")
+		}
+	})
+
+	t.Run("page follows og:image and takes its alt", func(t *testing.T) {
+		t.Parallel()
+		got, err := fetcher.Fetch(t.Context(), srv.URL+"/page")
+		if err != nil {
+			t.Fatalf("Fetch err = %v, want nil", err)
+		}
+		if !bytes.Equal(got.Data, pic.Bytes()) {
+			t.Errorf("Data = %d bytes, want the og:image's %d", len(got.Data), pic.Len())
+		}
+		if got, want := got.Alt, "The flag of Japan"; got != want {
+			t.Errorf("Alt = %q, want %q", got, want)
+		}
+	})
+
+	errCases := []struct {
+		name string
+		url  string
+		want error
+	}{
+		{"page without an image", srv.URL + "/bare", mediahttp.ErrNoImageFound},
+		{"neither image nor page", srv.URL + "/text", mediahttp.ErrNoImageFound},
+		{"not found", srv.URL + "/missing", mediahttp.ErrFetchFailed},
+		{"non-http scheme", "ftp://example.com/a.png", mediahttp.ErrInvalidFetchURL},
+		{"credentials in URL", "https://user:pw@example.com/a.png", mediahttp.ErrInvalidFetchURL},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := fetcher.Fetch(t.Context(), tc.url); !errors.Is(err, tc.want) {
+				t.Errorf("Fetch(%q) err = %v, want %v", tc.url, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewImageFetcher_RefusesNonPublicAddresses(t *testing.T) {
+	t.Parallel()
+
+	// Nothing needs to listen: the guard refuses before the connect. A
+	// hostname resolving to loopback is refused the same way as a literal.
+	fetcher := mediahttp.NewImageFetcher(0)
+	for _, target := range []string{
+		"http://127.0.0.1:1/a.png",
+		"http://[::1]:1/a.png",
+		"http://10.0.0.1:1/a.png",
+		"http://169.254.169.254/latest/meta-data",
+		"http://localhost:1/a.png",
+	} {
+		if _, err := fetcher.Fetch(t.Context(), target); !errors.Is(err, mediahttp.ErrFetchBlocked) {
+			t.Errorf("Fetch(%q) err = %v, want %v", target, err, mediahttp.ErrFetchBlocked)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package mediahttp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/media"
+	"github.com/starquake/topbanana/internal/ratelimit"
+)
+
+// fromURLFormField is the form field carrying the URL to fetch.
+const fromURLFormField = "url"
+
+// RemoteImageFetcher is the slice of *ImageFetcher the from-URL handler uses,
+// so a test can substitute a double that never touches the network.
+type RemoteImageFetcher interface {
+	// Fetch downloads the image at rawURL, following an HTML page's og:image
+	// metadata, and returns its bytes with a filename and alt text.
+	Fetch(ctx context.Context, rawURL string) (*FetchedImage, error)
+}
+
+// fromURLResultJSON is the wire shape of a successful from-URL fetch: the same
+// fields the inline upload returns for a stored file, plus the alt text the
+// row was prefilled with so the question form can show it straight away.
+type fromURLResultJSON struct {
+	uploadResultJSON
+
+	Alt string `json:"alt,omitempty"`
+}
+
+// HandleMediaFromURL serves POST /admin/quizzes/{quizID}/media/from-url: it
+// fetches the image at the posted url through fetcher (the server-side image
+// proxy), runs it through the same pipeline as an upload, stores the local
+// copy in the quiz's library, and prefills the row's description - the alt
+// text - from the page metadata. One action replaces the save-then-upload
+// round trip when authoring a picture round.
+//
+// It applies the upload route's gates in the upload route's order: the
+// per-quiz edit gate (opaque 404, 409 when published), the per-quiz image
+// ceiling (409), then one charge against the host's upload budget (429), so a
+// fetch costs the same as uploading one file. A missing or unusable URL, a
+// blocked address, an unreachable server, a page with no image, and a pipeline
+// rejection are all 400 with a short plain-text reason. A store failure is 500.
+//
+// The response is always JSON: the form's "Add from URL" box is JS-only, like
+// the inline upload next to it.
+func HandleMediaFromURL(
+	logger *slog.Logger, svc MediaService, quizzes QuizEditLookup,
+	budget *UploadBudgetLimiter, quizImageLimit int, fetcher RemoteImageFetcher,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		quizID, ok := handlers.ParseIDFromPath(w, r, logger, "quizID")
+		if !ok {
+			return
+		}
+
+		player, ok := auth.PlayerFromContext(r.Context())
+		if !ok {
+			logger.ErrorContext(r.Context(), "media from-url reached handler without a player on context")
+			http.Error(w, internalErrorMessage, http.StatusInternalServerError)
+
+			return
+		}
+
+		if !authorizeQuizEdit(w, r, logger, quizzes, quizID, player) {
+			return
+		}
+
+		rawURL := r.PostFormValue(fromURLFormField)
+		if rawURL == "" {
+			http.Error(w, "missing url", http.StatusBadRequest)
+
+			return
+		}
+
+		if !checkQuizMediaLimit(w, r, logger, svc, quizID, 1, quizImageLimit, media.TypeImage) {
+			return
+		}
+
+		allowed, retryAfter := budget.Charge(player.ID, 1)
+		ratelimit.SetHeaders(w, budget.Status(player.ID))
+		if !allowed {
+			writeRateLimited(w, retryAfter)
+
+			return
+		}
+
+		fetched, err := fetcher.Fetch(r.Context(), rawURL)
+		if err != nil {
+			writeFetchError(w, r, logger, err)
+
+			return
+		}
+
+		storeFetchedImage(w, r, logger, svc, quizID, player.ID, fetched)
+	})
+}
+
+// storeFetchedImage stores a fetched image in the quiz's library, prefills the
+// row's description with its alt text, and writes the JSON result. The image is
+// already stored when the description is written, so a failed write only loses
+// the prefill, which the host can type in: it is logged, not fatal.
+func storeFetchedImage(
+	w http.ResponseWriter, r *http.Request, logger *slog.Logger,
+	svc MediaService, quizID, playerID int64, fetched *FetchedImage,
+) {
+	stored, err := svc.StoreImage(r.Context(), quizID, playerID, fetched.Filename, bytes.NewReader(fetched.Data))
+	if err != nil {
+		writeUploadError(w, r, logger, err)
+
+		return
+	}
+	alt := fetched.Alt
+	if alt != "" {
+		if err = svc.UpdateDescription(r.Context(), stored.ID, alt); err != nil {
+			logger.WarnContext(r.Context(), "error prefilling media description from URL metadata",
+				slog.Int64("mediaID", stored.ID), slog.Any("err", err))
+			alt = ""
+		}
+	}
+
+	res := fromURLResultJSON{
+		uploadResultJSON: uploadResultJSON{
+			Filename: fetched.Filename,
+			ID:       stored.ID,
+			URL:      fmt.Sprintf("/media/%d", stored.ID),
+			ThumbURL: fmt.Sprintf("/media/%d/thumb", stored.ID),
+		},
+		Alt: alt,
+	}
+	if err = handlers.EncodeJSON(w, http.StatusOK, res); err != nil {
+		logger.ErrorContext(r.Context(), "error encoding media from-url response", slog.Any("err", err))
+	}
+}
+
+// writeFetchError maps an [ImageFetcher.Fetch] error to its response. Every
+// fetcher sentinel is the host's input problem and gets a 400 with a short
+// reason; a cancelled request (checked first, since a cancel mid-fetch also
+// wraps ErrFetchFailed) gets the 499 the upload path uses; anything else
+// is logged and returned as 500.
+func writeFetchError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidFetchURL):
+		http.Error(w, "enter a full http:// or https:// address", http.StatusBadRequest)
+	case errors.Is(err, ErrFetchBlocked):
+		http.Error(w, "that address cannot be fetched", http.StatusBadRequest)
+	case errors.Is(err, ErrNoImageFound):
+		http.Error(w, "no image found at that address (use a jpg or png link, or a page with a preview image)",
+			http.StatusBadRequest)
+	case errors.Is(err, context.Canceled):
+		w.WriteHeader(httpStatusClientClosedRequest)
+	case errors.Is(err, ErrFetchFailed):
+		logger.InfoContext(r.Context(), "media from-url fetch failed", slog.Any("err", err))
+		http.Error(w, "could not fetch that address", http.StatusBadRequest)
+	default:
+		logger.ErrorContext(r.Context(), "error fetching media from URL", slog.Any("err", err))
+		http.Error(w, internalErrorMessage, http.StatusInternalServerError)
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"context"
 	"io"
 	"net/http"
-	"os"
 
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/media"
@@ -49,12 +48,15 @@ type MediaService interface {
 	// media.ErrMediaNotFound when the id does not name a row.
 	Delete(ctx context.Context, id int64) error
 	// Open opens a stored media file for reading by its root-relative path. The
-	// caller closes the returned file.
-	Open(relPath string) (*os.File, error)
+	// caller closes the returned reader.
+	Open(ctx context.Context, relPath string) (io.ReadCloser, error)
+	// URL returns an address a browser can fetch the stored file from directly,
+	// or "" when it is only reachable through the serving handlers.
+	URL(relPath string) string
 	// CountByQuizAndType returns how many ready media rows of mediaType a quiz
 	// has, so each upload route can enforce a per-type library ceiling before
 	// storing a new batch.
-	CountByQuizAndType(ctx context.Context, quizID int64, mediaType string) (int64, error)
+	CountByQuizAndType(ctx context.Context, quizID int64, mediaType media.Type) (int64, error)
 }
 
 // QuizVisibilityLookup is the slice of the quiz store the serving handlers use
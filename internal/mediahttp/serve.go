@@ -1,7 +1,9 @@
 package mediahttp
 
 import (
+	"bytes"
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -91,6 +93,17 @@ func serveMedia(
 			return
 		}
 
+		// A public quiz's file held in storage with a public address is
+		// fetched from there: the gate above has nothing to hide, and the
+		// bytes no longer pass through the app. Anything else is proxied so
+		// the gate holds for every read.
+		if u := svc.URL(relPath); u != "" && visibility == quiz.VisibilityPublic {
+			w.Header().Set("Cache-Control", publicCacheControl)
+			http.Redirect(w, r, u, http.StatusFound)
+
+			return
+		}
+
 		streamMedia(w, r, logger, svc, m, relPath, visibility)
 	})
 }
@@ -134,13 +147,15 @@ func authorizeMediaRead(
 // streamMedia opens and streams the chosen file via [http.ServeContent], which
 // handles the ETag / If-None-Match / Range dance. The Content-Type is the row's
 // stored mime, the ETag is the stored image's sha256 (quoted, a strong
-// validator), and Cache-Control is keyed to visibility.
+// validator), and Cache-Control is keyed to visibility. A local file is served
+// straight from disk; an object from remote storage is not seekable, so it is
+// read into memory first (uploads are capped at a few megabytes).
 func streamMedia(
 	w http.ResponseWriter, r *http.Request,
 	logger *slog.Logger, svc MediaService, m *media.Media, relPath, visibility string,
 ) {
 	ctx := r.Context()
-	f, err := svc.Open(relPath)
+	f, err := svc.Open(ctx, relPath)
 	if err != nil {
 		if errors.Is(err, media.ErrPathEscapesRoot) {
 			http.NotFound(w, r)
@@ -158,6 +173,18 @@ func streamMedia(
 		}
 	}()
 
+	content, ok := f.(io.ReadSeeker)
+	if !ok {
+		body, rErr := io.ReadAll(f)
+		if rErr != nil {
+			logger.ErrorContext(ctx, "error reading media file", slog.Any("err", rErr))
+			http.Error(w, "internal error", http.StatusInternalServerError)
+
+			return
+		}
+		content = bytes.NewReader(body)
+	}
+
 	w.Header().Set("Content-Type", m.MIME)
 	w.Header().Set("ETag", strconv.Quote(m.SHA256))
 	if visibility == quiz.VisibilityPublic {
@@ -170,5 +197,5 @@ func streamMedia(
 	// If-None-Match with 304, so the conditional-request handling is not
 	// reimplemented here. The name is only used for content-type sniffing, which
 	// our explicit Content-Type pre-empts; created_at is the modtime.
-	http.ServeContent(w, r, m.Path, m.CreatedAt, f)
+	http.ServeContent(w, r, m.Path, m.CreatedAt, content)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/starquake/topbanana/internal/handlers"
 	"github.com/starquake/topbanana/internal/media"
 	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/ratelimit"
 )
 
 const (
@@ -184,7 +185,9 @@ func HandleMediaUpload(
 
 		// Per-host file budget over a rolling window: charges len(files) so the
 		// one-request-per-file form JS cannot bypass the per-request count cap.
-		if allowed, retryAfter := budget.Charge(player.ID, len(files)); !allowed {
+		allowed, retryAfter := budget.Charge(player.ID, len(files))
+		ratelimit.SetHeaders(w, budget.Status(player.ID))
+		if !allowed {
 			writeRateLimited(w, retryAfter)
 
 			return
@@ -226,7 +229,7 @@ func HandleMediaUpload(
 // the budget charge so a 409 never leaves a charge behind (#988).
 func checkQuizMediaLimit(
 	w http.ResponseWriter, r *http.Request, logger *slog.Logger,
-	svc MediaService, quizID int64, incoming, limit int, mediaType string,
+	svc MediaService, quizID int64, incoming, limit int, mediaType media.Type,
 ) bool {
 	if limit <= 0 {
 		return true
@@ -407,10 +410,14 @@ func wantsJSON(r *http.Request) bool {
 // what the client sent so a progress row can be matched up by name even when
 // the responses arrive out of order. ID and Reason are mutually exclusive: ID
 // is set on success (the new media row's id, also the URL suffix), Reason on
-// a pipeline rejection.
+// a pipeline rejection. URL and ThumbURL accompany ID so a client that
+// attaches the fresh upload inline (the question form's image picker) does
+// not have to know the /media route layout.
 type uploadResultJSON struct {
 	Filename string `json:"filename"`
 	ID       int64  `json:"id,omitempty"`
+	URL      string `json:"url,omitempty"`
+	ThumbURL string `json:"thumbUrl,omitempty"`
 	Reason   string `json:"reason,omitempty"`
 }
 
@@ -447,6 +454,8 @@ func writeUploadJSON(w http.ResponseWriter, r *http.Request, logger *slog.Logger
 		resp.Uploaded = append(resp.Uploaded, uploadResultJSON{
 			Filename: res.Filename,
 			ID:       res.MediaID,
+			URL:      fmt.Sprintf("/media/%d", res.MediaID),
+			ThumbURL: fmt.Sprintf("/media/%d/thumb", res.MediaID),
 		})
 	}
 
@@ -159,6 +159,8 @@ func TestWriteUploadJSON(t *testing.T) {
 		type uploadedItem struct {
 			Filename string `json:"filename"`
 			ID       int64  `json:"id"`
+			URL      string `json:"url"`
+			ThumbURL string `json:"thumbUrl"`
 		}
 		type failedItem struct {
 			Filename string `json:"filename"`
@@ -177,6 +179,12 @@ func TestWriteUploadJSON(t *testing.T) {
 		if got, want := payload.Uploaded[0].ID, int64(7); got != want {
 			t.Errorf("writeUploadJSON(pipeline only) uploaded[0].ID = %d, want %d", got, want)
 		}
+		if got, want := payload.Uploaded[0].URL, "/media/7"; got != want {
+			t.Errorf("writeUploadJSON(pipeline only) uploaded[0].URL = %q, want %q", got, want)
+		}
+		if got, want := payload.Uploaded[0].ThumbURL, "/media/7/thumb"; got != want {
+			t.Errorf("writeUploadJSON(pipeline only) uploaded[0].ThumbURL = %q, want %q", got, want)
+		}
 		if got, want := len(payload.Failed), 1; got != want {
 			t.Fatalf("writeUploadJSON(pipeline only) failed len = %d, want %d", got, want)
 		}
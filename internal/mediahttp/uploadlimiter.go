@@ -3,6 +3,8 @@ package mediahttp
 import (
 	"sync"
 	"time"
+
+	"github.com/starquake/topbanana/internal/ratelimit"
 )
 
 // UploadBudgetLimiter caps how many image files one host may upload within a
@@ -77,6 +79,28 @@ func (l *UploadBudgetLimiter) Charge(playerID int64, n int) (allowed bool, retry
 	return false, l.retryAfterFor(live, now, n)
 }
 
+// Status reports playerID's standing without charging: the budget, the files
+// left in the trailing window, and how long until the oldest in-window charge
+// ages out and frees one. A disabled limiter reports the zero Status.
+func (l *UploadBudgetLimiter) Status(playerID int64) ratelimit.Status {
+	if l.budget <= 0 || l.window <= 0 {
+		return ratelimit.Status{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+	live := l.charges[playerID]
+	st := ratelimit.Status{Limit: l.budget, Remaining: max(l.budget-len(live), 0)}
+	if len(live) > 0 {
+		st.Reset = live[0].Add(l.window).Sub(now)
+	}
+
+	return st
+}
+
 // retryAfterFor returns how long the caller should wait before n more files
 // fit under budget. prune has already dropped expired stamps, so live holds
 // only in-window charges sorted oldest-first (Charge only ever appends "now").
@@ -194,3 +194,35 @@ func TestUploadBudgetLimiter_Charge(t *testing.T) {
 		}
 	})
 }
+
+func TestUploadBudgetLimiter_Status(t *testing.T) {
+	t.Parallel()
+
+	const window = time.Minute
+	const player int64 = 42
+
+	clock := &mutableClock{now: time.Unix(0, 0)}
+	limiter := NewUploadBudgetLimiterWithClock(5, window, clock.Now)
+
+	if got := limiter.Status(player); got.Limit != 5 || got.Remaining != 5 || got.Reset != 0 {
+		t.Errorf("fresh Status = %+v, want 5 of 5 and no reset", got)
+	}
+
+	limiter.Charge(player, 3)
+	clock.Advance(20 * time.Second)
+	limiter.Charge(player, 1)
+	got := limiter.Status(player)
+	if got.Remaining != 1 {
+		t.Errorf("Status.Remaining = %d, want 1", got.Remaining)
+	}
+	if got, want := got.Reset, 40*time.Second; got != want {
+		t.Errorf("Status.Reset = %v, want %v until the oldest charge ages out", got, want)
+	}
+	if again := limiter.Status(player); again.Remaining != 1 {
+		t.Errorf("Status charged the budget: Remaining = %d, want 1", again.Remaining)
+	}
+
+	if got := NewUploadBudgetLimiter(0, window).Status(player); got.Limit != 0 {
+		t.Errorf("disabled Status = %+v, want the zero Status", got)
+	}
+}
@@ -0,0 +1,70 @@
+-- +goose Up
+-- tournaments group a sequence of solo quizzes played over days or weeks into
+-- one competition with cumulative standings. A host creates the tournament,
+-- shares its join_code, and appends a stage (quiz) whenever the next round of
+-- the competition is ready. Standings are not stored: they are summed on read
+-- from each member's games on the stage quizzes, so a reset or deleted game
+-- drops out of the standings the same way it drops out of the quiz leaderboard.
+--
+-- join_code uses the live-session room-code alphabet and is UNIQUE so the
+-- generator's collision retry can rely on the constraint. created_by_player_id
+-- is NULLABLE with ON DELETE SET NULL so a tournament outlives its host's
+-- account, mirroring quizzes.
+-- +goose StatementBegin
+CREATE TABLE tournaments
+(
+    id                   INTEGER  PRIMARY KEY,
+    title                TEXT     NOT NULL,
+    join_code            TEXT     NOT NULL UNIQUE,
+    created_by_player_id INTEGER           REFERENCES players (id) ON DELETE SET NULL,
+    created_at           DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- tournament_stages orders the tournament's quizzes. A quiz appears at most
+-- once per tournament; deleting the quiz or the tournament drops the stage.
+-- +goose StatementBegin
+CREATE TABLE tournament_stages
+(
+    tournament_id INTEGER  NOT NULL REFERENCES tournaments (id) ON DELETE CASCADE,
+    quiz_id       INTEGER  NOT NULL REFERENCES quizzes (id) ON DELETE CASCADE,
+    position      INTEGER  NOT NULL,
+    added_at      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tournament_id, quiz_id),
+    UNIQUE (tournament_id, position)
+);
+-- +goose StatementEnd
+
+-- tournament_players records who joined with the code. Only members appear in
+-- the standings; deleting the player (or sweeping an anonymous one) drops
+-- their membership.
+-- +goose StatementBegin
+CREATE TABLE tournament_players
+(
+    tournament_id INTEGER  NOT NULL REFERENCES tournaments (id) ON DELETE CASCADE,
+    player_id     INTEGER  NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    joined_at     DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tournament_id, player_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX tournament_players_player_idx ON tournament_players (player_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX tournament_players_player_idx;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE tournament_players;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE tournament_stages;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE tournaments;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- settings is a small key/value table for per-deployment configuration an
+-- Admin edits from the console rather than through an env var and a restart.
+-- The first user is the branding (name, logo, primary color) shown on the
+-- player and admin pages; a missing key means "use the built-in default", so
+-- a fresh database needs no seed rows.
+-- +goose StatementBegin
+CREATE TABLE settings
+(
+    key        TEXT     PRIMARY KEY,
+    value      TEXT     NOT NULL,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE settings;
+-- +goose StatementEnd
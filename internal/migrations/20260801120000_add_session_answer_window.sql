@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- window_started_at / window_expires_at record the answer window a live pick
+-- was scored against. The session's own question_started_at/expires_at are
+-- cleared when the room moves on, so without a per-answer copy a stored score
+-- cannot be recomputed after a scoring fix. Both stay NULL on rows scored
+-- before this migration; the recalculation tool reports those as not
+-- recomputable rather than guessing a window. A nullable ADD COLUMN is
+-- in-place in SQLite (no table rebuild).
+ALTER TABLE session_answers ADD COLUMN window_started_at DATETIME;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE session_answers ADD COLUMN window_expires_at DATETIME;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE session_answers DROP COLUMN window_expires_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE session_answers DROP COLUMN window_started_at;
+-- +goose StatementEnd
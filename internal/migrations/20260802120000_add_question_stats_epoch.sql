@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- questions.stats_epoch counts how many times a question's answer statistics
+-- have been reset. An edit that substantially changes the question text or its
+-- options can bump it, and every recorded pick (solo game_answers and live
+-- session_answers) is stamped with the question's epoch at insert time, so the
+-- per-question aggregates only count picks made against the current wording.
+-- Existing rows all land in epoch 0, which keeps today's history counted. NOT
+-- NULL with a constant default is an in-place ADD COLUMN in SQLite.
+ALTER TABLE questions ADD COLUMN stats_epoch INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE game_answers ADD COLUMN stats_epoch INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE session_answers ADD COLUMN stats_epoch INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE session_answers DROP COLUMN stats_epoch;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN stats_epoch;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE questions DROP COLUMN stats_epoch;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- game_questions.voided_at marks a question a host voided for one game after
+-- it turned out to be wrong: its answers stay recorded but score nothing in
+-- that game's results and on the quiz leaderboard. NULL means the question
+-- counts as usual, so existing rows are unaffected.
+ALTER TABLE game_questions ADD COLUMN voided_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_questions DROP COLUMN voided_at;
+-- +goose StatementEnd
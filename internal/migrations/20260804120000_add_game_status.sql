@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- games.status is the game's lifecycle: in_progress until the answer to the
+-- last question lands (finished) or the player ends it early via
+-- POST /api/games/{gameID}/finish (abandoned). finished_at stamps either
+-- terminal transition; a finished game issues no new questions and takes no
+-- new answers.
+ALTER TABLE games ADD COLUMN status TEXT NOT NULL DEFAULT 'in_progress'
+    CHECK (status IN ('in_progress', 'finished', 'abandoned'));
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE games ADD COLUMN finished_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Existing games that already had every quiz question issued, with none still
+-- inside its answer window, are finished. Their real end time was never
+-- recorded, so they are stamped with the migration time.
+UPDATE games
+SET status      = 'finished',
+    finished_at = CURRENT_TIMESTAMP
+WHERE (SELECT COUNT(*) FROM questions q WHERE q.quiz_id = games.quiz_id) > 0
+  AND (SELECT COUNT(*) FROM game_questions gq WHERE gq.game_id = games.id) >=
+      (SELECT COUNT(*) FROM questions q WHERE q.quiz_id = games.quiz_id)
+  AND NOT EXISTS (SELECT 1
+                  FROM game_questions gq
+                  WHERE gq.game_id = games.id
+                    AND gq.expired_at > CURRENT_TIMESTAMP
+                    AND NOT EXISTS (SELECT 1 FROM game_answers ga WHERE ga.game_question_id = gq.id));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE games DROP COLUMN finished_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE games DROP COLUMN status;
+-- +goose StatementEnd
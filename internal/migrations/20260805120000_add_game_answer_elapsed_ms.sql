@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- game_answers.elapsed_ms is the server's monotonic measurement of the time
+-- from the question's answer window opening to the answer arriving (less the
+-- bounded tappedAt latency refund), in milliseconds. Scoring prefers it over
+-- answered_at - started_at, which an NTP step between the two wall-clock
+-- reads can push negative or inflate. NULL when the server could not measure
+-- it (a restart between serving and answering) and on existing rows, which
+-- keep scoring on the wall-clock timestamps.
+ALTER TABLE game_answers ADD COLUMN elapsed_ms INTEGER;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN elapsed_ms;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Numeric "closest answer" questions. questions.kind tells a numeric question,
+-- where the player types a number, from the default multiple-choice kind.
+-- A numeric question keeps its answer key on a single option row, so every
+-- recorded answer still references an option and the existing option joins
+-- (leaderboard, statistics, exports) keep working: options.numeric_value is
+-- the correct value and tolerance_below / tolerance_above how far under or
+-- over it an answer may land and still score, the credit falling linearly
+-- to zero at each edge. The two tolerances are independent so a question can
+-- be forgiving on one side only. game_answers.numeric_value is the number the
+-- player typed; NULL on a multiple-choice pick. Existing rows are all
+-- multiple-choice and need no backfill.
+ALTER TABLE questions ADD COLUMN kind TEXT NOT NULL DEFAULT 'choice' CHECK (kind IN ('choice', 'numeric'));
+ALTER TABLE options ADD COLUMN numeric_value REAL;
+ALTER TABLE options ADD COLUMN tolerance_below REAL NOT NULL DEFAULT 0 CHECK (tolerance_below >= 0);
+ALTER TABLE options ADD COLUMN tolerance_above REAL NOT NULL DEFAULT 0 CHECK (tolerance_above >= 0);
+ALTER TABLE game_answers ADD COLUMN numeric_value REAL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN numeric_value;
+ALTER TABLE options DROP COLUMN tolerance_above;
+ALTER TABLE options DROP COLUMN tolerance_below;
+ALTER TABLE options DROP COLUMN numeric_value;
+ALTER TABLE questions DROP COLUMN kind;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- question_drafts holds the in-progress question form an admin has not saved
+-- yet, autosaved by the editor so a browser crash does not lose the work. One
+-- draft per (player, question): data is the form's fields as a JSON object of
+-- field name to values, restored when the admin reopens the form, and the row
+-- is dropped once the question saves. Deleting the player or the question
+-- takes its drafts with it.
+-- +goose StatementBegin
+CREATE TABLE question_drafts
+(
+    player_id   INTEGER  NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    question_id INTEGER  NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    data        TEXT     NOT NULL,
+    updated_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (player_id, question_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE question_drafts;
+-- +goose StatementEnd
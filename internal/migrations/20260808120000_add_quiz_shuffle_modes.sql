@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-quiz randomization. shuffle_questions plays each round's questions in a
+-- per-game order (rounds themselves stay in order, so their intro and recap
+-- cards still frame the right questions). keep_option_order turns off the
+-- per-game shuffle of each question's option buttons, which was unconditional
+-- before this column (#297); it is an opt-out so every existing quiz, and any
+-- quiz created without saying otherwise, keeps shuffling. Both orders are
+-- seeded from the game, so a reconnect sees the same sequence.
+ALTER TABLE quizzes ADD COLUMN shuffle_questions INTEGER NOT NULL DEFAULT 0 CHECK (shuffle_questions IN (0, 1));
+ALTER TABLE quizzes ADD COLUMN keep_option_order INTEGER NOT NULL DEFAULT 0 CHECK (keep_option_order IN (0, 1));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE quizzes DROP COLUMN keep_option_order;
+ALTER TABLE quizzes DROP COLUMN shuffle_questions;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Late-join policy for live games. late_join decides what a player who joins
+-- after the game started gets: 'skip' (the default, and the behaviour before
+-- this column, #836) lets them in and leaves the questions that already closed
+-- out of their tally, 'zero' lets them in and scores those questions 0, and
+-- 'closed' turns them away once the game is under way. join_deadline_seconds,
+-- when positive, stops late joins that many seconds after the game started; 0
+-- keeps them open for the whole game. A player already on the roster always
+-- gets back in: that is a reconnect, not a late join.
+ALTER TABLE quizzes ADD COLUMN late_join TEXT NOT NULL DEFAULT 'skip' CHECK (late_join IN ('skip', 'zero', 'closed'));
+ALTER TABLE quizzes ADD COLUMN join_deadline_seconds INTEGER NOT NULL DEFAULT 0 CHECK (join_deadline_seconds >= 0);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- One row per player who joined a game already under way, scoped to the game
+-- in the room (game_seq) so a late joiner of one game is a full player of the
+-- next. missed_questions counts the questions whose answer window had closed
+-- before they joined; the standings read it for the results footnote.
+CREATE TABLE session_late_joins
+(
+    session_id       TEXT     NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+    player_id        INTEGER  NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    game_seq         INTEGER  NOT NULL,
+    missed_questions INTEGER  NOT NULL CHECK (missed_questions >= 0),
+    joined_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (session_id, player_id, game_seq)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE session_late_joins;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE quizzes DROP COLUMN join_deadline_seconds;
+ALTER TABLE quizzes DROP COLUMN late_join;
+-- +goose StatementEnd
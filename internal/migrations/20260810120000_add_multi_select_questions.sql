@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- "Select all that apply" and true/false questions. questions.kind gains
+-- 'multi', where the player picks any number of the options and scores
+-- partial credit, and 'truefalse', a choice between two options. SQLite cannot
+-- widen a CHECK in place, so the column is swapped for one with the wider set:
+-- add it, copy the kinds across, drop the old column and take its name. kind
+-- was already the last column, so the column order the questions reads scan
+-- in is unchanged.
+ALTER TABLE questions ADD COLUMN kind_next TEXT NOT NULL DEFAULT 'choice' CHECK (kind_next IN ('choice', 'multi', 'truefalse', 'numeric'));
+UPDATE questions SET kind_next = kind;
+ALTER TABLE questions DROP COLUMN kind;
+ALTER TABLE questions RENAME COLUMN kind_next TO kind;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- The full pick set of a multi-select answer, one row per picked option.
+-- game_answers.option_id still holds one of the picks so every answer keeps
+-- referencing an option and the existing option joins keep working; a
+-- single-pick answer has no rows here.
+CREATE TABLE game_answer_options
+(
+    game_answer_id INTEGER NOT NULL REFERENCES game_answers (id) ON DELETE CASCADE,
+    option_id      INTEGER NOT NULL REFERENCES options (id) ON DELETE CASCADE,
+    PRIMARY KEY (game_answer_id, option_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE game_answer_options;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Narrowing back folds the new kinds into 'choice', which plays both as a
+-- single pick.
+ALTER TABLE questions ADD COLUMN kind_prev TEXT NOT NULL DEFAULT 'choice' CHECK (kind_prev IN ('choice', 'numeric'));
+UPDATE questions SET kind_prev = CASE WHEN kind = 'numeric' THEN 'numeric' ELSE 'choice' END;
+ALTER TABLE questions DROP COLUMN kind;
+ALTER TABLE questions RENAME COLUMN kind_prev TO kind;
+-- +goose StatementEnd
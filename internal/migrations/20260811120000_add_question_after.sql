@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ordering constraints between questions. after_question_id names the
+-- question this one must follow ("Part 2 of the previous question"): when the
+-- quiz shuffles its questions, a question and the ones that follow it move as
+-- one block, so the dependent question is still played straight after the one
+-- it builds on. The admin editor only offers an earlier question of the same
+-- round. Deleting the referenced question clears the constraint rather than
+-- the dependent question. NULL, the default for every existing row, means the
+-- question is free to move.
+ALTER TABLE questions ADD COLUMN after_question_id INTEGER REFERENCES questions (id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE questions DROP COLUMN after_question_id;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- results_public opts a game into the unauthenticated, anonymized standings at
+-- GET /api/games/{id}/results/public; defaults 0 so nothing is published unless the host turns it on.
+-- Constant-default ADD COLUMN is in-place in SQLite, so no FK rebuild despite games being a parent table.
+ALTER TABLE games ADD COLUMN results_public INTEGER NOT NULL DEFAULT 0
+    CHECK (results_public IN (0, 1));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE games DROP COLUMN results_public;
+-- +goose StatementEnd
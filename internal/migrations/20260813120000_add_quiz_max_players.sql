@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Room capacity for live games of this quiz. A positive max_players caps how
+-- many players the room admits while it plays the quiz, overriding the
+-- server-wide SESSION_MAX_PLAYERS; 0 (the default) defers to that setting.
+ALTER TABLE quizzes ADD COLUMN max_players INTEGER NOT NULL DEFAULT 0 CHECK (max_players >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE quizzes DROP COLUMN max_players;
+-- +goose StatementEnd
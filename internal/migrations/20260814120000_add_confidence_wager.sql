@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- quizzes.confidence_wager turns on the confidence wager for solo games of the
+-- quiz: before answering, the player stakes 1-3 on getting it right. Defaults 0
+-- so existing quizzes keep plain scoring.
+ALTER TABLE quizzes ADD COLUMN confidence_wager INTEGER NOT NULL DEFAULT 0
+    CHECK (confidence_wager IN (0, 1));
+-- game_questions.wager is the stake the player locked in for the issued
+-- question, NULL until they place one.
+ALTER TABLE game_questions ADD COLUMN wager INTEGER CHECK (wager BETWEEN 1 AND 3);
+-- game_answers.wager is the stake the answer is scored with, copied from the
+-- question when the answer is recorded: the locked-in stake, or 1 when the
+-- quiz wagers and the player placed none. NULL on a quiz without the wager
+-- and on existing rows, which keep plain scoring.
+ALTER TABLE game_answers ADD COLUMN wager INTEGER CHECK (wager BETWEEN 1 AND 3);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN wager;
+ALTER TABLE game_questions DROP COLUMN wager;
+ALTER TABLE quizzes DROP COLUMN confidence_wager;
+-- +goose StatementEnd
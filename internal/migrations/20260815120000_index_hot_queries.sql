@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- options has no index on question_id, so the correct_options subquery in
+-- ListAnswersByGameID and ListAnswersForQuizLeaderboard scanned every option
+-- of every quiz once per answer row, as did ListOptionsByQuizID and
+-- ListOptionsByQuestionID. The index is deliberately on question_id alone:
+-- entries sharing a key stay in rowid order, which is the option order
+-- callers of those unordered reads have always seen.
+CREATE INDEX options_question_id_idx ON options(question_id);
+-- ListAnswersByGameID filters on game_id and orders by game_question_id. The
+-- UNIQUE(game_id, player_id, game_question_id) constraint serves the filter
+-- but not the order, so every game-state read sorted the answers in a temp
+-- b-tree.
+CREATE INDEX game_answers_game_id_question_idx ON game_answers(game_id, game_question_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX options_question_id_idx;
+DROP INDEX game_answers_game_id_question_idx;
+-- +goose StatementEnd
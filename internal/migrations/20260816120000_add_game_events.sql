@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- game_events is the append-only log of what happened in each game - created,
+-- joined, question served, answered, finished - in the order it happened, for
+-- replaying a game and debugging client behaviour after the fact. payload is
+-- the event's JSON as the game service emitted it, its own timestamp included;
+-- recorded_at is when the row landed, which trails it by the delivery queue.
+-- Rows go when their game does.
+CREATE TABLE game_events
+(
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    game_id     VARCHAR(20) NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    type        TEXT        NOT NULL,
+    recorded_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    payload     TEXT        NOT NULL
+);
+CREATE INDEX game_events_game_id_idx ON game_events (game_id, id);
+CREATE TRIGGER game_events_append_only
+    BEFORE UPDATE ON game_events
+BEGIN
+    SELECT RAISE(ABORT, 'game_events is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE game_events;
+-- +goose StatementEnd
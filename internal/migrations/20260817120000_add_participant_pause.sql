@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- game_participants.paused_question_id and paused_at record the player's one
+-- "connection hiccup" pause of the game: the quiz question whose timer they
+-- paused and when. Both NULL until the pause is used; it is never cleared, so
+-- a participation gets at most one.
+ALTER TABLE game_participants ADD COLUMN paused_question_id INTEGER;
+ALTER TABLE game_participants ADD COLUMN paused_at DATETIME;
+-- game_answers.paused_ms is how much of the pause fell between the answer
+-- window opening and the answer, in milliseconds; scoring takes it off the
+-- answer's time. 0 on every answer but the paused question's.
+ALTER TABLE game_answers ADD COLUMN paused_ms INTEGER NOT NULL DEFAULT 0 CHECK (paused_ms >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN paused_ms;
+ALTER TABLE game_participants DROP COLUMN paused_at;
+ALTER TABLE game_participants DROP COLUMN paused_question_id;
+-- +goose StatementEnd
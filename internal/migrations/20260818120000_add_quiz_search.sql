@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- quizzes_fts is the full-text index behind quiz search: an external-content
+-- FTS5 table over quizzes' title, description and slug, so the text is stored
+-- once and the index only holds the tokens. unicode61 with diacritics removed
+-- lets "cafe" find "Café", and splits a slug on its hyphens.
+CREATE VIRTUAL TABLE quizzes_fts USING fts5(
+    title,
+    description,
+    slug,
+    content = 'quizzes',
+    content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+-- Rank a title hit above a slug hit above a description hit.
+INSERT INTO quizzes_fts (quizzes_fts, rank) VALUES ('rank', 'bm25(10.0, 1.0, 5.0)');
+-- Index the quizzes that already exist.
+INSERT INTO quizzes_fts (quizzes_fts) VALUES ('rebuild');
+
+-- The triggers keep the index in step with quizzes; an external-content table
+-- is not updated on its own.
+CREATE TRIGGER quizzes_fts_after_insert
+    AFTER INSERT
+    ON quizzes
+BEGIN
+    INSERT INTO quizzes_fts (rowid, title, description, slug)
+    VALUES (new.id, new.title, new.description, new.slug);
+END;
+
+CREATE TRIGGER quizzes_fts_after_delete
+    AFTER DELETE
+    ON quizzes
+BEGIN
+    INSERT INTO quizzes_fts (quizzes_fts, rowid, title, description, slug)
+    VALUES ('delete', old.id, old.title, old.description, old.slug);
+END;
+
+CREATE TRIGGER quizzes_fts_after_update
+    AFTER UPDATE OF title, description, slug
+    ON quizzes
+BEGIN
+    INSERT INTO quizzes_fts (quizzes_fts, rowid, title, description, slug)
+    VALUES ('delete', old.id, old.title, old.description, old.slug);
+    INSERT INTO quizzes_fts (rowid, title, description, slug)
+    VALUES (new.id, new.title, new.description, new.slug);
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER quizzes_fts_after_update;
+DROP TRIGGER quizzes_fts_after_delete;
+DROP TRIGGER quizzes_fts_after_insert;
+DROP TABLE quizzes_fts;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- published_at is when the quiz was last published, NULL while it is a draft.
+-- It moves with the published flag: stamped on publish, cleared on unpublish.
+ALTER TABLE quizzes ADD COLUMN published_at DATETIME;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- A quiz published before the column existed has no record of when; its last
+-- update is the closest honest stand-in.
+UPDATE quizzes SET published_at = updated_at WHERE published = 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE quizzes DROP COLUMN published_at;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version is the optimistic-concurrency counter of a quiz or question row:
+-- every full update bumps it and only applies while the caller still holds
+-- the value it read, so two admins editing the same row cannot silently
+-- overwrite each other.
+ALTER TABLE quizzes ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE questions ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE questions DROP COLUMN version;
+ALTER TABLE quizzes DROP COLUMN version;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- notes is the host's free-text jotting against one participant of a live
+-- session ("table 4, name pending"). Only the host reads or writes it; each
+-- change is also recorded in admin_audit against the participant.
+ALTER TABLE session_players ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE session_players DROP COLUMN notes;
+-- +goose StatementEnd
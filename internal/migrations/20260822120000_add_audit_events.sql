@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- audit_events records who changed what in the admin console: one row per
+-- create, update or delete of a quiz, question or round. entity_id is not a
+-- foreign key, so a deleted entity keeps its history; diff is the JSON object
+-- of changed fields, each as {"from": ..., "to": ...}. The actor is kept as
+-- NULL once their account is gone. Unlike admin_audit, which tracks actions
+-- taken against a player, these rows are about content.
+CREATE TABLE audit_events
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    actor_player_id INTEGER REFERENCES players (id) ON DELETE SET NULL,
+    entity_type     TEXT      NOT NULL,
+    entity_id       INTEGER   NOT NULL,
+    action          TEXT      NOT NULL,
+    diff            TEXT      NOT NULL DEFAULT '{}',
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_events;
+-- +goose StatementEnd
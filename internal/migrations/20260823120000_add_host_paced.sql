@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- quizzes.host_paced holds back scoring in solo games of the quiz: answer
+-- responses carry no correctness or points and results stay hidden until the
+-- quiz's host reveals them. Defaults 0 so existing quizzes keep instant
+-- feedback.
+ALTER TABLE quizzes ADD COLUMN host_paced INTEGER NOT NULL DEFAULT 0
+    CHECK (host_paced IN (0, 1));
+-- games.revealed_at is when the host revealed a host-paced game's scores,
+-- NULL until then (and on every game of a quiz that is not host-paced).
+ALTER TABLE games ADD COLUMN revealed_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE games DROP COLUMN revealed_at;
+ALTER TABLE quizzes DROP COLUMN host_paced;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- player_sessions lists the devices a player is signed in on: one row per
+-- session cookie, keyed by the random id the cookie carries, with the user
+-- agent and address it was last used from. revoked_at is set when the
+-- player (or an Admin) signs the session out; the row stays so the revoked
+-- cookie keeps being refused until it expires.
+CREATE TABLE player_sessions
+(
+    id           TEXT PRIMARY KEY,
+    player_id    INTEGER   NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    user_agent   TEXT      NOT NULL DEFAULT '',
+    ip_address   TEXT      NOT NULL DEFAULT '',
+    created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at   TIMESTAMP
+);
+CREATE INDEX player_sessions_player_id_idx ON player_sessions (player_id, last_seen_at DESC);
+-- players.session_revocations counts the player's revoked sessions. It
+-- travels with the row the auth middleware already loads on every request,
+-- so a cached list of revoked ids is reread only when the count moves.
+ALTER TABLE players ADD COLUMN session_revocations INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE players DROP COLUMN session_revocations;
+DROP TABLE player_sessions;
+-- +goose StatementEnd
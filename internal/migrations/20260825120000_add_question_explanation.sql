@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- questions.explanation is the quiz master's "why this is correct" note,
+-- shown to the player once their answer is recorded. Empty, the default for
+-- every existing row, means the question has none.
+ALTER TABLE questions ADD COLUMN explanation TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE questions DROP COLUMN explanation;
+-- +goose StatementEnd
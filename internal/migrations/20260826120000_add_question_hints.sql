@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- questions.hint is an optional nudge a player may ask for before answering,
+-- at a cost to the answer's score. Empty, the default for every existing row,
+-- means the question offers none.
+ALTER TABLE questions ADD COLUMN hint TEXT NOT NULL DEFAULT '';
+-- game_hints records the hints a player took: one row per issued question and
+-- player, written the first time they ask for it.
+CREATE TABLE game_hints
+(
+    game_question_id INTEGER   NOT NULL REFERENCES game_questions (id) ON DELETE CASCADE,
+    player_id        INTEGER   NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    used_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (game_question_id, player_id)
+);
+-- game_answers.hint_penalty is the percentage taken off the answer's score for
+-- the hint, copied when the answer is recorded: the server's configured
+-- penalty when the player took the hint, 0 otherwise and on existing rows.
+ALTER TABLE game_answers ADD COLUMN hint_penalty INTEGER NOT NULL DEFAULT 0
+    CHECK (hint_penalty BETWEEN 0 AND 100);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN hint_penalty;
+DROP TABLE game_hints;
+ALTER TABLE questions DROP COLUMN hint;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The host's changes to the play order of a live game's remaining questions.
+-- One row per question the host reordered or skipped, scoped to the game in
+-- the room (game_seq) so the next game plays the quiz as written again.
+-- position orders the reordered questions within their round: each reorder
+-- numbers its questions past every earlier row, so the questions already
+-- played keep their place. skipped drops the question from the game.
+CREATE TABLE session_question_plan
+(
+    session_id  TEXT    NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+    game_seq    INTEGER NOT NULL,
+    question_id INTEGER NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    position    INTEGER NOT NULL DEFAULT 0,
+    skipped     INTEGER NOT NULL DEFAULT 0 CHECK (skipped IN (0, 1)),
+    PRIMARY KEY (session_id, game_seq, question_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE session_question_plan;
+-- +goose StatementEnd
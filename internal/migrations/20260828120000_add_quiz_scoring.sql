@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- quizzes.scoring picks how the quiz's answers earn points: 'time' falls with
+-- the time taken (the only behaviour before the setting existed, so the
+-- default), 'flat' awards full points for any correct answer in the window,
+-- and 'streak' is the time curve multiplied by the player's run of correct
+-- answers.
+ALTER TABLE quizzes ADD COLUMN scoring TEXT NOT NULL DEFAULT 'time'
+    CHECK (scoring IN ('time', 'flat', 'streak'));
+-- game_answers.scoring is copied from the quiz when the answer is recorded, so
+-- changing a quiz's scoring never rescores the games already played.
+-- game_answers.streak is the player's run of consecutive correct answers in
+-- the game, this one included, as of the answer: 0 for a wrong answer, and 1
+-- for a correct one when the question issued before it went unanswered or
+-- wrong. Existing rows keep 'time' and 0, which is how they were scored.
+ALTER TABLE game_answers ADD COLUMN scoring TEXT NOT NULL DEFAULT 'time'
+    CHECK (scoring IN ('time', 'flat', 'streak'));
+ALTER TABLE game_answers ADD COLUMN streak INTEGER NOT NULL DEFAULT 0 CHECK (streak >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN streak;
+ALTER TABLE game_answers DROP COLUMN scoring;
+ALTER TABLE quizzes DROP COLUMN scoring;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Negative marking. quizzes.wrong_answer_penalty is the points a wrong answer
+-- in a solo game of the quiz costs, 0 (the default) for none.
+-- quizzes.score_floor is the lowest a player's game total can fall to, 0 by
+-- default so penalties never take a total below zero; a negative floor lets it.
+ALTER TABLE quizzes ADD COLUMN wrong_answer_penalty INTEGER NOT NULL DEFAULT 0
+    CHECK (wrong_answer_penalty BETWEEN 0 AND 1000);
+ALTER TABLE quizzes ADD COLUMN score_floor INTEGER NOT NULL DEFAULT 0 CHECK (score_floor <= 0);
+-- Both are copied onto game_answers when the answer is recorded, as the
+-- wager and the scoring mode are, so changing them never rescores a game
+-- already played. Existing rows keep 0 for both.
+ALTER TABLE game_answers ADD COLUMN wrong_answer_penalty INTEGER NOT NULL DEFAULT 0
+    CHECK (wrong_answer_penalty >= 0);
+ALTER TABLE game_answers ADD COLUMN score_floor INTEGER NOT NULL DEFAULT 0 CHECK (score_floor <= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN score_floor;
+ALTER TABLE game_answers DROP COLUMN wrong_answer_penalty;
+ALTER TABLE quizzes DROP COLUMN score_floor;
+ALTER TABLE quizzes DROP COLUMN wrong_answer_penalty;
+-- +goose StatementEnd
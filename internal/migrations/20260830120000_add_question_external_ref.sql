@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- questions.external_ref is an author-supplied key for a question, set by the
+-- authoring API so a content pipeline can create or update a question by its
+-- own id without tracking ours. NULL, the default, for questions authored
+-- anywhere else. A reference is unique within its quiz only.
+ALTER TABLE questions ADD COLUMN external_ref TEXT;
+CREATE UNIQUE INDEX questions_quiz_external_ref_idx ON questions(quiz_id, external_ref)
+    WHERE external_ref IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX questions_quiz_external_ref_idx;
+ALTER TABLE questions DROP COLUMN external_ref;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- host_advance puts a live room under the host's pacing: the runner still
+-- closes a question when its window runs out or everyone has answered, but it
+-- holds the round intro, the reveal and the round results until the host moves
+-- the room on. It is a room setting, so it carries over to the next game.
+ALTER TABLE sessions
+    ADD COLUMN host_advance INTEGER NOT NULL DEFAULT 0 CHECK (host_advance IN (0, 1));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions
+    DROP COLUMN host_advance;
+-- +goose StatementEnd
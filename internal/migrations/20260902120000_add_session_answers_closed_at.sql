@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- answers_closed_at records the host closing the open question's answering
+-- early. It stops new answers without touching question_expires_at, which
+-- stays the window the picks are scored against, so closing early never
+-- changes a score already earned. Cleared whenever the question is.
+ALTER TABLE sessions
+    ADD COLUMN answers_closed_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions
+    DROP COLUMN answers_closed_at;
+-- +goose StatementEnd
@@ -0,0 +1,105 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// confidenceWagerVersion is the ADD COLUMN migration for the confidence wager.
+const confidenceWagerVersion = 20260814120000
+
+// TestConfidenceWagerMigration_Columns pins the schema addition: quizzes gains
+// confidence_wager, game_questions and game_answers gain wager, the Down drops
+// all three, and the re-Up adds them back.
+func TestConfidenceWagerMigration_Columns(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	columns := []struct{ table, column string }{
+		{"quizzes", "confidence_wager"},
+		{"game_questions", "wager"},
+		{"game_answers", "wager"},
+	}
+	for _, c := range columns {
+		if !tableColumns(t, db, c.table)[c.column] {
+			t.Errorf("%s is missing the %s column", c.table, c.column)
+		}
+	}
+
+	if err := goose.DownTo(db, ".", confidenceWagerVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	for _, c := range columns {
+		if tableColumns(t, db, c.table)[c.column] {
+			t.Errorf("%s still has %s after Down", c.table, c.column)
+		}
+	}
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up after down err = %v, want nil", err)
+	}
+	for _, c := range columns {
+		if !tableColumns(t, db, c.table)[c.column] {
+			t.Errorf("%s is missing %s after re-Up", c.table, c.column)
+		}
+	}
+}
+
+// TestConfidenceWagerMigration_WagerRange pins the defaults and the CHECK: an
+// existing quiz does not wager, an issued question starts with no stake, and
+// a stake outside 1-3 is rejected.
+func TestConfidenceWagerMigration_WagerRange(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+	ctx := t.Context()
+
+	quizID := seedQuiz(t, db, "Wager", "wager")
+	questionID := seedQuestion(t, db, quizID, seedRound(t, db, quizID), 1)
+	seedCompletedGame(t, db, "g-wager", quizID, []int64{questionID})
+
+	var enabled int64
+	if err := db.QueryRowContext(
+		ctx, "SELECT confidence_wager FROM quizzes WHERE id = ?", quizID,
+	).Scan(&enabled); err != nil {
+		t.Fatalf("select confidence_wager: %v", err)
+	}
+	if got, want := enabled, int64(0); got != want {
+		t.Errorf("confidence_wager = %d, want %d", got, want)
+	}
+	var wager sql.NullInt64
+	if err := db.QueryRowContext(
+		ctx, "SELECT wager FROM game_questions WHERE game_id = 'g-wager'",
+	).Scan(&wager); err != nil {
+		t.Fatalf("select wager: %v", err)
+	}
+	if wager.Valid {
+		t.Errorf("wager = %d, want NULL", wager.Int64)
+	}
+
+	if _, err := db.ExecContext(ctx, "UPDATE game_questions SET wager = 3 WHERE game_id = 'g-wager'"); err != nil {
+		t.Errorf("UPDATE to 3 err = %v, want nil", err)
+	}
+	for _, bad := range []int{0, 4} {
+		if _, err := db.ExecContext(
+			ctx, "UPDATE game_questions SET wager = ? WHERE game_id = 'g-wager'", bad,
+		); err == nil {
+			t.Errorf("UPDATE to %d err = nil, want a CHECK violation", bad)
+		}
+	}
+}
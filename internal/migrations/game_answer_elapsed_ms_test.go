@@ -0,0 +1,45 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// gameAnswerElapsedMsVersion is the ADD COLUMN migration recording the
+// server-measured answer latency.
+const gameAnswerElapsedMsVersion = 20260805120000
+
+// TestGameAnswerElapsedMsMigration_Columns pins the schema addition:
+// game_answers gains elapsed_ms, the Down drops it, and the re-Up adds it
+// back.
+func TestGameAnswerElapsedMsMigration_Columns(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	if !tableColumns(t, db, "game_answers")["elapsed_ms"] {
+		t.Error("game_answers is missing the elapsed_ms column")
+	}
+
+	if err := goose.DownTo(db, ".", gameAnswerElapsedMsVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	if tableColumns(t, db, "game_answers")["elapsed_ms"] {
+		t.Error("game_answers still has elapsed_ms after Down")
+	}
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up after down err = %v, want nil", err)
+	}
+	if !tableColumns(t, db, "game_answers")["elapsed_ms"] {
+		t.Error("game_answers is missing elapsed_ms after re-Up")
+	}
+}
@@ -0,0 +1,45 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// gameQuestionVoidedAtVersion is the ADD COLUMN migration recording when a
+// host voided a question for one game.
+const gameQuestionVoidedAtVersion = 20260803120000
+
+// TestGameQuestionVoidedAtMigration_Columns pins the schema addition:
+// game_questions gains voided_at, the Down drops it, and the re-Up adds it
+// back.
+func TestGameQuestionVoidedAtMigration_Columns(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	if !tableColumns(t, db, "game_questions")["voided_at"] {
+		t.Error("game_questions is missing the voided_at column")
+	}
+
+	if err := goose.DownTo(db, ".", gameQuestionVoidedAtVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	if tableColumns(t, db, "game_questions")["voided_at"] {
+		t.Error("game_questions still has voided_at after Down")
+	}
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up after down err = %v, want nil", err)
+	}
+	if !tableColumns(t, db, "game_questions")["voided_at"] {
+		t.Error("game_questions is missing voided_at after re-Up")
+	}
+}
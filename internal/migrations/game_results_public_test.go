@@ -0,0 +1,47 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestGameResultsPublicMigration_DefaultAndCheck pins games.results_public:
+// a new game is private (0), and a value outside {0, 1} is refused.
+func TestGameResultsPublicMigration_DefaultAndCheck(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	if !tableColumns(t, db, "games")["results_public"] {
+		t.Fatal("games is missing the results_public column")
+	}
+
+	quizID := seedQuiz(t, db, "Public results", "public-results")
+	if _, err := db.ExecContext(
+		t.Context(), "INSERT INTO games (id, quiz_id) VALUES ('g-results-public', ?)", quizID,
+	); err != nil {
+		t.Fatalf("seed game err = %v, want nil", err)
+	}
+
+	var got int64
+	if err := db.QueryRowContext(
+		t.Context(), "SELECT results_public FROM games WHERE id = 'g-results-public'",
+	).Scan(&got); err != nil {
+		t.Fatalf("read results_public err = %v, want nil", err)
+	}
+	if want := int64(0); got != want {
+		t.Errorf("results_public = %d, want %d (private default)", got, want)
+	}
+
+	if _, err := db.ExecContext(
+		t.Context(), "UPDATE games SET results_public = 2 WHERE id = 'g-results-public'",
+	); err == nil {
+		t.Error("UPDATE to 2 err = nil, want a CHECK violation")
+	}
+}
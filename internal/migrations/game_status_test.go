@@ -0,0 +1,75 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// gameStatusVersion is the ADD COLUMN migration giving games a terminal
+// lifecycle state (status, finished_at).
+const gameStatusVersion = 20260804120000
+
+// TestGameStatusMigration_BackfillAndDown pins the backfill: a game with every
+// quiz question issued and none still open is finished, a game part-way
+// through stays in_progress. The Down drops both columns and the re-Up adds
+// them back.
+func TestGameStatusMigration_BackfillAndDown(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	if err := goose.DownTo(db, ".", gameStatusVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	cols := tableColumns(t, db, "games")
+	if cols["status"] || cols["finished_at"] {
+		t.Fatal("games still has status / finished_at after Down")
+	}
+
+	quizID := seedQuiz(t, db, "Status backfill", "status-backfill")
+	roundID := seedRound(t, db, quizID)
+	q1 := seedQuestion(t, db, quizID, roundID, 1)
+	q2 := seedQuestion(t, db, quizID, roundID, 2)
+	seedCompletedGame(t, db, "g-status-done", quizID, []int64{q1, q2})
+	seedCompletedGame(t, db, "g-status-open", quizID, []int64{q1})
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up err = %v, want nil", err)
+	}
+
+	tests := []struct {
+		gameID       string
+		wantStatus   string
+		wantFinished bool
+	}{
+		{gameID: "g-status-done", wantStatus: "finished", wantFinished: true},
+		{gameID: "g-status-open", wantStatus: "in_progress", wantFinished: false},
+	}
+	for _, tt := range tests {
+		var status string
+		var finished bool
+		if err := db.QueryRowContext(
+			t.Context(), "SELECT status, finished_at IS NOT NULL FROM games WHERE id = ?", tt.gameID,
+		).Scan(&status, &finished); err != nil {
+			t.Fatalf("read game %q err = %v, want nil", tt.gameID, err)
+		}
+		if status != tt.wantStatus || finished != tt.wantFinished {
+			t.Errorf("game %q = (%q, finished %v), want (%q, finished %v)",
+				tt.gameID, status, finished, tt.wantStatus, tt.wantFinished)
+		}
+	}
+
+	if _, err := db.ExecContext(
+		t.Context(), "UPDATE games SET status = 'paused' WHERE id = 'g-status-open'",
+	); err == nil {
+		t.Error("UPDATE to an unknown status err = nil, want a CHECK violation")
+	}
+}
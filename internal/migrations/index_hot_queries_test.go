@@ -0,0 +1,246 @@
+package migrations_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/db"
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// indexHotQueriesVersion is the migration that indexes options by question
+// and game_answers by (game_id, game_question_id).
+const indexHotQueriesVersion = 20260815120000
+
+// hotQueryIndexes are the indexes the indexHotQueriesVersion migration adds.
+//
+//nolint:gochecknoglobals // fixed test table shared by the Up and Down tests.
+var hotQueryIndexes = []string{
+	"options_question_id_idx",
+	"game_answers_game_id_question_idx",
+}
+
+// errPlanWrite is returned by planRecorder for writes; the audit only runs
+// reads.
+var errPlanWrite = errors.New("planRecorder records reads only")
+
+// planRecorder is a db.DBTX that runs EXPLAIN QUERY PLAN for every read
+// before executing it, so the audit exercises the exact SQL sqlc generated
+// rather than a hand-copied mirror that could drift from it.
+type planRecorder struct {
+	t     *testing.T
+	conn  *sql.DB
+	plans []string
+}
+
+func (p *planRecorder) record(ctx context.Context, query string, args ...any) {
+	p.t.Helper()
+
+	rows, err := p.conn.QueryContext(ctx, "EXPLAIN QUERY PLAN "+query, args...)
+	if err != nil {
+		p.t.Fatalf("EXPLAIN QUERY PLAN err = %v", err)
+	}
+	defer func() {
+		if cerr := rows.Close(); cerr != nil {
+			p.t.Errorf("rows.Close err = %v", cerr)
+		}
+	}()
+
+	// EXPLAIN QUERY PLAN rows: (id INTEGER, parent INTEGER, notused INTEGER, detail TEXT).
+	for rows.Next() {
+		var id, parent, notused int
+		var detail string
+		if err = rows.Scan(&id, &parent, &notused, &detail); err != nil {
+			p.t.Fatalf("scan plan row err = %v", err)
+		}
+		p.plans = append(p.plans, detail)
+	}
+	if err = rows.Err(); err != nil {
+		p.t.Fatalf("plan rows iteration err = %v", err)
+	}
+}
+
+func (p *planRecorder) ExecContext(context.Context, string, ...any) (sql.Result, error) {
+	return nil, errPlanWrite
+}
+
+func (p *planRecorder) PrepareContext(context.Context, string) (*sql.Stmt, error) {
+	return nil, errPlanWrite
+}
+
+func (p *planRecorder) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	p.record(ctx, query, args...)
+
+	return p.conn.QueryContext(ctx, query, args...) //nolint:wrapcheck // test double passes the driver error through.
+}
+
+func (p *planRecorder) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	p.record(ctx, query, args...)
+
+	return p.conn.QueryRowContext(ctx, query, args...)
+}
+
+// TestHotQueries_UseIndexes is the index audit: each hot read - the two
+// leaderboard queries, the my-game lookup, answers-by-game and
+// questions-by-quiz - runs through planRecorder against the migrated schema,
+// and the plan must name the supporting index and never SCAN a table. Where
+// the query's ORDER BY should come straight off the index, the plan must also
+// be free of a temp b-tree sort. A new query shape on these paths that loses
+// its index fails here rather than in production as the tables grow.
+func TestHotQueries_UseIndexes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		run         func(ctx context.Context, q *db.Queries) error
+		wantIndexes []string
+		sortFree    bool
+	}{
+		{
+			name: "answers leaderboard",
+			run: func(ctx context.Context, q *db.Queries) error {
+				_, err := q.ListAnswersForQuizLeaderboard(ctx, 1)
+
+				return err
+			},
+			wantIndexes: []string{"games_quiz_id_idx", "game_answers_game_id_question_idx", "options_question_id_idx"},
+		},
+		{
+			name: "participants leaderboard",
+			run: func(ctx context.Context, q *db.Queries) error {
+				_, err := q.ListParticipantsForQuizLeaderboard(ctx, db.ListParticipantsForQuizLeaderboardParams{
+					StaleBefore: "2026-01-01 00:00:00",
+					QuizID:      1,
+				})
+
+				return err
+			},
+			wantIndexes: []string{"games_quiz_id_idx", "game_participants_game_id_idx", "game_questions_game_id_idx"},
+		},
+		{
+			name: "my-game lookup",
+			run: func(ctx context.Context, q *db.Queries) error {
+				_, err := q.GetRealGameByPlayerAndQuiz(ctx, db.GetRealGameByPlayerAndQuizParams{PlayerID: 1, QuizID: 1})
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil
+				}
+
+				return err
+			},
+			wantIndexes: []string{"game_participants_player_quiz_idx"},
+		},
+		{
+			name: "answers by game",
+			run: func(ctx context.Context, q *db.Queries) error {
+				_, err := q.ListAnswersByGameID(ctx, "game")
+
+				return err
+			},
+			wantIndexes: []string{"game_answers_game_id_question_idx", "options_question_id_idx"},
+			sortFree:    true,
+		},
+		{
+			name: "questions by quiz",
+			run: func(ctx context.Context, q *db.Queries) error {
+				_, err := q.ListQuestionsByQuizID(ctx, 1)
+
+				return err
+			},
+			wantIndexes: []string{"questions_quiz_position_idx"},
+			sortFree:    true,
+		},
+		{
+			name: "options by quiz",
+			run: func(ctx context.Context, q *db.Queries) error {
+				_, err := q.ListOptionsByQuizID(ctx, 1)
+
+				return err
+			},
+			wantIndexes: []string{"questions_quiz_position_idx", "options_question_id_idx"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := dbtest.Open(t)
+			t.Cleanup(func() {
+				if cerr := conn.Close(); cerr != nil {
+					t.Errorf("db.Close err = %v, want nil", cerr)
+				}
+			})
+
+			rec := &planRecorder{t: t, conn: conn}
+			if err := tt.run(t.Context(), db.New(rec)); err != nil {
+				t.Fatalf("query err = %v, want nil", err)
+			}
+
+			plan := strings.Join(rec.plans, "\n")
+			t.Logf("EXPLAIN QUERY PLAN output:\n%s", plan)
+			for _, detail := range rec.plans {
+				if strings.HasPrefix(detail, "SCAN ") {
+					t.Errorf("plan step %q scans a table, want every table reached through an index", detail)
+				}
+				if tt.sortFree && strings.HasPrefix(detail, "USE TEMP B-TREE") {
+					t.Errorf("plan step %q sorts, want the ORDER BY served by the index", detail)
+				}
+			}
+			for _, idx := range tt.wantIndexes {
+				if !strings.Contains(plan, idx) {
+					t.Errorf("plan should use %q", idx)
+				}
+			}
+		})
+	}
+}
+
+// TestIndexHotQueries_UpCreatesIndexes pins that the Up migration creates
+// both indexes.
+func TestIndexHotQueries_UpCreatesIndexes(t *testing.T) {
+	t.Parallel()
+
+	conn := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := conn.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v, want nil", cerr)
+		}
+	})
+
+	for _, idx := range hotQueryIndexes {
+		if !indexExists(t, conn, idx) {
+			t.Errorf("index %q does not exist after migration, want it to", idx)
+		}
+	}
+}
+
+// TestIndexHotQueries_DownDropsIndexes pins that the Down migration removes
+// both indexes so the schema returns to its pre-migration shape.
+func TestIndexHotQueries_DownDropsIndexes(t *testing.T) {
+	t.Parallel()
+
+	conn := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := conn.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v, want nil", cerr)
+		}
+	})
+
+	if err := goose.DownTo(conn, ".", indexHotQueriesVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+
+	for _, idx := range hotQueryIndexes {
+		if indexExists(t, conn, idx) {
+			t.Errorf("index %q still exists after Down, want it dropped", idx)
+		}
+	}
+
+	if err := goose.Up(conn, "."); err != nil {
+		t.Fatalf("goose.Up err = %v, want nil", err)
+	}
+}
@@ -7,3 +7,10 @@ import "embed"
 //
 //go:embed *.sql
 var FS embed.FS
+
+// PostgresFS embeds the Postgres variant of the schema under postgres/. The
+// SQLite history in [FS] stays the source of truth; postgres/ starts from a
+// baseline of it and mirrors every later schema change.
+//
+//go:embed postgres/*.sql
+var PostgresFS embed.FS
@@ -0,0 +1,67 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// multiSelectQuestionsVersion widens questions.kind for select-all and
+// true/false questions and adds the per-answer pick set table.
+const multiSelectQuestionsVersion = 20260810120000
+
+// TestMultiSelectQuestionsMigration_Schema pins the widened kind check and the
+// game_answer_options table; the Down folds the new kinds back into choice and
+// drops the table, and the re-Up restores both.
+func TestMultiSelectQuestionsMigration_Schema(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	quizID := seedQuiz(t, db, "Multi", "multi")
+	roundID := seedRound(t, db, quizID)
+	questionID := seedQuestion(t, db, quizID, roundID, 1)
+	for _, kind := range []string{"multi", "truefalse"} {
+		if _, err := db.ExecContext(t.Context(), "UPDATE questions SET kind = ? WHERE id = ?", kind, questionID); err != nil {
+			t.Errorf("set kind %q err = %v, want nil", kind, err)
+		}
+	}
+	if _, err := db.ExecContext(t.Context(), "UPDATE questions SET kind = 'essay' WHERE id = ?", questionID); err == nil {
+		t.Error("set kind 'essay' err = nil, want a CHECK violation")
+	}
+
+	have := tableColumns(t, db, "game_answer_options")
+	for _, col := range []string{"game_answer_id", "option_id"} {
+		if !have[col] {
+			t.Errorf("game_answer_options is missing the %s column", col)
+		}
+	}
+
+	if err := goose.DownTo(db, ".", multiSelectQuestionsVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	if got := tableColumns(t, db, "game_answer_options"); len(got) != 0 {
+		t.Errorf("game_answer_options columns after down = %v, want none", got)
+	}
+	var kind string
+	if err := db.QueryRowContext(t.Context(), "SELECT kind FROM questions WHERE id = ?", questionID).Scan(&kind); err != nil {
+		t.Fatalf("read kind err = %v, want nil", err)
+	}
+	if got, want := kind, "choice"; got != want {
+		t.Errorf("kind after down = %q, want %q", got, want)
+	}
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up after down err = %v, want nil", err)
+	}
+	if !tableColumns(t, db, "game_answer_options")["option_id"] {
+		t.Error("game_answer_options missing after re-up")
+	}
+}
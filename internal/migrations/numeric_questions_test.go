@@ -0,0 +1,56 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// numericQuestionsVersion is the ADD COLUMN migration for numeric
+// closest-answer questions.
+const numericQuestionsVersion = 20260806120000
+
+// TestNumericQuestionsMigration_Columns pins the schema addition: questions
+// gains kind, options the answer key and its tolerances, game_answers the
+// typed value; the Down drops them all and the re-Up adds them back.
+func TestNumericQuestionsMigration_Columns(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	columns := map[string][]string{
+		"questions":    {"kind"},
+		"options":      {"numeric_value", "tolerance_below", "tolerance_above"},
+		"game_answers": {"numeric_value"},
+	}
+	check := func(stage string, want bool) {
+		t.Helper()
+		for table, cols := range columns {
+			have := tableColumns(t, db, table)
+			for _, col := range cols {
+				if have[col] != want {
+					t.Errorf("%s: %s.%s present = %t, want %t", stage, table, col, have[col], want)
+				}
+			}
+		}
+	}
+
+	check("up", true)
+
+	if err := goose.DownTo(db, ".", numericQuestionsVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	check("down", false)
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up after down err = %v, want nil", err)
+	}
+	check("re-up", true)
+}
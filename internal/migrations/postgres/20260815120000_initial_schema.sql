@@ -0,0 +1,384 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The Postgres schema starts from the SQLite schema as of migration
+-- 20260815120000 rather than replaying its history: the SQLite migrations
+-- rebuild tables to change constraints, which Postgres does in place. Column
+-- types mirror the SQLite declarations so the shared sqlc layer scans every
+-- row into the same Go types: INTEGER becomes BIGINT, DATETIME becomes
+-- TIMESTAMP (always UTC; the connection pins the session time zone), REAL
+-- becomes DOUBLE PRECISION, and 0/1 flags stay integers. A later SQLite
+-- migration that changes the schema gets a sibling here.
+CREATE TABLE players
+(
+    id                   BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    display_name         TEXT      NOT NULL UNIQUE,
+    email                TEXT UNIQUE,
+    password_hash        TEXT,
+    role                 TEXT      NOT NULL DEFAULT 'player',
+    created_at           TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    display_name_claimed BIGINT    NOT NULL DEFAULT 0,
+    email_verified_at    TIMESTAMP,
+    session_version      BIGINT    NOT NULL DEFAULT 0,
+    role_changed_at      TIMESTAMP,
+    approved_at          TIMESTAMP,
+    CHECK (password_hash IS NULL OR email IS NOT NULL)
+);
+
+CREATE TABLE quizzes
+(
+    id                    BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    title                 TEXT      NOT NULL,
+    slug                  TEXT      NOT NULL UNIQUE,
+    description           TEXT      NOT NULL DEFAULT '',
+    created_at            TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at            TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by_player_id  BIGINT    NOT NULL REFERENCES players (id),
+    time_limit_seconds    BIGINT    NOT NULL DEFAULT 10 CHECK (time_limit_seconds BETWEEN 1 AND 600),
+    visibility            TEXT      NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'unlisted', 'private')),
+    mode                  TEXT      NOT NULL DEFAULT 'solo' CHECK (mode IN ('solo', 'live')),
+    play_count            BIGINT    NOT NULL DEFAULT 0 CHECK (play_count >= 0),
+    published             BIGINT    NOT NULL DEFAULT 0 CHECK (published IN (0, 1)),
+    language              TEXT      NOT NULL DEFAULT 'en' CHECK (language IN ('en', 'nl')),
+    shuffle_questions     BIGINT    NOT NULL DEFAULT 0 CHECK (shuffle_questions IN (0, 1)),
+    keep_option_order     BIGINT    NOT NULL DEFAULT 0 CHECK (keep_option_order IN (0, 1)),
+    late_join             TEXT      NOT NULL DEFAULT 'skip' CHECK (late_join IN ('skip', 'zero', 'closed')),
+    join_deadline_seconds BIGINT    NOT NULL DEFAULT 0 CHECK (join_deadline_seconds >= 0),
+    max_players           BIGINT    NOT NULL DEFAULT 0 CHECK (max_players >= 0),
+    confidence_wager      BIGINT    NOT NULL DEFAULT 0 CHECK (confidence_wager IN (0, 1))
+);
+
+CREATE TABLE media
+(
+    id                   BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    quiz_id              BIGINT    NOT NULL REFERENCES quizzes (id) ON DELETE CASCADE,
+    type                 TEXT      NOT NULL DEFAULT 'image' CHECK (type IN ('image', 'video', 'audio')),
+    mime                 TEXT      NOT NULL,
+    path                 TEXT      NOT NULL,
+    thumb_path           TEXT,
+    width                BIGINT,
+    height               BIGINT,
+    size_bytes           BIGINT    NOT NULL,
+    sha256               TEXT      NOT NULL,
+    created_by_player_id BIGINT    NOT NULL REFERENCES players (id),
+    created_at           TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ready                BIGINT    NOT NULL DEFAULT 1 CHECK (ready IN (0, 1)),
+    duration_ms          BIGINT,
+    description          TEXT      NOT NULL DEFAULT '',
+    original_filename    TEXT      NOT NULL DEFAULT ''
+);
+
+CREATE TABLE rounds
+(
+    id                        BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    quiz_id                   BIGINT    NOT NULL REFERENCES quizzes (id) ON DELETE CASCADE,
+    position                  BIGINT    NOT NULL,
+    title                     TEXT      NOT NULL DEFAULT '',
+    summary                   TEXT      NOT NULL DEFAULT '',
+    created_at                TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    boundary_duration_seconds BIGINT
+        CHECK (boundary_duration_seconds IS NULL OR boundary_duration_seconds BETWEEN 1 AND 600)
+);
+
+CREATE TABLE questions
+(
+    id                 BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    quiz_id            BIGINT NOT NULL REFERENCES quizzes (id) ON DELETE CASCADE,
+    round_id           BIGINT NOT NULL REFERENCES rounds (id) ON DELETE CASCADE,
+    text               TEXT   NOT NULL DEFAULT '',
+    position           BIGINT NOT NULL,
+    time_limit_seconds BIGINT CHECK (time_limit_seconds IS NULL OR (time_limit_seconds BETWEEN 1 AND 600)),
+    image_media_id     BIGINT REFERENCES media (id) ON DELETE SET NULL,
+    audio_media_id     BIGINT REFERENCES media (id) ON DELETE SET NULL,
+    audio_repeat       BIGINT NOT NULL DEFAULT 0,
+    stats_epoch        BIGINT NOT NULL DEFAULT 0,
+    kind               TEXT   NOT NULL DEFAULT 'choice' CHECK (kind IN ('choice', 'multi', 'truefalse', 'numeric')),
+    after_question_id  BIGINT REFERENCES questions (id) ON DELETE SET NULL
+);
+
+CREATE TABLE options
+(
+    id              BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    question_id     BIGINT           NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    text            TEXT             NOT NULL,
+    is_correct      BOOLEAN          NOT NULL,
+    numeric_value   DOUBLE PRECISION,
+    tolerance_below DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance_below >= 0),
+    tolerance_above DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance_above >= 0)
+);
+
+CREATE TABLE games
+(
+    id             VARCHAR(20) PRIMARY KEY,
+    quiz_id        BIGINT    NOT NULL REFERENCES quizzes (id),
+    created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at     TIMESTAMP,
+    is_preview     BIGINT    NOT NULL DEFAULT 0 CHECK (is_preview IN (0, 1)),
+    status         TEXT      NOT NULL DEFAULT 'in_progress'
+        CHECK (status IN ('in_progress', 'finished', 'abandoned')),
+    finished_at    TIMESTAMP,
+    results_public BIGINT    NOT NULL DEFAULT 0 CHECK (results_public IN (0, 1))
+);
+
+CREATE TABLE game_questions
+(
+    id          BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    game_id     VARCHAR(20) NOT NULL REFERENCES games (id),
+    question_id BIGINT      NOT NULL REFERENCES questions (id),
+    started_at  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expired_at  TIMESTAMP   NOT NULL,
+    voided_at   TIMESTAMP,
+    wager       BIGINT CHECK (wager BETWEEN 1 AND 3)
+);
+
+CREATE TABLE game_answers
+(
+    id               BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    game_id          VARCHAR(20) NOT NULL REFERENCES games (id),
+    player_id        BIGINT      NOT NULL REFERENCES players (id),
+    game_question_id BIGINT      NOT NULL REFERENCES game_questions (id),
+    option_id        BIGINT      NOT NULL REFERENCES options (id),
+    answered_at      TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    stats_epoch      BIGINT      NOT NULL DEFAULT 0,
+    elapsed_ms       BIGINT,
+    numeric_value    DOUBLE PRECISION,
+    wager            BIGINT CHECK (wager BETWEEN 1 AND 3),
+    UNIQUE (game_id, player_id, game_question_id)
+);
+
+CREATE TABLE game_answer_options
+(
+    game_answer_id BIGINT NOT NULL REFERENCES game_answers (id) ON DELETE CASCADE,
+    option_id      BIGINT NOT NULL REFERENCES options (id) ON DELETE CASCADE,
+    PRIMARY KEY (game_answer_id, option_id)
+);
+
+CREATE TABLE game_participants
+(
+    id        BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    game_id   VARCHAR(20) NOT NULL REFERENCES games (id),
+    player_id BIGINT      NOT NULL REFERENCES players (id),
+    quiz_id   BIGINT      NOT NULL REFERENCES quizzes (id),
+    joined_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE game_seen_rounds
+(
+    game_id  VARCHAR(20) NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    round_id BIGINT      NOT NULL REFERENCES rounds (id) ON DELETE CASCADE,
+    phase    TEXT        NOT NULL CHECK (phase IN ('intro', 'results')),
+    seen_at  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (game_id, round_id, phase)
+);
+
+CREATE TABLE player_identities
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    player_id  BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    provider   TEXT      NOT NULL,
+    subject    TEXT      NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (provider, subject)
+);
+
+CREATE TABLE email_verify_tokens
+(
+    token_hash    TEXT PRIMARY KEY,
+    player_id     BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at    TIMESTAMP NOT NULL,
+    consumed_at   TIMESTAMP,
+    pending_email TEXT
+);
+
+CREATE TABLE password_reset_tokens
+(
+    token_hash  TEXT PRIMARY KEY,
+    player_id   BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at  TIMESTAMP NOT NULL,
+    consumed_at TIMESTAMP
+);
+
+CREATE TABLE admin_audit
+(
+    id               BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    actor_player_id  BIGINT REFERENCES players (id) ON DELETE SET NULL,
+    target_player_id BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    action           TEXT      NOT NULL,
+    payload          TEXT      NOT NULL DEFAULT '{}',
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE invites
+(
+    id                   BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    email                TEXT      NOT NULL,
+    invited_by_player_id BIGINT REFERENCES players (id) ON DELETE SET NULL,
+    token_hash           TEXT      NOT NULL UNIQUE,
+    status               TEXT      NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'accepted', 'revoked')),
+    note                 TEXT,
+    expires_at           TIMESTAMP NOT NULL,
+    created_at           TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    accepted_at          TIMESTAMP
+);
+
+CREATE TABLE sessions
+(
+    id                  TEXT PRIMARY KEY,
+    quiz_id             BIGINT REFERENCES quizzes (id) ON DELETE CASCADE,
+    host_player_id      BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    join_code           TEXT      NOT NULL UNIQUE,
+    phase               TEXT      NOT NULL DEFAULT 'lobby'
+        CHECK (phase IN ('lobby', 'round_intro', 'question', 'reveal',
+                         'round_results', 'intermission', 'finished')),
+    game_seq            BIGINT    NOT NULL DEFAULT 1,
+    current_round_id    BIGINT REFERENCES rounds (id) ON DELETE SET NULL,
+    current_question_id BIGINT REFERENCES questions (id) ON DELETE SET NULL,
+    question_started_at TIMESTAMP,
+    question_expires_at TIMESTAMP,
+    created_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at          TIMESTAMP,
+    finished_at         TIMESTAMP,
+    host_last_seen_at   TIMESTAMP,
+    start_at            TIMESTAMP
+);
+
+CREATE TABLE session_players
+(
+    id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    session_id   TEXT      NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+    player_id    BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    is_ready     BIGINT    NOT NULL DEFAULT 0,
+    joined_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    left_at      TIMESTAMP,
+    UNIQUE (session_id, player_id)
+);
+
+CREATE TABLE session_answers
+(
+    id                BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    session_id        TEXT      NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+    question_id       BIGINT    NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    player_id         BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    option_id         BIGINT    NOT NULL REFERENCES options (id) ON DELETE CASCADE,
+    answered_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    score             BIGINT,
+    game_seq          BIGINT    NOT NULL DEFAULT 1,
+    window_started_at TIMESTAMP,
+    window_expires_at TIMESTAMP,
+    stats_epoch       BIGINT    NOT NULL DEFAULT 0,
+    UNIQUE (session_id, question_id, player_id, game_seq)
+);
+
+CREATE TABLE session_late_joins
+(
+    session_id       TEXT      NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+    player_id        BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    game_seq         BIGINT    NOT NULL,
+    missed_questions BIGINT    NOT NULL CHECK (missed_questions >= 0),
+    joined_at        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (session_id, player_id, game_seq)
+);
+
+CREATE TABLE tournaments
+(
+    id                   BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    title                TEXT      NOT NULL,
+    join_code            TEXT      NOT NULL UNIQUE,
+    created_by_player_id BIGINT REFERENCES players (id) ON DELETE SET NULL,
+    created_at           TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE tournament_stages
+(
+    tournament_id BIGINT    NOT NULL REFERENCES tournaments (id) ON DELETE CASCADE,
+    quiz_id       BIGINT    NOT NULL REFERENCES quizzes (id) ON DELETE CASCADE,
+    position      BIGINT    NOT NULL,
+    added_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tournament_id, quiz_id),
+    UNIQUE (tournament_id, position)
+);
+
+CREATE TABLE tournament_players
+(
+    tournament_id BIGINT    NOT NULL REFERENCES tournaments (id) ON DELETE CASCADE,
+    player_id     BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    joined_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tournament_id, player_id)
+);
+
+CREATE TABLE settings
+(
+    key        TEXT PRIMARY KEY,
+    value      TEXT      NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE question_drafts
+(
+    player_id   BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    question_id BIGINT    NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    data        TEXT      NOT NULL,
+    updated_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (player_id, question_id)
+);
+
+CREATE INDEX games_quiz_id_idx ON games (quiz_id);
+CREATE INDEX game_answers_player_id_idx ON game_answers (player_id);
+CREATE INDEX game_answers_game_question_id_idx ON game_answers (game_question_id);
+CREATE INDEX game_answers_game_id_question_idx ON game_answers (game_id, game_question_id);
+CREATE UNIQUE INDEX game_participants_player_quiz_idx ON game_participants (player_id, quiz_id);
+CREATE INDEX game_participants_game_id_idx ON game_participants (game_id);
+CREATE UNIQUE INDEX game_questions_game_question_idx ON game_questions (game_id, question_id);
+CREATE INDEX game_questions_game_id_idx ON game_questions (game_id);
+CREATE INDEX player_identities_player_id_idx ON player_identities (player_id);
+CREATE INDEX email_verify_tokens_player_id_idx ON email_verify_tokens (player_id);
+CREATE INDEX password_reset_tokens_player_id_idx ON password_reset_tokens (player_id);
+CREATE INDEX admin_audit_target_idx ON admin_audit (target_player_id, created_at DESC);
+CREATE UNIQUE INDEX rounds_quiz_position_idx ON rounds (quiz_id, position);
+CREATE UNIQUE INDEX questions_quiz_position_idx ON questions (quiz_id, position);
+CREATE INDEX options_question_id_idx ON options (question_id);
+CREATE INDEX invites_email_idx ON invites (email);
+CREATE INDEX media_quiz_id_idx ON media (quiz_id);
+CREATE INDEX media_not_ready_idx ON media (created_at) WHERE ready = 0;
+CREATE INDEX tournament_players_player_idx ON tournament_players (player_id);
+
+-- Editing a quiz's options bumps the quiz's updated_at, as the SQLite
+-- triggers do.
+CREATE FUNCTION touch_quiz_for_option() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    UPDATE quizzes
+    SET updated_at = CURRENT_TIMESTAMP
+    WHERE id = (SELECT quiz_id FROM questions WHERE id = COALESCE(NEW.question_id, OLD.question_id));
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER quizzes_updated_at_on_option_change
+    AFTER INSERT OR UPDATE OR DELETE ON options
+    FOR EACH ROW
+EXECUTE FUNCTION touch_quiz_for_option();
+
+-- The seed admin the SQLite history creates in 20260111110308 and verifies,
+-- approves and promotes in later migrations. Its explicit id leaves the
+-- identity sequence behind, so move it past the row.
+INSERT INTO players (id, display_name, email, role, email_verified_at, approved_at)
+VALUES (1, 'admin', 'email@example.com', 'host', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+SELECT setval(pg_get_serial_sequence('players', 'id'), 1);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE question_drafts, settings, tournament_players, tournament_stages, tournaments,
+    session_late_joins, session_answers, session_players, sessions, invites, admin_audit,
+    password_reset_tokens, email_verify_tokens, player_identities, game_seen_rounds,
+    game_participants, game_answer_options, game_answers, game_questions, games, options,
+    questions, rounds, media, quizzes, players;
+DROP FUNCTION touch_quiz_for_option();
+-- +goose StatementEnd
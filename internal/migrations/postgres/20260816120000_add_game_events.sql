@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The game event log; see the SQLite migration of the same version.
+CREATE TABLE game_events
+(
+    id          BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    game_id     VARCHAR(20) NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    type        TEXT        NOT NULL,
+    recorded_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    payload     TEXT        NOT NULL
+);
+CREATE INDEX game_events_game_id_idx ON game_events (game_id, id);
+
+CREATE FUNCTION reject_game_event_update() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    RAISE EXCEPTION 'game_events is append-only';
+END;
+$$;
+
+CREATE TRIGGER game_events_append_only
+    BEFORE UPDATE ON game_events
+    FOR EACH ROW
+EXECUTE FUNCTION reject_game_event_update();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE game_events;
+DROP FUNCTION reject_game_event_update();
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The once-per-game pause; see the SQLite migration of the same version.
+ALTER TABLE game_participants ADD COLUMN paused_question_id BIGINT;
+ALTER TABLE game_participants ADD COLUMN paused_at TIMESTAMP;
+ALTER TABLE game_answers ADD COLUMN paused_ms BIGINT NOT NULL DEFAULT 0 CHECK (paused_ms >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN paused_ms;
+ALTER TABLE game_participants DROP COLUMN paused_at;
+ALTER TABLE game_participants DROP COLUMN paused_question_id;
+-- +goose StatementEnd
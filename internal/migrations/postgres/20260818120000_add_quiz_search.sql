@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Quiz search; see the SQLite migration of the same version. Postgres needs no
+-- shadow table: the SearchQuizzes override matches against this expression,
+-- which the GIN index serves.
+CREATE INDEX quizzes_search_idx ON quizzes
+    USING GIN (to_tsvector('simple', title || ' ' || description || ' ' || slug));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX quizzes_search_idx;
+-- +goose StatementEnd
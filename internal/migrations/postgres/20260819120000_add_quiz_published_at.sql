@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When the quiz was last published; see the SQLite migration of the same version.
+ALTER TABLE quizzes ADD COLUMN published_at TIMESTAMP;
+UPDATE quizzes SET published_at = updated_at WHERE published = 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE quizzes DROP COLUMN published_at;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Optimistic-concurrency counters; see the SQLite migration of the same version.
+ALTER TABLE quizzes ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE questions ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE questions DROP COLUMN version;
+ALTER TABLE quizzes DROP COLUMN version;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Host notes on a participant; see the SQLite migration of the same version.
+ALTER TABLE session_players ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE session_players DROP COLUMN notes;
+-- +goose StatementEnd
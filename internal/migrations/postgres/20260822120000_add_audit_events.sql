@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The admin content audit log; see the SQLite migration of the same version.
+CREATE TABLE audit_events
+(
+    id              BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    actor_player_id BIGINT REFERENCES players (id) ON DELETE SET NULL,
+    entity_type     TEXT      NOT NULL,
+    entity_id       BIGINT    NOT NULL,
+    action          TEXT      NOT NULL,
+    diff            TEXT      NOT NULL DEFAULT '{}',
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_events;
+-- +goose StatementEnd
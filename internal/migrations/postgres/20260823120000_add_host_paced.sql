@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Host-paced scoring; see the SQLite migration of the same version.
+ALTER TABLE quizzes ADD COLUMN host_paced BIGINT NOT NULL DEFAULT 0 CHECK (host_paced IN (0, 1));
+ALTER TABLE games ADD COLUMN revealed_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE games DROP COLUMN revealed_at;
+ALTER TABLE quizzes DROP COLUMN host_paced;
+-- +goose StatementEnd
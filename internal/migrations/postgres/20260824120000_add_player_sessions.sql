@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Player sessions and their revocation count; see the SQLite migration of
+-- the same version.
+CREATE TABLE player_sessions
+(
+    id           TEXT PRIMARY KEY,
+    player_id    BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    user_agent   TEXT      NOT NULL DEFAULT '',
+    ip_address   TEXT      NOT NULL DEFAULT '',
+    created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at   TIMESTAMP
+);
+CREATE INDEX player_sessions_player_id_idx ON player_sessions (player_id, last_seen_at DESC);
+ALTER TABLE players ADD COLUMN session_revocations BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE players DROP COLUMN session_revocations;
+DROP TABLE player_sessions;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The question explanation; see the SQLite migration of the same version.
+ALTER TABLE questions ADD COLUMN explanation TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE questions DROP COLUMN explanation;
+-- +goose StatementEnd
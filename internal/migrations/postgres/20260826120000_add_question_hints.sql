@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Question hints and their use; see the SQLite migration of the same version.
+ALTER TABLE questions ADD COLUMN hint TEXT NOT NULL DEFAULT '';
+CREATE TABLE game_hints
+(
+    game_question_id BIGINT    NOT NULL REFERENCES game_questions (id) ON DELETE CASCADE,
+    player_id        BIGINT    NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    used_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (game_question_id, player_id)
+);
+ALTER TABLE game_answers ADD COLUMN hint_penalty BIGINT NOT NULL DEFAULT 0
+    CHECK (hint_penalty BETWEEN 0 AND 100);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN hint_penalty;
+DROP TABLE game_hints;
+ALTER TABLE questions DROP COLUMN hint;
+-- +goose StatementEnd
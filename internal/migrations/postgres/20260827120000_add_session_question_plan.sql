@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The host's reorders and skips of a live game's remaining questions; see the
+-- SQLite migration of the same version.
+CREATE TABLE session_question_plan
+(
+    session_id  TEXT   NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+    game_seq    BIGINT NOT NULL,
+    question_id BIGINT NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    position    BIGINT NOT NULL DEFAULT 0,
+    skipped     BIGINT NOT NULL DEFAULT 0 CHECK (skipped IN (0, 1)),
+    PRIMARY KEY (session_id, game_seq, question_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE session_question_plan;
+-- +goose StatementEnd
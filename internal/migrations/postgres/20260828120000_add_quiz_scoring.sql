@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Quiz scoring modes and the answer streak; see the SQLite migration of the
+-- same version.
+ALTER TABLE quizzes ADD COLUMN scoring TEXT NOT NULL DEFAULT 'time'
+    CHECK (scoring IN ('time', 'flat', 'streak'));
+ALTER TABLE game_answers ADD COLUMN scoring TEXT NOT NULL DEFAULT 'time'
+    CHECK (scoring IN ('time', 'flat', 'streak'));
+ALTER TABLE game_answers ADD COLUMN streak BIGINT NOT NULL DEFAULT 0 CHECK (streak >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN streak;
+ALTER TABLE game_answers DROP COLUMN scoring;
+ALTER TABLE quizzes DROP COLUMN scoring;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Negative marking; see the SQLite migration of the same version.
+ALTER TABLE quizzes ADD COLUMN wrong_answer_penalty BIGINT NOT NULL DEFAULT 0
+    CHECK (wrong_answer_penalty BETWEEN 0 AND 1000);
+ALTER TABLE quizzes ADD COLUMN score_floor BIGINT NOT NULL DEFAULT 0 CHECK (score_floor <= 0);
+ALTER TABLE game_answers ADD COLUMN wrong_answer_penalty BIGINT NOT NULL DEFAULT 0
+    CHECK (wrong_answer_penalty >= 0);
+ALTER TABLE game_answers ADD COLUMN score_floor BIGINT NOT NULL DEFAULT 0 CHECK (score_floor <= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_answers DROP COLUMN score_floor;
+ALTER TABLE game_answers DROP COLUMN wrong_answer_penalty;
+ALTER TABLE quizzes DROP COLUMN score_floor;
+ALTER TABLE quizzes DROP COLUMN wrong_answer_penalty;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- quizzes.external_ref is an author-supplied key for a quiz, the quiz-level
+-- counterpart of questions.external_ref, so a content pipeline can find the
+-- quiz it synced before even after its title (and so its slug) changed. NULL,
+-- the default, for quizzes without one. Unique across the instance.
+ALTER TABLE quizzes ADD COLUMN external_ref TEXT;
+CREATE UNIQUE INDEX quizzes_external_ref_idx ON quizzes(external_ref)
+    WHERE external_ref IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX quizzes_external_ref_idx;
+ALTER TABLE quizzes DROP COLUMN external_ref;
+-- +goose StatementEnd
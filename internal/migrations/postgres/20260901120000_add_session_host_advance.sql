@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The host's pacing of a live room; see the SQLite migration of the same
+-- version.
+ALTER TABLE sessions
+    ADD COLUMN host_advance BIGINT NOT NULL DEFAULT 0 CHECK (host_advance IN (0, 1));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions
+    DROP COLUMN host_advance;
+-- +goose StatementEnd
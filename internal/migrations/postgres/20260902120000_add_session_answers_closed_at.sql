@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The host's early close of a question's answering; see the SQLite migration
+-- of the same version.
+ALTER TABLE sessions
+    ADD COLUMN answers_closed_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions
+    DROP COLUMN answers_closed_at;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestQuestionAfterMigration_Schema pins the question ordering constraint
+// column and its self-referencing foreign key.
+func TestQuestionAfterMigration_Schema(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	if !tableColumns(t, db, "questions")["after_question_id"] {
+		t.Error("questions is missing the after_question_id column")
+	}
+	if !foreignKeyOnColumn(t, db, "questions", "after_question_id", "questions") {
+		t.Error("questions.after_question_id is missing its foreign key to questions")
+	}
+}
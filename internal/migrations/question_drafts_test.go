@@ -0,0 +1,26 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestQuestionDraftsMigration_Schema pins the per-player question draft table.
+func TestQuestionDraftsMigration_Schema(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	got := tableColumns(t, db, "question_drafts")
+	for _, col := range []string{"player_id", "question_id", "data", "updated_at"} {
+		if !got[col] {
+			t.Errorf("question_drafts is missing the %s column", col)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// questionStatsEpochVersion is the ADD COLUMN migration tracking each
+// question's statistics epoch and stamping it on recorded picks.
+const questionStatsEpochVersion = 20260802120000
+
+// TestQuestionStatsEpochMigration_Columns pins the schema addition: questions,
+// game_answers and session_answers each gain stats_epoch, the Down drops all
+// three, and the re-Up adds them back.
+func TestQuestionStatsEpochMigration_Columns(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	tables := []string{"questions", "game_answers", "session_answers"}
+	for _, table := range tables {
+		if !tableColumns(t, db, table)["stats_epoch"] {
+			t.Errorf("%s is missing the stats_epoch column", table)
+		}
+	}
+
+	if err := goose.DownTo(db, ".", questionStatsEpochVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	for _, table := range tables {
+		if tableColumns(t, db, table)["stats_epoch"] {
+			t.Errorf("%s still has stats_epoch after Down", table)
+		}
+	}
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up after down err = %v, want nil", err)
+	}
+	for _, table := range tables {
+		if !tableColumns(t, db, table)["stats_epoch"] {
+			t.Errorf("%s is missing stats_epoch after re-Up", table)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestQuizLateJoinMigration_Schema pins the late-join settings on quizzes and
+// the per-game late-join table the standings read.
+func TestQuizLateJoinMigration_Schema(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	quizzes := tableColumns(t, db, "quizzes")
+	for _, col := range []string{"late_join", "join_deadline_seconds"} {
+		if !quizzes[col] {
+			t.Errorf("quizzes is missing the %s column", col)
+		}
+	}
+	lateJoins := tableColumns(t, db, "session_late_joins")
+	for _, col := range []string{"session_id", "player_id", "game_seq", "missed_questions", "joined_at"} {
+		if !lateJoins[col] {
+			t.Errorf("session_late_joins is missing the %s column", col)
+		}
+	}
+}
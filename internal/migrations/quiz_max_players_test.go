@@ -0,0 +1,35 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestQuizMaxPlayersMigration_Schema pins the room-capacity override on
+// quizzes and that an existing quiz defers to the server default (0).
+func TestQuizMaxPlayersMigration_Schema(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	if !tableColumns(t, db, "quizzes")["max_players"] {
+		t.Fatal("quizzes is missing the max_players column")
+	}
+
+	quizID := seedQuiz(t, db, "Capacity", "capacity")
+	var maxPlayers int64
+	if err := db.QueryRowContext(
+		t.Context(), "SELECT max_players FROM quizzes WHERE id = ?", quizID,
+	).Scan(&maxPlayers); err != nil {
+		t.Fatalf("select max_players: %v", err)
+	}
+	if got, want := maxPlayers, int64(0); got != want {
+		t.Errorf("max_players = %d, want %d", got, want)
+	}
+}
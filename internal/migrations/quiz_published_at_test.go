@@ -0,0 +1,65 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// quizPublishedAtVersion is the migration adding quizzes.published_at.
+const quizPublishedAtVersion = 20260819120000
+
+// TestQuizPublishedAtMigration_Backfill pins the backfill: a quiz published
+// before the column existed takes its updated_at, a draft stays NULL.
+func TestQuizPublishedAtMigration_Backfill(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	if err := goose.DownTo(db, ".", quizPublishedAtVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	if tableColumns(t, db, "quizzes")["published_at"] {
+		t.Fatal("quizzes still has published_at after Down, want it dropped")
+	}
+
+	publishedID := seedQuiz(t, db, "Published before", "published-before-published-at")
+	draftID := seedQuiz(t, db, "Draft before", "draft-before-published-at")
+	if _, err := db.ExecContext(t.Context(),
+		"UPDATE quizzes SET published = 1, updated_at = '2026-03-04 05:06:07' WHERE id = ?", publishedID,
+	); err != nil {
+		t.Fatalf("publish quiz err = %v, want nil", err)
+	}
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up err = %v, want nil", err)
+	}
+
+	var got sql.NullTime
+	if err := db.QueryRowContext(t.Context(),
+		"SELECT published_at FROM quizzes WHERE id = ?", publishedID,
+	).Scan(&got); err != nil {
+		t.Fatalf("read published_at err = %v, want nil", err)
+	}
+	if want := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC); !got.Valid || !got.Time.Equal(want) {
+		t.Errorf("published quiz published_at = %+v, want %v (its updated_at)", got, want)
+	}
+
+	if err := db.QueryRowContext(t.Context(),
+		"SELECT published_at FROM quizzes WHERE id = ?", draftID,
+	).Scan(&got); err != nil {
+		t.Fatalf("read published_at err = %v, want nil", err)
+	}
+	if got.Valid {
+		t.Errorf("draft published_at = %v, want NULL", got.Time)
+	}
+}
@@ -0,0 +1,26 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestQuizShuffleModesMigration_Schema pins the per-quiz randomization flags.
+func TestQuizShuffleModesMigration_Schema(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	got := tableColumns(t, db, "quizzes")
+	for _, col := range []string{"shuffle_questions", "keep_option_order"} {
+		if !got[col] {
+			t.Errorf("quizzes is missing the %s column", col)
+		}
+	}
+}
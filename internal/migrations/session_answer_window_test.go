@@ -0,0 +1,51 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// sessionAnswerWindowVersion is the ADD COLUMN migration recording the answer
+// window each live pick was scored against.
+const sessionAnswerWindowVersion = 20260801120000
+
+// TestSessionAnswerWindowMigration_Columns pins the schema addition:
+// session_answers gains window_started_at and window_expires_at, the Down drops
+// both, and the re-Up adds them back.
+func TestSessionAnswerWindowMigration_Columns(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	cols := tableColumns(t, db, "session_answers")
+	for _, col := range []string{"window_started_at", "window_expires_at"} {
+		if !cols[col] {
+			t.Errorf("session_answers is missing the %s column", col)
+		}
+	}
+
+	if err := goose.DownTo(db, ".", sessionAnswerWindowVersion-1); err != nil {
+		t.Fatalf("goose.DownTo err = %v, want nil", err)
+	}
+	cols = tableColumns(t, db, "session_answers")
+	for _, col := range []string{"window_started_at", "window_expires_at"} {
+		if cols[col] {
+			t.Errorf("session_answers still has %s after Down", col)
+		}
+	}
+
+	if err := goose.Up(db, "."); err != nil {
+		t.Fatalf("goose.Up after down err = %v, want nil", err)
+	}
+	if !tableColumns(t, db, "session_answers")["window_expires_at"] {
+		t.Error("session_answers is missing window_expires_at after re-Up")
+	}
+}
@@ -0,0 +1,26 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestSettingsMigration_Schema pins the key/value settings table.
+func TestSettingsMigration_Schema(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	got := tableColumns(t, db, "settings")
+	for _, col := range []string{"key", "value", "updated_at"} {
+		if !got[col] {
+			t.Errorf("settings is missing the %s column", col)
+		}
+	}
+}
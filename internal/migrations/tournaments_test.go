@@ -0,0 +1,77 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+)
+
+// TestTournamentsMigration_Schema pins the tournament tables and the member
+// lookup index.
+func TestTournamentsMigration_Schema(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	for table, cols := range map[string][]string{
+		"tournaments":        {"id", "title", "join_code", "created_by_player_id", "created_at"},
+		"tournament_stages":  {"tournament_id", "quiz_id", "position", "added_at"},
+		"tournament_players": {"tournament_id", "player_id", "joined_at"},
+	} {
+		got := tableColumns(t, db, table)
+		for _, col := range cols {
+			if !got[col] {
+				t.Errorf("%s is missing the %s column", table, col)
+			}
+		}
+	}
+	if !indexExists(t, db, "tournament_players_player_idx") {
+		t.Error("tournament_players_player_idx is missing")
+	}
+}
+
+// TestTournamentsMigration_CascadesOnPlayerDelete pins that deleting a player
+// drops their membership rather than blocking the delete.
+func TestTournamentsMigration_CascadesOnPlayerDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := db.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v", cerr)
+		}
+	})
+
+	playerID := seedPlayer(t, db)
+	res, err := db.ExecContext(ctx, "INSERT INTO tournaments (title, join_code) VALUES ('Cup', 'ABC234')")
+	if err != nil {
+		t.Fatalf("seed tournament err = %v, want nil", err)
+	}
+	tournamentID, err := res.LastInsertId()
+	if err != nil {
+		t.Fatalf("LastInsertId err = %v, want nil", err)
+	}
+	if _, err = db.ExecContext(
+		ctx, "INSERT INTO tournament_players (tournament_id, player_id) VALUES (?, ?)", tournamentID, playerID,
+	); err != nil {
+		t.Fatalf("seed membership err = %v, want nil", err)
+	}
+
+	if _, err = db.ExecContext(ctx, "DELETE FROM players WHERE id = ?", playerID); err != nil {
+		t.Fatalf("delete player err = %v, want nil", err)
+	}
+
+	var n int
+	if err = db.QueryRowContext(ctx, "SELECT count(*) FROM tournament_players").Scan(&n); err != nil {
+		t.Fatalf("count memberships err = %v, want nil", err)
+	}
+	if n != 0 {
+		t.Errorf("memberships after player delete = %d, want 0", n)
+	}
+}
@@ -237,6 +237,10 @@ func (*reloadFailStore) CreateAnonymousPlayer(_ context.Context, _ string) (*aut
 	return nil, errors.ErrUnsupported
 }
 
+func (*reloadFailStore) SetGeneratedDisplayName(_ context.Context, _ int64, _ string) (*auth.Player, error) {
+	return nil, errors.ErrUnsupported
+}
+
 func (*reloadFailStore) ClaimPlayer(_ context.Context, _ int64, _, _, _, _ string) (*auth.Player, error) {
 	return nil, errors.ErrUnsupported
 }
@@ -16,10 +16,11 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/starquake/topbanana/internal/absurl"
+	"github.com/starquake/topbanana/internal/assets"
 	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/envtag"
 	"github.com/starquake/topbanana/internal/locale"
@@ -275,6 +276,7 @@ func parseTemplate(page string) *template.Template {
 		"csrfToken":      func() string { return "" },
 		"ogImage":        func() string { return "" },
 		"envTitleTag":    envtag.Get,
+		"asset":          assets.URL,
 		"versionLabel":   version.Label,
 		"viewerName":     func() string { return "" },
 		"isSignedIn":     func() bool { return false },
@@ -289,11 +291,13 @@ func parseTemplate(page string) *template.Template {
 		"passwordMinLength": func() int { return auth.MinPasswordLength },
 		// Placeholder so the shared components glob parses; this surface
 		// does not render quiz_card, which is the only user (#889).
-		"humanizeTime": func(time.Time) string { return "" },
 		// Parse-time placeholders for the shared client_footer's t/lang (#1115);
 		// render.Renderer rebinds them per request.
 		"t":    func(string) string { return "" },
 		"lang": func() string { return locale.LocaleEN },
+		// Parse-time placeholder for the footer branding; render.Renderer
+		// rebinds it per request.
+		"brand": func() branding.Branding { return branding.Branding{} },
 	}
 
 	return render.Parse(tmpl.FS, funcs, page, "components/*.gohtml", "auth/layouts/*.gohtml")
@@ -53,6 +53,10 @@ func (*renameStubStore) CreateAnonymousPlayer(_ context.Context, _ string) (*aut
 	return nil, errors.ErrUnsupported
 }
 
+func (*renameStubStore) SetGeneratedDisplayName(_ context.Context, _ int64, _ string) (*auth.Player, error) {
+	return nil, errors.ErrUnsupported
+}
+
 func (*renameStubStore) ClaimPlayer(
 	_ context.Context, _ int64, _, _, _, _ string,
 ) (*auth.Player, error) {
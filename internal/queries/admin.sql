@@ -196,3 +196,96 @@ VALUES (
     1
 )
 RETURNING *;
+
+-- name: ListSoloAnswersForExport :many
+-- Solo answers for the analytics export, oldest first, resuming after the
+-- given game_answers id. Joined with the quiz, question, option and player so
+-- each row stands alone. game_answers rows are never updated once written, so
+-- the id is a stable resume cursor. Preview games are left out.
+SELECT ga.id,
+       ga.game_id,
+       ga.answered_at,
+       ga.player_id,
+       CAST(p.display_name AS TEXT) AS display_name,
+       q.quiz_id,
+       CAST(qz.title AS TEXT)       AS quiz_title,
+       gq.question_id,
+       CAST(q.text AS TEXT)         AS question_text,
+       ga.option_id,
+       CAST(o.text AS TEXT)         AS option_text,
+       o.is_correct
+FROM game_answers ga
+         JOIN games g ON g.id = ga.game_id
+         JOIN game_questions gq ON gq.id = ga.game_question_id
+         JOIN questions q ON q.id = gq.question_id
+         JOIN quizzes qz ON qz.id = q.quiz_id
+         JOIN options o ON o.id = ga.option_id
+         JOIN players p ON p.id = ga.player_id
+WHERE ga.id > sqlc.arg('after_id')
+  AND g.is_preview = 0
+ORDER BY ga.id
+LIMIT sqlc.arg('row_limit');
+
+-- name: ListLiveAnswersForExport :many
+-- Live-session answers for the analytics export, oldest first, resuming after
+-- the given session_answers id. A pick on a question that is still open can be
+-- overwritten and is unscored, so the export stops short of the oldest such
+-- pick: everything returned is settled and the id stays a safe resume cursor.
+-- Unscored picks on a question the room has moved past (an abandoned game) are
+-- settled too and come back with a NULL score. participant_notes carries the
+-- host's notes on the picker in that session.
+SELECT sa.id,
+       s.join_code,
+       sa.game_seq,
+       sa.answered_at,
+       sa.player_id,
+       CAST(p.display_name AS TEXT) AS display_name,
+       q.quiz_id,
+       CAST(qz.title AS TEXT)       AS quiz_title,
+       sa.question_id,
+       CAST(q.text AS TEXT)         AS question_text,
+       sa.option_id,
+       CAST(o.text AS TEXT)         AS option_text,
+       o.is_correct,
+       sa.score,
+       CAST(COALESCE(sp.notes, '') AS TEXT) AS participant_notes
+FROM session_answers sa
+         JOIN sessions s ON s.id = sa.session_id
+         JOIN questions q ON q.id = sa.question_id
+         JOIN quizzes qz ON qz.id = q.quiz_id
+         JOIN options o ON o.id = sa.option_id
+         JOIN players p ON p.id = sa.player_id
+         LEFT JOIN session_players sp ON sp.session_id = sa.session_id AND sp.player_id = sa.player_id
+WHERE sa.id > sqlc.arg('after_id')
+  AND sa.id < COALESCE((SELECT MIN(pending.id)
+                        FROM session_answers pending
+                                 JOIN sessions ps ON ps.id = pending.session_id
+                        WHERE pending.score IS NULL
+                          AND ps.current_question_id = pending.question_id
+                          AND ps.game_seq = pending.game_seq), 9223372036854775807)
+ORDER BY sa.id
+LIMIT sqlc.arg('row_limit');
+
+-- name: InsertAuditEvent :one
+-- Records one admin content mutation. diff is the JSON the writer encodes;
+-- created_at falls through to the column default, as on admin_audit.
+INSERT INTO audit_events (actor_player_id, entity_type, entity_id, action, diff)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, created_at;
+
+-- name: ListRecentAuditEvents :many
+-- The most recent content mutations, newest first, with the actor's current
+-- display name (empty once the account is gone). Backs the admin audit page;
+-- audit_events_created_at_idx serves the ORDER BY and LIMIT.
+SELECT e.id,
+       e.actor_player_id,
+       CAST(COALESCE(p.display_name, '') AS TEXT) AS actor_display_name,
+       e.entity_type,
+       e.entity_id,
+       e.action,
+       e.diff,
+       e.created_at
+FROM audit_events e
+         LEFT JOIN players p ON p.id = e.actor_player_id
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT sqlc.arg('row_limit');
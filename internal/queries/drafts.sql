@@ -0,0 +1,19 @@
+-- name: GetQuestionDraft :one
+-- The player's unsaved draft of a question, if the editor autosaved one.
+SELECT *
+FROM question_drafts
+WHERE player_id = ?
+  AND question_id = ?;
+
+-- name: UpsertQuestionDraft :exec
+-- Each autosave replaces the player's previous draft of the question.
+INSERT INTO question_drafts (player_id, question_id, data)
+VALUES (sqlc.arg('player_id'), sqlc.arg('question_id'), sqlc.arg('data'))
+ON CONFLICT (player_id, question_id) DO UPDATE SET data = excluded.data, updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteQuestionDraft :exec
+-- Drops the player's draft once the question is saved.
+DELETE
+FROM question_drafts
+WHERE player_id = ?
+  AND question_id = ?;
@@ -34,11 +34,77 @@ RETURNING *;
 -- and clamps it to [question.started_at, time.Now()] before this
 -- INSERT runs, so an honest player on a slow link gets the network
 -- latency refunded instead of being scored late, and a malicious or
--- clock-skewed client can't claim a time outside that window.
-INSERT INTO game_answers (game_id, player_id, game_question_id, option_id, answered_at)
-VALUES (?, ?, ?, ?, ?)
+-- clock-skewed client can't claim a time outside that window. stats_epoch is
+-- copied from the question so the per-question statistics only count picks
+-- made since its last reset. elapsed_ms is the service's monotonic
+-- window-open-to-answer measurement, NULL when it has none. numeric_value is
+-- the number typed for a numeric question, NULL on a multiple-choice pick.
+-- wager is copied from the question too: the stake the player locked in, or 1
+-- when the quiz uses the confidence wager and they placed none; NULL on a quiz
+-- without it. paused_ms is the part of the player's once-per-game pause that
+-- fell before the answer, 0 unless they paused this question. hint_penalty is
+-- the given penalty when the player took the question's hint, 0 otherwise.
+-- scoring, wrong_answer_penalty and score_floor are copied from the quiz.
+-- streak extends the player's streak on the
+-- question issued just before this one when correct is 1, and is 0 otherwise;
+-- an unanswered previous question starts the run over at 1.
+INSERT INTO game_answers (game_id, player_id, game_question_id, option_id, answered_at, elapsed_ms, numeric_value, paused_ms,
+                          stats_epoch, wager, hint_penalty, scoring, streak, wrong_answer_penalty,
+                          score_floor)
+VALUES (sqlc.arg('game_id'),
+        sqlc.arg('player_id'),
+        sqlc.arg('game_question_id'),
+        sqlc.arg('option_id'),
+        sqlc.arg('answered_at'),
+        sqlc.arg('elapsed_ms'),
+        sqlc.arg('numeric_value'),
+        sqlc.arg('paused_ms'),
+        (SELECT q.stats_epoch
+         FROM game_questions gq
+                  JOIN questions q ON q.id = gq.question_id
+         WHERE gq.id = sqlc.arg('game_question_id')),
+        (SELECT CASE WHEN qz.confidence_wager THEN COALESCE(gq.wager, 1) END
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = sqlc.arg('game_question_id')),
+        (SELECT CASE WHEN EXISTS (SELECT 1
+                                  FROM game_hints gh
+                                  WHERE gh.game_question_id = sqlc.arg('game_question_id')
+                                    AND gh.player_id = sqlc.arg('player_id'))
+                         THEN CAST(sqlc.arg('hint_penalty') AS INTEGER)
+                     ELSE 0 END),
+        (SELECT qz.scoring
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = sqlc.arg('game_question_id')),
+        CASE WHEN CAST(sqlc.arg('correct') AS INTEGER) = 0 THEN 0
+             ELSE 1 + COALESCE((SELECT pa.streak
+                                FROM game_answers pa
+                                WHERE pa.player_id = sqlc.arg('player_id')
+                                  AND pa.game_question_id = (SELECT MAX(pq.id)
+                                                             FROM game_questions pq
+                                                             WHERE pq.game_id = sqlc.arg('game_id')
+                                                               AND pq.id < sqlc.arg('game_question_id'))), 0) END,
+        (SELECT qz.wrong_answer_penalty
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = sqlc.arg('game_question_id')),
+        (SELECT qz.score_floor
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = sqlc.arg('game_question_id')))
 RETURNING *;
 
+-- name: CreateAnswerOption :exec
+-- Records one option of a multi-select answer's pick set. The answer row
+-- itself carries one of the picks in option_id.
+INSERT INTO game_answer_options (game_answer_id, option_id)
+VALUES (?, ?);
+
 -- name: GetPlayer :one
 SELECT *
 FROM players
@@ -62,13 +128,31 @@ WHERE game_question_id = ?;
 -- Returns every game_answer for a given game, ordered by
 -- game_question_id so callers can partition rows per question in a
 -- single pass. Replaces the N+1 pattern of calling
--- ListAnswersByGameQuestionID once per issued question (#356); the
--- UNIQUE(game_id, player_id, game_question_id) constraint serves as
--- the index for the game_id filter.
-SELECT *
-FROM game_answers
-WHERE game_id = ?
-ORDER BY game_question_id;
+-- ListAnswersByGameQuestionID once per issued question (#356);
+-- game_answers_game_id_question_idx serves both the game_id filter and
+-- the ORDER BY. picked_correct and picked_wrong tally a
+-- multi-select answer's picks (game_answer_options) against their options,
+-- and correct_options counts the question's correct options; both picked
+-- counts are 0 on a single-pick answer.
+SELECT ga.*,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND po.is_correct) AS picked_correct,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND NOT po.is_correct) AS picked_wrong,
+       (SELECT COUNT(*)
+        FROM options co
+                 JOIN options ao ON ao.question_id = co.question_id
+        WHERE ao.id = ga.option_id
+          AND co.is_correct) AS correct_options
+FROM game_answers ga
+WHERE ga.game_id = ?
+ORDER BY ga.game_question_id;
 
 -- name: CreateGameQuestion :one
 -- started_at and expired_at are bound as CURRENT_TIMESTAMP-format text strings
@@ -97,6 +181,23 @@ SELECT *
 FROM game_questions
 WHERE game_id = ? AND question_id = ?;
 
+-- name: GetNextUnaskedQuestion :one
+-- The lowest-position question of the game's quiz that has not been issued
+-- to the game yet, so game.Service.GetNextQuestion can pick the next question
+-- without loading the whole quiz. No rows means every question was asked.
+-- The id makes the order total, as in ListQuestionsByQuizID, so this path
+-- and the ones that walk the whole quiz can never pick different questions.
+SELECT q.*
+FROM questions q
+         JOIN games g ON g.quiz_id = q.quiz_id
+WHERE g.id = sqlc.arg('game_id')
+  AND NOT EXISTS (SELECT 1
+                  FROM game_questions gq
+                  WHERE gq.game_id = sqlc.arg('game_id')
+                    AND gq.question_id = q.id)
+ORDER BY q.position, q.id
+LIMIT 1;
+
 -- name: ListAnswersForQuizLeaderboard :many
 -- Selects the per-answer scoring inputs for every game of the given
 -- quiz (finished AND in-progress, #244). The completed-only filter
@@ -109,12 +210,50 @@ WHERE game_id = ? AND question_id = ?;
 -- question has been issued (game_questions rows >= quiz questions
 -- count). The Go layer collapses one row per (player, game) into a
 -- single LeaderboardEntry with the per-player Completed flag.
-SELECT ga.player_id        AS player_id,
+--
+-- picked_correct, picked_wrong and correct_options tally a multi-select
+-- answer as ListAnswersByGameID does, wager is the confidence stake the answer
+-- is scored with, paused_ms the paused time taken off it, hint_penalty the
+-- percentage its hint cost, scoring and streak the quiz's scoring mode and
+-- the run of correct answers it was recorded under, and wrong_answer_penalty
+-- and score_floor the negative marking it was recorded under. Answers to a
+-- question voided for its game are left out; they score nothing there. So are
+-- the answers of a host-paced quiz's game until its host reveals the scores.
+-- game_id lets the Go layer hold each game's total at its score floor before
+-- summing a player's games.
+SELECT ga.game_id          AS game_id,
+       ga.player_id        AS player_id,
        p.display_name           AS display_name,
        gq.started_at        AS question_started_at,
        gq.expired_at        AS question_expired_at,
        ga.answered_at       AS answered_at,
+       ga.elapsed_ms        AS elapsed_ms,
+       ga.numeric_value     AS numeric_value,
+       ga.wager             AS wager,
+       ga.paused_ms         AS paused_ms,
+       ga.hint_penalty      AS hint_penalty,
+       ga.scoring           AS scoring,
+       ga.streak            AS streak,
+       ga.wrong_answer_penalty AS wrong_answer_penalty,
+       ga.score_floor       AS score_floor,
        o.is_correct         AS is_correct,
+       o.numeric_value      AS key_value,
+       o.tolerance_below    AS tolerance_below,
+       o.tolerance_above    AS tolerance_above,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND po.is_correct) AS picked_correct,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND NOT po.is_correct) AS picked_wrong,
+       (SELECT COUNT(*)
+        FROM options co
+        WHERE co.question_id = o.question_id
+          AND co.is_correct) AS correct_options,
        CASE WHEN (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id) > 0
              AND (SELECT COUNT(*) FROM game_questions gqc WHERE gqc.game_id = g.id) >=
                  (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id)
@@ -125,12 +264,16 @@ FROM game_answers ga
          JOIN options o ON o.id = ga.option_id
          JOIN players p ON p.id = ga.player_id
 WHERE g.quiz_id = ?
-  AND g.is_preview = 0;
+  AND g.is_preview = 0
+  AND gq.voided_at IS NULL
+  AND (g.revealed_at IS NOT NULL
+    OR (SELECT qz.host_paced FROM quizzes qz WHERE qz.id = g.quiz_id) = 0);
 
 -- name: ListParticipantsForQuizLeaderboard :many
 -- One row per player joined to the quiz, flagged with is_completed
 -- (every quiz question issued) and is_stale (#336: latest
--- game_question unanswered and expired before stale_before).
+-- game_question unanswered and expired before stale_before, or the game
+-- already finished or abandoned, so it never shows as in progress).
 -- Canonical entry set per #335 so a joined-but-unanswered player
 -- still appears at 0.
 -- Joins through `games` so the WHERE filters on games.quiz_id (NOT
@@ -147,7 +290,7 @@ SELECT gp.player_id AS player_id,
              AND (SELECT COUNT(*) FROM game_questions gqc WHERE gqc.game_id = gp.game_id) >=
                  (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id)
             THEN 1 ELSE 0 END AS is_completed,
-       CASE WHEN EXISTS (
+       CASE WHEN g.finished_at IS NOT NULL OR EXISTS (
               SELECT 1 FROM game_questions gq
               WHERE gq.game_id = gp.game_id
                 AND gq.expired_at < CAST(sqlc.arg('stale_before') AS TEXT)
@@ -165,7 +308,8 @@ WHERE g.quiz_id = sqlc.arg('quiz_id')
 -- player-side resume flow (GET /api/quizzes/{slugID}/my-game) and as a
 -- defensive backstop in CreateGame so the same player cannot start a second
 -- attempt at a quiz they have already played.
-SELECT g.id, g.quiz_id, g.created_at, g.started_at, g.is_preview
+SELECT g.id, g.quiz_id, g.created_at, g.started_at, g.is_preview, g.status, g.finished_at, g.results_public,
+       g.revealed_at
 FROM games g
          JOIN game_participants gp ON gp.game_id = g.id
 WHERE gp.player_id = ?
@@ -176,7 +320,8 @@ LIMIT 1;
 -- name: GetRealGameByPlayerAndQuiz :one
 -- Returns the most-recent non-preview game for the (player, quiz) pair, so the
 -- resume flow skips a stale owner-preview and the owner can still record a real run (#1192).
-SELECT g.id, g.quiz_id, g.created_at, g.started_at, g.is_preview
+SELECT g.id, g.quiz_id, g.created_at, g.started_at, g.is_preview, g.status, g.finished_at, g.results_public,
+       g.revealed_at
 FROM games g
          JOIN game_participants gp ON gp.game_id = g.id
 WHERE gp.player_id = ?
@@ -201,6 +346,113 @@ SELECT DISTINCT gp.quiz_id
 FROM game_participants gp
 WHERE gp.player_id = ?;
 
+-- name: ListPlayerGameHistory :many
+-- One page of the player's past games, newest first, for the "Your previous
+-- games" screen: each game's quiz, lifecycle, and how far the player got.
+-- Owner previews are left out, as they are from every other player-facing
+-- total. scores_hidden flags a host-paced quiz's game whose host has not
+-- revealed the scores yet, so the Go layer leaves its score and rank out.
+SELECT g.id               AS game_id,
+       g.quiz_id          AS quiz_id,
+       qz.title           AS quiz_title,
+       qz.slug            AS quiz_slug,
+       g.created_at       AS created_at,
+       g.finished_at      AS finished_at,
+       g.status           AS status,
+       CASE WHEN qz.host_paced <> 0 AND g.revealed_at IS NULL THEN 1 ELSE 0 END AS scores_hidden,
+       (SELECT COUNT(*)
+        FROM game_answers ga
+        WHERE ga.game_id = g.id
+          AND ga.player_id = gp.player_id) AS answered_count,
+       (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id) AS question_count
+FROM game_participants gp
+         JOIN games g    ON g.id = gp.game_id
+         JOIN quizzes qz ON qz.id = g.quiz_id
+WHERE gp.player_id = sqlc.arg('player_id')
+  AND g.is_preview = 0
+ORDER BY g.created_at DESC, g.id DESC
+LIMIT sqlc.arg('row_limit') OFFSET sqlc.arg('row_offset');
+
+-- name: CountPlayerGameHistory :one
+-- The total behind ListPlayerGameHistory, for the page navigation.
+SELECT COUNT(*)
+FROM game_participants gp
+         JOIN games g ON g.id = gp.game_id
+WHERE gp.player_id = ?
+  AND g.is_preview = 0;
+
+-- name: ListQuizPlayReport :many
+-- Per-quiz play counts for the admin analytics page: how many real games of
+-- each quiz were started in [created_from, created_to), and how many of them
+-- finished. Quizzes without a game in the range are left out. The bounds are
+-- bound as CURRENT_TIMESTAMP-format text for the same reason as
+-- ListParticipantsForQuizLeaderboard's stale_before (#789).
+SELECT g.quiz_id                                                         AS quiz_id,
+       qz.title                                                          AS quiz_title,
+       COUNT(*)                                                          AS play_count,
+       CAST(SUM(CASE WHEN g.status = 'finished' THEN 1 ELSE 0 END) AS INTEGER) AS finished_count
+FROM games g
+         JOIN quizzes qz ON qz.id = g.quiz_id
+WHERE g.is_preview = 0
+  AND g.created_at >= CAST(sqlc.arg('created_from') AS TEXT)
+  AND g.created_at < CAST(sqlc.arg('created_to') AS TEXT)
+GROUP BY g.quiz_id, qz.title
+ORDER BY play_count DESC, g.quiz_id;
+
+-- name: ListAnswersForPlayReport :many
+-- Every answer given in a real game started in [created_from, created_to),
+-- with the scoring inputs ListAnswersForQuizLeaderboard reads plus the game,
+-- quiz and question it belongs to, so the Go layer can work out average
+-- scores, per-question correct rates and answer times with the scoring
+-- formula itself. Answers to a voided question are left out; they score
+-- nothing. Unlike the leaderboard, a host-paced quiz's unrevealed answers
+-- count: the report is for admins, not players.
+SELECT g.id                 AS game_id,
+       g.quiz_id            AS quiz_id,
+       gq.question_id       AS question_id,
+       q.text               AS question_text,
+       ga.player_id         AS player_id,
+       gq.started_at        AS question_started_at,
+       gq.expired_at        AS question_expired_at,
+       ga.answered_at       AS answered_at,
+       ga.elapsed_ms        AS elapsed_ms,
+       ga.numeric_value     AS numeric_value,
+       ga.wager             AS wager,
+       ga.paused_ms         AS paused_ms,
+       ga.hint_penalty      AS hint_penalty,
+       ga.scoring           AS scoring,
+       ga.streak            AS streak,
+       ga.wrong_answer_penalty AS wrong_answer_penalty,
+       ga.score_floor       AS score_floor,
+       o.is_correct         AS is_correct,
+       o.numeric_value      AS key_value,
+       o.tolerance_below    AS tolerance_below,
+       o.tolerance_above    AS tolerance_above,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND po.is_correct) AS picked_correct,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND NOT po.is_correct) AS picked_wrong,
+       (SELECT COUNT(*)
+        FROM options co
+        WHERE co.question_id = o.question_id
+          AND co.is_correct) AS correct_options
+FROM game_answers ga
+         JOIN games g ON g.id = ga.game_id
+         JOIN game_questions gq ON gq.id = ga.game_question_id
+         JOIN questions q ON q.id = gq.question_id
+         JOIN options o ON o.id = ga.option_id
+WHERE g.is_preview = 0
+  AND gq.voided_at IS NULL
+  AND g.created_at >= CAST(sqlc.arg('created_from') AS TEXT)
+  AND g.created_at < CAST(sqlc.arg('created_to') AS TEXT)
+ORDER BY ga.id;
+
 -- name: ListGameIDsForPlayerOnQuiz :many
 -- Lists every game ID the player has on the given quiz. The reset flow
 -- collects these once at the start of the in-Go transaction and feeds them
@@ -339,3 +591,91 @@ WHERE game_participants.player_id = sqlc.arg('from_player_id')
       WHERE gp2.player_id = sqlc.arg('to_player_id')
         AND gp2.quiz_id = game_participants.quiz_id
   );
+
+-- name: VoidGameQuestion :execrows
+-- Voids an issued question for one game so its answers stop scoring there.
+-- COALESCE keeps the first voided_at on a repeat call; zero rows affected
+-- means the question was never issued to the game.
+UPDATE game_questions
+SET voided_at = COALESCE(voided_at, CURRENT_TIMESTAMP)
+WHERE game_id = ?
+  AND question_id = ?;
+
+-- name: PlaceGameQuestionWager :execrows
+-- Locks in the player's confidence stake on an issued question. The
+-- wager IS NULL guard makes the first stake final: zero rows affected means
+-- one was already placed (or the question was never issued to the game).
+UPDATE game_questions
+SET wager = CAST(sqlc.arg('wager') AS INTEGER)
+WHERE game_id = sqlc.arg('game_id')
+  AND question_id = sqlc.arg('question_id')
+  AND wager IS NULL;
+
+-- name: FinishGame :execrows
+-- Moves a game to a terminal status (finished or abandoned) and stamps
+-- finished_at. The finished_at IS NULL guard makes the first transition win:
+-- zero rows affected means the game was already over (or does not exist).
+UPDATE games
+SET status      = sqlc.arg('status'),
+    finished_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id')
+  AND finished_at IS NULL;
+
+-- name: SetGameResultsPublic :execrows
+-- Turns the game's public, anonymized standings on or off. Zero rows affected
+-- means the game does not exist.
+UPDATE games
+SET results_public = sqlc.arg('results_public')
+WHERE id = sqlc.arg('id');
+
+-- name: RevealGame :execrows
+-- Stamps revealed_at on a host-paced game the first time its host reveals the
+-- scores. Zero rows affected means the game does not exist or was already
+-- revealed.
+UPDATE games
+SET revealed_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id')
+  AND revealed_at IS NULL;
+
+-- name: ListParticipantNamesByGameID :many
+-- Lists each participant's display name for the public standings, which show
+-- names only.
+SELECT gp.player_id, p.display_name
+FROM game_participants gp
+         JOIN players p ON p.id = gp.player_id
+WHERE gp.game_id = ?;
+
+-- name: CreateGameEvent :execrows
+-- Appends one event to the game's log. The game id is read back from games so
+-- an event that outlived its game - the game was reset while the event sat in
+-- the delivery queue - inserts nothing instead of failing the batch on the
+-- foreign key: zero rows affected means the game is gone.
+INSERT INTO game_events (game_id, type, payload)
+SELECT g.id, CAST(sqlc.arg('type') AS TEXT), CAST(sqlc.arg('payload') AS TEXT)
+FROM games g
+WHERE g.id = sqlc.arg('game_id');
+
+-- name: ListGameEventsByGameID :many
+-- Lists the game's event log in the order it was recorded.
+SELECT id, type, payload, recorded_at
+FROM game_events
+WHERE game_id = ?
+ORDER BY id;
+
+-- name: PauseParticipantOnce :execrows
+-- Spends the player's one pause of the game on an issued question. The
+-- paused_question_id IS NULL guard makes it once per participation: zero rows
+-- affected means the pause was already used.
+UPDATE game_participants
+SET paused_question_id = CAST(sqlc.arg('question_id') AS INTEGER),
+    paused_at          = sqlc.arg('paused_at')
+WHERE game_id = sqlc.arg('game_id')
+  AND player_id = sqlc.arg('player_id')
+  AND paused_question_id IS NULL;
+
+-- name: RecordGameHint :exec
+-- Marks the hint of an issued question as taken by the player. Asking again
+-- keeps the first row, so a hint costs its penalty once.
+INSERT INTO game_hints (game_question_id, player_id)
+VALUES (?, ?)
+ON CONFLICT DO NOTHING;
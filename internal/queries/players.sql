@@ -357,6 +357,20 @@ SET display_name = sqlc.arg('display_name'),
 WHERE id = sqlc.arg('id') AND password_hash IS NULL
 RETURNING *;
 
+-- name: SetGeneratedDisplayName :one
+-- Gives a freshly minted anonymous row its generated nickname (derived from the
+-- new id, so it cannot be chosen before the INSERT). Guarded on the row still
+-- being anonymous and unclaimed so it never overwrites a name the visitor
+-- picked; display_name_claimed stays 0 because the visitor did not choose it.
+-- sql.ErrNoRows means the guard filtered the row out; a UNIQUE constraint
+-- failure means the nickname is taken.
+UPDATE players
+SET display_name = sqlc.arg('display_name')
+WHERE id = sqlc.arg('id')
+  AND password_hash IS NULL
+  AND display_name_claimed = 0
+RETURNING *;
+
 -- name: RenamePlayer :one
 -- Renames any player row by id, regardless of password / email / role.
 -- The dedicated profile-page endpoint (POST /profile/display-name, #410)
@@ -587,3 +601,66 @@ UPDATE players
 SET password_hash = sqlc.arg('password_hash'),
     session_version = session_version + 1
 WHERE id = sqlc.arg('id');
+
+-- name: TouchPlayerSession :exec
+-- Records a sighting of a session cookie: the first one inserts the device
+-- row, later ones refresh its user agent, address and last_seen_at. The
+-- update leaves revoked_at alone, so a revoked session never comes back, and
+-- only touches a row of the same player.
+INSERT INTO player_sessions (id, player_id, user_agent, ip_address)
+VALUES (sqlc.arg('id'), sqlc.arg('player_id'), sqlc.arg('user_agent'), sqlc.arg('ip_address'))
+ON CONFLICT (id) DO UPDATE SET user_agent   = excluded.user_agent,
+                               ip_address   = excluded.ip_address,
+                               last_seen_at = CURRENT_TIMESTAMP
+WHERE player_sessions.player_id = excluded.player_id;
+
+-- name: ListPlayerSessions :many
+-- The player's sessions that have not been revoked, most recently used
+-- first.
+SELECT id, user_agent, ip_address, created_at, last_seen_at
+FROM player_sessions
+WHERE player_id = ?
+  AND revoked_at IS NULL
+ORDER BY last_seen_at DESC, id;
+
+-- name: ListRevokedPlayerSessionIDs :many
+-- The ids of the player's revoked sessions, which the auth middleware
+-- caches and refuses.
+SELECT id
+FROM player_sessions
+WHERE player_id = ?
+  AND revoked_at IS NOT NULL;
+
+-- name: RevokePlayerSession :execrows
+-- Marks one of the player's sessions revoked. Zero rows means the id is not
+-- a live session of this player.
+UPDATE player_sessions
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id')
+  AND player_id = sqlc.arg('player_id')
+  AND revoked_at IS NULL;
+
+-- name: RevokePlayerSessionsExcept :exec
+-- Marks every live session of the player revoked but the one with id
+-- keep_id; an empty keep_id revokes them all.
+UPDATE player_sessions
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE player_id = sqlc.arg('player_id')
+  AND id <> sqlc.arg('keep_id')
+  AND revoked_at IS NULL;
+
+-- name: IncrementSessionRevocations :exec
+-- Bumps players.session_revocations after a session is revoked, so every
+-- process's cached list of the player's revoked ids is reread.
+UPDATE players
+SET session_revocations = session_revocations + 1
+WHERE id = ?;
+
+-- name: BumpSessionVersion :one
+-- Bumps session_version, which invalidates every session cookie the player
+-- holds, including those minted before sessions were recorded, and returns
+-- the new value for re-issuing the cookie that stays.
+UPDATE players
+SET session_version = session_version + 1
+WHERE id = ?
+RETURNING session_version;
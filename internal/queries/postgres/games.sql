@@ -0,0 +1,148 @@
+-- name: CreateAnswer :one
+-- quizzes.confidence_wager is a 0/1 flag; Postgres will not read an integer
+-- as a condition, so the CASE compares it explicitly.
+INSERT INTO game_answers (game_id, player_id, game_question_id, option_id, answered_at, elapsed_ms, numeric_value, paused_ms,
+                          stats_epoch, wager, hint_penalty, scoring, streak, wrong_answer_penalty,
+                          score_floor)
+VALUES ($1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        (SELECT q.stats_epoch
+         FROM game_questions gq
+                  JOIN questions q ON q.id = gq.question_id
+         WHERE gq.id = $3),
+        (SELECT CASE WHEN qz.confidence_wager = 1 THEN COALESCE(gq.wager, 1) END
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = $3),
+        (SELECT CASE WHEN EXISTS (SELECT 1
+                                  FROM game_hints gh
+                                  WHERE gh.game_question_id = $3
+                                    AND gh.player_id = $2)
+                         THEN CAST($9 AS BIGINT)
+                     ELSE 0 END),
+        (SELECT qz.scoring
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = $3),
+        CASE WHEN CAST($10 AS BIGINT) = 0 THEN 0
+             ELSE 1 + COALESCE((SELECT pa.streak
+                                FROM game_answers pa
+                                WHERE pa.player_id = $2
+                                  AND pa.game_question_id = (SELECT MAX(pq.id)
+                                                             FROM game_questions pq
+                                                             WHERE pq.game_id = $1
+                                                               AND pq.id < $3)), 0) END,
+        (SELECT qz.wrong_answer_penalty
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = $3),
+        (SELECT qz.score_floor
+         FROM game_questions gq
+                  JOIN games g ON g.id = gq.game_id
+                  JOIN quizzes qz ON qz.id = g.quiz_id
+         WHERE gq.id = $3))
+RETURNING id, game_id, player_id, game_question_id, option_id, answered_at, stats_epoch, elapsed_ms, numeric_value, wager,
+          paused_ms, hint_penalty, scoring, streak, wrong_answer_penalty, score_floor;
+
+-- name: CreateGameQuestion :one
+-- The SQLite query casts the bounds to TEXT to match its stored datetime
+-- format; Postgres stores a TIMESTAMP.
+INSERT INTO game_questions (game_id, question_id, started_at, expired_at)
+VALUES ($1, $2, CAST($3 AS TIMESTAMP), CAST($4 AS TIMESTAMP))
+ON CONFLICT (game_id, question_id) DO NOTHING
+RETURNING id, game_id, question_id, started_at, expired_at, voided_at, wager;
+
+-- name: ListParticipantsForQuizLeaderboard :many
+-- stale_before compares against a TIMESTAMP rather than SQLite's datetime
+-- text.
+SELECT gp.player_id AS player_id,
+       p.display_name   AS display_name,
+       CASE WHEN (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id) > 0
+             AND (SELECT COUNT(*) FROM game_questions gqc WHERE gqc.game_id = gp.game_id) >=
+                 (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id)
+            THEN 1 ELSE 0 END AS is_completed,
+       CASE WHEN g.finished_at IS NOT NULL OR EXISTS (
+              SELECT 1 FROM game_questions gq
+              WHERE gq.game_id = gp.game_id
+                AND gq.expired_at < CAST($1 AS TIMESTAMP)
+                AND NOT EXISTS (SELECT 1 FROM game_answers ga WHERE ga.game_question_id = gq.id)
+                AND NOT EXISTS (SELECT 1 FROM game_questions gqn WHERE gqn.game_id = gp.game_id AND gqn.id > gq.id)
+            ) THEN 1 ELSE 0 END AS is_stale
+FROM game_participants gp
+         JOIN games g   ON g.id = gp.game_id
+         JOIN players p ON p.id = gp.player_id
+WHERE g.quiz_id = $2
+  AND g.is_preview = 0;
+
+-- name: ListQuizPlayReport :many
+-- created_from and created_to compare against a TIMESTAMP rather than
+-- SQLite's datetime text.
+SELECT g.quiz_id                                                         AS quiz_id,
+       qz.title                                                          AS quiz_title,
+       COUNT(*)                                                          AS play_count,
+       CAST(SUM(CASE WHEN g.status = 'finished' THEN 1 ELSE 0 END) AS INTEGER) AS finished_count
+FROM games g
+         JOIN quizzes qz ON qz.id = g.quiz_id
+WHERE g.is_preview = 0
+  AND g.created_at >= CAST($1 AS TIMESTAMP)
+  AND g.created_at < CAST($2 AS TIMESTAMP)
+GROUP BY g.quiz_id, qz.title
+ORDER BY play_count DESC, g.quiz_id;
+
+-- name: ListAnswersForPlayReport :many
+-- created_from and created_to compare against a TIMESTAMP rather than
+-- SQLite's datetime text.
+SELECT g.id                 AS game_id,
+       g.quiz_id            AS quiz_id,
+       gq.question_id       AS question_id,
+       q.text               AS question_text,
+       ga.player_id         AS player_id,
+       gq.started_at        AS question_started_at,
+       gq.expired_at        AS question_expired_at,
+       ga.answered_at       AS answered_at,
+       ga.elapsed_ms        AS elapsed_ms,
+       ga.numeric_value     AS numeric_value,
+       ga.wager             AS wager,
+       ga.paused_ms         AS paused_ms,
+       ga.hint_penalty      AS hint_penalty,
+       ga.scoring           AS scoring,
+       ga.streak            AS streak,
+       ga.wrong_answer_penalty AS wrong_answer_penalty,
+       ga.score_floor       AS score_floor,
+       o.is_correct         AS is_correct,
+       o.numeric_value      AS key_value,
+       o.tolerance_below    AS tolerance_below,
+       o.tolerance_above    AS tolerance_above,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND po.is_correct) AS picked_correct,
+       (SELECT COUNT(*)
+        FROM game_answer_options gao
+                 JOIN options po ON po.id = gao.option_id
+        WHERE gao.game_answer_id = ga.id
+          AND NOT po.is_correct) AS picked_wrong,
+       (SELECT COUNT(*)
+        FROM options co
+        WHERE co.question_id = o.question_id
+          AND co.is_correct) AS correct_options
+FROM game_answers ga
+         JOIN games g ON g.id = ga.game_id
+         JOIN game_questions gq ON gq.id = ga.game_question_id
+         JOIN questions q ON q.id = gq.question_id
+         JOIN options o ON o.id = ga.option_id
+WHERE g.is_preview = 0
+  AND gq.voided_at IS NULL
+  AND g.created_at >= CAST($1 AS TIMESTAMP)
+  AND g.created_at < CAST($2 AS TIMESTAMP)
+ORDER BY ga.id;
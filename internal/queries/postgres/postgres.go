@@ -0,0 +1,15 @@
+// Package postgres embeds the Postgres overrides of the shared sqlc queries.
+// Most queries in internal/queries run unchanged on Postgres once their
+// placeholders are rebound; the few that lean on SQLite's loose typing (an
+// integer used as a boolean, a datetime compared as text) get a Postgres
+// spelling here under the same "-- name:" so the dialect layer in
+// internal/database can swap it in. Each override keeps the parameter order
+// of the generated SQLite query, since both run behind the same Go method.
+package postgres
+
+import "embed"
+
+// FS holds the override .sql files.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,83 @@
+-- name: ListQuestionStatsByQuizID :many
+-- options.is_correct is a BOOLEAN on Postgres, so it is cast before being
+-- summed alongside the numeric-question branches.
+SELECT q.id                                             AS question_id,
+       CAST(COUNT(a.option_id) AS INTEGER)              AS answer_count,
+       CAST(COALESCE(SUM(CASE
+                             WHEN a.numeric_value IS NULL THEN CAST(o.is_correct AS INTEGER)
+                             WHEN a.numeric_value = o.numeric_value THEN 1
+                             WHEN a.numeric_value > o.numeric_value - o.tolerance_below
+                                 AND a.numeric_value < o.numeric_value + o.tolerance_above THEN 1
+                             ELSE 0 END), 0) AS INTEGER) AS correct_count
+FROM questions q
+         LEFT JOIN (SELECT gq.question_id, ga.option_id, ga.stats_epoch, ga.numeric_value
+                    FROM game_answers ga
+                             JOIN game_questions gq ON gq.id = ga.game_question_id
+                             JOIN games g ON g.id = ga.game_id
+                    WHERE g.is_preview = 0
+                    UNION ALL
+                    SELECT sa.question_id, sa.option_id, sa.stats_epoch, NULL
+                    FROM session_answers sa) a
+                   ON a.question_id = q.id AND a.stats_epoch = q.stats_epoch
+         LEFT JOIN options o ON o.id = a.option_id
+WHERE q.quiz_id = $1
+GROUP BY q.id
+ORDER BY q.position;
+
+-- name: SearchQuizzes :many
+-- Postgres has no FTS5 table: the match runs against the expression
+-- quizzes_search_idx indexes. $1 is the store's FTS5 prefix expression
+-- ("cap* euro*"), whose terms are plain letters and digits, so rewriting each
+-- "*" to ":*" and each space to " & " gives the same all-terms prefix query as
+-- a tsquery. The ranking weighs title over slug over description, as the
+-- FTS5 rank does. The 'simple' configuration does not fold diacritics.
+SELECT q.id,
+       q.title,
+       q.slug,
+       q.description,
+       q.created_at,
+       q.updated_at,
+       q.created_by_player_id,
+       q.time_limit_seconds,
+       q.visibility,
+       q.mode,
+       q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
+       q.play_count,
+       q.published,
+       p.display_name AS created_by_display_name
+FROM quizzes q
+         JOIN players p ON p.id = q.created_by_player_id
+WHERE to_tsvector('simple', q.title || ' ' || q.description || ' ' || q.slug)
+          @@ to_tsquery('simple', replace(replace(CAST($1 AS TEXT), '*', ':*'), ' ', ' & '))
+  AND (CAST($2 AS INTEGER) = 0 OR q.created_by_player_id = CAST($2 AS INTEGER))
+  AND (CAST($3 AS TEXT) = '' OR q.mode = CAST($3 AS TEXT))
+  AND (CAST($4 AS INTEGER) = 0
+    OR (q.visibility = 'public' AND q.mode = 'solo' AND q.published = 1))
+ORDER BY ts_rank(setweight(to_tsvector('simple', q.title), 'A')
+                     || setweight(to_tsvector('simple', q.slug), 'B')
+                     || setweight(to_tsvector('simple', q.description), 'C'),
+                 to_tsquery('simple', replace(replace(CAST($1 AS TEXT), '*', ':*'), ' ', ' & '))) DESC,
+         q.updated_at DESC, q.id DESC
+LIMIT $6 OFFSET $5;
+
+-- name: CountSearchQuizzes :one
+-- Total rows matching the SearchQuizzes override's filter.
+SELECT COUNT(*)
+FROM quizzes q
+WHERE to_tsvector('simple', q.title || ' ' || q.description || ' ' || q.slug)
+          @@ to_tsquery('simple', replace(replace(CAST($1 AS TEXT), '*', ':*'), ' ', ' & '))
+  AND (CAST($2 AS INTEGER) = 0 OR q.created_by_player_id = CAST($2 AS INTEGER))
+  AND (CAST($3 AS TEXT) = '' OR q.mode = CAST($3 AS TEXT))
+  AND (CAST($4 AS INTEGER) = 0
+    OR (q.visibility = 'public' AND q.mode = 'solo' AND q.published = 1));
@@ -23,6 +23,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -45,6 +56,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -71,6 +93,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -97,6 +130,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -121,6 +165,17 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
        p.display_name AS created_by_display_name
@@ -131,6 +186,97 @@ WHERE q.mode = 'live'
   AND q.created_by_player_id = ?
 ORDER BY q.updated_at DESC, q.id DESC;
 
+-- name: ListQuizzesPage :many
+-- Paged variant of ListQuizzes / ListQuizzesForOwner for the admin quiz list.
+-- owner_id = 0 disables the owner scope (an Admin's view); mode = '' disables
+-- the play-mode filter (the "All" tab). Same shape and order as ListQuizzes so
+-- a page boundary never reshuffles rows; CountQuizzesPage totals the same
+-- filter for the page navigation.
+SELECT q.id,
+       q.title,
+       q.slug,
+       q.description,
+       q.created_at,
+       q.updated_at,
+       q.created_by_player_id,
+       q.time_limit_seconds,
+       q.visibility,
+       q.mode,
+       q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
+       q.play_count,
+       q.published,
+       p.display_name AS created_by_display_name
+FROM quizzes q
+         JOIN players p ON p.id = q.created_by_player_id
+WHERE (CAST(sqlc.arg('owner_id') AS INTEGER) = 0 OR q.created_by_player_id = CAST(sqlc.arg('owner_id') AS INTEGER))
+  AND (CAST(sqlc.arg('mode') AS TEXT) = '' OR q.mode = CAST(sqlc.arg('mode') AS TEXT))
+ORDER BY q.updated_at DESC, q.id DESC
+LIMIT sqlc.arg('row_limit') OFFSET sqlc.arg('row_offset');
+
+-- name: CountQuizzesPage :one
+-- Total rows matching the ListQuizzesPage filter; powers the page count on
+-- the admin quiz list.
+SELECT COUNT(*)
+FROM quizzes q
+WHERE (CAST(sqlc.arg('owner_id') AS INTEGER) = 0 OR q.created_by_player_id = CAST(sqlc.arg('owner_id') AS INTEGER))
+  AND (CAST(sqlc.arg('mode') AS TEXT) = '' OR q.mode = CAST(sqlc.arg('mode') AS TEXT));
+
+-- name: ListPublicQuizzesPage :many
+-- Paged variant of ListPublicQuizzes for GET /api/quizzes?page=&pageSize=.
+-- Same visibility / mode / published filters and order.
+SELECT q.id,
+       q.title,
+       q.slug,
+       q.description,
+       q.created_at,
+       q.updated_at,
+       q.created_by_player_id,
+       q.time_limit_seconds,
+       q.visibility,
+       q.mode,
+       q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
+       q.play_count,
+       q.published,
+       p.display_name AS created_by_display_name
+FROM quizzes q
+         JOIN players p ON p.id = q.created_by_player_id
+WHERE q.visibility = 'public'
+  AND q.mode = 'solo'
+  AND q.published = 1
+ORDER BY q.updated_at DESC, q.id DESC
+LIMIT sqlc.arg('row_limit') OFFSET sqlc.arg('row_offset');
+
+-- name: CountPublicQuizzes :one
+-- Total rows ListPublicQuizzes would return; the API reports it in the list
+-- meta so a client can render its own page navigation.
+SELECT COUNT(*)
+FROM quizzes q
+WHERE q.visibility = 'public'
+  AND q.mode = 'solo'
+  AND q.published = 1;
+
 -- name: QuestionCountsByQuiz :many
 -- Returns one row per quiz that has at least one question. Quizzes with
 -- zero questions are absent; callers should treat a missing entry as 0.
@@ -155,8 +301,21 @@ SELECT q.id,
        q.visibility,
        q.mode,
        q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
        q.play_count,
        q.published,
+       q.published_at,
+       q.version,
        p.display_name AS created_by_display_name
 FROM quizzes q
          JOIN players p ON p.id = q.created_by_player_id
@@ -170,6 +329,21 @@ LIMIT 1;
 -- of questions and options that GetQuiz materialises.
 SELECT EXISTS(SELECT 1 FROM quizzes WHERE id = ?) AS quiz_exists;
 
+-- name: GetQuizIDByExternalRef :one
+-- Resolves an author-supplied quiz reference to its quiz ID, so a re-import
+-- finds the quiz it came from even after its title and slug changed.
+SELECT id
+FROM quizzes
+WHERE external_ref = ?;
+
+-- name: GetQuizIDBySlug :one
+-- Resolves a slug to its quiz ID. Used by the JSON import to find the
+-- quiz a re-imported document collides with, so it can offer a diff
+-- against it instead of a bare slug conflict.
+SELECT id
+FROM quizzes
+WHERE slug = ?;
+
 -- name: GetQuizVisibility :one
 -- Returns just the visibility column for a quiz. Used by the read-path
 -- visibility gate, which only needs visibility + existence and must not
@@ -183,46 +357,89 @@ LIMIT 1;
 -- created_by_player_id is NOT NULL with an FK to players.id (migration
 -- 20260520200000 / #281). [QuizStore.CreateQuiz] short-circuits with
 -- ErrCreatorRequired when the caller forgot to stamp the session
--- admin, so the FK constraint is the second line of defence.
-INSERT INTO quizzes (title, slug, description, created_by_player_id, time_limit_seconds, visibility, mode, language, published, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+-- admin, so the FK constraint is the second line of defence. A quiz created
+-- already published (fixtures, importers) gets its published_at stamped here.
+INSERT INTO quizzes (title, slug, description, created_by_player_id, time_limit_seconds, visibility, mode, language, published,
+                     shuffle_questions, keep_option_order, late_join, join_deadline_seconds, max_players,
+                     confidence_wager, host_paced, scoring, wrong_answer_penalty, score_floor, external_ref,
+                     updated_at, published_at)
+VALUES (sqlc.arg('title'), sqlc.arg('slug'), sqlc.arg('description'), sqlc.arg('created_by_player_id'),
+        sqlc.arg('time_limit_seconds'), sqlc.arg('visibility'), sqlc.arg('mode'), sqlc.arg('language'),
+        sqlc.arg('published'), sqlc.arg('shuffle_questions'), sqlc.arg('keep_option_order'), sqlc.arg('late_join'),
+        sqlc.arg('join_deadline_seconds'), sqlc.arg('max_players'), sqlc.arg('confidence_wager'),
+        sqlc.arg('host_paced'), sqlc.arg('scoring'), sqlc.arg('wrong_answer_penalty'),
+        sqlc.arg('score_floor'), sqlc.arg('external_ref'), CURRENT_TIMESTAMP,
+        CASE WHEN sqlc.arg('published') = 1 THEN CURRENT_TIMESTAMP END)
 RETURNING *;
 
 -- name: UpdateQuiz :execresult
+-- Applies only while the row still has the version the caller read, and bumps
+-- it; no row affected means the quiz is gone or was changed in between
+-- (QuizVersion tells the two apart).
 UPDATE quizzes
-SET title              = ?,
-    slug               = ?,
-    description        = ?,
-    time_limit_seconds = ?,
-    visibility         = ?,
-    mode               = ?,
-    language           = ?,
-    updated_at         = CURRENT_TIMESTAMP
+SET title                 = ?,
+    slug                  = ?,
+    description           = ?,
+    time_limit_seconds    = ?,
+    visibility            = ?,
+    mode                  = ?,
+    language              = ?,
+    shuffle_questions     = ?,
+    keep_option_order     = ?,
+    late_join             = ?,
+    join_deadline_seconds = ?,
+    max_players           = ?,
+    confidence_wager      = ?,
+    host_paced            = ?,
+    scoring               = ?,
+    wrong_answer_penalty  = ?,
+    score_floor           = ?,
+    external_ref          = ?,
+    updated_at            = CURRENT_TIMESTAMP,
+    version               = version + 1
+WHERE id = ?
+  AND version = ?;
+
+-- name: QuizVersion :one
+-- The current optimistic-concurrency version of a quiz, read after an
+-- UpdateQuiz that changed nothing to tell a stale write from a missing row.
+SELECT version
+FROM quizzes
 WHERE id = ?;
 
 -- name: UpdateQuizMode :execresult
 -- Flips just the play mode without touching the question tree, so the
 -- solo/live toggle (#830) cannot clobber a concurrent question edit the way
--- the full UpdateQuiz would.
+-- the full UpdateQuiz would. It bumps the version all the same, since the
+-- edit form carries the mode: a form opened before the toggle must not save
+-- the old mode back over it.
 UPDATE quizzes
 SET mode       = ?,
-    updated_at = CURRENT_TIMESTAMP
+    updated_at = CURRENT_TIMESTAMP,
+    version    = version + 1
 WHERE id = ?;
 
 -- name: SetQuizPublished :execresult
 -- Flips just the published flag without touching the question tree, so it cannot clobber a concurrent edit (#1192). Modelled on UpdateQuizMode.
+-- published_at is stamped when a draft is published, kept when an already-published quiz is published again, and cleared on unpublish.
 UPDATE quizzes
-SET published  = ?,
-    updated_at = CURRENT_TIMESTAMP
-WHERE id = ?;
+SET published    = sqlc.arg('published'),
+    published_at = CASE
+                       WHEN sqlc.arg('published') = 0 THEN NULL
+                       WHEN published = 1 THEN published_at
+                       ELSE CURRENT_TIMESTAMP
+        END,
+    updated_at   = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id');
 
 -- name: UnpublishQuizIfUnplayed :execresult
 -- Atomically returns a quiz to draft only while it has no real (non-preview) game;
 -- the NOT EXISTS guard closes the check-then-act race a read-then-write leaves open (#1192).
 -- Zero rows affected means the quiz is gone or has been played.
 UPDATE quizzes
-SET published  = 0,
-    updated_at = CURRENT_TIMESTAMP
+SET published    = 0,
+    published_at = NULL,
+    updated_at   = CURRENT_TIMESTAMP
 WHERE quizzes.id = ?
   AND NOT EXISTS (
       SELECT 1 FROM games WHERE quiz_id = quizzes.id AND is_preview = 0
@@ -245,17 +462,53 @@ FROM questions
 WHERE id = ?
 LIMIT 1;
 
+-- name: GetQuestionByExternalRef :one
+-- Looks a question up by the author-supplied reference the authoring API
+-- keys it by; references are unique per quiz only.
+SELECT *
+FROM questions
+WHERE quiz_id = ?
+  AND external_ref = ?
+LIMIT 1;
+
+-- name: GetQuestionProgress :one
+-- Places one question within its quiz for the gameplay header without loading
+-- the quiz: the quiz's question count, the round count, the question's round
+-- number, and its position and sibling count within that round. Rounds are
+-- numbered by the position of their first question, matching
+-- quiz.QuestionRoundProgress.
+SELECT (SELECT COUNT(*) FROM questions a WHERE a.quiz_id = q.quiz_id)                 AS total,
+       (SELECT COUNT(DISTINCT a.round_id) FROM questions a WHERE a.quiz_id = q.quiz_id) AS round_total,
+       (SELECT COUNT(DISTINCT a.round_id)
+        FROM questions a
+        WHERE a.quiz_id = q.quiz_id
+          AND a.position <= (SELECT MIN(b.position)
+                             FROM questions b
+                             WHERE b.quiz_id = q.quiz_id
+                               AND b.round_id = q.round_id))                         AS round_number,
+       (SELECT COUNT(*)
+        FROM questions a
+        WHERE a.quiz_id = q.quiz_id
+          AND a.round_id = q.round_id
+          AND a.position <= q.position)                                               AS round_position,
+       (SELECT COUNT(*)
+        FROM questions a
+        WHERE a.quiz_id = q.quiz_id
+          AND a.round_id = q.round_id)                                                AS round_questions
+FROM questions q
+WHERE q.id = ?;
+
 -- name: ListQuestionsByQuizID :many
 SELECT *
 FROM questions
 WHERE quiz_id = ?
-ORDER BY position;
+ORDER BY position, id;
 
 -- name: ListQuestionIDsByQuizID :many
 SELECT id
 FROM questions
 WHERE quiz_id = ?
-ORDER BY position;
+ORDER BY position, id;
 
 -- name: ListQuestionIDsByRoundID :many
 -- Lists the question IDs attached to a round, snapshotted up front by the
@@ -269,20 +522,75 @@ WHERE round_id = ?
 ORDER BY position;
 
 -- name: CreateQuestion :one
-INSERT INTO questions (quiz_id, round_id, text, position, image_media_id, audio_media_id, audio_repeat, time_limit_seconds)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO questions (quiz_id, round_id, text, position, image_media_id, audio_media_id, audio_repeat, time_limit_seconds, kind,
+                       after_question_id, explanation, hint, external_ref)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateQuestion :execresult
+-- Version-checked like UpdateQuiz; QuestionVersion tells a stale write from a
+-- missing row.
 UPDATE questions
 SET text               = ?,
     position           = ?,
     image_media_id     = ?,
     audio_media_id     = ?,
     audio_repeat       = ?,
-    time_limit_seconds = ?
+    time_limit_seconds = ?,
+    kind               = ?,
+    after_question_id  = ?,
+    explanation        = ?,
+    hint               = ?,
+    external_ref       = ?,
+    version            = version + 1
+WHERE id = ?
+  AND version = ?;
+
+-- name: QuestionVersion :one
+-- The current optimistic-concurrency version of a question; see QuizVersion.
+SELECT version
+FROM questions
+WHERE id = ?;
+
+-- name: BumpQuestionStatsEpoch :execresult
+-- Starts a fresh statistics epoch for a question whose text or options were
+-- substantially edited, so ListQuestionStatsByQuizID stops counting picks made
+-- against the old wording. The picks themselves are kept.
+UPDATE questions
+SET stats_epoch = stats_epoch + 1
 WHERE id = ?;
 
+-- name: ListQuestionStatsByQuizID :many
+-- Per-question answer statistics for the quiz view: how many picks each
+-- question has drawn and how many were correct, across real (non-preview)
+-- solo games and hosted live games. Only picks stamped with the question's
+-- current stats_epoch count. Anchored on questions with LEFT JOINs so an
+-- unanswered question still comes back with zero counts. A numeric answer
+-- counts as correct when it scores anything: within the key's tolerance, or
+-- exact.
+SELECT q.id                                             AS question_id,
+       CAST(COUNT(a.option_id) AS INTEGER)              AS answer_count,
+       CAST(COALESCE(SUM(CASE
+                             WHEN a.numeric_value IS NULL THEN o.is_correct
+                             WHEN a.numeric_value = o.numeric_value THEN 1
+                             WHEN a.numeric_value > o.numeric_value - o.tolerance_below
+                                 AND a.numeric_value < o.numeric_value + o.tolerance_above THEN 1
+                             ELSE 0 END), 0) AS INTEGER) AS correct_count
+FROM questions q
+         LEFT JOIN (SELECT gq.question_id, ga.option_id, ga.stats_epoch, ga.numeric_value
+                    FROM game_answers ga
+                             JOIN game_questions gq ON gq.id = ga.game_question_id
+                             JOIN games g ON g.id = ga.game_id
+                    WHERE g.is_preview = 0
+                    UNION ALL
+                    SELECT sa.question_id, sa.option_id, sa.stats_epoch, NULL
+                    FROM session_answers sa) a
+                   ON a.question_id = q.id AND a.stats_epoch = q.stats_epoch
+         LEFT JOIN options o ON o.id = a.option_id
+WHERE q.quiz_id = ?
+GROUP BY q.id
+ORDER BY q.position;
+
 -- name: SetQuestionMedia :execresult
 -- Patches only a question's media references (#1113): the restored image and
 -- audio media ids plus the audio repeat flag. Used by the archive importer
@@ -355,15 +663,18 @@ WHERE q.quiz_id = ?
 ORDER BY o.question_id, o.id;
 
 -- name: CreateOption :one
-INSERT INTO options (question_id, text, is_correct)
-VALUES (?, ?, ?)
+INSERT INTO options (question_id, text, is_correct, numeric_value, tolerance_below, tolerance_above)
+VALUES (?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateOption :execresult
 -- Scoped by question_id to keep the ownership boundary (#1165).
 UPDATE options
 SET text = ?,
-    is_correct = ?
+    is_correct = ?,
+    numeric_value = ?,
+    tolerance_below = ?,
+    tolerance_above = ?
 WHERE id = ?
   AND question_id = ?;
 
@@ -391,4 +702,55 @@ WHERE quizzes.id = (
 -- quiz_id (a quiz-less room) matches no quiz row, so the bump is a safe no-op.
 UPDATE quizzes
 SET play_count = play_count + 1
-WHERE quizzes.id = (SELECT quiz_id FROM sessions WHERE sessions.id = ?);
\ No newline at end of file
+WHERE quizzes.id = (SELECT quiz_id FROM sessions WHERE sessions.id = ?);
+
+-- name: SearchQuizzes :many
+-- One page of the quizzes whose title, description or slug match query, best
+-- match first. query is an FTS5 expression the store builds from the search
+-- box: lowercased terms, each a prefix ("cap* euro*"), all required. owner_id
+-- and mode scope it as in ListQuizzesPage; public_only = 1 further keeps it
+-- to what ListPublicQuizzes shows. CountSearchQuizzes totals the same filter.
+SELECT q.id,
+       q.title,
+       q.slug,
+       q.description,
+       q.created_at,
+       q.updated_at,
+       q.created_by_player_id,
+       q.time_limit_seconds,
+       q.visibility,
+       q.mode,
+       q.language,
+       q.shuffle_questions,
+       q.keep_option_order,
+       q.late_join,
+       q.join_deadline_seconds,
+       q.max_players,
+       q.confidence_wager,
+       q.host_paced,
+       q.scoring,
+       q.wrong_answer_penalty,
+       q.score_floor,
+       q.external_ref,
+       q.play_count,
+       q.published,
+       p.display_name AS created_by_display_name
+FROM quizzes_fts(CAST(sqlc.arg('query') AS TEXT)) f
+         JOIN quizzes q ON q.id = f.rowid
+         JOIN players p ON p.id = q.created_by_player_id
+WHERE (CAST(sqlc.arg('owner_id') AS INTEGER) = 0 OR q.created_by_player_id = CAST(sqlc.arg('owner_id') AS INTEGER))
+  AND (CAST(sqlc.arg('mode') AS TEXT) = '' OR q.mode = CAST(sqlc.arg('mode') AS TEXT))
+  AND (CAST(sqlc.arg('public_only') AS INTEGER) = 0
+    OR (q.visibility = 'public' AND q.mode = 'solo' AND q.published = 1))
+ORDER BY f.rank, q.updated_at DESC, q.id DESC
+LIMIT sqlc.arg('row_limit') OFFSET sqlc.arg('row_offset');
+
+-- name: CountSearchQuizzes :one
+-- Total rows matching the SearchQuizzes filter, for the page navigation.
+SELECT COUNT(*)
+FROM quizzes_fts(CAST(sqlc.arg('query') AS TEXT)) f
+         JOIN quizzes q ON q.id = f.rowid
+WHERE (CAST(sqlc.arg('owner_id') AS INTEGER) = 0 OR q.created_by_player_id = CAST(sqlc.arg('owner_id') AS INTEGER))
+  AND (CAST(sqlc.arg('mode') AS TEXT) = '' OR q.mode = CAST(sqlc.arg('mode') AS TEXT))
+  AND (CAST(sqlc.arg('public_only') AS INTEGER) = 0
+    OR (q.visibility = 'public' AND q.mode = 'solo' AND q.published = 1));
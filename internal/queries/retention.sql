@@ -82,6 +82,22 @@ WHERE g.created_at < datetime('now', '-' || CAST(sqlc.arg('days') AS INTEGER) ||
         (SELECT COUNT(*) FROM questions qc WHERE qc.quiz_id = g.quiz_id)
   );
 
+-- name: ListNeverStartedGameIDs :many
+-- Lists ids of in-progress games created more than the given number of
+-- minutes ago that never saw any play: no question was ever issued and no
+-- answer recorded. These are the games a double-submitted create leaves
+-- behind (the 409 race) or a player who opened a quiz and walked away; they
+-- hold nothing worth keeping, so they are pruned long before the 30-day
+-- abandoned-game window. As with the day windows, the cutoff is computed in
+-- SQL (datetime('now', '-<minutes> minutes')) so the comparison stays in the
+-- CURRENT_TIMESTAMP text encoding rows are minted with.
+SELECT g.id
+FROM games g
+WHERE g.status = 'in_progress'
+  AND g.created_at < datetime('now', '-' || CAST(sqlc.arg('minutes') AS INTEGER) || ' minutes')
+  AND NOT EXISTS (SELECT 1 FROM game_questions gq WHERE gq.game_id = g.id)
+  AND NOT EXISTS (SELECT 1 FROM game_answers ga WHERE ga.game_id = g.id);
+
 -- name: DeleteStaleAuditLog :execresult
 -- Prunes admin_audit rows created more than the retention window ago (#628).
 -- admin_audit is a low-volume, self-contained table (no rows FK-reference it),
@@ -101,6 +101,15 @@ FROM session_players
 WHERE session_id = ?
   AND player_id = ?;
 
+-- name: InsertSessionLateJoin :exec
+-- Records that a player joined the room's current game after it started, with
+-- how many questions had already closed. Keyed on the game (game_seq), so the
+-- mark does not follow the player into the next game; DO NOTHING keeps the
+-- first join's count should the same game record the player twice.
+INSERT INTO session_late_joins (session_id, player_id, game_seq, missed_questions)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (session_id, player_id, game_seq) DO NOTHING;
+
 -- name: SetSessionPlayerReady :execresult
 -- Toggles a participant's ready flag and refreshes last_seen_at. Scoped to
 -- (session_id, player_id) so it only ever touches the caller's own roster
@@ -124,13 +133,24 @@ SELECT sp.id,
        sp.is_ready,
        sp.joined_at,
        sp.last_seen_at,
-       sp.left_at
+       sp.left_at,
+       sp.notes
 FROM session_players sp
          JOIN players p ON p.id = sp.player_id
 WHERE sp.session_id = ?
   AND sp.left_at IS NULL
 ORDER BY sp.joined_at, sp.id;
 
+-- name: SetSessionPlayerNotes :execresult
+-- Replaces the host's notes on one participant. Unlike the ready toggle it
+-- leaves last_seen_at alone: the host wrote it, not the player. A player who
+-- has left keeps their row, so their notes can still be edited for the
+-- export; the execresult maps zero rows to "not a participant".
+UPDATE session_players
+SET notes = ?
+WHERE session_id = ?
+  AND player_id = ?;
+
 -- name: ListLiveSessionIDs :many
 -- Ids of every session not yet finished, in creation order. The runner scans
 -- these each beat to auto-start lobbies and advance in-flight games; finished
@@ -181,7 +201,8 @@ SET phase               = 'round_intro',
     current_round_id    = sqlc.arg('current_round_id'),
     current_question_id = NULL,
     question_started_at = NULL,
-    question_expires_at = NULL
+    question_expires_at = NULL,
+    answers_closed_at   = NULL
 WHERE id = sqlc.arg('id')
   AND phase = sqlc.arg('expected_phase');
 
@@ -194,10 +215,42 @@ SET phase               = 'question',
     current_round_id    = sqlc.arg('current_round_id'),
     current_question_id = sqlc.arg('current_question_id'),
     question_started_at = sqlc.arg('question_started_at'),
-    question_expires_at = sqlc.arg('question_expires_at')
+    question_expires_at = sqlc.arg('question_expires_at'),
+    answers_closed_at   = NULL
 WHERE id = sqlc.arg('id')
   AND phase = sqlc.arg('expected_phase');
 
+-- name: ExtendSessionQuestion :execresult
+-- Moves the open question's answer deadline (the host "extend" control).
+-- Scoped to the question phase and the question the host extended, so an
+-- extend that loses the race with the runner closing the question (or one sent
+-- from a stale tab for an earlier question) matches no row.
+UPDATE sessions
+SET question_expires_at = sqlc.arg('question_expires_at')
+WHERE id = sqlc.arg('id')
+  AND phase = 'question'
+  AND current_question_id = sqlc.arg('current_question_id')
+  AND answers_closed_at IS NULL;
+
+-- name: CloseSessionAnswers :execresult
+-- Closes the open question's answering early (the host "close answering"
+-- control), leaving question_expires_at as the window the picks are scored
+-- against. Scoped like ExtendSessionQuestion; a second close matches no row.
+UPDATE sessions
+SET answers_closed_at = sqlc.arg('answers_closed_at')
+WHERE id = sqlc.arg('id')
+  AND phase = 'question'
+  AND current_question_id = sqlc.arg('current_question_id')
+  AND answers_closed_at IS NULL;
+
+-- name: SetSessionHostAdvance :execresult
+-- Turns the host's pacing of the room on or off (the host "pacing" control).
+-- A closed room matches no row.
+UPDATE sessions
+SET host_advance = sqlc.arg('host_advance')
+WHERE id = sqlc.arg('id')
+  AND phase <> 'finished';
+
 -- name: SetSessionReveal :execresult
 -- Moves the session into the reveal phase, leaving the current question and
 -- its window in place so a reader still sees which question is being revealed.
@@ -217,6 +270,7 @@ SET phase               = 'finished',
     current_question_id = NULL,
     question_started_at = NULL,
     question_expires_at = NULL,
+    answers_closed_at   = NULL,
     finished_at         = CURRENT_TIMESTAMP
 WHERE id = ?;
 
@@ -234,6 +288,7 @@ SET phase               = 'intermission',
     current_question_id = NULL,
     question_started_at = NULL,
     question_expires_at = NULL,
+    answers_closed_at   = NULL,
     finished_at         = CURRENT_TIMESTAMP
 WHERE id = ?
   AND phase NOT IN ('intermission', 'finished');
@@ -258,6 +313,7 @@ SET quiz_id             = sqlc.arg('quiz_id'),
     current_question_id = NULL,
     question_started_at = NULL,
     question_expires_at = NULL,
+    answers_closed_at   = NULL,
     started_at          = NULL,
     finished_at         = NULL,
     start_at            = NULL
@@ -281,13 +337,16 @@ WHERE session_id = ?;
 -- player_id, game_seq): a re-submit within the same game overwrites the option
 -- and timestamp rather than duplicating, so a double-tap before close is the
 -- last pick rather than an error. score stays NULL until the question closes.
-INSERT INTO session_answers (session_id, question_id, player_id, option_id, answered_at, game_seq)
+-- stats_epoch is copied from the question so the per-question statistics
+-- only count picks made since its last reset.
+INSERT INTO session_answers (session_id, question_id, player_id, option_id, answered_at, game_seq, stats_epoch)
 VALUES (sqlc.arg('session_id'),
         sqlc.arg('question_id'),
         sqlc.arg('player_id'),
         sqlc.arg('option_id'),
         sqlc.arg('answered_at'),
-        (SELECT game_seq FROM sessions WHERE id = sqlc.arg('session_id')))
+        (SELECT game_seq FROM sessions WHERE id = sqlc.arg('session_id')),
+        (SELECT stats_epoch FROM questions WHERE id = sqlc.arg('question_id')))
 ON CONFLICT (session_id, question_id, player_id, game_seq)
     DO UPDATE SET option_id   = excluded.option_id,
                   answered_at = excluded.answered_at;
@@ -419,14 +478,52 @@ ORDER BY sa.answered_at, sa.id;
 -- name: SetSessionAnswerScore :exec
 -- Writes the computed score for one pick at question close, scoped to the room's
 -- current game (#836) so scoring a re-run does not overwrite the previous game's
--- recorded score for the same question/player.
+-- recorded score for the same question/player. The answer window the score was
+-- computed against is stored alongside it so a later recalculation can replay it.
 UPDATE session_answers
-SET score = sqlc.arg('score')
+SET score             = sqlc.arg('score'),
+    window_started_at = sqlc.arg('window_started_at'),
+    window_expires_at = sqlc.arg('window_expires_at')
 WHERE session_id = sqlc.arg('session_id')
   AND question_id = sqlc.arg('question_id')
   AND player_id = sqlc.arg('player_id')
   AND game_seq = (SELECT s.game_seq FROM sessions s WHERE s.id = sqlc.arg('session_id'));
 
+-- name: ListScoredSessionAnswersForQuiz :many
+-- Every scored live pick on the quiz's questions, across every room and game
+-- that ran it, for the score recalculation tool. Scoped by the question's quiz
+-- rather than sessions.quiz_id, which moves when a room switches quizzes between
+-- games. is_correct is the chosen option's CURRENT flag, so a corrected answer
+-- key is picked up; the window columns are NULL on picks scored before the
+-- window was recorded.
+SELECT sa.id,
+       sa.session_id,
+       s.join_code,
+       sa.game_seq,
+       sa.question_id,
+       sa.player_id,
+       CAST(p.display_name AS TEXT) AS display_name,
+       sa.answered_at,
+       sa.score,
+       sa.window_started_at,
+       sa.window_expires_at,
+       o.is_correct
+FROM session_answers sa
+         JOIN sessions s ON s.id = sa.session_id
+         JOIN questions q ON q.id = sa.question_id
+         JOIN options o ON o.id = sa.option_id
+         JOIN players p ON p.id = sa.player_id
+WHERE q.quiz_id = sqlc.arg('quiz_id')
+  AND sa.score IS NOT NULL
+ORDER BY s.join_code, sa.game_seq, sa.question_id, sa.answered_at, sa.id;
+
+-- name: UpdateSessionAnswerScore :exec
+-- Overwrites one stored pick's score by id. Used by the score recalculation
+-- tool when applying a recomputed score; the window is left as recorded.
+UPDATE session_answers
+SET score = sqlc.arg('score')
+WHERE id = sqlc.arg('id');
+
 -- name: SetSessionRoundResults :execresult
 -- Moves the session into the round_results phase shown after the last question
 -- of a round (before the next round's intro). current_round_id stays put so the
@@ -437,7 +534,8 @@ UPDATE sessions
 SET phase               = 'round_results',
     current_question_id = NULL,
     question_started_at = NULL,
-    question_expires_at = NULL
+    question_expires_at = NULL,
+    answers_closed_at   = NULL
 WHERE id = sqlc.arg('id')
   AND phase = sqlc.arg('expected_phase');
 
@@ -455,11 +553,16 @@ WHERE id = sqlc.arg('id')
 -- answer): the standings are a stable record of everyone who played, so a player
 -- who answered and then closed their browser keeps their score on the board
 -- across a TV refresh (#766). Someone who left during the lobby/intro without
--- ever answering never played and so does not appear.
+-- ever answering never played and so does not appear. missed_questions is how
+-- many questions had closed before a late joiner joined this game (0 for
+-- everyone who was there from the start).
 SELECT sp.player_id                 AS player_id,
        CAST(p.display_name AS TEXT) AS display_name,
        CAST(COALESCE(SUM(CASE WHEN q.round_id = sqlc.arg('round_id') THEN sa.score END), 0) AS INTEGER) AS round_score,
-       CAST(COALESCE(SUM(sa.score), 0) AS INTEGER)                                                      AS total_score
+       CAST(COALESCE(SUM(sa.score), 0) AS INTEGER)                                                      AS total_score,
+       CAST(COALESCE((SELECT lj.missed_questions FROM session_late_joins lj
+                      WHERE lj.session_id = sp.session_id AND lj.player_id = sp.player_id
+                        AND lj.game_seq = (SELECT s.game_seq FROM sessions s WHERE s.id = sp.session_id)), 0) AS INTEGER) AS missed_questions
 FROM session_players sp
          JOIN players p ON p.id = sp.player_id
          LEFT JOIN session_answers sa
@@ -499,10 +602,15 @@ WHERE sa.session_id = sqlc.arg('session_id')
 -- board is a stable record of everyone who played, so a player who answered and
 -- then closed their browser keeps their score across a TV refresh (#766).
 -- Someone who left during the lobby/intro without ever answering never played
--- and so does not appear.
+-- and so does not appear. missed_questions is how many questions had closed
+-- before the player joined this game late (0 for everyone who was there from
+-- the start), for the results footnote.
 SELECT sp.player_id                              AS player_id,
        CAST(p.display_name AS TEXT)              AS display_name,
-       CAST(COALESCE(SUM(sa.score), 0) AS INTEGER) AS total_score
+       CAST(COALESCE(SUM(sa.score), 0) AS INTEGER) AS total_score,
+       CAST(COALESCE((SELECT lj.missed_questions FROM session_late_joins lj
+                      WHERE lj.session_id = sp.session_id AND lj.player_id = sp.player_id
+                        AND lj.game_seq = (SELECT s.game_seq FROM sessions s WHERE s.id = sp.session_id)), 0) AS INTEGER) AS missed_questions
 FROM session_players sp
          JOIN players p ON p.id = sp.player_id
          LEFT JOIN session_answers sa
@@ -515,3 +623,27 @@ WHERE sp.session_id = sqlc.arg('session_id')
                     AND sa2.game_seq = (SELECT s.game_seq FROM sessions s WHERE s.id = sp.session_id)))
 GROUP BY sp.player_id, p.display_name
 ORDER BY total_score DESC, p.display_name;
+
+-- name: ListSessionQuestionPlan :many
+-- The host's reorders and skips for the room's game game_seq, in reorder
+-- order. The runner applies them over the quiz's own play order.
+SELECT question_id, position, skipped
+FROM session_question_plan
+WHERE session_id = ?
+  AND game_seq = ?
+ORDER BY position, question_id;
+
+-- name: UpsertSessionQuestionPosition :exec
+-- Places a remaining question of the room's game game_seq at position in the
+-- host's new order. Leaves skipped alone: only questions still in play are
+-- reordered.
+INSERT INTO session_question_plan (session_id, game_seq, question_id, position)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (session_id, game_seq, question_id) DO UPDATE SET position = excluded.position;
+
+-- name: SkipSessionQuestion :exec
+-- Drops a remaining question from the room's game game_seq. Skipping it
+-- again is a no-op.
+INSERT INTO session_question_plan (session_id, game_seq, question_id, skipped)
+VALUES (?, ?, ?, 1)
+ON CONFLICT (session_id, game_seq, question_id) DO UPDATE SET skipped = 1;
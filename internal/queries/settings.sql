@@ -0,0 +1,17 @@
+-- name: ListSettings :many
+-- Every stored setting. The table holds a handful of rows, so callers read it
+-- whole and pick the keys they need.
+SELECT *
+FROM settings
+ORDER BY key;
+
+-- name: UpsertSetting :exec
+INSERT INTO settings (key, value)
+VALUES (sqlc.arg('key'), sqlc.arg('value'))
+ON CONFLICT (key) DO UPDATE SET value = excluded.value, updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteSetting :exec
+-- Drops a setting so its reader falls back to the built-in default.
+DELETE
+FROM settings
+WHERE key = ?;
@@ -0,0 +1,74 @@
+-- name: CreateTournament :one
+-- Inserts a tournament with the join code the service allocated. A UNIQUE
+-- violation on join_code is the loser of the probe race; the store maps it to
+-- tournament.ErrJoinCodeUnavailable.
+INSERT INTO tournaments (title, join_code, created_by_player_id)
+VALUES (sqlc.arg('title'), sqlc.arg('join_code'), sqlc.arg('created_by_player_id'))
+RETURNING *;
+
+-- name: TournamentJoinCodeExists :one
+SELECT EXISTS (SELECT 1 FROM tournaments WHERE join_code = ?) AS code_exists;
+
+-- name: GetTournament :one
+SELECT *
+FROM tournaments
+WHERE id = ?;
+
+-- name: GetTournamentByJoinCode :one
+SELECT *
+FROM tournaments
+WHERE join_code = ?;
+
+-- name: ListTournaments :many
+-- Every tournament, newest first, for an Admin's tournament list.
+SELECT *
+FROM tournaments
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListTournamentsForOwner :many
+-- A Host's own tournaments, newest first, mirroring ListQuizzesForOwner.
+SELECT *
+FROM tournaments
+WHERE created_by_player_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListTournamentStages :many
+-- The tournament's quizzes in play order, with the quiz fields the standings
+-- and admin views show.
+SELECT ts.quiz_id  AS quiz_id,
+       ts.position AS position,
+       q.title     AS quiz_title,
+       q.slug      AS quiz_slug
+FROM tournament_stages ts
+         JOIN quizzes q ON q.id = ts.quiz_id
+WHERE ts.tournament_id = ?
+ORDER BY ts.position;
+
+-- name: AddTournamentStage :exec
+-- Appends the quiz as the tournament's next stage. The position is computed
+-- in the same statement so two concurrent appends cannot pick the same slot
+-- silently: the loser trips UNIQUE (tournament_id, position) instead.
+INSERT INTO tournament_stages (tournament_id, quiz_id, position)
+VALUES (
+    sqlc.arg('tournament_id'),
+    sqlc.arg('quiz_id'),
+    (SELECT COALESCE(MAX(position), 0) + 1 FROM tournament_stages WHERE tournament_id = sqlc.arg('tournament_id'))
+);
+
+-- name: AddTournamentPlayer :exec
+-- Idempotent join: a second join with the same code keeps the original
+-- joined_at.
+INSERT INTO tournament_players (tournament_id, player_id)
+VALUES (?, ?)
+ON CONFLICT (tournament_id, player_id) DO NOTHING;
+
+-- name: ListTournamentPlayers :many
+-- Members in join order with their current display name, so a rename shows up
+-- in the standings.
+SELECT tp.player_id    AS player_id,
+       p.display_name  AS display_name,
+       tp.joined_at    AS joined_at
+FROM tournament_players tp
+         JOIN players p ON p.id = tp.player_id
+WHERE tp.tournament_id = ?
+ORDER BY tp.joined_at, tp.player_id;
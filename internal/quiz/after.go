@@ -0,0 +1,34 @@
+package quiz
+
+// FollowCandidates returns the questions qs may be set to follow: the other
+// questions of its round that come before it, in the order of questions,
+// which is taken in quiz-wide position order. A new question (ID 0) lands
+// after every existing one, so its whole round qualifies. Rounds keep their
+// order even on a shuffled quiz, so a question in another round never needs
+// the constraint.
+func FollowCandidates(questions []*Question, qs *Question) []*Question {
+	var out []*Question
+	for _, q := range questions {
+		if q.RoundID != qs.RoundID {
+			continue
+		}
+		if q.ID == qs.ID {
+			break
+		}
+		out = append(out, q)
+	}
+
+	return out
+}
+
+// CanFollow reports whether qs may be set to follow the question with
+// afterID, i.e. whether afterID is among its [FollowCandidates].
+func CanFollow(questions []*Question, qs *Question, afterID int64) bool {
+	for _, q := range FollowCandidates(questions, qs) {
+		if q.ID == afterID {
+			return true
+		}
+	}
+
+	return false
+}
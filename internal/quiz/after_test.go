@@ -0,0 +1,42 @@
+package quiz_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+func TestCanFollow(t *testing.T) {
+	t.Parallel()
+
+	// Round 10 holds questions 1,2,3; round 20 holds question 4.
+	questions := []*quiz.Question{
+		{ID: 1, RoundID: 10},
+		{ID: 2, RoundID: 10},
+		{ID: 3, RoundID: 10},
+		{ID: 4, RoundID: 20},
+	}
+
+	tests := []struct {
+		name    string
+		qs      *quiz.Question
+		afterID int64
+		want    bool
+	}{
+		{name: "earlier question of the same round", qs: questions[2], afterID: 1, want: true},
+		{name: "the question itself", qs: questions[1], afterID: 2, want: false},
+		{name: "later question of the same round", qs: questions[0], afterID: 2, want: false},
+		{name: "question of another round", qs: questions[3], afterID: 3, want: false},
+		{name: "new question follows any of its round", qs: &quiz.Question{RoundID: 10}, afterID: 3, want: true},
+		{name: "unknown question", qs: questions[2], afterID: 99, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := quiz.CanFollow(questions, tt.qs, tt.afterID); got != tt.want {
+				t.Errorf("CanFollow(%d after %d) = %t, want %t", tt.qs.ID, tt.afterID, got, tt.want)
+			}
+		})
+	}
+}
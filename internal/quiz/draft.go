@@ -0,0 +1,14 @@
+package quiz
+
+import "time"
+
+// QuestionDraft is a player's unsaved edit of a question, autosaved by the
+// admin editor so a crashed browser does not lose the work. Data is the form's
+// fields as a JSON object of field name to values; the store keeps it opaque
+// and the editor restores it when the form is reopened.
+type QuestionDraft struct {
+	PlayerID   int64
+	QuestionID int64
+	Data       string
+	UpdatedAt  time.Time
+}
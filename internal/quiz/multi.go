@@ -0,0 +1,77 @@
+package quiz
+
+import "slices"
+
+// IsMulti reports whether q is a "select all that apply" question.
+func (q *Question) IsMulti() bool {
+	return q.Kind == KindMulti
+}
+
+// IsSinglePick reports whether q is answered with exactly one option: a
+// choice or true/false question. The live runner plays only these.
+func (q *Question) IsSinglePick() bool {
+	switch NormalizedKind(q.Kind) {
+	case KindChoice, KindTrueFalse:
+		return true
+	default:
+		return false
+	}
+}
+
+// CorrectCount reports how many of q's options are marked correct.
+func (q *Question) CorrectCount() int {
+	n := 0
+	for _, o := range q.Options {
+		if o.Correct {
+			n++
+		}
+	}
+
+	return n
+}
+
+// PickTally counts a multi-select answer against its question: how many of
+// the picked options are correct and how many are not, and how many correct
+// options the question has in all.
+type PickTally struct {
+	Correct      int
+	Wrong        int
+	CorrectTotal int
+}
+
+// TallyPicks tallies the picked option IDs against options, the question's
+// full option list. A picked ID that is not among options counts for
+// nothing, and a repeated ID counts once.
+func TallyPicks(options []*Option, picked []int64) PickTally {
+	var t PickTally
+	for _, o := range options {
+		if o.Correct {
+			t.CorrectTotal++
+		}
+		if !slices.Contains(picked, o.ID) {
+			continue
+		}
+		if o.Correct {
+			t.Correct++
+		} else {
+			t.Wrong++
+		}
+	}
+
+	return t
+}
+
+// MultiCredit is the share of a correct answer's points, between 0 and 1,
+// that a multi-select answer earns: each correct pick is worth an equal
+// share of the question's correct options and each wrong pick takes one
+// share back, floored at zero. Picking exactly the correct options earns all
+// of it; picking every option earns only what the correct picks outweigh
+// the wrong ones by. A question with no correct option earns nothing.
+func MultiCredit(t PickTally) float64 {
+	if t.CorrectTotal <= 0 {
+		return 0
+	}
+	credit := float64(t.Correct-t.Wrong) / float64(t.CorrectTotal)
+
+	return min(max(credit, 0), 1)
+}
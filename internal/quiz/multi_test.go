@@ -0,0 +1,49 @@
+package quiz_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+func TestTallyPicks(t *testing.T) {
+	t.Parallel()
+
+	options := []*quiz.Option{
+		{ID: 1, Correct: true},
+		{ID: 2, Correct: true},
+		{ID: 3},
+		{ID: 4},
+	}
+	got := quiz.TallyPicks(options, []int64{1, 3, 1, 99})
+	want := quiz.PickTally{Correct: 1, Wrong: 1, CorrectTotal: 2}
+	if got != want {
+		t.Errorf("TallyPicks() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMultiCredit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		tally quiz.PickTally
+		want  float64
+	}{
+		{name: "exactly the correct options", tally: quiz.PickTally{Correct: 2, CorrectTotal: 2}, want: 1},
+		{name: "one of two correct", tally: quiz.PickTally{Correct: 1, CorrectTotal: 2}, want: 0.5},
+		{name: "a wrong pick takes a share back", tally: quiz.PickTally{Correct: 2, Wrong: 1, CorrectTotal: 3}, want: 1.0 / 3},
+		{name: "every option", tally: quiz.PickTally{Correct: 2, Wrong: 2, CorrectTotal: 2}, want: 0},
+		{name: "more wrong than correct", tally: quiz.PickTally{Correct: 1, Wrong: 3, CorrectTotal: 2}, want: 0},
+		{name: "no correct option", tally: quiz.PickTally{Wrong: 1}, want: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := quiz.MultiCredit(tc.tally); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("MultiCredit(%+v) = %v, want %v", tc.tally, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package quiz
+
+import (
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Question kinds. The DB CHECK on questions.kind enforces this set.
+//   - KindChoice - the player picks one of the options; the default.
+//   - KindMulti - "select all that apply": the player picks any number of
+//     the options and scores partial credit, see [MultiCredit].
+//   - KindTrueFalse - a choice between exactly two options, one correct.
+//   - KindNumeric - "closest answer": the player types a number and scores
+//     by how near it lands to the answer key, see [NumericCredit].
+const (
+	KindChoice    = "choice"
+	KindMulti     = "multi"
+	KindTrueFalse = "truefalse"
+	KindNumeric   = "numeric"
+)
+
+// KindValues lists the question kinds in the admin selector's display order,
+// as a fresh slice callers can range over without sharing a backing array.
+func KindValues() []string {
+	return []string{KindChoice, KindMulti, KindTrueFalse, KindNumeric}
+}
+
+// IsValidKind reports whether k is one of the recognised question kinds.
+func IsValidKind(k string) bool {
+	return slices.Contains(KindValues(), k)
+}
+
+// NormalizedKind maps the empty zero value onto KindChoice.
+func NormalizedKind(k string) string {
+	if k == "" {
+		return KindChoice
+	}
+
+	return k
+}
+
+// IsNumeric reports whether q is a numeric question.
+func (q *Question) IsNumeric() bool {
+	return q.Kind == KindNumeric
+}
+
+// NumericKey returns a numeric question's answer key, its single option, or
+// nil when q is not numeric or the key is missing.
+func (q *Question) NumericKey() *Option {
+	if !q.IsNumeric() || len(q.Options) == 0 || q.Options[0].NumericValue == nil {
+		return nil
+	}
+
+	return q.Options[0]
+}
+
+// NewNumericKey builds the answer-key option of a numeric question. Text
+// carries the formatted value so option listings (statistics, exports) read
+// naturally; Correct is set so the answer counts as an attempt at the right
+// option everywhere correctness is a plain flag.
+func NewNumericKey(value, toleranceBelow, toleranceAbove float64) *Option {
+	return &Option{
+		Text:           FormatNumber(value),
+		Correct:        true,
+		NumericValue:   &value,
+		ToleranceBelow: toleranceBelow,
+		ToleranceAbove: toleranceAbove,
+	}
+}
+
+// FormatNumber renders v without a trailing ".0" or exponent noise.
+func FormatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// NumericCredit is the share of a correct answer's points, between 0 and 1,
+// that value earns against the numeric answer key. An exact hit earns all of
+// it; the credit falls linearly to zero at ToleranceBelow under the key or
+// ToleranceAbove over it, and is zero beyond. A zero tolerance on a side
+// means only an exact answer scores from that side. The sides are
+// independent, so a question can be forgiving of an overestimate and strict
+// about an underestimate.
+func NumericCredit(key *Option, value float64) float64 {
+	if key == nil || key.NumericValue == nil || math.IsNaN(value) {
+		return 0
+	}
+	diff := value - *key.NumericValue
+	tolerance := key.ToleranceAbove
+	if diff < 0 {
+		diff, tolerance = -diff, key.ToleranceBelow
+	}
+	if diff == 0 {
+		return 1
+	}
+	if diff >= tolerance {
+		return 0
+	}
+
+	return 1 - diff/tolerance
+}
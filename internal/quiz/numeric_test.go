@@ -0,0 +1,55 @@
+package quiz_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+func TestNumericCredit(t *testing.T) {
+	t.Parallel()
+
+	// Key 100, forgiving above (up to 150) and strict below (down to 90).
+	key := quiz.NewNumericKey(100, 10, 50)
+
+	tests := []struct {
+		name  string
+		value float64
+		want  float64
+	}{
+		{name: "exact", value: 100, want: 1},
+		{name: "halfway below", value: 95, want: 0.5},
+		{name: "halfway above", value: 125, want: 0.5},
+		{name: "at the lower edge", value: 90, want: 0},
+		{name: "at the upper edge", value: 150, want: 0},
+		{name: "beyond below", value: 80, want: 0},
+		{name: "beyond above", value: 1000, want: 0},
+		{name: "not a number", value: math.NaN(), want: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := quiz.NumericCredit(key, tc.value); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("NumericCredit(%v) = %v, want %v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestNumericCredit_ZeroTolerance pins that a zero tolerance only scores the
+// exact value from that side.
+func TestNumericCredit_ZeroTolerance(t *testing.T) {
+	t.Parallel()
+
+	key := quiz.NewNumericKey(7, 0, 0)
+	if got := quiz.NumericCredit(key, 7); got != 1 {
+		t.Errorf("NumericCredit(7) = %v, want 1", got)
+	}
+	if got := quiz.NumericCredit(key, 7.001); got != 0 {
+		t.Errorf("NumericCredit(7.001) = %v, want 0", got)
+	}
+	if got := quiz.NumericCredit(&quiz.Option{Correct: true}, 7); got != 0 {
+		t.Errorf("NumericCredit(no key) = %v, want 0", got)
+	}
+}
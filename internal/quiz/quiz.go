@@ -1,12 +1,15 @@
 // Package quiz provides a store for quizzes, questions, and options.
-// It only supports SQLite for now.
+// The store is backed by SQLite or Postgres, chosen by the DB_URI scheme.
 package quiz
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"slices"
 	"time"
+
+	"github.com/starquake/topbanana/internal/enum"
 )
 
 // Store represents a store for quizzes.
@@ -35,6 +38,24 @@ type Store interface {
 	// so they see only their own live-eligible quizzes; an admin uses the
 	// unscoped ListLiveQuizzes.
 	ListLiveQuizzesForOwner(ctx context.Context, ownerID int64) ([]*Quiz, error)
+	// ListQuizzesPage returns one page of ListQuizzes, in the same order.
+	// ownerID scopes the page to one creator (0 for every quiz) and mode to
+	// one play mode ("" for both). Backs the paginated admin quiz list.
+	ListQuizzesPage(ctx context.Context, ownerID int64, mode string, limit, offset int64) ([]*Quiz, error)
+	// CountQuizzes returns the number of quizzes matching the
+	// ListQuizzesPage filter, for the admin list's page navigation.
+	CountQuizzes(ctx context.Context, ownerID int64, mode string) (int64, error)
+	// ListPublicQuizzesPage returns one page of ListPublicQuizzes, in the
+	// same order. Backs GET /api/quizzes?page=&pageSize=.
+	ListPublicQuizzesPage(ctx context.Context, limit, offset int64) ([]*Quiz, error)
+	// CountPublicQuizzes returns the number of rows ListPublicQuizzes would
+	// return, reported as the API list total.
+	CountPublicQuizzes(ctx context.Context) (int64, error)
+	// SearchQuizzes returns one page of the quizzes whose title, description
+	// or slug match every term of query (each as a word prefix), best match
+	// first, with the total number of matches. A query with no letters or
+	// digits matches nothing.
+	SearchQuizzes(ctx context.Context, query string, opts SearchOptions) ([]*Quiz, int64, error)
 	// QuestionCountsByQuiz returns the number of questions per quiz, keyed by
 	// quiz ID. Quizzes with no questions are absent from the map; callers
 	// should treat a missing entry as 0. Used alongside ListQuizzes by the
@@ -62,14 +83,22 @@ type Store interface {
 	// does not need the rest of the tree. Returns ErrQuizNotFound when the
 	// quiz does not exist.
 	GetQuizVisibility(ctx context.Context, id int64) (string, error)
+	// GetQuizIDByExternalRef resolves an author-supplied quiz reference to
+	// its ID without loading the quiz. Returns ErrQuizNotFound when no quiz
+	// has the reference.
+	GetQuizIDByExternalRef(ctx context.Context, ref string) (int64, error)
+	// GetQuizIDBySlug resolves a quiz slug to its ID without loading the
+	// quiz. Returns ErrQuizNotFound when no quiz has the slug.
+	GetQuizIDBySlug(ctx context.Context, slug string) (int64, error)
 	// CreateQuiz creates a quiz.
 	CreateQuiz(ctx context.Context, qz *Quiz) error
-	// UpdateQuiz updates a quiz.
+	// UpdateQuiz updates a quiz. Returns ErrStaleUpdate when the quiz or
+	// one of its questions has moved past the version qz carries.
 	UpdateQuiz(ctx context.Context, qz *Quiz) error
 	// SetQuizMode flips just the play mode of a quiz between ModeSolo and
 	// ModeLive without touching its questions (#830). Returns ErrInvalidMode
 	// when mode is neither, and ErrQuizNotFound when no row matches the id.
-	SetQuizMode(ctx context.Context, id int64, mode string) error
+	SetQuizMode(ctx context.Context, id int64, mode Mode) error
 	// SetQuizPublished flips just the published flag without touching the questions (#1192). Returns ErrQuizNotFound when no row matches the id.
 	SetQuizPublished(ctx context.Context, id int64, published bool) error
 	// QuizHasRealPlays reports whether the quiz has at least one non-preview game (#1192); preview games do not count.
@@ -80,6 +109,15 @@ type Store interface {
 	ListQuestions(ctx context.Context, quizID int64) ([]*Question, error)
 	// GetQuestion returns a question with options, by its question ID.
 	GetQuestion(ctx context.Context, questionID int64) (*Question, error)
+	// GetQuestionByExternalRef returns the quiz's question with the given
+	// author-supplied reference, with its options. Returns
+	// ErrQuestionNotFound when the quiz has no such question.
+	GetQuestionByExternalRef(ctx context.Context, quizID int64, ref string) (*Question, error)
+	// GetQuestionProgress returns where the question sits in its quiz, the
+	// same placement [QuestionRoundProgress] derives from the loaded
+	// questions, computed in the store. Returns ErrQuestionNotFound when the
+	// question does not exist.
+	GetQuestionProgress(ctx context.Context, questionID int64) (*QuestionProgress, error)
 	// CreateQuestion creates a question.
 	CreateQuestion(ctx context.Context, qs *Question) error
 	// CreateQuestionAtNextPosition reads max(position)+1 and inserts
@@ -88,7 +126,8 @@ type Store interface {
 	// questions at the same position under concurrent "Add question"
 	// clicks (#352).
 	CreateQuestionAtNextPosition(ctx context.Context, qs *Question) error
-	// UpdateQuestion updates a question.
+	// UpdateQuestion updates a question. Returns ErrStaleUpdate when the
+	// question has moved past the version qs carries.
 	UpdateQuestion(ctx context.Context, qs *Question) error
 	// SetQuestionMedia patches only a question's media references - its image
 	// and audio media ids and the audio repeat flag - without touching the
@@ -171,6 +210,27 @@ type Store interface {
 	// mismatch returns ErrQuestionNotFound (question not on quiz) or
 	// ErrRoundNotFound (round not on quiz).
 	MoveQuestionToPosition(ctx context.Context, quizID, questionID, targetRoundID int64, newPosition int) error
+	// QuestionStats returns the answer statistics of each of the quiz's
+	// questions for its current stats epoch, keyed by question ID. Picks
+	// from owner preview games are not counted. Every question is present,
+	// with zero counts when it has not been answered.
+	QuestionStats(ctx context.Context, quizID int64) (map[int64]QuestionStats, error)
+	// RenumberQuestions rewrites every question position on the quiz to
+	// RenumberStep increments (10, 20, 30, ...) preserving the current
+	// order, as planned by PlanRenumber. The read and all writes share one
+	// transaction. A non-empty fingerprint must match PlanFingerprint of
+	// that plan, or nothing is written and ErrStaleUpdate is returned; an
+	// empty one skips the check. A quiz with no questions is a no-op.
+	RenumberQuestions(ctx context.Context, quizID int64, fingerprint string) error
+	// GetQuestionDraft returns the player's autosaved draft of the question.
+	// Returns ErrQuestionDraftNotFound when there is none.
+	GetQuestionDraft(ctx context.Context, playerID, questionID int64) (*QuestionDraft, error)
+	// SaveQuestionDraft stores the draft, replacing the player's previous
+	// draft of the same question.
+	SaveQuestionDraft(ctx context.Context, d *QuestionDraft) error
+	// DeleteQuestionDraft drops the player's draft of the question. Deleting
+	// a draft that does not exist is not an error.
+	DeleteQuestionDraft(ctx context.Context, playerID, questionID int64) error
 }
 
 var (
@@ -178,12 +238,24 @@ var (
 	ErrQuizNotFound = errors.New("quiz not found")
 	// ErrQuestionNotFound is returned when a question is not found.
 	ErrQuestionNotFound = errors.New("question not found")
+	// ErrExternalRefTaken is returned when a quiz is saved with the external
+	// reference of another quiz, or a question with that of another question
+	// of its quiz.
+	ErrExternalRefTaken = errors.New("external reference already in use")
+	// ErrQuestionDraftNotFound is returned when the player has no draft of
+	// the question.
+	ErrQuestionDraftNotFound = errors.New("question draft not found")
 	// ErrOptionNotFound is returned when an option is not found.
 	ErrOptionNotFound = errors.New("option not found")
 	// ErrUpdatingQuizNoRowsAffected is returned when no rows are affected when updating a quiz.
 	ErrUpdatingQuizNoRowsAffected = errors.New("no rows affected when updating quiz")
 	// ErrUpdatingQuestionNoRowsAffected is returned when no rows are affected when updating a question.
 	ErrUpdatingQuestionNoRowsAffected = errors.New("no rows affected when updating question")
+	// ErrStaleUpdate is returned by UpdateQuiz / UpdateQuestion when the row
+	// was saved by someone else after the caller read it: its version no
+	// longer matches, and nothing was written. The admin handlers turn it
+	// into a conflict page offering a reload.
+	ErrStaleUpdate = errors.New("quiz was changed since it was loaded")
 	// ErrDeletingQuizNoRowsAffected is returned when no rows are affected when deleting a quiz.
 	ErrDeletingQuizNoRowsAffected = errors.New("no rows affected when deleting quiz")
 	// ErrDeletingQuestionNoRowsAffected is returned when no rows are affected when deleting a question.
@@ -290,32 +362,67 @@ func IsValidVisibility(v string) bool {
 	return slices.Contains(VisibilityValues(), v)
 }
 
-// Play modes (MP-0 / #677). The DB CHECK on quizzes.mode enforces the
-// same set; keeping them as typed constants means handlers and
-// templates don't sprinkle stringly-typed values across the codebase.
+// Mode is a quiz's play mode (MP-0 / #677). The DB CHECK on quizzes.mode
+// enforces the same set, and Mode's Scan, Value and JSON methods reject
+// anything outside it, so a bad mode fails where it enters rather than at
+// the CHECK or not at all.
 //
 //   - ModeSolo - self-paced; listed in the solo browse paths and
 //     playable by anyone who can read it.
 //   - ModeLive - hosted-only (MP-1+); never listed in the solo browse
 //     paths and never solo-playable, so it cannot be pre-played and
 //     spoiled before a hosted game.
+type Mode string
+
+// Play modes.
 const (
-	ModeSolo = "solo"
-	ModeLive = "live"
+	ModeSolo Mode = "solo"
+	ModeLive Mode = "live"
 )
 
+// modes is the closed set of play modes, in the order the admin form's
+// selector renders them.
+//
+//nolint:gochecknoglobals // Fixed value set, read-only after init.
+var modes = enum.New("play mode", ModeSolo, ModeLive)
+
 // ModeValues lists the play modes in the order the admin form's
 // selector renders them. Returned as a fresh slice on every call so
-// callers can range over it without sharing a backing array (and to
-// keep the gochecknoglobals linter happy).
-func ModeValues() []string {
-	return []string{ModeSolo, ModeLive}
+// callers can range over it without sharing a backing array.
+func ModeValues() []Mode {
+	return modes.Values()
 }
 
 // IsValidMode reports whether m is one of the recognised play modes
 // (MP-0 / #677).
 func IsValidMode(m string) bool {
-	return slices.Contains(ModeValues(), m)
+	return modes.Valid(Mode(m))
+}
+
+// ParseMode returns raw as a Mode, or an error wrapping [enum.ErrInvalid]
+// when it is not a recognised play mode.
+func ParseMode(raw string) (Mode, error) {
+	return modes.Parse(raw) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// Scan implements [database/sql.Scanner].
+func (m *Mode) Scan(src any) error {
+	return modes.Scan(m, src) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// Value implements [driver.Valuer].
+func (m Mode) Value() (driver.Value, error) {
+	return modes.Value(m) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (m Mode) MarshalJSON() ([]byte, error) {
+	return modes.EncodeJSON(m) //nolint:wrapcheck // enum errors carry their own context.
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (m *Mode) UnmarshalJSON(data []byte) error {
+	return modes.DecodeJSON(m, data) //nolint:wrapcheck // enum errors carry their own context.
 }
 
 // Content languages (#1115): an advisory label recording which language a
@@ -337,10 +444,98 @@ func IsValidLanguage(l string) bool {
 	return slices.Contains(LanguageValues(), l)
 }
 
+// Late-join policies for live games: what a player who joins after the game
+// started gets. The DB CHECK on quizzes.late_join enforces the same set.
+//
+//   - LateJoinSkip - let them in; the questions that closed before they
+//     joined are left out of their tally. The behaviour before the policy
+//     existed (#836), and the default.
+//   - LateJoinZero - let them in; those questions count against them,
+//     scored 0.
+//   - LateJoinClosed - turn them away once the game is under way.
+const (
+	LateJoinSkip   = "skip"
+	LateJoinZero   = "zero"
+	LateJoinClosed = "closed"
+)
+
+// MaxJoinDeadlineSeconds caps Quiz.JoinDeadlineSeconds: an hour is longer
+// than any live game runs, so a larger value means nothing.
+const MaxJoinDeadlineSeconds = 3600
+
+// MaxWrongAnswerPenalty caps Quiz.WrongAnswerPenalty at the most a correct
+// answer can earn on time, so a miss never costs more than a hit is worth.
+const MaxWrongAnswerPenalty = 1000
+
+// MinScoreFloor bounds Quiz.ScoreFloor from below: a hundred full-price
+// misses, deeper than any quiz goes.
+const MinScoreFloor = -100 * MaxWrongAnswerPenalty
+
+// MaxPlayersLimit caps Quiz.MaxPlayers: a room beyond it is past what one
+// session hub is sized for, so the admin form rejects a larger value.
+const MaxPlayersLimit = 10000
+
+// LateJoinValues lists the late-join policies in the admin selector's display
+// order, as a fresh slice callers can range over without sharing a backing array.
+func LateJoinValues() []string {
+	return []string{LateJoinSkip, LateJoinZero, LateJoinClosed}
+}
+
+// IsValidLateJoin reports whether p is one of the recognised late-join policies.
+func IsValidLateJoin(p string) bool {
+	return slices.Contains(LateJoinValues(), p)
+}
+
+// NormalizedLateJoin resolves a quiz's late-join policy default: an empty
+// value maps to LateJoinSkip.
+func NormalizedLateJoin(p string) string {
+	if p == "" {
+		return LateJoinSkip
+	}
+
+	return p
+}
+
+// Scoring modes: how a quiz's answers earn points. The DB CHECK on
+// quizzes.scoring enforces the same set.
+//
+//   - ScoringTime - a correct answer's points fall with the time taken. The
+//     behaviour before the setting existed, and the default.
+//   - ScoringFlat - every correct answer in the window earns full points,
+//     however quickly it came.
+//   - ScoringStreak - the time curve, multiplied by the player's run of
+//     consecutive correct answers in the game.
+const (
+	ScoringTime   = "time"
+	ScoringFlat   = "flat"
+	ScoringStreak = "streak"
+)
+
+// ScoringValues lists the scoring modes in the admin selector's display
+// order, as a fresh slice callers can range over without sharing a backing array.
+func ScoringValues() []string {
+	return []string{ScoringTime, ScoringFlat, ScoringStreak}
+}
+
+// IsValidScoring reports whether m is one of the recognised scoring modes.
+func IsValidScoring(m string) bool {
+	return slices.Contains(ScoringValues(), m)
+}
+
+// NormalizedScoring resolves a quiz's scoring mode default: an empty value
+// maps to ScoringTime.
+func NormalizedScoring(m string) string {
+	if m == "" {
+		return ScoringTime
+	}
+
+	return m
+}
+
 // NormalizedFields resolves a quiz's visibility, mode, and language defaults: an
 // empty value maps to public / solo / English. Shared by the store write path
 // and the admin view-model so the defaulting lives in one place.
-func NormalizedFields(qz *Quiz) (visibility, mode, language string) {
+func NormalizedFields(qz *Quiz) (visibility string, mode Mode, language string) {
 	visibility, mode, language = qz.Visibility, qz.Mode, qz.Language
 	if visibility == "" {
 		visibility = VisibilityPublic
@@ -355,6 +550,18 @@ func NormalizedFields(qz *Quiz) (visibility, mode, language string) {
 	return visibility, mode, language
 }
 
+// SearchOptions scopes and pages a [Store.SearchQuizzes] call. OwnerID and
+// Mode narrow it as they do ListQuizzesPage, their zero values meaning no
+// scope; PublicOnly keeps it to the quizzes ListPublicQuizzes shows. Limit and
+// Offset page the best-match-first result.
+type SearchOptions struct {
+	OwnerID    int64
+	Mode       string
+	PublicOnly bool
+	Limit      int64
+	Offset     int64
+}
+
 // Quiz represents a quiz. CreatedByPlayerID + CreatedByDisplayName were
 // added in migration 20260520200000 to support the creator-only-edit
 // rule from #281. CreatedByPlayerID is NOT NULL at the DB level;
@@ -388,11 +595,55 @@ type Quiz struct {
 	// never solo-playable. A zero value (empty string) is treated as
 	// ModeSolo by the store layer so existing fixtures and the
 	// JSON-import path don't need to repeat the default.
-	Mode string
+	Mode Mode
 	// Language is the advisory content-language label (#1115): LanguageEN or
 	// LanguageNL. A zero value (empty string) is treated as LanguageEN by the
 	// store layer so existing fixtures and the JSON-import path skip the default.
 	Language string
+	// ShuffleQuestions plays each round's questions in a per-game order
+	// instead of by position. Rounds stay in order, and the order is seeded
+	// from the game so a reconnect sees the same sequence.
+	ShuffleQuestions bool
+	// KeepOptionOrder lays out the option buttons in the order they were
+	// written instead of the per-game shuffle every quiz gets by default
+	// (#297).
+	KeepOptionOrder bool
+	// LateJoin is the live-game late-join policy: LateJoinSkip, LateJoinZero
+	// or LateJoinClosed. A zero value (empty string) is treated as
+	// LateJoinSkip by the store layer.
+	LateJoin string
+	// JoinDeadlineSeconds, when positive, closes a live game to late joiners
+	// that many seconds after it started. Zero keeps it open for the whole
+	// game (unless LateJoin is LateJoinClosed). A player already on the
+	// roster always gets back in.
+	JoinDeadlineSeconds int
+	// MaxPlayers, when positive, caps how many players a live room admits
+	// while it plays this quiz, overriding the server-wide default. Zero
+	// defers to that default.
+	MaxPlayers int
+	// ConfidenceWager turns on the confidence wager for solo games of the
+	// quiz: before answering, the player stakes 1-3, which multiplies a
+	// correct answer's points and is taken off for a wrong one.
+	ConfidenceWager bool
+	// HostPaced holds back scoring in solo games of the quiz: answers are
+	// recorded without telling the player whether they were right, and a
+	// game's results stay hidden until the quiz's host reveals them.
+	HostPaced bool
+	// Scoring is how answers in solo games of the quiz earn points:
+	// ScoringTime, ScoringFlat or ScoringStreak. A zero value (empty string)
+	// is treated as ScoringTime by the store layer.
+	Scoring string
+	// WrongAnswerPenalty is the points a wrong answer costs in solo games of
+	// the quiz, 0..MaxWrongAnswerPenalty; zero turns negative marking off.
+	WrongAnswerPenalty int
+	// ScoreFloor is the lowest a player's game total can fall to,
+	// MinScoreFloor..0. Zero, the default, keeps totals from going negative.
+	ScoreFloor int
+	// ExternalRef is the author-supplied key a content pipeline tracks the
+	// quiz by, unique across the instance. It survives export and import so
+	// a re-import finds the quiz even after its title changed. Empty for
+	// quizzes without one.
+	ExternalRef string
 	// PlayCount is the durable hit counter on the quiz row (#891): bumped
 	// once when a play of the quiz completes (the solo path bumps when the
 	// final game_questions row is issued, since that is the moment
@@ -402,6 +653,13 @@ type Quiz struct {
 	PlayCount int64
 	// Published reports whether the quiz is playable by real players (#1192): a draft is previewable only by its owner, stays out of the public and live-host listings, and is editable; a published quiz is locked from content edits. New quizzes default to draft.
 	Published bool
+	// PublishedAt is when the quiz was last published, nil while it is a
+	// draft. Read-only: the store stamps it as Published flips.
+	PublishedAt *time.Time
+	// Version is the quiz row's optimistic-concurrency counter. UpdateQuiz
+	// applies only while the row still has this version, bumps it, and
+	// returns ErrStaleUpdate when someone else saved in between.
+	Version   int64
 	Questions []*Question
 	// Rounds, when non-empty, tells the create path to author the quiz's
 	// rounds explicitly instead of dropping every question in the single
@@ -427,6 +685,10 @@ type Question struct {
 	// the caller leaves it zero.
 	RoundID int64
 	Text    string
+	// Kind is KindChoice or KindNumeric. A zero value (empty string) is
+	// treated as KindChoice by the store layer so existing fixtures and the
+	// JSON-import path don't need to repeat the default.
+	Kind string
 	// ImageMediaID references an uploaded image in the question's own quiz
 	// library (#937). Nil means no image attached. The referenced media
 	// row is quiz-scoped; the admin save handler validates same-quiz
@@ -442,18 +704,57 @@ type Question struct {
 	AudioMediaID *int64
 	// AudioRepeat, when true, makes the play surfaces replay the attached clip
 	// up to 3 times (#1073). Meaningful only when AudioMediaID is set.
-	AudioRepeat      bool
+	AudioRepeat bool
+	// AfterQuestionID names the question this one must follow ("Part 2 of the
+	// previous question"). Nil means the question is free to move. When the
+	// quiz shuffles its questions the two are played as one block, in
+	// position order; see [FollowCandidates] for which questions qualify.
+	AfterQuestionID *int64
+	// Explanation is the quiz master's "why this is correct" note, shown to
+	// the player once their answer is recorded. Empty means none.
+	Explanation string
+	// Hint is an optional nudge the player may ask for before answering, at
+	// the cost of part of the answer's score. Empty means the question
+	// offers none.
+	Hint string
+	// ExternalRef is the author-supplied key the authoring API creates and
+	// updates the question by, unique within its quiz. It survives export
+	// and import, where a re-import pairs questions by it before falling
+	// back to their text. Empty for questions without one.
+	ExternalRef      string
 	Position         int
 	TimeLimitSeconds *int
 	Options          []*Option
+	// StatsEpoch counts how many times the question's answer statistics have
+	// been reset. Every recorded pick is stamped with the epoch it was made
+	// in, and [Store.QuestionStats] only counts the current one. Read-only:
+	// UpdateQuestion never writes it directly.
+	StatsEpoch int
+	// Version is the question row's optimistic-concurrency counter, checked
+	// and bumped by UpdateQuestion (and by UpdateQuiz for each of the quiz's
+	// questions) as [Quiz.Version] is.
+	Version int64
+	// ResetStats, when true on a question passed to UpdateQuestion, starts a
+	// fresh statistics epoch in the same transaction. The admin editor sets it
+	// when an edit is [SubstantiallyEdited] and the host left the reset box
+	// ticked.
+	ResetStats bool
 }
 
 // Option represents an option for a question.
+//
+// A numeric question has exactly one option, its answer key: NumericValue is
+// the correct value, ToleranceBelow and ToleranceAbove how far under or over
+// it an answer may land and still score (see [NumericCredit]). All three are
+// unset on a multiple-choice option.
 type Option struct {
-	ID         int64
-	QuestionID int64
-	Text       string
-	Correct    bool
+	ID             int64
+	QuestionID     int64
+	Text           string
+	Correct        bool
+	NumericValue   *float64
+	ToleranceBelow float64
+	ToleranceAbove float64
 }
 
 // Round is a named section within a quiz (#444). Every question belongs
@@ -40,8 +40,8 @@ func TestIsValidMode(t *testing.T) {
 		input string
 		want  bool
 	}{
-		{name: "solo", input: ModeSolo, want: true},
-		{name: "live", input: ModeLive, want: true},
+		{name: "solo", input: string(ModeSolo), want: true},
+		{name: "live", input: string(ModeLive), want: true},
 		{name: "empty", input: "", want: false},
 		{name: "unknown", input: "team", want: false},
 	}
@@ -70,7 +70,7 @@ func TestModeValues(t *testing.T) {
 	t.Parallel()
 
 	got := ModeValues()
-	want := []string{"solo", "live"}
+	want := []Mode{"solo", "live"}
 	if !slices.Equal(got, want) {
 		t.Errorf("ModeValues() = %v, want %v", got, want)
 	}
@@ -0,0 +1,65 @@
+package quiz
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+)
+
+// RenumberStep is the gap [Store.RenumberQuestions] leaves between
+// consecutive question positions, so positions read 10, 20, 30, ... and a
+// later insert has room between two neighbours.
+const RenumberStep = 10
+
+// PositionChange is one question's row in a renumber plan: its position
+// before (From) and after (To) the renumber.
+type PositionChange struct {
+	QuestionID int64
+	Text       string
+	From       int
+	To         int
+}
+
+// Changed reports whether the renumber moves this question.
+func (c PositionChange) Changed() bool {
+	return c.From != c.To
+}
+
+// PlanRenumber returns the renumber plan for questions: their current
+// position order (ties broken by ID) mapped onto RenumberStep increments.
+// The admin preview renders it, and the store recomputes it inside its
+// transaction; [PlanFingerprint] ties the two together.
+func PlanRenumber(questions []*Question) []PositionChange {
+	ordered := slices.Clone(questions)
+	slices.SortStableFunc(ordered, func(a, b *Question) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.ID, b.ID))
+	})
+
+	plan := make([]PositionChange, len(ordered))
+	for i, q := range ordered {
+		plan[i] = PositionChange{
+			QuestionID: q.ID,
+			Text:       q.Text,
+			From:       q.Position,
+			To:         (i + 1) * RenumberStep,
+		}
+	}
+
+	return plan
+}
+
+// PlanFingerprint digests plan's rows (question, from, to) into a token the
+// admin preview posts back with the confirm. [Store.RenumberQuestions]
+// compares it with the plan it recomputes, so a question added, removed or
+// moved since the preview refuses the renumber rather than writing positions
+// the host never saw.
+func PlanFingerprint(plan []PositionChange) string {
+	h := sha256.New()
+	for _, c := range plan {
+		_, _ = fmt.Fprintf(h, "%d:%d:%d;", c.QuestionID, c.From, c.To)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,65 @@
+package quiz_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+func TestPlanRenumber(t *testing.T) {
+	t.Parallel()
+
+	// Drifted positions, out of slice order, with a tie at 7 broken by ID.
+	questions := []*quiz.Question{
+		{ID: 3, Text: "c", Position: 93},
+		{ID: 2, Text: "b", Position: 7},
+		{ID: 1, Text: "a", Position: 7},
+		{ID: 4, Text: "d", Position: 30},
+	}
+
+	got := quiz.PlanRenumber(questions)
+	want := []quiz.PositionChange{
+		{QuestionID: 1, Text: "a", From: 7, To: 10},
+		{QuestionID: 2, Text: "b", From: 7, To: 20},
+		{QuestionID: 4, Text: "d", From: 30, To: 30},
+		{QuestionID: 3, Text: "c", From: 93, To: 40},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("PlanRenumber() = %+v, want %+v", got, want)
+	}
+
+	if got, want := got[2].Changed(), false; got != want {
+		t.Errorf("got[2].Changed() = %t, want %t", got, want)
+	}
+	if got, want := questions[0].ID, int64(3); got != want {
+		t.Errorf("input reordered: questions[0].ID = %d, want %d", got, want)
+	}
+}
+
+func TestPlanRenumber_Empty(t *testing.T) {
+	t.Parallel()
+
+	if got := quiz.PlanRenumber(nil); len(got) != 0 {
+		t.Errorf("PlanRenumber(nil) = %+v, want empty", got)
+	}
+}
+
+func TestPlanFingerprint(t *testing.T) {
+	t.Parallel()
+
+	questions := []*quiz.Question{{ID: 1, Position: 7}, {ID: 2, Position: 9}}
+	got := quiz.PlanFingerprint(quiz.PlanRenumber(questions))
+	if again := quiz.PlanFingerprint(quiz.PlanRenumber(questions)); got != again {
+		t.Errorf("PlanFingerprint() = %q then %q, want stable", got, again)
+	}
+
+	moved := []*quiz.Question{{ID: 1, Position: 8}, {ID: 2, Position: 9}}
+	if other := quiz.PlanFingerprint(quiz.PlanRenumber(moved)); got == other {
+		t.Errorf("PlanFingerprint() = %q for a moved question, want a different token", other)
+	}
+	added := append(slices.Clone(questions), &quiz.Question{ID: 3, Position: 1})
+	if other := quiz.PlanFingerprint(quiz.PlanRenumber(added)); got == other {
+		t.Errorf("PlanFingerprint() = %q for an added question, want a different token", other)
+	}
+}
@@ -12,6 +12,14 @@ type RoundProgress struct {
 	RoundQuestions int
 }
 
+// QuestionProgress is a question's [RoundProgress] plus the number of
+// questions in its quiz: everything the gameplay header needs to place one
+// question, as [Store.GetQuestionProgress] returns it without loading the quiz.
+type QuestionProgress struct {
+	Total int
+	RoundProgress
+}
+
 // QuestionRoundProgress derives the [RoundProgress] for questionID from the
 // quiz's questions, which carry their round_id and are taken in quiz-wide
 // position order. Rounds are numbered by the order their first question appears
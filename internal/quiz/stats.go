@@ -0,0 +1,50 @@
+package quiz
+
+import "strings"
+
+// QuestionStats is a question's answer statistics for its current stats
+// epoch: how many picks it has drawn and how many of those were correct.
+// Picks made before the question's last statistics reset are not counted.
+type QuestionStats struct {
+	Answers int
+	Correct int
+}
+
+// CorrectPercent is the share of counted picks that were correct, rounded
+// down to a whole percent. It is 0 when nothing has been answered yet.
+func (s QuestionStats) CorrectPercent() int {
+	if s.Answers == 0 {
+		return 0
+	}
+
+	return s.Correct * percent / s.Answers
+}
+
+// percent scales a ratio to a whole percentage.
+const percent = 100
+
+// SubstantiallyEdited reports whether after differs from before in a way that
+// makes the old answer statistics misleading: the question text changed, or an
+// option was added, removed, reworded, or had its correctness flipped.
+// Whitespace and letter-case differences are ignored so fixing a typo's
+// capitalisation keeps the history; media, time limit and position are not
+// content and never count.
+func SubstantiallyEdited(before, after *Question) bool {
+	if !sameContentText(before.Text, after.Text) || len(before.Options) != len(after.Options) {
+		return true
+	}
+	for i, o := range before.Options {
+		n := after.Options[i]
+		if o.Correct != n.Correct || !sameContentText(o.Text, n.Text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameContentText compares question or option text ignoring surrounding and
+// repeated whitespace and letter case.
+func sameContentText(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), " "), strings.Join(strings.Fields(b), " "))
+}
@@ -0,0 +1,79 @@
+package quiz_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+func TestSubstantiallyEdited(t *testing.T) {
+	t.Parallel()
+
+	base := func() *quiz.Question {
+		return &quiz.Question{
+			Text: "Which fruit is yellow?",
+			Options: []*quiz.Option{
+				{Text: "Banana", Correct: true},
+				{Text: "Cherry"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		edit func(q *quiz.Question)
+		want bool
+	}{
+		{name: "unchanged", edit: func(*quiz.Question) {}, want: false},
+		{
+			name: "case and whitespace only",
+			edit: func(q *quiz.Question) {
+				q.Text = "  which fruit   is YELLOW? "
+				q.Options[1].Text = "cherry"
+			},
+			want: false,
+		},
+		{name: "media and time limit only", edit: func(q *quiz.Question) {
+			id, limit := int64(7), 12
+			q.ImageMediaID, q.TimeLimitSeconds = &id, &limit
+		}, want: false},
+		{name: "reworded text", edit: func(q *quiz.Question) { q.Text = "Which fruit is red?" }, want: true},
+		{name: "reworded option", edit: func(q *quiz.Question) { q.Options[1].Text = "Lemon" }, want: true},
+		{name: "answer key flipped", edit: func(q *quiz.Question) {
+			q.Options[0].Correct, q.Options[1].Correct = false, true
+		}, want: true},
+		{name: "option added", edit: func(q *quiz.Question) {
+			q.Options = append(q.Options, &quiz.Option{Text: "Plum"})
+		}, want: true},
+		{name: "option removed", edit: func(q *quiz.Question) { q.Options = q.Options[:1] }, want: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			after := base()
+			tc.edit(after)
+			if got := quiz.SubstantiallyEdited(base(), after); got != tc.want {
+				t.Errorf("SubstantiallyEdited() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestQuestionStats_CorrectPercent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		stats quiz.QuestionStats
+		want  int
+	}{
+		{stats: quiz.QuestionStats{}, want: 0},
+		{stats: quiz.QuestionStats{Answers: 3, Correct: 2}, want: 66},
+		{stats: quiz.QuestionStats{Answers: 4, Correct: 4}, want: 100},
+	}
+	for _, tc := range tests {
+		if got := tc.stats.CorrectPercent(); got != tc.want {
+			t.Errorf("%+v.CorrectPercent() = %d, want %d", tc.stats, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Package ratelimit is the shared surface of the app's rate limiters: the
+// RateLimit-* response headers a limited endpoint sends so a client knows
+// how many attempts it has left and when to retry, and the [Registry] of
+// per-caller probes GET /api/limits reports from. The limiters themselves
+// stay with the handlers they guard (auth's login and resend cool-downs,
+// mediahttp's upload budget); each exposes a Status method returning a
+// [Status] for one caller.
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Status is one limiter's view of one caller: how many attempts its window
+// allows, how many of those are left, and how long until the caller gets
+// one back. Reset is zero when nothing is being held against the caller.
+type Status struct {
+	Limit     int
+	Remaining int
+	Reset     time.Duration
+}
+
+// Cooldown is the status of a one-attempt-per-window limiter whose caller
+// must still wait wait: an attempt left when wait is not positive, none and
+// a reset of wait otherwise.
+func Cooldown(wait time.Duration) Status {
+	if wait <= 0 {
+		return Status{Limit: 1, Remaining: 1}
+	}
+
+	return Status{Limit: 1, Reset: wait}
+}
+
+// ResetSeconds is Reset in whole seconds, rounded up so a 0.4s wait reports
+// as 1 rather than 0 and a scripted client does not retry-loop the limiter.
+func (s Status) ResetSeconds() int {
+	return int((s.Reset + time.Second - 1) / time.Second)
+}
+
+// SetHeaders writes s as the RateLimit-Limit, RateLimit-Remaining, and
+// RateLimit-Reset response headers of the IETF RateLimit header fields
+// draft, Reset in whole seconds. A zero Limit is a disabled limiter and
+// writes nothing.
+func SetHeaders(w http.ResponseWriter, s Status) {
+	if s.Limit <= 0 {
+		return
+	}
+	h := w.Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(s.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(s.Remaining))
+	h.Set("RateLimit-Reset", strconv.Itoa(s.ResetSeconds()))
+}
+
+// Probe reports a limiter's status for the caller of r, whose session player
+// is playerID. A per-IP limiter resolves its bucket from r; a per-player one
+// keys on playerID.
+type Probe func(r *http.Request, playerID int64) Status
+
+// Entry is one named limiter's status in a [Registry.Snapshot].
+type Entry struct {
+	Name string
+	Status
+}
+
+// Registry collects the named limiters a caller can introspect. Routes
+// register each limiter as they build it; GET /api/limits snapshots them in
+// registration order. A nil *Registry is valid: Register is a no-op and
+// Snapshot is empty, so route helpers wired without one (unit tests) need no
+// guard.
+type Registry struct {
+	mu     sync.Mutex
+	names  []string
+	probes map[string]Probe
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{probes: map[string]Probe{}}
+}
+
+// Register adds the limiter probe under name, replacing an earlier probe of
+// the same name in place.
+func (g *Registry) Register(name string, probe Probe) {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.probes[name]; !ok {
+		g.names = append(g.names, name)
+	}
+	g.probes[name] = probe
+}
+
+// Snapshot reports every registered limiter's status for the caller of r,
+// leaving out a disabled one (zero Limit). Probing charges nothing: a caller
+// may introspect as often as it likes.
+func (g *Registry) Snapshot(r *http.Request, playerID int64) []Entry {
+	if g == nil {
+		return []Entry{}
+	}
+	g.mu.Lock()
+	names := append([]string(nil), g.names...)
+	probes := make([]Probe, len(names))
+	for i, name := range names {
+		probes[i] = g.probes[name]
+	}
+	g.mu.Unlock()
+
+	entries := make([]Entry, 0, len(names))
+	for i, name := range names {
+		if st := probes[i](r, playerID); st.Limit > 0 {
+			entries = append(entries, Entry{Name: name, Status: st})
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,84 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/ratelimit"
+)
+
+func TestSetHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status Status
+		want   map[string]string
+	}{
+		{
+			name:   "cool-down rounds the reset up",
+			status: Cooldown(1400 * time.Millisecond),
+			want:   map[string]string{"RateLimit-Limit": "1", "RateLimit-Remaining": "0", "RateLimit-Reset": "2"},
+		},
+		{
+			name:   "free cool-down",
+			status: Cooldown(0),
+			want:   map[string]string{"RateLimit-Limit": "1", "RateLimit-Remaining": "1", "RateLimit-Reset": "0"},
+		},
+		{
+			name:   "disabled limiter writes nothing",
+			status: Status{},
+			want:   map[string]string{"RateLimit-Limit": "", "RateLimit-Remaining": "", "RateLimit-Reset": ""},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			SetHeaders(rec, tc.status)
+			for header, want := range tc.want {
+				if got := rec.Header().Get(header); got != want {
+					t.Errorf("%s = %q, want %q", header, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistry_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/limits", nil)
+
+	t.Run("reports in registration order and skips a disabled limiter", func(t *testing.T) {
+		t.Parallel()
+		reg := NewRegistry()
+		reg.Register("login", func(*http.Request, int64) Status { return Cooldown(time.Second) })
+		reg.Register("off", func(*http.Request, int64) Status { return Status{} })
+		reg.Register("upload", func(_ *http.Request, playerID int64) Status {
+			return Status{Limit: 10, Remaining: int(playerID)}
+		})
+
+		got := reg.Snapshot(req, 7)
+		if len(got) != 2 {
+			t.Fatalf("Snapshot = %+v, want 2 entries", got)
+		}
+		if got[0].Name != "login" || got[0].Remaining != 0 || got[0].ResetSeconds() != 1 {
+			t.Errorf("entry 0 = %+v, want the login cool-down", got[0])
+		}
+		if got[1].Name != "upload" || got[1].Limit != 10 || got[1].Remaining != 7 {
+			t.Errorf("entry 1 = %+v, want the upload budget keyed on the player", got[1])
+		}
+	})
+
+	t.Run("nil registry is empty", func(t *testing.T) {
+		t.Parallel()
+		var reg *Registry
+		reg.Register("login", func(*http.Request, int64) Status { return Cooldown(0) })
+		if got := reg.Snapshot(req, 1); len(got) != 0 {
+			t.Errorf("Snapshot = %+v, want empty", got)
+		}
+	})
+}
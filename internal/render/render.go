@@ -12,6 +12,7 @@ import (
 	"maps"
 	"net/http"
 
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/locale"
 )
@@ -22,16 +23,20 @@ import (
 // shares: the base globs are parsed first, then cloned and the page parsed into
 // the clone so the base stays page-free. globs are passed straight to
 // [template.Template.ParseFS]; funcs must cover every func the templates
-// reference at parse time.
+// reference at parse time, apart from the [locale.TemplateFuncs] formatting
+// funcs, which Parse registers itself and the Renderer rebinds per request.
 func Parse(fsys fs.FS, funcs template.FuncMap, page string, globs ...string) *template.Template {
-	base := template.Must(template.New("").Funcs(funcs).ParseFS(fsys, globs...))
+	base := template.Must(
+		template.New("").Funcs(locale.TemplateFuncs(locale.LocaleEN)).Funcs(funcs).ParseFS(fsys, globs...),
+	)
 
 	return template.Must(template.Must(base.Clone()).ParseFS(fsys, page))
 }
 
 // PerRequestFuncs returns the surface-specific template funcs to bind for a
 // single request (e.g. the admin top bar's viewerName / navSection / isAdmin,
-// resolved from the request context). The Renderer always binds csrfToken
+// resolved from the request context). The Renderer always binds csrfToken,
+// t, tCount, lang, brand and the [locale.TemplateFuncs] formatting funcs
 // itself, so implementations need not. May be nil for a surface that needs
 // nothing beyond csrfToken.
 type PerRequestFuncs func(r *http.Request) template.FuncMap
@@ -116,14 +121,19 @@ func (re *Renderer) prepare(w http.ResponseWriter, r *http.Request) (*template.T
 		csrfToken = re.csrf.Token(w, r)
 	}
 	// t and lang are bound here so every server-rendered surface can localize
-	// text and set <html lang> without wiring the locale itself (#1115).
+	// text and set <html lang> without wiring the locale itself (#1115), and
+	// the number/date/relative-time formatters follow the same locale; brand
+	// likewise hands every surface the deployment branding from the context.
 	loc := locale.Resolve(r)
-	funcs := template.FuncMap{
+	brand := branding.FromContext(r.Context())
+	funcs := locale.TemplateFuncs(loc)
+	maps.Copy(funcs, template.FuncMap{
 		"csrfToken": func() string { return csrfToken },
 		"t":         func(key string) string { return locale.Translate(loc, locale.MessageID(key)) },
 		"tCount":    func(key string, n int) string { return locale.TranslateCount(loc, locale.MessageID(key), n) },
 		"lang":      func() string { return loc },
-	}
+		"brand":     func() branding.Branding { return brand },
+	})
 	if re.funcs != nil {
 		maps.Copy(funcs, re.funcs(r))
 	}
@@ -1,8 +1,9 @@
 // Package request provides shared helpers for inspecting an
 // [*http.Request] in ways the stdlib does not. The first inhabitant is
-// [ClientIP], the source-IP extractor every per-IP rate limiter uses;
-// the package exists so admin/, auth/, and any future per-IP guard
-// share one implementation rather than copy-pasting RemoteAddr parsing.
+// [ClientIP], the source-IP extractor every per-IP rate limiter uses,
+// joined by [Scheme] for the original request scheme; the package exists
+// so admin/, auth/, server/ and any future per-IP guard share one
+// implementation rather than copy-pasting RemoteAddr parsing.
 package request
 
 import (
@@ -99,6 +100,32 @@ func ClientIP(r *http.Request, trustedCIDRs []*net.IPNet) string {
 	return host
 }
 
+// Scheme returns the scheme ("https" or "http") the client used to reach
+// r. A TLS connection is always "https". Otherwise X-Forwarded-Proto is
+// honoured only when r.RemoteAddr falls inside trustedCIDRs - the same
+// trust rule as [ClientIP] - so a client talking to the binary directly
+// cannot claim HTTPS it never negotiated. The first entry of a hop list
+// wins (the client-facing proxy's view); any value other than "https"
+// reads as "http".
+func Scheme(r *http.Request, trustedCIDRs []*net.IPNet) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !ipInCIDRs(host, trustedCIDRs) {
+		return "http"
+	}
+	proto, _, _ := strings.Cut(r.Header.Get("X-Forwarded-Proto"), ",")
+	if strings.EqualFold(strings.TrimSpace(proto), "https") {
+		return "https"
+	}
+
+	return "http"
+}
+
 // ipInCIDRs reports whether ip parses as an IP literal and is contained
 // in any of cidrs. Non-IP strings (e.g. "unknown", a hostname) read as
 // "not in any CIDR" so a malformed XFF segment is treated as an external
@@ -1,6 +1,7 @@
 package request_test
 
 import (
+	"crypto/tls"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -213,3 +214,79 @@ func TestClientIP(t *testing.T) {
 		})
 	}
 }
+
+func TestScheme(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		trusted    string
+		remoteAddr string
+		proto      string
+		tls        bool
+		want       string
+	}{
+		{
+			name:       "plain request without a proxy is http",
+			remoteAddr: "127.0.0.1:12345",
+			want:       "http",
+		},
+		{
+			name:       "TLS connection is https",
+			remoteAddr: "8.8.8.8:12345",
+			tls:        true,
+			want:       "https",
+		},
+		{
+			name:       "empty trust list ignores X-Forwarded-Proto",
+			remoteAddr: "127.0.0.1:12345",
+			proto:      "https",
+			want:       "http",
+		},
+		{
+			name:       "untrusted peer ignores X-Forwarded-Proto",
+			trusted:    "127.0.0.1/32",
+			remoteAddr: "8.8.8.8:12345",
+			proto:      "https",
+			want:       "http",
+		},
+		{
+			name:       "trusted peer is believed",
+			trusted:    "127.0.0.1/32",
+			remoteAddr: "127.0.0.1:12345",
+			proto:      "HTTPS",
+			want:       "https",
+		},
+		{
+			name:       "first hop of a list wins",
+			trusted:    "127.0.0.1/32",
+			remoteAddr: "127.0.0.1:12345",
+			proto:      "https, http",
+			want:       "https",
+		},
+		{
+			name:       "unknown value reads as http",
+			trusted:    "127.0.0.1/32",
+			remoteAddr: "127.0.0.1:12345",
+			proto:      "gopher",
+			want:       "http",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/anything", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.proto != "" {
+				req.Header.Set("X-Forwarded-Proto", tt.proto)
+			}
+			if tt.tls {
+				req.TLS = &tls.ConnectionState{}
+			}
+			if got, want := Scheme(req, mustCIDRs(t, tt.trusted)), tt.want; got != want {
+				t.Errorf("Scheme = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ var (
 	ExportLogRequests       = logRequests
 	ExportRecoverPanic      = recoverPanic
 	ExportRequestLogger     = requestLogger
+	ExportForwardedClient   = forwardedClient
 	ExportLoggerFrom        = loggerFrom
 	ExportSameOriginCheck   = sameOriginCheck
 	ExportOriginFromBaseURL = originFromBaseURL
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+)
+
+// NewHTTPSRedirect returns the handler the optional plain-HTTP listener serves
+// (HTTP_REDIRECT_PORT): every request is permanently redirected to the same
+// path and query under baseURL. The target host always comes from baseURL,
+// never from the request's Host header, so a forged header cannot bounce a
+// visitor to another site. 308 keeps the method, so a form posted to the
+// http:// address is re-posted rather than silently turned into a GET.
+func NewHTTPSRedirect(baseURL string) http.Handler {
+	base := strings.TrimRight(baseURL, "/")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, base+r.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
@@ -0,0 +1,31 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/server"
+)
+
+// TestNewHTTPSRedirect pins that the plain-HTTP listener keeps the path and
+// query, takes the host from BASE_URL rather than the forged Host header, and
+// answers 308 so a POST stays a POST.
+func TestNewHTTPSRedirect(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHTTPSRedirect("https://quiz.example.com/")
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		req := httptest.NewRequestWithContext(t.Context(), method, "http://evil.example/join?code=ABC234", nil)
+		req.Host = "evil.example"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if got, want := rec.Code, http.StatusPermanentRedirect; got != want {
+			t.Errorf("%s status = %d, want %d", method, got, want)
+		}
+		if got, want := rec.Header().Get("Location"), "https://quiz.example.com/join?code=ABC234"; got != want {
+			t.Errorf("%s Location = %q, want %q", method, got, want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package server
+
+import (
+	"github.com/starquake/topbanana/internal/config"
+	"github.com/starquake/topbanana/internal/media"
+)
+
+// NewMediaStorage returns the media file storage MEDIA_STORAGE selects: the
+// local MediaDir by default, or the configured S3-compatible bucket. Parse
+// has already validated the S3 settings, so building it cannot fail.
+func NewMediaStorage(cfg *config.Config) media.Storage {
+	if cfg.MediaStorage != config.MediaStorageS3 {
+		return media.NewLocalStorage(cfg.MediaDir)
+	}
+
+	return media.NewS3Storage(media.S3Config{
+		Endpoint:        cfg.MediaS3Endpoint,
+		Region:          cfg.MediaS3Region,
+		Bucket:          cfg.MediaS3Bucket,
+		AccessKeyID:     cfg.MediaS3AccessKeyID,
+		SecretAccessKey: cfg.MediaS3SecretAccessKey,
+		PathStyle:       cfg.MediaS3PathStyle,
+		PublicURL:       cfg.MediaS3PublicURL,
+	})
+}
@@ -6,11 +6,13 @@ import (
 	"encoding/hex"
 	"errors"
 	"log/slog"
+	"net"
 	"net/http"
 	"runtime/debug"
 	"time"
 
 	"github.com/starquake/topbanana/internal/handlers"
+	"github.com/starquake/topbanana/internal/request"
 )
 
 // loggerFrom returns the request-scoped logger stashed on ctx by
@@ -53,6 +55,83 @@ func requestLogger(base *slog.Logger, next http.Handler) http.Handler {
 	})
 }
 
+// schemeHTTPS is the scheme [request.Scheme] reports for a request that
+// reached us (or the trusted proxy in front of us) over TLS.
+const schemeHTTPS = "https"
+
+// forwardedClient resolves the real client of each request once, honouring
+// X-Forwarded-For and X-Forwarded-Proto only from the trusted proxy CIDRs
+// (see [request.ClientIP] and [request.Scheme]), and binds the client address
+// and scheme onto the request-scoped logger so the access log and every
+// handler line name the visitor rather than the proxy. When the client used
+// HTTPS but the cookie policy is not Secure (a development server behind a
+// TLS tunnel), every cookie the handler sets is upgraded to Secure on the way
+// out. Mount it just inside requestLogger, whose logger it extends.
+func forwardedClient(trusted []*net.IPNet, secureCookies bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scheme := request.Scheme(r, trusted)
+			reqLogger := loggerFrom(r.Context()).With(
+				slog.String("clientIp", request.ClientIP(r, trusted)),
+				slog.String("scheme", scheme),
+			)
+			if scheme == schemeHTTPS && !secureCookies {
+				w = &secureCookieWriter{ResponseWriter: w}
+			}
+			next.ServeHTTP(w, r.WithContext(handlers.WithLogger(r.Context(), reqLogger)))
+		})
+	}
+}
+
+// secureCookieWriter adds the Secure attribute to every Set-Cookie header
+// just before the response headers go out.
+type secureCookieWriter struct {
+	http.ResponseWriter
+
+	sealed bool
+}
+
+func (w *secureCookieWriter) WriteHeader(code int) {
+	w.seal()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *secureCookieWriter) Write(b []byte) (int, error) {
+	w.seal()
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush seals the headers before flushing, since a flush commits them just
+// like the first Write.
+func (w *secureCookieWriter) Flush() {
+	w.seal()
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *secureCookieWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// seal rewrites the pending Set-Cookie headers once, the first time the
+// headers are about to be committed. A header that does not parse is left
+// as it is.
+func (w *secureCookieWriter) seal() {
+	if w.sealed {
+		return
+	}
+	w.sealed = true
+	cookies := w.Header()["Set-Cookie"]
+	for i, raw := range cookies {
+		c, err := http.ParseSetCookie(raw)
+		if err != nil || c.Secure {
+			continue
+		}
+		c.Secure = true
+		cookies[i] = c.String()
+	}
+}
+
 type responseWriter struct {
 	http.ResponseWriter
 
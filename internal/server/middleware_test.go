@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -211,6 +212,93 @@ func TestRequestLogger_DistinctIDsPerRequest(t *testing.T) {
 	}
 }
 
+// TestForwardedClient_BindsClientOnLogger pins that a request relayed by a
+// trusted proxy logs the visitor's address and scheme from the forwarded
+// headers, while the same headers from an untrusted peer are ignored.
+func TestForwardedClient_BindsClientOnLogger(t *testing.T) {
+	t.Parallel()
+
+	_, trusted, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatalf("ParseCIDR err = %v, want nil", err)
+	}
+	tests := []struct {
+		name       string
+		remoteAddr string
+		wantIP     string
+		wantScheme string
+	}{
+		{name: "trusted proxy", remoteAddr: "10.1.2.3:4000", wantIP: "203.0.113.7", wantScheme: "https"},
+		{name: "untrusted peer", remoteAddr: "198.51.100.9:4000", wantIP: "198.51.100.9", wantScheme: "http"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logs := newCaptureHandler()
+			inner := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				ExportLoggerFrom(r.Context()).InfoContext(r.Context(), "handler line")
+			})
+			handler := withReqLogger(slog.New(logs), ExportForwardedClient([]*net.IPNet{trusted}, true)(inner))
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/proxied", nil)
+			req.RemoteAddr = tt.remoteAddr
+			req.Header.Set("X-Forwarded-For", "203.0.113.7")
+			req.Header.Set("X-Forwarded-Proto", "https")
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			attrs := logs.attrsFor(t, "handler line")
+			if got, want := attrs["clientIp"].String(), tt.wantIP; got != want {
+				t.Errorf("clientIp = %q, want %q", got, want)
+			}
+			if got, want := attrs["scheme"].String(), tt.wantScheme; got != want {
+				t.Errorf("scheme = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// TestForwardedClient_SecuresCookiesOverProxiedHTTPS pins the cookie upgrade:
+// with a non-Secure cookie policy (development), a cookie set on a request
+// the trusted proxy received over HTTPS still goes out Secure, and one set
+// on a plain-HTTP request is left alone.
+func TestForwardedClient_SecuresCookiesOverProxiedHTTPS(t *testing.T) {
+	t.Parallel()
+
+	_, trusted, err := net.ParseCIDR("127.0.0.1/32")
+	if err != nil {
+		t.Fatalf("ParseCIDR err = %v, want nil", err)
+	}
+	handler := ExportForwardedClient([]*net.IPNet{trusted}, false)(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "v", Path: "/", HttpOnly: true})
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+
+	for _, tt := range []struct {
+		proto      string
+		wantSecure bool
+	}{
+		{proto: "https", wantSecure: true},
+		{proto: "http", wantSecure: false},
+	} {
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+		req.RemoteAddr = "127.0.0.1:4000"
+		req.Header.Set("X-Forwarded-Proto", tt.proto)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		cookies := rec.Result().Cookies()
+		if got, want := len(cookies), 1; got != want {
+			t.Fatalf("proto %s: len(cookies) = %d, want %d", tt.proto, got, want)
+		}
+		if got := cookies[0]; got.Secure != tt.wantSecure || !got.HttpOnly || got.Value != "v" {
+			t.Errorf("proto %s: cookie = %+v, want Secure=%v with HttpOnly and value kept", tt.proto, got, tt.wantSecure)
+		}
+	}
+}
+
 // TestLoggerFrom_FallsBackToDefault pins that loggerFrom on a context with no
 // request-scoped logger returns a usable logger rather than nil, so a handler
 // invoked outside the middleware chain still logs.
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"expvar"
 	"log/slog"
 	"net"
 	"net/http"
@@ -10,12 +11,14 @@ import (
 	"github.com/starquake/topbanana/internal/assets"
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/bgtasks"
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/client"
 	"github.com/starquake/topbanana/internal/clientapi"
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/demo"
 	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/handlers"
 	"github.com/starquake/topbanana/internal/health"
 	"github.com/starquake/topbanana/internal/home"
 	"github.com/starquake/topbanana/internal/host"
@@ -25,8 +28,10 @@ import (
 	"github.com/starquake/topbanana/internal/media"
 	"github.com/starquake/topbanana/internal/mediahttp"
 	"github.com/starquake/topbanana/internal/profile"
+	"github.com/starquake/topbanana/internal/ratelimit"
 	"github.com/starquake/topbanana/internal/session"
 	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/tournament"
 )
 
 func addRoutes(
@@ -37,8 +42,10 @@ func addRoutes(
 	realtime Realtime,
 	cfg *config.Config,
 	mail Mail,
+	brand *branding.Service,
 ) {
 	sessions := session.New([]byte(cfg.SessionKey), cfg.SecureCookies())
+	sessions.Track(session.NewTracker(stores.Devices, logger, cfg.TrustedProxyCIDRs))
 	csrfMgr := csrf.New([]byte(cfg.SessionKey), cfg.SecureCookies())
 
 	emailDeps := adminEmailDeps{
@@ -62,10 +69,13 @@ func addRoutes(
 		mailConfigured:        mail.Status.Configured,
 		tasks:                 mail.Tasks,
 		loginApprovalRequired: cfg.LoginApprovalRequired,
+		branding:              brand,
 	}
+	tournamentService := tournament.NewService(stores.Tournaments, gameService, logger)
 	gameDeps := adminGameDeps{
-		gameService:  gameService,
-		runningGames: realtime.SessionService,
+		gameService:       gameService,
+		runningGames:      realtime.SessionService,
+		tournamentService: tournamentService,
 		uploadLimits: admin.MediaUploadLimits{
 			ImageMaxBytes:     cfg.MediaImageMaxBytes,
 			AudioMaxBytes:     mediahttp.ClampSingleUploadBytes(cfg.MediaAudioMaxBytes),
@@ -73,20 +83,50 @@ func addRoutes(
 		},
 	}
 
-	addAuthRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail)
+	// limits collects every player-facing rate limiter as it is built so
+	// GET /api/limits can report the caller's standing against each.
+	limits := ratelimit.NewRegistry()
+	addAuthRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail, limits)
 	if cfg.DemoMode {
 		mux.Handle("POST /demo/enter", demo.HandleEnter(sessions, stores.Players, logger))
 	}
-	mediaSvc := media.NewService(stores.Media, cfg.MediaDir, cfg.MediaImageMaxBytes, cfg.MediaAudioMaxBytes, logger)
+	mediaStorage := NewMediaStorage(cfg)
+	mediaSvc := media.NewService(stores.Media, mediaStorage, cfg.MediaImageMaxBytes, cfg.MediaAudioMaxBytes, logger)
 	gameDeps.mediaSvc = mediaSvc
 	addAdminRoutes(mux, logger, stores, gameDeps, sessions, csrfMgr, emailDeps, playerDeps)
-	addMediaRoutes(mux, logger, stores, sessions, csrfMgr, mediaSvc, cfg)
+	addMediaRoutes(mux, logger, stores, sessions, csrfMgr, mediaSvc, cfg, limits)
 	if cfg.ProfileEnabled {
 		addProfileRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail)
 	}
-	addAPIRoutes(mux, logger, stores, gameService, realtime, sessions, cfg)
+	addAPIRoutes(mux, logger, stores, gameService, tournamentService, realtime, sessions, cfg, limits)
 	addHostRoutes(mux, logger, stores, sessions, csrfMgr, realtime.SessionService, cfg.BaseURL)
-	addClientAndPublicRoutes(mux, logger, stores, sessions, csrfMgr, cfg)
+	addClientAndPublicRoutes(mux, logger, stores, sessions, csrfMgr, cfg, realtime.Drain, mediaStorage)
+	addHealthDetailRoute(mux, logger, stores, sessions, realtime)
+	addAdminAPIRoutes(mux, logger, stores, cfg.AdminAPIToken)
+}
+
+// addAdminAPIRoutes registers the programmatic authoring API, gated on the
+// ADMIN_API_TOKEN bearer token rather than a session: its clients are
+// content pipelines, not browsers, so there is no cookie and no CSRF token.
+// With no token configured every route 404s. The API is new, so it always
+// answers in the enveloped shape.
+func addAdminAPIRoutes(mux *http.ServeMux, logger *slog.Logger, stores *store.Stores, token string) {
+	mux.Handle("PUT /api/admin/quizzes/{slug}/questions/{externalRef}", handlers.WithAPIShapes(
+		auth.RequireAPIToken(admin.HandleQuestionUpsert(logger, stores.Quizzes, stores.Audit), token), false,
+	))
+}
+
+// addHealthDetailRoute registers GET /healthz/detail, the component-level
+// health report for dashboards and alerting. Unlike the probes it names
+// versions, counts and free bytes, so it sits behind the same admin gate as
+// /admin/metrics.
+func addHealthDetailRoute(
+	mux *http.ServeMux, logger *slog.Logger, stores *store.Stores, sessions *session.Manager, realtime Realtime,
+) {
+	detail := health.HandleHealthzDetail(logger, stores, realtime.Drain, healthDetailSources(realtime))
+	mux.Handle("GET /healthz/detail", auth.RequireAdmin(
+		auth.RequireVerifiedEmail(detail), stores.Players, sessions, logger,
+	))
 }
 
 // addClientAndPublicRoutes registers the player SPA shell, static assets, PWA
@@ -100,6 +140,8 @@ func addClientAndPublicRoutes(
 	sessions *session.Manager,
 	csrfMgr *csrf.Manager,
 	cfg *config.Config,
+	drain *health.Drain,
+	mediaStorage media.Storage,
 ) {
 	// Client
 	shell := client.NewShellHandlers(cfg, stores.Quizzes, logger)
@@ -126,7 +168,14 @@ func addClientAndPublicRoutes(
 	mux.Handle("GET /sw.js", assets.ServiceWorkerHandler(cfg))
 
 	// Health
-	mux.Handle("GET /healthz", health.HandleHealthz(logger, stores))
+	mux.Handle("GET /healthz", health.HandleHealthz(logger, stores, drain))
+	// Split probes: /livez only says the process is up (fail = restart it),
+	// /readyz says whether it can serve (fail = keep traffic away). Media
+	// storage is optional: without it uploads fail but games still play.
+	mux.Handle("GET /livez", health.HandleLivez(logger))
+	mux.Handle("GET /readyz", health.HandleReadyz(logger, stores, drain,
+		health.Check{Name: "media", Run: mediaStorage.Ping, Optional: true},
+	))
 
 	// Build stamp (#663). Public + side-effect free so uptime checks and
 	// humans can read which release + commit is live without auth.
@@ -163,6 +212,7 @@ func addEmailFlowRoutes(
 	csrfMgr *csrf.Manager,
 	cfg *config.Config,
 	mail Mail,
+	limits *ratelimit.Registry,
 ) {
 	csrfMW := csrfMgr.Middleware
 
@@ -187,6 +237,7 @@ func addEmailFlowRoutes(
 	// in-session resend must not throttle the public self-service form,
 	// and vice versa. Both share the same window via VerifyResendCooldown.
 	resendLimiter := auth.NewVerifyResendLimiter(auth.VerifyResendCooldown(), cfg.TrustedProxyCIDRs)
+	limits.Register("verifyEmailResend", perIPProbe(resendLimiter.ClientIP, resendLimiter.Status))
 	mux.Handle("GET /verify-email/pending", auth.HandleVerifyPending(
 		logger, csrfMgr, stores.Players, sessions, verifyFlash,
 	))
@@ -200,6 +251,7 @@ func addEmailFlowRoutes(
 		auth.VerifyRequestFlashCookieName, auth.VerifyRequestFlashCookiePath,
 	)
 	verifyRequestLimiter := auth.NewVerifyResendLimiter(auth.VerifyResendCooldown(), cfg.TrustedProxyCIDRs)
+	limits.Register("verifyEmailRequest", perIPProbe(verifyRequestLimiter.ClientIP, verifyRequestLimiter.Status))
 	mux.Handle("GET /verify-email/request", auth.HandleVerifyEmailRequestForm(
 		logger, csrfMgr, stores.Players, sessions, verifyRequestFlash,
 	))
@@ -219,7 +271,7 @@ func addEmailFlowRoutes(
 	// Without SMTP the reset email can never send, so mounting these would
 	// dead-end every user; gate them like the Google-login routes (#1170).
 	if cfg.SMTPConfigured() {
-		addPasswordResetRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail)
+		addPasswordResetRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail, limits)
 	}
 
 	mux.Handle("GET /accept-invite", auth.HandleAcceptInviteForm(logger, csrfMgr, stores.Invites))
@@ -246,6 +298,7 @@ func addPasswordResetRoutes(
 	csrfMgr *csrf.Manager,
 	cfg *config.Config,
 	mail Mail,
+	limits *ratelimit.Registry,
 ) {
 	csrfMW := csrfMgr.Middleware
 	forgotFlash := auth.NewSignedFlash(
@@ -253,6 +306,7 @@ func addPasswordResetRoutes(
 		auth.ForgotFlashCookieName, auth.ForgotFlashCookiePath,
 	)
 	forgotLimiter := auth.NewVerifyResendLimiter(auth.ForgotPasswordCooldown(), cfg.TrustedProxyCIDRs)
+	limits.Register("forgotPassword", perIPProbe(forgotLimiter.ClientIP, forgotLimiter.Status))
 	mux.Handle("GET /forgot-password", auth.HandleForgotForm(
 		logger, csrfMgr, stores.Players, sessions, forgotFlash,
 	))
@@ -275,6 +329,22 @@ func addPasswordResetRoutes(
 	)))
 }
 
+// perIPProbe adapts a per-IP limiter's bucket resolver and Status method to a
+// [ratelimit.Probe] for the /api/limits registry.
+func perIPProbe(clientIP func(*http.Request) string, status func(string) ratelimit.Status) ratelimit.Probe {
+	return func(r *http.Request, _ int64) ratelimit.Status {
+		return status(clientIP(r))
+	}
+}
+
+// perPlayerProbe adapts a per-player limiter's Status method to a
+// [ratelimit.Probe] for the /api/limits registry.
+func perPlayerProbe(status func(int64) ratelimit.Status) ratelimit.Probe {
+	return func(_ *http.Request, playerID int64) ratelimit.Status {
+		return status(playerID)
+	}
+}
+
 // homeViewerFunc returns a closure that resolves the signed-in player
 // for the home-page footer affordance. Returns nil for anonymous
 // sessions (or any lookup error) so the template falls back to the
@@ -309,6 +379,7 @@ func addAuthRoutes(
 	csrfMgr *csrf.Manager,
 	cfg *config.Config,
 	mail Mail,
+	limits *ratelimit.Registry,
 ) {
 	csrfMW := csrfMgr.Middleware
 	googleEnabled := cfg.GoogleLoginEnabled()
@@ -329,9 +400,9 @@ func addAuthRoutes(
 			)),
 		)
 	}
-	addLoginRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail, googleEnabled)
+	addLoginRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail, limits)
 
-	addEmailFlowRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail)
+	addEmailFlowRoutes(mux, logger, stores, sessions, csrfMgr, cfg, mail, limits)
 
 	if googleEnabled {
 		googleAuth := auth.NewGoogleAuthenticator(auth.GoogleConfig{
@@ -372,7 +443,9 @@ func addAuthRoutes(
 // cooldown for the verify-email send the login handler issues on an
 // unverified-but-correct attempt (#492), separate from the
 // verify-email/pending resend so a stampede on one path cannot starve
-// the other.
+// the other. Only the per-IP gap is registered with limits: the
+// per-account backoff is deliberately invisible (see auth's
+// AccountLoginLimiter), and the resend cooldown rides on a login.
 func addLoginRoutes(
 	mux *http.ServeMux,
 	logger *slog.Logger,
@@ -381,10 +454,12 @@ func addLoginRoutes(
 	csrfMgr *csrf.Manager,
 	cfg *config.Config,
 	mail Mail,
-	googleEnabled bool,
+	limits *ratelimit.Registry,
 ) {
 	csrfMW := csrfMgr.Middleware
+	googleEnabled := cfg.GoogleLoginEnabled()
 	loginLimiter := auth.NewLoginRateLimiter(cfg.LoginCooldown, cfg.TrustedProxyCIDRs)
+	limits.Register("login", perIPProbe(loginLimiter.ClientIP, loginLimiter.Status))
 	accountLoginLimiter := auth.NewAccountLoginLimiter(auth.AccountLoginThreshold(), auth.AccountLoginCooldown())
 	loginResendLimiter := auth.NewVerifyResendLimiter(auth.VerifyResendCooldown(), cfg.TrustedProxyCIDRs)
 	forgotPasswordEnabled := cfg.SMTPConfigured()
@@ -517,6 +592,9 @@ type adminPlayerDeps struct {
 	// loginApprovalRequired gates the approval status + approve action in the
 	// admin player list and detail views (#1227).
 	loginApprovalRequired bool
+	// branding backs the settings page's branding form, which reports back
+	// through flash like the rest of the page.
+	branding *branding.Service
 }
 
 // adminGameDeps bundles the game-facing deps the admin quiz routes need
@@ -531,6 +609,9 @@ type adminGameDeps struct {
 	uploadLimits admin.MediaUploadLimits
 	// mediaSvc lets the quiz-delete handler unlink a deleted quiz's files (#1174).
 	mediaSvc *media.Service
+	// tournamentService backs the tournament pages; its standings reuse
+	// gameService's per-quiz scoring.
+	tournamentService *tournament.Service
 }
 
 func addAdminRoutes(
@@ -560,6 +641,38 @@ func addAdminRoutes(
 	}
 
 	addAdminSettingsRoutes(mux, logger, csrfMgr, requireAdmin, stores, playerDeps)
+	mux.Handle("GET /admin/anomalies", requireAdmin(
+		admin.HandleAnomalies(logger, csrfMgr, gameDeps.gameService.Anomalies()),
+	))
+	mux.Handle("GET /admin/audit", requireAdmin(admin.HandleAuditLog(logger, csrfMgr, stores.Audit)))
+	mux.Handle("GET /admin/analytics", requireAdmin(admin.HandleAnalytics(logger, csrfMgr, gameDeps.gameService)))
+	addAdminRescoreRoutes(mux, logger, csrfMgr, requireAdmin, stores, gameDeps.gameService, playerDeps.flash)
+	// The process's expvar registry as JSON: runtime memstats, the
+	// db_maintenance counters the nightly maintenance job records, and the
+	// db_pool connection-pool stats.
+	mux.Handle("GET /admin/metrics", requireAdmin(expvar.Handler()))
+	mux.Handle("GET /admin/games/{gameID}", requireGameHost(
+		admin.HandleGameReview(logger, csrfMgr, gameDeps.gameService, playerDeps.flash),
+	))
+	mux.Handle(
+		"POST /admin/games/{gameID}/questions/{questionID}/void",
+		admin.MaxFormSizeMiddleware(csrfMW(requireGameHost(
+			admin.HandleGameQuestionVoid(logger, csrfMgr, gameDeps.gameService, playerDeps.flash),
+		))),
+	)
+	mux.Handle(
+		"POST /admin/games/{gameID}/results-public",
+		admin.MaxFormSizeMiddleware(csrfMW(requireGameHost(
+			admin.HandleGameResultsPublic(logger, csrfMgr, gameDeps.gameService, playerDeps.flash),
+		))),
+	)
+	mux.Handle("GET /admin/export/answers", requireAdmin(admin.HandleAnswerExport(logger, stores.AnswerExports)))
+	// The limiter is built once here so the per-Admin cool-down is
+	// process-wide.
+	mux.Handle("GET /admin/snapshot.db", requireAdmin(admin.HandleSnapshot(
+		logger, stores.Snapshots, admin.NewPerTargetLimiter(admin.SnapshotCooldown), stores.AdminPlayers,
+	)))
+	mux.Handle("GET /admin/games/{gameID}/events", requireAdmin(admin.HandleGameEvents(logger, stores.GameEvents)))
 	mux.Handle("GET /admin/players", requireAdmin(
 		admin.HandlePlayersList(logger, csrfMgr, stores.PlayerLister, playerDeps.loginApprovalRequired),
 	))
@@ -576,11 +689,21 @@ func addAdminRoutes(
 		),
 	)
 	mux.Handle("GET /admin/quizzes/new", requireGameHost(admin.HandleQuizCreate(logger, csrfMgr)))
-	mux.Handle("POST /admin/quizzes", csrfMW(requireGameHost(admin.HandleQuizSave(logger, csrfMgr, stores.Quizzes))))
+	mux.Handle("POST /admin/quizzes", csrfMW(requireGameHost(
+		admin.HandleQuizSave(logger, csrfMgr, stores.Quizzes, stores.Audit),
+	)))
 	mux.Handle("GET /admin/quizzes/import", requireGameHost(admin.HandleQuizImportForm(logger, csrfMgr)))
 	mux.Handle(
 		"POST /admin/quizzes/import",
-		csrfMW(requireGameHost(admin.HandleQuizImportSave(logger, csrfMgr, stores.Quizzes))),
+		admin.MaxImportFormMiddleware(
+			csrfMW(requireGameHost(admin.HandleQuizImportSave(logger, csrfMgr, stores.Quizzes))),
+		),
+	)
+	mux.Handle(
+		"POST /admin/quizzes/{quizID}/import",
+		admin.MaxImportFormMiddleware(
+			csrfMW(requireGameHost(admin.HandleQuizReimport(logger, csrfMgr, stores.Quizzes))),
+		),
 	)
 	mux.Handle(
 		"GET /admin/quizzes/{quizID}/edit",
@@ -588,7 +711,11 @@ func addAdminRoutes(
 	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}",
-		csrfMW(requireGameHost(admin.HandleQuizSave(logger, csrfMgr, stores.Quizzes))),
+		csrfMW(requireGameHost(admin.HandleQuizSave(logger, csrfMgr, stores.Quizzes, stores.Audit))),
+	)
+	mux.Handle(
+		"POST /admin/quizzes/{quizID}/duplicate",
+		csrfMW(requireGameHost(admin.HandleQuizDuplicate(logger, csrfMgr, stores.Quizzes))),
 	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/mode/{mode}",
@@ -596,7 +723,9 @@ func addAdminRoutes(
 	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/delete",
-		csrfMW(requireGameHost(admin.HandleQuizDelete(logger, csrfMgr, stores.Quizzes, gameDeps.mediaSvc))),
+		csrfMW(requireGameHost(
+			admin.HandleQuizDelete(logger, csrfMgr, stores.Quizzes, gameDeps.mediaSvc, stores.Audit),
+		)),
 	)
 	mux.Handle(
 		"GET /admin/quizzes/{quizID}/publish",
@@ -606,6 +735,18 @@ func addAdminRoutes(
 		"POST /admin/quizzes/{quizID}/publish",
 		csrfMW(requireGameHost(admin.HandleQuizPublish(logger, csrfMgr, stores.Quizzes))),
 	)
+	mux.Handle(
+		"GET /admin/quizzes/{quizID}/renumber",
+		requireGameHost(admin.HandleQuizRenumberPreview(logger, csrfMgr, stores.Quizzes)),
+	)
+	mux.Handle(
+		"POST /admin/quizzes/{quizID}/renumber",
+		csrfMW(requireGameHost(admin.HandleQuizRenumber(logger, csrfMgr, stores.Quizzes))),
+	)
+	mux.Handle(
+		"GET /admin/quizzes/{quizID}/print",
+		requireGameHost(admin.HandleQuizPrint(logger, csrfMgr, stores.Quizzes)),
+	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/unpublish",
 		csrfMW(requireGameHost(admin.HandleQuizUnpublish(logger, csrfMgr, stores.Quizzes))),
@@ -617,6 +758,38 @@ func addAdminRoutes(
 
 	addAdminQuestionRoutes(mux, logger, stores, csrfMW, requireGameHost, csrfMgr)
 	addAdminRoundRoutes(mux, logger, stores, csrfMW, requireGameHost, csrfMgr)
+	addAdminTournamentRoutes(mux, logger, stores, gameDeps.tournamentService, csrfMW, requireGameHost, csrfMgr)
+}
+
+// addAdminTournamentRoutes registers the tournament pages: list, create, and
+// the tournament view with its add-stage form and standings. Gated like the
+// quiz routes (requireGameHost); the view and add-stage handlers add the
+// creator-or-Admin check.
+func addAdminTournamentRoutes(
+	mux *http.ServeMux,
+	logger *slog.Logger,
+	stores *store.Stores,
+	service *tournament.Service,
+	csrfMW func(http.Handler) http.Handler,
+	requireGameHost func(http.Handler) http.Handler,
+	csrfMgr *csrf.Manager,
+) {
+	mux.Handle(
+		"GET /admin/tournaments",
+		requireGameHost(admin.HandleTournamentList(logger, csrfMgr, stores.Tournaments)),
+	)
+	mux.Handle("GET /admin/tournaments/new", requireGameHost(admin.HandleTournamentCreate(logger, csrfMgr)))
+	mux.Handle("POST /admin/tournaments", csrfMW(requireGameHost(admin.HandleTournamentSave(logger, csrfMgr, service))))
+	mux.Handle(
+		"GET /admin/tournaments/{tournamentID}",
+		requireGameHost(admin.HandleTournamentView(logger, csrfMgr, service, stores.Quizzes)),
+	)
+	mux.Handle(
+		"POST /admin/tournaments/{tournamentID}/stages",
+		csrfMW(requireGameHost(
+			admin.HandleTournamentAddStage(logger, csrfMgr, service, stores.Tournaments, stores.Quizzes),
+		)),
+	)
 }
 
 // addMediaRoutes registers the media slice's HTTP surface (#936 slice 2): the
@@ -653,6 +826,7 @@ func addMediaRoutes(
 	csrfMgr *csrf.Manager,
 	svc *media.Service,
 	cfg *config.Config,
+	limits *ratelimit.Registry,
 ) {
 	requireGameHost := func(h http.Handler) http.Handler {
 		return auth.RequireGameHost(auth.RequireVerifiedEmail(h), stores.Players, sessions, csrfMgr, logger)
@@ -666,14 +840,15 @@ func addMediaRoutes(
 	// satisfies.
 	mux.Handle(
 		"GET /admin/quizzes/{quizID}/export",
-		requireGameHost(admin.HandleQuizExport(logger, stores.Quizzes, svc)),
+		requireGameHost(admin.HandleQuizExport(logger, stores.QuizReports, svc)),
 	)
 
-	addQuizImportArchiveRoute(mux, logger, stores, csrfMgr, svc, cfg, requireGameHost)
+	addQuizImportArchiveRoute(mux, logger, stores, csrfMgr, svc, cfg, requireGameHost, limits)
 
 	// auth outermost so an unauthenticated caller is rejected before the body is
 	// spooled; the parse still precedes CSRF, which reads the token from PostForm.
 	uploadBudget := mediahttp.NewUploadBudgetLimiter(cfg.MediaUploadBudget, cfg.MediaUploadBudgetWindow)
+	limits.Register("mediaUpload", perPlayerProbe(uploadBudget.Status))
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/media",
 		requireGameHost(mediahttp.MaxMultipartFormMiddleware(csrfMgr.Middleware(
@@ -693,6 +868,21 @@ func addMediaRoutes(
 		))),
 	)
 
+	// Adding an image from a URL (picture-round authoring) is an ordinary
+	// urlencoded form (csrf_token plus the url), so it uses the form-size + CSRF
+	// path like the description edit below. The server fetches the image itself
+	// through the address-guarded ImageFetcher, then applies the upload route's
+	// edit gate, library ceiling and budget charge before storing it.
+	mux.Handle(
+		"POST /admin/quizzes/{quizID}/media/from-url",
+		admin.MaxFormSizeMiddleware(csrfMgr.Middleware(requireGameHost(
+			mediahttp.HandleMediaFromURL(
+				logger, svc, stores.Quizzes, uploadBudget, cfg.MediaQuizImageLimit,
+				mediahttp.NewImageFetcher(cfg.MediaImageMaxBytes),
+			),
+		))),
+	)
+
 	// The delete POST is an ordinary urlencoded form (only a csrf_token), not a
 	// multipart upload, so it uses the normal CSRF/form path - no
 	// MaxMultipartFormMiddleware. The handler adds the per-quiz creator-or-admin
@@ -747,8 +937,10 @@ func addQuizImportArchiveRoute(
 	svc *media.Service,
 	cfg *config.Config,
 	requireGameHost func(http.Handler) http.Handler,
+	rateLimits *ratelimit.Registry,
 ) {
 	budget := mediahttp.NewUploadBudgetLimiter(cfg.MediaImportBudget, cfg.MediaImportBudgetWindow)
+	rateLimits.Register("quizImport", perPlayerProbe(budget.Status))
 	limits := admin.NewArchiveImportLimits(cfg.MediaImageMaxBytes, cfg.MediaAudioMaxBytes, cfg.MediaImportMaxBytes)
 	mux.Handle(
 		"POST /admin/quizzes/import/archive",
@@ -774,9 +966,13 @@ func addAdminQuestionRoutes(
 		"GET /admin/quizzes/{quizID}/questions/new",
 		requireGameHost(admin.HandleQuestionCreate(logger, csrfMgr, stores.Quizzes, stores.Media)),
 	)
+	// The question form's field definitions, for external authoring tools.
+	mux.Handle("GET /admin/api/schema/question", requireGameHost(admin.HandleQuestionSchema(logger)))
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/questions",
-		csrfMW(requireGameHost(admin.HandleQuestionSave(logger, csrfMgr, stores.Quizzes, stores.Media))),
+		csrfMW(requireGameHost(
+			admin.HandleQuestionSave(logger, csrfMgr, stores.Quizzes, stores.Media, stores.Audit),
+		)),
 	)
 	mux.Handle(
 		"GET /admin/quizzes/{quizID}/questions/{questionID}/edit",
@@ -784,11 +980,17 @@ func addAdminQuestionRoutes(
 	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/questions/{questionID}",
-		csrfMW(requireGameHost(admin.HandleQuestionSave(logger, csrfMgr, stores.Quizzes, stores.Media))),
+		csrfMW(requireGameHost(
+			admin.HandleQuestionSave(logger, csrfMgr, stores.Quizzes, stores.Media, stores.Audit),
+		)),
+	)
+	mux.Handle(
+		"PUT /admin/quizzes/{quizID}/questions/{questionID}/draft",
+		csrfMW(requireGameHost(admin.HandleQuestionDraftSave(logger, csrfMgr, stores.Quizzes))),
 	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/questions/{questionID}/delete",
-		csrfMW(requireGameHost(admin.HandleQuestionDelete(logger, csrfMgr, stores.Quizzes))),
+		csrfMW(requireGameHost(admin.HandleQuestionDelete(logger, csrfMgr, stores.Quizzes, stores.Audit))),
 	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/questions/{questionID}/move/{direction}",
@@ -796,11 +998,35 @@ func addAdminQuestionRoutes(
 	)
 }
 
+// addAdminRescoreRoutes registers the Admin-only score recalculation tool: the
+// page (whose GET runs the dry run) and the apply POST, which replays a quiz's
+// stored live-session scores through gameService's scoring curve.
+func addAdminRescoreRoutes(
+	mux *http.ServeMux,
+	logger *slog.Logger,
+	csrfMgr *csrf.Manager,
+	requireAdmin func(http.Handler) http.Handler,
+	stores *store.Stores,
+	scorer livesession.Scorer,
+	flash *auth.SignedFlash,
+) {
+	rescorer := livesession.NewRescorer(stores.LiveRescores, scorer, logger)
+	mux.Handle("GET /admin/scores/recalculate", requireAdmin(
+		admin.HandleRescorePage(logger, csrfMgr, rescorer, flash),
+	))
+	mux.Handle(
+		"POST /admin/scores/recalculate",
+		admin.MaxFormSizeMiddleware(csrfMgr.Middleware(requireAdmin(
+			admin.HandleRescoreApply(logger, rescorer, flash),
+		))),
+	)
+}
+
 // addAdminSettingsRoutes registers the Admin settings page (#320/#538): the
-// GET render of the current Admins list. The page's demote buttons post to the
-// id-based role endpoint under /admin/players (#538), so there is no
-// settings-scoped POST here. Gated by requireAdmin so a signed-in non-Admin
-// gets a 404 (the route stays hidden).
+// GET render of the current Admins list and the branding form's POST. The
+// page's demote buttons post to the id-based role endpoint under
+// /admin/players (#538). Gated by requireAdmin so a signed-in non-Admin gets a
+// 404 (the route stays hidden).
 func addAdminSettingsRoutes(
 	mux *http.ServeMux,
 	logger *slog.Logger,
@@ -813,6 +1039,12 @@ func addAdminSettingsRoutes(
 		"GET /admin/settings",
 		requireAdmin(admin.HandleSettings(logger, csrfMgr, stores.AdminList, deps.flash)),
 	)
+	mux.Handle(
+		"POST /admin/settings/branding",
+		admin.MaxFormSizeMiddleware(csrfMgr.Middleware(requireAdmin(
+			admin.HandleBrandingSave(logger, deps.branding, deps.flash),
+		))),
+	)
 }
 
 // addAdminPlayerRoutes registers the admin player-management routes (#450).
@@ -874,6 +1106,12 @@ func addAdminPlayerRoutes(
 			),
 		))),
 	)
+	mux.Handle(
+		"POST /admin/players/{playerID}/sessions/revoke",
+		admin.MaxFormSizeMiddleware(csrfMW(requireAdmin(
+			admin.HandlePlayerRevokeSessions(logger, stores.AdminPlayers, stores.Devices, deps.flash),
+		))),
+	)
 	mux.Handle(
 		"POST /admin/players/{playerID}/email",
 		admin.MaxFormSizeMiddleware(csrfMW(requireAdmin(
@@ -1025,7 +1263,7 @@ func addAdminRoundRoutes(
 	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/rounds/{roundID}/delete",
-		csrfMW(requireGameHost(admin.HandleRoundDelete(logger, csrfMgr, stores.Quizzes))),
+		csrfMW(requireGameHost(admin.HandleRoundDelete(logger, csrfMgr, stores.Quizzes, stores.Audit))),
 	)
 	mux.Handle(
 		"POST /admin/quizzes/{quizID}/rounds/{roundID}/move/{direction}",
@@ -1045,6 +1283,17 @@ func addAdminRoundRoutes(
 	)
 }
 
+// Per-route Cache-Control policies for the JSON API. apiRevalidateCacheControl
+// goes with handlers.WithETag on reads that change only with their data: the
+// browser keeps the body but revalidates every poll, and an unchanged one
+// comes back as a 304. apiNoStoreCacheControl is for a body that differs on
+// every read, such as a question carrying serverNow: a revalidated copy would
+// hand the client a stale clock.
+const (
+	apiRevalidateCacheControl = "private, no-cache"
+	apiNoStoreCacheControl    = "no-store"
+)
+
 // addAPIRoutes registers the JSON API routes consumed by the game client.
 // API routes use the same session cookie as the rest of the app. CSRF
 // protection has two layers: SameSite=Lax on the session cookie (see
@@ -1058,29 +1307,77 @@ func addAdminRoundRoutes(
 // mutating request is rejected before any players row is minted. The static
 // /client/* assets are intentionally not wrapped - loading the SPA shell
 // should not create a row; the first /api/ call does.
+//
+// The game-creating and answer-submitting POSTs also honour an
+// Idempotency-Key header (clientapi.WithIdempotency). It sits inside
+// EnsurePlayer because stored responses are scoped per player.
+//
+// handlers.WithAPIShapes picks each response's shape: the data/meta/error
+// envelope, or the legacy bare shapes while cfg.APILegacyShapes is on and the
+// request has not opted in.
+//
+// The polled reads (branding, the quiz list, quiz metadata) carry a content
+// ETag and answer If-None-Match with a 304; the policy constants above pick
+// each route's Cache-Control.
+//
+//nolint:revive // argument-limit: limits is filled by the auth and media route helpers before this runs, so it cannot be built here.
 func addAPIRoutes(
 	mux *http.ServeMux,
 	logger *slog.Logger,
 	stores *store.Stores,
 	gameService *game.Service,
+	tournamentService *tournament.Service,
 	realtime Realtime,
 	sessions *session.Manager,
 	cfg *config.Config,
+	limits *ratelimit.Registry,
 ) {
 	expectedOrigin := originFromBaseURL(cfg.BaseURL)
+	// identities derives each player's generated nickname and avatar color
+	// from their id: the default display name of a fresh visitor and the
+	// avatar fields of /api/players/me. Swap the generator here to change the
+	// scheme.
+	var identities auth.IdentityGenerator = auth.AnimalIdentity{}
 	ensurePlayer := func(h http.Handler) http.Handler {
-		return sameOriginCheck(expectedOrigin, auth.EnsurePlayer(h, stores.Players, sessions, logger))
+		return sameOriginCheck(expectedOrigin, handlers.WithAPIShapes(
+			auth.EnsurePlayer(h, stores.Players, identities, sessions, logger), cfg.APILegacyShapes,
+		))
+	}
+	idempotency := clientapi.NewIdempotencyCache(clientapi.DefaultIdempotencyTTL)
+	idempotent := func(h http.Handler) http.Handler {
+		return clientapi.WithIdempotency(idempotency, h)
 	}
 
-	mux.Handle("GET /api/players/me", ensurePlayer(clientapi.HandlePlayerGetMe(logger)))
+	// The branding is public and read before any player exists, so it skips
+	// EnsurePlayer (no session is minted for it) and only takes the API shape.
+	mux.Handle("GET /api/branding", handlers.WithAPIShapes(
+		handlers.WithETag(clientapi.HandleBranding(), apiRevalidateCacheControl), cfg.APILegacyShapes,
+	))
+	mux.Handle("GET /api/players/me", ensurePlayer(clientapi.HandlePlayerGetMe(logger, identities)))
+	mux.Handle("GET /api/limits", ensurePlayer(clientapi.HandleLimits(logger, limits)))
 	mux.Handle(
 		"PATCH /api/players/me",
-		ensurePlayer(clientapi.HandlePlayerClaimName(logger, stores.Players, gameService)),
+		ensurePlayer(clientapi.HandlePlayerClaimName(logger, stores.Players, gameService, identities)),
 	)
-	mux.Handle("GET /api/quizzes", ensurePlayer(clientapi.HandleQuizList(logger, stores.Quizzes)))
+	mux.Handle("GET /api/players/me/games", ensurePlayer(clientapi.HandleGameHistory(logger, gameService)))
+	mux.Handle(
+		"GET /api/players/me/sessions",
+		ensurePlayer(clientapi.HandlePlayerSessionList(logger, stores.Devices, sessions)),
+	)
+	mux.Handle(
+		"DELETE /api/players/me/sessions",
+		ensurePlayer(clientapi.HandlePlayerSessionRevokeOthers(logger, stores.Devices, sessions)),
+	)
+	mux.Handle(
+		"DELETE /api/players/me/sessions/{sessionID}",
+		ensurePlayer(clientapi.HandlePlayerSessionRevoke(logger, stores.Devices, sessions)),
+	)
+	mux.Handle("GET /api/quizzes", ensurePlayer(
+		handlers.WithETag(clientapi.HandleQuizList(logger, stores.Quizzes), apiRevalidateCacheControl),
+	))
 	mux.Handle(
 		"GET /api/quizzes/{slugID}",
-		ensurePlayer(clientapi.HandleQuizMeta(logger, gameService)),
+		ensurePlayer(handlers.WithETag(clientapi.HandleQuizMeta(logger, gameService), apiRevalidateCacheControl)),
 	)
 	mux.Handle(
 		"GET /api/quizzes/{slugID}/leaderboard",
@@ -1088,19 +1385,23 @@ func addAPIRoutes(
 	)
 	mux.Handle(
 		"GET /api/quizzes/{slugID}/leaderboard/stream",
-		ensurePlayer(clientapi.HandleQuizLeaderboardStream(
+		ensurePlayer(realtime.Drain.EndStreams(clientapi.HandleQuizLeaderboardStream(
 			logger, gameService, realtime.LeaderboardHub,
 			realtime.LeaderboardHeartbeatInterval,
-		)),
+		))),
 	)
 	mux.Handle(
 		"GET /api/quizzes/{slugID}/my-game",
 		ensurePlayer(clientapi.HandleGameForQuiz(logger, gameService)),
 	)
-	mux.Handle("POST /api/games", ensurePlayer(clientapi.HandleCreateGame(logger, gameService)))
+	mux.Handle("POST /api/games", ensurePlayer(idempotent(clientapi.HandleCreateGame(logger, gameService))))
+	var questionNext http.Handler = clientapi.HandleQuestionNext(logger, gameService)
+	if cfg.ShuffleSeedHeader {
+		questionNext = clientapi.WithShuffleSeed(questionNext)
+	}
 	mux.Handle(
 		"GET /api/games/{gameID}/questions/next",
-		ensurePlayer(clientapi.HandleQuestionNext(logger, gameService)),
+		ensurePlayer(handlers.WithCacheControl(questionNext, apiNoStoreCacheControl)),
 	)
 	mux.Handle(
 		"GET /api/games/{gameID}/audio",
@@ -1108,17 +1409,56 @@ func addAPIRoutes(
 	)
 	mux.Handle(
 		"POST /api/games/{gameID}/questions/{questionID}/answers",
-		ensurePlayer(clientapi.HandleAnswerPost(logger, gameService)),
+		ensurePlayer(idempotent(clientapi.HandleAnswerPost(logger, gameService))),
+	)
+	mux.Handle(
+		"POST /api/games/{gameID}/questions/{questionID}/wager",
+		ensurePlayer(clientapi.HandleWagerPost(logger, gameService)),
+	)
+	mux.Handle(
+		"POST /api/games/{gameID}/questions/{questionID}/pause-once",
+		ensurePlayer(clientapi.HandlePauseOncePost(logger, gameService)),
+	)
+	mux.Handle(
+		"POST /api/games/{gameID}/questions/{questionID}/hint",
+		ensurePlayer(clientapi.HandleHintPost(logger, gameService)),
 	)
 	mux.Handle(
 		"POST /api/games/{gameID}/rounds/{roundID}/seen/{phase}",
 		ensurePlayer(clientapi.HandleRoundSeen(logger, gameService)),
 	)
+	mux.Handle("POST /api/games/{gameID}/finish", ensurePlayer(clientapi.HandleFinishGame(logger, gameService)))
 	mux.Handle("GET /api/games/{gameID}/results", ensurePlayer(clientapi.HandleGameResults(logger, gameService)))
+	mux.Handle(
+		"GET /api/games/{gameID}/results/compare",
+		ensurePlayer(clientapi.HandleCompareResults(logger, gameService)),
+	)
+	mux.Handle("POST /api/games/{gameID}/reveal", ensurePlayer(clientapi.HandleRevealScores(logger, gameService)))
+	mux.Handle(
+		"GET /api/games/{gameID}/events",
+		ensurePlayer(realtime.Drain.EndStreams(clientapi.HandleGameEventsStream(
+			logger, gameService, realtime.LeaderboardHub,
+			realtime.LeaderboardHeartbeatInterval,
+		))),
+	)
+	// Like the branding, public standings skip EnsurePlayer: they are read
+	// without a session, and the host opts the game in (display names only).
+	mux.Handle(
+		"GET /api/games/{gameID}/results/public",
+		handlers.WithAPIShapes(clientapi.HandlePublicResults(logger, gameService), cfg.APILegacyShapes),
+	)
+	mux.Handle(
+		"POST /api/tournaments/{code}/join",
+		ensurePlayer(clientapi.HandleTournamentJoin(tournamentService)),
+	)
+	mux.Handle(
+		"GET /api/tournaments/{code}/standings",
+		ensurePlayer(clientapi.HandleTournamentStandings(tournamentService)),
+	)
 
 	addSessionRoutes(
 		mux, realtime.SessionService, realtime.SessionHub,
-		realtime.SessionEventHeartbeatInterval, ensurePlayer,
+		realtime.SessionEventHeartbeatInterval, realtime.Drain, ensurePlayer, idempotent,
 	)
 }
 
@@ -1130,13 +1470,15 @@ func addAPIRoutes(
 // every transition and the events handler subscribes. Every route is wrapped
 // in ensurePlayer so create (host gate, in-handler), join, ready, start,
 // answer, state, and events all see a players row on the context; the host
-// gate and participant gates live in the handlers and service.
+// gate and participant gates live in the handlers and service. The SSE
+// event channel ends when drain starts, so it does not hold a shutdown open.
 func addSessionRoutes(
 	mux *http.ServeMux,
 	sessionService *livesession.Service,
 	sessionHub *livesession.Hub,
 	heartbeatInterval time.Duration,
-	ensurePlayer func(http.Handler) http.Handler,
+	drain *health.Drain,
+	ensurePlayer, idempotent func(http.Handler) http.Handler,
 ) {
 	mux.Handle("POST /api/sessions", ensurePlayer(clientapi.HandleSessionCreate(sessionService)))
 	mux.Handle("POST /api/sessions/{code}/join", ensurePlayer(clientapi.HandleSessionJoin(sessionService)))
@@ -1150,20 +1492,41 @@ func addSessionRoutes(
 		"POST /api/sessions/{code}/cancel-start",
 		ensurePlayer(clientapi.HandleSessionCancelStart(sessionService)),
 	)
-	mux.Handle("POST /api/sessions/{code}/answer", ensurePlayer(clientapi.HandleSessionAnswer(sessionService)))
+	mux.Handle(
+		"POST /api/sessions/{code}/questions/{questionID}/extend",
+		ensurePlayer(idempotent(clientapi.HandleSessionExtendQuestion(sessionService))),
+	)
+	mux.Handle(
+		"POST /api/sessions/{code}/questions/close",
+		ensurePlayer(clientapi.HandleSessionCloseAnswers(sessionService)),
+	)
+	mux.Handle("POST /api/sessions/{code}/advance", ensurePlayer(clientapi.HandleSessionAdvance(sessionService)))
+	mux.Handle("POST /api/sessions/{code}/pacing", ensurePlayer(clientapi.HandleSessionPacing(sessionService)))
+	mux.Handle(
+		"POST /api/sessions/{code}/questions/reorder",
+		ensurePlayer(clientapi.HandleSessionReorderQuestions(sessionService)),
+	)
+	mux.Handle(
+		"POST /api/sessions/{code}/questions/{questionID}/skip",
+		ensurePlayer(clientapi.HandleSessionSkipQuestion(sessionService)),
+	)
+	mux.Handle(
+		"POST /api/sessions/{code}/answer",
+		ensurePlayer(idempotent(clientapi.HandleSessionAnswer(sessionService))),
+	)
 	mux.Handle("POST /api/sessions/{code}/leave", ensurePlayer(clientapi.HandleSessionLeave(sessionService)))
 	mux.Handle("GET /api/sessions/{code}/state", ensurePlayer(clientapi.HandleSessionState(sessionService)))
 	mux.Handle("GET /api/sessions/{code}/audio", ensurePlayer(clientapi.HandleSessionAudio(sessionService)))
 	mux.Handle(
 		"GET /api/sessions/{code}/events",
-		ensurePlayer(clientapi.HandleSessionEvents(sessionService, sessionHub, heartbeatInterval)),
+		ensurePlayer(drain.EndStreams(clientapi.HandleSessionEvents(sessionService, sessionHub, heartbeatInterval))),
 	)
 }
 
 // addHostRoutes registers the host presentation surface (MP-3 / #680): the
 // "Host live" entry that opens a session and the big screen it redirects to,
-// plus the host start control. All three are host-gated (RequireGameHost)
-// and the mutating POSTs carry CSRF protection. The big screen reads live state
+// plus the host controls (start, next quiz, end, player notes). All are
+// host-gated (RequireGameHost) and the mutating POSTs carry CSRF protection. The big screen reads live state
 // through the JSON API the page polls (SSE tick -> GET /state); the host
 // handlers reuse the shared session service so the page and the API see the
 // same in-memory session.
@@ -1196,4 +1559,26 @@ func addHostRoutes(
 	mux.Handle("POST /host/{code}/start", csrfMW(requireGameHost(http.HandlerFunc(handlers.Start))))
 	mux.Handle("POST /host/{code}/next-quiz", csrfMW(requireGameHost(http.HandlerFunc(handlers.NextQuiz))))
 	mux.Handle("POST /host/{code}/end", csrfMW(requireGameHost(http.HandlerFunc(handlers.End))))
+	mux.Handle(
+		"POST /host/{code}/players/{playerID}/notes",
+		admin.MaxFormSizeMiddleware(csrfMW(requireGameHost(http.HandlerFunc(handlers.PlayerNotes)))),
+	)
+}
+
+// healthDetailSources collects the realtime components /healthz/detail
+// reports on, leaving out any the wiring did not supply (tests build a
+// Realtime without a runner or hubs).
+func healthDetailSources(realtime Realtime) health.DetailSources {
+	src := health.DetailSources{Hubs: make(map[string]health.SubscriberCounter, 2)}
+	if realtime.SessionRunner != nil {
+		src.Runner = realtime.SessionRunner
+	}
+	if realtime.LeaderboardHub != nil {
+		src.Hubs["leaderboard"] = realtime.LeaderboardHub
+	}
+	if realtime.SessionHub != nil {
+		src.Hubs["sessions"] = realtime.SessionHub
+	}
+
+	return src
 }
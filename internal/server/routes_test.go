@@ -14,6 +14,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/csrf"
 	"github.com/starquake/topbanana/internal/dbtest"
@@ -51,7 +52,7 @@ func newRouter(t *testing.T, db *sql.DB, cfg *config.Config) *http.ServeMux {
 	}
 	ExportAddRoutes(
 		mux, logger, stores, gameSvc, realtime, cfg,
-		Mail{Tester: mailer.NewTester(mailer.NewNoop())},
+		Mail{Tester: mailer.NewTester(mailer.NewNoop())}, branding.NewService(stores.Branding, logger),
 	)
 
 	return mux
@@ -110,17 +111,21 @@ func createGame(t *testing.T, client *http.Client, baseURL string, quizID int64)
 		t.Fatalf("POST /api/games status = %d, want %d, body=%q", got, want, raw)
 	}
 
+	// The test config leaves APILegacyShapes off, so the response is the
+	// data envelope.
 	var created struct {
-		ID string `json:"id"`
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
 	}
 	if derr := json.NewDecoder(resp.Body).Decode(&created); derr != nil {
 		t.Fatalf("decode create-game response err = %v, want nil", derr)
 	}
-	if created.ID == "" {
+	if created.Data.ID == "" {
 		t.Fatal("create-game response carried an empty game id")
 	}
 
-	return created.ID
+	return created.Data.ID
 }
 
 // TestAddRoutes_RegisteredRoutesDoNot404 drives every registered route through
@@ -170,6 +175,7 @@ func TestAddRoutes_RegisteredRoutesDoNot404(t *testing.T) {
 		{name: "API Game Create", method: http.MethodPost, path: "/api/games"},
 		{name: "API Question Next", method: http.MethodGet, path: "/api/games/" + gameID + "/questions/next"},
 		{name: "API Answer Post", method: http.MethodPost, path: "/api/games/" + gameID + "/questions/1/answers"},
+		{name: "API Wager Post", method: http.MethodPost, path: "/api/games/" + gameID + "/questions/1/wager"},
 		{name: "API Game Results", method: http.MethodGet, path: "/api/games/" + gameID + "/results"},
 
 		{name: "Admin Quiz Save (create)", method: http.MethodPost, path: "/admin/quizzes"},
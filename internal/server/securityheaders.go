@@ -25,16 +25,13 @@ const contentSecurityPolicy = `default-src 'self'; ` +
 	`base-uri 'none'; ` +
 	`frame-ancestors 'none'`
 
-// strictTransportSecurity is the HSTS value applied only when cookies are
-// Secure (any non-development env). Browsers ignore HSTS over HTTP, so gating
-// avoids pinning a dev laptop that serves plain HTTP.
-const strictTransportSecurity = "max-age=31536000; includeSubDomains"
-
 // securityHeaders sets the sitewide security response headers. Wire it as the
 // innermost wrapper so the headers are on w.Header() before any handler writes
 // the response, including recoverPanic's 500 on a handler panic (the headers
-// stay on the header map across the unwind). HSTS is gated on SecureCookies so
-// a development server reachable over plain HTTP does not pin itself.
+// stay on the header map across the unwind). The HSTS value comes from
+// [config.Config.StrictTransportSecurity], which is empty whenever cookies are
+// not Secure so a development server reachable over plain HTTP does not pin
+// itself.
 func securityHeaders(cfg *config.Config) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -48,8 +45,8 @@ func securityHeaders(cfg *config.Config) func(http.Handler) http.Handler {
 			// response header: nothing is emitted for the key, and any value an
 			// earlier layer set is cleared.
 			h["Server"] = nil
-			if cfg.SecureCookies() {
-				h.Set("Strict-Transport-Security", strictTransportSecurity)
+			if hsts := cfg.StrictTransportSecurity(); hsts != "" {
+				h.Set("Strict-Transport-Security", hsts)
 			}
 			next.ServeHTTP(w, r)
 		})
@@ -4,15 +4,19 @@ package server
 import (
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/starquake/topbanana/internal/bgtasks"
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/health"
 	"github.com/starquake/topbanana/internal/leaderboard"
 	"github.com/starquake/topbanana/internal/livesession"
 	"github.com/starquake/topbanana/internal/mailer"
 	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/tracing"
 )
 
 // Realtime bundles the process-local pub/sub and live-session deps so they
@@ -21,7 +25,13 @@ import (
 // is the SSE leaderboard stream's hub (the same instance wired into the game
 // service via SetLeaderboardPublisher). SessionService + SessionHub are the
 // hosted live-session service and its SSE tick hub; the same instances the
-// runner goroutine publishes through (MP-5 / #682).
+// runner goroutine publishes through (MP-5 / #682). SessionRunner is that
+// runner, read only for its heartbeat on /healthz/detail; nil leaves the
+// runner out of the report.
+//
+// Drain is the process's shutdown state: once it starts, /healthz answers
+// 503 and both SSE streams end, so an open stream does not hold a graceful
+// shutdown until its timeout. Nil never drains.
 //
 // LeaderboardHeartbeatInterval and SessionEventHeartbeatInterval are how
 // often the two SSE handlers emit a no-op comment frame on an otherwise
@@ -33,8 +43,10 @@ type Realtime struct {
 	LeaderboardHub                *leaderboard.Hub
 	SessionService                *livesession.Service
 	SessionHub                    *livesession.Hub
+	SessionRunner                 *livesession.Runner
 	LeaderboardHeartbeatInterval  time.Duration
 	SessionEventHeartbeatInterval time.Duration
+	Drain                         *health.Drain
 }
 
 // Mail bundles the mailer deps so they travel as one argument through
@@ -53,7 +65,8 @@ type Mail struct {
 
 // New creates a new server. realtime carries the process-local pub/sub hubs
 // and the live-session service. mail bundles the mailer wiring plus the
-// background-task tracker shutdown drains.
+// background-task tracker shutdown drains. tracer opens a span per request;
+// nil disables tracing.
 func New(
 	logger *slog.Logger,
 	stores *store.Stores,
@@ -61,10 +74,85 @@ func New(
 	realtime Realtime,
 	cfg *config.Config,
 	mail Mail,
+	tracer *tracing.Tracer,
 ) http.Handler {
+	mux, brand := newMux(logger, stores, gameService, realtime, cfg, mail)
+
+	return withMiddleware(mux, logger, cfg, brand, tracer)
+}
+
+// NewSplit creates the two handlers for a server whose admin UI has its own
+// listener (ADMIN_PORT). Both serve one set of routes, so the hubs, stores and
+// CSRF keys behind them are shared, but each gets its own middleware stack.
+// The public handler answers /admin and everything under it with 404; the
+// admin handler serves the whole app, so /login, the static assets and the
+// links an admin page makes back to the game all work from the admin
+// address. The arguments are those of [New].
+func NewSplit(
+	logger *slog.Logger,
+	stores *store.Stores,
+	gameService *game.Service,
+	realtime Realtime,
+	cfg *config.Config,
+	mail Mail,
+	tracer *tracing.Tracer,
+) (http.Handler, http.Handler) {
+	mux, brand := newMux(logger, stores, gameService, realtime, cfg, mail)
+
+	public := withMiddleware(hideAdmin(mux), logger, cfg, brand, tracer)
+	adminHandler := withMiddleware(mux, logger, cfg, brand, tracer)
+
+	return public, adminHandler
+}
+
+// newMux registers every route on a fresh mux and returns it with the
+// branding service the middleware stack reads from.
+func newMux(
+	logger *slog.Logger,
+	stores *store.Stores,
+	gameService *game.Service,
+	realtime Realtime,
+	cfg *config.Config,
+	mail Mail,
+) (*http.ServeMux, *branding.Service) {
 	mux := http.NewServeMux()
-	addRoutes(mux, logger, stores, gameService, realtime, cfg, mail)
-	var handler http.Handler = mux
+	brand := branding.NewService(stores.Branding, logger)
+	addRoutes(mux, logger, stores, gameService, realtime, cfg, mail, brand)
+
+	return mux, brand
+}
+
+// hideAdmin answers /admin and every path under it with 404 instead of
+// passing the request to next. It fronts the public listener once the admin
+// UI has moved to its own, so the public address does not even confirm the
+// admin routes exist.
+func hideAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/admin" || strings.HasPrefix(r.URL.Path, "/admin/") {
+			http.NotFound(w, r)
+
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withMiddleware wraps handler in the middleware stack every listener
+// serves through.
+func withMiddleware(
+	handler http.Handler,
+	logger *slog.Logger,
+	cfg *config.Config,
+	brand *branding.Service,
+	tracer *tracing.Tracer,
+) http.Handler {
+	// The tracing middleware wraps the mux directly: it names each span after
+	// the matched route, which the mux records on the request it is handed,
+	// and every wrapper further out swaps in a request copy of its own.
+	handler = tracer.Middleware(handler)
+	// The branding middleware sits directly around the mux so every page and
+	// the /api/branding endpoint read the cached branding from the context.
+	handler = brand.Middleware(handler)
 	// securityHeaders is the innermost wrapper so the security headers land on
 	// w.Header() before any handler writes the response, including the 500
 	// recoverPanic emits on a handler panic (the headers survive the unwind).
@@ -74,6 +162,10 @@ func New(
 	// request fields logRequests would have recorded and the 500 reaches the
 	// client cleanly instead of leaking a half-written response (#346).
 	handler = recoverPanic(handler)
+	// forwardedClient sits just inside requestLogger so the client address
+	// and scheme it resolves from trusted proxy headers ride on every line
+	// recoverPanic and logRequests emit.
+	handler = forwardedClient(cfg.TrustedProxyCIDRs, cfg.SecureCookies())(handler)
 	// requestLogger is the OUTERMOST wrapper so the request-scoped logger
 	// (carrying a generated request id) is bound on the context before
 	// recoverPanic and logRequests draw their lines from it.
@@ -1,11 +1,13 @@
 package server_test
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/starquake/topbanana/internal/branding"
 	"github.com/starquake/topbanana/internal/config"
 	"github.com/starquake/topbanana/internal/game"
 	"github.com/starquake/topbanana/internal/leaderboard"
@@ -13,14 +15,25 @@ import (
 	"github.com/starquake/topbanana/internal/mailer"
 	. "github.com/starquake/topbanana/internal/server"
 	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/tracing"
 )
 
+// nopBrandingStore serves the default branding. The branding middleware reads
+// it on every request, so even the route-wiring tests need a Branding store.
+type nopBrandingStore struct{}
+
+func (nopBrandingStore) GetBranding(context.Context) (branding.Branding, error) {
+	return branding.Branding{}, nil
+}
+
+func (nopBrandingStore) SaveBranding(context.Context, branding.Branding) error { return nil }
+
 func TestNewServer(t *testing.T) {
 	t.Parallel()
 
 	srv := New(
 		slog.New(slog.DiscardHandler),
-		&store.Stores{}, &game.Service{},
+		&store.Stores{Branding: nopBrandingStore{}}, &game.Service{},
 		Realtime{
 			LeaderboardHub: leaderboard.NewHub(),
 			SessionService: &livesession.Service{},
@@ -28,6 +41,7 @@ func TestNewServer(t *testing.T) {
 		},
 		&config.Config{},
 		Mail{Tester: mailer.NewTester(mailer.NewNoop())},
+		nil,
 	)
 
 	if srv == nil {
@@ -40,7 +54,7 @@ func newDemoTestServer(t *testing.T, cfg *config.Config) http.Handler {
 
 	return New(
 		slog.New(slog.DiscardHandler),
-		&store.Stores{}, &game.Service{},
+		&store.Stores{Branding: nopBrandingStore{}}, &game.Service{},
 		Realtime{
 			LeaderboardHub: leaderboard.NewHub(),
 			SessionService: &livesession.Service{},
@@ -48,9 +62,102 @@ func newDemoTestServer(t *testing.T, cfg *config.Config) http.Handler {
 		},
 		cfg,
 		Mail{Tester: mailer.NewTester(mailer.NewNoop())},
+		nil,
 	)
 }
 
+// TestNewSplit pins the ADMIN_PORT split: the public handler answers the
+// admin UI with 404 while the admin handler serves it, and both serve the
+// public routes.
+func TestNewSplit(t *testing.T) {
+	t.Parallel()
+
+	public, adminHandler := NewSplit(
+		slog.New(slog.DiscardHandler),
+		&store.Stores{Branding: nopBrandingStore{}}, &game.Service{},
+		Realtime{
+			LeaderboardHub: leaderboard.NewHub(),
+			SessionService: &livesession.Service{},
+			SessionHub:     livesession.NewHub(),
+		},
+		&config.Config{},
+		Mail{Tester: mailer.NewTester(mailer.NewNoop())},
+		nil,
+	)
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+		path    string
+		want    int
+	}{
+		{name: "public hides the admin index", handler: public, path: "/admin", want: http.StatusNotFound},
+		{name: "public hides admin pages", handler: public, path: "/admin/quizzes", want: http.StatusNotFound},
+		{name: "public keeps look-alike paths", handler: public, path: "/administrator", want: http.StatusNotFound},
+		{name: "public serves the game", handler: public, path: "/version", want: http.StatusOK},
+		{name: "admin serves the admin UI", handler: adminHandler, path: "/admin", want: http.StatusSeeOther},
+		{name: "admin serves the game", handler: adminHandler, path: "/version", want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, req)
+			if got := rec.Code; got != tt.want {
+				t.Errorf("GET %s code = %d, want %d", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// recordingExporter keeps every exported span.
+type recordingExporter struct {
+	spans []*tracing.Span
+}
+
+func (e *recordingExporter) Export(_ context.Context, spans []*tracing.Span) error {
+	e.spans = append(e.spans, spans...)
+
+	return nil
+}
+
+// TestNewServer_TracesRequests pins that with a tracer wired in, a request
+// is recorded as one server span named after its matched route.
+func TestNewServer_TracesRequests(t *testing.T) {
+	t.Parallel()
+
+	exp := &recordingExporter{}
+	tracer := tracing.NewTracer(exp, "topbanana", slog.New(slog.DiscardHandler))
+	srv := New(
+		slog.New(slog.DiscardHandler),
+		&store.Stores{Branding: nopBrandingStore{}}, &game.Service{},
+		Realtime{
+			LeaderboardHub: leaderboard.NewHub(),
+			SessionService: &livesession.Service{},
+			SessionHub:     livesession.NewHub(),
+		},
+		&config.Config{},
+		Mail{Tester: mailer.NewTester(mailer.NewNoop())},
+		tracer,
+	)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/version", nil)
+	srv.ServeHTTP(httptest.NewRecorder(), req)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	tracer.Run(ctx)
+
+	if got, want := len(exp.spans), 1; got != want {
+		t.Fatalf("exported %d spans, want %d", got, want)
+	}
+	if got, want := exp.spans[0].Name(), "GET /version"; got != want {
+		t.Errorf("span name = %q, want %q", got, want)
+	}
+}
+
 func TestServer_DemoModeRoutes(t *testing.T) {
 	t.Parallel()
 
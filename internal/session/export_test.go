@@ -3,6 +3,7 @@ package session
 import (
 	"encoding/base64"
 	"strconv"
+	"time"
 )
 
 // ExportNewWithClock exposes newWithClock so external _test packages
@@ -22,3 +23,25 @@ func ExportEncodeLegacy(playerID, issuedAt int64, key []byte) string {
 
 	return payloadPart + "." + macPart
 }
+
+// ExportEncodeUntracked emits the three-field session cookie
+// (playerID|sessionVersion|issuedAt) minted before cookies carried a
+// session ID.
+func ExportEncodeUntracked(playerID, sessionVersion, issuedAt int64, key []byte) string {
+	payload := strconv.FormatInt(playerID, integerBase) + "|" +
+		strconv.FormatInt(sessionVersion, integerBase) + "|" +
+		strconv.FormatInt(issuedAt, integerBase)
+	payloadPart := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	macPart := base64.RawURLEncoding.EncodeToString(sign([]byte(payload), key))
+
+	return payloadPart + "." + macPart
+}
+
+// ExportSetTrackerClock replaces the tracker's clock so the touch
+// throttling can be tested without sleeping.
+func ExportSetTrackerClock(t *Tracker, now func() time.Time) {
+	t.now = now
+}
+
+// ExportTouchInterval exposes touchInterval.
+const ExportTouchInterval = touchInterval
@@ -1,17 +1,20 @@
 // Package session provides signed-cookie session helpers for storing
 // the authenticated player ID plus the session-version stamp.
 //
-// Cookies issued today are three pipe-separated fields signed as one
+// Cookies issued today are four pipe-separated fields signed as one
 // payload:
 //
-//	base64url(playerID|sessionVersion|issuedAt) + "." +
-//	  base64url(hmac_sha256(key, playerID|sessionVersion|issuedAt))
+//	base64url(playerID|sessionVersion|issuedAt|sessionID) + "." +
+//	  base64url(hmac_sha256(key, playerID|sessionVersion|issuedAt|sessionID))
 //
-// Two-field cookies minted before #112 PR3 (playerID|issuedAt) are
-// still accepted with an implicit sessionVersion=0 so a deploy does
-// not invalidate every live session. The decoder returns the parsed
-// version; the auth middleware compares it against the player's
-// current players.session_version and treats a mismatch as an
+// sessionID is a random id naming the session in the player's device
+// list; see [Tracker]. Three-field cookies minted before it existed
+// decode with an empty ID, and two-field cookies minted before #112
+// PR3 (playerID|issuedAt) are still accepted with an implicit
+// sessionVersion=0, so a deploy does not invalidate every live
+// session. The decoder returns the parsed version; the auth
+// middleware compares it against the player's current
+// players.session_version and treats a mismatch as an
 // unauthenticated request - this is how a password reset invalidates
 // every cookie minted before the reset.
 //
@@ -28,6 +31,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/rs/xid"
 )
 
 // CookieName is the name of the session cookie.
@@ -56,6 +61,16 @@ type Manager struct {
 	key           []byte
 	now           func() time.Time
 	secureCookies bool
+	tracker       *Tracker
+}
+
+// Session is a decoded session cookie. ID is empty on a cookie minted
+// before sessions were recorded.
+type Session struct {
+	PlayerID       int64
+	SessionVersion int64
+	ID             string
+	IssuedAt       time.Time
 }
 
 // New returns a Manager that signs cookies with the given key. secureCookies
@@ -73,13 +88,34 @@ func newWithClock(key []byte, secureCookies bool, now func() time.Time) *Manager
 	return &Manager{key: key, now: now, secureCookies: secureCookies}
 }
 
-// Set writes a signed session cookie carrying the player ID and the
-// session_version stamp the cookie was issued at. The caller passes
-// the value from players.session_version on the row being signed in;
-// a later password reset bumps that column and so invalidates this
-// cookie at the auth-middleware check.
+// Set writes a signed session cookie carrying the player ID, the
+// session_version stamp the cookie was issued at and a fresh session ID.
+// The caller passes the value from players.session_version on the row
+// being signed in; a later password reset bumps that column and so
+// invalidates this cookie at the auth-middleware check.
 func (m *Manager) Set(w http.ResponseWriter, playerID, sessionVersion int64) {
-	http.SetCookie(w, m.newCookie(encode(playerID, sessionVersion, m.now().Unix(), m.key), MaxAge))
+	m.set(w, playerID, sessionVersion, xid.New().String())
+}
+
+// Renew re-issues the request's session cookie with a new
+// session_version stamp, keeping its session ID so the device list
+// still shows it as the same session. A cookie of another player, or one
+// without an ID, gets a fresh ID as with [Manager.Set].
+func (m *Manager) Renew(w http.ResponseWriter, r *http.Request, playerID, sessionVersion int64) {
+	s, ok := m.Current(r)
+	if !ok || s.PlayerID != playerID || s.ID == "" {
+		m.Set(w, playerID, sessionVersion)
+
+		return
+	}
+	m.set(w, playerID, sessionVersion, s.ID)
+}
+
+func (m *Manager) set(w http.ResponseWriter, playerID, sessionVersion int64, sessionID string) {
+	value := encode(decodedPayload{
+		PlayerID: playerID, SessionVersion: sessionVersion, IssuedAt: m.now().Unix(), SessionID: sessionID,
+	}, m.key)
+	http.SetCookie(w, m.newCookie(value, MaxAge))
 }
 
 // Clear deletes the session cookie by setting it with an empty value and a negative MaxAge.
@@ -105,12 +141,29 @@ func (m *Manager) PlayerID(r *http.Request) (int64, bool) {
 // conditions as [Manager.PlayerID]: missing cookie, malformed
 // payload, bad signature, or age greater than MaxAge.
 func (m *Manager) Decode(r *http.Request) (playerID, sessionVersion int64, ok bool) {
+	s, ok := m.Current(r)
+
+	return s.PlayerID, s.SessionVersion, ok
+}
+
+// Current returns the request's session cookie decoded, or ok=false on
+// the same conditions as [Manager.PlayerID].
+func (m *Manager) Current(r *http.Request) (Session, bool) {
 	c, err := r.Cookie(CookieName)
 	if err != nil {
-		return 0, 0, false
+		return Session{}, false
+	}
+	p, ok := decode(c.Value, m.key, m.now)
+	if !ok {
+		return Session{}, false
 	}
 
-	return decode(c.Value, m.key, m.now)
+	return Session{
+		PlayerID:       p.PlayerID,
+		SessionVersion: p.SessionVersion,
+		ID:             p.SessionID,
+		IssuedAt:       time.Unix(p.IssuedAt, 0).UTC(),
+	}, true
 }
 
 // newCookie returns the session cookie with the safe defaults always applied:
@@ -137,10 +190,11 @@ func (m *Manager) newCookie(value string, maxAge int) *http.Cookie {
 	}
 }
 
-func encode(playerID, sessionVersion, issuedAt int64, key []byte) string {
-	payload := strconv.FormatInt(playerID, integerBase) + "|" +
-		strconv.FormatInt(sessionVersion, integerBase) + "|" +
-		strconv.FormatInt(issuedAt, integerBase)
+func encode(p decodedPayload, key []byte) string {
+	payload := strconv.FormatInt(p.PlayerID, integerBase) + "|" +
+		strconv.FormatInt(p.SessionVersion, integerBase) + "|" +
+		strconv.FormatInt(p.IssuedAt, integerBase) + "|" +
+		p.SessionID
 	payloadPart := base64.RawURLEncoding.EncodeToString([]byte(payload))
 	mac := sign([]byte(payload), key)
 	macPart := base64.RawURLEncoding.EncodeToString(mac)
@@ -148,38 +202,38 @@ func encode(playerID, sessionVersion, issuedAt int64, key []byte) string {
 	return payloadPart + "." + macPart
 }
 
-func decode(value string, key []byte, now func() time.Time) (playerID, sessionVersion int64, ok bool) {
+func decode(value string, key []byte, now func() time.Time) (decodedPayload, bool) {
 	payloadPart, macPart, sep := strings.Cut(value, ".")
 	if !sep {
-		return 0, 0, false
+		return decodedPayload{}, false
 	}
 
 	payloadBytes, err := base64.RawURLEncoding.DecodeString(payloadPart)
 	if err != nil {
-		return 0, 0, false
+		return decodedPayload{}, false
 	}
 
 	gotMAC, err := base64.RawURLEncoding.DecodeString(macPart)
 	if err != nil {
-		return 0, 0, false
+		return decodedPayload{}, false
 	}
 
 	wantMAC := sign(payloadBytes, key)
 	if !hmac.Equal(gotMAC, wantMAC) {
-		return 0, 0, false
+		return decodedPayload{}, false
 	}
 
 	p, ok := parsePayload(string(payloadBytes))
 	if !ok {
-		return 0, 0, false
+		return decodedPayload{}, false
 	}
 
 	// "Expired" means strictly older than MaxAge: age == MaxAge is still valid.
 	if now().Unix()-p.IssuedAt > MaxAge {
-		return 0, 0, false
+		return decodedPayload{}, false
 	}
 
-	return p.PlayerID, p.SessionVersion, true
+	return p, true
 }
 
 // decodedPayload is the parsed form of the cookie's pipe-separated
@@ -189,13 +243,16 @@ type decodedPayload struct {
 	PlayerID       int64
 	SessionVersion int64
 	IssuedAt       int64
+	SessionID      string
 }
 
-// parsePayload accepts both the new three-field (playerID|sessionVersion|issuedAt)
-// and the legacy two-field (playerID|issuedAt) wire formats. Legacy
-// cookies decode with SessionVersion=0 so a deploy does not log
-// everyone out; the version-mismatch check at the middleware then
-// only fires for accounts whose session_version has actually moved.
+// parsePayload accepts the current four-field
+// (playerID|sessionVersion|issuedAt|sessionID) wire format, the
+// three-field one without a session ID and the legacy two-field
+// (playerID|issuedAt) one. Legacy cookies decode with SessionVersion=0
+// so a deploy does not log everyone out; the version-mismatch check at
+// the middleware then only fires for accounts whose session_version has
+// actually moved.
 func parsePayload(payload string) (decodedPayload, bool) {
 	parts := strings.Split(payload, "|")
 	switch len(parts) {
@@ -210,7 +267,7 @@ func parsePayload(payload string) (decodedPayload, bool) {
 		}
 
 		return decodedPayload{PlayerID: id, IssuedAt: ts}, true
-	case versionedPayloadParts:
+	case versionedPayloadParts, trackedPayloadParts:
 		id, err := strconv.ParseInt(parts[0], integerBase, playerIDBitSize)
 		if err != nil {
 			return decodedPayload{}, false
@@ -224,7 +281,15 @@ func parsePayload(payload string) (decodedPayload, bool) {
 			return decodedPayload{}, false
 		}
 
-		return decodedPayload{PlayerID: id, SessionVersion: v, IssuedAt: ts}, true
+		p := decodedPayload{PlayerID: id, SessionVersion: v, IssuedAt: ts}
+		if len(parts) == trackedPayloadParts {
+			if parts[3] == "" {
+				return decodedPayload{}, false
+			}
+			p.SessionID = parts[3]
+		}
+
+		return p, true
 	default:
 		return decodedPayload{}, false
 	}
@@ -233,6 +298,7 @@ func parsePayload(payload string) (decodedPayload, bool) {
 const (
 	legacyPayloadParts    = 2
 	versionedPayloadParts = 3
+	trackedPayloadParts   = 4
 	sessionVersionBitSize = 64
 )
 
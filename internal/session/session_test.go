@@ -332,3 +332,86 @@ func TestDecode_LegacyTwoFieldCookieDecodesAsVersionZero(t *testing.T) {
 		t.Errorf("Decode sessionVersion = %d, want %d (legacy implicit zero)", got, want)
 	}
 }
+
+// cookieRequest returns a request carrying the cookies rec was sent.
+func cookieRequest(t *testing.T, rec *httptest.ResponseRecorder) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+	for _, c := range rec.Result().Cookies() {
+		req.AddCookie(c)
+	}
+
+	return req
+}
+
+func TestCurrent_SetMintsAFreshSessionID(t *testing.T) {
+	t.Parallel()
+
+	when := time.Unix(1_000_000_000, 0).UTC()
+	mgr := newManagerAt(t, when)
+	first, second := httptest.NewRecorder(), httptest.NewRecorder()
+	mgr.Set(first, 42, 7)
+	mgr.Set(second, 42, 7)
+
+	a, ok := mgr.Current(cookieRequest(t, first))
+	if !ok {
+		t.Fatal("Current ok = false, want true")
+	}
+	if a.PlayerID != 42 || a.SessionVersion != 7 || !a.IssuedAt.Equal(when) {
+		t.Errorf("Current = %+v, want player 42, version 7, issued %v", a, when)
+	}
+	if a.ID == "" {
+		t.Error("Current ID is empty, want a session ID")
+	}
+	b, _ := mgr.Current(cookieRequest(t, second))
+	if a.ID == b.ID {
+		t.Errorf("two sign-ins share session ID %q, want distinct", a.ID)
+	}
+}
+
+func TestCurrent_UntrackedCookieDecodesWithoutID(t *testing.T) {
+	t.Parallel()
+
+	// A cookie minted before session IDs existed must stay valid: it
+	// decodes with an empty ID, which the tracker always lets through.
+	key := []byte("k")
+	mgr := newManagerAt(t, time.Unix(1_000_000_000, 0))
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: CookieName, Value: ExportEncodeUntracked(42, 3, 1_000_000_000, key)})
+
+	s, ok := mgr.Current(req)
+	if !ok {
+		t.Fatal("Current ok = false, want true (three-field cookie must decode)")
+	}
+	if s.PlayerID != 42 || s.SessionVersion != 3 || s.ID != "" {
+		t.Errorf("Current = %+v, want player 42, version 3, no ID", s)
+	}
+}
+
+func TestRenew_KeepsTheSessionID(t *testing.T) {
+	t.Parallel()
+
+	mgr := New([]byte("k"), true)
+	rec := httptest.NewRecorder()
+	mgr.Set(rec, 42, 1)
+	req := cookieRequest(t, rec)
+	before, _ := mgr.Current(req)
+
+	renewed := httptest.NewRecorder()
+	mgr.Renew(renewed, req, 42, 2)
+	after, ok := mgr.Current(cookieRequest(t, renewed))
+	if !ok {
+		t.Fatal("Current after Renew ok = false, want true")
+	}
+	if after.ID != before.ID || after.SessionVersion != 2 {
+		t.Errorf("renewed = %+v, want ID %q at version 2", after, before.ID)
+	}
+
+	// Another player's cookie is not carried over.
+	other := httptest.NewRecorder()
+	mgr.Renew(other, req, 43, 0)
+	if s, _ := mgr.Current(cookieRequest(t, other)); s.ID == before.ID || s.PlayerID != 43 {
+		t.Errorf("renewed for another player = %+v, want a fresh ID for 43", s)
+	}
+}
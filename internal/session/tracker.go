@@ -0,0 +1,215 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/starquake/topbanana/internal/request"
+)
+
+// touchInterval is how often a session's device row is refreshed while it
+// is in use; sightings in between are not written.
+const touchInterval = 5 * time.Minute
+
+// maxUserAgentLength caps the user agent stored for a device.
+const maxUserAgentLength = 512
+
+// Device is a session as the player's device list shows it: the browser
+// and address it was last used from.
+type Device struct {
+	ID         string
+	PlayerID   int64
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
+
+// Store records the sessions a [Tracker] sees and lists the revoked ones.
+type Store interface {
+	// TouchSession records a sighting of d, creating its row on the first.
+	// It never revives a revoked session.
+	TouchSession(ctx context.Context, d *Device) error
+	// ListRevokedSessionIDs returns the ids of the player's revoked
+	// sessions.
+	ListRevokedSessionIDs(ctx context.Context, playerID int64) ([]string, error)
+}
+
+// ErrSessionNotFound is returned when a session id is not a live session
+// of the player.
+var ErrSessionNotFound = errors.New("session not found")
+
+// DeviceStore is the device list behind the players' session endpoints:
+// the [Store] a [Tracker] writes plus the reads and revocations.
+type DeviceStore interface {
+	Store
+	// ListSessions returns the player's sessions that are not revoked and
+	// were last seen at or after since, most recently used first.
+	ListSessions(ctx context.Context, playerID int64, since time.Time) ([]*Device, error)
+	// RevokeSession revokes one of the player's sessions and bumps their
+	// players.session_revocations. Returns ErrSessionNotFound when id is not
+	// a live session of the player.
+	RevokeSession(ctx context.Context, playerID int64, id string) error
+	// RevokeOtherSessions revokes every session of the player but keepID
+	// (all of them for "") and bumps their session_version, so cookies
+	// minted before sessions were recorded are signed out too. Returns the
+	// new session_version, for re-issuing the kept session's cookie.
+	RevokeOtherSessions(ctx context.Context, playerID int64, keepID string) (int64, error)
+}
+
+// revokedSessions is a player's revoked session ids as of a
+// players.session_revocations count.
+type revokedSessions struct {
+	revocations int64
+	ids         map[string]bool
+}
+
+// Tracker records the devices session cookies are used from and refuses
+// revoked sessions. Revoking a session bumps the player's revocation
+// count, which the auth middleware reads with the player row on every
+// request; the tracker caches each player's revoked ids against that count
+// and only asks the store again when it moves, so a request costs no extra
+// query. A player who never revoked a session is never looked up.
+type Tracker struct {
+	store             Store
+	logger            *slog.Logger
+	trustedProxyCIDRs []*net.IPNet
+	now               func() time.Time
+
+	mu        sync.Mutex
+	revoked   map[int64]revokedSessions
+	touched   map[string]time.Time
+	lastPrune time.Time
+}
+
+// NewTracker returns a Tracker writing to store. trustedProxyCIDRs picks the
+// client address recorded for a device as [request.ClientIP] does.
+func NewTracker(store Store, logger *slog.Logger, trustedProxyCIDRs []*net.IPNet) *Tracker {
+	return &Tracker{
+		store:             store,
+		logger:            logger,
+		trustedProxyCIDRs: trustedProxyCIDRs,
+		now:               time.Now,
+		revoked:           map[int64]revokedSessions{},
+		touched:           map[string]time.Time{},
+	}
+}
+
+// Track makes the Manager check sessions against t. Without a tracker
+// every validly signed cookie is active.
+func (m *Manager) Track(t *Tracker) {
+	m.tracker = t
+}
+
+// Active reports whether s, a cookie of a player whose row shows
+// revocations revoked sessions, has not been revoked, and records the
+// sighting on the session's device row. A cookie without a session ID
+// predates the device list and is always active; the session_version
+// check still covers it.
+func (m *Manager) Active(r *http.Request, s Session, revocations int64) (bool, error) {
+	if m.tracker == nil || s.ID == "" {
+		return true, nil
+	}
+
+	return m.tracker.check(r, s, revocations)
+}
+
+func (t *Tracker) check(r *http.Request, s Session, revocations int64) (bool, error) {
+	if revocations > 0 {
+		revoked, err := t.revokedIDs(r.Context(), s.PlayerID, revocations)
+		if err != nil {
+			return false, err
+		}
+		if revoked[s.ID] {
+			return false, nil
+		}
+	}
+	t.touch(r, s)
+
+	return true, nil
+}
+
+// revokedIDs returns the player's revoked session ids, from the cache when
+// it was filled at the same revocation count.
+func (t *Tracker) revokedIDs(ctx context.Context, playerID, revocations int64) (map[string]bool, error) {
+	t.mu.Lock()
+	cached, ok := t.revoked[playerID]
+	t.mu.Unlock()
+	if ok && cached.revocations == revocations {
+		return cached.ids, nil
+	}
+
+	ids, err := t.store.ListRevokedSessionIDs(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("list revoked sessions: %w", err)
+	}
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	t.mu.Lock()
+	t.revoked[playerID] = revokedSessions{revocations: revocations, ids: set}
+	t.mu.Unlock()
+
+	return set, nil
+}
+
+// touch writes the session's device row when it was not written in the
+// last touchInterval. A failed write is logged, not returned: the device
+// list going stale must not sign the player out.
+func (t *Tracker) touch(r *http.Request, s Session) {
+	now := t.now()
+	t.mu.Lock()
+	last, seen := t.touched[s.ID]
+	due := !seen || now.Sub(last) >= touchInterval
+	if due {
+		t.touched[s.ID] = now
+		t.pruneLocked(now)
+	}
+	t.mu.Unlock()
+	if !due {
+		return
+	}
+
+	d := &Device{
+		ID:        s.ID,
+		PlayerID:  s.PlayerID,
+		UserAgent: truncateUserAgent(r.UserAgent()),
+		IPAddress: request.ClientIP(r, t.trustedProxyCIDRs),
+	}
+	if err := t.store.TouchSession(r.Context(), d); err != nil {
+		t.logger.WarnContext(r.Context(), "error recording session",
+			slog.Int64("player_id", s.PlayerID), slog.Any("err", err))
+	}
+}
+
+// pruneLocked drops sightings older than touchInterval, at most once per
+// interval, so the map only holds sessions in recent use. Callers hold mu.
+func (t *Tracker) pruneLocked(now time.Time) {
+	if now.Sub(t.lastPrune) < touchInterval {
+		return
+	}
+	t.lastPrune = now
+	for id, last := range t.touched {
+		if now.Sub(last) >= touchInterval {
+			delete(t.touched, id)
+		}
+	}
+}
+
+// truncateUserAgent caps ua at maxUserAgentLength bytes without splitting a
+// character.
+func truncateUserAgent(ua string) string {
+	if len(ua) <= maxUserAgentLength {
+		return ua
+	}
+
+	return strings.ToValidUTF8(ua[:maxUserAgentLength], "")
+}
@@ -0,0 +1,190 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/starquake/topbanana/internal/session"
+)
+
+// errRevokedList is the failure fakeSessionStore returns when told to.
+var errRevokedList = errors.New("revoked list unavailable")
+
+// fakeSessionStore records touches and serves a fixed revoked list,
+// counting how often it was read.
+type fakeSessionStore struct {
+	mu          sync.Mutex
+	revoked     []string
+	listErr     error
+	listCalls   int
+	touches     []Device
+	touchFailed bool
+}
+
+func (f *fakeSessionStore) TouchSession(_ context.Context, d *Device) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.touches = append(f.touches, *d)
+	if f.touchFailed {
+		return errRevokedList
+	}
+
+	return nil
+}
+
+func (f *fakeSessionStore) ListRevokedSessionIDs(context.Context, int64) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listCalls++
+
+	return f.revoked, f.listErr
+}
+
+// trackedRequest signs playerID in on mgr and returns a request carrying the
+// cookie, with its decoded session.
+func trackedRequest(t *testing.T, mgr *Manager, playerID int64) (*http.Request, Session) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	mgr.Set(rec, playerID, 0)
+	req := cookieRequest(t, rec)
+	req.Header.Set("User-Agent", "Firefox")
+	s, ok := mgr.Current(req)
+	if !ok {
+		t.Fatal("Current ok = false, want true")
+	}
+
+	return req, s
+}
+
+// newTracked returns a Manager tracking into store with the tracker clock
+// reading *now.
+func newTracked(store *fakeSessionStore, now *time.Time) *Manager {
+	mgr := New([]byte("k"), true)
+	tracker := NewTracker(store, slog.New(slog.DiscardHandler), nil)
+	ExportSetTrackerClock(tracker, func() time.Time { return *now })
+	mgr.Track(tracker)
+
+	return mgr
+}
+
+func TestActive_RefusesRevokedSessions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000_000, 0)
+	store := &fakeSessionStore{}
+	mgr := newTracked(store, &now)
+	req, s := trackedRequest(t, mgr, 42)
+	otherReq, other := trackedRequest(t, mgr, 42)
+
+	// No revocations: the store's list is never read.
+	if ok, err := mgr.Active(req, s, 0); err != nil || !ok {
+		t.Fatalf("Active with no revocations = %v, %v; want true, nil", ok, err)
+	}
+	if store.listCalls != 0 {
+		t.Errorf("revoked list read %d times with no revocations, want 0", store.listCalls)
+	}
+
+	store.revoked = []string{s.ID}
+	if ok, err := mgr.Active(req, s, 1); err != nil || ok {
+		t.Errorf("Active on a revoked session = %v, %v; want false, nil", ok, err)
+	}
+	if ok, err := mgr.Active(otherReq, other, 1); err != nil || !ok {
+		t.Errorf("Active on another session = %v, %v; want true, nil", ok, err)
+	}
+	if got, want := store.listCalls, 1; got != want {
+		t.Errorf("revoked list read %d times at one count, want %d (cached)", got, want)
+	}
+
+	// A second revocation moves the count and rereads the list.
+	store.revoked = []string{s.ID, other.ID}
+	if ok, _ := mgr.Active(otherReq, other, 2); ok {
+		t.Error("Active after the count moved = true, want false")
+	}
+	if got, want := store.listCalls, 2; got != want {
+		t.Errorf("revoked list read %d times, want %d", got, want)
+	}
+}
+
+func TestActive_StoreFailureIsAnError(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000_000, 0)
+	store := &fakeSessionStore{listErr: errRevokedList}
+	mgr := newTracked(store, &now)
+	req, s := trackedRequest(t, mgr, 42)
+
+	if _, err := mgr.Active(req, s, 1); !errors.Is(err, errRevokedList) {
+		t.Errorf("Active err = %v, want %v", err, errRevokedList)
+	}
+}
+
+func TestActive_TouchesAtMostOncePerInterval(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000_000, 0)
+	store := &fakeSessionStore{}
+	mgr := newTracked(store, &now)
+	req, s := trackedRequest(t, mgr, 42)
+
+	for range 3 {
+		if ok, err := mgr.Active(req, s, 0); err != nil || !ok {
+			t.Fatalf("Active = %v, %v; want true, nil", ok, err)
+		}
+	}
+	now = now.Add(ExportTouchInterval)
+	if ok, _ := mgr.Active(req, s, 0); !ok {
+		t.Fatal("Active after the interval = false, want true")
+	}
+
+	if got, want := len(store.touches), 2; got != want {
+		t.Fatalf("touches = %d, want %d (first sighting, then once the interval passed)", got, want)
+	}
+	if d := store.touches[0]; d.ID != s.ID || d.PlayerID != 42 || d.UserAgent != "Firefox" || d.IPAddress == "" {
+		t.Errorf("touch = %+v, want session %q of player 42 from Firefox with an address", d, s.ID)
+	}
+}
+
+func TestActive_TouchFailureKeepsTheSession(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000_000, 0)
+	store := &fakeSessionStore{touchFailed: true}
+	mgr := newTracked(store, &now)
+	req, s := trackedRequest(t, mgr, 42)
+
+	if ok, err := mgr.Active(req, s, 0); err != nil || !ok {
+		t.Errorf("Active with a failing touch = %v, %v; want true, nil", ok, err)
+	}
+}
+
+func TestActive_UntrackedSessionsAreActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000_000, 0)
+	store := &fakeSessionStore{revoked: []string{""}}
+	mgr := newTracked(store, &now)
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+
+	// A cookie without an ID predates the device list: neither checked nor
+	// recorded.
+	if ok, err := mgr.Active(req, Session{PlayerID: 42}, 1); err != nil || !ok {
+		t.Errorf("Active without an ID = %v, %v; want true, nil", ok, err)
+	}
+	if store.listCalls != 0 || len(store.touches) != 0 {
+		t.Errorf("store used %d/%d times for an untracked cookie, want 0", store.listCalls, len(store.touches))
+	}
+
+	// Without a tracker every session is active.
+	untracked := New([]byte("k"), true)
+	treq, s := trackedRequest(t, untracked, 42)
+	if ok, err := untracked.Active(treq, s, 1); err != nil || !ok {
+		t.Errorf("Active without a tracker = %v, %v; want true, nil", ok, err)
+	}
+}
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/starquake/topbanana/internal/answerexport"
+	"github.com/starquake/topbanana/internal/db"
+)
+
+// AnswerExportStore reads recorded answers for the analytics export. It is a
+// reporting read, so [NewWithReader] wires it to the read-only pool.
+type AnswerExportStore struct {
+	q *db.Queries
+}
+
+// NewAnswerExportStore wires an AnswerExportStore against the supplied
+// database connection.
+func NewAnswerExportStore(conn *sql.DB) *AnswerExportStore {
+	return &AnswerExportStore{q: newQueries(conn)}
+}
+
+// ListSoloAnswersAfter returns up to limit solo answers from real games with
+// an id greater than afterID, oldest first.
+func (s *AnswerExportStore) ListSoloAnswersAfter(
+	ctx context.Context, afterID int64, limit int,
+) ([]*answerexport.Answer, error) {
+	rows, err := s.q.ListSoloAnswersForExport(ctx, db.ListSoloAnswersForExportParams{
+		AfterID:  afterID,
+		RowLimit: int64(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list solo answers for export: %w", err)
+	}
+
+	out := make([]*answerexport.Answer, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, &answerexport.Answer{
+			Source:       answerexport.SourceSolo,
+			ID:           r.ID,
+			AnsweredAt:   r.AnsweredAt,
+			QuizID:       r.QuizID,
+			QuizTitle:    r.QuizTitle,
+			QuestionID:   r.QuestionID,
+			QuestionText: r.QuestionText,
+			OptionID:     r.OptionID,
+			OptionText:   r.OptionText,
+			Correct:      r.IsCorrect,
+			PlayerID:     r.PlayerID,
+			PlayerName:   r.DisplayName,
+			GameID:       r.GameID,
+		})
+	}
+
+	return out, nil
+}
+
+// ListLiveAnswersAfter returns up to limit settled live-session answers with
+// an id greater than afterID, oldest first. Picks on a question that is still
+// open, and every pick after the oldest of them, are held back until it
+// closes.
+func (s *AnswerExportStore) ListLiveAnswersAfter(
+	ctx context.Context, afterID int64, limit int,
+) ([]*answerexport.Answer, error) {
+	rows, err := s.q.ListLiveAnswersForExport(ctx, db.ListLiveAnswersForExportParams{
+		AfterID:  afterID,
+		RowLimit: int64(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list live answers for export: %w", err)
+	}
+
+	out := make([]*answerexport.Answer, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, &answerexport.Answer{
+			Source:       answerexport.SourceLive,
+			ID:           r.ID,
+			AnsweredAt:   r.AnsweredAt,
+			QuizID:       r.QuizID,
+			QuizTitle:    r.QuizTitle,
+			QuestionID:   r.QuestionID,
+			QuestionText: r.QuestionText,
+			OptionID:     r.OptionID,
+			OptionText:   r.OptionText,
+			Correct:      r.IsCorrect,
+			PlayerID:     r.PlayerID,
+			PlayerName:   r.DisplayName,
+			JoinCode:     r.JoinCode,
+			GameSeq:      r.GameSeq,
+			Score:        nullableIntToPtr(r.Score),
+
+			ParticipantNotes: r.ParticipantNotes,
+		})
+	}
+
+	return out, nil
+}
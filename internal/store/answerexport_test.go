@@ -0,0 +1,90 @@
+package store_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/answerexport"
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/livesession"
+	. "github.com/starquake/topbanana/internal/store"
+)
+
+// TestAnswerExportStore_LiveWatermark pins the live export's watermark: a pick
+// on the question a room is still showing is unscored and may yet be changed,
+// so it is held back until the question closes and its score is written.
+func TestAnswerExportStore_LiveWatermark(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	playerStore := NewPlayerStore(db, slog.Default())
+	sessionStore := NewLiveSessionStore(db, slog.Default())
+	exportStore := NewAnswerExportStore(db)
+	qz := newLiveQuizWithQuestion(t, quizStore)
+	q := qz.Questions[0]
+
+	sess := &livesession.Session{QuizID: liveQuizIDPtr(qz.ID), HostPlayerID: seededAdminID, JoinCode: "EXPO23"}
+	if err := sessionStore.CreateSession(t.Context(), sess); err != nil {
+		t.Fatalf("CreateSession err = %v, want nil", err)
+	}
+	p, err := playerStore.CreateAnonymousPlayer(t.Context(), "expo-p1")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+	if _, err = sessionStore.AddPlayer(t.Context(), sess.ID, p.ID); err != nil {
+		t.Fatalf("AddPlayer err = %v, want nil", err)
+	}
+	if err = sessionStore.SetPlayerNotes(t.Context(), sess.ID, sess.JoinCode, seededAdminID, p.ID, "table 4"); err != nil {
+		t.Fatalf("SetPlayerNotes err = %v, want nil", err)
+	}
+	if _, err = sessionStore.MarkStarted(t.Context(), sess.ID); err != nil {
+		t.Fatalf("MarkStarted err = %v, want nil", err)
+	}
+	if _, err = sessionStore.EnterRoundIntro(t.Context(), sess.ID, livesession.PhaseLobby, q.RoundID); err != nil {
+		t.Fatalf("EnterRoundIntro err = %v, want nil", err)
+	}
+	started := time.Date(2026, time.June, 5, 12, 0, 0, 0, time.UTC)
+	if _, err = sessionStore.EnterQuestion(
+		t.Context(), sess.ID, livesession.PhaseRoundIntro, q.RoundID, q.ID, started, started.Add(10*time.Second),
+	); err != nil {
+		t.Fatalf("EnterQuestion err = %v, want nil", err)
+	}
+	if err = sessionStore.RecordAnswer(
+		t.Context(), sess.ID, q.ID, p.ID, q.Options[0].ID, started.Add(2*time.Second),
+	); err != nil {
+		t.Fatalf("RecordAnswer err = %v, want nil", err)
+	}
+
+	open, err := exportStore.ListLiveAnswersAfter(t.Context(), 0, answerexport.BatchSize)
+	if err != nil {
+		t.Fatalf("ListLiveAnswersAfter err = %v, want nil", err)
+	}
+	if got := len(open); got != 0 {
+		t.Errorf("len(answers) while the question is open = %d, want 0", got)
+	}
+
+	if err = sessionStore.SetAnswerScore(
+		t.Context(), sess.ID, q.ID, p.ID, 800, started, started.Add(10*time.Second),
+	); err != nil {
+		t.Fatalf("SetAnswerScore err = %v, want nil", err)
+	}
+	closed, err := exportStore.ListLiveAnswersAfter(t.Context(), 0, answerexport.BatchSize)
+	if err != nil {
+		t.Fatalf("ListLiveAnswersAfter err = %v, want nil", err)
+	}
+	if got, want := len(closed), 1; got != want {
+		t.Fatalf("len(answers) after scoring = %d, want %d", got, want)
+	}
+	a := closed[0]
+	if a.Source != answerexport.SourceLive || a.JoinCode != "EXPO23" || !a.Correct || a.PlayerName != "expo-p1" {
+		t.Errorf("answer = %+v, want a correct live pick by expo-p1 in EXPO23", a)
+	}
+	if a.Score == nil || *a.Score != 800 {
+		t.Errorf("answer Score = %v, want 800", a.Score)
+	}
+	if got, want := a.ParticipantNotes, "table 4"; got != want {
+		t.Errorf("answer ParticipantNotes = %q, want %q", got, want)
+	}
+}
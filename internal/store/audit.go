@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/starquake/topbanana/internal/audit"
+	"github.com/starquake/topbanana/internal/db"
+)
+
+// AuditStore is the audit_events table, the admin content audit log. It
+// implements [audit.Store].
+type AuditStore struct {
+	q *db.Queries
+}
+
+// NewAuditStore wires an AuditStore against the supplied database connection.
+func NewAuditStore(conn *sql.DB) *AuditStore {
+	return &AuditStore{q: newQueries(conn)}
+}
+
+// RecordEvent appends e and sets its ID and CreatedAt. A zero ActorPlayerID
+// is stored as NULL.
+func (s *AuditStore) RecordEvent(ctx context.Context, e *audit.Event) error {
+	row, err := s.q.InsertAuditEvent(ctx, db.InsertAuditEventParams{
+		ActorPlayerID: sql.NullInt64{Int64: e.ActorPlayerID, Valid: e.ActorPlayerID != 0},
+		EntityType:    e.EntityType,
+		EntityID:      e.EntityID,
+		Action:        e.Action,
+		Diff:          e.Diff,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert audit event: %w", err)
+	}
+	e.ID = row.ID
+	e.CreatedAt = row.CreatedAt
+
+	return nil
+}
+
+// ListRecentEvents returns up to limit audit events, newest first.
+func (s *AuditStore) ListRecentEvents(ctx context.Context, limit int) ([]*audit.Event, error) {
+	rows, err := s.q.ListRecentAuditEvents(ctx, int64(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	events := make([]*audit.Event, 0, len(rows))
+	for _, r := range rows {
+		events = append(events, &audit.Event{
+			ID:               r.ID,
+			ActorPlayerID:    r.ActorPlayerID.Int64,
+			ActorDisplayName: r.ActorDisplayName,
+			EntityType:       r.EntityType,
+			EntityID:         r.EntityID,
+			Action:           r.Action,
+			Diff:             r.Diff,
+			CreatedAt:        r.CreatedAt,
+		})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,50 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/audit"
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/store"
+)
+
+// TestAuditStore_RecordAndList pins the audit log round trip: events come
+// back newest first with the actor's display name, a zero actor is stored as
+// no actor, and the limit caps the list.
+func TestAuditStore_RecordAndList(t *testing.T) {
+	t.Parallel()
+
+	events := NewAuditStore(dbtest.OpenBackend(t))
+	recorded := []*audit.Event{
+		{ActorPlayerID: seededAdminID, EntityType: audit.EntityQuiz, EntityID: 7, Action: audit.ActionCreate,
+			Diff: `{"title":{"to":"Quiz"}}`},
+		{EntityType: audit.EntityRound, EntityID: 9, Action: audit.ActionDelete, Diff: `{}`},
+	}
+	for _, e := range recorded {
+		if err := events.RecordEvent(t.Context(), e); err != nil {
+			t.Fatalf("RecordEvent err = %v, want nil", err)
+		}
+		if e.ID == 0 || e.CreatedAt.IsZero() {
+			t.Errorf("recorded event = %+v, want an ID and CreatedAt", e)
+		}
+	}
+
+	listed, err := events.ListRecentEvents(t.Context(), 10)
+	if err != nil {
+		t.Fatalf("ListRecentEvents err = %v, want nil", err)
+	}
+	if got, want := len(listed), 2; got != want {
+		t.Fatalf("listed = %d, want %d", got, want)
+	}
+	if got := listed[0]; got.ID != recorded[1].ID || got.ActorPlayerID != 0 || got.EntityType != audit.EntityRound {
+		t.Errorf("listed[0] = %+v, want the actorless round delete", got)
+	}
+	if got := listed[1]; got.ActorPlayerID != seededAdminID || got.ActorDisplayName == "" ||
+		got.Diff != recorded[0].Diff || got.EntityID != 7 {
+		t.Errorf("listed[1] = %+v, want the admin's quiz create with their name", got)
+	}
+
+	if limited, lErr := events.ListRecentEvents(t.Context(), 1); lErr != nil || len(limited) != 1 {
+		t.Errorf("ListRecentEvents(1) = %d events, %v, want 1, nil", len(limited), lErr)
+	}
+}
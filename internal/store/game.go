@@ -9,24 +9,35 @@ import (
 	"time"
 
 	"github.com/rs/xid"
-	"modernc.org/sqlite"
-	sqlite3 "modernc.org/sqlite/lib"
 
 	"github.com/starquake/topbanana/internal/database"
 	"github.com/starquake/topbanana/internal/db"
 	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/quiz"
 )
 
 // GameStore provides methods for managing game-related data in a database, including queries and transactions.
 type GameStore struct {
-	q      *db.Queries
+	q *db.Queries
+	// rq serves the leaderboard reads; it is q unless withReader routed
+	// them to the read-only pool.
+	rq     *db.Queries
 	db     *sql.DB
 	logger *slog.Logger
 }
 
 // NewGameStore initializes and returns a GameStore instance with the provided database connection and logger.
 func NewGameStore(conn *sql.DB, logger *slog.Logger) *GameStore {
-	return &GameStore{q: db.New(conn), db: conn, logger: logger}
+	q := newQueries(conn)
+
+	return &GameStore{q: q, rq: q, db: conn, logger: logger}
+}
+
+// withReader routes the store's reporting reads through reader.
+func (s *GameStore) withReader(reader *sql.DB) *GameStore {
+	s.rq = newQueries(reader)
+
+	return s
 }
 
 // Ping verifies the connection to the database, returning an error if the ping operation fails.
@@ -52,17 +63,7 @@ func (s *GameStore) GetGame(ctx context.Context, id string) (*game.Game, error)
 		return nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
-	g := &game.Game{
-		ID:        row.ID,
-		QuizID:    row.QuizID,
-		Preview:   row.IsPreview != 0,
-		CreatedAt: row.CreatedAt,
-	}
-
-	if row.StartedAt.Valid {
-		g.StartedAt = &row.StartedAt.Time
-	}
-
+	g := gameFromRow(row)
 	g.Questions, err = s.listGameQuestions(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list game questions for game %q: %w", id, err)
@@ -128,6 +129,7 @@ func (s *GameStore) CreateGame(ctx context.Context, g *game.Game) error {
 
 	g.ID = row.ID
 	g.CreatedAt = row.CreatedAt
+	g.Status = game.GameStatus(row.Status)
 
 	return nil
 }
@@ -161,8 +163,7 @@ func (s *GameStore) CreateParticipant(ctx context.Context, p *game.Participant)
 		QuizID:   p.QuizID,
 	})
 	if err != nil {
-		var sqliteErr *sqlite.Error
-		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		if isUniqueViolation(err) {
 			return game.ErrGameAlreadyExists
 		}
 
@@ -222,6 +223,7 @@ func execCreateGameAndParticipant(
 	}
 	g.ID = gameRow.ID
 	g.CreatedAt = gameRow.CreatedAt
+	g.Status = game.GameStatus(gameRow.Status)
 
 	p.GameID = g.ID
 	partRow, err := q.CreateParticipant(ctx, db.CreateParticipantParams{
@@ -230,8 +232,7 @@ func execCreateGameAndParticipant(
 		QuizID:   p.QuizID,
 	})
 	if err != nil {
-		var sqliteErr *sqlite.Error
-		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		if isUniqueViolation(err) {
 			return game.ErrGameAlreadyExists
 		}
 
@@ -290,6 +291,7 @@ func (s *GameStore) CreateQuestion(ctx context.Context, gq *game.Question, compl
 				gq.ID = existing.ID
 				gq.StartedAt = existing.StartedAt
 				gq.ExpiredAt = existing.ExpiredAt
+				gq.Voided = existing.VoidedAt.Valid
 
 				return game.ErrQuestionAlreadyIssued
 			}
@@ -319,6 +321,28 @@ func (s *GameStore) CreateQuestion(ctx context.Context, gq *game.Question, compl
 	return nil
 }
 
+// GetNextUnaskedQuestion returns the game's next question to issue, with its
+// options, read by one query instead of the whole quiz.
+// Returns game.ErrNoMoreQuestions when every question of the quiz was issued.
+func (s *GameStore) GetNextUnaskedQuestion(ctx context.Context, gameID string) (*quiz.Question, error) {
+	row, err := s.q.GetNextUnaskedQuestion(ctx, gameID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, game.ErrNoMoreQuestions
+		}
+
+		return nil, fmt.Errorf("failed to get next unasked question: %w", err)
+	}
+
+	qs := questionFromRow(row)
+	qs.Options, err = listQuestionOptions(ctx, s.q, qs.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list options for question %d: %w", qs.ID, err)
+	}
+
+	return qs, nil
+}
+
 // CreateAnswer saves a new answer in the database and updates the provided Answer object with generated values.
 // The caller supplies a.AnsweredAt - the service clamps the client's tappedAt
 // to [question.StartedAt, [time.Now]] before invoking the store (#237) so the
@@ -329,27 +353,56 @@ func (s *GameStore) CreateQuestion(ctx context.Context, gq *game.Question, compl
 // Returns [game.ErrAnswerAlreadyRecorded] when the UNIQUE(game_id,
 // player_id, game_question_id) constraint trips - a double-tap or
 // network retry - so the handler can serve an idempotent response
-// instead of a 500 (#353).
+// instead of a 500 (#353). A multi-select answer's OptionIDs are recorded in
+// the same transaction as the answer row. The insert copies the confidence
+// stake off the question and the scoring mode and negative marking off the
+// quiz, and extends the player's streak when a is correct, so a.Wager,
+// a.Scoring, a.Streak, a.WrongAnswerPenalty and a.ScoreFloor are set from the
+// stored row.
 func (s *GameStore) CreateAnswer(ctx context.Context, a *game.Answer) error {
-	row, err := s.q.CreateAnswer(ctx, db.CreateAnswerParams{
-		GameID:         a.GameID,
-		PlayerID:       a.PlayerID,
-		GameQuestionID: a.QuestionID,
-		OptionID:       a.OptionID,
-		AnsweredAt:     a.AnsweredAt,
+	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		row, cerr := q.CreateAnswer(ctx, db.CreateAnswerParams{
+			GameID:         a.GameID,
+			PlayerID:       a.PlayerID,
+			GameQuestionID: a.QuestionID,
+			OptionID:       a.OptionID,
+			AnsweredAt:     a.AnsweredAt,
+			ElapsedMs:      nullableInt64(a.ElapsedMs),
+			NumericValue:   nullableFloat64(a.NumericValue),
+			PausedMs:       a.PausedMs,
+			HintPenalty:    int64(a.HintPenalty),
+			Correct:        boolToInt64(answerCorrect(a)),
+		})
+		if cerr != nil {
+			return cerr
+		}
+		for _, optionID := range a.OptionIDs {
+			if oerr := q.CreateAnswerOption(ctx, db.CreateAnswerOptionParams{
+				GameAnswerID: row.ID,
+				OptionID:     optionID,
+			}); oerr != nil {
+				return fmt.Errorf("failed to record picked option %d: %w", optionID, oerr)
+			}
+		}
+		a.ID = row.ID
+		a.AnsweredAt = row.AnsweredAt
+		a.Wager = nullableIntToPtr(row.Wager)
+		a.HintPenalty = int(row.HintPenalty)
+		a.Scoring = row.Scoring
+		a.Streak = int(row.Streak)
+		a.WrongAnswerPenalty = int(row.WrongAnswerPenalty)
+		a.ScoreFloor = int(row.ScoreFloor)
+
+		return nil
 	})
 	if err != nil {
-		var sqliteErr *sqlite.Error
-		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		if isUniqueViolation(err) {
 			return game.ErrAnswerAlreadyRecorded
 		}
 
 		return fmt.Errorf("failed to create answer: %w", err)
 	}
 
-	a.ID = row.ID
-	a.AnsweredAt = row.AnsweredAt
-
 	return nil
 }
 
@@ -408,7 +461,7 @@ func (s *GameStore) DeleteGamesForPlayerOnQuiz(ctx context.Context, playerID, qu
 func (s *GameStore) ListAnswersForQuizLeaderboard(
 	ctx context.Context, quizID int64,
 ) ([]*game.LeaderboardAnswer, error) {
-	rows, err := s.q.ListAnswersForQuizLeaderboard(ctx, quizID)
+	rows, err := s.rq.ListAnswersForQuizLeaderboard(ctx, quizID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list leaderboard answers for quiz %d: %w", quizID, err)
 	}
@@ -416,22 +469,70 @@ func (s *GameStore) ListAnswersForQuizLeaderboard(
 	answers := make([]*game.LeaderboardAnswer, 0, len(rows))
 	for _, r := range rows {
 		answers = append(answers, &game.LeaderboardAnswer{
+			GameID:            r.GameID,
 			PlayerID:          r.PlayerID,
 			DisplayName:       r.DisplayName,
 			QuestionStartedAt: r.QuestionStartedAt,
 			QuestionExpiredAt: r.QuestionExpiredAt,
 			AnsweredAt:        r.AnsweredAt,
+			ElapsedMs:         nullableInt64ToPtr(r.ElapsedMs),
 			Correct:           r.IsCorrect,
 			// is_completed is a SQLite CASE expression that comes back
 			// as 1/0; treat anything non-zero as "this row belongs to a
 			// game that has issued every quiz question".
-			IsCompleted: r.IsCompleted != 0,
+			IsCompleted:        r.IsCompleted != 0,
+			NumericValue:       nullableFloat64ToPtr(r.NumericValue),
+			NumericKey:         leaderboardNumericKey(r),
+			Tally:              pickTally(r.PickedCorrect, r.PickedWrong, r.CorrectOptions),
+			Wager:              nullableIntToPtr(r.Wager),
+			PausedMs:           r.PausedMs,
+			HintPenalty:        int(r.HintPenalty),
+			Scoring:            r.Scoring,
+			Streak:             int(r.Streak),
+			WrongAnswerPenalty: int(r.WrongAnswerPenalty),
+			ScoreFloor:         int(r.ScoreFloor),
 		})
 	}
 
 	return answers, nil
 }
 
+// answerCorrect reports whether a being recorded extends the player's streak.
+// An answer carrying neither its option nor a pick tally cannot be judged and
+// counts as wrong.
+func answerCorrect(a *game.Answer) bool {
+	if a.Option == nil && a.Tally == nil {
+		return false
+	}
+
+	return a.IsCorrect()
+}
+
+// pickTally rebuilds a multi-select answer's tally from the picked-option
+// counts an answer read carries, or nil for a single pick, which has none.
+func pickTally(correct, wrong, correctTotal int64) *quiz.PickTally {
+	if correct+wrong == 0 {
+		return nil
+	}
+
+	return &quiz.PickTally{Correct: int(correct), Wrong: int(wrong), CorrectTotal: int(correctTotal)}
+}
+
+// leaderboardNumericKey rebuilds the answer key option a numeric answer was
+// scored against from its leaderboard row, or nil for an option pick.
+func leaderboardNumericKey(r db.ListAnswersForQuizLeaderboardRow) *quiz.Option {
+	if !r.NumericValue.Valid || !r.KeyValue.Valid {
+		return nil
+	}
+
+	return &quiz.Option{
+		Correct:        r.IsCorrect,
+		NumericValue:   nullableFloat64ToPtr(r.KeyValue),
+		ToleranceBelow: r.ToleranceBelow,
+		ToleranceAbove: r.ToleranceAbove,
+	}
+}
+
 // ListParticipantsForQuizLeaderboard returns one row per player joined
 // to the quiz, flagged with IsCompleted and IsStale (#336). Pass
 // [time.Now]-stalePeriod for staleBefore. Canonical entry set per #335.
@@ -441,7 +542,7 @@ func (s *GameStore) ListAnswersForQuizLeaderboard(
 func (s *GameStore) ListParticipantsForQuizLeaderboard(
 	ctx context.Context, quizID int64, staleBefore time.Time,
 ) ([]*game.LeaderboardParticipant, error) {
-	rows, err := s.q.ListParticipantsForQuizLeaderboard(ctx, db.ListParticipantsForQuizLeaderboardParams{
+	rows, err := s.rq.ListParticipantsForQuizLeaderboard(ctx, db.ListParticipantsForQuizLeaderboardParams{
 		StaleBefore: staleBefore.UTC().Format(sqliteTimestampLayout),
 		QuizID:      quizID,
 	})
@@ -480,6 +581,124 @@ func (s *GameStore) ListQuizIDsForPlayer(ctx context.Context, playerID int64) ([
 	return ids, nil
 }
 
+// ListPlayerGameHistory returns one page of the player's non-preview
+// games, newest first, and their total. The page and the total are two
+// reads; a game created in between can shift the page by one, which the
+// history screen tolerates.
+func (s *GameStore) ListPlayerGameHistory(
+	ctx context.Context, playerID, limit, offset int64,
+) ([]*game.PlayedGame, int64, error) {
+	total, err := s.q.CountPlayerGameHistory(ctx, playerID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count game history for player %d: %w", playerID, err)
+	}
+	rows, err := s.q.ListPlayerGameHistory(ctx, db.ListPlayerGameHistoryParams{
+		PlayerID:  playerID,
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list game history for player %d: %w", playerID, err)
+	}
+
+	played := make([]*game.PlayedGame, 0, len(rows))
+	for _, r := range rows {
+		played = append(played, &game.PlayedGame{
+			GameID:     r.GameID,
+			QuizID:     r.QuizID,
+			QuizTitle:  r.QuizTitle,
+			QuizSlug:   r.QuizSlug,
+			CreatedAt:  r.CreatedAt,
+			FinishedAt: nullTimeToPtr(r.FinishedAt),
+			Status:     r.Status,
+			// CASE returns 1/0.
+			ScoresHidden:  r.ScoresHidden != 0,
+			AnsweredCount: int(r.AnsweredCount),
+			QuestionCount: int(r.QuestionCount),
+		})
+	}
+
+	return played, total, nil
+}
+
+// ListQuizPlayReport returns the play counts of every quiz with a
+// non-preview game started in [from, to), most played first. The bounds are
+// formatted as UTC CURRENT_TIMESTAMP-format text so they compare against
+// the stored created_at in one encoding (#789).
+func (s *GameStore) ListQuizPlayReport(ctx context.Context, from, to time.Time) ([]*game.QuizPlayCount, error) {
+	rows, err := s.q.ListQuizPlayReport(ctx, db.ListQuizPlayReportParams{
+		CreatedFrom: from.UTC().Format(sqliteTimestampLayout),
+		CreatedTo:   to.UTC().Format(sqliteTimestampLayout),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quiz play counts: %w", err)
+	}
+
+	counts := make([]*game.QuizPlayCount, 0, len(rows))
+	for _, r := range rows {
+		counts = append(counts, &game.QuizPlayCount{
+			QuizID:    r.QuizID,
+			QuizTitle: r.QuizTitle,
+			Plays:     int(r.PlayCount),
+			Finished:  int(r.FinishedCount),
+		})
+	}
+
+	return counts, nil
+}
+
+// ListAnswersForPlayReport returns every answer given in a non-preview game
+// started in [from, to), with the scoring inputs
+// [GameStore.ListAnswersForQuizLeaderboard] carries plus the game, quiz and
+// question it belongs to.
+func (s *GameStore) ListAnswersForPlayReport(ctx context.Context, from, to time.Time) ([]*game.ReportAnswer, error) {
+	rows, err := s.q.ListAnswersForPlayReport(ctx, db.ListAnswersForPlayReportParams{
+		CreatedFrom: from.UTC().Format(sqliteTimestampLayout),
+		CreatedTo:   to.UTC().Format(sqliteTimestampLayout),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list report answers: %w", err)
+	}
+
+	answers := make([]*game.ReportAnswer, 0, len(rows))
+	for _, r := range rows {
+		a := &game.ReportAnswer{
+			LeaderboardAnswer: game.LeaderboardAnswer{
+				PlayerID:           r.PlayerID,
+				QuestionStartedAt:  r.QuestionStartedAt,
+				QuestionExpiredAt:  r.QuestionExpiredAt,
+				AnsweredAt:         r.AnsweredAt,
+				ElapsedMs:          nullableInt64ToPtr(r.ElapsedMs),
+				Correct:            r.IsCorrect,
+				NumericValue:       nullableFloat64ToPtr(r.NumericValue),
+				Tally:              pickTally(r.PickedCorrect, r.PickedWrong, r.CorrectOptions),
+				Wager:              nullableIntToPtr(r.Wager),
+				PausedMs:           r.PausedMs,
+				HintPenalty:        int(r.HintPenalty),
+				Scoring:            r.Scoring,
+				Streak:             int(r.Streak),
+				WrongAnswerPenalty: int(r.WrongAnswerPenalty),
+				ScoreFloor:         int(r.ScoreFloor),
+			},
+			GameID:       r.GameID,
+			QuizID:       r.QuizID,
+			QuestionID:   r.QuestionID,
+			QuestionText: r.QuestionText,
+		}
+		if r.NumericValue.Valid && r.KeyValue.Valid {
+			a.NumericKey = &quiz.Option{
+				Correct:        r.IsCorrect,
+				NumericValue:   nullableFloat64ToPtr(r.KeyValue),
+				ToleranceBelow: r.ToleranceBelow,
+				ToleranceAbove: r.ToleranceAbove,
+			}
+		}
+		answers = append(answers, a)
+	}
+
+	return answers, nil
+}
+
 // MarkRoundSeen records that the player acknowledged the given phase of
 // the round boundary in the given game (#548). The underlying INSERT
 // uses ON CONFLICT DO NOTHING so a duplicate call is a no-op success,
@@ -523,6 +742,137 @@ func (s *GameStore) ListSeenRoundPhasesByGame(
 	return phases, nil
 }
 
+// VoidQuestion marks the quiz question as voided for the given game. The
+// UPDATE keeps the first voided_at on a repeat call, so the only miss is a
+// question that was never issued to the game: that returns
+// [game.ErrQuestionNotInGame].
+func (s *GameStore) VoidQuestion(ctx context.Context, gameID string, questionID int64) error {
+	n, err := s.q.VoidGameQuestion(ctx, db.VoidGameQuestionParams{GameID: gameID, QuestionID: questionID})
+	if err != nil {
+		return fmt.Errorf("failed to void question %d on game %q: %w", questionID, gameID, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("question %d not issued to game %q: %w", questionID, gameID, game.ErrQuestionNotInGame)
+	}
+
+	return nil
+}
+
+// PlaceWager locks in the confidence stake on the question issued to the
+// game. The UPDATE only fills an empty stake, so zero rows affected means one
+// was already placed: that returns [game.ErrWagerAlreadyPlaced]. The service
+// checks the question was issued before calling.
+func (s *GameStore) PlaceWager(ctx context.Context, gameID string, questionID int64, wager int) error {
+	n, err := s.q.PlaceGameQuestionWager(ctx, db.PlaceGameQuestionWagerParams{
+		Wager:      int64(wager),
+		GameID:     gameID,
+		QuestionID: questionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to place wager on question %d in game %q: %w", questionID, gameID, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("question %d in game %q: %w", questionID, gameID, game.ErrWagerAlreadyPlaced)
+	}
+
+	return nil
+}
+
+// RecordHint marks the hint of the issued question gameQuestionID as taken
+// by the player. Taking it again is a no-op, so the penalty is only ever
+// counted once. The service checks the player is a participant and the
+// question was issued before calling.
+func (s *GameStore) RecordHint(ctx context.Context, gameQuestionID, playerID int64) error {
+	if err := s.q.RecordGameHint(ctx, db.RecordGameHintParams{
+		GameQuestionID: gameQuestionID,
+		PlayerID:       playerID,
+	}); err != nil {
+		return fmt.Errorf("failed to record hint on question %d for player %d: %w", gameQuestionID, playerID, err)
+	}
+
+	return nil
+}
+
+// PauseParticipant spends the player's one pause of the game on the quiz
+// question questionID. The UPDATE only fills an unused pause, so zero rows
+// affected means it was already spent: that returns
+// [game.ErrPauseAlreadyUsed]. The service checks the player is a participant
+// and the question was issued before calling.
+func (s *GameStore) PauseParticipant(
+	ctx context.Context, gameID string, playerID, questionID int64, pausedAt time.Time,
+) error {
+	n, err := s.q.PauseParticipantOnce(ctx, db.PauseParticipantOnceParams{
+		QuestionID: questionID,
+		PausedAt:   sql.NullTime{Time: pausedAt, Valid: true},
+		GameID:     gameID,
+		PlayerID:   playerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pause player %d in game %q: %w", playerID, gameID, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("player %d in game %q: %w", playerID, gameID, game.ErrPauseAlreadyUsed)
+	}
+
+	return nil
+}
+
+// FinishGame moves the game to status and stamps finished_at. It reports
+// false, with no error, when the game was already finished or abandoned (or
+// does not exist), so the first terminal transition wins.
+func (s *GameStore) FinishGame(ctx context.Context, gameID string, status game.GameStatus) (bool, error) {
+	n, err := s.q.FinishGame(ctx, db.FinishGameParams{Status: status, ID: gameID})
+	if err != nil {
+		return false, fmt.Errorf("failed to finish game %q: %w", gameID, err)
+	}
+
+	return n > 0, nil
+}
+
+// SetResultsPublic flips the game's results_public flag. Zero rows affected
+// means no such game: that returns [game.ErrGameNotFound].
+func (s *GameStore) SetResultsPublic(ctx context.Context, gameID string, public bool) error {
+	n, err := s.q.SetGameResultsPublic(ctx, db.SetGameResultsPublicParams{
+		ResultsPublic: boolToInt64(public),
+		ID:            gameID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set results visibility on game %q: %w", gameID, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("game %q: %w", gameID, game.ErrGameNotFound)
+	}
+
+	return nil
+}
+
+// RevealGame stamps the game's revealed_at. It reports false, with no error,
+// when the game was already revealed (or does not exist), so the first
+// reveal's stamp stays.
+func (s *GameStore) RevealGame(ctx context.Context, gameID string) (bool, error) {
+	n, err := s.q.RevealGame(ctx, gameID)
+	if err != nil {
+		return false, fmt.Errorf("failed to reveal game %q: %w", gameID, err)
+	}
+
+	return n > 0, nil
+}
+
+// ListParticipantNames returns the display name of every participant in
+// the game, keyed by player ID.
+func (s *GameStore) ListParticipantNames(ctx context.Context, gameID string) (map[int64]string, error) {
+	rows, err := s.q.ListParticipantNamesByGameID(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participant names for game %q: %w", gameID, err)
+	}
+	names := make(map[int64]string, len(rows))
+	for _, r := range rows {
+		names[r.PlayerID] = r.DisplayName
+	}
+
+	return names, nil
+}
+
 // ReattributeGames moves game_answers + game_participants from
 // fromPlayerID to toPlayerID atomically, skipping quizzes the
 // destination has already played (the UNIQUE (player_id, quiz_id)
@@ -561,17 +911,7 @@ func (s *GameStore) ReattributeGames(ctx context.Context, fromPlayerID, toPlayer
 
 // resumeGameFromRow maps a games row into a [game.Game] with Questions populated, shared by the real and preview-inclusive resume lookups.
 func (s *GameStore) resumeGameFromRow(ctx context.Context, row db.Game) (*game.Game, error) {
-	g := &game.Game{
-		ID:        row.ID,
-		QuizID:    row.QuizID,
-		Preview:   row.IsPreview != 0,
-		CreatedAt: row.CreatedAt,
-	}
-
-	if row.StartedAt.Valid {
-		g.StartedAt = &row.StartedAt.Time
-	}
-
+	g := gameFromRow(row)
 	var err error
 	g.Questions, err = s.listGameQuestions(ctx, g.ID)
 	if err != nil {
@@ -581,6 +921,28 @@ func (s *GameStore) resumeGameFromRow(ctx context.Context, row db.Game) (*game.G
 	return g, nil
 }
 
+// gameFromRow maps the games row itself; callers attach questions and
+// participants.
+func gameFromRow(row db.Game) *game.Game {
+	g := &game.Game{
+		ID:            row.ID,
+		QuizID:        row.QuizID,
+		Preview:       row.IsPreview != 0,
+		CreatedAt:     row.CreatedAt,
+		Status:        game.GameStatus(row.Status),
+		ResultsPublic: row.ResultsPublic != 0,
+	}
+	if row.StartedAt.Valid {
+		g.StartedAt = &row.StartedAt.Time
+	}
+	if row.FinishedAt.Valid {
+		g.FinishedAt = &row.FinishedAt.Time
+	}
+	g.RevealedAt = nullTimeToPtr(row.RevealedAt)
+
+	return g
+}
+
 func (s *GameStore) listGameQuestions(ctx context.Context, gameID string) ([]*game.Question, error) {
 	rows, err := s.q.ListGameQuestionsByGameID(ctx, gameID)
 	if err != nil {
@@ -598,12 +960,22 @@ func (s *GameStore) listGameQuestions(ctx context.Context, gameID string) ([]*ga
 	answersByGQ := make(map[int64][]*game.Answer, len(rows))
 	for _, r := range answerRows {
 		answersByGQ[r.GameQuestionID] = append(answersByGQ[r.GameQuestionID], &game.Answer{
-			ID:         r.ID,
-			GameID:     r.GameID,
-			PlayerID:   r.PlayerID,
-			QuestionID: r.GameQuestionID,
-			OptionID:   r.OptionID,
-			AnsweredAt: r.AnsweredAt,
+			ID:                 r.ID,
+			GameID:             r.GameID,
+			PlayerID:           r.PlayerID,
+			QuestionID:         r.GameQuestionID,
+			OptionID:           r.OptionID,
+			AnsweredAt:         r.AnsweredAt,
+			ElapsedMs:          nullableInt64ToPtr(r.ElapsedMs),
+			NumericValue:       nullableFloat64ToPtr(r.NumericValue),
+			Tally:              pickTally(r.PickedCorrect, r.PickedWrong, r.CorrectOptions),
+			Wager:              nullableIntToPtr(r.Wager),
+			PausedMs:           r.PausedMs,
+			HintPenalty:        int(r.HintPenalty),
+			Scoring:            r.Scoring,
+			Streak:             int(r.Streak),
+			WrongAnswerPenalty: int(r.WrongAnswerPenalty),
+			ScoreFloor:         int(r.ScoreFloor),
 		})
 	}
 
@@ -615,6 +987,8 @@ func (s *GameStore) listGameQuestions(ctx context.Context, gameID string) ([]*ga
 			QuestionID: r.QuestionID,
 			StartedAt:  r.StartedAt,
 			ExpiredAt:  r.ExpiredAt,
+			Voided:     r.VoidedAt.Valid,
+			Wager:      nullableIntToPtr(r.Wager),
 			Answers:    answersByGQ[r.ID],
 		})
 	}
@@ -633,13 +1007,18 @@ func (s *GameStore) listParticipants(ctx context.Context, gameID string) ([]*gam
 	for _, r := range rows {
 		// quiz_id became NOT NULL in 20260524200000 (#357), so the
 		// generated row carries it as int64 - no more Valid-guard.
-		participants = append(participants, &game.Participant{
-			ID:       r.ID,
-			GameID:   r.GameID,
-			PlayerID: r.PlayerID,
-			QuizID:   r.QuizID,
-			JoinedAt: r.JoinedAt,
-		})
+		p := &game.Participant{
+			ID:               r.ID,
+			GameID:           r.GameID,
+			PlayerID:         r.PlayerID,
+			QuizID:           r.QuizID,
+			JoinedAt:         r.JoinedAt,
+			PausedQuestionID: r.PausedQuestionID.Int64,
+		}
+		if r.PausedAt.Valid {
+			p.PausedAt = &r.PausedAt.Time
+		}
+		participants = append(participants, p)
 	}
 
 	return participants, nil
@@ -8,6 +8,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/starquake/topbanana/internal/dbtest"
 	"github.com/starquake/topbanana/internal/game"
 	"github.com/starquake/topbanana/internal/quiz"
@@ -19,7 +21,7 @@ func TestGameStore_Ping(t *testing.T) {
 
 	t.Run("ping success", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		s := NewGameStore(db, slog.Default())
 		if err := s.Ping(t.Context()); err != nil {
 			t.Errorf("unexpected error: %v", err)
@@ -28,7 +30,7 @@ func TestGameStore_Ping(t *testing.T) {
 
 	t.Run("ping failure", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		s := NewGameStore(db, slog.Default())
 		if err := db.Close(); err != nil {
 			t.Fatalf("failed to close db: %v", err)
@@ -48,7 +50,7 @@ func TestGameStore_CreateGame(t *testing.T) {
 
 	t.Run("populates ID and CreatedAt", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -74,7 +76,7 @@ func TestGameStore_GetGame(t *testing.T) {
 
 	t.Run("returns existing game", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -101,7 +103,7 @@ func TestGameStore_GetGame(t *testing.T) {
 
 	t.Run("returns ErrGameNotFound for unknown ID", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		gameStore := NewGameStore(db, slog.Default())
 		_, err := gameStore.GetGame(t.Context(), "nonexistent")
 		if got, want := err, game.ErrGameNotFound; !errors.Is(got, want) {
@@ -115,7 +117,7 @@ func TestGameStore_StartGame(t *testing.T) {
 
 	t.Run("sets started_at on the game", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -142,7 +144,7 @@ func TestGameStore_StartGame(t *testing.T) {
 
 	t.Run("returns ErrStartingGameNoRowsAffected for unknown ID", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		gameStore := NewGameStore(db, slog.Default())
 		err := gameStore.StartGame(t.Context(), "nonexistent")
 		if got, want := err, game.ErrStartingGameNoRowsAffected; !errors.Is(got, want) {
@@ -151,12 +153,75 @@ func TestGameStore_StartGame(t *testing.T) {
 	})
 }
 
+func TestGameStore_SetResultsPublic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("toggles the flag and names the participants", func(t *testing.T) {
+		t.Parallel()
+		db := dbtest.OpenBackend(t)
+		quizStore := NewQuizStore(db, slog.Default())
+		testQuiz := newTestQuizzes()[0]
+		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+			t.Fatalf("failed to create quiz: %v", err)
+		}
+		player, err := NewPlayerStore(db, slog.Default()).CreateAnonymousPlayer(t.Context(), "anon-public")
+		if err != nil {
+			t.Fatalf("failed to create player: %v", err)
+		}
+
+		gameStore := NewGameStore(db, slog.Default())
+		g := &game.Game{QuizID: testQuiz.ID}
+		if err = gameStore.CreateGameAndParticipant(
+			t.Context(), g, &game.Participant{PlayerID: player.ID, QuizID: testQuiz.ID},
+		); err != nil {
+			t.Fatalf("failed to create game: %v", err)
+		}
+
+		got, err := gameStore.GetGame(t.Context(), g.ID)
+		if err != nil {
+			t.Fatalf("failed to get game: %v", err)
+		}
+		if got.ResultsPublic {
+			t.Error("ResultsPublic = true on a new game, want false")
+		}
+
+		if err = gameStore.SetResultsPublic(t.Context(), g.ID, true); err != nil {
+			t.Fatalf("SetResultsPublic err = %v, want nil", err)
+		}
+		got, err = gameStore.GetGame(t.Context(), g.ID)
+		if err != nil {
+			t.Fatalf("failed to get game: %v", err)
+		}
+		if !got.ResultsPublic {
+			t.Error("ResultsPublic = false after SetResultsPublic(true), want true")
+		}
+
+		names, err := gameStore.ListParticipantNames(t.Context(), g.ID)
+		if err != nil {
+			t.Fatalf("ListParticipantNames err = %v, want nil", err)
+		}
+		if got, want := names[player.ID], player.DisplayName; got != want {
+			t.Errorf("names[%d] = %q, want %q", player.ID, got, want)
+		}
+	})
+
+	t.Run("returns ErrGameNotFound for unknown ID", func(t *testing.T) {
+		t.Parallel()
+		db := dbtest.OpenBackend(t)
+		gameStore := NewGameStore(db, slog.Default())
+		err := gameStore.SetResultsPublic(t.Context(), "nonexistent", true)
+		if got, want := err, game.ErrGameNotFound; !errors.Is(got, want) {
+			t.Errorf("err = %v, want %v", got, want)
+		}
+	})
+}
+
 func TestGameStore_CreateParticipant(t *testing.T) {
 	t.Parallel()
 
 	t.Run("populates ID and JoinedAt", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -183,7 +248,7 @@ func TestGameStore_CreateParticipant(t *testing.T) {
 
 	t.Run("returns ErrGameAlreadyExists on the (player, quiz) UNIQUE collision", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -223,7 +288,7 @@ func TestGameStore_CreateParticipant(t *testing.T) {
 
 	t.Run("wraps the underlying error on a closed DB", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		gameStore := NewGameStore(db, slog.Default())
 		if err := db.Close(); err != nil {
 			t.Fatalf("failed to close db: %v", err)
@@ -246,7 +311,7 @@ func TestGameStore_CreateQuestion(t *testing.T) {
 
 	t.Run("populates ID, StartedAt, and ExpiredAt", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -276,7 +341,7 @@ func TestGameStore_CreateQuestion(t *testing.T) {
 
 	t.Run("completesGame=false does not move the counter", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -307,7 +372,7 @@ func TestGameStore_CreateQuestion(t *testing.T) {
 
 	t.Run("completesGame on the final question moves the counter exactly once", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -341,7 +406,7 @@ func TestGameStore_CreateQuestion(t *testing.T) {
 
 	t.Run("bump rolls back when the question insert fails", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -369,12 +434,53 @@ func TestGameStore_CreateQuestion(t *testing.T) {
 	})
 }
 
+func TestGameStore_GetNextUnaskedQuestion(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	testQuiz := newTestQuizzes()[0]
+	if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+
+	gameStore := NewGameStore(db, slog.Default())
+	g := &game.Game{QuizID: testQuiz.ID}
+	if err := gameStore.CreateGame(t.Context(), g); err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+
+	now := time.Now()
+	for _, want := range testQuiz.Questions {
+		got, err := gameStore.GetNextUnaskedQuestion(t.Context(), g.ID)
+		if err != nil {
+			t.Fatalf("GetNextUnaskedQuestion err = %v, want nil", err)
+		}
+		if got.ID != want.ID {
+			t.Fatalf("next question ID = %d, want %d", got.ID, want.ID)
+		}
+		if got, want := len(got.Options), len(want.Options); got != want {
+			t.Errorf("len(Options) = %d, want %d", got, want)
+		}
+
+		gq := &game.Question{GameID: g.ID, QuestionID: got.ID, StartedAt: now, ExpiredAt: now.Add(10 * time.Second)}
+		if err = gameStore.CreateQuestion(t.Context(), gq, false); err != nil {
+			t.Fatalf("CreateQuestion err = %v, want nil", err)
+		}
+	}
+
+	_, err := gameStore.GetNextUnaskedQuestion(t.Context(), g.ID)
+	if got, want := err, game.ErrNoMoreQuestions; !errors.Is(got, want) {
+		t.Errorf("err after every question = %v, want %v", got, want)
+	}
+}
+
 func TestGameStore_CreateAnswer(t *testing.T) {
 	t.Parallel()
 
 	t.Run("populates ID and round-trips caller-supplied AnsweredAt", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -422,9 +528,119 @@ func TestGameStore_CreateAnswer(t *testing.T) {
 		}
 	})
 
+	t.Run("keeps the hint penalty only on a hinted question", func(t *testing.T) {
+		t.Parallel()
+		db := dbtest.OpenBackend(t)
+		quizStore := NewQuizStore(db, slog.Default())
+		testQuiz := newTestQuizzes()[0]
+		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+			t.Fatalf("failed to create quiz: %v", err)
+		}
+
+		gameStore := NewGameStore(db, slog.Default())
+		g := &game.Game{QuizID: testQuiz.ID}
+		if err := gameStore.CreateGame(t.Context(), g); err != nil {
+			t.Fatalf("failed to create game: %v", err)
+		}
+
+		now := time.Now()
+		for i, want := range []int{30, 0} {
+			gq := &game.Question{
+				GameID:     g.ID,
+				QuestionID: testQuiz.Questions[i].ID,
+				StartedAt:  now,
+				ExpiredAt:  now.Add(10 * time.Second),
+			}
+			if err := gameStore.CreateQuestion(t.Context(), gq, false); err != nil {
+				t.Fatalf("failed to create game question: %v", err)
+			}
+			if want > 0 {
+				// Taking the hint twice records it once.
+				for range 2 {
+					if err := gameStore.RecordHint(t.Context(), gq.ID, 1); err != nil {
+						t.Fatalf("RecordHint err = %v, want nil", err)
+					}
+				}
+			}
+
+			a := &game.Answer{
+				GameID:      g.ID,
+				PlayerID:    1,
+				QuestionID:  gq.ID,
+				OptionID:    testQuiz.Questions[i].Options[0].ID,
+				AnsweredAt:  now,
+				HintPenalty: 30,
+			}
+			if err := gameStore.CreateAnswer(t.Context(), a); err != nil {
+				t.Fatalf("CreateAnswer err = %v, want nil", err)
+			}
+			if got := a.HintPenalty; got != want {
+				t.Errorf("question %d HintPenalty = %d, want %d", i, got, want)
+			}
+		}
+	})
+
+	t.Run("copies the quiz's scoring and builds the streak from consecutive correct answers", func(t *testing.T) {
+		t.Parallel()
+		db := dbtest.OpenBackend(t)
+		quizStore := NewQuizStore(db, slog.Default())
+		testQuiz := newTestQuizzes()[0]
+		testQuiz.Scoring = quiz.ScoringStreak
+		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+			t.Fatalf("failed to create quiz: %v", err)
+		}
+
+		gameStore := NewGameStore(db, slog.Default())
+		g := &game.Game{QuizID: testQuiz.ID}
+		if err := gameStore.CreateGame(t.Context(), g); err != nil {
+			t.Fatalf("failed to create game: %v", err)
+		}
+
+		other, err := NewPlayerStore(db, slog.Default()).CreateAnonymousPlayer(t.Context(), "anon-streak")
+		if err != nil {
+			t.Fatalf("failed to create player: %v", err)
+		}
+
+		// Player 1 is right twice in a row; the other is wrong, then right.
+		now := time.Now()
+		picks := map[int64][]int{1: {2, 2}, other.ID: {0, 2}}
+		want := map[int64][]int{1: {1, 2}, other.ID: {0, 1}}
+		for i, qs := range testQuiz.Questions {
+			gq := &game.Question{
+				GameID:     g.ID,
+				QuestionID: qs.ID,
+				StartedAt:  now,
+				ExpiredAt:  now.Add(10 * time.Second),
+			}
+			if err := gameStore.CreateQuestion(t.Context(), gq, false); err != nil {
+				t.Fatalf("failed to create game question: %v", err)
+			}
+			for _, playerID := range []int64{1, other.ID} {
+				option := qs.Options[picks[playerID][i]]
+				a := &game.Answer{
+					GameID:     g.ID,
+					PlayerID:   playerID,
+					QuestionID: gq.ID,
+					OptionID:   option.ID,
+					Option:     option,
+					AnsweredAt: now,
+				}
+				if err := gameStore.CreateAnswer(t.Context(), a); err != nil {
+					t.Fatalf("CreateAnswer err = %v, want nil", err)
+				}
+				if got := a.Scoring; got != quiz.ScoringStreak {
+					t.Errorf("player %d question %d Scoring = %q, want %q", playerID, i, got, quiz.ScoringStreak)
+				}
+				if got := a.Streak; got != want[playerID][i] {
+					t.Errorf("player %d question %d Streak = %d, want %d", playerID, i, got, want[playerID][i])
+				}
+			}
+		}
+	})
+
 	t.Run("returns ErrAnswerAlreadyRecorded on a duplicate answer", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -476,7 +692,7 @@ func TestGameStore_CreateAnswer(t *testing.T) {
 
 	t.Run("wraps the underlying error on a closed DB", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		gameStore := NewGameStore(db, slog.Default())
 		if err := db.Close(); err != nil {
 			t.Fatalf("failed to close db: %v", err)
@@ -503,7 +719,7 @@ func TestGameStore_GetGameByPlayerAndQuiz(t *testing.T) {
 
 	t.Run("returns ErrGameNotFound when player has no game", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -519,7 +735,7 @@ func TestGameStore_GetGameByPlayerAndQuiz(t *testing.T) {
 
 	t.Run("returns the most-recent game for the player and quiz", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
@@ -562,7 +778,7 @@ func TestGameStore_GetRealGameByPlayerAndQuiz(t *testing.T) {
 
 	t.Run("returns ErrGameNotFound when player has no game", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -578,7 +794,7 @@ func TestGameStore_GetRealGameByPlayerAndQuiz(t *testing.T) {
 
 	t.Run("skips a preview game and returns ErrGameNotFound", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
@@ -611,7 +827,7 @@ func TestGameStore_GetRealGameByPlayerAndQuiz(t *testing.T) {
 
 	t.Run("returns the real game", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
@@ -651,7 +867,7 @@ func TestGameStore_DeleteGamesForPlayerOnQuiz(t *testing.T) {
 
 	t.Run("removes games and dependent rows for the player", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
@@ -719,7 +935,7 @@ func TestGameStore_DeleteGamesForPlayerOnQuiz(t *testing.T) {
 
 	t.Run("no-op when player has no games for the quiz", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
@@ -735,7 +951,7 @@ func TestGameStore_DeleteGamesForPlayerOnQuiz(t *testing.T) {
 
 	t.Run("does not touch other players' games on the same quiz", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
@@ -786,7 +1002,7 @@ func TestGameStore_DeleteGamesForPlayerOnQuiz(t *testing.T) {
 
 	t.Run("wraps the underlying error on a closed DB", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		gameStore := NewGameStore(db, slog.Default())
 		if err := db.Close(); err != nil {
 			t.Fatalf("failed to close db: %v", err)
@@ -803,7 +1019,7 @@ func TestGameStore_DeleteGamesForPlayerOnQuiz(t *testing.T) {
 
 	t.Run("rolls back and surfaces the error when a dependent delete fails", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.Open(t) // SQLite trigger syntax.
 
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
@@ -879,7 +1095,7 @@ func TestGameStore_ListAnswersForQuizLeaderboard(t *testing.T) {
 
 	t.Run("returns empty slice for quiz with no games", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -898,7 +1114,7 @@ func TestGameStore_ListAnswersForQuizLeaderboard(t *testing.T) {
 
 	t.Run("returns one row per stored answer with joined fields", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -974,7 +1190,7 @@ func TestGameStore_ListAnswersForQuizLeaderboard(t *testing.T) {
 
 	t.Run("partial games appear with IsCompleted=false", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -1038,7 +1254,7 @@ func TestGameStore_ListAnswersForQuizLeaderboard(t *testing.T) {
 
 	t.Run("only returns answers for the requested quiz", func(t *testing.T) {
 		t.Parallel()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		// Two distinct quizzes; the leaderboard query for quiz A must not
@@ -1119,7 +1335,7 @@ func TestGameStore_ListParticipantsForQuizLeaderboard(t *testing.T) {
 		// exist) but has not yet been issued a question should still
 		// surface so the live leaderboard can show them at 0 / dotted
 		// instead of leaving the table empty.
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -1168,7 +1384,7 @@ func TestGameStore_ListParticipantsForQuizLeaderboard(t *testing.T) {
 		// `<=` or always returned 0 would pass the pre-answer subtest
 		// above. This sibling exercises the true-branch by issuing
 		// every quiz question.
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		testQuiz := newTestQuizzes()[0]
 		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -1223,7 +1439,7 @@ func TestGameStore_ListParticipantsForQuizLeaderboard(t *testing.T) {
 		// #335: prevents the CASE from evaluating 0>=0 -> true and
 		// marking a freshly-joined participant on an empty quiz as
 		// Completed when there is literally no question to answer.
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		emptyQuiz := &quiz.Quiz{
 			Title:             "Empty Quiz",
@@ -1272,7 +1488,7 @@ func TestGameStore_ListParticipantsForQuizLeaderboard(t *testing.T) {
 			// with no game_answer rows referencing it. With staleBefore set
 			// to now, the predicate should fire and surface the participant
 			// as stale even though they have not finished the quiz.
-			db := dbtest.Open(t)
+			db := dbtest.OpenBackend(t)
 			quizStore := NewQuizStore(db, slog.Default())
 			testQuiz := newTestQuizzes()[0]
 			if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -1354,7 +1570,7 @@ const sqliteDateTimeLayout = "2006-01-02 15:04:05"
 func TestGameStore_CreateQuestion_StoresUTCTimestampText(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.Open(t) // pins the SQLite datetime text.
 	quizStore := NewQuizStore(db, slog.Default())
 	testQuiz := newTestQuizzes()[0]
 	if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -1408,7 +1624,7 @@ func TestGameStore_CreateQuestion_StoresUTCTimestampText(t *testing.T) {
 func TestGameStore_ListParticipantsForQuizLeaderboard_StaleBoundary(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 	testQuiz := newTestQuizzes()[0]
 	if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
@@ -1487,7 +1703,7 @@ func TestGameStore_ListParticipantsForQuizLeaderboard_StaleBoundary(t *testing.T
 func TestGameStore_ListQuizIDsForPlayer_IncludesJoinedButUnanswered(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	logger := slog.Default()
 	quizzes := NewQuizStore(db, logger)
 	games := NewGameStore(db, logger)
@@ -1540,7 +1756,7 @@ func TestGameStore_ListAnswersForQuizLeaderboard_UsesQuizIDIndex(t *testing.T) {
 	// EXPLAIN this minimal mirror of that join shape and assert the planner
 	// picks games_quiz_id_idx - the assertion is about the join access path,
 	// not the full leaderboard SQL.
-	db := dbtest.Open(t)
+	db := dbtest.Open(t) // SQLite EXPLAIN QUERY PLAN.
 	rows, err := db.QueryContext(t.Context(),
 		"EXPLAIN QUERY PLAN SELECT 1 FROM game_answers ga JOIN games g ON g.id = ga.game_id WHERE g.quiz_id = ?",
 		int64(1),
@@ -1582,7 +1798,7 @@ func TestGameStore_ListAnswersForQuizLeaderboard_UsesQuizIDIndex(t *testing.T) {
 func TestGameStore_ListAnswersByGameQuestionID_UsesIndex(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.Open(t) // SQLite EXPLAIN QUERY PLAN.
 	rows, err := db.QueryContext(t.Context(),
 		"EXPLAIN QUERY PLAN SELECT 1 FROM game_answers WHERE game_question_id = ?",
 		int64(1),
@@ -1629,3 +1845,172 @@ func readQuizPlayCount(t *testing.T, db *sql.DB, quizID int64) int64 {
 
 	return n
 }
+
+// TestGameStore_ListPlayerGameHistory pins the history page: the player's
+// games newest first with their quiz and answer counts, owner previews and
+// other players' games left out, and a total that ignores the page size.
+func TestGameStore_ListPlayerGameHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.OpenBackend(t)
+	logger := slog.Default()
+	quizzes := NewQuizStore(db, logger)
+	games := NewGameStore(db, logger)
+	playerStore := NewPlayerStore(db, logger)
+
+	player, err := playerStore.CreateAnonymousPlayer(ctx, "history-player")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+	other, err := playerStore.CreateAnonymousPlayer(ctx, "history-other")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+
+	join := func(t *testing.T, title string, playerID int64, preview bool) *game.Game {
+		t.Helper()
+
+		q := &quiz.Quiz{
+			Title: title, Slug: strings.ToLower(title),
+			CreatedByPlayerID: seededAdminID,
+			Questions: []*quiz.Question{
+				{Text: "One?", Position: 1, Options: []*quiz.Option{{Text: "yes", Correct: true}}},
+				{Text: "Two?", Position: 2, Options: []*quiz.Option{{Text: "yes", Correct: true}}},
+			},
+		}
+		if err := quizzes.CreateQuiz(ctx, q); err != nil {
+			t.Fatalf("CreateQuiz err = %v, want nil", err)
+		}
+		g := &game.Game{QuizID: q.ID, Preview: preview}
+		if err := games.CreateGame(ctx, g); err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		p := &game.Participant{GameID: g.ID, PlayerID: playerID, QuizID: q.ID}
+		if err := games.CreateParticipant(ctx, p); err != nil {
+			t.Fatalf("CreateParticipant err = %v, want nil", err)
+		}
+
+		return g
+	}
+
+	first := join(t, "First", player.ID, false)
+	join(t, "Preview", player.ID, true)
+	join(t, "Theirs", other.ID, false)
+	second := join(t, "Second", player.ID, false)
+
+	played, total, err := games.ListPlayerGameHistory(ctx, player.ID, 10, 0)
+	if err != nil {
+		t.Fatalf("ListPlayerGameHistory err = %v, want nil", err)
+	}
+	if got, want := total, int64(2); got != want {
+		t.Errorf("total = %d, want %d", got, want)
+	}
+	if got, want := len(played), 2; got != want {
+		t.Fatalf("len(played) = %d, want %d", got, want)
+	}
+	if played[0].GameID != second.ID || played[1].GameID != first.ID {
+		t.Errorf("played = %s, %s, want %s, %s (newest first)", played[0].GameID, played[1].GameID, second.ID, first.ID)
+	}
+	if got, want := played[0].QuizTitle, "Second"; got != want {
+		t.Errorf("QuizTitle = %q, want %q", got, want)
+	}
+	if played[0].QuestionCount != 2 || played[0].AnsweredCount != 0 {
+		t.Errorf("counts = %d answered of %d, want 0 of 2", played[0].AnsweredCount, played[0].QuestionCount)
+	}
+	if played[0].ScoresHidden {
+		t.Error("ScoresHidden = true, want false on a quiz without host pacing")
+	}
+
+	page, total, err := games.ListPlayerGameHistory(ctx, player.ID, 1, 1)
+	if err != nil {
+		t.Fatalf("ListPlayerGameHistory page 2 err = %v, want nil", err)
+	}
+	if len(page) != 1 || page[0].GameID != first.ID || total != 2 {
+		t.Errorf("page 2 = %d games (total %d), want the first game of 2", len(page), total)
+	}
+}
+
+// TestGameStore_PlayReport pins the analytics page's reporting queries: a
+// game started in the range is counted with its answers and their question,
+// an owner preview is not, and a range that misses the games reports
+// nothing.
+func TestGameStore_PlayReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.OpenBackend(t)
+	logger := slog.Default()
+	quizzes := NewQuizStore(db, logger)
+	games := NewGameStore(db, logger)
+	playerStore := NewPlayerStore(db, logger)
+
+	testQuiz := newTestQuizzes()[0]
+	if err := quizzes.CreateQuiz(ctx, testQuiz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+	player, err := playerStore.CreateAnonymousPlayer(ctx, "report-player")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+	owner, err := playerStore.CreateAnonymousPlayer(ctx, "report-owner")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	question := testQuiz.Questions[0]
+	play := func(t *testing.T, playerID int64, preview bool) {
+		t.Helper()
+
+		g := &game.Game{QuizID: testQuiz.ID, Preview: preview}
+		if err := games.CreateGame(ctx, g); err != nil {
+			t.Fatalf("CreateGame err = %v, want nil", err)
+		}
+		p := &game.Participant{GameID: g.ID, PlayerID: playerID, QuizID: testQuiz.ID}
+		if err := games.CreateParticipant(ctx, p); err != nil {
+			t.Fatalf("CreateParticipant err = %v, want nil", err)
+		}
+		gq := &game.Question{GameID: g.ID, QuestionID: question.ID, StartedAt: now, ExpiredAt: now.Add(10 * time.Second)}
+		if err := games.CreateQuestion(ctx, gq, false); err != nil {
+			t.Fatalf("CreateQuestion err = %v, want nil", err)
+		}
+		a := &game.Answer{GameID: g.ID, PlayerID: playerID, QuestionID: gq.ID, OptionID: question.Options[2].ID}
+		if err := games.CreateAnswer(ctx, a); err != nil {
+			t.Fatalf("CreateAnswer err = %v, want nil", err)
+		}
+	}
+	play(t, player.ID, false)
+	play(t, owner.ID, true)
+
+	from, to := now.Add(-time.Hour), now.Add(time.Hour)
+	counts, err := games.ListQuizPlayReport(ctx, from, to)
+	if err != nil {
+		t.Fatalf("ListQuizPlayReport err = %v, want nil", err)
+	}
+	want := []*game.QuizPlayCount{{QuizID: testQuiz.ID, QuizTitle: testQuiz.Title, Plays: 1}}
+	if diff := cmp.Diff(want, counts); diff != "" {
+		t.Errorf("ListQuizPlayReport mismatch (-want +got):\n%s", diff)
+	}
+
+	answers, err := games.ListAnswersForPlayReport(ctx, from, to)
+	if err != nil {
+		t.Fatalf("ListAnswersForPlayReport err = %v, want nil", err)
+	}
+	if got, want := len(answers), 1; got != want {
+		t.Fatalf("len(answers) = %d, want %d", got, want)
+	}
+	if a := answers[0]; a.QuizID != testQuiz.ID || a.QuestionID != question.ID || a.QuestionText != question.Text ||
+		!a.Correct {
+		t.Errorf("answer = quiz %d question %d %q correct %v, want quiz %d question %d %q correct",
+			a.QuizID, a.QuestionID, a.QuestionText, a.Correct, testQuiz.ID, question.ID, question.Text)
+	}
+
+	earlier := from.Add(-time.Hour)
+	if counts, err = games.ListQuizPlayReport(ctx, earlier, from); err != nil || len(counts) != 0 {
+		t.Errorf("ListQuizPlayReport before the games = %d rows, err %v, want none", len(counts), err)
+	}
+	if answers, err = games.ListAnswersForPlayReport(ctx, earlier, from); err != nil || len(answers) != 0 {
+		t.Errorf("ListAnswersForPlayReport before the games = %d rows, err %v, want none", len(answers), err)
+	}
+}
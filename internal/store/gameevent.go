@@ -0,0 +1,74 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/starquake/topbanana/internal/analytics"
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/db"
+	"github.com/starquake/topbanana/internal/game"
+)
+
+// GameEventStore is the game_events table, the append-only game event log. It
+// is the [analytics.Sink] behind the log's emitter and implements
+// [game.EventLogReader] for the admin debugging view.
+type GameEventStore struct {
+	q  *db.Queries
+	db *sql.DB
+}
+
+// NewGameEventStore wires a GameEventStore against the supplied database
+// connection.
+func NewGameEventStore(conn *sql.DB) *GameEventStore {
+	return &GameEventStore{q: newQueries(conn), db: conn}
+}
+
+// Send appends a batch of events in one transaction. An event whose game no
+// longer exists is skipped rather than failing the batch.
+func (s *GameEventStore) Send(ctx context.Context, events []analytics.Event) error {
+	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		for _, e := range events {
+			payload, err := json.Marshal(e)
+			if err != nil {
+				return fmt.Errorf("encode game event: %w", err)
+			}
+			if _, err = q.CreateGameEvent(ctx, db.CreateGameEventParams{
+				Type:    e.Type,
+				Payload: string(payload),
+				GameID:  e.GameID,
+			}); err != nil {
+				return fmt.Errorf("insert game event: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to append game events: %w", err)
+	}
+
+	return nil
+}
+
+// ListGameEvents returns the game's event log, oldest entry first. A game
+// with no events, or no such game, returns an empty slice.
+func (s *GameEventStore) ListGameEvents(ctx context.Context, gameID string) ([]*game.LoggedEvent, error) {
+	rows, err := s.q.ListGameEventsByGameID(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game events: %w", err)
+	}
+
+	out := make([]*game.LoggedEvent, 0, len(rows))
+	for _, r := range rows {
+		e := &game.LoggedEvent{ID: r.ID, RecordedAt: r.RecordedAt}
+		if err = json.Unmarshal([]byte(r.Payload), &e.Event); err != nil {
+			return nil, fmt.Errorf("failed to decode game event %d: %w", r.ID, err)
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,75 @@
+package store_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/analytics"
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/game"
+	. "github.com/starquake/topbanana/internal/store"
+)
+
+// TestGameEventStore_AppendAndList pins the game event log round trip: a
+// batch lands in order with every field of the emitted event intact, an
+// event for a game that no longer exists is skipped without failing the
+// rest of the batch, and a logged row cannot be rewritten.
+func TestGameEventStore_AppendAndList(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	testQuiz := newTestQuizzes()[0]
+	if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+	g := &game.Game{QuizID: testQuiz.ID}
+	if err := NewGameStore(db, slog.Default()).CreateGame(t.Context(), g); err != nil {
+		t.Fatalf("failed to create game: %v", err)
+	}
+
+	at := time.Date(2026, 8, 16, 12, 0, 0, 0, time.UTC)
+	correct := true
+	events := NewGameEventStore(db)
+	err := events.Send(t.Context(), []analytics.Event{
+		{Type: analytics.TypeGameCreated, At: at, GameID: g.ID, QuizID: testQuiz.ID, PlayerID: seededAdminID},
+		{Type: analytics.TypeGameCreated, At: at, GameID: "gone", QuizID: testQuiz.ID, PlayerID: seededAdminID},
+		{
+			Type: analytics.TypeAnswerSubmitted, At: at.Add(time.Second), GameID: g.ID, QuizID: testQuiz.ID,
+			PlayerID: seededAdminID, QuestionID: testQuiz.Questions[0].ID, Correct: &correct,
+			LatencyBucket: analytics.BucketUnder1s,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Send err = %v, want nil", err)
+	}
+
+	logged, err := events.ListGameEvents(t.Context(), g.ID)
+	if err != nil {
+		t.Fatalf("ListGameEvents err = %v, want nil", err)
+	}
+	if got, want := len(logged), 2; got != want {
+		t.Fatalf("logged events = %d, want %d (%+v)", got, want, logged)
+	}
+	if got, want := logged[0].Type, analytics.TypeGameCreated; got != want {
+		t.Errorf("logged[0].Type = %q, want %q", got, want)
+	}
+	answered := logged[1]
+	if answered.Type != analytics.TypeAnswerSubmitted || !answered.At.Equal(at.Add(time.Second)) ||
+		answered.Correct == nil || !*answered.Correct || answered.QuestionID != testQuiz.Questions[0].ID {
+		t.Errorf("logged[1] = %+v, want the answer_submitted event as sent", answered.Event)
+	}
+	if logged[0].ID >= answered.ID || answered.RecordedAt.IsZero() {
+		t.Errorf("logged ids = %d, %d and RecordedAt %v, want increasing ids and a stamp",
+			logged[0].ID, answered.ID, answered.RecordedAt)
+	}
+
+	if gone, lErr := events.ListGameEvents(t.Context(), "gone"); lErr != nil || len(gone) != 0 {
+		t.Errorf("ListGameEvents(gone) = %+v, %v, want empty, nil", gone, lErr)
+	}
+
+	if _, err = db.ExecContext(t.Context(), "UPDATE game_events SET type = 'rewritten'"); err == nil {
+		t.Error("UPDATE game_events err = nil, want the append-only trigger to reject it")
+	}
+}
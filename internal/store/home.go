@@ -18,7 +18,7 @@ type HomeStore struct {
 
 // NewHomeStore wires a HomeStore against the supplied database connection.
 func NewHomeStore(conn *sql.DB) *HomeStore {
-	return &HomeStore{q: db.New(conn)}
+	return &HomeStore{q: newQueries(conn)}
 }
 
 // ListPopularQuizzes returns the top-ranked quizzes by recent play
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -12,6 +13,7 @@ import (
 	"modernc.org/sqlite"
 	sqlite3 "modernc.org/sqlite/lib"
 
+	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/database"
 	"github.com/starquake/topbanana/internal/db"
 	"github.com/starquake/topbanana/internal/livesession"
@@ -32,7 +34,7 @@ type LiveSessionStore struct {
 // database connection and logger.
 func NewLiveSessionStore(conn *sql.DB, logger *slog.Logger) *LiveSessionStore {
 	return &LiveSessionStore{
-		q:      db.New(conn),
+		q:      newQueries(conn),
 		db:     conn,
 		logger: logger,
 		newID:  func() string { return xid.New().String() },
@@ -164,6 +166,41 @@ func (s *LiveSessionStore) AddPlayer(
 	return playerFromSessionRow(row), nil
 }
 
+// HasJoined reports whether the player holds a roster row in the session,
+// left or not.
+func (s *LiveSessionStore) HasJoined(ctx context.Context, sessionID string, playerID int64) (bool, error) {
+	_, err := s.q.GetSessionPlayer(ctx, db.GetSessionPlayerParams{
+		SessionID: sessionID,
+		PlayerID:  playerID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get session player: %w", err)
+	}
+
+	return true, nil
+}
+
+// RecordLateJoin records that the player joined the session's game gameSeq
+// after it started, with missed questions already closed. A repeat for the
+// same game keeps the first count.
+func (s *LiveSessionStore) RecordLateJoin(
+	ctx context.Context, sessionID string, playerID, gameSeq int64, missed int,
+) error {
+	if err := s.q.InsertSessionLateJoin(ctx, db.InsertSessionLateJoinParams{
+		SessionID:       sessionID,
+		PlayerID:        playerID,
+		GameSeq:         gameSeq,
+		MissedQuestions: int64(missed),
+	}); err != nil {
+		return fmt.Errorf("failed to record late join: %w", err)
+	}
+
+	return nil
+}
+
 // SetReady toggles a participant's ready flag. Returns
 // [livesession.ErrNotParticipant] when the UPDATE matches no roster row
 // (the player has not joined the session).
@@ -191,6 +228,48 @@ func (s *LiveSessionStore) SetReady(
 	return nil
 }
 
+// SetPlayerNotes replaces the host's notes on a participant and writes the
+// matching admin_audit row (actor the host, target the participant, payload
+// the join code and the new notes) in one transaction, so a note never
+// changes unrecorded. Returns [livesession.ErrNotParticipant] when the UPDATE
+// matches no roster row.
+func (s *LiveSessionStore) SetPlayerNotes(
+	ctx context.Context, sessionID, joinCode string, hostPlayerID, playerID int64, notes string,
+) error {
+	payload, err := json.Marshal(map[string]string{"join_code": joinCode, "notes": notes})
+	if err != nil {
+		return fmt.Errorf("failed to marshal player notes audit payload: %w", err)
+	}
+	err = database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		res, uerr := q.SetSessionPlayerNotes(ctx, db.SetSessionPlayerNotesParams{
+			Notes:     notes,
+			SessionID: sessionID,
+			PlayerID:  playerID,
+		})
+		if uerr != nil {
+			return fmt.Errorf("failed to set session player notes: %w", uerr)
+		}
+		if database.MustRowsAffected(res) == 0 {
+			return livesession.ErrNotParticipant
+		}
+		if aerr := q.InsertAdminAudit(ctx, db.InsertAdminAuditParams{
+			ActorPlayerID:  sql.NullInt64{Int64: hostPlayerID, Valid: true},
+			TargetPlayerID: playerID,
+			Action:         auth.AdminActionParticipantNotes,
+			Payload:        string(payload),
+		}); aerr != nil {
+			return fmt.Errorf("failed to insert player notes audit: %w", aerr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set player notes: %w", err)
+	}
+
+	return nil
+}
+
 // GetSessionByID resolves a session by its primary key with the lobby roster
 // populated. Returns [livesession.ErrSessionNotFound] when the id is unknown.
 func (s *LiveSessionStore) GetSessionByID(ctx context.Context, id string) (*livesession.Session, error) {
@@ -256,6 +335,176 @@ func (s *LiveSessionStore) CancelStart(ctx context.Context, sessionID string) er
 	return nil
 }
 
+// SetHostAdvance turns the host's pacing of the session on or off. Returns
+// [livesession.ErrSessionNotFound] when the UPDATE matches no row (an unknown
+// or closed session).
+func (s *LiveSessionStore) SetHostAdvance(ctx context.Context, sessionID string, on bool) error {
+	res, err := s.q.SetSessionHostAdvance(ctx, db.SetSessionHostAdvanceParams{
+		HostAdvance: boolToInt64(on),
+		ID:          sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set session host advance: %w", err)
+	}
+	if database.MustRowsAffected(res) == 0 {
+		return livesession.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// ExtendQuestion moves the open question's answer deadline to expiresAt.
+// Returns [livesession.ErrQuestionNotOpen] when the UPDATE matches no row (the
+// session left the question phase or moved on to another question).
+func (s *LiveSessionStore) ExtendQuestion(
+	ctx context.Context, sessionID string, questionID int64, expiresAt time.Time,
+) error {
+	res, err := s.q.ExtendSessionQuestion(ctx, db.ExtendSessionQuestionParams{
+		QuestionExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+		ID:                sessionID,
+		CurrentQuestionID: sql.NullInt64{Int64: questionID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to extend session question: %w", err)
+	}
+	if database.MustRowsAffected(res) == 0 {
+		return livesession.ErrQuestionNotOpen
+	}
+
+	return nil
+}
+
+// CloseAnswers closes the open question's answering at closedAt, leaving its
+// scoring window alone. Zero rows affected means the question is no longer
+// open for answers (the runner closed it first, or it was already closed), so
+// it surfaces [livesession.ErrQuestionNotOpen].
+func (s *LiveSessionStore) CloseAnswers(
+	ctx context.Context, sessionID string, questionID int64, closedAt time.Time,
+) error {
+	res, err := s.q.CloseSessionAnswers(ctx, db.CloseSessionAnswersParams{
+		AnswersClosedAt:   sql.NullTime{Time: closedAt, Valid: true},
+		ID:                sessionID,
+		CurrentQuestionID: sql.NullInt64{Int64: questionID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close session answers: %w", err)
+	}
+	if database.MustRowsAffected(res) == 0 {
+		return livesession.ErrQuestionNotOpen
+	}
+
+	return nil
+}
+
+// ListQuestionPlan returns the host's reorders and skips of the room's game
+// gameSeq, in reorder order.
+func (s *LiveSessionStore) ListQuestionPlan(
+	ctx context.Context, sessionID string, gameSeq int64,
+) ([]*livesession.PlanChange, error) {
+	rows, err := s.q.ListSessionQuestionPlan(ctx, db.ListSessionQuestionPlanParams{
+		SessionID: sessionID,
+		GameSeq:   gameSeq,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session question plan: %w", err)
+	}
+
+	return planChangesFromRows(rows), nil
+}
+
+// ReorderQuestions ranks questionIDs past every earlier reorder of the
+// session's current game, in one transaction with the check that the game is
+// still at sess's point. Returns [livesession.ErrPlanMoved] when it is not.
+func (s *LiveSessionStore) ReorderQuestions(ctx context.Context, sess *livesession.Session, questionIDs []int64) error {
+	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		if err := checkPlanPoint(ctx, q, sess); err != nil {
+			return err
+		}
+		rows, err := q.ListSessionQuestionPlan(ctx, db.ListSessionQuestionPlanParams{
+			SessionID: sess.ID,
+			GameSeq:   sess.GameSeq,
+		})
+		if err != nil {
+			return fmt.Errorf("list session question plan: %w", err)
+		}
+		var last int64
+		if len(rows) > 0 {
+			last = rows[len(rows)-1].Position
+		}
+		for i, id := range questionIDs {
+			err = q.UpsertSessionQuestionPosition(ctx, db.UpsertSessionQuestionPositionParams{
+				SessionID:  sess.ID,
+				GameSeq:    sess.GameSeq,
+				QuestionID: id,
+				Position:   last + int64(i) + 1,
+			})
+			if err != nil {
+				return fmt.Errorf("upsert session question position: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reorder session questions: %w", err)
+	}
+
+	return nil
+}
+
+// SkipQuestion drops questionID from the session's current game, under the
+// same point check as ReorderQuestions.
+func (s *LiveSessionStore) SkipQuestion(ctx context.Context, sess *livesession.Session, questionID int64) error {
+	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		if err := checkPlanPoint(ctx, q, sess); err != nil {
+			return err
+		}
+		err := q.SkipSessionQuestion(ctx, db.SkipSessionQuestionParams{
+			SessionID:  sess.ID,
+			GameSeq:    sess.GameSeq,
+			QuestionID: questionID,
+		})
+		if err != nil {
+			return fmt.Errorf("skip session question: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to skip session question: %w", err)
+	}
+
+	return nil
+}
+
+// checkPlanPoint re-reads the session inside a plan change's transaction and
+// returns [livesession.ErrPlanMoved] when the runner moved it on since sess
+// was loaded.
+func checkPlanPoint(ctx context.Context, q *db.Queries, sess *livesession.Session) error {
+	row, err := q.GetSession(ctx, sess.ID)
+	if err != nil {
+		return fmt.Errorf("get session: %w", err)
+	}
+	if !sessionFromRow(row).SamePoint(sess) {
+		return livesession.ErrPlanMoved
+	}
+
+	return nil
+}
+
+func planChangesFromRows(rows []db.ListSessionQuestionPlanRow) []*livesession.PlanChange {
+	changes := make([]*livesession.PlanChange, len(rows))
+	for i, r := range rows {
+		changes[i] = &livesession.PlanChange{
+			QuestionID: r.QuestionID,
+			Position:   int(r.Position),
+			Skipped:    r.Skipped != 0,
+		}
+	}
+
+	return changes
+}
+
 // EnterRoundIntro moves the session into the round_intro phase for the round.
 // Optimistic write against expected (the phase the runner loaded): reports false
 // when it wrote no row because the session moved on (e.g. was ended).
@@ -419,10 +668,11 @@ func (s *LiveSessionStore) ListRoundStandings(
 	standings := make([]*livesession.Standing, 0, len(rows))
 	for _, r := range rows {
 		standings = append(standings, &livesession.Standing{
-			PlayerID:    r.PlayerID,
-			DisplayName: r.DisplayName,
-			RoundScore:  int(r.RoundScore),
-			TotalScore:  int(r.TotalScore),
+			PlayerID:        r.PlayerID,
+			DisplayName:     r.DisplayName,
+			RoundScore:      int(r.RoundScore),
+			TotalScore:      int(r.TotalScore),
+			MissedQuestions: int(r.MissedQuestions),
 		})
 	}
 
@@ -443,9 +693,10 @@ func (s *LiveSessionStore) ListFinalStandings(
 	standings := make([]*livesession.Standing, 0, len(rows))
 	for _, r := range rows {
 		standings = append(standings, &livesession.Standing{
-			PlayerID:    r.PlayerID,
-			DisplayName: r.DisplayName,
-			TotalScore:  int(r.TotalScore),
+			PlayerID:        r.PlayerID,
+			DisplayName:     r.DisplayName,
+			TotalScore:      int(r.TotalScore),
+			MissedQuestions: int(r.MissedQuestions),
 		})
 	}
 
@@ -641,15 +892,18 @@ func (s *LiveSessionStore) ListAnswers(
 	return answers, nil
 }
 
-// SetAnswerScore writes the computed score for one pick at close.
+// SetAnswerScore writes the computed score for one pick at close, with the
+// answer window it was scored against.
 func (s *LiveSessionStore) SetAnswerScore(
-	ctx context.Context, sessionID string, questionID, playerID int64, score int,
+	ctx context.Context, sessionID string, questionID, playerID int64, score int, startedAt, expiresAt time.Time,
 ) error {
 	if err := s.q.SetSessionAnswerScore(ctx, db.SetSessionAnswerScoreParams{
-		Score:      sql.NullInt64{Int64: int64(score), Valid: true},
-		SessionID:  sessionID,
-		QuestionID: questionID,
-		PlayerID:   playerID,
+		Score:           sql.NullInt64{Int64: int64(score), Valid: true},
+		WindowStartedAt: sql.NullTime{Time: startedAt, Valid: true},
+		WindowExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+		SessionID:       sessionID,
+		QuestionID:      questionID,
+		PlayerID:        playerID,
 	}); err != nil {
 		return fmt.Errorf("failed to set session answer score: %w", err)
 	}
@@ -657,6 +911,63 @@ func (s *LiveSessionStore) SetAnswerScore(
 	return nil
 }
 
+// ListScoredAnswersForQuiz returns every scored live pick on the quiz's
+// questions, across every room and game that ran it, with the window each was
+// scored against (nil when it predates the recorded window).
+func (s *LiveSessionStore) ListScoredAnswersForQuiz(
+	ctx context.Context, quizID int64,
+) ([]*livesession.ScoredAnswer, error) {
+	rows, err := s.q.ListScoredSessionAnswersForQuiz(ctx, quizID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scored session answers for quiz %d: %w", quizID, err)
+	}
+
+	answers := make([]*livesession.ScoredAnswer, 0, len(rows))
+	for _, r := range rows {
+		a := &livesession.ScoredAnswer{
+			ID:          r.ID,
+			SessionID:   r.SessionID,
+			JoinCode:    r.JoinCode,
+			GameSeq:     r.GameSeq,
+			QuestionID:  r.QuestionID,
+			PlayerID:    r.PlayerID,
+			DisplayName: r.DisplayName,
+			AnsweredAt:  r.AnsweredAt,
+			Correct:     r.IsCorrect,
+			Score:       int(r.Score.Int64),
+		}
+		if r.WindowStartedAt.Valid && r.WindowExpiresAt.Valid {
+			a.WindowStartedAt = &r.WindowStartedAt.Time
+			a.WindowExpiresAt = &r.WindowExpiresAt.Time
+		}
+		answers = append(answers, a)
+	}
+
+	return answers, nil
+}
+
+// UpdateAnswerScores overwrites the stored score of each answer id in scores
+// in one transaction, so an applied recalculation lands whole or not at all.
+func (s *LiveSessionStore) UpdateAnswerScores(ctx context.Context, scores map[int64]int) error {
+	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		for id, score := range scores {
+			if uerr := q.UpdateSessionAnswerScore(ctx, db.UpdateSessionAnswerScoreParams{
+				Score: sql.NullInt64{Int64: int64(score), Valid: true},
+				ID:    id,
+			}); uerr != nil {
+				return fmt.Errorf("failed to update session answer %d score: %w", id, uerr)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update session answer scores: %w", err)
+	}
+
+	return nil
+}
+
 // ListLiveSessionIDs returns the ids of every session not yet finished.
 func (s *LiveSessionStore) ListLiveSessionIDs(ctx context.Context) ([]string, error) {
 	ids, err := s.q.ListLiveSessionIDs(ctx)
@@ -691,6 +1002,7 @@ func sessionFromRow(row db.Session) *livesession.Session {
 		JoinCode:     row.JoinCode,
 		Phase:        livesession.Phase(row.Phase),
 		GameSeq:      row.GameSeq,
+		HostAdvance:  row.HostAdvance != 0,
 		CreatedAt:    row.CreatedAt,
 	}
 	if row.QuizID.Valid {
@@ -708,6 +1020,9 @@ func sessionFromRow(row db.Session) *livesession.Session {
 	if row.QuestionExpiresAt.Valid {
 		sess.QuestionExpiresAt = &row.QuestionExpiresAt.Time
 	}
+	if row.AnswersClosedAt.Valid {
+		sess.AnswersClosedAt = &row.AnswersClosedAt.Time
+	}
 	if row.StartedAt.Valid {
 		sess.StartedAt = &row.StartedAt.Time
 	}
@@ -751,6 +1066,7 @@ func playerFromRosterRow(row db.ListSessionPlayersRow) *livesession.Player {
 		IsReady:     row.IsReady != 0,
 		JoinedAt:    row.JoinedAt,
 		LastSeenAt:  row.LastSeenAt,
+		Notes:       row.Notes,
 	}
 }
 
@@ -766,5 +1082,6 @@ func playerFromSessionRow(row db.SessionPlayer) *livesession.Player {
 		IsReady:    row.IsReady != 0,
 		JoinedAt:   row.JoinedAt,
 		LastSeenAt: row.LastSeenAt,
+		Notes:      row.Notes,
 	}
 }
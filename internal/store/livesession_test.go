@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/dbtest"
 	"github.com/starquake/topbanana/internal/livesession"
 	"github.com/starquake/topbanana/internal/quiz"
@@ -316,6 +317,69 @@ func TestLiveSessionStore_SetReady_NotParticipant(t *testing.T) {
 	}
 }
 
+// TestLiveSessionStore_SetPlayerNotes pins the host notes write: the roster
+// read carries the notes, the change is audit-logged against the participant
+// with the host as actor, and a player with no roster row is rejected without
+// an audit row.
+func TestLiveSessionStore_SetPlayerNotes(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	playerStore := NewPlayerStore(db, slog.Default())
+	sessionStore := NewLiveSessionStore(db, slog.Default())
+	qz := newLiveQuiz(t, quizStore)
+
+	sess := &livesession.Session{QuizID: liveQuizIDPtr(qz.ID), HostPlayerID: seededAdminID, JoinCode: "NOTE23"}
+	if err := sessionStore.CreateSession(t.Context(), sess); err != nil {
+		t.Fatalf("CreateSession err = %v, want nil", err)
+	}
+	p1, err := playerStore.CreateAnonymousPlayer(t.Context(), "note-p1")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+	if _, err = sessionStore.AddPlayer(t.Context(), sess.ID, p1.ID); err != nil {
+		t.Fatalf("AddPlayer err = %v, want nil", err)
+	}
+
+	if err = sessionStore.SetPlayerNotes(
+		t.Context(), sess.ID, sess.JoinCode, seededAdminID, p1.ID, "table 4",
+	); err != nil {
+		t.Fatalf("SetPlayerNotes err = %v, want nil", err)
+	}
+	loaded, err := sessionStore.GetSessionByJoinCode(t.Context(), "NOTE23")
+	if err != nil {
+		t.Fatalf("GetSessionByJoinCode err = %v, want nil", err)
+	}
+	if got, want := loaded.Players[0].Notes, "table 4"; got != want {
+		t.Errorf("Notes = %q, want %q", got, want)
+	}
+
+	entries, err := playerStore.ListAdminAuditForTarget(t.Context(), p1.ID, 10)
+	if err != nil {
+		t.Fatalf("ListAdminAuditForTarget err = %v, want nil", err)
+	}
+	if got, want := len(entries), 1; got != want {
+		t.Fatalf("len(audit) = %d, want %d", got, want)
+	}
+	if e := entries[0]; e.Action != auth.AdminActionParticipantNotes || e.ActorPlayerID != seededAdminID ||
+		e.Payload != `{"join_code":"NOTE23","notes":"table 4"}` {
+		t.Errorf("audit entry = %+v, want a notes row by the host carrying the room and notes", e)
+	}
+
+	err = sessionStore.SetPlayerNotes(t.Context(), sess.ID, sess.JoinCode, seededAdminID, seededAdminID, "x")
+	if got, want := err, livesession.ErrNotParticipant; !errors.Is(got, want) {
+		t.Errorf("SetPlayerNotes non-participant err = %v, want %v", got, want)
+	}
+	entries, err = playerStore.ListAdminAuditForTarget(t.Context(), seededAdminID, 10)
+	if err != nil {
+		t.Fatalf("ListAdminAuditForTarget err = %v, want nil", err)
+	}
+	if got := len(entries); got != 0 {
+		t.Errorf("len(audit) for a rejected notes write = %d, want 0", got)
+	}
+}
+
 // newLiveQuizWithQuestion seeds a live quiz carrying one question with a
 // correct + wrong option, returning the quiz with its rounds/questions
 // loaded, so the runner-facing store tests have real round/question/option
@@ -516,6 +580,40 @@ func TestLiveSessionStore_ArmAndCancelStart(t *testing.T) {
 	}
 }
 
+func TestLiveSessionStore_SetHostAdvance(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	sessionStore := NewLiveSessionStore(db, slog.Default())
+	qz := newLiveQuizWithQuestion(t, quizStore)
+
+	sess := &livesession.Session{QuizID: liveQuizIDPtr(qz.ID), HostPlayerID: seededAdminID, JoinCode: "PAC234"}
+	if err := sessionStore.CreateSession(t.Context(), sess); err != nil {
+		t.Fatalf("CreateSession err = %v, want nil", err)
+	}
+	if sess.HostAdvance {
+		t.Error("HostAdvance on a new session = true, want false")
+	}
+
+	for _, on := range []bool{true, false} {
+		if err := sessionStore.SetHostAdvance(t.Context(), sess.ID, on); err != nil {
+			t.Fatalf("SetHostAdvance(%t) err = %v, want nil", on, err)
+		}
+		got, err := sessionStore.GetSessionByID(t.Context(), sess.ID)
+		if err != nil {
+			t.Fatalf("GetSessionByID err = %v, want nil", err)
+		}
+		if got.HostAdvance != on {
+			t.Errorf("HostAdvance = %t, want %t", got.HostAdvance, on)
+		}
+	}
+
+	if err := sessionStore.SetHostAdvance(t.Context(), "missing", true); !errors.Is(err, livesession.ErrSessionNotFound) {
+		t.Errorf("SetHostAdvance on an unknown session err = %v, want %v", err, livesession.ErrSessionNotFound)
+	}
+}
+
 func TestLiveSessionStore_AnswersRoundTrip(t *testing.T) {
 	t.Parallel()
 
@@ -566,7 +664,10 @@ func TestLiveSessionStore_AnswersRoundTrip(t *testing.T) {
 		t.Errorf("answer Score = %v, want nil before scoring", *answers[0].Score)
 	}
 
-	if err = sessionStore.SetAnswerScore(t.Context(), sess.ID, q.ID, p.ID, 800); err != nil {
+	startedAt := answeredAt.Add(-5 * time.Second)
+	if err = sessionStore.SetAnswerScore(
+		t.Context(), sess.ID, q.ID, p.ID, 800, startedAt, startedAt.Add(20*time.Second),
+	); err != nil {
 		t.Fatalf("SetAnswerScore err = %v, want nil", err)
 	}
 	scored, err := sessionStore.ListAnswers(t.Context(), sess.ID, q.ID)
@@ -1151,7 +1252,9 @@ func scoreAnswer(
 	if err := s.RecordAnswer(t.Context(), sessionID, questionID, playerID, optionID, answeredAt); err != nil {
 		t.Fatalf("RecordAnswer err = %v, want nil", err)
 	}
-	if err := s.SetAnswerScore(t.Context(), sessionID, questionID, playerID, score); err != nil {
+	if err := s.SetAnswerScore(
+		t.Context(), sessionID, questionID, playerID, score, answeredAt.Add(-time.Second), answeredAt.Add(10*time.Second),
+	); err != nil {
 		t.Fatalf("SetAnswerScore err = %v, want nil", err)
 	}
 }
@@ -1475,3 +1578,135 @@ func TestLiveSessionStore_Intermission_RepeatCallDoesNotDoubleBump(t *testing.T)
 		t.Errorf("play_count after repeat intermission = %d, want %d (no double-bump)", got, want)
 	}
 }
+
+// TestLiveSessionStore_LateJoin pins the late-join bookkeeping: HasJoined
+// sees a roster row, a recorded late join surfaces as MissedQuestions on both
+// standings reads, a repeat record keeps the first count, and a mark for
+// another game in the room does not leak into the current one.
+func TestLiveSessionStore_LateJoin(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	playerStore := NewPlayerStore(db, slog.Default())
+	sessionStore := NewLiveSessionStore(db, slog.Default())
+	qz := newLiveQuiz(t, quizStore)
+
+	sess := &livesession.Session{QuizID: liveQuizIDPtr(qz.ID), HostPlayerID: seededAdminID, JoinCode: "LATE23"}
+	if err := sessionStore.CreateSession(t.Context(), sess); err != nil {
+		t.Fatalf("CreateSession err = %v, want nil", err)
+	}
+	early, err := playerStore.CreateAnonymousPlayer(t.Context(), "late-early")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer early err = %v, want nil", err)
+	}
+	late, err := playerStore.CreateAnonymousPlayer(t.Context(), "late-late")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer late err = %v, want nil", err)
+	}
+	if _, err = sessionStore.AddPlayer(t.Context(), sess.ID, early.ID); err != nil {
+		t.Fatalf("AddPlayer early err = %v, want nil", err)
+	}
+
+	joined, err := sessionStore.HasJoined(t.Context(), sess.ID, late.ID)
+	if err != nil {
+		t.Fatalf("HasJoined err = %v, want nil", err)
+	}
+	if joined {
+		t.Error("HasJoined before joining = true, want false")
+	}
+	if _, err = sessionStore.AddPlayer(t.Context(), sess.ID, late.ID); err != nil {
+		t.Fatalf("AddPlayer late err = %v, want nil", err)
+	}
+	if joined, err = sessionStore.HasJoined(t.Context(), sess.ID, late.ID); err != nil || !joined {
+		t.Errorf("HasJoined after joining = %t, %v, want true, nil", joined, err)
+	}
+
+	if err = sessionStore.RecordLateJoin(t.Context(), sess.ID, late.ID, 1, 3); err != nil {
+		t.Fatalf("RecordLateJoin err = %v, want nil", err)
+	}
+	if err = sessionStore.RecordLateJoin(t.Context(), sess.ID, late.ID, 1, 5); err != nil {
+		t.Fatalf("RecordLateJoin repeat err = %v, want nil", err)
+	}
+	// A mark for a later game in the room is not this game's.
+	if err = sessionStore.RecordLateJoin(t.Context(), sess.ID, early.ID, 2, 4); err != nil {
+		t.Fatalf("RecordLateJoin other game err = %v, want nil", err)
+	}
+
+	final, err := sessionStore.ListFinalStandings(t.Context(), sess.ID)
+	if err != nil {
+		t.Fatalf("ListFinalStandings err = %v, want nil", err)
+	}
+	round, err := sessionStore.ListRoundStandings(t.Context(), sess.ID, qz.Questions[0].RoundID)
+	if err != nil {
+		t.Fatalf("ListRoundStandings err = %v, want nil", err)
+	}
+	want := map[int64]int{early.ID: 0, late.ID: 3}
+	for name, standings := range map[string][]*livesession.Standing{"final": final, "round": round} {
+		if got, wantLen := len(standings), 2; got != wantLen {
+			t.Fatalf("%s standings = %d rows, want %d", name, got, wantLen)
+		}
+		for _, st := range standings {
+			if got := st.MissedQuestions; got != want[st.PlayerID] {
+				t.Errorf("%s standings: player %d MissedQuestions = %d, want %d", name, st.PlayerID, got, want[st.PlayerID])
+			}
+		}
+	}
+}
+
+// TestLiveSessionStore_QuestionPlan pins the host's plan changes: each reorder
+// ranks past the last, a skip keeps the question's rank, a later game starts
+// clean, and a change made against a point the session has left is
+// ErrPlanMoved.
+func TestLiveSessionStore_QuestionPlan(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.Open(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	sessionStore := NewLiveSessionStore(db, slog.Default())
+	qz := newLiveQuiz(t, quizStore)
+	questionID := qz.Questions[0].ID
+
+	created := &livesession.Session{QuizID: liveQuizIDPtr(qz.ID), HostPlayerID: seededAdminID, JoinCode: "PLAN23"}
+	if err := sessionStore.CreateSession(t.Context(), created); err != nil {
+		t.Fatalf("CreateSession err = %v, want nil", err)
+	}
+	sess, err := sessionStore.GetSessionByID(t.Context(), created.ID)
+	if err != nil {
+		t.Fatalf("GetSessionByID err = %v, want nil", err)
+	}
+
+	for range 2 {
+		if err = sessionStore.ReorderQuestions(t.Context(), sess, []int64{questionID}); err != nil {
+			t.Fatalf("ReorderQuestions err = %v, want nil", err)
+		}
+	}
+	if err = sessionStore.SkipQuestion(t.Context(), sess, questionID); err != nil {
+		t.Fatalf("SkipQuestion err = %v, want nil", err)
+	}
+	changes, err := sessionStore.ListQuestionPlan(t.Context(), sess.ID, sess.GameSeq)
+	if err != nil {
+		t.Fatalf("ListQuestionPlan err = %v, want nil", err)
+	}
+	want := livesession.PlanChange{QuestionID: questionID, Position: 2, Skipped: true}
+	if len(changes) != 1 || *changes[0] != want {
+		t.Errorf("ListQuestionPlan = %+v, want [%+v]", changes, want)
+	}
+	changes, err = sessionStore.ListQuestionPlan(t.Context(), sess.ID, sess.GameSeq+1)
+	if err != nil || len(changes) != 0 {
+		t.Errorf("ListQuestionPlan next game = %+v, %v, want empty, nil", changes, err)
+	}
+
+	if _, err = sessionStore.EnterRoundIntro(
+		t.Context(), sess.ID, livesession.PhaseLobby, qz.Questions[0].RoundID,
+	); err != nil {
+		t.Fatalf("EnterRoundIntro err = %v, want nil", err)
+	}
+	err = sessionStore.ReorderQuestions(t.Context(), sess, []int64{questionID})
+	if !errors.Is(err, livesession.ErrPlanMoved) {
+		t.Errorf("ReorderQuestions after the game moved err = %v, want %v", err, livesession.ErrPlanMoved)
+	}
+	if err = sessionStore.SkipQuestion(t.Context(), sess, questionID); !errors.Is(err, livesession.ErrPlanMoved) {
+		t.Errorf("SkipQuestion after the game moved err = %v, want %v", err, livesession.ErrPlanMoved)
+	}
+}
@@ -21,7 +21,7 @@ type MediaStore struct {
 
 // NewMediaStore initializes a new MediaStore with the provided database connection.
 func NewMediaStore(conn *sql.DB, logger *slog.Logger) *MediaStore {
-	return &MediaStore{q: db.New(conn), logger: logger}
+	return &MediaStore{q: newQueries(conn), logger: logger}
 }
 
 // CreateMedia inserts a media row not-ready and returns it with the assigned id
@@ -184,7 +184,7 @@ func (s *MediaStore) DeleteMedia(ctx context.Context, id int64) error {
 
 // CountMediaByQuizAndType returns the number of ready media rows of mediaType
 // for quizID, so each upload route enforces a per-type library ceiling.
-func (s *MediaStore) CountMediaByQuizAndType(ctx context.Context, quizID int64, mediaType string) (int64, error) {
+func (s *MediaStore) CountMediaByQuizAndType(ctx context.Context, quizID int64, mediaType media.Type) (int64, error) {
 	n, err := s.q.CountMediaByQuizAndType(ctx, db.CountMediaByQuizAndTypeParams{
 		QuizID: quizID,
 		Type:   mediaType,
@@ -454,7 +454,7 @@ func TestMediaStore_CreateRejectsUnknownQuiz(t *testing.T) {
 	}
 }
 
-func countOrFatal(t *testing.T, s *MediaStore, quizID int64, mediaType string) int64 {
+func countOrFatal(t *testing.T, s *MediaStore, quizID int64, mediaType media.Type) int64 {
 	t.Helper()
 	n, err := s.CountMediaByQuizAndType(t.Context(), quizID, mediaType)
 	if err != nil {
@@ -19,14 +19,26 @@ import (
 
 // PlayerStore is a wrapper around database operations for managing players.
 type PlayerStore struct {
-	q      *db.Queries
+	q *db.Queries
+	// rq serves the player stats reads; it is q unless withReader routed
+	// them to the read-only pool.
+	rq     *db.Queries
 	db     *sql.DB
 	logger *slog.Logger
 }
 
 // NewPlayerStore initializes a new PlayerStore with the provided database connection and returns it.
 func NewPlayerStore(conn *sql.DB, logger *slog.Logger) *PlayerStore {
-	return &PlayerStore{q: db.New(conn), db: conn, logger: logger}
+	q := newQueries(conn)
+
+	return &PlayerStore{q: q, rq: q, db: conn, logger: logger}
+}
+
+// withReader routes the store's reporting reads through reader.
+func (s *PlayerStore) withReader(reader *sql.DB) *PlayerStore {
+	s.rq = newQueries(reader)
+
+	return s
 }
 
 // Ping checks the connection to the database.
@@ -170,6 +182,34 @@ func (s *PlayerStore) UpdatePlayerDisplayName(
 	return playerFromRow(row), nil
 }
 
+// SetGeneratedDisplayName gives a freshly minted anonymous row its generated
+// nickname without marking it claimed. Returns auth.ErrDisplayNameTaken on a
+// UNIQUE collision and auth.ErrPlayerNotFound when no anonymous, unclaimed
+// row matches the id.
+func (s *PlayerStore) SetGeneratedDisplayName(
+	ctx context.Context,
+	playerID int64,
+	displayName string,
+) (*auth.Player, error) {
+	row, err := s.q.SetGeneratedDisplayName(ctx, db.SetGeneratedDisplayNameParams{
+		DisplayName: displayName,
+		ID:          playerID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, auth.ErrPlayerNotFound
+		}
+		var sqliteErr *sqlite.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+			return nil, auth.ErrDisplayNameTaken
+		}
+
+		return nil, fmt.Errorf("failed to set generated display name: %w", err)
+	}
+
+	return playerFromRow(row), nil
+}
+
 // RenamePlayer changes the display name on an arbitrary player row,
 // not just an anonymous one. Used by the profile-page rename endpoint
 // (POST /profile/display-name, #410) so authenticated players (password,
@@ -749,7 +789,7 @@ func (s *PlayerStore) ListPlayerFinishStats(ctx context.Context, playerIDs []int
 		return nil, nil
 	}
 
-	rows, err := s.q.ListPlayerFinishStats(ctx, playerIDs)
+	rows, err := s.rq.ListPlayerFinishStats(ctx, playerIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list player finish stats: %w", err)
 	}
@@ -809,7 +849,7 @@ func (s *PlayerStore) GetPlayerDetail(ctx context.Context, id int64) (*auth.Play
 func (s *PlayerStore) ListRecentFinishedGamesForPlayer(
 	ctx context.Context, playerID, limit int64,
 ) ([]*auth.RecentFinishedGame, error) {
-	rows, err := s.q.ListRecentFinishedGamesForPlayer(ctx, db.ListRecentFinishedGamesForPlayerParams{
+	rows, err := s.rq.ListRecentFinishedGamesForPlayer(ctx, db.ListRecentFinishedGamesForPlayerParams{
 		PlayerID: playerID,
 		RowLimit: limit,
 	})
@@ -836,7 +876,7 @@ func (s *PlayerStore) ListRecentFinishedGamesForPlayer(
 func (s *PlayerStore) ListFinishedSessionPlaysForPlayer(
 	ctx context.Context, playerID, limit int64,
 ) ([]*auth.FinishedSessionPlay, error) {
-	rows, err := s.q.ListFinishedSessionPlaysForPlayer(ctx, db.ListFinishedSessionPlaysForPlayerParams{
+	rows, err := s.rq.ListFinishedSessionPlaysForPlayer(ctx, db.ListFinishedSessionPlaysForPlayerParams{
 		PlayerID: playerID,
 		RowLimit: limit,
 	})
@@ -1208,6 +1248,7 @@ func playerFromRow(row db.Player) *auth.Player {
 		CreatedAt:          row.CreatedAt,
 		DisplayNameClaimed: row.DisplayNameClaimed != 0,
 		SessionVersion:     row.SessionVersion,
+		SessionRevocations: row.SessionRevocations,
 	}
 	if row.Email.Valid {
 		p.Email = row.Email.String
@@ -0,0 +1,115 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/db"
+	"github.com/starquake/topbanana/internal/session"
+)
+
+// TouchSession records a sighting of the session d names, inserting its
+// row on the first one. A revoked session stays revoked.
+func (s *PlayerStore) TouchSession(ctx context.Context, d *session.Device) error {
+	if err := s.q.TouchPlayerSession(ctx, db.TouchPlayerSessionParams{
+		ID:        d.ID,
+		PlayerID:  d.PlayerID,
+		UserAgent: d.UserAgent,
+		IpAddress: d.IPAddress,
+	}); err != nil {
+		return fmt.Errorf("failed to touch player session: %w", err)
+	}
+
+	return nil
+}
+
+// ListRevokedSessionIDs returns the ids of the player's revoked sessions.
+func (s *PlayerStore) ListRevokedSessionIDs(ctx context.Context, playerID int64) ([]string, error) {
+	ids, err := s.q.ListRevokedPlayerSessionIDs(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revoked player sessions: %w", err)
+	}
+
+	return ids, nil
+}
+
+// ListSessions returns the player's live sessions last seen at or after
+// since, most recently used first. A session not seen since then has
+// outlived its cookie and is left out.
+func (s *PlayerStore) ListSessions(ctx context.Context, playerID int64, since time.Time) ([]*session.Device, error) {
+	rows, err := s.q.ListPlayerSessions(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list player sessions: %w", err)
+	}
+	devices := make([]*session.Device, 0, len(rows))
+	for _, row := range rows {
+		if row.LastSeenAt.Before(since) {
+			continue
+		}
+		devices = append(devices, &session.Device{
+			ID:         row.ID,
+			PlayerID:   playerID,
+			UserAgent:  row.UserAgent,
+			IPAddress:  row.IpAddress,
+			CreatedAt:  row.CreatedAt,
+			LastSeenAt: row.LastSeenAt,
+		})
+	}
+
+	return devices, nil
+}
+
+// RevokeSession revokes one of the player's sessions and bumps their
+// session_revocations in the same transaction, so the auth middleware's
+// cache of revoked ids is reread on the next request. Returns
+// session.ErrSessionNotFound when id is not a live session of the player.
+func (s *PlayerStore) RevokeSession(ctx context.Context, playerID int64, id string) error {
+	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		rows, err := q.RevokePlayerSession(ctx, db.RevokePlayerSessionParams{ID: id, PlayerID: playerID})
+		if err != nil {
+			return fmt.Errorf("revoke: %w", err)
+		}
+		if rows == 0 {
+			return session.ErrSessionNotFound
+		}
+		if err = q.IncrementSessionRevocations(ctx, playerID); err != nil {
+			return fmt.Errorf("count revocation: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke player session: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeOtherSessions revokes every live session of the player but keepID
+// (every one for "") and bumps their session_version in the same
+// transaction, returning the new version.
+func (s *PlayerStore) RevokeOtherSessions(ctx context.Context, playerID int64, keepID string) (int64, error) {
+	var version int64
+	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		err := q.RevokePlayerSessionsExcept(ctx, db.RevokePlayerSessionsExceptParams{
+			PlayerID: playerID,
+			KeepID:   keepID,
+		})
+		if err != nil {
+			return fmt.Errorf("revoke: %w", err)
+		}
+		version, err = q.BumpSessionVersion(ctx, playerID)
+		if err != nil {
+			return fmt.Errorf("bump session version: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke other player sessions: %w", err)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,115 @@
+package store_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/session"
+	. "github.com/starquake/topbanana/internal/store"
+)
+
+// TestPlayerStore_Sessions walks a player's device list: sightings create and
+// refresh rows, a revoked session drops off the list, bumps the revocation
+// count and stays revoked when seen again, and revoking the others keeps
+// only the current one while bumping session_version.
+func TestPlayerStore_Sessions(t *testing.T) {
+	t.Parallel()
+
+	ps := NewPlayerStore(dbtest.Open(t), slog.New(slog.DiscardHandler))
+	ctx := t.Context()
+	player, err := ps.CreateAnonymousPlayer(ctx, "devices")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+	rival, err := ps.CreateAnonymousPlayer(ctx, "rival")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+	since := time.Now().Add(-time.Hour)
+
+	touch := func(id, ua string) {
+		t.Helper()
+		d := &session.Device{ID: id, PlayerID: player.ID, UserAgent: ua, IPAddress: "192.0.2.1"}
+		if err := ps.TouchSession(ctx, d); err != nil {
+			t.Fatalf("TouchSession(%s) err = %v, want nil", id, err)
+		}
+	}
+	list := func() []*session.Device {
+		t.Helper()
+		devices, err := ps.ListSessions(ctx, player.ID, since)
+		if err != nil {
+			t.Fatalf("ListSessions err = %v, want nil", err)
+		}
+
+		return devices
+	}
+
+	touch("phone", "Safari")
+	touch("laptop", "Firefox")
+	touch("tablet", "Chrome")
+	touch("phone", "Safari 2")
+	// Another player cannot take over a session row.
+	if err = ps.TouchSession(ctx, &session.Device{ID: "phone", PlayerID: rival.ID, UserAgent: "Evil"}); err != nil {
+		t.Fatalf("TouchSession by another player err = %v, want nil", err)
+	}
+
+	devices := list()
+	if got, want := len(devices), 3; got != want {
+		t.Fatalf("len(sessions) = %d, want %d", got, want)
+	}
+	byID := map[string]*session.Device{}
+	for _, d := range devices {
+		byID[d.ID] = d
+	}
+	if d := byID["phone"]; d == nil || d.UserAgent != "Safari 2" || d.IPAddress != "192.0.2.1" {
+		t.Errorf("phone = %+v, want the latest user agent from 192.0.2.1", d)
+	}
+	if got, _ := ps.ListSessions(ctx, player.ID, time.Now().Add(time.Hour)); len(got) != 0 {
+		t.Errorf("sessions seen after a future cutoff = %d, want 0", len(got))
+	}
+
+	if err = ps.RevokeSession(ctx, player.ID, "phone"); err != nil {
+		t.Fatalf("RevokeSession err = %v, want nil", err)
+	}
+	if err = ps.RevokeSession(ctx, player.ID, "phone"); !errors.Is(err, session.ErrSessionNotFound) {
+		t.Errorf("RevokeSession twice err = %v, want %v", err, session.ErrSessionNotFound)
+	}
+	if err = ps.RevokeSession(ctx, rival.ID, "laptop"); !errors.Is(err, session.ErrSessionNotFound) {
+		t.Errorf("RevokeSession of another player's session err = %v, want %v", err, session.ErrSessionNotFound)
+	}
+	touch("phone", "Safari 3")
+	revoked, err := ps.ListRevokedSessionIDs(ctx, player.ID)
+	if err != nil {
+		t.Fatalf("ListRevokedSessionIDs err = %v, want nil", err)
+	}
+	if len(revoked) != 1 || revoked[0] != "phone" {
+		t.Errorf("revoked = %v, want [phone] (a later sighting must not revive it)", revoked)
+	}
+	reloaded, err := ps.GetPlayerByID(ctx, player.ID)
+	if err != nil {
+		t.Fatalf("GetPlayerByID err = %v, want nil", err)
+	}
+	if got, want := reloaded.SessionRevocations, int64(1); got != want {
+		t.Errorf("SessionRevocations = %d, want %d", got, want)
+	}
+
+	version, err := ps.RevokeOtherSessions(ctx, player.ID, "laptop")
+	if err != nil {
+		t.Fatalf("RevokeOtherSessions err = %v, want nil", err)
+	}
+	if got, want := version, reloaded.SessionVersion+1; got != want {
+		t.Errorf("session version = %d, want %d", got, want)
+	}
+	if devices = list(); len(devices) != 1 || devices[0].ID != "laptop" {
+		t.Errorf("sessions after revoking the others = %+v, want only laptop", devices)
+	}
+	if _, err = ps.RevokeOtherSessions(ctx, player.ID, ""); err != nil {
+		t.Fatalf("RevokeOtherSessions(all) err = %v, want nil", err)
+	}
+	if devices = list(); len(devices) != 0 {
+		t.Errorf("sessions after revoking all = %d, want 0", len(devices))
+	}
+}
@@ -7,9 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"slices"
-
-	"modernc.org/sqlite"
-	sqlite3 "modernc.org/sqlite/lib"
+	"time"
 
 	"github.com/starquake/topbanana/internal/database"
 	"github.com/starquake/topbanana/internal/db"
@@ -25,7 +23,7 @@ type QuizStore struct {
 
 // NewQuizStore initializes a new QuizStore with the provided database connection and returns it.
 func NewQuizStore(conn *sql.DB, logger *slog.Logger) *QuizStore {
-	return &QuizStore{q: db.New(conn), db: conn, logger: logger}
+	return &QuizStore{q: newQueries(conn), db: conn, logger: logger}
 }
 
 // Ping checks the connection to the database, ensuring it's reachable and responsive.
@@ -52,19 +50,30 @@ func (s *QuizStore) ListQuizzes(ctx context.Context) ([]*quiz.Quiz, error) {
 	quizzes := make([]*quiz.Quiz, 0, len(rows))
 	for _, r := range rows {
 		qz := &quiz.Quiz{
-			ID:                r.ID,
-			Title:             r.Title,
-			Slug:              r.Slug,
-			Description:       r.Description,
-			CreatedAt:         r.CreatedAt,
-			UpdatedAt:         r.UpdatedAt,
-			CreatedByPlayerID: r.CreatedByPlayerID,
-			TimeLimitSeconds:  int(r.TimeLimitSeconds),
-			Visibility:        r.Visibility,
-			Mode:              r.Mode,
-			Language:          r.Language,
-			PlayCount:         r.PlayCount,
-			Published:         r.Published != 0,
+			ID:                  r.ID,
+			Title:               r.Title,
+			Slug:                r.Slug,
+			Description:         r.Description,
+			CreatedAt:           r.CreatedAt,
+			UpdatedAt:           r.UpdatedAt,
+			CreatedByPlayerID:   r.CreatedByPlayerID,
+			TimeLimitSeconds:    int(r.TimeLimitSeconds),
+			Visibility:          r.Visibility,
+			Mode:                r.Mode,
+			Language:            r.Language,
+			ShuffleQuestions:    r.ShuffleQuestions != 0,
+			KeepOptionOrder:     r.KeepOptionOrder != 0,
+			LateJoin:            r.LateJoin,
+			JoinDeadlineSeconds: int(r.JoinDeadlineSeconds),
+			MaxPlayers:          int(r.MaxPlayers),
+			ConfidenceWager:     r.ConfidenceWager != 0,
+			HostPaced:           r.HostPaced != 0,
+			Scoring:             r.Scoring,
+			WrongAnswerPenalty:  int(r.WrongAnswerPenalty),
+			ScoreFloor:          int(r.ScoreFloor),
+			ExternalRef:         r.ExternalRef.String,
+			PlayCount:           r.PlayCount,
+			Published:           r.Published != 0,
 			// INNER JOIN on players makes this a plain string (#359);
 			// the FK guarantees a creator row exists.
 			CreatedByDisplayName: r.CreatedByDisplayName,
@@ -89,19 +98,30 @@ func (s *QuizStore) ListQuizzesForOwner(ctx context.Context, ownerID int64) ([]*
 	quizzes := make([]*quiz.Quiz, 0, len(rows))
 	for _, r := range rows {
 		qz := &quiz.Quiz{
-			ID:                r.ID,
-			Title:             r.Title,
-			Slug:              r.Slug,
-			Description:       r.Description,
-			CreatedAt:         r.CreatedAt,
-			UpdatedAt:         r.UpdatedAt,
-			CreatedByPlayerID: r.CreatedByPlayerID,
-			TimeLimitSeconds:  int(r.TimeLimitSeconds),
-			Visibility:        r.Visibility,
-			Mode:              r.Mode,
-			Language:          r.Language,
-			PlayCount:         r.PlayCount,
-			Published:         r.Published != 0,
+			ID:                  r.ID,
+			Title:               r.Title,
+			Slug:                r.Slug,
+			Description:         r.Description,
+			CreatedAt:           r.CreatedAt,
+			UpdatedAt:           r.UpdatedAt,
+			CreatedByPlayerID:   r.CreatedByPlayerID,
+			TimeLimitSeconds:    int(r.TimeLimitSeconds),
+			Visibility:          r.Visibility,
+			Mode:                r.Mode,
+			Language:            r.Language,
+			ShuffleQuestions:    r.ShuffleQuestions != 0,
+			KeepOptionOrder:     r.KeepOptionOrder != 0,
+			LateJoin:            r.LateJoin,
+			JoinDeadlineSeconds: int(r.JoinDeadlineSeconds),
+			MaxPlayers:          int(r.MaxPlayers),
+			ConfidenceWager:     r.ConfidenceWager != 0,
+			HostPaced:           r.HostPaced != 0,
+			Scoring:             r.Scoring,
+			WrongAnswerPenalty:  int(r.WrongAnswerPenalty),
+			ScoreFloor:          int(r.ScoreFloor),
+			ExternalRef:         r.ExternalRef.String,
+			PlayCount:           r.PlayCount,
+			Published:           r.Published != 0,
 			// INNER JOIN, see ListQuizzes (#359).
 			CreatedByDisplayName: r.CreatedByDisplayName,
 		}
@@ -125,19 +145,30 @@ func (s *QuizStore) ListPublicQuizzes(ctx context.Context) ([]*quiz.Quiz, error)
 	quizzes := make([]*quiz.Quiz, 0, len(rows))
 	for _, r := range rows {
 		qz := &quiz.Quiz{
-			ID:                r.ID,
-			Title:             r.Title,
-			Slug:              r.Slug,
-			Description:       r.Description,
-			CreatedAt:         r.CreatedAt,
-			UpdatedAt:         r.UpdatedAt,
-			CreatedByPlayerID: r.CreatedByPlayerID,
-			TimeLimitSeconds:  int(r.TimeLimitSeconds),
-			Visibility:        r.Visibility,
-			Mode:              r.Mode,
-			Language:          r.Language,
-			PlayCount:         r.PlayCount,
-			Published:         r.Published != 0,
+			ID:                  r.ID,
+			Title:               r.Title,
+			Slug:                r.Slug,
+			Description:         r.Description,
+			CreatedAt:           r.CreatedAt,
+			UpdatedAt:           r.UpdatedAt,
+			CreatedByPlayerID:   r.CreatedByPlayerID,
+			TimeLimitSeconds:    int(r.TimeLimitSeconds),
+			Visibility:          r.Visibility,
+			Mode:                r.Mode,
+			Language:            r.Language,
+			ShuffleQuestions:    r.ShuffleQuestions != 0,
+			KeepOptionOrder:     r.KeepOptionOrder != 0,
+			LateJoin:            r.LateJoin,
+			JoinDeadlineSeconds: int(r.JoinDeadlineSeconds),
+			MaxPlayers:          int(r.MaxPlayers),
+			ConfidenceWager:     r.ConfidenceWager != 0,
+			HostPaced:           r.HostPaced != 0,
+			Scoring:             r.Scoring,
+			WrongAnswerPenalty:  int(r.WrongAnswerPenalty),
+			ScoreFloor:          int(r.ScoreFloor),
+			ExternalRef:         r.ExternalRef.String,
+			PlayCount:           r.PlayCount,
+			Published:           r.Published != 0,
 			// INNER JOIN, see ListQuizzes (#359).
 			CreatedByDisplayName: r.CreatedByDisplayName,
 		}
@@ -162,19 +193,30 @@ func (s *QuizStore) ListLiveQuizzes(ctx context.Context) ([]*quiz.Quiz, error) {
 	quizzes := make([]*quiz.Quiz, 0, len(rows))
 	for _, r := range rows {
 		qz := &quiz.Quiz{
-			ID:                r.ID,
-			Title:             r.Title,
-			Slug:              r.Slug,
-			Description:       r.Description,
-			CreatedAt:         r.CreatedAt,
-			UpdatedAt:         r.UpdatedAt,
-			CreatedByPlayerID: r.CreatedByPlayerID,
-			TimeLimitSeconds:  int(r.TimeLimitSeconds),
-			Visibility:        r.Visibility,
-			Mode:              r.Mode,
-			Language:          r.Language,
-			PlayCount:         r.PlayCount,
-			Published:         r.Published != 0,
+			ID:                  r.ID,
+			Title:               r.Title,
+			Slug:                r.Slug,
+			Description:         r.Description,
+			CreatedAt:           r.CreatedAt,
+			UpdatedAt:           r.UpdatedAt,
+			CreatedByPlayerID:   r.CreatedByPlayerID,
+			TimeLimitSeconds:    int(r.TimeLimitSeconds),
+			Visibility:          r.Visibility,
+			Mode:                r.Mode,
+			Language:            r.Language,
+			ShuffleQuestions:    r.ShuffleQuestions != 0,
+			KeepOptionOrder:     r.KeepOptionOrder != 0,
+			LateJoin:            r.LateJoin,
+			JoinDeadlineSeconds: int(r.JoinDeadlineSeconds),
+			MaxPlayers:          int(r.MaxPlayers),
+			ConfidenceWager:     r.ConfidenceWager != 0,
+			HostPaced:           r.HostPaced != 0,
+			Scoring:             r.Scoring,
+			WrongAnswerPenalty:  int(r.WrongAnswerPenalty),
+			ScoreFloor:          int(r.ScoreFloor),
+			ExternalRef:         r.ExternalRef.String,
+			PlayCount:           r.PlayCount,
+			Published:           r.Published != 0,
 			// INNER JOIN, see ListQuizzes (#359).
 			CreatedByDisplayName: r.CreatedByDisplayName,
 		}
@@ -198,19 +240,86 @@ func (s *QuizStore) ListLiveQuizzesForOwner(ctx context.Context, ownerID int64)
 	quizzes := make([]*quiz.Quiz, 0, len(rows))
 	for _, r := range rows {
 		qz := &quiz.Quiz{
-			ID:                r.ID,
-			Title:             r.Title,
-			Slug:              r.Slug,
-			Description:       r.Description,
-			CreatedAt:         r.CreatedAt,
-			UpdatedAt:         r.UpdatedAt,
-			CreatedByPlayerID: r.CreatedByPlayerID,
-			TimeLimitSeconds:  int(r.TimeLimitSeconds),
-			Visibility:        r.Visibility,
-			Mode:              r.Mode,
-			Language:          r.Language,
-			PlayCount:         r.PlayCount,
-			Published:         r.Published != 0,
+			ID:                  r.ID,
+			Title:               r.Title,
+			Slug:                r.Slug,
+			Description:         r.Description,
+			CreatedAt:           r.CreatedAt,
+			UpdatedAt:           r.UpdatedAt,
+			CreatedByPlayerID:   r.CreatedByPlayerID,
+			TimeLimitSeconds:    int(r.TimeLimitSeconds),
+			Visibility:          r.Visibility,
+			Mode:                r.Mode,
+			Language:            r.Language,
+			ShuffleQuestions:    r.ShuffleQuestions != 0,
+			KeepOptionOrder:     r.KeepOptionOrder != 0,
+			LateJoin:            r.LateJoin,
+			JoinDeadlineSeconds: int(r.JoinDeadlineSeconds),
+			MaxPlayers:          int(r.MaxPlayers),
+			ConfidenceWager:     r.ConfidenceWager != 0,
+			HostPaced:           r.HostPaced != 0,
+			Scoring:             r.Scoring,
+			WrongAnswerPenalty:  int(r.WrongAnswerPenalty),
+			ScoreFloor:          int(r.ScoreFloor),
+			ExternalRef:         r.ExternalRef.String,
+			PlayCount:           r.PlayCount,
+			Published:           r.Published != 0,
+			// INNER JOIN, see ListQuizzes (#359).
+			CreatedByDisplayName: r.CreatedByDisplayName,
+		}
+		quizzes = append(quizzes, qz)
+	}
+
+	return quizzes, nil
+}
+
+// ListQuizzesPage returns one page of [QuizStore.ListQuizzes], in the same
+// order, for the admin quiz list. ownerID scopes the page to one creator's
+// quizzes (0 disables the scope, an Admin's view); mode keeps only quizzes of
+// that play mode ("" disables the filter). Pair with [QuizStore.CountQuizzes]
+// for the page count.
+//
+//nolint:dupl // See ListQuizzes: distinct sqlc row types, identical mapping.
+func (s *QuizStore) ListQuizzesPage(
+	ctx context.Context, ownerID int64, mode string, limit, offset int64,
+) ([]*quiz.Quiz, error) {
+	rows, err := s.q.ListQuizzesPage(ctx, db.ListQuizzesPageParams{
+		OwnerID:   ownerID,
+		Mode:      mode,
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quizzes page: %w", err)
+	}
+
+	quizzes := make([]*quiz.Quiz, 0, len(rows))
+	for _, r := range rows {
+		qz := &quiz.Quiz{
+			ID:                  r.ID,
+			Title:               r.Title,
+			Slug:                r.Slug,
+			Description:         r.Description,
+			CreatedAt:           r.CreatedAt,
+			UpdatedAt:           r.UpdatedAt,
+			CreatedByPlayerID:   r.CreatedByPlayerID,
+			TimeLimitSeconds:    int(r.TimeLimitSeconds),
+			Visibility:          r.Visibility,
+			Mode:                r.Mode,
+			Language:            r.Language,
+			ShuffleQuestions:    r.ShuffleQuestions != 0,
+			KeepOptionOrder:     r.KeepOptionOrder != 0,
+			LateJoin:            r.LateJoin,
+			JoinDeadlineSeconds: int(r.JoinDeadlineSeconds),
+			MaxPlayers:          int(r.MaxPlayers),
+			ConfidenceWager:     r.ConfidenceWager != 0,
+			HostPaced:           r.HostPaced != 0,
+			Scoring:             r.Scoring,
+			WrongAnswerPenalty:  int(r.WrongAnswerPenalty),
+			ScoreFloor:          int(r.ScoreFloor),
+			ExternalRef:         r.ExternalRef.String,
+			PlayCount:           r.PlayCount,
+			Published:           r.Published != 0,
 			// INNER JOIN, see ListQuizzes (#359).
 			CreatedByDisplayName: r.CreatedByDisplayName,
 		}
@@ -220,6 +329,78 @@ func (s *QuizStore) ListLiveQuizzesForOwner(ctx context.Context, ownerID int64)
 	return quizzes, nil
 }
 
+// CountQuizzes returns the number of quizzes matching the
+// [QuizStore.ListQuizzesPage] filter, with the same ownerID and mode
+// semantics.
+func (s *QuizStore) CountQuizzes(ctx context.Context, ownerID int64, mode string) (int64, error) {
+	count, err := s.q.CountQuizzesPage(ctx, db.CountQuizzesPageParams{OwnerID: ownerID, Mode: mode})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count quizzes: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListPublicQuizzesPage returns one page of [QuizStore.ListPublicQuizzes], in
+// the same order. Pair with [QuizStore.CountPublicQuizzes] for the total.
+//
+//nolint:dupl // See ListQuizzes: distinct sqlc row types, identical mapping.
+func (s *QuizStore) ListPublicQuizzesPage(ctx context.Context, limit, offset int64) ([]*quiz.Quiz, error) {
+	rows, err := s.q.ListPublicQuizzesPage(ctx, db.ListPublicQuizzesPageParams{
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list public quizzes page: %w", err)
+	}
+
+	quizzes := make([]*quiz.Quiz, 0, len(rows))
+	for _, r := range rows {
+		qz := &quiz.Quiz{
+			ID:                  r.ID,
+			Title:               r.Title,
+			Slug:                r.Slug,
+			Description:         r.Description,
+			CreatedAt:           r.CreatedAt,
+			UpdatedAt:           r.UpdatedAt,
+			CreatedByPlayerID:   r.CreatedByPlayerID,
+			TimeLimitSeconds:    int(r.TimeLimitSeconds),
+			Visibility:          r.Visibility,
+			Mode:                r.Mode,
+			Language:            r.Language,
+			ShuffleQuestions:    r.ShuffleQuestions != 0,
+			KeepOptionOrder:     r.KeepOptionOrder != 0,
+			LateJoin:            r.LateJoin,
+			JoinDeadlineSeconds: int(r.JoinDeadlineSeconds),
+			MaxPlayers:          int(r.MaxPlayers),
+			ConfidenceWager:     r.ConfidenceWager != 0,
+			HostPaced:           r.HostPaced != 0,
+			Scoring:             r.Scoring,
+			WrongAnswerPenalty:  int(r.WrongAnswerPenalty),
+			ScoreFloor:          int(r.ScoreFloor),
+			ExternalRef:         r.ExternalRef.String,
+			PlayCount:           r.PlayCount,
+			Published:           r.Published != 0,
+			// INNER JOIN, see ListQuizzes (#359).
+			CreatedByDisplayName: r.CreatedByDisplayName,
+		}
+		quizzes = append(quizzes, qz)
+	}
+
+	return quizzes, nil
+}
+
+// CountPublicQuizzes returns the number of rows [QuizStore.ListPublicQuizzes]
+// would return.
+func (s *QuizStore) CountPublicQuizzes(ctx context.Context) (int64, error) {
+	count, err := s.q.CountPublicQuizzes(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count public quizzes: %w", err)
+	}
+
+	return count, nil
+}
+
 // QuestionCountsByQuiz returns the number of questions per quiz, keyed by
 // quiz ID. Quizzes with zero questions are absent from the map; callers
 // should treat a missing entry as 0. Pair with [QuizStore.ListQuizzes] when
@@ -239,6 +420,23 @@ func (s *QuizStore) QuestionCountsByQuiz(ctx context.Context) (map[int64]int, er
 	return counts, nil
 }
 
+// QuestionStats returns each of the quiz's questions' answer statistics for
+// its current stats epoch, keyed by question ID. Every question has an entry;
+// an unanswered one counts zero.
+func (s *QuizStore) QuestionStats(ctx context.Context, quizID int64) (map[int64]quiz.QuestionStats, error) {
+	rows, err := s.q.ListQuestionStatsByQuizID(ctx, quizID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question stats for quiz %d: %w", quizID, err)
+	}
+
+	stats := make(map[int64]quiz.QuestionStats, len(rows))
+	for _, r := range rows {
+		stats[r.QuestionID] = quiz.QuestionStats{Answers: int(r.AnswerCount), Correct: int(r.CorrectCount)}
+	}
+
+	return stats, nil
+}
+
 // QuizExists reports whether a quiz with the given ID exists. It runs a
 // single one-row SELECT EXISTS probe and does not load the quiz's
 // questions or options, so callers that only need to validate the quiz
@@ -288,23 +486,73 @@ func (s *QuizStore) GetQuizMeta(ctx context.Context, id int64) (*quiz.Quiz, erro
 	return quizFromRow(row), nil
 }
 
+// questionFromRow maps a questions row to a quiz.Question without its
+// options.
+func questionFromRow(row db.Question) *quiz.Question {
+	return &quiz.Question{
+		ID:               row.ID,
+		QuizID:           row.QuizID,
+		RoundID:          row.RoundID,
+		Text:             row.Text,
+		Position:         int(row.Position),
+		ImageMediaID:     nullableInt64ToPtr(row.ImageMediaID),
+		AudioMediaID:     nullableInt64ToPtr(row.AudioMediaID),
+		AudioRepeat:      row.AudioRepeat != 0,
+		TimeLimitSeconds: nullableIntToPtr(row.TimeLimitSeconds),
+		StatsEpoch:       int(row.StatsEpoch),
+		Version:          row.Version,
+		Kind:             row.Kind,
+		AfterQuestionID:  nullableInt64ToPtr(row.AfterQuestionID),
+		Explanation:      row.Explanation,
+		Hint:             row.Hint,
+		ExternalRef:      row.ExternalRef.String,
+	}
+}
+
+// optionFromRow maps an options row to a quiz.Option, including the
+// numeric answer key fields a numeric question's single option carries.
+func optionFromRow(row db.Option) *quiz.Option {
+	return &quiz.Option{
+		ID:             row.ID,
+		QuestionID:     row.QuestionID,
+		Text:           row.Text,
+		Correct:        row.IsCorrect,
+		NumericValue:   nullableFloat64ToPtr(row.NumericValue),
+		ToleranceBelow: row.ToleranceBelow,
+		ToleranceAbove: row.ToleranceAbove,
+	}
+}
+
 // quizFromRow projects a single-quiz row onto the domain type. Questions are
 // left nil; callers that need the tree load it separately.
 func quizFromRow(row db.GetQuizRow) *quiz.Quiz {
 	return &quiz.Quiz{
-		ID:                row.ID,
-		Title:             row.Title,
-		Slug:              row.Slug,
-		Description:       row.Description,
-		CreatedAt:         row.CreatedAt,
-		UpdatedAt:         row.UpdatedAt,
-		CreatedByPlayerID: row.CreatedByPlayerID,
-		TimeLimitSeconds:  int(row.TimeLimitSeconds),
-		Visibility:        row.Visibility,
-		Mode:              row.Mode,
-		Language:          row.Language,
-		PlayCount:         row.PlayCount,
-		Published:         row.Published != 0,
+		ID:                  row.ID,
+		Title:               row.Title,
+		Slug:                row.Slug,
+		Description:         row.Description,
+		CreatedAt:           row.CreatedAt,
+		UpdatedAt:           row.UpdatedAt,
+		CreatedByPlayerID:   row.CreatedByPlayerID,
+		TimeLimitSeconds:    int(row.TimeLimitSeconds),
+		Visibility:          row.Visibility,
+		Mode:                row.Mode,
+		Language:            row.Language,
+		ShuffleQuestions:    row.ShuffleQuestions != 0,
+		KeepOptionOrder:     row.KeepOptionOrder != 0,
+		LateJoin:            row.LateJoin,
+		JoinDeadlineSeconds: int(row.JoinDeadlineSeconds),
+		MaxPlayers:          int(row.MaxPlayers),
+		ConfidenceWager:     row.ConfidenceWager != 0,
+		HostPaced:           row.HostPaced != 0,
+		Scoring:             row.Scoring,
+		WrongAnswerPenalty:  int(row.WrongAnswerPenalty),
+		ScoreFloor:          int(row.ScoreFloor),
+		ExternalRef:         row.ExternalRef.String,
+		PlayCount:           row.PlayCount,
+		Published:           row.Published != 0,
+		PublishedAt:         nullTimeToPtr(row.PublishedAt),
+		Version:             row.Version,
 		// INNER JOIN, see ListQuizzes (#359).
 		CreatedByDisplayName: row.CreatedByDisplayName,
 	}
@@ -326,6 +574,36 @@ func (s *QuizStore) GetQuizVisibility(ctx context.Context, id int64) (string, er
 	return visibility, nil
 }
 
+// GetQuizIDBySlug resolves a slug to its quiz ID.
+// Returns quiz.ErrQuizNotFound if no quiz has the slug.
+func (s *QuizStore) GetQuizIDBySlug(ctx context.Context, slug string) (int64, error) {
+	id, err := s.q.GetQuizIDBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, quiz.ErrQuizNotFound
+		}
+
+		return 0, fmt.Errorf("failed to get quiz by slug: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetQuizIDByExternalRef resolves an author-supplied quiz reference to its
+// ID. Returns quiz.ErrQuizNotFound if no quiz has the reference.
+func (s *QuizStore) GetQuizIDByExternalRef(ctx context.Context, ref string) (int64, error) {
+	id, err := s.q.GetQuizIDByExternalRef(ctx, nullableString(ref))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, quiz.ErrQuizNotFound
+		}
+
+		return 0, fmt.Errorf("failed to get quiz by external ref: %w", err)
+	}
+
+	return id, nil
+}
+
 // CreateQuiz creates a new quiz using a transaction.
 func (s *QuizStore) CreateQuiz(ctx context.Context, qz *quiz.Quiz) error {
 	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
@@ -353,8 +631,8 @@ func (s *QuizStore) UpdateQuiz(ctx context.Context, qz *quiz.Quiz) error {
 // SetQuizMode flips just the quiz's play mode (#830). It validates the mode
 // up front so an invalid value never reaches the DB CHECK constraint, and
 // maps a no-op update (id gone) to ErrQuizNotFound.
-func (s *QuizStore) SetQuizMode(ctx context.Context, id int64, mode string) error {
-	if !quiz.IsValidMode(mode) {
+func (s *QuizStore) SetQuizMode(ctx context.Context, id int64, mode quiz.Mode) error {
+	if !quiz.IsValidMode(string(mode)) {
 		return fmt.Errorf("%w: %q", quiz.ErrInvalidMode, mode)
 	}
 
@@ -424,17 +702,7 @@ func (s *QuizStore) ListQuestions(ctx context.Context, quizID int64) ([]*quiz.Qu
 
 	questions := make([]*quiz.Question, 0, len(rows))
 	for _, r := range rows {
-		qs := &quiz.Question{
-			ID:               r.ID,
-			QuizID:           r.QuizID,
-			RoundID:          r.RoundID,
-			Text:             r.Text,
-			Position:         int(r.Position),
-			ImageMediaID:     nullableInt64ToPtr(r.ImageMediaID),
-			AudioMediaID:     nullableInt64ToPtr(r.AudioMediaID),
-			AudioRepeat:      r.AudioRepeat != 0,
-			TimeLimitSeconds: nullableIntToPtr(r.TimeLimitSeconds),
-		}
+		qs := questionFromRow(r)
 
 		options := optionsByQuestion[qs.ID]
 		if options == nil {
@@ -459,18 +727,33 @@ func (s *QuizStore) GetQuestion(ctx context.Context, id int64) (*quiz.Question,
 		return nil, fmt.Errorf("failed to get question: %w", err)
 	}
 
-	qs := &quiz.Question{
-		ID:               row.ID,
-		QuizID:           row.QuizID,
-		RoundID:          row.RoundID,
-		Text:             row.Text,
-		Position:         int(row.Position),
-		ImageMediaID:     nullableInt64ToPtr(row.ImageMediaID),
-		AudioMediaID:     nullableInt64ToPtr(row.AudioMediaID),
-		AudioRepeat:      row.AudioRepeat != 0,
-		TimeLimitSeconds: nullableIntToPtr(row.TimeLimitSeconds),
+	qs := questionFromRow(row)
+	options, err := s.listOptions(ctx, qs.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list options for question %d: %w", qs.ID, err)
 	}
+	qs.Options = options
+
+	return qs, nil
+}
 
+// GetQuestionByExternalRef retrieves the quiz's question with the given
+// author-supplied reference, including its options. Returns
+// quiz.ErrQuestionNotFound if the quiz has no such question.
+func (s *QuizStore) GetQuestionByExternalRef(ctx context.Context, quizID int64, ref string) (*quiz.Question, error) {
+	row, err := s.q.GetQuestionByExternalRef(ctx, db.GetQuestionByExternalRefParams{
+		QuizID:      quizID,
+		ExternalRef: nullableString(ref),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, quiz.ErrQuestionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get question by external ref: %w", err)
+	}
+
+	qs := questionFromRow(row)
 	options, err := s.listOptions(ctx, qs.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list options for question %d: %w", qs.ID, err)
@@ -480,6 +763,30 @@ func (s *QuizStore) GetQuestion(ctx context.Context, id int64) (*quiz.Question,
 	return qs, nil
 }
 
+// GetQuestionProgress returns where the question sits in its quiz, computed
+// by a single aggregate query rather than from the loaded quiz.
+// Returns quiz.ErrQuestionNotFound if the question does not exist.
+func (s *QuizStore) GetQuestionProgress(ctx context.Context, id int64) (*quiz.QuestionProgress, error) {
+	row, err := s.q.GetQuestionProgress(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, quiz.ErrQuestionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get question progress: %w", err)
+	}
+
+	return &quiz.QuestionProgress{
+		Total: int(row.Total),
+		RoundProgress: quiz.RoundProgress{
+			RoundNumber:    int(row.RoundNumber),
+			RoundTotal:     int(row.RoundTotal),
+			RoundPosition:  int(row.RoundPosition),
+			RoundQuestions: int(row.RoundQuestions),
+		},
+	}, nil
+}
+
 // CreateQuestion creates a new question using a transaction.
 func (s *QuizStore) CreateQuestion(ctx context.Context, qs *quiz.Question) error {
 	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
@@ -585,8 +892,7 @@ func (s *QuizStore) CreateQuestionAtNextPosition(ctx context.Context, qs *quiz.Q
 		if txErr == nil {
 			return nil
 		}
-		var sqliteErr *sqlite.Error
-		if errors.As(txErr, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		if isUniqueViolation(txErr) {
 			lastErr = txErr
 
 			continue
@@ -755,6 +1061,48 @@ func moveQuestionToPositionTx(
 	})
 }
 
+// RenumberQuestions rewrites the quiz's question positions to
+// quiz.RenumberStep increments in their current order. The plan is computed
+// from rows read inside the transaction; when fingerprint is set and no
+// longer matches it (the questions changed after the admin preview), nothing
+// is written and quiz.ErrStaleUpdate is returned.
+func (s *QuizStore) RenumberQuestions(ctx context.Context, quizID int64, fingerprint string) error {
+	if err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		rows, err := q.ListQuestionsByQuizID(ctx, quizID)
+		if err != nil {
+			return fmt.Errorf("failed to list questions for renumber: %w", err)
+		}
+
+		questions := make([]*quiz.Question, len(rows))
+		for i, r := range rows {
+			questions[i] = &quiz.Question{ID: r.ID, Position: int(r.Position)}
+		}
+
+		plan := quiz.PlanRenumber(questions)
+		if fingerprint != "" && fingerprint != quiz.PlanFingerprint(plan) {
+			return quiz.ErrStaleUpdate
+		}
+		ids := make([]int64, len(plan))
+		current := make([]int64, len(plan))
+		final := make([]int64, len(plan))
+		for i, c := range plan {
+			ids[i], current[i], final[i] = c.QuestionID, int64(c.From), int64(c.To)
+		}
+
+		return renumberPositions(ctx, ids, current, final, func(ctx context.Context, id, pos int64) error {
+			if _, err := q.UpdateQuestionPosition(ctx, db.UpdateQuestionPositionParams{Position: pos, ID: id}); err != nil {
+				return fmt.Errorf("update question position: %w", err)
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return fmt.Errorf("failed to renumber questions: %w", err)
+	}
+
+	return nil
+}
+
 // validateQuestionMove confirms the question and target round both exist
 // and belong to quizID, mirroring moveQuestionToRoundTx's cross-quiz IDOR
 // gate.
@@ -830,14 +1178,7 @@ func (s *QuizStore) GetOption(ctx context.Context, optionID int64) (*quiz.Option
 		return nil, fmt.Errorf("failed to get option: %w", err)
 	}
 
-	option := &quiz.Option{
-		ID:         row.ID,
-		QuestionID: row.QuestionID,
-		Text:       row.Text,
-		Correct:    row.IsCorrect,
-	}
-
-	return option, nil
+	return optionFromRow(row), nil
 }
 
 // GetOptionsByIDs retrieves options for the given IDs from the data store.
@@ -849,29 +1190,67 @@ func (s *QuizStore) GetOptionsByIDs(ctx context.Context, ids []int64) ([]*quiz.O
 
 	options := make([]*quiz.Option, 0, len(rows))
 	for _, row := range rows {
-		options = append(options, &quiz.Option{
-			ID:         row.ID,
-			QuestionID: row.QuestionID,
-			Text:       row.Text,
-			Correct:    row.IsCorrect,
-		})
+		options = append(options, optionFromRow(row))
 	}
 
 	return options, nil
 }
 
+// GetQuestionDraft returns the player's autosaved draft of the question, or
+// quiz.ErrQuestionDraftNotFound when there is none.
+func (s *QuizStore) GetQuestionDraft(ctx context.Context, playerID, questionID int64) (*quiz.QuestionDraft, error) {
+	row, err := s.q.GetQuestionDraft(ctx, db.GetQuestionDraftParams{PlayerID: playerID, QuestionID: questionID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, quiz.ErrQuestionDraftNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get question draft: %w", err)
+	}
+
+	return &quiz.QuestionDraft{
+		PlayerID:   row.PlayerID,
+		QuestionID: row.QuestionID,
+		Data:       row.Data,
+		UpdatedAt:  row.UpdatedAt,
+	}, nil
+}
+
+// SaveQuestionDraft upserts the player's draft of the question.
+func (s *QuizStore) SaveQuestionDraft(ctx context.Context, d *quiz.QuestionDraft) error {
+	err := s.q.UpsertQuestionDraft(ctx, db.UpsertQuestionDraftParams{
+		PlayerID:   d.PlayerID,
+		QuestionID: d.QuestionID,
+		Data:       d.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save question draft: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteQuestionDraft drops the player's draft of the question, if any.
+func (s *QuizStore) DeleteQuestionDraft(ctx context.Context, playerID, questionID int64) error {
+	err := s.q.DeleteQuestionDraft(ctx, db.DeleteQuestionDraftParams{PlayerID: playerID, QuestionID: questionID})
+	if err != nil {
+		return fmt.Errorf("failed to delete question draft: %w", err)
+	}
+
+	return nil
+}
+
 // classifySlugConflictErr maps a CreateQuiz / UpdateQuiz storage error
-// onto [quiz.ErrSlugTaken] when the underlying SQLite failure is a
+// onto [quiz.ErrSlugTaken] when the underlying failure is a
 // UNIQUE-constraint violation. `slug` is the only UNIQUE column on the
 // quizzes table (see migration 20251201084529 / 20260520200000), so a
-// SQLITE_CONSTRAINT_UNIQUE on this path can only mean a slug collision -
+// unique violation on this path can only mean a slug collision -
 // the classifier doesn't need to inspect the error message to
 // disambiguate. The wrapped err is still returned via %w so callers
-// using [errors.Is] can still recover the original sqlite.Error if
+// using [errors.Is] can still recover the original driver error if
 // they need details for logging (#293).
 func classifySlugConflictErr(err error, op string) error {
-	var sqliteErr *sqlite.Error
-	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+	if isUniqueViolation(err) {
 		return quiz.ErrSlugTaken
 	}
 
@@ -890,16 +1269,30 @@ func (s *QuizStore) execCreateQuiz(ctx context.Context, q *db.Queries, qz *quiz.
 	if timeLimit == 0 {
 		timeLimit = quiz.DefaultTimeLimitSeconds
 	}
+	if err := checkQuizExternalRef(ctx, q, qz); err != nil {
+		return err
+	}
 	visibility, mode, language := quiz.NormalizedFields(qz)
 	row, err := q.CreateQuiz(ctx, db.CreateQuizParams{
-		Title:             qz.Title,
-		Slug:              qz.Slug,
-		Description:       qz.Description,
-		CreatedByPlayerID: qz.CreatedByPlayerID,
-		TimeLimitSeconds:  int64(timeLimit),
-		Visibility:        visibility,
-		Mode:              mode,
-		Language:          language,
+		Title:               qz.Title,
+		Slug:                qz.Slug,
+		Description:         qz.Description,
+		CreatedByPlayerID:   qz.CreatedByPlayerID,
+		TimeLimitSeconds:    int64(timeLimit),
+		Visibility:          visibility,
+		Mode:                mode,
+		Language:            language,
+		ShuffleQuestions:    boolToInt64(qz.ShuffleQuestions),
+		KeepOptionOrder:     boolToInt64(qz.KeepOptionOrder),
+		LateJoin:            quiz.NormalizedLateJoin(qz.LateJoin),
+		JoinDeadlineSeconds: int64(qz.JoinDeadlineSeconds),
+		MaxPlayers:          int64(qz.MaxPlayers),
+		ConfidenceWager:     boolToInt64(qz.ConfidenceWager),
+		HostPaced:           boolToInt64(qz.HostPaced),
+		Scoring:             quiz.NormalizedScoring(qz.Scoring),
+		WrongAnswerPenalty:  int64(qz.WrongAnswerPenalty),
+		ScoreFloor:          int64(qz.ScoreFloor),
+		ExternalRef:         nullableString(qz.ExternalRef),
 		// New quizzes default to draft; seed callers (fixtures, importers) set Published explicitly (#1192).
 		Published: boolToInt64(qz.Published),
 	})
@@ -910,12 +1303,25 @@ func (s *QuizStore) execCreateQuiz(ctx context.Context, q *db.Queries, qz *quiz.
 	qz.ID = row.ID
 	qz.CreatedAt = row.CreatedAt
 	qz.UpdatedAt = row.UpdatedAt
+	qz.Version = row.Version
 	qz.TimeLimitSeconds = int(row.TimeLimitSeconds)
 	qz.Visibility = row.Visibility
 	qz.Mode = row.Mode
 	qz.Language = row.Language
+	qz.ShuffleQuestions = row.ShuffleQuestions != 0
+	qz.KeepOptionOrder = row.KeepOptionOrder != 0
+	qz.LateJoin = row.LateJoin
+	qz.JoinDeadlineSeconds = int(row.JoinDeadlineSeconds)
+	qz.MaxPlayers = int(row.MaxPlayers)
+	qz.ConfidenceWager = row.ConfidenceWager != 0
+	qz.HostPaced = row.HostPaced != 0
+	qz.Scoring = row.Scoring
+	qz.WrongAnswerPenalty = int(row.WrongAnswerPenalty)
+	qz.ScoreFloor = int(row.ScoreFloor)
+	qz.ExternalRef = row.ExternalRef.String
 	qz.PlayCount = row.PlayCount
 	qz.Published = row.Published != 0
+	qz.PublishedAt = nullTimeToPtr(row.PublishedAt)
 
 	// Every quiz needs a default round (#444): questions.round_id is NOT
 	// NULL and execCreateQuestion resolves it via GetDefaultRound.
@@ -1006,29 +1412,54 @@ func (s *QuizStore) execUpdateQuiz(ctx context.Context, q *db.Queries, qz *quiz.
 		return quiz.ErrCannotUpdateQuizWithIDZero
 	}
 
+	err := checkQuizExternalRef(ctx, q, qz)
+	if err != nil {
+		return err
+	}
 	visibility, mode, language := quiz.NormalizedFields(qz)
-	var err error
 	timeLimit := qz.TimeLimitSeconds
 	if timeLimit == 0 {
 		timeLimit = quiz.DefaultTimeLimitSeconds
 	}
 	res, err := q.UpdateQuiz(ctx, db.UpdateQuizParams{
-		Title:            qz.Title,
-		Slug:             qz.Slug,
-		Description:      qz.Description,
-		TimeLimitSeconds: int64(timeLimit),
-		Visibility:       visibility,
-		Mode:             mode,
-		Language:         language,
-		ID:               qz.ID,
+		Title:               qz.Title,
+		Slug:                qz.Slug,
+		Description:         qz.Description,
+		TimeLimitSeconds:    int64(timeLimit),
+		Visibility:          visibility,
+		Mode:                mode,
+		Language:            language,
+		ShuffleQuestions:    boolToInt64(qz.ShuffleQuestions),
+		KeepOptionOrder:     boolToInt64(qz.KeepOptionOrder),
+		LateJoin:            quiz.NormalizedLateJoin(qz.LateJoin),
+		JoinDeadlineSeconds: int64(qz.JoinDeadlineSeconds),
+		MaxPlayers:          int64(qz.MaxPlayers),
+		ConfidenceWager:     boolToInt64(qz.ConfidenceWager),
+		HostPaced:           boolToInt64(qz.HostPaced),
+		Scoring:             quiz.NormalizedScoring(qz.Scoring),
+		WrongAnswerPenalty:  int64(qz.WrongAnswerPenalty),
+		ScoreFloor:          int64(qz.ScoreFloor),
+		ExternalRef:         nullableString(qz.ExternalRef),
+		ID:                  qz.ID,
+		Version:             qz.Version,
 	})
 	if err != nil {
 		return classifySlugConflictErr(err, "failed to update quiz")
 	}
 
 	if database.MustRowsAffected(res) == 0 {
-		return quiz.ErrUpdatingQuizNoRowsAffected
+		if _, err = q.QuizVersion(ctx, qz.ID); errors.Is(err, sql.ErrNoRows) {
+			return quiz.ErrUpdatingQuizNoRowsAffected
+		} else if err != nil {
+			return fmt.Errorf("failed to read quiz version: %w", err)
+		}
+
+		return quiz.ErrStaleUpdate
 	}
+	qz.Version++
+	// Mirror the columns the write just defaulted, as CreateQuiz does.
+	qz.LateJoin = quiz.NormalizedLateJoin(qz.LateJoin)
+	qz.Scoring = quiz.NormalizedScoring(qz.Scoring)
 
 	for _, qs := range qz.Questions {
 		qs.QuizID = qz.ID
@@ -1099,6 +1530,9 @@ func (s *QuizStore) execCreateQuestion(ctx context.Context, q *db.Queries, qs *q
 		}
 		qs.RoundID = round.ID
 	}
+	if err := checkQuestionExternalRef(ctx, q, qs); err != nil {
+		return err
+	}
 
 	row, err := q.CreateQuestion(ctx, db.CreateQuestionParams{
 		QuizID:           qs.QuizID,
@@ -1109,6 +1543,11 @@ func (s *QuizStore) execCreateQuestion(ctx context.Context, q *db.Queries, qs *q
 		AudioMediaID:     nullableInt64(qs.AudioMediaID),
 		AudioRepeat:      boolToInt64(qs.AudioRepeat),
 		TimeLimitSeconds: nullableInt(qs.TimeLimitSeconds),
+		Kind:             quiz.NormalizedKind(qs.Kind),
+		AfterQuestionID:  nullableInt64(qs.AfterQuestionID),
+		Explanation:      qs.Explanation,
+		Hint:             qs.Hint,
+		ExternalRef:      nullableString(qs.ExternalRef),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create question: %w", err)
@@ -1116,8 +1555,10 @@ func (s *QuizStore) execCreateQuestion(ctx context.Context, q *db.Queries, qs *q
 
 	qs.ID = row.ID
 	qs.RoundID = row.RoundID
+	qs.Version = row.Version
 	qs.AudioRepeat = row.AudioRepeat != 0
 	qs.TimeLimitSeconds = nullableIntToPtr(row.TimeLimitSeconds)
+	qs.Kind = row.Kind
 	for _, o := range qs.Options {
 		o.ID = 0
 		o.QuestionID = qs.ID
@@ -1135,7 +1576,10 @@ func (s *QuizStore) execUpdateQuestion(ctx context.Context, q *db.Queries, qs *q
 		return quiz.ErrCannotUpdateQuestionWithIDZero
 	}
 
-	var err error
+	err := checkQuestionExternalRef(ctx, q, qs)
+	if err != nil {
+		return err
+	}
 	res, err := q.UpdateQuestion(ctx, db.UpdateQuestionParams{
 		Text:             qs.Text,
 		Position:         int64(qs.Position),
@@ -1143,14 +1587,36 @@ func (s *QuizStore) execUpdateQuestion(ctx context.Context, q *db.Queries, qs *q
 		AudioMediaID:     nullableInt64(qs.AudioMediaID),
 		AudioRepeat:      boolToInt64(qs.AudioRepeat),
 		TimeLimitSeconds: nullableInt(qs.TimeLimitSeconds),
+		Kind:             quiz.NormalizedKind(qs.Kind),
+		AfterQuestionID:  nullableInt64(qs.AfterQuestionID),
+		Explanation:      qs.Explanation,
+		Hint:             qs.Hint,
+		ExternalRef:      nullableString(qs.ExternalRef),
 		ID:               qs.ID,
+		Version:          qs.Version,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update question: %w", err)
 	}
 
 	if database.MustRowsAffected(res) == 0 {
-		return quiz.ErrUpdatingQuestionNoRowsAffected
+		if _, err = q.QuestionVersion(ctx, qs.ID); errors.Is(err, sql.ErrNoRows) {
+			return quiz.ErrUpdatingQuestionNoRowsAffected
+		} else if err != nil {
+			return fmt.Errorf("failed to read question version: %w", err)
+		}
+
+		return quiz.ErrStaleUpdate
+	}
+	qs.Version++
+	qs.Kind = quiz.NormalizedKind(qs.Kind)
+
+	if qs.ResetStats {
+		if _, err = q.BumpQuestionStatsEpoch(ctx, qs.ID); err != nil {
+			return fmt.Errorf("failed to reset question stats: %w", err)
+		}
+		qs.StatsEpoch++
+		qs.ResetStats = false
 	}
 
 	for _, o := range qs.Options {
@@ -1250,19 +1716,20 @@ func (s *QuizStore) execDeleteQuestions(ctx context.Context, q *db.Queries, ids
 }
 
 func (s *QuizStore) listOptions(ctx context.Context, questionID int64) ([]*quiz.Option, error) {
-	rows, err := s.q.ListOptionsByQuestionID(ctx, questionID)
+	return listQuestionOptions(ctx, s.q, questionID)
+}
+
+// listQuestionOptions fetches one question's options in ascending option ID
+// order. Shared by QuizStore and GameStore's next-question read.
+func listQuestionOptions(ctx context.Context, q *db.Queries, questionID int64) ([]*quiz.Option, error) {
+	rows, err := q.ListOptionsByQuestionID(ctx, questionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list options for question %d: %w", questionID, err)
 	}
 
 	options := make([]*quiz.Option, 0, len(rows))
 	for _, r := range rows {
-		options = append(options, &quiz.Option{
-			ID:         r.ID,
-			QuestionID: r.QuestionID,
-			Text:       r.Text,
-			Correct:    r.IsCorrect,
-		})
+		options = append(options, optionFromRow(r))
 	}
 
 	return options, nil
@@ -1280,12 +1747,7 @@ func (s *QuizStore) listOptionsByQuiz(ctx context.Context, quizID int64) (map[in
 
 	optionsByQuestion := make(map[int64][]*quiz.Option)
 	for _, r := range rows {
-		optionsByQuestion[r.QuestionID] = append(optionsByQuestion[r.QuestionID], &quiz.Option{
-			ID:         r.ID,
-			QuestionID: r.QuestionID,
-			Text:       r.Text,
-			Correct:    r.IsCorrect,
-		})
+		optionsByQuestion[r.QuestionID] = append(optionsByQuestion[r.QuestionID], optionFromRow(r))
 	}
 
 	return optionsByQuestion, nil
@@ -1330,9 +1792,12 @@ func (s *QuizStore) handleOptions(ctx context.Context, q *db.Queries, qs *quiz.Q
 
 func (*QuizStore) createOption(ctx context.Context, q *db.Queries, o *quiz.Option) error {
 	row, err := q.CreateOption(ctx, db.CreateOptionParams{
-		QuestionID: o.QuestionID,
-		Text:       o.Text,
-		IsCorrect:  o.Correct,
+		QuestionID:     o.QuestionID,
+		Text:           o.Text,
+		IsCorrect:      o.Correct,
+		NumericValue:   nullableFloat64(o.NumericValue),
+		ToleranceBelow: o.ToleranceBelow,
+		ToleranceAbove: o.ToleranceAbove,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create option: %w", err)
@@ -1345,10 +1810,13 @@ func (*QuizStore) createOption(ctx context.Context, q *db.Queries, o *quiz.Optio
 
 func (*QuizStore) updateOption(ctx context.Context, q *db.Queries, questionID int64, o *quiz.Option) error {
 	res, err := q.UpdateOption(ctx, db.UpdateOptionParams{
-		Text:       o.Text,
-		IsCorrect:  o.Correct,
-		ID:         o.ID,
-		QuestionID: questionID,
+		Text:           o.Text,
+		IsCorrect:      o.Correct,
+		NumericValue:   nullableFloat64(o.NumericValue),
+		ToleranceBelow: o.ToleranceBelow,
+		ToleranceAbove: o.ToleranceAbove,
+		ID:             o.ID,
+		QuestionID:     questionID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update option: %w", err)
@@ -1386,6 +1854,60 @@ func (*QuizStore) deleteOption(ctx context.Context, q *db.Queries, questionID, i
 	return nil
 }
 
+// checkQuizExternalRef returns quiz.ErrExternalRefTaken when another quiz
+// already has qz's external reference. Checked before the write so a taken
+// reference surfaces as its own sentinel instead of the unique violation
+// classifySlugConflictErr would report as a taken slug.
+func checkQuizExternalRef(ctx context.Context, q *db.Queries, qz *quiz.Quiz) error {
+	if qz.ExternalRef == "" {
+		return nil
+	}
+	id, err := q.GetQuizIDByExternalRef(ctx, nullableString(qz.ExternalRef))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check quiz external ref: %w", err)
+	}
+	if id != qz.ID {
+		return quiz.ErrExternalRefTaken
+	}
+
+	return nil
+}
+
+// checkQuestionExternalRef returns quiz.ErrExternalRefTaken when another
+// question of qs's quiz already has its external reference. Checked before
+// the write so a taken reference surfaces as its sentinel rather than as a
+// unique violation, which CreateQuestionAtNextPosition would retry as a
+// position clash.
+func checkQuestionExternalRef(ctx context.Context, q *db.Queries, qs *quiz.Question) error {
+	if qs.ExternalRef == "" {
+		return nil
+	}
+	row, err := q.GetQuestionByExternalRef(ctx, db.GetQuestionByExternalRefParams{
+		QuizID:      qs.QuizID,
+		ExternalRef: nullableString(qs.ExternalRef),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check question external ref: %w", err)
+	}
+	if row.ID != qs.ID {
+		return quiz.ErrExternalRefTaken
+	}
+
+	return nil
+}
+
+// nullableString packs an optional text value for a nullable TEXT column
+// such as questions.external_ref; the empty string maps to NULL.
+func nullableString(v string) sql.NullString {
+	return sql.NullString{String: v, Valid: v != ""}
+}
+
 // nullableInt packs a *int into the [sql.NullInt64] the sqlc-generated params
 // expect for nullable integer columns such as questions.time_limit_seconds
 // (nil -> "inherit the quiz default", #99) and media.duration_ms (nil ->
@@ -1432,6 +1954,39 @@ func nullableInt64ToPtr(v sql.NullInt64) *int64 {
 	return &out
 }
 
+// nullableFloat64 packs a *float64 into the [sql.NullFloat64] the
+// sqlc-generated params expect for options.numeric_value and
+// game_answers.numeric_value. nil -> NULL: not a numeric answer key / not a
+// numeric answer.
+func nullableFloat64(v *float64) sql.NullFloat64 {
+	if v == nil {
+		return sql.NullFloat64{}
+	}
+
+	return sql.NullFloat64{Float64: *v, Valid: true}
+}
+
+// nullableFloat64ToPtr is the inverse of nullableFloat64.
+func nullableFloat64ToPtr(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	out := v.Float64
+
+	return &out
+}
+
+// nullTimeToPtr maps a nullable DATETIME column (quizzes.published_at) onto
+// a *time.Time, nil for NULL.
+func nullTimeToPtr(v sql.NullTime) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	out := v.Time
+
+	return &out
+}
+
 // boolToInt64 maps a Go bool onto the 0/1 INTEGER column sqlc generates as
 // int64 (e.g. questions.audio_repeat).
 //
@@ -15,6 +15,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/starquake/topbanana/internal/database"
 	"github.com/starquake/topbanana/internal/dbtest"
 	"github.com/starquake/topbanana/internal/game"
 	"github.com/starquake/topbanana/internal/quiz"
@@ -220,7 +221,7 @@ func TestQuizStore_Ping(t *testing.T) {
 	t.Run("ping success", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -232,7 +233,7 @@ func TestQuizStore_Ping(t *testing.T) {
 	t.Run("ping failure", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		// Close the database to trigger a ping error
@@ -254,7 +255,7 @@ func TestQuizStore_Ping(t *testing.T) {
 func TestQuizStore_ListQuizzes(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 
 	quizStore := NewQuizStore(db, slog.Default())
 
@@ -288,6 +289,8 @@ func TestQuizStore_ListQuizzes(t *testing.T) {
 			Visibility:           qz.Visibility,
 			Mode:                 qz.Mode,
 			Language:             qz.Language,
+			LateJoin:             qz.LateJoin,
+			Scoring:              qz.Scoring,
 		})
 	}
 
@@ -302,7 +305,7 @@ func TestQuizStore_ListQuizzes(t *testing.T) {
 func TestQuizStore_ListQuizzesForOwner(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.New(slog.DiscardHandler))
 	playerStore := NewPlayerStore(db, slog.Default())
 
@@ -345,7 +348,7 @@ func TestQuizStore_ListQuizzesForOwner(t *testing.T) {
 func TestQuizStore_ListLiveQuizzes(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.New(slog.DiscardHandler))
 
 	soloQz := &quiz.Quiz{
@@ -394,7 +397,7 @@ func TestQuizStore_ListLiveQuizzes(t *testing.T) {
 func TestQuizStore_ListLiveQuizzesForOwner(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.New(slog.DiscardHandler))
 	playerStore := NewPlayerStore(db, slog.Default())
 
@@ -438,6 +441,138 @@ func TestQuizStore_ListLiveQuizzesForOwner(t *testing.T) {
 	}
 }
 
+func TestQuizStore_ListQuizzesPage(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.New(slog.DiscardHandler))
+	playerStore := NewPlayerStore(db, slog.Default())
+
+	other, err := playerStore.CreateAnonymousPlayer(t.Context(), "page-owner-other")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+
+	for i := range 3 {
+		qz := &quiz.Quiz{
+			Title: fmt.Sprintf("Admin Solo %d", i+1), Slug: fmt.Sprintf("admin-solo-%d", i+1), Description: "x",
+			CreatedByPlayerID: seededAdminID, Visibility: quiz.VisibilityPublic, Published: true,
+		}
+		if err = quizStore.CreateQuiz(t.Context(), qz); err != nil {
+			t.Fatalf("CreateQuiz(%s) err = %v, want nil", qz.Title, err)
+		}
+	}
+	live := &quiz.Quiz{
+		Title: "Admin Live", Slug: "admin-live", Description: "x",
+		CreatedByPlayerID: seededAdminID, Visibility: quiz.VisibilityPublic, Published: true,
+	}
+	otherQuiz := &quiz.Quiz{
+		Title: "Other Solo", Slug: "other-solo", Description: "x",
+		CreatedByPlayerID: other.ID, Visibility: quiz.VisibilityPrivate, Published: true,
+	}
+	for _, qz := range []*quiz.Quiz{live, otherQuiz} {
+		if err = quizStore.CreateQuiz(t.Context(), qz); err != nil {
+			t.Fatalf("CreateQuiz(%s) err = %v, want nil", qz.Title, err)
+		}
+	}
+	if err = quizStore.SetQuizMode(t.Context(), live.ID, quiz.ModeLive); err != nil {
+		t.Fatalf("SetQuizMode(live) err = %v, want nil", err)
+	}
+
+	t.Run("pages cover the whole list once", func(t *testing.T) {
+		t.Parallel()
+
+		total, err := quizStore.CountQuizzes(t.Context(), 0, "")
+		if err != nil {
+			t.Fatalf("CountQuizzes err = %v, want nil", err)
+		}
+		if got, want := total, int64(5); got != want {
+			t.Fatalf("CountQuizzes = %d, want %d", got, want)
+		}
+
+		all, err := quizStore.ListQuizzes(t.Context())
+		if err != nil {
+			t.Fatalf("ListQuizzes err = %v, want nil", err)
+		}
+		var paged []*quiz.Quiz
+		for offset := int64(0); offset < total; offset += 2 {
+			page, err := quizStore.ListQuizzesPage(t.Context(), 0, "", 2, offset)
+			if err != nil {
+				t.Fatalf("ListQuizzesPage(offset %d) err = %v, want nil", offset, err)
+			}
+			if len(page) > 2 {
+				t.Fatalf("ListQuizzesPage(offset %d) len = %d, want <= 2", offset, len(page))
+			}
+			paged = append(paged, page...)
+		}
+		if got, want := quizIDs(paged), quizIDs(all); !slices.Equal(got, want) {
+			t.Errorf("paged ids = %v, want ListQuizzes order %v", got, want)
+		}
+	})
+
+	t.Run("owner and mode filters", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			ownerID int64
+			mode    string
+			want    []string
+		}{
+			{"owner", other.ID, "", []string{"Other Solo"}},
+			{"live", 0, string(quiz.ModeLive), []string{"Admin Live"}},
+			{"owner and solo", seededAdminID, string(quiz.ModeSolo), []string{"Admin Solo 1", "Admin Solo 2", "Admin Solo 3"}},
+		}
+		for _, tt := range tests {
+			page, err := quizStore.ListQuizzesPage(t.Context(), tt.ownerID, tt.mode, 10, 0)
+			if err != nil {
+				t.Fatalf("%s: ListQuizzesPage err = %v, want nil", tt.name, err)
+			}
+			if got, want := quizTitles(page), tt.want; !slices.Equal(got, want) {
+				t.Errorf("%s: titles = %v, want %v", tt.name, got, want)
+			}
+			total, err := quizStore.CountQuizzes(t.Context(), tt.ownerID, tt.mode)
+			if err != nil {
+				t.Fatalf("%s: CountQuizzes err = %v, want nil", tt.name, err)
+			}
+			if got, want := total, int64(len(tt.want)); got != want {
+				t.Errorf("%s: CountQuizzes = %d, want %d", tt.name, got, want)
+			}
+		}
+	})
+
+	t.Run("public page", func(t *testing.T) {
+		t.Parallel()
+
+		// Only the three public solo quizzes qualify: the live one and the
+		// private one stay out, as in ListPublicQuizzes.
+		total, err := quizStore.CountPublicQuizzes(t.Context())
+		if err != nil {
+			t.Fatalf("CountPublicQuizzes err = %v, want nil", err)
+		}
+		if got, want := total, int64(3); got != want {
+			t.Errorf("CountPublicQuizzes = %d, want %d", got, want)
+		}
+		page, err := quizStore.ListPublicQuizzesPage(t.Context(), 2, 2)
+		if err != nil {
+			t.Fatalf("ListPublicQuizzesPage err = %v, want nil", err)
+		}
+		if got, want := len(page), 1; got != want {
+			t.Errorf("len(ListPublicQuizzesPage(2, 2)) = %d, want %d", got, want)
+		}
+	})
+}
+
+// quizIDs returns the ids of the given quizzes in order.
+func quizIDs(quizzes []*quiz.Quiz) []int64 {
+	ids := make([]int64, 0, len(quizzes))
+	for _, qz := range quizzes {
+		ids = append(ids, qz.ID)
+	}
+
+	return ids
+}
+
 // quizTitles returns the sorted titles of the given quizzes for order-independent
 // assertions.
 func quizTitles(quizzes []*quiz.Quiz) []string {
@@ -453,7 +588,7 @@ func quizTitles(quizzes []*quiz.Quiz) []string {
 func TestQuizStore_QuestionCountsByQuiz(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	// Two quizzes with different question counts; one with no questions
@@ -494,7 +629,7 @@ func TestQuizStore_QuestionCountsByQuiz(t *testing.T) {
 func TestQuizStore_RoundCountsByQuiz(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	// CreateQuiz seeds a default round (#444), so every quiz starts with
@@ -547,7 +682,7 @@ func TestQuizStore_ListQuizzes_ErrorHandling(t *testing.T) {
 	t.Run("context canceled", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -570,7 +705,7 @@ func TestQuizStore_GetQuiz(t *testing.T) {
 	t.Run("valid quiz ID", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -598,7 +733,7 @@ func TestQuizStore_GetQuiz(t *testing.T) {
 	t.Run("invalid quiz ID", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -618,7 +753,7 @@ func TestQuizStore_ListQuestions(t *testing.T) {
 	t.Run("groups options under their question in order", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -663,7 +798,7 @@ func TestQuizStore_ListQuestions(t *testing.T) {
 	t.Run("question with no options yields a non-nil empty slice", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -703,7 +838,7 @@ func TestQuizStore_GetQuizVisibility(t *testing.T) {
 	t.Run("returns the visibility of an existing quiz", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -724,7 +859,7 @@ func TestQuizStore_GetQuizVisibility(t *testing.T) {
 	t.Run("returns ErrQuizNotFound for a missing quiz", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		_, err := quizStore.GetQuizVisibility(t.Context(), 999)
@@ -740,7 +875,7 @@ func TestQuizStore_GetQuizMeta(t *testing.T) {
 	t.Run("returns metadata without the question tree", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -769,7 +904,7 @@ func TestQuizStore_GetQuizMeta(t *testing.T) {
 	t.Run("returns ErrQuizNotFound for a missing quiz", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		_, err := quizStore.GetQuizMeta(t.Context(), 999)
@@ -785,7 +920,7 @@ func TestQuizStore_GetQuiz_ErrorHandling(t *testing.T) {
 	t.Run("context canceled", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -805,7 +940,7 @@ func TestQuizStore_GetQuiz_ErrorHandling(t *testing.T) {
 	t.Run("list questions error", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -837,7 +972,7 @@ func TestQuizStore_QuizExists(t *testing.T) {
 	t.Run("returns true for an existing quiz", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -857,7 +992,7 @@ func TestQuizStore_QuizExists(t *testing.T) {
 	t.Run("returns false for a missing quiz", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		exists, err := quizStore.QuizExists(t.Context(), 999)
@@ -876,7 +1011,7 @@ func TestQuizStore_GetQuestion(t *testing.T) {
 	t.Run("valid question ID", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -903,7 +1038,7 @@ func TestQuizStore_GetQuestion(t *testing.T) {
 	t.Run("invalid question ID", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -917,13 +1052,144 @@ func TestQuizStore_GetQuestion(t *testing.T) {
 	})
 }
 
+func TestQuizStore_GetQuestionByExternalRef(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	testQuiz := newTestQuizzes()[0]
+	if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+
+	qs := &quiz.Question{
+		QuizID:      testQuiz.ID,
+		Text:        "Synced question",
+		ExternalRef: "cms-1",
+		Options:     []*quiz.Option{{Text: "A", Correct: true}, {Text: "B"}},
+	}
+	if err := quizStore.CreateQuestionAtNextPosition(t.Context(), qs); err != nil {
+		t.Fatalf("CreateQuestionAtNextPosition err = %v", err)
+	}
+
+	got, err := quizStore.GetQuestionByExternalRef(t.Context(), testQuiz.ID, "cms-1")
+	if err != nil {
+		t.Fatalf("GetQuestionByExternalRef err = %v", err)
+	}
+	if got.ID != qs.ID || got.ExternalRef != "cms-1" || len(got.Options) != 2 {
+		t.Errorf("got question %d ref %q with %d options, want %d ref %q with 2",
+			got.ID, got.ExternalRef, len(got.Options), qs.ID, "cms-1")
+	}
+
+	if _, err = quizStore.GetQuestionByExternalRef(t.Context(), testQuiz.ID, "cms-2"); !errors.Is(
+		err, quiz.ErrQuestionNotFound,
+	) {
+		t.Errorf("unknown ref err = %v, want %v", err, quiz.ErrQuestionNotFound)
+	}
+
+	dup := &quiz.Question{QuizID: testQuiz.ID, Text: "Again", ExternalRef: "cms-1"}
+	if err = quizStore.CreateQuestionAtNextPosition(t.Context(), dup); !errors.Is(err, quiz.ErrExternalRefTaken) {
+		t.Errorf("duplicate ref err = %v, want %v", err, quiz.ErrExternalRefTaken)
+	}
+}
+
+func TestQuizStore_GetQuizIDByExternalRef(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	quizzes := newTestQuizzes()
+	first, second := quizzes[0], quizzes[1]
+	first.ExternalRef = "cms-quiz"
+	if err := quizStore.CreateQuiz(t.Context(), first); err != nil {
+		t.Fatalf("CreateQuiz err = %v", err)
+	}
+
+	got, err := quizStore.GetQuizIDByExternalRef(t.Context(), "cms-quiz")
+	if err != nil {
+		t.Fatalf("GetQuizIDByExternalRef err = %v", err)
+	}
+	if got != first.ID {
+		t.Errorf("GetQuizIDByExternalRef = %d, want %d", got, first.ID)
+	}
+	if _, err = quizStore.GetQuizIDByExternalRef(t.Context(), "nope"); !errors.Is(err, quiz.ErrQuizNotFound) {
+		t.Errorf("unknown ref err = %v, want %v", err, quiz.ErrQuizNotFound)
+	}
+
+	second.ExternalRef = "cms-quiz"
+	if err = quizStore.CreateQuiz(t.Context(), second); !errors.Is(err, quiz.ErrExternalRefTaken) {
+		t.Fatalf("duplicate ref on create err = %v, want %v", err, quiz.ErrExternalRefTaken)
+	}
+	second.ExternalRef = ""
+	if err = quizStore.CreateQuiz(t.Context(), second); err != nil {
+		t.Fatalf("CreateQuiz err = %v", err)
+	}
+	second.ExternalRef = "cms-quiz"
+	if err = quizStore.UpdateQuiz(t.Context(), second); !errors.Is(err, quiz.ErrExternalRefTaken) {
+		t.Errorf("duplicate ref on update err = %v, want %v", err, quiz.ErrExternalRefTaken)
+	}
+}
+
+func TestQuizStore_GetQuestionProgress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("matches QuestionRoundProgress across rounds", func(t *testing.T) {
+		t.Parallel()
+
+		db := dbtest.OpenBackend(t)
+		quizStore := NewQuizStore(db, slog.Default())
+		testQuiz := newTestQuizzes()[0]
+		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+			t.Fatalf("CreateQuiz err = %v, want nil", err)
+		}
+		second := &quiz.Round{QuizID: testQuiz.ID, Position: 1, Title: "Round 2"}
+		if err := quizStore.CreateRound(t.Context(), second); err != nil {
+			t.Fatalf("CreateRound err = %v, want nil", err)
+		}
+		extra := &quiz.Question{QuizID: testQuiz.ID, RoundID: second.ID, Text: "Question 3"}
+		if err := quizStore.CreateQuestionAtNextPosition(t.Context(), extra); err != nil {
+			t.Fatalf("CreateQuestionAtNextPosition err = %v, want nil", err)
+		}
+
+		questions, err := quizStore.ListQuestions(t.Context(), testQuiz.ID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v, want nil", err)
+		}
+		for _, qs := range questions {
+			got, err := quizStore.GetQuestionProgress(t.Context(), qs.ID)
+			if err != nil {
+				t.Fatalf("GetQuestionProgress(%d) err = %v, want nil", qs.ID, err)
+			}
+			want := &quiz.QuestionProgress{
+				Total:         len(questions),
+				RoundProgress: quiz.QuestionRoundProgress(questions, qs.ID),
+			}
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Errorf("GetQuestionProgress(%d) diff (-got +want):\n%s", qs.ID, diff)
+			}
+		}
+	})
+
+	t.Run("invalid question ID", func(t *testing.T) {
+		t.Parallel()
+
+		db := dbtest.OpenBackend(t)
+		quizStore := NewQuizStore(db, slog.Default())
+
+		_, err := quizStore.GetQuestionProgress(t.Context(), 999)
+		if got, want := err, quiz.ErrQuestionNotFound; !errors.Is(got, want) {
+			t.Errorf("err = %v, want %v", got, want)
+		}
+	})
+}
+
 func TestQuizStore_GetQuestion_ErrorHandling(t *testing.T) {
 	t.Parallel()
 
 	t.Run("context canceled", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -942,7 +1208,7 @@ func TestQuizStore_GetQuestion_ErrorHandling(t *testing.T) {
 	t.Run("list options error", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -974,7 +1240,7 @@ func TestQuizStore_CreateQuiz(t *testing.T) {
 	t.Run("quiz with questions and options", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -1001,7 +1267,7 @@ func TestQuizStore_CreateQuiz(t *testing.T) {
 	t.Run("ignore supplied ID's", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -1060,7 +1326,7 @@ func TestQuizStore_CreateQuiz_ErrorHandling(t *testing.T) {
 	t.Run("quiz insert error", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -1085,7 +1351,7 @@ func TestQuizStore_CreateQuiz_ErrorHandling(t *testing.T) {
 	t.Run("question insert error", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -1110,7 +1376,7 @@ func TestQuizStore_CreateQuiz_ErrorHandling(t *testing.T) {
 	t.Run("option insert error", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, slog.Default())
 
@@ -1134,7 +1400,7 @@ func TestQuizStore_CreateQuiz_ErrorHandling(t *testing.T) {
 	t.Run("duplicate slug returns ErrSlugTaken", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		first := &quiz.Quiz{
@@ -1169,7 +1435,7 @@ func TestQuizStore_UpdateQuiz(t *testing.T) {
 	buf := bytes.Buffer{}
 	logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 
 	quizStore := NewQuizStore(db, logger)
 
@@ -1183,6 +1449,7 @@ func TestQuizStore_UpdateQuiz(t *testing.T) {
 
 	updatedQuiz := &quiz.Quiz{
 		ID:                   originalQuiz.ID,
+		Version:              originalQuiz.Version,
 		Title:                originalQuiz.Title + " Updated",
 		Slug:                 originalQuiz.Slug + "-updated",
 		Description:          originalQuiz.Description + " Updated",
@@ -1196,9 +1463,10 @@ func TestQuizStore_UpdateQuiz(t *testing.T) {
 		Language:             originalQuiz.Language,
 		Questions: []*quiz.Question{
 			{
-				ID:     originalQuiz.Questions[0].ID,
-				QuizID: originalQuiz.ID,
-				Text:   originalQuiz.Questions[0].Text + " Updated",
+				ID:      originalQuiz.Questions[0].ID,
+				Version: originalQuiz.Questions[0].Version,
+				QuizID:  originalQuiz.ID,
+				Text:    originalQuiz.Questions[0].Text + " Updated",
 				Options: []*quiz.Option{
 					{
 						ID:      originalQuiz.Questions[0].Options[1].ID,
@@ -1248,7 +1516,7 @@ func TestQuizStore_UpdateQuiz(t *testing.T) {
 
 // modeOf reads a quiz's persisted play mode, failing the test if the read
 // errors. Keeps the SetQuizMode assertions free of repeated GetQuiz plumbing.
-func modeOf(t *testing.T, s *QuizStore, id int64) string {
+func modeOf(t *testing.T, s *QuizStore, id int64) quiz.Mode {
 	t.Helper()
 
 	qz, err := s.GetQuiz(t.Context(), id)
@@ -1267,7 +1535,7 @@ func TestQuizStore_QuizLanguage(t *testing.T) {
 	t.Run("create stores and reads back the language", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 
 		qz := newTestQuizzes()[0]
@@ -1288,7 +1556,7 @@ func TestQuizStore_QuizLanguage(t *testing.T) {
 	t.Run("empty language defaults to English", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 
 		qz := newTestQuizzes()[0]
@@ -1309,7 +1577,7 @@ func TestQuizStore_QuizLanguage(t *testing.T) {
 	t.Run("update changes the language", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 
 		qz := newTestQuizzes()[0]
@@ -1340,7 +1608,7 @@ func TestQuizStore_SetQuizMode(t *testing.T) {
 	t.Run("flips solo to live and back", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 
 		qz := newTestQuizzes()[0]
@@ -1366,7 +1634,7 @@ func TestQuizStore_SetQuizMode(t *testing.T) {
 	t.Run("rejects an unknown mode", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 
 		qz := newTestQuizzes()[0]
@@ -1383,7 +1651,7 @@ func TestQuizStore_SetQuizMode(t *testing.T) {
 	t.Run("missing quiz returns ErrQuizNotFound", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 
 		err := quizStore.SetQuizMode(t.Context(), 9999, quiz.ModeLive)
@@ -1401,7 +1669,7 @@ func TestQuizStore_SetQuizPublished(t *testing.T) {
 	t.Run("publishes a draft and unpublishes it again", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 
 		qz := newTestQuizzes()[0]
@@ -1428,10 +1696,72 @@ func TestQuizStore_SetQuizPublished(t *testing.T) {
 		}
 	})
 
+	t.Run("stamps published_at on publish, keeps it on a re-publish and clears it on unpublish", func(t *testing.T) {
+		t.Parallel()
+
+		db := dbtest.OpenBackend(t)
+		quizStore := NewQuizStore(db, logger)
+
+		qz := newTestQuizzes()[0]
+		if err := quizStore.CreateQuiz(t.Context(), qz); err != nil {
+			t.Fatalf("CreateQuiz err = %v, want nil", err)
+		}
+		if qz.PublishedAt != nil {
+			t.Fatalf("draft PublishedAt = %v, want nil", qz.PublishedAt)
+		}
+
+		if err := quizStore.SetQuizPublished(t.Context(), qz.ID, true); err != nil {
+			t.Fatalf("SetQuizPublished(true) err = %v, want nil", err)
+		}
+		if got := publishedAtOf(t, quizStore, qz.ID); got == nil {
+			t.Fatal("PublishedAt = nil after publish, want a time")
+		}
+
+		// Backdate the stamp so a re-publish that overwrote it would show.
+		if _, err := db.ExecContext(t.Context(),
+			"UPDATE quizzes SET published_at = '2026-01-02 03:04:05' WHERE id = ?", qz.ID,
+		); err != nil {
+			t.Fatalf("backdate published_at err = %v, want nil", err)
+		}
+		if err := quizStore.SetQuizPublished(t.Context(), qz.ID, true); err != nil {
+			t.Fatalf("SetQuizPublished(true) again err = %v, want nil", err)
+		}
+		want := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		if got := publishedAtOf(t, quizStore, qz.ID); got == nil || !got.Equal(want) {
+			t.Errorf("PublishedAt after re-publish = %v, want %v (kept)", got, want)
+		}
+
+		if err := quizStore.SetQuizPublished(t.Context(), qz.ID, false); err != nil {
+			t.Fatalf("SetQuizPublished(false) err = %v, want nil", err)
+		}
+		if got := publishedAtOf(t, quizStore, qz.ID); got != nil {
+			t.Errorf("PublishedAt after unpublish = %v, want nil", got)
+		}
+	})
+
+	t.Run("a quiz created published is stamped", func(t *testing.T) {
+		t.Parallel()
+
+		db := dbtest.OpenBackend(t)
+		quizStore := NewQuizStore(db, logger)
+
+		qz := newTestQuizzes()[0]
+		qz.Published = true
+		if err := quizStore.CreateQuiz(t.Context(), qz); err != nil {
+			t.Fatalf("CreateQuiz err = %v, want nil", err)
+		}
+		if qz.PublishedAt == nil {
+			t.Error("CreateQuiz PublishedAt = nil, want the create time")
+		}
+		if got := publishedAtOf(t, quizStore, qz.ID); got == nil {
+			t.Error("stored PublishedAt = nil, want the create time")
+		}
+	})
+
 	t.Run("missing quiz returns ErrQuizNotFound", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 
 		err := quizStore.SetQuizPublished(t.Context(), 9999, true)
@@ -1464,7 +1794,7 @@ func TestQuizStore_QuizHasRealPlays(t *testing.T) {
 	t.Run("false with no games and false with only a preview game", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 		gameStore := NewGameStore(db, logger)
 		quizID, playerID := seedQuizAndPlayer(t, db, quizStore)
@@ -1488,7 +1818,7 @@ func TestQuizStore_QuizHasRealPlays(t *testing.T) {
 	t.Run("true once a non-preview game exists", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 		gameStore := NewGameStore(db, logger)
 		quizID, playerID := seedQuizAndPlayer(t, db, quizStore)
@@ -1531,7 +1861,7 @@ func TestQuizStore_UnpublishQuizIfUnplayed(t *testing.T) {
 	t.Run("unplayed quiz is unpublished", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 		quizID, _ := seedPublishedQuiz(t, db, quizStore)
 
@@ -1550,7 +1880,7 @@ func TestQuizStore_UnpublishQuizIfUnplayed(t *testing.T) {
 	t.Run("a preview-only game still allows unpublish", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 		gameStore := NewGameStore(db, logger)
 		quizID, playerID := seedPublishedQuiz(t, db, quizStore)
@@ -1577,7 +1907,7 @@ func TestQuizStore_UnpublishQuizIfUnplayed(t *testing.T) {
 	t.Run("a played quiz is not unpublished", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, logger)
 		gameStore := NewGameStore(db, logger)
 		quizID, playerID := seedPublishedQuiz(t, db, quizStore)
@@ -1606,7 +1936,7 @@ func TestQuizStore_UnpublishQuizIfUnplayed(t *testing.T) {
 func TestQuizStore_ListPublicQuizzes_ExcludesDrafts(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.New(slog.DiscardHandler))
 
 	published := &quiz.Quiz{
@@ -1652,6 +1982,16 @@ func publishedOf(t *testing.T, quizStore *QuizStore, quizID int64) bool {
 	return qz.Published
 }
 
+func publishedAtOf(t *testing.T, quizStore *QuizStore, quizID int64) *time.Time {
+	t.Helper()
+	qz, err := quizStore.GetQuiz(t.Context(), quizID)
+	if err != nil {
+		t.Fatalf("GetQuiz err = %v, want nil", err)
+	}
+
+	return qz.PublishedAt
+}
+
 func hasRealPlays(t *testing.T, quizStore *QuizStore, quizID int64) bool {
 	t.Helper()
 	got, err := quizStore.QuizHasRealPlays(t.Context(), quizID)
@@ -1671,7 +2011,7 @@ func TestQuizStore_UpdateQuiz_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -1690,7 +2030,7 @@ func TestQuizStore_UpdateQuiz_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		// Rename questions table to force an insert error
 		_, err := db.ExecContext(t.Context(), "ALTER TABLE quizzes RENAME TO quizzes_backup")
@@ -1717,7 +2057,7 @@ func TestQuizStore_UpdateQuiz_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -1737,7 +2077,7 @@ func TestQuizStore_UpdateQuiz_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -1756,6 +2096,7 @@ func TestQuizStore_UpdateQuiz_ErrorHandling(t *testing.T) {
 
 		updatedQuiz := &quiz.Quiz{
 			ID:          originalQuiz.ID,
+			Version:     originalQuiz.Version,
 			Title:       "Quiz 1",
 			Slug:        "quiz-1",
 			Description: "Description",
@@ -1785,7 +2126,7 @@ func TestQuizStore_CreateQuestion(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -1832,7 +2173,7 @@ func TestQuizStore_CreateQuestion(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -1867,7 +2208,7 @@ func TestQuizStore_CreateQuestion_ErrorHandling(t *testing.T) {
 	t.Run("fail on nonexisting quizID", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -1886,7 +2227,7 @@ func TestQuizStore_CreateQuestion_ErrorHandling(t *testing.T) {
 	t.Run("fail creating question on nonexisting quiz with a supplied ID", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -1910,7 +2251,7 @@ func TestQuizStore_UpdateQuestion(t *testing.T) {
 	buf := bytes.Buffer{}
 	logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 
 	quizStore := NewQuizStore(db, logger)
 
@@ -1921,8 +2262,9 @@ func TestQuizStore_UpdateQuestion(t *testing.T) {
 	}
 
 	updatedQuestion := &quiz.Question{
-		ID:     originalQuiz.Questions[0].ID,
-		QuizID: originalQuiz.ID,
+		ID:      originalQuiz.Questions[0].ID,
+		Version: originalQuiz.Questions[0].Version,
+		QuizID:  originalQuiz.ID,
 		// UpdateQuestion does not move a question between groups (#444),
 		// so the round it was created in (the quiz's default group) is
 		// the value GetQuestion reads back.
@@ -1969,7 +2311,7 @@ func TestQuizStore_UpdateQuestion_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -1988,7 +2330,7 @@ func TestQuizStore_UpdateQuestion_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -2011,7 +2353,7 @@ func TestQuizStore_UpdateQuestion_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -2048,7 +2390,7 @@ func TestQuizStore_UpdateQuestion_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -2065,8 +2407,9 @@ func TestQuizStore_UpdateQuestion_ErrorHandling(t *testing.T) {
 		}
 
 		updatedQuestion := &quiz.Question{
-			ID:   testQuiz.ID,
-			Text: testQuiz.Questions[0].Text + " Updated",
+			ID:      testQuiz.ID,
+			Version: testQuiz.Questions[0].Version,
+			Text:    testQuiz.Questions[0].Text + " Updated",
 			Options: []*quiz.Option{
 				{
 					ID:      testQuiz.Questions[0].Options[0].ID,
@@ -2091,7 +2434,7 @@ func TestQuizStore_UpdateQuestion_ErrorHandling(t *testing.T) {
 		buf := bytes.Buffer{}
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		db := dbtest.Open(t)
+		db := dbtest.Open(t) // SQLite trigger syntax.
 
 		quizStore := NewQuizStore(db, logger)
 
@@ -2115,9 +2458,10 @@ func TestQuizStore_UpdateQuestion_ErrorHandling(t *testing.T) {
 
 		// Keep the first option, drop the second, forces a DELETE during UpdateQuestion.
 		updatedQuestion := &quiz.Question{
-			ID:     testQuiz.Questions[0].ID,
-			QuizID: testQuiz.ID,
-			Text:   testQuiz.Questions[0].Text + " Updated",
+			ID:      testQuiz.Questions[0].ID,
+			Version: testQuiz.Questions[0].Version,
+			QuizID:  testQuiz.ID,
+			Text:    testQuiz.Questions[0].Text + " Updated",
 			Options: []*quiz.Option{
 				{
 					ID:      testQuiz.Questions[0].Options[0].ID,
@@ -2163,7 +2507,7 @@ func TestQuizStore_SetQuestionMedia(t *testing.T) {
 	t.Run("sets and clears media references", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		mediaStore := NewMediaStore(db, slog.Default())
 
@@ -2228,7 +2572,7 @@ func TestQuizStore_SetQuestionMedia(t *testing.T) {
 	t.Run("unknown id returns no-rows sentinel", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		err := quizStore.SetQuestionMedia(t.Context(), 999999, nil, nil, false)
@@ -2238,13 +2582,87 @@ func TestQuizStore_SetQuestionMedia(t *testing.T) {
 	})
 }
 
+// TestQuizStore_QuestionAfter pins the ordering constraint round-trip: an
+// update stores after_question_id, reads return it, and deleting the
+// referenced question clears it instead of the dependent question.
+func TestQuizStore_QuestionAfter(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.Default())
+
+	testQuiz := newTestQuizzes()[0]
+	if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+	first, second := testQuiz.Questions[0], testQuiz.Questions[1]
+
+	second.AfterQuestionID = &first.ID
+	if err := quizStore.UpdateQuestion(t.Context(), second); err != nil {
+		t.Fatalf("UpdateQuestion err = %v, want nil", err)
+	}
+	got, err := quizStore.GetQuestion(t.Context(), second.ID)
+	if err != nil {
+		t.Fatalf("GetQuestion err = %v, want nil", err)
+	}
+	if got.AfterQuestionID == nil || *got.AfterQuestionID != first.ID {
+		t.Fatalf("AfterQuestionID = %v, want %d", got.AfterQuestionID, first.ID)
+	}
+
+	if err = quizStore.DeleteQuestion(t.Context(), first.ID); err != nil {
+		t.Fatalf("DeleteQuestion err = %v, want nil", err)
+	}
+	got, err = quizStore.GetQuestion(t.Context(), second.ID)
+	if err != nil {
+		t.Fatalf("GetQuestion after delete err = %v, want nil", err)
+	}
+	if got.AfterQuestionID != nil {
+		t.Errorf("AfterQuestionID = %d, want nil once the referenced question is gone", *got.AfterQuestionID)
+	}
+}
+
+// TestQuizStore_QuestionExplanation pins the explanation round-trip through
+// both create and update.
+func TestQuizStore_QuestionExplanation(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.Default())
+
+	testQuiz := newTestQuizzes()[0]
+	testQuiz.Questions[0].Explanation = "Because it is."
+	if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+	qs := testQuiz.Questions[0]
+	got, err := quizStore.GetQuestion(t.Context(), qs.ID)
+	if err != nil {
+		t.Fatalf("GetQuestion err = %v, want nil", err)
+	}
+	if got, want := got.Explanation, "Because it is."; got != want {
+		t.Errorf("Explanation after create = %q, want %q", got, want)
+	}
+
+	qs.Explanation = "Because the atlas says so."
+	if err = quizStore.UpdateQuestion(t.Context(), qs); err != nil {
+		t.Fatalf("UpdateQuestion err = %v, want nil", err)
+	}
+	got, err = quizStore.GetQuestion(t.Context(), qs.ID)
+	if err != nil {
+		t.Fatalf("GetQuestion err = %v, want nil", err)
+	}
+	if got, want := got.Explanation, qs.Explanation; got != want {
+		t.Errorf("Explanation after update = %q, want %q", got, want)
+	}
+}
+
 func TestQuizStore_ImageMediaID(t *testing.T) {
 	t.Parallel()
 
 	t.Run("create question persists image_media_id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2279,7 +2697,7 @@ func TestQuizStore_ImageMediaID(t *testing.T) {
 	t.Run("update question persists and clears image_media_id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2291,6 +2709,7 @@ func TestQuizStore_ImageMediaID(t *testing.T) {
 		original := testQuiz.Questions[0]
 		attached := &quiz.Question{
 			ID:           original.ID,
+			Version:      original.Version,
 			QuizID:       testQuiz.ID,
 			Text:         original.Text,
 			Position:     original.Position,
@@ -2312,6 +2731,7 @@ func TestQuizStore_ImageMediaID(t *testing.T) {
 		// A nil ImageMediaID on a later save clears the attachment (NULL).
 		detached := &quiz.Question{
 			ID:           original.ID,
+			Version:      qs.Version,
 			QuizID:       testQuiz.ID,
 			Text:         original.Text,
 			Position:     original.Position,
@@ -2333,7 +2753,7 @@ func TestQuizStore_ImageMediaID(t *testing.T) {
 	t.Run("list questions includes image_media_id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2370,7 +2790,7 @@ func TestQuizStore_ImageMediaID(t *testing.T) {
 	t.Run("deleting attached media clears the question's image_media_id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		mediaStore := NewMediaStore(db, slog.Default())
 
@@ -2409,7 +2829,7 @@ func TestQuizStore_AudioMediaID(t *testing.T) {
 	t.Run("create question persists audio_media_id alongside image_media_id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2450,7 +2870,7 @@ func TestQuizStore_AudioMediaID(t *testing.T) {
 	t.Run("update question persists and clears audio_media_id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2462,6 +2882,7 @@ func TestQuizStore_AudioMediaID(t *testing.T) {
 		original := testQuiz.Questions[0]
 		attached := &quiz.Question{
 			ID:           original.ID,
+			Version:      original.Version,
 			QuizID:       testQuiz.ID,
 			Text:         original.Text,
 			Position:     original.Position,
@@ -2482,6 +2903,7 @@ func TestQuizStore_AudioMediaID(t *testing.T) {
 
 		detached := &quiz.Question{
 			ID:           original.ID,
+			Version:      qs.Version,
 			QuizID:       testQuiz.ID,
 			Text:         original.Text,
 			Position:     original.Position,
@@ -2503,7 +2925,7 @@ func TestQuizStore_AudioMediaID(t *testing.T) {
 	t.Run("list questions includes audio_media_id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2540,7 +2962,7 @@ func TestQuizStore_AudioMediaID(t *testing.T) {
 	t.Run("deleting attached sound clears the question's audio_media_id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		mediaStore := NewMediaStore(db, slog.Default())
 
@@ -2579,7 +3001,7 @@ func TestQuizStore_AudioRepeat(t *testing.T) {
 	t.Run("create with audio_repeat true round-trips, update to false clears it", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2624,7 +3046,7 @@ func TestQuizStore_AudioRepeat(t *testing.T) {
 	t.Run("create without setting audio_repeat defaults to false", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2658,7 +3080,7 @@ func TestQuizStore_GetOptionsByIDs(t *testing.T) {
 	t.Run("returns all matching options", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2684,7 +3106,7 @@ func TestQuizStore_GetOptionsByIDs(t *testing.T) {
 	t.Run("empty ids returns empty slice", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		options, err := quizStore.GetOptionsByIDs(t.Context(), []int64{})
@@ -2704,7 +3126,7 @@ func TestQuizStore_DeleteQuiz(t *testing.T) {
 	t.Run("delete quiz cascades to questions and options", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2734,7 +3156,7 @@ func TestQuizStore_DeleteQuiz(t *testing.T) {
 	t.Run("quiz not found", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		err := quizStore.DeleteQuiz(t.Context(), 999999)
@@ -2746,7 +3168,7 @@ func TestQuizStore_DeleteQuiz(t *testing.T) {
 	t.Run("delete quiz also wipes played games and their dependent rows", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2823,7 +3245,7 @@ func TestQuizStore_DeleteQuestion(t *testing.T) {
 	t.Run("delete question cascades to options", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2854,7 +3276,7 @@ func TestQuizStore_DeleteQuestion(t *testing.T) {
 	t.Run("question not found", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		err := quizStore.DeleteQuestion(t.Context(), 999999)
@@ -2866,7 +3288,7 @@ func TestQuizStore_DeleteQuestion(t *testing.T) {
 	t.Run("delete question also wipes played game_questions and game_answers for that question", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -2990,11 +3412,11 @@ func TestQuizStore_DeleteQuestion(t *testing.T) {
 func rewindQuizUpdatedAt(t *testing.T, db *sql.DB, quizID int64) time.Time {
 	t.Helper()
 
-	if _, err := db.ExecContext(
-		t.Context(),
-		`UPDATE quizzes SET updated_at = datetime(updated_at, '-60 seconds') WHERE id = ?`,
-		quizID,
-	); err != nil {
+	rewind := `UPDATE quizzes SET updated_at = datetime(updated_at, '-60 seconds') WHERE id = ?`
+	if database.IsPostgres(db) {
+		rewind = `UPDATE quizzes SET updated_at = updated_at - INTERVAL '60 seconds' WHERE id = ?`
+	}
+	if _, err := db.ExecContext(t.Context(), rewind, quizID); err != nil {
 		t.Fatalf("failed to rewind updated_at for quiz %d: %v", quizID, err)
 	}
 
@@ -3013,7 +3435,7 @@ func rewindQuizUpdatedAt(t *testing.T, db *sql.DB, quizID int64) time.Time {
 func TestQuizStore_UpdateQuiz_BumpsUpdatedAt(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	original := newTestQuizzes()[0]
@@ -3041,7 +3463,7 @@ func TestQuizStore_UpdateQuiz_BumpsUpdatedAt(t *testing.T) {
 func TestQuizStore_CreateQuestion_BumpsParentQuizUpdatedAt(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	parent := newTestQuizzes()[0]
@@ -3077,7 +3499,7 @@ func TestQuizStore_CreateQuestion_BumpsParentQuizUpdatedAt(t *testing.T) {
 func TestQuizStore_UpdateQuestion_BumpsParentQuizUpdatedAt(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	parent := newTestQuizzes()[0]
@@ -3106,7 +3528,7 @@ func TestQuizStore_UpdateQuestion_BumpsParentQuizUpdatedAt(t *testing.T) {
 func TestQuizStore_DeleteOption_BumpsParentQuizUpdatedAt(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	parent := newTestQuizzes()[0]
@@ -3140,7 +3562,7 @@ func TestQuizStore_DeleteOption_BumpsParentQuizUpdatedAt(t *testing.T) {
 func TestQuizStore_UpdateQuestion_RejectsCrossQuestionOptionID(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	quizzes := newTestQuizzes()
@@ -3193,7 +3615,7 @@ func TestQuizStore_UpdateQuestion_RejectsCrossQuestionOptionID(t *testing.T) {
 func TestQuizStore_ListQuizzes_OrderedByUpdatedAtDesc(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	first := &quiz.Quiz{Title: "First", Slug: "first", Description: "first", CreatedByPlayerID: seededAdminID}
@@ -3278,7 +3700,7 @@ func TestQuizStore_SwapQuestionPositions(t *testing.T) {
 
 	t.Run("swap down moves a question past its successor", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		qz := seedQuizWithQuestions(t, quizStore, 3) // Q1@10, Q2@20, Q3@30
 
 		err := quizStore.SwapQuestionPositions(t.Context(), qz.ID, qz.Questions[0].ID, quiz.DirectionDown)
@@ -3301,7 +3723,7 @@ func TestQuizStore_SwapQuestionPositions(t *testing.T) {
 
 	t.Run("swap up moves a question past its predecessor", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		qz := seedQuizWithQuestions(t, quizStore, 3)
 
 		err := quizStore.SwapQuestionPositions(t.Context(), qz.ID, qz.Questions[2].ID, quiz.DirectionUp)
@@ -3322,7 +3744,7 @@ func TestQuizStore_SwapQuestionPositions(t *testing.T) {
 
 	t.Run("swap up from the first question returns ErrQuestionAtTop", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		qz := seedQuizWithQuestions(t, quizStore, 3)
 
 		err := quizStore.SwapQuestionPositions(t.Context(), qz.ID, qz.Questions[0].ID, quiz.DirectionUp)
@@ -3333,7 +3755,7 @@ func TestQuizStore_SwapQuestionPositions(t *testing.T) {
 
 	t.Run("swap down from the last question returns ErrQuestionAtBottom", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		qz := seedQuizWithQuestions(t, quizStore, 3)
 
 		err := quizStore.SwapQuestionPositions(t.Context(), qz.ID, qz.Questions[2].ID, quiz.DirectionDown)
@@ -3344,7 +3766,7 @@ func TestQuizStore_SwapQuestionPositions(t *testing.T) {
 
 	t.Run("invalid direction returns ErrInvalidDirection", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		qz := seedQuizWithQuestions(t, quizStore, 2)
 
 		err := quizStore.SwapQuestionPositions(t.Context(), qz.ID, qz.Questions[0].ID, "sideways")
@@ -3355,7 +3777,7 @@ func TestQuizStore_SwapQuestionPositions(t *testing.T) {
 
 	t.Run("unknown question ID returns ErrQuestionNotFound", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		qz := seedQuizWithQuestions(t, quizStore, 2)
 
 		err := quizStore.SwapQuestionPositions(t.Context(), qz.ID, 9999, quiz.DirectionUp)
@@ -3478,7 +3900,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("within-round move to top", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		if err := quizStore.MoveQuestionToPosition(
@@ -3496,7 +3918,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("within-round move to bottom", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		if err := quizStore.MoveQuestionToPosition(
@@ -3514,7 +3936,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("within-round move to middle", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		if err := quizStore.MoveQuestionToPosition(
@@ -3532,7 +3954,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("cross-round move changes round_id and renumbers", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		// Move Q2 (R1) into R2 at slot 1.
@@ -3551,7 +3973,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("move into an empty round", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, []string{"R1", "R2", "R3"}, map[string][]string{
 			"R1": {"Q1", "Q2"},
 			"R2": {"Q3"},
@@ -3572,7 +3994,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("out-of-range position clamps to round bottom", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		// newPosition 99 in R1 clamps to the end of R1's list.
@@ -3591,7 +4013,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("unknown question returns ErrQuestionNotFound", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		err := quizStore.MoveQuestionToPosition(t.Context(), f.quiz.ID, 999999, f.roundIDs["R1"], 1)
@@ -3602,7 +4024,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("foreign quiz returns ErrQuestionNotFound", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		err := quizStore.MoveQuestionToPosition(
@@ -3615,7 +4037,7 @@ func TestQuizStore_MoveQuestionToPosition(t *testing.T) {
 
 	t.Run("foreign round returns ErrRoundNotFound", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		err := quizStore.MoveQuestionToPosition(
@@ -3633,7 +4055,7 @@ func TestQuizStore_GetOption(t *testing.T) {
 	t.Run("returns the stored option", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -3654,7 +4076,7 @@ func TestQuizStore_GetOption(t *testing.T) {
 	t.Run("returns ErrOptionNotFound for a missing option", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		opt, err := quizStore.GetOption(t.Context(), 999)
@@ -3669,7 +4091,7 @@ func TestQuizStore_GetOption(t *testing.T) {
 	t.Run("wraps the underlying error on a closed DB", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		if err := db.Close(); err != nil {
 			t.Fatalf("failed to close database: %v", err)
@@ -3691,7 +4113,7 @@ func TestQuizStore_CreateQuestionAtNextPosition(t *testing.T) {
 	t.Run("appends after the highest existing position", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -3716,7 +4138,7 @@ func TestQuizStore_CreateQuestionAtNextPosition(t *testing.T) {
 	t.Run("wraps the underlying error on a closed DB", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		testQuiz := newTestQuizzes()[0]
@@ -3737,3 +4159,331 @@ func TestQuizStore_CreateQuestionAtNextPosition(t *testing.T) {
 		}
 	})
 }
+
+func TestQuizStore_RenumberQuestions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rewrites drifted positions to step increments in order", func(t *testing.T) {
+		t.Parallel()
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
+
+		testQuiz := newTestQuizzes()[0]
+		testQuiz.Questions = nil
+		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+			t.Fatalf("CreateQuiz err = %v", err)
+		}
+		// C sits at 5, so its new slot (10) is A's current one and A's new
+		// slot (20) is B's: the rewrite must park rows to dodge the unique
+		// (quiz_id, position) index.
+		for _, seed := range []struct {
+			text string
+			pos  int
+		}{{"A", 10}, {"B", 20}, {"C", 5}, {"D", 93}} {
+			qs := &quiz.Question{QuizID: testQuiz.ID, Text: seed.text, Position: seed.pos}
+			if err := quizStore.CreateQuestion(t.Context(), qs); err != nil {
+				t.Fatalf("CreateQuestion %q err = %v", seed.text, err)
+			}
+		}
+
+		if err := quizStore.RenumberQuestions(t.Context(), testQuiz.ID, ""); err != nil {
+			t.Fatalf("RenumberQuestions err = %v, want nil", err)
+		}
+
+		listed, err := quizStore.ListQuestions(t.Context(), testQuiz.ID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v", err)
+		}
+		got := make([]string, 0, len(listed))
+		for _, qs := range listed {
+			got = append(got, fmt.Sprintf("%s@%d", qs.Text, qs.Position))
+		}
+		want := []string{"C@10", "A@20", "B@30", "D@40"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("positions mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("no questions is a no-op", func(t *testing.T) {
+		t.Parallel()
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
+
+		testQuiz := newTestQuizzes()[0]
+		testQuiz.Questions = nil
+		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+			t.Fatalf("CreateQuiz err = %v", err)
+		}
+
+		if err := quizStore.RenumberQuestions(t.Context(), testQuiz.ID, ""); err != nil {
+			t.Errorf("RenumberQuestions err = %v, want nil", err)
+		}
+	})
+
+	t.Run("a plan changed since the fingerprint is refused", func(t *testing.T) {
+		t.Parallel()
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
+
+		testQuiz := newTestQuizzes()[0]
+		testQuiz.Questions = nil
+		if err := quizStore.CreateQuiz(t.Context(), testQuiz); err != nil {
+			t.Fatalf("CreateQuiz err = %v", err)
+		}
+		for _, seed := range []struct {
+			text string
+			pos  int
+		}{{"A", 3}, {"B", 7}} {
+			qs := &quiz.Question{QuizID: testQuiz.ID, Text: seed.text, Position: seed.pos}
+			if err := quizStore.CreateQuestion(t.Context(), qs); err != nil {
+				t.Fatalf("CreateQuestion %q err = %v", seed.text, err)
+			}
+		}
+		previewed, err := quizStore.ListQuestions(t.Context(), testQuiz.ID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v", err)
+		}
+		fingerprint := quiz.PlanFingerprint(quiz.PlanRenumber(previewed))
+
+		// Another editor adds a question between the preview and the confirm.
+		added := &quiz.Question{QuizID: testQuiz.ID, Text: "C", Position: 1}
+		if err = quizStore.CreateQuestion(t.Context(), added); err != nil {
+			t.Fatalf("CreateQuestion err = %v", err)
+		}
+
+		err = quizStore.RenumberQuestions(t.Context(), testQuiz.ID, fingerprint)
+		if !errors.Is(err, quiz.ErrStaleUpdate) {
+			t.Fatalf("RenumberQuestions err = %v, want %v", err, quiz.ErrStaleUpdate)
+		}
+		listed, err := quizStore.ListQuestions(t.Context(), testQuiz.ID)
+		if err != nil {
+			t.Fatalf("ListQuestions err = %v", err)
+		}
+		got := make([]string, 0, len(listed))
+		for _, qs := range listed {
+			got = append(got, fmt.Sprintf("%s@%d", qs.Text, qs.Position))
+		}
+		if diff := cmp.Diff([]string{"C@1", "A@3", "B@7"}, got); diff != "" {
+			t.Errorf("positions after a refused renumber mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+// TestQuizStore_QuestionStats_EpochReset records solo picks on a question,
+// resets its statistics through UpdateQuestion, and checks the aggregate only
+// counts picks made since: the old picks drop out, a new one is counted, and a
+// preview game's pick never is. The untouched question keeps zero counts
+// throughout.
+func TestQuizStore_QuestionStats_EpochReset(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.DiscardHandler)
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, logger)
+	gameStore := NewGameStore(db, logger)
+	playerStore := NewPlayerStore(db, logger)
+
+	qz := newTestQuizzes()[0]
+	if err := quizStore.CreateQuiz(t.Context(), qz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+	first, other := qz.Questions[0], qz.Questions[1]
+	right, wrong := first.Options[2], first.Options[0]
+
+	answer := func(name string, optionID int64, preview bool) {
+		t.Helper()
+		player, err := playerStore.CreateAnonymousPlayer(t.Context(), name)
+		if err != nil {
+			t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+		}
+		g := &game.Game{QuizID: qz.ID, Preview: preview}
+		if err = gameStore.CreateGameAndParticipant(
+			t.Context(), g, &game.Participant{PlayerID: player.ID, QuizID: qz.ID},
+		); err != nil {
+			t.Fatalf("CreateGameAndParticipant err = %v, want nil", err)
+		}
+		now := time.Now()
+		gq := &game.Question{GameID: g.ID, QuestionID: first.ID, StartedAt: now, ExpiredAt: now.Add(time.Minute)}
+		if err = gameStore.CreateQuestion(t.Context(), gq, false); err != nil {
+			t.Fatalf("CreateQuestion err = %v, want nil", err)
+		}
+		if err = gameStore.CreateAnswer(t.Context(), &game.Answer{
+			GameID: g.ID, PlayerID: player.ID, QuestionID: gq.ID, OptionID: optionID, AnsweredAt: now,
+		}); err != nil {
+			t.Fatalf("CreateAnswer err = %v, want nil", err)
+		}
+	}
+	stats := func() map[int64]quiz.QuestionStats {
+		t.Helper()
+		got, err := quizStore.QuestionStats(t.Context(), qz.ID)
+		if err != nil {
+			t.Fatalf("QuestionStats err = %v, want nil", err)
+		}
+
+		return got
+	}
+
+	answer("stats-right", right.ID, false)
+	answer("stats-wrong", wrong.ID, false)
+	answer("stats-preview", right.ID, true)
+	got := stats()
+	if want := (quiz.QuestionStats{Answers: 2, Correct: 1}); got[first.ID] != want {
+		t.Errorf("stats before reset = %+v, want %+v", got[first.ID], want)
+	}
+	if want := (quiz.QuestionStats{}); got[other.ID] != want {
+		t.Errorf("untouched question stats = %+v, want %+v", got[other.ID], want)
+	}
+
+	first.Text = "Question 1-1, reworded"
+	first.ResetStats = true
+	if err := quizStore.UpdateQuestion(t.Context(), first); err != nil {
+		t.Fatalf("UpdateQuestion err = %v, want nil", err)
+	}
+	reloaded, err := quizStore.GetQuestion(t.Context(), first.ID)
+	if err != nil {
+		t.Fatalf("GetQuestion err = %v, want nil", err)
+	}
+	if got, want := reloaded.StatsEpoch, 1; got != want {
+		t.Errorf("StatsEpoch after reset = %d, want %d", got, want)
+	}
+	if got, want := stats()[first.ID], (quiz.QuestionStats{}); got != want {
+		t.Errorf("stats right after reset = %+v, want %+v", got, want)
+	}
+
+	answer("stats-after", right.ID, false)
+	got = stats()
+	if want := (quiz.QuestionStats{Answers: 1, Correct: 1}); got[first.ID] != want {
+		t.Errorf("stats after a new pick = %+v, want %+v", got[first.ID], want)
+	}
+	if got, want := len(got), len(qz.Questions); got != want {
+		t.Errorf("len(QuestionStats) = %d, want %d (one entry per question)", got, want)
+	}
+}
+
+func TestQuizStore_QuestionDraft(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.New(slog.DiscardHandler))
+
+	qz := newTestQuizzes()[0]
+	if err := quizStore.CreateQuiz(t.Context(), qz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+	first, other := qz.Questions[0], qz.Questions[1]
+
+	if _, err := quizStore.GetQuestionDraft(t.Context(), seededAdminID, first.ID); !errors.Is(
+		err, quiz.ErrQuestionDraftNotFound,
+	) {
+		t.Fatalf("GetQuestionDraft before save err = %v, want %v", err, quiz.ErrQuestionDraftNotFound)
+	}
+
+	for _, data := range []string{`{"text":["first"]}`, `{"text":["second"]}`} {
+		if err := quizStore.SaveQuestionDraft(t.Context(), &quiz.QuestionDraft{
+			PlayerID: seededAdminID, QuestionID: first.ID, Data: data,
+		}); err != nil {
+			t.Fatalf("SaveQuestionDraft err = %v, want nil", err)
+		}
+	}
+	draft, err := quizStore.GetQuestionDraft(t.Context(), seededAdminID, first.ID)
+	if err != nil {
+		t.Fatalf("GetQuestionDraft err = %v, want nil", err)
+	}
+	if got, want := draft.Data, `{"text":["second"]}`; got != want {
+		t.Errorf("draft data = %q, want %q (the later save replaces the earlier)", got, want)
+	}
+	if draft.UpdatedAt.IsZero() {
+		t.Error("draft UpdatedAt is zero, want the save time")
+	}
+
+	if err = quizStore.DeleteQuestionDraft(t.Context(), seededAdminID, first.ID); err != nil {
+		t.Fatalf("DeleteQuestionDraft err = %v, want nil", err)
+	}
+	if _, err = quizStore.GetQuestionDraft(t.Context(), seededAdminID, first.ID); !errors.Is(
+		err, quiz.ErrQuestionDraftNotFound,
+	) {
+		t.Errorf("GetQuestionDraft after delete err = %v, want %v", err, quiz.ErrQuestionDraftNotFound)
+	}
+	if err = quizStore.DeleteQuestionDraft(t.Context(), seededAdminID, first.ID); err != nil {
+		t.Errorf("DeleteQuestionDraft of a missing draft err = %v, want nil", err)
+	}
+
+	// Deleting the question takes its drafts with it.
+	if err = quizStore.SaveQuestionDraft(t.Context(), &quiz.QuestionDraft{
+		PlayerID: seededAdminID, QuestionID: other.ID, Data: `{}`,
+	}); err != nil {
+		t.Fatalf("SaveQuestionDraft err = %v, want nil", err)
+	}
+	if err = quizStore.DeleteQuestion(t.Context(), other.ID); err != nil {
+		t.Fatalf("DeleteQuestion err = %v, want nil", err)
+	}
+	if _, err = quizStore.GetQuestionDraft(t.Context(), seededAdminID, other.ID); !errors.Is(
+		err, quiz.ErrQuestionDraftNotFound,
+	) {
+		t.Errorf("GetQuestionDraft after question delete err = %v, want %v", err, quiz.ErrQuestionDraftNotFound)
+	}
+}
+
+// TestQuizStore_StaleUpdate pins the optimistic-concurrency check: two copies
+// loaded at the same version race, the first UpdateQuiz / UpdateQuestion wins
+// and bumps the version, and the second gets ErrStaleUpdate and writes
+// nothing. A missing row keeps its own error.
+func TestQuizStore_StaleUpdate(t *testing.T) {
+	t.Parallel()
+
+	quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
+	original := newTestQuizzes()[0]
+	if err := quizStore.CreateQuiz(t.Context(), original); err != nil {
+		t.Fatalf("failed to create quiz: %v", err)
+	}
+
+	mine, err := quizStore.GetQuiz(t.Context(), original.ID)
+	if err != nil {
+		t.Fatalf("GetQuiz err = %v, want nil", err)
+	}
+	theirs, err := quizStore.GetQuiz(t.Context(), original.ID)
+	if err != nil {
+		t.Fatalf("GetQuiz err = %v, want nil", err)
+	}
+	theirs.Title = "Theirs"
+	if err = quizStore.UpdateQuiz(t.Context(), theirs); err != nil {
+		t.Fatalf("first UpdateQuiz err = %v, want nil", err)
+	}
+	if got, want := theirs.Version, mine.Version+1; got != want {
+		t.Errorf("Version after UpdateQuiz = %d, want %d", got, want)
+	}
+	mine.Title = "Mine"
+	if err = quizStore.UpdateQuiz(t.Context(), mine); !errors.Is(err, quiz.ErrStaleUpdate) {
+		t.Errorf("stale UpdateQuiz err = %v, want %v", err, quiz.ErrStaleUpdate)
+	}
+
+	question, err := quizStore.GetQuestion(t.Context(), original.Questions[0].ID)
+	if err != nil {
+		t.Fatalf("GetQuestion err = %v, want nil", err)
+	}
+	if question.Version == original.Questions[0].Version {
+		t.Errorf("question Version = %d after its quiz was saved, want it bumped", question.Version)
+	}
+	staleQuestion := *question
+	question.Text = "Theirs?"
+	if err = quizStore.UpdateQuestion(t.Context(), question); err != nil {
+		t.Fatalf("first UpdateQuestion err = %v, want nil", err)
+	}
+	staleQuestion.Text = "Mine?"
+	if err = quizStore.UpdateQuestion(t.Context(), &staleQuestion); !errors.Is(err, quiz.ErrStaleUpdate) {
+		t.Errorf("stale UpdateQuestion err = %v, want %v", err, quiz.ErrStaleUpdate)
+	}
+
+	stored, err := quizStore.GetQuiz(t.Context(), original.ID)
+	if err != nil {
+		t.Fatalf("GetQuiz err = %v, want nil", err)
+	}
+	if got, want := stored.Title, "Theirs"; got != want {
+		t.Errorf("stored title = %q, want %q", got, want)
+	}
+	if got, want := stored.Questions[0].Text, "Theirs?"; got != want {
+		t.Errorf("stored question text = %q, want %q", got, want)
+	}
+
+	missing := &quiz.Quiz{ID: original.ID + 1000, Title: "Gone", Slug: "gone", Version: 1}
+	if err = quizStore.UpdateQuiz(t.Context(), missing); !errors.Is(err, quiz.ErrUpdatingQuizNoRowsAffected) {
+		t.Errorf("UpdateQuiz on a missing quiz err = %v, want %v", err, quiz.ErrUpdatingQuizNoRowsAffected)
+	}
+}
@@ -0,0 +1,115 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/starquake/topbanana/internal/db"
+	"github.com/starquake/topbanana/internal/quiz"
+)
+
+// maxSearchTerms caps how many terms of a search query reach the index, so a
+// pasted paragraph cannot turn into an arbitrarily large MATCH expression.
+const maxSearchTerms = 8
+
+// searchExpr turns free text into the match expression SearchQuizzes and
+// CountSearchQuizzes take: the query's runs of letters and digits, lowercased,
+// each as a word prefix ("Capitals of Eur" -> "capitals* of* eur*"). Every
+// FTS5 operator and quote is dropped with the punctuation, so user input can
+// never be parsed as query syntax. The Postgres twins of the two queries
+// rewrite this same shape into a tsquery. Returns "" when nothing searchable
+// is left.
+func searchExpr(raw string) string {
+	terms := strings.FieldsFunc(strings.ToLower(raw), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	for i, t := range terms {
+		terms[i] = t + "*"
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// SearchQuizzes returns one page of the quizzes whose title, description or
+// slug contain every term of query as a word prefix, best match first (a
+// title hit outweighs a slug hit, which outweighs a description hit), along
+// with the total number of matches. See [quiz.SearchOptions] for the scope.
+//
+//nolint:dupl // See ListQuizzes: distinct sqlc row types, identical mapping.
+func (s *QuizStore) SearchQuizzes(
+	ctx context.Context, query string, opts quiz.SearchOptions,
+) ([]*quiz.Quiz, int64, error) {
+	expr := searchExpr(query)
+	if expr == "" {
+		return nil, 0, nil
+	}
+	var publicOnly int64
+	if opts.PublicOnly {
+		publicOnly = 1
+	}
+
+	total, err := s.q.CountSearchQuizzes(ctx, db.CountSearchQuizzesParams{
+		Query:      expr,
+		OwnerID:    opts.OwnerID,
+		Mode:       opts.Mode,
+		PublicOnly: publicOnly,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count quiz search results: %w", err)
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	rows, err := s.q.SearchQuizzes(ctx, db.SearchQuizzesParams{
+		Query:      expr,
+		OwnerID:    opts.OwnerID,
+		Mode:       opts.Mode,
+		PublicOnly: publicOnly,
+		RowLimit:   opts.Limit,
+		RowOffset:  opts.Offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search quizzes: %w", err)
+	}
+
+	quizzes := make([]*quiz.Quiz, 0, len(rows))
+	for _, r := range rows {
+		qz := &quiz.Quiz{
+			ID:                  r.ID,
+			Title:               r.Title,
+			Slug:                r.Slug,
+			Description:         r.Description,
+			CreatedAt:           r.CreatedAt,
+			UpdatedAt:           r.UpdatedAt,
+			CreatedByPlayerID:   r.CreatedByPlayerID,
+			TimeLimitSeconds:    int(r.TimeLimitSeconds),
+			Visibility:          r.Visibility,
+			Mode:                r.Mode,
+			Language:            r.Language,
+			ShuffleQuestions:    r.ShuffleQuestions != 0,
+			KeepOptionOrder:     r.KeepOptionOrder != 0,
+			LateJoin:            r.LateJoin,
+			JoinDeadlineSeconds: int(r.JoinDeadlineSeconds),
+			MaxPlayers:          int(r.MaxPlayers),
+			ConfidenceWager:     r.ConfidenceWager != 0,
+			HostPaced:           r.HostPaced != 0,
+			Scoring:             r.Scoring,
+			WrongAnswerPenalty:  int(r.WrongAnswerPenalty),
+			ScoreFloor:          int(r.ScoreFloor),
+			ExternalRef:         r.ExternalRef.String,
+			PlayCount:           r.PlayCount,
+			Published:           r.Published != 0,
+			// INNER JOIN, see ListQuizzes (#359).
+			CreatedByDisplayName: r.CreatedByDisplayName,
+		}
+		quizzes = append(quizzes, qz)
+	}
+
+	return quizzes, total, nil
+}
@@ -0,0 +1,180 @@
+package store_test
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/quiz"
+	. "github.com/starquake/topbanana/internal/store"
+)
+
+func TestQuizStore_SearchQuizzes(t *testing.T) {
+	t.Parallel()
+
+	db := dbtest.OpenBackend(t)
+	quizStore := NewQuizStore(db, slog.New(slog.DiscardHandler))
+	playerStore := NewPlayerStore(db, slog.Default())
+
+	other, err := playerStore.CreateAnonymousPlayer(t.Context(), "search-owner-other")
+	if err != nil {
+		t.Fatalf("CreateAnonymousPlayer err = %v, want nil", err)
+	}
+
+	capitals := &quiz.Quiz{
+		Title: "Capitals of Europe", Slug: "capitals-of-europe", Description: "Name the city.",
+		CreatedByPlayerID: seededAdminID, Visibility: quiz.VisibilityPublic, Published: true,
+	}
+	rivers := &quiz.Quiz{
+		Title: "Rivers", Slug: "rivers", Description: "The longest rivers of Europe.",
+		CreatedByPlayerID: seededAdminID, Visibility: quiz.VisibilityPublic, Published: true,
+	}
+	private := &quiz.Quiz{
+		Title: "European Kitchens", Slug: "kitchens", Description: "x",
+		CreatedByPlayerID: other.ID, Visibility: quiz.VisibilityPrivate, Published: true,
+	}
+	for _, qz := range []*quiz.Quiz{capitals, rivers, private} {
+		if err = quizStore.CreateQuiz(t.Context(), qz); err != nil {
+			t.Fatalf("CreateQuiz(%s) err = %v, want nil", qz.Title, err)
+		}
+	}
+	// bm25 only tells matches apart when most of the corpus does not match.
+	for i := range 4 {
+		filler := &quiz.Quiz{
+			Title: fmt.Sprintf("Filler %d", i+1), Slug: fmt.Sprintf("filler-%d", i+1), Description: "x",
+			CreatedByPlayerID: seededAdminID, Visibility: quiz.VisibilityPublic, Published: true,
+		}
+		if err = quizStore.CreateQuiz(t.Context(), filler); err != nil {
+			t.Fatalf("CreateQuiz(%s) err = %v, want nil", filler.Title, err)
+		}
+	}
+
+	search := func(t *testing.T, query string, opts quiz.SearchOptions) ([]string, int64) {
+		t.Helper()
+		if opts.Limit == 0 {
+			opts.Limit = 10
+		}
+		got, total, err := quizStore.SearchQuizzes(t.Context(), query, opts)
+		if err != nil {
+			t.Fatalf("SearchQuizzes(%q) err = %v, want nil", query, err)
+		}
+
+		titles := make([]string, 0, len(got))
+		for _, qz := range got {
+			titles = append(titles, qz.Title)
+		}
+
+		return titles, total
+	}
+
+	t.Run("prefix terms rank title hits first", func(t *testing.T) {
+		t.Parallel()
+
+		got, total := search(t, "EURO", quiz.SearchOptions{})
+		if total != 3 || len(got) != 3 {
+			t.Fatalf("SearchQuizzes = %v, %d, want 3 matches", got, total)
+		}
+		if got[2] != "Rivers" {
+			t.Errorf("SearchQuizzes titles = %v, want the description-only hit Rivers last", got)
+		}
+	})
+
+	t.Run("every term must match", func(t *testing.T) {
+		t.Parallel()
+
+		if got, _ := search(t, "longest euro", quiz.SearchOptions{}); !slices.Equal(got, []string{"Rivers"}) {
+			t.Errorf("SearchQuizzes titles = %v, want [Rivers]", got)
+		}
+	})
+
+	t.Run("query syntax is plain text", func(t *testing.T) {
+		t.Parallel()
+
+		got, _ := search(t, `"capitals" OR NOT (rivers`, quiz.SearchOptions{})
+		if len(got) != 0 {
+			t.Errorf("SearchQuizzes titles = %v, want none", got)
+		}
+		got, total := search(t, `*"()`, quiz.SearchOptions{})
+		if len(got) != 0 || total != 0 {
+			t.Errorf("SearchQuizzes(no terms) = %v, %d, want none, 0", got, total)
+		}
+	})
+
+	t.Run("owner and public scope", func(t *testing.T) {
+		t.Parallel()
+
+		if got, _ := search(t, "euro", quiz.SearchOptions{OwnerID: other.ID}); !slices.Equal(got, []string{"European Kitchens"}) {
+			t.Errorf("SearchQuizzes(owner) titles = %v, want [European Kitchens]", got)
+		}
+		got, total := search(t, "euro", quiz.SearchOptions{PublicOnly: true})
+		if want := []string{"Capitals of Europe", "Rivers"}; !slices.Equal(got, want) || total != 2 {
+			t.Errorf("SearchQuizzes(public) = %v, %d, want %v, 2", got, total, want)
+		}
+	})
+
+	t.Run("pages keep the order and the total", func(t *testing.T) {
+		t.Parallel()
+
+		all, _ := search(t, "euro", quiz.SearchOptions{})
+		var paged []string
+		for offset := range int64(3) {
+			page, total := search(t, "euro", quiz.SearchOptions{Limit: 1, Offset: offset})
+			if total != 3 {
+				t.Fatalf("SearchQuizzes(offset %d) total = %d, want 3", offset, total)
+			}
+			paged = append(paged, page...)
+		}
+		if !slices.Equal(paged, all) {
+			t.Errorf("paged titles = %v, want %v", paged, all)
+		}
+	})
+}
+
+func TestQuizStore_SearchQuizzes_FollowsEdits(t *testing.T) {
+	t.Parallel()
+
+	// SQLite only: the diacritic folding is the FTS5 tokenizer's.
+	db := dbtest.Open(t)
+	quizStore := NewQuizStore(db, slog.New(slog.DiscardHandler))
+
+	qz := &quiz.Quiz{
+		Title: "Café Culture", Slug: "cafe-culture", Description: "x",
+		CreatedByPlayerID: seededAdminID, Visibility: quiz.VisibilityPublic,
+	}
+	if err := quizStore.CreateQuiz(t.Context(), qz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+	titles := func(query string) []string {
+		t.Helper()
+		got, _, err := quizStore.SearchQuizzes(t.Context(), query, quiz.SearchOptions{Limit: 10})
+		if err != nil {
+			t.Fatalf("SearchQuizzes(%q) err = %v, want nil", query, err)
+		}
+
+		return quizTitles(got)
+	}
+
+	if got := titles("cafe"); !slices.Equal(got, []string{"Café Culture"}) {
+		t.Fatalf("SearchQuizzes(cafe) = %v, want [Café Culture]", got)
+	}
+
+	qz.Title = "Tea Culture"
+	if err := quizStore.UpdateQuiz(t.Context(), qz); err != nil {
+		t.Fatalf("UpdateQuiz err = %v, want nil", err)
+	}
+	if got := titles("cafe"); !slices.Equal(got, []string{"Tea Culture"}) {
+		t.Errorf("SearchQuizzes(cafe) after edit = %v, want [Tea Culture] (slug still matches)", got)
+	}
+	if got := titles("tea"); !slices.Equal(got, []string{"Tea Culture"}) {
+		t.Errorf("SearchQuizzes(tea) after edit = %v, want [Tea Culture]", got)
+	}
+
+	if err := quizStore.DeleteQuiz(t.Context(), qz.ID); err != nil {
+		t.Fatalf("DeleteQuiz err = %v, want nil", err)
+	}
+	if got := titles("culture"); len(got) != 0 {
+		t.Errorf("SearchQuizzes(culture) after delete = %v, want none", got)
+	}
+}
@@ -35,11 +35,18 @@ const (
 	AdminAuditRetentionDays = 180
 )
 
+// NeverStartedGameMinutes is how long after creation a game with no issued
+// question and no answer is kept before the sweep prunes it. Unlike the day
+// windows above it is short: such a game holds no play at all, and the
+// double-submitted creates of the 409 race leave them behind by the dozen.
+const NeverStartedGameMinutes = 30
+
 // RetentionStore runs the periodic data-retention sweeps: it prunes stale
 // anonymous players together with all their game data (#626), abandoned,
-// never-finished games regardless of player (#627), and admin_audit rows past
-// their retention window (#628). Each sweep takes its retention window in days
-// and computes the cutoff date in SQL.
+// never-finished games regardless of player (#627), games that never started
+// at all, and admin_audit rows past their retention window (#628). Each sweep
+// takes its retention window in days (minutes for never-started games) and
+// computes the cutoff date in SQL.
 type RetentionStore struct {
 	q      *db.Queries
 	db     *sql.DB
@@ -49,7 +56,7 @@ type RetentionStore struct {
 // NewRetentionStore initializes a new RetentionStore with the provided
 // database connection and returns it.
 func NewRetentionStore(conn *sql.DB, logger *slog.Logger) *RetentionStore {
-	return &RetentionStore{q: db.New(conn), db: conn, logger: logger}
+	return &RetentionStore{q: newQueries(conn), db: conn, logger: logger}
 }
 
 // SweepStaleAnonymousPlayers hard-deletes anonymous players minted more than
@@ -97,13 +104,24 @@ func (s *RetentionStore) SweepAbandonedGames(ctx context.Context, days int) erro
 		return fmt.Errorf("failed to list abandoned games: %w", err)
 	}
 
-	for gameBatch := range slices.Chunk(gameIDs, retentionBatchSize) {
-		err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
-			return deleteGamesByIDs(ctx, q, gameBatch)
-		})
-		if err != nil {
-			return fmt.Errorf("failed to sweep abandoned games: %w", err)
-		}
+	return s.sweepGames(ctx, gameIDs, "abandoned")
+}
+
+// SweepNeverStartedGames hard-deletes in-progress games created more than
+// minutes ago that never issued a question nor recorded an answer, along with
+// their participant rows. It does not touch players, and a game with any play
+// is left to [RetentionStore.SweepAbandonedGames]; see ListNeverStartedGameIDs
+// for the predicate. Batched like the abandoned-game sweep.
+func (s *RetentionStore) SweepNeverStartedGames(ctx context.Context, minutes int) error {
+	gameIDs, err := s.q.ListNeverStartedGameIDs(ctx, int64(minutes))
+	if err != nil {
+		return fmt.Errorf("failed to list never-started games: %w", err)
+	}
+	if err = s.sweepGames(ctx, gameIDs, "never-started"); err != nil {
+		return err
+	}
+	if len(gameIDs) > 0 {
+		s.logger.InfoContext(ctx, "swept never-started games", slog.Int("count", len(gameIDs)))
 	}
 
 	return nil
@@ -120,6 +138,21 @@ func (s *RetentionStore) SweepStaleAuditLog(ctx context.Context, days int) error
 	return nil
 }
 
+// sweepGames deletes the given games in chunks of retentionBatchSize, one
+// transaction per chunk. kind names the sweep in the error.
+func (s *RetentionStore) sweepGames(ctx context.Context, gameIDs []string, kind string) error {
+	for gameBatch := range slices.Chunk(gameIDs, retentionBatchSize) {
+		err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+			return deleteGamesByIDs(ctx, q, gameBatch)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to sweep %s games: %w", kind, err)
+		}
+	}
+
+	return nil
+}
+
 // sweepPlayerBatch deletes one chunk of anonymous players and all their game
 // data inside a single transaction. The snapshot ids are re-filtered to the
 // still-anonymous subset first so a guest claimed after the snapshot keeps both
@@ -162,7 +195,7 @@ func (s *RetentionStore) sweepPlayerBatch(ctx context.Context, playerIDs []int64
 // deleteGamesByIDs drops every game_* row attached to the given game IDs in
 // foreign-key order, then the games themselves. game_seen_rounds references
 // games(id) ON DELETE CASCADE, so it is removed implicitly when the games go.
-// Shared by both retention sweeps and a no-op on an empty slice. Callers chunk
+// Shared by the retention sweeps and a no-op on an empty slice. Callers chunk
 // the id slice to at most retentionBatchSize before calling.
 func deleteGamesByIDs(ctx context.Context, q *db.Queries, gameIDs []string) error {
 	if len(gameIDs) == 0 {
@@ -9,6 +9,7 @@ import (
 
 	dbgen "github.com/starquake/topbanana/internal/db"
 	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/game"
 	. "github.com/starquake/topbanana/internal/store"
 )
 
@@ -234,6 +235,59 @@ func TestSweepAbandonedGames_DeleteError(t *testing.T) {
 	}
 }
 
+// TestSweepNeverStartedGames prunes in-progress games older than the window
+// that never issued a question, keeping a game inside the window and an old
+// game that did start. Sweeping a player's never-started game also drops its
+// participant row, so the UNIQUE (player_id, quiz_id) slot is free and the
+// player's next create succeeds instead of answering 409 with a dead game.
+func TestSweepNeverStartedGames(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+
+	ownerID := insertSignedInPlayer(ctx, t, db, "never-started-owner", seedRecent)
+	playerID := insertSignedInPlayer(ctx, t, db, "never-started-player", seedRecent)
+
+	qStale := seedQuiz(ctx, t, db, "never-started-stale", seedRecent, ownerID)
+	staleID := insertGame(ctx, t, db, "g-never-started", qStale.quizID, seedRecent)
+	insertParticipant(ctx, t, db, staleID, playerID, qStale.quizID, seedRecent)
+
+	qFresh := seedQuiz(ctx, t, db, "never-started-fresh", seedRecent, ownerID)
+	freshID := insertGame(ctx, t, db, "g-never-started-fresh", qFresh.quizID, "datetime('now', '-5 minutes')")
+	insertParticipant(ctx, t, db, freshID, playerID, qFresh.quizID, seedRecent)
+
+	qPlayed := seedQuiz(ctx, t, db, "never-started-played", seedRecent, ownerID)
+	playedID := insertGame(ctx, t, db, "g-started", qPlayed.quizID, seedRecent)
+	insertParticipant(ctx, t, db, playedID, playerID, qPlayed.quizID, seedRecent)
+	insertGameQuestion(ctx, t, db, playedID, qPlayed.q1, seedRecent)
+
+	retention := NewRetentionStore(db, slog.Default())
+	if err := retention.SweepNeverStartedGames(ctx, NeverStartedGameMinutes); err != nil {
+		t.Fatalf("SweepNeverStartedGames err = %v, want nil", err)
+	}
+
+	assertGameAbsent(ctx, t, db, staleID)
+	assertNoOrphans(ctx, t, db, staleID)
+	assertGamePresent(ctx, t, db, freshID)
+	assertGamePresent(ctx, t, db, playedID)
+	assertPlayerPresent(ctx, t, db, playerID)
+
+	games := NewGameStore(db, slog.Default())
+	g := &game.Game{QuizID: qStale.quizID}
+	pa := &game.Participant{PlayerID: playerID, QuizID: qStale.quizID}
+	if err := games.CreateGameAndParticipant(ctx, g, pa); err != nil {
+		t.Fatalf("CreateGameAndParticipant after sweep err = %v, want nil", err)
+	}
+	got, err := games.GetRealGameByPlayerAndQuiz(ctx, playerID, qStale.quizID)
+	if err != nil {
+		t.Fatalf("GetRealGameByPlayerAndQuiz err = %v, want nil", err)
+	}
+	if got.ID != g.ID {
+		t.Errorf("resumed game = %q, want the new game %q", got.ID, g.ID)
+	}
+}
+
 // seededQuiz bundles the quiz, its round, and its two questions (each with
 // one option) so a game can be seeded as finished (both questions issued) or
 // unfinished (one issued). Each scenario gets its own quiz so the UNIQUE
@@ -7,9 +7,6 @@ import (
 	"fmt"
 	"slices"
 
-	"modernc.org/sqlite"
-	sqlite3 "modernc.org/sqlite/lib"
-
 	"github.com/starquake/topbanana/internal/database"
 	"github.com/starquake/topbanana/internal/db"
 	"github.com/starquake/topbanana/internal/quiz"
@@ -375,18 +372,32 @@ func moveRoundToPositionTx(ctx context.Context, q *db.Queries, quizID, roundID i
 }
 
 // renumberDensePositions assigns the ids the dense positions 1..N (in the
-// order given) via setPos, using the negative-parking idiom. SQLite checks
-// the UNIQUE(quiz_id, position) index per statement (no deferred
-// uniqueness), so any row whose final position differs from its current
-// one (current[i]) is first parked at a distinct negative slot (-id,
-// unique because ids are positive) before the second pass assigns the
-// final positions. Rows already at their final position are skipped so a
-// no-op move writes nothing. Shared by the round and question reorder
-// paths, which differ only in which Update*Position query setPos calls.
+// order given) via setPos. Shared by the round and question reorder paths,
+// which differ only in which Update*Position query setPos calls.
 func renumberDensePositions(
 	ctx context.Context,
 	ids, current []int64,
 	setPos func(ctx context.Context, id, pos int64) error,
+) error {
+	final := make([]int64, len(ids))
+	for i := range ids {
+		final[i] = int64(i + 1)
+	}
+
+	return renumberPositions(ctx, ids, current, final, setPos)
+}
+
+// renumberPositions moves ids[i] from current[i] to final[i] via setPos,
+// using the negative-parking idiom. SQLite checks the UNIQUE(quiz_id,
+// position) index per statement (no deferred uniqueness), so any row whose
+// final position differs from its current one is first parked at a
+// distinct negative slot (-id, unique because ids are positive) before the
+// second pass assigns the final positions. Rows already at their final
+// position are skipped so a no-op move writes nothing.
+func renumberPositions(
+	ctx context.Context,
+	ids, current, final []int64,
+	setPos func(ctx context.Context, id, pos int64) error,
 ) error {
 	type move struct {
 		id       int64
@@ -394,11 +405,10 @@ func renumberDensePositions(
 	}
 	var moves []move
 	for i, id := range ids {
-		finalPos := int64(i + 1)
-		if current[i] == finalPos {
+		if current[i] == final[i] {
 			continue
 		}
-		moves = append(moves, move{id: id, finalPos: finalPos})
+		moves = append(moves, move{id: id, finalPos: final[i]})
 	}
 
 	for _, m := range moves {
@@ -445,11 +455,9 @@ func roundFromRow(r db.Round) *quiz.Round {
 	}
 }
 
-// isRoundUniqueViolation reports whether err is the SQLite
-// SQLITE_CONSTRAINT_UNIQUE that rounds_quiz_position_idx raises
-// when a (quiz_id, position) slot is already in use.
+// isRoundUniqueViolation reports whether err is the unique violation
+// that rounds_quiz_position_idx raises when a (quiz_id, position) slot
+// is already in use.
 func isRoundUniqueViolation(err error) bool {
-	var sqliteErr *sqlite.Error
-
-	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+	return isUniqueViolation(err)
 }
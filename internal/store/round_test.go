@@ -35,7 +35,7 @@ func newTestQuizForGroups(t *testing.T, qs *QuizStore) *quiz.Quiz {
 func TestQuizStore_CreateQuiz_SeedsDefaultGroup(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 	qz := newTestQuizForGroups(t, quizStore)
 
@@ -62,7 +62,7 @@ func TestQuizStore_CreateQuiz_SeedsDefaultGroup(t *testing.T) {
 func TestQuizStore_CreateQuiz_WithAuthoredRounds(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 
 	q1 := &quiz.Question{Text: "Q1", Position: 1, Options: []*quiz.Option{{Text: "a", Correct: true}}}
@@ -124,7 +124,7 @@ func TestQuizStore_CreateQuiz_WithAuthoredRounds(t *testing.T) {
 func TestQuizStore_CreateQuestion_LandsInDefaultGroup(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 	qz := newTestQuizForGroups(t, quizStore)
 
@@ -156,7 +156,7 @@ func TestQuizStore_CreateRound(t *testing.T) {
 	t.Run("stores the supplied fields", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -192,7 +192,7 @@ func TestQuizStore_CreateRound(t *testing.T) {
 	t.Run("round-trips an explicit boundary duration", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -223,7 +223,7 @@ func TestQuizStore_CreateRound(t *testing.T) {
 	t.Run("position collision returns ErrRoundPositionTaken", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -242,7 +242,7 @@ func TestQuizStore_GetRound(t *testing.T) {
 	t.Run("returns ErrRoundNotFound for missing id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		_, err := quizStore.GetRound(t.Context(), 99999)
@@ -258,7 +258,7 @@ func TestQuizStore_GetDefaultRound(t *testing.T) {
 	t.Run("returns the lowest-position round", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -279,7 +279,7 @@ func TestQuizStore_GetDefaultRound(t *testing.T) {
 	t.Run("returns ErrRoundNotFound when quiz has no rounds", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		_, err := quizStore.GetDefaultRound(t.Context(), 99999)
@@ -292,7 +292,7 @@ func TestQuizStore_GetDefaultRound(t *testing.T) {
 func TestQuizStore_ListRoundsByQuiz(t *testing.T) {
 	t.Parallel()
 
-	db := dbtest.Open(t)
+	db := dbtest.OpenBackend(t)
 	quizStore := NewQuizStore(db, slog.Default())
 	qz := newTestQuizForGroups(t, quizStore)
 
@@ -325,7 +325,7 @@ func TestQuizStore_UpdateRound(t *testing.T) {
 	t.Run("updates title, summary and position", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -359,7 +359,7 @@ func TestQuizStore_UpdateRound(t *testing.T) {
 	t.Run("sets and clears the boundary duration", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -401,7 +401,7 @@ func TestQuizStore_UpdateRound(t *testing.T) {
 	t.Run("moving onto an occupied slot returns ErrRoundPositionTaken", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -421,7 +421,7 @@ func TestQuizStore_UpdateRound(t *testing.T) {
 	t.Run("returns ErrCannotUpdateRoundWithIDZero when id is unset", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		err := quizStore.UpdateRound(t.Context(), &quiz.Round{Title: "noop"})
@@ -433,7 +433,7 @@ func TestQuizStore_UpdateRound(t *testing.T) {
 	t.Run("returns ErrUpdatingRoundNoRowsAffected for a stale id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		err := quizStore.UpdateRound(t.Context(), &quiz.Round{ID: 99999, Title: "noop"})
@@ -449,7 +449,7 @@ func TestQuizStore_DeleteRound(t *testing.T) {
 	t.Run("removes the row", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -471,7 +471,7 @@ func TestQuizStore_DeleteRound(t *testing.T) {
 	t.Run("returns ErrDeletingRoundNoRowsAffected for a stale id", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 
 		err := quizStore.DeleteRound(t.Context(), 99999)
@@ -483,7 +483,7 @@ func TestQuizStore_DeleteRound(t *testing.T) {
 	t.Run("cascades to the round's questions", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -510,7 +510,7 @@ func TestQuizStore_DeleteRound(t *testing.T) {
 	t.Run("wipes played game_questions and game_answers for the round's questions", func(t *testing.T) {
 		t.Parallel()
 
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		quizStore := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, quizStore)
 
@@ -614,7 +614,7 @@ func TestQuizStore_MoveRound(t *testing.T) {
 	// between.
 	seed := func(t *testing.T) (*QuizStore, *quiz.Quiz) {
 		t.Helper()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		qs := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, qs)
 		for _, pos := range []int{1, 2} {
@@ -764,7 +764,7 @@ func TestQuizStore_MoveRoundToPosition(t *testing.T) {
 
 	seed := func(t *testing.T) (*QuizStore, *quiz.Quiz, map[string]int64) {
 		t.Helper()
-		db := dbtest.Open(t)
+		db := dbtest.OpenBackend(t)
 		qs := NewQuizStore(db, slog.Default())
 		qz := newTestQuizForGroups(t, qs)
 
@@ -882,7 +882,7 @@ func TestQuizStore_MoveQuestionToRound(t *testing.T) {
 
 	t.Run("reassigns the question to the target round", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		if err := quizStore.MoveQuestionToRound(
@@ -902,7 +902,7 @@ func TestQuizStore_MoveQuestionToRound(t *testing.T) {
 
 	t.Run("unknown question returns ErrQuestionNotFound", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		err := quizStore.MoveQuestionToRound(t.Context(), f.quiz.ID, 999999, f.roundIDs["R2"])
@@ -913,7 +913,7 @@ func TestQuizStore_MoveQuestionToRound(t *testing.T) {
 
 	t.Run("question on a foreign quiz returns ErrQuestionNotFound", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		err := quizStore.MoveQuestionToRound(t.Context(), f.quiz.ID+1, f.questionIDs["Q1"], f.roundIDs["R2"])
@@ -924,7 +924,7 @@ func TestQuizStore_MoveQuestionToRound(t *testing.T) {
 
 	t.Run("unknown round returns ErrRoundNotFound", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		err := quizStore.MoveQuestionToRound(t.Context(), f.quiz.ID, f.questionIDs["Q1"], 999999)
@@ -935,7 +935,7 @@ func TestQuizStore_MoveQuestionToRound(t *testing.T) {
 
 	t.Run("round on a foreign quiz returns ErrRoundNotFound", func(t *testing.T) {
 		t.Parallel()
-		quizStore := NewQuizStore(dbtest.Open(t), slog.Default())
+		quizStore := NewQuizStore(dbtest.OpenBackend(t), slog.Default())
 		f := seedRoundQuiz(t, quizStore, rounds, layout)
 
 		// Seed a second quiz and target one of its rounds; the cross-quiz
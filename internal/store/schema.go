@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/starquake/topbanana/internal/database"
+)
+
+// SchemaStore reads the state of the database schema, for the readiness
+// probe's migration check and the health detail report.
+type SchemaStore struct {
+	db *sql.DB
+}
+
+// NewSchemaStore returns a SchemaStore over conn.
+func NewSchemaStore(conn *sql.DB) *SchemaStore {
+	return &SchemaStore{db: conn}
+}
+
+// HasPendingMigrations reports whether an embedded migration has not been
+// applied to the database yet. It reads the goose version table without
+// taking goose's package-level state, so it is safe alongside a running
+// database.Migrate.
+func (s *SchemaStore) HasPendingMigrations(ctx context.Context) (bool, error) {
+	provider, err := database.NewMigrationProvider(s.db)
+	if err != nil {
+		return false, fmt.Errorf("failed to load migrations: %w", err)
+	}
+	pending, err := provider.HasPending(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for pending migrations: %w", err)
+	}
+
+	return pending, nil
+}
+
+// MigrationVersion returns the version of the latest migration applied to
+// the database, read the same goose-state-free way as
+// [SchemaStore.HasPendingMigrations].
+func (s *SchemaStore) MigrationVersion(ctx context.Context) (int64, error) {
+	provider, err := database.NewMigrationProvider(s.db)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load migrations: %w", err)
+	}
+	version, err := provider.GetDBVersion(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the migration version: %w", err)
+	}
+
+	return version, nil
+}
+
+// DiskUsage reports the space left on the filesystem holding the SQLite
+// file; see [database.FileDiskUsage].
+func (s *SchemaStore) DiskUsage(ctx context.Context) (database.DiskUsage, error) {
+	usage, err := database.FileDiskUsage(ctx, s.db)
+	if err != nil {
+		return usage, fmt.Errorf("failed to read disk usage: %w", err)
+	}
+
+	return usage, nil
+}
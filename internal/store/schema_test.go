@@ -0,0 +1,38 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/store"
+)
+
+func TestSchemaStore_HasPendingMigrations(t *testing.T) {
+	t.Parallel()
+
+	conn := dbtest.Open(t)
+	schema := NewSchemaStore(conn)
+
+	pending, err := schema.HasPendingMigrations(t.Context())
+	if err != nil {
+		t.Fatalf("HasPendingMigrations err = %v, want nil", err)
+	}
+	if pending {
+		t.Error("HasPendingMigrations = true on a migrated database, want false")
+	}
+
+	// Forget the newest migration, as a binary ahead of its database would see it.
+	if _, err = conn.ExecContext(t.Context(),
+		`DELETE FROM goose_db_version WHERE version_id = (SELECT MAX(version_id) FROM goose_db_version)`,
+	); err != nil {
+		t.Fatalf("failed to rewind goose version: %v", err)
+	}
+
+	pending, err = schema.HasPendingMigrations(t.Context())
+	if err != nil {
+		t.Fatalf("HasPendingMigrations err = %v, want nil", err)
+	}
+	if !pending {
+		t.Error("HasPendingMigrations = false with the newest migration unapplied, want true")
+	}
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/starquake/topbanana/internal/branding"
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/db"
+)
+
+// Setting keys for the branding fields in the settings table.
+const (
+	settingBrandName         = "branding.name"
+	settingBrandLogoURL      = "branding.logo_url"
+	settingBrandPrimaryColor = "branding.primary_color"
+)
+
+// SettingsStore is the SQLite-backed key/value settings table. It implements
+// [branding.Store].
+type SettingsStore struct {
+	q      *db.Queries
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewSettingsStore initializes a SettingsStore with the provided database
+// connection and logger.
+func NewSettingsStore(conn *sql.DB, logger *slog.Logger) *SettingsStore {
+	return &SettingsStore{
+		q:      newQueries(conn),
+		db:     conn,
+		logger: logger,
+	}
+}
+
+// GetBranding assembles the branding from its setting keys. A missing key
+// leaves its field empty, which the branding package renders as the default.
+func (s *SettingsStore) GetBranding(ctx context.Context) (branding.Branding, error) {
+	rows, err := s.q.ListSettings(ctx)
+	if err != nil {
+		return branding.Branding{}, fmt.Errorf("failed to list settings: %w", err)
+	}
+
+	var b branding.Branding
+	for _, row := range rows {
+		switch row.Key {
+		case settingBrandName:
+			b.Name = row.Value
+		case settingBrandLogoURL:
+			b.LogoURL = row.Value
+		case settingBrandPrimaryColor:
+			b.PrimaryColor = row.Value
+		}
+	}
+
+	return b, nil
+}
+
+// SaveBranding writes every branding field in one transaction. An empty field
+// deletes its key so the default applies again.
+func (s *SettingsStore) SaveBranding(ctx context.Context, b branding.Branding) error {
+	err := database.ExecTx(ctx, s.db, func(q *db.Queries) error {
+		for key, value := range map[string]string{
+			settingBrandName:         b.Name,
+			settingBrandLogoURL:      b.LogoURL,
+			settingBrandPrimaryColor: b.PrimaryColor,
+		} {
+			if err := saveSetting(ctx, q, key, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save branding: %w", err)
+	}
+
+	return nil
+}
+
+// saveSetting upserts key, or deletes it when value is empty.
+func saveSetting(ctx context.Context, q *db.Queries, key, value string) error {
+	if value == "" {
+		if err := q.DeleteSetting(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete setting %q: %w", key, err)
+		}
+
+		return nil
+	}
+	if err := q.UpsertSetting(ctx, db.UpsertSettingParams{Key: key, Value: value}); err != nil {
+		return fmt.Errorf("failed to upsert setting %q: %w", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package store_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/branding"
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/store"
+)
+
+// TestSettingsStore_Branding covers the empty read on a fresh database, a
+// full save and read back, and a blank field clearing its stored key.
+func TestSettingsStore_Branding(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := NewSettingsStore(dbtest.Open(t), slog.Default())
+
+	got, err := store.GetBranding(ctx)
+	if err != nil {
+		t.Fatalf("GetBranding err = %v, want nil", err)
+	}
+	if got != (branding.Branding{}) {
+		t.Errorf("GetBranding on a fresh DB = %+v, want the zero Branding", got)
+	}
+
+	want := branding.Branding{Name: "Quiz Club", LogoURL: "/media/logo.png", PrimaryColor: "#336699"}
+	if err = store.SaveBranding(ctx, want); err != nil {
+		t.Fatalf("SaveBranding err = %v, want nil", err)
+	}
+	if got, err = store.GetBranding(ctx); err != nil {
+		t.Fatalf("GetBranding err = %v, want nil", err)
+	}
+	if got != want {
+		t.Errorf("GetBranding = %+v, want %+v", got, want)
+	}
+
+	want = branding.Branding{Name: "Quiz Club"}
+	if err = store.SaveBranding(ctx, want); err != nil {
+		t.Fatalf("SaveBranding err = %v, want nil", err)
+	}
+	if got, err = store.GetBranding(ctx); err != nil {
+		t.Fatalf("GetBranding err = %v, want nil", err)
+	}
+	if got != want {
+		t.Errorf("GetBranding after clearing fields = %+v, want %+v", got, want)
+	}
+}
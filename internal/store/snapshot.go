@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/starquake/topbanana/internal/database"
+)
+
+// SnapshotStore writes point-in-time copies of the database for the admin
+// snapshot download. Give it the read-only pool: the copy is one long read,
+// which has no business holding a connection gameplay writes need.
+type SnapshotStore struct {
+	db *sql.DB
+}
+
+// NewSnapshotStore returns a SnapshotStore over conn.
+func NewSnapshotStore(conn *sql.DB) *SnapshotStore {
+	return &SnapshotStore{db: conn}
+}
+
+// WriteSnapshot writes a scrubbed copy of the database to path; see
+// [database.Snapshot]. On Postgres the error wraps
+// [database.ErrSnapshotUnsupported].
+func (s *SnapshotStore) WriteSnapshot(ctx context.Context, path string) error {
+	if err := database.Snapshot(ctx, s.db, path); err != nil {
+		return fmt.Errorf("failed to write database snapshot: %w", err)
+	}
+
+	return nil
+}
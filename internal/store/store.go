@@ -3,14 +3,26 @@ package store
 
 import (
 	"database/sql"
+	"errors"
 	"log/slog"
 
+	"github.com/jackc/pgx/v5/pgconn"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/starquake/topbanana/internal/answerexport"
+	"github.com/starquake/topbanana/internal/audit"
 	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/branding"
+	"github.com/starquake/topbanana/internal/db"
 	"github.com/starquake/topbanana/internal/game"
 	"github.com/starquake/topbanana/internal/home"
 	"github.com/starquake/topbanana/internal/livesession"
 	"github.com/starquake/topbanana/internal/media"
 	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/session"
+	"github.com/starquake/topbanana/internal/tournament"
+	"github.com/starquake/topbanana/internal/tracing"
 )
 
 // Stores is a collection of stores for the application.
@@ -40,10 +52,37 @@ type Stores struct {
 	// InvitePlayers is the narrow create+verify+read slice the
 	// accept-invite flow uses; backed by the same PlayerStore instance.
 	InvitePlayers auth.InvitePlayerStore
-	Home          home.Store
-	Retention     *RetentionStore
-	LiveSessions  livesession.Store
-	Media         media.Store
+	// Devices is the player_sessions table behind the device list and the
+	// session tracker; backed by the same PlayerStore instance.
+	Devices      session.DeviceStore
+	Home         home.Store
+	Retention    *RetentionStore
+	LiveSessions livesession.Store
+	// LiveRescores is the score recalculation's read+write slice of the
+	// live-session tables; backed by the same LiveSessionStore instance.
+	LiveRescores livesession.RescoreStore
+	Media        media.Store
+	// QuizReports is a QuizStore on the read-only pool, used by the quiz
+	// export so a large archive read does not hold up gameplay writes.
+	// Read methods only: a write through it fails.
+	QuizReports quiz.Store
+	Tournaments tournament.Store
+	// Branding is the settings table's branding slice, read through
+	// branding.Service's cache.
+	Branding branding.Store
+	// AnswerExports reads recorded answers for the analytics export, on the
+	// read-only pool.
+	AnswerExports answerexport.Store
+	// Schema reports whether the migrations are applied, for /readyz.
+	Schema *SchemaStore
+	// GameEvents is the game event log: the sink the game service's event
+	// emitter writes to, and the admin debugging view's reader.
+	GameEvents *GameEventStore
+	// Snapshots writes the admin snapshot download, on the read-only pool.
+	Snapshots *SnapshotStore
+	// Audit is the admin content audit log the quiz, question and round
+	// handlers write and the admin audit page reads.
+	Audit audit.Store
 }
 
 // New initializes a new Stores instance with the provided database connection.
@@ -53,8 +92,17 @@ type Stores struct {
 // concrete instance through every interface slot so they only see the
 // methods relevant to their flow.
 func New(conn *sql.DB, logger *slog.Logger) *Stores {
-	players := NewPlayerStore(conn, logger)
-	games := NewGameStore(conn, logger)
+	return NewWithReader(conn, conn, logger)
+}
+
+// NewWithReader is [New] with the reporting reads - leaderboards, player
+// stats, the home page aggregates, and quiz and answer exports - routed
+// through reader, a read-only pool from database.OpenReadOnly. Every write and
+// every read on a gameplay path stays on conn.
+func NewWithReader(conn, reader *sql.DB, logger *slog.Logger) *Stores {
+	players := NewPlayerStore(conn, logger).withReader(reader)
+	games := NewGameStore(conn, logger).withReader(reader)
+	liveSessions := NewLiveSessionStore(conn, logger)
 
 	return &Stores{
 		Quizzes:          NewQuizStore(conn, logger),
@@ -70,9 +118,43 @@ func New(conn *sql.DB, logger *slog.Logger) *Stores {
 		ResetTokens:      players,
 		Invites:          players,
 		InvitePlayers:    players,
-		Home:             NewHomeStore(conn),
+		Devices:          players,
+		Home:             NewHomeStore(reader),
 		Retention:        NewRetentionStore(conn, logger),
-		LiveSessions:     NewLiveSessionStore(conn, logger),
+		LiveSessions:     liveSessions,
+		LiveRescores:     liveSessions,
 		Media:            NewMediaStore(conn, logger),
+		QuizReports:      NewQuizStore(reader, logger),
+		Tournaments:      NewTournamentStore(conn, logger),
+		Branding:         NewSettingsStore(conn, logger),
+		AnswerExports:    NewAnswerExportStore(reader),
+		Schema:           NewSchemaStore(conn),
+		GameEvents:       NewGameEventStore(conn),
+		Snapshots:        NewSnapshotStore(reader),
+		Audit:            NewAuditStore(conn),
+	}
+}
+
+// newQueries returns the sqlc queries for conn, each run inside a client
+// span when the request is traced.
+func newQueries(conn *sql.DB) *db.Queries {
+	return db.New(tracing.WrapDB(conn))
+}
+
+// pgUniqueViolation is the Postgres SQLSTATE for a unique-constraint
+// violation.
+const pgUniqueViolation = "23505"
+
+// isUniqueViolation reports whether err is a unique-constraint violation
+// from either backend: SQLite's SQLITE_CONSTRAINT_UNIQUE or Postgres's
+// 23505. The quiz and game stores map it onto their conflict sentinels
+// without caring which database raised it.
+func isUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
 	}
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
 }
@@ -4,7 +4,11 @@ import (
 	"database/sql"
 	"log/slog"
 	"testing"
+	"time"
 
+	"github.com/starquake/topbanana/internal/database"
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/quiz"
 	. "github.com/starquake/topbanana/internal/store"
 )
 
@@ -20,3 +24,38 @@ func TestNew(t *testing.T) {
 		t.Error("stores is nil")
 	}
 }
+
+func TestNewWithReader_RoutesReportsToReader(t *testing.T) {
+	t.Parallel()
+
+	dsn, cleanup := dbtest.SetupTestDB(t)
+	t.Cleanup(cleanup)
+	conn, err := database.Open(t.Context(), "sqlite", dsn, 1, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("Open err = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	reader, err := database.OpenReadOnly(t.Context(), "sqlite", dsn, 1, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("OpenReadOnly err = %v", err)
+	}
+	t.Cleanup(func() { _ = reader.Close() })
+
+	stores := NewWithReader(conn, reader, slog.New(slog.DiscardHandler))
+
+	qz := &quiz.Quiz{Title: "Reader", Slug: "reader", CreatedByPlayerID: seededAdminID}
+	if err = stores.Quizzes.CreateQuiz(t.Context(), qz); err != nil {
+		t.Fatalf("CreateQuiz on the primary err = %v, want nil", err)
+	}
+	if _, err = stores.QuizReports.GetQuiz(t.Context(), qz.ID); err != nil {
+		t.Errorf("GetQuiz on the reader err = %v, want nil", err)
+	}
+	if _, err = stores.Games.ListParticipantsForQuizLeaderboard(t.Context(), qz.ID, time.Now()); err != nil {
+		t.Errorf("leaderboard read err = %v, want nil", err)
+	}
+	if err = stores.QuizReports.CreateQuiz(t.Context(), &quiz.Quiz{
+		Title: "Rejected", Slug: "rejected", CreatedByPlayerID: seededAdminID,
+	}); err == nil {
+		t.Error("CreateQuiz on the reader err = nil, want a read-only error")
+	}
+}
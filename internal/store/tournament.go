@@ -0,0 +1,210 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/starquake/topbanana/internal/db"
+	"github.com/starquake/topbanana/internal/tournament"
+)
+
+// TournamentStore is the SQLite-backed implementation of [tournament.Store].
+type TournamentStore struct {
+	q      *db.Queries
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewTournamentStore initializes a TournamentStore with the provided database
+// connection and logger.
+func NewTournamentStore(conn *sql.DB, logger *slog.Logger) *TournamentStore {
+	return &TournamentStore{
+		q:      newQueries(conn),
+		db:     conn,
+		logger: logger,
+	}
+}
+
+// CreateTournament inserts the tournament and populates t.ID / t.CreatedAt
+// from the returned row. A join_code UNIQUE collision (the loser of a probe
+// race in the service) surfaces as [tournament.ErrJoinCodeUnavailable].
+func (s *TournamentStore) CreateTournament(ctx context.Context, t *tournament.Tournament) error {
+	row, err := s.q.CreateTournament(ctx, db.CreateTournamentParams{
+		Title:             t.Title,
+		JoinCode:          t.JoinCode,
+		CreatedByPlayerID: sql.NullInt64{Int64: t.CreatedByPlayerID, Valid: t.CreatedByPlayerID != 0},
+	})
+	if err != nil {
+		var sqliteErr *sqlite.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+			return tournament.ErrJoinCodeUnavailable
+		}
+
+		return fmt.Errorf("failed to create tournament: %w", err)
+	}
+
+	t.ID = row.ID
+	t.CreatedAt = row.CreatedAt
+
+	return nil
+}
+
+// JoinCodeExists reports whether a tournament already uses the candidate
+// join code.
+func (s *TournamentStore) JoinCodeExists(ctx context.Context, joinCode string) (bool, error) {
+	exists, err := s.q.TournamentJoinCodeExists(ctx, joinCode)
+	if err != nil {
+		return false, fmt.Errorf("failed to check tournament join code exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetTournament returns the tournament with its stages populated. Returns
+// [tournament.ErrTournamentNotFound] when the id is unknown.
+func (s *TournamentStore) GetTournament(ctx context.Context, id int64) (*tournament.Tournament, error) {
+	row, err := s.q.GetTournament(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, tournament.ErrTournamentNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	return s.withStages(ctx, tournamentFromRow(row))
+}
+
+// GetTournamentByJoinCode resolves a join code to its tournament with the
+// stages populated. Returns [tournament.ErrTournamentNotFound] when no
+// tournament uses the code.
+func (s *TournamentStore) GetTournamentByJoinCode(
+	ctx context.Context, joinCode string,
+) (*tournament.Tournament, error) {
+	row, err := s.q.GetTournamentByJoinCode(ctx, joinCode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, tournament.ErrTournamentNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get tournament by join code: %w", err)
+	}
+
+	return s.withStages(ctx, tournamentFromRow(row))
+}
+
+// ListTournaments returns every tournament, newest first, without stages.
+func (s *TournamentStore) ListTournaments(ctx context.Context) ([]*tournament.Tournament, error) {
+	rows, err := s.q.ListTournaments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournaments: %w", err)
+	}
+
+	return tournamentsFromRows(rows), nil
+}
+
+// ListTournamentsForOwner returns the player's own tournaments, newest first,
+// without stages.
+func (s *TournamentStore) ListTournamentsForOwner(
+	ctx context.Context, playerID int64,
+) ([]*tournament.Tournament, error) {
+	rows, err := s.q.ListTournamentsForOwner(ctx, sql.NullInt64{Int64: playerID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournaments for owner: %w", err)
+	}
+
+	return tournamentsFromRows(rows), nil
+}
+
+// AddStage appends the quiz as the tournament's last stage. The (tournament,
+// quiz) primary key rejects a repeat as [tournament.ErrStageExists]; any
+// other constraint failure (including the position race) is returned
+// wrapped.
+func (s *TournamentStore) AddStage(ctx context.Context, tournamentID, quizID int64) error {
+	err := s.q.AddTournamentStage(ctx, db.AddTournamentStageParams{TournamentID: tournamentID, QuizID: quizID})
+	if err != nil {
+		var sqliteErr *sqlite.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY {
+			return tournament.ErrStageExists
+		}
+
+		return fmt.Errorf("failed to add tournament stage: %w", err)
+	}
+
+	return nil
+}
+
+// AddMember records the player as a member of the tournament. A repeat join
+// is a no-op.
+func (s *TournamentStore) AddMember(ctx context.Context, tournamentID, playerID int64) error {
+	err := s.q.AddTournamentPlayer(ctx, db.AddTournamentPlayerParams{TournamentID: tournamentID, PlayerID: playerID})
+	if err != nil {
+		return fmt.Errorf("failed to add tournament player: %w", err)
+	}
+
+	return nil
+}
+
+// ListMembers returns the tournament's members in join order with their
+// current display names.
+func (s *TournamentStore) ListMembers(ctx context.Context, tournamentID int64) ([]*tournament.Member, error) {
+	rows, err := s.q.ListTournamentPlayers(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournament players: %w", err)
+	}
+
+	members := make([]*tournament.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, &tournament.Member{
+			PlayerID:    row.PlayerID,
+			DisplayName: row.DisplayName,
+			JoinedAt:    row.JoinedAt,
+		})
+	}
+
+	return members, nil
+}
+
+func (s *TournamentStore) withStages(ctx context.Context, t *tournament.Tournament) (*tournament.Tournament, error) {
+	rows, err := s.q.ListTournamentStages(ctx, t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournament stages: %w", err)
+	}
+
+	t.Stages = make([]*tournament.Stage, 0, len(rows))
+	for _, row := range rows {
+		t.Stages = append(t.Stages, &tournament.Stage{
+			QuizID:    row.QuizID,
+			Position:  int(row.Position),
+			QuizTitle: row.QuizTitle,
+			QuizSlug:  row.QuizSlug,
+		})
+	}
+
+	return t, nil
+}
+
+func tournamentFromRow(row db.Tournament) *tournament.Tournament {
+	return &tournament.Tournament{
+		ID:                row.ID,
+		Title:             row.Title,
+		JoinCode:          row.JoinCode,
+		CreatedByPlayerID: row.CreatedByPlayerID.Int64,
+		CreatedAt:         row.CreatedAt,
+	}
+}
+
+func tournamentsFromRows(rows []db.Tournament) []*tournament.Tournament {
+	out := make([]*tournament.Tournament, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, tournamentFromRow(row))
+	}
+
+	return out
+}
@@ -0,0 +1,120 @@
+package store_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/tournament"
+)
+
+// TestTournamentStore_Roundtrip covers create, code lookup, stage ordering,
+// idempotent membership, and owner scoping.
+func TestTournamentStore_Roundtrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	db := dbtest.Open(t)
+	quizStore := NewQuizStore(db, slog.Default())
+	quizzes := newTestQuizzes()[:2]
+	for _, qz := range quizzes {
+		if err := quizStore.CreateQuiz(ctx, qz); err != nil {
+			t.Fatalf("CreateQuiz err = %v, want nil", err)
+		}
+	}
+
+	store := NewTournamentStore(db, slog.Default())
+	tm := &tournament.Tournament{Title: "Spring Cup", JoinCode: "CUP234", CreatedByPlayerID: seededAdminID}
+	if err := store.CreateTournament(ctx, tm); err != nil {
+		t.Fatalf("CreateTournament err = %v, want nil", err)
+	}
+	if tm.ID == 0 || tm.CreatedAt.IsZero() {
+		t.Fatalf("CreateTournament did not populate ID/CreatedAt: %+v", tm)
+	}
+
+	// Added out of slice order: position follows append order, not quiz id.
+	for _, qz := range []int64{quizzes[1].ID, quizzes[0].ID} {
+		if err := store.AddStage(ctx, tm.ID, qz); err != nil {
+			t.Fatalf("AddStage err = %v, want nil", err)
+		}
+	}
+	if err := store.AddStage(ctx, tm.ID, quizzes[0].ID); !errors.Is(err, tournament.ErrStageExists) {
+		t.Errorf("repeat AddStage err = %v, want %v", err, tournament.ErrStageExists)
+	}
+
+	for range 2 {
+		if err := store.AddMember(ctx, tm.ID, seededAdminID); err != nil {
+			t.Fatalf("AddMember err = %v, want nil", err)
+		}
+	}
+
+	got, err := store.GetTournamentByJoinCode(ctx, "CUP234")
+	if err != nil {
+		t.Fatalf("GetTournamentByJoinCode err = %v, want nil", err)
+	}
+	if got, want := len(got.Stages), 2; got != want {
+		t.Fatalf("len(Stages) = %d, want %d", got, want)
+	}
+	if got, want := got.Stages[0].QuizID, quizzes[1].ID; got != want {
+		t.Errorf("Stages[0].QuizID = %d, want %d", got, want)
+	}
+	if got, want := got.Stages[1].Position, 2; got != want {
+		t.Errorf("Stages[1].Position = %d, want %d", got, want)
+	}
+
+	members, err := store.ListMembers(ctx, tm.ID)
+	if err != nil {
+		t.Fatalf("ListMembers err = %v, want nil", err)
+	}
+	if got, want := len(members), 1; got != want {
+		t.Errorf("len(members) = %d, want %d", got, want)
+	}
+
+	owned, err := store.ListTournamentsForOwner(ctx, seededAdminID)
+	if err != nil {
+		t.Fatalf("ListTournamentsForOwner err = %v, want nil", err)
+	}
+	if got, want := len(owned), 1; got != want {
+		t.Errorf("len(owned) = %d, want %d", got, want)
+	}
+	others, err := store.ListTournamentsForOwner(ctx, seededAdminID+1000)
+	if err != nil {
+		t.Fatalf("ListTournamentsForOwner err = %v, want nil", err)
+	}
+	if got, want := len(others), 0; got != want {
+		t.Errorf("len(others) = %d, want %d", got, want)
+	}
+}
+
+// TestTournamentStore_Errors pins the sentinel mapping for an unknown id/code
+// and a join code taken by a concurrent create.
+func TestTournamentStore_Errors(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := NewTournamentStore(dbtest.Open(t), slog.Default())
+
+	if _, err := store.GetTournament(ctx, 999); !errors.Is(err, tournament.ErrTournamentNotFound) {
+		t.Errorf("GetTournament err = %v, want %v", err, tournament.ErrTournamentNotFound)
+	}
+	if _, err := store.GetTournamentByJoinCode(ctx, "NOPE22"); !errors.Is(err, tournament.ErrTournamentNotFound) {
+		t.Errorf("GetTournamentByJoinCode err = %v, want %v", err, tournament.ErrTournamentNotFound)
+	}
+
+	if err := store.CreateTournament(ctx, &tournament.Tournament{Title: "A", JoinCode: "DUP234"}); err != nil {
+		t.Fatalf("CreateTournament err = %v, want nil", err)
+	}
+	exists, err := store.JoinCodeExists(ctx, "DUP234")
+	if err != nil {
+		t.Fatalf("JoinCodeExists err = %v, want nil", err)
+	}
+	if !exists {
+		t.Error("JoinCodeExists = false, want true")
+	}
+	err = store.CreateTournament(ctx, &tournament.Tournament{Title: "B", JoinCode: "DUP234"})
+	if !errors.Is(err, tournament.ErrJoinCodeUnavailable) {
+		t.Errorf("duplicate CreateTournament err = %v, want %v", err, tournament.ErrJoinCodeUnavailable)
+	}
+}
@@ -0,0 +1,170 @@
+// Package testserver boots the real application server for full-stack tests:
+// an ephemeral port, a fresh database per test on the backend the run
+// selects (see [dbtest.SetupBackendDB]), an optional seeded clock, and
+// shutdown registered on the test's cleanup.
+package testserver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"maps"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/cmd/server/app"
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/testutil"
+)
+
+// SessionKey is the fixed session signing key every test server runs with,
+// so a test can mint a matching session cookie itself. Overridable through
+// [Options.Env].
+const SessionKey = "integration-test-session-key-0123456789abcdef"
+
+const shutdownTimeout = 10 * time.Second
+
+// Options configures [Start]. The zero value boots a server on the defaults.
+type Options struct {
+	// Env is merged on top of the default environment (APP_ENV=development,
+	// HOST=localhost, PORT=0, DB_URI=<fresh test DB>, SESSION_KEY=SessionKey,
+	// SESSION_RUNNER_BEAT=1h), so a test can opt in to flags like
+	// REGISTRATION_ENABLED without redoing the rest.
+	Env map[string]string
+	// RunOptions are forwarded to [app.Run] for values that have no env-var
+	// hook, such as the HTTP write timeout and the SSE heartbeat intervals.
+	RunOptions []app.Option
+	// Now seeds the server's game and live-session clock: it reads Now when
+	// the server starts and advances with the wall clock from there, so the
+	// runner's beats still fire while every timestamp the game records is
+	// reproducible. The zero value leaves the wall clock in place.
+	Now time.Time
+}
+
+// Server is a test server started by [Start].
+type Server struct {
+	// BaseURL is the server's root URL, without a trailing slash.
+	BaseURL string
+	// DBURI is the test database the server runs against.
+	DBURI string
+	// DB is a connection to that database for direct reads and fixture
+	// writes; the stores [Start] returns sit on it.
+	DB *sql.DB
+
+	now func() time.Time
+}
+
+// Now reads the server's clock: the seeded clock when [Options.Now] was set,
+// the wall clock otherwise.
+func (s *Server) Now() time.Time {
+	return s.now()
+}
+
+// Start boots a real server against an ephemeral port and a fresh test DB,
+// waits until /healthz responds, and returns it with a [store.Stores] over
+// the same DB and the server's base URL. Shutdown is registered via
+// t.Cleanup: the server is stopped, its exit drained, and an exit other than
+// the cancellation fails the test. Under -short the test is skipped, as it
+// needs a real server.
+func Start(t *testing.T, opts Options) (*Server, *store.Stores, string) {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("integration: needs a real server")
+	}
+
+	ctx, stop := testutil.SignalCtx(t)
+	stdout := testutil.NewTestWriter(t)
+
+	dbURI := dbtest.SetupBackendDB(t)
+
+	env := map[string]string{
+		// APP_ENV=development keeps cookies non-Secure so a test's
+		// http.Client (no TLS) gets the cookies the handlers set.
+		"APP_ENV":     "development",
+		"HOST":        "localhost",
+		"PORT":        "0",
+		"DB_URI":      dbURI,
+		"SESSION_KEY": SessionKey,
+		// Park the session runner's beat: its poll of the DB in every test
+		// server adds enough background load across parallel servers to widen
+		// the #608 readiness flake, and most tests never run a live game. The
+		// runner tests opt back in (SESSION_RUNNER_BEAT=30ms).
+		"SESSION_RUNNER_BEAT": "1h",
+	}
+	maps.Copy(env, opts.Env)
+	getenv := func(key string) string { return env[key] }
+
+	srv := &Server{DBURI: dbURI, now: time.Now}
+	runOpts := opts.RunOptions
+	if !opts.Now.IsZero() {
+		srv.now = seededClock(opts.Now)
+		runOpts = append(runOpts[:len(runOpts):len(runOpts)], app.WithClock(srv.now))
+	}
+
+	listenConfig := &net.ListenConfig{}
+	ln, err := listenConfig.Listen(ctx, "tcp", net.JoinHostPort(getenv("HOST"), getenv("PORT")))
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- app.Run(ctx, getenv, stdout, ln, runOpts...)
+	}()
+
+	t.Cleanup(func() {
+		// Stop forwarding the server's request logs to t.Log before draining
+		// it: an in-flight request that logs during/after shutdown would
+		// otherwise call t.Log as the test completes and race the testing
+		// framework's own teardown (#1008).
+		stdout.Disable()
+		stop()
+		select {
+		case rerr := <-errCh:
+			if rerr != nil && !errors.Is(rerr, context.Canceled) {
+				t.Errorf("server exited with error: %v", rerr)
+			}
+		case <-time.After(shutdownTimeout):
+			t.Error("server timed out during shutdown")
+		}
+	})
+
+	srv.BaseURL = "http://" + ln.Addr().String()
+	if err = testutil.WaitForReady(ctx, t, readyTimeout(), srv.BaseURL+"/healthz"); err != nil {
+		t.Fatalf("error waiting for server to be ready: %v", err)
+	}
+
+	srv.DB = dbtest.OpenURI(t, dbURI)
+	t.Cleanup(func() {
+		if cerr := srv.DB.Close(); cerr != nil {
+			t.Errorf("db.Close err = %v, want nil", cerr)
+		}
+	})
+
+	return srv, store.New(srv.DB, slog.Default()), srv.BaseURL
+}
+
+// readyTimeout is how long Start waits for /healthz. Coverage
+// instrumentation plus -race slows startup enough that a batch of parallel
+// boots, each migrating a fresh DB, can blow past a tight budget all at once
+// (#608), so the budget widens only under coverage; a plain run keeps it short
+// so a genuine startup hang still fails fast.
+func readyTimeout() time.Duration {
+	if testing.CoverMode() != "" {
+		return 60 * time.Second
+	}
+
+	return 10 * time.Second
+}
+
+// seededClock returns a clock that reads seed now and advances with the wall
+// clock from there.
+func seededClock(seed time.Time) func() time.Time {
+	start := time.Now()
+
+	return func() time.Time { return seed.Add(time.Since(start)) }
+}
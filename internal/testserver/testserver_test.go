@@ -0,0 +1,62 @@
+package testserver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/quiz"
+	. "github.com/starquake/topbanana/internal/testserver"
+	apiclient "github.com/starquake/topbanana/pkg/client"
+)
+
+// TestStart_SeededClock pins the harness end to end: the stores share the
+// server's database, and a question the server issues is stamped on the
+// seeded clock rather than the wall clock.
+func TestStart_SeededClock(t *testing.T) {
+	t.Parallel()
+
+	seed := time.Date(2030, time.January, 2, 12, 0, 0, 0, time.UTC)
+	srv, stores, baseURL := Start(t, Options{Now: seed})
+	ctx := t.Context()
+
+	qz := &quiz.Quiz{
+		Title:             "Clock Quiz",
+		Published:         true,
+		Slug:              "clock-quiz",
+		CreatedByPlayerID: 1,
+		Questions: []*quiz.Question{
+			{Text: "Q1", Position: 1, Options: []*quiz.Option{{Text: "A", Correct: true}, {Text: "B"}}},
+		},
+	}
+	if err := stores.Quizzes.CreateQuiz(ctx, qz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+
+	client, err := apiclient.New(baseURL, nil)
+	if err != nil {
+		t.Fatalf("New err = %v, want nil", err)
+	}
+	gameID, err := client.CreateGame(ctx, qz.ID)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	if _, err = client.NextQuestion(ctx, gameID); err != nil {
+		t.Fatalf("NextQuestion err = %v, want nil", err)
+	}
+
+	g, err := stores.Games.GetGame(ctx, gameID)
+	if err != nil {
+		t.Fatalf("GetGame err = %v, want nil", err)
+	}
+	if len(g.Questions) != 1 {
+		t.Fatalf("game questions = %d, want 1", len(g.Questions))
+	}
+	// The question opens after the read delay, well inside a minute of the
+	// seed, and years away from the wall clock.
+	if got := g.Questions[0].StartedAt; got.Before(seed) || got.After(seed.Add(time.Minute)) {
+		t.Errorf("question StartedAt = %v, want within a minute after %v", got, seed)
+	}
+	if got := srv.Now(); got.Before(seed) || got.After(seed.Add(time.Minute)) {
+		t.Errorf("server Now = %v, want within a minute after %v", got, seed)
+	}
+}
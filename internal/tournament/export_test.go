@@ -0,0 +1,5 @@
+package tournament
+
+// ExportNewServiceWithCodeGen re-exports newServiceWithCodeGen so the
+// external test package can force join-code collisions deterministically.
+var ExportNewServiceWithCodeGen = newServiceWithCodeGen
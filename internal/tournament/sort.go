@@ -0,0 +1,22 @@
+package tournament
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// sortStandings orders rows by total descending, then display name, then
+// player id so equal totals keep a stable order across requests.
+func sortStandings(entries []Standing) {
+	slices.SortFunc(entries, func(a, b Standing) int {
+		if c := cmp.Compare(b.Total, a.Total); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.DisplayName, b.DisplayName); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.PlayerID, b.PlayerID)
+	})
+}
@@ -0,0 +1,295 @@
+// Package tournament contains the multi-quiz tournament domain: a host groups
+// a sequence of solo quizzes played over days or weeks, players join with a
+// code, and the standings sum each member's score across the quizzes.
+//
+// Standings are derived on read from the same per-quiz scoring the quiz
+// leaderboard uses, so nothing about a game changes when its quiz is part of
+// a tournament.
+package tournament
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/starquake/topbanana/internal/livesession"
+)
+
+var (
+	// ErrTournamentNotFound is returned when a lookup by id or join code finds
+	// no tournament. Handlers map it to 404.
+	ErrTournamentNotFound = errors.New("tournament not found")
+
+	// ErrJoinCodeUnavailable is returned by [Service.Create] when no free join
+	// code turned up within the attempt budget, or when a concurrent create
+	// took the probed code first.
+	ErrJoinCodeUnavailable = errors.New("could not allocate a unique tournament join code")
+
+	// ErrStageExists is returned by [Service.AddStage] when the quiz is
+	// already one of the tournament's stages.
+	ErrStageExists = errors.New("quiz is already a tournament stage")
+
+	// ErrTitleRequired is returned by [Service.Create] for a blank title.
+	ErrTitleRequired = errors.New("tournament title is required")
+)
+
+// joinCodeAttempts bounds how many generated codes [Service.Create] probes
+// before giving up with [ErrJoinCodeUnavailable].
+const joinCodeAttempts = 10
+
+// Tournament is a named, ordered sequence of quizzes. Stages is populated by
+// [Store.GetTournament] and [Store.GetTournamentByJoinCode]; list reads leave
+// it nil.
+type Tournament struct {
+	ID                int64
+	Title             string
+	JoinCode          string
+	CreatedByPlayerID int64
+	CreatedAt         time.Time
+	Stages            []*Stage
+}
+
+// Stage is one quiz of a tournament. Position is 1-based play order.
+type Stage struct {
+	QuizID    int64
+	Position  int
+	QuizTitle string
+	QuizSlug  string
+}
+
+// Member is a player who joined the tournament.
+type Member struct {
+	PlayerID    int64
+	DisplayName string
+	JoinedAt    time.Time
+}
+
+// StageResult is a member's outcome on one stage. Played is false when the
+// member has no game on the stage's quiz yet; Score is then 0.
+type StageResult struct {
+	Played bool
+	Score  int
+}
+
+// Standing is one row of the tournament standings. Stages lines up with
+// [Tournament.Stages].
+type Standing struct {
+	Rank         int
+	PlayerID     int64
+	DisplayName  string
+	Total        int
+	StagesPlayed int
+	Stages       []StageResult
+}
+
+// Standings is the full cumulative table for a tournament.
+type Standings struct {
+	Tournament *Tournament
+	Entries    []Standing
+}
+
+// Store persists tournaments, their stages, and their members.
+type Store interface {
+	// CreateTournament inserts t and sets its ID and CreatedAt. Returns
+	// [ErrJoinCodeUnavailable] when t.JoinCode is already taken.
+	CreateTournament(ctx context.Context, t *Tournament) error
+	// JoinCodeExists reports whether a tournament already uses the code.
+	JoinCodeExists(ctx context.Context, joinCode string) (bool, error)
+	// GetTournament returns the tournament with its stages, or
+	// [ErrTournamentNotFound].
+	GetTournament(ctx context.Context, id int64) (*Tournament, error)
+	// GetTournamentByJoinCode returns the tournament with its stages, or
+	// [ErrTournamentNotFound].
+	GetTournamentByJoinCode(ctx context.Context, joinCode string) (*Tournament, error)
+	// ListTournaments returns every tournament, newest first.
+	ListTournaments(ctx context.Context) ([]*Tournament, error)
+	// ListTournamentsForOwner returns the player's own tournaments, newest
+	// first.
+	ListTournamentsForOwner(ctx context.Context, playerID int64) ([]*Tournament, error)
+	// AddStage appends the quiz as the tournament's last stage. Returns
+	// [ErrStageExists] when the quiz is already a stage.
+	AddStage(ctx context.Context, tournamentID, quizID int64) error
+	// AddMember records the player as a member. Joining twice is a no-op.
+	AddMember(ctx context.Context, tournamentID, playerID int64) error
+	// ListMembers returns the members in join order.
+	ListMembers(ctx context.Context, tournamentID int64) ([]*Member, error)
+}
+
+// Scorer supplies per-quiz player totals. game.Service satisfies it with the
+// same scoring the quiz leaderboard uses.
+type Scorer interface {
+	// QuizScoreTotals returns the total of every player with a game on the
+	// quiz, keyed by player id.
+	QuizScoreTotals(ctx context.Context, quizID int64) (map[int64]int, error)
+}
+
+// Service is the tournament use-case layer.
+type Service struct {
+	store     Store
+	scorer    Scorer
+	logger    *slog.Logger
+	newCode   func() string
+	codeTries int
+}
+
+// NewService returns a Service. Join codes come from the live-session room
+// code generator, so a tournament code reads and types like a room code.
+func NewService(store Store, scorer Scorer, logger *slog.Logger) *Service {
+	return newServiceWithCodeGen(store, scorer, logger, livesession.GenerateJoinCode, joinCodeAttempts)
+}
+
+// newServiceWithCodeGen builds a service with an injected code generator and
+// attempt budget so tests can force collisions. Exposed to the external test
+// package via export_test.go.
+func newServiceWithCodeGen(
+	store Store, scorer Scorer, logger *slog.Logger, newCode func() string, tries int,
+) *Service {
+	return &Service{store: store, scorer: scorer, logger: logger, newCode: newCode, codeTries: tries}
+}
+
+// Create allocates a join code and stores a new tournament owned by
+// creatorID, with quizIDs as its initial stages in order.
+func (s *Service) Create(ctx context.Context, title string, creatorID int64, quizIDs []int64) (*Tournament, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, ErrTitleRequired
+	}
+
+	code, err := s.allocateJoinCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Tournament{Title: title, JoinCode: code, CreatedByPlayerID: creatorID}
+	if err = s.store.CreateTournament(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to create tournament: %w", err)
+	}
+	for _, quizID := range quizIDs {
+		if err = s.store.AddStage(ctx, t.ID, quizID); err != nil && !errors.Is(err, ErrStageExists) {
+			return nil, fmt.Errorf("failed to add tournament stage: %w", err)
+		}
+	}
+
+	s.logger.InfoContext(ctx, "tournament created",
+		slog.Int64("tournament_id", t.ID), slog.String("join_code", t.JoinCode), slog.Int64("host_id", creatorID))
+
+	return t, nil
+}
+
+// AddStage appends a quiz to the tournament; the next round of a running
+// tournament is added this way once it is ready.
+func (s *Service) AddStage(ctx context.Context, tournamentID, quizID int64) error {
+	if err := s.store.AddStage(ctx, tournamentID, quizID); err != nil {
+		return fmt.Errorf("failed to add tournament stage: %w", err)
+	}
+
+	return nil
+}
+
+// Join adds the player to the tournament identified by joinCode and returns
+// it. Codes are matched case-insensitively. Returns [ErrTournamentNotFound]
+// for an unknown code.
+func (s *Service) Join(ctx context.Context, joinCode string, playerID int64) (*Tournament, error) {
+	t, err := s.store.GetTournamentByJoinCode(ctx, NormalizeJoinCode(joinCode))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament by join code: %w", err)
+	}
+	if err = s.store.AddMember(ctx, t.ID, playerID); err != nil {
+		return nil, fmt.Errorf("failed to join tournament: %w", err)
+	}
+
+	return t, nil
+}
+
+// StandingsByJoinCode resolves the code and returns [Service.Standings].
+func (s *Service) StandingsByJoinCode(ctx context.Context, joinCode string) (*Standings, error) {
+	t, err := s.store.GetTournamentByJoinCode(ctx, NormalizeJoinCode(joinCode))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament by join code: %w", err)
+	}
+
+	return s.standings(ctx, t)
+}
+
+// Standings returns the cumulative table for the tournament: every member,
+// ranked by their total across all stages. Members who have not played yet
+// appear at 0.
+func (s *Service) Standings(ctx context.Context, tournamentID int64) (*Standings, error) {
+	t, err := s.store.GetTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament: %w", err)
+	}
+
+	return s.standings(ctx, t)
+}
+
+func (s *Service) standings(ctx context.Context, t *Tournament) (*Standings, error) {
+	members, err := s.store.ListMembers(ctx, t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournament members: %w", err)
+	}
+
+	stageTotals := make([]map[int64]int, len(t.Stages))
+	for i, st := range t.Stages {
+		stageTotals[i], err = s.scorer.QuizScoreTotals(ctx, st.QuizID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to score tournament stage %d: %w", st.Position, err)
+		}
+	}
+
+	return &Standings{Tournament: t, Entries: RankStandings(members, stageTotals)}, nil
+}
+
+// RankStandings builds one row per member from the per-stage totals (one map
+// per stage, keyed by player id) and ranks them by total, highest first. Ties
+// are broken by display name, then player id, matching the quiz leaderboard's
+// ordering; ranks are positional.
+func RankStandings(members []*Member, stageTotals []map[int64]int) []Standing {
+	entries := make([]Standing, 0, len(members))
+	for _, m := range members {
+		row := Standing{PlayerID: m.PlayerID, DisplayName: m.DisplayName, Stages: make([]StageResult, len(stageTotals))}
+		for i, totals := range stageTotals {
+			score, played := totals[m.PlayerID]
+			row.Stages[i] = StageResult{Played: played, Score: score}
+			row.Total += score
+			if played {
+				row.StagesPlayed++
+			}
+		}
+		entries = append(entries, row)
+	}
+
+	sortStandings(entries)
+	for i := range entries {
+		entries[i].Rank = i + 1
+	}
+
+	return entries
+}
+
+// NormalizeJoinCode upper-cases and trims a user-typed code so "abc234 "
+// resolves like "ABC234".
+func NormalizeJoinCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// allocateJoinCode probes generated codes against the store until one is
+// free. The store's UNIQUE constraint still arbitrates a race between two
+// creates that probe the same code.
+func (s *Service) allocateJoinCode(ctx context.Context) (string, error) {
+	for range s.codeTries {
+		code := s.newCode()
+		exists, err := s.store.JoinCodeExists(ctx, code)
+		if err != nil {
+			return "", fmt.Errorf("failed to probe tournament join code: %w", err)
+		}
+		if !exists {
+			return code, nil
+		}
+	}
+
+	return "", ErrJoinCodeUnavailable
+}
@@ -0,0 +1,237 @@
+package tournament_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	. "github.com/starquake/topbanana/internal/tournament"
+)
+
+// fakeStore is an in-memory [Store] holding a single tournament's worth of
+// state, enough for the service paths under test.
+type fakeStore struct {
+	taken   map[string]bool
+	created []*Tournament
+	stages  map[int64][]*Stage
+	members map[int64][]*Member
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{taken: map[string]bool{}, stages: map[int64][]*Stage{}, members: map[int64][]*Member{}}
+}
+
+func (f *fakeStore) CreateTournament(_ context.Context, t *Tournament) error {
+	if f.taken[t.JoinCode] {
+		return ErrJoinCodeUnavailable
+	}
+	f.taken[t.JoinCode] = true
+	t.ID = int64(len(f.created) + 1)
+	f.created = append(f.created, t)
+
+	return nil
+}
+
+func (f *fakeStore) JoinCodeExists(_ context.Context, code string) (bool, error) {
+	return f.taken[code], nil
+}
+
+func (f *fakeStore) GetTournament(_ context.Context, id int64) (*Tournament, error) {
+	for _, t := range f.created {
+		if t.ID == id {
+			t.Stages = f.stages[id]
+
+			return t, nil
+		}
+	}
+
+	return nil, ErrTournamentNotFound
+}
+
+func (f *fakeStore) GetTournamentByJoinCode(ctx context.Context, code string) (*Tournament, error) {
+	for _, t := range f.created {
+		if t.JoinCode == code {
+			return f.GetTournament(ctx, t.ID)
+		}
+	}
+
+	return nil, ErrTournamentNotFound
+}
+
+func (f *fakeStore) ListTournaments(context.Context) ([]*Tournament, error) { return f.created, nil }
+
+func (f *fakeStore) ListTournamentsForOwner(context.Context, int64) ([]*Tournament, error) {
+	return f.created, nil
+}
+
+func (f *fakeStore) AddStage(_ context.Context, tournamentID, quizID int64) error {
+	for _, st := range f.stages[tournamentID] {
+		if st.QuizID == quizID {
+			return ErrStageExists
+		}
+	}
+	f.stages[tournamentID] = append(f.stages[tournamentID], &Stage{
+		QuizID: quizID, Position: len(f.stages[tournamentID]) + 1,
+	})
+
+	return nil
+}
+
+func (f *fakeStore) AddMember(_ context.Context, tournamentID, playerID int64) error {
+	for _, m := range f.members[tournamentID] {
+		if m.PlayerID == playerID {
+			return nil
+		}
+	}
+	f.members[tournamentID] = append(f.members[tournamentID], &Member{PlayerID: playerID})
+
+	return nil
+}
+
+func (f *fakeStore) ListMembers(_ context.Context, tournamentID int64) ([]*Member, error) {
+	return f.members[tournamentID], nil
+}
+
+// fakeScorer returns canned per-quiz totals.
+type fakeScorer map[int64]map[int64]int
+
+func (f fakeScorer) QuizScoreTotals(_ context.Context, quizID int64) (map[int64]int, error) {
+	return f[quizID], nil
+}
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retries past a taken code and adds stages in order", func(t *testing.T) {
+		t.Parallel()
+
+		store := newFakeStore()
+		store.taken["AAAAAA"] = true
+		codes := []string{"AAAAAA", "BBBBBB"}
+		svc := ExportNewServiceWithCodeGen(store, fakeScorer{}, slog.Default(), func() string {
+			c := codes[0]
+			codes = codes[1:]
+
+			return c
+		}, 3)
+
+		tm, err := svc.Create(t.Context(), "  Spring Cup ", 7, []int64{20, 10, 20})
+		if err != nil {
+			t.Fatalf("Create err = %v, want nil", err)
+		}
+		if got, want := tm.JoinCode, "BBBBBB"; got != want {
+			t.Errorf("JoinCode = %q, want %q", got, want)
+		}
+		if got, want := tm.Title, "Spring Cup"; got != want {
+			t.Errorf("Title = %q, want %q", got, want)
+		}
+		var gotQuizzes []int64
+		for _, st := range store.stages[tm.ID] {
+			gotQuizzes = append(gotQuizzes, st.QuizID)
+		}
+		if diff := cmp.Diff([]int64{20, 10}, gotQuizzes); diff != "" {
+			t.Errorf("stage quizzes mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("gives up after the attempt budget", func(t *testing.T) {
+		t.Parallel()
+
+		store := newFakeStore()
+		store.taken["AAAAAA"] = true
+		svc := ExportNewServiceWithCodeGen(store, fakeScorer{}, slog.Default(), func() string { return "AAAAAA" }, 3)
+
+		if _, err := svc.Create(t.Context(), "Cup", 7, nil); !errors.Is(err, ErrJoinCodeUnavailable) {
+			t.Errorf("Create err = %v, want %v", err, ErrJoinCodeUnavailable)
+		}
+	})
+
+	t.Run("rejects a blank title", func(t *testing.T) {
+		t.Parallel()
+
+		svc := NewService(newFakeStore(), fakeScorer{}, slog.Default())
+		if _, err := svc.Create(t.Context(), "   ", 7, nil); !errors.Is(err, ErrTitleRequired) {
+			t.Errorf("Create err = %v, want %v", err, ErrTitleRequired)
+		}
+	})
+}
+
+func TestService_Join(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeStore()
+	svc := ExportNewServiceWithCodeGen(store, fakeScorer{}, slog.Default(), func() string { return "CUP234" }, 1)
+	if _, err := svc.Create(t.Context(), "Cup", 7, nil); err != nil {
+		t.Fatalf("Create err = %v, want nil", err)
+	}
+
+	if _, err := svc.Join(t.Context(), " cup234 ", 11); err != nil {
+		t.Fatalf("Join err = %v, want nil", err)
+	}
+	if _, err := svc.Join(t.Context(), "CUP234", 11); err != nil {
+		t.Fatalf("repeat Join err = %v, want nil", err)
+	}
+	if got, want := len(store.members[1]), 1; got != want {
+		t.Errorf("len(members) = %d, want %d", got, want)
+	}
+	if _, err := svc.Join(t.Context(), "NOPE22", 11); !errors.Is(err, ErrTournamentNotFound) {
+		t.Errorf("Join unknown err = %v, want %v", err, ErrTournamentNotFound)
+	}
+}
+
+func TestService_Standings(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeStore()
+	scorer := fakeScorer{
+		10: {1: 300, 2: 500, 99: 1000},
+		20: {1: 400},
+	}
+	svc := ExportNewServiceWithCodeGen(store, scorer, slog.Default(), func() string { return "CUP234" }, 1)
+	tm, err := svc.Create(t.Context(), "Cup", 7, []int64{10, 20})
+	if err != nil {
+		t.Fatalf("Create err = %v, want nil", err)
+	}
+	store.members[tm.ID] = []*Member{
+		{PlayerID: 2, DisplayName: "Bea"},
+		{PlayerID: 1, DisplayName: "Ann"},
+		{PlayerID: 3, DisplayName: "Cal"},
+	}
+
+	got, err := svc.StandingsByJoinCode(t.Context(), "cup234")
+	if err != nil {
+		t.Fatalf("StandingsByJoinCode err = %v, want nil", err)
+	}
+
+	// Player 99 played stage 1 but never joined, so they are not ranked;
+	// Cal joined but has not played and sits at 0.
+	want := []Standing{
+		{Rank: 1, PlayerID: 1, DisplayName: "Ann", Total: 700, StagesPlayed: 2, Stages: []StageResult{
+			{Played: true, Score: 300}, {Played: true, Score: 400},
+		}},
+		{Rank: 2, PlayerID: 2, DisplayName: "Bea", Total: 500, StagesPlayed: 1, Stages: []StageResult{
+			{Played: true, Score: 500}, {},
+		}},
+		{Rank: 3, PlayerID: 3, DisplayName: "Cal", Stages: []StageResult{{}, {}}},
+	}
+	if diff := cmp.Diff(want, got.Entries); diff != "" {
+		t.Errorf("Entries mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRankStandings_TiesBreakByName(t *testing.T) {
+	t.Parallel()
+
+	members := []*Member{{PlayerID: 1, DisplayName: "Zed"}, {PlayerID: 2, DisplayName: "Amy"}}
+	got := RankStandings(members, []map[int64]int{{1: 100, 2: 100}})
+
+	if got, want := got[0].DisplayName, "Amy"; got != want {
+		t.Errorf("first DisplayName = %q, want %q", got, want)
+	}
+	if got, want := got[1].Rank, 2; got != want {
+		t.Errorf("second Rank = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,9 @@
+package tracing
+
+import "context"
+
+// ExportStartRoot opens a root span, as the HTTP middleware does, so tests
+// can trace work without a request.
+func (t *Tracer) ExportStartRoot(ctx context.Context, name string) (context.Context, *Span) {
+	return t.start(ctx, name, KindServer, nil)
+}
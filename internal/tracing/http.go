@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// traceparentHeader is the W3C Trace Context header a caller uses to make
+// this request's span a child of its own.
+const traceparentHeader = "traceparent"
+
+// Middleware opens a server span around each request. Mount it directly
+// around the ServeMux: the span is named after the matched route pattern
+// ("GET /api/games/{gameID}"), which the mux records on the request it is
+// handed, so a wrapper that swaps the request first would hide it. A
+// traceparent header from the caller is honoured. With a nil tracer next is
+// returned unchanged.
+func (t *Tracer) Middleware(next http.Handler) http.Handler {
+	if t == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if remote, ok := parseTraceparent(r.Header.Get(traceparentHeader)); ok {
+			ctx = context.WithValue(ctx, remoteCtxKey{}, remote)
+		}
+		ctx, span := t.start(ctx, r.Method, KindServer, []Attr{
+			String("http.request.method", r.Method),
+			String("url.path", r.URL.Path),
+		})
+		defer span.End()
+
+		rw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		r = r.WithContext(ctx)
+		next.ServeHTTP(rw, r)
+
+		if r.Pattern != "" {
+			span.SetName(r.Pattern)
+			span.SetAttributes(String("http.route", r.Pattern))
+		}
+		span.SetAttributes(Int64("http.response.status_code", int64(rw.status)))
+		if rw.status >= http.StatusInternalServerError {
+			span.failed = true
+			span.errMsg = http.StatusText(rw.status)
+		}
+	})
+}
+
+// statusWriter records the status code written through it. Unwrap lets
+// http.ResponseController reach the underlying writer, so the SSE handlers
+// can still flush.
+type statusWriter struct {
+	http.ResponseWriter
+
+	status int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.status = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// parseTraceparent reads a version-00 W3C traceparent value
+// ("00-<32 hex trace id>-<16 hex span id>-<2 hex flags>"). Anything
+// malformed, or an all-zero ID, is ignored and the request starts a new
+// trace.
+func parseTraceparent(v string) (remoteParent, bool) {
+	parts := strings.Split(v, "-")
+	if len(parts) != 4 || parts[0] != "00" || len(parts[3]) != 2 {
+		return remoteParent{}, false
+	}
+	var p remoteParent
+	if n, err := hex.Decode(p.traceID[:], []byte(parts[1])); err != nil || n != len(p.traceID) || len(parts[1]) != 32 {
+		return remoteParent{}, false
+	}
+	if n, err := hex.Decode(p.spanID[:], []byte(parts[2])); err != nil || n != len(p.spanID) || len(parts[2]) != 16 {
+		return remoteParent{}, false
+	}
+	if p.traceID == (TraceID{}) || p.spanID == (SpanID{}) {
+		return remoteParent{}, false
+	}
+
+	return p, true
+}
@@ -0,0 +1,82 @@
+package tracing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/tracing"
+)
+
+func TestTracer_Middleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("names the span after the route and records the status", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, exp := newTracer()
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /api/games/{gameID}", func(w http.ResponseWriter, r *http.Request) {
+			_, span := Start(r.Context(), "game.GetNext")
+			span.End()
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/games/g1", nil)
+		tracer.Middleware(mux).ServeHTTP(httptest.NewRecorder(), req)
+		flush(t, tracer)
+
+		root := exp.byName(t, "GET /api/games/{gameID}")
+		if got, want := root.Err(), "Internal Server Error"; got != want {
+			t.Errorf("root Err = %q, want %q", got, want)
+		}
+		if got := exp.byName(t, "game.GetNext").ParentID(); got != root.SpanID() {
+			t.Errorf("handler span ParentID = %s, want the request span %s", got, root.SpanID())
+		}
+	})
+
+	t.Run("continues the caller's trace", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, exp := newTracer()
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/version", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		tracer.Middleware(http.NotFoundHandler()).ServeHTTP(httptest.NewRecorder(), req)
+		flush(t, tracer)
+
+		span := exp.byName(t, http.MethodGet)
+		if got, want := span.TraceID().String(), "4bf92f3577b34da6a3ce929d0e0e4736"; got != want {
+			t.Errorf("TraceID = %s, want %s", got, want)
+		}
+		if got, want := span.ParentID().String(), "00f067aa0ba902b7"; got != want {
+			t.Errorf("ParentID = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("a malformed traceparent starts a new trace", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, exp := newTracer()
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/version", nil)
+		req.Header.Set("traceparent", "00-00000000000000000000000000000000-00f067aa0ba902b7-01")
+		tracer.Middleware(http.NotFoundHandler()).ServeHTTP(httptest.NewRecorder(), req)
+		flush(t, tracer)
+
+		span := exp.byName(t, http.MethodGet)
+		if span.TraceID() == (TraceID{}) || span.ParentID() != (SpanID{}) {
+			t.Errorf("span trace = %s parent = %s, want a fresh root", span.TraceID(), span.ParentID())
+		}
+	})
+
+	t.Run("a nil tracer passes requests through", func(t *testing.T) {
+		t.Parallel()
+
+		var tracer *Tracer
+		next := http.NotFoundHandler()
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+		tracer.Middleware(next).ServeHTTP(rec, req)
+		if got, want := rec.Code, http.StatusNotFound; got != want {
+			t.Errorf("code = %d, want %d", got, want)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+package tracing_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	. "github.com/starquake/topbanana/internal/tracing"
+)
+
+func TestOTLPExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	type request struct{ path, contentType, body string }
+	requests := make(chan request, 2)
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{path: r.URL.Path, contentType: r.Header.Get("Content-Type"), body: string(body)}
+		w.WriteHeader(int(status.Load()))
+	}))
+	t.Cleanup(srv.Close)
+
+	tracer, rec := newTracer()
+	ctx, root := tracer.ExportStartRoot(t.Context(), "GET /version")
+	_, child := Start(ctx, "db.GetGame", Int64("rows", 1), Bool("cached", false))
+	child.RecordError(errors.New("no rows"))
+	child.End()
+	root.End()
+	flush(t, tracer)
+
+	exporter := NewOTLPExporter(srv.URL+"/", "topbanana")
+	if err := exporter.Export(t.Context(), rec.spans); err != nil {
+		t.Fatalf("Export err = %v, want nil", err)
+	}
+	got := <-requests
+	if want := "/v1/traces"; got.path != want {
+		t.Errorf("path = %q, want %q", got.path, want)
+	}
+	if want := "application/json"; got.contentType != want {
+		t.Errorf("Content-Type = %q, want %q", got.contentType, want)
+	}
+
+	var body struct {
+		ResourceSpans []struct {
+			Resource struct {
+				Attributes []struct {
+					Key   string `json:"key"`
+					Value struct {
+						StringValue string `json:"stringValue"`
+					} `json:"value"`
+				} `json:"attributes"`
+			} `json:"resource"`
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID      string `json:"traceId"`
+					ParentSpanID string `json:"parentSpanId"`
+					Name         string `json:"name"`
+					Kind         int    `json:"kind"`
+					Attributes   []struct {
+						Key   string         `json:"key"`
+						Value map[string]any `json:"value"`
+					} `json:"attributes"`
+					Status *struct {
+						Code    int    `json:"code"`
+						Message string `json:"message"`
+					} `json:"status"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	if err := json.Unmarshal([]byte(got.body), &body); err != nil {
+		t.Fatalf("json.Unmarshal err = %v, want nil", err)
+	}
+	rs := body.ResourceSpans[0]
+	if attr := rs.Resource.Attributes[0]; attr.Key != "service.name" || attr.Value.StringValue != "topbanana" {
+		t.Errorf("resource attribute = %+v, want service.name topbanana", attr)
+	}
+	spans := rs.ScopeSpans[0].Spans
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("spans = %d, want %d", got, want)
+	}
+	span := spans[0]
+	if span.Name != "db.GetGame" || span.ParentSpanID != root.SpanID().String() || span.TraceID != root.TraceID().String() {
+		t.Errorf("span = %+v, want db.GetGame under the root span", span)
+	}
+	if span.Status == nil || span.Status.Code != 2 || span.Status.Message != "no rows" {
+		t.Errorf("span status = %+v, want an error status with the message", span.Status)
+	}
+	if got, want := span.Attributes[0].Value["intValue"], "1"; got != want {
+		t.Errorf("rows attribute = %v, want intValue %q", got, want)
+	}
+	if spans[1].ParentSpanID != "" || spans[1].Kind != int(KindServer) {
+		t.Errorf("root span = %+v, want a parentless server span", spans[1])
+	}
+
+	status.Store(http.StatusServiceUnavailable)
+	if err := exporter.Export(t.Context(), rec.spans); !errors.Is(err, ErrCollectorStatus) {
+		t.Errorf("Export err = %v, want ErrCollectorStatus", err)
+	}
+}
+
+// TestOTLPExporter_ExportDecodesAsOTLP checks the request body against the
+// OTLP/JSON mapping by decoding it with the collector's own decoder: a
+// misnamed field, a wrongly encoded ID or an integer sent as a number would
+// lose the value or fail the decode.
+func TestOTLPExporter_ExportDecodesAsOTLP(t *testing.T) {
+	t.Parallel()
+
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies <- body
+	}))
+	t.Cleanup(srv.Close)
+
+	tracer, rec := newTracer()
+	ctx, root := tracer.ExportStartRoot(t.Context(), "GET /version")
+	_, child := Start(ctx, "db.GetGame", Int64("rows", 1), Bool("cached", true), String("db.system", "sqlite"))
+	child.RecordError(errors.New("no rows"))
+	child.End()
+	root.End()
+	flush(t, tracer)
+
+	if err := NewOTLPExporter(srv.URL, "topbanana").Export(t.Context(), rec.spans); err != nil {
+		t.Fatalf("Export err = %v, want nil", err)
+	}
+	traces, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(<-bodies)
+	if err != nil {
+		t.Fatalf("UnmarshalTraces err = %v, want nil", err)
+	}
+
+	if got, want := traces.ResourceSpans().Len(), 1; got != want {
+		t.Fatalf("resource spans = %d, want %d", got, want)
+	}
+	rs := traces.ResourceSpans().At(0)
+	if got, ok := rs.Resource().Attributes().Get("service.name"); !ok || got.Str() != "topbanana" {
+		t.Errorf("service.name = %q (present %t), want %q", got.Str(), ok, "topbanana")
+	}
+	scope := rs.ScopeSpans().At(0)
+	if got, want := scope.Scope().Name(), "github.com/starquake/topbanana"; got != want {
+		t.Errorf("scope name = %q, want %q", got, want)
+	}
+	if got, want := scope.Spans().Len(), 2; got != want {
+		t.Fatalf("spans = %d, want %d", got, want)
+	}
+
+	span := scope.Spans().At(0)
+	if got, want := span.Name(), "db.GetGame"; got != want {
+		t.Errorf("span name = %q, want %q", got, want)
+	}
+	if got, want := span.TraceID().String(), root.TraceID().String(); got != want {
+		t.Errorf("traceId = %s, want %s", got, want)
+	}
+	if got, want := span.SpanID().String(), child.SpanID().String(); got != want {
+		t.Errorf("spanId = %s, want %s", got, want)
+	}
+	if got, want := span.ParentSpanID().String(), root.SpanID().String(); got != want {
+		t.Errorf("parentSpanId = %s, want %s", got, want)
+	}
+	if got, want := span.Kind(), ptrace.SpanKindInternal; got != want {
+		t.Errorf("kind = %v, want %v", got, want)
+	}
+	if start, end := span.StartTimestamp().AsTime(), span.EndTimestamp().AsTime(); start.IsZero() || end.Before(start) {
+		t.Errorf("start, end = %v, %v, want a set start no later than the end", start, end)
+	}
+	attrs := span.Attributes()
+	if got, ok := attrs.Get("rows"); !ok || got.Type() != pcommon.ValueTypeInt || got.Int() != 1 {
+		t.Errorf("rows = %v (present %t), want int 1", got.AsRaw(), ok)
+	}
+	if got, ok := attrs.Get("cached"); !ok || got.Type() != pcommon.ValueTypeBool || !got.Bool() {
+		t.Errorf("cached = %v (present %t), want bool true", got.AsRaw(), ok)
+	}
+	if got, ok := attrs.Get("db.system"); !ok || got.Type() != pcommon.ValueTypeStr || got.Str() != "sqlite" {
+		t.Errorf("db.system = %v (present %t), want string sqlite", got.AsRaw(), ok)
+	}
+	if got, want := span.Status().Code(), ptrace.StatusCodeError; got != want {
+		t.Errorf("status code = %v, want %v", got, want)
+	}
+	if got, want := span.Status().Message(), "no rows"; got != want {
+		t.Errorf("status message = %q, want %q", got, want)
+	}
+
+	rootSpan := scope.Spans().At(1)
+	if !rootSpan.ParentSpanID().IsEmpty() {
+		t.Errorf("root parentSpanId = %s, want empty", rootSpan.ParentSpanID())
+	}
+	if got, want := rootSpan.Kind(), ptrace.SpanKindServer; got != want {
+		t.Errorf("root kind = %v, want %v", got, want)
+	}
+	if got, want := rootSpan.Status().Code(), ptrace.StatusCodeUnset; got != want {
+		t.Errorf("root status code = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,96 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// sqlcNamePrefix opens the comment sqlc writes at the top of every
+// generated query ("-- name: GetGame :one").
+const sqlcNamePrefix = "-- name: "
+
+// DBTX is the query surface sqlc's generated code runs against: a *sql.DB,
+// a *sql.Tx, or a wrapper around either. Its method set matches db.DBTX, so
+// a value of either type satisfies the other.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// WrapDB returns conn with a client span around each query, named after the
+// sqlc query ("db.GetGame"). Like [Start], it only records when the
+// context already carries a span, so wrapping is free with tracing off.
+func WrapDB(conn DBTX) DBTX {
+	return tracedDB{conn: conn}
+}
+
+type tracedDB struct {
+	conn DBTX
+}
+
+func (d tracedDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, span := startQuery(ctx, query)
+	defer span.End()
+	res, err := d.conn.ExecContext(ctx, query, args...)
+	span.RecordError(err)
+
+	return res, err //nolint:wrapcheck // A transparent wrapper: callers see the driver's error.
+}
+
+func (d tracedDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	ctx, span := startQuery(ctx, query)
+	defer span.End()
+	stmt, err := d.conn.PrepareContext(ctx, query)
+	span.RecordError(err)
+
+	return stmt, err //nolint:wrapcheck // A transparent wrapper: callers see the driver's error.
+}
+
+func (d tracedDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	ctx, span := startQuery(ctx, query)
+	defer span.End()
+	rows, err := d.conn.QueryContext(ctx, query, args...)
+	span.RecordError(err)
+
+	return rows, err //nolint:wrapcheck // A transparent wrapper: callers see the driver's error.
+}
+
+// QueryRowContext's span ends when the row is returned, before the caller
+// scans it; with SQLite the query has run by then.
+func (d tracedDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	ctx, span := startQuery(ctx, query)
+	defer span.End()
+	row := d.conn.QueryRowContext(ctx, query, args...)
+	span.RecordError(row.Err())
+
+	return row
+}
+
+// startQuery opens the client span for one query, or returns a nil span
+// when ctx is not traced.
+func startQuery(ctx context.Context, query string) (context.Context, *Span) {
+	if FromContext(ctx) == nil {
+		return ctx, nil
+	}
+
+	return start(ctx, "db."+queryName(query), KindClient, []Attr{String("db.system", "sqlite")})
+}
+
+// queryName returns the sqlc name of query, or "query" for SQL that did not
+// come from sqlc.
+func queryName(query string) string {
+	query = strings.TrimSpace(query)
+	rest, ok := strings.CutPrefix(query, sqlcNamePrefix)
+	if !ok {
+		return "query"
+	}
+	name, _, _ := strings.Cut(rest, " ")
+	if name == "" {
+		return "query"
+	}
+
+	return name
+}
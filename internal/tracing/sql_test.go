@@ -0,0 +1,50 @@
+package tracing_test
+
+import (
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	. "github.com/starquake/topbanana/internal/tracing"
+)
+
+// TestWrapDB pins that each query runs in a span named after its sqlc
+// query, and that an untraced context records nothing.
+func TestWrapDB(t *testing.T) {
+	t.Parallel()
+
+	conn := dbtest.Open(t)
+	t.Cleanup(func() {
+		if cerr := conn.Close(); cerr != nil {
+			t.Errorf("conn.Close err = %v", cerr)
+		}
+	})
+	wrapped := WrapDB(conn)
+
+	tracer, exp := newTracer()
+	var one int
+	if err := wrapped.QueryRowContext(t.Context(), "-- name: Untraced :one\nSELECT 1").Scan(&one); err != nil {
+		t.Fatalf("untraced query err = %v, want nil", err)
+	}
+
+	ctx, root := tracer.ExportStartRoot(t.Context(), "root")
+	if err := wrapped.QueryRowContext(ctx, "-- name: CountOne :one\nSELECT 1").Scan(&one); err != nil {
+		t.Fatalf("QueryRowContext err = %v, want nil", err)
+	}
+	if _, err := wrapped.ExecContext(ctx, "SELECT * FROM no_such_table"); err == nil {
+		t.Fatal("ExecContext err = nil, want a missing-table error")
+	}
+	root.End()
+	flush(t, tracer)
+
+	if got := exp.byName(t, "db.CountOne").ParentID(); got != root.SpanID() {
+		t.Errorf("db.CountOne ParentID = %s, want the root %s", got, root.SpanID())
+	}
+	if exp.byName(t, "db.query").Err() == "" {
+		t.Error("db.query Err = \"\", want the driver error")
+	}
+	if got, want := len(exp.spans), 3; got != want {
+		t.Errorf("exported %d spans, want %d (root plus two queries)", got, want)
+	}
+}
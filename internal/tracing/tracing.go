@@ -0,0 +1,307 @@
+// Package tracing records OpenTelemetry-style spans - one per HTTP request,
+// game service call and store query - and exports them to an OTLP/HTTP
+// collector, so a slow request can be followed down to the query that made
+// it slow. Tracing is off unless a [Tracer] is wired in: without one every
+// call here is a no-op.
+//
+// Spans travel on the context. The HTTP [Tracer.Middleware] opens the root
+// span of a request; [Start] opens a child of whatever span the context
+// carries, and does nothing when it carries none, so the service and store
+// layers need no tracer of their own. Finished spans are queued in memory
+// and exported in batches by [Tracer.Run]; when the queue is full a span is
+// dropped and counted rather than slowing the request down.
+//
+// The tracer and exporter are written here rather than taken from the
+// OpenTelemetry Go SDK on purpose. The app needs spans with a parent,
+// attributes and an error status, exported as OTLP/JSON over HTTP; that is a
+// few hundred lines, where the SDK and its otlptracehttp exporter bring in
+// gRPC and protobuf. The wire format is the part that has to match, so the
+// exporter's tests decode what it sends with the OpenTelemetry collector's
+// own OTLP/JSON decoder. If sampling, metrics or other SDK features are ever
+// needed, the SDK can replace the internals behind [Start] and [Tracer]
+// without touching the callers.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	queueSize = 2048
+	batchSize = 256
+)
+
+// Kind is the OTLP span kind: what role the span plays in its trace.
+type Kind int
+
+// Span kinds, numbered as in the OTLP SpanKind enum.
+const (
+	KindInternal Kind = 1
+	KindServer   Kind = 2
+	KindClient   Kind = 3
+)
+
+// TraceID identifies a trace; every span of one request shares it.
+type TraceID [16]byte
+
+// String returns the lowercase hex form used on the wire.
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+// SpanID identifies a span within its trace.
+type SpanID [8]byte
+
+// String returns the lowercase hex form used on the wire.
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+// Attr is one span attribute. Value is a string, int64 or bool; build it
+// with [String], [Int64] or [Bool].
+type Attr struct {
+	Key   string
+	Value any
+}
+
+// String returns a string attribute.
+func String(key, value string) Attr { return Attr{Key: key, Value: value} }
+
+// Int64 returns an integer attribute.
+func Int64(key string, value int64) Attr { return Attr{Key: key, Value: value} }
+
+// Bool returns a boolean attribute.
+func Bool(key string, value bool) Attr { return Attr{Key: key, Value: value} }
+
+// Span is one timed operation. A nil *Span is valid and ignores every call,
+// which is what [Start] hands out when tracing is off. A span is used by
+// the goroutine that started it; End may be called once.
+type Span struct {
+	tracer   *Tracer
+	traceID  TraceID
+	spanID   SpanID
+	parentID SpanID
+	name     string
+	kind     Kind
+	start    time.Time
+	end      time.Time
+	attrs    []Attr
+	errMsg   string
+	failed   bool
+	ended    atomic.Bool
+}
+
+// SetAttributes adds attributes to the span.
+func (s *Span) SetAttributes(attrs ...Attr) {
+	if s == nil {
+		return
+	}
+	s.attrs = append(s.attrs, attrs...)
+}
+
+// SetName renames the span, for a name only known once the work is done
+// (the matched route of a request).
+func (s *Span) SetName(name string) {
+	if s == nil {
+		return
+	}
+	s.name = name
+}
+
+// RecordError marks the span failed with err's message. A nil err is
+// ignored, so callers can pass their return value unconditionally.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.failed = true
+	s.errMsg = err.Error()
+}
+
+// End stamps the span's end time and queues it for export. Calls after the
+// first are ignored.
+func (s *Span) End() {
+	if s == nil || !s.ended.CompareAndSwap(false, true) {
+		return
+	}
+	s.end = time.Now()
+	s.tracer.enqueue(s)
+}
+
+// Name returns the span's name.
+func (s *Span) Name() string { return s.name }
+
+// TraceID returns the ID of the trace the span belongs to.
+func (s *Span) TraceID() TraceID { return s.traceID }
+
+// SpanID returns the span's own ID.
+func (s *Span) SpanID() SpanID { return s.spanID }
+
+// ParentID returns the parent span's ID, zero for a trace's root span.
+func (s *Span) ParentID() SpanID { return s.parentID }
+
+// Attributes returns the span's attributes.
+func (s *Span) Attributes() []Attr { return s.attrs }
+
+// Err returns the recorded error message, or "" when the span did not fail.
+func (s *Span) Err() string { return s.errMsg }
+
+// spanCtxKey carries the current *Span; remoteCtxKey a parent propagated
+// from another process, which has IDs but no local span.
+type (
+	spanCtxKey   struct{}
+	remoteCtxKey struct{}
+)
+
+// remoteParent is the trace and span ID of a caller's span, read from an
+// incoming traceparent header.
+type remoteParent struct {
+	traceID TraceID
+	spanID  SpanID
+}
+
+// FromContext returns the span ctx carries, or nil.
+func FromContext(ctx context.Context) *Span {
+	s, _ := ctx.Value(spanCtxKey{}).(*Span)
+
+	return s
+}
+
+// Start opens a child of the span ctx carries and returns a context
+// carrying the child. When ctx carries no span - tracing is off, or the
+// work runs outside a traced request - it returns ctx and a nil span, so
+// the caller's deferred End is a no-op.
+func Start(ctx context.Context, name string, attrs ...Attr) (context.Context, *Span) {
+	return start(ctx, name, KindInternal, attrs)
+}
+
+// start is [Start] with an explicit kind, for the HTTP and SQL wrappers.
+func start(ctx context.Context, name string, kind Kind, attrs []Attr) (context.Context, *Span) {
+	parent := FromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+
+	return parent.tracer.start(ctx, name, kind, attrs)
+}
+
+// Exporter delivers a batch of finished spans. Export is only ever called
+// from the [Tracer]'s run loop, so implementations need not be safe for
+// concurrent use.
+type Exporter interface {
+	Export(ctx context.Context, spans []*Span) error
+}
+
+// Tracer opens root spans and exports finished ones through its
+// [Exporter] from [Tracer.Run]. A nil *Tracer is valid: its middleware
+// passes requests straight through.
+type Tracer struct {
+	exporter Exporter
+	service  string
+	logger   *slog.Logger
+	queue    chan *Span
+	dropped  atomic.Int64
+}
+
+// NewTracer returns a tracer whose spans are reported under serviceName and
+// delivered to exporter. Nothing is exported until [Tracer.Run] is started.
+func NewTracer(exporter Exporter, serviceName string, logger *slog.Logger) *Tracer {
+	return &Tracer{
+		exporter: exporter,
+		service:  serviceName,
+		logger:   logger,
+		queue:    make(chan *Span, queueSize),
+	}
+}
+
+// ServiceName returns the service.name the tracer reports its spans under.
+func (t *Tracer) ServiceName() string { return t.service }
+
+// start opens a span under the span or remote parent ctx carries, or as the
+// root of a new trace.
+func (t *Tracer) start(ctx context.Context, name string, kind Kind, attrs []Attr) (context.Context, *Span) {
+	s := &Span{tracer: t, name: name, kind: kind, start: time.Now(), attrs: attrs}
+	switch parent := FromContext(ctx); {
+	case parent != nil:
+		s.traceID, s.parentID = parent.traceID, parent.spanID
+	default:
+		if remote, ok := ctx.Value(remoteCtxKey{}).(remoteParent); ok {
+			s.traceID, s.parentID = remote.traceID, remote.spanID
+		} else {
+			_, _ = rand.Read(s.traceID[:])
+		}
+	}
+	_, _ = rand.Read(s.spanID[:])
+
+	return context.WithValue(ctx, spanCtxKey{}, s), s
+}
+
+// enqueue queues a finished span without blocking. When the queue is full
+// the span is dropped; [Tracer.Dropped] counts them.
+func (t *Tracer) enqueue(s *Span) {
+	select {
+	case t.queue <- s:
+	default:
+		t.dropped.Add(1)
+	}
+}
+
+// Dropped reports how many spans were dropped because the queue was full.
+func (t *Tracer) Dropped() int64 {
+	return t.dropped.Load()
+}
+
+// Run exports queued spans until ctx is done, then flushes whatever is
+// still queued and returns. A failed export is logged and the batch
+// discarded: tracing is best-effort and must not back up into requests.
+func (t *Tracer) Run(ctx context.Context) {
+	for {
+		select {
+		case s := <-t.queue:
+			t.export(ctx, t.batch(s))
+		case <-ctx.Done():
+			t.flush()
+
+			return
+		}
+	}
+}
+
+// batch collects first plus whatever else is already queued, up to
+// batchSize.
+func (t *Tracer) batch(first *Span) []*Span {
+	spans := []*Span{first}
+	for len(spans) < batchSize {
+		select {
+		case s := <-t.queue:
+			spans = append(spans, s)
+		default:
+			return spans
+		}
+	}
+
+	return spans
+}
+
+// flush exports what is left in the queue after shutdown began, on a fresh
+// bounded context since the run context is already cancelled.
+func (t *Tracer) flush() {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	for {
+		select {
+		case s := <-t.queue:
+			t.export(ctx, t.batch(s))
+		default:
+			return
+		}
+	}
+}
+
+// export delivers one batch, logging a failure.
+func (t *Tracer) export(ctx context.Context, spans []*Span) {
+	if err := t.exporter.Export(ctx, spans); err != nil {
+		t.logger.WarnContext(ctx, "trace export failed", slog.Int("spans", len(spans)), slog.Any("err", err))
+	}
+}
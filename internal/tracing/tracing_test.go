@@ -0,0 +1,123 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	. "github.com/starquake/topbanana/internal/tracing"
+)
+
+// recordingExporter keeps every exported span.
+type recordingExporter struct {
+	mu    sync.Mutex
+	spans []*Span
+}
+
+func (e *recordingExporter) Export(_ context.Context, spans []*Span) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, spans...)
+
+	return nil
+}
+
+// byName returns the exported span called name, failing the test when there
+// is none.
+func (e *recordingExporter) byName(t *testing.T, name string) *Span {
+	t.Helper()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, s := range e.spans {
+		if s.Name() == name {
+			return s
+		}
+	}
+	t.Fatalf("no exported span named %q", name)
+
+	return nil
+}
+
+// newTracer returns a tracer recording into a fresh exporter.
+func newTracer() (*Tracer, *recordingExporter) {
+	exp := &recordingExporter{}
+
+	return NewTracer(exp, "topbanana", slog.New(slog.DiscardHandler)), exp
+}
+
+// flush runs the tracer on an already-cancelled context, which exports
+// everything queued and returns.
+func flush(t *testing.T, tracer *Tracer) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	tracer.Run(ctx)
+}
+
+func TestStart_UntracedContextIsNoop(t *testing.T) {
+	t.Parallel()
+
+	ctx, span := Start(t.Context(), "game.GetNext")
+	if span != nil {
+		t.Fatalf("Start span = %v, want nil on an untraced context", span)
+	}
+	if ctx != t.Context() {
+		t.Error("Start returned a new context, want the caller's")
+	}
+	// A nil span ignores every call.
+	span.SetAttributes(String("game.id", "g1"))
+	span.RecordError(errors.New("boom"))
+	span.End()
+}
+
+// TestStart_ChildSharesTrace pins that a span started under another joins its
+// trace with the parent's ID, and that both reach the exporter.
+func TestStart_ChildSharesTrace(t *testing.T) {
+	t.Parallel()
+
+	tracer, exp := newTracer()
+	ctx, root := tracer.ExportStartRoot(t.Context(), "GET /api/games/{gameID}")
+	_, child := Start(ctx, "game.GetNext", String("game.id", "g1"))
+	child.RecordError(errors.New("game not found"))
+	child.End()
+	root.End()
+	flush(t, tracer)
+
+	got := exp.byName(t, "game.GetNext")
+	if got.TraceID() != root.TraceID() {
+		t.Errorf("child TraceID = %s, want the root's %s", got.TraceID(), root.TraceID())
+	}
+	if got.ParentID() != root.SpanID() {
+		t.Errorf("child ParentID = %s, want the root's SpanID %s", got.ParentID(), root.SpanID())
+	}
+	if got, want := got.Err(), "game not found"; got != want {
+		t.Errorf("child Err = %q, want %q", got, want)
+	}
+	if got := exp.byName(t, "GET /api/games/{gameID}").ParentID(); got != (SpanID{}) {
+		t.Errorf("root ParentID = %s, want zero", got)
+	}
+}
+
+// TestTracer_DropsWhenFull pins that ending a span never blocks: with
+// nothing draining the queue, spans past its capacity are dropped and
+// counted.
+func TestTracer_DropsWhenFull(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := newTracer()
+	ctx, root := tracer.ExportStartRoot(t.Context(), "root")
+	const extra = 5
+	for range 2048 + extra - 1 {
+		_, span := Start(ctx, "db.GetGame")
+		span.End()
+	}
+	root.End()
+	root.End()
+	if got, want := tracer.Dropped(), int64(extra); got != want {
+		t.Errorf("Dropped = %d, want %d", got, want)
+	}
+}
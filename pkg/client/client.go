@@ -0,0 +1,469 @@
+// Package client is a small Go client for the Top Banana! player API
+// (/api/*). The request and response types are the ones the server's
+// handlers encode and decode, so a client built against this package stays
+// in step with the wire format.
+//
+// The API identifies a player by session cookie: the first request mints an
+// anonymous player and sets the cookie, so a Client must keep a cookie jar
+// for its requests to be attributed to the same player.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxErrorBodySize bounds how much of an error response is kept on an
+// [APIError].
+const maxErrorBodySize = 4 * 1024
+
+// ErrUnexpectedItem is returned by [Client.NextQuestion] for a next-item
+// response whose type or phase this client does not know.
+var ErrUnexpectedItem = errors.New("unexpected next item")
+
+// envelopeHeader opts a request into the enveloped response shape, which
+// the server otherwise only sends once its legacy-shapes flag is off.
+const envelopeHeader = "X-Api-Envelope"
+
+// APIError is returned for any non-2xx response. Code is the envelope's
+// camelCase error code (e.g. "notFound"); it is empty for a plain-text error
+// from a layer in front of the API handlers, whose trimmed body becomes
+// Message. The API keeps messages short and free of internals.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("api: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Client calls the player API of one server as one player.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// New returns a Client for the server at baseURL (e.g.
+// "https://quiz.example.com"). A nil httpClient gets a fresh [http.Client]
+// with its own cookie jar, i.e. a new anonymous player; pass a client to
+// reuse an existing session or to set timeouts.
+func New(baseURL string, httpClient *http.Client) (*Client, error) {
+	if httpClient == nil {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, fmt.Errorf("create cookie jar: %w", err)
+		}
+		httpClient = &http.Client{Jar: jar}
+	}
+
+	return &Client{baseURL: strings.TrimRight(baseURL, "/"), httpClient: httpClient}, nil
+}
+
+// ListQuizzes returns the public quizzes.
+func (c *Client) ListQuizzes(ctx context.Context) ([]Quiz, error) {
+	var quizzes []Quiz
+	if err := c.do(ctx, http.MethodGet, "/api/quizzes", nil, &quizzes); err != nil {
+		return nil, err
+	}
+
+	return quizzes, nil
+}
+
+// ListQuizzesPage returns one page of the public quizzes along with the list
+// meta, whose Total lets a caller work out how many pages there are. The
+// server caps pageSize; the meta reports the size it used.
+func (c *Client) ListQuizzesPage(ctx context.Context, page, pageSize int) ([]Quiz, ListMeta, error) {
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+	q.Set("pageSize", strconv.Itoa(pageSize))
+
+	var (
+		quizzes []Quiz
+		meta    ListMeta
+	)
+	if err := c.doMeta(ctx, http.MethodGet, "/api/quizzes?"+q.Encode(), nil, &quizzes, &meta); err != nil {
+		return nil, ListMeta{}, err
+	}
+
+	return quizzes, meta, nil
+}
+
+// SearchQuizzes returns one page of the public quizzes whose title,
+// description or slug match every word of query, best match first, along with
+// the list meta. Total counts the matches, not the whole list.
+func (c *Client) SearchQuizzes(ctx context.Context, query string, page, pageSize int) ([]Quiz, ListMeta, error) {
+	q := url.Values{}
+	q.Set("q", query)
+	q.Set("page", strconv.Itoa(page))
+	q.Set("pageSize", strconv.Itoa(pageSize))
+
+	var (
+		quizzes []Quiz
+		meta    ListMeta
+	)
+	if err := c.doMeta(ctx, http.MethodGet, "/api/quizzes?"+q.Encode(), nil, &quizzes, &meta); err != nil {
+		return nil, ListMeta{}, err
+	}
+
+	return quizzes, meta, nil
+}
+
+// GameHistory returns one page of the player's past games, newest first,
+// along with the list meta, whose Total counts all of them.
+func (c *Client) GameHistory(ctx context.Context, page, pageSize int) ([]PlayedGame, ListMeta, error) {
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(page))
+	q.Set("pageSize", strconv.Itoa(pageSize))
+
+	var (
+		games []PlayedGame
+		meta  ListMeta
+	)
+	if err := c.doMeta(ctx, http.MethodGet, "/api/players/me/games?"+q.Encode(), nil, &games, &meta); err != nil {
+		return nil, ListMeta{}, err
+	}
+
+	return games, meta, nil
+}
+
+// Sessions lists the devices the player is signed in on, most recently
+// used first.
+func (c *Client) Sessions(ctx context.Context) ([]PlayerSession, error) {
+	var sessions []PlayerSession
+	if err := c.do(ctx, http.MethodGet, "/api/players/me/sessions", nil, &sessions); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// CreateGame starts a solo game on quizID and returns its id. A 409
+// [APIError] means the player already has a game for the quiz.
+func (c *Client) CreateGame(ctx context.Context, quizID int64) (string, error) {
+	var res CreateGameResponse
+	if err := c.do(ctx, http.MethodPost, "/api/games", CreateGameRequest{QuizID: quizID}, &res); err != nil {
+		return "", err
+	}
+
+	return res.ID, nil
+}
+
+// NextQuestion issues the game's next item: a question or a round boundary.
+// A 404 [APIError] means the game does not exist for this player or every
+// question has been issued; a 410 means the game was finished or abandoned.
+func (c *Client) NextQuestion(ctx context.Context, gameID string) (*NextItem, error) {
+	var raw json.RawMessage
+	if err := c.do(ctx, http.MethodGet, "/api/games/"+url.PathEscape(gameID)+"/questions/next", nil, &raw); err != nil {
+		return nil, err
+	}
+
+	return decodeNextItem(raw)
+}
+
+// SubmitAnswer answers questionID with req's optionID, its OptionIDs for a
+// multi-select question, or its NumericValue for a numeric question. A 400 [APIError] means the answer does not fit the
+// question; a 409 means the question was already answered or its window has
+// closed; a 410 means the game was finished or abandoned.
+func (c *Client) SubmitAnswer(
+	ctx context.Context, gameID string, questionID int64, req AnswerRequest,
+) (*AnswerResponse, error) {
+	path := "/api/games/" + url.PathEscape(gameID) +
+		"/questions/" + strconv.FormatInt(questionID, 10) + "/answers"
+	var res AnswerResponse
+	if err := c.do(ctx, http.MethodPost, path, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// SubmitAnswerPrefetch is [Client.SubmitAnswer] with ?prefetch=true: the
+// server issues the player's next item in the same round-trip and it is
+// returned decoded, as [Client.NextQuestion] would. A nil item means there
+// was nothing to prefetch; call NextQuestion to learn why (404 when the quiz
+// is exhausted, 410 when the game is finished). The next question's clock
+// starts as soon as this returns.
+func (c *Client) SubmitAnswerPrefetch(
+	ctx context.Context, gameID string, questionID int64, req AnswerRequest,
+) (*AnswerResponse, *NextItem, error) {
+	path := "/api/games/" + url.PathEscape(gameID) +
+		"/questions/" + strconv.FormatInt(questionID, 10) + "/answers?prefetch=true"
+	var res AnswerResponse
+	if err := c.do(ctx, http.MethodPost, path, req, &res); err != nil {
+		return nil, nil, err
+	}
+	if len(res.Next) == 0 {
+		return &res, nil, nil
+	}
+	item, err := decodeNextItem(res.Next)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &res, item, nil
+}
+
+// PlaceWager locks in a confidence stake of 1 to 3 on questionID before it is
+// answered. A 400 [APIError] means the stake is out of range; a 409 means the
+// quiz does not use the wager, a stake was already placed, or the question was
+// already answered or its window has closed.
+func (c *Client) PlaceWager(ctx context.Context, gameID string, questionID int64, wager int) (*WagerResponse, error) {
+	path := "/api/games/" + url.PathEscape(gameID) +
+		"/questions/" + strconv.FormatInt(questionID, 10) + "/wager"
+	var res WagerResponse
+	if err := c.do(ctx, http.MethodPost, path, WagerRequest{Wager: wager}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// PauseOnce spends the player's one pause of the game on questionID, holding
+// their timer for a short extension after a connection drop. A 409 [APIError]
+// means the pause was already used in this game, or the question was already
+// answered or its window has closed.
+func (c *Client) PauseOnce(ctx context.Context, gameID string, questionID int64) (*PauseResponse, error) {
+	path := "/api/games/" + url.PathEscape(gameID) +
+		"/questions/" + strconv.FormatInt(questionID, 10) + "/pause-once"
+	var res PauseResponse
+	if err := c.do(ctx, http.MethodPost, path, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UseHint fetches questionID's hint, costing a share of the answer's points.
+// Asking again returns the same hint at no extra cost. A 409 [APIError] means
+// the question has no hint, or it was already answered or its window has
+// closed.
+func (c *Client) UseHint(ctx context.Context, gameID string, questionID int64) (*HintResponse, error) {
+	path := "/api/games/" + url.PathEscape(gameID) +
+		"/questions/" + strconv.FormatInt(questionID, 10) + "/hint"
+	var res HintResponse
+	if err := c.do(ctx, http.MethodPost, path, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// FinishGame ends the game: finished when every question was played,
+// abandoned otherwise. Finishing twice is harmless.
+func (c *Client) FinishGame(ctx context.Context, gameID string) (*FinishGameResponse, error) {
+	var res FinishGameResponse
+	if err := c.do(ctx, http.MethodPost, "/api/games/"+url.PathEscape(gameID)+"/finish", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Results returns the game's scores.
+func (c *Client) Results(ctx context.Context, gameID string) (*Results, error) {
+	var res Results
+	if err := c.do(ctx, http.MethodGet, "/api/games/"+url.PathEscape(gameID)+"/results", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// RevealScores reveals the held-back scores of a game of a host-paced quiz.
+// Only the quiz's owner or an Admin may; anyone else gets a 404 [APIError].
+// A 409 means the quiz is not host-paced.
+func (c *Client) RevealScores(ctx context.Context, gameID string) (*RevealResponse, error) {
+	var res RevealResponse
+	if err := c.do(ctx, http.MethodPost, "/api/games/"+url.PathEscape(gameID)+"/reveal", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CompareResults returns the player's score in the game against the quiz's
+// average and the player's percentile among everyone who completed it.
+func (c *Client) CompareResults(ctx context.Context, gameID string) (*ResultsComparison, error) {
+	var res ResultsComparison
+	if err := c.do(ctx, http.MethodGet, "/api/games/"+url.PathEscape(gameID)+"/results/compare", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// PublicResults returns the anonymized standings of a finished game whose
+// host made its results public. It needs no player. A 404 [APIError] means
+// the game does not exist, is not finished, or is not public.
+func (c *Client) PublicResults(ctx context.Context, gameID string) (*PublicResults, error) {
+	var res PublicResults
+	if err := c.do(ctx, http.MethodGet, "/api/games/"+url.PathEscape(gameID)+"/results/public", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// JoinTournament joins the tournament with the given join code. Joining
+// twice is harmless. A 404 [APIError] means no tournament uses the code.
+func (c *Client) JoinTournament(ctx context.Context, joinCode string) (*Tournament, error) {
+	var res Tournament
+	if err := c.do(ctx, http.MethodPost, "/api/tournaments/"+url.PathEscape(joinCode)+"/join", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// TournamentStandings returns the cumulative standings of the tournament
+// with the given join code.
+func (c *Client) TournamentStandings(ctx context.Context, joinCode string) (*TournamentStandings, error) {
+	var res TournamentStandings
+	if err := c.do(
+		ctx, http.MethodGet, "/api/tournaments/"+url.PathEscape(joinCode)+"/standings", nil, &res,
+	); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Branding returns the deployment branding.
+func (c *Client) Branding(ctx context.Context) (*Branding, error) {
+	var res Branding
+	if err := c.do(ctx, http.MethodGet, "/api/branding", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Limits returns the caller's standing against each rate limiter.
+func (c *Client) Limits(ctx context.Context) (*Limits, error) {
+	var res Limits
+	if err := c.do(ctx, http.MethodGet, "/api/limits", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// decodeNextItem picks the variant named by the response's type and phase.
+func decodeNextItem(raw json.RawMessage) (*NextItem, error) {
+	var tag struct {
+		Type  string `json:"type"`
+		Phase string `json:"phase"`
+	}
+	if err := json.Unmarshal(raw, &tag); err != nil {
+		return nil, fmt.Errorf("decode next item: %w", err)
+	}
+
+	item := &NextItem{Type: tag.Type, Phase: tag.Phase}
+	var target any
+	switch {
+	case tag.Type == ItemTypeQuestion:
+		item.Question = &Question{}
+		target = item.Question
+	case tag.Type == ItemTypeRoundBoundary && tag.Phase == RoundPhaseIntro:
+		item.RoundIntro = &RoundIntro{}
+		target = item.RoundIntro
+	case tag.Type == ItemTypeRoundBoundary && tag.Phase == RoundPhaseResults:
+		item.RoundResults = &RoundResults{}
+		target = item.RoundResults
+	default:
+		return nil, fmt.Errorf("%w: type %q phase %q", ErrUnexpectedItem, tag.Type, tag.Phase)
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return nil, fmt.Errorf("decode %s item: %w", tag.Type, err)
+	}
+
+	return item, nil
+}
+
+// do sends a JSON request (body may be nil) and decodes a 2xx response into
+// out, turning anything else into an [APIError].
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	return c.doMeta(ctx, method, path, body, out, nil)
+}
+
+// doMeta is [Client.do] that also decodes the envelope meta into meta when
+// meta is non-nil and the response carries one.
+func (c *Client) doMeta(ctx context.Context, method, path string, body, out, meta any) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(envelopeHeader, "1")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+		return newAPIError(resp.StatusCode, msg)
+	}
+	var env envelope
+	if err = json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return fmt.Errorf("decode %s %s response: %w", method, path, err)
+	}
+	if err = json.Unmarshal(env.Data, out); err != nil {
+		return fmt.Errorf("decode %s %s response data: %w", method, path, err)
+	}
+	if meta != nil && len(env.Meta) > 0 {
+		if err = json.Unmarshal(env.Meta, meta); err != nil {
+			return fmt.Errorf("decode %s %s response meta: %w", method, path, err)
+		}
+	}
+
+	return nil
+}
+
+// envelope is the wire shape of every API response; Data is decoded into the
+// caller's type once the envelope has been read.
+type envelope struct {
+	Data  json.RawMessage `json:"data"`
+	Meta  json.RawMessage `json:"meta"`
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// newAPIError builds the error for a non-2xx response from its body: the
+// envelope's error when there is one, else the body as plain text.
+func newAPIError(statusCode int, body []byte) *APIError {
+	var env envelope
+	if err := json.Unmarshal(body, &env); err == nil && env.Error != nil {
+		return &APIError{StatusCode: statusCode, Code: env.Error.Code, Message: env.Error.Message}
+	}
+
+	return &APIError{StatusCode: statusCode, Message: strings.TrimSpace(string(body))}
+}
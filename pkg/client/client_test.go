@@ -0,0 +1,179 @@
+package client_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/starquake/topbanana/pkg/client"
+)
+
+// newServer serves body with status for every request and returns a Client
+// pointed at it.
+func newServer(t *testing.T, status int, body string) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(srv.URL+"/", nil)
+	if err != nil {
+		t.Fatalf("New err = %v, want nil", err)
+	}
+
+	return c
+}
+
+func TestClient_NextQuestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("question", func(t *testing.T) {
+		t.Parallel()
+
+		c := newServer(t, http.StatusOK, `{"data":{"type":"question","id":7,"text":"Q","options":[{"id":1,"text":"A"}]}}`)
+		item, err := c.NextQuestion(t.Context(), "g1")
+		if err != nil {
+			t.Fatalf("NextQuestion err = %v, want nil", err)
+		}
+		if item.Question == nil || item.RoundIntro != nil || item.RoundResults != nil {
+			t.Fatalf("item = %+v, want only Question set", item)
+		}
+		if got, want := item.Question.ID, int64(7); got != want {
+			t.Errorf("Question.ID = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("round intro", func(t *testing.T) {
+		t.Parallel()
+
+		c := newServer(t, http.StatusOK, `{"data":{"type":"round_boundary","phase":"intro","id":3,"title":"R1"}}`)
+		item, err := c.NextQuestion(t.Context(), "g1")
+		if err != nil {
+			t.Fatalf("NextQuestion err = %v, want nil", err)
+		}
+		if item.RoundIntro == nil {
+			t.Fatalf("item = %+v, want RoundIntro set", item)
+		}
+		if got, want := item.RoundIntro.Title, "R1"; got != want {
+			t.Errorf("RoundIntro.Title = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("round results", func(t *testing.T) {
+		t.Parallel()
+
+		c := newServer(t, http.StatusOK, `{"data":{"type":"round_boundary","phase":"results","id":3,"roundScore":250}}`)
+		item, err := c.NextQuestion(t.Context(), "g1")
+		if err != nil {
+			t.Fatalf("NextQuestion err = %v, want nil", err)
+		}
+		if item.RoundResults == nil {
+			t.Fatalf("item = %+v, want RoundResults set", item)
+		}
+		if got, want := item.RoundResults.RoundScore, 250; got != want {
+			t.Errorf("RoundResults.RoundScore = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		t.Parallel()
+
+		c := newServer(t, http.StatusOK, `{"data":{"type":"bonus"}}`)
+		if _, err := c.NextQuestion(t.Context(), "g1"); !errors.Is(err, ErrUnexpectedItem) {
+			t.Errorf("NextQuestion err = %v, want ErrUnexpectedItem", err)
+		}
+	})
+}
+
+func TestClient_SubmitAnswerPrefetch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("next item", func(t *testing.T) {
+		t.Parallel()
+
+		c := newServer(t, http.StatusOK,
+			`{"data":{"correct":true,"score":900,"correctOptionIds":[1],"next":{"type":"question","id":8,"text":"Q2"}}}`)
+		res, item, err := c.SubmitAnswerPrefetch(t.Context(), "g1", 7, AnswerRequest{OptionID: 1})
+		if err != nil {
+			t.Fatalf("SubmitAnswerPrefetch err = %v, want nil", err)
+		}
+		if !res.Correct {
+			t.Error("Correct = false, want true")
+		}
+		if item == nil || item.Question == nil {
+			t.Fatalf("item = %+v, want a Question", item)
+		}
+		if got, want := item.Question.ID, int64(8); got != want {
+			t.Errorf("Question.ID = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("nothing left", func(t *testing.T) {
+		t.Parallel()
+
+		c := newServer(t, http.StatusOK, `{"data":{"correct":false,"score":0,"correctOptionIds":[2]}}`)
+		_, item, err := c.SubmitAnswerPrefetch(t.Context(), "g1", 7, AnswerRequest{OptionID: 1})
+		if err != nil {
+			t.Fatalf("SubmitAnswerPrefetch err = %v, want nil", err)
+		}
+		if item != nil {
+			t.Errorf("item = %+v, want nil", item)
+		}
+	})
+}
+
+func TestClient_APIError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode string
+	}{
+		{"envelope error", `{"error":{"code":"conflict","message":"question already answered"}}`, "conflict"},
+		{"plain-text error", "question already answered\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newServer(t, http.StatusConflict, tt.body)
+			_, err := c.SubmitAnswer(t.Context(), "g1", 7, AnswerRequest{OptionID: 1})
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("SubmitAnswer err = %v, want *APIError", err)
+			}
+			if got, want := apiErr.StatusCode, http.StatusConflict; got != want {
+				t.Errorf("StatusCode = %d, want %d", got, want)
+			}
+			if got, want := apiErr.Code, tt.wantCode; got != want {
+				t.Errorf("Code = %q, want %q", got, want)
+			}
+			if got, want := apiErr.Message, "question already answered"; got != want {
+				t.Errorf("Message = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestClient_ListQuizzesPage(t *testing.T) {
+	t.Parallel()
+
+	c := newServer(t, http.StatusOK,
+		`{"data":[{"id":3,"title":"Q3"}],"meta":{"count":1,"total":21,"page":2,"pageSize":20}}`)
+	quizzes, meta, err := c.ListQuizzesPage(t.Context(), 2, 20)
+	if err != nil {
+		t.Fatalf("ListQuizzesPage err = %v, want nil", err)
+	}
+	if got, want := len(quizzes), 1; got != want {
+		t.Fatalf("len(quizzes) = %d, want %d", got, want)
+	}
+	if got, want := meta, (ListMeta{Count: 1, Total: 21, Page: 2, PageSize: 20}); got != want {
+		t.Errorf("meta = %+v, want %+v", got, want)
+	}
+}
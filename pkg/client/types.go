@@ -0,0 +1,439 @@
+package client
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Item types and round phases of the GET /api/games/{gameID}/questions/next
+// tagged union.
+const (
+	ItemTypeQuestion      = "question"
+	ItemTypeRoundBoundary = "round_boundary"
+	RoundPhaseIntro       = "intro"
+	RoundPhaseResults     = "results"
+)
+
+// Quiz is one entry of the GET /api/quizzes list. Only public quizzes are
+// listed.
+type Quiz struct {
+	ID          int64     `json:"id"`
+	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// ListMeta is the envelope meta of a list response. Count is the number of
+// items in this response; Total, Page and PageSize are set only on a paged
+// request (GET /api/quizzes?page=&pageSize=), Total being the row count
+// across every page.
+type ListMeta struct {
+	Count    int   `json:"count"`
+	Total    int64 `json:"total,omitempty"`
+	Page     int   `json:"page,omitempty"`
+	PageSize int   `json:"pageSize,omitempty"`
+}
+
+// CreateGameRequest is the POST /api/games body.
+type CreateGameRequest struct {
+	QuizID int64 `json:"quizId"`
+	// Preview requests an owner preview game that stays off the
+	// leaderboard; only the quiz's creator or an admin may ask for one.
+	Preview bool `json:"preview,omitempty"`
+}
+
+// CreateGameResponse is the POST /api/games response. A 409 (the caller
+// already has a game for the quiz) carries the existing game's ID in the same
+// shape, so a retried or raced create can resume it.
+type CreateGameResponse struct {
+	ID string `json:"id"`
+}
+
+// Option is one answer option on a question. The order is shuffled per game
+// and stable across reloads.
+type Option struct {
+	ID   int64  `json:"id"`
+	Text string `json:"text"`
+}
+
+// Question is the type=question variant of the next-item response.
+// Position/Total place the question in the quiz; the Round* fields place it
+// in its round. ServerNow lets a client correct for clock offset against
+// StartedAt/ExpiredAt. Kind is "choice", "multi", "truefalse" or "numeric".
+// A multi question is answered with AnswerRequest.OptionIDs, any number of
+// its options; a numeric question has no Options, since its only option is
+// the answer key, and is answered with AnswerRequest.NumericValue.
+// ConfidenceWager is set on a quiz that asks for a stake before the options;
+// Wager is the stake already locked in, 0 until one is placed. HasHint is set
+// when the question offers a hint, fetched with the hint endpoint at a cost.
+type Question struct {
+	Type            string    `json:"type"`
+	ID              int64     `json:"id"`
+	Kind            string    `json:"kind"`
+	Text            string    `json:"text"`
+	ImageURL        string    `json:"imageUrl,omitempty"`
+	AudioURL        string    `json:"audioUrl,omitempty"`
+	AudioRepeat     bool      `json:"audioRepeat,omitempty"`
+	Options         []Option  `json:"options"`
+	StartedAt       time.Time `json:"startedAt"`
+	ExpiredAt       time.Time `json:"expiredAt"`
+	ServerNow       time.Time `json:"serverNow"`
+	Position        int       `json:"position"`
+	Total           int       `json:"total"`
+	RoundNumber     int       `json:"roundNumber"`
+	RoundTotal      int       `json:"roundTotal"`
+	RoundPosition   int       `json:"roundPosition"`
+	RoundQuestions  int       `json:"roundQuestions"`
+	ConfidenceWager bool      `json:"confidenceWager,omitempty"`
+	Wager           int       `json:"wager,omitempty"`
+	HasHint         bool      `json:"hasHint,omitempty"`
+}
+
+// RoundIntro is the type=round_boundary, phase=intro variant: shown before a
+// round's first question, auto-advancing at ExpiredAt.
+type RoundIntro struct {
+	Type      string    `json:"type"`
+	Phase     string    `json:"phase"`
+	ID        int64     `json:"id"`
+	Title     string    `json:"title"`
+	Summary   string    `json:"summary"`
+	StartedAt time.Time `json:"startedAt"`
+	ExpiredAt time.Time `json:"expiredAt"`
+	ServerNow time.Time `json:"serverNow"`
+	Total     int       `json:"total"`
+}
+
+// RoundResults is the type=round_boundary, phase=results variant: the
+// player's own recap after a round. Score is the running game total,
+// RoundScore the points earned in this round, and RoundCorrect of
+// RoundQuestions the questions answered correctly in it. There is no
+// cross-player leaderboard here; the recap is self-referential only. On a
+// host-paced quiz whose host has not revealed the game's scores yet,
+// ScoresHidden is set and the three scores are zero.
+type RoundResults struct {
+	Type           string    `json:"type"`
+	Phase          string    `json:"phase"`
+	ID             int64     `json:"id"`
+	Title          string    `json:"title"`
+	Score          int       `json:"score"`
+	RoundScore     int       `json:"roundScore"`
+	RoundCorrect   int       `json:"roundCorrect"`
+	RoundQuestions int       `json:"roundQuestions"`
+	ScoresHidden   bool      `json:"scoresHidden,omitempty"`
+	StartedAt      time.Time `json:"startedAt"`
+	ExpiredAt      time.Time `json:"expiredAt"`
+	ServerNow      time.Time `json:"serverNow"`
+	Total          int       `json:"total"`
+}
+
+// NextItem is the decoded next-item response. Exactly one of Question,
+// RoundIntro, and RoundResults is set, matching Type and Phase.
+type NextItem struct {
+	Type         string
+	Phase        string
+	Question     *Question
+	RoundIntro   *RoundIntro
+	RoundResults *RoundResults
+}
+
+// AnswerRequest is the POST .../questions/{questionID}/answers body. TappedAt
+// is the client's tap time; the server clamps it to [StartedAt, now] so a
+// player on a slow link is not scored late, and a zero value means "now".
+// A numeric question is answered with NumericValue instead of OptionID, and a
+// multi-select question with OptionIDs, the full set of picked options.
+type AnswerRequest struct {
+	OptionID     int64     `json:"optionId"`
+	OptionIDs    []int64   `json:"optionIds,omitempty"`
+	NumericValue *float64  `json:"numericValue,omitempty"`
+	TappedAt     time.Time `json:"tappedAt"`
+}
+
+// AnswerResponse is the answer outcome. CorrectOptionIDs is always set so a
+// client can reveal the right answer after a wrong pick; for a numeric
+// question it is empty and CorrectValue carries the answer key instead.
+// Correct on a numeric answer means it landed within the tolerance and
+// scored something; on a multi-select answer, that its correct picks
+// outweighed its wrong ones and earned partial or full credit. Wager is the
+// confidence stake the answer was scored with, 0 on a quiz without the wager;
+// with one, Score is negative for a wrong answer. Next is the item the
+// answer was prefetched with (POST .../answers?prefetch=true), in the
+// GET .../questions/next shape; it is absent without the prefetch, and when
+// the game has nothing left to serve. ScoresHidden is set on a host-paced
+// quiz whose host has not revealed the game's scores yet: the response is
+// then a [HiddenAnswerResponse], and Correct, Score, the correct answer and
+// the explanation arrive later in the [Reveal]. Explanation is the quiz
+// master's "why this is correct" note, absent when the question has none.
+// Streak and Multiplier are set on a quiz with streak scoring: the player's
+// run of consecutive correct answers as of this one (0 after a wrong one),
+// and the multiplier that run put on Score.
+type AnswerResponse struct {
+	Correct          bool            `json:"correct"`
+	Score            int             `json:"score"`
+	CorrectOptionIDs []int64         `json:"correctOptionIds"`
+	CorrectValue     *float64        `json:"correctValue,omitempty"`
+	Explanation      string          `json:"explanation,omitempty"`
+	Wager            int             `json:"wager,omitempty"`
+	Streak           int             `json:"streak,omitempty"`
+	Multiplier       float64         `json:"multiplier,omitempty"`
+	ScoresHidden     bool            `json:"scoresHidden,omitempty"`
+	Next             json.RawMessage `json:"next,omitempty"`
+}
+
+// HiddenAnswerResponse is the answer response while a host-paced game's
+// scores are held back: the answer is recorded, but whether it was right and
+// what it scored are left out until the host reveals them.
+type HiddenAnswerResponse struct {
+	ScoresHidden bool            `json:"scoresHidden"`
+	Wager        int             `json:"wager,omitempty"`
+	Next         json.RawMessage `json:"next,omitempty"`
+}
+
+// RevealedAnswer is the outcome of one of the player's answers, as an
+// [AnswerResponse] would have reported it.
+type RevealedAnswer struct {
+	QuestionID       int64    `json:"questionId"`
+	Correct          bool     `json:"correct"`
+	Score            int      `json:"score"`
+	CorrectOptionIDs []int64  `json:"correctOptionIds"`
+	CorrectValue     *float64 `json:"correctValue,omitempty"`
+	Explanation      string   `json:"explanation,omitempty"`
+	Wager            int      `json:"wager,omitempty"`
+	Streak           int      `json:"streak,omitempty"`
+	Multiplier       float64  `json:"multiplier,omitempty"`
+}
+
+// Reveal is the "reveal" event on GET /api/games/{gameID}/events: the
+// outcomes of every answer the player gave in the game, in question order,
+// and their total. A host-paced game sends it once its host reveals the
+// scores; any other game sends it straight away.
+type Reveal struct {
+	GameID  string           `json:"gameId"`
+	Answers []RevealedAnswer `json:"answers"`
+	Score   int              `json:"score"`
+}
+
+// RevealResponse is the POST /api/games/{gameID}/reveal response.
+type RevealResponse struct {
+	GameID   string `json:"gameId"`
+	Revealed bool   `json:"revealed"`
+}
+
+// WagerRequest is the POST .../questions/{questionID}/wager body: the
+// confidence stake, 1 to 3, locked in before answering.
+type WagerRequest struct {
+	Wager int `json:"wager"`
+}
+
+// WagerResponse echoes the stake that was locked in.
+type WagerResponse struct {
+	Wager int `json:"wager"`
+}
+
+// PauseResponse is the POST .../questions/{questionID}/pause-once response:
+// the player's new answer deadline for the question, ExtensionSeconds past its
+// original ExpiredAt.
+type PauseResponse struct {
+	ExpiredAt        time.Time `json:"expiredAt"`
+	ExtensionSeconds int       `json:"extensionSeconds"`
+}
+
+// HintResponse is the POST .../questions/{questionID}/hint response: the
+// question's hint and the percentage it takes off the answer's points.
+type HintResponse struct {
+	Hint           string `json:"hint"`
+	PenaltyPercent int    `json:"penaltyPercent"`
+}
+
+// GameForQuiz is the GET /api/quizzes/{slugID}/my-game response, the resume
+// probe. Completed is true only once every question has been issued and none
+// is still in its answer window.
+type GameForQuiz struct {
+	GameID    string `json:"gameId"`
+	Completed bool   `json:"completed"`
+}
+
+// LeaderboardEntry is one row of a quiz leaderboard. InProgress marks a
+// player still mid-quiz, whose Score is a running partial total.
+type LeaderboardEntry struct {
+	PlayerID        int64  `json:"playerId"`
+	DisplayName     string `json:"displayName"`
+	Score           int    `json:"score"`
+	Rank            int    `json:"rank"`
+	IsCurrentPlayer bool   `json:"isCurrentPlayer"`
+	InProgress      bool   `json:"inProgress"`
+}
+
+// Leaderboard is the GET /api/quizzes/{slugID}/leaderboard response and the
+// payload of each leaderboard stream event. CurrentPlayer is the caller's own
+// row when they rank outside Entries.
+type Leaderboard struct {
+	QuizID        int64              `json:"quizId"`
+	Entries       []LeaderboardEntry `json:"entries"`
+	CurrentPlayer *LeaderboardEntry  `json:"currentPlayer"`
+}
+
+// PlayerScore is one row of a game's results, ordered by score descending.
+type PlayerScore struct {
+	PlayerID int64 `json:"playerId"`
+	Score    int   `json:"score"`
+}
+
+// Results is the GET /api/games/{gameID}/results response. Winner is the
+// winning player's id as a string, empty when nobody has scored.
+// VoidedQuestionIDs lists the questions a host voided for this game; their
+// answers count for nothing in PlayerScores. Always present, empty when none.
+// Status is in_progress, finished or abandoned; FinishedAt is set once the
+// game is over.
+type Results struct {
+	GameID            string        `json:"gameId"`
+	Winner            string        `json:"winner"`
+	PlayerScores      []PlayerScore `json:"playerScores"`
+	VoidedQuestionIDs []int64       `json:"voidedQuestionIds"`
+	Status            string        `json:"status"`
+	FinishedAt        *time.Time    `json:"finishedAt,omitempty"`
+}
+
+// ResultsComparison is the GET /api/games/{gameID}/results/compare response:
+// the player's score in the game next to the other players who completed the
+// quiz. AverageScore is their mean total and Percentile the whole-percentage
+// share of them who scored less ("You beat 72% of players"). ComparedPlayers
+// counts them; at 0 there is nothing to compare and the other two are 0.
+type ResultsComparison struct {
+	GameID          string  `json:"gameId"`
+	Score           int     `json:"score"`
+	AverageScore    float64 `json:"averageScore"`
+	Percentile      int     `json:"percentile"`
+	ComparedPlayers int     `json:"comparedPlayers"`
+}
+
+// PlayedGame is one entry of GET /api/players/me/games, the player's past
+// games newest first: the quiz it was a game of, when it was played, the
+// player's score in it and their rank on the quiz leaderboard. A client
+// links to the quiz at /play/{QuizSlug}-{QuizID}. ScoresHidden is set on a
+// host-paced game whose host has not revealed the scores yet; Score and
+// Rank are then absent.
+type PlayedGame struct {
+	GameID        string     `json:"gameId"`
+	QuizID        int64      `json:"quizId"`
+	QuizTitle     string     `json:"quizTitle"`
+	QuizSlug      string     `json:"quizSlug"`
+	PlayedAt      time.Time  `json:"playedAt"`
+	FinishedAt    *time.Time `json:"finishedAt,omitempty"`
+	Status        string     `json:"status"`
+	Score         int        `json:"score"`
+	Rank          int        `json:"rank,omitempty"`
+	AnsweredCount int        `json:"answeredCount"`
+	QuestionCount int        `json:"questionCount"`
+	ScoresHidden  bool       `json:"scoresHidden,omitempty"`
+}
+
+// PlayerSession is one entry of GET /api/players/me/sessions, a device the
+// player is signed in on: the browser and address it was last used from,
+// when it signed in and when it was last seen. Current marks the session
+// making the request. Timestamps are as precise as the server records them,
+// and LastSeenAt lags by up to a few minutes.
+type PlayerSession struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	Current    bool      `json:"current"`
+}
+
+// PublicStanding is one row of a game's public standings: a display name and
+// score, never a player ID.
+type PublicStanding struct {
+	Rank        int    `json:"rank"`
+	DisplayName string `json:"displayName"`
+	Score       int    `json:"score"`
+}
+
+// PublicResults is the GET /api/games/{gameID}/results/public response: the
+// anonymized standings of a finished game whose host made its results
+// public. Standings run from the highest score down.
+type PublicResults struct {
+	GameID     string           `json:"gameId"`
+	FinishedAt *time.Time       `json:"finishedAt,omitempty"`
+	Standings  []PublicStanding `json:"standings"`
+}
+
+// FinishGameResponse is the POST /api/games/{gameID}/finish response: the
+// status the game ended in (finished or abandoned) and when.
+type FinishGameResponse struct {
+	ID         string     `json:"id"`
+	Status     string     `json:"status"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// TournamentStage is one quiz of a tournament, in play order. A client links
+// to the quiz at /play/{QuizSlug}-{QuizID}.
+type TournamentStage struct {
+	Position  int    `json:"position"`
+	QuizID    int64  `json:"quizId"`
+	QuizTitle string `json:"quizTitle"`
+	QuizSlug  string `json:"quizSlug"`
+}
+
+// Tournament is the POST /api/tournaments/{code}/join response.
+type Tournament struct {
+	ID       int64             `json:"id"`
+	Title    string            `json:"title"`
+	JoinCode string            `json:"joinCode"`
+	Stages   []TournamentStage `json:"stages"`
+}
+
+// TournamentStageResult is a member's score on one stage. Played is false
+// until the member has a game on the stage's quiz.
+type TournamentStageResult struct {
+	Played bool `json:"played"`
+	Score  int  `json:"score"`
+}
+
+// TournamentStanding is one row of the tournament standings. Stages lines up
+// with [Tournament.Stages].
+type TournamentStanding struct {
+	Rank            int                     `json:"rank"`
+	PlayerID        int64                   `json:"playerId"`
+	DisplayName     string                  `json:"displayName"`
+	Total           int                     `json:"total"`
+	StagesPlayed    int                     `json:"stagesPlayed"`
+	Stages          []TournamentStageResult `json:"stages"`
+	IsCurrentPlayer bool                    `json:"isCurrentPlayer"`
+}
+
+// TournamentStandings is the GET /api/tournaments/{code}/standings response:
+// every member ranked by their total across all stages.
+type TournamentStandings struct {
+	Tournament Tournament           `json:"tournament"`
+	Entries    []TournamentStanding `json:"entries"`
+}
+
+// RateLimit is one rate limiter's standing for the caller in the GET
+// /api/limits response: how many attempts its window allows, how many are
+// left, and the whole seconds until one comes back (0 when none is held).
+// The same numbers ride on a limited endpoint's RateLimit-Limit,
+// RateLimit-Remaining, and RateLimit-Reset response headers.
+type RateLimit struct {
+	Name         string `json:"name"`
+	Limit        int    `json:"limit"`
+	Remaining    int    `json:"remaining"`
+	ResetSeconds int    `json:"resetSeconds"`
+}
+
+// Limits is the GET /api/limits response: the caller's standing against each
+// enabled limiter. Per-IP limiters (login, the email flows) key on the
+// caller's address; the upload and import budgets key on the session player.
+type Limits struct {
+	Limits []RateLimit `json:"limits"`
+}
+
+// Branding is the GET /api/branding response. Name is always set (the product
+// name when the deployment has no custom one); an empty LogoURL or
+// PrimaryColor means the stock logo or color.
+type Branding struct {
+	Name         string `json:"name"`
+	LogoURL      string `json:"logoUrl"`
+	PrimaryColor string `json:"primaryColor"`
+}
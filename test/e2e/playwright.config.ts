@@ -213,6 +213,10 @@ const workerServer = (workerIndex: number) => {
       // suite logs in repeatedly from 127.0.0.1, so the cooldown would
       // falsely trip "Too many attempts" on back-to-back same-IP logins.
       LOGIN_COOLDOWN: '0s',
+      // Honour the X-Shuffle-Seed header every browser context sends (see
+      // use.extraHTTPHeaders below), so a solo game's option buttons come
+      // out in the same order on every run instead of per fresh game id.
+      SHUFFLE_SEED_HEADER_ENABLED: 'true',
       ADMIN_EMAILS,
       // Point the mailer at the shared mailpit catch-all so the email
       // round-trip specs can read the verify and invite link back
@@ -279,6 +283,9 @@ export default defineConfig({
     // routes each worker to its own server. This fallback only matters
     // for tests that use the raw @playwright/test entrypoint.
     baseURL: `http://127.0.0.1:${WORKER_PORTS[0]}`,
+    // Pins the solo option shuffle (SHUFFLE_SEED_HEADER_ENABLED above), so
+    // a spec can rely on the option layout a run produces.
+    extraHTTPHeaders: { 'X-Shuffle-Seed': 'e2e' },
     trace: 'retain-on-failure',
     video: 'retain-on-failure',
   },
@@ -39,7 +39,7 @@ test('400 on answer POST does not show the retry banner', async ({ page, browser
   // path for ErrOptionNotInQuestion). The point is "no banner for
   // non-retryable"; subsequent POSTs go through so the game advances.
   let answersPostCount = 0;
-  await page.route(/\/api\/games\/[^/]+\/questions\/\d+\/answers$/, async (route: Route, request: Request) => {
+  await page.route(/\/api\/games\/[^/]+\/questions\/\d+\/answers(\?|$)/, async (route: Route, request: Request) => {
     if (request.method() === 'POST' && answersPostCount === 0) {
       answersPostCount++;
       await route.fulfill({ status: 400, body: 'option not in question' });
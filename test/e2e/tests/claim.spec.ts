@@ -9,10 +9,11 @@ import {
 } from './helpers';
 import { adminStatePath } from '../e2e-auth';
 
-// Petname format: Title-cased Adjective-Adjective-Noun, e.g. "Steamy-Farty-Bear".
-// EnsurePlayer middleware generates one of these for every fresh anonymous
-// visitor on the first /api/players/me round-trip.
-const PETNAME_PATTERN = /^[A-Z][a-z]+-[A-Z][a-z]+-[A-Z][a-z]+$/;
+// Generated nickname format: Title-cased "Adjective Animal", e.g. "Brave Banana",
+// derived from the player id, with the id appended when another row already
+// holds the bare nickname. EnsurePlayer middleware gives one of these to every
+// fresh anonymous visitor on the first /api/players/me round-trip.
+const PETNAME_PATTERN = /^[A-Z][a-z]+ [A-Z][a-z]+( \d+)?$/;
 
 // Test 1 — petname card visible for fresh anonymous visitor.
 test('start screen shows a Playing as card with an auto-generated petname for a fresh anonymous visitor', async ({ page }) => {
@@ -27,7 +28,7 @@ test('start screen shows a Playing as card with an auto-generated petname for a
   const card = page.locator('.claim-cta:visible');
   await expect(card).toBeVisible();
 
-  // Petname format: Adjective-Adjective-Noun, each segment Title-cased.
+  // Generated nickname format: Adjective Animal, each word Title-cased.
   const name = await card.getByTestId('claim-cta-name').textContent();
   expect(name).toMatch(PETNAME_PATTERN);
 
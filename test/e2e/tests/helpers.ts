@@ -339,7 +339,7 @@ export async function seedQuiz(
 // submitNewQuizForm fills and submits the create-quiz form, binding the wait to
 // the Save POST so it can never race the navigation, and returns that response.
 // The create handler 303-redirects to /admin/quizzes/{id} on success; a non-303
-// re-renders the form in place at /admin/quizzes (a 400 field error, a 409 slug
+// re-renders the form in place at /admin/quizzes (a 422 field error, a 409 slug
 // collision, ...), which clicking-then-asserting-the-URL would otherwise surface
 // only as an opaque toHaveURL timeout on the bare list URL (#1090).
 async function submitNewQuizForm(page: Page, title: string): Promise<Response> {
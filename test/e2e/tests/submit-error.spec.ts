@@ -32,7 +32,7 @@ test('retry banner appears when answers POST fails, and a re-click advances the
   // Scoped to POST so the in-progress GETs (countdown, next question)
   // are unaffected.
   let answersPostCount = 0;
-  await page.route(/\/api\/games\/[^/]+\/questions\/\d+\/answers$/, async (route, request) => {
+  await page.route(/\/api\/games\/[^/]+\/questions\/\d+\/answers(\?|$)/, async (route, request) => {
     if (request.method() === 'POST' && answersPostCount === 0) {
       answersPostCount++;
       await route.fulfill({ status: 500, body: 'simulated server error' });
@@ -0,0 +1,61 @@
+package integration_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestAdminAnalytics_Integration pins the analytics page gate: a Host gets a
+// 404 like the rest of the Admin-only console, an Admin gets the page for the
+// default range, and an unreadable date is a 400.
+func TestAdminAnalytics_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "analytics-boss@example.test",
+	})
+	baseURL := srv.BaseURL
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "analytics-boss")
+	host := registerAdminClient(ctx, t, baseURL, srv.DBURI, "analytics-host")
+	makeHost(ctx, t, srv.DBURI, "analytics-host")
+
+	t.Run("host gets 404", func(t *testing.T) {
+		t.Parallel()
+		resp := getWith(ctx, t, host, baseURL+"/admin/analytics")
+		defer closeBody(t, resp.Body)
+		if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+			t.Errorf("analytics status for host = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("admin sees the report", func(t *testing.T) {
+		t.Parallel()
+		resp := getWith(ctx, t, boss, baseURL+"/admin/analytics")
+		defer closeBody(t, resp.Body)
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Fatalf("analytics status for admin = %d, want %d", got, want)
+		}
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("ReadAll err = %v, want nil", err)
+		}
+		for _, want := range []string{"Hardest questions", "No games were started"} {
+			if !strings.Contains(string(b), want) {
+				t.Errorf("analytics page missing %q", want)
+			}
+		}
+	})
+
+	t.Run("unreadable date is a 400", func(t *testing.T) {
+		t.Parallel()
+		resp := getWith(ctx, t, boss, baseURL+"/admin/analytics?from=soon")
+		defer closeBody(t, resp.Body)
+		if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+			t.Errorf("analytics status for a bad date = %d, want %d", got, want)
+		}
+	})
+}
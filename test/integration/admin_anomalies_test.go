@@ -0,0 +1,53 @@
+package integration_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestAdminAnomalies_Integration pins the scoring-anomalies page gate: a Host
+// gets a 404 (the route stays hidden like the rest of the Admin-only console),
+// an Admin gets the page with a zero row for every kind.
+func TestAdminAnomalies_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "anomalies-boss@example.test",
+	})
+	baseURL := srv.BaseURL
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "anomalies-boss")
+	host := registerAdminClient(ctx, t, baseURL, srv.DBURI, "anomalies-host")
+	makeHost(ctx, t, srv.DBURI, "anomalies-host")
+
+	t.Run("host gets 404", func(t *testing.T) {
+		t.Parallel()
+		resp := getWith(ctx, t, host, baseURL+"/admin/anomalies")
+		defer closeBody(t, resp.Body)
+		if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+			t.Errorf("anomalies status for host = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("admin sees every kind", func(t *testing.T) {
+		t.Parallel()
+		resp := getWith(ctx, t, boss, baseURL+"/admin/anomalies")
+		defer closeBody(t, resp.Body)
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Fatalf("anomalies status for admin = %d, want %d", got, want)
+		}
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("ReadAll err = %v, want nil", err)
+		}
+		body := string(b)
+		for _, want := range []string{"early_answer", "fast_answer", "repeated_max_score", "No anomalies"} {
+			if !strings.Contains(body, want) {
+				t.Errorf("anomalies page missing %q", want)
+			}
+		}
+	})
+}
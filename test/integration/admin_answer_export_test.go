@@ -0,0 +1,117 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/testserver"
+)
+
+// TestAdminAnswerExport_Integration pins the JSONL answer export: a Host gets
+// a 404 like the rest of the Admin-only console, a malformed cursor is
+// rejected, a recorded solo pick streams as one JSON line carrying its
+// cursor, and resuming from that cursor returns nothing new.
+func TestAdminAnswerExport_Integration(t *testing.T) {
+	t.Parallel()
+
+	srv, stores, baseURL := testserver.Start(t, testserver.Options{Env: map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "export-boss@example.test",
+	}})
+	ctx := t.Context()
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "export-boss")
+	host := registerAdminClient(ctx, t, baseURL, srv.DBURI, "export-host")
+	makeHost(ctx, t, srv.DBURI, "export-host")
+
+	resp := getWith(ctx, t, host, baseURL+"/admin/export/answers")
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("export status for host = %d, want %d", got, want)
+	}
+
+	resp = getWith(ctx, t, boss, baseURL+"/admin/export/answers?since=bad")
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+		t.Errorf("export status for a malformed cursor = %d, want %d", got, want)
+	}
+
+	qz := seedSoloQuiz(ctx, t, stores.Quizzes, "export-solo")
+	g := &game.Game{QuizID: qz.ID}
+	if err := stores.Games.CreateGame(ctx, g); err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	if err := stores.Games.CreateParticipant(ctx, &game.Participant{
+		GameID: g.ID, PlayerID: seededAdminID, QuizID: qz.ID,
+	}); err != nil {
+		t.Fatalf("CreateParticipant err = %v, want nil", err)
+	}
+	now := time.Now()
+	gq := &game.Question{
+		GameID: g.ID, QuestionID: qz.Questions[0].ID, StartedAt: now, ExpiredAt: now.Add(10 * time.Second),
+	}
+	if err := stores.Games.CreateQuestion(ctx, gq, true); err != nil {
+		t.Fatalf("CreateQuestion err = %v, want nil", err)
+	}
+	if err := stores.Games.CreateAnswer(ctx, &game.Answer{
+		GameID:     g.ID,
+		PlayerID:   seededAdminID,
+		QuestionID: gq.ID,
+		OptionID:   qz.Questions[0].Options[0].ID,
+		AnsweredAt: now.Add(time.Second),
+	}); err != nil {
+		t.Fatalf("CreateAnswer err = %v, want nil", err)
+	}
+
+	resp = getWith(ctx, t, boss, baseURL+"/admin/export/answers")
+	body := readExportBody(t, resp)
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("export status = %d, want %d", got, want)
+	}
+	if got, want := resp.Header.Get("Content-Type"), "application/x-ndjson"; got != want {
+		t.Errorf("export Content-Type = %q, want %q", got, want)
+	}
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	if got, want := len(lines), 1; got != want {
+		t.Fatalf("export lines = %d, want %d (body %q)", got, want, body)
+	}
+	var line struct {
+		Source       string `json:"source"`
+		QuizTitle    string `json:"quizTitle"`
+		QuestionText string `json:"questionText"`
+		Correct      bool   `json:"correct"`
+		Cursor       string `json:"cursor"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &line); err != nil {
+		t.Fatalf("json.Unmarshal err = %v, want nil", err)
+	}
+	if line.Source != "solo" || line.QuizTitle != qz.Title || line.QuestionText != "Q1" || !line.Correct {
+		t.Errorf("export line = %+v, want a correct solo pick on %q / Q1", line, qz.Title)
+	}
+	if line.Cursor == "" {
+		t.Fatal("export line cursor is empty")
+	}
+
+	resp = getWith(ctx, t, boss, baseURL+"/admin/export/answers?since="+url.QueryEscape(line.Cursor))
+	if body = readExportBody(t, resp); strings.TrimSpace(body) != "" {
+		t.Errorf("export resumed from the last cursor = %q, want empty", body)
+	}
+}
+
+// readExportBody drains and closes an export response.
+func readExportBody(t *testing.T, resp *http.Response) string {
+	t.Helper()
+	defer closeBody(t, resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("io.ReadAll err = %v, want nil", err)
+	}
+
+	return string(body)
+}
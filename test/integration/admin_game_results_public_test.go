@@ -0,0 +1,89 @@
+package integration_test
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/testserver"
+	apiclient "github.com/starquake/topbanana/pkg/client"
+)
+
+// TestAdminGameResultsPublic_Integration drives the public standings toggle:
+// a finished game's results/public is a 404 until the Admin makes it public
+// from the review page, then it serves the standings to a client with no
+// session, and making it private again takes them back down.
+func TestAdminGameResultsPublic_Integration(t *testing.T) {
+	t.Parallel()
+
+	srv, stores, baseURL := testserver.Start(t, testserver.Options{Env: map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "public-boss@example.test",
+	}})
+	ctx := t.Context()
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "public-boss")
+
+	qz := seedSoloQuiz(ctx, t, stores.Quizzes, "public-results")
+	g := &game.Game{QuizID: qz.ID}
+	if err := stores.Games.CreateGame(ctx, g); err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	if err := stores.Games.CreateParticipant(ctx, &game.Participant{
+		GameID: g.ID, PlayerID: seededAdminID, QuizID: qz.ID,
+	}); err != nil {
+		t.Fatalf("CreateParticipant err = %v, want nil", err)
+	}
+	now := time.Now()
+	gq := &game.Question{
+		GameID: g.ID, QuestionID: qz.Questions[0].ID, StartedAt: now, ExpiredAt: now.Add(10 * time.Second),
+	}
+	if err := stores.Games.CreateQuestion(ctx, gq, true); err != nil {
+		t.Fatalf("CreateQuestion err = %v, want nil", err)
+	}
+	if _, err := stores.Games.FinishGame(ctx, g.ID, game.GameStatusFinished); err != nil {
+		t.Fatalf("FinishGame err = %v, want nil", err)
+	}
+
+	anon, err := apiclient.New(baseURL, nil)
+	if err != nil {
+		t.Fatalf("New err = %v, want nil", err)
+	}
+	var apiErr *apiclient.APIError
+	if _, err = anon.PublicResults(ctx, g.ID); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("PublicResults before the toggle err = %v, want a 404", err)
+	}
+
+	pageURL := baseURL + "/admin/games/" + g.ID
+	toggleURL := baseURL + "/admin/games/" + g.ID + "/results-public"
+	token := fetchCSRFToken(ctx, t, boss, pageURL)
+	status, location, _ := postForm(ctx, t, boss, toggleURL, url.Values{"csrf_token": {token}, "public": {"1"}})
+	if got, want := status, http.StatusSeeOther; got != want {
+		t.Fatalf("toggle status = %d, want %d", got, want)
+	}
+	if got, want := location, "/admin/games/"+g.ID; got != want {
+		t.Errorf("toggle redirect = %q, want %q", got, want)
+	}
+
+	res, err := anon.PublicResults(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("PublicResults err = %v, want nil", err)
+	}
+	if got, want := len(res.Standings), 1; got != want {
+		t.Fatalf("len(Standings) = %d, want %d", got, want)
+	}
+	if res.Standings[0].DisplayName == "" {
+		t.Error("Standings[0].DisplayName is empty, want the player's name")
+	}
+
+	token = fetchCSRFToken(ctx, t, boss, pageURL)
+	if status, _, _ = postForm(ctx, t, boss, toggleURL, url.Values{"csrf_token": {token}}); status != http.StatusSeeOther {
+		t.Fatalf("untoggle status = %d, want %d", status, http.StatusSeeOther)
+	}
+	if _, err = anon.PublicResults(ctx, g.ID); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("PublicResults after making it private err = %v, want a 404", err)
+	}
+}
@@ -0,0 +1,91 @@
+package integration_test
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/starquake/topbanana/internal/game"
+	"github.com/starquake/topbanana/internal/testserver"
+)
+
+// TestAdminGameVoid_Integration drives a disputed question through the game
+// review page: a Host who does not own the quiz gets a 404, the Admin's void
+// POST redirects back to the page, and the game's results then score the
+// voided question's answer as nothing and list the void.
+func TestAdminGameVoid_Integration(t *testing.T) {
+	t.Parallel()
+
+	srv, stores, baseURL := testserver.Start(t, testserver.Options{Env: map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "void-boss@example.test",
+	}})
+	ctx := t.Context()
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "void-boss")
+	host := registerAdminClient(ctx, t, baseURL, srv.DBURI, "void-host")
+	makeHost(ctx, t, srv.DBURI, "void-host")
+
+	qz := seedSoloQuiz(ctx, t, stores.Quizzes, "void-solo")
+	g := &game.Game{QuizID: qz.ID}
+	if err := stores.Games.CreateGame(ctx, g); err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	if err := stores.Games.CreateParticipant(ctx, &game.Participant{
+		GameID: g.ID, PlayerID: seededAdminID, QuizID: qz.ID,
+	}); err != nil {
+		t.Fatalf("CreateParticipant err = %v, want nil", err)
+	}
+	now := time.Now()
+	gq := &game.Question{
+		GameID: g.ID, QuestionID: qz.Questions[0].ID, StartedAt: now, ExpiredAt: now.Add(10 * time.Second),
+	}
+	if err := stores.Games.CreateQuestion(ctx, gq, true); err != nil {
+		t.Fatalf("CreateQuestion err = %v, want nil", err)
+	}
+	if err := stores.Games.CreateAnswer(ctx, &game.Answer{
+		GameID:     g.ID,
+		PlayerID:   seededAdminID,
+		QuestionID: gq.ID,
+		OptionID:   qz.Questions[0].Options[0].ID,
+		AnsweredAt: now.Add(time.Second),
+	}); err != nil {
+		t.Fatalf("CreateAnswer err = %v, want nil", err)
+	}
+
+	pageURL := baseURL + "/admin/games/" + g.ID
+	resp := getWith(ctx, t, host, pageURL)
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("review page status for a non-owner host = %d, want %d", got, want)
+	}
+
+	if body := getPageBody(ctx, t, boss, pageURL); !strings.Contains(body, "Q1") {
+		t.Errorf("review page does not list the asked question Q1")
+	}
+
+	token := fetchCSRFToken(ctx, t, boss, pageURL)
+	voidURL := fmt.Sprintf("%s/admin/games/%s/questions/%d/void", baseURL, g.ID, qz.Questions[0].ID)
+	status, location, _ := postForm(ctx, t, boss, voidURL, url.Values{"csrf_token": {token}})
+	if got, want := status, http.StatusSeeOther; got != want {
+		t.Fatalf("void status = %d, want %d", got, want)
+	}
+	if got, want := location, "/admin/games/"+g.ID; got != want {
+		t.Errorf("void redirect = %q, want %q", got, want)
+	}
+
+	results, err := game.NewService(stores.Games, stores.Quizzes, slog.Default()).GetResults(ctx, g.ID, seededAdminID)
+	if err != nil {
+		t.Fatalf("GetResults err = %v, want nil", err)
+	}
+	if got := results.PlayerScores[seededAdminID]; got != 0 {
+		t.Errorf("score after the void = %d, want 0", got)
+	}
+	if got := results.VoidedQuestionIDs; len(got) != 1 || got[0] != qz.Questions[0].ID {
+		t.Errorf("VoidedQuestionIDs = %v, want [%d]", got, qz.Questions[0].ID)
+	}
+}
@@ -2,10 +2,8 @@ package integration_test
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"io"
-	"log/slog"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -13,7 +11,7 @@ import (
 	"testing"
 
 	"github.com/starquake/topbanana/internal/quiz"
-	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/testserver"
 )
 
 // TestAdminHTMX_QuestionReorder pins the HX-Request branch on the
@@ -29,24 +27,14 @@ import (
 func TestAdminHTMX_QuestionReorder(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, map[string]string{
+	srv, stores, _ := testserver.Start(t, testserver.Options{Env: map[string]string{
 		"REGISTRATION_ENABLED": "true",
-	})
-
-	// Open a *sql.DB against the same URI so we can seed a quiz with
-	// two questions directly - keeps this test focused on the reorder
-	// endpoint rather than re-exercising the full create-quiz flow.
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
+	}})
+	ctx := t.Context()
 
+	// Seed a quiz with two questions directly through the stores - keeps
+	// this test focused on the reorder endpoint rather than re-exercising
+	// the full create-quiz flow.
 	// Register an admin via the HTTP flow first so we can attribute the
 	// seeded quiz to their player id. Owner-gated routes (#281) reject
 	// the reorder POST if the session player isn't the quiz creator,
@@ -219,20 +207,10 @@ func TestAdminHTMX_QuestionReorder(t *testing.T) {
 func TestAdminHTMX_RoundMove(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, map[string]string{
+	srv, stores, _ := testserver.Start(t, testserver.Options{Env: map[string]string{
 		"REGISTRATION_ENABLED": "true",
-	})
-
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
+	}})
+	ctx := t.Context()
 
 	jar, err := cookiejar.New(nil)
 	if err != nil {
@@ -411,20 +389,10 @@ func postHXRoundMove(
 func TestAdminHTMX_DeleteSwaps(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, map[string]string{
+	srv, stores, _ := testserver.Start(t, testserver.Options{Env: map[string]string{
 		"REGISTRATION_ENABLED": "true",
-	})
-
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
+	}})
+	ctx := t.Context()
 
 	jar, err := cookiejar.New(nil)
 	if err != nil {
@@ -1,8 +1,10 @@
 package integration_test
 
 import (
+	"bytes"
 	"context"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -206,7 +208,7 @@ func TestAdminImport_Integration(t *testing.T) {
 		t.Parallel()
 		// Empty title trips quiz.Quiz.Valid (Title is required). The
 		// "validation errors:" prefix is what the handler prepends to
-		// any map of field problems — pin it so a future refactor of
+		// the field problems — pin it so a future refactor of
 		// the error rendering doesn't silently swallow this branch.
 		postImportRejection(
 			ctx,
@@ -215,10 +217,52 @@ func TestAdminImport_Integration(t *testing.T) {
 			importURL,
 			`{"title": "", "description": "ok", "questions": [{"text": "Q", "options": [{"text": "A", "correct": true}]}]}`,
 			http.StatusBadRequest,
-			[]string{"validation errors", "Title is required"},
+			[]string{"validation errors", "title: Title is required"},
 		)
 	})
 
+	t.Run("every field problem is listed", func(t *testing.T) {
+		t.Parallel()
+		// An offline-authored file with several mistakes reports each one
+		// as its own "field: message" line, tagged with its field path and
+		// code, so the author fixes them in one pass.
+		postImportRejection(ctx, t, client, importURL,
+			`{"title": "", "description": "ok", "questions": [{"text": "", "options": [{"text": "A", "correct": true}]}]}`,
+			http.StatusBadRequest,
+			[]string{
+				`data-testid="import-problems"`,
+				`<li class="font-mono text-[0.8rem]" data-field="questions[0].text" data-code="required">` +
+					"questions[0].text: Text is required</li>",
+				"title: Title is required",
+			},
+		)
+	})
+
+	t.Run("uploaded JSON file imports", func(t *testing.T) {
+		t.Parallel()
+		status, location, _ := postImportFile(ctx, t, client, importURL, "solo",
+			`{"title": "Uploaded File Quiz", "description": "d",`+
+				` "questions": [{"text": "Q", "options": [{"text": "A", "correct": true}, {"text": "B"}]}]}`,
+		)
+		if got, want := status, http.StatusSeeOther; got != want {
+			t.Fatalf("file import status = %d, want %d", got, want)
+		}
+		if body := getPageBody(ctx, t, client, srv.BaseURL+location); !strings.Contains(body, "Uploaded File Quiz") {
+			t.Errorf("imported quiz view should contain %q; body=%q", "Uploaded File Quiz", body)
+		}
+	})
+
+	t.Run("invalid uploaded JSON file re-renders the form", func(t *testing.T) {
+		t.Parallel()
+		status, _, body := postImportFile(ctx, t, client, importURL, "solo", `{"title": ""}`)
+		if got, want := status, http.StatusBadRequest; got != want {
+			t.Errorf("file import status = %d, want %d", got, want)
+		}
+		if !strings.Contains(body, "validation errors") {
+			t.Errorf("file import body should contain %q; body=%q", "validation errors", body)
+		}
+	})
+
 	t.Run("imported quiz carries the payload's timeLimitSeconds", func(t *testing.T) {
 		t.Parallel()
 		// #99: the payload exposes timeLimitSeconds at both the quiz
@@ -774,3 +818,47 @@ func postImportRejection(
 		}
 	}
 }
+
+// postImportFile uploads jsonText as the import form's multipart .json file
+// (with an empty textarea) and returns the status, Location and body.
+func postImportFile(
+	ctx context.Context, t *testing.T, client *http.Client, importURL, mode, jsonText string,
+) (int, string, string) {
+	t.Helper()
+
+	csrfToken := fetchCSRFToken(ctx, t, client, importURL)
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	for name, value := range map[string]string{"csrf_token": csrfToken, "mode": mode, "json": ""} {
+		if err := mw.WriteField(name, value); err != nil {
+			t.Fatalf("WriteField(%q) err = %v, want nil", name, err)
+		}
+	}
+	fw, err := mw.CreateFormFile("file", "quiz.json")
+	if err != nil {
+		t.Fatalf("CreateFormFile err = %v, want nil", err)
+	}
+	if _, err := fw.Write([]byte(jsonText)); err != nil {
+		t.Fatalf("write file part err = %v, want nil", err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatalf("multipart Close err = %v, want nil", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, importURL, &buf)
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("client.Do err = %v, want nil", err)
+	}
+	defer closeBody(t, resp.Body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll err = %v, want nil", err)
+	}
+
+	return resp.StatusCode, resp.Header.Get("Location"), string(body)
+}
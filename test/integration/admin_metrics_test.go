@@ -0,0 +1,49 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// TestAdminMetrics_Integration pins the expvar endpoint's gate: a Host gets a
+// 404 like the rest of the Admin-only console, an Admin gets the JSON
+// registry with the database maintenance counters published.
+func TestAdminMetrics_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "metrics-boss@example.test",
+	})
+	baseURL := srv.BaseURL
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "metrics-boss")
+	host := registerAdminClient(ctx, t, baseURL, srv.DBURI, "metrics-host")
+	makeHost(ctx, t, srv.DBURI, "metrics-host")
+
+	t.Run("host gets 404", func(t *testing.T) {
+		t.Parallel()
+		resp := getWith(ctx, t, host, baseURL+"/admin/metrics")
+		defer closeBody(t, resp.Body)
+		if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+			t.Errorf("metrics status for host = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("admin gets the registry", func(t *testing.T) {
+		t.Parallel()
+		resp := getWith(ctx, t, boss, baseURL+"/admin/metrics")
+		defer closeBody(t, resp.Body)
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Fatalf("metrics status for admin = %d, want %d", got, want)
+		}
+		var vars map[string]json.RawMessage
+		if err := json.NewDecoder(resp.Body).Decode(&vars); err != nil {
+			t.Fatalf("decode metrics err = %v, want nil", err)
+		}
+		if _, ok := vars["db_maintenance"]; !ok {
+			t.Error("metrics missing the db_maintenance map")
+		}
+	})
+}
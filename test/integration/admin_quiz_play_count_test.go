@@ -1,7 +1,6 @@
 package integration_test
 
 import (
-	"database/sql"
 	"net/http"
 	"strings"
 	"testing"
@@ -25,16 +24,7 @@ func TestAdminQuizList_RendersPlayCount(t *testing.T) {
 
 	qz := seedSoloQuiz(ctx, t, setup.Stores.Quizzes, "play-count-list")
 
-	db, err := sql.Open("sqlite", setup.DBURI)
-	if err != nil {
-		t.Fatalf("open db err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	if _, err := db.ExecContext(
+	if _, err := setup.DB.ExecContext(
 		ctx, "UPDATE quizzes SET play_count = 7 WHERE id = ?", qz.ID,
 	); err != nil {
 		t.Fatalf("seed play_count err = %v, want nil", err)
@@ -2,10 +2,8 @@ package integration_test
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"io"
-	"log/slog"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -15,6 +13,7 @@ import (
 
 	"github.com/starquake/topbanana/internal/quiz"
 	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/testserver"
 )
 
 // reorderFixture bundles the seeded quiz tree and the owner client for
@@ -36,20 +35,10 @@ type reorderFixture struct {
 func seedReorderQuiz(t *testing.T) (context.Context, reorderFixture) {
 	t.Helper()
 
-	ctx, srv := startServer(t, map[string]string{
+	srv, stores, _ := testserver.Start(t, testserver.Options{Env: map[string]string{
 		"REGISTRATION_ENABLED": "true",
-	})
-
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
+	}})
+	ctx := t.Context()
 
 	jar, err := cookiejar.New(nil)
 	if err != nil {
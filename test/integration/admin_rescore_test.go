@@ -0,0 +1,61 @@
+package integration_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// TestAdminRescore_Integration pins the score recalculation tool's gates and
+// flow: a Host gets a 404 like the rest of the Admin-only console, a malformed
+// quiz id is rejected, the dry run renders a report without writing, and the
+// apply POST redirects back to the same quiz's preview with a flash.
+func TestAdminRescore_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "rescore-boss@example.test",
+	})
+	baseURL := srv.BaseURL
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "rescore-boss")
+	host := registerAdminClient(ctx, t, baseURL, srv.DBURI, "rescore-host")
+	makeHost(ctx, t, srv.DBURI, "rescore-host")
+
+	resp := getWith(ctx, t, host, baseURL+"/admin/scores/recalculate")
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("recalculate status for host = %d, want %d", got, want)
+	}
+
+	resp = getWith(ctx, t, boss, baseURL+"/admin/scores/recalculate?quiz=abc")
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+		t.Errorf("recalculate status for a malformed quiz id = %d, want %d", got, want)
+	}
+
+	body := getPageBody(ctx, t, boss, baseURL+"/admin/scores/recalculate?quiz=999")
+	for _, want := range []string{`data-testid="rescore-report"`, "0 scored answers replayed"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("recalculate preview missing %q", want)
+		}
+	}
+
+	token := fetchCSRFToken(ctx, t, boss, baseURL+"/admin/scores/recalculate")
+	status, location, _ := postForm(ctx, t, boss, baseURL+"/admin/scores/recalculate", url.Values{
+		"csrf_token": {token},
+		"quiz_id":    {"999"},
+		"join_code":  {"ABC234"},
+	})
+	if got, want := status, http.StatusSeeOther; got != want {
+		t.Fatalf("apply status = %d, want %d", got, want)
+	}
+	if got, want := location, "/admin/scores/recalculate?code=ABC234&quiz=999"; got != want {
+		t.Errorf("apply Location = %q, want %q", got, want)
+	}
+	if body = getPageBody(ctx, t, boss, baseURL+location); !strings.Contains(body, "No stored scores needed changing.") {
+		t.Error("recalculate page after apply does not show the flash")
+	}
+}
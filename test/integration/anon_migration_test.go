@@ -2,7 +2,6 @@ package integration_test
 
 import (
 	"context"
-	"database/sql"
 	"io"
 	"net/http"
 	"net/url"
@@ -12,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/dbtest"
 	"github.com/starquake/topbanana/internal/quiz"
 )
 
@@ -173,10 +173,7 @@ func primeLoginCSRF(ctx context.Context, t *testing.T, client *http.Client, base
 // game_participants count for the given player matches want.
 func requirePlayerGameCount(t *testing.T, dbURI string, playerID int64, want int) {
 	t.Helper()
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
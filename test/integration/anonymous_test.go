@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"log/slog"
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
@@ -13,7 +12,8 @@ import (
 
 	"github.com/starquake/topbanana/internal/quiz"
 	"github.com/starquake/topbanana/internal/session"
-	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/testserver"
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // TestAnonymous_Integration exercises the score-claiming acceptance criteria:
@@ -24,22 +24,12 @@ import (
 func TestAnonymous_Integration(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, nil)
-	baseURL := srv.BaseURL
+	srv, stores, baseURL := testserver.Start(t, testserver.Options{})
+	ctx := t.Context()
 
 	// Seed a quiz directly via the DB so we can ask the API to start a
 	// game against it. Using the store keeps this independent of the admin
 	// HTTP flow exercised in admin_test.go.
-	dbConn, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("failed to open db: %v", err)
-	}
-	t.Cleanup(func() {
-		if cerr := dbConn.Close(); cerr != nil {
-			t.Errorf("dbConn.Close err = %v, want nil", cerr)
-		}
-	})
-
 	qz := &quiz.Quiz{
 		Title:             "Anonymous Quiz",
 		Published:         true,
@@ -57,13 +47,12 @@ func TestAnonymous_Integration(t *testing.T) {
 			},
 		},
 	}
-	stores := store.New(dbConn, slog.Default())
 	if createErr := stores.Quizzes.CreateQuiz(ctx, qz); createErr != nil {
 		t.Fatalf("CreateQuiz err = %v, want nil", createErr)
 	}
 
 	// Three scenarios run sequentially in this body (rather than as t.Run
-	// subtests) because they share dbConn and the EnsurePlayer-managed
+	// subtests) because they share srv.DB and the EnsurePlayer-managed
 	// players-row count — paralleltest would force subtests to be parallel,
 	// which would race the count-delta assertions below.
 
@@ -76,7 +65,7 @@ func TestAnonymous_Integration(t *testing.T) {
 	}
 	client1 := &http.Client{Jar: jar1}
 
-	startCount := countAnonymousPlayers(ctx, t, dbConn)
+	startCount := countAnonymousPlayers(ctx, t, srv.DB)
 	gameID, setCookie := postCreateGame(ctx, t, client1, baseURL, qz.ID)
 	if gameID == "" {
 		t.Fatal("expected non-empty game ID")
@@ -84,7 +73,7 @@ func TestAnonymous_Integration(t *testing.T) {
 	if !setCookie {
 		t.Fatal("expected Set-Cookie on first /api/games response")
 	}
-	if got, want := countAnonymousPlayers(ctx, t, dbConn)-startCount, 1; got != want {
+	if got, want := countAnonymousPlayers(ctx, t, srv.DB)-startCount, 1; got != want {
 		t.Errorf("[scenario 1] anonymous players added = %d, want %d", got, want)
 	}
 
@@ -93,7 +82,7 @@ func TestAnonymous_Integration(t *testing.T) {
 	// legacy "anon-<xid>" format. The petname path is the default; the
 	// xid fallback only runs when the petname pool collides several times
 	// in a row, which is astronomically unlikely in a single-call test.
-	if got, want := countLegacyAnonDisplayNames(ctx, t, dbConn), 0; got != want {
+	if got, want := countLegacyAnonDisplayNames(ctx, t, srv.DB), 0; got != want {
 		t.Errorf("[scenario 1b] rows matching anon-%% = %d, want %d (petname path should win)", got, want)
 	}
 
@@ -110,11 +99,11 @@ func TestAnonymous_Integration(t *testing.T) {
 	}
 	client2 := &http.Client{Jar: jar2}
 
-	startCount = countAnonymousPlayers(ctx, t, dbConn)
+	startCount = countAnonymousPlayers(ctx, t, srv.DB)
 	_, _ = postCreateGame(ctx, t, client2, baseURL, qz.ID)
 	fetchAPIQuizzes(ctx, t, client2, baseURL)
 	fetchAPIQuizzes(ctx, t, client2, baseURL)
-	if got, want := countAnonymousPlayers(ctx, t, dbConn)-startCount, 1; got != want {
+	if got, want := countAnonymousPlayers(ctx, t, srv.DB)-startCount, 1; got != want {
 		t.Errorf("[scenario 2] anonymous players added = %d, want %d (jar should reuse row)", got, want)
 	}
 
@@ -130,10 +119,10 @@ func TestAnonymous_Integration(t *testing.T) {
 	clientA := &http.Client{Jar: jarA}
 	clientB := &http.Client{Jar: jarB}
 
-	startCount = countAnonymousPlayers(ctx, t, dbConn)
+	startCount = countAnonymousPlayers(ctx, t, srv.DB)
 	_, _ = postCreateGame(ctx, t, clientA, baseURL, qz.ID)
 	_, _ = postCreateGame(ctx, t, clientB, baseURL, qz.ID)
-	if got, want := countAnonymousPlayers(ctx, t, dbConn)-startCount, 2; got != want {
+	if got, want := countAnonymousPlayers(ctx, t, srv.DB)-startCount, 2; got != want {
 		t.Errorf("[scenario 3] anonymous players added = %d, want %d (two jars → two rows)", got, want)
 	}
 
@@ -214,9 +203,7 @@ func postCreateGame(
 		t.Fatalf("status = %d, want %d", got, want)
 	}
 
-	var out struct {
-		ID string `json:"id"`
-	}
+	var out apiclient.CreateGameResponse
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
 		t.Fatalf("json.Decode err = %v, want nil", err)
 	}
@@ -262,6 +249,8 @@ func fetchAPIQuizzes(ctx context.Context, t *testing.T, client *http.Client, bas
 type meResponse struct {
 	ID              int64  `json:"id"`
 	DisplayName     string `json:"displayName"`
+	Nickname        string `json:"nickname"`
+	AvatarColor     string `json:"avatarColor"`
 	IsAnonymous     bool   `json:"isAnonymous"`
 	HasCustomName   bool   `json:"hasCustomName"`
 	IsAuthenticated bool   `json:"isAuthenticated"`
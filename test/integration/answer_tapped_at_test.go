@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/starquake/topbanana/internal/quiz"
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // TestAnswer_TappedAtClamp pins the #237 wire contract end-to-end: an
@@ -59,9 +60,7 @@ func TestAnswer_TappedAtClamp(t *testing.T) {
 	if got, want := createResp.StatusCode, http.StatusCreated; got != want {
 		t.Fatalf("create game status = %d, want %d", got, want)
 	}
-	var createRes struct {
-		ID string `json:"id"`
-	}
+	var createRes apiclient.CreateGameResponse
 	if derr := json.NewDecoder(createResp.Body).Decode(&createRes); derr != nil {
 		t.Fatalf("decode create game: %v", derr)
 	}
@@ -72,7 +71,7 @@ func TestAnswer_TappedAtClamp(t *testing.T) {
 	if got, want := nextResp.StatusCode, http.StatusOK; got != want {
 		t.Fatalf("next question status = %d, want %d", got, want)
 	}
-	var nextQ nextQuestionRes
+	var nextQ apiclient.Question
 	if derr := json.NewDecoder(nextResp.Body).Decode(&nextQ); derr != nil {
 		t.Fatalf("decode next question: %v", derr)
 	}
@@ -89,10 +88,7 @@ func TestAnswer_TappedAtClamp(t *testing.T) {
 		t.Fatalf("answer status = %d, want %d", got, want)
 	}
 
-	var answerRes struct {
-		Correct bool `json:"correct"`
-		Score   int  `json:"score"`
-	}
+	var answerRes apiclient.AnswerResponse
 	if derr := json.NewDecoder(answerResp.Body).Decode(&answerRes); derr != nil {
 		t.Fatalf("decode answer response: %v", derr)
 	}
@@ -0,0 +1,83 @@
+package integration_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	apiclient "github.com/starquake/topbanana/pkg/client"
+)
+
+// TestAPIClient_PlaysSoloQuiz drives a full solo game through pkg/client, so
+// the SDK and the handlers are pinned to the same wire shapes: list, create,
+// next, answer, exhaust, results.
+func TestAPIClient_PlaysSoloQuiz(t *testing.T) {
+	t.Parallel()
+
+	ctx, setup := setupIntegration(t)
+	qz := seedSoloQuiz(ctx, t, setup.Stores.Quizzes, "api-client")
+
+	c, err := apiclient.New(setup.BaseURL, nil)
+	if err != nil {
+		t.Fatalf("New err = %v, want nil", err)
+	}
+
+	quizzes, err := c.ListQuizzes(ctx)
+	if err != nil {
+		t.Fatalf("ListQuizzes err = %v, want nil", err)
+	}
+	found := false
+	for _, listed := range quizzes {
+		if listed.ID == qz.ID {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("ListQuizzes = %+v, want quiz %d listed", quizzes, qz.ID)
+	}
+
+	gameID, err := c.CreateGame(ctx, qz.ID)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+
+	item, err := c.NextQuestion(ctx, gameID)
+	if err != nil {
+		t.Fatalf("NextQuestion err = %v, want nil", err)
+	}
+	if item.Question == nil {
+		t.Fatalf("NextQuestion type = %q, want a question", item.Type)
+	}
+	if got, want := len(item.Question.Options), 2; got != want {
+		t.Fatalf("len(Options) = %d, want %d", got, want)
+	}
+
+	correctID := qz.Questions[0].Options[0].ID
+	ans, err := c.SubmitAnswer(ctx, gameID, item.Question.ID, apiclient.AnswerRequest{OptionID: correctID})
+	if err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+	if !ans.Correct {
+		t.Error("SubmitAnswer Correct = false, want true")
+	}
+
+	_, err = c.NextQuestion(ctx, gameID)
+	var apiErr *apiclient.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("NextQuestion after last err = %v, want a 404 APIError", err)
+	}
+	if got, want := apiErr.Code, "notFound"; got != want {
+		t.Errorf("APIError.Code = %q, want %q", got, want)
+	}
+
+	res, err := c.Results(ctx, gameID)
+	if err != nil {
+		t.Fatalf("Results err = %v, want nil", err)
+	}
+	if got, want := len(res.PlayerScores), 1; got != want {
+		t.Fatalf("len(PlayerScores) = %d, want %d", got, want)
+	}
+	if got, want := res.PlayerScores[0].Score, ans.Score; got != want {
+		t.Errorf("Results score = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,319 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/starquake/topbanana/cmd/server/app"
+	"github.com/starquake/topbanana/internal/fixture"
+	"github.com/starquake/topbanana/internal/quiz"
+	"github.com/starquake/topbanana/internal/testserver"
+)
+
+// updateContract rewrites the contract goldens from the current responses:
+// go test ./test/integration -run TestAPIContract -update.
+var updateContract = flag.Bool("update", false, "rewrite testdata/contract goldens")
+
+// contractDir holds one golden per contract step, named after the step.
+const contractDir = "testdata/contract"
+
+// contractRevealDelay is the REVEAL_DELAY the contract server runs with: the
+// gap between issuing a question and opening its answer window. Each answer
+// step moves the clock this far, so the pick lands as the window opens.
+const contractRevealDelay = 3 * time.Second
+
+// volatileKeys are response fields the fixed clock cannot pin: the database
+// stamps them with CURRENT_TIMESTAMP. Their values are replaced before the
+// comparison; the key itself is still part of the contract.
+var volatileKeys = map[string]bool{"createdAt": true, "finishedAt": true}
+
+// contractStep is one request of the contract walk. client is nil for an
+// anonymous request without a session cookie; advance moves the server's
+// clock before the request.
+type contractStep struct {
+	name    string
+	client  *http.Client
+	method  string
+	path    string
+	body    string
+	advance time.Duration
+}
+
+// contractClock is the contract server's game clock: fixed at fixture.Epoch
+// and moved only by the test, so every timestamp and score is reproducible.
+type contractClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *contractClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *contractClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// contractQuiz is the fixed two-question quiz the contract plays, with its
+// options kept in authored order so the goldens do not depend on a shuffle.
+func contractQuiz() *quiz.Quiz {
+	return &quiz.Quiz{
+		Title:             "Contract",
+		Slug:              "contract",
+		Description:       "seeded",
+		CreatedByPlayerID: seededAdminID,
+		Visibility:        quiz.VisibilityPublic,
+		Published:         true,
+		KeepOptionOrder:   true,
+		Questions: []*quiz.Question{
+			{
+				Text:     "What is the capital of France?",
+				Position: 1,
+				Options:  []*quiz.Option{{Text: "Paris", Correct: true}, {Text: "London"}},
+			},
+			{
+				Text:     "What is the capital of Germany?",
+				Position: 2,
+				Options:  []*quiz.Option{{Text: "Berlin", Correct: true}, {Text: "Hamburg"}},
+			},
+		},
+	}
+}
+
+// contractOption returns the question's id and its correct (or, with correct
+// false, an incorrect) option id.
+func contractOption(t *testing.T, q *quiz.Question, correct bool) (questionID, optionID int64) {
+	t.Helper()
+
+	for _, o := range q.Options {
+		if o.Correct == correct {
+			return q.ID, o.ID
+		}
+	}
+	t.Fatalf("question %d has no option with Correct = %t", q.ID, correct)
+
+	return 0, 0
+}
+
+// TestAPIContract pins the wire shape of the player API the SPA depends on,
+// through the real server. Each step's response (status, Location and the
+// enveloped JSON body) is compared with testdata/contract/<step>.json. The
+// quiz, players and game clock are fixed, so everything but the game ID and
+// database timestamps is reproducible; those two are replaced by
+// placeholders. A deliberate shape change is recorded by rerunning with
+// -update and reviewing the golden diff.
+func TestAPIContract(t *testing.T) {
+	t.Parallel()
+
+	clock := &contractClock{now: fixture.Epoch}
+	srv, stores, baseURL := testserver.Start(t, testserver.Options{
+		Env:        map[string]string{"REVEAL_DELAY": contractRevealDelay.String()},
+		RunOptions: []app.Option{app.WithClock(clock.Now)},
+	})
+	ctx := t.Context()
+
+	qz := contractQuiz()
+	if err := stores.Quizzes.CreateQuiz(ctx, qz); err != nil {
+		t.Fatalf("CreateQuiz err = %v, want nil", err)
+	}
+	// Players are created in a fixed order: their ids, and the nickname and
+	// avatar derived from them, are part of the goldens.
+	alice, bob := newAnonClient(t), newAnonClient(t)
+	for _, p := range []struct {
+		name   string
+		client *http.Client
+	}{{"alice", alice}, {"bob", bob}} {
+		if _, err := stores.Players.CreateAnonymousPlayer(ctx, p.name); err != nil {
+			t.Fatalf("CreateAnonymousPlayer(%q) err = %v, want nil", p.name, err)
+		}
+		mintSessionCookie(ctx, t, p.client, baseURL, srv.DBURI, p.name)
+	}
+
+	createBody := fmt.Sprintf(`{"quizId":%d}`, qz.ID)
+	answerPath := func(i int, correct bool) (string, string) {
+		questionID, optionID := contractOption(t, qz.Questions[i], correct)
+
+		return fmt.Sprintf("/api/games/{gameID}/questions/%d/answers", questionID),
+			fmt.Sprintf(`{"optionId":%d}`, optionID)
+	}
+	answer1, answer1Body := answerPath(0, true)
+	answer2, answer2Body := answerPath(1, false)
+
+	steps := []contractStep{
+		{name: "branding", method: http.MethodGet, path: "/api/branding"},
+		{name: "players_me", client: alice, method: http.MethodGet, path: "/api/players/me"},
+		{name: "quiz_list", client: alice, method: http.MethodGet, path: "/api/quizzes"},
+		{name: "quiz_meta", client: alice, method: http.MethodGet, path: "/api/quizzes/{quiz}"},
+		{name: "my_game_none", client: alice, method: http.MethodGet, path: "/api/quizzes/{quiz}/my-game"},
+		{name: "game_create", client: alice, method: http.MethodPost, path: "/api/games", body: createBody},
+		{name: "game_create_conflict", client: alice, method: http.MethodPost, path: "/api/games", body: createBody},
+		{name: "question_next_first", client: alice, method: http.MethodGet, path: "/api/games/{gameID}/questions/next"},
+		{
+			name: "answer_correct", client: alice, method: http.MethodPost, path: answer1, body: answer1Body,
+			advance: contractRevealDelay,
+		},
+		{name: "question_next_second", client: alice, method: http.MethodGet, path: "/api/games/{gameID}/questions/next"},
+		{
+			name: "answer_wrong", client: alice, method: http.MethodPost, path: answer2, body: answer2Body,
+			advance: contractRevealDelay,
+		},
+		{name: "question_next_exhausted", client: alice, method: http.MethodGet, path: "/api/games/{gameID}/questions/next"},
+		{name: "my_game_completed", client: alice, method: http.MethodGet, path: "/api/quizzes/{quiz}/my-game"},
+		{name: "game_finish", client: alice, method: http.MethodPost, path: "/api/games/{gameID}/finish"},
+		{name: "results", client: alice, method: http.MethodGet, path: "/api/games/{gameID}/results"},
+		{name: "results_compare", client: alice, method: http.MethodGet, path: "/api/games/{gameID}/results/compare"},
+		{name: "results_not_participant", client: bob, method: http.MethodGet, path: "/api/games/{gameID}/results"},
+		{name: "results_public_private", method: http.MethodGet, path: "/api/games/{gameID}/results/public"},
+		{name: "leaderboard", client: bob, method: http.MethodGet, path: "/api/quizzes/{quiz}/leaderboard"},
+	}
+
+	var gameID string
+	for _, step := range steps {
+		clock.advance(step.advance)
+		path := strings.NewReplacer(
+			"{gameID}", gameID,
+			"{quiz}", fmt.Sprintf("%s-%d", qz.Slug, qz.ID),
+		).Replace(step.path)
+		req, err := http.NewRequestWithContext(ctx, step.method, baseURL+path, strings.NewReader(step.body))
+		if err != nil {
+			t.Fatalf("NewRequest err = %v, want nil", err)
+		}
+		req.Header.Set("X-Api-Envelope", "1")
+		if step.body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		client := step.client
+		if client == nil {
+			client = &http.Client{}
+		}
+		client.CheckRedirect = func(_ *http.Request, _ []*http.Request) error { return http.ErrUseLastResponse }
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s: client.Do err = %v, want nil", step.name, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		closeBody(t, resp.Body)
+		if err != nil {
+			t.Fatalf("%s: read body err = %v, want nil", step.name, err)
+		}
+
+		if step.name == "game_create" {
+			gameID = createdGameID(t, body)
+		}
+		compareContract(t, step.name, contractRecord(t, resp, body, gameID))
+	}
+}
+
+// createdGameID reads the new game's ID out of the enveloped create
+// response.
+func createdGameID(t *testing.T, body []byte) string {
+	t.Helper()
+
+	var res struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil || res.Data.ID == "" {
+		t.Fatalf("create game body = %s, want a game id (err = %v)", body, err)
+	}
+
+	return res.Data.ID
+}
+
+// contractRecord renders a response as the stable, indented JSON stored in
+// a golden: the status, the Location header when set, and the body with the
+// game ID and volatile timestamps replaced.
+func contractRecord(t *testing.T, resp *http.Response, raw []byte, gameID string) []byte {
+	t.Helper()
+
+	if gameID != "" {
+		raw = bytes.ReplaceAll(raw, []byte(gameID), []byte("{gameID}"))
+	}
+	var body any
+	if len(bytes.TrimSpace(raw)) > 0 {
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Fatalf("response body %q is not JSON: %v", raw, err)
+		}
+	}
+
+	record := map[string]any{
+		"status": resp.StatusCode,
+		"body":   scrubVolatile(body),
+	}
+	if loc := resp.Header.Get("Location"); loc != "" {
+		record["location"] = strings.ReplaceAll(loc, gameID, "{gameID}")
+	}
+	out, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		t.Fatalf("MarshalIndent err = %v", err)
+	}
+
+	return append(out, '\n')
+}
+
+// scrubVolatile replaces the value of every [volatileKeys] field, at any
+// depth, with a placeholder.
+func scrubVolatile(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if volatileKeys[k] && val != nil {
+				v[k] = "{timestamp}"
+
+				continue
+			}
+			v[k] = scrubVolatile(val)
+		}
+	case []any:
+		for i := range v {
+			v[i] = scrubVolatile(v[i])
+		}
+	}
+
+	return v
+}
+
+// compareContract checks got against the step's golden, or rewrites the
+// golden under -update.
+func compareContract(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join(contractDir, name+".json")
+	if *updateContract {
+		if err := os.MkdirAll(contractDir, 0o750); err != nil {
+			t.Fatalf("MkdirAll err = %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o600); err != nil {
+			t.Fatalf("WriteFile(%s) err = %v", path, err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path) //nolint:gosec // path is built from a fixed step name
+	if err != nil {
+		t.Fatalf("%s: %v (run with -update to record it)", name, err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("%s: response drifted from %s (-want +got):\n%s", name, path, diff)
+	}
+}
@@ -0,0 +1,81 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+// TestAPIEnvelope pins the response shapes behind API_LEGACY_SHAPES: the
+// default keeps bare values for a request that has not opted in, and with the
+// flag off every response is the data/meta/error envelope.
+func TestAPIEnvelope(t *testing.T) {
+	t.Parallel()
+
+	t.Run("legacy default serves the bare list", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, srv := startServer(t, nil)
+		resp := getWith(ctx, t, &http.Client{}, srv.BaseURL+"/api/quizzes")
+		body := readAPIBody(t, resp)
+
+		var quizzes []json.RawMessage
+		if err := json.Unmarshal(body, &quizzes); err != nil {
+			t.Errorf("GET /api/quizzes body = %s, want a bare JSON array (err = %v)", body, err)
+		}
+	})
+
+	t.Run("flag off serves the envelope", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, srv := startServer(t, map[string]string{"API_LEGACY_SHAPES": "false"})
+
+		resp := getWith(ctx, t, &http.Client{}, srv.BaseURL+"/api/quizzes")
+		var list struct {
+			Data []json.RawMessage `json:"data"`
+			Meta struct {
+				Count *int `json:"count"`
+			} `json:"meta"`
+		}
+		if err := json.Unmarshal(readAPIBody(t, resp), &list); err != nil {
+			t.Fatalf("decode /api/quizzes err = %v, want nil", err)
+		}
+		if list.Data == nil || list.Meta.Count == nil {
+			t.Fatalf("GET /api/quizzes = %+v, want data and meta.count", list)
+		}
+		if got, want := *list.Meta.Count, len(list.Data); got != want {
+			t.Errorf("meta.count = %d, want %d", got, want)
+		}
+
+		resp = getWith(ctx, t, &http.Client{}, srv.BaseURL+"/api/games/missing/questions/next")
+		if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+			t.Errorf("status = %d, want %d", got, want)
+		}
+		var failed struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(readAPIBody(t, resp), &failed); err != nil {
+			t.Fatalf("decode error body err = %v, want nil", err)
+		}
+		if got, want := failed.Error.Code, "notFound"; got != want {
+			t.Errorf("error.code = %q, want %q", got, want)
+		}
+	})
+}
+
+// readAPIBody reads and closes resp's body.
+func readAPIBody(t *testing.T, resp *http.Response) []byte {
+	t.Helper()
+	defer closeBody(t, resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body err = %v, want nil", err)
+	}
+
+	return body
+}
@@ -0,0 +1,51 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestAPIConditionalGET pins the polled reads' caching: GET /api/quizzes
+// carries an ETag and a revalidate-every-time Cache-Control, and a repeat
+// with that ETag in If-None-Match is a bodiless 304. The question endpoint is
+// no-store, since its serverNow makes every body unique.
+func TestAPIConditionalGET(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, nil)
+
+	resp := getWith(ctx, t, &http.Client{}, srv.BaseURL+"/api/quizzes")
+	readAPIBody(t, resp)
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("GET /api/quizzes status = %d, want %d", got, want)
+	}
+	tag := resp.Header.Get("ETag")
+	if tag == "" {
+		t.Fatal("GET /api/quizzes has no ETag")
+	}
+	if got, want := resp.Header.Get("Cache-Control"), "private, no-cache"; got != want {
+		t.Errorf("GET /api/quizzes Cache-Control = %q, want %q", got, want)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.BaseURL+"/api/quizzes", nil)
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	req.Header.Set("If-None-Match", tag)
+	resp, err = (&http.Client{}).Do(req)
+	if err != nil {
+		t.Fatalf("conditional GET err = %v, want nil", err)
+	}
+	if body := readAPIBody(t, resp); len(body) != 0 {
+		t.Errorf("conditional GET body = %q, want empty", body)
+	}
+	if got, want := resp.StatusCode, http.StatusNotModified; got != want {
+		t.Errorf("conditional GET status = %d, want %d", got, want)
+	}
+
+	resp = getWith(ctx, t, &http.Client{}, srv.BaseURL+"/api/games/missing/questions/next")
+	readAPIBody(t, resp)
+	if got, want := resp.Header.Get("Cache-Control"), "no-store"; got != want {
+		t.Errorf("GET questions/next Cache-Control = %q, want %q", got, want)
+	}
+}
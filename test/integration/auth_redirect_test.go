@@ -12,6 +12,7 @@ import (
 
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/session"
+	"github.com/starquake/topbanana/internal/testserver"
 )
 
 // TestAuthRedirect_PerRole pins the #288 fix end-to-end: register and
@@ -174,13 +175,13 @@ func loginForRedirectWithNext(
 	return resp.Header.Get("Location")
 }
 
-// testSessionKey is the fixed signing key startServer sets as the
-// default SESSION_KEY (see testmain_test.go) so tests can mint a
-// matching session cookie with mintSessionCookie. The hard
+// testSessionKey is the fixed signing key every test server runs with
+// as its default SESSION_KEY (see [testserver.SessionKey]) so tests can
+// mint a matching session cookie with mintSessionCookie. The hard
 // email-verification gate (#574) means register and login no longer
 // hand out a session for an unverified account, so the verify-gate
 // tests forge the signed-in-but-unverified state directly instead.
-const testSessionKey = "integration-test-session-key-0123456789abcdef"
+const testSessionKey = testserver.SessionKey
 
 // mintSessionCookie signs a session cookie for the named player using
 // testSessionKey and installs it on client's jar, putting the client in
@@ -0,0 +1,106 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// TestBranding_Integration covers the per-deployment branding: the defaults
+// before anything is saved, a Host kept off the form, an invalid color
+// rejected with a flash, and a saved branding showing up on /api/branding,
+// the admin top bar, the home footer, and the player SPA shell.
+func TestBranding_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "brand-boss@example.test",
+	})
+	baseURL := srv.BaseURL
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "brand-boss")
+	host := registerAdminClient(ctx, t, baseURL, srv.DBURI, "brand-host")
+	makeHost(ctx, t, srv.DBURI, "brand-host")
+
+	if got, want := getBranding(ctx, t, boss, baseURL), (client.Branding{Name: "Top Banana!"}); got != want {
+		t.Errorf("default branding = %+v, want %+v", got, want)
+	}
+
+	token := fetchCSRFToken(ctx, t, host, baseURL+"/admin")
+	status, _, _ := postForm(ctx, t, host, baseURL+"/admin/settings/branding", url.Values{
+		"csrf_token": {token},
+		"name":       {"Host Club"},
+	})
+	if got, want := status, http.StatusNotFound; got != want {
+		t.Errorf("host branding POST status = %d, want %d", got, want)
+	}
+
+	token = fetchCSRFToken(ctx, t, boss, baseURL+"/admin/settings")
+	status, location, _ := postForm(ctx, t, boss, baseURL+"/admin/settings/branding", url.Values{
+		"csrf_token":    {token},
+		"name":          {"Quiz Club"},
+		"primary_color": {"red"},
+	})
+	if got, want := status, http.StatusSeeOther; got != want {
+		t.Fatalf("invalid branding POST status = %d, want %d", got, want)
+	}
+	if got, want := location, "/admin/settings"; got != want {
+		t.Errorf("invalid branding POST Location = %q, want %q", got, want)
+	}
+	if body := getSettingsBody(ctx, t, boss, baseURL); !strings.Contains(body, "Primary color must be a hex color") {
+		t.Errorf("settings page does not show the color error; body=%q", body)
+	}
+	if got, want := getBranding(ctx, t, boss, baseURL).Name, "Top Banana!"; got != want {
+		t.Errorf("branding name after a rejected save = %q, want %q", got, want)
+	}
+
+	token = fetchCSRFToken(ctx, t, boss, baseURL+"/admin/settings")
+	status, _, _ = postForm(ctx, t, boss, baseURL+"/admin/settings/branding", url.Values{
+		"csrf_token":    {token},
+		"name":          {"Quiz Club"},
+		"logo_url":      {"/media/logo.png"},
+		"primary_color": {"#336699"},
+	})
+	if got, want := status, http.StatusSeeOther; got != want {
+		t.Fatalf("branding POST status = %d, want %d", got, want)
+	}
+
+	want := client.Branding{Name: "Quiz Club", LogoURL: "/media/logo.png", PrimaryColor: "#336699"}
+	if got := getBranding(ctx, t, boss, baseURL); got != want {
+		t.Errorf("saved branding = %+v, want %+v", got, want)
+	}
+
+	for _, path := range []string{"/admin", "/", "/client/"} {
+		body := getPageBody(ctx, t, boss, baseURL+path)
+		for _, fragment := range []string{
+			`aria-label="Quiz Club"`, `src="/media/logo.png"`, "--color-accent: #336699",
+		} {
+			if !strings.Contains(body, fragment) {
+				t.Errorf("GET %s is missing %q", path, fragment)
+			}
+		}
+	}
+}
+
+// getBranding fetches GET /api/branding as c and decodes the bare response.
+func getBranding(ctx context.Context, t *testing.T, c *http.Client, baseURL string) client.Branding {
+	t.Helper()
+	resp := getWith(ctx, t, c, baseURL+"/api/branding")
+	body := readAPIBody(t, resp)
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("GET /api/branding status = %d, want %d", got, want)
+	}
+
+	var b client.Branding
+	if err := json.Unmarshal(body, &b); err != nil {
+		t.Fatalf("decode /api/branding err = %v, want nil", err)
+	}
+
+	return b
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log/slog"
 	"maps"
 	"net/http"
 	"net/http/cookiejar"
@@ -19,6 +18,8 @@ import (
 	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/quiz"
 	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/testserver"
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // httpGet issues a GET with a request-scoped context so the noctx linter is
@@ -53,51 +54,6 @@ func httpPostJSON(ctx context.Context, t *testing.T, client *http.Client, target
 	return resp
 }
 
-// nextQuestionOption mirrors one option in the GET /api/games/.../next
-// response. Pulled out so the parent decode target isn't a nested struct
-// (revive's nested-structs rule).
-type nextQuestionOption struct {
-	ID   int64  `json:"id"`
-	Text string `json:"text"`
-}
-
-// nextQuestionRes is the decode target for GET /api/games/.../next.
-type nextQuestionRes struct {
-	ID        int64                `json:"id"`
-	Text      string               `json:"text"`
-	Options   []nextQuestionOption `json:"options"`
-	StartedAt time.Time            `json:"startedAt"`
-	ServerNow time.Time            `json:"serverNow"`
-}
-
-// playerScoreRes mirrors one player_scores entry in the GET .../results
-// response. Pulled out for the same nested-structs reason.
-type playerScoreRes struct {
-	PlayerID int64 `json:"playerId"`
-	Score    int   `json:"score"`
-}
-
-// resultsRes is the decode target for GET /api/games/.../results.
-type resultsRes struct {
-	GameID       string           `json:"gameId"`
-	PlayerScores []playerScoreRes `json:"playerScores"`
-}
-
-// leaderboardEntryRes mirrors one entry in the leaderboard response. Pulled
-// out of the parent struct to keep nested-structs-friendly types.
-type leaderboardEntryRes struct {
-	PlayerID        int64  `json:"playerId"`
-	DisplayName     string `json:"displayName"`
-	Score           int    `json:"score"`
-	IsCurrentPlayer bool   `json:"isCurrentPlayer"`
-}
-
-// leaderboardRes is the decode target for GET /api/quizzes/{slugID}/leaderboard.
-type leaderboardRes struct {
-	QuizID  int64                 `json:"quizId"`
-	Entries []leaderboardEntryRes `json:"entries"`
-}
-
 const (
 	gameplayAdminDisplayName = "gameplay-admin"
 	gameplayAdminPassword    = "gameplay-admin-pass-123"
@@ -223,14 +179,16 @@ func registerGameplayAdmin(ctx context.Context, t *testing.T, client *http.Clien
 type integrationSetup struct {
 	BaseURL string
 	DBURI   string
-	Stores  *store.Stores
+	// DB is the harness's connection to the server's database, for direct
+	// reads and fixture writes the stores do not cover.
+	DB     *sql.DB
+	Stores *store.Stores
 }
 
-// setupIntegration is a gameplay-flavoured wrapper around startServer that
-// opens a *sql.DB against the same dbURI and exposes a store.Stores for
-// direct seeding. REGISTRATION_ENABLED is on so the admin-reset portion of
-// the test can register the first user (who becomes the admin) and POST to
-// /admin/quizzes/.../reset.
+// setupIntegration is a gameplay-flavoured wrapper around [testserver.Start]
+// that exposes its store.Stores for direct seeding. REGISTRATION_ENABLED is
+// on so the admin-reset portion of the test can register the first user (who
+// becomes the admin) and POST to /admin/quizzes/.../reset.
 func setupIntegration(t *testing.T) (context.Context, integrationSetup) {
 	t.Helper()
 
@@ -254,22 +212,13 @@ func setupIntegrationWithEnv(
 	env := map[string]string{"REGISTRATION_ENABLED": "true"}
 	maps.Copy(env, extraEnv)
 
-	ctx, srv := startServer(t, env, runOpts...)
-
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("failed to open db: %v", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
+	srv, stores, baseURL := testserver.Start(t, testserver.Options{Env: env, RunOptions: runOpts})
 
-	return ctx, integrationSetup{
-		BaseURL: srv.BaseURL,
+	return t.Context(), integrationSetup{
+		BaseURL: baseURL,
 		DBURI:   srv.DBURI,
-		Stores:  store.New(db, slog.Default()),
+		DB:      srv.DB,
+		Stores:  stores,
 	}
 }
 
@@ -410,10 +359,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("list quizzes status = %d, want %d", got, want)
 		}
 
-		var quizzesRes []struct {
-			Title       string `json:"title"`
-			Description string `json:"description"`
-		}
+		var quizzesRes []apiclient.Quiz
 		err = json.NewDecoder(resp.Body).Decode(&quizzesRes)
 		if cerr := resp.Body.Close(); cerr != nil {
 			t.Errorf("resp.Body.Close err = %v, want nil", cerr)
@@ -441,9 +387,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("create game status = %d, want %d", got, want)
 		}
 
-		var createGameRes struct {
-			ID string `json:"id"`
-		}
+		var createGameRes apiclient.CreateGameResponse
 		err := json.NewDecoder(resp.Body).Decode(&createGameRes)
 		if cerr := resp.Body.Close(); cerr != nil {
 			t.Errorf("resp.Body.Close err = %v, want nil", cerr)
@@ -462,7 +406,7 @@ func TestGameplay_Integration(t *testing.T) {
 				t.Fatalf("next question status = %d, want %d", got, want)
 			}
 
-			var nextQsRes nextQuestionRes
+			var nextQsRes apiclient.Question
 			err = json.NewDecoder(resp.Body).Decode(&nextQsRes)
 			if cerr := resp.Body.Close(); cerr != nil {
 				t.Errorf("resp.Body.Close err = %v, want nil", cerr)
@@ -518,11 +462,7 @@ func TestGameplay_Integration(t *testing.T) {
 				t.Fatalf("answer status = %d, want %d", got, want)
 			}
 
-			var answerRes struct {
-				Correct          bool    `json:"correct"`
-				Score            int     `json:"score"`
-				CorrectOptionIDs []int64 `json:"correctOptionIds"`
-			}
+			var answerRes apiclient.AnswerResponse
 			err = json.NewDecoder(resp.Body).Decode(&answerRes)
 			if cerr := resp.Body.Close(); cerr != nil {
 				t.Errorf("resp.Body.Close err = %v, want nil", cerr)
@@ -550,7 +490,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("results status = %d, want %d", got, want)
 		}
 
-		var results resultsRes
+		var results apiclient.Results
 		err = json.NewDecoder(resp.Body).Decode(&results)
 		if cerr := resp.Body.Close(); cerr != nil {
 			t.Errorf("resp.Body.Close err = %v, want nil", cerr)
@@ -592,7 +532,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("leaderboard status = %d, want %d", got, want)
 		}
 
-		var leaderboard leaderboardRes
+		var leaderboard apiclient.Leaderboard
 		err = json.NewDecoder(resp.Body).Decode(&leaderboard)
 		if cerr := resp.Body.Close(); cerr != nil {
 			t.Errorf("resp.Body.Close err = %v, want nil", cerr)
@@ -639,9 +579,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("player2 create game status = %d, want %d", got, want)
 		}
 
-		var createGame2Res struct {
-			ID string `json:"id"`
-		}
+		var createGame2Res apiclient.CreateGameResponse
 		if derr := json.NewDecoder(resp.Body).Decode(&createGame2Res); derr != nil {
 			t.Fatalf("failed to decode player2 create-game response: %v", derr)
 		}
@@ -657,7 +595,7 @@ func TestGameplay_Integration(t *testing.T) {
 				t.Fatalf("player2 next question status = %d, want %d", got, want)
 			}
 
-			var nextQs2Res nextQuestionRes
+			var nextQs2Res apiclient.Question
 			if derr := json.NewDecoder(resp.Body).Decode(&nextQs2Res); derr != nil {
 				t.Fatalf("failed to decode player2 next question: %v", derr)
 			}
@@ -694,10 +632,7 @@ func TestGameplay_Integration(t *testing.T) {
 				t.Fatalf("player2 answer status = %d, want %d", got, want)
 			}
 
-			var answer2Res struct {
-				Correct bool `json:"correct"`
-				Score   int  `json:"score"`
-			}
+			var answer2Res apiclient.AnswerResponse
 			if derr := json.NewDecoder(resp.Body).Decode(&answer2Res); derr != nil {
 				t.Fatalf("failed to decode player2 answer response: %v", derr)
 			}
@@ -723,7 +658,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("player2 leaderboard status = %d, want %d", got, want)
 		}
 
-		var leaderboard2 leaderboardRes
+		var leaderboard2 apiclient.Leaderboard
 		if derr := json.NewDecoder(resp.Body).Decode(&leaderboard2); derr != nil {
 			t.Fatalf("failed to decode player2 leaderboard response: %v", derr)
 		}
@@ -766,7 +701,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("player1 re-fetch leaderboard status = %d, want %d", got, want)
 		}
 
-		var leaderboard1Again leaderboardRes
+		var leaderboard1Again apiclient.Leaderboard
 		if derr := json.NewDecoder(resp.Body).Decode(&leaderboard1Again); derr != nil {
 			t.Fatalf("failed to decode player1 re-fetch leaderboard response: %v", derr)
 		}
@@ -806,10 +741,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("GET /my-game status = %d, want %d", got, want)
 		}
 
-		var myGameRes struct {
-			GameID    string `json:"gameId"`
-			Completed bool   `json:"completed"`
-		}
+		var myGameRes apiclient.GameForQuiz
 		if derr := json.NewDecoder(resp.Body).Decode(&myGameRes); derr != nil {
 			t.Fatalf("failed to decode my-game response: %v", derr)
 		}
@@ -868,9 +800,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("after reset, create game status = %d, want %d", got, want)
 		}
 
-		var freshGameRes struct {
-			ID string `json:"id"`
-		}
+		var freshGameRes apiclient.CreateGameResponse
 		if derr := json.NewDecoder(resp.Body).Decode(&freshGameRes); derr != nil {
 			t.Fatalf("failed to decode fresh create-game response: %v", derr)
 		}
@@ -922,7 +852,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("fresh next question status = %d, want %d", got, want)
 		}
 
-		var freshQs nextQuestionRes
+		var freshQs apiclient.Question
 		if derr := json.NewDecoder(resp.Body).Decode(&freshQs); derr != nil {
 			t.Fatalf("failed to decode fresh next question: %v", derr)
 		}
@@ -949,10 +879,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("in-flight /my-game status = %d, want %d", got, want)
 		}
 
-		var inFlightMyGame struct {
-			GameID    string `json:"gameId"`
-			Completed bool   `json:"completed"`
-		}
+		var inFlightMyGame apiclient.GameForQuiz
 		if derr := json.NewDecoder(resp.Body).Decode(&inFlightMyGame); derr != nil {
 			t.Fatalf("failed to decode in-flight my-game response: %v", derr)
 		}
@@ -1026,9 +953,7 @@ func TestGameplay_Integration(t *testing.T) {
 		if got, want := startResp.StatusCode, http.StatusCreated; got != want {
 			t.Fatalf("start game status = %d, want %d", got, want)
 		}
-		var startedGame struct {
-			ID string `json:"id"`
-		}
+		var startedGame apiclient.CreateGameResponse
 		if derr := json.NewDecoder(startResp.Body).Decode(&startedGame); derr != nil {
 			t.Fatalf("decode start game: %v", derr)
 		}
@@ -1038,7 +963,7 @@ func TestGameplay_Integration(t *testing.T) {
 
 		nextURL := fmt.Sprintf("%s/api/games/%s/questions/next", baseURL, startedGame.ID)
 		nextResp := httpGet(ctx, t, finalClient, nextURL)
-		var q1 nextQuestionRes
+		var q1 apiclient.Question
 		if derr := json.NewDecoder(nextResp.Body).Decode(&q1); derr != nil {
 			t.Fatalf("decode Q1: %v", derr)
 		}
@@ -1072,10 +997,7 @@ func TestGameplay_Integration(t *testing.T) {
 		if got, want := probe.StatusCode, http.StatusOK; got != want {
 			t.Fatalf("final-question /my-game status = %d, want %d", got, want)
 		}
-		var probeRes struct {
-			GameID    string `json:"gameId"`
-			Completed bool   `json:"completed"`
-		}
+		var probeRes apiclient.GameForQuiz
 		if derr := json.NewDecoder(probe.Body).Decode(&probeRes); derr != nil {
 			t.Fatalf("decode /my-game: %v", derr)
 		}
@@ -1100,7 +1022,7 @@ func TestGameplay_Integration(t *testing.T) {
 		if got, want := resp.StatusCode, http.StatusOK; got != want {
 			t.Fatalf("first /questions/next status = %d, want %d", got, want)
 		}
-		var first nextQuestionRes
+		var first apiclient.Question
 		if derr := json.NewDecoder(resp.Body).Decode(&first); derr != nil {
 			t.Fatalf("decode first /questions/next: %v", derr)
 		}
@@ -1112,7 +1034,7 @@ func TestGameplay_Integration(t *testing.T) {
 		if got, want := resp.StatusCode, http.StatusOK; got != want {
 			t.Fatalf("second /questions/next status = %d, want %d", got, want)
 		}
-		var second nextQuestionRes
+		var second apiclient.Question
 		if derr := json.NewDecoder(resp.Body).Decode(&second); derr != nil {
 			t.Fatalf("decode second /questions/next: %v", derr)
 		}
@@ -1252,9 +1174,7 @@ func TestGameplay_Integration(t *testing.T) {
 			t.Fatalf("post-delete /api/quizzes status = %d, want %d", got, want)
 		}
 
-		var afterDelete []struct {
-			Title string `json:"title"`
-		}
+		var afterDelete []apiclient.Quiz
 		if derr := json.NewDecoder(resp.Body).Decode(&afterDelete); derr != nil {
 			t.Fatalf("failed to decode quizzes after delete: %v", derr)
 		}
@@ -26,6 +26,9 @@ import (
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/starquake/topbanana/internal/dbtest"
+	"github.com/starquake/topbanana/internal/testserver"
 )
 
 const (
@@ -542,10 +545,7 @@ func doGet(ctx context.Context, t *testing.T, client *http.Client, target string
 func requireDBRowCounts(t *testing.T, dbURI, email string, wantPlayers, wantIdentities int) {
 	t.Helper()
 
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
@@ -582,10 +582,7 @@ func requireDBRowCounts(t *testing.T, dbURI, email string, wantPlayers, wantIden
 func lookupOnlyPlayerID(t *testing.T, dbURI string) int64 {
 	t.Helper()
 
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
@@ -624,10 +621,7 @@ func lookupOnlyPlayerID(t *testing.T, dbURI string) int64 {
 func lookupPlayerIDByEmail(t *testing.T, dbURI, email string) int64 {
 	t.Helper()
 
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
@@ -650,10 +644,7 @@ func lookupPlayerIDByEmail(t *testing.T, dbURI, email string) int64 {
 func seedPlayerWithEmail(t *testing.T, dbURI, displayName, email string) {
 	t.Helper()
 
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
@@ -677,10 +668,7 @@ func seedPlayerWithEmail(t *testing.T, dbURI, displayName, email string) {
 func seedCredentialledPlayer(t *testing.T, dbURI, displayName, email string) {
 	t.Helper()
 
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
@@ -704,10 +692,7 @@ func seedCredentialledPlayer(t *testing.T, dbURI, displayName, email string) {
 func seedAdminPlayerWithEmail(t *testing.T, dbURI, displayName, email string) {
 	t.Helper()
 
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
@@ -728,10 +713,7 @@ func seedAdminPlayerWithEmail(t *testing.T, dbURI, displayName, email string) {
 func lookupPlayerRoleByEmail(t *testing.T, dbURI, email string) (string, sql.NullString) {
 	t.Helper()
 
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
@@ -765,7 +747,7 @@ func lookupPlayerRoleByEmail(t *testing.T, dbURI, email string) (string, sql.Nul
 // the create-fresh path is exercised by default. Tests that need
 // registration off use startGoogleServerEnv with
 // REGISTRATION_ENABLED=false.
-func startGoogleServer(t *testing.T, mock *googleMock) (context.Context, testServer) {
+func startGoogleServer(t *testing.T, mock *googleMock) (context.Context, *testserver.Server) {
 	t.Helper()
 
 	return startGoogleServerEnv(t, mock, map[string]string{"REGISTRATION_ENABLED": "true"})
@@ -773,7 +755,9 @@ func startGoogleServer(t *testing.T, mock *googleMock) (context.Context, testSer
 
 // startGoogleServerEnv is startGoogleServer with caller-supplied extra
 // env merged on top of the Google OAuth defaults.
-func startGoogleServerEnv(t *testing.T, mock *googleMock, extra map[string]string) (context.Context, testServer) {
+func startGoogleServerEnv(
+	t *testing.T, mock *googleMock, extra map[string]string,
+) (context.Context, *testserver.Server) {
 	t.Helper()
 
 	// The mock's URL is also the redirect host as far as the mock
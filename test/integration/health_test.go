@@ -1,6 +1,8 @@
 package integration_test
 
 import (
+	"encoding/json"
+	"net/http"
 	"testing"
 )
 
@@ -12,3 +14,88 @@ func TestHeath_Integration(t *testing.T) {
 	// cleanup it registers also asserts the server shuts down cleanly.
 	startServer(t, nil)
 }
+
+func TestLivezReadyz_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, nil)
+
+	for _, path := range []string{"/livez", "/readyz"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.BaseURL+path, nil)
+		if err != nil {
+			t.Fatalf("NewRequest err = %v, want nil", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET %s err = %v, want nil", path, err)
+		}
+		var body struct {
+			Status string            `json:"status"`
+			Checks map[string]string `json:"checks"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		_ = resp.Body.Close()
+		if err != nil {
+			t.Fatalf("decode %s err = %v, want nil", path, err)
+		}
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Errorf("GET %s status = %d, want %d", path, got, want)
+		}
+		if got, want := body.Status, "ok"; got != want {
+			t.Errorf("GET %s body status = %q, want %q (checks %v)", path, got, want, body.Checks)
+		}
+	}
+}
+
+// TestHealthzDetail_Integration pins the detail report's gate and wiring: a
+// Host gets a 404 like the rest of the Admin-only console, an Admin gets
+// every component, including the running session runner and both hubs.
+func TestHealthzDetail_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"ADMIN_EMAILS":         "detail-boss@example.test",
+	})
+	baseURL := srv.BaseURL
+
+	boss := registerAdminClient(ctx, t, baseURL, srv.DBURI, "detail-boss")
+	host := registerAdminClient(ctx, t, baseURL, srv.DBURI, "detail-host")
+	makeHost(ctx, t, srv.DBURI, "detail-host")
+
+	resp := getWith(ctx, t, host, baseURL+"/healthz/detail")
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("detail status for host = %d, want %d", got, want)
+	}
+
+	resp = getWith(ctx, t, boss, baseURL+"/healthz/detail")
+	defer closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("detail status for admin = %d, want %d", got, want)
+	}
+	var body struct {
+		Status     string `json:"status"`
+		Migrations struct {
+			Version int64 `json:"version"`
+		} `json:"migrations"`
+		Runner *struct {
+			IntervalMs int64 `json:"intervalMs"`
+		} `json:"runner"`
+		Hubs map[string]int `json:"hubs"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode detail err = %v, want nil", err)
+	}
+	if body.Migrations.Version == 0 {
+		t.Error("detail migrations version = 0, want the applied version")
+	}
+	if body.Runner == nil || body.Runner.IntervalMs == 0 {
+		t.Errorf("detail runner = %+v, want the session runner's heartbeat", body.Runner)
+	}
+	for _, hub := range []string{"leaderboard", "sessions"} {
+		if _, ok := body.Hubs[hub]; !ok {
+			t.Errorf("detail hubs = %v, want %q", body.Hubs, hub)
+		}
+	}
+}
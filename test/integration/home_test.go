@@ -179,7 +179,7 @@ func TestHome_Integration(t *testing.T) {
 			`data-share-text="Play this quiz: Bananas of the World"`,
 			`data-share-path="/play/capital-cities-`,
 			`data-share-title="Capital Cities"`,
-			`<script type="module" src="/static/js/dist/share.js"></script>`,
+			`<script type="module" src="/static/js/dist/share.js?v=`,
 		} {
 			if !strings.Contains(body, want) {
 				t.Errorf("body missing share-trigger marker %q", want)
@@ -4,9 +4,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/starquake/topbanana/internal/auth"
 	"github.com/starquake/topbanana/internal/livesession"
 )
 
@@ -186,3 +188,71 @@ func TestHostSession_EndAuthz(t *testing.T) {
 		}
 	})
 }
+
+// TestHostSession_PlayerNotes pins the host's notes on a participant: the
+// host's save lands on the roster the host reads, stays out of the players'
+// own state reads, and is audit-logged against the participant. A foreign
+// host 404s and overlong notes are a 400.
+func TestHostSession_PlayerNotes(t *testing.T) {
+	t.Parallel()
+
+	const foreignEmail = "host-notes-other@example.test"
+	ctx, setup := setupIntegrationWithEnv(t, map[string]string{"ADMIN_EMAILS": foreignEmail})
+	baseURL := setup.BaseURL
+	qz := seedLiveQuiz(ctx, t, setup.Stores.Quizzes, "host-notes")
+
+	host := &http.Client{
+		Jar:           mustJar(t),
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	registerVerifyAndSignIn(ctx, t, host, baseURL, setup.DBURI, "host-notes-host", "host-notes-pass-123")
+	code := createSession(ctx, t, host, baseURL, qz.ID)
+
+	alice := newAnonClient(t)
+	joinSession(ctx, t, alice, baseURL, code, "Alice")
+	state := getSessionState(ctx, t, host, baseURL, code)
+	aliceID := state.Players[0].PlayerID
+	notesURL := baseURL + "/host/" + code + "/players/" + strconv.FormatInt(aliceID, 10) + "/notes"
+
+	token := fetchCSRFToken(ctx, t, host, baseURL+"/host/"+code)
+	resp := httpPostForm(ctx, t, host, notesURL, url.Values{
+		"csrf_token": {token},
+		"notes":      {" table 4, name pending "},
+	})
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusSeeOther; got != want {
+		t.Fatalf("host notes status = %d, want %d", got, want)
+	}
+
+	if got, want := getSessionState(ctx, t, host, baseURL, code).Players[0].Notes, "table 4, name pending"; got != want {
+		t.Errorf("host's roster notes = %q, want %q", got, want)
+	}
+	if got := getSessionState(ctx, t, alice, baseURL, code).Players[0].Notes; got != "" {
+		t.Errorf("player's roster notes = %q, want none (notes are host-only)", got)
+	}
+	assertAuditRow(ctx, t, setup.DBURI, aliceID, state.HostID, auth.AdminActionParticipantNotes)
+
+	resp = httpPostForm(ctx, t, host, notesURL, url.Values{
+		"csrf_token": {token},
+		"notes":      {strings.Repeat("x", livesession.MaxPlayerNotesLength+1)},
+	})
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+		t.Errorf("overlong notes status = %d, want %d", got, want)
+	}
+
+	foreign := &http.Client{
+		Jar:           mustJar(t),
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	registerVerifyViaLinkAndMint(ctx, t, foreign, baseURL, setup.DBURI, "host-notes-other", "host-notes-other-123")
+	foreignToken := fetchCSRFToken(ctx, t, foreign, baseURL+"/admin/quizzes")
+	resp = httpPostForm(ctx, t, foreign, notesURL, url.Values{
+		"csrf_token": {foreignToken},
+		"notes":      {"mine now"},
+	})
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("foreign host notes status = %d, want %d", got, want)
+	}
+}
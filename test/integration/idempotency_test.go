@@ -0,0 +1,72 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	apiclient "github.com/starquake/topbanana/pkg/client"
+)
+
+// postCreateGameWithKey issues POST /api/games carrying an Idempotency-Key and
+// returns the status and decoded game id.
+func postCreateGameWithKey(
+	ctx context.Context, t *testing.T, client *http.Client, baseURL, key string, quizID int64,
+) (int, string) {
+	t.Helper()
+	body := fmt.Sprintf(`{"quizId":%d}`, quizID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/api/games", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", key)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("client.Do err = %v, want nil", err)
+	}
+	defer closeBody(t, resp.Body)
+
+	var res apiclient.CreateGameResponse
+	if resp.StatusCode == http.StatusCreated {
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			t.Fatalf("decode create game err = %v, want nil", err)
+		}
+	}
+
+	return resp.StatusCode, res.ID
+}
+
+// TestCreateGame_IdempotencyKeyReplays drives the retry-after-network-error
+// case through the real stack: a second POST /api/games with the same key and
+// body gets the original game back rather than a 409 or a duplicate game, and a
+// reused key with a different body is refused.
+func TestCreateGame_IdempotencyKeyReplays(t *testing.T) {
+	t.Parallel()
+
+	ctx, setup := setupIntegration(t)
+	qz := seedSoloQuiz(ctx, t, setup.Stores.Quizzes, "idempotent-create")
+	other := seedSoloQuiz(ctx, t, setup.Stores.Quizzes, "idempotent-create-other")
+	client := newAnonClient(t)
+
+	status, firstID := postCreateGameWithKey(ctx, t, client, setup.BaseURL, "retry-1", qz.ID)
+	if got, want := status, http.StatusCreated; got != want {
+		t.Fatalf("first create status = %d, want %d", got, want)
+	}
+
+	status, retryID := postCreateGameWithKey(ctx, t, client, setup.BaseURL, "retry-1", qz.ID)
+	if got, want := status, http.StatusCreated; got != want {
+		t.Fatalf("retry create status = %d, want %d", got, want)
+	}
+	if got, want := retryID, firstID; got != want {
+		t.Errorf("retry game id = %q, want %q (replayed)", got, want)
+	}
+
+	status, _ = postCreateGameWithKey(ctx, t, client, setup.BaseURL, "retry-1", other.ID)
+	if got, want := status, http.StatusUnprocessableEntity; got != want {
+		t.Errorf("mismatched-body create status = %d, want %d", got, want)
+	}
+}
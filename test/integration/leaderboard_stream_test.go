@@ -3,11 +3,9 @@ package integration_test
 import (
 	"bufio"
 	"context"
-	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log/slog"
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
@@ -16,7 +14,8 @@ import (
 
 	"github.com/starquake/topbanana/cmd/server/app"
 	"github.com/starquake/topbanana/internal/quiz"
-	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/testserver"
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // TestLeaderboardStream_Integration covers the SSE leaderboard pipe (#239)
@@ -34,18 +33,8 @@ import (
 func TestLeaderboardStream_Integration(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, nil)
-
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
+	srv, stores, _ := testserver.Start(t, testserver.Options{})
+	ctx := t.Context()
 
 	// Seed: one quiz, one question, two options (one correct).
 	qz := &quiz.Quiz{
@@ -238,21 +227,11 @@ func TestLeaderboardStream_HeartbeatKeepsConnectionAlivePastWriteTimeout(t *test
 		window            = 1500 * time.Millisecond
 	)
 
-	ctx, srv := startServer(t, nil,
+	srv, stores, _ := testserver.Start(t, testserver.Options{RunOptions: []app.Option{
 		app.WithWriteTimeout(writeTimeout),
 		app.WithLeaderboardHeartbeatInterval(heartbeatInterval),
-	)
-
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
+	}})
+	ctx := t.Context()
 
 	qz := &quiz.Quiz{
 		Title:             "Heartbeat Quiz",
@@ -380,18 +359,8 @@ func TestLeaderboardStream_UnknownQuiz_Returns404(t *testing.T) {
 func TestQuizLeaderboard_ShowsParticipantBeforeAnyAnswer(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, nil)
-
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
+	srv, stores, _ := testserver.Start(t, testserver.Options{})
+	ctx := t.Context()
 
 	qz := &quiz.Quiz{
 		Title:             "Pre-Answer Quiz",
@@ -452,7 +421,7 @@ func TestQuizLeaderboard_ShowsParticipantBeforeAnyAnswer(t *testing.T) {
 		t.Fatalf("leaderboard status = %d, want %d", got, want)
 	}
 
-	var payload leaderboardEventPayload
+	var payload apiclient.Leaderboard
 	if err = json.NewDecoder(lbResp.Body).Decode(&payload); err != nil {
 		t.Fatalf("Decode leaderboard err = %v, want nil", err)
 	}
@@ -493,18 +462,8 @@ func TestQuizLeaderboard_ShowsParticipantBeforeAnyAnswer(t *testing.T) {
 func TestLeaderboardStream_NameUpdate_RepaintsSubscribers(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, nil)
-
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
+	srv, stores, _ := testserver.Start(t, testserver.Options{})
+	ctx := t.Context()
 
 	qz := &quiz.Quiz{
 		Title:             "Name Update Quiz",
@@ -705,29 +664,10 @@ func getMyDisplayName(ctx context.Context, t *testing.T, baseURL string, client
 	return out.DisplayName
 }
 
-// leaderboardEventEntry mirrors one row in the SSE leaderboard payload.
-// Lifted to package scope so revive's nested-structs rule is happy.
-type leaderboardEventEntry struct {
-	PlayerID        int64  `json:"playerId"`
-	DisplayName     string `json:"displayName"`
-	Score           int    `json:"score"`
-	Rank            int    `json:"rank"`
-	IsCurrentPlayer bool   `json:"isCurrentPlayer"`
-	InProgress      bool   `json:"inProgress"`
-}
-
-// leaderboardEventPayload mirrors the JSON shape the SSE handler emits.
-// Local to the integration test rather than shared so the test pins the
-// wire contract independently of the production type names.
-type leaderboardEventPayload struct {
-	QuizID  int64                   `json:"quizId"`
-	Entries []leaderboardEventEntry `json:"entries"`
-}
-
 // readSSEEvent consumes one `data: ...\n\n` event from the SSE stream
 // and decodes the payload. SSE events end with a blank line; we scan
 // line-by-line and stitch back the `data:` payload.
-func readSSEEvent(t *testing.T, scanner *bufio.Scanner) leaderboardEventPayload {
+func readSSEEvent(t *testing.T, scanner *bufio.Scanner) apiclient.Leaderboard {
 	t.Helper()
 
 	var dataLine string
@@ -748,7 +688,7 @@ func readSSEEvent(t *testing.T, scanner *bufio.Scanner) leaderboardEventPayload
 		t.Fatal("no SSE event received before stream closed or timeout")
 	}
 
-	var payload leaderboardEventPayload
+	var payload apiclient.Leaderboard
 	if err := json.Unmarshal([]byte(dataLine), &payload); err != nil {
 		t.Fatalf("Unmarshal SSE payload err = %v, body = %q", err, dataLine)
 	}
@@ -764,9 +704,7 @@ func decodeGameID(t *testing.T, resp *http.Response) string {
 	if got, want := resp.StatusCode, http.StatusCreated; got != want {
 		t.Fatalf("create game status = %d, want %d", got, want)
 	}
-	var out struct {
-		ID string `json:"id"`
-	}
+	var out apiclient.CreateGameResponse
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
 		t.Fatalf("decode game err = %v, want nil", err)
 	}
@@ -777,20 +715,6 @@ func decodeGameID(t *testing.T, resp *http.Response) string {
 	return out.ID
 }
 
-// streamTestQuestionOption mirrors one option in the GET /next response.
-// Test-local name (with the test-file prefix) so it doesn't collide with
-// the same-shaped helper in gameplay_test.go.
-type streamTestQuestionOption struct {
-	ID int64 `json:"id"`
-}
-
-// streamTestQuestionResponse is the decode target for GET /next. Only the
-// fields this test needs are modelled.
-type streamTestQuestionResponse struct {
-	ID      int64                      `json:"id"`
-	Options []streamTestQuestionOption `json:"options"`
-}
-
 // pickedOption is the typed return for [decodeQuestionAndPickCorrect].
 // Returning a struct (instead of (int64, int64)) keeps the
 // confusing-results lint rule happy.
@@ -810,7 +734,7 @@ func decodeQuestionAndPickCorrect(t *testing.T, resp *http.Response, qz *quiz.Qu
 	if got, want := resp.StatusCode, http.StatusOK; got != want {
 		t.Fatalf("next status = %d, want %d", got, want)
 	}
-	var out streamTestQuestionResponse
+	var out apiclient.Question
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
 		t.Fatalf("decode next err = %v", err)
 	}
@@ -2,12 +2,15 @@ package integration_test
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
 	"testing"
+
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // TestLogin_RateLimited pins #494: two POSTs to /login from the same
@@ -75,6 +78,18 @@ func TestLogin_RateLimited(t *testing.T) {
 	if got := limited.Header.Get("Retry-After"); got == "" {
 		t.Error("Retry-After header empty on rate-limited POST")
 	}
+	for _, r := range resps {
+		if got, want := r.Header.Get("RateLimit-Limit"), "1"; got != want {
+			t.Errorf("status %d: RateLimit-Limit = %q, want %q", r.StatusCode, got, want)
+		}
+		if got, want := r.Header.Get("RateLimit-Remaining"), "0"; got != want {
+			t.Errorf("status %d: RateLimit-Remaining = %q, want %q", r.StatusCode, got, want)
+		}
+		if got := r.Header.Get("RateLimit-Reset"); got == "" || got == "0" {
+			t.Errorf("status %d: RateLimit-Reset = %q, want a positive wait", r.StatusCode, got)
+		}
+	}
+	requireLoginLimit(ctx, t, client1, srv.BaseURL, 0)
 	body, err := io.ReadAll(limited.Body)
 	if err != nil {
 		t.Fatalf("ReadAll err = %v, want nil", err)
@@ -84,6 +99,32 @@ func TestLogin_RateLimited(t *testing.T) {
 	}
 }
 
+// requireLoginLimit reads GET /api/limits on client and fails unless its
+// login entry reports wantRemaining attempts out of one.
+func requireLoginLimit(ctx context.Context, t *testing.T, client *http.Client, baseURL string, wantRemaining int) {
+	t.Helper()
+	resp := httpGet(ctx, t, client, baseURL+"/api/limits")
+	defer closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("/api/limits status = %d, want %d", got, want)
+	}
+	var res apiclient.Limits
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatalf("decode /api/limits err = %v, want nil", err)
+	}
+	for _, l := range res.Limits {
+		if l.Name != "login" {
+			continue
+		}
+		if l.Limit != 1 || l.Remaining != wantRemaining {
+			t.Errorf("login limit = %+v, want %d of 1 remaining", l, wantRemaining)
+		}
+
+		return
+	}
+	t.Errorf("/api/limits = %+v, want a login entry", res.Limits)
+}
+
 // postLoginWithToken POSTs /login with the supplied credentials and a
 // pre-fetched CSRF token, returning the raw response. The token is
 // passed in (rather than fetched here) so two concurrent POSTs can
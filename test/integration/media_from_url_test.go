@@ -0,0 +1,85 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// TestMediaFromURL_Integration covers adding a question image from a URL
+// through the real routing and gates. The fetch itself is unit-tested in
+// internal/mediahttp; here every remote is an httptest server on loopback,
+// which the production fetcher must refuse, so the cases pin the edit gate,
+// the input check and the address guard end to end.
+func TestMediaFromURL_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, setup := setupMedia(t, nil)
+	baseURL := setup.BaseURL
+
+	owner := registerAdminClient(ctx, t, baseURL, setup.DBURI, "from-url-owner")
+	other := registerAdminClient(ctx, t, baseURL, setup.DBURI, "from-url-other")
+	makeHost(ctx, t, setup.DBURI, "from-url-owner")
+	makeHost(ctx, t, setup.DBURI, "from-url-other")
+
+	quizID := createQuizAs(ctx, t, owner, baseURL, "From URL Quiz")
+
+	pic := pngBytes(t, 64, 64)
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(pic)
+	}))
+	t.Cleanup(remote.Close)
+
+	t.Run("loopback address is refused", func(t *testing.T) {
+		t.Parallel()
+		status, body := postFromURL(ctx, t, owner, baseURL, quizID, remote.URL+"/pic.png")
+		if got, want := status, http.StatusBadRequest; got != want {
+			t.Fatalf("status = %d, want %d; body=%q", got, want, body)
+		}
+		if got, want := body, "cannot be fetched"; !strings.Contains(got, want) {
+			t.Errorf("body = %q, should contain %q", got, want)
+		}
+	})
+
+	t.Run("non-http URL is 400", func(t *testing.T) {
+		t.Parallel()
+		status, body := postFromURL(ctx, t, owner, baseURL, quizID, "file:///etc/passwd")
+		if got, want := status, http.StatusBadRequest; got != want {
+			t.Errorf("status = %d, want %d; body=%q", got, want, body)
+		}
+	})
+
+	t.Run("non-owner host gets an opaque 404", func(t *testing.T) {
+		t.Parallel()
+		status, body := postFromURL(ctx, t, other, baseURL, quizID, "https://example.com/pic.png")
+		if got, want := status, http.StatusNotFound; got != want {
+			t.Errorf("status = %d, want %d; body=%q", got, want, body)
+		}
+	})
+}
+
+// postFromURL posts target to the quiz's from-url route and returns the status
+// and body.
+func postFromURL(
+	ctx context.Context, t *testing.T, client *http.Client, baseURL string, quizID int64, target string,
+) (int, string) {
+	t.Helper()
+	token := fetchCSRFToken(ctx, t, client, baseURL+"/admin/quizzes")
+	form := url.Values{"csrf_token": {token}, "url": {target}}
+	req := newFormReq(ctx, t, baseURL+fmt.Sprintf("/admin/quizzes/%d/media/from-url", quizID), form)
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("from-url Do err = %v, want nil", err)
+	}
+	defer closeBody(t, resp.Body)
+	body, _ := io.ReadAll(resp.Body)
+
+	return resp.StatusCode, string(body)
+}
@@ -0,0 +1,57 @@
+package integration_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/starquake/topbanana/internal/auth"
+)
+
+// avatarColorPattern matches the "#rrggbb" avatar colors the identity palette
+// uses.
+var avatarColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// TestPlayerIdentity_Integration pins the generated identity on the player
+// bootstrap endpoint: a fresh visitor's default display name is the nickname
+// derived from their id, the nickname and avatar color are stable across
+// requests, and renaming changes the display name but not the generated
+// identity the client draws the avatar from.
+func TestPlayerIdentity_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, nil)
+	baseURL := srv.BaseURL
+	client := newAnonClient(t)
+
+	me := fetchPlayerMe(ctx, t, client, baseURL)
+	want := auth.AnimalIdentity{}.Identity(me.ID)
+	if got := me.Nickname; got != want.Nickname {
+		t.Errorf("nickname = %q, want %q", got, want.Nickname)
+	}
+	if got := me.AvatarColor; got != want.Color || !avatarColorPattern.MatchString(got) {
+		t.Errorf("avatarColor = %q, want %q", got, want.Color)
+	}
+	if got := me.DisplayName; got != want.Nickname {
+		t.Errorf("default displayName = %q, want the generated nickname %q", got, want.Nickname)
+	}
+	if me.HasCustomName {
+		t.Error("hasCustomName = true for a generated nickname, want false")
+	}
+
+	if again := fetchPlayerMe(ctx, t, client, baseURL); again != me {
+		t.Errorf("second /api/players/me = %+v, want %+v", again, me)
+	}
+
+	if got, want := patchPlayerDisplayName(ctx, t, client, baseURL, "Identity Renamer"), http.StatusOK; got != want {
+		t.Fatalf("PATCH status = %d, want %d", got, want)
+	}
+	renamed := fetchPlayerMe(ctx, t, client, baseURL)
+	if got, want := renamed.DisplayName, "Identity Renamer"; got != want {
+		t.Errorf("displayName after rename = %q, want %q", got, want)
+	}
+	if renamed.Nickname != me.Nickname || renamed.AvatarColor != me.AvatarColor {
+		t.Errorf("identity after rename = %q %q, want %q %q",
+			renamed.Nickname, renamed.AvatarColor, me.Nickname, me.AvatarColor)
+	}
+}
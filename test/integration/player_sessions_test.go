@@ -0,0 +1,155 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/starquake/topbanana/pkg/client"
+)
+
+// TestPlayerSessions_Integration signs one account in on three clients and
+// walks the device list: each client shows up once it is used, revoking one
+// signs that client out while the others stay in, and revoking the others
+// leaves only the client that asked.
+//
+//nolint:paralleltest,tparallel // the steps share the three cookie jars and must run in order.
+func TestPlayerSessions_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, srv := startServer(t, map[string]string{
+		"REGISTRATION_ENABLED": "true",
+		"LOGIN_COOLDOWN":       "1ms",
+	})
+	displayName := "devices-player"
+	password := "correct-battery-13"
+
+	laptop := authClient(t)
+	registerVerifyAndMint(ctx, t, laptop, srv.BaseURL, srv.DBURI, displayName, password)
+	phone := authClient(t)
+	loginForRedirect(ctx, t, phone, srv.BaseURL, displayName, password)
+	tablet := authClient(t)
+	loginForRedirect(ctx, t, tablet, srv.BaseURL, displayName, password)
+
+	// A session is recorded on its first request past the auth middleware.
+	for _, c := range []*http.Client{phone, tablet} {
+		if got := profileStatus(ctx, t, c, srv.BaseURL); got != http.StatusOK {
+			t.Fatalf("profile status = %d, want %d", got, http.StatusOK)
+		}
+	}
+	phoneID := currentSessionID(t, listSessions(ctx, t, phone, srv.BaseURL))
+
+	t.Run("lists every signed-in device", func(t *testing.T) {
+		sessions := listSessions(ctx, t, laptop, srv.BaseURL)
+		if got, want := len(sessions), 3; got != want {
+			t.Fatalf("len(sessions) = %d, want %d", got, want)
+		}
+		if id := currentSessionID(t, sessions); id == phoneID {
+			t.Errorf("laptop's current session = %q, the phone's", id)
+		}
+	})
+
+	t.Run("revoking one signs only that device out", func(t *testing.T) {
+		if got, want := deleteSession(ctx, t, laptop, srv.BaseURL+"/api/players/me/sessions/"+phoneID),
+			http.StatusNoContent; got != want {
+			t.Fatalf("revoke status = %d, want %d", got, want)
+		}
+		if got, want := profileStatus(ctx, t, phone, srv.BaseURL), http.StatusSeeOther; got != want {
+			t.Errorf("revoked phone profile status = %d, want %d (signed out)", got, want)
+		}
+		if got, want := profileStatus(ctx, t, tablet, srv.BaseURL), http.StatusOK; got != want {
+			t.Errorf("tablet profile status = %d, want %d", got, want)
+		}
+		if got, want := deleteSession(ctx, t, laptop, srv.BaseURL+"/api/players/me/sessions/"+phoneID),
+			http.StatusNotFound; got != want {
+			t.Errorf("second revoke status = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("revoking the others keeps this device", func(t *testing.T) {
+		if got, want := deleteSession(ctx, t, laptop, srv.BaseURL+"/api/players/me/sessions"),
+			http.StatusNoContent; got != want {
+			t.Fatalf("revoke others status = %d, want %d", got, want)
+		}
+		if got, want := profileStatus(ctx, t, tablet, srv.BaseURL), http.StatusSeeOther; got != want {
+			t.Errorf("tablet profile status = %d, want %d (signed out)", got, want)
+		}
+		if got, want := profileStatus(ctx, t, laptop, srv.BaseURL), http.StatusOK; got != want {
+			t.Errorf("laptop profile status = %d, want %d (kept)", got, want)
+		}
+		sessions := listSessions(ctx, t, laptop, srv.BaseURL)
+		if len(sessions) != 1 || !sessions[0].Current {
+			t.Errorf("sessions = %+v, want only the current one", sessions)
+		}
+	})
+}
+
+// listSessions reads GET /api/players/me/sessions as c.
+func listSessions(ctx context.Context, t *testing.T, c *http.Client, baseURL string) []client.PlayerSession {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/api/players/me/sessions", nil)
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	req.Header.Set("X-Api-Envelope", "1")
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("client.Do err = %v, want nil", err)
+	}
+	defer closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("sessions status = %d, want %d", got, want)
+	}
+	var res struct {
+		Data []client.PlayerSession `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatalf("decode err = %v, want nil", err)
+	}
+
+	return res.Data
+}
+
+// currentSessionID returns the id of the session marked current.
+func currentSessionID(t *testing.T, sessions []client.PlayerSession) string {
+	t.Helper()
+
+	for _, s := range sessions {
+		if s.Current {
+			return s.ID
+		}
+	}
+	t.Fatalf("no current session in %+v", sessions)
+
+	return ""
+}
+
+// deleteSession sends a DELETE to target as c and returns the status.
+func deleteSession(ctx context.Context, t *testing.T, c *http.Client, target string) int {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, target, nil)
+	if err != nil {
+		t.Fatalf("NewRequest err = %v, want nil", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("client.Do err = %v, want nil", err)
+	}
+	closeBody(t, resp.Body)
+
+	return resp.StatusCode
+}
+
+// profileStatus returns the status of GET /profile as c: 200 while signed
+// in, a 303 to /login once the session is gone.
+func profileStatus(ctx context.Context, t *testing.T, c *http.Client, baseURL string) int {
+	t.Helper()
+
+	resp := getWith(ctx, t, c, baseURL+"/profile")
+	closeBody(t, resp.Body)
+
+	return resp.StatusCode
+}
@@ -2,17 +2,15 @@ package integration_test
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"io"
-	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
 	"testing"
 
 	"github.com/starquake/topbanana/internal/quiz"
-	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/testserver"
 )
 
 // TestQuestionIDOR_Integration pins the #339 fix: a question route
@@ -26,26 +24,15 @@ import (
 func TestQuestionIDOR_Integration(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, map[string]string{
+	srv, stores, baseURL := testserver.Start(t, testserver.Options{Env: map[string]string{
 		"REGISTRATION_ENABLED": "true",
 		"ADMIN_EMAILS":         "idor-admin-a@example.test,idor-admin-b@example.test",
-	})
-	baseURL := srv.BaseURL
+	}})
+	ctx := t.Context()
 
 	adminA := registerAdminClient(ctx, t, baseURL, srv.DBURI, "idor-admin-a")
 	adminB := registerAdminClient(ctx, t, baseURL, srv.DBURI, "idor-admin-b")
 
-	db, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
-	t.Cleanup(func() {
-		if cerr := db.Close(); cerr != nil {
-			t.Errorf("db.Close err = %v, want nil", cerr)
-		}
-	})
-	stores := store.New(db, slog.Default())
-
 	playerA, err := stores.Players.GetPlayerByDisplayName(ctx, "idor-admin-a")
 	if err != nil {
 		t.Fatalf("GetPlayerByDisplayName(a) err = %v, want nil", err)
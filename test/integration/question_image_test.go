@@ -10,6 +10,7 @@ import (
 	"github.com/starquake/topbanana/internal/media"
 	"github.com/starquake/topbanana/internal/quiz"
 	"github.com/starquake/topbanana/internal/store"
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // questionImageRes is one question's text + image field, shared by the /next
@@ -19,11 +20,6 @@ type questionImageRes struct {
 	ImageURL string `json:"imageUrl"`
 }
 
-// createGameRes decodes the POST /api/games response.
-type createGameRes struct {
-	ID string `json:"id"`
-}
-
 // sessionImageRes decodes only the current question's image field off the live
 // session state DTO (GET /api/sessions/{code}/state).
 type sessionImageRes struct {
@@ -73,14 +69,14 @@ func attachMediaToQuestion(
 // the decoded response, closing the body before returning.
 func createSoloGame(
 	ctx context.Context, t *testing.T, client *http.Client, baseURL string, quizID int64,
-) createGameRes {
+) apiclient.CreateGameResponse {
 	t.Helper()
 	resp := httpPostJSON(ctx, t, client, baseURL+"/api/games", fmt.Sprintf(`{"quizId": %d}`, quizID))
 	defer closeBody(t, resp.Body)
 	if got, want := resp.StatusCode, http.StatusCreated; got != want {
 		t.Fatalf("create game status = %d, want %d", got, want)
 	}
-	var created createGameRes
+	var created apiclient.CreateGameResponse
 	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
 		t.Fatalf("decode create game: %v", err)
 	}
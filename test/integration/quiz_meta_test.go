@@ -22,7 +22,7 @@ func TestQuizMeta_Integration(t *testing.T) {
 	baseURL := setup.BaseURL
 	stores := setup.Stores
 
-	newQuiz := func(title, slug, desc, visibility, mode string, published bool) *quiz.Quiz {
+	newQuiz := func(title, slug, desc, visibility string, mode quiz.Mode, published bool) *quiz.Quiz {
 		return &quiz.Quiz{
 			Title:             title,
 			Published:         published,
@@ -93,7 +93,7 @@ func TestQuizMeta_Integration(t *testing.T) {
 		if got, want := meta.Slug, "public-meta"; got != want {
 			t.Errorf("slug = %q, want %q", got, want)
 		}
-		if got, want := meta.Mode, quiz.ModeSolo; got != want {
+		if got, want := meta.Mode, string(quiz.ModeSolo); got != want {
 			t.Errorf("mode = %q, want %q", got, want)
 		}
 	})
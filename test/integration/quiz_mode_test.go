@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/starquake/topbanana/internal/quiz"
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // TestQuizModeGating_Integration pins the MP-0 solo gate (#677): a live
@@ -67,9 +68,7 @@ func TestQuizModeGating_Integration(t *testing.T) {
 		if got, want := resp.StatusCode, http.StatusOK; got != want {
 			t.Fatalf("status = %d, want %d", got, want)
 		}
-		var quizzes []struct {
-			Title string `json:"title"`
-		}
+		var quizzes []apiclient.Quiz
 		if derr := json.NewDecoder(resp.Body).Decode(&quizzes); derr != nil {
 			t.Fatalf("decode: %v", derr)
 		}
@@ -177,7 +176,7 @@ func TestQuizModeForm_Integration(t *testing.T) {
 	createForm.Add("description", "Created via the admin form.")
 	createForm.Add("time_limit_seconds", "10")
 	createForm.Add("visibility", quiz.VisibilityPublic)
-	createForm.Add("mode", quiz.ModeLive)
+	createForm.Add("mode", string(quiz.ModeLive))
 	createForm.Add("csrf_token", createToken)
 
 	location := postQuizForm(ctx, t, client, baseURL+"/admin/quizzes", createForm)
@@ -199,7 +198,7 @@ func TestQuizModeForm_Integration(t *testing.T) {
 	editForm.Add("description", "Created via the admin form.")
 	editForm.Add("time_limit_seconds", "10")
 	editForm.Add("visibility", quiz.VisibilityPublic)
-	editForm.Add("mode", quiz.ModeSolo)
+	editForm.Add("mode", string(quiz.ModeSolo))
 	editForm.Add("csrf_token", editToken)
 
 	postQuizForm(ctx, t, client, fmt.Sprintf("%s/admin/quizzes/%d", baseURL, quizID), editForm)
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/starquake/topbanana/internal/quiz"
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // TestQuizVisibility_Integration pins #103: a private quiz is hidden from
@@ -82,9 +83,7 @@ func TestQuizVisibility_Integration(t *testing.T) {
 		if got, want := resp.StatusCode, http.StatusOK; got != want {
 			t.Fatalf("status = %d, want %d", got, want)
 		}
-		var quizzes []struct {
-			Title string `json:"title"`
-		}
+		var quizzes []apiclient.Quiz
 		if derr := json.NewDecoder(resp.Body).Decode(&quizzes); derr != nil {
 			t.Fatalf("decode: %v", derr)
 		}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/starquake/topbanana/internal/quiz"
 	"github.com/starquake/topbanana/internal/store"
+	apiclient "github.com/starquake/topbanana/pkg/client"
 )
 
 // roundItemRes is the wire shape for the `type=round_boundary` variant
@@ -43,13 +44,6 @@ type nextItemRes struct {
 	ID   int64  `json:"id"`
 }
 
-// roundAnswerRes mirrors the JSON shape of POST .../answers. Pulled
-// out for the nested-structs linter.
-type roundAnswerRes struct {
-	Correct bool `json:"correct"`
-	Score   int  `json:"score"`
-}
-
 // roundPlayQuiz is the fixture used by the round play-loop tests: two
 // questions in the quiz's default round. Created fresh per test so a
 // flaky run doesn't leak state across tables. The store attaches both
@@ -435,7 +429,7 @@ func answerNextCorrect(
 	if got, want := peekType(t, body), "question"; got != want {
 		t.Fatalf("/next type = %q, want %q; body=%q", got, want, body)
 	}
-	var q nextQuestionRes
+	var q apiclient.Question
 	if err := json.Unmarshal(body, &q); err != nil {
 		t.Fatalf("decode /next question err = %v, want nil; body=%q", err, body)
 	}
@@ -447,7 +441,7 @@ func answerNextCorrect(
 	if got, want := answerResp.StatusCode, http.StatusOK; got != want {
 		t.Fatalf("answer status = %d, want %d", got, want)
 	}
-	var ans roundAnswerRes
+	var ans apiclient.AnswerResponse
 	if err := json.NewDecoder(answerResp.Body).Decode(&ans); err != nil {
 		t.Fatalf("decode answer err = %v, want nil", err)
 	}
@@ -514,9 +508,7 @@ func createRoundPlayGame(
 	if got, want := resp.StatusCode, http.StatusCreated; got != want {
 		t.Fatalf("create game status = %d, want %d", got, want)
 	}
-	var createGameRes struct {
-		ID string `json:"id"`
-	}
+	var createGameRes apiclient.CreateGameResponse
 	if err := json.NewDecoder(resp.Body).Decode(&createGameRes); err != nil {
 		t.Fatalf("decode create game err = %v, want nil", err)
 	}
@@ -2,7 +2,6 @@ package integration_test
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/starquake/topbanana/internal/dbtest"
 	"github.com/starquake/topbanana/internal/quiz"
 	"github.com/starquake/topbanana/internal/store"
 )
@@ -46,10 +46,7 @@ func postForm(
 // create redirect). Closed via t.Cleanup.
 func openRoundStores(t *testing.T, dbURI string) *store.Stores {
 	t.Helper()
-	db, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	db := dbtest.OpenURI(t, dbURI)
 	t.Cleanup(func() {
 		if cerr := db.Close(); cerr != nil {
 			t.Errorf("db.Close err = %v, want nil", cerr)
@@ -308,7 +305,7 @@ func TestRounds_EmptyTitleRejected(t *testing.T) {
 		baseURL+fmt.Sprintf("/admin/quizzes/%d/rounds", quizID),
 		url.Values{"title": {""}, "csrf_token": {createToken}},
 	)
-	if got, want := status, http.StatusBadRequest; got != want {
+	if got, want := status, http.StatusUnprocessableEntity; got != want {
 		t.Fatalf("empty-title status = %d, want %d; body=%q", got, want, body)
 	}
 	if got, want := string(body), "Give the round a name."; !strings.Contains(got, want) {
@@ -0,0 +1,86 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// extendQuestion posts the host extend for questionID and asserts the status,
+// returning the new deadline on a 200.
+func extendQuestion(
+	ctx context.Context, t *testing.T, client *http.Client, baseURL, code string,
+	questionID int64, seconds, wantStatus int,
+) time.Time {
+	t.Helper()
+	target := fmt.Sprintf("%s/api/sessions/%s/questions/%d/extend", baseURL, code, questionID)
+	resp := httpPostJSON(ctx, t, client, target, fmt.Sprintf(`{"seconds": %d}`, seconds))
+	defer closeBody(t, resp.Body)
+	if got := resp.StatusCode; got != wantStatus {
+		t.Fatalf("extend status = %d, want %d", got, wantStatus)
+	}
+	if wantStatus != http.StatusOK {
+		return time.Time{}
+	}
+	var res struct {
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatalf("decode extend response: %v", err)
+	}
+
+	return res.ExpiresAt
+}
+
+// TestSessionExtendQuestion_HostMovesDeadline drives the host "extend" control
+// against the real server: only the host may extend, the extension must be in
+// range and target the open question, and a successful extend moves the
+// deadline every participant's state read reports.
+func TestSessionExtendQuestion_HostMovesDeadline(t *testing.T) {
+	t.Parallel()
+
+	ctx, setup := setupIntegrationWithEnv(t, map[string]string{
+		"SESSION_RUNNER_BEAT": "250ms",
+	})
+	baseURL := setup.BaseURL
+
+	qz := seedRunnerLiveQuiz(ctx, t, setup.Stores.Quizzes, "extend-question")
+
+	host := &http.Client{
+		Jar:           mustJar(t),
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	registerVerifyAndSignIn(ctx, t, host, baseURL, setup.DBURI, "extend-host", "extend-host-pass-123")
+	code := createSession(ctx, t, host, baseURL, qz.ID)
+
+	player := newAnonClient(t)
+	joinSession(ctx, t, player, baseURL, code, "Slowpoke")
+	startSession(ctx, t, host, baseURL, code)
+
+	state := waitForPhase(ctx, t, player, baseURL, code, "question")
+	if state.Question == nil || state.Question.ExpiresAt == nil {
+		t.Fatal("question phase has no question deadline in state")
+	}
+	questionID, expiresAt := state.Question.ID, *state.Question.ExpiresAt
+
+	extendQuestion(ctx, t, player, baseURL, code, questionID, 30, http.StatusForbidden)
+	extendQuestion(ctx, t, host, baseURL, code, questionID, 0, http.StatusBadRequest)
+	extendQuestion(ctx, t, host, baseURL, code, questionID+1000, 30, http.StatusConflict)
+	extendQuestion(ctx, t, host, baseURL, "NOPE99", questionID, 30, http.StatusNotFound)
+
+	got := extendQuestion(ctx, t, host, baseURL, code, questionID, 30, http.StatusOK)
+	if want := expiresAt.Add(30 * time.Second); !got.Equal(want) {
+		t.Errorf("extend expiresAt = %v, want %v", got, want)
+	}
+
+	after := getRunnerState(ctx, t, player, baseURL, code)
+	if after.Question == nil || after.Question.ExpiresAt == nil {
+		t.Fatal("state after extend has no question deadline")
+	}
+	if !after.Question.ExpiresAt.Equal(got) {
+		t.Errorf("player state expiresAt = %v, want %v", *after.Question.ExpiresAt, got)
+	}
+}
@@ -26,6 +26,7 @@ type sessionStatePlayerRes struct {
 	PlayerID    int64  `json:"playerId"`
 	DisplayName string `json:"displayName"`
 	IsReady     bool   `json:"isReady"`
+	Notes       string `json:"notes"`
 }
 
 type sessionStateQuizRes struct {
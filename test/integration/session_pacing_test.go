@@ -0,0 +1,133 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// postHostControl posts body to the session control at path and asserts the
+// status, returning the response for the caller to read and close.
+func postHostControl(
+	ctx context.Context, t *testing.T, client *http.Client, baseURL, code, path, body string, wantStatus int,
+) *http.Response {
+	t.Helper()
+	resp := httpPostJSON(ctx, t, client, fmt.Sprintf("%s/api/sessions/%s/%s", baseURL, code, path), body)
+	if got := resp.StatusCode; got != wantStatus {
+		closeBody(t, resp.Body)
+		t.Fatalf("%s status = %d, want %d", path, got, wantStatus)
+	}
+
+	return resp
+}
+
+// hostControl posts a host control and discards the response body.
+func hostControl(
+	ctx context.Context, t *testing.T, client *http.Client, baseURL, code, path, body string, wantStatus int,
+) {
+	t.Helper()
+	resp := postHostControl(ctx, t, client, baseURL, code, path, body, wantStatus)
+	closeBody(t, resp.Body)
+}
+
+// advanceMoved posts a stale advance and returns the session state the 409
+// carries.
+func advanceMoved(
+	ctx context.Context, t *testing.T, client *http.Client, baseURL, code, body string,
+) sessionRunnerStateRes {
+	t.Helper()
+	resp := postHostControl(ctx, t, client, baseURL, code, "advance", body, http.StatusConflict)
+	defer closeBody(t, resp.Body)
+	var state sessionRunnerStateRes
+	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
+		t.Fatalf("decode advance conflict: %v", err)
+	}
+
+	return state
+}
+
+// TestSessionHostPacing drives the host pacing controls against the real
+// server: only the host may set the pacing, advance or close answering; the
+// pacing shows on the state read; an advance names the screen it leaves, so a
+// phase with no "next" is refused and a stale press gets the current state
+// back instead of moving the room on twice; and closing answering reveals the
+// question.
+func TestSessionHostPacing(t *testing.T) {
+	t.Parallel()
+
+	ctx, setup := setupIntegrationWithEnv(t, map[string]string{
+		"SESSION_RUNNER_BEAT": "250ms",
+	})
+	baseURL := setup.BaseURL
+
+	qz := seedRunnerLiveQuiz(ctx, t, setup.Stores.Quizzes, "host-pacing")
+
+	host := &http.Client{
+		Jar:           mustJar(t),
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	registerVerifyAndSignIn(ctx, t, host, baseURL, setup.DBURI, "pacing-host", "pacing-host-pass-123")
+	code := createSession(ctx, t, host, baseURL, qz.ID)
+
+	player := newAnonClient(t)
+	joinSession(ctx, t, player, baseURL, code, "Paced")
+
+	hostControl(ctx, t, player, baseURL, code, "pacing", `{"hostAdvance": true}`, http.StatusForbidden)
+	hostControl(ctx, t, host, baseURL, code, "pacing", `{"hostAdvance": "yes"}`, http.StatusBadRequest)
+	hostControl(ctx, t, host, baseURL, "NOPE99", "pacing", `{"hostAdvance": true}`, http.StatusNotFound)
+	hostControl(ctx, t, host, baseURL, code, "pacing", `{"hostAdvance": true}`, http.StatusNoContent)
+
+	var paced struct {
+		HostAdvance bool `json:"hostAdvance"`
+	}
+	resp := httpGet(ctx, t, player, fmt.Sprintf("%s/api/sessions/%s/state", baseURL, code))
+	err := json.NewDecoder(resp.Body).Decode(&paced)
+	closeBody(t, resp.Body)
+	if err != nil {
+		t.Fatalf("decode state: %v", err)
+	}
+	if !paced.HostAdvance {
+		t.Error("state hostAdvance = false after the host took the pacing, want true")
+	}
+
+	hostControl(ctx, t, host, baseURL, code, "advance", `{"phase": "lobby"}`, http.StatusUnprocessableEntity)
+	hostControl(ctx, t, host, baseURL, code, "advance", `{"phase": 3}`, http.StatusBadRequest)
+
+	startSession(ctx, t, host, baseURL, code)
+	waitForPhase(ctx, t, host, baseURL, code, "round_intro")
+
+	hostControl(ctx, t, player, baseURL, code, "advance", `{"phase": "round_intro"}`, http.StatusForbidden)
+	hostControl(ctx, t, host, baseURL, code, "advance", `{"phase": "round_intro"}`, http.StatusNoContent)
+
+	// A second press from the intro, as a second host device would send it.
+	moved := advanceMoved(ctx, t, host, baseURL, code, `{"phase": "round_intro"}`)
+	if moved.Phase != "question" || moved.Question == nil {
+		t.Fatalf("conflict state phase = %q, question %v, want the open question", moved.Phase, moved.Question)
+	}
+	questionID := moved.Question.ID
+	hostControl(ctx, t, host, baseURL, code, "advance",
+		fmt.Sprintf(`{"phase": "question", "questionId": %d}`, questionID), http.StatusUnprocessableEntity)
+
+	hostControl(ctx, t, player, baseURL, code, "questions/close", `{}`, http.StatusForbidden)
+	hostControl(ctx, t, host, baseURL, code, "questions/close", `{}`, http.StatusNoContent)
+	revealed := getRunnerState(ctx, t, player, baseURL, code)
+	if got, want := revealed.Phase, "reveal"; got != want {
+		t.Fatalf("phase after close = %q, want %q", got, want)
+	}
+	if revealed.Question == nil || len(revealed.Question.CorrectOptionIDs) == 0 {
+		t.Error("reveal after close carries no correct option")
+	}
+	// Closing again is a no-op: nothing is open for answers.
+	hostControl(ctx, t, host, baseURL, code, "questions/close", `{}`, http.StatusNoContent)
+
+	stale := advanceMoved(ctx, t, host, baseURL, code,
+		fmt.Sprintf(`{"phase": "reveal", "questionId": %d}`, questionID+1000))
+	if got, want := stale.Phase, "reveal"; got != want {
+		t.Errorf("conflict state phase for another question = %q, want %q", got, want)
+	}
+	hostControl(ctx, t, host, baseURL, code, "advance",
+		fmt.Sprintf(`{"phase": "reveal", "questionId": %d}`, questionID), http.StatusNoContent)
+	waitForPhase(ctx, t, player, baseURL, code, "intermission")
+}
@@ -1,16 +1,14 @@
 package integration_test
 
 import (
-	"database/sql"
 	"fmt"
-	"log/slog"
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
 	"testing"
 
 	"github.com/starquake/topbanana/internal/quiz"
-	"github.com/starquake/topbanana/internal/store"
+	"github.com/starquake/topbanana/internal/testserver"
 )
 
 // TestGameTenancy_Integration covers #272: the three player-facing endpoints
@@ -25,18 +23,8 @@ import (
 func TestGameTenancy_Integration(t *testing.T) {
 	t.Parallel()
 
-	ctx, srv := startServer(t, nil)
-	baseURL := srv.BaseURL
-
-	dbConn, err := sql.Open("sqlite", srv.DBURI)
-	if err != nil {
-		t.Fatalf("failed to open db: %v", err)
-	}
-	t.Cleanup(func() {
-		if cerr := dbConn.Close(); cerr != nil {
-			t.Errorf("dbConn.Close err = %v, want nil", cerr)
-		}
-	})
+	_, stores, baseURL := testserver.Start(t, testserver.Options{})
+	ctx := t.Context()
 
 	qz := &quiz.Quiz{
 		Title:             "Tenancy Quiz",
@@ -55,7 +43,6 @@ func TestGameTenancy_Integration(t *testing.T) {
 			},
 		},
 	}
-	stores := store.New(dbConn, slog.Default())
 	if createErr := stores.Quizzes.CreateQuiz(ctx, qz); createErr != nil {
 		t.Fatalf("CreateQuiz err = %v, want nil", createErr)
 	}
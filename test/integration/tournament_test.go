@@ -0,0 +1,119 @@
+package integration_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	apiclient "github.com/starquake/topbanana/pkg/client"
+)
+
+var tournamentJoinCodePattern = regexp.MustCompile(`data-testid="tournament-join-code">([A-Z0-9]+)<`)
+
+// TestTournament_Integration drives a tournament end to end: an Admin creates
+// it and adds a stage from the admin pages, a player joins with the code
+// through pkg/client, plays the stage's quiz, and the standings (API and
+// admin page) carry the game's score.
+func TestTournament_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx, setup := setupIntegration(t)
+	baseURL := setup.BaseURL
+	qz := seedSoloQuiz(ctx, t, setup.Stores.Quizzes, "tournament-stage")
+	admin := registerAdminClient(ctx, t, baseURL, setup.DBURI, "tourney-admin")
+
+	token := fetchCSRFToken(ctx, t, admin, baseURL+"/admin/tournaments/new")
+	resp, err := admin.Do(newFormReq(ctx, t, baseURL+"/admin/tournaments", url.Values{
+		"title":      {"Autumn League"},
+		"csrf_token": {token},
+	}))
+	if err != nil {
+		t.Fatalf("create tournament err = %v, want nil", err)
+	}
+	closeBody(t, resp.Body)
+	if got, want := resp.StatusCode, http.StatusSeeOther; got != want {
+		t.Fatalf("create tournament status = %d, want %d", got, want)
+	}
+	viewURL := baseURL + resp.Header.Get("Location")
+
+	token = fetchCSRFToken(ctx, t, admin, viewURL)
+	if got, want := postFormStatus(ctx, t, admin, viewURL+"/stages", url.Values{
+		"quiz_id":    {strconv.FormatInt(qz.ID, 10)},
+		"csrf_token": {token},
+	}), http.StatusSeeOther; got != want {
+		t.Fatalf("add stage status = %d, want %d", got, want)
+	}
+
+	view := getWith(ctx, t, admin, viewURL)
+	body, err := io.ReadAll(view.Body)
+	closeBody(t, view.Body)
+	if err != nil {
+		t.Fatalf("ReadAll err = %v, want nil", err)
+	}
+	m := tournamentJoinCodePattern.FindStringSubmatch(string(body))
+	if m == nil {
+		t.Fatalf("tournament page has no join code; body=%q", body)
+	}
+	joinCode := m[1]
+
+	player, err := apiclient.New(baseURL, nil)
+	if err != nil {
+		t.Fatalf("New err = %v, want nil", err)
+	}
+	joined, err := player.JoinTournament(ctx, strings.ToLower(joinCode))
+	if err != nil {
+		t.Fatalf("JoinTournament err = %v, want nil", err)
+	}
+	if got, want := len(joined.Stages), 1; got != want {
+		t.Fatalf("len(Stages) = %d, want %d", got, want)
+	}
+	if got, want := joined.Stages[0].QuizID, qz.ID; got != want {
+		t.Errorf("Stages[0].QuizID = %d, want %d", got, want)
+	}
+
+	gameID, err := player.CreateGame(ctx, qz.ID)
+	if err != nil {
+		t.Fatalf("CreateGame err = %v, want nil", err)
+	}
+	item, err := player.NextQuestion(ctx, gameID)
+	if err != nil || item.Question == nil {
+		t.Fatalf("NextQuestion = %+v, %v, want a question", item, err)
+	}
+	ans, err := player.SubmitAnswer(ctx, gameID, item.Question.ID, apiclient.AnswerRequest{
+		OptionID: qz.Questions[0].Options[0].ID,
+	})
+	if err != nil {
+		t.Fatalf("SubmitAnswer err = %v, want nil", err)
+	}
+
+	standings, err := player.TournamentStandings(ctx, joinCode)
+	if err != nil {
+		t.Fatalf("TournamentStandings err = %v, want nil", err)
+	}
+	if got, want := len(standings.Entries), 1; got != want {
+		t.Fatalf("len(Entries) = %d, want %d", got, want)
+	}
+	row := standings.Entries[0]
+	if got, want := row.Total, ans.Score; got != want {
+		t.Errorf("Total = %d, want %d", got, want)
+	}
+	if !row.IsCurrentPlayer || !row.Stages[0].Played {
+		t.Errorf("row = %+v, want the current player with stage 1 played", row)
+	}
+
+	_, err = player.TournamentStandings(ctx, "NOPE22")
+	var apiErr *apiclient.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("TournamentStandings unknown code err = %v, want a 404 APIError", err)
+	}
+
+	page := getOK(ctx, t, admin, viewURL)
+	if !strings.Contains(page, ">"+row.DisplayName+"<") {
+		t.Errorf("tournament page missing standings row for %q", row.DisplayName)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/starquake/topbanana/internal/auth"
+	"github.com/starquake/topbanana/internal/dbtest"
 	"github.com/starquake/topbanana/internal/locale"
 	"github.com/starquake/topbanana/internal/mailer"
 	"github.com/starquake/topbanana/internal/store"
@@ -284,10 +285,7 @@ func TestSendVerifyEmail_RoundtripStoresAndSends(t *testing.T) {
 // store access to create + inspect the token + player rows.
 func openStores(t *testing.T, dbURI string) (*sql.DB, *store.Stores) {
 	t.Helper()
-	dbConn, err := sql.Open("sqlite", dbURI)
-	if err != nil {
-		t.Fatalf("sql.Open err = %v, want nil", err)
-	}
+	dbConn := dbtest.OpenURI(t, dbURI)
 
 	return dbConn, store.New(dbConn, slog.Default())
 }